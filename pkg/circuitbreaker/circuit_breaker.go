@@ -7,6 +7,10 @@ import (
 	"time"
 )
 
+// ErrOpen is returned by Execute for calls the breaker rejects without
+// running them
+var ErrOpen = errors.New("circuit breaker is open")
+
 // State represents the circuit breaker state
 type State int
 
@@ -18,25 +22,31 @@ const (
 
 // CircuitBreaker implements the circuit breaker pattern
 type CircuitBreaker struct {
-	mu                sync.RWMutex
-	state             State
-	failureCount      int
-	successCount      int
-	lastFailureTime   time.Time
-	maxFailures       int
-	timeout           time.Duration
-	maxRequests       int
-	onStateChange     func(name string, from State, to State)
-	name              string
+	mu               sync.RWMutex
+	state            State
+	failureCount     int
+	successCount     int
+	halfOpenRequests int
+	lastFailureTime  time.Time
+	maxFailures      int
+	timeout          time.Duration
+	maxRequests      int
+	onStateChange    func(name string, from State, to State)
+	isSuccessful     func(err error) bool
+	name             string
 }
 
 // Config holds circuit breaker configuration
 type Config struct {
-	Name         string
-	MaxFailures  int
-	Timeout      time.Duration
-	MaxRequests  int
+	Name          string
+	MaxFailures   int
+	Timeout       time.Duration
+	MaxRequests   int
 	OnStateChange func(name string, from State, to State)
+	// IsSuccessful reports whether an error returned by fn still counts as a
+	// success, such as an error the caller caused; by default only a nil
+	// error does
+	IsSuccessful func(err error) bool
 }
 
 // NewCircuitBreaker creates a new circuit breaker
@@ -50,6 +60,9 @@ func NewCircuitBreaker(config Config) *CircuitBreaker {
 	if config.MaxRequests == 0 {
 		config.MaxRequests = 1
 	}
+	if config.IsSuccessful == nil {
+		config.IsSuccessful = func(err error) bool { return err == nil }
+	}
 
 	return &CircuitBreaker{
 		state:         StateClosed,
@@ -57,6 +70,7 @@ func NewCircuitBreaker(config Config) *CircuitBreaker {
 		timeout:       config.Timeout,
 		maxRequests:   config.MaxRequests,
 		onStateChange: config.OnStateChange,
+		isSuccessful:  config.IsSuccessful,
 		name:          config.Name,
 	}
 }
@@ -64,26 +78,37 @@ func NewCircuitBreaker(config Config) *CircuitBreaker {
 // Execute runs the given function with circuit breaker protection
 func (cb *CircuitBreaker) Execute(ctx context.Context, fn func() error) error {
 	if !cb.canExecute() {
-		return errors.New("circuit breaker is open")
+		return ErrOpen
 	}
 
 	err := fn()
-	cb.recordResult(err == nil)
+	cb.recordResult(cb.isSuccessful(err))
 	return err
 }
 
-// canExecute checks if the circuit breaker allows execution
+// canExecute checks if the circuit breaker allows execution. Once the
+// timeout has passed an open breaker turns half-open, where it lets at most
+// maxRequests calls through until they have succeeded.
 func (cb *CircuitBreaker) canExecute() bool {
-	cb.mu.RLock()
-	defer cb.mu.RUnlock()
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
 
 	switch cb.state {
 	case StateClosed:
 		return true
 	case StateOpen:
-		return time.Since(cb.lastFailureTime) > cb.timeout
+		if time.Since(cb.lastFailureTime) <= cb.timeout {
+			return false
+		}
+		cb.setState(StateHalfOpen)
+		cb.halfOpenRequests++
+		return true
 	case StateHalfOpen:
-		return cb.successCount < cb.maxRequests
+		if cb.halfOpenRequests >= cb.maxRequests {
+			return false
+		}
+		cb.halfOpenRequests++
+		return true
 	default:
 		return false
 	}
@@ -147,6 +172,7 @@ func (cb *CircuitBreaker) setState(state State) {
 	case StateHalfOpen:
 		cb.successCount = 0
 	}
+	cb.halfOpenRequests = 0
 
 	if cb.onStateChange != nil {
 		cb.onStateChange(cb.name, prevState, state)
@@ -160,6 +186,20 @@ func (cb *CircuitBreaker) State() State {
 	return cb.state
 }
 
+// RetryAfter returns how long an open breaker keeps rejecting calls, or 0
+// when it lets calls through now
+func (cb *CircuitBreaker) RetryAfter() time.Duration {
+	cb.mu.RLock()
+	defer cb.mu.RUnlock()
+	if cb.state != StateOpen {
+		return 0
+	}
+	if wait := cb.timeout - time.Since(cb.lastFailureTime); wait > 0 {
+		return wait
+	}
+	return 0
+}
+
 // Counts returns failure and success counts
 func (cb *CircuitBreaker) Counts() (failures, successes int) {
 	cb.mu.RLock()
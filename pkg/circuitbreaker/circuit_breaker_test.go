@@ -0,0 +1,153 @@
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+var errBackend = errors.New("backend down")
+
+func failing() error { return errBackend }
+
+func succeeding() error { return nil }
+
+// trip runs failing calls until the breaker has seen n failures
+func trip(cb *CircuitBreaker, n int) {
+	for i := 0; i < n; i++ {
+		cb.Execute(context.Background(), failing)
+	}
+}
+
+func TestOpensAfterMaxFailures(t *testing.T) {
+	cb := NewCircuitBreaker(Config{Name: "test", MaxFailures: 3, Timeout: time.Minute})
+
+	trip(cb, 2)
+	if cb.State() != StateClosed {
+		t.Fatalf("state after 2 failures = %v, want closed", cb.State())
+	}
+	trip(cb, 1)
+	if cb.State() != StateOpen {
+		t.Fatalf("state after 3 failures = %v, want open", cb.State())
+	}
+
+	called := false
+	err := cb.Execute(context.Background(), func() error { called = true; return nil })
+	if !errors.Is(err, ErrOpen) {
+		t.Fatalf("err = %v, want ErrOpen", err)
+	}
+	if called {
+		t.Fatal("an open breaker must not run the call")
+	}
+	if wait := cb.RetryAfter(); wait <= 0 || wait > time.Minute {
+		t.Fatalf("RetryAfter = %v, want within the timeout", wait)
+	}
+}
+
+func TestSuccessResetsFailureCount(t *testing.T) {
+	cb := NewCircuitBreaker(Config{Name: "test", MaxFailures: 2, Timeout: time.Minute})
+
+	trip(cb, 1)
+	cb.Execute(context.Background(), succeeding)
+	trip(cb, 1)
+
+	if cb.State() != StateClosed {
+		t.Fatalf("state = %v, want closed: the failures were not consecutive", cb.State())
+	}
+}
+
+func TestHalfOpenProbe(t *testing.T) {
+	tests := []struct {
+		name      string
+		probe     func() error
+		wantState State
+	}{
+		{name: "successful probe closes", probe: succeeding, wantState: StateClosed},
+		{name: "failed probe reopens", probe: failing, wantState: StateOpen},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var transitions []State
+			cb := NewCircuitBreaker(Config{
+				Name:        "test",
+				MaxFailures: 1,
+				Timeout:     10 * time.Millisecond,
+				OnStateChange: func(name string, from, to State) {
+					transitions = append(transitions, to)
+				},
+			})
+			trip(cb, 1)
+			time.Sleep(20 * time.Millisecond)
+			if cb.RetryAfter() != 0 {
+				t.Fatalf("RetryAfter = %v after the timeout, want 0", cb.RetryAfter())
+			}
+
+			cb.Execute(context.Background(), tt.probe)
+
+			if cb.State() != tt.wantState {
+				t.Fatalf("state = %v, want %v", cb.State(), tt.wantState)
+			}
+			want := []State{StateOpen, StateHalfOpen, tt.wantState}
+			if len(transitions) != len(want) {
+				t.Fatalf("transitions = %v, want %v", transitions, want)
+			}
+			for i := range want {
+				if transitions[i] != want[i] {
+					t.Fatalf("transitions = %v, want %v", transitions, want)
+				}
+			}
+		})
+	}
+}
+
+func TestHalfOpenLimitsProbes(t *testing.T) {
+	cb := NewCircuitBreaker(Config{Name: "test", MaxFailures: 1, Timeout: 10 * time.Millisecond})
+	trip(cb, 1)
+	time.Sleep(20 * time.Millisecond)
+
+	probing := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- cb.Execute(context.Background(), func() error {
+			close(probing)
+			<-release
+			return nil
+		})
+	}()
+	<-probing
+
+	if err := cb.Execute(context.Background(), succeeding); !errors.Is(err, ErrOpen) {
+		t.Fatalf("second call during the probe = %v, want ErrOpen", err)
+	}
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if cb.State() != StateClosed {
+		t.Fatalf("state = %v, want closed after the probe succeeded", cb.State())
+	}
+}
+
+func TestIsSuccessful(t *testing.T) {
+	errCaller := errors.New("bad request")
+	cb := NewCircuitBreaker(Config{
+		Name:         "test",
+		MaxFailures:  1,
+		Timeout:      time.Minute,
+		IsSuccessful: func(err error) bool { return err == nil || errors.Is(err, errCaller) },
+	})
+
+	err := cb.Execute(context.Background(), func() error { return errCaller })
+
+	if !errors.Is(err, errCaller) {
+		t.Fatalf("err = %v, want the call's own error", err)
+	}
+	if cb.State() != StateClosed {
+		t.Fatalf("state = %v, want closed: the error does not count as a failure", cb.State())
+	}
+	if failures, _ := cb.Counts(); failures != 0 {
+		t.Fatalf("failures = %d, want 0", failures)
+	}
+}
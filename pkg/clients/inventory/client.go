@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"go.uber.org/zap"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/grpclimits"
 	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
 	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
 )
@@ -24,6 +25,11 @@ type Client struct {
 type Config struct {
 	Address string
 	Timeout time.Duration
+	// MessageLimits bounds request and response sizes; zero values use
+	// grpclimits.DefaultMaxMsgSize
+	MessageLimits grpclimits.MessageLimits
+	// DialOptions are added to the connection, e.g. interceptors
+	DialOptions []grpc.DialOption
 }
 
 // New creates a new Inventory service client
@@ -32,10 +38,12 @@ func New(config Config, logger *zap.Logger) (*Client, error) {
 		config.Timeout = 30 * time.Second
 	}
 
-	conn, err := grpc.Dial(config.Address, 
+	dialOpts := append([]grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithTimeout(config.Timeout),
-	)
+		config.MessageLimits.DialOption(),
+	}, config.DialOptions...)
+	conn, err := grpc.Dial(config.Address, dialOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to inventory service: %w", err)
 	}
@@ -122,6 +130,27 @@ func (c *Client) UpdateInventory(ctx context.Context, item *models.InventoryItem
 	return resp.Success, nil
 }
 
+// GetLocation gets a location from the inventory service's location registry
+func (c *Client) GetLocation(ctx context.Context, id string) (*models.StoreLocation, error) {
+	c.logger.Debug("Getting location", zap.String("id", id))
+
+	resp, err := c.client.GetLocation(ctx, &inventoryv1.GetLocationRequest{Id: id})
+	if err != nil {
+		c.logger.Error("Failed to get location", zap.Error(err))
+		return nil, fmt.Errorf("failed to get location: %w", err)
+	}
+
+	location := resp.GetLocation()
+	return &models.StoreLocation{
+		ID:      location.GetId(),
+		Name:    location.GetName(),
+		Type:    location.GetType(),
+		City:    location.GetCity(),
+		Country: location.GetCountry(),
+		Active:  location.GetActive(),
+	}, nil
+}
+
 // ListInventory lists all inventory items with pagination
 func (c *Client) ListInventory(ctx context.Context, limit, offset int32) ([]*models.InventoryItem, error) {
 	c.logger.Debug("Listing inventory")
@@ -144,6 +173,71 @@ func (c *Client) ListInventory(ctx context.Context, limit, offset int32) ([]*mod
 	return items, nil
 }
 
+// ListInventoryFiltered lists a page of inventory items, optionally at one
+// location, in one stock status (in_stock, low_stock, out_of_stock) and
+// carrying all of the given tags. A non-zero updatedSince keeps only items
+// modified after it, oldest change first, for incremental syncs.
+func (c *Client) ListInventoryFiltered(ctx context.Context, locationID, stockStatus string, tags []string, updatedSince time.Time, limit, offset int32) (*models.ListInventoryResponse, error) {
+	c.logger.Debug("Listing filtered inventory",
+		zap.String("locationID", locationID),
+		zap.String("stockStatus", stockStatus),
+		zap.Strings("tags", tags),
+		zap.Time("updatedSince", updatedSince),
+	)
+
+	var since string
+	if !updatedSince.IsZero() {
+		since = updatedSince.UTC().Format(time.RFC3339Nano)
+	}
+
+	var (
+		resp *inventoryv1.ListInventoryResponse
+		err  error
+	)
+	if locationID != "" {
+		resp, err = c.client.ListInventoryByLocation(ctx, &inventoryv1.ListInventoryByLocationRequest{
+			LocationId:   locationID,
+			Limit:        limit,
+			Offset:       offset,
+			StockStatus:  stockStatus,
+			Tags:         tags,
+			UpdatedSince: since,
+		})
+	} else {
+		resp, err = c.client.ListInventory(ctx, &inventoryv1.ListInventoryRequest{
+			Limit:        limit,
+			Offset:       offset,
+			StockStatus:  stockStatus,
+			Tags:         tags,
+			UpdatedSince: since,
+		})
+	}
+	if err != nil {
+		c.logger.Error("Failed to list filtered inventory", zap.Error(err))
+		return nil, fmt.Errorf("failed to list inventory: %w", err)
+	}
+
+	return c.convertToListInventoryResponse(resp), nil
+}
+
+// UpdateInventoryTags adds and removes tags on inventory items at a location.
+// With no item IDs every item at the location is updated. It returns the
+// number of items matched.
+func (c *Client) UpdateInventoryTags(ctx context.Context, locationID string, itemIDs, add, remove []string) (int64, error) {
+	resp, err := c.client.UpdateInventoryTags(ctx, &inventoryv1.UpdateInventoryTagsRequest{
+		LocationId: locationID,
+		ItemIds:    itemIDs,
+		AddTags:    add,
+		RemoveTags: remove,
+	})
+	if err != nil {
+		c.logger.Error("Failed to update inventory tags", zap.Error(err))
+		return 0, fmt.Errorf("failed to update inventory tags: %w", err)
+	}
+
+	return resp.GetMatchedCount(), nil
+}
+
 // AddStock adds stock to an inventory item
 func (c *Client) AddStock(ctx context.Context, id string, quantity int32, reason, performedBy string) (bool, error) {
 	c.logger.Debug("Adding stock", zap.String("id", id), zap.Int32("quantity", quantity))
@@ -204,6 +298,50 @@ func (c *Client) ReserveStock(ctx context.Context, id string, quantity int32) (b
 	return resp.Success, nil
 }
 
+// ReleaseReservation releases reserved stock without fulfilling it. Passing
+// the order the item is reserved for takes the quantity off that reservation.
+func (c *Client) ReleaseReservation(ctx context.Context, id string, quantity int32, orderID string) error {
+	c.logger.Debug("Releasing reservation",
+		zap.String("id", id),
+		zap.Int32("quantity", quantity),
+		zap.String("order_id", orderID),
+	)
+
+	_, err := c.client.ReleaseReservation(ctx, &inventoryv1.ReleaseReservationRequest{
+		Id:       id,
+		Quantity: quantity,
+		OrderId:  orderID,
+	})
+	if err != nil {
+		c.logger.Error("Failed to release reservation", zap.Error(err))
+		return fmt.Errorf("failed to release reservation: %w", err)
+	}
+
+	return nil
+}
+
+// FulfillReservation deducts reserved stock from on-hand. Passing the order
+// the item is reserved for marks that reservation as fulfilled.
+func (c *Client) FulfillReservation(ctx context.Context, id string, quantity int32, orderID string) error {
+	c.logger.Debug("Fulfilling reservation",
+		zap.String("id", id),
+		zap.Int32("quantity", quantity),
+		zap.String("order_id", orderID),
+	)
+
+	_, err := c.client.FulfillReservation(ctx, &inventoryv1.FulfillReservationRequest{
+		Id:       id,
+		Quantity: quantity,
+		OrderId:  orderID,
+	})
+	if err != nil {
+		c.logger.Error("Failed to fulfill reservation", zap.Error(err))
+		return fmt.Errorf("failed to fulfill reservation: %w", err)
+	}
+
+	return nil
+}
+
 // CheckAvailability checks item availability at a specific location
 func (c *Client) CheckAvailability(ctx context.Context, locationID string, items []*models.InventoryRequestItem) (*models.CheckAvailabilityResponse, error) {
 	c.logger.Debug("Checking availability", zap.String("location_id", locationID), zap.Int("items_count", len(items)))
@@ -281,27 +419,283 @@ func (c *Client) CompletePickup(ctx context.Context, reservationID, staffID, not
 	return nil
 }
 
-// GetLowStockItems gets inventory items that are low in stock
-// Note: This is a placeholder implementation since the protobuf service doesn't have this method yet
-func (c *Client) GetLowStockItems(ctx context.Context, location string, threshold, limit, offset int) ([]*models.InventoryItem, error) {
-	c.logger.Debug("Getting low stock items", zap.String("location", location), zap.Int("threshold", threshold))
+// GetReservationsForOrder gets the reservations held for an order across all locations
+func (c *Client) GetReservationsForOrder(ctx context.Context, orderID string) ([]*models.InventoryReservation, error) {
+	c.logger.Debug("Getting reservations for order", zap.String("order_id", orderID))
+
+	resp, err := c.client.GetReservationsForOrder(ctx, &inventoryv1.GetReservationsForOrderRequest{
+		OrderId: orderID,
+	})
+	if err != nil {
+		c.logger.Error("Failed to get reservations for order", zap.Error(err))
+		return nil, fmt.Errorf("failed to get reservations for order: %w", err)
+	}
+
+	reservations := make([]*models.InventoryReservation, 0, len(resp.Reservations))
+	for _, r := range resp.Reservations {
+		reservations = append(reservations, c.convertToInventoryReservation(r))
+	}
+
+	return reservations, nil
+}
+
+// ReleaseAllForOrder releases every active reservation of an order, at all
+// locations, and returns the reservations released
+func (c *Client) ReleaseAllForOrder(ctx context.Context, orderID string) ([]*models.InventoryReservation, error) {
+	c.logger.Debug("Releasing all reservations for order", zap.String("order_id", orderID))
 
-	// For now, we'll use ListInventory and filter client-side
-	// In the future, this should be implemented as a proper RPC method
-	allItems, err := c.ListInventory(ctx, int32(limit), int32(offset))
+	resp, err := c.client.ReleaseAllForOrder(ctx, &inventoryv1.ReleaseAllForOrderRequest{
+		OrderId: orderID,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get inventory for low stock check: %w", err)
+		c.logger.Error("Failed to release reservations for order", zap.Error(err))
+		return nil, fmt.Errorf("failed to release reservations for order: %w", err)
 	}
 
-	// Filter items that have quantity <= threshold
-	var lowStockItems []*models.InventoryItem
-	for _, item := range allItems {
-		if item.Quantity <= int32(threshold) {
-			if location == "" || item.LocationID == location {
-				lowStockItems = append(lowStockItems, item)
-			}
+	released := make([]*models.InventoryReservation, 0, len(resp.Released))
+	for _, r := range resp.Released {
+		released = append(released, c.convertToInventoryReservation(r))
+	}
+
+	return released, nil
+}
+
+// ReconcileReservations releases active reservations whose orders were
+// cancelled, completed or no longer exist. With dryRun it only reports them.
+func (c *Client) ReconcileReservations(ctx context.Context, dryRun bool) (*models.ReservationReconcileReport, error) {
+	c.logger.Debug("Reconciling reservations", zap.Bool("dry_run", dryRun))
+
+	resp, err := c.client.ReconcileReservations(ctx, &inventoryv1.ReconcileReservationsRequest{
+		DryRun: dryRun,
+	})
+	if err != nil {
+		c.logger.Error("Failed to reconcile reservations", zap.Error(err))
+		return nil, fmt.Errorf("failed to reconcile reservations: %w", err)
+	}
+
+	report := &models.ReservationReconcileReport{
+		ReservationsChecked: resp.ReservationsChecked,
+		OrdersChecked:       resp.OrdersChecked,
+		Corrections:         make([]*models.ReservationCorrection, 0, len(resp.Corrections)),
+		Failed:              resp.Failed,
+		DryRun:              resp.DryRun,
+	}
+	for _, corr := range resp.Corrections {
+		correction := &models.ReservationCorrection{
+			OrderID:      corr.OrderId,
+			OrderState:   corr.OrderState,
+			Reservations: make([]*models.InventoryReservation, 0, len(corr.Reservations)),
 		}
+		for _, r := range corr.Reservations {
+			correction.Reservations = append(correction.Reservations, c.convertToInventoryReservation(r))
+		}
+		report.Corrections = append(report.Corrections, correction)
+	}
+
+	return report, nil
+}
+
+// ReserveForOrder reserves an order's items across locations, all or none,
+// preferring preferredLocationID when it is set. The reservations are linked
+// to the order, so GetReservationsForOrder and ReleaseAllForOrder find them.
+// They expire after ttl; a zero ttl leaves it to the inventory service default.
+func (c *Client) ReserveForOrder(ctx context.Context, orderID, preferredLocationID string, items []*models.InventoryRequestItem, ttl time.Duration) ([]*models.InventoryReservation, error) {
+	c.logger.Debug("Reserving stock for order",
+		zap.String("order_id", orderID),
+		zap.String("preferred_location_id", preferredLocationID),
+		zap.Int("items_count", len(items)),
+		zap.Duration("ttl", ttl),
+	)
+
+	req := &inventoryv1.ReserveWithAllocationRequest{
+		OrderId:    orderID,
+		Lines:      make([]*inventoryv1.AllocationLine, 0, len(items)),
+		TtlSeconds: int64(ttl / time.Second),
+	}
+	for _, item := range items {
+		req.Lines = append(req.Lines, &inventoryv1.AllocationLine{
+			ProductId: item.ProductID,
+			Quantity:  item.Quantity,
+		})
+	}
+	if preferredLocationID != "" {
+		req.Strategy = "PREFER_LOCATION"
+		req.PreferredLocationId = preferredLocationID
+	}
+
+	resp, err := c.client.ReserveWithAllocation(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to reserve stock for order", zap.Error(err))
+		return nil, fmt.Errorf("failed to reserve stock for order: %w", err)
+	}
+
+	var expiresAt *time.Time
+	if t := parseTimestamp(resp.ExpiresAt); !t.IsZero() {
+		expiresAt = &t
+	}
+	reservations := make([]*models.InventoryReservation, 0, len(resp.Allocations))
+	for _, a := range resp.Allocations {
+		reservations = append(reservations, &models.InventoryReservation{
+			InventoryItemID: a.InventoryItemId,
+			ProductID:       a.ProductId,
+			LocationID:      a.LocationId,
+			Quantity:        a.Quantity,
+			Status:          "active",
+			ExpiresAt:       expiresAt,
+		})
+	}
+
+	return reservations, nil
+}
+
+// SubscribeBackInStock subscribes a user to a back-in-stock alert for a product
+func (c *Client) SubscribeBackInStock(ctx context.Context, userID, productID string) (*models.BackInStockSubscription, error) {
+	c.logger.Debug("Subscribing to back-in-stock alert",
+		zap.String("user_id", userID),
+		zap.String("product_id", productID),
+	)
+
+	resp, err := c.client.SubscribeBackInStock(ctx, &inventoryv1.SubscribeBackInStockRequest{
+		UserId:    userID,
+		ProductId: productID,
+	})
+	if err != nil {
+		c.logger.Error("Failed to subscribe to back-in-stock alert", zap.Error(err))
+		return nil, fmt.Errorf("failed to subscribe to back-in-stock alert: %w", err)
 	}
 
-	return lowStockItems, nil
+	sub := resp.GetSubscription()
+	return &models.BackInStockSubscription{
+		ID:                sub.GetId(),
+		UserID:            sub.GetUserId(),
+		ProductID:         sub.GetProductId(),
+		CreatedAt:         protoTime(sub.GetCreatedAtTs(), sub.GetCreatedAt()),
+		AlreadySubscribed: resp.GetAlreadySubscribed(),
+	}, nil
+}
+
+// UnsubscribeBackInStock removes a user's back-in-stock alert for a product
+func (c *Client) UnsubscribeBackInStock(ctx context.Context, userID, productID string) error {
+	c.logger.Debug("Unsubscribing from back-in-stock alert",
+		zap.String("user_id", userID),
+		zap.String("product_id", productID),
+	)
+
+	_, err := c.client.UnsubscribeBackInStock(ctx, &inventoryv1.UnsubscribeBackInStockRequest{
+		UserId:    userID,
+		ProductId: productID,
+	})
+	if err != nil {
+		c.logger.Error("Failed to unsubscribe from back-in-stock alert", zap.Error(err))
+		return fmt.Errorf("failed to unsubscribe from back-in-stock alert: %w", err)
+	}
+
+	return nil
+}
+
+// NotifyBackInStock queues back-in-stock notifications for a product that is available again
+func (c *Client) NotifyBackInStock(ctx context.Context, productID string, available int32) (int, error) {
+	resp, err := c.client.NotifyBackInStock(ctx, &inventoryv1.NotifyBackInStockRequest{
+		ProductId: productID,
+		Available: available,
+	})
+	if err != nil {
+		c.logger.Error("Failed to notify back-in-stock subscribers", zap.Error(err))
+		return 0, fmt.Errorf("failed to notify back-in-stock subscribers: %w", err)
+	}
+
+	return int(resp.GetNotifiedCount()), nil
+}
+
+// RestockReturn puts returned units of a product back into inventory, either as
+// sellable stock or into the damaged bucket
+func (c *Client) RestockReturn(ctx context.Context, productID, locationID string, quantity int32, damaged bool, referenceID, performedBy string) (*models.InventoryItem, error) {
+	c.logger.Debug("Restocking returned units",
+		zap.String("product_id", productID),
+		zap.Int32("quantity", quantity),
+		zap.Bool("damaged", damaged),
+	)
+
+	resp, err := c.client.RestockReturn(ctx, &inventoryv1.RestockReturnRequest{
+		ProductId:   productID,
+		LocationId:  locationID,
+		Quantity:    quantity,
+		Damaged:     damaged,
+		ReferenceId: referenceID,
+		PerformedBy: performedBy,
+	})
+	if err != nil {
+		c.logger.Error("Failed to restock returned units", zap.Error(err))
+		return nil, fmt.Errorf("failed to restock returned units: %w", err)
+	}
+
+	return c.convertToInventoryItem(resp.Inventory), nil
+}
+
+// CountLowStock counts inventory items at or below their reorder point; an
+// empty location counts across all locations
+func (c *Client) CountLowStock(ctx context.Context, locationID string) (int64, error) {
+	resp, err := c.client.CountLowStock(ctx, &inventoryv1.CountLowStockRequest{LocationId: locationID})
+	if err != nil {
+		c.logger.Error("Failed to count low stock items", zap.Error(err))
+		return 0, fmt.Errorf("failed to count low stock items: %w", err)
+	}
+
+	return resp.GetCount(), nil
+}
+
+// GetLowStockItems gets a page of inventory items at or below their reorder
+// point; an empty location lists across all locations
+func (c *Client) GetLowStockItems(ctx context.Context, location string, limit, offset int) (*models.ListInventoryResponse, error) {
+	c.logger.Debug("Getting low stock items", zap.String("location", location))
+
+	resp, err := c.client.ListLowStockItems(ctx, &inventoryv1.ListLowStockItemsRequest{
+		LocationId: location,
+		Limit:      int32(limit),
+		Offset:     int32(offset),
+	})
+	if err != nil {
+		c.logger.Error("Failed to get low stock items", zap.Error(err))
+		return nil, fmt.Errorf("failed to get low stock items: %w", err)
+	}
+
+	return c.convertToListInventoryResponse(resp), nil
+}
+
+// ListDueCounts gets a page of inventory items whose next count date is on or
+// before asOf, earliest first; an empty location lists across all locations
+// and a zero asOf means now
+func (c *Client) ListDueCounts(ctx context.Context, location string, asOf time.Time, limit, offset int) (*models.ListInventoryResponse, error) {
+	c.logger.Debug("Listing inventory items due for counting",
+		zap.String("location", location),
+		zap.Time("as_of", asOf),
+	)
+
+	req := &inventoryv1.ListDueCountsRequest{
+		LocationId: location,
+		Limit:      int32(limit),
+		Offset:     int32(offset),
+	}
+	if !asOf.IsZero() {
+		req.AsOf = asOf.Format(time.RFC3339)
+	}
+	resp, err := c.client.ListDueCounts(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to list inventory items due for counting", zap.Error(err))
+		return nil, fmt.Errorf("failed to list inventory items due for counting: %w", err)
+	}
+
+	return c.convertToListInventoryResponse(resp), nil
+}
+
+// convertToListInventoryResponse converts a protobuf inventory page to the model
+func (c *Client) convertToListInventoryResponse(resp *inventoryv1.ListInventoryResponse) *models.ListInventoryResponse {
+	items := make([]*models.InventoryItem, len(resp.Inventories))
+	for i, item := range resp.Inventories {
+		items[i] = c.convertToInventoryItem(item)
+	}
+	return &models.ListInventoryResponse{
+		Items:      items,
+		TotalCount: resp.TotalCount,
+	}
 }
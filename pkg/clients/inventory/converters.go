@@ -3,6 +3,8 @@ package inventory
 import (
 	"time"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
 	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
 )
@@ -16,22 +18,45 @@ func (c *Client) convertToInventoryItem(proto *inventoryv1.InventoryItem) *model
 	// Calculate available quantity (total - reserved)
 	available := proto.Quantity - proto.Reserved
 	
-	return &models.InventoryItem{
+	item := &models.InventoryItem{
 		ID:          proto.Id,
 		ProductID:   proto.ProductId,
 		SKU:         proto.Sku,
 		Quantity:    proto.Quantity,
 		Reserved:    proto.Reserved,
+		Damaged:     proto.Damaged,
+		Backordered: proto.Backordered,
 		LocationID:  proto.LocationId,
+		Tags:        proto.Tags,
 		Available:   available,
 		ReorderAt:   proto.ReorderThreshold,
 		ReorderQty:  proto.ReorderAmount,
 		Cost:        0.0, // Cost not available in protobuf schema
 		
-		// Handle timestamp conversion from string
-		CreatedAt: parseTimestamp(proto.CreatedAt),
-		UpdatedAt: parseTimestamp(proto.LastUpdated),
+		CreatedAt: protoTime(proto.CreatedAtTs, proto.CreatedAt),
+		UpdatedAt: protoTime(proto.LastUpdatedTs, proto.LastUpdated),
+	}
+	if nextCount := parseTimestamp(proto.NextCountDate); !nextCount.IsZero() {
+		item.NextCountDate = &nextCount
 	}
+	return item
+}
+
+// convertToInventoryReservation converts a protobuf OrderReservation to a domain InventoryReservation
+func (c *Client) convertToInventoryReservation(proto *inventoryv1.OrderReservation) *models.InventoryReservation {
+	reservation := &models.InventoryReservation{
+		InventoryItemID: proto.InventoryItemId,
+		ProductID:       proto.ProductId,
+		SKU:             proto.Sku,
+		LocationID:      proto.LocationId,
+		Quantity:        proto.Quantity,
+		Status:          proto.Status,
+		UpdatedAt:       protoTime(proto.UpdatedAtTs, proto.UpdatedAt),
+	}
+	if expiresAt := parseTimestamp(proto.ExpiresAt); !expiresAt.IsZero() {
+		reservation.ExpiresAt = &expiresAt
+	}
+	return reservation
 }
 
 // convertFromInventoryItem converts domain InventoryItem to protobuf InventoryItem
@@ -50,9 +75,11 @@ func (c *Client) convertFromInventoryItem(item *models.InventoryItem) *inventory
 		ReorderThreshold: item.ReorderAt,
 		ReorderAmount:    item.ReorderQty,
 		
-		// Handle timestamp conversion to string
-		CreatedAt:   formatTimestamp(item.CreatedAt),
-		LastUpdated: formatTimestamp(item.UpdatedAt),
+		// Both timestamp forms, while the string ones are deprecated
+		CreatedAt:     formatTimestamp(item.CreatedAt),
+		LastUpdated:   formatTimestamp(item.UpdatedAt),
+		CreatedAtTs:   toProtoTime(item.CreatedAt),
+		LastUpdatedTs: toProtoTime(item.UpdatedAt),
 	}
 }
 
@@ -124,6 +151,23 @@ func parseTimestamp(timestamp string) time.Time {
 	return time.Time{}
 }
 
+// protoTime reads a timestamp from its Timestamp field, falling back to the
+// deprecated string form for inventory services that do not send it yet
+func protoTime(ts *timestamppb.Timestamp, legacy string) time.Time {
+	if ts != nil {
+		return ts.AsTime()
+	}
+	return parseTimestamp(legacy)
+}
+
+// toProtoTime converts time.Time to a proto timestamp, or nil when unset
+func toProtoTime(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
 // formatTimestamp converts time.Time to string
 func formatTimestamp(t time.Time) string {
 	if t.IsZero() {
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"go.uber.org/zap"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/grpclimits"
 	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
 	orderv1 "github.com/leonvanderhaeghen/stockplatform/services/orderSvc/api/gen/go/proto/order/v1"
 )
@@ -24,6 +25,11 @@ type Client struct {
 type Config struct {
 	Address string
 	Timeout time.Duration
+	// MessageLimits bounds request and response sizes; zero values use
+	// grpclimits.DefaultMaxMsgSize
+	MessageLimits grpclimits.MessageLimits
+	// DialOptions are added to the connection, e.g. interceptors
+	DialOptions []grpc.DialOption
 }
 
 // New creates a new Order service client
@@ -32,10 +38,12 @@ func New(config Config, logger *zap.Logger) (*Client, error) {
 		config.Timeout = 30 * time.Second
 	}
 
-	conn, err := grpc.Dial(config.Address, 
+	dialOpts := append([]grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithTimeout(config.Timeout),
-	)
+		config.MessageLimits.DialOption(),
+	}, config.DialOptions...)
+	conn, err := grpc.Dial(config.Address, dialOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to order service: %w", err)
 	}
@@ -183,6 +191,31 @@ func (c *Client) UpdateOrderStatus(ctx context.Context, id, status string) error
 	return nil
 }
 
+// BulkUpdateOrderStatus moves many orders to the same status. Orders that
+// cannot make the transition are reported in their result rather than failing the call.
+func (c *Client) BulkUpdateOrderStatus(ctx context.Context, ids []string, status string) ([]*models.OrderStatusUpdateResult, error) {
+	c.logger.Debug("Bulk updating order status", zap.Int("order_count", len(ids)), zap.String("status", status))
+
+	resp, err := c.client.BulkUpdateOrderStatus(ctx, &orderv1.BulkUpdateOrderStatusRequest{
+		Ids:    ids,
+		Status: convertStringToOrderStatus(status),
+	})
+	if err != nil {
+		c.logger.Error("Failed to bulk update order status", zap.Error(err))
+		return nil, fmt.Errorf("failed to bulk update order status: %w", err)
+	}
+
+	results := make([]*models.OrderStatusUpdateResult, 0, len(resp.Results))
+	for _, r := range resp.Results {
+		results = append(results, &models.OrderStatusUpdateResult{
+			OrderID: r.Id,
+			Success: r.Success,
+			Error:   r.Error,
+		})
+	}
+	return results, nil
+}
+
 // CancelOrder cancels an order
 func (c *Client) CancelOrder(ctx context.Context, id, reason string) error {
 	c.logger.Debug("Cancelling order", zap.String("id", id))
@@ -234,6 +267,181 @@ func (c *Client) AddTrackingCode(ctx context.Context, orderID, trackingCode stri
 	return nil
 }
 
+// AddOrderNote appends a note to an order, or to one of its items when
+// productID is set, and returns the updated order. The author is the
+// authenticated caller forwarded in the context.
+func (c *Client) AddOrderNote(ctx context.Context, orderID, text, productID string) (*models.Order, error) {
+	req := &orderv1.AddOrderNoteRequest{
+		OrderId:   orderID,
+		Text:      text,
+		ProductId: productID,
+	}
+
+	resp, err := c.client.AddOrderNote(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to add order note", zap.Error(err))
+		return nil, fmt.Errorf("failed to add order note: %w", err)
+	}
+
+	return c.convertToOrder(resp.Order), nil
+}
+
+// ListWebhookDeliveries lists webhook delivery status, optionally filtered by subscriber and status
+func (c *Client) ListWebhookDeliveries(ctx context.Context, subscriberID, status string, limit, offset int32) ([]*models.WebhookDelivery, error) {
+	req := &orderv1.ListWebhookDeliveriesRequest{
+		SubscriberId: subscriberID,
+		Status:       status,
+		Limit:        limit,
+		Offset:       offset,
+	}
+
+	resp, err := c.client.ListWebhookDeliveries(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to list webhook deliveries", zap.Error(err))
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+
+	return c.convertToWebhookDeliveries(resp.Deliveries), nil
+}
+
+// ListDeadLetteredWebhooks lists webhook deliveries that exhausted their retries
+func (c *Client) ListDeadLetteredWebhooks(ctx context.Context, subscriberID string, limit, offset int32) ([]*models.WebhookDelivery, error) {
+	req := &orderv1.ListDeadLetteredWebhooksRequest{
+		SubscriberId: subscriberID,
+		Limit:        limit,
+		Offset:       offset,
+	}
+
+	resp, err := c.client.ListDeadLetteredWebhooks(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to list dead-lettered webhooks", zap.Error(err))
+		return nil, fmt.Errorf("failed to list dead-lettered webhooks: %w", err)
+	}
+
+	return c.convertToWebhookDeliveries(resp.Deliveries), nil
+}
+
+// ReplayDeadLetteredWebhook retries a dead-lettered webhook delivery
+func (c *Client) ReplayDeadLetteredWebhook(ctx context.Context, id string) (*models.ReplayWebhookResponse, error) {
+	resp, err := c.client.ReplayDeadLetteredWebhook(ctx, &orderv1.ReplayDeadLetteredWebhookRequest{Id: id})
+	if err != nil {
+		c.logger.Error("Failed to replay webhook delivery", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("failed to replay webhook delivery: %w", err)
+	}
+
+	return &models.ReplayWebhookResponse{
+		Success:  resp.Success,
+		Delivery: c.convertToWebhookDelivery(resp.Delivery),
+	}, nil
+}
+
+// CreateReturn opens a return (RMA) for items of an order
+func (c *Client) CreateReturn(ctx context.Context, orderID string, lines []*models.ReturnLine, reason string) (*models.OrderReturn, error) {
+	req := &orderv1.CreateReturnRequest{
+		OrderId: orderID,
+		Lines:   make([]*orderv1.ReturnLine, 0, len(lines)),
+		Reason:  reason,
+	}
+	for _, line := range lines {
+		req.Lines = append(req.Lines, &orderv1.ReturnLine{
+			ProductId: line.ProductID,
+			Quantity:  line.Quantity,
+			Condition: line.Condition,
+		})
+	}
+
+	resp, err := c.client.CreateReturn(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to create return", zap.String("order_id", orderID), zap.Error(err))
+		return nil, fmt.Errorf("failed to create return: %w", err)
+	}
+
+	return c.convertToOrderReturn(resp.Return), nil
+}
+
+// GetReturn retrieves a return by ID
+func (c *Client) GetReturn(ctx context.Context, id string) (*models.OrderReturn, error) {
+	resp, err := c.client.GetReturn(ctx, &orderv1.GetReturnRequest{Id: id})
+	if err != nil {
+		c.logger.Error("Failed to get return", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("failed to get return: %w", err)
+	}
+
+	return c.convertToOrderReturn(resp.Return), nil
+}
+
+// ListOrderReturns lists the returns of an order
+func (c *Client) ListOrderReturns(ctx context.Context, orderID string) ([]*models.OrderReturn, error) {
+	resp, err := c.client.ListOrderReturns(ctx, &orderv1.ListOrderReturnsRequest{OrderId: orderID})
+	if err != nil {
+		c.logger.Error("Failed to list returns", zap.String("order_id", orderID), zap.Error(err))
+		return nil, fmt.Errorf("failed to list returns: %w", err)
+	}
+
+	returns := make([]*models.OrderReturn, 0, len(resp.Returns))
+	for _, ret := range resp.Returns {
+		returns = append(returns, c.convertToOrderReturn(ret))
+	}
+	return returns, nil
+}
+
+// UpdateReturnStatus moves a return through its workflow. conditions maps product
+// IDs to the condition found on inspection and is used when receiving a return.
+func (c *Client) UpdateReturnStatus(ctx context.Context, id, status string, conditions map[string]string) (*models.OrderReturn, error) {
+	resp, err := c.client.UpdateReturnStatus(ctx, &orderv1.UpdateReturnStatusRequest{
+		Id:         id,
+		Status:     status,
+		Conditions: conditions,
+	})
+	if err != nil {
+		c.logger.Error("Failed to update return status", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("failed to update return status: %w", err)
+	}
+
+	return c.convertToOrderReturn(resp.Return), nil
+}
+
+// GetOrderSummary counts the non-cancelled orders created in [from, to) and sums their revenue
+func (c *Client) GetOrderSummary(ctx context.Context, from, to time.Time) (*models.OrderSummary, error) {
+	resp, err := c.client.GetOrderSummary(ctx, &orderv1.GetOrderSummaryRequest{
+		FromDate: from.Format(time.RFC3339),
+		ToDate:   to.Format(time.RFC3339),
+	})
+	if err != nil {
+		c.logger.Error("Failed to get order summary", zap.Error(err))
+		return nil, fmt.Errorf("failed to get order summary: %w", err)
+	}
+
+	return &models.OrderSummary{
+		OrderCount: resp.GetOrderCount(),
+		Revenue:    resp.GetRevenue(),
+	}, nil
+}
+
+// GetOrderTimeline lists everything that happened to an order, oldest first
+func (c *Client) GetOrderTimeline(ctx context.Context, orderID string) ([]*models.OrderTimelineEntry, error) {
+	resp, err := c.client.GetOrderTimeline(ctx, &orderv1.GetOrderTimelineRequest{OrderId: orderID})
+	if err != nil {
+		c.logger.Error("Failed to get order timeline", zap.String("order_id", orderID), zap.Error(err))
+		return nil, fmt.Errorf("failed to get order timeline: %w", err)
+	}
+
+	entries := make([]*models.OrderTimelineEntry, 0, len(resp.Entries))
+	for _, e := range resp.Entries {
+		entry := &models.OrderTimelineEntry{
+			Type:        e.Type,
+			Actor:       e.Actor,
+			Summary:     e.Summary,
+			ReferenceID: e.ReferenceId,
+		}
+		if t, err := time.Parse(time.RFC3339, e.Timestamp); err == nil {
+			entry.Timestamp = t
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
 // Helper function to convert string status to protobuf enum
 func convertStringToOrderStatus(status string) orderv1.OrderStatus {
 	switch status {
@@ -2,11 +2,34 @@ package order
 
 import (
 	"time"
-	
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
 	orderv1 "github.com/leonvanderhaeghen/stockplatform/services/orderSvc/api/gen/go/proto/order/v1"
 )
 
+// protoTime reads a timestamp from its Timestamp field, falling back to the
+// deprecated RFC3339 string for order services that do not send it yet. It
+// returns the zero time when neither is set.
+func protoTime(ts *timestamppb.Timestamp, legacy string) time.Time {
+	if ts != nil {
+		return ts.AsTime()
+	}
+	if t, err := time.Parse(time.RFC3339, legacy); err == nil {
+		return t
+	}
+	return time.Time{}
+}
+
+// protoTimeString is protoTime in RFC3339, or "" when neither field is set
+func protoTimeString(ts *timestamppb.Timestamp, legacy string) string {
+	if ts != nil {
+		return ts.AsTime().Format(time.RFC3339)
+	}
+	return legacy
+}
+
 // convertToOrder converts protobuf Order to domain Order
 func (c *Client) convertToOrder(proto *orderv1.Order) *models.Order {
 	if proto == nil {
@@ -18,6 +41,18 @@ func (c *Client) convertToOrder(proto *orderv1.Order) *models.Order {
 		CustomerID:  proto.UserId,
 		Status:      convertOrderStatusFromProto(proto.Status),
 		TotalAmount: proto.TotalAmount,
+		Notes:       proto.Notes,
+	}
+
+	for _, n := range proto.NoteLog {
+		note := &models.OrderNote{
+			ID:        n.Id,
+			AuthorID:  n.AuthorId,
+			Text:      n.Text,
+			ProductID: n.ProductId,
+			CreatedAt: protoTime(n.CreatedAtTs, n.CreatedAt),
+		}
+		order.NoteLog = append(order.NoteLog, note)
 	}
 
 	// Convert order items
@@ -26,17 +61,8 @@ func (c *Client) convertToOrder(proto *orderv1.Order) *models.Order {
 		order.Items[i] = c.convertToOrderItem(protoItem)
 	}
 
-	// Handle timestamps (protobuf uses strings)
-	if proto.CreatedAt != "" {
-		if t, err := time.Parse(time.RFC3339, proto.CreatedAt); err == nil {
-			order.CreatedAt = t
-		}
-	}
-	if proto.UpdatedAt != "" {
-		if t, err := time.Parse(time.RFC3339, proto.UpdatedAt); err == nil {
-			order.UpdatedAt = t
-		}
-	}
+	order.CreatedAt = protoTime(proto.CreatedAtTs, proto.CreatedAt)
+	order.UpdatedAt = protoTime(proto.UpdatedAtTs, proto.UpdatedAt)
 
 	// Handle shipping address if present
 	if proto.ShippingAddress != nil {
@@ -96,12 +122,14 @@ func (c *Client) convertFromOrder(order *models.Order) *orderv1.Order {
 		proto.Items[i] = c.convertFromOrderItem(item)
 	}
 
-	// Handle timestamps (convert to strings)
+	// Handle timestamps, in both forms while the string ones are deprecated
 	if !order.CreatedAt.IsZero() {
 		proto.CreatedAt = order.CreatedAt.Format(time.RFC3339)
+		proto.CreatedAtTs = timestamppb.New(order.CreatedAt)
 	}
 	if !order.UpdatedAt.IsZero() {
 		proto.UpdatedAt = order.UpdatedAt.Format(time.RFC3339)
+		proto.UpdatedAtTs = timestamppb.New(order.UpdatedAt)
 	}
 
 	// Handle shipping address if present
@@ -167,7 +195,9 @@ func (c *Client) convertToListOrdersResponse(proto *orderv1.ListOrdersResponse)
 
 	return &models.ListOrdersResponse{
 		Orders:     orders,
-		TotalCount: int32(len(orders)), // protobuf doesn't have total_count field
+		TotalCount: proto.TotalCount,
+		Limit:      proto.Limit,
+		Offset:     proto.Offset,
 	}
 }
 
@@ -198,6 +228,8 @@ func convertOrderStatusFromProto(protoStatus orderv1.OrderStatus) models.OrderSt
 		return models.OrderStatusDelivered
 	case orderv1.OrderStatus_ORDER_STATUS_CANCELLED:
 		return models.OrderStatusCancelled
+	case orderv1.OrderStatus_ORDER_STATUS_FAILED:
+		return models.OrderStatusFailed
 	default:
 		return models.OrderStatusPending
 	}
@@ -217,7 +249,71 @@ func convertOrderStatusToProto(status models.OrderStatus) orderv1.OrderStatus {
 		return orderv1.OrderStatus_ORDER_STATUS_DELIVERED
 	case models.OrderStatusCancelled:
 		return orderv1.OrderStatus_ORDER_STATUS_CANCELLED
+	case models.OrderStatusFailed:
+		return orderv1.OrderStatus_ORDER_STATUS_FAILED
 	default:
 		return orderv1.OrderStatus_ORDER_STATUS_PENDING
 	}
 }
+
+// convertToWebhookDeliveries converts protobuf webhook deliveries to domain models
+func (c *Client) convertToWebhookDeliveries(protos []*orderv1.WebhookDelivery) []*models.WebhookDelivery {
+	deliveries := make([]*models.WebhookDelivery, 0, len(protos))
+	for _, p := range protos {
+		deliveries = append(deliveries, c.convertToWebhookDelivery(p))
+	}
+	return deliveries
+}
+
+// convertToWebhookDelivery converts a protobuf webhook delivery to a domain model
+func (c *Client) convertToWebhookDelivery(proto *orderv1.WebhookDelivery) *models.WebhookDelivery {
+	if proto == nil {
+		return nil
+	}
+
+	return &models.WebhookDelivery{
+		ID:            proto.Id,
+		EventID:       proto.EventId,
+		EventType:     proto.EventType,
+		OrderID:       proto.OrderId,
+		SubscriberID:  proto.SubscriberId,
+		URL:           proto.Url,
+		Status:        proto.Status,
+		Attempts:      proto.Attempts,
+		LastError:     proto.LastError,
+		Payload:       proto.Payload,
+		CreatedAt:     protoTimeString(proto.CreatedAtTs, proto.CreatedAt),
+		UpdatedAt:     protoTimeString(proto.UpdatedAtTs, proto.UpdatedAt),
+		LastAttemptAt: protoTimeString(proto.LastAttemptAtTs, proto.LastAttemptAt),
+		DeliveredAt:   protoTimeString(proto.DeliveredAtTs, proto.DeliveredAt),
+	}
+}
+
+// convertToOrderReturn converts a protobuf return to a domain model
+func (c *Client) convertToOrderReturn(proto *orderv1.Return) *models.OrderReturn {
+	if proto == nil {
+		return nil
+	}
+
+	ret := &models.OrderReturn{
+		ID:         proto.Id,
+		OrderID:    proto.OrderId,
+		UserID:     proto.UserId,
+		Lines:      make([]*models.ReturnLine, 0, len(proto.Lines)),
+		Reason:     proto.Reason,
+		Status:     proto.Status,
+		CreatedAt:  protoTimeString(proto.CreatedAtTs, proto.CreatedAt),
+		UpdatedAt:  protoTimeString(proto.UpdatedAtTs, proto.UpdatedAt),
+		ReceivedAt: protoTimeString(proto.ReceivedAtTs, proto.ReceivedAt),
+		RefundedAt: protoTimeString(proto.RefundedAtTs, proto.RefundedAt),
+	}
+	for _, line := range proto.Lines {
+		ret.Lines = append(ret.Lines, &models.ReturnLine{
+			ProductID: line.ProductId,
+			Quantity:  line.Quantity,
+			Condition: line.Condition,
+			Restocked: line.Restocked,
+		})
+	}
+	return ret
+}
@@ -0,0 +1,62 @@
+package order
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
+	orderv1 "github.com/leonvanderhaeghen/stockplatform/services/orderSvc/api/gen/go/proto/order/v1"
+)
+
+func TestOrderTimestampsRoundTrip(t *testing.T) {
+	c := &Client{}
+	created := time.Date(2024, 5, 1, 9, 30, 15, 123456789, time.UTC)
+	updated := created.Add(90 * time.Minute)
+
+	proto := c.convertFromOrder(&models.Order{ID: "order-1", CreatedAt: created, UpdatedAt: updated})
+
+	if !proto.GetCreatedAtTs().AsTime().Equal(created) || !proto.GetUpdatedAtTs().AsTime().Equal(updated) {
+		t.Fatalf("timestamps = %v, %v, want %v, %v", proto.GetCreatedAtTs().AsTime(), proto.GetUpdatedAtTs().AsTime(), created, updated)
+	}
+	if proto.GetCreatedAt() != created.Format(time.RFC3339) {
+		t.Fatalf("deprecated created_at = %q, want it still populated", proto.GetCreatedAt())
+	}
+
+	order := c.convertToOrder(proto)
+	if !order.CreatedAt.Equal(created) || !order.UpdatedAt.Equal(updated) {
+		t.Fatalf("round trip = %v, %v, want %v, %v to the nanosecond", order.CreatedAt, order.UpdatedAt, created, updated)
+	}
+}
+
+func TestOrderTimestampsFallBackToStrings(t *testing.T) {
+	c := &Client{}
+	created := time.Date(2024, 5, 1, 9, 30, 15, 0, time.UTC)
+
+	order := c.convertToOrder(&orderv1.Order{
+		Id:        "order-1",
+		CreatedAt: created.Format(time.RFC3339),
+		NoteLog:   []*orderv1.OrderNote{{Id: "note-1", CreatedAt: created.Format(time.RFC3339)}},
+	})
+
+	if !order.CreatedAt.Equal(created) || !order.NoteLog[0].CreatedAt.Equal(created) {
+		t.Fatalf("created at = %v, note %v, want %v from the string fields", order.CreatedAt, order.NoteLog[0].CreatedAt, created)
+	}
+	if !order.UpdatedAt.IsZero() {
+		t.Fatalf("updated at = %v, want zero when neither field is set", order.UpdatedAt)
+	}
+}
+
+func TestOrderTimestampFieldWinsOverString(t *testing.T) {
+	ts := time.Date(2024, 5, 1, 9, 30, 15, 500000000, time.UTC)
+
+	got := protoTime(timestamppb.New(ts), "2020-01-01T00:00:00Z")
+
+	if !got.Equal(ts) {
+		t.Fatalf("protoTime = %v, want the Timestamp %v", got, ts)
+	}
+	if s := protoTimeString(timestamppb.New(ts), ""); s != "2024-05-01T09:30:15Z" {
+		t.Fatalf("protoTimeString = %q, want RFC3339", s)
+	}
+}
@@ -2,13 +2,17 @@ package product
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/grpclimits"
 	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
 	productv1 "github.com/leonvanderhaeghen/stockplatform/services/productSvc/api/gen/go/proto/product/v1"
 )
@@ -24,6 +28,11 @@ type Client struct {
 type Config struct {
 	Address string
 	Timeout time.Duration
+	// MessageLimits bounds request and response sizes; zero values use
+	// grpclimits.DefaultMaxMsgSize
+	MessageLimits grpclimits.MessageLimits
+	// DialOptions are added to the connection, e.g. interceptors
+	DialOptions []grpc.DialOption
 }
 
 // New creates a new Product service client
@@ -32,10 +41,12 @@ func New(config Config, logger *zap.Logger) (*Client, error) {
 		config.Timeout = 30 * time.Second
 	}
 
-	conn, err := grpc.Dial(config.Address, 
+	dialOpts := append([]grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithTimeout(config.Timeout),
-	)
+		config.MessageLimits.DialOption(),
+	}, config.DialOptions...)
+	conn, err := grpc.Dial(config.Address, dialOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to product service: %w", err)
 	}
@@ -55,10 +66,10 @@ func (c *Client) Close() error {
 }
 
 // CreateProduct creates a new product
-func (c *Client) CreateProduct(ctx context.Context, name, description, sku, supplierID string, costPrice, sellingPrice float64, isActive bool, categoryIDs []string) (*models.CreateProductResponse, error) {
+func (c *Client) CreateProduct(ctx context.Context, name, description, sku, supplierID string, costPrice, sellingPrice float64, isActive bool, categoryIDs []string, orderQty models.OrderQuantityLimits) (*models.CreateProductResponse, error) {
 	c.logger.Debug("Creating product", zap.String("name", name))
 	
-	req := convertToCreateProductRequest(name, description, sku, supplierID, costPrice, sellingPrice, isActive, categoryIDs)
+	req := convertToCreateProductRequest(name, description, sku, supplierID, costPrice, sellingPrice, isActive, categoryIDs, orderQty)
 	
 	resp, err := c.client.CreateProduct(ctx, req)
 	if err != nil {
@@ -70,6 +81,35 @@ func (c *Client) CreateProduct(ctx context.Context, name, description, sku, supp
 	return convertToCreateProductResponse(resp), nil
 }
 
+// UpdateProduct replaces the editable fields of a product. A non-zero version
+// must still be the product's current one, otherwise the Product service
+// rejects the update with codes.Aborted.
+func (c *Client) UpdateProduct(ctx context.Context, id, name, description, sku string, categoryIDs []string, costPrice, sellingPrice string, isActive bool, imageURLs []string, metadata map[string]string, version int64) (*models.Product, error) {
+	c.logger.Debug("Updating product", zap.String("id", id), zap.Int64("version", version))
+
+	req := &productv1.UpdateProductRequest{
+		Id:           id,
+		Name:         name,
+		Description:  description,
+		Sku:          sku,
+		CategoryIds:  categoryIDs,
+		CostPrice:    costPrice,
+		SellingPrice: sellingPrice,
+		IsActive:     isActive,
+		ImageUrls:    imageURLs,
+		Metadata:     metadata,
+		Version:      version,
+	}
+
+	resp, err := c.client.UpdateProduct(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to update product", zap.Error(err))
+		return nil, fmt.Errorf("failed to update product: %w", err)
+	}
+
+	return convertToProduct(resp.Product), nil
+}
+
 // GetProduct retrieves a product by ID
 func (c *Client) GetProduct(ctx context.Context, id string) (*models.Product, error) {
 	c.logger.Debug("Getting product", zap.String("id", id))
@@ -87,6 +127,20 @@ func (c *Client) GetProduct(ctx context.Context, id string) (*models.Product, er
 	return convertToProduct(resp.Product), nil
 }
 
+// BatchGetProducts retrieves several products by ID in one call. IDs that do
+// not match a product are listed in MissingIDs.
+func (c *Client) BatchGetProducts(ctx context.Context, ids []string) (*models.BatchGetProductsResponse, error) {
+	c.logger.Debug("Batch getting products", zap.Int("count", len(ids)))
+
+	resp, err := c.client.BatchGetProducts(ctx, &productv1.BatchGetProductsRequest{Ids: ids})
+	if err != nil {
+		c.logger.Error("Failed to batch get products", zap.Error(err))
+		return nil, fmt.Errorf("failed to batch get products: %w", err)
+	}
+
+	return convertToBatchGetProductsResponse(resp), nil
+}
+
 // ListProducts lists products with filtering and sorting
 func (c *Client) ListProducts(ctx context.Context, categoryID, supplierID string, isActive *bool, limit, offset int32) (*models.ListProductsResponse, error) {
 	c.logger.Debug("Listing products")
@@ -99,12 +153,14 @@ func (c *Client) ListProducts(ctx context.Context, categoryID, supplierID string
 	}
 	
 	// Add filters if provided
-	if categoryID != "" || supplierID != "" {
-		req.Filter = &productv1.ProductFilter{}
+	if categoryID != "" || supplierID != "" || isActive != nil {
+		req.Filter = &productv1.ProductFilter{
+			SupplierId: supplierID,
+			IsActive:   isActive,
+		}
 		if categoryID != "" {
 			req.Filter.CategoryIds = []string{categoryID}
 		}
-		// Note: SupplierId not available in ProductFilter, ignoring for now
 	}
 	
 	resp, err := c.client.ListProducts(ctx, req)
@@ -154,3 +210,211 @@ func (c *Client) CreateCategory(ctx context.Context, name, description, parentID
 	category := convertProtoCategory(resp.Category)
 	return &category, nil
 }
+
+// GetCategory retrieves a category with its attribute schema
+func (c *Client) GetCategory(ctx context.Context, id string) (*models.Category, error) {
+	resp, err := c.client.GetCategory(ctx, &productv1.GetCategoryRequest{Id: id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+
+	category := convertProtoCategory(resp.Category)
+	return &category, nil
+}
+
+// SetCategoryAttributes replaces the attribute schema of a category
+func (c *Client) SetCategoryAttributes(ctx context.Context, id string, attributes []models.CategoryAttribute) (*models.Category, error) {
+	c.logger.Debug("Setting category attributes",
+		zap.String("id", id),
+		zap.Int("attributes", len(attributes)),
+	)
+
+	req := &productv1.SetCategoryAttributesRequest{
+		CategoryId: id,
+		Attributes: make([]*productv1.CategoryAttribute, 0, len(attributes)),
+	}
+	for _, a := range attributes {
+		req.Attributes = append(req.Attributes, &productv1.CategoryAttribute{
+			Name:          a.Name,
+			Type:          a.Type,
+			Required:      a.Required,
+			AllowedValues: a.AllowedValues,
+		})
+	}
+
+	resp, err := c.client.SetCategoryAttributes(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to set category attributes", zap.Error(err))
+		return nil, fmt.Errorf("failed to set category attributes: %w", err)
+	}
+
+	category := convertProtoCategory(resp.Category)
+	return &category, nil
+}
+
+// ExportProducts exports the products matching filter in the given format.
+// The Product service limits supplier callers to their own products.
+func (c *Client) ExportProducts(ctx context.Context, format string, filter models.ProductExportFilter) (*models.ProductExport, error) {
+	c.logger.Debug("Exporting products",
+		zap.String("format", format),
+		zap.String("supplier_id", filter.SupplierID),
+	)
+
+	resp, err := c.client.ExportProducts(ctx, &productv1.ExportProductsRequest{
+		Filter: toProtoExportFilter(filter),
+		Format: format,
+	})
+	if err != nil {
+		c.logger.Error("Failed to export products", zap.Error(err))
+		return nil, fmt.Errorf("failed to export products: %w", err)
+	}
+
+	return &models.ProductExport{
+		Data:        resp.Data,
+		Filename:    resp.Filename,
+		ContentType: resp.ContentType,
+	}, nil
+}
+
+// StreamProducts passes every product matching filter to fn as the Product
+// service streams them, so large catalogues need no pagination loop.
+// batchSize is the number of products per message, 0 for the service default.
+// Returning an error from fn, or cancelling ctx, ends the stream early.
+func (c *Client) StreamProducts(ctx context.Context, filter models.ProductExportFilter, batchSize int32, fn func(*models.Product) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.client.StreamProducts(ctx, &productv1.StreamProductsRequest{
+		Filter:    toProtoExportFilter(filter),
+		BatchSize: batchSize,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stream products: %w", err)
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to stream products: %w", err)
+		}
+		for _, p := range resp.Products {
+			if err := fn(convertToProduct(p)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// toProtoExportFilter converts an export filter to a protobuf product filter
+func toProtoExportFilter(filter models.ProductExportFilter) *productv1.ProductFilter {
+	protoFilter := &productv1.ProductFilter{
+		SupplierId: filter.SupplierID,
+		IsActive:   filter.IsActive,
+	}
+	if filter.CategoryID != "" {
+		protoFilter.CategoryIds = []string{filter.CategoryID}
+	}
+	if !filter.CreatedAfter.IsZero() {
+		protoFilter.CreatedAfter = timestamppb.New(filter.CreatedAfter)
+	}
+	if !filter.CreatedBefore.IsZero() {
+		protoFilter.CreatedBefore = timestamppb.New(filter.CreatedBefore)
+	}
+	return protoFilter
+}
+
+// RebuildSearchIndex asks the Product service to recreate its text search index
+// and returns the number of products covered by it
+func (c *Client) RebuildSearchIndex(ctx context.Context) (int64, error) {
+	c.logger.Debug("Rebuilding product search index")
+
+	resp, err := c.client.RebuildSearchIndex(ctx, &productv1.RebuildSearchIndexRequest{})
+	if err != nil {
+		c.logger.Error("Failed to rebuild search index", zap.Error(err))
+		return 0, fmt.Errorf("failed to rebuild search index: %w", err)
+	}
+
+	return resp.ProductsIndexed, nil
+}
+
+// ReassignSupplierProducts moves every product of one supplier to another and
+// returns the number of products moved
+func (c *Client) ReassignSupplierProducts(ctx context.Context, fromSupplierID, toSupplierID string) (int64, error) {
+	c.logger.Debug("Reassigning supplier products",
+		zap.String("from_supplier_id", fromSupplierID),
+		zap.String("to_supplier_id", toSupplierID),
+	)
+
+	resp, err := c.client.ReassignSupplierProducts(ctx, &productv1.ReassignSupplierProductsRequest{
+		FromSupplierId: fromSupplierID,
+		ToSupplierId:   toSupplierID,
+	})
+	if err != nil {
+		c.logger.Error("Failed to reassign supplier products", zap.Error(err))
+		return 0, fmt.Errorf("failed to reassign supplier products: %w", err)
+	}
+
+	return resp.ProductsReassigned, nil
+}
+
+// CreateProductReview reviews a product as the user forwarded in the
+// outgoing metadata
+func (c *Client) CreateProductReview(ctx context.Context, productID string, rating int32, text string) (*models.ProductReview, error) {
+	c.logger.Debug("Creating product review", zap.String("product_id", productID))
+
+	resp, err := c.client.CreateProductReview(ctx, &productv1.CreateProductReviewRequest{
+		ProductId: productID,
+		Rating:    rating,
+		Text:      text,
+	})
+	if err != nil {
+		c.logger.Debug("Failed to create product review", zap.Error(err))
+		return nil, fmt.Errorf("failed to create product review: %w", err)
+	}
+
+	return convertReview(resp.Review), nil
+}
+
+// ListProductReviews lists a page of a product's reviews, newest first
+func (c *Client) ListProductReviews(ctx context.Context, productID string, includeUnapproved bool, limit, offset int32) (*models.ListProductReviewsResponse, error) {
+	c.logger.Debug("Listing product reviews", zap.String("product_id", productID))
+
+	resp, err := c.client.ListProductReviews(ctx, &productv1.ListProductReviewsRequest{
+		ProductId:         productID,
+		IncludeUnapproved: includeUnapproved,
+		Limit:             limit,
+		Offset:            offset,
+	})
+	if err != nil {
+		c.logger.Error("Failed to list product reviews", zap.Error(err))
+		return nil, fmt.Errorf("failed to list product reviews: %w", err)
+	}
+
+	reviews := make([]*models.ProductReview, 0, len(resp.Reviews))
+	for _, r := range resp.Reviews {
+		reviews = append(reviews, convertReview(r))
+	}
+	return &models.ListProductReviewsResponse{Reviews: reviews, TotalCount: resp.TotalCount}, nil
+}
+
+// ModerateProductReview approves or rejects a review
+func (c *Client) ModerateProductReview(ctx context.Context, reviewID string, approved bool) (*models.ProductReview, error) {
+	c.logger.Debug("Moderating product review",
+		zap.String("review_id", reviewID),
+		zap.Bool("approved", approved),
+	)
+
+	resp, err := c.client.ModerateProductReview(ctx, &productv1.ModerateProductReviewRequest{
+		ReviewId: reviewID,
+		Approved: approved,
+	})
+	if err != nil {
+		c.logger.Error("Failed to moderate product review", zap.Error(err))
+		return nil, fmt.Errorf("failed to moderate product review: %w", err)
+	}
+
+	return convertReview(resp.Review), nil
+}
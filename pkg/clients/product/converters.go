@@ -29,6 +29,12 @@ func convertToProduct(protoProduct *productv1.Product) *models.Product {
 	// 	}
 	// }
 
+	var deletedAt *time.Time
+	if protoProduct.IsDeleted && protoProduct.DeletedAt != nil {
+		t := protoProduct.DeletedAt.AsTime()
+		deletedAt = &t
+	}
+
 	return &models.Product{
 		ID:          protoProduct.Id,
 		Name:        protoProduct.Name,
@@ -45,7 +51,55 @@ func convertToProduct(protoProduct *productv1.Product) *models.Product {
 		SupplierID:  protoProduct.SupplierId,
 		CreatedAt:   convertTimestamp(protoProduct.CreatedAt),
 		UpdatedAt:   convertTimestamp(protoProduct.UpdatedAt),
+		CreatedBy:   protoProduct.CreatedBy,
+		UpdatedBy:   protoProduct.UpdatedBy,
+		IsPublished: protoProduct.IsPublished,
+		DeletedAt:   deletedAt,
+		OrderQuantityLimits: models.OrderQuantityLimits{
+			MinOrderQty:       protoProduct.MinOrderQty,
+			MaxOrderQty:       protoProduct.MaxOrderQty,
+			OrderQtyIncrement: protoProduct.OrderQtyIncrement,
+		},
+		BundleComponents: convertBundleComponents(protoProduct.BundleComponents),
+		RatingAverage:    protoProduct.RatingAverage,
+		RatingCount:      protoProduct.RatingCount,
+		Version:          protoProduct.Version,
+	}
+}
+
+// convertReview converts a protobuf product review
+func convertReview(r *productv1.ProductReview) *models.ProductReview {
+	if r == nil {
+		return nil
+	}
+	return &models.ProductReview{
+		ID:               r.Id,
+		ProductID:        r.ProductId,
+		UserID:           r.UserId,
+		Rating:           r.Rating,
+		Text:             r.Text,
+		VerifiedPurchase: r.VerifiedPurchase,
+		Approved:         r.Approved,
+		CreatedAt:        convertTimestamp(r.CreatedAt),
+		UpdatedAt:        convertTimestamp(r.UpdatedAt),
+	}
+}
+
+// convertBundleComponents converts the protobuf components of a bundle
+func convertBundleComponents(protoComponents []*productv1.BundleComponent) []models.BundleComponent {
+	if len(protoComponents) == 0 {
+		return nil
+	}
+	components := make([]models.BundleComponent, 0, len(protoComponents))
+	for _, c := range protoComponents {
+		components = append(components, models.BundleComponent{
+			ProductID:       c.ProductId,
+			VariantOptionID: c.VariantOptionId,
+			SKU:             c.Sku,
+			Quantity:        c.Quantity,
+		})
 	}
+	return components
 }
 
 // convertToProtoProduct converts domain Product to protobuf Product
@@ -99,6 +153,23 @@ func convertToListProductsResponse(resp *productv1.ListProductsResponse) *models
 	}
 }
 
+// convertToBatchGetProductsResponse converts protobuf BatchGetProductsResponse to domain BatchGetProductsResponse
+func convertToBatchGetProductsResponse(resp *productv1.BatchGetProductsResponse) *models.BatchGetProductsResponse {
+	if resp == nil {
+		return nil
+	}
+
+	products := make([]*models.Product, len(resp.Products))
+	for i, protoProduct := range resp.Products {
+		products[i] = convertToProduct(protoProduct)
+	}
+
+	return &models.BatchGetProductsResponse{
+		Products:   products,
+		MissingIDs: resp.MissingIds,
+	}
+}
+
 // convertTimestamp converts protobuf timestamp to time.Time
 func convertTimestamp(ts *timestamppb.Timestamp) time.Time {
 	if ts == nil {
@@ -108,17 +179,20 @@ func convertTimestamp(ts *timestamppb.Timestamp) time.Time {
 }
 
 // convertToCreateProductRequest converts domain parameters to protobuf CreateProductRequest
-func convertToCreateProductRequest(name, description, sku, supplierID string, costPrice, sellingPrice float64, isActive bool, categoryIDs []string) *productv1.CreateProductRequest {
+func convertToCreateProductRequest(name, description, sku, supplierID string, costPrice, sellingPrice float64, isActive bool, categoryIDs []string, orderQty models.OrderQuantityLimits) *productv1.CreateProductRequest {
 	return &productv1.CreateProductRequest{
-		Name:         name,
-		Description:  description,
-		CostPrice:    strconv.FormatFloat(costPrice, 'f', 2, 64),
-		SellingPrice: strconv.FormatFloat(sellingPrice, 'f', 2, 64),
-		Currency:     "USD", // Default currency
-		Sku:          sku,
-		CategoryIds:  categoryIDs,
-		SupplierId:   supplierID,
-		IsActive:     isActive,
+		Name:              name,
+		Description:       description,
+		CostPrice:         strconv.FormatFloat(costPrice, 'f', 2, 64),
+		SellingPrice:      strconv.FormatFloat(sellingPrice, 'f', 2, 64),
+		Currency:          "USD", // Default currency
+		Sku:               sku,
+		CategoryIds:       categoryIDs,
+		SupplierId:        supplierID,
+		IsActive:          isActive,
+		MinOrderQty:       orderQty.MinOrderQty,
+		MaxOrderQty:       orderQty.MaxOrderQty,
+		OrderQtyIncrement: orderQty.OrderQtyIncrement,
 	}
 }
 
@@ -136,7 +210,28 @@ func convertProtoCategory(pc *productv1.Category) models.Category {
 		Path:        pc.Path,
 		CreatedAt:   convertTimestamp(pc.CreatedAt),
 		UpdatedAt:   convertTimestamp(pc.UpdatedAt),
+
+		ProductCount: pc.ProductCount,
+		Attributes:   convertProtoAttributes(pc.Attributes),
+	}
+}
+
+// convertProtoAttributes converts a protobuf category attribute schema to
+// shared models
+func convertProtoAttributes(pb []*productv1.CategoryAttribute) []models.CategoryAttribute {
+	if len(pb) == 0 {
+		return nil
+	}
+	attributes := make([]models.CategoryAttribute, 0, len(pb))
+	for _, a := range pb {
+		attributes = append(attributes, models.CategoryAttribute{
+			Name:          a.GetName(),
+			Type:          a.GetType(),
+			Required:      a.GetRequired(),
+			AllowedValues: a.GetAllowedValues(),
+		})
 	}
+	return attributes
 }
 
 // convertToCategories converts slice of protobuf Categories to models.Category slice
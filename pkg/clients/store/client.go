@@ -7,8 +7,9 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/grpclimits"
 	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
-	storev1 "github.com/leonvanderhaeghen/stockplatform/services/storeSvc/api/gen/go/api/proto/store/v1"
+	storev1 "github.com/leonvanderhaeghen/stockplatform/services/storeSvc/api/gen/go/proto/store/v1"
 )
 
 // Client provides an abstraction for the store service
@@ -17,9 +18,14 @@ type Client struct {
 	client storev1.StoreServiceClient
 }
 
-// NewClient creates a new store service client
-func NewClient(address string) (*Client, error) {
-	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// NewClient creates a new store service client; opts are added to the
+// connection, e.g. interceptors
+func NewClient(address string, opts ...grpc.DialOption) (*Client, error) {
+	dialOpts := append([]grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpclimits.DefaultMessageLimits().DialOption(),
+	}, opts...)
+	conn, err := grpc.Dial(address, dialOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to store service: %w", err)
 	}
@@ -68,21 +74,22 @@ func (c *Client) GetStore(ctx context.Context, id string) (*models.Store, error)
 	return convertStoreFromProto(resp.GetStore()), nil
 }
 
-func (c *Client) ListStores(ctx context.Context, limit, offset int32) ([]*models.Store, error) {
+// ListStores lists a page of the stores matching filter
+func (c *Client) ListStores(ctx context.Context, filter models.StoreFilter, limit, offset int32) (*models.ListStoresResponse, error) {
 	req := &storev1.ListStoresRequest{
-		Limit:  limit,
-		Offset: offset,
+		Search:     filter.Search,
+		Country:    filter.Country,
+		City:       filter.City,
+		State:      filter.State,
+		ActiveOnly: filter.ActiveOnly,
+		Limit:      limit,
+		Offset:     offset,
 	}
 	resp, err := c.client.ListStores(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	
-	stores := make([]*models.Store, len(resp.GetStores()))
-	for i, store := range resp.GetStores() {
-		stores[i] = convertStoreFromProto(store)
-	}
-	return stores, nil
+	return convertListStoresResponseFromProto(resp), nil
 }
 
 func (c *Client) UpdateStore(ctx context.Context, req *storev1.UpdateStoreRequest) (*storev1.UpdateStoreResponse, error) {
@@ -114,6 +121,16 @@ func (c *Client) GetProductStoreLocations(ctx context.Context, req *storev1.GetP
 	return c.client.GetProductStoreLocations(ctx, req)
 }
 
+// CheckCartAvailability checks every item of a cart against a store's stock in one call
+func (c *Client) CheckCartAvailability(ctx context.Context, req *storev1.CheckCartAvailabilityRequest) (*storev1.CheckCartAvailabilityResponse, error) {
+	return c.client.CheckCartAvailability(ctx, req)
+}
+
+// ListStoreCatalog lists the products sold at a store with their store price and availability
+func (c *Client) ListStoreCatalog(ctx context.Context, req *storev1.ListStoreCatalogRequest) (*storev1.ListStoreCatalogResponse, error) {
+	return c.client.ListStoreCatalog(ctx, req)
+}
+
 // Product Reservation Methods
 func (c *Client) ReserveProduct(ctx context.Context, req *storev1.ReserveProductRequest) (*storev1.ReserveProductResponse, error) {
 	return c.client.ReserveProduct(ctx, req)
@@ -2,7 +2,7 @@ package store
 
 import (
 	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
-	storev1 "github.com/leonvanderhaeghen/stockplatform/services/storeSvc/api/gen/go/api/proto/store/v1"
+	storev1 "github.com/leonvanderhaeghen/stockplatform/services/storeSvc/api/gen/go/proto/store/v1"
 )
 
 // convertStoreFromProto converts a protobuf Store to a domain model Store
@@ -81,13 +81,15 @@ func convertListStoresResponseFromProto(protoResponse *storev1.ListStoresRespons
 		return nil
 	}
 
-	// Calculate HasNextPage based on the number of stores returned and total count
+	// Calculate HasNextPage based on how far into the total this page reaches
 	stores := make([]*models.Store, len(protoResponse.GetStores()))
-	hasNextPage := len(protoResponse.GetStores()) > 0 && int32(len(protoResponse.GetStores())) < protoResponse.GetTotalCount()
+	hasNextPage := len(protoResponse.GetStores()) > 0 && protoResponse.GetOffset()+int32(len(protoResponse.GetStores())) < protoResponse.GetTotalCount()
 
 	response := &models.ListStoresResponse{
 		Stores:      stores,
 		TotalCount:  protoResponse.GetTotalCount(),
+		Limit:       protoResponse.GetLimit(),
+		Offset:      protoResponse.GetOffset(),
 		HasNextPage: hasNextPage,
 	}
 
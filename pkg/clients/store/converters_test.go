@@ -0,0 +1,39 @@
+package store
+
+import (
+	"testing"
+
+	storev1 "github.com/leonvanderhaeghen/stockplatform/services/storeSvc/api/gen/go/proto/store/v1"
+)
+
+func TestConvertListStoresResponseHasNextPage(t *testing.T) {
+	page := func(n int) []*storev1.Store {
+		stores := make([]*storev1.Store, n)
+		for i := range stores {
+			stores[i] = &storev1.Store{Id: "store"}
+		}
+		return stores
+	}
+
+	tests := []struct {
+		name string
+		resp *storev1.ListStoresResponse
+		want bool
+	}{
+		{name: "first page", resp: &storev1.ListStoresResponse{Stores: page(2), TotalCount: 5, Limit: 2}, want: true},
+		{name: "middle page", resp: &storev1.ListStoresResponse{Stores: page(2), TotalCount: 5, Limit: 2, Offset: 2}, want: true},
+		{name: "last page", resp: &storev1.ListStoresResponse{Stores: page(1), TotalCount: 5, Limit: 2, Offset: 4}},
+		{name: "empty page", resp: &storev1.ListStoresResponse{TotalCount: 5, Limit: 2, Offset: 6}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := convertListStoresResponseFromProto(tt.resp)
+			if got.HasNextPage != tt.want {
+				t.Errorf("has next page = %v, want %v", got.HasNextPage, tt.want)
+			}
+			if got.Limit != tt.resp.Limit || got.Offset != tt.resp.Offset {
+				t.Errorf("limit %d offset %d, want %d and %d echoed", got.Limit, got.Offset, tt.resp.Limit, tt.resp.Offset)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"go.uber.org/zap"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/grpclimits"
 	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
 	supplierv1 "github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/api/gen/go/proto/supplier/v1"
 )
@@ -24,6 +25,11 @@ type Client struct {
 type Config struct {
 	Address string
 	Timeout time.Duration
+	// MessageLimits bounds request and response sizes; zero values use
+	// grpclimits.DefaultMaxMsgSize
+	MessageLimits grpclimits.MessageLimits
+	// DialOptions are added to the connection, e.g. interceptors
+	DialOptions []grpc.DialOption
 }
 
 // New creates a new Supplier service client
@@ -32,10 +38,12 @@ func New(config Config, logger *zap.Logger) (*Client, error) {
 		config.Timeout = 30 * time.Second
 	}
 
-	conn, err := grpc.Dial(config.Address, 
+	dialOpts := append([]grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithTimeout(config.Timeout),
-	)
+		config.MessageLimits.DialOption(),
+	}, config.DialOptions...)
+	conn, err := grpc.Dial(config.Address, dialOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to supplier service: %w", err)
 	}
@@ -103,28 +111,12 @@ func (c *Client) GetSupplier(ctx context.Context, id string) (*models.Supplier,
 	return c.convertToSupplier(resp.Supplier), nil
 }
 
-// UpdateSupplier updates an existing supplier
-func (c *Client) UpdateSupplier(ctx context.Context, id, name, contactPerson, email, phone, address, city, state, country, postalCode, taxID, website, currency, paymentTerms string, leadTimeDays int32, metadata map[string]string) (*models.UpdateSupplierResponse, error) {
+// UpdateSupplier applies a partial update to an existing supplier. Only the
+// fields set on update are sent, so everything else is preserved.
+func (c *Client) UpdateSupplier(ctx context.Context, id string, update *models.SupplierUpdate) (*models.UpdateSupplierResponse, error) {
 	c.logger.Debug("Updating supplier", zap.String("id", id))
 	
-	req := &supplierv1.UpdateSupplierRequest{
-		Id:            id,
-		Name:          name,
-		ContactPerson: contactPerson,
-		Email:         email,
-		Phone:         phone,
-		Address:       address,
-		City:          city,
-		State:         state,
-		Country:       country,
-		PostalCode:    postalCode,
-		TaxId:         taxID,
-		Website:       website,
-		Currency:      currency,
-		LeadTimeDays:  leadTimeDays,
-		PaymentTerms:  paymentTerms,
-		Metadata:      metadata,
-	}
+	req := c.convertToUpdateSupplierRequest(id, update)
 	
 	resp, err := c.client.UpdateSupplier(ctx, req)
 	if err != nil {
@@ -135,22 +127,26 @@ func (c *Client) UpdateSupplier(ctx context.Context, id, name, contactPerson, em
 	return c.convertToUpdateSupplierResponse(resp), nil
 }
 
-// DeleteSupplier deletes a supplier by ID
-func (c *Client) DeleteSupplier(ctx context.Context, id string) error {
+// DeleteSupplier deletes a supplier by ID. A supplier that products still
+// reference is only deleted when its products are reassigned to reassignTo
+// or force is set. It returns the number of products reassigned.
+func (c *Client) DeleteSupplier(ctx context.Context, id, reassignTo string, force bool) (int64, error) {
 	c.logger.Debug("Deleting supplier", zap.String("id", id))
 	
 	req := &supplierv1.DeleteSupplierRequest{
-		Id: id,
+		Id:         id,
+		ReassignTo: reassignTo,
+		Force:      force,
 	}
 	
-	_, err := c.client.DeleteSupplier(ctx, req)
+	resp, err := c.client.DeleteSupplier(ctx, req)
 	if err != nil {
 		c.logger.Error("Failed to delete supplier", zap.Error(err))
-		return fmt.Errorf("failed to delete supplier: %w", err)
+		return 0, fmt.Errorf("failed to delete supplier: %w", err)
 	}
 	
 	c.logger.Debug("Supplier deleted successfully", zap.String("id", id))
-	return nil
+	return resp.ProductsReassigned, nil
 }
 
 // ListSuppliers lists suppliers with pagination
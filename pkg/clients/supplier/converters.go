@@ -5,6 +5,7 @@ import (
 
 	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
 	supplierv1 "github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/api/gen/go/proto/supplier/v1"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -123,6 +124,50 @@ func (c *Client) convertToUpdateSupplierResponse(proto *supplierv1.UpdateSupplie
 	}
 }
 
+// convertToUpdateSupplierRequest converts a partial domain update into a protobuf
+// UpdateSupplierRequest whose update mask lists only the fields that were set
+func (c *Client) convertToUpdateSupplierRequest(id string, update *models.SupplierUpdate) *supplierv1.UpdateSupplierRequest {
+	req := &supplierv1.UpdateSupplierRequest{
+		Id:         id,
+		UpdateMask: &fieldmaskpb.FieldMask{},
+	}
+	if update == nil {
+		return req
+	}
+
+	setString := func(path string, value *string, dst *string) {
+		if value != nil {
+			*dst = *value
+			req.UpdateMask.Paths = append(req.UpdateMask.Paths, path)
+		}
+	}
+
+	setString("name", update.Name, &req.Name)
+	setString("contact_person", update.ContactPerson, &req.ContactPerson)
+	setString("email", update.Email, &req.Email)
+	setString("phone", update.Phone, &req.Phone)
+	setString("address", update.Address, &req.Address)
+	setString("city", update.City, &req.City)
+	setString("state", update.State, &req.State)
+	setString("country", update.Country, &req.Country)
+	setString("postal_code", update.PostalCode, &req.PostalCode)
+	setString("tax_id", update.TaxID, &req.TaxId)
+	setString("website", update.Website, &req.Website)
+	setString("currency", update.Currency, &req.Currency)
+	setString("payment_terms", update.PaymentTerms, &req.PaymentTerms)
+
+	if update.LeadTimeDays != nil {
+		req.LeadTimeDays = *update.LeadTimeDays
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "lead_time_days")
+	}
+	if update.Metadata != nil {
+		req.Metadata = update.Metadata
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "metadata")
+	}
+
+	return req
+}
+
 // convertToListAdaptersResponse converts protobuf ListAdaptersResponse to domain ListAdaptersResponse
 func (c *Client) convertToListAdaptersResponse(proto *supplierv1.ListAdaptersResponse) *models.ListAdaptersResponse {
 	if proto == nil {
@@ -0,0 +1,37 @@
+package supplier
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
+)
+
+func TestConvertToUpdateSupplierRequestMasksOnlyPresentFields(t *testing.T) {
+	var update models.SupplierUpdate
+	if err := json.Unmarshal([]byte(`{"phone": "+32 2 222 22 22", "website": ""}`), &update); err != nil {
+		t.Fatalf("decode update: %v", err)
+	}
+
+	req := (&Client{}).convertToUpdateSupplierRequest("supplier-1", &update)
+
+	if got, want := req.GetUpdateMask().GetPaths(), []string{"phone", "website"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("mask = %v, want %v", got, want)
+	}
+	if req.GetPhone() != "+32 2 222 22 22" {
+		t.Errorf("phone = %q", req.GetPhone())
+	}
+	if req.GetId() != "supplier-1" {
+		t.Errorf("id = %q", req.GetId())
+	}
+}
+
+func TestConvertToUpdateSupplierRequestEmptyUpdate(t *testing.T) {
+	for _, update := range []*models.SupplierUpdate{nil, {}} {
+		req := (&Client{}).convertToUpdateSupplierRequest("supplier-1", update)
+		if paths := req.GetUpdateMask().GetPaths(); len(paths) != 0 {
+			t.Errorf("mask = %v, want no paths so nothing is changed", paths)
+		}
+	}
+}
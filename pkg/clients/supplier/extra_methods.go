@@ -3,8 +3,10 @@ package supplier
 import (
     "context"
     "fmt"
+    "time"
 
     "go.uber.org/zap"
+    "google.golang.org/protobuf/types/known/timestamppb"
     "github.com/leonvanderhaeghen/stockplatform/pkg/models"
     supplierv1 "github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/api/gen/go/proto/supplier/v1"
 )
@@ -59,8 +61,10 @@ func (c *Client) TestAdapterConnection(ctx context.Context, adapterName string,
     return c.convertToTestConnectionResponse(resp), nil
 }
 
-// SyncProducts synchronizes products from a supplier
-func (c *Client) SyncProducts(ctx context.Context, supplierID string, fullSync, dryRun bool, batchSize int32) (*models.SyncResponse, error) {
+// SyncProducts synchronizes products from a supplier. A non-nil since limits
+// the sync to records changed after it; without it an incremental sync resumes
+// from the supplier's last successful sync.
+func (c *Client) SyncProducts(ctx context.Context, supplierID string, fullSync, dryRun bool, batchSize int32, since *time.Time) (*models.SyncResponse, error) {
     c.logger.Debug("Syncing products", 
         zap.String("supplier_id", supplierID),
         zap.Bool("full_sync", fullSync),
@@ -75,6 +79,10 @@ func (c *Client) SyncProducts(ctx context.Context, supplierID string, fullSync,
         },
     }
     
+    if since != nil {
+        req.Options.Since = timestamppb.New(*since)
+    }
+
     resp, err := c.client.SyncProducts(ctx, req)
     if err != nil {
         c.logger.Error("Failed to sync products", zap.Error(err))
@@ -84,8 +92,9 @@ func (c *Client) SyncProducts(ctx context.Context, supplierID string, fullSync,
     return c.convertToSyncResponse(resp), nil
 }
 
-// SyncInventory synchronizes inventory from a supplier
-func (c *Client) SyncInventory(ctx context.Context, supplierID string, fullSync, dryRun bool, batchSize int32) (*models.SyncResponse, error) {
+// SyncInventory synchronizes inventory from a supplier. since is handled as in
+// SyncProducts.
+func (c *Client) SyncInventory(ctx context.Context, supplierID string, fullSync, dryRun bool, batchSize int32, since *time.Time) (*models.SyncResponse, error) {
     c.logger.Debug("Syncing inventory", 
         zap.String("supplier_id", supplierID),
         zap.Bool("full_sync", fullSync),
@@ -101,6 +110,10 @@ func (c *Client) SyncInventory(ctx context.Context, supplierID string, fullSync,
         },
     }
     
+    if since != nil {
+        req.Options.Since = timestamppb.New(*since)
+    }
+
     resp, err := c.client.SyncInventory(ctx, req)
     if err != nil {
         c.logger.Error("Failed to sync inventory", zap.Error(err))
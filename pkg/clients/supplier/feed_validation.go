@@ -0,0 +1,56 @@
+package supplier
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
+	supplierv1 "github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/api/gen/go/proto/supplier/v1"
+)
+
+// ValidateFeed validates a supplier's product feed without syncing it
+func (c *Client) ValidateFeed(ctx context.Context, supplierID string, opts models.FeedValidationOptions) (*models.FeedValidationReport, error) {
+	c.logger.Debug("Validating supplier feed", zap.String("supplier_id", supplierID))
+
+	req := &supplierv1.ValidateFeedRequest{
+		SupplierId: supplierID,
+		Options: &supplierv1.SyncOptions{
+			FullSync:  opts.FullSync,
+			BatchSize: opts.BatchSize,
+		},
+		CategoryMapping: opts.CategoryMapping,
+		SampleSize:      opts.SampleSize,
+	}
+	if opts.Since != nil {
+		req.Options.Since = timestamppb.New(*opts.Since)
+	}
+
+	resp, err := c.client.ValidateFeed(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to validate supplier feed", zap.Error(err))
+		return nil, fmt.Errorf("failed to validate supplier feed: %w", err)
+	}
+
+	report := &models.FeedValidationReport{
+		SupplierID:   resp.GetSupplierId(),
+		AdapterName:  resp.GetAdapterName(),
+		Total:        resp.GetTotal(),
+		Valid:        resp.GetValid(),
+		Invalid:      resp.GetInvalid(),
+		SampleErrors: make([]models.FeedRecordError, 0, len(resp.GetSampleErrors())),
+		StartedAt:    resp.GetStartedAt().AsTime(),
+		CompletedAt:  resp.GetCompletedAt().AsTime(),
+	}
+	for _, e := range resp.GetSampleErrors() {
+		report.SampleErrors = append(report.SampleErrors, models.FeedRecordError{
+			ExternalID: e.GetExternalId(),
+			SKU:        e.GetSku(),
+			Field:      e.GetField(),
+			Message:    e.GetMessage(),
+		})
+	}
+	return report, nil
+}
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/grpclimits"
 	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
 	userv1 "github.com/leonvanderhaeghen/stockplatform/services/userSvc/api/gen/go/proto/user/v1"
 )
@@ -25,6 +26,11 @@ type Client struct {
 type Config struct {
 	Address string
 	Timeout time.Duration
+	// MessageLimits bounds request and response sizes; zero values use
+	// grpclimits.DefaultMaxMsgSize
+	MessageLimits grpclimits.MessageLimits
+	// DialOptions are added to the connection, e.g. interceptors
+	DialOptions []grpc.DialOption
 }
 
 // New creates a new User service client
@@ -33,10 +39,12 @@ func New(config Config, logger *zap.Logger) (*Client, error) {
 		config.Timeout = 30 * time.Second
 	}
 
-	conn, err := grpc.Dial(config.Address,
+	dialOpts := append([]grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithTimeout(config.Timeout),
-	)
+		config.MessageLimits.DialOption(),
+	}, config.DialOptions...)
+	conn, err := grpc.Dial(config.Address, dialOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to user service: %w", err)
 	}
@@ -97,6 +105,25 @@ func (c *Client) AuthenticateUser(ctx context.Context, email, password string) (
 	return c.convertToAuthenticateUserResponse(resp), nil
 }
 
+// RefreshToken exchanges a refresh token for a new access token
+func (c *Client) RefreshToken(ctx context.Context, refreshToken string) (*models.AuthenticateUserResponse, error) {
+	c.logger.Debug("Refreshing token")
+
+	resp, err := c.client.RefreshToken(ctx, &userv1.RefreshTokenRequest{RefreshToken: refreshToken})
+	if err != nil {
+		c.logger.Debug("Failed to refresh token", zap.Error(err))
+		return nil, fmt.Errorf("failed to refresh token: %w", err)
+	}
+
+	return &models.AuthenticateUserResponse{
+		Token:            resp.Token,
+		ExpiresAt:        resp.ExpiresAt,
+		RefreshToken:     resp.RefreshToken,
+		RefreshExpiresAt: resp.RefreshExpiresAt,
+		User:             c.convertToUser(resp.User),
+	}, nil
+}
+
 // GetUser retrieves a user by ID
 func (c *Client) GetUser(ctx context.Context, id string) (*models.User, error) {
 	c.logger.Debug("Getting user", zap.String("id", id))
@@ -171,6 +198,20 @@ func (c *Client) ListUsers(ctx context.Context, role string, active bool, limit,
 	return c.convertToListUsersResponse(resp), nil
 }
 
+// CountUsers counts users, optionally only those with a role or that are active
+func (c *Client) CountUsers(ctx context.Context, role string, activeOnly bool) (int64, error) {
+	resp, err := c.client.CountUsers(ctx, &userv1.CountUsersRequest{
+		Role:       role,
+		ActiveOnly: activeOnly,
+	})
+	if err != nil {
+		c.logger.Error("Failed to count users", zap.Error(err))
+		return 0, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	return resp.GetCount(), nil
+}
+
 // ValidateToken validates a JWT token and returns user claims
 func (c *Client) ValidateToken(ctx context.Context, token string) (*models.User, bool, error) {
 	c.logger.Debug("Validating token")
@@ -254,6 +295,50 @@ func (c *Client) CreateUserAddress(ctx context.Context, userID, name, street, ci
 	return convertAddressFromProto(resp.GetAddress()), nil
 }
 
+// BulkCreateUserAddresses imports several addresses for a user at once
+func (c *Client) BulkCreateUserAddresses(ctx context.Context, userID string, inputs []models.UserAddressInput) (*models.BulkCreateUserAddressesResult, error) {
+	req := &userv1.BulkCreateUserAddressesRequest{
+		UserId:    userID,
+		Addresses: make([]*userv1.AddressInput, len(inputs)),
+	}
+	for i, in := range inputs {
+		req.Addresses[i] = &userv1.AddressInput{
+			Name:       in.Name,
+			Street:     in.Street,
+			City:       in.City,
+			State:      in.State,
+			PostalCode: in.PostalCode,
+			Country:    in.Country,
+			Phone:      in.Phone,
+			IsDefault:  in.IsDefault,
+		}
+	}
+
+	resp, err := c.client.BulkCreateUserAddresses(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to bulk create user addresses", zap.Error(err))
+		return nil, fmt.Errorf("failed to bulk create user addresses: %w", err)
+	}
+
+	result := &models.BulkCreateUserAddressesResult{
+		Results:      make([]*models.UserAddressResult, len(resp.GetResults())),
+		CreatedCount: int(resp.GetCreatedCount()),
+		FailedCount:  int(resp.GetFailedCount()),
+	}
+	for i, r := range resp.GetResults() {
+		result.Results[i] = &models.UserAddressResult{
+			Index:   int(r.GetIndex()),
+			Success: r.GetSuccess(),
+			Error:   r.GetError(),
+		}
+		if r.GetAddress() != nil {
+			result.Results[i].Address = convertAddressFromProto(r.GetAddress())
+		}
+	}
+
+	return result, nil
+}
+
 // GetUserAddresses retrieves all addresses for a user
 func (c *Client) GetUserAddresses(ctx context.Context, userID string) ([]*models.UserAddress, error) {
 	req := &userv1.GetUserAddressesRequest{
@@ -412,8 +497,8 @@ func convertAddressFromProto(protoAddr *userv1.Address) *models.UserAddress {
 		Country:    protoAddr.GetCountry(),
 		Phone:      protoAddr.GetPhone(),
 		IsDefault:  protoAddr.GetIsDefault(),
-		// Note: CreatedAt and UpdatedAt would need to be converted from proto timestamps
-		// if they were included in the proto definition
+		CreatedAt:  protoTime(protoAddr.GetCreatedAtTs(), protoAddr.GetCreatedAt()),
+		UpdatedAt:  protoTime(protoAddr.GetUpdatedAtTs(), protoAddr.GetUpdatedAt()),
 	}
 }
 
@@ -2,11 +2,25 @@ package user
 
 import (
 	"time"
-	
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
 	userv1 "github.com/leonvanderhaeghen/stockplatform/services/userSvc/api/gen/go/proto/user/v1"
 )
 
+// protoTime reads a timestamp from its Timestamp field, falling back to the
+// deprecated RFC3339 string for user services that do not send it yet
+func protoTime(ts *timestamppb.Timestamp, legacy string) time.Time {
+	if ts != nil {
+		return ts.AsTime()
+	}
+	if t, err := time.Parse(time.RFC3339, legacy); err == nil {
+		return t
+	}
+	return time.Time{}
+}
+
 // convertToUser converts protobuf User to domain User
 func (c *Client) convertToUser(proto *userv1.User) *models.User {
 	if proto == nil {
@@ -22,17 +36,8 @@ func (c *Client) convertToUser(proto *userv1.User) *models.User {
 		IsActive:  proto.Active,
 	}
 
-	// Handle timestamps (convert from strings to time.Time)
-	if proto.CreatedAt != "" {
-		if t, err := time.Parse(time.RFC3339, proto.CreatedAt); err == nil {
-			user.CreatedAt = t
-		}
-	}
-	if proto.UpdatedAt != "" {
-		if t, err := time.Parse(time.RFC3339, proto.UpdatedAt); err == nil {
-			user.UpdatedAt = t
-		}
-	}
+	user.CreatedAt = protoTime(proto.CreatedAtTs, proto.CreatedAt)
+	user.UpdatedAt = protoTime(proto.UpdatedAtTs, proto.UpdatedAt)
 
 	return user
 }
@@ -52,12 +57,14 @@ func (c *Client) convertFromUser(user *models.User) *userv1.User {
 		Active:    user.IsActive,
 	}
 
-	// Handle timestamps (convert from time.Time to strings)
+	// Handle timestamps, in both forms while the string ones are deprecated
 	if !user.CreatedAt.IsZero() {
 		proto.CreatedAt = user.CreatedAt.Format(time.RFC3339)
+		proto.CreatedAtTs = timestamppb.New(user.CreatedAt)
 	}
 	if !user.UpdatedAt.IsZero() {
 		proto.UpdatedAt = user.UpdatedAt.Format(time.RFC3339)
+		proto.UpdatedAtTs = timestamppb.New(user.UpdatedAt)
 	}
 
 	return proto
@@ -82,9 +89,11 @@ func (c *Client) convertToAuthenticateUserResponse(proto *userv1.AuthenticateUse
 	}
 
 	return &models.AuthenticateUserResponse{
-		Token:     proto.Token,
-		ExpiresAt: 0, // protobuf doesn't have expires_at field
-		User:      c.convertToUser(proto.User),
+		Token:            proto.Token,
+		ExpiresAt:        proto.ExpiresAt,
+		RefreshToken:     proto.RefreshToken,
+		RefreshExpiresAt: proto.RefreshExpiresAt,
+		User:             c.convertToUser(proto.User),
 	}
 }
 
@@ -101,7 +110,9 @@ func (c *Client) convertToListUsersResponse(proto *userv1.ListUsersResponse) *mo
 
 	return &models.ListUsersResponse{
 		Users:      users,
-		TotalCount: int32(len(users)), // protobuf doesn't have total_count field
+		TotalCount: proto.TotalCount,
+		Limit:      proto.Limit,
+		Offset:     proto.Offset,
 	}
 }
 
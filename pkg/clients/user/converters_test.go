@@ -0,0 +1,48 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
+	userv1 "github.com/leonvanderhaeghen/stockplatform/services/userSvc/api/gen/go/proto/user/v1"
+)
+
+func TestUserTimestampsRoundTrip(t *testing.T) {
+	c := &Client{}
+	created := time.Date(2024, 5, 1, 9, 30, 15, 123456789, time.UTC)
+	updated := created.Add(time.Hour)
+
+	proto := c.convertFromUser(&models.User{ID: "user-1", CreatedAt: created, UpdatedAt: updated})
+	if proto.GetCreatedAt() != created.Format(time.RFC3339) {
+		t.Fatalf("deprecated created_at = %q, want it still populated", proto.GetCreatedAt())
+	}
+
+	user := c.convertToUser(proto)
+	if !user.CreatedAt.Equal(created) || !user.UpdatedAt.Equal(updated) {
+		t.Fatalf("round trip = %v, %v, want %v, %v to the nanosecond", user.CreatedAt, user.UpdatedAt, created, updated)
+	}
+}
+
+func TestUserTimestampsFallBackToStrings(t *testing.T) {
+	created := time.Date(2024, 5, 1, 9, 30, 15, 0, time.UTC)
+
+	user := (&Client{}).convertToUser(&userv1.User{Id: "user-1", CreatedAt: created.Format(time.RFC3339)})
+
+	if !user.CreatedAt.Equal(created) {
+		t.Fatalf("created at = %v, want %v from the string field", user.CreatedAt, created)
+	}
+	if !user.UpdatedAt.IsZero() {
+		t.Fatalf("updated at = %v, want zero when neither field is set", user.UpdatedAt)
+	}
+}
+
+func TestAddressTimestampsFromProto(t *testing.T) {
+	created := time.Date(2024, 5, 1, 9, 30, 15, 0, time.UTC)
+
+	address := convertAddressFromProto(&userv1.Address{Id: "address-1", CreatedAt: created.Format(time.RFC3339)})
+
+	if !address.CreatedAt.Equal(created) {
+		t.Fatalf("created at = %v, want %v", address.CreatedAt, created)
+	}
+}
@@ -0,0 +1,26 @@
+package countries
+
+import "testing"
+
+func TestIsCode(t *testing.T) {
+	tests := []struct {
+		code string
+		want bool
+	}{
+		{code: "BE", want: true},
+		{code: "be", want: true},
+		{code: "US", want: true},
+		{code: "GB", want: true},
+		{code: "UK", want: false},
+		{code: "XX", want: false},
+		{code: "BEL", want: false},
+		{code: "B", want: false},
+		{code: "", want: false},
+		{code: "Belgium", want: false},
+	}
+	for _, tt := range tests {
+		if got := IsCode(tt.code); got != tt.want {
+			t.Errorf("IsCode(%q) = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+}
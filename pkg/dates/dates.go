@@ -0,0 +1,51 @@
+// Package dates parses the date and time values accepted by date filters and
+// schedules across the platform, so every endpoint accepts the same formats.
+package dates
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidDate is returned for values that are not in any accepted format
+var ErrInvalidDate = errors.New("invalid date")
+
+// millisThreshold separates Unix seconds from Unix milliseconds: 1e12 seconds
+// is tens of thousands of years away, 1e12 milliseconds is September 2001
+const millisThreshold = 1_000_000_000_000
+
+// layouts are the ISO-8601 forms accepted, tried in order. Values without a
+// zone are taken as UTC.
+var layouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// Parse parses an ISO-8601 date or date-time, such as "2024-03-01",
+// "2024-03-01T10:00:00" or "2024-03-01T10:00:00+01:00", or a Unix timestamp
+// in seconds or milliseconds, such as "1709287200" or "1709287200000".
+func Parse(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, fmt.Errorf("%w: empty value", ErrInvalidDate)
+	}
+
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if n >= millisThreshold || n <= -millisThreshold {
+			return time.UnixMilli(n).UTC(), nil
+		}
+		return time.Unix(n, 0).UTC(), nil
+	}
+
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: %q, use ISO-8601 (e.g. 2024-03-01 or 2024-03-01T10:00:00Z) or Unix seconds or milliseconds", ErrInvalidDate, value)
+}
@@ -0,0 +1,47 @@
+package dates
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseAcceptedFormats(t *testing.T) {
+	want := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value string
+		want  time.Time
+	}{
+		{name: "RFC3339", value: "2024-03-01T10:00:00Z", want: want},
+		{name: "RFC3339 with offset", value: "2024-03-01T11:00:00+01:00", want: want},
+		{name: "RFC3339 with fraction", value: "2024-03-01T10:00:00.250Z", want: want.Add(250 * time.Millisecond)},
+		{name: "date-time without zone", value: "2024-03-01T10:00:00", want: want},
+		{name: "date-time without seconds", value: "2024-03-01T10:00", want: want},
+		{name: "date only", value: "2024-03-01", want: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "unix seconds", value: "1709287200", want: want},
+		{name: "unix milliseconds", value: "1709287200000", want: want},
+		{name: "surrounding spaces", value: " 2024-03-01T10:00:00Z ", want: want},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(tt.want) {
+				t.Fatalf("Parse(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRejectsInvalidValues(t *testing.T) {
+	for _, value := range []string{"", "yesterday", "01/03/2024", "2024-13-01", "1709287200.5"} {
+		if _, err := Parse(value); !errors.Is(err, ErrInvalidDate) {
+			t.Errorf("Parse(%q) err = %v, want ErrInvalidDate", value, err)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Package featureflags carries the feature flags and experiment variants of a
+// request from the gateway to the backend services. Clients send them in the
+// X-Feature-Flags header as comma separated name=variant pairs, e.g.
+// "pricing=discount-10,product-ranking=newest"; the gateway forwards them in
+// the x-feature-flags gRPC metadata, where services read them back.
+package featureflags
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// Header is the HTTP header clients send flags in
+	Header = "X-Feature-Flags"
+	// MetadataKey is the gRPC metadata key flags are forwarded in
+	MetadataKey = "x-feature-flags"
+
+	// Control is the variant of every flag a request does not set
+	Control = "control"
+	// On is the variant of a flag given by name only
+	On = "on"
+
+	// maxFlags bounds the flags taken from one request
+	maxFlags = 32
+	// maxTokenLength bounds the length of flag names and variants
+	maxTokenLength = 64
+)
+
+// Flags maps flag names to the variant a request is in
+type Flags map[string]string
+
+// Parse reads flags from their header form. Names and variants are lower-cased;
+// malformed entries are dropped rather than failing the request, and entries
+// past the 32nd are ignored. A name without a variant is On.
+func Parse(value string) Flags {
+	flags := Flags{}
+	for _, entry := range strings.Split(value, ",") {
+		if len(flags) == maxFlags {
+			break
+		}
+		name, variant, found := strings.Cut(strings.TrimSpace(entry), "=")
+		name = strings.ToLower(strings.TrimSpace(name))
+		variant = strings.ToLower(strings.TrimSpace(variant))
+		if !found {
+			variant = On
+		}
+		if !validToken(name) || !validToken(variant) {
+			continue
+		}
+		flags[name] = variant
+	}
+	return flags
+}
+
+// validToken accepts 1 to 64 characters of [a-z0-9._-]
+func validToken(s string) bool {
+	if s == "" || len(s) > maxTokenLength {
+		return false
+	}
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' && r != '_' && r != '.' {
+			return false
+		}
+	}
+	return true
+}
+
+// Variant returns the variant of flag name, or Control when it is not set
+func (f Flags) Variant(name string) string {
+	if variant, ok := f[name]; ok {
+		return variant
+	}
+	return Control
+}
+
+// Enabled reports whether flag name is set to anything but Control or "off"
+func (f Flags) Enabled(name string) bool {
+	variant := f.Variant(name)
+	return variant != Control && variant != "off"
+}
+
+// String returns the flags in header form, sorted by name
+func (f Flags) String() string {
+	names := make([]string, 0, len(f))
+	for name := range f {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pairs := make([]string, len(names))
+	for i, name := range names {
+		pairs[i] = name + "=" + f[name]
+	}
+	return strings.Join(pairs, ",")
+}
+
+// AppendToOutgoingContext forwards flags on the gRPC calls made with the
+// returned context. Empty flags leave ctx unchanged.
+func AppendToOutgoingContext(ctx context.Context, flags Flags) context.Context {
+	if len(flags) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, flags.String())
+}
+
+// FromIncomingContext returns the flags forwarded to a gRPC handler. A call
+// without flags gets empty Flags, so every flag is Control.
+func FromIncomingContext(ctx context.Context) Flags {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return Flags{}
+	}
+	return Parse(strings.Join(md.Get(MetadataKey), ","))
+}
@@ -0,0 +1,88 @@
+package featureflags
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  Flags
+	}{
+		{name: "empty", value: "", want: Flags{}},
+		{name: "pairs", value: "pricing=discount-10, product-ranking=newest", want: Flags{"pricing": "discount-10", "product-ranking": "newest"}},
+		{name: "name only", value: "new-checkout", want: Flags{"new-checkout": On}},
+		{name: "lower-cased", value: "Pricing=Discount-10", want: Flags{"pricing": "discount-10"}},
+		{name: "malformed dropped", value: "pricing=discount 10,=x,ranking=newest,bad!=on", want: Flags{"ranking": "newest"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Parse(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Parse(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnsetFlagIsControl(t *testing.T) {
+	flags := Parse("pricing=off")
+	if got := flags.Variant("product-ranking"); got != Control {
+		t.Errorf("Variant of unset flag = %q, want %q", got, Control)
+	}
+	if flags.Enabled("product-ranking") || flags.Enabled("pricing") {
+		t.Error("unset and off flags should not be enabled")
+	}
+	if got := FromIncomingContext(context.Background()); len(got) != 0 {
+		t.Errorf("flags without metadata = %v, want none", got)
+	}
+}
+
+// flagRecordingHealthServer records the flags its Check calls arrive with
+type flagRecordingHealthServer struct {
+	grpc_health_v1.UnimplementedHealthServer
+	flags Flags
+}
+
+func (s *flagRecordingHealthServer) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	s.flags = FromIncomingContext(ctx)
+	return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_SERVING}, nil
+}
+
+func TestFlagsReachHandlerThroughMetadata(t *testing.T) {
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	health := &flagRecordingHealthServer{}
+	grpc_health_v1.RegisterHealthServer(server, health)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := grpc.Dial("passthrough:///flags",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	ctx := AppendToOutgoingContext(context.Background(), Parse("product-ranking=newest,pricing=discount-10"))
+	if _, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := Flags{"product-ranking": "newest", "pricing": "discount-10"}
+	if !reflect.DeepEqual(health.flags, want) {
+		t.Fatalf("handler saw flags %v, want %v", health.flags, want)
+	}
+}
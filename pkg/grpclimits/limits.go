@@ -0,0 +1,73 @@
+// Package grpclimits holds the gRPC message size limits shared by the
+// services and their clients
+package grpclimits
+
+import (
+	"os"
+	"strconv"
+
+	"google.golang.org/grpc"
+)
+
+// DefaultMaxMsgSize is the largest message servers and clients send or accept
+// unless configured otherwise. gRPC's own default for received messages is
+// 4MB, which bulk requests and report responses can exceed.
+const DefaultMaxMsgSize = 10 * 1024 * 1024
+
+// MessageLimits bounds the size in bytes of a single gRPC message. Zero means
+// DefaultMaxMsgSize.
+type MessageLimits struct {
+	MaxRecvMsgSize int
+	MaxSendMsgSize int
+}
+
+// DefaultMessageLimits returns DefaultMaxMsgSize in both directions
+func DefaultMessageLimits() MessageLimits {
+	return MessageLimits{MaxRecvMsgSize: DefaultMaxMsgSize, MaxSendMsgSize: DefaultMaxMsgSize}
+}
+
+// MessageLimitsFromEnv overrides defaults with GRPC_MAX_RECV_MSG_SIZE and
+// GRPC_MAX_SEND_MSG_SIZE, in bytes. Unset or invalid values keep the default.
+func MessageLimitsFromEnv(defaults MessageLimits) MessageLimits {
+	limits := defaults
+	limits.MaxRecvMsgSize = envSize("GRPC_MAX_RECV_MSG_SIZE", limits.MaxRecvMsgSize)
+	limits.MaxSendMsgSize = envSize("GRPC_MAX_SEND_MSG_SIZE", limits.MaxSendMsgSize)
+	return limits
+}
+
+// ServerOptions applies the limits to a gRPC server
+func (l MessageLimits) ServerOptions() []grpc.ServerOption {
+	l = l.withDefaults()
+	return []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(l.MaxRecvMsgSize),
+		grpc.MaxSendMsgSize(l.MaxSendMsgSize),
+	}
+}
+
+// DialOption applies the limits to every call made over a client connection
+func (l MessageLimits) DialOption() grpc.DialOption {
+	l = l.withDefaults()
+	return grpc.WithDefaultCallOptions(
+		grpc.MaxCallRecvMsgSize(l.MaxRecvMsgSize),
+		grpc.MaxCallSendMsgSize(l.MaxSendMsgSize),
+	)
+}
+
+func (l MessageLimits) withDefaults() MessageLimits {
+	if l.MaxRecvMsgSize <= 0 {
+		l.MaxRecvMsgSize = DefaultMaxMsgSize
+	}
+	if l.MaxSendMsgSize <= 0 {
+		l.MaxSendMsgSize = DefaultMaxMsgSize
+	}
+	return l
+}
+
+func envSize(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		if n, err := strconv.Atoi(value); err == nil && n > 0 {
+			return n
+		}
+	}
+	return defaultValue
+}
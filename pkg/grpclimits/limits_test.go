@@ -0,0 +1,95 @@
+package grpclimits
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// sizeHealthServer reports the size of the service name it was asked about,
+// so a test can check a large request arrived whole
+type sizeHealthServer struct {
+	grpc_health_v1.UnimplementedHealthServer
+	received int
+}
+
+func (s *sizeHealthServer) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	s.received = len(req.GetService())
+	return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_SERVING}, nil
+}
+
+// checkWithPayload sends a health check carrying size bytes to a server
+// started with serverOpts, over a client dialled with dialOpts
+func checkWithPayload(t *testing.T, size int, serverOpts []grpc.ServerOption, dialOpts ...grpc.DialOption) (*sizeHealthServer, error) {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(serverOpts...)
+	health := &sizeHealthServer{}
+	grpc_health_v1.RegisterHealthServer(server, health)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	dialOpts = append(dialOpts,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+	)
+	conn, err := grpc.Dial("passthrough:///limits", dialOpts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	_, err = grpc_health_v1.NewHealthClient(conn).Check(context.Background(), &grpc_health_v1.HealthCheckRequest{
+		Service: strings.Repeat("x", size),
+	})
+	return health, err
+}
+
+func TestMessageLargerThanGRPCDefaultIsAccepted(t *testing.T) {
+	const size = 6 * 1024 * 1024 // over gRPC's 4MB default, under the configured 10MB
+
+	if _, err := checkWithPayload(t, size, nil); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("default server: err = %v, want ResourceExhausted", err)
+	}
+
+	limits := DefaultMessageLimits()
+	health, err := checkWithPayload(t, size, limits.ServerOptions(), limits.DialOption())
+	if err != nil {
+		t.Fatalf("configured server: %v", err)
+	}
+	if health.received != size {
+		t.Fatalf("server received %d bytes, want %d", health.received, size)
+	}
+}
+
+func TestMessageOverConfiguredLimitIsRejected(t *testing.T) {
+	limits := MessageLimits{MaxRecvMsgSize: 1024 * 1024}
+
+	_, err := checkWithPayload(t, 2*1024*1024, limits.ServerOptions(), limits.DialOption())
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("err = %v, want ResourceExhausted", err)
+	}
+}
+
+func TestMessageLimitsFromEnv(t *testing.T) {
+	t.Setenv("GRPC_MAX_RECV_MSG_SIZE", "20971520")
+	t.Setenv("GRPC_MAX_SEND_MSG_SIZE", "not-a-size")
+
+	limits := MessageLimitsFromEnv(DefaultMessageLimits())
+	if limits.MaxRecvMsgSize != 20*1024*1024 {
+		t.Errorf("MaxRecvMsgSize = %d, want 20MB", limits.MaxRecvMsgSize)
+	}
+	if limits.MaxSendMsgSize != DefaultMaxMsgSize {
+		t.Errorf("MaxSendMsgSize = %d, want the default kept for an invalid value", limits.MaxSendMsgSize)
+	}
+}
@@ -0,0 +1,101 @@
+// Package identity carries the authenticated user behind a request from the
+// gateway to the backend services. The gateway forwards the user ID, role and,
+// for supplier users, the supplier ID from the token as gRPC metadata, where
+// services read them back. A request without them comes from another service
+// rather than from a user.
+package identity
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// UserIDMetadataKey is the gRPC metadata key of the caller's user ID
+	UserIDMetadataKey = "x-user-id"
+	// RoleMetadataKey is the gRPC metadata key of the caller's role
+	RoleMetadataKey = "x-user-role"
+	// SupplierIDMetadataKey is the gRPC metadata key of the supplier a
+	// supplier user acts for
+	SupplierIDMetadataKey = "x-supplier-id"
+
+	// RoleAdmin and RoleStaff are the roles of back-office users
+	RoleAdmin = "ADMIN"
+	RoleStaff = "STAFF"
+)
+
+// Caller is the authenticated user behind a request. All fields are empty for
+// internal callers.
+type Caller struct {
+	UserID     string
+	Role       string
+	SupplierID string
+}
+
+// IsStaff reports whether the caller is authenticated as staff or admin
+func (c Caller) IsStaff() bool {
+	return c.Role == RoleAdmin || c.Role == RoleStaff
+}
+
+// AppendToOutgoingContext forwards the caller to the services called with ctx.
+// Empty fields are left out.
+func AppendToOutgoingContext(ctx context.Context, caller Caller) context.Context {
+	var kv []string
+	if caller.Role != "" {
+		kv = append(kv, RoleMetadataKey, caller.Role)
+	}
+	if caller.UserID != "" {
+		kv = append(kv, UserIDMetadataKey, caller.UserID)
+	}
+	if caller.SupplierID != "" {
+		kv = append(kv, SupplierIDMetadataKey, caller.SupplierID)
+	}
+	if len(kv) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
+
+// FromIncomingContext returns the caller forwarded to the current request
+func FromIncomingContext(ctx context.Context) Caller {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return Caller{
+		UserID:     first(md, UserIDMetadataKey),
+		Role:       first(md, RoleMetadataKey),
+		SupplierID: first(md, SupplierIDMetadataKey),
+	}
+}
+
+// UserID returns the caller's user ID, or "" for anonymous and internal callers
+func UserID(ctx context.Context) string {
+	return FromIncomingContext(ctx).UserID
+}
+
+// Role returns the caller's role, or "" when none was forwarded
+func Role(ctx context.Context) string {
+	return FromIncomingContext(ctx).Role
+}
+
+// IsStaff reports whether the caller is authenticated as staff or admin.
+// Callers without a role, including anonymous shoppers, are not.
+func IsStaff(ctx context.Context) bool {
+	return FromIncomingContext(ctx).IsStaff()
+}
+
+// ForwardRole copies the caller's role from the incoming request to the
+// outgoing context of downstream calls made while serving it
+func ForwardRole(ctx context.Context) context.Context {
+	if role := Role(ctx); role != "" {
+		return metadata.AppendToOutgoingContext(ctx, RoleMetadataKey, role)
+	}
+	return ctx
+}
+
+// first returns the first value of key in md, or ""
+func first(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
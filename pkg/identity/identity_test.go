@@ -0,0 +1,68 @@
+package identity
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// received returns the incoming context a service sees for a call made with ctx
+func received(ctx context.Context) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestCallerRoundTrip(t *testing.T) {
+	sent := Caller{UserID: "user-1", Role: "SUPPLIER", SupplierID: "supplier-1"}
+
+	ctx := received(AppendToOutgoingContext(context.Background(), sent))
+
+	if got := FromIncomingContext(ctx); got != sent {
+		t.Fatalf("caller = %+v, want %+v", got, sent)
+	}
+	if UserID(ctx) != "user-1" || Role(ctx) != "SUPPLIER" {
+		t.Fatalf("UserID, Role = %q, %q", UserID(ctx), Role(ctx))
+	}
+	if IsStaff(ctx) {
+		t.Fatal("a supplier is not staff")
+	}
+}
+
+func TestInternalCaller(t *testing.T) {
+	ctx := received(AppendToOutgoingContext(context.Background(), Caller{}))
+
+	if got := FromIncomingContext(ctx); got != (Caller{}) {
+		t.Fatalf("caller = %+v, want none", got)
+	}
+	if got := FromIncomingContext(context.Background()); got != (Caller{}) {
+		t.Fatalf("caller without metadata = %+v, want none", got)
+	}
+}
+
+func TestIsStaff(t *testing.T) {
+	for role, want := range map[string]bool{"ADMIN": true, "STAFF": true, "CUSTOMER": false, "": false} {
+		if got := (Caller{Role: role}).IsStaff(); got != want {
+			t.Errorf("IsStaff(%q) = %v, want %v", role, got, want)
+		}
+	}
+}
+
+func TestForwardRole(t *testing.T) {
+	incoming := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		RoleMetadataKey, "STAFF",
+		UserIDMetadataKey, "staff-1",
+	))
+
+	md, _ := metadata.FromOutgoingContext(ForwardRole(incoming))
+	if got := md.Get(RoleMetadataKey); len(got) != 1 || got[0] != "STAFF" {
+		t.Fatalf("forwarded roles = %v, want [STAFF]", got)
+	}
+	if got := md.Get(UserIDMetadataKey); len(got) != 0 {
+		t.Fatalf("only the role should be forwarded, got user ID %v", got)
+	}
+
+	if _, ok := metadata.FromOutgoingContext(ForwardRole(context.Background())); ok {
+		t.Fatal("nothing should be forwarded without an incoming role")
+	}
+}
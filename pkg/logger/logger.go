@@ -1,6 +1,7 @@
 package logger
 
 import (
+	"fmt"
 	"os"
 	"strings"
 
@@ -8,22 +9,57 @@ import (
 	"go.uber.org/zap/zapcore"
 )
 
-// Config holds logger configuration
+// Log encodings supported by Config.Encoding
+const (
+	EncodingJSON    = "json"
+	EncodingConsole = "console"
+)
+
+// Config holds logger configuration. Level and Encoding default to info and
+// JSON in production, and to debug and console output otherwise.
 type Config struct {
 	Level       string `mapstructure:"level"`
+	Encoding    string `mapstructure:"encoding"`
 	Environment string `mapstructure:"environment"`
 	ServiceName string `mapstructure:"service_name"`
 }
 
+// FromEnv creates a logger configured from the LOG_LEVEL, LOG_ENCODING and
+// ENVIRONMENT environment variables. It is meant to be called before the
+// service configuration is loaded, so startup is logged the same way.
+func FromEnv(serviceName string) (*zap.Logger, error) {
+	return New(Config{
+		Level:       os.Getenv("LOG_LEVEL"),
+		Encoding:    os.Getenv("LOG_ENCODING"),
+		Environment: os.Getenv("ENVIRONMENT"),
+		ServiceName: serviceName,
+	})
+}
+
 // New creates a new structured logger based on configuration
 func New(cfg Config) (*zap.Logger, error) {
+	return newWithOutput(cfg, zapcore.AddSync(os.Stdout))
+}
+
+// newWithOutput creates a logger like New that writes to out
+func newWithOutput(cfg Config, out zapcore.WriteSyncer) (*zap.Logger, error) {
 	// Set default values
-	if cfg.Level == "" {
-		cfg.Level = "info"
-	}
 	if cfg.Environment == "" {
 		cfg.Environment = "development"
 	}
+	production := strings.ToLower(cfg.Environment) == "production"
+	if cfg.Level == "" {
+		cfg.Level = "debug"
+		if production {
+			cfg.Level = "info"
+		}
+	}
+	if cfg.Encoding == "" {
+		cfg.Encoding = EncodingConsole
+		if production {
+			cfg.Encoding = EncodingJSON
+		}
+	}
 	if cfg.ServiceName == "" {
 		cfg.ServiceName = "unknown"
 	}
@@ -35,18 +71,26 @@ func New(cfg Config) (*zap.Logger, error) {
 	}
 
 	// Configure encoder
-	var encoderConfig zapcore.EncoderConfig
-	if strings.ToLower(cfg.Environment) == "production" {
-		encoderConfig = zap.NewProductionEncoderConfig()
-	} else {
-		encoderConfig = zap.NewDevelopmentEncoderConfig()
-		encoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	var encoder zapcore.Encoder
+	switch strings.ToLower(cfg.Encoding) {
+	case EncodingJSON:
+		encoderConfig := zap.NewProductionEncoderConfig()
+		encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+		encoder = zapcore.NewJSONEncoder(encoderConfig)
+	case EncodingConsole:
+		encoderConfig := zap.NewDevelopmentEncoderConfig()
+		if !production {
+			encoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+		}
+		encoder = zapcore.NewConsoleEncoder(encoderConfig)
+	default:
+		return nil, fmt.Errorf("unsupported log encoding %q: use %s or %s", cfg.Encoding, EncodingJSON, EncodingConsole)
 	}
 
 	// Configure core
 	core := zapcore.NewCore(
-		zapcore.NewConsoleEncoder(encoderConfig),
-		zapcore.AddSync(os.Stdout),
+		encoder,
+		out,
 		level,
 	)
 
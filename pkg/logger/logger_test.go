@@ -0,0 +1,71 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// logAtEveryLevel logs one message per level from debug to error with a
+// logger built from cfg and returns the lines holding the messages
+func logAtEveryLevel(t *testing.T, cfg Config) []string {
+	t.Helper()
+	var out bytes.Buffer
+	log, err := newWithOutput(cfg, zapcore.AddSync(&out))
+	if err != nil {
+		t.Fatal(err)
+	}
+	log.Debug("debug message")
+	log.Info("info message")
+	log.Warn("warn message")
+	log.Error("error message")
+	var lines []string
+	for _, line := range strings.Split(out.String(), "\n") {
+		// Skip the stack trace console output adds after errors
+		if strings.Contains(line, " message") {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+func TestConfiguredLevelFiltersLowerSeverities(t *testing.T) {
+	lines := logAtEveryLevel(t, Config{Level: "warn", Encoding: EncodingJSON, ServiceName: "test"})
+
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want the warn and error ones:\n%s", len(lines), strings.Join(lines, "\n"))
+	}
+	for i, want := range []string{"warn", "error"} {
+		var entry map[string]interface{}
+		if err := json.Unmarshal([]byte(lines[i]), &entry); err != nil {
+			t.Fatalf("line %d is not JSON: %v", i, err)
+		}
+		if entry["level"] != want || entry["service"] != "test" {
+			t.Errorf("line %d = %v, want level %s of service test", i, entry, want)
+		}
+	}
+}
+
+func TestEnvironmentDefaults(t *testing.T) {
+	production := logAtEveryLevel(t, Config{Environment: "production"})
+	if len(production) != 3 || !strings.HasPrefix(production[0], "{") {
+		t.Fatalf("production should log info and up as JSON, got:\n%s", strings.Join(production, "\n"))
+	}
+
+	development := logAtEveryLevel(t, Config{})
+	if len(development) != 4 || strings.HasPrefix(development[0], "{") {
+		t.Fatalf("development should log debug and up to the console, got:\n%s", strings.Join(development, "\n"))
+	}
+}
+
+func TestNewRejectsUnknownSettings(t *testing.T) {
+	if _, err := New(Config{Level: "loud"}); err == nil {
+		t.Error("unknown level should be rejected")
+	}
+	if _, err := New(Config{Encoding: "xml"}); err == nil {
+		t.Error("unknown encoding should be rejected")
+	}
+}
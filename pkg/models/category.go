@@ -12,4 +12,18 @@ type Category struct {
 	Path        string    `json:"path"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
+
+	ProductCount int64 `json:"product_count"`
+
+	// Attributes is the schema of the metadata products in the category carry
+	Attributes []CategoryAttribute `json:"attributes,omitempty"`
+}
+
+// CategoryAttribute describes an attribute products in a category carry in
+// their metadata
+type CategoryAttribute struct {
+	Name          string   `json:"name" binding:"required"`
+	Type          string   `json:"type,omitempty"` // string, number or boolean; empty means string
+	Required      bool     `json:"required"`
+	AllowedValues []string `json:"allowed_values,omitempty"`
 }
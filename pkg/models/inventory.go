@@ -16,6 +16,30 @@ type InventoryItem struct {
 	Cost       float64   `json:"cost"`
 	CreatedAt  time.Time `json:"created_at"`
 	UpdatedAt  time.Time `json:"updated_at"`
+	Damaged    int32     `json:"damaged"`
+	Tags       []string  `json:"tags,omitempty"`
+
+	// Backordered is how many units were removed beyond the available stock
+	Backordered int32 `json:"backordered,omitempty"`
+
+	// NextCountDate is when the item is next due for a stock count, if scheduled
+	NextCountDate *time.Time `json:"next_count_date,omitempty"`
+}
+
+// StoreLocation represents a store, warehouse or other stock-holding location
+type StoreLocation struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Type    string `json:"type"` // store, warehouse, fulfillment_center, online
+	City    string `json:"city"`
+	Country string `json:"country"`
+	Active  bool   `json:"active"`
+}
+
+// ListInventoryResponse represents a page of inventory items
+type ListInventoryResponse struct {
+	Items      []*InventoryItem `json:"items"`
+	TotalCount int32            `json:"total_count"`
 }
 
 // CheckAvailabilityResponse represents availability check results
@@ -39,3 +63,41 @@ type InventoryRequestItem struct {
 	SKU       string `json:"sku"`
 	Quantity  int32  `json:"quantity"`
 }
+
+// InventoryReservation represents stock reserved for an order at a location
+type InventoryReservation struct {
+	InventoryItemID string    `json:"inventory_item_id"`
+	ProductID       string    `json:"product_id"`
+	SKU             string    `json:"sku"`
+	LocationID      string    `json:"location_id"`
+	Quantity        int32     `json:"quantity"`
+	Status          string    `json:"status"`
+	UpdatedAt       time.Time `json:"updated_at"`
+	// ExpiresAt is when the reservation lapses; nil when it does not
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// ReservationCorrection is an order whose leaked reservations were released
+type ReservationCorrection struct {
+	OrderID      string                  `json:"order_id"`
+	OrderState   string                  `json:"order_state"`
+	Reservations []*InventoryReservation `json:"reservations"`
+}
+
+// ReservationReconcileReport sums up a reservation reconciliation pass
+type ReservationReconcileReport struct {
+	ReservationsChecked int32                    `json:"reservations_checked"`
+	OrdersChecked       int32                    `json:"orders_checked"`
+	Corrections         []*ReservationCorrection `json:"corrections"`
+	Failed              int32                    `json:"failed"`
+	DryRun              bool                     `json:"dry_run"`
+}
+
+// BackInStockSubscription represents a user's pending back-in-stock alert for a product
+type BackInStockSubscription struct {
+	ID                string    `json:"id"`
+	UserID            string    `json:"user_id"`
+	ProductID         string    `json:"product_id"`
+	CreatedAt         time.Time `json:"created_at"`
+	AlreadySubscribed bool      `json:"already_subscribed"`
+}
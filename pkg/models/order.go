@@ -11,10 +11,36 @@ type Order struct {
 	Items       []*OrderItem `json:"items"`
 	ShippingAddress *Address `json:"shipping_address,omitempty"`
 	BillingAddress  *Address `json:"billing_address,omitempty"`
+	// Reservations is populated by callers that aggregate inventory data into order details
+	Reservations []*InventoryReservation `json:"reservations,omitempty"`
+	// Timeline is populated by callers that show the order's history, oldest first
+	Timeline []*OrderTimelineEntry `json:"timeline,omitempty"`
+	// Notes is the latest note's text; NoteLog holds every note, oldest first
+	Notes       string       `json:"notes,omitempty"`
+	NoteLog     []*OrderNote `json:"note_log,omitempty"`
 	CreatedAt   time.Time   `json:"created_at"`
 	UpdatedAt   time.Time   `json:"updated_at"`
 }
 
+// OrderNote is a note on an order, or on one of its items when ProductID is set
+type OrderNote struct {
+	ID        string    `json:"id"`
+	AuthorID  string    `json:"author_id,omitempty"`
+	Text      string    `json:"text"`
+	ProductID string    `json:"product_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// OrderTimelineEntry is one event in the history of an order, such as a
+// status change, payment, shipment, note or return
+type OrderTimelineEntry struct {
+	Type        string    `json:"type"`
+	Timestamp   time.Time `json:"timestamp"`
+	Actor       string    `json:"actor,omitempty"`
+	Summary     string    `json:"summary"`
+	ReferenceID string    `json:"reference_id,omitempty"`
+}
+
 // OrderItem represents an item in an order
 type OrderItem struct {
 	ID        string  `json:"id"`
@@ -35,6 +61,7 @@ const (
 	OrderStatusShipped    OrderStatus = "shipped"
 	OrderStatusDelivered  OrderStatus = "delivered"
 	OrderStatusCancelled  OrderStatus = "cancelled"
+	OrderStatusFailed     OrderStatus = "failed"
 )
 
 // Address represents a shipping or billing address
@@ -59,6 +86,8 @@ type CreateOrderResponse struct {
 type ListOrdersResponse struct {
 	Orders     []*Order `json:"orders"`
 	TotalCount int32    `json:"total_count"`
+	Limit      int32    `json:"limit"`
+	Offset     int32    `json:"offset"`
 }
 
 // UpdateOrderResponse represents the response from updating an order
@@ -66,3 +95,62 @@ type UpdateOrderResponse struct {
 	Order   *Order `json:"order"`
 	Message string `json:"message"`
 }
+
+// WebhookDelivery represents the delivery of one order event to one webhook subscriber
+type WebhookDelivery struct {
+	ID            string `json:"id"`
+	EventID       string `json:"event_id"`
+	EventType     string `json:"event_type"`
+	OrderID       string `json:"order_id"`
+	SubscriberID  string `json:"subscriber_id"`
+	URL           string `json:"url"`
+	Status        string `json:"status"`
+	Attempts      int32  `json:"attempts"`
+	LastError     string `json:"last_error,omitempty"`
+	Payload       string `json:"payload,omitempty"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at"`
+	LastAttemptAt string `json:"last_attempt_at,omitempty"`
+	DeliveredAt   string `json:"delivered_at,omitempty"`
+}
+
+// ReplayWebhookResponse represents the outcome of replaying a dead-lettered webhook delivery
+type ReplayWebhookResponse struct {
+	Success  bool             `json:"success"`
+	Delivery *WebhookDelivery `json:"delivery"`
+}
+
+// ReturnLine represents a product and quantity in an order return
+type ReturnLine struct {
+	ProductID string `json:"product_id"`
+	Quantity  int32  `json:"quantity"`
+	Condition string `json:"condition,omitempty"`
+	Restocked bool   `json:"restocked"`
+}
+
+// OrderReturn represents a return merchandise authorization (RMA) for an order
+type OrderReturn struct {
+	ID         string        `json:"id"`
+	OrderID    string        `json:"order_id"`
+	UserID     string        `json:"user_id"`
+	Lines      []*ReturnLine `json:"lines"`
+	Reason     string        `json:"reason"`
+	Status     string        `json:"status"`
+	CreatedAt  string        `json:"created_at"`
+	UpdatedAt  string        `json:"updated_at"`
+	ReceivedAt string        `json:"received_at,omitempty"`
+	RefundedAt string        `json:"refunded_at,omitempty"`
+}
+
+// OrderStatusUpdateResult is the outcome for one order of a bulk status update
+type OrderStatusUpdateResult struct {
+	OrderID string `json:"order_id"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
+
+// OrderSummary holds the number of non-cancelled orders of a period and their revenue
+type OrderSummary struct {
+	OrderCount int64   `json:"order_count"`
+	Revenue    float64 `json:"revenue"`
+}
@@ -9,7 +9,7 @@ type Product struct {
 	Description string     `json:"description"`
 	SKU         string     `json:"sku"`
 	Price       float64    `json:"price"`
-	Cost        float64    `json:"cost"`
+	Cost        float64    `json:"cost,omitempty"` // Only returned to staff
 	CategoryIDs []string   `json:"category_ids"`
 	Categories  []Category `json:"categories,omitempty"`
 	Brand       string     `json:"brand"`
@@ -19,6 +19,63 @@ type Product struct {
 	SupplierID  string     `json:"supplier_id"`
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at"`
+	CreatedBy   string     `json:"created_by,omitempty"` // Only returned to staff
+	UpdatedBy   string     `json:"updated_by,omitempty"` // Only returned to staff
+
+	// Unpublished products are drafts; DeletedAt is set on soft-deleted
+	// products, which only staff can list
+	IsPublished bool       `json:"is_published"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+
+	OrderQuantityLimits
+
+	// BundleComponents is set on bundles: kits whose sale deducts the stock
+	// of these products instead of their own
+	BundleComponents []BundleComponent `json:"bundle_components,omitempty"`
+
+	// RatingAverage and RatingCount summarize the product's approved reviews
+	RatingAverage float64 `json:"rating_average"`
+	RatingCount   int64   `json:"rating_count"`
+
+	// Version changes whenever the product is edited
+	Version int64 `json:"version"`
+}
+
+// ProductReview is a customer's 1 to 5 star rating of a product. Reviews are
+// shown to customers once staff approve them.
+type ProductReview struct {
+	ID               string    `json:"id"`
+	ProductID        string    `json:"product_id"`
+	UserID           string    `json:"user_id"`
+	Rating           int32     `json:"rating"`
+	Text             string    `json:"text,omitempty"`
+	VerifiedPurchase bool      `json:"verified_purchase"`
+	Approved         bool      `json:"approved"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// ListProductReviewsResponse is a page of a product's reviews
+type ListProductReviewsResponse struct {
+	Reviews    []*ProductReview `json:"reviews"`
+	TotalCount int64            `json:"total_count"`
+}
+
+// BundleComponent is a product that goes into every unit of a bundle
+type BundleComponent struct {
+	ProductID       string `json:"product_id"`
+	VariantOptionID string `json:"variant_option_id,omitempty"`
+	SKU             string `json:"sku"`
+	Quantity        int32  `json:"quantity"`
+}
+
+// OrderQuantityLimits bounds the quantity of a product on one order line:
+// at least MinOrderQty, at most MaxOrderQty, in multiples of
+// OrderQtyIncrement. Zero means no limit.
+type OrderQuantityLimits struct {
+	MinOrderQty       int32 `json:"min_order_qty,omitempty"`
+	MaxOrderQty       int32 `json:"max_order_qty,omitempty"`
+	OrderQtyIncrement int32 `json:"order_qty_increment,omitempty"`
 }
 
 // Dimensions represents product dimensions
@@ -41,12 +98,35 @@ type ListProductsResponse struct {
 	TotalCount int32      `json:"total_count"`
 }
 
+// BatchGetProductsResponse represents the response from fetching several
+// products at once
+type BatchGetProductsResponse struct {
+	Products   []*Product `json:"products"`
+	MissingIDs []string   `json:"missing_ids"`
+}
+
 // UpdateProductResponse represents the response from updating a product
 type UpdateProductResponse struct {
 	Product *Product `json:"product"`
 	Message string   `json:"message"`
 }
 
+// ProductExportFilter narrows a product export; zero values match everything
+type ProductExportFilter struct {
+	CategoryID    string
+	SupplierID    string
+	IsActive      *bool
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+}
+
+// ProductExport is an exported product catalogue file
+type ProductExport struct {
+	Data        []byte
+	Filename    string
+	ContentType string
+}
+
 // ProductSearchResult represents a product search result
 type ProductSearchResult struct {
 	Products   []*Product `json:"products"`
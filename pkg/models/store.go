@@ -25,5 +25,16 @@ type CreateStoreResponse struct {
 type ListStoresResponse struct {
 	Stores      []*Store `json:"stores"`
 	TotalCount  int32    `json:"total_count"`
+	Limit       int32    `json:"limit"`
+	Offset      int32    `json:"offset"`
 	HasNextPage bool     `json:"has_next_page"`
 }
+
+// StoreFilter narrows a store listing; zero values match every store
+type StoreFilter struct {
+	Search     string // Matches name or description, case-insensitive
+	Country    string
+	City       string
+	State      string
+	ActiveOnly bool
+}
@@ -33,6 +33,26 @@ type UpdateSupplierResponse struct {
 	Message  string    `json:"message"`
 }
 
+// SupplierUpdate describes a partial supplier update. Only non-nil fields are
+// applied; nil fields leave the stored value untouched.
+type SupplierUpdate struct {
+	Name          *string           `json:"name,omitempty"`
+	ContactPerson *string           `json:"contact_person,omitempty"`
+	Email         *string           `json:"email,omitempty"`
+	Phone         *string           `json:"phone,omitempty"`
+	Address       *string           `json:"address,omitempty"`
+	City          *string           `json:"city,omitempty"`
+	State         *string           `json:"state,omitempty"`
+	Country       *string           `json:"country,omitempty"`
+	PostalCode    *string           `json:"postal_code,omitempty"`
+	TaxID         *string           `json:"tax_id,omitempty"`
+	Website       *string           `json:"website,omitempty"`
+	Currency      *string           `json:"currency,omitempty"`
+	LeadTimeDays  *int32            `json:"lead_time_days,omitempty"`
+	PaymentTerms  *string           `json:"payment_terms,omitempty"`
+	Metadata      map[string]string `json:"metadata,omitempty"`
+}
+
 // SupplierSearchResult represents a supplier search result
 type SupplierSearchResult struct {
 	Suppliers  []*Supplier `json:"suppliers"`
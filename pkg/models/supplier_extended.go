@@ -72,3 +72,33 @@ type SyncError struct {
 	ErrorCode   string `json:"error_code"`
 	LineNumber  int32  `json:"line_number,omitempty"`
 }
+
+// FeedValidationOptions configures a dry-run validation of a supplier's feed
+type FeedValidationOptions struct {
+	FullSync        bool       `json:"full_sync"`
+	BatchSize       int32      `json:"batch_size,omitempty"`
+	Since           *time.Time `json:"since,omitempty"`
+	CategoryMapping []string   `json:"category_mapping,omitempty"`
+	SampleSize      int32      `json:"sample_size,omitempty"`
+}
+
+// FeedRecordError describes a problem found in one supplier feed record
+type FeedRecordError struct {
+	ExternalID string `json:"external_id"`
+	SKU        string `json:"sku,omitempty"`
+	Field      string `json:"field"`
+	Message    string `json:"message"`
+}
+
+// FeedValidationReport summarizes a supplier feed validation. Nothing is
+// written while producing it.
+type FeedValidationReport struct {
+	SupplierID   string            `json:"supplier_id"`
+	AdapterName  string            `json:"adapter_name"`
+	Total        int32             `json:"total"`
+	Valid        int32             `json:"valid"`
+	Invalid      int32             `json:"invalid"`
+	SampleErrors []FeedRecordError `json:"sample_errors"`
+	StartedAt    time.Time         `json:"started_at"`
+	CompletedAt  time.Time         `json:"completed_at"`
+}
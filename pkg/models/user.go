@@ -24,13 +24,18 @@ type RegisterUserResponse struct {
 type AuthenticateUserResponse struct {
 	Token     string `json:"token"`
 	ExpiresAt int64  `json:"expires_at"`
-	User      *User  `json:"user"`
+	// RefreshToken is exchanged for a new token until RefreshExpiresAt
+	RefreshToken     string `json:"refresh_token,omitempty"`
+	RefreshExpiresAt int64  `json:"refresh_expires_at,omitempty"`
+	User             *User  `json:"user"`
 }
 
 // ListUsersResponse represents the response from listing users
 type ListUsersResponse struct {
 	Users      []*User `json:"users"`
 	TotalCount int32   `json:"total_count"`
+	Limit      int32   `json:"limit"`
+	Offset     int32   `json:"offset"`
 }
 
 // UpdateUserProfileResponse represents the response from updating user profile
@@ -64,6 +69,33 @@ type UserAddress struct {
 	UpdatedAt  time.Time `json:"updated_at"`
 }
 
+// UserAddressInput represents one address in a bulk address import
+type UserAddressInput struct {
+	Name       string `json:"name"`
+	Street     string `json:"street"`
+	City       string `json:"city"`
+	State      string `json:"state"`
+	PostalCode string `json:"postal_code"`
+	Country    string `json:"country"`
+	Phone      string `json:"phone"`
+	IsDefault  bool   `json:"is_default"`
+}
+
+// UserAddressResult represents the outcome of one address in a bulk address import
+type UserAddressResult struct {
+	Index   int          `json:"index"`
+	Success bool         `json:"success"`
+	Address *UserAddress `json:"address,omitempty"`
+	Error   string       `json:"error,omitempty"`
+}
+
+// BulkCreateUserAddressesResult represents the outcome of a bulk address import
+type BulkCreateUserAddressesResult struct {
+	Results      []*UserAddressResult `json:"results"`
+	CreatedCount int                  `json:"created_count"`
+	FailedCount  int                  `json:"failed_count"`
+}
+
 // CheckPermissionResponse represents the response from permission check
 type CheckPermissionResponse struct {
 	HasPermission bool   `json:"has_permission"`
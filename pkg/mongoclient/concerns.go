@@ -0,0 +1,150 @@
+package mongoclient
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+)
+
+// ConcernConfig holds MongoDB read/write concern settings. Empty values keep
+// the driver defaults.
+type ConcernConfig struct {
+	// ReadPreference, ReadConcern and WriteConcern apply to every collection
+	ReadPreference string
+	ReadConcern    string
+	WriteConcern   string
+	// CriticalWriteConcern applies to writes that must not be lost on failover
+	CriticalWriteConcern string
+	// ReportReadPreference applies to read-heavy report queries
+	ReportReadPreference string
+}
+
+// ConcernConfigFromEnv reads MONGO_READ_PREFERENCE, MONGO_READ_CONCERN,
+// MONGO_WRITE_CONCERN, MONGO_CRITICAL_WRITE_CONCERN (default majority) and
+// MONGO_REPORT_READ_PREFERENCE (default secondaryPreferred)
+func ConcernConfigFromEnv() ConcernConfig {
+	return ConcernConfig{
+		ReadPreference:       os.Getenv("MONGO_READ_PREFERENCE"),
+		ReadConcern:          os.Getenv("MONGO_READ_CONCERN"),
+		WriteConcern:         os.Getenv("MONGO_WRITE_CONCERN"),
+		CriticalWriteConcern: envString("MONGO_CRITICAL_WRITE_CONCERN", "majority"),
+		ReportReadPreference: envString("MONGO_REPORT_READ_PREFERENCE", "secondaryPreferred"),
+	}
+}
+
+// Apply sets the default concerns used by every collection handle on opts
+func (c ConcernConfig) Apply(opts *options.ClientOptions) error {
+	rp, err := parseReadPreference(c.ReadPreference)
+	if err != nil {
+		return err
+	}
+	rc, err := parseReadConcern(c.ReadConcern)
+	if err != nil {
+		return err
+	}
+	wc, err := parseWriteConcern(c.WriteConcern)
+	if err != nil {
+		return err
+	}
+
+	if rp != nil {
+		opts.SetReadPreference(rp)
+	}
+	if rc != nil {
+		opts.SetReadConcern(rc)
+	}
+	if wc != nil {
+		opts.SetWriteConcern(wc)
+	}
+	return nil
+}
+
+// CriticalDatabaseOptions returns the options for the handle used by writes
+// that must survive a primary failover
+func (c ConcernConfig) CriticalDatabaseOptions() (*options.DatabaseOptions, error) {
+	opts := options.Database()
+	wc, err := parseWriteConcern(c.CriticalWriteConcern)
+	if err != nil {
+		return nil, err
+	}
+	if wc != nil {
+		opts.SetWriteConcern(wc)
+	}
+	return opts, nil
+}
+
+// ReportDatabaseOptions returns the options for the handle used by read-heavy
+// report queries, which can tolerate slightly stale data
+func (c ConcernConfig) ReportDatabaseOptions() (*options.DatabaseOptions, error) {
+	opts := options.Database()
+	rp, err := parseReadPreference(c.ReportReadPreference)
+	if err != nil {
+		return nil, err
+	}
+	if rp != nil {
+		opts.SetReadPreference(rp)
+	}
+	return opts, nil
+}
+
+// parseReadPreference converts a read preference mode name into a driver read preference.
+// An empty mode leaves the driver default (primary) in place.
+func parseReadPreference(mode string) (*readpref.ReadPref, error) {
+	if mode == "" {
+		return nil, nil
+	}
+	m, err := readpref.ModeFromString(mode)
+	if err != nil {
+		return nil, fmt.Errorf("invalid read preference %q: %w", mode, err)
+	}
+	return readpref.New(m)
+}
+
+// parseReadConcern converts a read concern level ("local", "available", "majority",
+// "linearizable" or "snapshot") into a driver read concern
+func parseReadConcern(level string) (*readconcern.ReadConcern, error) {
+	switch strings.ToLower(level) {
+	case "":
+		return nil, nil
+	case "local":
+		return readconcern.Local(), nil
+	case "available":
+		return readconcern.Available(), nil
+	case "majority":
+		return readconcern.Majority(), nil
+	case "linearizable":
+		return readconcern.Linearizable(), nil
+	case "snapshot":
+		return readconcern.Snapshot(), nil
+	default:
+		return nil, fmt.Errorf("invalid read concern %q", level)
+	}
+}
+
+// parseWriteConcern converts "majority" or a node count such as "1" into a driver write concern
+func parseWriteConcern(w string) (*writeconcern.WriteConcern, error) {
+	switch strings.ToLower(w) {
+	case "":
+		return nil, nil
+	case "majority":
+		return writeconcern.Majority(), nil
+	}
+	n, err := strconv.Atoi(w)
+	if err != nil || n < 0 {
+		return nil, fmt.Errorf("invalid write concern %q", w)
+	}
+	return &writeconcern.WriteConcern{W: n}, nil
+}
+
+func envString(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
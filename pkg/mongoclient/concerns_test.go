@@ -0,0 +1,106 @@
+package mongoclient
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+// newTestClient builds a client with cfg applied; nothing is connected to
+// until an operation runs
+func newTestClient(t *testing.T, cfg ConcernConfig) *mongo.Client {
+	t.Helper()
+	opts := options.Client().ApplyURI("mongodb://localhost:27017")
+	if err := cfg.Apply(opts); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	client, err := mongo.Connect(context.Background(), opts)
+	if err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	t.Cleanup(func() { client.Disconnect(context.Background()) })
+	return client
+}
+
+// Collections inherit their concerns from the database handle they come from
+func TestConcernsAreAppliedToDatabaseHandles(t *testing.T) {
+	cfg := ConcernConfig{
+		ReadPreference:       "primaryPreferred",
+		ReadConcern:          "local",
+		WriteConcern:         "1",
+		CriticalWriteConcern: "majority",
+		ReportReadPreference: "secondaryPreferred",
+	}
+	client := newTestClient(t, cfg)
+
+	plain := client.Database("stock")
+	if got := plain.WriteConcern(); got == nil || got.W != 1 {
+		t.Errorf("default write concern = %+v, want w=1", got)
+	}
+	if got := plain.ReadConcern(); got == nil || got.Level != "local" {
+		t.Errorf("default read concern = %+v, want local", got)
+	}
+	if got := plain.ReadPreference(); got.Mode() != readpref.PrimaryPreferredMode {
+		t.Errorf("default read preference = %v, want primaryPreferred", got.Mode())
+	}
+
+	criticalOpts, err := cfg.CriticalDatabaseOptions()
+	if err != nil {
+		t.Fatalf("CriticalDatabaseOptions: %v", err)
+	}
+	critical := client.Database("stock", criticalOpts)
+	if got := critical.WriteConcern(); got == nil || got.W != "majority" {
+		t.Errorf("critical write concern = %+v, want majority", got)
+	}
+
+	reportOpts, err := cfg.ReportDatabaseOptions()
+	if err != nil {
+		t.Fatalf("ReportDatabaseOptions: %v", err)
+	}
+	reports := client.Database("stock", reportOpts)
+	if got := reports.ReadPreference(); got.Mode() != readpref.SecondaryPreferredMode {
+		t.Errorf("report read preference = %v, want secondaryPreferred", got.Mode())
+	}
+	if got := reports.WriteConcern(); got == nil || got.W != 1 {
+		t.Errorf("report write concern = %+v, want the client default w=1", got)
+	}
+}
+
+func TestConcernConfigRejectsInvalidValues(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  ConcernConfig
+	}{
+		{name: "read preference", cfg: ConcernConfig{ReadPreference: "closest"}},
+		{name: "read concern", cfg: ConcernConfig{ReadConcern: "strong"}},
+		{name: "write concern", cfg: ConcernConfig{WriteConcern: "-1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.cfg.Apply(options.Client()); err == nil {
+				t.Error("want an error")
+			}
+		})
+	}
+
+	if _, err := (ConcernConfig{CriticalWriteConcern: "all"}).CriticalDatabaseOptions(); err == nil {
+		t.Error("invalid critical write concern: want an error")
+	}
+	if _, err := (ConcernConfig{ReportReadPreference: "closest"}).ReportDatabaseOptions(); err == nil {
+		t.Error("invalid report read preference: want an error")
+	}
+}
+
+func TestConcernConfigFromEnvDefaults(t *testing.T) {
+	t.Setenv("MONGO_CRITICAL_WRITE_CONCERN", "")
+	t.Setenv("MONGO_REPORT_READ_PREFERENCE", "")
+	t.Setenv("MONGO_WRITE_CONCERN", "2")
+
+	cfg := ConcernConfigFromEnv()
+	if cfg.CriticalWriteConcern != "majority" || cfg.ReportReadPreference != "secondaryPreferred" || cfg.WriteConcern != "2" {
+		t.Errorf("config = %+v", cfg)
+	}
+}
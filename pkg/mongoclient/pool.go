@@ -0,0 +1,76 @@
+// Package mongoclient holds the MongoDB client settings shared by the services
+package mongoclient
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// PoolConfig sizes a service's MongoDB connection pool and bounds how long
+// connecting, selecting a server and socket I/O may take
+type PoolConfig struct {
+	MaxPoolSize            uint64
+	MinPoolSize            uint64
+	ConnectTimeout         time.Duration
+	SocketTimeout          time.Duration
+	ServerSelectionTimeout time.Duration
+}
+
+// DefaultPoolConfig returns the settings every service used before they
+// became configurable
+func DefaultPoolConfig() PoolConfig {
+	return PoolConfig{
+		MaxPoolSize:            10,
+		ConnectTimeout:         10 * time.Second,
+		SocketTimeout:          30 * time.Second,
+		ServerSelectionTimeout: 10 * time.Second,
+	}
+}
+
+// PoolConfigFromEnv overrides defaults with MONGO_MAX_POOL_SIZE,
+// MONGO_MIN_POOL_SIZE, MONGO_CONNECT_TIMEOUT, MONGO_SOCKET_TIMEOUT and
+// MONGO_SERVER_SELECTION_TIMEOUT. Timeouts are durations such as "15s";
+// unset or invalid values keep the default.
+func PoolConfigFromEnv(defaults PoolConfig) PoolConfig {
+	cfg := defaults
+	cfg.MaxPoolSize = envUint("MONGO_MAX_POOL_SIZE", cfg.MaxPoolSize)
+	cfg.MinPoolSize = envUint("MONGO_MIN_POOL_SIZE", cfg.MinPoolSize)
+	cfg.ConnectTimeout = envDuration("MONGO_CONNECT_TIMEOUT", cfg.ConnectTimeout)
+	cfg.SocketTimeout = envDuration("MONGO_SOCKET_TIMEOUT", cfg.SocketTimeout)
+	cfg.ServerSelectionTimeout = envDuration("MONGO_SERVER_SELECTION_TIMEOUT", cfg.ServerSelectionTimeout)
+	if cfg.MinPoolSize > cfg.MaxPoolSize && cfg.MaxPoolSize > 0 {
+		cfg.MinPoolSize = cfg.MaxPoolSize
+	}
+	return cfg
+}
+
+// Apply sets the pool size and timeouts on opts and returns it
+func (c PoolConfig) Apply(opts *options.ClientOptions) *options.ClientOptions {
+	return opts.
+		SetMaxPoolSize(c.MaxPoolSize).
+		SetMinPoolSize(c.MinPoolSize).
+		SetConnectTimeout(c.ConnectTimeout).
+		SetSocketTimeout(c.SocketTimeout).
+		SetServerSelectionTimeout(c.ServerSelectionTimeout)
+}
+
+func envUint(key string, defaultValue uint64) uint64 {
+	if value := os.Getenv(key); value != "" {
+		if n, err := strconv.ParseUint(value, 10, 64); err == nil {
+			return n
+		}
+	}
+	return defaultValue
+}
+
+func envDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if d, err := time.ParseDuration(value); err == nil && d > 0 {
+			return d
+		}
+	}
+	return defaultValue
+}
@@ -0,0 +1,65 @@
+package mongoclient
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func TestPoolConfigIsAppliedToClientOptions(t *testing.T) {
+	cfg := PoolConfig{
+		MaxPoolSize:            50,
+		MinPoolSize:            5,
+		ConnectTimeout:         3 * time.Second,
+		SocketTimeout:          20 * time.Second,
+		ServerSelectionTimeout: 4 * time.Second,
+	}
+
+	opts := cfg.Apply(options.Client().ApplyURI("mongodb://localhost:27017"))
+
+	if opts.MaxPoolSize == nil || *opts.MaxPoolSize != 50 {
+		t.Errorf("MaxPoolSize = %v, want 50", opts.MaxPoolSize)
+	}
+	if opts.MinPoolSize == nil || *opts.MinPoolSize != 5 {
+		t.Errorf("MinPoolSize = %v, want 5", opts.MinPoolSize)
+	}
+	if opts.ConnectTimeout == nil || *opts.ConnectTimeout != 3*time.Second {
+		t.Errorf("ConnectTimeout = %v, want 3s", opts.ConnectTimeout)
+	}
+	if opts.SocketTimeout == nil || *opts.SocketTimeout != 20*time.Second {
+		t.Errorf("SocketTimeout = %v, want 20s", opts.SocketTimeout)
+	}
+	if opts.ServerSelectionTimeout == nil || *opts.ServerSelectionTimeout != 4*time.Second {
+		t.Errorf("ServerSelectionTimeout = %v, want 4s", opts.ServerSelectionTimeout)
+	}
+	if err := opts.Validate(); err != nil {
+		t.Errorf("options should be valid: %v", err)
+	}
+}
+
+func TestPoolConfigFromEnv(t *testing.T) {
+	t.Setenv("MONGO_MAX_POOL_SIZE", "100")
+	t.Setenv("MONGO_MIN_POOL_SIZE", "200")
+	t.Setenv("MONGO_CONNECT_TIMEOUT", "not a duration")
+	t.Setenv("MONGO_SOCKET_TIMEOUT", "45s")
+	t.Setenv("MONGO_SERVER_SELECTION_TIMEOUT", "")
+
+	cfg := PoolConfigFromEnv(DefaultPoolConfig())
+
+	if cfg.MaxPoolSize != 100 {
+		t.Errorf("MaxPoolSize = %d, want 100", cfg.MaxPoolSize)
+	}
+	if cfg.MinPoolSize != 100 {
+		t.Errorf("MinPoolSize = %d, want it capped at the max pool size", cfg.MinPoolSize)
+	}
+	if cfg.ConnectTimeout != 10*time.Second {
+		t.Errorf("ConnectTimeout = %v, an invalid value should keep the default", cfg.ConnectTimeout)
+	}
+	if cfg.SocketTimeout != 45*time.Second {
+		t.Errorf("SocketTimeout = %v, want 45s", cfg.SocketTimeout)
+	}
+	if cfg.ServerSelectionTimeout != 10*time.Second {
+		t.Errorf("ServerSelectionTimeout = %v, want the default", cfg.ServerSelectionTimeout)
+	}
+}
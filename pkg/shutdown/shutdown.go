@@ -0,0 +1,218 @@
+// Package shutdown orders the shutdown of a service. Background workers are
+// drained first, while the servers and connections they depend on are still
+// up; servers are stopped next and connections closed last.
+package shutdown
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+const (
+	// DefaultDrainTimeout is how long workers get to finish their current
+	// item unless configured otherwise
+	DefaultDrainTimeout = 10 * time.Second
+	// ServerStopTimeout is how long servers get to finish in-flight requests
+	// after the workers are drained, when shutting down on a signal
+	ServerStopTimeout = 10 * time.Second
+)
+
+// DrainTimeoutFromEnv reads SHUTDOWN_DRAIN_TIMEOUT, e.g. "30s". Unset or
+// invalid values keep defaultValue.
+func DrainTimeoutFromEnv(defaultValue time.Duration) time.Duration {
+	if value := os.Getenv("SHUTDOWN_DRAIN_TIMEOUT"); value != "" {
+		if d, err := time.ParseDuration(value); err == nil && d > 0 {
+			return d
+		}
+	}
+	return defaultValue
+}
+
+// component is something the coordinator stops
+type component struct {
+	name string
+	stop func(ctx context.Context) error
+}
+
+// Coordinator stops the parts of a service in order: workers, then servers,
+// then closers. Register everything at startup and call Shutdown once.
+type Coordinator struct {
+	drainTimeout time.Duration
+	logger       *zap.Logger
+
+	mu      sync.Mutex
+	workers []component
+	servers []component
+	closers []component
+	once    sync.Once
+	err     error
+}
+
+// New creates a coordinator that gives workers drainTimeout to finish
+func New(drainTimeout time.Duration, logger *zap.Logger) *Coordinator {
+	if drainTimeout <= 0 {
+		drainTimeout = DefaultDrainTimeout
+	}
+	return &Coordinator{
+		drainTimeout: drainTimeout,
+		logger:       logger.Named("shutdown"),
+	}
+}
+
+// AddWorker registers a background worker. stop must make the worker take no
+// new work and return once the item it is working on is finished. Workers
+// are stopped concurrently.
+func (c *Coordinator) AddWorker(name string, stop func()) {
+	c.add(&c.workers, name, func(context.Context) error {
+		stop()
+		return nil
+	})
+}
+
+// AddServer registers a server. Servers are stopped one by one, in the order
+// they were added, once the workers are drained; stop should let in-flight
+// requests finish and give up when ctx ends.
+func (c *Coordinator) AddServer(name string, stop func(ctx context.Context) error) {
+	c.add(&c.servers, name, stop)
+}
+
+// AddCloser registers a connection or other resource to close after the
+// servers have stopped
+func (c *Coordinator) AddCloser(name string, close func() error) {
+	c.add(&c.closers, name, func(context.Context) error {
+		return close()
+	})
+}
+
+func (c *Coordinator) add(list *[]component, name string, stop func(ctx context.Context) error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	*list = append(*list, component{name: name, stop: stop})
+}
+
+// Shutdown drains the workers, waiting at most the drain timeout, then stops
+// the servers within ctx and closes the closers. Workers still busy after the
+// timeout are logged and left behind. Only the first call does anything;
+// later calls return its result.
+func (c *Coordinator) Shutdown(ctx context.Context) error {
+	c.once.Do(func() {
+		c.mu.Lock()
+		workers, servers, closers := c.workers, c.servers, c.closers
+		c.mu.Unlock()
+
+		c.drain(ctx, workers)
+
+		var errs []error
+		for _, s := range servers {
+			if err := s.stop(ctx); err != nil {
+				c.logger.Warn("Server did not stop cleanly", zap.String("server", s.name), zap.Error(err))
+				errs = append(errs, fmt.Errorf("%s: %w", s.name, err))
+			}
+		}
+		for _, cl := range closers {
+			if err := cl.stop(ctx); err != nil {
+				c.logger.Warn("Failed to close", zap.String("closer", cl.name), zap.Error(err))
+				errs = append(errs, fmt.Errorf("%s: %w", cl.name, err))
+			}
+		}
+		c.err = errors.Join(errs...)
+		c.logger.Info("Shutdown complete")
+	})
+	return c.err
+}
+
+// ShutdownOnSignal blocks until the process receives SIGINT or SIGTERM and
+// then shuts down, giving the servers ServerStopTimeout on top of the drain
+// timeout. Problems are logged, as there is no one left to report them to.
+func (c *Coordinator) ShutdownOnSignal() {
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	sig := <-quit
+	c.logger.Info("Received shutdown signal", zap.String("signal", sig.String()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.drainTimeout+ServerStopTimeout)
+	defer cancel()
+	if err := c.Shutdown(ctx); err != nil {
+		c.logger.Warn("Shutdown did not complete cleanly", zap.Error(err))
+	}
+}
+
+// drain stops every worker concurrently and waits for them until the drain
+// timeout or ctx ends
+func (c *Coordinator) drain(ctx context.Context, workers []component) {
+	if len(workers) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.drainTimeout)
+	defer cancel()
+
+	var (
+		mu      sync.Mutex
+		running = make(map[string]bool, len(workers))
+		wg      sync.WaitGroup
+	)
+	for _, w := range workers {
+		running[w.name] = true
+	}
+	for _, w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = w.stop(ctx)
+			mu.Lock()
+			delete(running, w.name)
+			mu.Unlock()
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		c.logger.Info("Background workers drained", zap.Int("workers", len(workers)))
+	case <-ctx.Done():
+		mu.Lock()
+		names := make([]string, 0, len(running))
+		for name := range running {
+			names = append(names, name)
+		}
+		mu.Unlock()
+		c.logger.Warn("Background workers still busy after drain timeout",
+			zap.Strings("workers", names),
+			zap.Duration("drain_timeout", c.drainTimeout),
+		)
+	}
+}
+
+// GRPCServer returns a stop function for AddServer that lets in-flight RPCs
+// finish and forces the server down when ctx ends first
+func GRPCServer(srv *grpc.Server) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		done := make(chan struct{})
+		go func() {
+			srv.GracefulStop()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			srv.Stop()
+			return ctx.Err()
+		}
+	}
+}
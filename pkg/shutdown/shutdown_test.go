@@ -0,0 +1,158 @@
+package shutdown
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// stopLog records the order components are stopped in
+type stopLog struct {
+	mu    sync.Mutex
+	steps []string
+}
+
+func (l *stopLog) add(step string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.steps = append(l.steps, step)
+}
+
+func (l *stopLog) get() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.steps...)
+}
+
+func TestLongRunningWorkerFinishesWithinTimeout(t *testing.T) {
+	log := &stopLog{}
+	c := New(time.Second, zap.NewNop())
+	c.AddWorker("webhooks", func() {
+		// The delivery in flight takes a while to finish
+		time.Sleep(50 * time.Millisecond)
+		log.add("webhooks finished")
+	})
+	c.AddServer("grpc", func(ctx context.Context) error {
+		log.add("grpc stopped")
+		return nil
+	})
+	c.AddCloser("mongo", func() error {
+		log.add("mongo closed")
+		return nil
+	})
+
+	if err := c.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"webhooks finished", "grpc stopped", "mongo closed"}
+	if got := log.get(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("stopped %v, want %v", got, want)
+	}
+}
+
+func TestWorkerPastTimeoutIsLeftBehind(t *testing.T) {
+	log := &stopLog{}
+	release := make(chan struct{})
+	defer close(release)
+
+	c := New(20*time.Millisecond, zap.NewNop())
+	c.AddWorker("stuck", func() { <-release })
+	c.AddServer("grpc", func(ctx context.Context) error {
+		log.add("grpc stopped")
+		return nil
+	})
+
+	start := time.Now()
+	if err := c.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("shutdown took %s, want it bounded by the drain timeout", elapsed)
+	}
+	if got := log.get(); !reflect.DeepEqual(got, []string{"grpc stopped"}) {
+		t.Fatalf("stopped %v, want the server stopped anyway", got)
+	}
+}
+
+func TestWorkersDrainConcurrently(t *testing.T) {
+	c := New(time.Second, zap.NewNop())
+	for _, name := range []string{"sweeper", "reconciler", "prober"} {
+		c.AddWorker(name, func() { time.Sleep(100 * time.Millisecond) })
+	}
+
+	start := time.Now()
+	if err := c.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed > 250*time.Millisecond {
+		t.Fatalf("draining took %s, want the workers stopped side by side", elapsed)
+	}
+}
+
+func TestShutdownJoinsErrorsAndRunsOnce(t *testing.T) {
+	serverErr := errors.New("server stuck")
+	closeErr := errors.New("close failed")
+	stops := 0
+
+	c := New(time.Second, zap.NewNop())
+	c.AddServer("http", func(ctx context.Context) error {
+		stops++
+		return serverErr
+	})
+	c.AddCloser("kafka", func() error { return closeErr })
+
+	err := c.Shutdown(context.Background())
+	if !errors.Is(err, serverErr) || !errors.Is(err, closeErr) {
+		t.Fatalf("err = %v, want both failures", err)
+	}
+	if again := c.Shutdown(context.Background()); again != err {
+		t.Fatalf("second Shutdown = %v, want the first result", again)
+	}
+	if stops != 1 {
+		t.Fatalf("server stopped %d times, want once", stops)
+	}
+}
+
+func TestGRPCServerStopsGracefully(t *testing.T) {
+	listener := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	served := make(chan error, 1)
+	go func() { served <- srv.Serve(listener) }()
+
+	if err := GRPCServer(srv)(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-served:
+	case <-time.After(time.Second):
+		t.Fatal("server still serving after stop")
+	}
+}
+
+func TestDrainTimeoutFromEnv(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{value: "", want: DefaultDrainTimeout},
+		{value: "30s", want: 30 * time.Second},
+		{value: "soon", want: DefaultDrainTimeout},
+		{value: "-5s", want: DefaultDrainTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Setenv("SHUTDOWN_DRAIN_TIMEOUT", tt.value)
+		if got := DrainTimeoutFromEnv(DefaultDrainTimeout); got != tt.want {
+			t.Errorf("SHUTDOWN_DRAIN_TIMEOUT=%q: %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
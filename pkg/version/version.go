@@ -0,0 +1,104 @@
+// Package version holds the build information of a service binary. The
+// values are injected at build time with -ldflags, e.g.
+//
+//	go build -ldflags "-X github.com/leonvanderhaeghen/stockplatform/pkg/version.Version=1.4.0 \
+//	  -X github.com/leonvanderhaeghen/stockplatform/pkg/version.Commit=$(git rev-parse --short HEAD) \
+//	  -X github.com/leonvanderhaeghen/stockplatform/pkg/version.BuildDate=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+//
+// Binaries built without them report "dev" and "unknown".
+package version
+
+import (
+	"context"
+	"runtime"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+)
+
+// Set with -ldflags -X at build time
+var (
+	Version   = "dev"
+	Commit    = "unknown"
+	BuildDate = "unknown"
+)
+
+// gRPC response header keys the build information is sent in
+const (
+	VersionKey   = "x-build-version"
+	CommitKey    = "x-build-commit"
+	BuildDateKey = "x-build-date"
+)
+
+// Info is the build information of one binary
+type Info struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildDate string `json:"build_date"`
+	GoVersion string `json:"go_version,omitempty"`
+}
+
+// Get returns the build information of the running binary
+func Get() Info {
+	return Info{
+		Version:   Version,
+		Commit:    Commit,
+		BuildDate: BuildDate,
+		GoVersion: runtime.Version(),
+	}
+}
+
+// LogFields returns the build information as log fields for the startup log
+func LogFields() []zap.Field {
+	return []zap.Field{
+		zap.String("version", Version),
+		zap.String("commit", Commit),
+		zap.String("build_date", BuildDate),
+	}
+}
+
+// Metadata returns the build information as gRPC metadata
+func Metadata() metadata.MD {
+	return metadata.Pairs(
+		VersionKey, Version,
+		CommitKey, Commit,
+		BuildDateKey, BuildDate,
+	)
+}
+
+// FromMetadata reads build information sent with Metadata. ok is false when
+// md carries no version, as with a backend built before it was added.
+func FromMetadata(md metadata.MD) (info Info, ok bool) {
+	first := func(key string) string {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+	info = Info{
+		Version:   first(VersionKey),
+		Commit:    first(CommitKey),
+		BuildDate: first(BuildDateKey),
+	}
+	return info, info.Version != ""
+}
+
+// healthServer adds the build information to health check responses
+type healthServer struct {
+	grpc_health_v1.HealthServer
+}
+
+// NewHealthServer wraps a gRPC health server so that its Check responses
+// carry the build information in their header metadata. Clients read it with
+// the grpc.Header call option and FromMetadata.
+func NewHealthServer(inner grpc_health_v1.HealthServer) grpc_health_v1.HealthServer {
+	return &healthServer{HealthServer: inner}
+}
+
+// Check sends the build information ahead of the wrapped server's response
+func (h *healthServer) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	_ = grpc.SetHeader(ctx, Metadata())
+	return h.HealthServer.Check(ctx, req)
+}
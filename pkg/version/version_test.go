@@ -0,0 +1,93 @@
+package version
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// setBuild sets the build information as -ldflags would for the duration of
+// the test
+func setBuild(t *testing.T, version, commit, buildDate string) {
+	t.Helper()
+	oldVersion, oldCommit, oldBuildDate := Version, Commit, BuildDate
+	Version, Commit, BuildDate = version, commit, buildDate
+	t.Cleanup(func() {
+		Version, Commit, BuildDate = oldVersion, oldCommit, oldBuildDate
+	})
+}
+
+func TestGetDefaults(t *testing.T) {
+	info := Get()
+	if info.Version != "dev" || info.Commit != "unknown" || info.BuildDate != "unknown" {
+		t.Fatalf("info = %+v, want dev/unknown/unknown without -ldflags", info)
+	}
+	if info.GoVersion == "" {
+		t.Error("go version is empty")
+	}
+}
+
+func TestGetReturnsInjectedValues(t *testing.T) {
+	setBuild(t, "1.4.0", "abc1234", "2026-10-01T12:00:00Z")
+
+	info := Get()
+	if info.Version != "1.4.0" || info.Commit != "abc1234" || info.BuildDate != "2026-10-01T12:00:00Z" {
+		t.Fatalf("info = %+v, want the injected build", info)
+	}
+}
+
+func TestMetadataRoundTrip(t *testing.T) {
+	setBuild(t, "1.4.0", "abc1234", "2026-10-01T12:00:00Z")
+
+	info, ok := FromMetadata(Metadata())
+	if !ok {
+		t.Fatal("metadata carries no version")
+	}
+	if info != (Info{Version: "1.4.0", Commit: "abc1234", BuildDate: "2026-10-01T12:00:00Z"}) {
+		t.Fatalf("info = %+v, want the injected build", info)
+	}
+
+	if _, ok := FromMetadata(metadata.MD{}); ok {
+		t.Error("empty metadata should report no version")
+	}
+}
+
+func TestHealthServerSendsBuildInHeader(t *testing.T) {
+	setBuild(t, "1.4.0", "abc1234", "2026-10-01T12:00:00Z")
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, NewHealthServer(health.NewServer()))
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := grpc.Dial("passthrough:///version",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	var header metadata.MD
+	resp, err := grpc_health_v1.NewHealthClient(conn).Check(context.Background(), &grpc_health_v1.HealthCheckRequest{}, grpc.Header(&header))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		t.Errorf("status = %s, want the wrapped server's SERVING", resp.Status)
+	}
+	if info, ok := FromMetadata(header); !ok || info.Commit != "abc1234" {
+		t.Fatalf("header build = %+v (%t), want the injected build", info, ok)
+	}
+}
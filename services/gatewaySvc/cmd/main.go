@@ -5,19 +5,21 @@ import (
 
 	"go.uber.org/zap"
 
+	logging "github.com/leonvanderhaeghen/stockplatform/pkg/logger"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/version"
 	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/config"
 	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/server"
 )
 
 func main() {
 	// Initialize logger
-	logger, err := zap.NewDevelopment()
+	logger, err := logging.FromEnv("api-gateway")
 	if err != nil {
 		log.Fatalf("Failed to initialize logger: %v", err)
 	}
 	defer logger.Sync()
 
-	logger.Info("Starting gateway service...")
+	logger.Info("Starting gateway service...", version.LogFields()...)
 
 	// Load configuration
 	cfg, err := config.Load()
@@ -0,0 +1,192 @@
+package availability
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Status is the coarse availability badge shown on product listings
+type Status string
+
+const (
+	StatusInStock    Status = "IN_STOCK"
+	StatusLowStock   Status = "LOW_STOCK"
+	StatusOutOfStock Status = "OUT_OF_STOCK"
+	StatusUnknown    Status = "UNKNOWN"
+)
+
+// Availability is the cached stock picture for a single product
+type Availability struct {
+	ProductID string    `json:"product_id"`
+	Available int32     `json:"available"`
+	ReorderAt int32     `json:"reorder_at"`
+	Status    Status    `json:"status"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewAvailability builds an Availability and derives its status from the quantities
+func NewAvailability(productID string, available, reorderAt int32, updatedAt time.Time) *Availability {
+	status := StatusInStock
+	switch {
+	case available <= 0:
+		status = StatusOutOfStock
+	case available <= reorderAt:
+		status = StatusLowStock
+	}
+
+	return &Availability{
+		ProductID: productID,
+		Available: available,
+		ReorderAt: reorderAt,
+		Status:    status,
+		UpdatedAt: updatedAt,
+	}
+}
+
+// Loader fetches the authoritative availability for a product from the inventory service
+type Loader func(ctx context.Context, productID string) (*Availability, error)
+
+// RestockHandler is called when a stock change event is flagged as taking a
+// product from out of stock to available
+type RestockHandler func(productID string, available int32)
+
+type entry struct {
+	availability *Availability
+	expiresAt    time.Time
+}
+
+// Cache is a read-optimized, product-keyed availability cache. Entries are kept
+// fresh by stock change events and reloaded lazily once they are older than the
+// configured TTL, which bounds how stale a listing badge can be.
+type Cache struct {
+	mu      sync.RWMutex
+	entries map[string]entry
+	load    Loader
+	ttl     time.Duration
+	logger  *zap.Logger
+
+	onRestock RestockHandler
+}
+
+// NewCache creates a new availability cache
+func NewCache(load Loader, ttl time.Duration, logger *zap.Logger) *Cache {
+	return &Cache{
+		entries: make(map[string]entry),
+		load:    load,
+		ttl:     ttl,
+		logger:  logger.Named("availability_cache"),
+	}
+}
+
+// Get returns the availability for a product, loading it on a miss or once the
+// cached entry has expired
+func (c *Cache) Get(ctx context.Context, productID string) (*Availability, error) {
+	c.mu.RLock()
+	e, ok := c.entries[productID]
+	c.mu.RUnlock()
+
+	if ok && time.Now().Before(e.expiresAt) {
+		return e.availability, nil
+	}
+
+	return c.GetFresh(ctx, productID)
+}
+
+// GetFresh bypasses the cache and loads availability straight from the inventory
+// service. Use it on accuracy-critical paths such as checkout. The result is
+// written back so subsequent listings benefit from it.
+func (c *Cache) GetFresh(ctx context.Context, productID string) (*Availability, error) {
+	availability, err := c.load(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.set(availability)
+	return availability, nil
+}
+
+// GetMany returns availability for several products. Products whose availability
+// cannot be loaded are reported with StatusUnknown rather than failing the batch.
+func (c *Cache) GetMany(ctx context.Context, productIDs []string) map[string]*Availability {
+	result := make(map[string]*Availability, len(productIDs))
+	for _, id := range productIDs {
+		availability, err := c.Get(ctx, id)
+		if err != nil {
+			c.logger.Warn("Failed to load product availability",
+				zap.String("product_id", id),
+				zap.Error(err),
+			)
+			availability = &Availability{ProductID: id, Status: StatusUnknown}
+		}
+		result[id] = availability
+	}
+	return result
+}
+
+// OnRestock registers a handler for products coming back in stock. It must be
+// called before events are consumed.
+func (c *Cache) OnRestock(handler RestockHandler) {
+	c.onRestock = handler
+}
+
+// Invalidate drops the cached entry for a product so the next read reloads it
+func (c *Cache) Invalidate(productID string) {
+	c.mu.Lock()
+	delete(c.entries, productID)
+	c.mu.Unlock()
+}
+
+// HandleStockChanged applies a stock change event to the cache. Events that carry
+// the new quantities update the entry in place; anything else just invalidates it.
+// Events older than the cached entry are ignored so redelivered messages cannot
+// roll the cache back.
+func (c *Cache) HandleStockChanged(event *StockChangedEvent) {
+	if event == nil || event.ProductID == "" {
+		return
+	}
+
+	if event.Available == nil {
+		c.Invalidate(event.ProductID)
+		return
+	}
+
+	occurredAt := event.Timestamp
+	if occurredAt.IsZero() {
+		occurredAt = time.Now()
+	}
+
+	c.mu.RLock()
+	current, ok := c.entries[event.ProductID]
+	c.mu.RUnlock()
+	if ok && current.availability.UpdatedAt.After(occurredAt) {
+		return
+	}
+
+	reorderAt := int32(0)
+	if event.ReorderAt != nil {
+		reorderAt = *event.ReorderAt
+	} else if ok {
+		reorderAt = current.availability.ReorderAt
+	}
+
+	c.set(NewAvailability(event.ProductID, *event.Available, reorderAt, occurredAt))
+
+	// Only the inventory service knows the level before the write, so it flags
+	// moves from zero to positive. A product missing from the cache may well
+	// have been in stock all along, so the cache does not guess.
+	if event.Restocked && *event.Available > 0 && c.onRestock != nil {
+		c.onRestock(event.ProductID, *event.Available)
+	}
+}
+
+func (c *Cache) set(availability *Availability) {
+	c.mu.Lock()
+	c.entries[availability.ProductID] = entry{
+		availability: availability,
+		expiresAt:    time.Now().Add(c.ttl),
+	}
+	c.mu.Unlock()
+}
@@ -0,0 +1,136 @@
+package availability
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// countingLoader serves availability from a map and counts the loads
+type countingLoader struct {
+	available map[string]int32
+	loads     int
+}
+
+func (l *countingLoader) load(ctx context.Context, productID string) (*Availability, error) {
+	l.loads++
+	return NewAvailability(productID, l.available[productID], 2, time.Now()), nil
+}
+
+func int32Ptr(v int32) *int32 { return &v }
+
+func TestCacheAppliesStockChangedQuantities(t *testing.T) {
+	loader := &countingLoader{available: map[string]int32{"p1": 10}}
+	cache := NewCache(loader.load, time.Hour, zap.NewNop())
+	ctx := context.Background()
+
+	if _, err := cache.Get(ctx, "p1"); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	cache.HandleStockChanged(&StockChangedEvent{
+		Type:      EventTypeStockChanged,
+		ProductID: "p1",
+		Available: int32Ptr(1),
+		Timestamp: time.Now(),
+	})
+
+	got, err := cache.Get(ctx, "p1")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Available != 1 || got.Status != StatusLowStock {
+		t.Errorf("got available %d status %s, want 1 %s", got.Available, got.Status, StatusLowStock)
+	}
+	if got.ReorderAt != 2 {
+		t.Errorf("reorder point = %d, want the cached 2 kept", got.ReorderAt)
+	}
+	if loader.loads != 1 {
+		t.Errorf("loads = %d, want the event applied without reloading", loader.loads)
+	}
+}
+
+func TestCacheInvalidatesOnStockChangedWithoutQuantities(t *testing.T) {
+	loader := &countingLoader{available: map[string]int32{"p1": 10}}
+	cache := NewCache(loader.load, time.Hour, zap.NewNop())
+	ctx := context.Background()
+
+	if _, err := cache.Get(ctx, "p1"); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	loader.available["p1"] = 0
+
+	cache.HandleStockChanged(&StockChangedEvent{Type: EventTypeStockChanged, ProductID: "p1"})
+
+	got, err := cache.Get(ctx, "p1")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if loader.loads != 2 {
+		t.Errorf("loads = %d, want a reload after invalidation", loader.loads)
+	}
+	if got.Status != StatusOutOfStock {
+		t.Errorf("status = %s, want %s", got.Status, StatusOutOfStock)
+	}
+}
+
+func TestCacheIgnoresStaleStockChanged(t *testing.T) {
+	cache := NewCache((&countingLoader{}).load, time.Hour, zap.NewNop())
+	now := time.Now()
+
+	cache.HandleStockChanged(&StockChangedEvent{ProductID: "p1", Available: int32Ptr(5), Timestamp: now})
+	cache.HandleStockChanged(&StockChangedEvent{ProductID: "p1", Available: int32Ptr(9), Timestamp: now.Add(-time.Minute)})
+
+	got, err := cache.Get(context.Background(), "p1")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Available != 5 {
+		t.Errorf("available = %d, want a redelivered older event ignored", got.Available)
+	}
+}
+
+func TestCacheRestockFiresOnlyWhenFlagged(t *testing.T) {
+	cache := NewCache((&countingLoader{}).load, time.Hour, zap.NewNop())
+	var restocked []int32
+	cache.OnRestock(func(productID string, available int32) {
+		restocked = append(restocked, available)
+	})
+
+	now := time.Now()
+	for i, step := range []struct {
+		available int32
+		restocked bool
+	}{{0, false}, {3, true}, {7, false}, {0, false}, {2, true}} {
+		cache.HandleStockChanged(&StockChangedEvent{
+			ProductID: "p1",
+			Available: int32Ptr(step.available),
+			Restocked: step.restocked,
+			Timestamp: now.Add(time.Duration(i) * time.Second),
+		})
+	}
+
+	if len(restocked) != 2 || restocked[0] != 3 || restocked[1] != 2 {
+		t.Errorf("restocks = %v, want [3 2]", restocked)
+	}
+}
+
+func TestCacheRestockIgnoresUncachedInStockProduct(t *testing.T) {
+	cache := NewCache((&countingLoader{}).load, time.Hour, zap.NewNop())
+	var restocked []string
+	cache.OnRestock(func(productID string, available int32) {
+		restocked = append(restocked, productID)
+	})
+
+	cache.HandleStockChanged(&StockChangedEvent{
+		ProductID: "p1",
+		Available: int32Ptr(8),
+		Timestamp: time.Now(),
+	})
+
+	if len(restocked) != 0 {
+		t.Errorf("restocks = %v, want none for a product not known to be out of stock", restocked)
+	}
+}
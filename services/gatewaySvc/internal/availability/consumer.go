@@ -0,0 +1,165 @@
+package availability
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+)
+
+// EventTypeStockChanged is the event type emitted whenever a product's stock level moves
+const EventTypeStockChanged = "inventory.stock_changed"
+
+// StockChangedEvent is the payload of an inventory.stock_changed event. The
+// quantities are optional; when absent the cache simply drops its entry.
+// Restocked is set by the inventory service when the write took stock that it
+// knew was at zero or less to a positive level.
+type StockChangedEvent struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	ProductID string    `json:"product_id"`
+	Available *int32    `json:"available,omitempty"`
+	ReorderAt *int32    `json:"reorder_at,omitempty"`
+	Restocked bool      `json:"restocked,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// ConsumerConfig holds Kafka settings for the stock change consumer
+type ConsumerConfig struct {
+	Brokers []string
+	Topic   string
+	GroupID string
+}
+
+// InstanceGroupID returns the consumer group of this gateway instance: prefix
+// followed by the host name, or by a random suffix when the host name cannot
+// be read. Every instance keeps its own cache, so every instance must see
+// every stock change; sharing one group would split the partitions between
+// instances and leave each cache blind to the others' share. Groups of
+// instances that are gone are dropped by Kafka once their offsets expire.
+func InstanceGroupID(prefix string) string {
+	if hostname, err := os.Hostname(); err == nil && hostname != "" {
+		return prefix + "-" + hostname
+	}
+	suffix := make([]byte, 8)
+	_, _ = rand.Read(suffix)
+	return prefix + "-" + hex.EncodeToString(suffix)
+}
+
+// Consumer feeds inventory.stock_changed events from Kafka into a Cache
+type Consumer struct {
+	consumerGroup sarama.ConsumerGroup
+	cache         *Cache
+	topic         string
+	logger        *zap.Logger
+	ctx           context.Context
+	cancel        context.CancelFunc
+	wg            sync.WaitGroup
+}
+
+// NewConsumer creates a new stock change consumer
+func NewConsumer(config ConsumerConfig, cache *Cache, logger *zap.Logger) (*Consumer, error) {
+	saramaConfig := sarama.NewConfig()
+	saramaConfig.Consumer.Group.Rebalance.Strategy = sarama.BalanceStrategyRoundRobin
+	saramaConfig.Consumer.Offsets.Initial = sarama.OffsetNewest
+	saramaConfig.Consumer.Return.Errors = true
+
+	consumerGroup, err := sarama.NewConsumerGroup(config.Brokers, config.GroupID, saramaConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kafka consumer group: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Consumer{
+		consumerGroup: consumerGroup,
+		cache:         cache,
+		topic:         config.Topic,
+		logger:        logger.Named("availability_consumer"),
+		ctx:           ctx,
+		cancel:        cancel,
+	}, nil
+}
+
+// Start begins consuming stock change events in the background
+func (c *Consumer) Start() {
+	handler := &stockChangedHandler{cache: c.cache, logger: c.logger}
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		for {
+			if c.ctx.Err() != nil {
+				return
+			}
+			if err := c.consumerGroup.Consume(c.ctx, []string{c.topic}, handler); err != nil {
+				c.logger.Error("Error consuming stock events",
+					zap.Error(err),
+					zap.String("topic", c.topic),
+				)
+			}
+		}
+	}()
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		for err := range c.consumerGroup.Errors() {
+			c.logger.Error("Consumer group error", zap.Error(err))
+		}
+	}()
+}
+
+// Close stops the consumer and waits for it to exit
+func (c *Consumer) Close() error {
+	c.cancel()
+	err := c.consumerGroup.Close()
+	c.wg.Wait()
+	return err
+}
+
+// stockChangedHandler implements sarama.ConsumerGroupHandler
+type stockChangedHandler struct {
+	cache  *Cache
+	logger *zap.Logger
+}
+
+// Setup is run at the beginning of a new session, before ConsumeClaim
+func (h *stockChangedHandler) Setup(sarama.ConsumerGroupSession) error { return nil }
+
+// Cleanup is run at the end of a session, once all ConsumeClaim goroutines have exited
+func (h *stockChangedHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+// ConsumeClaim applies each stock change message to the cache
+func (h *stockChangedHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for {
+		select {
+		case message := <-claim.Messages():
+			if message == nil {
+				return nil
+			}
+
+			var event StockChangedEvent
+			if err := json.Unmarshal(message.Value, &event); err != nil {
+				h.logger.Error("Failed to decode stock event",
+					zap.Error(err),
+					zap.Int64("offset", message.Offset),
+				)
+			} else if event.Type == EventTypeStockChanged {
+				h.cache.HandleStockChanged(&event)
+			}
+
+			session.MarkMessage(message, "")
+
+		case <-session.Context().Done():
+			return nil
+		}
+	}
+}
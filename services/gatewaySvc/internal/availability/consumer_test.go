@@ -0,0 +1,17 @@
+package availability
+
+import (
+	"os"
+	"testing"
+)
+
+func TestInstanceGroupIDIsPerHost(t *testing.T) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		t.Skipf("host name unavailable: %v", err)
+	}
+
+	if got := InstanceGroupID("gateway-availability"); got != "gateway-availability-"+hostname {
+		t.Fatalf("group = %q, want the prefix followed by the host name %q", got, hostname)
+	}
+}
@@ -3,21 +3,32 @@ package config
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/shutdown"
 )
 
 // Config holds the application configuration
 type Config struct {
-	Server   ServerConfig   `mapstructure:"server"`
-	JWT      JWTConfig      `mapstructure:"jwt"`
-	Services ServicesConfig `mapstructure:"services"`
-	Logging  LoggingConfig  `mapstructure:"logging"`
+	Server       ServerConfig       `mapstructure:"server"`
+	JWT          JWTConfig          `mapstructure:"jwt"`
+	Services     ServicesConfig     `mapstructure:"services"`
+	Logging      LoggingConfig      `mapstructure:"logging"`
+	Availability AvailabilityConfig `mapstructure:"availability"`
+	Dashboard    DashboardConfig    `mapstructure:"dashboard"`
+	Timeouts     TimeoutsConfig     `mapstructure:"timeouts"`
+	Media        MediaConfig        `mapstructure:"media"`
+	Resilience   ResilienceConfig   `mapstructure:"resilience"`
 }
 
 // ServerConfig holds server-related configuration
 type ServerConfig struct {
 	Port string `mapstructure:"port" validate:"required"`
+	// ShutdownDrainTimeout is how long running background jobs get to
+	// finish on shutdown before the REST server is stopped
+	ShutdownDrainTimeout time.Duration `mapstructure:"shutdown_drain_timeout"`
 }
 
 // JWTConfig holds JWT-related configuration
@@ -35,6 +46,63 @@ type ServicesConfig struct {
 	StoreAddr     string `mapstructure:"store_addr" validate:"required"`
 }
 
+// AvailabilityConfig holds settings for the product availability cache
+type AvailabilityConfig struct {
+	// CacheTTL bounds how stale a cached availability entry may get before it is reloaded
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
+	// KafkaBrokers enables event-driven updates when set; otherwise the cache relies on its TTL alone
+	KafkaBrokers []string `mapstructure:"kafka_brokers"`
+	Topic        string   `mapstructure:"topic"`
+	// ConsumerGroup is the prefix of each instance's own consumer group, see
+	// availability.InstanceGroupID
+	ConsumerGroup string `mapstructure:"consumer_group"`
+}
+
+// DashboardConfig holds settings for the admin dashboard summary
+type DashboardConfig struct {
+	// CacheTTL is how long a complete summary is served before it is recomputed
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
+	// BackendTimeout bounds each backend call; slower backends are reported as unavailable
+	BackendTimeout time.Duration `mapstructure:"backend_timeout"`
+}
+
+// TimeoutsConfig bounds how long a REST request may take before it is
+// answered with 504 and its backend calls are cancelled
+type TimeoutsConfig struct {
+	// Default applies to most routes
+	Default time.Duration `mapstructure:"default"`
+	// Long applies to product exports and supplier syncs
+	Long time.Duration `mapstructure:"long"`
+}
+
+// MediaConfig holds settings for the image proxy
+type MediaConfig struct {
+	// AllowedHosts are the hosts images may be proxied from; the proxy is
+	// disabled while it is empty
+	AllowedHosts []string `mapstructure:"allowed_hosts"`
+	// FetchTimeout bounds fetching one image
+	FetchTimeout time.Duration `mapstructure:"fetch_timeout"`
+	// MaxBytes caps the size of a proxied image
+	MaxBytes int64 `mapstructure:"max_bytes"`
+	// CacheMaxAge is how long clients may cache a proxied image
+	CacheMaxAge time.Duration `mapstructure:"cache_max_age"`
+}
+
+// ResilienceConfig holds the retry and circuit breaker settings for the
+// backend service clients
+type ResilienceConfig struct {
+	// MaxAttempts is how often a read failing with Unavailable is tried in
+	// total; writes are never retried
+	MaxAttempts    int           `mapstructure:"max_attempts"`
+	InitialBackoff time.Duration `mapstructure:"initial_backoff"`
+	MaxBackoff     time.Duration `mapstructure:"max_backoff"`
+	// FailureThreshold is the number of consecutive failures that opens a
+	// backend's breaker; 0 disables the breaker
+	FailureThreshold int `mapstructure:"failure_threshold"`
+	// OpenTimeout is how long an open breaker answers 503 before it probes the backend again
+	OpenTimeout time.Duration `mapstructure:"open_timeout"`
+}
+
 // LoggingConfig holds logging configuration
 type LoggingConfig struct {
 	Level string `mapstructure:"level"`
@@ -74,6 +142,7 @@ func Load() (*Config, error) {
 func setDefaults() {
 	// Server defaults
 	viper.SetDefault("server.port", "8080")
+	viper.SetDefault("server.shutdown_drain_timeout", shutdown.DefaultDrainTimeout.String())
 
 	// JWT defaults
 	viper.SetDefault("jwt.secret", "your-secret-key-here")
@@ -86,6 +155,33 @@ func setDefaults() {
 	viper.SetDefault("services.supplier_addr", "localhost:50057")
 	viper.SetDefault("services.store_addr", "localhost:50058")
 
+	// Availability cache defaults
+	viper.SetDefault("availability.cache_ttl", "30s")
+	viper.SetDefault("availability.kafka_brokers", []string{})
+	viper.SetDefault("availability.topic", "inventory-events")
+	viper.SetDefault("availability.consumer_group", "gateway-availability")
+
+	// Dashboard defaults
+	viper.SetDefault("dashboard.cache_ttl", "30s")
+	viper.SetDefault("dashboard.backend_timeout", "2s")
+
+	// Request timeout defaults
+	viper.SetDefault("timeouts.default", "15s")
+	viper.SetDefault("timeouts.long", "2m")
+
+	// Image proxy defaults
+	viper.SetDefault("media.allowed_hosts", []string{})
+	viper.SetDefault("media.fetch_timeout", "10s")
+	viper.SetDefault("media.max_bytes", 10<<20)
+	viper.SetDefault("media.cache_max_age", "24h")
+
+	// Backend client resilience defaults
+	viper.SetDefault("resilience.max_attempts", 3)
+	viper.SetDefault("resilience.initial_backoff", "100ms")
+	viper.SetDefault("resilience.max_backoff", "1s")
+	viper.SetDefault("resilience.failure_threshold", 5)
+	viper.SetDefault("resilience.open_timeout", "30s")
+
 	// Logging defaults
 	viper.SetDefault("logging.level", "info")
 }
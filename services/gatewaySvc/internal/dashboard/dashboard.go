@@ -0,0 +1,151 @@
+package dashboard
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Backend names reported in Summary.Unavailable
+const (
+	BackendOrders    = "orders"
+	BackendInventory = "inventory"
+	BackendUsers     = "users"
+)
+
+// Summary is the combined set of KPIs shown on the admin dashboard. A KPI is
+// nil when its backend did not answer in time; Partial is then set and the
+// backend is listed in Unavailable.
+type Summary struct {
+	OrdersToday   *int64    `json:"orders_today"`
+	RevenueToday  *float64  `json:"revenue_today"`
+	LowStockCount *int64    `json:"low_stock_count"`
+	ActiveUsers   *int64    `json:"active_users"`
+	Partial       bool      `json:"partial"`
+	Unavailable   []string  `json:"unavailable,omitempty"`
+	GeneratedAt   time.Time `json:"generated_at"`
+}
+
+// Sources fetch the aggregate each KPI is built from
+type Sources struct {
+	// Orders returns the number of orders created in [from, to) and their revenue
+	Orders func(ctx context.Context, from, to time.Time) (count int64, revenue float64, err error)
+	// LowStock returns the number of inventory items that need reordering
+	LowStock func(ctx context.Context) (int64, error)
+	// ActiveUsers returns the number of active users
+	ActiveUsers func(ctx context.Context) (int64, error)
+}
+
+// Aggregator fans out to the backends concurrently and caches complete
+// summaries for a short while. Partial summaries are not cached, so the
+// dashboard recovers as soon as the failing backend does.
+type Aggregator struct {
+	sources Sources
+	ttl     time.Duration
+	timeout time.Duration
+	logger  *zap.Logger
+
+	mu        sync.Mutex
+	cached    *Summary
+	expiresAt time.Time
+}
+
+// NewAggregator creates a new dashboard aggregator. timeout bounds how long a
+// single backend may take before its KPI is left out.
+func NewAggregator(sources Sources, ttl, timeout time.Duration, logger *zap.Logger) *Aggregator {
+	return &Aggregator{
+		sources: sources,
+		ttl:     ttl,
+		timeout: timeout,
+		logger:  logger.Named("dashboard"),
+	}
+}
+
+// Summary returns the dashboard KPIs, from cache when a complete summary is
+// still fresh
+func (a *Aggregator) Summary(ctx context.Context) *Summary {
+	a.mu.Lock()
+	if a.cached != nil && time.Now().Before(a.expiresAt) {
+		summary := a.cached
+		a.mu.Unlock()
+		return summary
+	}
+	a.mu.Unlock()
+
+	summary := a.collect(ctx)
+
+	if !summary.Partial {
+		a.mu.Lock()
+		a.cached = summary
+		a.expiresAt = time.Now().Add(a.ttl)
+		a.mu.Unlock()
+	}
+	return summary
+}
+
+// collect queries every backend concurrently, each bounded by the backend timeout
+func (a *Aggregator) collect(ctx context.Context) *Summary {
+	now := time.Now()
+	dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	summary := &Summary{GeneratedAt: now}
+
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+	run := func(backend string, fetch func(ctx context.Context) error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fetchCtx, cancel := context.WithTimeout(ctx, a.timeout)
+			defer cancel()
+
+			if err := fetch(fetchCtx); err != nil {
+				a.logger.Warn("Dashboard backend unavailable",
+					zap.String("backend", backend),
+					zap.Error(err),
+				)
+				mu.Lock()
+				summary.Partial = true
+				summary.Unavailable = append(summary.Unavailable, backend)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	run(BackendOrders, func(ctx context.Context) error {
+		count, revenue, err := a.sources.Orders(ctx, dayStart, dayStart.AddDate(0, 0, 1))
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		summary.OrdersToday, summary.RevenueToday = &count, &revenue
+		mu.Unlock()
+		return nil
+	})
+	run(BackendInventory, func(ctx context.Context) error {
+		count, err := a.sources.LowStock(ctx)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		summary.LowStockCount = &count
+		mu.Unlock()
+		return nil
+	})
+	run(BackendUsers, func(ctx context.Context) error {
+		count, err := a.sources.ActiveUsers(ctx)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		summary.ActiveUsers = &count
+		mu.Unlock()
+		return nil
+	})
+
+	wg.Wait()
+	return summary
+}
@@ -0,0 +1,83 @@
+package dashboard
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// healthySources answers every KPI immediately and counts the order lookups
+func healthySources(orderCalls *int32) Sources {
+	return Sources{
+		Orders: func(ctx context.Context, from, to time.Time) (int64, float64, error) {
+			atomic.AddInt32(orderCalls, 1)
+			return 12, 340.5, nil
+		},
+		LowStock:    func(ctx context.Context) (int64, error) { return 3, nil },
+		ActiveUsers: func(ctx context.Context) (int64, error) { return 42, nil },
+	}
+}
+
+func TestSummaryIsPartialWhenBackendsFail(t *testing.T) {
+	var orderCalls int32
+	sources := healthySources(&orderCalls)
+	sources.LowStock = func(ctx context.Context) (int64, error) {
+		return 0, errors.New("inventory unavailable")
+	}
+	sources.ActiveUsers = func(ctx context.Context) (int64, error) {
+		// Slower than the backend timeout
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}
+	aggregator := NewAggregator(sources, time.Minute, 50*time.Millisecond, zap.NewNop())
+
+	start := time.Now()
+	summary := aggregator.Summary(context.Background())
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("summary took %s, want a slow backend cut off at its timeout", elapsed)
+	}
+
+	if !summary.Partial {
+		t.Fatal("want a partial summary")
+	}
+	unavailable := append([]string(nil), summary.Unavailable...)
+	sort.Strings(unavailable)
+	if len(unavailable) != 2 || unavailable[0] != BackendInventory || unavailable[1] != BackendUsers {
+		t.Errorf("unavailable = %v, want inventory and users", summary.Unavailable)
+	}
+	if summary.OrdersToday == nil || *summary.OrdersToday != 12 || summary.RevenueToday == nil || *summary.RevenueToday != 340.5 {
+		t.Errorf("order KPIs = %v, %v, want the healthy backend's numbers", summary.OrdersToday, summary.RevenueToday)
+	}
+	if summary.LowStockCount != nil || summary.ActiveUsers != nil {
+		t.Errorf("KPIs of failed backends = %v, %v, want nil", summary.LowStockCount, summary.ActiveUsers)
+	}
+
+	// Partial summaries are not cached
+	aggregator.Summary(context.Background())
+	if calls := atomic.LoadInt32(&orderCalls); calls != 2 {
+		t.Errorf("order backend called %d times, want the partial summary recomputed", calls)
+	}
+}
+
+func TestCompleteSummaryIsCached(t *testing.T) {
+	var orderCalls int32
+	aggregator := NewAggregator(healthySources(&orderCalls), time.Minute, time.Second, zap.NewNop())
+
+	first := aggregator.Summary(context.Background())
+	second := aggregator.Summary(context.Background())
+
+	if first.Partial || len(first.Unavailable) != 0 {
+		t.Fatalf("summary = %+v, want complete", first)
+	}
+	if *first.LowStockCount != 3 || *first.ActiveUsers != 42 {
+		t.Errorf("KPIs = %d low stock, %d users", *first.LowStockCount, *first.ActiveUsers)
+	}
+	if second != first || atomic.LoadInt32(&orderCalls) != 1 {
+		t.Errorf("order backend called %d times, want the cached summary reused", orderCalls)
+	}
+}
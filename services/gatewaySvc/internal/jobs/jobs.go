@@ -0,0 +1,186 @@
+package jobs
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Status represents the lifecycle state of a background job
+type Status string
+
+const (
+	StatusPending   Status = "PENDING"
+	StatusRunning   Status = "RUNNING"
+	StatusCompleted Status = "COMPLETED"
+	StatusFailed    Status = "FAILED"
+)
+
+// Job is a snapshot of a background job tracked by the gateway
+type Job struct {
+	ID          string     `json:"id"`
+	Type        string     `json:"type"`
+	Status      Status     `json:"status"`
+	Total       int64      `json:"total"`
+	Processed   int64      `json:"processed"`
+	Error       string     `json:"error,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// Progress lets a running job report how far along it is
+type Progress struct {
+	manager *Manager
+	id      string
+}
+
+// SetTotal records the total amount of work the job expects to do
+func (p *Progress) SetTotal(total int64) {
+	p.manager.update(p.id, func(j *Job) { j.Total = total })
+}
+
+// SetProcessed records how much work the job has completed so far
+func (p *Progress) SetProcessed(processed int64) {
+	p.manager.update(p.id, func(j *Job) { j.Processed = processed })
+}
+
+// Func is the work performed by a job
+type Func func(ctx context.Context, progress *Progress) error
+
+// Manager runs jobs in the background and keeps their state in memory
+type Manager struct {
+	mu      sync.RWMutex
+	jobs    map[string]*Job
+	timeout time.Duration
+	logger  *zap.Logger
+	running sync.WaitGroup
+	stopped bool
+}
+
+// NewManager creates a new job manager. Each job is cancelled once it has run
+// for longer than timeout.
+func NewManager(timeout time.Duration, logger *zap.Logger) *Manager {
+	return &Manager{
+		jobs:    make(map[string]*Job),
+		timeout: timeout,
+		logger:  logger.Named("jobs"),
+	}
+}
+
+// Start registers a job of the given type and runs fn in the background.
+// The returned snapshot reflects the job as it was queued. After Stop, jobs
+// are recorded as failed without running.
+func (m *Manager) Start(jobType string, fn Func) Job {
+	job := &Job{
+		ID:        newID(),
+		Type:      jobType,
+		Status:    StatusPending,
+		CreatedAt: time.Now(),
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.stopped {
+		job.Status = StatusFailed
+		job.Error = "gateway is shutting down"
+		job.CompletedAt = &job.CreatedAt
+		m.jobs[job.ID] = job
+		return *job
+	}
+	m.jobs[job.ID] = job
+	m.running.Add(1)
+	go m.run(job.ID, fn)
+
+	return *job
+}
+
+// Stop stops accepting jobs and waits for the running ones to finish
+func (m *Manager) Stop() {
+	m.mu.Lock()
+	m.stopped = true
+	m.mu.Unlock()
+	m.running.Wait()
+}
+
+// Get returns a snapshot of the job with the given ID
+func (m *Manager) Get(id string) (Job, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	job, ok := m.jobs[id]
+	if !ok {
+		return Job{}, false
+	}
+	return *job, true
+}
+
+// List returns snapshots of all known jobs, newest first
+func (m *Manager) List() []Job {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make([]Job, 0, len(m.jobs))
+	for _, job := range m.jobs {
+		result = append(result, *job)
+	}
+	sort.Slice(result, func(i, k int) bool {
+		return result[i].CreatedAt.After(result[k].CreatedAt)
+	})
+	return result
+}
+
+func (m *Manager) run(id string, fn Func) {
+	defer m.running.Done()
+	log := m.logger.With(zap.String("job_id", id))
+
+	m.update(id, func(j *Job) {
+		now := time.Now()
+		j.Status = StatusRunning
+		j.StartedAt = &now
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	err := fn(ctx, &Progress{manager: m, id: id})
+
+	m.update(id, func(j *Job) {
+		now := time.Now()
+		j.CompletedAt = &now
+		if err != nil {
+			j.Status = StatusFailed
+			j.Error = err.Error()
+			return
+		}
+		j.Status = StatusCompleted
+	})
+
+	if err != nil {
+		log.Error("Job failed", zap.Error(err))
+		return
+	}
+	log.Info("Job completed")
+}
+
+func (m *Manager) update(id string, fn func(j *Job)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if job, ok := m.jobs[id]; ok {
+		fn(job)
+	}
+}
+
+func newID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return time.Now().Format("20060102150405.000000000")
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,197 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrInvalidURL is returned for URLs that are not absolute http(s) URLs
+	ErrInvalidURL = errors.New("invalid media URL")
+	// ErrHostNotAllowed is returned for URLs on hosts outside the allow-list
+	ErrHostNotAllowed = errors.New("media host not allowed")
+	// ErrTooLarge is returned for media larger than the size cap
+	ErrTooLarge = errors.New("media too large")
+	// ErrNotImage is returned when the upstream answers with something other
+	// than an image, which must not be served from the gateway's domain
+	ErrNotImage = errors.New("media is not an image")
+	// ErrUpstream is returned when the upstream cannot be fetched or answers
+	// with an error
+	ErrUpstream = errors.New("media upstream failed")
+)
+
+// maxRedirects bounds the redirects followed for one fetch
+const maxRedirects = 5
+
+// Config configures the media proxy
+type Config struct {
+	// AllowedHosts are the hosts media may be fetched from: host names,
+	// matched exactly, or "*." wildcards that match any subdomain
+	AllowedHosts []string
+	// Timeout bounds a whole fetch, including reading the body
+	Timeout time.Duration
+	// MaxBytes caps the size of fetched media
+	MaxBytes int64
+	// CacheMaxAge is how long clients may cache proxied media
+	CacheMaxAge time.Duration
+}
+
+// Proxy fetches images from allow-listed hosts so the front-end can load
+// supplier images through the gateway's own domain. Every URL, including
+// every redirect, must be on an allowed host, so the proxy cannot be used to
+// reach anything else.
+type Proxy struct {
+	hosts       map[string]bool
+	wildcards   []string
+	client      *http.Client
+	maxBytes    int64
+	cacheMaxAge time.Duration
+	logger      *zap.Logger
+}
+
+// Media is a fetched image ready to be streamed to the client. The caller
+// must close Body.
+type Media struct {
+	Body          io.ReadCloser
+	ContentType   string
+	ContentLength int64
+	ETag          string
+	LastModified  string
+}
+
+// NewProxy creates a media proxy. Host entries are matched
+// case-insensitively; ports are not part of the match.
+func NewProxy(cfg Config, logger *zap.Logger) *Proxy {
+	p := &Proxy{
+		hosts:       make(map[string]bool, len(cfg.AllowedHosts)),
+		maxBytes:    cfg.MaxBytes,
+		cacheMaxAge: cfg.CacheMaxAge,
+		logger:      logger.Named("media_proxy"),
+	}
+	for _, host := range cfg.AllowedHosts {
+		host = strings.ToLower(strings.TrimSpace(host))
+		switch {
+		case host == "":
+		case strings.HasPrefix(host, "*."):
+			p.wildcards = append(p.wildcards, host[1:])
+		default:
+			p.hosts[host] = true
+		}
+	}
+	p.client = &http.Client{
+		Timeout: cfg.Timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("%w: too many redirects", ErrUpstream)
+			}
+			_, err := p.CheckURL(req.URL.String())
+			return err
+		},
+	}
+	return p
+}
+
+// CacheMaxAge returns how long clients may cache proxied media
+func (p *Proxy) CacheMaxAge() time.Duration {
+	return p.cacheMaxAge
+}
+
+// CheckURL parses a media URL and checks it against the allow-list
+func (p *Proxy) CheckURL(raw string) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidURL, raw)
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if p.hosts[host] {
+		return u, nil
+	}
+	for _, suffix := range p.wildcards {
+		if strings.HasSuffix(host, suffix) {
+			return u, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrHostNotAllowed, host)
+}
+
+// Fetch requests an image from an allowed host. Media whose declared size
+// exceeds the cap is rejected before any of it is read; media of unknown
+// size is read up to the cap first, so an oversized image is rejected
+// rather than cut off halfway through the response.
+func (p *Proxy) Fetch(ctx context.Context, raw string) (*Media, error) {
+	u, err := p.CheckURL(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidURL, raw)
+	}
+	req.Header.Set("Accept", "image/*")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) && errors.Is(urlErr.Err, ErrHostNotAllowed) {
+			return nil, urlErr.Err
+		}
+		return nil, fmt.Errorf("%w: %v", ErrUpstream, err)
+	}
+
+	media, err := p.checkResponse(resp)
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	return media, nil
+}
+
+// checkResponse turns an upstream response into Media, enforcing the status,
+// content type and size cap
+func (p *Proxy) checkResponse(resp *http.Response) (*Media, error) {
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: upstream answered %d", ErrUpstream, resp.StatusCode)
+	}
+	contentType := resp.Header.Get("Content-Type")
+	if !strings.HasPrefix(strings.ToLower(contentType), "image/") {
+		return nil, fmt.Errorf("%w: content type %q", ErrNotImage, contentType)
+	}
+
+	media := &Media{
+		Body:          resp.Body,
+		ContentType:   contentType,
+		ContentLength: resp.ContentLength,
+		ETag:          resp.Header.Get("ETag"),
+		LastModified:  resp.Header.Get("Last-Modified"),
+	}
+	if p.maxBytes <= 0 {
+		return media, nil
+	}
+	if resp.ContentLength > p.maxBytes {
+		return nil, fmt.Errorf("%w: %d bytes, at most %d allowed", ErrTooLarge, resp.ContentLength, p.maxBytes)
+	}
+	if resp.ContentLength < 0 {
+		data, err := io.ReadAll(io.LimitReader(resp.Body, p.maxBytes+1))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrUpstream, err)
+		}
+		if int64(len(data)) > p.maxBytes {
+			return nil, fmt.Errorf("%w: more than %d bytes", ErrTooLarge, p.maxBytes)
+		}
+		resp.Body.Close()
+		media.Body = io.NopCloser(bytes.NewReader(data))
+		media.ContentLength = int64(len(data))
+	}
+	return media, nil
+}
@@ -0,0 +1,146 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// pngBytes is a stand-in image body; the proxy never decodes it
+var pngBytes = []byte("\x89PNG\r\n\x1a\nimage-bytes")
+
+// newImageServer serves pngBytes at /logo.png, a body of unknown length at
+// /chunked.png, a redirect to another host at /elsewhere, and an HTML page
+// at /page.html
+func newImageServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/logo.png", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Header().Set("ETag", `"logo-v1"`)
+		w.Write(pngBytes)
+	})
+	mux.HandleFunc("/chunked.png", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		for i := 0; i < 4; i++ {
+			w.Write(pngBytes)
+			w.(http.Flusher).Flush()
+		}
+	})
+	mux.HandleFunc("/elsewhere", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://internal.example/secret.png", http.StatusFound)
+	})
+	mux.HandleFunc("/page.html", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte("<html></html>"))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestProxy(maxBytes int64) *Proxy {
+	return NewProxy(Config{
+		AllowedHosts: []string{"127.0.0.1", "*.cdn.example"},
+		Timeout:      5 * time.Second,
+		MaxBytes:     maxBytes,
+		CacheMaxAge:  time.Hour,
+	}, zap.NewNop())
+}
+
+func TestFetchAllowedImage(t *testing.T) {
+	server := newImageServer(t)
+	proxy := newTestProxy(1024)
+
+	m, err := proxy.Fetch(context.Background(), server.URL+"/logo.png")
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	defer m.Body.Close()
+
+	body, err := io.ReadAll(m.Body)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+	if string(body) != string(pngBytes) {
+		t.Errorf("body = %q, want the image", body)
+	}
+	if m.ContentType != "image/png" || m.ETag != `"logo-v1"` || m.ContentLength != int64(len(pngBytes)) {
+		t.Errorf("media = %+v, want image/png, the ETag and the length", m)
+	}
+}
+
+func TestFetchRejectsDisallowedHosts(t *testing.T) {
+	server := newImageServer(t)
+	proxy := newTestProxy(1024)
+
+	tests := []struct {
+		name    string
+		url     string
+		wantErr error
+	}{
+		{name: "host not allowed", url: "http://evil.example/logo.png", wantErr: ErrHostNotAllowed},
+		{name: "wildcard does not match the bare domain", url: "https://cdn.example/logo.png", wantErr: ErrHostNotAllowed},
+		{name: "redirect to a host not allowed", url: server.URL + "/elsewhere", wantErr: ErrHostNotAllowed},
+		{name: "not http", url: "file:///etc/passwd", wantErr: ErrInvalidURL},
+		{name: "relative", url: "/logo.png", wantErr: ErrInvalidURL},
+		{name: "not an image", url: server.URL + "/page.html", wantErr: ErrNotImage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := proxy.Fetch(context.Background(), tt.url)
+			if !errors.Is(err, tt.wantErr) {
+				if m != nil {
+					m.Body.Close()
+				}
+				t.Fatalf("Fetch(%q) error = %v, want %v", tt.url, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckURLWildcardMatchesSubdomains(t *testing.T) {
+	proxy := newTestProxy(1024)
+
+	if _, err := proxy.CheckURL("https://Images.CDN.example/a.png"); err != nil {
+		t.Fatalf("CheckURL of a subdomain: %v", err)
+	}
+}
+
+func TestFetchRejectsOversizedImages(t *testing.T) {
+	server := newImageServer(t)
+	limit := int64(len(pngBytes) - 1)
+
+	for _, path := range []string{"/logo.png", "/chunked.png"} {
+		t.Run(strings.TrimPrefix(path, "/"), func(t *testing.T) {
+			m, err := newTestProxy(limit).Fetch(context.Background(), server.URL+path)
+			if !errors.Is(err, ErrTooLarge) {
+				if m != nil {
+					m.Body.Close()
+				}
+				t.Fatalf("Fetch error = %v, want %v", err, ErrTooLarge)
+			}
+		})
+	}
+}
+
+func TestFetchBuffersImagesOfUnknownSizeUnderTheCap(t *testing.T) {
+	server := newImageServer(t)
+
+	m, err := newTestProxy(1024).Fetch(context.Background(), server.URL+"/chunked.png")
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	defer m.Body.Close()
+
+	if want := int64(4 * len(pngBytes)); m.ContentLength != want {
+		t.Errorf("content length = %d, want %d", m.ContentLength, want)
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/identity"
 )
 
 // Claims represents the JWT claims
@@ -16,9 +18,17 @@ type Claims struct {
 	Name      string `json:"name"`
 	Email     string `json:"email"`
 	Role      string `json:"role"`
+	// SupplierID is set on tokens of SUPPLIER users to the supplier they act for
+	SupplierID string `json:"supplier_id,omitempty"`
+	// TokenType is "refresh" on refresh tokens, which only the refresh
+	// endpoint accepts
+	TokenType string `json:"typ,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// refreshTokenType marks refresh tokens, which must not authenticate requests
+const refreshTokenType = "refresh"
+
 // authMiddleware creates a middleware for JWT authentication
 func (s *Server) authMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -38,17 +48,7 @@ func (s *Server) authMiddleware() gin.HandlerFunc {
 			return
 		}
 
-		tokenString := parts[1]
-
-		// Parse and validate the token
-		token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-			// Validate the signing algorithm
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, errors.New("unexpected signing method")
-			}
-			return []byte(s.jwtSecret), nil
-		})
-
+		claims, err := s.parseToken(parts[1])
 		if err != nil {
 			s.logger.Debug("JWT validation failed", zap.Error(err))
 			respondWithError(c, http.StatusUnauthorized, "Invalid or expired token")
@@ -56,58 +56,63 @@ func (s *Server) authMiddleware() gin.HandlerFunc {
 			return
 		}
 
-		// Extract the claims
-		if claims, ok := token.Claims.(*Claims); ok && token.Valid {
-			// Store the claims in the context for later use
-			c.Set("userID", claims.UserID)
-			c.Set("name", claims.Name)
-			c.Set("email", claims.Email)
-			c.Set("role", claims.Role)
-			c.Next()
-		} else {
-			respondWithError(c, http.StatusUnauthorized, "Invalid token claims")
-			c.Abort()
-			return
-		}
+		setClaims(c, claims)
+		c.Next()
 	}
 }
 
-// adminMiddleware checks if the user has the admin role
-func (s *Server) adminMiddleware() gin.HandlerFunc {
+// optionalAuthMiddleware authenticates the caller when a valid bearer token is
+// present and otherwise lets the request through anonymously. It is used on
+// public routes whose responses depend on the caller's role.
+func (s *Server) optionalAuthMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		role, exists := c.Get("role")
-		if !exists {
-			respondWithError(c, http.StatusUnauthorized, "User role not found")
-			c.Abort()
-			return
-		}
-
-		if role != "ADMIN" {
-			respondWithError(c, http.StatusForbidden, "Admin access required")
-			c.Abort()
-			return
+		parts := strings.Split(c.GetHeader("Authorization"), " ")
+		if len(parts) == 2 && parts[0] == "Bearer" {
+			if claims, err := s.parseToken(parts[1]); err == nil {
+				setClaims(c, claims)
+			} else {
+				s.logger.Debug("Ignoring invalid token on public route", zap.Error(err))
+			}
 		}
-
 		c.Next()
 	}
 }
 
-// staffMiddleware checks if the user has the staff or admin role
-func (s *Server) staffMiddleware() gin.HandlerFunc {
-	return func(c *gin.Context) {
-		role, exists := c.Get("role")
-		if !exists {
-			respondWithError(c, http.StatusUnauthorized, "User role not found")
-			c.Abort()
-			return
-		}
-
-		if role != "ADMIN" && role != "STAFF" {
-			respondWithError(c, http.StatusForbidden, "Staff access required")
-			c.Abort()
-			return
+// parseToken parses and validates a JWT and returns its claims
+func (s *Server) parseToken(tokenString string) (*Claims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
+		// Validate the signing algorithm
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
 		}
+		return []byte(s.jwtSecret), nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		c.Next()
+	claims, ok := token.Claims.(*Claims)
+	if !ok || !token.Valid {
+		return nil, errors.New("invalid token claims")
+	}
+	if claims.TokenType == refreshTokenType {
+		return nil, errors.New("refresh tokens cannot authenticate requests")
 	}
+	return claims, nil
+}
+
+// setClaims stores the claims in the gin context for the handlers and forwards
+// the caller to the backend services as outgoing gRPC metadata
+func setClaims(c *gin.Context, claims *Claims) {
+	c.Set("userID", claims.UserID)
+	c.Set("name", claims.Name)
+	c.Set("email", claims.Email)
+	c.Set("role", claims.Role)
+
+	ctx := identity.AppendToOutgoingContext(c.Request.Context(), identity.Caller{
+		UserID:     claims.UserID,
+		Role:       claims.Role,
+		SupplierID: claims.SupplierID,
+	})
+	c.Request = c.Request.WithContext(ctx)
 }
@@ -0,0 +1,109 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/services"
+)
+
+// refreshingUserService looks users up by ID and refreshes tokens with the
+// answer in refreshErr, recording the refresh token it was given
+type refreshingUserService struct {
+	services.UserService
+	refreshErr   error
+	refreshedFor string
+}
+
+func (f *refreshingUserService) GetUserByID(ctx context.Context, userID string) (interface{}, error) {
+	return map[string]string{"id": userID}, nil
+}
+
+func (f *refreshingUserService) RefreshToken(ctx context.Context, refreshToken string) (interface{}, error) {
+	f.refreshedFor = refreshToken
+	if f.refreshErr != nil {
+		return nil, f.refreshErr
+	}
+	return &models.AuthenticateUserResponse{Token: "new-access-token", RefreshToken: refreshToken}, nil
+}
+
+// testRefreshToken returns a refresh token as the user service issues it
+func testRefreshToken(t *testing.T, userID string) string {
+	t.Helper()
+	claims := &Claims{
+		UserID:    userID,
+		TokenType: refreshTokenType,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testJWTSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestRefreshTokenCannotAuthenticate(t *testing.T) {
+	s := newTestServer(t, testBackends{users: &refreshingUserService{}})
+
+	if rec := serve(s, http.MethodGet, "/api/v1/users/me", testToken(t, "user-1", "CUSTOMER")); rec.Code != http.StatusOK {
+		t.Fatalf("access token: status = %d, want 200: %s", rec.Code, rec.Body.String())
+	}
+	if rec := serve(s, http.MethodGet, "/api/v1/users/me", testRefreshToken(t, "user-1")); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("refresh token: status = %d, want 401: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestRefreshEndpoint(t *testing.T) {
+	tests := []struct {
+		name       string
+		refreshErr error
+		wantStatus int
+	}{
+		{name: "refreshed", wantStatus: http.StatusOK},
+		{name: "expired or revoked", refreshErr: status.Error(codes.Unauthenticated, "token expired"), wantStatus: http.StatusUnauthorized},
+		{name: "deactivated account", refreshErr: status.Error(codes.PermissionDenied, "user not active"), wantStatus: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := &refreshingUserService{refreshErr: tt.refreshErr}
+			s := newTestServer(t, testBackends{users: users})
+			refreshToken := testRefreshToken(t, "user-1")
+
+			rec := serveJSON(s, http.MethodPost, "/api/v1/auth/refresh", "", `{"refresh_token":"`+refreshToken+`"}`)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if users.refreshedFor != refreshToken {
+				t.Fatalf("refreshed %q, want the refresh token from the body", users.refreshedFor)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var got models.AuthenticateUserResponse
+			decodeData(t, rec, &got)
+			if got.Token != "new-access-token" || got.RefreshToken != refreshToken {
+				t.Fatalf("response = %+v, want the new access token and the same refresh token", got)
+			}
+		})
+	}
+}
+
+func TestRefreshEndpointRequiresRefreshToken(t *testing.T) {
+	s := newTestServer(t, testBackends{users: &refreshingUserService{}})
+
+	rec := serveJSON(s, http.MethodPost, "/api/v1/auth/refresh", "", `{"token":"old-style"}`)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want 400: %s", rec.Code, rec.Body.String())
+	}
+}
@@ -0,0 +1,15 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// getDashboardSummary returns today's orders and revenue, the low-stock count and
+// the number of active users. Backends that fail or time out are left out and the
+// summary is flagged as partial instead of failing the request.
+func (s *Server) getDashboardSummary(c *gin.Context) {
+	summary := s.dashboard.Summary(c.Request.Context())
+	respondWithSuccess(c, http.StatusOK, summary)
+}
@@ -0,0 +1,21 @@
+package rest
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/featureflags"
+)
+
+// featureFlagsMiddleware forwards the request's X-Feature-Flags header to the
+// backend services as gRPC metadata, so experiments run on a request are
+// applied by every service it reaches. Requests without the header get the
+// control behaviour everywhere.
+func featureFlagsMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		flags := featureflags.Parse(c.GetHeader(featureflags.Header))
+		if len(flags) > 0 {
+			c.Request = c.Request.WithContext(featureflags.AppendToOutgoingContext(c.Request.Context(), flags))
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,51 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/featureflags"
+)
+
+// forwardedFlags runs a request with header through featureFlagsMiddleware and
+// returns the flags a downstream service would read from the outgoing metadata
+func forwardedFlags(t *testing.T, header string) featureflags.Flags {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(featureFlagsMiddleware())
+
+	var forwarded featureflags.Flags
+	router.GET("/probe", func(c *gin.Context) {
+		// What a backend receives as incoming metadata is what the gateway
+		// put in its outgoing metadata
+		md, _ := metadata.FromOutgoingContext(c.Request.Context())
+		forwarded = featureflags.FromIncomingContext(metadata.NewIncomingContext(c.Request.Context(), md))
+		c.Status(http.StatusNoContent)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/probe", nil)
+	if header != "" {
+		req.Header.Set(featureflags.Header, header)
+	}
+	router.ServeHTTP(httptest.NewRecorder(), req)
+	return forwarded
+}
+
+func TestFeatureFlagsAreForwardedAsMetadata(t *testing.T) {
+	flags := forwardedFlags(t, "product-ranking=newest")
+	if got := flags.Variant("product-ranking"); got != "newest" {
+		t.Fatalf("product-ranking = %q, want newest", got)
+	}
+}
+
+func TestMissingFeatureFlagsMeanControl(t *testing.T) {
+	flags := forwardedFlags(t, "")
+	if got := flags.Variant("product-ranking"); got != featureflags.Control {
+		t.Fatalf("product-ranking = %q, want %q", got, featureflags.Control)
+	}
+}
@@ -0,0 +1,44 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/services"
+)
+
+// idCheckingInventoryService rejects item IDs the way the inventory service
+// does: malformed ones as invalid, well-formed unknown ones as not found
+type idCheckingInventoryService struct {
+	services.InventoryService
+}
+
+func (f *idCheckingInventoryService) GetInventoryItemByID(ctx context.Context, id string) (interface{}, error) {
+	if id == "not-an-id" {
+		return nil, status.Errorf(codes.InvalidArgument, "%q is not a valid inventory item ID", id)
+	}
+	return nil, status.Error(codes.NotFound, "inventory item not found")
+}
+
+func TestBackendIDErrorsMapToClientErrors(t *testing.T) {
+	s := newTestServer(t, testBackends{inventory: &idCheckingInventoryService{}})
+	token := testToken(t, "staff-1", "STAFF")
+
+	tests := []struct {
+		path string
+		want int
+	}{
+		{path: "/api/v1/inventory/not-an-id", want: http.StatusBadRequest},
+		{path: "/api/v1/inventory/6f1c2a8e-1d3b-4c5e-9f70-2a4b6c8d0e1f", want: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		rec := serve(s, http.MethodGet, tt.path, token)
+		if rec.Code != tt.want {
+			t.Errorf("GET %s: status = %d, want %d: %s", tt.path, rec.Code, tt.want, rec.Body.String())
+		}
+	}
+}
@@ -2,9 +2,15 @@ package rest
 
 import (
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/dates"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
 )
 
 // InventoryRequest represents the inventory request body
@@ -35,10 +41,46 @@ type ReservationRequest struct {
 	StoreID   string `json:"storeId,omitempty"` // For POS reservations
 }
 
-// listInventory returns a list of inventory items (supports POS availability checking)
+// InventoryTagsRequest represents a bulk tag change for inventory items at a location
+type InventoryTagsRequest struct {
+	LocationID string   `json:"locationId" binding:"required"`
+	ItemIDs    []string `json:"itemIds"` // Optional; all items at the location when empty
+	Add        []string `json:"add"`
+	Remove     []string `json:"remove"`
+}
+
+// Stock statuses accepted by the inventory listing's status filter
+var inventoryStockStatuses = map[string]bool{
+	"":             true,
+	"all":          true,
+	"in_stock":     true,
+	"low_stock":    true,
+	"out_of_stock": true,
+}
+
+// listInventory returns a page of inventory items, optionally filtered by
+// location, stock status, tags and last update (supports POS availability
+// checking). With updated_since only items changed after it are listed,
+// oldest change first, so downstream systems can sync incrementally.
 func (s *Server) listInventory(c *gin.Context) {
 	location := c.Query("location")
-	lowStock := c.Query("lowStock") == "true"
+	status := c.Query("status") // in_stock, low_stock, out_of_stock or all
+	if c.Query("lowStock") == "true" {
+		status = "low_stock"
+	}
+	if !inventoryStockStatuses[status] {
+		respondWithError(c, http.StatusBadRequest, "Invalid status parameter")
+		return
+	}
+	tags := parseTagsParam(c.Query("tags")) // Comma-separated; items must carry all of them
+	var updatedSince time.Time
+	if value := c.Query("updated_since"); value != "" {
+		var err error
+		if updatedSince, err = dates.Parse(value); err != nil {
+			respondWithError(c, http.StatusBadRequest, "Invalid updated_since parameter: "+err.Error())
+			return
+		}
+	}
 	limitStr := c.DefaultQuery("limit", "10")
 	offsetStr := c.DefaultQuery("offset", "0")
 	
@@ -108,13 +150,21 @@ func (s *Server) listInventory(c *gin.Context) {
 		}
 	}
 
-	items, err := s.inventorySvc.ListInventory(c.Request.Context(), location, lowStock, limit, offset)
+	list, err := s.inventorySvc.ListInventory(c.Request.Context(), location, status, tags, updatedSince, limit, offset)
 	if err != nil {
 		genericErrorHandler(c, err, s.logger, "List inventory")
 		return
 	}
 
-	respondWithSuccess(c, http.StatusOK, items)
+	respondWithInventoryPage(c, list, limit, offset)
+}
+
+// respondWithInventoryPage responds with a page of inventory items and its pagination metadata
+func respondWithInventoryPage(c *gin.Context, list *models.ListInventoryResponse, limit, offset int) {
+	respondWithSuccess(c, http.StatusOK, gin.H{
+		"items":      list.Items,
+		"pagination": newPagination(limit, offset, len(list.Items), int64(list.TotalCount)),
+	})
 }
 
 // getInventoryItem returns an inventory item by ID
@@ -348,6 +398,27 @@ func (s *Server) getInventoryReservations(c *gin.Context) {
 	respondWithSuccess(c, http.StatusOK, reservations)
 }
 
+// reconcileReservations releases reservations whose orders were cancelled,
+// completed or deleted (admin only). With ?dryRun=true it only reports them.
+func (s *Server) reconcileReservations(c *gin.Context) {
+	dryRun := false
+	if v := c.Query("dryRun"); v != "" {
+		var err error
+		if dryRun, err = strconv.ParseBool(v); err != nil {
+			respondWithError(c, http.StatusBadRequest, "dryRun must be true or false")
+			return
+		}
+	}
+
+	report, err := s.inventorySvc.ReconcileReservations(c.Request.Context(), dryRun)
+	if err != nil {
+		genericErrorHandler(c, err, s.logger, "Reconcile reservations")
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, report)
+}
+
 // createInventoryReservation creates a new inventory reservation (supports POS source tracking)
 func (s *Server) createInventoryReservation(c *gin.Context) {
 	var req ReservationRequest
@@ -391,37 +462,96 @@ func (s *Server) createInventoryReservation(c *gin.Context) {
 	respondWithSuccess(c, http.StatusCreated, response)
 }
 
-// getLowStockItems returns inventory items that are low in stock
+// getLowStockItems returns a page of inventory items at or below their reorder point
 func (s *Server) getLowStockItems(c *gin.Context) {
-	thresholdStr := c.DefaultQuery("threshold", "10")
 	location := c.Query("location")
 	limitStr := c.DefaultQuery("limit", "10")
 	offsetStr := c.DefaultQuery("offset", "0")
 
-	threshold, err := parseIntParam(thresholdStr, 10)
+	limit, err := parseIntParam(limitStr, 10)
+	if err != nil {
+		respondWithError(c, http.StatusBadRequest, "Invalid limit parameter")
+		return
+	}
+
+	offset, err := parseIntParam(offsetStr, 0)
 	if err != nil {
-		respondWithError(c, http.StatusBadRequest, "Invalid threshold parameter")
+		respondWithError(c, http.StatusBadRequest, "Invalid offset parameter")
 		return
 	}
 
-	limit, err := parseIntParam(limitStr, 10)
+	list, err := s.inventorySvc.GetLowStockItems(c.Request.Context(), location, limit, offset)
+	if err != nil {
+		genericErrorHandler(c, err, s.logger, "Get low stock items")
+		return
+	}
+
+	respondWithInventoryPage(c, list, limit, offset)
+}
+
+// getDueCounts returns a page of inventory items due for counting, the daily
+// count worklist for warehouse staff
+func (s *Server) getDueCounts(c *gin.Context) {
+	location := c.Query("location")
+
+	var asOf time.Time
+	if value := c.Query("as_of"); value != "" {
+		var err error
+		if asOf, err = dates.Parse(value); err != nil {
+			respondWithError(c, http.StatusBadRequest, "Invalid as_of parameter: "+err.Error())
+			return
+		}
+	}
+
+	limit, err := parseIntParam(c.DefaultQuery("limit", "10"), 10)
 	if err != nil {
 		respondWithError(c, http.StatusBadRequest, "Invalid limit parameter")
 		return
 	}
 
-	offset, err := parseIntParam(offsetStr, 0)
+	offset, err := parseIntParam(c.DefaultQuery("offset", "0"), 0)
 	if err != nil {
 		respondWithError(c, http.StatusBadRequest, "Invalid offset parameter")
 		return
 	}
 
-	// Get low stock items with threshold and location filtering
-	items, err := s.inventorySvc.GetLowStockItems(c.Request.Context(), location, threshold, limit, offset)
+	list, err := s.inventorySvc.ListDueCounts(c.Request.Context(), location, asOf, limit, offset)
 	if err != nil {
-		genericErrorHandler(c, err, s.logger, "Get low stock items")
+		genericErrorHandler(c, err, s.logger, "List due counts")
 		return
 	}
 
-	respondWithSuccess(c, http.StatusOK, items)
+	respondWithInventoryPage(c, list, limit, offset)
+}
+
+// updateInventoryTags adds and removes tags on inventory items at a location
+func (s *Server) updateInventoryTags(c *gin.Context) {
+	var req InventoryTagsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+	if len(req.Add) == 0 && len(req.Remove) == 0 {
+		respondWithError(c, http.StatusBadRequest, "At least one tag to add or remove is required")
+		return
+	}
+
+	matched, err := s.inventorySvc.UpdateInventoryTags(c.Request.Context(), req.LocationID, req.ItemIDs, req.Add, req.Remove)
+	if err != nil {
+		genericErrorHandler(c, err, s.logger, "Update inventory tags")
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, gin.H{"matched": matched})
+}
+
+// parseTagsParam splits a comma-separated tags query parameter
+func parseTagsParam(value string) []string {
+	var tags []string
+	for _, tag := range strings.Split(value, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
 }
@@ -0,0 +1,115 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/services"
+)
+
+// listInventoryCall records the arguments of a listing call
+type listInventoryCall struct {
+	location, status string
+	limit, offset    int
+}
+
+// listingInventoryService is an inventory service that only serves listings
+type listingInventoryService struct {
+	services.InventoryService
+	items    []*models.InventoryItem
+	total    int32
+	list     []listInventoryCall
+	lowStock []listInventoryCall
+}
+
+func (f *listingInventoryService) ListInventory(ctx context.Context, location, stockStatus string, tags []string, updatedSince time.Time, limit, offset int) (*models.ListInventoryResponse, error) {
+	f.list = append(f.list, listInventoryCall{location, stockStatus, limit, offset})
+	return &models.ListInventoryResponse{Items: f.items, TotalCount: f.total}, nil
+}
+
+func (f *listingInventoryService) GetLowStockItems(ctx context.Context, location string, limit, offset int) (*models.ListInventoryResponse, error) {
+	f.lowStock = append(f.lowStock, listInventoryCall{location: location, limit: limit, offset: offset})
+	return &models.ListInventoryResponse{Items: f.items, TotalCount: f.total}, nil
+}
+
+type inventoryPage struct {
+	Items      []*models.InventoryItem `json:"items"`
+	Pagination Pagination              `json:"pagination"`
+}
+
+func TestListInventoryByLocationReturnsPagination(t *testing.T) {
+	inventory := &listingInventoryService{
+		items: []*models.InventoryItem{{ID: "item-3"}, {ID: "item-4"}},
+		total: 5,
+	}
+	s := newTestServer(t, testBackends{inventory: inventory})
+
+	rec := serve(s, http.MethodGet, "/api/v1/inventory?location=store-1&status=in_stock&limit=2&offset=2", testToken(t, "staff-1", "STAFF"))
+
+	var page inventoryPage
+	decodeData(t, rec, &page)
+	if len(page.Items) != 2 {
+		t.Fatalf("items = %d, want 2", len(page.Items))
+	}
+	want := Pagination{Limit: 2, Offset: 2, Total: 5, HasMore: true}
+	if page.Pagination != want {
+		t.Fatalf("pagination = %+v, want %+v", page.Pagination, want)
+	}
+	if len(inventory.list) != 1 || inventory.list[0] != (listInventoryCall{"store-1", "in_stock", 2, 2}) {
+		t.Fatalf("ListInventory calls = %+v", inventory.list)
+	}
+}
+
+func TestListInventoryRejectsUnknownStatus(t *testing.T) {
+	inventory := &listingInventoryService{}
+	s := newTestServer(t, testBackends{inventory: inventory})
+
+	rec := serve(s, http.MethodGet, "/api/v1/inventory?status=plenty", testToken(t, "staff-1", "STAFF"))
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want 400", rec.Code)
+	}
+	if len(inventory.list) != 0 {
+		t.Fatal("the inventory service should not be called")
+	}
+}
+
+func TestGetLowStockItemsLastPage(t *testing.T) {
+	inventory := &listingInventoryService{
+		items: []*models.InventoryItem{{ID: "item-5"}},
+		total: 5,
+	}
+	s := newTestServer(t, testBackends{inventory: inventory})
+
+	rec := serve(s, http.MethodGet, "/api/v1/inventory/low-stock?location=store-2&limit=4&offset=4", testToken(t, "admin-1", "ADMIN"))
+
+	var page inventoryPage
+	decodeData(t, rec, &page)
+	want := Pagination{Limit: 4, Offset: 4, Total: 5, HasMore: false}
+	if page.Pagination != want {
+		t.Fatalf("pagination = %+v, want %+v", page.Pagination, want)
+	}
+	if len(inventory.lowStock) != 1 || inventory.lowStock[0] != (listInventoryCall{location: "store-2", limit: 4, offset: 4}) {
+		t.Fatalf("GetLowStockItems calls = %+v", inventory.lowStock)
+	}
+}
+
+func TestInventoryListingsRequireStaff(t *testing.T) {
+	inventory := &listingInventoryService{}
+	s := newTestServer(t, testBackends{inventory: inventory})
+
+	for _, path := range []string{"/api/v1/inventory?location=store-1", "/api/v1/inventory/low-stock"} {
+		if rec := serve(s, http.MethodGet, path, ""); rec.Code != http.StatusUnauthorized {
+			t.Errorf("%s without token: status = %d, want 401", path, rec.Code)
+		}
+		if rec := serve(s, http.MethodGet, path, testToken(t, "customer-1", "CUSTOMER")); rec.Code != http.StatusForbidden {
+			t.Errorf("%s as customer: status = %d, want 403", path, rec.Code)
+		}
+	}
+	if len(inventory.list)+len(inventory.lowStock) != 0 {
+		t.Fatal("the inventory service should not be called")
+	}
+}
@@ -0,0 +1,66 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/jobs"
+)
+
+// jobTypeProductReindex identifies product search reindex jobs
+const jobTypeProductReindex = "product_reindex"
+
+// reindexProducts starts a background job that rebuilds the product search index (admin only)
+func (s *Server) reindexProducts(c *gin.Context) {
+	job := s.jobs.Start(jobTypeProductReindex, s.runProductReindex)
+
+	s.logger.Info("Product reindex job started", zap.String("job_id", job.ID))
+	respondWithSuccess(c, http.StatusAccepted, job)
+}
+
+// runProductReindex is the body of a product reindex job
+func (s *Server) runProductReindex(ctx context.Context, progress *jobs.Progress) error {
+	// Look up the catalogue size first so callers polling the job can see
+	// how much work is expected before the rebuild finishes
+	resp, err := s.productSvc.ListProducts(ctx, "", "", false, 1, 0, "", true)
+	if err != nil {
+		return err
+	}
+	if list, ok := resp.(*models.ListProductsResponse); ok {
+		progress.SetTotal(int64(list.TotalCount))
+	}
+
+	count, err := s.productSvc.RebuildSearchIndex(ctx)
+	if err != nil {
+		return err
+	}
+	progress.SetTotal(count)
+	progress.SetProcessed(count)
+	return nil
+}
+
+// listJobs returns all background jobs known to the gateway (admin only)
+func (s *Server) listJobs(c *gin.Context) {
+	respondWithSuccess(c, http.StatusOK, s.jobs.List())
+}
+
+// getJob returns the state of a single background job (admin only)
+func (s *Server) getJob(c *gin.Context) {
+	jobID := c.Param("id")
+	if jobID == "" {
+		respondWithError(c, http.StatusBadRequest, "Job ID is required")
+		return
+	}
+
+	job, ok := s.jobs.Get(jobID)
+	if !ok {
+		respondWithError(c, http.StatusNotFound, "Job not found")
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, job)
+}
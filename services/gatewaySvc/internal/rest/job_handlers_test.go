@@ -0,0 +1,94 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/jobs"
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/services"
+)
+
+// reindexProductService is a product service that only supports what the
+// reindex job calls; anything else panics on the nil embedded interface
+type reindexProductService struct {
+	services.ProductService
+	total      int32
+	rebuilt    int64
+	rebuildErr error
+}
+
+func (f *reindexProductService) ListProducts(ctx context.Context, categoryID, query string, active bool, limit, offset int, sortBy string, ascending bool) (interface{}, error) {
+	return &models.ListProductsResponse{TotalCount: f.total}, nil
+}
+
+func (f *reindexProductService) RebuildSearchIndex(ctx context.Context) (int64, error) {
+	return f.rebuilt, f.rebuildErr
+}
+
+// waitForJob polls the manager until the job is no longer pending or running
+func waitForJob(t *testing.T, m *jobs.Manager, id string) jobs.Job {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		job, ok := m.Get(id)
+		if !ok {
+			t.Fatalf("job %s not found", id)
+		}
+		if job.Status == jobs.StatusCompleted || job.Status == jobs.StatusFailed {
+			return job
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("job %s did not finish", id)
+	return jobs.Job{}
+}
+
+func TestProductReindexJobReportsProductsProcessed(t *testing.T) {
+	products := &reindexProductService{total: 42, rebuilt: 40}
+	s := &Server{
+		productSvc: products,
+		jobs:       jobs.NewManager(time.Minute, zap.NewNop()),
+		logger:     zap.NewNop(),
+	}
+
+	started := s.jobs.Start(jobTypeProductReindex, s.runProductReindex)
+	if started.Status != jobs.StatusPending {
+		t.Fatalf("started status = %s, want %s", started.Status, jobs.StatusPending)
+	}
+
+	job := waitForJob(t, s.jobs, started.ID)
+	if job.Status != jobs.StatusCompleted {
+		t.Fatalf("status = %s (%s), want %s", job.Status, job.Error, jobs.StatusCompleted)
+	}
+	if job.Processed != 40 || job.Total != 40 {
+		t.Fatalf("processed/total = %d/%d, want 40/40", job.Processed, job.Total)
+	}
+	if job.StartedAt == nil || job.CompletedAt == nil {
+		t.Fatal("started_at and completed_at should be set")
+	}
+}
+
+func TestProductReindexJobRecordsFailure(t *testing.T) {
+	products := &reindexProductService{total: 3, rebuildErr: errors.New("index build failed")}
+	s := &Server{
+		productSvc: products,
+		jobs:       jobs.NewManager(time.Minute, zap.NewNop()),
+		logger:     zap.NewNop(),
+	}
+
+	job := waitForJob(t, s.jobs, s.jobs.Start(jobTypeProductReindex, s.runProductReindex).ID)
+	if job.Status != jobs.StatusFailed {
+		t.Fatalf("status = %s, want %s", job.Status, jobs.StatusFailed)
+	}
+	if job.Error != "index build failed" {
+		t.Fatalf("error = %q", job.Error)
+	}
+	if job.Total != 3 || job.Processed != 0 {
+		t.Fatalf("processed/total = %d/%d, want 0/3", job.Processed, job.Total)
+	}
+}
@@ -0,0 +1,68 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/media"
+)
+
+// proxyMedia streams an image from an allow-listed host, so the front-end
+// can load supplier images from the gateway's domain
+func (s *Server) proxyMedia(c *gin.Context) {
+	raw := c.Query("url")
+	if raw == "" {
+		respondWithError(c, http.StatusBadRequest, "url is required")
+		return
+	}
+
+	m, err := s.media.Fetch(c.Request.Context(), raw)
+	if err != nil {
+		s.logger.Warn("Failed to proxy media",
+			zap.String("url", raw),
+			zap.Error(err),
+		)
+		switch {
+		case errors.Is(err, media.ErrInvalidURL):
+			respondWithError(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, media.ErrHostNotAllowed):
+			respondWithError(c, http.StatusForbidden, err.Error())
+		case errors.Is(err, media.ErrTooLarge), errors.Is(err, media.ErrNotImage):
+			// The upstream's answer is what is wrong, not the request
+			respondWithError(c, http.StatusBadGateway, err.Error())
+		default:
+			respondWithError(c, http.StatusBadGateway, "failed to fetch media")
+		}
+		return
+	}
+	defer m.Body.Close()
+
+	header := c.Writer.Header()
+	header.Set("Content-Type", m.ContentType)
+	header.Set("X-Content-Type-Options", "nosniff")
+	header.Set("Content-Security-Policy", "default-src 'none'; sandbox")
+	header.Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(s.media.CacheMaxAge().Seconds())))
+	if m.ContentLength >= 0 {
+		header.Set("Content-Length", strconv.FormatInt(m.ContentLength, 10))
+	}
+	if m.ETag != "" {
+		header.Set("ETag", m.ETag)
+	}
+	if m.LastModified != "" {
+		header.Set("Last-Modified", m.LastModified)
+	}
+	c.Status(http.StatusOK)
+
+	if _, err := io.Copy(c.Writer, m.Body); err != nil {
+		s.logger.Warn("Failed to stream proxied media",
+			zap.String("url", raw),
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,84 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/media"
+)
+
+// newMediaRouter routes /media to a server whose proxy allows 127.0.0.1
+// and caps media at maxBytes
+func newMediaRouter(maxBytes int64) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	s := &Server{
+		media: media.NewProxy(media.Config{
+			AllowedHosts: []string{"127.0.0.1"},
+			Timeout:      5 * time.Second,
+			MaxBytes:     maxBytes,
+			CacheMaxAge:  10 * time.Minute,
+		}, zap.NewNop()),
+		logger: zap.NewNop(),
+	}
+	router := gin.New()
+	router.GET("/media", s.proxyMedia)
+	return router
+}
+
+func TestProxyMedia(t *testing.T) {
+	image := []byte("\x89PNG\r\n\x1a\nimage-bytes")
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(image)
+	}))
+	defer upstream.Close()
+
+	tests := []struct {
+		name     string
+		mediaURL string
+		maxBytes int64
+		wantCode int
+	}{
+		{name: "allowed host", mediaURL: upstream.URL + "/logo.png", maxBytes: 1024, wantCode: http.StatusOK},
+		{name: "disallowed host", mediaURL: "http://evil.example/logo.png", maxBytes: 1024, wantCode: http.StatusForbidden},
+		{name: "oversized response", mediaURL: upstream.URL + "/logo.png", maxBytes: 4, wantCode: http.StatusBadGateway},
+		{name: "invalid URL", mediaURL: "ftp://127.0.0.1/logo.png", maxBytes: 1024, wantCode: http.StatusBadRequest},
+		{name: "missing URL", maxBytes: 1024, wantCode: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := "/media"
+			if tt.mediaURL != "" {
+				target += "?url=" + url.QueryEscape(tt.mediaURL)
+			}
+			rec := httptest.NewRecorder()
+			newMediaRouter(tt.maxBytes).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+
+			if rec.Code != tt.wantCode {
+				t.Fatalf("status = %d (%s), want %d", rec.Code, rec.Body.String(), tt.wantCode)
+			}
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+			if rec.Body.String() != string(image) {
+				t.Errorf("body = %q, want the image", rec.Body.String())
+			}
+			header := rec.Header()
+			if got := header.Get("Cache-Control"); got != "public, max-age=600" {
+				t.Errorf("Cache-Control = %q, want public, max-age=600", got)
+			}
+			if got := header.Get("X-Content-Type-Options"); got != "nosniff" {
+				t.Errorf("X-Content-Type-Options = %q, want nosniff", got)
+			}
+			if got := header.Get("Content-Type"); got != "image/png" {
+				t.Errorf("Content-Type = %q, want image/png", got)
+			}
+		})
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
 )
 
 // OrderItemRequest represents an order item in the order request
@@ -34,6 +37,19 @@ type OrderStatusRequest struct {
 	Description string `json:"description"`
 }
 
+// BulkOrderStatusRequest represents moving many orders to one status
+type BulkOrderStatusRequest struct {
+	OrderIDs []string `json:"order_ids" binding:"required,min=1,dive,required"`
+	Status   string   `json:"status" binding:"required"`
+}
+
+// OrderNoteRequest represents a note added to an order; ProductID attaches it
+// to one of the order's items
+type OrderNoteRequest struct {
+	Text      string `json:"text" binding:"required"`
+	ProductID string `json:"product_id"`
+}
+
 // OrderPaymentRequest represents adding a payment to an order
 type OrderPaymentRequest struct {
 	Amount      float64           `json:"amount" binding:"required,gt=0"`
@@ -122,38 +138,69 @@ func (s *Server) getUserOrder(c *gin.Context) {
 	respondWithSuccess(c, http.StatusOK, order)
 }
 
-// createOrder creates a new order for the current user
+// createOrder creates a new online order for the current user. POS orders are
+// placed through createPOSOrder, which only staff may reach.
 func (s *Server) createOrder(c *gin.Context) {
-	userID, _ := c.Get("userID")
-	userIDStr, ok := userID.(string)
-	if !ok {
-		respondWithError(c, http.StatusUnauthorized, "Invalid user ID")
+	var req OrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
 		return
 	}
 
+	if isPOSSource(req.Source) {
+		respondWithError(c, http.StatusBadRequest, "POS orders are placed through /pos/orders")
+		return
+	}
+	// Online orders require addressId and shippingType
+	if req.AddressID == "" {
+		respondWithError(c, http.StatusBadRequest, "Address ID is required for online orders")
+		return
+	}
+	if req.ShippingType == "" {
+		respondWithError(c, http.StatusBadRequest, "Shipping type is required for online orders")
+		return
+	}
+
+	s.placeOrder(c, req)
+}
+
+// createPOSOrder creates an order rung up at a store till (admin/staff only).
+// The source defaults to POS.
+func (s *Server) createPOSOrder(c *gin.Context) {
 	var req OrderRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		respondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
 		return
 	}
 
-	// Validate request based on source type
-	if req.Source == "POS" || req.Source == "QUICK_POS" {
-		// POS orders require storeId
-		if req.StoreID == "" {
-			respondWithError(c, http.StatusBadRequest, "Store ID is required for POS orders")
-			return
-		}
-	} else {
-		// Online orders require addressId and shippingType
-		if req.AddressID == "" {
-			respondWithError(c, http.StatusBadRequest, "Address ID is required for online orders")
-			return
-		}
-		if req.ShippingType == "" {
-			respondWithError(c, http.StatusBadRequest, "Shipping type is required for online orders")
-			return
-		}
+	if req.Source == "" {
+		req.Source = "POS"
+	}
+	if !isPOSSource(req.Source) {
+		respondWithError(c, http.StatusBadRequest, "Source must be POS or QUICK_POS")
+		return
+	}
+	// POS orders require storeId
+	if req.StoreID == "" {
+		respondWithError(c, http.StatusBadRequest, "Store ID is required for POS orders")
+		return
+	}
+
+	s.placeOrder(c, req)
+}
+
+// isPOSSource reports whether source marks an order rung up at a till
+func isPOSSource(source string) bool {
+	return source == "POS" || source == "QUICK_POS"
+}
+
+// placeOrder creates the validated order req for the current user
+func (s *Server) placeOrder(c *gin.Context, req OrderRequest) {
+	userID, _ := c.Get("userID")
+	userIDStr, ok := userID.(string)
+	if !ok {
+		respondWithError(c, http.StatusUnauthorized, "Invalid user ID")
+		return
 	}
 
 	// Convert request items to service items
@@ -240,9 +287,51 @@ func (s *Server) getOrder(c *gin.Context) {
 		return
 	}
 
+	// Show support what is reserved for the order and where, and what has
+	// happened to it so far; the order is still returned if either can't be
+	// loaded
+	if o, ok := order.(*models.Order); ok {
+		reservations, err := s.inventorySvc.GetReservationsForOrder(c.Request.Context(), orderID)
+		if err != nil {
+			s.logger.Warn("Failed to load reservations for order",
+				zap.String("order_id", orderID),
+				zap.Error(err),
+			)
+		} else {
+			o.Reservations = reservations
+		}
+
+		timeline, err := s.orderSvc.GetOrderTimeline(c.Request.Context(), orderID)
+		if err != nil {
+			s.logger.Warn("Failed to load timeline for order",
+				zap.String("order_id", orderID),
+				zap.Error(err),
+			)
+		} else {
+			o.Timeline = timeline
+		}
+	}
+
 	respondWithSuccess(c, http.StatusOK, order)
 }
 
+// getOrderTimeline lists everything that happened to an order, oldest first (admin/staff only)
+func (s *Server) getOrderTimeline(c *gin.Context) {
+	orderID := c.Param("id")
+	if orderID == "" {
+		respondWithError(c, http.StatusBadRequest, "Order ID is required")
+		return
+	}
+
+	timeline, err := s.orderSvc.GetOrderTimeline(c.Request.Context(), orderID)
+	if err != nil {
+		genericErrorHandler(c, err, s.logger, "Get order timeline")
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, timeline)
+}
+
 // updateOrderStatus updates the status of an order (admin/staff only)
 func (s *Server) updateOrderStatus(c *gin.Context) {
 	orderID := c.Param("id")
@@ -271,6 +360,49 @@ func (s *Server) updateOrderStatus(c *gin.Context) {
 	respondWithSuccess(c, http.StatusOK, gin.H{"message": "Order status updated successfully"})
 }
 
+// bulkUpdateOrderStatus moves many orders to one status, e.g. marking a pickup
+// run as shipped (admin/staff only). Orders that cannot make the transition are
+// reported per order; the request itself still succeeds.
+func (s *Server) bulkUpdateOrderStatus(c *gin.Context) {
+	var req BulkOrderStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	results, err := s.orderSvc.BulkUpdateOrderStatus(c.Request.Context(), req.OrderIDs, req.Status)
+	if err != nil {
+		genericErrorHandler(c, err, s.logger, "Bulk update order status")
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, results)
+}
+
+// addOrderNote appends a note to an order, or to one of its items when a
+// product ID is given, keeping the notes before it (admin/staff only)
+func (s *Server) addOrderNote(c *gin.Context) {
+	orderID := c.Param("id")
+	if orderID == "" {
+		respondWithError(c, http.StatusBadRequest, "Order ID is required")
+		return
+	}
+
+	var req OrderNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	order, err := s.orderSvc.AddOrderNote(c.Request.Context(), orderID, req.Text, req.ProductID)
+	if err != nil {
+		genericErrorHandler(c, err, s.logger, "Add order note")
+		return
+	}
+
+	respondWithSuccess(c, http.StatusCreated, order)
+}
+
 // addOrderPayment adds a payment to an order (admin/staff only)
 func (s *Server) addOrderPayment(c *gin.Context) {
 	orderID := c.Param("id")
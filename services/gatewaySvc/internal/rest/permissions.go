@@ -0,0 +1,175 @@
+package rest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Permission names something a user may do through the API, as
+// "<resource>:<action>". Most routes are guarded by permissions rather than
+// roles, and GET /me/permissions reports the same set, so what front-ends show
+// and what the gateway allows come from one table.
+type Permission string
+
+const (
+	PermissionPlaceOrders     Permission = "orders:place"
+	PermissionViewOwnOrders   Permission = "orders:read:own"
+	PermissionRequestReturns  Permission = "returns:request"
+	PermissionManageProfile   Permission = "profile:manage"
+	PermissionWriteReviews    Permission = "reviews:write"
+	PermissionModerateReviews Permission = "reviews:moderate"
+	PermissionExportProducts  Permission = "products:export"
+	PermissionManageProducts  Permission = "products:manage"
+	PermissionManageInventory Permission = "inventory:manage"
+	PermissionManageOrders    Permission = "orders:manage"
+	PermissionManageReturns   Permission = "returns:manage"
+	PermissionManageSuppliers Permission = "suppliers:manage"
+	PermissionManageStores    Permission = "stores:manage"
+	PermissionUsePOS          Permission = "pos:use"
+	PermissionManageUsers     Permission = "users:manage"
+	PermissionViewDashboard   Permission = "dashboard:view"
+	PermissionManageJobs      Permission = "jobs:manage"
+	PermissionManageWebhooks  Permission = "webhooks:manage"
+)
+
+// customerPermissions are granted to every authenticated user
+var customerPermissions = []Permission{
+	PermissionPlaceOrders,
+	PermissionViewOwnOrders,
+	PermissionRequestReturns,
+	PermissionManageProfile,
+	PermissionWriteReviews,
+}
+
+// staffPermissions are granted to staff and admins on top of the customer ones
+var staffPermissions = []Permission{
+	PermissionExportProducts,
+	PermissionManageProducts,
+	PermissionManageInventory,
+	PermissionManageOrders,
+	PermissionManageReturns,
+	PermissionManageSuppliers,
+	PermissionManageStores,
+	PermissionUsePOS,
+	PermissionModerateReviews,
+}
+
+// rolePermissions lists the permissions of each role in a stable order.
+// Unknown roles get none. Suppliers may export products because the product
+// service limits their export to their own supplier's products.
+var rolePermissions = map[string][]Permission{
+	"CUSTOMER": customerPermissions,
+	"SUPPLIER": concatPermissions(customerPermissions, []Permission{PermissionExportProducts}),
+	"STAFF":    concatPermissions(customerPermissions, staffPermissions),
+	"ADMIN": concatPermissions(customerPermissions, staffPermissions, []Permission{
+		PermissionManageUsers,
+		PermissionViewDashboard,
+		PermissionManageJobs,
+		PermissionManageWebhooks,
+	}),
+}
+
+func concatPermissions(sets ...[]Permission) []Permission {
+	var all []Permission
+	for _, set := range sets {
+		all = append(all, set...)
+	}
+	return all
+}
+
+// permissionsForRole returns the permissions granted to role
+func permissionsForRole(role string) []Permission {
+	return rolePermissions[role]
+}
+
+// hasPermission reports whether role grants permission
+func hasPermission(role string, permission Permission) bool {
+	for _, p := range rolePermissions[role] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// requirePermission rejects callers whose role does not grant permission with
+// 403. Callers without a user ID or role get 401, so it must run after
+// authMiddleware. Handlers behind it need no role checks of their own.
+func (s *Server) requirePermission(permission Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, _ := c.Get("userID")
+		role, _ := c.Get("role")
+		userIDStr, _ := userID.(string)
+		roleStr, _ := role.(string)
+		if userIDStr == "" || roleStr == "" {
+			respondWithError(c, http.StatusUnauthorized, "Authentication required")
+			c.Abort()
+			return
+		}
+
+		if !hasPermission(roleStr, permission) {
+			respondWithError(c, http.StatusForbidden, "Permission "+string(permission)+" required")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RequireRoles rejects callers whose role is not one of roles, compared
+// case-insensitively, with 403. Callers without a user ID or role get 401, so
+// it must run after authMiddleware. Prefer requirePermission for single
+// routes; this suits route groups reserved for whole roles.
+func RequireRoles(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, _ := c.Get("userID")
+		role, _ := c.Get("role")
+		userIDStr, _ := userID.(string)
+		roleStr, _ := role.(string)
+		if userIDStr == "" || roleStr == "" {
+			respondWithError(c, http.StatusUnauthorized, "Authentication required")
+			c.Abort()
+			return
+		}
+
+		for _, allowed := range roles {
+			if strings.EqualFold(roleStr, allowed) {
+				c.Next()
+				return
+			}
+		}
+
+		respondWithError(c, http.StatusForbidden, "Role "+roleStr+" may not access this resource")
+		c.Abort()
+	}
+}
+
+// PermissionsResponse lists what the current user may do
+type PermissionsResponse struct {
+	UserID      string       `json:"user_id"`
+	Role        string       `json:"role"`
+	Permissions []Permission `json:"permissions"`
+}
+
+// getMyPermissions returns the permissions of the current user's role, so
+// front-ends can show only the actions the user may take
+func (s *Server) getMyPermissions(c *gin.Context) {
+	userID, _ := c.Get("userID")
+	role, _ := c.Get("role")
+	userIDStr, _ := userID.(string)
+	roleStr, _ := role.(string)
+
+	permissions := permissionsForRole(roleStr)
+	if permissions == nil {
+		permissions = []Permission{}
+	}
+
+	respondWithSuccess(c, http.StatusOK, PermissionsResponse{
+		UserID:      userIDStr,
+		Role:        roleStr,
+		Permissions: permissions,
+	})
+}
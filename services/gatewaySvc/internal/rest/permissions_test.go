@@ -0,0 +1,302 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/services"
+)
+
+func TestMyPermissionsPerRole(t *testing.T) {
+	s := newTestServer(t, testBackends{})
+
+	customer := []Permission{
+		PermissionPlaceOrders,
+		PermissionViewOwnOrders,
+		PermissionRequestReturns,
+		PermissionManageProfile,
+		PermissionWriteReviews,
+	}
+	staff := append(append([]Permission(nil), customer...),
+		PermissionExportProducts,
+		PermissionManageProducts,
+		PermissionManageInventory,
+		PermissionManageOrders,
+		PermissionManageReturns,
+		PermissionManageSuppliers,
+		PermissionManageStores,
+		PermissionUsePOS,
+		PermissionModerateReviews,
+	)
+	admin := append(append([]Permission(nil), staff...),
+		PermissionManageUsers,
+		PermissionViewDashboard,
+		PermissionManageJobs,
+		PermissionManageWebhooks,
+	)
+
+	tests := []struct {
+		role string
+		want []Permission
+	}{
+		{role: "CUSTOMER", want: customer},
+		{role: "STAFF", want: staff},
+		{role: "ADMIN", want: admin},
+		{role: "UNKNOWN", want: []Permission{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.role, func(t *testing.T) {
+			var got PermissionsResponse
+			decodeData(t, serve(s, http.MethodGet, "/api/v1/me/permissions", testToken(t, "user-1", tt.role)), &got)
+
+			if got.UserID != "user-1" || got.Role != tt.role {
+				t.Fatalf("user = %s/%s, want user-1/%s", got.UserID, got.Role, tt.role)
+			}
+			if !reflect.DeepEqual(got.Permissions, tt.want) {
+				t.Fatalf("permissions = %v, want %v", got.Permissions, tt.want)
+			}
+		})
+	}
+}
+
+func TestMyPermissionsRequiresAuthentication(t *testing.T) {
+	s := newTestServer(t, testBackends{})
+
+	if rec := serve(s, http.MethodGet, "/api/v1/me/permissions", ""); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want 401", rec.Code)
+	}
+}
+
+func TestRoutesEnforceReportedPermissions(t *testing.T) {
+	s := newTestServer(t, testBackends{})
+
+	tests := []struct {
+		role string
+		path string
+	}{
+		{role: "CUSTOMER", path: "/api/v1/inventory"},
+		{role: "CUSTOMER", path: "/api/v1/returns/return-1"},
+		{role: "STAFF", path: "/api/v1/dashboard/summary"},
+		{role: "STAFF", path: "/api/v1/admin/users"},
+		{role: "CUSTOMER", path: "/api/v1/suppliers"},
+		{role: "SUPPLIER", path: "/api/v1/suppliers"},
+		{role: "CUSTOMER", path: "/api/v1/products/export"},
+	}
+	for _, tt := range tests {
+		rec := serve(s, http.MethodGet, tt.path, testToken(t, "user-1", tt.role))
+		if rec.Code != http.StatusForbidden {
+			t.Errorf("%s GET %s: status = %d, want 403", tt.role, tt.path, rec.Code)
+		}
+	}
+}
+
+// runRequirePermission runs requirePermission on a request whose context
+// holds the given user ID and role, leaving out empty ones as authMiddleware
+// would for a caller it could not identify
+func runRequirePermission(userID, role string, permission Permission) (*httptest.ResponseRecorder, bool) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		if userID != "" {
+			c.Set("userID", userID)
+		}
+		if role != "" {
+			c.Set("role", role)
+		}
+	})
+
+	reached := false
+	router.GET("/", (&Server{}).requirePermission(permission), func(c *gin.Context) {
+		reached = true
+		c.Status(http.StatusOK)
+	})
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	return rec, reached
+}
+
+func TestRequirePermission(t *testing.T) {
+	tests := []struct {
+		name        string
+		userID      string
+		role        string
+		permission  Permission
+		wantStatus  int
+		wantReached bool
+	}{
+		{name: "allowed", userID: "user-1", role: "STAFF", permission: PermissionManageSuppliers, wantStatus: http.StatusOK, wantReached: true},
+		{name: "allowed supplier export", userID: "user-1", role: "SUPPLIER", permission: PermissionExportProducts, wantStatus: http.StatusOK, wantReached: true},
+		{name: "forbidden", userID: "user-1", role: "CUSTOMER", permission: PermissionManageSuppliers, wantStatus: http.StatusForbidden},
+		{name: "role compared exactly", userID: "user-1", role: "staff", permission: PermissionManageSuppliers, wantStatus: http.StatusForbidden},
+		{name: "unknown role", userID: "user-1", role: "GUEST", permission: PermissionPlaceOrders, wantStatus: http.StatusForbidden},
+		{name: "no role", userID: "user-1", permission: PermissionPlaceOrders, wantStatus: http.StatusUnauthorized},
+		{name: "no user ID", role: "ADMIN", permission: PermissionPlaceOrders, wantStatus: http.StatusUnauthorized},
+		{name: "unauthenticated", permission: PermissionPlaceOrders, wantStatus: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, reached := runRequirePermission(tt.userID, tt.role, tt.permission)
+
+			if reached != tt.wantReached {
+				t.Fatalf("handler reached = %v, want %v", reached, tt.wantReached)
+			}
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+		})
+	}
+}
+
+// recordingOrderService records the orders it is asked to create
+type recordingOrderService struct {
+	services.OrderService
+	created []string
+}
+
+func (f *recordingOrderService) CreateOrder(ctx context.Context, userID string, items []map[string]interface{}, addressID, paymentType string, paymentData map[string]string, shippingType, notes, source, storeID string, customerInfo map[string]string) (interface{}, error) {
+	f.created = append(f.created, source)
+	return map[string]string{"source": source}, nil
+}
+
+func TestPOSOrdersRequireStaffRole(t *testing.T) {
+	tests := []struct {
+		role       string
+		source     string
+		wantStatus int
+	}{
+		{role: "STAFF", source: "POS", wantStatus: http.StatusCreated},
+		{role: "ADMIN", source: "QUICK_POS", wantStatus: http.StatusCreated},
+		{role: "STAFF", source: "", wantStatus: http.StatusCreated},
+		{role: "STAFF", source: "ONLINE", wantStatus: http.StatusBadRequest},
+		{role: "CUSTOMER", source: "POS", wantStatus: http.StatusForbidden},
+		{role: "SUPPLIER", source: "QUICK_POS", wantStatus: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.role+" "+tt.source, func(t *testing.T) {
+			orders := &recordingOrderService{}
+			s := newTestServer(t, testBackends{orders: orders})
+
+			rec := serveJSON(s, http.MethodPost, "/api/v1/pos/orders", testToken(t, "user-1", tt.role),
+				`{"items":[{"productId":"product-1","sku":"SKU-1","quantity":1,"price":9.99}],"paymentType":"CASH","source":"`+tt.source+`","storeId":"store-1"}`)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if created := len(orders.created) == 1; created != (tt.wantStatus == http.StatusCreated) {
+				t.Fatalf("orders created = %v, want one only when allowed", orders.created)
+			}
+			if tt.wantStatus == http.StatusCreated && orders.created[0] == "" {
+				t.Fatal("POS order created without a source")
+			}
+		})
+	}
+}
+
+func TestOnlineOrdersRejectPOSSources(t *testing.T) {
+	tests := []struct {
+		role       string
+		source     string
+		wantStatus int
+	}{
+		{role: "CUSTOMER", source: "ONLINE", wantStatus: http.StatusCreated},
+		{role: "CUSTOMER", source: "POS", wantStatus: http.StatusBadRequest},
+		{role: "STAFF", source: "QUICK_POS", wantStatus: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.role+" "+tt.source, func(t *testing.T) {
+			orders := &recordingOrderService{}
+			s := newTestServer(t, testBackends{orders: orders})
+
+			rec := serveJSON(s, http.MethodPost, "/api/v1/orders", testToken(t, "user-1", tt.role),
+				`{"items":[{"productId":"product-1","sku":"SKU-1","quantity":1,"price":9.99}],"paymentType":"CASH","source":"`+tt.source+`","storeId":"store-1","addressId":"address-1","shippingType":"STANDARD"}`)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if created := len(orders.created) == 1; created != (tt.wantStatus == http.StatusCreated) {
+				t.Fatalf("orders created = %v, want one only when allowed", orders.created)
+			}
+		})
+	}
+}
+
+// runRequireRoles runs RequireRoles(roles...) on a request whose context
+// holds the given user ID and role, leaving out empty ones
+func runRequireRoles(userID, role string, roles ...string) (*httptest.ResponseRecorder, bool) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		if userID != "" {
+			c.Set("userID", userID)
+		}
+		if role != "" {
+			c.Set("role", role)
+		}
+	})
+
+	reached := false
+	router.GET("/", RequireRoles(roles...), func(c *gin.Context) {
+		reached = true
+		c.Status(http.StatusOK)
+	})
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	return rec, reached
+}
+
+func TestRequireRoles(t *testing.T) {
+	tests := []struct {
+		name        string
+		userID      string
+		role        string
+		wantStatus  int
+		wantReached bool
+	}{
+		{name: "allowed", userID: "user-1", role: "STAFF", wantStatus: http.StatusOK, wantReached: true},
+		{name: "allowed second role", userID: "user-1", role: "ADMIN", wantStatus: http.StatusOK, wantReached: true},
+		{name: "case-insensitive", userID: "user-1", role: "staff", wantStatus: http.StatusOK, wantReached: true},
+		{name: "forbidden", userID: "user-1", role: "CUSTOMER", wantStatus: http.StatusForbidden},
+		{name: "no role", userID: "user-1", wantStatus: http.StatusUnauthorized},
+		{name: "no user ID", role: "ADMIN", wantStatus: http.StatusUnauthorized},
+		{name: "unauthenticated", wantStatus: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, reached := runRequireRoles(tt.userID, tt.role, "ADMIN", "STAFF")
+
+			if reached != tt.wantReached {
+				t.Fatalf("handler reached = %v, want %v", reached, tt.wantReached)
+			}
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestRoutesAllowPermittedRoles(t *testing.T) {
+	s := newTestServer(t, testBackends{products: &exportingProductService{}, suppliers: &downSupplierService{}})
+
+	tests := []struct {
+		role string
+		path string
+	}{
+		{role: "STAFF", path: "/api/v1/products/export"},
+		{role: "ADMIN", path: "/api/v1/products/export"},
+		{role: "STAFF", path: "/api/v1/suppliers/supplier-1"},
+		{role: "ADMIN", path: "/api/v1/suppliers/supplier-1"},
+	}
+	for _, tt := range tests {
+		rec := serve(s, http.MethodGet, tt.path, testToken(t, "user-1", tt.role))
+		if rec.Code == http.StatusForbidden || rec.Code == http.StatusUnauthorized {
+			t.Errorf("%s GET %s: status = %d, want the route reached", tt.role, tt.path, rec.Code)
+		}
+	}
+}
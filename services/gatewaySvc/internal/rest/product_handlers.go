@@ -3,8 +3,14 @@ package rest
 import (
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/dates"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
 )
 
 // CategoryRequest represents the category request body
@@ -22,7 +28,7 @@ type ProductRequest struct {
 	CostPrice    string            `json:"cost_price" binding:"required"`
 	SellingPrice string            `json:"selling_price" binding:"required"`
 	Currency     string            `json:"currency"`
-	SKU          string            `json:"sku" binding:"required"`
+	SKU          string            `json:"sku"` // Generated by the product service when empty
 	Barcode      string            `json:"barcode"`
 	CategoryIDs  []string          `json:"category_ids"`
 	SupplierID   string            `json:"supplier_id"`
@@ -33,6 +39,16 @@ type ProductRequest struct {
 	ImageURLs    []string          `json:"image_urls"`
 	VideoURLs    []string          `json:"video_urls"`
 	Metadata     map[string]string `json:"metadata"`
+
+	models.OrderQuantityLimits
+}
+
+// UpdateProductRequest represents the product update request. Version is the
+// version of the product the edit was made on; when someone else saved the
+// product since, the update is rejected with 409. Zero skips the check.
+type UpdateProductRequest struct {
+	ProductRequest
+	Version int64 `json:"version"`
 }
 
 // listCategories returns a list of product categories
@@ -63,6 +79,40 @@ func (s *Server) createCategory(c *gin.Context) {
 	respondWithSuccess(c, http.StatusCreated, category)
 }
 
+// CategoryAttributesRequest represents the body of a category attribute
+// schema update
+type CategoryAttributesRequest struct {
+	Attributes []models.CategoryAttribute `json:"attributes" binding:"dive"`
+}
+
+// getCategory returns a category with its attribute schema
+func (s *Server) getCategory(c *gin.Context) {
+	category, err := s.productSvc.GetCategory(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		genericErrorHandler(c, err, s.logger, "Get category")
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, category)
+}
+
+// setCategoryAttributes replaces the attribute schema of a category
+func (s *Server) setCategoryAttributes(c *gin.Context) {
+	var req CategoryAttributesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	category, err := s.productSvc.SetCategoryAttributes(c.Request.Context(), c.Param("id"), req.Attributes)
+	if err != nil {
+		genericErrorHandler(c, err, s.logger, "Set category attributes")
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, category)
+}
+
 // listProducts returns a list of products
 func (s *Server) listProducts(c *gin.Context) {
 	categoryID := c.Query("category")
@@ -95,9 +145,104 @@ func (s *Server) listProducts(c *gin.Context) {
 		return
 	}
 
+	// Optionally annotate the listing with cached stock badges
+	if c.Query("availability") == "true" {
+		if list, ok := products.(*models.ListProductsResponse); ok {
+			ids := make([]string, 0, len(list.Products))
+			for _, p := range list.Products {
+				ids = append(ids, p.ID)
+			}
+
+			respondWithSuccess(c, http.StatusOK, gin.H{
+				"products":     list.Products,
+				"total_count":  list.TotalCount,
+				"availability": s.availability.GetMany(c.Request.Context(), ids),
+			})
+			return
+		}
+	}
+
 	respondWithSuccess(c, http.StatusOK, products)
 }
 
+// getProductAvailability returns the stock availability of a product.
+// Pass fresh=true to bypass the cache, e.g. right before checkout.
+func (s *Server) getProductAvailability(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		respondWithError(c, http.StatusBadRequest, "Product ID is required")
+		return
+	}
+
+	var (
+		availability interface{}
+		err          error
+	)
+	if c.Query("fresh") == "true" {
+		availability, err = s.availability.GetFresh(c.Request.Context(), id)
+	} else {
+		availability, err = s.availability.Get(c.Request.Context(), id)
+	}
+	if err != nil {
+		genericErrorHandler(c, err, s.logger, "Get product availability")
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, availability)
+}
+
+// subscribeBackInStock subscribes the current user to a back-in-stock alert for a product.
+// Subscribing again while an alert is pending returns the existing subscription.
+func (s *Server) subscribeBackInStock(c *gin.Context) {
+	userID, _ := c.Get("userID")
+	userIDStr, ok := userID.(string)
+	if !ok {
+		respondWithError(c, http.StatusUnauthorized, "Invalid user ID")
+		return
+	}
+
+	productID := c.Param("id")
+	if productID == "" {
+		respondWithError(c, http.StatusBadRequest, "Product ID is required")
+		return
+	}
+
+	sub, err := s.inventorySvc.SubscribeBackInStock(c.Request.Context(), userIDStr, productID)
+	if err != nil {
+		genericErrorHandler(c, err, s.logger, "Subscribe to back-in-stock alert")
+		return
+	}
+
+	statusCode := http.StatusCreated
+	if sub.AlreadySubscribed {
+		statusCode = http.StatusOK
+	}
+	respondWithSuccess(c, statusCode, sub)
+}
+
+// unsubscribeBackInStock removes the current user's back-in-stock alert for a product
+func (s *Server) unsubscribeBackInStock(c *gin.Context) {
+	userID, _ := c.Get("userID")
+	userIDStr, ok := userID.(string)
+	if !ok {
+		respondWithError(c, http.StatusUnauthorized, "Invalid user ID")
+		return
+	}
+
+	productID := c.Param("id")
+	if productID == "" {
+		respondWithError(c, http.StatusBadRequest, "Product ID is required")
+		return
+	}
+
+	if err := s.inventorySvc.UnsubscribeBackInStock(c.Request.Context(), userIDStr, productID); err != nil {
+		genericErrorHandler(c, err, s.logger, "Unsubscribe from back-in-stock alert")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
 // getProduct returns a product by ID
 func (s *Server) getProduct(c *gin.Context) {
 	id := c.Param("id")
@@ -153,6 +298,7 @@ func (s *Server) createProduct(c *gin.Context) {
 		req.ImageURLs,
 		req.VideoURLs,
 		req.Metadata,
+		req.OrderQuantityLimits,
 	)
 	if err != nil {
 		genericErrorHandler(c, err, s.logger, "Create product")
@@ -170,7 +316,7 @@ func (s *Server) updateProduct(c *gin.Context) {
 		return
 	}
 
-	var req ProductRequest
+	var req UpdateProductRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		respondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
 		return
@@ -200,6 +346,7 @@ func (s *Server) updateProduct(c *gin.Context) {
 		req.IsActive,
 		req.ImageURLs,
 		req.Metadata,
+		req.Version,
 	)
 	if err != nil {
 		genericErrorHandler(c, err, s.logger, "Update product")
@@ -226,6 +373,49 @@ func (s *Server) deleteProduct(c *gin.Context) {
 	respondWithSuccess(c, http.StatusOK, gin.H{"message": "Product deleted successfully"})
 }
 
+// exportProducts downloads the products matching the query filters (staff or
+// supplier). Suppliers are limited to their own products by the product service.
+func (s *Server) exportProducts(c *gin.Context) {
+	filter := models.ProductExportFilter{
+		CategoryID: c.Query("category"),
+		SupplierID: c.Query("supplier_id"),
+	}
+	if activeStr := c.Query("active"); activeStr != "" {
+		active, err := strconv.ParseBool(activeStr)
+		if err != nil {
+			respondWithError(c, http.StatusBadRequest, "Invalid active parameter")
+			return
+		}
+		filter.IsActive = &active
+	}
+	for param, dst := range map[string]*time.Time{
+		"created_after":  &filter.CreatedAfter,
+		"created_before": &filter.CreatedBefore,
+	} {
+		if value := c.Query(param); value != "" {
+			t, err := dates.Parse(value)
+			if err != nil {
+				respondWithError(c, http.StatusBadRequest, "Invalid "+param+" parameter: "+err.Error())
+				return
+			}
+			*dst = t
+		}
+	}
+
+	export, err := s.productSvc.ExportProducts(c.Request.Context(), c.DefaultQuery("format", "csv"), filter)
+	if err != nil {
+		if status.Code(err) == codes.PermissionDenied {
+			respondWithError(c, http.StatusForbidden, "Suppliers can only export their own products")
+			return
+		}
+		genericErrorHandler(c, err, s.logger, "Export products")
+		return
+	}
+
+	c.Header("Content-Disposition", "attachment; filename="+export.Filename)
+	c.Data(http.StatusOK, export.ContentType, export.Data)
+}
+
 // parseIntParam parses a string parameter to an integer with a default value
 func parseIntParam(param string, defaultValue int) (int, error) {
 	if param == "" {
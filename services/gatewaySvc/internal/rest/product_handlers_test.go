@@ -0,0 +1,143 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/identity"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/services"
+)
+
+// exportingProductService is a product service that only exports. Like the
+// product service, it refuses supplier callers another supplier's products.
+type exportingProductService struct {
+	services.ProductService
+	filters   []models.ProductExportFilter
+	suppliers []string
+}
+
+func (f *exportingProductService) ExportProducts(ctx context.Context, format string, filter models.ProductExportFilter) (*models.ProductExport, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	supplierID := ""
+	if values := md.Get(identity.SupplierIDMetadataKey); len(values) > 0 {
+		supplierID = values[0]
+	}
+	f.filters = append(f.filters, filter)
+	f.suppliers = append(f.suppliers, supplierID)
+
+	if md.Get(identity.RoleMetadataKey)[0] == "SUPPLIER" && (supplierID == "" || (filter.SupplierID != "" && filter.SupplierID != supplierID)) {
+		return nil, status.Error(codes.PermissionDenied, "products of another supplier")
+	}
+	return &models.ProductExport{Data: []byte("SKU\n"), Filename: "products.csv", ContentType: "text/csv"}, nil
+}
+
+// testSupplierToken returns a bearer token for a SUPPLIER user acting for supplierID
+func testSupplierToken(t *testing.T, userID, supplierID string) string {
+	t.Helper()
+	claims := &Claims{
+		UserID:     userID,
+		Role:       "SUPPLIER",
+		SupplierID: supplierID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testJWTSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestSupplierExportsOwnProducts(t *testing.T) {
+	products := &exportingProductService{}
+	s := newTestServer(t, testBackends{products: products})
+
+	rec := serve(s, http.MethodGet, "/api/v1/products/export?supplier_id=globex&category=lamps", testSupplierToken(t, "user-1", "globex"))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", rec.Code, rec.Body)
+	}
+	if len(products.suppliers) != 1 || products.suppliers[0] != "globex" {
+		t.Fatalf("forwarded supplier = %v, want the token's supplier globex", products.suppliers)
+	}
+	if got := products.filters[0]; got.SupplierID != "globex" || got.CategoryID != "lamps" {
+		t.Fatalf("filter = %+v", got)
+	}
+	if rec.Header().Get("Content-Disposition") != "attachment; filename=products.csv" {
+		t.Fatalf("Content-Disposition = %q", rec.Header().Get("Content-Disposition"))
+	}
+}
+
+func TestSupplierCannotExportOtherSuppliersProducts(t *testing.T) {
+	products := &exportingProductService{}
+	s := newTestServer(t, testBackends{products: products})
+
+	rec := serve(s, http.MethodGet, "/api/v1/products/export?supplier_id=acme", testSupplierToken(t, "user-1", "globex"))
+
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("status = %d, want 403", rec.Code)
+	}
+}
+
+func TestExportProductsRequiresRole(t *testing.T) {
+	products := &exportingProductService{}
+	s := newTestServer(t, testBackends{products: products})
+
+	if rec := serve(s, http.MethodGet, "/api/v1/products/export", ""); rec.Code != http.StatusUnauthorized {
+		t.Errorf("no token: status = %d, want 401", rec.Code)
+	}
+	if rec := serve(s, http.MethodGet, "/api/v1/products/export", testToken(t, "user-1", "CUSTOMER")); rec.Code != http.StatusForbidden {
+		t.Errorf("customer: status = %d, want 403", rec.Code)
+	}
+	if rec := serve(s, http.MethodGet, "/api/v1/products/export", testToken(t, "staff-1", "STAFF")); rec.Code != http.StatusOK {
+		t.Errorf("staff: status = %d, want 200", rec.Code)
+	}
+	if len(products.filters) != 1 {
+		t.Fatalf("export reached the product service %d times, want only for staff", len(products.filters))
+	}
+}
+
+// versionedProductService is a product service that only updates, rejecting
+// edits made on any version but current
+type versionedProductService struct {
+	services.ProductService
+	current  int64
+	versions []int64
+}
+
+func (f *versionedProductService) UpdateProduct(ctx context.Context, id, name, description, sku string, categories []string, price, cost string, active bool, images []string, attributes map[string]string, version int64) error {
+	f.versions = append(f.versions, version)
+	if version != 0 && version != f.current {
+		return status.Error(codes.Aborted, "product was modified by someone else, fetch it again and retry")
+	}
+	f.current++
+	return nil
+}
+
+func TestUpdateProductForwardsVersion(t *testing.T) {
+	products := &versionedProductService{current: 3}
+	s := newTestServer(t, testBackends{products: products})
+	token := testToken(t, "user-1", "STAFF")
+	body := func(version string) string {
+		return `{"name":"Mug","cost_price":"2.00","selling_price":"5.00","version":` + version + `}`
+	}
+
+	if rec := serveJSON(s, http.MethodPut, "/api/v1/products/product-1", token, body("3")); rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", rec.Code, rec.Body.String())
+	}
+	if rec := serveJSON(s, http.MethodPut, "/api/v1/products/product-1", token, body("3")); rec.Code != http.StatusConflict {
+		t.Fatalf("stale update status = %d, want 409: %s", rec.Code, rec.Body.String())
+	}
+	if len(products.versions) != 2 || products.versions[0] != 3 || products.versions[1] != 3 {
+		t.Fatalf("versions = %v, want [3 3]", products.versions)
+	}
+}
@@ -0,0 +1,150 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
+)
+
+// ReturnLineRequest represents one product in a return request
+type ReturnLineRequest struct {
+	ProductID string `json:"productId" binding:"required"`
+	Quantity  int32  `json:"quantity" binding:"required,min=1"`
+	Condition string `json:"condition" enums:"SELLABLE,DAMAGED"`
+}
+
+// ReturnRequest represents the body of a return (RMA) request
+type ReturnRequest struct {
+	Items  []ReturnLineRequest `json:"items" binding:"required,min=1,dive"`
+	Reason string              `json:"reason" binding:"required"`
+}
+
+// ReturnStatusRequest represents a return status change. Conditions maps
+// product IDs to the condition found on inspection and is applied on receipt.
+type ReturnStatusRequest struct {
+	Status     string            `json:"status" binding:"required" enums:"APPROVED,RECEIVED,REFUNDED,REJECTED"`
+	Conditions map[string]string `json:"conditions"`
+}
+
+// createOrderReturn opens a return for items of one of the current user's orders
+func (s *Server) createOrderReturn(c *gin.Context) {
+	userID, _ := c.Get("userID")
+	userIDStr, ok := userID.(string)
+	if !ok {
+		respondWithError(c, http.StatusUnauthorized, "Invalid user ID")
+		return
+	}
+
+	orderID := c.Param("id")
+	if orderID == "" {
+		respondWithError(c, http.StatusBadRequest, "Order ID is required")
+		return
+	}
+
+	var req ReturnRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	lines := make([]*models.ReturnLine, 0, len(req.Items))
+	for _, item := range req.Items {
+		lines = append(lines, &models.ReturnLine{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			Condition: item.Condition,
+		})
+	}
+
+	ret, err := s.orderSvc.CreateUserReturn(c.Request.Context(), orderID, userIDStr, lines, req.Reason)
+	if err != nil {
+		genericErrorHandler(c, err, s.logger, "Create order return")
+		return
+	}
+
+	respondWithSuccess(c, http.StatusCreated, ret)
+}
+
+// getUserOrderReturns lists the returns of one of the current user's orders
+func (s *Server) getUserOrderReturns(c *gin.Context) {
+	userID, _ := c.Get("userID")
+	userIDStr, ok := userID.(string)
+	if !ok {
+		respondWithError(c, http.StatusUnauthorized, "Invalid user ID")
+		return
+	}
+
+	orderID := c.Param("id")
+	if orderID == "" {
+		respondWithError(c, http.StatusBadRequest, "Order ID is required")
+		return
+	}
+
+	returns, err := s.orderSvc.GetUserOrderReturns(c.Request.Context(), orderID, userIDStr)
+	if err != nil {
+		genericErrorHandler(c, err, s.logger, "Get user order returns")
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, returns)
+}
+
+// listOrderReturns lists the returns of an order (admin/staff only)
+func (s *Server) listOrderReturns(c *gin.Context) {
+	orderID := c.Param("id")
+	if orderID == "" {
+		respondWithError(c, http.StatusBadRequest, "Order ID is required")
+		return
+	}
+
+	returns, err := s.orderSvc.ListOrderReturns(c.Request.Context(), orderID)
+	if err != nil {
+		genericErrorHandler(c, err, s.logger, "List order returns")
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, returns)
+}
+
+// getReturn returns a return by ID (admin/staff only)
+func (s *Server) getReturn(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		respondWithError(c, http.StatusBadRequest, "Return ID is required")
+		return
+	}
+
+	ret, err := s.orderSvc.GetReturn(c.Request.Context(), id)
+	if err != nil {
+		genericErrorHandler(c, err, s.logger, "Get return")
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, ret)
+}
+
+// updateReturnStatus approves, receives, refunds or rejects a return (admin/staff only).
+// Receiving a return restocks its items.
+func (s *Server) updateReturnStatus(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		respondWithError(c, http.StatusBadRequest, "Return ID is required")
+		return
+	}
+
+	var req ReturnStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	ret, err := s.orderSvc.UpdateReturnStatus(c.Request.Context(), id, req.Status, req.Conditions)
+	if err != nil {
+		genericErrorHandler(c, err, s.logger, "Update return status")
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, ret)
+}
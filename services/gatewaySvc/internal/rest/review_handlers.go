@@ -0,0 +1,101 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ProductReviewRequest represents the product review request body
+type ProductReviewRequest struct {
+	Rating int32  `json:"rating" binding:"required,min=1,max=5"`
+	Text   string `json:"text"`
+}
+
+// ModerateReviewRequest represents the review moderation request body
+type ModerateReviewRequest struct {
+	Approved *bool `json:"approved" binding:"required"`
+}
+
+// listProductReviews returns a page of a product's approved reviews. Staff
+// can pass include_unapproved=true to see reviews waiting for moderation.
+func (s *Server) listProductReviews(c *gin.Context) {
+	productID := c.Param("id")
+
+	limit, err := parseIntParam(c.Query("limit"), 20)
+	if err != nil || limit < 0 {
+		respondWithError(c, http.StatusBadRequest, "Invalid limit parameter")
+		return
+	}
+	offset, err := parseIntParam(c.Query("offset"), 0)
+	if err != nil || offset < 0 {
+		respondWithError(c, http.StatusBadRequest, "Invalid offset parameter")
+		return
+	}
+	includeUnapproved := false
+	if value := c.Query("include_unapproved"); value != "" {
+		includeUnapproved, err = strconv.ParseBool(value)
+		if err != nil {
+			respondWithError(c, http.StatusBadRequest, "Invalid include_unapproved parameter")
+			return
+		}
+	}
+
+	resp, err := s.productSvc.ListProductReviews(c.Request.Context(), productID, includeUnapproved, limit, offset)
+	if err != nil {
+		if status.Code(err) == codes.PermissionDenied {
+			respondWithError(c, http.StatusForbidden, "Only staff can list unapproved reviews")
+			return
+		}
+		genericErrorHandler(c, err, s.logger, "List product reviews")
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, gin.H{
+		"reviews":    resp.Reviews,
+		"pagination": newPagination(limit, offset, len(resp.Reviews), resp.TotalCount),
+	})
+}
+
+// createProductReview reviews a product as the current user. The review is
+// shown once staff approve it.
+func (s *Server) createProductReview(c *gin.Context) {
+	var req ProductReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	review, err := s.productSvc.CreateProductReview(c.Request.Context(), c.Param("id"), req.Rating, req.Text)
+	if err != nil {
+		if status.Code(err) == codes.AlreadyExists {
+			respondWithError(c, http.StatusConflict, "You have already reviewed this product")
+			return
+		}
+		genericErrorHandler(c, err, s.logger, "Create product review")
+		return
+	}
+
+	respondWithSuccess(c, http.StatusCreated, review)
+}
+
+// moderateProductReview approves or rejects a review, which updates the
+// product's average rating
+func (s *Server) moderateProductReview(c *gin.Context) {
+	var req ModerateReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	review, err := s.productSvc.ModerateProductReview(c.Request.Context(), c.Param("reviewId"), *req.Approved)
+	if err != nil {
+		genericErrorHandler(c, err, s.logger, "Moderate product review")
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, review)
+}
@@ -2,8 +2,11 @@ package rest
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-contrib/cors"
@@ -11,9 +14,18 @@ import (
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/featureflags"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/version"
 	_ "github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/docs" // Import generated docs
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/availability"
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/dashboard"
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/jobs"
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/media"
 	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/services"
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/versions"
 )
 
 // Server represents the REST API server
@@ -25,9 +37,15 @@ type Server struct {
 	userSvc     services.UserService
 	supplierSvc services.SupplierService
 	storeSvc    services.StoreService
+	availability *availability.Cache
+	dashboard   *dashboard.Aggregator
+	versions    *versions.Collector
+	media       *media.Proxy // Nil when no media hosts are allowed
+	jobs        *jobs.Manager
 	logger      *zap.Logger
 	jwtSecret   string
 	port        string
+	httpServer  *http.Server
 }
 
 // NewServer creates a new REST API server
@@ -38,6 +56,11 @@ func NewServer(
 	userSvc services.UserService,
 	supplierSvc services.SupplierService,
 	storeSvc services.StoreService,
+	availabilityCache *availability.Cache,
+	dashboardAggregator *dashboard.Aggregator,
+	versionCollector *versions.Collector,
+	mediaProxy *media.Proxy,
+	timeouts RequestTimeouts,
 	jwtSecret string,
 	port string,
 	logger *zap.Logger,
@@ -47,12 +70,14 @@ func NewServer(
 	// Add middlewares
 	router.Use(gin.Recovery())
 	router.Use(loggerMiddleware(logger))
+	router.Use(timeoutMiddleware(timeouts, logger))
+	router.Use(featureFlagsMiddleware())
 	
 	// Configure CORS
 	router.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"*"},
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", featureflags.Header},
 		ExposeHeaders:    []string{"Content-Length"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
@@ -66,9 +91,18 @@ func NewServer(
 		userSvc:     userSvc,
 		supplierSvc: supplierSvc,
 		storeSvc:    storeSvc,
+		availability: availabilityCache,
+		dashboard:   dashboardAggregator,
+		versions:    versionCollector,
+		media:       mediaProxy,
+		jobs:        jobs.NewManager(30*time.Minute, logger),
 		logger:      logger.Named("rest_server"),
 		jwtSecret:   jwtSecret,
 		port:        port,
+		httpServer: &http.Server{
+			Addr:    ":" + port,
+			Handler: router,
+		},
 	}
 }
 
@@ -79,6 +113,12 @@ func (s *Server) SetupRoutes() {
 	
 	// Health check
 	v1.GET("/health", s.healthCheck)
+	v1.GET("/version", s.getVersions)
+
+	// Image proxy, only offered once the hosts it may fetch from are configured
+	if s.media != nil {
+		v1.GET("/media", s.proxyMedia)
+	}
 	
 	// Swagger documentation
 	s.router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler, 
@@ -91,6 +131,7 @@ func (s *Server) SetupRoutes() {
 	{
 		auth.POST("/register", s.registerUser)
 		auth.POST("/login", s.loginUser)
+		auth.POST("/refresh", s.refreshToken)
 	}
 	
 	// User routes (protected)
@@ -109,48 +150,95 @@ func (s *Server) SetupRoutes() {
 		// Address management
 		users.GET("/me/addresses", s.getUserAddresses)
 		users.POST("/me/addresses", s.createUserAddress)
+		users.POST("/me/addresses/bulk", s.bulkCreateUserAddresses)
 		users.GET("/me/addresses/default", s.getUserDefaultAddress)
 		users.PUT("/me/addresses/:id", s.updateUserAddress)
 		users.DELETE("/me/addresses/:id", s.deleteUserAddress)
 		users.PUT("/me/addresses/:id/default", s.setDefaultUserAddress)
 	}
 	
-	// Admin routes (protected + admin role)
+	// Current user's permissions
+	me := v1.Group("/me")
+	me.Use(s.authMiddleware())
+	{
+		me.GET("/permissions", s.getMyPermissions)
+	}
+
+	// Admin routes (protected + admin permissions)
 	admin := v1.Group("/admin")
-	admin.Use(s.authMiddleware(), s.adminMiddleware())
+	admin.Use(s.authMiddleware())
 	{
-		admin.GET("/users", s.listUsers)
-		admin.GET("/users/:id", s.getUserByID)
-		admin.PUT("/users/:id/activate", s.activateUser)
-		admin.PUT("/users/:id/deactivate", s.deactivateUser)
+		adminUsers := admin.Group("/users", s.requirePermission(PermissionManageUsers))
+		adminUsers.GET("", s.listUsers)
+		adminUsers.GET("/:id", s.getUserByID)
+		adminUsers.PUT("/:id/activate", s.activateUser)
+		adminUsers.PUT("/:id/deactivate", s.deactivateUser)
+
+		// Background jobs
+		adminJobs := admin.Group("", s.requirePermission(PermissionManageJobs))
+		adminJobs.POST("/products/reindex", s.reindexProducts)
+		adminJobs.GET("/jobs", s.listJobs)
+		adminJobs.GET("/jobs/:id", s.getJob)
+		adminJobs.POST("/inventory/reservations/reconcile", s.reconcileReservations)
+
+		// Order webhooks
+		adminWebhooks := admin.Group("/webhooks", s.requirePermission(PermissionManageWebhooks))
+		adminWebhooks.GET("/deliveries", s.listWebhookDeliveries)
+		adminWebhooks.GET("/dead-letters", s.listDeadLetteredWebhooks)
+		adminWebhooks.POST("/dead-letters/:id/replay", s.replayDeadLetteredWebhook)
+	}
+
+	// Dashboard routes (protected + admin role)
+	dashboardGroup := v1.Group("/dashboard")
+	dashboardGroup.Use(s.authMiddleware(), s.requirePermission(PermissionViewDashboard))
+	{
+		dashboardGroup.GET("/summary", s.getDashboardSummary)
 	}
 	
 	// Product routes
 	products := v1.Group("/products")
+	products.Use(s.optionalAuthMiddleware()) // Cost prices are only returned to staff
 	{
 		products.GET("", s.listProducts)
 		products.GET("/:id", s.getProduct)
+		products.GET("/:id/availability", s.getProductAvailability)
+		products.GET("/:id/reviews", s.listProductReviews)
 		products.GET("/categories", s.listCategories)
+		products.GET("/categories/:id", s.getCategory)
+
+		// Back-in-stock alerts for the current user
+		productsAuth := products.Group("")
+		productsAuth.Use(s.authMiddleware())
+		{
+			productsAuth.GET("/export", s.requirePermission(PermissionExportProducts), s.exportProducts)
+			productsAuth.POST("/:id/back-in-stock", s.subscribeBackInStock)
+			productsAuth.DELETE("/:id/back-in-stock", s.unsubscribeBackInStock)
+			productsAuth.POST("/:id/reviews", s.requirePermission(PermissionWriteReviews), s.createProductReview)
+			productsAuth.PUT("/reviews/:reviewId/moderation", s.requirePermission(PermissionModerateReviews), s.moderateProductReview)
+		}
 		
 		// Protected product routes (admin/staff only)
 		productsAdmin := products.Group("")
-		productsAdmin.Use(s.authMiddleware(), s.staffMiddleware())
+		productsAdmin.Use(s.authMiddleware(), s.requirePermission(PermissionManageProducts))
 		{
 			productsAdmin.POST("", s.createProduct)
 			productsAdmin.PUT("/:id", s.updateProduct)
 			productsAdmin.DELETE("/:id", s.deleteProduct)
 			productsAdmin.POST("/categories", s.createCategory)
+			productsAdmin.PUT("/categories/:id/attributes", s.setCategoryAttributes)
 		}
 	}
 	
 	// Inventory routes (mostly protected)
 	inventory := v1.Group("/inventory")
-	inventory.Use(s.authMiddleware(), s.staffMiddleware())
+	inventory.Use(s.authMiddleware(), s.requirePermission(PermissionManageInventory))
 	{
 		inventory.GET("", s.listInventory)
 		inventory.GET("/reservations", s.getInventoryReservations)
 		inventory.POST("/reservations", s.createInventoryReservation)
 		inventory.GET("/low-stock", s.getLowStockItems)
+		inventory.GET("/counts/due", s.getDueCounts)
+		inventory.PUT("/tags", s.updateInventoryTags)
 		inventory.GET("/:id", s.getInventoryItem)
 		inventory.GET("/product/:productId", s.getInventoryItemByProduct)
 		inventory.GET("/sku/:sku", s.getInventoryItemBySKU)
@@ -168,24 +256,45 @@ func (s *Server) SetupRoutes() {
 		// Customer routes
 		orders.GET("/me", s.getUserOrders)
 		orders.GET("/me/:id", s.getUserOrder)
+		orders.GET("/me/:id/returns", s.getUserOrderReturns)
+		orders.POST("/me/:id/returns", s.createOrderReturn)
 		orders.POST("", s.createOrder)
 		
 		// Admin/staff routes
 		ordersAdmin := orders.Group("")
-		ordersAdmin.Use(s.staffMiddleware())
+		ordersAdmin.Use(s.requirePermission(PermissionManageOrders))
 		{
 			ordersAdmin.GET("", s.listOrders)
 			ordersAdmin.GET("/:id", s.getOrder)
+			ordersAdmin.GET("/:id/timeline", s.getOrderTimeline)
 			ordersAdmin.PUT("/:id/status", s.updateOrderStatus)
+			ordersAdmin.POST("/status/bulk", s.bulkUpdateOrderStatus)
 			ordersAdmin.POST("/:id/payment", s.addOrderPayment)
 			ordersAdmin.POST("/:id/tracking", s.addOrderTracking)
+			ordersAdmin.POST("/:id/notes", s.addOrderNote)
 			ordersAdmin.PUT("/:id/cancel", s.cancelOrder)
+			ordersAdmin.GET("/:id/returns", s.listOrderReturns)
 		}
 	}
 
+	// Return (RMA) routes (admin/staff only)
+	returns := v1.Group("/returns")
+	returns.Use(s.authMiddleware(), s.requirePermission(PermissionManageReturns))
+	{
+		returns.GET("/:id", s.getReturn)
+		returns.PUT("/:id/status", s.updateReturnStatus)
+	}
+
+	// POS routes (admin/staff only)
+	pos := v1.Group("/pos")
+	pos.Use(s.authMiddleware(), RequireRoles("ADMIN", "STAFF"))
+	{
+		pos.POST("/orders", s.createPOSOrder)
+	}
+
 	// Supplier routes (admin/staff only)
 	suppliers := v1.Group("/suppliers")
-	suppliers.Use(s.authMiddleware(), s.staffMiddleware())
+	suppliers.Use(s.authMiddleware(), RequireRoles("ADMIN", "STAFF"))
 	{
 		// Initialize supplier handler
 		supplierHandler := NewSupplierHandler(s.supplierSvc, s.logger)
@@ -195,6 +304,7 @@ func (s *Server) SetupRoutes() {
 		suppliers.POST("", supplierHandler.CreateSupplier)
 		suppliers.GET("/:id", supplierHandler.GetSupplier)
 		suppliers.PUT("/:id", supplierHandler.UpdateSupplier)
+		suppliers.PATCH("/:id", supplierHandler.UpdateSupplier)
 		suppliers.DELETE("/:id", supplierHandler.DeleteSupplier)
 		
 		// Adapter routes
@@ -205,11 +315,12 @@ func (s *Server) SetupRoutes() {
 		// Sync routes
 		suppliers.POST("/:id/sync/products", supplierHandler.SyncProducts)
 		suppliers.POST("/:id/sync/inventory", supplierHandler.SyncInventory)
+		suppliers.POST("/:id/sync/validate", supplierHandler.ValidateFeed)
 	}
 	
 	// Store routes (admin/staff only)
 	stores := v1.Group("/stores")
-	stores.Use(s.authMiddleware(), s.staffMiddleware())
+	stores.Use(s.authMiddleware(), s.requirePermission(PermissionManageStores))
 	{
 		stores.GET("", s.getStores)
         stores.POST("", s.createStore)
@@ -217,35 +328,31 @@ func (s *Server) SetupRoutes() {
 	}
 	
 	// Note: All POS operations are now consolidated into standard endpoints:
-	// - POS order creation: POST /orders (with source="POS" parameter)
+	// - POS order creation: POST /orders (with source="POS" parameter, which
+	//   requires the pos:use permission)
 	// - POS inventory check: GET /inventory (with availability query params)
 	// - POS inventory reserve: POST /inventory/reservations (with source parameter)
 	// - POS inventory deduct: POST /inventory/:id/stock/remove (with source parameter)
 	// - Pickup completion: PUT /orders/{id}/status (status="PICKUP_COMPLETED")
 }
 
-// Start starts the server
+// Start starts the server. It returns http.ErrServerClosed once Shutdown
+// has been called.
 func (s *Server) Start() error {
 	s.logger.Info("Starting REST server", zap.String("port", s.port))
-	
-	server := &http.Server{
-		Addr:    ":" + s.port,
-		Handler: s.router,
-	}
-	
-	return server.ListenAndServe()
+	return s.httpServer.ListenAndServe()
 }
 
-// Shutdown gracefully shuts down the server
+// Shutdown gracefully shuts down the server, letting in-flight requests
+// finish until ctx ends
 func (s *Server) Shutdown(ctx context.Context) error {
 	s.logger.Info("Shutting down REST server")
-	
-	server := &http.Server{
-		Addr:    ":" + s.port,
-		Handler: s.router,
-	}
-	
-	return server.Shutdown(ctx)
+	return s.httpServer.Shutdown(ctx)
+}
+
+// StopJobs stops accepting background jobs and waits for the running ones
+func (s *Server) StopJobs() {
+	s.jobs.Stop()
 }
 
 // loggerMiddleware creates a gin middleware for logging requests
@@ -290,7 +397,7 @@ func (s *Server) healthCheck(c *gin.Context) {
 		"status":   status,
 		"time":     time.Now().Format(time.RFC3339),
 		"service":  "gateway",
-		"version":  "1.0.0",
+		"version":  version.Version,
 		"services": services,
 	}
 	
@@ -312,8 +419,74 @@ func respondWithSuccess(c *gin.Context, code int, data interface{}) {
 	})
 }
 
-// genericErrorHandler is a generic error handler
+// Pagination describes where a page sits in a listing
+type Pagination struct {
+	Limit   int   `json:"limit"`
+	Offset  int   `json:"offset"`
+	Total   int64 `json:"total"`
+	HasMore bool  `json:"has_more"`
+}
+
+// newPagination builds the pagination metadata for a page of count items
+func newPagination(limit, offset, count int, total int64) Pagination {
+	return Pagination{
+		Limit:   limit,
+		Offset:  offset,
+		Total:   total,
+		HasMore: int64(offset+count) < total,
+	}
+}
+
+// respondIfUnavailable answers 503 when err shows a backend is down, with a
+// Retry-After header when its circuit breaker is open. It reports whether it
+// responded.
+func respondIfUnavailable(c *gin.Context, err error) bool {
+	var breakerErr *services.BreakerOpenError
+	if errors.As(err, &breakerErr) {
+		retryAfter := int(math.Ceil(breakerErr.RetryAfter.Seconds()))
+		if retryAfter < 1 {
+			retryAfter = 1
+		}
+		c.Header("Retry-After", strconv.Itoa(retryAfter))
+		respondWithError(c, http.StatusServiceUnavailable, breakerErr.Error())
+		return true
+	}
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) && grpcErr.GRPCStatus().Code() == codes.Unavailable {
+		respondWithError(c, http.StatusServiceUnavailable, "backend service unavailable, please retry")
+		return true
+	}
+	return false
+}
+
+// genericErrorHandler is a generic error handler. Backend rejections of the
+// request itself, such as a malformed ID, are passed on as 400 or 404 with the
+// backend's message, and an unreachable backend is a 503; anything else is a 500.
 func genericErrorHandler(c *gin.Context, err error, logger *zap.Logger, operation string) {
+	if respondIfUnavailable(c, err) {
+		logger.Warn("Backend unavailable",
+			zap.String("operation", operation),
+			zap.Error(err),
+		)
+		return
+	}
+
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		st := grpcErr.GRPCStatus()
+		switch st.Code() {
+		case codes.InvalidArgument:
+			respondWithError(c, http.StatusBadRequest, st.Message())
+			return
+		case codes.NotFound:
+			respondWithError(c, http.StatusNotFound, st.Message())
+			return
+		case codes.Aborted:
+			respondWithError(c, http.StatusConflict, st.Message())
+			return
+		}
+	}
+
 	logger.Error("Operation failed",
 		zap.String("operation", operation),
 		zap.Error(err),
@@ -0,0 +1,96 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/services"
+)
+
+const testJWTSecret = "test-secret"
+
+// testBackends are the backend services a test server talks to; nil ones
+// panic when a route reaches them
+type testBackends struct {
+	products  services.ProductService
+	inventory services.InventoryService
+	orders    services.OrderService
+	users     services.UserService
+	suppliers services.SupplierService
+	stores    services.StoreService
+}
+
+// newTestServer returns a server with all routes set up over the backends
+func newTestServer(t *testing.T, backends testBackends) *Server {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	s := NewServer(backends.products, backends.inventory, backends.orders, backends.users,
+		backends.suppliers, backends.stores, nil, nil, nil, nil,
+		RequestTimeouts{Default: 5 * time.Second, Long: 5 * time.Second}, testJWTSecret, "0", zap.NewNop())
+	s.SetupRoutes()
+	return s
+}
+
+// testToken returns a bearer token for a user with role
+func testToken(t *testing.T, userID, role string) string {
+	t.Helper()
+	claims := &Claims{
+		UserID: userID,
+		Role:   role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testJWTSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+// serve sends a request to the server, authenticated when token is not empty
+func serve(s *Server, method, path, token string) *httptest.ResponseRecorder {
+	return serveJSON(s, method, path, token, "")
+}
+
+// serveJSON sends a request with a JSON body to the server, authenticated
+// when token is not empty
+func serveJSON(s *Server, method, path, token, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+	return rec
+}
+
+// decodeData decodes the data of a successful response into v
+func decodeData(t *testing.T, rec *httptest.ResponseRecorder, v interface{}) {
+	t.Helper()
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", rec.Code, rec.Body.String())
+	}
+	var body struct {
+		Success bool            `json:"success"`
+		Data    json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if !body.Success {
+		t.Fatalf("response not successful: %s", rec.Body.String())
+	}
+	if err := json.Unmarshal(body.Data, v); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -5,9 +5,12 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
 )
 
-// getStores returns a list of stores with pagination
+// getStores returns a page of stores, optionally searched by name or
+// description and filtered by location and whether they are active
 func (s *Server) getStores(c *gin.Context) {
 	limitStr := c.DefaultQuery("limit", "50")
 	offsetStr := c.DefaultQuery("offset", "0")
@@ -24,7 +27,21 @@ func (s *Server) getStores(c *gin.Context) {
 		return
 	}
 
-	stores, err := s.storeSvc.ListStores(c.Request.Context(), limit, offset)
+	activeOnly, err := strconv.ParseBool(c.DefaultQuery("active_only", "false"))
+	if err != nil {
+		respondWithError(c, http.StatusBadRequest, "Invalid active_only parameter")
+		return
+	}
+
+	filter := models.StoreFilter{
+		Search:     c.Query("search"),
+		Country:    c.Query("country"),
+		City:       c.Query("city"),
+		State:      c.Query("state"),
+		ActiveOnly: activeOnly,
+	}
+
+	stores, err := s.storeSvc.ListStores(c.Request.Context(), filter, limit, offset)
 	if err != nil {
 		genericErrorHandler(c, err, s.logger, "Get stores")
 		return
@@ -0,0 +1,58 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/services"
+)
+
+// listingStoreService is a store service that only serves listings and
+// records the filter of each
+type listingStoreService struct {
+	services.StoreService
+	filters []models.StoreFilter
+}
+
+func (f *listingStoreService) ListStores(ctx context.Context, filter models.StoreFilter, limit, offset int) (interface{}, error) {
+	f.filters = append(f.filters, filter)
+	return &models.ListStoresResponse{
+		Stores:     []*models.Store{{ID: "store-2", Name: "Ghent Central"}},
+		TotalCount: 3,
+		Limit:      int32(limit),
+		Offset:     int32(offset),
+	}, nil
+}
+
+func TestGetStoresSearchAndCountry(t *testing.T) {
+	stores := &listingStoreService{}
+	s := newTestServer(t, testBackends{stores: stores})
+
+	rec := serve(s, http.MethodGet, "/api/v1/stores?search=central&country=BE&active_only=true&limit=1&offset=2", testToken(t, "admin-1", "ADMIN"))
+
+	var page models.ListStoresResponse
+	decodeData(t, rec, &page)
+	if len(page.Stores) != 1 || page.TotalCount != 3 || page.Limit != 1 || page.Offset != 2 {
+		t.Fatalf("page = %+v, want one of three stores at limit 1 offset 2", page)
+	}
+	want := models.StoreFilter{Search: "central", Country: "BE", ActiveOnly: true}
+	if len(stores.filters) != 1 || stores.filters[0] != want {
+		t.Fatalf("filters = %+v, want %+v", stores.filters, want)
+	}
+}
+
+func TestGetStoresRejectsInvalidActiveOnly(t *testing.T) {
+	stores := &listingStoreService{}
+	s := newTestServer(t, testBackends{stores: stores})
+
+	rec := serve(s, http.MethodGet, "/api/v1/stores?active_only=maybe", testToken(t, "admin-1", "ADMIN"))
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want 400", rec.Code)
+	}
+	if len(stores.filters) != 0 {
+		t.Fatal("the store service should not be called")
+	}
+}
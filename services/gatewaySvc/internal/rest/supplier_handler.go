@@ -3,11 +3,15 @@ package rest
 import (
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/dates"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
 	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/services"
 )
 
@@ -52,6 +56,7 @@ type CreateSupplierRequest struct {
 // @Param request body CreateSupplierRequest true "Supplier details"
 // @Success 201 {object} supplierv1.Supplier
 // @Failure 400 {object} map[string]string
+// @Failure 409 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /api/v1/suppliers [post]
 func (h *SupplierHandler) CreateSupplier(c *gin.Context) {
@@ -82,6 +87,13 @@ func (h *SupplierHandler) CreateSupplier(c *gin.Context) {
 	)
 
 	if err != nil {
+		if status.Code(err) == codes.AlreadyExists {
+			c.JSON(http.StatusConflict, gin.H{"error": status.Convert(err).Message()})
+			return
+		}
+		if respondIfUnavailable(c, err) {
+			return
+		}
 		h.logger.Error("Failed to create supplier", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create supplier"})
 		return
@@ -114,6 +126,9 @@ func (h *SupplierHandler) GetSupplier(c *gin.Context) {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Supplier not found"})
 			return
 		}
+		if respondIfUnavailable(c, err) {
+			return
+		}
 		h.logger.Error("Failed to get supplier", zap.Error(err), zap.String("supplier_id", id))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get supplier"})
 		return
@@ -122,27 +137,29 @@ func (h *SupplierHandler) GetSupplier(c *gin.Context) {
 	c.JSON(http.StatusOK, supplier)
 }
 
-// UpdateSupplierRequest represents the request body for updating a supplier
+// UpdateSupplierRequest represents the request body for updating a supplier.
+// Fields left out of the JSON body are not changed; send an empty string to clear one.
 type UpdateSupplierRequest struct {
-	Name          string `json:"name"`
-	ContactPerson string `json:"contact_person"`
-	Email         string `json:"email"`
-	Phone         string `json:"phone"`
-	Address       string `json:"address"`
-	City          string `json:"city"`
-	State         string `json:"state"`
-	PostalCode    string `json:"postal_code"`
-	Country       string `json:"country"`
-	TaxID         string `json:"tax_id"`
-	Website       string `json:"website"`
-	Currency      string `json:"currency"`
-	LeadTimeDays  int32  `json:"lead_time_days"`
-	PaymentTerms  string `json:"payment_terms"`
+	Name          *string           `json:"name"`
+	ContactPerson *string           `json:"contact_person"`
+	Email         *string           `json:"email"`
+	Phone         *string           `json:"phone"`
+	Address       *string           `json:"address"`
+	City          *string           `json:"city"`
+	State         *string           `json:"state"`
+	PostalCode    *string           `json:"postal_code"`
+	Country       *string           `json:"country"`
+	TaxID         *string           `json:"tax_id"`
+	Website       *string           `json:"website"`
+	Currency      *string           `json:"currency"`
+	LeadTimeDays  *int32            `json:"lead_time_days"`
+	PaymentTerms  *string           `json:"payment_terms"`
+	Metadata      map[string]string `json:"metadata"`
 }
 
 // UpdateSupplier updates an existing supplier
 // @Summary Update a supplier
-// @Description Update an existing supplier with the provided details
+// @Description Update an existing supplier. Only the fields present in the request body are changed.
 // @Tags suppliers
 // @Accept json
 // @Produce json
@@ -151,8 +168,10 @@ type UpdateSupplierRequest struct {
 // @Success 200 {object} supplierv1.Supplier
 // @Failure 400 {object} map[string]string
 // @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /api/v1/suppliers/{id} [put]
+// @Router /api/v1/suppliers/{id} [patch]
 func (h *SupplierHandler) UpdateSupplier(c *gin.Context) {
 	id := c.Param("id")
 	if id == "" {
@@ -167,31 +186,41 @@ func (h *SupplierHandler) UpdateSupplier(c *gin.Context) {
 		return
 	}
 
-	supplier, err := h.svc.UpdateSupplier(
-		c.Request.Context(),
-		id,
-		req.Name,
-		req.ContactPerson,
-		req.Email,
-		req.Phone,
-		req.Address,
-		req.City,
-		req.State,
-		req.Country,
-		req.PostalCode,
-		req.TaxID,
-		req.Website,
-		req.Currency,
-		req.PaymentTerms,
-		req.LeadTimeDays,
-		map[string]string{}, // metadata
-	)
+	if req.Name != nil && *req.Name == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Supplier name cannot be empty"})
+		return
+	}
+
+	supplier, err := h.svc.UpdateSupplier(c.Request.Context(), id, &models.SupplierUpdate{
+		Name:          req.Name,
+		ContactPerson: req.ContactPerson,
+		Email:         req.Email,
+		Phone:         req.Phone,
+		Address:       req.Address,
+		City:          req.City,
+		State:         req.State,
+		PostalCode:    req.PostalCode,
+		Country:       req.Country,
+		TaxID:         req.TaxID,
+		Website:       req.Website,
+		Currency:      req.Currency,
+		LeadTimeDays:  req.LeadTimeDays,
+		PaymentTerms:  req.PaymentTerms,
+		Metadata:      req.Metadata,
+	})
 
 	if err != nil {
 		if status.Code(err) == 404 {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Supplier not found"})
 			return
 		}
+		if status.Code(err) == codes.AlreadyExists {
+			c.JSON(http.StatusConflict, gin.H{"error": status.Convert(err).Message()})
+			return
+		}
+		if respondIfUnavailable(c, err) {
+			return
+		}
 		h.logger.Error("Failed to update supplier", zap.Error(err), zap.String("supplier_id", id))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update supplier"})
 		return
@@ -202,12 +231,16 @@ func (h *SupplierHandler) UpdateSupplier(c *gin.Context) {
 
 // DeleteSupplier deletes a supplier by ID
 // @Summary Delete a supplier
-// @Description Delete a supplier by its ID
+// @Description Delete a supplier by its ID. A supplier that products still reference is only deleted when they are moved to reassign_to, or with force=true.
 // @Tags suppliers
 // @Param id path string true "Supplier ID"
+// @Param reassign_to query string false "Supplier to move the products to before deleting"
+// @Param force query bool false "Delete even though products reference the supplier"
+// @Success 200 {object} map[string]int64 "Products were reassigned"
 // @Success 204
 // @Failure 400 {object} map[string]string
 // @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /api/v1/suppliers/{id} [delete]
 func (h *SupplierHandler) DeleteSupplier(c *gin.Context) {
@@ -217,17 +250,41 @@ func (h *SupplierHandler) DeleteSupplier(c *gin.Context) {
 		return
 	}
 
-	err := h.svc.DeleteSupplier(c.Request.Context(), id)
+	reassignTo := c.Query("reassign_to")
+	force := false
+	if v := c.Query("force"); v != "" {
+		var err error
+		if force, err = strconv.ParseBool(v); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "force must be true or false"})
+			return
+		}
+	}
+
+	reassigned, err := h.svc.DeleteSupplier(c.Request.Context(), id, reassignTo, force)
 	if err != nil {
-		if status.Code(err) == 404 {
+		switch status.Code(err) {
+		case codes.NotFound:
 			c.JSON(http.StatusNotFound, gin.H{"error": "Supplier not found"})
 			return
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message()})
+			return
+		case codes.FailedPrecondition:
+			c.JSON(http.StatusConflict, gin.H{"error": status.Convert(err).Message()})
+			return
+		}
+		if respondIfUnavailable(c, err) {
+			return
 		}
 		h.logger.Error("Failed to delete supplier", zap.Error(err), zap.String("supplier_id", id))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete supplier"})
 		return
 	}
 
+	if reassignTo != "" {
+		c.JSON(http.StatusOK, gin.H{"products_reassigned": reassigned})
+		return
+	}
 	c.Status(http.StatusNoContent)
 }
 
@@ -267,6 +324,9 @@ func (h *SupplierHandler) ListSuppliers(c *gin.Context) {
 
 	result, err := h.svc.ListSuppliers(c.Request.Context(), int32(page), int32(pageSize), search)
 	if err != nil {
+		if respondIfUnavailable(c, err) {
+			return
+		}
 		h.logger.Error("Failed to list suppliers", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list suppliers"})
 		return
@@ -282,6 +342,7 @@ func (h *SupplierHandler) RegisterRoutes(router *gin.RouterGroup) {
 		suppliersGroup.POST("", h.CreateSupplier)
 		suppliersGroup.GET(":id", h.GetSupplier)
 		suppliersGroup.PUT(":id", h.UpdateSupplier)
+		suppliersGroup.PATCH(":id", h.UpdateSupplier)
 		suppliersGroup.DELETE(":id", h.DeleteSupplier)
 		suppliersGroup.GET("", h.ListSuppliers)
 		
@@ -293,6 +354,7 @@ func (h *SupplierHandler) RegisterRoutes(router *gin.RouterGroup) {
 		// Sync routes
 		suppliersGroup.POST(":id/sync/products", h.SyncProducts)
 		suppliersGroup.POST(":id/sync/inventory", h.SyncInventory)
+		suppliersGroup.POST(":id/sync/validate", h.ValidateFeed)
 	}
 }
 
@@ -314,6 +376,18 @@ type SyncOptionsRequest struct {
 	IncludeInactive bool  `json:"include_inactive,omitempty"`
 }
 
+// sinceTime parses Since, returning nil when it is empty
+func (r SyncOptionsRequest) sinceTime() (*time.Time, error) {
+	if r.Since == "" {
+		return nil, nil
+	}
+	since, err := dates.Parse(r.Since)
+	if err != nil {
+		return nil, err
+	}
+	return &since, nil
+}
+
 // SyncResponse represents the response for sync operations
 type SyncResponse struct {
 	JobID   string `json:"job_id"`
@@ -331,6 +405,9 @@ type SyncResponse struct {
 func (h *SupplierHandler) ListAdapters(c *gin.Context) {
 	adapters, err := h.svc.ListAdapters(c.Request.Context())
 	if err != nil {
+		if respondIfUnavailable(c, err) {
+			return
+		}
 		h.logger.Error("Failed to list adapters", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve adapters"})
 		return
@@ -363,6 +440,9 @@ func (h *SupplierHandler) GetAdapterCapabilities(c *gin.Context) {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Adapter not found"})
 			return
 		}
+		if respondIfUnavailable(c, err) {
+			return
+		}
 		h.logger.Error("Failed to get adapter capabilities", zap.Error(err), zap.String("adapter_name", adapterName))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve adapter capabilities"})
 		return
@@ -404,6 +484,9 @@ func (h *SupplierHandler) TestAdapterConnection(c *gin.Context) {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Adapter not found"})
 			return
 		}
+		if respondIfUnavailable(c, err) {
+			return
+		}
 		h.logger.Error("Failed to test connection", zap.Error(err), zap.String("adapter_name", adapterName))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -423,6 +506,7 @@ func (h *SupplierHandler) TestAdapterConnection(c *gin.Context) {
 // @Success 202 {object} SyncResponse
 // @Failure 400 {object} map[string]string
 // @Failure 404 {object} map[string]string
+// @Failure 422 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /api/v1/suppliers/{id}/sync/products [post]
 func (h *SupplierHandler) SyncProducts(c *gin.Context) {
@@ -442,15 +526,28 @@ func (h *SupplierHandler) SyncProducts(c *gin.Context) {
 		}
 	}
 
+	since, err := req.sinceTime()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid since: " + err.Error()})
+		return
+	}
+
 	fullSync := req.FullSync
 	batchSize := req.BatchSize
 	dryRun := false // Default value
 	
-	jobID, err := h.svc.SyncProducts(c.Request.Context(), supplierID, fullSync, dryRun, batchSize)
+	jobID, err := h.svc.SyncProducts(c.Request.Context(), supplierID, fullSync, dryRun, batchSize, since)
 	if err != nil {
-		if status.Code(err) == 404 {
+		switch status.Code(err) {
+		case codes.NotFound:
 			c.JSON(http.StatusNotFound, gin.H{"error": "Supplier not found"})
 			return
+		case codes.FailedPrecondition:
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": status.Convert(err).Message()})
+			return
+		}
+		if respondIfUnavailable(c, err) {
+			return
 		}
 		h.logger.Error("Failed to sync products", zap.Error(err), zap.String("supplier_id", supplierID))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to initiate product synchronization"})
@@ -474,6 +571,7 @@ func (h *SupplierHandler) SyncProducts(c *gin.Context) {
 // @Success 202 {object} SyncResponse
 // @Failure 400 {object} map[string]string
 // @Failure 404 {object} map[string]string
+// @Failure 422 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /api/v1/suppliers/{id}/sync/inventory [post]
 func (h *SupplierHandler) SyncInventory(c *gin.Context) {
@@ -493,15 +591,28 @@ func (h *SupplierHandler) SyncInventory(c *gin.Context) {
 		}
 	}
 
+	since, err := req.sinceTime()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid since: " + err.Error()})
+		return
+	}
+
 	fullSync := req.FullSync
 	batchSize := req.BatchSize
 	dryRun := false // Default value
 	
-	jobID, err := h.svc.SyncInventory(c.Request.Context(), supplierID, fullSync, dryRun, batchSize)
+	jobID, err := h.svc.SyncInventory(c.Request.Context(), supplierID, fullSync, dryRun, batchSize, since)
 	if err != nil {
-		if status.Code(err) == 404 {
+		switch status.Code(err) {
+		case codes.NotFound:
 			c.JSON(http.StatusNotFound, gin.H{"error": "Supplier not found"})
 			return
+		case codes.FailedPrecondition:
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": status.Convert(err).Message()})
+			return
+		}
+		if respondIfUnavailable(c, err) {
+			return
 		}
 		h.logger.Error("Failed to sync inventory", zap.Error(err), zap.String("supplier_id", supplierID))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to initiate inventory synchronization"})
@@ -513,3 +624,47 @@ func (h *SupplierHandler) SyncInventory(c *gin.Context) {
 		Message: "Inventory synchronization job started",
 	})
 }
+
+// ValidateFeed checks a supplier's product feed without syncing it
+// @Summary Validate supplier feed
+// @Description Fetch the supplier's product feed through its configured adapter and validate every record without writing anything
+// @Tags suppliers
+// @Accept json
+// @Produce json
+// @Param id path string true "Supplier ID"
+// @Param options body models.FeedValidationOptions false "Validation options"
+// @Success 200 {object} models.FeedValidationReport
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 422 {object} map[string]string
+// @Failure 502 {object} map[string]string
+// @Router /api/v1/suppliers/{id}/sync/validate [post]
+func (h *SupplierHandler) ValidateFeed(c *gin.Context) {
+	supplierID := c.Param("id")
+	if supplierID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Supplier ID is required"})
+		return
+	}
+
+	var req models.FeedValidationOptions
+	if err := c.ShouldBindJSON(&req); err != nil && err.Error() != "EOF" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	report, err := h.svc.ValidateFeed(c.Request.Context(), supplierID, req)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Supplier not found"})
+		case codes.InvalidArgument, codes.FailedPrecondition:
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": status.Convert(err).Message()})
+		default:
+			h.logger.Error("Failed to validate supplier feed", zap.Error(err), zap.String("supplier_id", supplierID))
+			c.JSON(http.StatusBadGateway, gin.H{"error": "Failed to validate supplier feed"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
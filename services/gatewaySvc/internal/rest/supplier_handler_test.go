@@ -0,0 +1,85 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/services"
+)
+
+// taxIDSupplierService creates suppliers and, like the supplier service,
+// refuses a second supplier with the same tax ID
+type taxIDSupplierService struct {
+	services.SupplierService
+	taxIDs map[string]bool
+}
+
+func (f *taxIDSupplierService) CreateSupplier(ctx context.Context, name, contactPerson, email, phone, address, city, state, country, postalCode, taxID, website, currency, paymentTerms string, leadTimeDays int32, metadata map[string]string) (interface{}, error) {
+	if f.taxIDs[taxID] {
+		return nil, status.Errorf(codes.AlreadyExists, "a supplier with tax ID %q already exists", taxID)
+	}
+	f.taxIDs[taxID] = true
+	return map[string]string{"name": name, "tax_id": taxID}, nil
+}
+
+func TestCreateSupplierWithDuplicateTaxIDConflicts(t *testing.T) {
+	s := newTestServer(t, testBackends{suppliers: &taxIDSupplierService{taxIDs: map[string]bool{}}})
+	token := testToken(t, "admin-1", "ADMIN")
+
+	first := serveJSON(s, http.MethodPost, "/api/v1/suppliers", token,
+		`{"name":"Acme","email":"sales@acme.test","tax_id":"BE0123456789"}`)
+	if first.Code != http.StatusCreated {
+		t.Fatalf("first supplier: status = %d, want 201: %s", first.Code, first.Body.String())
+	}
+
+	second := serveJSON(s, http.MethodPost, "/api/v1/suppliers", token,
+		`{"name":"Acme Trading","email":"info@acme.test","tax_id":"BE0123456789"}`)
+	if second.Code != http.StatusConflict {
+		t.Fatalf("second supplier: status = %d, want 409: %s", second.Code, second.Body.String())
+	}
+	if body := second.Body.String(); !strings.Contains(body, "BE0123456789") {
+		t.Fatalf("conflict should name the tax ID: %s", body)
+	}
+}
+
+// downSupplierService answers like a supplier backend whose breaker is open
+type downSupplierService struct {
+	services.SupplierService
+	err error
+}
+
+func (f *downSupplierService) GetSupplier(ctx context.Context, id string) (interface{}, error) {
+	return nil, f.err
+}
+
+func TestGetSupplierFromDownedBackend(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		wantRetryAfter string
+	}{
+		{name: "breaker open", err: &services.BreakerOpenError{Service: "supplier", RetryAfter: 2500 * time.Millisecond}, wantRetryAfter: "3"},
+		{name: "breaker about to probe", err: &services.BreakerOpenError{Service: "supplier"}, wantRetryAfter: "1"},
+		{name: "retries exhausted", err: status.Error(codes.Unavailable, "connection refused")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t, testBackends{suppliers: &downSupplierService{err: tt.err}})
+
+			rec := serve(s, http.MethodGet, "/api/v1/suppliers/supplier-1", testToken(t, "admin-1", "ADMIN"))
+
+			if rec.Code != http.StatusServiceUnavailable {
+				t.Fatalf("status = %d, want 503: %s", rec.Code, rec.Body.String())
+			}
+			if got := rec.Header().Get("Retry-After"); got != tt.wantRetryAfter {
+				t.Fatalf("Retry-After = %q, want %q", got, tt.wantRetryAfter)
+			}
+		})
+	}
+}
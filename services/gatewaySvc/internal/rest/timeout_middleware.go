@@ -0,0 +1,107 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// RequestTimeouts bounds how long the gateway waits on a request before it
+// cancels the downstream calls and answers 504. Zero disables the bound.
+type RequestTimeouts struct {
+	// Default applies to every route not listed in longRunningRoutes
+	Default time.Duration
+	// Long applies to exports, supplier syncs and other slow routes
+	Long time.Duration
+}
+
+// longRunningRoutes are route prefixes that get RequestTimeouts.Long
+var longRunningRoutes = []string{
+	"/api/v1/products/export",
+	"/api/v1/suppliers/:id/sync/",
+	"/api/v1/suppliers/:id/test-connection",
+}
+
+// timeoutFor returns the timeout of a matched route
+func (t RequestTimeouts) timeoutFor(route string) time.Duration {
+	for _, prefix := range longRunningRoutes {
+		if strings.HasPrefix(route, prefix) {
+			return t.Long
+		}
+	}
+	return t.Default
+}
+
+// timeoutMiddleware puts a deadline on the request context. Handlers pass
+// c.Request.Context() to the service clients, so the deadline reaches the
+// gRPC calls and aborts them when it passes. A handler that then fails with a
+// server error, or writes nothing, is answered with 504 instead.
+func timeoutMiddleware(timeouts RequestTimeouts, logger *zap.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		timeout := timeouts.timeoutFor(c.FullPath())
+		if timeout <= 0 {
+			c.Next()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+		c.Writer = &timeoutWriter{ResponseWriter: c.Writer, ctx: ctx}
+
+		c.Next()
+
+		if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return
+		}
+		logger.Warn("Request timed out",
+			zap.String("path", c.Request.URL.Path),
+			zap.Duration("timeout", timeout),
+		)
+		if !c.Writer.Written() {
+			c.AbortWithStatusJSON(http.StatusGatewayTimeout, gin.H{"error": timeoutMessage})
+		}
+	}
+}
+
+const timeoutMessage = "request timed out"
+
+// timeoutWriter turns a server error written after the request deadline
+// passed into a 504, replacing the handler's body
+type timeoutWriter struct {
+	gin.ResponseWriter
+	ctx      context.Context
+	timedOut bool
+	replaced bool
+}
+
+func (w *timeoutWriter) WriteHeader(code int) {
+	if code >= http.StatusInternalServerError && errors.Is(w.ctx.Err(), context.DeadlineExceeded) {
+		w.timedOut = true
+		code = http.StatusGatewayTimeout
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *timeoutWriter) Write(data []byte) (int, error) {
+	if !w.timedOut {
+		return w.ResponseWriter.Write(data)
+	}
+	if !w.replaced {
+		w.replaced = true
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		if _, err := w.ResponseWriter.Write([]byte(`{"error":"` + timeoutMessage + `"}`)); err != nil {
+			return 0, err
+		}
+	}
+	return len(data), nil
+}
+
+func (w *timeoutWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
@@ -0,0 +1,65 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/services"
+)
+
+// hangingProductService blocks until the request context is done, like a
+// hung backend, and reports the context error it was released with
+type hangingProductService struct {
+	services.ProductService
+	released chan error
+}
+
+func (f *hangingProductService) GetProductByID(ctx context.Context, id string) (interface{}, error) {
+	<-ctx.Done()
+	f.released <- ctx.Err()
+	return nil, ctx.Err()
+}
+
+func TestSlowBackendTimesOutWith504(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	products := &hangingProductService{released: make(chan error, 1)}
+	s := NewServer(products, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		RequestTimeouts{Default: 20 * time.Millisecond, Long: time.Minute}, testJWTSecret, "0", zap.NewNop())
+	s.SetupRoutes()
+
+	rec := serve(s, http.MethodGet, "/api/v1/products/product-1", "")
+
+	if rec.Code != http.StatusGatewayTimeout {
+		t.Fatalf("status = %d, want 504: %s", rec.Code, rec.Body.String())
+	}
+	select {
+	case err := <-products.released:
+		if err != context.DeadlineExceeded {
+			t.Fatalf("downstream context err = %v, want deadline exceeded", err)
+		}
+	default:
+		t.Fatal("the downstream call was never cancelled")
+	}
+}
+
+func TestRequestTimeoutsPerRoute(t *testing.T) {
+	timeouts := RequestTimeouts{Default: time.Second, Long: time.Minute}
+
+	tests := map[string]time.Duration{
+		"/api/v1/products/:id":                  time.Second,
+		"/api/v1/products/export":               time.Minute,
+		"/api/v1/suppliers/:id/sync/products":   time.Minute,
+		"/api/v1/suppliers/:id/test-connection": time.Minute,
+		"/api/v1/orders":                        time.Second,
+	}
+	for route, want := range tests {
+		if got := timeouts.timeoutFor(route); got != want {
+			t.Errorf("timeoutFor(%s) = %v, want %v", route, got, want)
+		}
+	}
+}
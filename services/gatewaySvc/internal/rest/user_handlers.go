@@ -5,6 +5,10 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
 )
 
 // UserRegisterRequest represents the register request body
@@ -22,6 +26,11 @@ type UserLoginRequest struct {
 	Password string `json:"password" binding:"required"`
 }
 
+// RefreshTokenRequest represents the token refresh request body
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
 // UpdateProfileRequest represents the profile update request body
 type UpdateProfileRequest struct {
 	FirstName string `json:"firstName" binding:"required"`
@@ -47,6 +56,12 @@ type AddressRequest struct {
 	IsDefault  bool   `json:"isDefault"`
 }
 
+// BulkAddressRequest represents a bulk address import. Entries are validated
+// individually by the user service so that valid ones are still created.
+type BulkAddressRequest struct {
+	Addresses []AddressRequest `json:"addresses" binding:"required,min=1"`
+}
+
 // registerUser handles user registration
 func (s *Server) registerUser(c *gin.Context) {
 	var req UserRegisterRequest
@@ -103,6 +118,31 @@ func (s *Server) loginUser(c *gin.Context) {
 	respondWithSuccess(c, http.StatusOK, result)
 }
 
+// refreshToken exchanges an unexpired refresh token for a new access token
+func (s *Server) refreshToken(c *gin.Context) {
+	var req RefreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	result, err := s.userSvc.RefreshToken(c.Request.Context(), req.RefreshToken)
+	if err != nil {
+		if respondIfUnavailable(c, err) {
+			return
+		}
+		s.logger.Debug("Token refresh failed", zap.Error(err))
+		if status.Code(err) == codes.PermissionDenied {
+			respondWithError(c, http.StatusForbidden, "Account is deactivated")
+			return
+		}
+		respondWithError(c, http.StatusUnauthorized, "Invalid or expired token")
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, result)
+}
+
 // getCurrentUser returns the current authenticated user
 func (s *Server) getCurrentUser(c *gin.Context) {
 	userID, _ := c.Get("userID")
@@ -222,6 +262,44 @@ func (s *Server) createUserAddress(c *gin.Context) {
 	respondWithSuccess(c, http.StatusCreated, address)
 }
 
+// bulkCreateUserAddresses imports several addresses for the current user
+func (s *Server) bulkCreateUserAddresses(c *gin.Context) {
+	userID, _ := c.Get("userID")
+	userIDStr, ok := userID.(string)
+	if !ok {
+		respondWithError(c, http.StatusUnauthorized, "Invalid user ID")
+		return
+	}
+
+	var req BulkAddressRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondWithError(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	inputs := make([]models.UserAddressInput, len(req.Addresses))
+	for i, a := range req.Addresses {
+		inputs[i] = models.UserAddressInput{
+			Name:       a.Name,
+			Street:     a.Street,
+			City:       a.City,
+			State:      a.State,
+			PostalCode: a.PostalCode,
+			Country:    a.Country,
+			Phone:      a.Phone,
+			IsDefault:  a.IsDefault,
+		}
+	}
+
+	result, err := s.userSvc.BulkCreateUserAddresses(c.Request.Context(), userIDStr, inputs)
+	if err != nil {
+		genericErrorHandler(c, err, s.logger, "Bulk create user addresses")
+		return
+	}
+
+	respondWithSuccess(c, http.StatusCreated, result)
+}
+
 // getUserDefaultAddress returns the default address for the current user
 func (s *Server) getUserDefaultAddress(c *gin.Context) {
 	userID, _ := c.Get("userID")
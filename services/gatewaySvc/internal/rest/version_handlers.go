@@ -0,0 +1,15 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// getVersions returns the build of the gateway and of every backend service.
+// Backends that cannot be reached are listed with an error, so the endpoint
+// still answers while one of them is down.
+func (s *Server) getVersions(c *gin.Context) {
+	report := s.versions.Report(c.Request.Context())
+	respondWithSuccess(c, http.StatusOK, report)
+}
@@ -0,0 +1,112 @@
+package rest
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/version"
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/versions"
+)
+
+// startVersionedBackend serves a health service that reports the build, as
+// every backend does, and returns its address
+func startVersionedBackend(t *testing.T) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, version.NewHealthServer(health.NewServer()))
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+	return listener.Addr().String()
+}
+
+// unusedAddr returns an address nothing listens on
+func unusedAddr(t *testing.T) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+	return addr
+}
+
+// getVersionReport fetches /api/v1/version from a server whose collector asks
+// the backends at addrs
+func getVersionReport(t *testing.T, addrs map[string]string) versions.Report {
+	t.Helper()
+	collector, err := versions.NewCollector(addrs, 2*time.Second, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(collector.Close)
+
+	gin.SetMode(gin.TestMode)
+	s := NewServer(nil, nil, nil, nil, nil, nil, nil, nil, collector, nil,
+		RequestTimeouts{Default: 5 * time.Second, Long: 5 * time.Second}, testJWTSecret, "0", zap.NewNop())
+	s.SetupRoutes()
+
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/version", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", rec.Code, rec.Body)
+	}
+
+	var body struct {
+		Data versions.Report `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	return body.Data
+}
+
+func TestVersionHandlerReportsInjectedBuild(t *testing.T) {
+	oldVersion, oldCommit, oldBuildDate := version.Version, version.Commit, version.BuildDate
+	version.Version, version.Commit, version.BuildDate = "1.4.0", "abc1234", "2026-10-01T12:00:00Z"
+	t.Cleanup(func() {
+		version.Version, version.Commit, version.BuildDate = oldVersion, oldCommit, oldBuildDate
+	})
+
+	report := getVersionReport(t, map[string]string{
+		"product": startVersionedBackend(t),
+		"order":   unusedAddr(t),
+	})
+
+	if g := report.Gateway; g.Version != "1.4.0" || g.Commit != "abc1234" || g.BuildDate != "2026-10-01T12:00:00Z" {
+		t.Errorf("gateway = %+v, want the injected build", g)
+	}
+	product := report.Services["product"]
+	if product == nil || product.Version != "1.4.0" || product.Commit != "abc1234" || product.Error != "" {
+		t.Errorf("product = %+v, want the injected build", product)
+	}
+	// A backend that is down is listed rather than failing the report
+	if order := report.Services["order"]; order == nil || order.Error != "unavailable" {
+		t.Errorf("order = %+v, want it listed as unavailable", order)
+	}
+}
+
+func TestVersionHandlerDefaults(t *testing.T) {
+	report := getVersionReport(t, map[string]string{"product": startVersionedBackend(t)})
+
+	if g := report.Gateway; g.Version != "dev" || g.Commit != "unknown" || g.BuildDate != "unknown" {
+		t.Errorf("gateway = %+v, want dev/unknown/unknown without -ldflags", g)
+	}
+	if product := report.Services["product"]; product == nil || product.Version != "dev" {
+		t.Errorf("product = %+v, want the default build", product)
+	}
+}
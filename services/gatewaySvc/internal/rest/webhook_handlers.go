@@ -0,0 +1,81 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// listWebhookDeliveries returns per-subscriber order webhook delivery status (admin only)
+func (s *Server) listWebhookDeliveries(c *gin.Context) {
+	limit, err := parseIntParam(c.DefaultQuery("limit", "50"), 50)
+	if err != nil {
+		respondWithError(c, http.StatusBadRequest, "Invalid limit parameter")
+		return
+	}
+
+	offset, err := parseIntParam(c.DefaultQuery("offset", "0"), 0)
+	if err != nil {
+		respondWithError(c, http.StatusBadRequest, "Invalid offset parameter")
+		return
+	}
+
+	deliveries, err := s.orderSvc.ListWebhookDeliveries(
+		c.Request.Context(),
+		c.Query("subscriberId"),
+		c.Query("status"),
+		limit,
+		offset,
+	)
+	if err != nil {
+		genericErrorHandler(c, err, s.logger, "List webhook deliveries")
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, deliveries)
+}
+
+// listDeadLetteredWebhooks returns webhook deliveries that exhausted their retries (admin only)
+func (s *Server) listDeadLetteredWebhooks(c *gin.Context) {
+	limit, err := parseIntParam(c.DefaultQuery("limit", "50"), 50)
+	if err != nil {
+		respondWithError(c, http.StatusBadRequest, "Invalid limit parameter")
+		return
+	}
+
+	offset, err := parseIntParam(c.DefaultQuery("offset", "0"), 0)
+	if err != nil {
+		respondWithError(c, http.StatusBadRequest, "Invalid offset parameter")
+		return
+	}
+
+	deliveries, err := s.orderSvc.ListDeadLetteredWebhooks(
+		c.Request.Context(),
+		c.Query("subscriberId"),
+		limit,
+		offset,
+	)
+	if err != nil {
+		genericErrorHandler(c, err, s.logger, "List dead-lettered webhooks")
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, deliveries)
+}
+
+// replayDeadLetteredWebhook retries a dead-lettered webhook delivery (admin only)
+func (s *Server) replayDeadLetteredWebhook(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		respondWithError(c, http.StatusBadRequest, "Delivery ID is required")
+		return
+	}
+
+	result, err := s.orderSvc.ReplayDeadLetteredWebhook(c.Request.Context(), id)
+	if err != nil {
+		genericErrorHandler(c, err, s.logger, "Replay webhook delivery")
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, result)
+}
@@ -2,16 +2,22 @@ package server
 
 import (
 	"context"
-	"os"
-	"os/signal"
-	"syscall"
+	"errors"
+	"fmt"
+	"net/http"
 	"time"
 
 	"go.uber.org/zap"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/shutdown"
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/availability"
 	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/config"
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/dashboard"
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/media"
 	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/rest"
 	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/services"
+	"github.com/leonvanderhaeghen/stockplatform/services/gatewaySvc/internal/versions"
 )
 
 // ServiceClients holds all service client instances
@@ -26,9 +32,12 @@ type ServiceClients struct {
 
 // Server holds the REST server and its dependencies
 type Server struct {
-	restServer *rest.Server
-	config     *config.Config
-	logger     *zap.Logger
+	restServer           *rest.Server
+	availabilityConsumer *availability.Consumer
+	versionCollector     *versions.Collector
+	shutdown             *shutdown.Coordinator
+	config               *config.Config
+	logger               *zap.Logger
 }
 
 // New creates a new server instance
@@ -47,6 +56,62 @@ func (s *Server) Initialize() error {
 		return err
 	}
 
+	// Initialize the availability cache used to badge product listings
+	availabilityCache := availability.NewCache(
+		inventoryAvailabilityLoader(serviceClients.InventorySvc),
+		s.config.Availability.CacheTTL,
+		s.logger,
+	)
+	availabilityCache.OnRestock(backInStockNotifier(serviceClients.InventorySvc, s.logger))
+	if len(s.config.Availability.KafkaBrokers) > 0 {
+		consumer, err := availability.NewConsumer(availability.ConsumerConfig{
+			Brokers: s.config.Availability.KafkaBrokers,
+			Topic:   s.config.Availability.Topic,
+			GroupID: availability.InstanceGroupID(s.config.Availability.ConsumerGroup),
+		}, availabilityCache, s.logger)
+		if err != nil {
+			return err
+		}
+		consumer.Start()
+		s.availabilityConsumer = consumer
+	} else {
+		s.logger.Info("No Kafka brokers configured, availability cache will rely on TTL expiry only")
+	}
+
+	// Initialize the admin dashboard aggregator
+	dashboardAggregator := dashboard.NewAggregator(
+		dashboardSources(serviceClients),
+		s.config.Dashboard.CacheTTL,
+		s.config.Dashboard.BackendTimeout,
+		s.logger,
+	)
+
+	// Initialize the collector behind the version endpoint
+	versionCollector, err := versions.NewCollector(map[string]string{
+		"product":   s.config.Services.ProductAddr,
+		"inventory": s.config.Services.InventoryAddr,
+		"order":     s.config.Services.OrderAddr,
+		"user":      s.config.Services.UserAddr,
+		"supplier":  s.config.Services.SupplierAddr,
+		"store":     s.config.Services.StoreAddr,
+	}, s.config.Dashboard.BackendTimeout, s.logger)
+	if err != nil {
+		return err
+	}
+	s.versionCollector = versionCollector
+
+	// The image proxy fetches only from allow-listed hosts, so it stays off
+	// until some are configured
+	var mediaProxy *media.Proxy
+	if len(s.config.Media.AllowedHosts) > 0 {
+		mediaProxy = media.NewProxy(media.Config{
+			AllowedHosts: s.config.Media.AllowedHosts,
+			Timeout:      s.config.Media.FetchTimeout,
+			MaxBytes:     s.config.Media.MaxBytes,
+			CacheMaxAge:  s.config.Media.CacheMaxAge,
+		}, s.logger)
+	}
+
 	// Initialize REST server
 	s.restServer = rest.NewServer(
 		serviceClients.ProductSvc,
@@ -55,6 +120,14 @@ func (s *Server) Initialize() error {
 		serviceClients.UserSvc,
 		serviceClients.SupplierSvc,
 		serviceClients.StoreSvc,
+		availabilityCache,
+		dashboardAggregator,
+		versionCollector,
+		mediaProxy,
+		rest.RequestTimeouts{
+			Default: s.config.Timeouts.Default,
+			Long:    s.config.Timeouts.Long,
+		},
 		s.config.JWT.Secret,
 		s.config.Server.Port,
 		s.logger,
@@ -62,68 +135,83 @@ func (s *Server) Initialize() error {
 
 	s.restServer.SetupRoutes()
 
+	s.shutdown = shutdown.New(s.config.Server.ShutdownDrainTimeout, s.logger)
+	s.shutdown.AddWorker("jobs", s.restServer.StopJobs)
+	if s.availabilityConsumer != nil {
+		consumer := s.availabilityConsumer
+		s.shutdown.AddWorker("availability_consumer", func() {
+			if err := consumer.Close(); err != nil {
+				s.logger.Error("Failed to close availability consumer", zap.Error(err))
+			}
+		})
+	}
+	s.shutdown.AddServer("rest", s.restServer.Shutdown)
+	s.shutdown.AddCloser("version_collector", func() error {
+		s.versionCollector.Close()
+		return nil
+	})
+
 	s.logger.Info("Server initialized successfully")
 	return nil
 }
 
-// Start starts the REST server with graceful shutdown
+// Start starts the REST server and blocks until the process is told to
+// stop, then shuts down through the coordinator
 func (s *Server) Start() error {
 	// Start server in a goroutine
 	go func() {
 		s.logger.Info("Starting REST server")
-		if err := s.restServer.Start(); err != nil {
+		if err := s.restServer.Start(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			s.logger.Fatal("Failed to start REST server", zap.Error(err))
 		}
 	}()
 
-	// Wait for interrupt signal to gracefully shutdown the server
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
-
-	s.logger.Info("Shutting down server...")
-
-	// Create a deadline to wait for
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	// Gracefully shutdown
-	return s.restServer.Shutdown(ctx)
+	s.shutdown.ShutdownOnSignal()
+	return nil
 }
 
-// Shutdown gracefully shuts down the server
+// Shutdown lets running jobs finish and stops consuming stock events, then
+// shuts down the REST server within ctx and closes the backend connections
 func (s *Server) Shutdown(ctx context.Context) error {
-	return s.restServer.Shutdown(ctx)
+	return s.shutdown.Shutdown(ctx)
 }
 
 // initServices initializes all service clients
 func (s *Server) initServices() (*ServiceClients, error) {
-	productSvc, err := services.NewProductService(s.config.Services.ProductAddr, s.logger)
+	resilienceCfg := services.ResilienceConfig{
+		MaxAttempts:      s.config.Resilience.MaxAttempts,
+		InitialBackoff:   s.config.Resilience.InitialBackoff,
+		MaxBackoff:       s.config.Resilience.MaxBackoff,
+		FailureThreshold: s.config.Resilience.FailureThreshold,
+		OpenTimeout:      s.config.Resilience.OpenTimeout,
+	}
+
+	productSvc, err := services.NewProductService(s.config.Services.ProductAddr, resilienceCfg, s.logger)
 	if err != nil {
 		return nil, err
 	}
 
-	inventorySvc, err := services.NewInventoryService(s.config.Services.InventoryAddr, s.logger)
+	inventorySvc, err := services.NewInventoryService(s.config.Services.InventoryAddr, resilienceCfg, s.logger)
 	if err != nil {
 		return nil, err
 	}
 
-	orderSvc, err := services.NewOrderService(s.config.Services.OrderAddr, s.logger)
+	orderSvc, err := services.NewOrderService(s.config.Services.OrderAddr, resilienceCfg, s.logger)
 	if err != nil {
 		return nil, err
 	}
 
-	userSvc, err := services.NewUserService(s.config.Services.UserAddr, s.logger)
+	userSvc, err := services.NewUserService(s.config.Services.UserAddr, resilienceCfg, s.logger)
 	if err != nil {
 		return nil, err
 	}
 
-	supplierSvc, err := services.NewSupplierService(s.config.Services.SupplierAddr, s.logger)
+	supplierSvc, err := services.NewSupplierService(s.config.Services.SupplierAddr, resilienceCfg, s.logger)
 	if err != nil {
 		return nil, err
 	}
 
-	storeSvc, err := services.NewStoreService(s.config.Services.StoreAddr, s.logger)
+	storeSvc, err := services.NewStoreService(s.config.Services.StoreAddr, resilienceCfg, s.logger)
 	if err != nil {
 		return nil, err
 	}
@@ -137,3 +225,63 @@ func (s *Server) initServices() (*ServiceClients, error) {
 		StoreSvc:     storeSvc,
 	}, nil
 }
+
+// inventoryAvailabilityLoader loads product availability from the inventory service
+func inventoryAvailabilityLoader(inventorySvc services.InventoryService) availability.Loader {
+	return func(ctx context.Context, productID string) (*availability.Availability, error) {
+		resp, err := inventorySvc.GetInventoryItemsByProductID(ctx, productID)
+		if err != nil {
+			return nil, err
+		}
+
+		item, ok := resp.(*models.InventoryItem)
+		if !ok || item == nil {
+			return nil, fmt.Errorf("unexpected inventory response for product %s", productID)
+		}
+
+		return availability.NewAvailability(productID, item.Available, item.ReorderAt, time.Now()), nil
+	}
+}
+
+// dashboardSources builds the dashboard KPI sources on top of the backend services
+func dashboardSources(clients *ServiceClients) dashboard.Sources {
+	return dashboard.Sources{
+		Orders: func(ctx context.Context, from, to time.Time) (int64, float64, error) {
+			summary, err := clients.OrderSvc.GetOrderSummary(ctx, from, to)
+			if err != nil {
+				return 0, 0, err
+			}
+			return summary.OrderCount, summary.Revenue, nil
+		},
+		LowStock: func(ctx context.Context) (int64, error) {
+			return clients.InventorySvc.CountLowStockItems(ctx, "")
+		},
+		ActiveUsers: func(ctx context.Context) (int64, error) {
+			return clients.UserSvc.CountUsers(ctx, "", true)
+		},
+	}
+}
+
+// backInStockNotifier asks the inventory service to queue back-in-stock
+// notifications when a product returns to stock
+func backInStockNotifier(inventorySvc services.InventoryService, logger *zap.Logger) availability.RestockHandler {
+	return func(productID string, available int32) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		count, err := inventorySvc.NotifyBackInStock(ctx, productID, available)
+		if err != nil {
+			logger.Error("Failed to notify back-in-stock subscribers",
+				zap.String("product_id", productID),
+				zap.Error(err),
+			)
+			return
+		}
+		if count > 0 {
+			logger.Info("Queued back-in-stock notifications",
+				zap.String("product_id", productID),
+				zap.Int("count", count),
+			)
+		}
+	}
+}
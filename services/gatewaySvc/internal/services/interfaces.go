@@ -3,6 +3,8 @@ package services
 import (
 	"context"
 	"time"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
 )
 
 // ProductService defines the interface for product operations
@@ -15,6 +17,12 @@ type ProductService interface {
 	
 	// Create a new product category
 	CreateCategory(ctx context.Context, name, description, parentID string, isActive bool) (interface{}, error)
+
+	// Get a category with its attribute schema
+	GetCategory(ctx context.Context, id string) (*models.Category, error)
+
+	// Replace the attribute schema of a category
+	SetCategoryAttributes(ctx context.Context, id string, attributes []models.CategoryAttribute) (*models.Category, error)
 	
 	// Get a product by ID
 	GetProductByID(ctx context.Context, id string) (interface{}, error)
@@ -31,10 +39,11 @@ type ProductService interface {
 		stockQty, lowStockAt int32,
 		imageURLs, videoURLs []string,
 		metadata map[string]string,
+		orderQty models.OrderQuantityLimits,
 	) (interface{}, error)
 	
-	// Update an existing product
-	// Note: This is not fully implemented in the gRPC service
+	// Update an existing product. A non-zero version must be the product's
+	// current one, otherwise the update fails with codes.Aborted.
 	UpdateProduct(
 		ctx context.Context,
 		id, name, description, sku string,
@@ -43,17 +52,32 @@ type ProductService interface {
 		active bool,
 		images []string,
 		attributes map[string]string,
+		version int64,
 	) error
 	
 	// Delete a product
 	// Note: This is not implemented in the gRPC service
 	DeleteProduct(ctx context.Context, id string) error
+
+	// Rebuild the product text search index, returning the number of products indexed
+	RebuildSearchIndex(ctx context.Context) (int64, error)
+
+	// Export the products matching a filter; suppliers only get their own products
+	ExportProducts(ctx context.Context, format string, filter models.ProductExportFilter) (*models.ProductExport, error)
+
+	// Review a product as the current user; the review waits for moderation
+	CreateProductReview(ctx context.Context, productID string, rating int32, text string) (*models.ProductReview, error)
+	// List a page of a product's reviews; unapproved ones are for staff only
+	ListProductReviews(ctx context.Context, productID string, includeUnapproved bool, limit, offset int) (*models.ListProductReviewsResponse, error)
+	// Approve or reject a review
+	ModerateProductReview(ctx context.Context, reviewID string, approved bool) (*models.ProductReview, error)
 }
 
 // InventoryService defines the interface for inventory operations
 type InventoryService interface {
-	// List inventory items with filtering options
-	ListInventory(ctx context.Context, location string, lowStock bool, limit, offset int) (interface{}, error)
+	// List a page of inventory items, optionally at one location, in one stock status
+	// (in_stock, low_stock, out_of_stock) and carrying all of the given tags
+	ListInventory(ctx context.Context, location, stockStatus string, tags []string, updatedSince time.Time, limit, offset int) (*models.ListInventoryResponse, error)
 	
 	// Get an inventory item by ID
 	GetInventoryItemByID(ctx context.Context, id string) (interface{}, error)
@@ -86,16 +110,32 @@ type InventoryService interface {
 	
 	// GetInventoryReservations gets inventory reservations with optional filters
 	GetInventoryReservations(ctx context.Context, orderId, productId, status string, limit, offset int) (interface{}, error)
+	// GetReservationsForOrder gets the reservations held for an order across all locations
+	GetReservationsForOrder(ctx context.Context, orderID string) ([]*models.InventoryReservation, error)
+	// ReconcileReservations releases reservations of cancelled, completed or missing orders; dryRun only reports them
+	ReconcileReservations(ctx context.Context, dryRun bool) (*models.ReservationReconcileReport, error)
+	// SubscribeBackInStock subscribes a user to a back-in-stock alert; subscribing twice is a no-op
+	SubscribeBackInStock(ctx context.Context, userID, productID string) (*models.BackInStockSubscription, error)
+	// UnsubscribeBackInStock removes a user's back-in-stock alert for a product
+	UnsubscribeBackInStock(ctx context.Context, userID, productID string) error
+	// NotifyBackInStock queues notifications for subscribers of a product that is available again
+	NotifyBackInStock(ctx context.Context, productID string, available int32) (int, error)
 	// CreateInventoryReservation creates a new inventory reservation (supports POS source tracking)
 	CreateInventoryReservation(ctx context.Context, productID string, quantity int32, orderID string) (interface{}, error)
-	// GetLowStockItems gets inventory items that are low in stock with threshold and location filtering
-	GetLowStockItems(ctx context.Context, location string, threshold, limit, offset int) (interface{}, error)
+	// GetLowStockItems gets a page of inventory items at or below their reorder point, optionally at one location
+	GetLowStockItems(ctx context.Context, location string, limit, offset int) (*models.ListInventoryResponse, error)
+	// ListDueCounts gets a page of items whose next count date is on or before asOf (zero means now), optionally at one location
+	ListDueCounts(ctx context.Context, location string, asOf time.Time, limit, offset int) (*models.ListInventoryResponse, error)
+	// UpdateInventoryTags adds and removes tags on items at a location; no item IDs means every item there
+	UpdateInventoryTags(ctx context.Context, locationID string, itemIDs, add, remove []string) (int64, error)
+	// CountLowStockItems counts inventory items at or below their reorder point; an empty location counts all locations
+	CountLowStockItems(ctx context.Context, location string) (int64, error)
 }
 
 // StoreService defines the interface for store operations
 type StoreService interface {
-	// ListStores lists all stores with pagination
-	ListStores(ctx context.Context, limit, offset int) (interface{}, error)
+	// ListStores lists the stores matching filter with pagination
+	ListStores(ctx context.Context, filter models.StoreFilter, limit, offset int) (interface{}, error)
 	// GetStore retrieves a store by ID
 	GetStore(ctx context.Context, id string) (interface{}, error)
 	// CreateStore creates a new store
@@ -121,6 +161,12 @@ type OrderService interface {
 	
 	// Update order status (admin/staff)
 	UpdateOrderStatus(ctx context.Context, orderID, status, description string) error
+
+	// Update the status of many orders at once, reporting the outcome per order (admin/staff)
+	BulkUpdateOrderStatus(ctx context.Context, orderIDs []string, status string) ([]*models.OrderStatusUpdateResult, error)
+	
+	// Append a note to an order or one of its items, returning the updated order (admin/staff)
+	AddOrderNote(ctx context.Context, orderID, text, productID string) (*models.Order, error)
 	
 	// Add payment to an order (admin/staff)
 	AddOrderPayment(ctx context.Context, orderID string, amount float64, paymentType, reference, status string, date time.Time, description string, metadata map[string]string) error
@@ -130,6 +176,36 @@ type OrderService interface {
 	
 	// Cancel an order (admin/staff)
 	CancelOrder(ctx context.Context, orderID, reason string) error
+
+	// List webhook delivery status (admin)
+	ListWebhookDeliveries(ctx context.Context, subscriberID, status string, limit, offset int) (interface{}, error)
+
+	// List dead-lettered webhook deliveries (admin)
+	ListDeadLetteredWebhooks(ctx context.Context, subscriberID string, limit, offset int) (interface{}, error)
+
+	// Replay a dead-lettered webhook delivery (admin)
+	ReplayDeadLetteredWebhook(ctx context.Context, id string) (interface{}, error)
+
+	// Open a return for items of one of the user's orders
+	CreateUserReturn(ctx context.Context, orderID, userID string, lines []*models.ReturnLine, reason string) (interface{}, error)
+
+	// List the returns of one of the user's orders
+	GetUserOrderReturns(ctx context.Context, orderID, userID string) (interface{}, error)
+
+	// List the returns of an order (admin/staff)
+	ListOrderReturns(ctx context.Context, orderID string) (interface{}, error)
+
+	// Get a return by ID (admin/staff)
+	GetReturn(ctx context.Context, id string) (interface{}, error)
+
+	// Move a return through its workflow; receiving it restocks inventory (admin/staff)
+	UpdateReturnStatus(ctx context.Context, id, status string, conditions map[string]string) (interface{}, error)
+
+	// Count the non-cancelled orders created in [from, to) and sum their revenue (admin)
+	GetOrderSummary(ctx context.Context, from, to time.Time) (*models.OrderSummary, error)
+
+	// List everything that happened to an order, oldest first (admin/staff)
+	GetOrderTimeline(ctx context.Context, orderID string) ([]*models.OrderTimelineEntry, error)
 }
 
 // UserService defines the interface for user operations
@@ -138,6 +214,8 @@ type UserService interface {
 	RegisterUser(ctx context.Context, email, password, firstName, lastName, role string) (interface{}, error)
 	// Authenticate a user
 	AuthenticateUser(ctx context.Context, email, password string) (interface{}, error)
+	// Exchange a refresh token for a new access token
+	RefreshToken(ctx context.Context, refreshToken string) (interface{}, error)
 	// Get a user by ID
 	GetUserByID(ctx context.Context, userID string) (interface{}, error)
 	// Update user profile
@@ -148,6 +226,8 @@ type UserService interface {
 	GetUserAddresses(ctx context.Context, userID string) (interface{}, error)
 	// Create a new address for a user
 	CreateUserAddress(ctx context.Context, userID, name, street, city, state, postalCode, country, phone string, isDefault bool) (interface{}, error)
+	// Create several addresses for a user, reporting the outcome of each
+	BulkCreateUserAddresses(ctx context.Context, userID string, addresses []models.UserAddressInput) (interface{}, error)
 	// Get default address for a user
 	GetUserDefaultAddress(ctx context.Context, userID string) (interface{}, error)
 	// Update an address for a user
@@ -162,6 +242,8 @@ type UserService interface {
 	ActivateUser(ctx context.Context, userID string) error
 	// Deactivate a user (admin only)
 	DeactivateUser(ctx context.Context, userID string) error
+	// Count users, optionally only those with a role or that are active (admin only)
+	CountUsers(ctx context.Context, role string, activeOnly bool) (int64, error)
 }
 
 // SupplierService defines the interface for supplier operations
@@ -170,10 +252,10 @@ type SupplierService interface {
 	CreateSupplier(ctx context.Context, name, contactPerson, email, phone, address, city, state, country, postalCode, taxID, website, currency, paymentTerms string, leadTimeDays int32, metadata map[string]string) (interface{}, error)
 	// Get a supplier by ID
 	GetSupplier(ctx context.Context, id string) (interface{}, error)
-	// Update an existing supplier
-	UpdateSupplier(ctx context.Context, id, name, contactPerson, email, phone, address, city, state, country, postalCode, taxID, website, currency, paymentTerms string, leadTimeDays int32, metadata map[string]string) (interface{}, error)
+	// Update an existing supplier, applying only the fields set on update
+	UpdateSupplier(ctx context.Context, id string, update *models.SupplierUpdate) (interface{}, error)
 	// Delete a supplier
-	DeleteSupplier(ctx context.Context, id string) error
+	DeleteSupplier(ctx context.Context, id, reassignTo string, force bool) (int64, error)
 	// List suppliers with pagination and search
 	ListSuppliers(ctx context.Context, page, pageSize int32, search string) (interface{}, error)
 	// Close closes the connection to the supplier service
@@ -186,9 +268,11 @@ type SupplierService interface {
 	// TestAdapterConnection tests the connection to a supplier's system using the specified adapter
 	TestAdapterConnection(ctx context.Context, adapterName string, config map[string]string) error
 	// SyncProducts synchronizes products from a supplier using their configured adapter
-	SyncProducts(ctx context.Context, supplierID string, fullSync, dryRun bool, batchSize int32) (string, error)
+	SyncProducts(ctx context.Context, supplierID string, fullSync, dryRun bool, batchSize int32, since *time.Time) (string, error)
 	// SyncInventory synchronizes inventory from a supplier using their configured adapter
-	SyncInventory(ctx context.Context, supplierID string, fullSync, dryRun bool, batchSize int32) (string, error)
+	SyncInventory(ctx context.Context, supplierID string, fullSync, dryRun bool, batchSize int32, since *time.Time) (string, error)
+	// ValidateFeed checks a supplier's product feed without syncing anything
+	ValidateFeed(ctx context.Context, supplierID string, opts models.FeedValidationOptions) (*models.FeedValidationReport, error)
 }
 
 // POSService defines the interface for point-of-sale operations
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
 	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
 	inventoryclient "github.com/leonvanderhaeghen/stockplatform/pkg/clients/inventory"
@@ -18,10 +19,13 @@ type InventoryServiceImpl struct {
 }
 
 // NewInventoryService creates a new instance of InventoryServiceImpl
-func NewInventoryService(inventoryServiceAddr string, logger *zap.Logger) (InventoryService, error) {
+func NewInventoryService(inventoryServiceAddr string, resilienceCfg ResilienceConfig, logger *zap.Logger) (InventoryService, error) {
 	// Create a gRPC client
 	// Note: NewInventoryClient doesn't take a logger parameter
-	invCfg := inventoryclient.Config{Address: inventoryServiceAddr}
+	invCfg := inventoryclient.Config{
+		Address:     inventoryServiceAddr,
+		DialOptions: []grpc.DialOption{newResilience("inventory", resilienceCfg, logger).dialOption()},
+	}
 	client, err := inventoryclient.New(invCfg, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create inventory client: %w", err)
@@ -33,31 +37,25 @@ func NewInventoryService(inventoryServiceAddr string, logger *zap.Logger) (Inven
 	}, nil
 }
 
-// ListInventory lists all inventory items with pagination
+// ListInventory lists a page of inventory items, optionally filtered by
+// location, stock status, tags and last update
 func (s *InventoryServiceImpl) ListInventory(
 	ctx context.Context,
-	location string,
-	lowStock bool,
+	location, stockStatus string,
+	tags []string,
+	updatedSince time.Time,
 	limit, offset int,
-) (interface{}, error) {
+) (*models.ListInventoryResponse, error) {
 	s.logger.Debug("ListInventory",
 		zap.String("location", location),
-		zap.Bool("lowStock", lowStock),
+		zap.String("stockStatus", stockStatus),
+		zap.Strings("tags", tags),
+		zap.Time("updatedSince", updatedSince),
 		zap.Int("limit", limit),
 		zap.Int("offset", offset),
 	)
 
-	if lowStock {
-		// Use GetLowStockItems if lowStock is requested
-		resp, err := s.client.GetLowStockItems(ctx, location, 10, limit, offset) // threshold = 10
-		if err != nil {
-			s.logger.Error("Failed to get low stock inventory", zap.Error(err))
-			return nil, fmt.Errorf("failed to get low stock inventory: %w", err)
-		}
-		return resp, nil
-	}
-
-	resp, err := s.client.ListInventory(ctx, int32(limit), int32(offset))
+	resp, err := s.client.ListInventoryFiltered(ctx, location, stockStatus, tags, updatedSince, int32(limit), int32(offset))
 	if err != nil {
 		s.logger.Error("Failed to list inventory", zap.Error(err))
 		return nil, fmt.Errorf("failed to list inventory: %w", err)
@@ -281,8 +279,8 @@ func (s *InventoryServiceImpl) CompletePickup(
 // All POS functionality has been consolidated into standard inventory endpoints
 
 // GetInventoryReservations gets inventory reservations with optional filters
-// Note: The inventory service doesn't currently have a method to list reservations,
-// so this returns an empty list as a placeholder
+// Note: The inventory service can only look reservations up by order, so
+// without an order ID this returns an empty list as a placeholder
 func (s *InventoryServiceImpl) GetInventoryReservations(
 	ctx context.Context,
 	orderId, productId, status string,
@@ -296,13 +294,145 @@ func (s *InventoryServiceImpl) GetInventoryReservations(
 		zap.Int("offset", offset),
 	)
 
-	// NOTE: The inventory service doesn't have a ListReservations method yet.
-	// This is a placeholder implementation that returns an empty list.
-	// FEATURE ENHANCEMENT: Add ListReservations method to inventory service for full reservation tracking
-	s.logger.Info("GetInventoryReservations called - returning empty list (method not implemented in inventory service)")
+	if orderId == "" {
+		// FEATURE ENHANCEMENT: Add ListReservations method to inventory service for full reservation tracking
+		s.logger.Info("GetInventoryReservations called without order ID - returning empty list")
+		return []*models.InventoryReservation{}, nil
+	}
+
+	reservations, err := s.GetReservationsForOrder(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]*models.InventoryReservation, 0, len(reservations))
+	for _, r := range reservations {
+		if productId != "" && r.ProductID != productId {
+			continue
+		}
+		if status != "" && r.Status != status {
+			continue
+		}
+		filtered = append(filtered, r)
+	}
+
+	if offset >= len(filtered) {
+		return []*models.InventoryReservation{}, nil
+	}
+	filtered = filtered[offset:]
+	if limit > 0 && limit < len(filtered) {
+		filtered = filtered[:limit]
+	}
+
+	return filtered, nil
+}
+
+// GetReservationsForOrder gets the reservations held for an order across all locations
+func (s *InventoryServiceImpl) GetReservationsForOrder(
+	ctx context.Context,
+	orderID string,
+) ([]*models.InventoryReservation, error) {
+	s.logger.Debug("GetReservationsForOrder",
+		zap.String("orderId", orderID),
+	)
+
+	reservations, err := s.client.GetReservationsForOrder(ctx, orderID)
+	if err != nil {
+		s.logger.Error("Failed to get reservations for order",
+			zap.String("orderId", orderID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get reservations for order: %w", err)
+	}
+
+	return reservations, nil
+}
+
+// ReconcileReservations releases reservations held for orders that no longer need them
+func (s *InventoryServiceImpl) ReconcileReservations(
+	ctx context.Context,
+	dryRun bool,
+) (*models.ReservationReconcileReport, error) {
+	s.logger.Debug("ReconcileReservations",
+		zap.Bool("dryRun", dryRun),
+	)
+
+	report, err := s.client.ReconcileReservations(ctx, dryRun)
+	if err != nil {
+		s.logger.Error("Failed to reconcile reservations",
+			zap.Bool("dryRun", dryRun),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to reconcile reservations: %w", err)
+	}
 
-	// Return empty reservations list as placeholder
-	return []interface{}{}, nil
+	return report, nil
+}
+
+// SubscribeBackInStock subscribes a user to a back-in-stock alert for a product
+func (s *InventoryServiceImpl) SubscribeBackInStock(
+	ctx context.Context,
+	userID, productID string,
+) (*models.BackInStockSubscription, error) {
+	s.logger.Debug("SubscribeBackInStock",
+		zap.String("userId", userID),
+		zap.String("productId", productID),
+	)
+
+	sub, err := s.client.SubscribeBackInStock(ctx, userID, productID)
+	if err != nil {
+		s.logger.Error("Failed to subscribe to back-in-stock alert",
+			zap.String("productId", productID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to subscribe to back-in-stock alert: %w", err)
+	}
+
+	return sub, nil
+}
+
+// UnsubscribeBackInStock removes a user's back-in-stock alert for a product
+func (s *InventoryServiceImpl) UnsubscribeBackInStock(
+	ctx context.Context,
+	userID, productID string,
+) error {
+	s.logger.Debug("UnsubscribeBackInStock",
+		zap.String("userId", userID),
+		zap.String("productId", productID),
+	)
+
+	if err := s.client.UnsubscribeBackInStock(ctx, userID, productID); err != nil {
+		s.logger.Error("Failed to unsubscribe from back-in-stock alert",
+			zap.String("productId", productID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to unsubscribe from back-in-stock alert: %w", err)
+	}
+
+	return nil
+}
+
+// NotifyBackInStock queues notifications for subscribers of a product that is available again
+func (s *InventoryServiceImpl) NotifyBackInStock(
+	ctx context.Context,
+	productID string,
+	available int32,
+) (int, error) {
+	s.logger.Debug("NotifyBackInStock",
+		zap.String("productId", productID),
+		zap.Int32("available", available),
+	)
+
+	count, err := s.client.NotifyBackInStock(ctx, productID, available)
+	if err != nil {
+		s.logger.Error("Failed to notify back-in-stock subscribers",
+			zap.String("productId", productID),
+			zap.Error(err),
+		)
+		return 0, fmt.Errorf("failed to notify back-in-stock subscribers: %w", err)
+	}
+
+	return count, nil
 }
 
 // CreateInventoryReservation creates a new inventory reservation (supports POS source tracking)
@@ -374,23 +504,21 @@ func (s *InventoryServiceImpl) CreateInventoryReservation(
 	return resp, nil
 }
 
-// GetLowStockItems gets inventory items that are low in stock with threshold and location filtering
+// GetLowStockItems gets a page of inventory items at or below their reorder point
 func (s *InventoryServiceImpl) GetLowStockItems(
 	ctx context.Context,
 	location string,
-	threshold, limit, offset int,
-) (interface{}, error) {
+	limit, offset int,
+) (*models.ListInventoryResponse, error) {
 	s.logger.Debug("GetLowStockItems",
 		zap.String("location", location),
-		zap.Int("threshold", threshold),
 		zap.Int("limit", limit),
 		zap.Int("offset", offset),
 	)
 
-	resp, err := s.client.GetLowStockItems(ctx, location, threshold, limit, offset)
+	resp, err := s.client.GetLowStockItems(ctx, location, limit, offset)
 	if err != nil {
 		s.logger.Error("Failed to get low stock items",
-			zap.Int("threshold", threshold),
 			zap.String("location", location),
 			zap.Error(err),
 		)
@@ -400,4 +528,71 @@ func (s *InventoryServiceImpl) GetLowStockItems(
 	return resp, nil
 }
 
+// ListDueCounts gets a page of inventory items due for counting as of asOf
+func (s *InventoryServiceImpl) ListDueCounts(
+	ctx context.Context,
+	location string,
+	asOf time.Time,
+	limit, offset int,
+) (*models.ListInventoryResponse, error) {
+	s.logger.Debug("ListDueCounts",
+		zap.String("location", location),
+		zap.Time("as_of", asOf),
+		zap.Int("limit", limit),
+		zap.Int("offset", offset),
+	)
 
+	resp, err := s.client.ListDueCounts(ctx, location, asOf, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list inventory items due for counting",
+			zap.String("location", location),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to list inventory items due for counting: %w", err)
+	}
+
+	return resp, nil
+}
+
+// CountLowStockItems counts inventory items at or below their reorder point
+func (s *InventoryServiceImpl) CountLowStockItems(ctx context.Context, location string) (int64, error) {
+	s.logger.Debug("CountLowStockItems",
+		zap.String("location", location),
+	)
+
+	count, err := s.client.CountLowStock(ctx, location)
+	if err != nil {
+		s.logger.Error("Failed to count low stock items",
+			zap.String("location", location),
+			zap.Error(err),
+		)
+		return 0, fmt.Errorf("failed to count low stock items: %w", err)
+	}
+
+	return count, nil
+}
+
+// UpdateInventoryTags adds and removes tags on inventory items at a location
+func (s *InventoryServiceImpl) UpdateInventoryTags(
+	ctx context.Context,
+	locationID string,
+	itemIDs, add, remove []string,
+) (int64, error) {
+	s.logger.Debug("UpdateInventoryTags",
+		zap.String("locationId", locationID),
+		zap.Int("itemCount", len(itemIDs)),
+		zap.Strings("add", add),
+		zap.Strings("remove", remove),
+	)
+
+	matched, err := s.client.UpdateInventoryTags(ctx, locationID, itemIDs, add, remove)
+	if err != nil {
+		s.logger.Error("Failed to update inventory tags",
+			zap.String("locationId", locationID),
+			zap.Error(err),
+		)
+		return 0, fmt.Errorf("failed to update inventory tags: %w", err)
+	}
+
+	return matched, nil
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
 	orderclient "github.com/leonvanderhaeghen/stockplatform/pkg/clients/order"
 	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
@@ -18,9 +19,12 @@ type OrderServiceImpl struct {
 }
 
 // NewOrderService creates a new instance of OrderServiceImpl
-func NewOrderService(orderServiceAddr string, logger *zap.Logger) (OrderService, error) {
+func NewOrderService(orderServiceAddr string, resilienceCfg ResilienceConfig, logger *zap.Logger) (OrderService, error) {
 	// Create a gRPC client via the new abstraction
-	ordCfg := orderclient.Config{Address: orderServiceAddr}
+	ordCfg := orderclient.Config{
+		Address:     orderServiceAddr,
+		DialOptions: []grpc.DialOption{newResilience("order", resilienceCfg, logger).dialOption()},
+	}
 	client, err := orderclient.New(ordCfg, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create order client: %w", err)
@@ -319,6 +323,48 @@ func (s *OrderServiceImpl) GetOrderByID(
 	return resp, nil
 }
 
+// BulkUpdateOrderStatus updates the status of many orders (admin/staff)
+func (s *OrderServiceImpl) BulkUpdateOrderStatus(
+	ctx context.Context,
+	orderIDs []string,
+	status string,
+) ([]*models.OrderStatusUpdateResult, error) {
+	s.logger.Debug("BulkUpdateOrderStatus",
+		zap.Int("orderCount", len(orderIDs)),
+		zap.String("status", status),
+	)
+
+	results, err := s.client.BulkUpdateOrderStatus(ctx, orderIDs, status)
+	if err != nil {
+		s.logger.Error("Failed to bulk update order status",
+			zap.String("status", status),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// AddOrderNote appends a note to an order (admin/staff)
+func (s *OrderServiceImpl) AddOrderNote(ctx context.Context, orderID, text, productID string) (*models.Order, error) {
+	s.logger.Debug("AddOrderNote",
+		zap.String("orderID", orderID),
+		zap.String("productID", productID),
+	)
+
+	order, err := s.client.AddOrderNote(ctx, orderID, text, productID)
+	if err != nil {
+		s.logger.Error("Failed to add order note",
+			zap.String("orderID", orderID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return order, nil
+}
+
 // UpdateOrderStatus updates order status (admin/staff)
 func (s *OrderServiceImpl) UpdateOrderStatus(
 	ctx context.Context,
@@ -378,3 +424,207 @@ func (s *OrderServiceImpl) CancelOrder(
 
 // Note: Quick POS transactions are now handled via CreateOrder with source="QUICK_POS" parameter
 // All POS functionality has been consolidated into standard order endpoints
+
+// ListWebhookDeliveries lists webhook delivery status (admin)
+func (s *OrderServiceImpl) ListWebhookDeliveries(
+	ctx context.Context,
+	subscriberID, status string,
+	limit, offset int,
+) (interface{}, error) {
+	s.logger.Debug("ListWebhookDeliveries",
+		zap.String("subscriberID", subscriberID),
+		zap.String("status", status),
+	)
+
+	deliveries, err := s.client.ListWebhookDeliveries(ctx, subscriberID, status, int32(limit), int32(offset))
+	if err != nil {
+		s.logger.Error("Failed to list webhook deliveries", zap.Error(err))
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// ListDeadLetteredWebhooks lists dead-lettered webhook deliveries (admin)
+func (s *OrderServiceImpl) ListDeadLetteredWebhooks(
+	ctx context.Context,
+	subscriberID string,
+	limit, offset int,
+) (interface{}, error) {
+	s.logger.Debug("ListDeadLetteredWebhooks",
+		zap.String("subscriberID", subscriberID),
+	)
+
+	deliveries, err := s.client.ListDeadLetteredWebhooks(ctx, subscriberID, int32(limit), int32(offset))
+	if err != nil {
+		s.logger.Error("Failed to list dead-lettered webhooks", zap.Error(err))
+		return nil, fmt.Errorf("failed to list dead-lettered webhooks: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// ReplayDeadLetteredWebhook replays a dead-lettered webhook delivery (admin)
+func (s *OrderServiceImpl) ReplayDeadLetteredWebhook(
+	ctx context.Context,
+	id string,
+) (interface{}, error) {
+	s.logger.Debug("ReplayDeadLetteredWebhook",
+		zap.String("id", id),
+	)
+
+	resp, err := s.client.ReplayDeadLetteredWebhook(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to replay webhook delivery",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to replay webhook delivery: %w", err)
+	}
+
+	return resp, nil
+}
+
+// CreateUserReturn opens a return for items of one of the user's orders
+func (s *OrderServiceImpl) CreateUserReturn(
+	ctx context.Context,
+	orderID, userID string,
+	lines []*models.ReturnLine,
+	reason string,
+) (interface{}, error) {
+	s.logger.Debug("CreateUserReturn",
+		zap.String("orderID", orderID),
+		zap.String("userID", userID),
+	)
+
+	if _, err := s.GetUserOrder(ctx, orderID, userID); err != nil {
+		return nil, err
+	}
+
+	ret, err := s.client.CreateReturn(ctx, orderID, lines, reason)
+	if err != nil {
+		s.logger.Error("Failed to create return",
+			zap.String("orderID", orderID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to create return: %w", err)
+	}
+
+	return ret, nil
+}
+
+// GetUserOrderReturns lists the returns of one of the user's orders
+func (s *OrderServiceImpl) GetUserOrderReturns(
+	ctx context.Context,
+	orderID, userID string,
+) (interface{}, error) {
+	s.logger.Debug("GetUserOrderReturns",
+		zap.String("orderID", orderID),
+		zap.String("userID", userID),
+	)
+
+	if _, err := s.GetUserOrder(ctx, orderID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.ListOrderReturns(ctx, orderID)
+}
+
+// ListOrderReturns lists the returns of an order (admin/staff)
+func (s *OrderServiceImpl) ListOrderReturns(
+	ctx context.Context,
+	orderID string,
+) (interface{}, error) {
+	s.logger.Debug("ListOrderReturns",
+		zap.String("orderID", orderID),
+	)
+
+	returns, err := s.client.ListOrderReturns(ctx, orderID)
+	if err != nil {
+		s.logger.Error("Failed to list returns",
+			zap.String("orderID", orderID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to list returns: %w", err)
+	}
+
+	return returns, nil
+}
+
+// GetReturn gets a return by ID (admin/staff)
+func (s *OrderServiceImpl) GetReturn(
+	ctx context.Context,
+	id string,
+) (interface{}, error) {
+	s.logger.Debug("GetReturn",
+		zap.String("id", id),
+	)
+
+	ret, err := s.client.GetReturn(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to get return",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get return: %w", err)
+	}
+
+	return ret, nil
+}
+
+// UpdateReturnStatus moves a return through its workflow (admin/staff)
+func (s *OrderServiceImpl) UpdateReturnStatus(
+	ctx context.Context,
+	id, status string,
+	conditions map[string]string,
+) (interface{}, error) {
+	s.logger.Debug("UpdateReturnStatus",
+		zap.String("id", id),
+		zap.String("status", status),
+	)
+
+	ret, err := s.client.UpdateReturnStatus(ctx, id, status, conditions)
+	if err != nil {
+		s.logger.Error("Failed to update return status",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to update return status: %w", err)
+	}
+
+	return ret, nil
+}
+
+// GetOrderSummary counts the non-cancelled orders created in [from, to) and sums their revenue
+func (s *OrderServiceImpl) GetOrderSummary(ctx context.Context, from, to time.Time) (*models.OrderSummary, error) {
+	s.logger.Debug("GetOrderSummary",
+		zap.Time("from", from),
+		zap.Time("to", to),
+	)
+
+	summary, err := s.client.GetOrderSummary(ctx, from, to)
+	if err != nil {
+		s.logger.Error("Failed to get order summary", zap.Error(err))
+		return nil, fmt.Errorf("failed to get order summary: %w", err)
+	}
+
+	return summary, nil
+}
+
+// GetOrderTimeline lists everything that happened to an order, oldest first (admin/staff)
+func (s *OrderServiceImpl) GetOrderTimeline(ctx context.Context, orderID string) ([]*models.OrderTimelineEntry, error) {
+	s.logger.Debug("GetOrderTimeline",
+		zap.String("orderID", orderID),
+	)
+
+	timeline, err := s.client.GetOrderTimeline(ctx, orderID)
+	if err != nil {
+		s.logger.Error("Failed to get order timeline",
+			zap.String("orderID", orderID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get order timeline: %w", err)
+	}
+
+	return timeline, nil
+}
@@ -6,8 +6,10 @@ import (
 	"strconv"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
 	productclient "github.com/leonvanderhaeghen/stockplatform/pkg/clients/product"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
 )
 
 // ProductServiceImpl implements the ProductService interface
@@ -17,9 +19,12 @@ type ProductServiceImpl struct {
 }
 
 // NewProductService creates a new instance of ProductServiceImpl
-func NewProductService(productServiceAddr string, logger *zap.Logger) (ProductService, error) {
+func NewProductService(productServiceAddr string, resilienceCfg ResilienceConfig, logger *zap.Logger) (ProductService, error) {
 	// Create a new gRPC client
-	prodCfg := productclient.Config{Address: productServiceAddr}
+	prodCfg := productclient.Config{
+		Address:     productServiceAddr,
+		DialOptions: []grpc.DialOption{newResilience("product", resilienceCfg, logger).dialOption()},
+	}
 	client, err := productclient.New(prodCfg, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create product client: %w", err)
@@ -56,6 +61,39 @@ func (s *ProductServiceImpl) CreateCategory(
 	return resp, nil
 }
 
+// GetCategory retrieves a category with its attribute schema
+func (s *ProductServiceImpl) GetCategory(ctx context.Context, id string) (*models.Category, error) {
+	s.logger.Debug("GetCategory", zap.String("id", id))
+
+	category, err := s.client.GetCategory(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to get category",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return category, nil
+}
+
+// SetCategoryAttributes replaces the attribute schema of a category
+func (s *ProductServiceImpl) SetCategoryAttributes(ctx context.Context, id string, attributes []models.CategoryAttribute) (*models.Category, error) {
+	s.logger.Debug("SetCategoryAttributes",
+		zap.String("id", id),
+		zap.Int("attributes", len(attributes)),
+	)
+
+	category, err := s.client.SetCategoryAttributes(ctx, id, attributes)
+	if err != nil {
+		s.logger.Error("Failed to set category attributes",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return category, nil
+}
+
 // ListCategories lists all product categories
 // parentID: Optional parent category ID to filter by
 // depth: Maximum depth of subcategories to return (0 for all)
@@ -102,8 +140,9 @@ func (s *ProductServiceImpl) ListProducts(
 		isActivePtr = &active
 	}
 
-	// Call the gRPC service via client abstraction
-	resp, err := s.client.ListProducts(ctx, categoryID, query, isActivePtr, int32(limit), int32(offset))
+	// Call the gRPC service via client abstraction. The client takes a
+	// supplier rather than a search query, so no supplier filter is passed.
+	resp, err := s.client.ListProducts(ctx, categoryID, "", isActivePtr, int32(limit), int32(offset))
 	if err != nil {
 		s.logger.Error("Failed to list products",
 			zap.Error(err),
@@ -145,6 +184,7 @@ func (s *ProductServiceImpl) CreateProduct(
 	stockQty, lowStockAt int32,
 	imageURLs, videoURLs []string,
 	metadata map[string]string,
+	orderQty models.OrderQuantityLimits,
 ) (interface{}, error) {
 	s.logger.Debug("CreateProduct",
 		zap.String("name", name),
@@ -163,7 +203,7 @@ func (s *ProductServiceImpl) CreateProduct(
 	}
 
 	// Call the gRPC service using refactored client
-	resp, err := s.client.CreateProduct(ctx, name, description, sku, supplierID, costPriceFloat, sellingPriceFloat, isActive, categoryIDs)
+	resp, err := s.client.CreateProduct(ctx, name, description, sku, supplierID, costPriceFloat, sellingPriceFloat, isActive, categoryIDs, orderQty)
 	if err != nil {
 		s.logger.Error("Failed to create product",
 			zap.Error(err),
@@ -176,9 +216,8 @@ func (s *ProductServiceImpl) CreateProduct(
 	return resp, nil
 }
 
-// UpdateProduct updates an existing product
-// Note: Since the gRPC service doesn't have an update method,
-// we implement this by fetching the existing product and creating a new one with the updated fields.
+// UpdateProduct updates an existing product. A non-zero version must be the
+// product's current one, otherwise the update fails with codes.Aborted.
 func (s *ProductServiceImpl) UpdateProduct(
 	ctx context.Context,
 	id, name, description, sku string,
@@ -187,19 +226,24 @@ func (s *ProductServiceImpl) UpdateProduct(
 	active bool,
 	images []string,
 	attributes map[string]string,
+	version int64,
 ) error {
 	s.logger.Debug("UpdateProduct",
 		zap.String("id", id),
 		zap.String("name", name),
 		zap.String("sku", sku),
+		zap.Int64("version", version),
 	)
 
-	// Note: UpdateProduct is not implemented in the current gRPC client abstraction
-	// This method currently returns an error indicating the limitation
-	s.logger.Warn("UpdateProduct not implemented in client abstraction",
-		zap.String("id", id),
-	)
-	return fmt.Errorf("update product operation not supported by client abstraction")
+	if _, err := s.client.UpdateProduct(ctx, id, name, description, sku, categories, cost, price, active, images, attributes, version); err != nil {
+		s.logger.Error("Failed to update product",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to update product: %w", err)
+	}
+
+	return nil
 }
 
 // DeleteProduct marks a product as inactive (soft delete)
@@ -217,3 +261,75 @@ func (s *ProductServiceImpl) DeleteProduct(ctx context.Context, id string) error
 	)
 	return fmt.Errorf("delete product operation not supported by client abstraction")
 }
+
+// ExportProducts exports the products matching filter in the given format
+func (s *ProductServiceImpl) ExportProducts(ctx context.Context, format string, filter models.ProductExportFilter) (*models.ProductExport, error) {
+	s.logger.Debug("ExportProducts",
+		zap.String("format", format),
+		zap.String("supplierID", filter.SupplierID),
+	)
+
+	export, err := s.client.ExportProducts(ctx, format, filter)
+	if err != nil {
+		s.logger.Error("Failed to export products",
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to export products: %w", err)
+	}
+
+	return export, nil
+}
+
+// RebuildSearchIndex triggers a rebuild of the product search index
+func (s *ProductServiceImpl) RebuildSearchIndex(ctx context.Context) (int64, error) {
+	s.logger.Debug("RebuildSearchIndex")
+
+	count, err := s.client.RebuildSearchIndex(ctx)
+	if err != nil {
+		s.logger.Error("Failed to rebuild search index",
+			zap.Error(err),
+		)
+		return 0, fmt.Errorf("failed to rebuild search index: %w", err)
+	}
+
+	return count, nil
+}
+
+// CreateProductReview reviews a product as the user in ctx
+func (s *ProductServiceImpl) CreateProductReview(ctx context.Context, productID string, rating int32, text string) (*models.ProductReview, error) {
+	s.logger.Debug("CreateProductReview", zap.String("productID", productID))
+
+	review, err := s.client.CreateProductReview(ctx, productID, rating, text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create product review: %w", err)
+	}
+	return review, nil
+}
+
+// ListProductReviews lists a page of a product's reviews
+func (s *ProductServiceImpl) ListProductReviews(ctx context.Context, productID string, includeUnapproved bool, limit, offset int) (*models.ListProductReviewsResponse, error) {
+	s.logger.Debug("ListProductReviews",
+		zap.String("productID", productID),
+		zap.Bool("includeUnapproved", includeUnapproved),
+	)
+
+	resp, err := s.client.ListProductReviews(ctx, productID, includeUnapproved, int32(limit), int32(offset))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list product reviews: %w", err)
+	}
+	return resp, nil
+}
+
+// ModerateProductReview approves or rejects a review
+func (s *ProductServiceImpl) ModerateProductReview(ctx context.Context, reviewID string, approved bool) (*models.ProductReview, error) {
+	s.logger.Debug("ModerateProductReview",
+		zap.String("reviewID", reviewID),
+		zap.Bool("approved", approved),
+	)
+
+	review, err := s.client.ModerateProductReview(ctx, reviewID, approved)
+	if err != nil {
+		return nil, fmt.Errorf("failed to moderate product review: %w", err)
+	}
+	return review, nil
+}
@@ -0,0 +1,169 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/circuitbreaker"
+)
+
+// ResilienceConfig configures the retries and the circuit breaker in front
+// of every backend the gateway calls
+type ResilienceConfig struct {
+	// MaxAttempts is how often a read failing with Unavailable is tried in
+	// total; 1 disables retries. Writes are never retried.
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry; it doubles with
+	// every further retry up to MaxBackoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// FailureThreshold is the number of consecutive failed calls that opens
+	// the breaker; 0 disables the breaker
+	FailureThreshold int
+	// OpenTimeout is how long an open breaker rejects calls before it lets a
+	// single probe through
+	OpenTimeout time.Duration
+}
+
+// ErrBreakerOpen is returned, wrapped in a BreakerOpenError, for calls
+// rejected without reaching the backend
+var ErrBreakerOpen = errors.New("circuit breaker open")
+
+// BreakerOpenError is returned for calls to a backend whose breaker is open
+type BreakerOpenError struct {
+	Service string
+	// RetryAfter is how long until the breaker lets a call through again
+	RetryAfter time.Duration
+}
+
+func (e *BreakerOpenError) Error() string {
+	return fmt.Sprintf("%s service unavailable: %v", e.Service, ErrBreakerOpen)
+}
+
+func (e *BreakerOpenError) Unwrap() error {
+	return ErrBreakerOpen
+}
+
+// GRPCStatus lets the error pass for the Unavailable status the backend
+// would have answered with
+func (e *BreakerOpenError) GRPCStatus() *status.Status {
+	return status.New(codes.Unavailable, e.Error())
+}
+
+// resilience retries idempotent reads to one backend and opens a circuit
+// breaker once the backend keeps failing, so requests fail fast instead of
+// piling up
+type resilience struct {
+	service string
+	config  ResilienceConfig
+	logger  *zap.Logger
+	// breaker is nil when FailureThreshold disables it
+	breaker *circuitbreaker.CircuitBreaker
+}
+
+// newResilience creates the retry and breaker state for one backend
+func newResilience(service string, config ResilienceConfig, logger *zap.Logger) *resilience {
+	if config.MaxAttempts < 1 {
+		config.MaxAttempts = 1
+	}
+	r := &resilience{
+		service: service,
+		config:  config,
+		logger:  logger.Named("resilience").With(zap.String("backend", service)),
+	}
+	if config.FailureThreshold > 0 {
+		r.breaker = circuitbreaker.NewCircuitBreaker(circuitbreaker.Config{
+			Name:          service,
+			MaxFailures:   config.FailureThreshold,
+			Timeout:       config.OpenTimeout,
+			MaxRequests:   1,
+			OnStateChange: r.logStateChange,
+			IsSuccessful:  backendReached,
+		})
+	}
+	return r
+}
+
+// backendReached reports whether a call's outcome shows the backend is up.
+// Only Unavailable and DeadlineExceeded count against the breaker; a call the
+// caller canceled says nothing about the backend.
+func backendReached(err error) bool {
+	code := status.Code(err)
+	return code != codes.Unavailable && code != codes.DeadlineExceeded
+}
+
+func (r *resilience) logStateChange(name string, from, to circuitbreaker.State) {
+	if to == circuitbreaker.StateOpen {
+		r.logger.Warn("Circuit breaker opened",
+			zap.String("from", from.String()),
+			zap.Duration("open_timeout", r.config.OpenTimeout),
+		)
+		return
+	}
+	r.logger.Info("Circuit breaker state changed",
+		zap.String("from", from.String()),
+		zap.String("to", to.String()),
+	)
+}
+
+// dialOption installs the retries and the breaker on a client connection
+func (r *resilience) dialOption() grpc.DialOption {
+	return grpc.WithChainUnaryInterceptor(r.unaryInterceptor)
+}
+
+// unaryInterceptor runs one call through the breaker and retries it while the
+// backend answers Unavailable, for the read methods in retryableMethods only.
+// Unavailable can also come back after the backend acted on a call, so a
+// write such as CreateOrder or a stock reservation is tried exactly once.
+func (r *resilience) unaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	maxAttempts := 1
+	if retryableMethods[method] {
+		maxAttempts = r.config.MaxAttempts
+	}
+
+	backoff := r.config.InitialBackoff
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = r.invoke(ctx, func() error {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		})
+		if errors.Is(err, ErrBreakerOpen) || status.Code(err) != codes.Unavailable || attempt >= maxAttempts {
+			return err
+		}
+
+		r.logger.Debug("Retrying unavailable backend",
+			zap.String("method", method),
+			zap.Int("attempt", attempt),
+			zap.Duration("backoff", backoff),
+		)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if r.config.MaxBackoff > 0 && backoff > r.config.MaxBackoff {
+			backoff = r.config.MaxBackoff
+		}
+	}
+}
+
+// invoke runs one attempt through the breaker, turning a rejection into a
+// BreakerOpenError that tells the caller when to retry
+func (r *resilience) invoke(ctx context.Context, call func() error) error {
+	if r.breaker == nil {
+		return call()
+	}
+	err := r.breaker.Execute(ctx, call)
+	if errors.Is(err, circuitbreaker.ErrOpen) {
+		return &BreakerOpenError{Service: r.service, RetryAfter: r.breaker.RetryAfter()}
+	}
+	return err
+}
@@ -0,0 +1,221 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	readMethod  = "/order.v1.OrderService/GetOrder"
+	writeMethod = "/order.v1.OrderService/CreateOrder"
+)
+
+// fakeBackend answers calls with the codes in answers, in order, and with OK
+// once they run out
+type fakeBackend struct {
+	answers []codes.Code
+	calls   int
+}
+
+func (b *fakeBackend) invoke(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+	b.calls++
+	if len(b.answers) == 0 {
+		return nil
+	}
+	code := b.answers[0]
+	b.answers = b.answers[1:]
+	if code == codes.OK {
+		return nil
+	}
+	return status.Error(code, "backend answer")
+}
+
+// downBackend answers every call with Unavailable
+func downBackend() *fakeBackend {
+	answers := make([]codes.Code, 100)
+	for i := range answers {
+		answers[i] = codes.Unavailable
+	}
+	return &fakeBackend{answers: answers}
+}
+
+func testResilience(config ResilienceConfig) *resilience {
+	return newResilience("order", config, zap.NewNop())
+}
+
+func call(r *resilience, backend *fakeBackend, method string) error {
+	return r.unaryInterceptor(context.Background(), method, nil, nil, nil, backend.invoke)
+}
+
+func TestFlakyBackendRetriesReads(t *testing.T) {
+	r := testResilience(ResilienceConfig{MaxAttempts: 3, InitialBackoff: time.Millisecond})
+	backend := &fakeBackend{answers: []codes.Code{codes.Unavailable, codes.Unavailable}}
+
+	if err := call(r, backend, readMethod); err != nil {
+		t.Fatalf("err = %v, want the third attempt to succeed", err)
+	}
+	if backend.calls != 3 {
+		t.Fatalf("backend called %d times, want 3", backend.calls)
+	}
+}
+
+func TestFlakyBackendGivesUpAfterMaxAttempts(t *testing.T) {
+	r := testResilience(ResilienceConfig{MaxAttempts: 2, InitialBackoff: time.Millisecond})
+	backend := downBackend()
+
+	err := call(r, backend, readMethod)
+
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("err = %v, want Unavailable", err)
+	}
+	if backend.calls != 2 {
+		t.Fatalf("backend called %d times, want 2", backend.calls)
+	}
+}
+
+func TestFlakyBackendNeverRetriesWrites(t *testing.T) {
+	writes := []string{
+		writeMethod,
+		"/inventory.v1.InventoryService/ReserveStock",
+		"/store.v1.StoreService/ReserveProduct",
+		"/user.v1.UserService/RefreshToken",
+	}
+	for _, method := range writes {
+		t.Run(method, func(t *testing.T) {
+			r := testResilience(ResilienceConfig{MaxAttempts: 3, InitialBackoff: time.Millisecond})
+			backend := &fakeBackend{answers: []codes.Code{codes.Unavailable}}
+
+			err := call(r, backend, method)
+
+			if status.Code(err) != codes.Unavailable {
+				t.Fatalf("err = %v, want the Unavailable answer", err)
+			}
+			if backend.calls != 1 {
+				t.Fatalf("backend called %d times, want 1", backend.calls)
+			}
+		})
+	}
+}
+
+func TestRetriesOnlyUnavailable(t *testing.T) {
+	r := testResilience(ResilienceConfig{MaxAttempts: 3, InitialBackoff: time.Millisecond})
+	backend := &fakeBackend{answers: []codes.Code{codes.NotFound}}
+
+	if err := call(r, backend, readMethod); status.Code(err) != codes.NotFound {
+		t.Fatalf("err = %v, want NotFound", err)
+	}
+	if backend.calls != 1 {
+		t.Fatalf("backend called %d times, want 1", backend.calls)
+	}
+}
+
+func TestDownedBackendOpensBreaker(t *testing.T) {
+	r := testResilience(ResilienceConfig{MaxAttempts: 1, FailureThreshold: 2, OpenTimeout: time.Minute})
+	backend := downBackend()
+
+	for i := 0; i < 2; i++ {
+		if err := call(r, backend, readMethod); status.Code(err) != codes.Unavailable {
+			t.Fatalf("call %d: err = %v, want Unavailable", i+1, err)
+		}
+	}
+
+	err := call(r, backend, writeMethod)
+
+	var open *BreakerOpenError
+	if !errors.As(err, &open) {
+		t.Fatalf("err = %v, want a BreakerOpenError", err)
+	}
+	if !errors.Is(err, ErrBreakerOpen) || status.Code(err) != codes.Unavailable {
+		t.Fatalf("err = %v, want ErrBreakerOpen with status Unavailable", err)
+	}
+	if open.Service != "order" || open.RetryAfter <= 0 || open.RetryAfter > time.Minute {
+		t.Fatalf("breaker error = %+v, want the order service and a wait within the open timeout", open)
+	}
+	if backend.calls != 2 {
+		t.Fatalf("backend called %d times, want the open breaker to answer without it", backend.calls)
+	}
+}
+
+func TestOpenBreakerStopsRetries(t *testing.T) {
+	r := testResilience(ResilienceConfig{MaxAttempts: 5, InitialBackoff: time.Millisecond, FailureThreshold: 2, OpenTimeout: time.Minute})
+	backend := downBackend()
+
+	err := call(r, backend, readMethod)
+
+	if !errors.Is(err, ErrBreakerOpen) {
+		t.Fatalf("err = %v, want ErrBreakerOpen once the retries opened the breaker", err)
+	}
+	if backend.calls != 2 {
+		t.Fatalf("backend called %d times, want 2", backend.calls)
+	}
+}
+
+func TestDownedBackendProbedAfterOpenTimeout(t *testing.T) {
+	r := testResilience(ResilienceConfig{MaxAttempts: 1, FailureThreshold: 1, OpenTimeout: 10 * time.Millisecond})
+	backend := &fakeBackend{answers: []codes.Code{codes.Unavailable}}
+
+	call(r, backend, readMethod)
+	if err := call(r, backend, readMethod); !errors.Is(err, ErrBreakerOpen) {
+		t.Fatalf("err = %v, want ErrBreakerOpen", err)
+	}
+	time.Sleep(20 * time.Millisecond)
+
+	if err := call(r, backend, readMethod); err != nil {
+		t.Fatalf("probe err = %v, want the recovered backend to answer", err)
+	}
+	if err := call(r, backend, readMethod); err != nil {
+		t.Fatalf("err = %v, want the breaker closed after a successful probe", err)
+	}
+	if backend.calls != 3 {
+		t.Fatalf("backend called %d times, want 3", backend.calls)
+	}
+}
+
+func TestBackendErrorsDoNotOpenBreaker(t *testing.T) {
+	r := testResilience(ResilienceConfig{MaxAttempts: 1, FailureThreshold: 1, OpenTimeout: time.Minute})
+	backend := &fakeBackend{answers: []codes.Code{codes.NotFound, codes.InvalidArgument, codes.Canceled, codes.PermissionDenied}}
+
+	for i := 0; i < 5; i++ {
+		if err := call(r, backend, readMethod); errors.Is(err, ErrBreakerOpen) {
+			t.Fatalf("call %d: breaker opened on an answer from a healthy backend", i+1)
+		}
+	}
+	if backend.calls != 5 {
+		t.Fatalf("backend called %d times, want 5", backend.calls)
+	}
+}
+
+func TestBreakerDisabled(t *testing.T) {
+	r := testResilience(ResilienceConfig{MaxAttempts: 1})
+	backend := downBackend()
+
+	for i := 0; i < 10; i++ {
+		if err := call(r, backend, readMethod); errors.Is(err, ErrBreakerOpen) {
+			t.Fatal("a zero failure threshold leaves the breaker off")
+		}
+	}
+	if backend.calls != 10 {
+		t.Fatalf("backend called %d times, want 10", backend.calls)
+	}
+}
+
+func TestRetryableMethodsAreReads(t *testing.T) {
+	writes := []string{"Create", "Update", "Delete", "Reserve", "Add", "Remove", "Cancel", "Set", "Record"}
+	for method := range retryableMethods {
+		name := path.Base(method)
+		for _, write := range writes {
+			if strings.HasPrefix(name, write) {
+				t.Errorf("%s looks like a write and must not be retried", method)
+			}
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package services
+
+// retryableMethods lists the backend methods the gateway retries on
+// Unavailable. They only read, so running one twice is harmless; every other
+// method, writes such as CreateOrder and stock reservations in particular, is
+// tried once.
+var retryableMethods = map[string]bool{
+	"/product.v1.ProductService/GetProduct":                true,
+	"/product.v1.ProductService/BatchGetProducts":          true,
+	"/product.v1.ProductService/ListProducts":              true,
+	"/product.v1.ProductService/ListCategories":            true,
+	"/product.v1.ProductService/GetCategory":               true,
+	"/product.v1.ProductService/ExportProducts":            true,
+	"/product.v1.ProductService/GetStoreAvailableProducts": true,
+	"/product.v1.ProductService/GetVariant":                true,
+	"/product.v1.ProductService/ListVariants":              true,
+	"/product.v1.ProductService/GetBundleAvailability":     true,
+	"/product.v1.ProductService/ListProductReviews":        true,
+
+	"/inventory.v1.InventoryService/GetInventory":            true,
+	"/inventory.v1.InventoryService/GetInventoryByProductID": true,
+	"/inventory.v1.InventoryService/GetInventoryBySKU":       true,
+	"/inventory.v1.InventoryService/ListInventory":           true,
+	"/inventory.v1.InventoryService/ListInventoryByLocation": true,
+	"/inventory.v1.InventoryService/GetLocation":             true,
+	"/inventory.v1.InventoryService/ListLocations":           true,
+	"/inventory.v1.InventoryService/GetTransfer":             true,
+	"/inventory.v1.InventoryService/ListTransfers":           true,
+	"/inventory.v1.InventoryService/CheckAvailability":       true,
+	"/inventory.v1.InventoryService/GetNearbyInventory":      true,
+	"/inventory.v1.InventoryService/GetInventoryHistory":     true,
+	"/inventory.v1.InventoryService/GetReservationsForOrder": true,
+	"/inventory.v1.InventoryService/ListLowStockItems":       true,
+	"/inventory.v1.InventoryService/CountLowStock":           true,
+	"/inventory.v1.InventoryService/ListDueCounts":           true,
+	"/inventory.v1.InventoryService/ExportStockAdjustments":  true,
+	"/inventory.v1.InventoryService/GetReservationStatus":    true,
+
+	"/order.v1.OrderService/GetOrder":                 true,
+	"/order.v1.OrderService/GetUserOrders":            true,
+	"/order.v1.OrderService/ListOrders":               true,
+	"/order.v1.OrderService/GetStoreOrders":           true,
+	"/order.v1.OrderService/ExportOrders":             true,
+	"/order.v1.OrderService/ListWebhookDeliveries":    true,
+	"/order.v1.OrderService/ListDeadLetteredWebhooks": true,
+	"/order.v1.OrderService/GetReturn":                true,
+	"/order.v1.OrderService/ListOrderReturns":         true,
+	"/order.v1.OrderService/GetOrderSummary":          true,
+	"/order.v1.OrderService/GetOrderTimeline":         true,
+	"/order.v1.OrderService/GetGuestOrder":            true,
+
+	"/store.v1.StoreService/GetStore":                 true,
+	"/store.v1.StoreService/ListStores":               true,
+	"/store.v1.StoreService/CheckStoreOpen":           true,
+	"/store.v1.StoreService/GetStoreProducts":         true,
+	"/store.v1.StoreService/GetProductStoreLocations": true,
+	"/store.v1.StoreService/CheckCartAvailability":    true,
+	"/store.v1.StoreService/ListStoreCatalog":         true,
+	"/store.v1.StoreService/GetReservations":          true,
+	"/store.v1.StoreService/GetStoreUsers":            true,
+	"/store.v1.StoreService/GetUserStores":            true,
+	"/store.v1.StoreService/GetStoreSales":            true,
+	"/store.v1.StoreService/ExportStoreProducts":      true,
+	"/store.v1.StoreService/ExportStoreSales":         true,
+
+	"/supplier.v1.SupplierService/GetSupplier":            true,
+	"/supplier.v1.SupplierService/ListSuppliers":          true,
+	"/supplier.v1.SupplierService/ListAdapters":           true,
+	"/supplier.v1.SupplierService/GetAdapterCapabilities": true,
+
+	"/user.v1.UserService/GetUser":               true,
+	"/user.v1.UserService/GetUserByEmail":        true,
+	"/user.v1.UserService/ListUsers":             true,
+	"/user.v1.UserService/GetUserAddresses":      true,
+	"/user.v1.UserService/GetUserDefaultAddress": true,
+	"/user.v1.UserService/CountUsers":            true,
+}
@@ -7,6 +7,7 @@ import (
 	"go.uber.org/zap"
 
 	storeclient "github.com/leonvanderhaeghen/stockplatform/pkg/clients/store"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
 )
 
 // StoreServiceImpl implements the StoreService interface
@@ -16,9 +17,9 @@ type StoreServiceImpl struct {
 }
 
 // NewStoreService creates a new instance of StoreServiceImpl
-func NewStoreService(storeServiceAddr string, logger *zap.Logger) (StoreService, error) {
+func NewStoreService(storeServiceAddr string, resilienceCfg ResilienceConfig, logger *zap.Logger) (StoreService, error) {
 	// Create a gRPC client
-	client, err := storeclient.NewClient(storeServiceAddr)
+	client, err := storeclient.NewClient(storeServiceAddr, newResilience("store", resilienceCfg, logger).dialOption())
 	if err != nil {
 		return nil, fmt.Errorf("failed to create store client: %w", err)
 	}
@@ -29,11 +30,17 @@ func NewStoreService(storeServiceAddr string, logger *zap.Logger) (StoreService,
 	}, nil
 }
 
-// ListStores lists all stores with pagination
-func (s *StoreServiceImpl) ListStores(ctx context.Context, limit, offset int) (interface{}, error) {
-	s.logger.Debug("ListStores", zap.Int("limit", limit), zap.Int("offset", offset))
+// ListStores lists the stores matching filter with pagination
+func (s *StoreServiceImpl) ListStores(ctx context.Context, filter models.StoreFilter, limit, offset int) (interface{}, error) {
+	s.logger.Debug("ListStores",
+		zap.String("search", filter.Search),
+		zap.String("country", filter.Country),
+		zap.Bool("active_only", filter.ActiveOnly),
+		zap.Int("limit", limit),
+		zap.Int("offset", offset),
+	)
 
-	resp, err := s.client.ListStores(ctx, int32(limit), int32(offset))
+	resp, err := s.client.ListStores(ctx, filter, int32(limit), int32(offset))
 	if err != nil {
 		s.logger.Error("Failed to list stores", zap.Error(err))
 		return nil, fmt.Errorf("failed to list stores: %w", err)
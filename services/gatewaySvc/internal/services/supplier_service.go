@@ -3,10 +3,13 @@ package services
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
 	supplierclient "github.com/leonvanderhaeghen/stockplatform/pkg/clients/supplier"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
 )
 
 // SupplierServiceImpl implements the SupplierService interface
@@ -16,9 +19,12 @@ type SupplierServiceImpl struct {
 }
 
 // NewSupplierService creates a new instance of SupplierServiceImpl
-func NewSupplierService(supplierServiceAddr string, logger *zap.Logger) (SupplierService, error) {
+func NewSupplierService(supplierServiceAddr string, resilienceCfg ResilienceConfig, logger *zap.Logger) (SupplierService, error) {
 	// Create a gRPC client via new abstraction
-	supCfg := supplierclient.Config{Address: supplierServiceAddr}
+	supCfg := supplierclient.Config{
+		Address:     supplierServiceAddr,
+		DialOptions: []grpc.DialOption{newResilience("supplier", resilienceCfg, logger).dialOption()},
+	}
 	client, err := supplierclient.New(supCfg, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create supplier client: %w", err)
@@ -65,14 +71,13 @@ func (s *SupplierServiceImpl) GetSupplier(ctx context.Context, id string) (inter
 	return resp, nil
 }
 
-// UpdateSupplier updates a supplier
-func (s *SupplierServiceImpl) UpdateSupplier(ctx context.Context, id, name, contactPerson, email, phone, address, city, state, country, postalCode, taxID, website, currency, paymentTerms string, leadTimeDays int32, metadata map[string]string) (interface{}, error) {
+// UpdateSupplier partially updates a supplier
+func (s *SupplierServiceImpl) UpdateSupplier(ctx context.Context, id string, update *models.SupplierUpdate) (interface{}, error) {
 	s.logger.Debug("UpdateSupplier",
 		zap.String("id", id),
-		zap.String("name", name),
 	)
 	
-	resp, err := s.client.UpdateSupplier(ctx, id, name, contactPerson, email, phone, address, city, state, country, postalCode, taxID, website, currency, paymentTerms, leadTimeDays, metadata)
+	resp, err := s.client.UpdateSupplier(ctx, id, update)
 	if err != nil {
 		s.logger.Error("Failed to update supplier",
 			zap.String("id", id),
@@ -84,22 +89,24 @@ func (s *SupplierServiceImpl) UpdateSupplier(ctx context.Context, id, name, cont
 }
 
 // DeleteSupplier deletes a supplier by ID
-func (s *SupplierServiceImpl) DeleteSupplier(ctx context.Context, id string) error {
+func (s *SupplierServiceImpl) DeleteSupplier(ctx context.Context, id, reassignTo string, force bool) (int64, error) {
 	s.logger.Debug("DeleteSupplier",
 		zap.String("id", id),
+		zap.String("reassign_to", reassignTo),
+		zap.Bool("force", force),
 	)
 	
-	err := s.client.DeleteSupplier(ctx, id)
+	reassigned, err := s.client.DeleteSupplier(ctx, id, reassignTo, force)
 	if err != nil {
 		s.logger.Error("Failed to delete supplier",
 			zap.String("id", id),
 			zap.Error(err),
 		)
-		return fmt.Errorf("failed to delete supplier: %w", err)
+		return 0, fmt.Errorf("failed to delete supplier: %w", err)
 	}
 	
 	
-	return nil
+	return reassigned, nil
 }
 
 // ListSuppliers lists suppliers with pagination and search
@@ -185,14 +192,14 @@ func (s *SupplierServiceImpl) TestAdapterConnection(ctx context.Context, adapter
 }
 
 // SyncProducts synchronizes products from a supplier
-func (s *SupplierServiceImpl) SyncProducts(ctx context.Context, supplierID string, fullSync, dryRun bool, batchSize int32) (string, error) {
+func (s *SupplierServiceImpl) SyncProducts(ctx context.Context, supplierID string, fullSync, dryRun bool, batchSize int32, since *time.Time) (string, error) {
 	s.logger.Debug("SyncProducts",
 		zap.String("supplierID", supplierID),
 		zap.Bool("fullSync", fullSync),
 		zap.Bool("dryRun", dryRun),
 	)
 	
-	resp, err := s.client.SyncProducts(ctx, supplierID, fullSync, dryRun, batchSize)
+	resp, err := s.client.SyncProducts(ctx, supplierID, fullSync, dryRun, batchSize, since)
 	if err != nil {
 		s.logger.Error("Failed to sync products",
 			zap.String("supplierID", supplierID),
@@ -209,14 +216,14 @@ func (s *SupplierServiceImpl) SyncProducts(ctx context.Context, supplierID strin
 }
 
 // SyncInventory synchronizes inventory from a supplier
-func (s *SupplierServiceImpl) SyncInventory(ctx context.Context, supplierID string, fullSync, dryRun bool, batchSize int32) (string, error) {
+func (s *SupplierServiceImpl) SyncInventory(ctx context.Context, supplierID string, fullSync, dryRun bool, batchSize int32, since *time.Time) (string, error) {
 	s.logger.Debug("SyncInventory",
 		zap.String("supplierID", supplierID),
 		zap.Bool("fullSync", fullSync),
 		zap.Bool("dryRun", dryRun),
 	)
 	
-	resp, err := s.client.SyncInventory(ctx, supplierID, fullSync, dryRun, batchSize)
+	resp, err := s.client.SyncInventory(ctx, supplierID, fullSync, dryRun, batchSize, since)
 	if err != nil {
 		s.logger.Error("Failed to sync inventory",
 			zap.String("supplierID", supplierID),
@@ -231,3 +238,18 @@ func (s *SupplierServiceImpl) SyncInventory(ctx context.Context, supplierID stri
 	}
 	return "", fmt.Errorf("received nil response from sync inventory")
 }
+
+// ValidateFeed validates a supplier's product feed without syncing it
+func (s *SupplierServiceImpl) ValidateFeed(ctx context.Context, supplierID string, opts models.FeedValidationOptions) (*models.FeedValidationReport, error) {
+	s.logger.Debug("ValidateFeed", zap.String("supplierID", supplierID))
+
+	report, err := s.client.ValidateFeed(ctx, supplierID, opts)
+	if err != nil {
+		s.logger.Error("Failed to validate supplier feed",
+			zap.String("supplierID", supplierID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return report, nil
+}
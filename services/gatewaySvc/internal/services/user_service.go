@@ -5,8 +5,10 @@ import (
 	"fmt"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
 	userclient "github.com/leonvanderhaeghen/stockplatform/pkg/clients/user"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
 )
 
 
@@ -18,9 +20,12 @@ type UserServiceImpl struct {
 }
 
 // NewUserService creates a new instance of UserServiceImpl
-func NewUserService(userServiceAddr string, logger *zap.Logger) (UserService, error) {
+func NewUserService(userServiceAddr string, resilienceCfg ResilienceConfig, logger *zap.Logger) (UserService, error) {
 	// Create a gRPC client via new abstraction
-	usrCfg := userclient.Config{Address: userServiceAddr}
+	usrCfg := userclient.Config{
+		Address:     userServiceAddr,
+		DialOptions: []grpc.DialOption{newResilience("user", resilienceCfg, logger).dialOption()},
+	}
 	client, err := userclient.New(usrCfg, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create user client: %w", err)
@@ -79,6 +84,17 @@ func (s *UserServiceImpl) AuthenticateUser(
 	return resp, nil
 }
 
+// RefreshToken exchanges a refresh token for a new access token
+func (s *UserServiceImpl) RefreshToken(ctx context.Context, refreshToken string) (interface{}, error) {
+	resp, err := s.client.RefreshToken(ctx, refreshToken)
+	if err != nil {
+		s.logger.Debug("Failed to refresh token", zap.Error(err))
+		return nil, fmt.Errorf("failed to refresh token: %w", err)
+	}
+
+	return resp, nil
+}
+
 // GetUserByID gets a user by ID
 func (s *UserServiceImpl) GetUserByID(
 	ctx context.Context,
@@ -188,6 +204,29 @@ func (s *UserServiceImpl) CreateUserAddress(
 	return address, nil
 }
 
+// BulkCreateUserAddresses creates several addresses for a user at once
+func (s *UserServiceImpl) BulkCreateUserAddresses(
+	ctx context.Context,
+	userID string,
+	addresses []models.UserAddressInput,
+) (interface{}, error) {
+	s.logger.Debug("BulkCreateUserAddresses",
+		zap.String("userID", userID),
+		zap.Int("count", len(addresses)),
+	)
+
+	result, err := s.client.BulkCreateUserAddresses(ctx, userID, addresses)
+	if err != nil {
+		s.logger.Error("Failed to bulk create user addresses",
+			zap.String("userID", userID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to bulk create user addresses: %w", err)
+	}
+
+	return result, nil
+}
+
 // GetUserDefaultAddress gets the default address for a user
 func (s *UserServiceImpl) GetUserDefaultAddress(
 	ctx context.Context,
@@ -345,3 +384,19 @@ func (s *UserServiceImpl) DeactivateUser(ctx context.Context, userID string) err
 
 	return nil
 }
+
+// CountUsers counts users, optionally only those with a role or that are active (admin only)
+func (s *UserServiceImpl) CountUsers(ctx context.Context, role string, activeOnly bool) (int64, error) {
+	s.logger.Debug("CountUsers",
+		zap.String("role", role),
+		zap.Bool("activeOnly", activeOnly),
+	)
+
+	count, err := s.client.CountUsers(ctx, role, activeOnly)
+	if err != nil {
+		s.logger.Error("Failed to count users", zap.Error(err))
+		return 0, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	return count, nil
+}
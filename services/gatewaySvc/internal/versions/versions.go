@@ -0,0 +1,109 @@
+package versions
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/version"
+)
+
+// Backend is the build a backend service reported, or why it did not
+type Backend struct {
+	version.Info
+	Error string `json:"error,omitempty"`
+}
+
+// Report lists the build of the gateway and of every backend
+type Report struct {
+	Gateway  version.Info        `json:"gateway"`
+	Services map[string]*Backend `json:"services"`
+}
+
+// Collector asks every backend for its build through the gRPC health
+// service, whose responses carry it in their header metadata
+type Collector struct {
+	conns   map[string]*grpc.ClientConn
+	timeout time.Duration
+	logger  *zap.Logger
+}
+
+// NewCollector creates a collector for the backends at addrs, keyed by
+// service name. Connections are made lazily, so a backend that is down does
+// not stop the gateway from starting. timeout bounds each backend's answer.
+func NewCollector(addrs map[string]string, timeout time.Duration, logger *zap.Logger) (*Collector, error) {
+	c := &Collector{
+		conns:   make(map[string]*grpc.ClientConn, len(addrs)),
+		timeout: timeout,
+		logger:  logger.Named("versions"),
+	}
+	for name, addr := range addrs {
+		conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			c.Close()
+			return nil, err
+		}
+		c.conns[name] = conn
+	}
+	return c, nil
+}
+
+// Report returns the gateway's build and asks every backend for its own,
+// concurrently. Backends that fail or are too old to report a version are
+// listed with an error rather than failing the report.
+func (c *Collector) Report(ctx context.Context) *Report {
+	report := &Report{
+		Gateway:  version.Get(),
+		Services: make(map[string]*Backend, len(c.conns)),
+	}
+
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+	for name, conn := range c.conns {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			backend := c.fetch(ctx, name, conn)
+			mu.Lock()
+			report.Services[name] = backend
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	return report
+}
+
+// fetch runs one backend's health check and reads the build from its header
+func (c *Collector) fetch(ctx context.Context, name string, conn *grpc.ClientConn) *Backend {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var header metadata.MD
+	_, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{}, grpc.Header(&header))
+	if err != nil {
+		c.logger.Warn("Backend version unavailable", zap.String("backend", name), zap.Error(err))
+		return &Backend{Error: "unavailable"}
+	}
+	info, ok := version.FromMetadata(header)
+	if !ok {
+		return &Backend{Error: "version not reported"}
+	}
+	return &Backend{Info: info}
+}
+
+// Close closes the backend connections
+func (c *Collector) Close() {
+	for name, conn := range c.conns {
+		if err := conn.Close(); err != nil {
+			c.logger.Warn("Failed to close backend connection", zap.String("backend", name), zap.Error(err))
+		}
+	}
+}
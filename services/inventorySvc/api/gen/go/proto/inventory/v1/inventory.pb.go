@@ -9,6 +9,7 @@ package inventoryv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -33,11 +34,33 @@ type InventoryItem struct {
 	ShelfLocation    string                 `protobuf:"bytes,7,opt,name=shelf_location,json=shelfLocation,proto3" json:"shelf_location,omitempty"`
 	ReorderThreshold int32                  `protobuf:"varint,8,opt,name=reorder_threshold,json=reorderThreshold,proto3" json:"reorder_threshold,omitempty"`
 	ReorderAmount    int32                  `protobuf:"varint,9,opt,name=reorder_amount,json=reorderAmount,proto3" json:"reorder_amount,omitempty"`
-	LastUpdated      string                 `protobuf:"bytes,10,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
-	CreatedAt        string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	NextCountDate    string                 `protobuf:"bytes,12,opt,name=next_count_date,json=nextCountDate,proto3" json:"next_count_date,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// RFC3339 forms of the *_ts fields, still populated until clients have moved over
+	//
+	// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
+	LastUpdated string `protobuf:"bytes,10,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
+	CreatedAt     string `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	NextCountDate string `protobuf:"bytes,12,opt,name=next_count_date,json=nextCountDate,proto3" json:"next_count_date,omitempty"`
+	// Returned units held back from sale because they are damaged
+	Damaged int32 `protobuf:"varint,13,opt,name=damaged,proto3" json:"damaged,omitempty"`
+	// Handling tags such as "hazmat", "fragile" or "cold-chain"
+	Tags []string `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Unit of measure. quantity, reserved and damaged are counted in
+	// selling_unit; one stocking_unit holds units_per_stocking_unit of them.
+	SellingUnit          string `protobuf:"bytes,15,opt,name=selling_unit,json=sellingUnit,proto3" json:"selling_unit,omitempty"`
+	StockingUnit         string `protobuf:"bytes,16,opt,name=stocking_unit,json=stockingUnit,proto3" json:"stocking_unit,omitempty"`
+	UnitsPerStockingUnit int32  `protobuf:"varint,17,opt,name=units_per_stocking_unit,json=unitsPerStockingUnit,proto3" json:"units_per_stocking_unit,omitempty"`
+	// Available quantity expressed in stocking units, e.g. 1.75 cases
+	AvailableStockingUnits float64 `protobuf:"fixed64,18,opt,name=available_stocking_units,json=availableStockingUnits,proto3" json:"available_stocking_units,omitempty"`
+	// Units removed beyond the available stock and still owed
+	Backordered int32 `protobuf:"varint,19,opt,name=backordered,proto3" json:"backordered,omitempty"`
+	// The item's own backorder policy: "allow", "deny", or empty to follow the
+	// service-wide setting
+	BackorderPolicy string                 `protobuf:"bytes,20,opt,name=backorder_policy,json=backorderPolicy,proto3" json:"backorder_policy,omitempty"`
+	LastUpdatedTs   *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=last_updated_ts,json=lastUpdatedTs,proto3" json:"last_updated_ts,omitempty"`
+	CreatedAtTs     *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=created_at_ts,json=createdAtTs,proto3" json:"created_at_ts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *InventoryItem) Reset() {
@@ -133,6 +156,7 @@ func (x *InventoryItem) GetReorderAmount() int32 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
 func (x *InventoryItem) GetLastUpdated() string {
 	if x != nil {
 		return x.LastUpdated
@@ -140,6 +164,7 @@ func (x *InventoryItem) GetLastUpdated() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
 func (x *InventoryItem) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
@@ -154,23 +179,99 @@ func (x *InventoryItem) GetNextCountDate() string {
 	return ""
 }
 
+func (x *InventoryItem) GetDamaged() int32 {
+	if x != nil {
+		return x.Damaged
+	}
+	return 0
+}
+
+func (x *InventoryItem) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *InventoryItem) GetSellingUnit() string {
+	if x != nil {
+		return x.SellingUnit
+	}
+	return ""
+}
+
+func (x *InventoryItem) GetStockingUnit() string {
+	if x != nil {
+		return x.StockingUnit
+	}
+	return ""
+}
+
+func (x *InventoryItem) GetUnitsPerStockingUnit() int32 {
+	if x != nil {
+		return x.UnitsPerStockingUnit
+	}
+	return 0
+}
+
+func (x *InventoryItem) GetAvailableStockingUnits() float64 {
+	if x != nil {
+		return x.AvailableStockingUnits
+	}
+	return 0
+}
+
+func (x *InventoryItem) GetBackordered() int32 {
+	if x != nil {
+		return x.Backordered
+	}
+	return 0
+}
+
+func (x *InventoryItem) GetBackorderPolicy() string {
+	if x != nil {
+		return x.BackorderPolicy
+	}
+	return ""
+}
+
+func (x *InventoryItem) GetLastUpdatedTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdatedTs
+	}
+	return nil
+}
+
+func (x *InventoryItem) GetCreatedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtTs
+	}
+	return nil
+}
+
 // StoreLocation represents a physical or virtual location where inventory is stored
 type StoreLocation struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // store, warehouse, fulfillment_center, online
-	AddressLine1  string                 `protobuf:"bytes,4,opt,name=address_line1,json=addressLine1,proto3" json:"address_line1,omitempty"`
-	AddressLine2  string                 `protobuf:"bytes,5,opt,name=address_line2,json=addressLine2,proto3" json:"address_line2,omitempty"`
-	City          string                 `protobuf:"bytes,6,opt,name=city,proto3" json:"city,omitempty"`
-	State         string                 `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
-	PostalCode    string                 `protobuf:"bytes,8,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
-	Country       string                 `protobuf:"bytes,9,opt,name=country,proto3" json:"country,omitempty"`
-	Phone         string                 `protobuf:"bytes,10,opt,name=phone,proto3" json:"phone,omitempty"`
-	Email         string                 `protobuf:"bytes,11,opt,name=email,proto3" json:"email,omitempty"`
-	Active        bool                   `protobuf:"varint,12,opt,name=active,proto3" json:"active,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type         string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // store, warehouse, fulfillment_center, online
+	AddressLine1 string                 `protobuf:"bytes,4,opt,name=address_line1,json=addressLine1,proto3" json:"address_line1,omitempty"`
+	AddressLine2 string                 `protobuf:"bytes,5,opt,name=address_line2,json=addressLine2,proto3" json:"address_line2,omitempty"`
+	City         string                 `protobuf:"bytes,6,opt,name=city,proto3" json:"city,omitempty"`
+	State        string                 `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	PostalCode   string                 `protobuf:"bytes,8,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Country      string                 `protobuf:"bytes,9,opt,name=country,proto3" json:"country,omitempty"`
+	Phone        string                 `protobuf:"bytes,10,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email        string                 `protobuf:"bytes,11,opt,name=email,proto3" json:"email,omitempty"`
+	Active       bool                   `protobuf:"varint,12,opt,name=active,proto3" json:"active,omitempty"`
+	// RFC3339 forms of the *_ts fields, still populated until clients have moved over
+	//
+	// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
+	CreatedAt string `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
 	UpdatedAt     string                 `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedAtTs   *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at_ts,json=createdAtTs,proto3" json:"created_at_ts,omitempty"`
+	UpdatedAtTs   *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at_ts,json=updatedAtTs,proto3" json:"updated_at_ts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -289,6 +390,7 @@ func (x *StoreLocation) GetActive() bool {
 	return false
 }
 
+// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
 func (x *StoreLocation) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
@@ -296,6 +398,7 @@ func (x *StoreLocation) GetCreatedAt() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
 func (x *StoreLocation) GetUpdatedAt() string {
 	if x != nil {
 		return x.UpdatedAt
@@ -303,6 +406,20 @@ func (x *StoreLocation) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *StoreLocation) GetCreatedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtTs
+	}
+	return nil
+}
+
+func (x *StoreLocation) GetUpdatedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAtTs
+	}
+	return nil
+}
+
 // InventoryTransfer represents a movement of inventory between locations
 type InventoryTransfer struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
@@ -319,10 +436,16 @@ type InventoryTransfer struct {
 	ExpectedDeliveryDate  string                 `protobuf:"bytes,11,opt,name=expected_delivery_date,json=expectedDeliveryDate,proto3" json:"expected_delivery_date,omitempty"`
 	ActualDeliveryDate    string                 `protobuf:"bytes,12,opt,name=actual_delivery_date,json=actualDeliveryDate,proto3" json:"actual_delivery_date,omitempty"`
 	Notes                 string                 `protobuf:"bytes,13,opt,name=notes,proto3" json:"notes,omitempty"`
-	CreatedAt             string                 `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt             string                 `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// RFC3339 forms of the *_ts fields, still populated until clients have moved over
+	//
+	// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
+	CreatedAt string `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
+	UpdatedAt     string                 `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedAtTs   *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_at_ts,json=createdAtTs,proto3" json:"created_at_ts,omitempty"`
+	UpdatedAtTs   *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=updated_at_ts,json=updatedAtTs,proto3" json:"updated_at_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InventoryTransfer) Reset() {
@@ -446,6 +569,7 @@ func (x *InventoryTransfer) GetNotes() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
 func (x *InventoryTransfer) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
@@ -453,6 +577,7 @@ func (x *InventoryTransfer) GetCreatedAt() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
 func (x *InventoryTransfer) GetUpdatedAt() string {
 	if x != nil {
 		return x.UpdatedAt
@@ -460,6 +585,20 @@ func (x *InventoryTransfer) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *InventoryTransfer) GetCreatedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtTs
+	}
+	return nil
+}
+
+func (x *InventoryTransfer) GetUpdatedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAtTs
+	}
+	return nil
+}
+
 // CreateInventoryRequest is the request for creating an inventory item
 type CreateInventoryRequest struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
@@ -470,6 +609,10 @@ type CreateInventoryRequest struct {
 	ShelfLocation    string                 `protobuf:"bytes,5,opt,name=shelf_location,json=shelfLocation,proto3" json:"shelf_location,omitempty"`
 	ReorderThreshold int32                  `protobuf:"varint,6,opt,name=reorder_threshold,json=reorderThreshold,proto3" json:"reorder_threshold,omitempty"`
 	ReorderAmount    int32                  `protobuf:"varint,7,opt,name=reorder_amount,json=reorderAmount,proto3" json:"reorder_amount,omitempty"`
+	Tags             []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Skips checking that product_id is a real product with this SKU, for
+	// bulk imports made while the product service is not available
+	SkipProductCheck bool `protobuf:"varint,9,opt,name=skip_product_check,json=skipProductCheck,proto3" json:"skip_product_check,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -553,6 +696,20 @@ func (x *CreateInventoryRequest) GetReorderAmount() int32 {
 	return 0
 }
 
+func (x *CreateInventoryRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateInventoryRequest) GetSkipProductCheck() bool {
+	if x != nil {
+		return x.SkipProductCheck
+	}
+	return false
+}
+
 // CreateInventoryResponse is the response for creating an inventory item
 type CreateInventoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -976,10 +1133,13 @@ func (x *DeleteInventoryResponse) GetSuccess() bool {
 
 // ListInventoryRequest is the request for listing inventory items
 type ListInventoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	StockStatus   string                 `protobuf:"bytes,3,opt,name=stock_status,json=stockStatus,proto3" json:"stock_status,omitempty"` // in_stock, low_stock, out_of_stock, all
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Limit       int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset      int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	StockStatus string                 `protobuf:"bytes,3,opt,name=stock_status,json=stockStatus,proto3" json:"stock_status,omitempty"` // in_stock, low_stock, out_of_stock, all
+	Tags        []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`                                  // Only items carrying all of these tags
+	// Only items modified after this time (RFC3339), listed oldest change first
+	UpdatedSince  string `protobuf:"bytes,5,opt,name=updated_since,json=updatedSince,proto3" json:"updated_since,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1035,13 +1195,30 @@ func (x *ListInventoryRequest) GetStockStatus() string {
 	return ""
 }
 
+func (x *ListInventoryRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListInventoryRequest) GetUpdatedSince() string {
+	if x != nil {
+		return x.UpdatedSince
+	}
+	return ""
+}
+
 // ListInventoryByLocationRequest is the request for listing inventory items by location
 type ListInventoryByLocationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LocationId    string                 `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	StockStatus   string                 `protobuf:"bytes,4,opt,name=stock_status,json=stockStatus,proto3" json:"stock_status,omitempty"` // in_stock, low_stock, out_of_stock, all
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	LocationId  string                 `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Limit       int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset      int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	StockStatus string                 `protobuf:"bytes,4,opt,name=stock_status,json=stockStatus,proto3" json:"stock_status,omitempty"` // in_stock, low_stock, out_of_stock, all
+	Tags        []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`                                  // Only items carrying all of these tags
+	// Only items modified after this time (RFC3339), listed oldest change first
+	UpdatedSince  string `protobuf:"bytes,6,opt,name=updated_since,json=updatedSince,proto3" json:"updated_since,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1104,10 +1281,25 @@ func (x *ListInventoryByLocationRequest) GetStockStatus() string {
 	return ""
 }
 
+func (x *ListInventoryByLocationRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListInventoryByLocationRequest) GetUpdatedSince() string {
+	if x != nil {
+		return x.UpdatedSince
+	}
+	return ""
+}
+
 // ListInventoryResponse is the response for listing inventory items
 type ListInventoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Inventories   []*InventoryItem       `protobuf:"bytes,1,rep,name=inventories,proto3" json:"inventories,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Number of matching items across all pages
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1149,13 +1341,22 @@ func (x *ListInventoryResponse) GetInventories() []*InventoryItem {
 	return nil
 }
 
+func (x *ListInventoryResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 // AddStockRequest is the request for adding stock
 type AddStockRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	PerformedBy   string                 `protobuf:"bytes,4,opt,name=performed_by,json=performedBy,proto3" json:"performed_by,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Quantity    int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reason      string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	PerformedBy string                 `protobuf:"bytes,4,opt,name=performed_by,json=performedBy,proto3" json:"performed_by,omitempty"`
+	// Unit of quantity: the item's selling unit (default) or its stocking unit
+	Unit          string `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1218,6 +1419,13 @@ func (x *AddStockRequest) GetPerformedBy() string {
 	return ""
 }
 
+func (x *AddStockRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
 // AddStockResponse is the response for adding stock
 type AddStockResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1265,11 +1473,13 @@ func (x *AddStockResponse) GetSuccess() bool {
 
 // RemoveStockRequest is the request for removing stock
 type RemoveStockRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	PerformedBy   string                 `protobuf:"bytes,4,opt,name=performed_by,json=performedBy,proto3" json:"performed_by,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Quantity    int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reason      string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	PerformedBy string                 `protobuf:"bytes,4,opt,name=performed_by,json=performedBy,proto3" json:"performed_by,omitempty"`
+	// Unit of quantity: the item's selling unit (default) or its stocking unit
+	Unit          string `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1332,6 +1542,13 @@ func (x *RemoveStockRequest) GetPerformedBy() string {
 	return ""
 }
 
+func (x *RemoveStockRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
 // RemoveStockResponse is the response for removing stock
 type RemoveStockResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1480,6 +1697,7 @@ type ReleaseReservationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	OrderId       string                 `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // When the item is reserved for this order, that much of its reservation is cancelled
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1528,6 +1746,13 @@ func (x *ReleaseReservationRequest) GetQuantity() int32 {
 	return 0
 }
 
+func (x *ReleaseReservationRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
 // ReleaseReservationResponse is the response for releasing a reservation
 type ReleaseReservationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1578,6 +1803,7 @@ type FulfillReservationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	OrderId       string                 `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // When the item is reserved for this order, its reservation is marked fulfilled
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1626,6 +1852,13 @@ func (x *FulfillReservationRequest) GetQuantity() int32 {
 	return 0
 }
 
+func (x *FulfillReservationRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
 // FulfillReservationResponse is the response for fulfilling a reservation
 type FulfillReservationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -3509,7 +3742,9 @@ type CompletePickupResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	TransactionId string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	CompletedAt   string                 `protobuf:"bytes,3,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
+	CompletedAt   string                 `protobuf:"bytes,3,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"` // RFC3339 form of completed_at_ts
+	CompletedAtTs *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=completed_at_ts,json=completedAtTs,proto3" json:"completed_at_ts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3558,6 +3793,7 @@ func (x *CompletePickupResponse) GetTransactionId() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
 func (x *CompletePickupResponse) GetCompletedAt() string {
 	if x != nil {
 		return x.CompletedAt
@@ -3565,6 +3801,13 @@ func (x *CompletePickupResponse) GetCompletedAt() string {
 	return ""
 }
 
+func (x *CompletePickupResponse) GetCompletedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAtTs
+	}
+	return nil
+}
+
 // CancelPickupRequest is the request for canceling an in-store pickup
 type CancelPickupRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -3744,9 +3987,11 @@ type InventoryHistoryEntry struct {
 	ReferenceId    string                 `protobuf:"bytes,7,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`       // e.g., order ID, transfer ID, etc.
 	ReferenceType  string                 `protobuf:"bytes,8,opt,name=reference_type,json=referenceType,proto3" json:"reference_type,omitempty"` // e.g., ORDER, TRANSFER, ADJUSTMENT, etc.
 	PerformedBy    string                 `protobuf:"bytes,9,opt,name=performed_by,json=performedBy,proto3" json:"performed_by,omitempty"`       // User ID who performed the change
-	CreatedAt      string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`            // Timestamp of the change
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
+	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`         // RFC3339 form of created_at_ts
+	CreatedAtTs   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at_ts,json=createdAtTs,proto3" json:"created_at_ts,omitempty"` // Timestamp of the change
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InventoryHistoryEntry) Reset() {
@@ -3842,6 +4087,7 @@ func (x *InventoryHistoryEntry) GetPerformedBy() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
 func (x *InventoryHistoryEntry) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
@@ -3849,6 +4095,13 @@ func (x *InventoryHistoryEntry) GetCreatedAt() string {
 	return ""
 }
 
+func (x *InventoryHistoryEntry) GetCreatedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtTs
+	}
+	return nil
+}
+
 // GetInventoryHistoryResponse is the response containing inventory history
 type GetInventoryHistoryResponse struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
@@ -4210,140 +4463,3241 @@ func (x *AdjustInventoryForOrderResponse) GetItems() []*InventoryAdjustmentResul
 	return nil
 }
 
-var File_inventory_v1_inventory_proto protoreflect.FileDescriptor
+// OrderReservation is the stock an inventory item holds for an order
+type OrderReservation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InventoryItemId string                 `protobuf:"bytes,1,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	ProductId       string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku             string                 `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`
+	LocationId      string                 `protobuf:"bytes,4,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Quantity        int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Status          string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // active, fulfilled, cancelled, expired
+	// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339 form of updated_at_ts
+	ExpiresAt     string                 `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Empty when the reservation does not expire
+	UpdatedAtTs   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at_ts,json=updatedAtTs,proto3" json:"updated_at_ts,omitempty"`
+	OrderId       string                 `protobuf:"bytes,10,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_inventory_v1_inventory_proto_rawDesc = "" +
-	"\n" +
-	"\x1cinventory/v1/inventory.proto\x12\finventory.v1\"\x8e\x03\n" +
-	"\rInventoryItem\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1a\n" +
-	"\breserved\x18\x04 \x01(\x05R\breserved\x12\x10\n" +
-	"\x03sku\x18\x05 \x01(\tR\x03sku\x12\x1f\n" +
-	"\vlocation_id\x18\x06 \x01(\tR\n" +
-	"locationId\x12%\n" +
-	"\x0eshelf_location\x18\a \x01(\tR\rshelfLocation\x12+\n" +
-	"\x11reorder_threshold\x18\b \x01(\x05R\x10reorderThreshold\x12%\n" +
-	"\x0ereorder_amount\x18\t \x01(\x05R\rreorderAmount\x12!\n" +
-	"\flast_updated\x18\n" +
-	" \x01(\tR\vlastUpdated\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\v \x01(\tR\tcreatedAt\x12&\n" +
-	"\x0fnext_count_date\x18\f \x01(\tR\rnextCountDate\"\xf8\x02\n" +
-	"\rStoreLocation\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04type\x12#\n" +
-	"\raddress_line1\x18\x04 \x01(\tR\faddressLine1\x12#\n" +
-	"\raddress_line2\x18\x05 \x01(\tR\faddressLine2\x12\x12\n" +
-	"\x04city\x18\x06 \x01(\tR\x04city\x12\x14\n" +
-	"\x05state\x18\a \x01(\tR\x05state\x12\x1f\n" +
-	"\vpostal_code\x18\b \x01(\tR\n" +
-	"postalCode\x12\x18\n" +
-	"\acountry\x18\t \x01(\tR\acountry\x12\x14\n" +
-	"\x05phone\x18\n" +
-	" \x01(\tR\x05phone\x12\x14\n" +
-	"\x05email\x18\v \x01(\tR\x05email\x12\x16\n" +
-	"\x06active\x18\f \x01(\bR\x06active\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\r \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\x0e \x01(\tR\tupdatedAt\"\x95\x04\n" +
-	"\x11InventoryTransfer\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
-	"\x12source_location_id\x18\x02 \x01(\tR\x10sourceLocationId\x126\n" +
-	"\x17destination_location_id\x18\x03 \x01(\tR\x15destinationLocationId\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x04 \x01(\tR\tproductId\x12\x10\n" +
-	"\x03sku\x18\x05 \x01(\tR\x03sku\x12\x1a\n" +
-	"\bquantity\x18\x06 \x01(\x05R\bquantity\x12\x16\n" +
-	"\x06status\x18\a \x01(\tR\x06status\x12!\n" +
-	"\frequested_by\x18\b \x01(\tR\vrequestedBy\x12\x1f\n" +
-	"\vapproved_by\x18\t \x01(\tR\n" +
-	"approvedBy\x12%\n" +
-	"\x0erequested_date\x18\n" +
-	" \x01(\tR\rrequestedDate\x124\n" +
-	"\x16expected_delivery_date\x18\v \x01(\tR\x14expectedDeliveryDate\x120\n" +
-	"\x14actual_delivery_date\x18\f \x01(\tR\x12actualDeliveryDate\x12\x14\n" +
-	"\x05notes\x18\r \x01(\tR\x05notes\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x0e \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\x0f \x01(\tR\tupdatedAt\"\x81\x02\n" +
-	"\x16CreateInventoryRequest\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x10\n" +
-	"\x03sku\x18\x03 \x01(\tR\x03sku\x12\x1f\n" +
-	"\vlocation_id\x18\x04 \x01(\tR\n" +
-	"locationId\x12%\n" +
-	"\x0eshelf_location\x18\x05 \x01(\tR\rshelfLocation\x12+\n" +
-	"\x11reorder_threshold\x18\x06 \x01(\x05R\x10reorderThreshold\x12%\n" +
-	"\x0ereorder_amount\x18\a \x01(\x05R\rreorderAmount\"T\n" +
-	"\x17CreateInventoryResponse\x129\n" +
-	"\tinventory\x18\x01 \x01(\v2\x1b.inventory.v1.InventoryItemR\tinventory\"%\n" +
-	"\x13GetInventoryRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"`\n" +
-	"\x1eGetInventoryByProductIDRequest\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1f\n" +
-	"\vlocation_id\x18\x02 \x01(\tR\n" +
-	"locationId\"M\n" +
-	"\x18GetInventoryBySKURequest\x12\x10\n" +
-	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1f\n" +
-	"\vlocation_id\x18\x02 \x01(\tR\n" +
-	"locationId\"Q\n" +
-	"\x14GetInventoryResponse\x129\n" +
-	"\tinventory\x18\x01 \x01(\v2\x1b.inventory.v1.InventoryItemR\tinventory\"S\n" +
-	"\x16UpdateInventoryRequest\x129\n" +
-	"\tinventory\x18\x01 \x01(\v2\x1b.inventory.v1.InventoryItemR\tinventory\"3\n" +
-	"\x17UpdateInventoryResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"(\n" +
-	"\x16DeleteInventoryRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"3\n" +
-	"\x17DeleteInventoryResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"g\n" +
-	"\x14ListInventoryRequest\x12\x14\n" +
-	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12!\n" +
-	"\fstock_status\x18\x03 \x01(\tR\vstockStatus\"\x92\x01\n" +
-	"\x1eListInventoryByLocationRequest\x12\x1f\n" +
-	"\vlocation_id\x18\x01 \x01(\tR\n" +
-	"locationId\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+func (x *OrderReservation) Reset() {
+	*x = OrderReservation{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderReservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderReservation) ProtoMessage() {}
+
+func (x *OrderReservation) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderReservation.ProtoReflect.Descriptor instead.
+func (*OrderReservation) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *OrderReservation) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *OrderReservation) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *OrderReservation) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *OrderReservation) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *OrderReservation) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderReservation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
+func (x *OrderReservation) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *OrderReservation) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *OrderReservation) GetUpdatedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAtTs
+	}
+	return nil
+}
+
+func (x *OrderReservation) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+// GetReservationsForOrderRequest is the request for listing an order's reservations
+type GetReservationsForOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReservationsForOrderRequest) Reset() {
+	*x = GetReservationsForOrderRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReservationsForOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationsForOrderRequest) ProtoMessage() {}
+
+func (x *GetReservationsForOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationsForOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetReservationsForOrderRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetReservationsForOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+// GetReservationsForOrderResponse lists an order's reservations by location
+type GetReservationsForOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Reservations  []*OrderReservation    `protobuf:"bytes,2,rep,name=reservations,proto3" json:"reservations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReservationsForOrderResponse) Reset() {
+	*x = GetReservationsForOrderResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReservationsForOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationsForOrderResponse) ProtoMessage() {}
+
+func (x *GetReservationsForOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationsForOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetReservationsForOrderResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetReservationsForOrderResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *GetReservationsForOrderResponse) GetReservations() []*OrderReservation {
+	if x != nil {
+		return x.Reservations
+	}
+	return nil
+}
+
+// ReleaseAllForOrderRequest is the request for releasing an order's reservations
+type ReleaseAllForOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseAllForOrderRequest) Reset() {
+	*x = ReleaseAllForOrderRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseAllForOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseAllForOrderRequest) ProtoMessage() {}
+
+func (x *ReleaseAllForOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseAllForOrderRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseAllForOrderRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ReleaseAllForOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+// ReleaseAllForOrderResponse lists the reservations that were released, with
+// the quantity each one held
+type ReleaseAllForOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Released      []*OrderReservation    `protobuf:"bytes,2,rep,name=released,proto3" json:"released,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseAllForOrderResponse) Reset() {
+	*x = ReleaseAllForOrderResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseAllForOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseAllForOrderResponse) ProtoMessage() {}
+
+func (x *ReleaseAllForOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseAllForOrderResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseAllForOrderResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ReleaseAllForOrderResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ReleaseAllForOrderResponse) GetReleased() []*OrderReservation {
+	if x != nil {
+		return x.Released
+	}
+	return nil
+}
+
+// ReconcileReservationsRequest is the request for reconciling order
+// reservations against the order service
+type ReconcileReservationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Report what would be released without releasing it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileReservationsRequest) Reset() {
+	*x = ReconcileReservationsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileReservationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileReservationsRequest) ProtoMessage() {}
+
+func (x *ReconcileReservationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileReservationsRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileReservationsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ReconcileReservationsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// ReservationCorrection is an order whose leaked reservations were released
+type ReservationCorrection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OrderState    string                 `protobuf:"bytes,2,opt,name=order_state,json=orderState,proto3" json:"order_state,omitempty"` // cancelled, completed, missing
+	Reservations  []*OrderReservation    `protobuf:"bytes,3,rep,name=reservations,proto3" json:"reservations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservationCorrection) Reset() {
+	*x = ReservationCorrection{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservationCorrection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationCorrection) ProtoMessage() {}
+
+func (x *ReservationCorrection) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationCorrection.ProtoReflect.Descriptor instead.
+func (*ReservationCorrection) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ReservationCorrection) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ReservationCorrection) GetOrderState() string {
+	if x != nil {
+		return x.OrderState
+	}
+	return ""
+}
+
+func (x *ReservationCorrection) GetReservations() []*OrderReservation {
+	if x != nil {
+		return x.Reservations
+	}
+	return nil
+}
+
+// ReconcileReservationsResponse sums up a reconciliation pass
+type ReconcileReservationsResponse struct {
+	state               protoimpl.MessageState   `protogen:"open.v1"`
+	ReservationsChecked int32                    `protobuf:"varint,1,opt,name=reservations_checked,json=reservationsChecked,proto3" json:"reservations_checked,omitempty"`
+	OrdersChecked       int32                    `protobuf:"varint,2,opt,name=orders_checked,json=ordersChecked,proto3" json:"orders_checked,omitempty"`
+	Corrections         []*ReservationCorrection `protobuf:"bytes,3,rep,name=corrections,proto3" json:"corrections,omitempty"`
+	Failed              int32                    `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"` // Orders that could not be looked up or released
+	DryRun              bool                     `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ReconcileReservationsResponse) Reset() {
+	*x = ReconcileReservationsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileReservationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileReservationsResponse) ProtoMessage() {}
+
+func (x *ReconcileReservationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileReservationsResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileReservationsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ReconcileReservationsResponse) GetReservationsChecked() int32 {
+	if x != nil {
+		return x.ReservationsChecked
+	}
+	return 0
+}
+
+func (x *ReconcileReservationsResponse) GetOrdersChecked() int32 {
+	if x != nil {
+		return x.OrdersChecked
+	}
+	return 0
+}
+
+func (x *ReconcileReservationsResponse) GetCorrections() []*ReservationCorrection {
+	if x != nil {
+		return x.Corrections
+	}
+	return nil
+}
+
+func (x *ReconcileReservationsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ReconcileReservationsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// BackInStockSubscription is a user's pending back-in-stock alert for a product
+type BackInStockSubscription struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId    string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339 form of created_at_ts
+	CreatedAtTs   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at_ts,json=createdAtTs,proto3" json:"created_at_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackInStockSubscription) Reset() {
+	*x = BackInStockSubscription{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackInStockSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackInStockSubscription) ProtoMessage() {}
+
+func (x *BackInStockSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackInStockSubscription.ProtoReflect.Descriptor instead.
+func (*BackInStockSubscription) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *BackInStockSubscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BackInStockSubscription) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BackInStockSubscription) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
+func (x *BackInStockSubscription) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *BackInStockSubscription) GetCreatedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtTs
+	}
+	return nil
+}
+
+// SubscribeBackInStockRequest subscribes a user to a product's back-in-stock alert
+type SubscribeBackInStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeBackInStockRequest) Reset() {
+	*x = SubscribeBackInStockRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeBackInStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeBackInStockRequest) ProtoMessage() {}
+
+func (x *SubscribeBackInStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeBackInStockRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeBackInStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SubscribeBackInStockRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubscribeBackInStockRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+// SubscribeBackInStockResponse returns the subscription; already_subscribed is
+// set when the user was subscribed before this request
+type SubscribeBackInStockResponse struct {
+	state             protoimpl.MessageState   `protogen:"open.v1"`
+	Subscription      *BackInStockSubscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	AlreadySubscribed bool                     `protobuf:"varint,2,opt,name=already_subscribed,json=alreadySubscribed,proto3" json:"already_subscribed,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SubscribeBackInStockResponse) Reset() {
+	*x = SubscribeBackInStockResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeBackInStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeBackInStockResponse) ProtoMessage() {}
+
+func (x *SubscribeBackInStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeBackInStockResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeBackInStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SubscribeBackInStockResponse) GetSubscription() *BackInStockSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *SubscribeBackInStockResponse) GetAlreadySubscribed() bool {
+	if x != nil {
+		return x.AlreadySubscribed
+	}
+	return false
+}
+
+// UnsubscribeBackInStockRequest removes a user's back-in-stock alert
+type UnsubscribeBackInStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeBackInStockRequest) Reset() {
+	*x = UnsubscribeBackInStockRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeBackInStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeBackInStockRequest) ProtoMessage() {}
+
+func (x *UnsubscribeBackInStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeBackInStockRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeBackInStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UnsubscribeBackInStockRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnsubscribeBackInStockRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+// UnsubscribeBackInStockResponse reports whether the alert was removed
+type UnsubscribeBackInStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeBackInStockResponse) Reset() {
+	*x = UnsubscribeBackInStockResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeBackInStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeBackInStockResponse) ProtoMessage() {}
+
+func (x *UnsubscribeBackInStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeBackInStockResponse.ProtoReflect.Descriptor instead.
+func (*UnsubscribeBackInStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *UnsubscribeBackInStockResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// NotifyBackInStockRequest reports a product's new available-to-promise quantity
+type NotifyBackInStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Available     int32                  `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifyBackInStockRequest) Reset() {
+	*x = NotifyBackInStockRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyBackInStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyBackInStockRequest) ProtoMessage() {}
+
+func (x *NotifyBackInStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyBackInStockRequest.ProtoReflect.Descriptor instead.
+func (*NotifyBackInStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *NotifyBackInStockRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *NotifyBackInStockRequest) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+// NotifyBackInStockResponse reports how many notifications were queued
+type NotifyBackInStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NotifiedCount int32                  `protobuf:"varint,1,opt,name=notified_count,json=notifiedCount,proto3" json:"notified_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifyBackInStockResponse) Reset() {
+	*x = NotifyBackInStockResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyBackInStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyBackInStockResponse) ProtoMessage() {}
+
+func (x *NotifyBackInStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyBackInStockResponse.ProtoReflect.Descriptor instead.
+func (*NotifyBackInStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *NotifyBackInStockResponse) GetNotifiedCount() int32 {
+	if x != nil {
+		return x.NotifiedCount
+	}
+	return 0
+}
+
+// RestockReturnRequest puts returned units back into inventory
+type RestockReturnRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Optional; defaults to the product's first inventory item
+	LocationId string `protobuf:"bytes,2,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Quantity   int32  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Damaged units go to the damaged bucket instead of the sellable quantity
+	Damaged bool `protobuf:"varint,4,opt,name=damaged,proto3" json:"damaged,omitempty"`
+	// Return (RMA) the units came from
+	ReferenceId   string `protobuf:"bytes,5,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	PerformedBy   string `protobuf:"bytes,6,opt,name=performed_by,json=performedBy,proto3" json:"performed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestockReturnRequest) Reset() {
+	*x = RestockReturnRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestockReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestockReturnRequest) ProtoMessage() {}
+
+func (x *RestockReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestockReturnRequest.ProtoReflect.Descriptor instead.
+func (*RestockReturnRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *RestockReturnRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RestockReturnRequest) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *RestockReturnRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *RestockReturnRequest) GetDamaged() bool {
+	if x != nil {
+		return x.Damaged
+	}
+	return false
+}
+
+func (x *RestockReturnRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *RestockReturnRequest) GetPerformedBy() string {
+	if x != nil {
+		return x.PerformedBy
+	}
+	return ""
+}
+
+// RestockReturnResponse returns the updated inventory item
+type RestockReturnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Inventory     *InventoryItem         `protobuf:"bytes,1,opt,name=inventory,proto3" json:"inventory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestockReturnResponse) Reset() {
+	*x = RestockReturnResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestockReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestockReturnResponse) ProtoMessage() {}
+
+func (x *RestockReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestockReturnResponse.ProtoReflect.Descriptor instead.
+func (*RestockReturnResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RestockReturnResponse) GetInventory() *InventoryItem {
+	if x != nil {
+		return x.Inventory
+	}
+	return nil
+}
+
+// ListLowStockItemsRequest lists inventory items that need reordering
+type ListLowStockItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional; lists across all locations when empty
+	LocationId    string `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Limit         int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLowStockItemsRequest) Reset() {
+	*x = ListLowStockItemsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLowStockItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLowStockItemsRequest) ProtoMessage() {}
+
+func (x *ListLowStockItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLowStockItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListLowStockItemsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListLowStockItemsRequest) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *ListLowStockItemsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListLowStockItemsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// ListDueCountsRequest lists inventory items whose next count date has come
+type ListDueCountsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional; lists across all locations when empty
+	LocationId string `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	// ISO-8601 date or date-time, or Unix seconds or milliseconds; defaults to now
+	AsOf          string `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	Limit         int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDueCountsRequest) Reset() {
+	*x = ListDueCountsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDueCountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDueCountsRequest) ProtoMessage() {}
+
+func (x *ListDueCountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDueCountsRequest.ProtoReflect.Descriptor instead.
+func (*ListDueCountsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListDueCountsRequest) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *ListDueCountsRequest) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
+func (x *ListDueCountsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDueCountsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// CountLowStockRequest counts low-stock inventory items
+type CountLowStockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional; counts across all locations when empty
+	LocationId    string `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountLowStockRequest) Reset() {
+	*x = CountLowStockRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountLowStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountLowStockRequest) ProtoMessage() {}
+
+func (x *CountLowStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountLowStockRequest.ProtoReflect.Descriptor instead.
+func (*CountLowStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CountLowStockRequest) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+// CountLowStockResponse returns the number of low-stock inventory items
+type CountLowStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountLowStockResponse) Reset() {
+	*x = CountLowStockResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountLowStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountLowStockResponse) ProtoMessage() {}
+
+func (x *CountLowStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountLowStockResponse.ProtoReflect.Descriptor instead.
+func (*CountLowStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CountLowStockResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// SetUnitOfMeasureRequest sets the units an inventory item is sold and stocked in
+type SetUnitOfMeasureRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SellingUnit          string                 `protobuf:"bytes,2,opt,name=selling_unit,json=sellingUnit,proto3" json:"selling_unit,omitempty"`                                 // Default "each"
+	StockingUnit         string                 `protobuf:"bytes,3,opt,name=stocking_unit,json=stockingUnit,proto3" json:"stocking_unit,omitempty"`                              // Default: the selling unit
+	UnitsPerStockingUnit int32                  `protobuf:"varint,4,opt,name=units_per_stocking_unit,json=unitsPerStockingUnit,proto3" json:"units_per_stocking_unit,omitempty"` // Must be positive; 1 when both units are the same
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SetUnitOfMeasureRequest) Reset() {
+	*x = SetUnitOfMeasureRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUnitOfMeasureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUnitOfMeasureRequest) ProtoMessage() {}
+
+func (x *SetUnitOfMeasureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUnitOfMeasureRequest.ProtoReflect.Descriptor instead.
+func (*SetUnitOfMeasureRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SetUnitOfMeasureRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetUnitOfMeasureRequest) GetSellingUnit() string {
+	if x != nil {
+		return x.SellingUnit
+	}
+	return ""
+}
+
+func (x *SetUnitOfMeasureRequest) GetStockingUnit() string {
+	if x != nil {
+		return x.StockingUnit
+	}
+	return ""
+}
+
+func (x *SetUnitOfMeasureRequest) GetUnitsPerStockingUnit() int32 {
+	if x != nil {
+		return x.UnitsPerStockingUnit
+	}
+	return 0
+}
+
+// SetUnitOfMeasureResponse returns the updated item
+type SetUnitOfMeasureResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Inventory     *InventoryItem         `protobuf:"bytes,1,opt,name=inventory,proto3" json:"inventory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUnitOfMeasureResponse) Reset() {
+	*x = SetUnitOfMeasureResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUnitOfMeasureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUnitOfMeasureResponse) ProtoMessage() {}
+
+func (x *SetUnitOfMeasureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUnitOfMeasureResponse.ProtoReflect.Descriptor instead.
+func (*SetUnitOfMeasureResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SetUnitOfMeasureResponse) GetInventory() *InventoryItem {
+	if x != nil {
+		return x.Inventory
+	}
+	return nil
+}
+
+// SetBackorderPolicyRequest sets whether an inventory item may be backordered
+type SetBackorderPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Policy        string                 `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"` // "allow", "deny", or empty to follow the service-wide setting
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBackorderPolicyRequest) Reset() {
+	*x = SetBackorderPolicyRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBackorderPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBackorderPolicyRequest) ProtoMessage() {}
+
+func (x *SetBackorderPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBackorderPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetBackorderPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SetBackorderPolicyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetBackorderPolicyRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+// SetBackorderPolicyResponse returns the updated item
+type SetBackorderPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Inventory     *InventoryItem         `protobuf:"bytes,1,opt,name=inventory,proto3" json:"inventory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBackorderPolicyResponse) Reset() {
+	*x = SetBackorderPolicyResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBackorderPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBackorderPolicyResponse) ProtoMessage() {}
+
+func (x *SetBackorderPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBackorderPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetBackorderPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SetBackorderPolicyResponse) GetInventory() *InventoryItem {
+	if x != nil {
+		return x.Inventory
+	}
+	return nil
+}
+
+// UpdateInventoryTagsRequest adds and removes tags on inventory items at a location
+type UpdateInventoryTagsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LocationId string                 `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	// Optional; every item at the location is updated when empty
+	ItemIds       []string `protobuf:"bytes,2,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	AddTags       []string `protobuf:"bytes,3,rep,name=add_tags,json=addTags,proto3" json:"add_tags,omitempty"`
+	RemoveTags    []string `protobuf:"bytes,4,rep,name=remove_tags,json=removeTags,proto3" json:"remove_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateInventoryTagsRequest) Reset() {
+	*x = UpdateInventoryTagsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInventoryTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInventoryTagsRequest) ProtoMessage() {}
+
+func (x *UpdateInventoryTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInventoryTagsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInventoryTagsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *UpdateInventoryTagsRequest) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *UpdateInventoryTagsRequest) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+func (x *UpdateInventoryTagsRequest) GetAddTags() []string {
+	if x != nil {
+		return x.AddTags
+	}
+	return nil
+}
+
+func (x *UpdateInventoryTagsRequest) GetRemoveTags() []string {
+	if x != nil {
+		return x.RemoveTags
+	}
+	return nil
+}
+
+// UpdateInventoryTagsResponse returns the number of items the update matched
+type UpdateInventoryTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchedCount  int64                  `protobuf:"varint,1,opt,name=matched_count,json=matchedCount,proto3" json:"matched_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateInventoryTagsResponse) Reset() {
+	*x = UpdateInventoryTagsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInventoryTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInventoryTagsResponse) ProtoMessage() {}
+
+func (x *UpdateInventoryTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInventoryTagsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateInventoryTagsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *UpdateInventoryTagsResponse) GetMatchedCount() int64 {
+	if x != nil {
+		return x.MatchedCount
+	}
+	return 0
+}
+
+// MergeDuplicateInventoryRequest names the location whose duplicate items are merged
+type MergeDuplicateInventoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LocationId    string                 `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeDuplicateInventoryRequest) Reset() {
+	*x = MergeDuplicateInventoryRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeDuplicateInventoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeDuplicateInventoryRequest) ProtoMessage() {}
+
+func (x *MergeDuplicateInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeDuplicateInventoryRequest.ProtoReflect.Descriptor instead.
+func (*MergeDuplicateInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *MergeDuplicateInventoryRequest) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+// DuplicateMerge reports how the items sharing one SKU were consolidated
+type DuplicateMerge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	KeptItemId    string                 `protobuf:"bytes,2,opt,name=kept_item_id,json=keptItemId,proto3" json:"kept_item_id,omitempty"`
+	MergedItemIds []string               `protobuf:"bytes,3,rep,name=merged_item_ids,json=mergedItemIds,proto3" json:"merged_item_ids,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reserved      int32                  `protobuf:"varint,5,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	Damaged       int32                  `protobuf:"varint,6,opt,name=damaged,proto3" json:"damaged,omitempty"`
+	// First of order_ids; kept for older clients
+	//
+	// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
+	OrderId string `protobuf:"bytes,7,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// No longer set: items reserved for different orders are merged too
+	//
+	// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
+	Skipped string `protobuf:"bytes,8,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// Orders whose active reservations now point at the kept item
+	OrderIds      []string `protobuf:"bytes,9,rep,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateMerge) Reset() {
+	*x = DuplicateMerge{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateMerge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateMerge) ProtoMessage() {}
+
+func (x *DuplicateMerge) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateMerge.ProtoReflect.Descriptor instead.
+func (*DuplicateMerge) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *DuplicateMerge) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *DuplicateMerge) GetKeptItemId() string {
+	if x != nil {
+		return x.KeptItemId
+	}
+	return ""
+}
+
+func (x *DuplicateMerge) GetMergedItemIds() []string {
+	if x != nil {
+		return x.MergedItemIds
+	}
+	return nil
+}
+
+func (x *DuplicateMerge) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *DuplicateMerge) GetReserved() int32 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *DuplicateMerge) GetDamaged() int32 {
+	if x != nil {
+		return x.Damaged
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
+func (x *DuplicateMerge) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
+func (x *DuplicateMerge) GetSkipped() string {
+	if x != nil {
+		return x.Skipped
+	}
+	return ""
+}
+
+func (x *DuplicateMerge) GetOrderIds() []string {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+// MergeDuplicateInventoryResponse lists one entry per duplicated SKU
+type MergeDuplicateInventoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merges        []*DuplicateMerge      `protobuf:"bytes,1,rep,name=merges,proto3" json:"merges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeDuplicateInventoryResponse) Reset() {
+	*x = MergeDuplicateInventoryResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeDuplicateInventoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeDuplicateInventoryResponse) ProtoMessage() {}
+
+func (x *MergeDuplicateInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeDuplicateInventoryResponse.ProtoReflect.Descriptor instead.
+func (*MergeDuplicateInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *MergeDuplicateInventoryResponse) GetMerges() []*DuplicateMerge {
+	if x != nil {
+		return x.Merges
+	}
+	return nil
+}
+
+// PurchaseOrderLine is one line of a purchase order receipt
+type PurchaseOrderLine struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LineId          string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	InventoryItemId string                 `protobuf:"bytes,2,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	OrderedQuantity int32                  `protobuf:"varint,3,opt,name=ordered_quantity,json=orderedQuantity,proto3" json:"ordered_quantity,omitempty"`
+	// Total units received for the line so far, not the units in this delivery
+	ReceivedQuantity int32 `protobuf:"varint,4,opt,name=received_quantity,json=receivedQuantity,proto3" json:"received_quantity,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PurchaseOrderLine) Reset() {
+	*x = PurchaseOrderLine{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseOrderLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseOrderLine) ProtoMessage() {}
+
+func (x *PurchaseOrderLine) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseOrderLine.ProtoReflect.Descriptor instead.
+func (*PurchaseOrderLine) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *PurchaseOrderLine) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *PurchaseOrderLine) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *PurchaseOrderLine) GetOrderedQuantity() int32 {
+	if x != nil {
+		return x.OrderedQuantity
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetReceivedQuantity() int32 {
+	if x != nil {
+		return x.ReceivedQuantity
+	}
+	return 0
+}
+
+// ReceivePurchaseOrderRequest reports what has been received against a purchase order
+type ReceivePurchaseOrderRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrderId string                 `protobuf:"bytes,1,opt,name=purchase_order_id,json=purchaseOrderId,proto3" json:"purchase_order_id,omitempty"`
+	Lines           []*PurchaseOrderLine   `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	ReceivedBy      string                 `protobuf:"bytes,3,opt,name=received_by,json=receivedBy,proto3" json:"received_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReceivePurchaseOrderRequest) Reset() {
+	*x = ReceivePurchaseOrderRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceivePurchaseOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceivePurchaseOrderRequest) ProtoMessage() {}
+
+func (x *ReceivePurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceivePurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*ReceivePurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ReceivePurchaseOrderRequest) GetPurchaseOrderId() string {
+	if x != nil {
+		return x.PurchaseOrderId
+	}
+	return ""
+}
+
+func (x *ReceivePurchaseOrderRequest) GetLines() []*PurchaseOrderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *ReceivePurchaseOrderRequest) GetReceivedBy() string {
+	if x != nil {
+		return x.ReceivedBy
+	}
+	return ""
+}
+
+// ReceivePurchaseOrderResponse holds the receiving state of the purchase order
+type ReceivePurchaseOrderResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrderId string                 `protobuf:"bytes,1,opt,name=purchase_order_id,json=purchaseOrderId,proto3" json:"purchase_order_id,omitempty"`
+	// PARTIALLY_RECEIVED or RECEIVED
+	Status string               `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Lines  []*PurchaseOrderLine `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
+	UpdatedAt     string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339 form of updated_at_ts
+	UpdatedAtTs   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at_ts,json=updatedAtTs,proto3" json:"updated_at_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceivePurchaseOrderResponse) Reset() {
+	*x = ReceivePurchaseOrderResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceivePurchaseOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceivePurchaseOrderResponse) ProtoMessage() {}
+
+func (x *ReceivePurchaseOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceivePurchaseOrderResponse.ProtoReflect.Descriptor instead.
+func (*ReceivePurchaseOrderResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ReceivePurchaseOrderResponse) GetPurchaseOrderId() string {
+	if x != nil {
+		return x.PurchaseOrderId
+	}
+	return ""
+}
+
+func (x *ReceivePurchaseOrderResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReceivePurchaseOrderResponse) GetLines() []*PurchaseOrderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
+func (x *ReceivePurchaseOrderResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *ReceivePurchaseOrderResponse) GetUpdatedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAtTs
+	}
+	return nil
+}
+
+// ExportStockAdjustmentsRequest selects the stock adjustments to export. Empty
+// fields do not filter.
+type ExportStockAdjustmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LocationId    string                 `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // Matches the reason exactly, ignoring case
+	From          string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`     // RFC3339, inclusive
+	To            string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`         // RFC3339, exclusive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportStockAdjustmentsRequest) Reset() {
+	*x = ExportStockAdjustmentsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportStockAdjustmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStockAdjustmentsRequest) ProtoMessage() {}
+
+func (x *ExportStockAdjustmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStockAdjustmentsRequest.ProtoReflect.Descriptor instead.
+func (*ExportStockAdjustmentsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ExportStockAdjustmentsRequest) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *ExportStockAdjustmentsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ExportStockAdjustmentsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ExportStockAdjustmentsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+// ExportStockAdjustmentsResponse holds the exported CSV
+type ExportStockAdjustmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Count         int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportStockAdjustmentsResponse) Reset() {
+	*x = ExportStockAdjustmentsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportStockAdjustmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStockAdjustmentsResponse) ProtoMessage() {}
+
+func (x *ExportStockAdjustmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStockAdjustmentsResponse.ProtoReflect.Descriptor instead.
+func (*ExportStockAdjustmentsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ExportStockAdjustmentsResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportStockAdjustmentsResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportStockAdjustmentsResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportStockAdjustmentsResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// AllocationLine is a quantity of a product an order needs
+type AllocationLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllocationLine) Reset() {
+	*x = AllocationLine{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllocationLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocationLine) ProtoMessage() {}
+
+func (x *AllocationLine) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocationLine.ProtoReflect.Descriptor instead.
+func (*AllocationLine) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *AllocationLine) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AllocationLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// ReserveWithAllocationRequest asks to reserve an order across locations
+type ReserveWithAllocationRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Lines   []*AllocationLine      `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	// MINIMIZE_SHIPMENTS (default) or PREFER_LOCATION
+	Strategy string `protobuf:"bytes,3,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// Required for PREFER_LOCATION
+	PreferredLocationId string `protobuf:"bytes,4,opt,name=preferred_location_id,json=preferredLocationId,proto3" json:"preferred_location_id,omitempty"`
+	// How long the reservations are held; 0 uses the service default. Must not
+	// exceed the configured maximum.
+	TtlSeconds    int64 `protobuf:"varint,5,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveWithAllocationRequest) Reset() {
+	*x = ReserveWithAllocationRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveWithAllocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveWithAllocationRequest) ProtoMessage() {}
+
+func (x *ReserveWithAllocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveWithAllocationRequest.ProtoReflect.Descriptor instead.
+func (*ReserveWithAllocationRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ReserveWithAllocationRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ReserveWithAllocationRequest) GetLines() []*AllocationLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *ReserveWithAllocationRequest) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *ReserveWithAllocationRequest) GetPreferredLocationId() string {
+	if x != nil {
+		return x.PreferredLocationId
+	}
+	return ""
+}
+
+func (x *ReserveWithAllocationRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+// Allocation is the quantity of a product reserved on an inventory item
+type Allocation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	LocationId      string                 `protobuf:"bytes,2,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	InventoryItemId string                 `protobuf:"bytes,3,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	Quantity        int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Allocation) Reset() {
+	*x = Allocation{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Allocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Allocation) ProtoMessage() {}
+
+func (x *Allocation) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Allocation.ProtoReflect.Descriptor instead.
+func (*Allocation) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *Allocation) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *Allocation) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *Allocation) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *Allocation) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// ReserveWithAllocationResponse is the allocation plan that was reserved
+type ReserveWithAllocationResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	OrderId     string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Strategy    string                 `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Allocations []*Allocation          `protobuf:"bytes,3,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	// Number of distinct locations the order ships from
+	Shipments int32 `protobuf:"varint,4,opt,name=shipments,proto3" json:"shipments,omitempty"`
+	// When the reservations expire (RFC 3339); empty when they do not
+	ExpiresAt     string `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveWithAllocationResponse) Reset() {
+	*x = ReserveWithAllocationResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveWithAllocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveWithAllocationResponse) ProtoMessage() {}
+
+func (x *ReserveWithAllocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveWithAllocationResponse.ProtoReflect.Descriptor instead.
+func (*ReserveWithAllocationResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ReserveWithAllocationResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ReserveWithAllocationResponse) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *ReserveWithAllocationResponse) GetAllocations() []*Allocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+func (x *ReserveWithAllocationResponse) GetShipments() int32 {
+	if x != nil {
+		return x.Shipments
+	}
+	return 0
+}
+
+func (x *ReserveWithAllocationResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+// TransferStockRequest moves stock of a SKU straight between two locations
+type TransferStockRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Sku            string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	FromLocationId string                 `protobuf:"bytes,2,opt,name=from_location_id,json=fromLocationId,proto3" json:"from_location_id,omitempty"`
+	ToLocationId   string                 `protobuf:"bytes,3,opt,name=to_location_id,json=toLocationId,proto3" json:"to_location_id,omitempty"`
+	Quantity       int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	PerformedBy    string                 `protobuf:"bytes,5,opt,name=performed_by,json=performedBy,proto3" json:"performed_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TransferStockRequest) Reset() {
+	*x = TransferStockRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferStockRequest) ProtoMessage() {}
+
+func (x *TransferStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferStockRequest.ProtoReflect.Descriptor instead.
+func (*TransferStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *TransferStockRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *TransferStockRequest) GetFromLocationId() string {
+	if x != nil {
+		return x.FromLocationId
+	}
+	return ""
+}
+
+func (x *TransferStockRequest) GetToLocationId() string {
+	if x != nil {
+		return x.ToLocationId
+	}
+	return ""
+}
+
+func (x *TransferStockRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *TransferStockRequest) GetPerformedBy() string {
+	if x != nil {
+		return x.PerformedBy
+	}
+	return ""
+}
+
+// TransferStockResponse is the outcome of a stock transfer
+type TransferStockResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Shared by the history entries recorded on both items
+	TransferId  string         `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	Source      *InventoryItem `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Destination *InventoryItem `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	// Set when the destination had no item for the SKU yet
+	DestinationCreated bool `protobuf:"varint,4,opt,name=destination_created,json=destinationCreated,proto3" json:"destination_created,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TransferStockResponse) Reset() {
+	*x = TransferStockResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferStockResponse) ProtoMessage() {}
+
+func (x *TransferStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferStockResponse.ProtoReflect.Descriptor instead.
+func (*TransferStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *TransferStockResponse) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *TransferStockResponse) GetSource() *InventoryItem {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *TransferStockResponse) GetDestination() *InventoryItem {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *TransferStockResponse) GetDestinationCreated() bool {
+	if x != nil {
+		return x.DestinationCreated
+	}
+	return false
+}
+
+// BatchAdjustLine changes the stock of a SKU at the batch's location
+type BatchAdjustLine struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Sku   string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	// Negative for a loss
+	Quantity      int32 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchAdjustLine) Reset() {
+	*x = BatchAdjustLine{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchAdjustLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAdjustLine) ProtoMessage() {}
+
+func (x *BatchAdjustLine) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAdjustLine.ProtoReflect.Descriptor instead.
+func (*BatchAdjustLine) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *BatchAdjustLine) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *BatchAdjustLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// BatchAdjustRequest applies several adjustments at one location with one reason
+type BatchAdjustRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LocationId    string                 `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Lines         []*BatchAdjustLine     `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	PerformedBy   string                 `protobuf:"bytes,4,opt,name=performed_by,json=performedBy,proto3" json:"performed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchAdjustRequest) Reset() {
+	*x = BatchAdjustRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchAdjustRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAdjustRequest) ProtoMessage() {}
+
+func (x *BatchAdjustRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAdjustRequest.ProtoReflect.Descriptor instead.
+func (*BatchAdjustRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *BatchAdjustRequest) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *BatchAdjustRequest) GetLines() []*BatchAdjustLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *BatchAdjustRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BatchAdjustRequest) GetPerformedBy() string {
+	if x != nil {
+		return x.PerformedBy
+	}
+	return ""
+}
+
+// BatchAdjustLineResult is the outcome of one line of a batch adjustment
+type BatchAdjustLineResult struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Sku             string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	InventoryItemId string                 `protobuf:"bytes,2,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	QuantityBefore  int32                  `protobuf:"varint,3,opt,name=quantity_before,json=quantityBefore,proto3" json:"quantity_before,omitempty"`
+	QuantityAfter   int32                  `protobuf:"varint,4,opt,name=quantity_after,json=quantityAfter,proto3" json:"quantity_after,omitempty"`
+	Success         bool                   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// Why the line was not applied; empty on success
+	ErrorMessage  string `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchAdjustLineResult) Reset() {
+	*x = BatchAdjustLineResult{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchAdjustLineResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAdjustLineResult) ProtoMessage() {}
+
+func (x *BatchAdjustLineResult) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAdjustLineResult.ProtoReflect.Descriptor instead.
+func (*BatchAdjustLineResult) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *BatchAdjustLineResult) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *BatchAdjustLineResult) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *BatchAdjustLineResult) GetQuantityBefore() int32 {
+	if x != nil {
+		return x.QuantityBefore
+	}
+	return 0
+}
+
+func (x *BatchAdjustLineResult) GetQuantityAfter() int32 {
+	if x != nil {
+		return x.QuantityAfter
+	}
+	return 0
+}
+
+func (x *BatchAdjustLineResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BatchAdjustLineResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// BatchAdjustResponse holds a result per requested line, in request order
+type BatchAdjustResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Reference ID of the history entries of every applied line
+	BatchId       string                   `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	Results       []*BatchAdjustLineResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	Applied       int32                    `protobuf:"varint,3,opt,name=applied,proto3" json:"applied,omitempty"`
+	Failed        int32                    `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchAdjustResponse) Reset() {
+	*x = BatchAdjustResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchAdjustResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAdjustResponse) ProtoMessage() {}
+
+func (x *BatchAdjustResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAdjustResponse.ProtoReflect.Descriptor instead.
+func (*BatchAdjustResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *BatchAdjustResponse) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *BatchAdjustResponse) GetResults() []*BatchAdjustLineResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchAdjustResponse) GetApplied() int32 {
+	if x != nil {
+		return x.Applied
+	}
+	return 0
+}
+
+func (x *BatchAdjustResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+// GetReservationStatusRequest names the inventory item whose reservation is read
+type GetReservationStatusRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InventoryItemId string                 `protobuf:"bytes,1,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	// Order whose reservation to return; may be empty when the item was only
+	// ever reserved for one order
+	OrderId       string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReservationStatusRequest) Reset() {
+	*x = GetReservationStatusRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReservationStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationStatusRequest) ProtoMessage() {}
+
+func (x *GetReservationStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetReservationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *GetReservationStatusRequest) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *GetReservationStatusRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+// GetReservationStatusResponse is the reservation an item holds and its order
+type GetReservationStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Reservation   *OrderReservation      `protobuf:"bytes,2,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReservationStatusResponse) Reset() {
+	*x = GetReservationStatusResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReservationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationStatusResponse) ProtoMessage() {}
+
+func (x *GetReservationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetReservationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetReservationStatusResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *GetReservationStatusResponse) GetReservation() *OrderReservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+// SetReservationStatusRequest changes the status of an item's order reservation
+type SetReservationStatusRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InventoryItemId string                 `protobuf:"bytes,1,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	// fulfilled, cancelled or expired; the current status is a no-op
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Why support changed the reservation; added to its notes and history
+	Reason      string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	PerformedBy string `protobuf:"bytes,4,opt,name=performed_by,json=performedBy,proto3" json:"performed_by,omitempty"`
+	// Order whose reservation to change; may be empty when the item was only
+	// ever reserved for one order
+	OrderId       string `protobuf:"bytes,5,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReservationStatusRequest) Reset() {
+	*x = SetReservationStatusRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReservationStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReservationStatusRequest) ProtoMessage() {}
+
+func (x *SetReservationStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReservationStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetReservationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *SetReservationStatusRequest) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *SetReservationStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SetReservationStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SetReservationStatusRequest) GetPerformedBy() string {
+	if x != nil {
+		return x.PerformedBy
+	}
+	return ""
+}
+
+func (x *SetReservationStatusRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+// SetReservationStatusResponse is the reservation after the change
+type SetReservationStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Reservation   *OrderReservation      `protobuf:"bytes,2,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReservationStatusResponse) Reset() {
+	*x = SetReservationStatusResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReservationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReservationStatusResponse) ProtoMessage() {}
+
+func (x *SetReservationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReservationStatusResponse.ProtoReflect.Descriptor instead.
+func (*SetReservationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *SetReservationStatusResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *SetReservationStatusResponse) GetReservation() *OrderReservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+var File_inventory_v1_inventory_proto protoreflect.FileDescriptor
+
+const file_inventory_v1_inventory_proto_rawDesc = "" +
+	"\n" +
+	"\x1cinventory/v1/inventory.proto\x12\finventory.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xce\x06\n" +
+	"\rInventoryItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1a\n" +
+	"\breserved\x18\x04 \x01(\x05R\breserved\x12\x10\n" +
+	"\x03sku\x18\x05 \x01(\tR\x03sku\x12\x1f\n" +
+	"\vlocation_id\x18\x06 \x01(\tR\n" +
+	"locationId\x12%\n" +
+	"\x0eshelf_location\x18\a \x01(\tR\rshelfLocation\x12+\n" +
+	"\x11reorder_threshold\x18\b \x01(\x05R\x10reorderThreshold\x12%\n" +
+	"\x0ereorder_amount\x18\t \x01(\x05R\rreorderAmount\x12%\n" +
+	"\flast_updated\x18\n" +
+	" \x01(\tB\x02\x18\x01R\vlastUpdated\x12!\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tB\x02\x18\x01R\tcreatedAt\x12&\n" +
+	"\x0fnext_count_date\x18\f \x01(\tR\rnextCountDate\x12\x18\n" +
+	"\adamaged\x18\r \x01(\x05R\adamaged\x12\x12\n" +
+	"\x04tags\x18\x0e \x03(\tR\x04tags\x12!\n" +
+	"\fselling_unit\x18\x0f \x01(\tR\vsellingUnit\x12#\n" +
+	"\rstocking_unit\x18\x10 \x01(\tR\fstockingUnit\x125\n" +
+	"\x17units_per_stocking_unit\x18\x11 \x01(\x05R\x14unitsPerStockingUnit\x128\n" +
+	"\x18available_stocking_units\x18\x12 \x01(\x01R\x16availableStockingUnits\x12 \n" +
+	"\vbackordered\x18\x13 \x01(\x05R\vbackordered\x12)\n" +
+	"\x10backorder_policy\x18\x14 \x01(\tR\x0fbackorderPolicy\x12B\n" +
+	"\x0flast_updated_ts\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\rlastUpdatedTs\x12>\n" +
+	"\rcreated_at_ts\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedAtTs\"\x80\x04\n" +
+	"\rStoreLocation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12#\n" +
+	"\raddress_line1\x18\x04 \x01(\tR\faddressLine1\x12#\n" +
+	"\raddress_line2\x18\x05 \x01(\tR\faddressLine2\x12\x12\n" +
+	"\x04city\x18\x06 \x01(\tR\x04city\x12\x14\n" +
+	"\x05state\x18\a \x01(\tR\x05state\x12\x1f\n" +
+	"\vpostal_code\x18\b \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\t \x01(\tR\acountry\x12\x14\n" +
+	"\x05phone\x18\n" +
+	" \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\v \x01(\tR\x05email\x12\x16\n" +
+	"\x06active\x18\f \x01(\bR\x06active\x12!\n" +
+	"\n" +
+	"created_at\x18\r \x01(\tB\x02\x18\x01R\tcreatedAt\x12!\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\tB\x02\x18\x01R\tupdatedAt\x12>\n" +
+	"\rcreated_at_ts\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedAtTs\x12>\n" +
+	"\rupdated_at_ts\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedAtTs\"\x9d\x05\n" +
+	"\x11InventoryTransfer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
+	"\x12source_location_id\x18\x02 \x01(\tR\x10sourceLocationId\x126\n" +
+	"\x17destination_location_id\x18\x03 \x01(\tR\x15destinationLocationId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x04 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03sku\x18\x05 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12!\n" +
+	"\frequested_by\x18\b \x01(\tR\vrequestedBy\x12\x1f\n" +
+	"\vapproved_by\x18\t \x01(\tR\n" +
+	"approvedBy\x12%\n" +
+	"\x0erequested_date\x18\n" +
+	" \x01(\tR\rrequestedDate\x124\n" +
+	"\x16expected_delivery_date\x18\v \x01(\tR\x14expectedDeliveryDate\x120\n" +
+	"\x14actual_delivery_date\x18\f \x01(\tR\x12actualDeliveryDate\x12\x14\n" +
+	"\x05notes\x18\r \x01(\tR\x05notes\x12!\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\tB\x02\x18\x01R\tcreatedAt\x12!\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\tB\x02\x18\x01R\tupdatedAt\x12>\n" +
+	"\rcreated_at_ts\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedAtTs\x12>\n" +
+	"\rupdated_at_ts\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedAtTs\"\xc3\x02\n" +
+	"\x16CreateInventoryRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x10\n" +
+	"\x03sku\x18\x03 \x01(\tR\x03sku\x12\x1f\n" +
+	"\vlocation_id\x18\x04 \x01(\tR\n" +
+	"locationId\x12%\n" +
+	"\x0eshelf_location\x18\x05 \x01(\tR\rshelfLocation\x12+\n" +
+	"\x11reorder_threshold\x18\x06 \x01(\x05R\x10reorderThreshold\x12%\n" +
+	"\x0ereorder_amount\x18\a \x01(\x05R\rreorderAmount\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12,\n" +
+	"\x12skip_product_check\x18\t \x01(\bR\x10skipProductCheck\"T\n" +
+	"\x17CreateInventoryResponse\x129\n" +
+	"\tinventory\x18\x01 \x01(\v2\x1b.inventory.v1.InventoryItemR\tinventory\"%\n" +
+	"\x13GetInventoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"`\n" +
+	"\x1eGetInventoryByProductIDRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1f\n" +
+	"\vlocation_id\x18\x02 \x01(\tR\n" +
+	"locationId\"M\n" +
+	"\x18GetInventoryBySKURequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1f\n" +
+	"\vlocation_id\x18\x02 \x01(\tR\n" +
+	"locationId\"Q\n" +
+	"\x14GetInventoryResponse\x129\n" +
+	"\tinventory\x18\x01 \x01(\v2\x1b.inventory.v1.InventoryItemR\tinventory\"S\n" +
+	"\x16UpdateInventoryRequest\x129\n" +
+	"\tinventory\x18\x01 \x01(\v2\x1b.inventory.v1.InventoryItemR\tinventory\"3\n" +
+	"\x17UpdateInventoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"(\n" +
+	"\x16DeleteInventoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"3\n" +
+	"\x17DeleteInventoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa0\x01\n" +
+	"\x14ListInventoryRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12!\n" +
+	"\fstock_status\x18\x03 \x01(\tR\vstockStatus\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12#\n" +
+	"\rupdated_since\x18\x05 \x01(\tR\fupdatedSince\"\xcb\x01\n" +
+	"\x1eListInventoryByLocationRequest\x12\x1f\n" +
+	"\vlocation_id\x18\x01 \x01(\tR\n" +
+	"locationId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12!\n" +
-	"\fstock_status\x18\x04 \x01(\tR\vstockStatus\"V\n" +
+	"\fstock_status\x18\x04 \x01(\tR\vstockStatus\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12#\n" +
+	"\rupdated_since\x18\x06 \x01(\tR\fupdatedSince\"w\n" +
 	"\x15ListInventoryResponse\x12=\n" +
-	"\vinventories\x18\x01 \x03(\v2\x1b.inventory.v1.InventoryItemR\vinventories\"x\n" +
+	"\vinventories\x18\x01 \x03(\v2\x1b.inventory.v1.InventoryItemR\vinventories\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\x8c\x01\n" +
 	"\x0fAddStockRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
 	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x16\n" +
 	"\x06reason\x18\x03 \x01(\tR\x06reason\x12!\n" +
-	"\fperformed_by\x18\x04 \x01(\tR\vperformedBy\",\n" +
+	"\fperformed_by\x18\x04 \x01(\tR\vperformedBy\x12\x12\n" +
+	"\x04unit\x18\x05 \x01(\tR\x04unit\",\n" +
 	"\x10AddStockResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"{\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x8f\x01\n" +
 	"\x12RemoveStockRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
 	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x16\n" +
 	"\x06reason\x18\x03 \x01(\tR\x06reason\x12!\n" +
-	"\fperformed_by\x18\x04 \x01(\tR\vperformedBy\"/\n" +
+	"\fperformed_by\x18\x04 \x01(\tR\vperformedBy\x12\x12\n" +
+	"\x04unit\x18\x05 \x01(\tR\x04unit\"/\n" +
 	"\x13RemoveStockResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"A\n" +
 	"\x13ReserveStockRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
 	"\bquantity\x18\x02 \x01(\x05R\bquantity\"0\n" +
 	"\x14ReserveStockResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"G\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"b\n" +
 	"\x19ReleaseReservationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x05R\bquantity\"6\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\tR\aorderId\"6\n" +
 	"\x1aReleaseReservationResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"G\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"b\n" +
 	"\x19FulfillReservationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x05R\bquantity\"6\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\tR\aorderId\"6\n" +
 	"\x1aFulfillReservationResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x9a\x02\n" +
 	"\x15CreateLocationRequest\x12\x12\n" +
@@ -4485,11 +7839,12 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\x15CompletePickupRequest\x12%\n" +
 	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\x12\x19\n" +
 	"\bstaff_id\x18\x02 \x01(\tR\astaffId\x12\x14\n" +
-	"\x05notes\x18\x03 \x01(\tR\x05notes\"|\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\"\xc4\x01\n" +
 	"\x16CompletePickupResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
-	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12!\n" +
-	"\fcompleted_at\x18\x03 \x01(\tR\vcompletedAt\"T\n" +
+	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12%\n" +
+	"\fcompleted_at\x18\x03 \x01(\tB\x02\x18\x01R\vcompletedAt\x12B\n" +
+	"\x0fcompleted_at_ts\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rcompletedAtTs\"T\n" +
 	"\x13CancelPickupRequest\x12%\n" +
 	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\x12\x16\n" +
 	"\x06reason\x18\x02 \x01(\tR\x06reason\"J\n" +
@@ -4499,7 +7854,7 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\x1aGetInventoryHistoryRequest\x12!\n" +
 	"\finventory_id\x18\x01 \x01(\tR\vinventoryId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"\xe9\x02\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"\xad\x03\n" +
 	"\x15InventoryHistoryEntry\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
 	"\finventory_id\x18\x02 \x01(\tR\vinventoryId\x12\x1f\n" +
@@ -4510,10 +7865,11 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\x0equantity_after\x18\x06 \x01(\x05R\rquantityAfter\x12!\n" +
 	"\freference_id\x18\a \x01(\tR\vreferenceId\x12%\n" +
 	"\x0ereference_type\x18\b \x01(\tR\rreferenceType\x12!\n" +
-	"\fperformed_by\x18\t \x01(\tR\vperformedBy\x12\x1d\n" +
+	"\fperformed_by\x18\t \x01(\tR\vperformedBy\x12!\n" +
 	"\n" +
 	"created_at\x18\n" +
-	" \x01(\tR\tcreatedAt\"r\n" +
+	" \x01(\tB\x02\x18\x01R\tcreatedAt\x12>\n" +
+	"\rcreated_at_ts\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedAtTs\"r\n" +
 	"\x1bGetInventoryHistoryResponse\x12=\n" +
 	"\aentries\x18\x01 \x03(\v2#.inventory.v1.InventoryHistoryEntryR\aentries\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\"\x80\x02\n" +
@@ -4543,7 +7899,239 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\rerror_message\x18\a \x01(\tR\ferrorMessage\"z\n" +
 	"\x1fAdjustInventoryForOrderResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12=\n" +
-	"\x05items\x18\x02 \x03(\v2'.inventory.v1.InventoryAdjustmentResultR\x05items2\xf0\x15\n" +
+	"\x05items\x18\x02 \x03(\v2'.inventory.v1.InventoryAdjustmentResultR\x05items\"\xe1\x02\n" +
+	"\x10OrderReservation\x12*\n" +
+	"\x11inventory_item_id\x18\x01 \x01(\tR\x0finventoryItemId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03sku\x18\x03 \x01(\tR\x03sku\x12\x1f\n" +
+	"\vlocation_id\x18\x04 \x01(\tR\n" +
+	"locationId\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12!\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tB\x02\x18\x01R\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\tR\texpiresAt\x12>\n" +
+	"\rupdated_at_ts\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedAtTs\x12\x19\n" +
+	"\border_id\x18\n" +
+	" \x01(\tR\aorderId\";\n" +
+	"\x1eGetReservationsForOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"\x80\x01\n" +
+	"\x1fGetReservationsForOrderResponse\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12B\n" +
+	"\freservations\x18\x02 \x03(\v2\x1e.inventory.v1.OrderReservationR\freservations\"6\n" +
+	"\x19ReleaseAllForOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"s\n" +
+	"\x1aReleaseAllForOrderResponse\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12:\n" +
+	"\breleased\x18\x02 \x03(\v2\x1e.inventory.v1.OrderReservationR\breleased\"7\n" +
+	"\x1cReconcileReservationsRequest\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\"\x97\x01\n" +
+	"\x15ReservationCorrection\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1f\n" +
+	"\vorder_state\x18\x02 \x01(\tR\n" +
+	"orderState\x12B\n" +
+	"\freservations\x18\x03 \x03(\v2\x1e.inventory.v1.OrderReservationR\freservations\"\xf1\x01\n" +
+	"\x1dReconcileReservationsResponse\x121\n" +
+	"\x14reservations_checked\x18\x01 \x01(\x05R\x13reservationsChecked\x12%\n" +
+	"\x0eorders_checked\x18\x02 \x01(\x05R\rordersChecked\x12E\n" +
+	"\vcorrections\x18\x03 \x03(\v2#.inventory.v1.ReservationCorrectionR\vcorrections\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\"\xc4\x01\n" +
+	"\x17BackInStockSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\x12!\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tB\x02\x18\x01R\tcreatedAt\x12>\n" +
+	"\rcreated_at_ts\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedAtTs\"U\n" +
+	"\x1bSubscribeBackInStockRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\"\x98\x01\n" +
+	"\x1cSubscribeBackInStockResponse\x12I\n" +
+	"\fsubscription\x18\x01 \x01(\v2%.inventory.v1.BackInStockSubscriptionR\fsubscription\x12-\n" +
+	"\x12already_subscribed\x18\x02 \x01(\bR\x11alreadySubscribed\"W\n" +
+	"\x1dUnsubscribeBackInStockRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\":\n" +
+	"\x1eUnsubscribeBackInStockResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"W\n" +
+	"\x18NotifyBackInStockRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1c\n" +
+	"\tavailable\x18\x02 \x01(\x05R\tavailable\"B\n" +
+	"\x19NotifyBackInStockResponse\x12%\n" +
+	"\x0enotified_count\x18\x01 \x01(\x05R\rnotifiedCount\"\xd2\x01\n" +
+	"\x14RestockReturnRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1f\n" +
+	"\vlocation_id\x18\x02 \x01(\tR\n" +
+	"locationId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x18\n" +
+	"\adamaged\x18\x04 \x01(\bR\adamaged\x12!\n" +
+	"\freference_id\x18\x05 \x01(\tR\vreferenceId\x12!\n" +
+	"\fperformed_by\x18\x06 \x01(\tR\vperformedBy\"R\n" +
+	"\x15RestockReturnResponse\x129\n" +
+	"\tinventory\x18\x01 \x01(\v2\x1b.inventory.v1.InventoryItemR\tinventory\"i\n" +
+	"\x18ListLowStockItemsRequest\x12\x1f\n" +
+	"\vlocation_id\x18\x01 \x01(\tR\n" +
+	"locationId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"z\n" +
+	"\x14ListDueCountsRequest\x12\x1f\n" +
+	"\vlocation_id\x18\x01 \x01(\tR\n" +
+	"locationId\x12\x13\n" +
+	"\x05as_of\x18\x02 \x01(\tR\x04asOf\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"7\n" +
+	"\x14CountLowStockRequest\x12\x1f\n" +
+	"\vlocation_id\x18\x01 \x01(\tR\n" +
+	"locationId\"-\n" +
+	"\x15CountLowStockResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"\xa8\x01\n" +
+	"\x17SetUnitOfMeasureRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fselling_unit\x18\x02 \x01(\tR\vsellingUnit\x12#\n" +
+	"\rstocking_unit\x18\x03 \x01(\tR\fstockingUnit\x125\n" +
+	"\x17units_per_stocking_unit\x18\x04 \x01(\x05R\x14unitsPerStockingUnit\"U\n" +
+	"\x18SetUnitOfMeasureResponse\x129\n" +
+	"\tinventory\x18\x01 \x01(\v2\x1b.inventory.v1.InventoryItemR\tinventory\"C\n" +
+	"\x19SetBackorderPolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06policy\x18\x02 \x01(\tR\x06policy\"W\n" +
+	"\x1aSetBackorderPolicyResponse\x129\n" +
+	"\tinventory\x18\x01 \x01(\v2\x1b.inventory.v1.InventoryItemR\tinventory\"\x94\x01\n" +
+	"\x1aUpdateInventoryTagsRequest\x12\x1f\n" +
+	"\vlocation_id\x18\x01 \x01(\tR\n" +
+	"locationId\x12\x19\n" +
+	"\bitem_ids\x18\x02 \x03(\tR\aitemIds\x12\x19\n" +
+	"\badd_tags\x18\x03 \x03(\tR\aaddTags\x12\x1f\n" +
+	"\vremove_tags\x18\x04 \x03(\tR\n" +
+	"removeTags\"B\n" +
+	"\x1bUpdateInventoryTagsResponse\x12#\n" +
+	"\rmatched_count\x18\x01 \x01(\x03R\fmatchedCount\"A\n" +
+	"\x1eMergeDuplicateInventoryRequest\x12\x1f\n" +
+	"\vlocation_id\x18\x01 \x01(\tR\n" +
+	"locationId\"\x98\x02\n" +
+	"\x0eDuplicateMerge\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12 \n" +
+	"\fkept_item_id\x18\x02 \x01(\tR\n" +
+	"keptItemId\x12&\n" +
+	"\x0fmerged_item_ids\x18\x03 \x03(\tR\rmergedItemIds\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x1a\n" +
+	"\breserved\x18\x05 \x01(\x05R\breserved\x12\x18\n" +
+	"\adamaged\x18\x06 \x01(\x05R\adamaged\x12\x1d\n" +
+	"\border_id\x18\a \x01(\tB\x02\x18\x01R\aorderId\x12\x1c\n" +
+	"\askipped\x18\b \x01(\tB\x02\x18\x01R\askipped\x12\x1b\n" +
+	"\torder_ids\x18\t \x03(\tR\borderIds\"W\n" +
+	"\x1fMergeDuplicateInventoryResponse\x124\n" +
+	"\x06merges\x18\x01 \x03(\v2\x1c.inventory.v1.DuplicateMergeR\x06merges\"\xb0\x01\n" +
+	"\x11PurchaseOrderLine\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12*\n" +
+	"\x11inventory_item_id\x18\x02 \x01(\tR\x0finventoryItemId\x12)\n" +
+	"\x10ordered_quantity\x18\x03 \x01(\x05R\x0forderedQuantity\x12+\n" +
+	"\x11received_quantity\x18\x04 \x01(\x05R\x10receivedQuantity\"\xa1\x01\n" +
+	"\x1bReceivePurchaseOrderRequest\x12*\n" +
+	"\x11purchase_order_id\x18\x01 \x01(\tR\x0fpurchaseOrderId\x125\n" +
+	"\x05lines\x18\x02 \x03(\v2\x1f.inventory.v1.PurchaseOrderLineR\x05lines\x12\x1f\n" +
+	"\vreceived_by\x18\x03 \x01(\tR\n" +
+	"receivedBy\"\xfc\x01\n" +
+	"\x1cReceivePurchaseOrderResponse\x12*\n" +
+	"\x11purchase_order_id\x18\x01 \x01(\tR\x0fpurchaseOrderId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x125\n" +
+	"\x05lines\x18\x03 \x03(\v2\x1f.inventory.v1.PurchaseOrderLineR\x05lines\x12!\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\tB\x02\x18\x01R\tupdatedAt\x12>\n" +
+	"\rupdated_at_ts\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedAtTs\"|\n" +
+	"\x1dExportStockAdjustmentsRequest\x12\x1f\n" +
+	"\vlocation_id\x18\x01 \x01(\tR\n" +
+	"locationId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\"\x89\x01\n" +
+	"\x1eExportStockAdjustmentsResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\"K\n" +
+	"\x0eAllocationLine\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\xde\x01\n" +
+	"\x1cReserveWithAllocationRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x122\n" +
+	"\x05lines\x18\x02 \x03(\v2\x1c.inventory.v1.AllocationLineR\x05lines\x12\x1a\n" +
+	"\bstrategy\x18\x03 \x01(\tR\bstrategy\x122\n" +
+	"\x15preferred_location_id\x18\x04 \x01(\tR\x13preferredLocationId\x12\x1f\n" +
+	"\vttl_seconds\x18\x05 \x01(\x03R\n" +
+	"ttlSeconds\"\x94\x01\n" +
+	"\n" +
+	"Allocation\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1f\n" +
+	"\vlocation_id\x18\x02 \x01(\tR\n" +
+	"locationId\x12*\n" +
+	"\x11inventory_item_id\x18\x03 \x01(\tR\x0finventoryItemId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\"\xcf\x01\n" +
+	"\x1dReserveWithAllocationResponse\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1a\n" +
+	"\bstrategy\x18\x02 \x01(\tR\bstrategy\x12:\n" +
+	"\vallocations\x18\x03 \x03(\v2\x18.inventory.v1.AllocationR\vallocations\x12\x1c\n" +
+	"\tshipments\x18\x04 \x01(\x05R\tshipments\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\tR\texpiresAt\"\xb7\x01\n" +
+	"\x14TransferStockRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12(\n" +
+	"\x10from_location_id\x18\x02 \x01(\tR\x0efromLocationId\x12$\n" +
+	"\x0eto_location_id\x18\x03 \x01(\tR\ftoLocationId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12!\n" +
+	"\fperformed_by\x18\x05 \x01(\tR\vperformedBy\"\xdd\x01\n" +
+	"\x15TransferStockResponse\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\tR\n" +
+	"transferId\x123\n" +
+	"\x06source\x18\x02 \x01(\v2\x1b.inventory.v1.InventoryItemR\x06source\x12=\n" +
+	"\vdestination\x18\x03 \x01(\v2\x1b.inventory.v1.InventoryItemR\vdestination\x12/\n" +
+	"\x13destination_created\x18\x04 \x01(\bR\x12destinationCreated\"?\n" +
+	"\x0fBatchAdjustLine\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\xa5\x01\n" +
+	"\x12BatchAdjustRequest\x12\x1f\n" +
+	"\vlocation_id\x18\x01 \x01(\tR\n" +
+	"locationId\x123\n" +
+	"\x05lines\x18\x02 \x03(\v2\x1d.inventory.v1.BatchAdjustLineR\x05lines\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12!\n" +
+	"\fperformed_by\x18\x04 \x01(\tR\vperformedBy\"\xe4\x01\n" +
+	"\x15BatchAdjustLineResult\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12*\n" +
+	"\x11inventory_item_id\x18\x02 \x01(\tR\x0finventoryItemId\x12'\n" +
+	"\x0fquantity_before\x18\x03 \x01(\x05R\x0equantityBefore\x12%\n" +
+	"\x0equantity_after\x18\x04 \x01(\x05R\rquantityAfter\x12\x18\n" +
+	"\asuccess\x18\x05 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\"\xa1\x01\n" +
+	"\x13BatchAdjustResponse\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\tR\abatchId\x12=\n" +
+	"\aresults\x18\x02 \x03(\v2#.inventory.v1.BatchAdjustLineResultR\aresults\x12\x18\n" +
+	"\aapplied\x18\x03 \x01(\x05R\aapplied\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\"d\n" +
+	"\x1bGetReservationStatusRequest\x12*\n" +
+	"\x11inventory_item_id\x18\x01 \x01(\tR\x0finventoryItemId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\"{\n" +
+	"\x1cGetReservationStatusResponse\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12@\n" +
+	"\vreservation\x18\x02 \x01(\v2\x1e.inventory.v1.OrderReservationR\vreservation\"\xb7\x01\n" +
+	"\x1bSetReservationStatusRequest\x12*\n" +
+	"\x11inventory_item_id\x18\x01 \x01(\tR\x0finventoryItemId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12!\n" +
+	"\fperformed_by\x18\x04 \x01(\tR\vperformedBy\x12\x19\n" +
+	"\border_id\x18\x05 \x01(\tR\aorderId\"{\n" +
+	"\x1cSetReservationStatusResponse\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12@\n" +
+	"\vreservation\x18\x02 \x01(\v2\x1e.inventory.v1.OrderReservationR\vreservation2\x8f'\n" +
 	"\x10InventoryService\x12^\n" +
 	"\x0fCreateInventory\x12$.inventory.v1.CreateInventoryRequest\x1a%.inventory.v1.CreateInventoryResponse\x12U\n" +
 	"\fGetInventory\x12!.inventory.v1.GetInventoryRequest\x1a\".inventory.v1.GetInventoryResponse\x12k\n" +
@@ -4573,7 +8161,28 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\x0eCompletePickup\x12#.inventory.v1.CompletePickupRequest\x1a$.inventory.v1.CompletePickupResponse\x12U\n" +
 	"\fCancelPickup\x12!.inventory.v1.CancelPickupRequest\x1a\".inventory.v1.CancelPickupResponse\x12v\n" +
 	"\x17AdjustInventoryForOrder\x12,.inventory.v1.AdjustInventoryForOrderRequest\x1a-.inventory.v1.AdjustInventoryForOrderResponse\x12j\n" +
-	"\x13GetInventoryHistory\x12(.inventory.v1.GetInventoryHistoryRequest\x1a).inventory.v1.GetInventoryHistoryResponseBMZKgithub.com/leonvanderhaeghen/stockplatform/pkg/gen/inventory/v1;inventoryv1b\x06proto3"
+	"\x13GetInventoryHistory\x12(.inventory.v1.GetInventoryHistoryRequest\x1a).inventory.v1.GetInventoryHistoryResponse\x12v\n" +
+	"\x17GetReservationsForOrder\x12,.inventory.v1.GetReservationsForOrderRequest\x1a-.inventory.v1.GetReservationsForOrderResponse\x12g\n" +
+	"\x12ReleaseAllForOrder\x12'.inventory.v1.ReleaseAllForOrderRequest\x1a(.inventory.v1.ReleaseAllForOrderResponse\x12p\n" +
+	"\x15ReconcileReservations\x12*.inventory.v1.ReconcileReservationsRequest\x1a+.inventory.v1.ReconcileReservationsResponse\x12m\n" +
+	"\x14SubscribeBackInStock\x12).inventory.v1.SubscribeBackInStockRequest\x1a*.inventory.v1.SubscribeBackInStockResponse\x12s\n" +
+	"\x16UnsubscribeBackInStock\x12+.inventory.v1.UnsubscribeBackInStockRequest\x1a,.inventory.v1.UnsubscribeBackInStockResponse\x12d\n" +
+	"\x11NotifyBackInStock\x12&.inventory.v1.NotifyBackInStockRequest\x1a'.inventory.v1.NotifyBackInStockResponse\x12X\n" +
+	"\rRestockReturn\x12\".inventory.v1.RestockReturnRequest\x1a#.inventory.v1.RestockReturnResponse\x12`\n" +
+	"\x11ListLowStockItems\x12&.inventory.v1.ListLowStockItemsRequest\x1a#.inventory.v1.ListInventoryResponse\x12X\n" +
+	"\rCountLowStock\x12\".inventory.v1.CountLowStockRequest\x1a#.inventory.v1.CountLowStockResponse\x12X\n" +
+	"\rListDueCounts\x12\".inventory.v1.ListDueCountsRequest\x1a#.inventory.v1.ListInventoryResponse\x12j\n" +
+	"\x13UpdateInventoryTags\x12(.inventory.v1.UpdateInventoryTagsRequest\x1a).inventory.v1.UpdateInventoryTagsResponse\x12a\n" +
+	"\x10SetUnitOfMeasure\x12%.inventory.v1.SetUnitOfMeasureRequest\x1a&.inventory.v1.SetUnitOfMeasureResponse\x12g\n" +
+	"\x12SetBackorderPolicy\x12'.inventory.v1.SetBackorderPolicyRequest\x1a(.inventory.v1.SetBackorderPolicyResponse\x12v\n" +
+	"\x17MergeDuplicateInventory\x12,.inventory.v1.MergeDuplicateInventoryRequest\x1a-.inventory.v1.MergeDuplicateInventoryResponse\x12m\n" +
+	"\x14ReceivePurchaseOrder\x12).inventory.v1.ReceivePurchaseOrderRequest\x1a*.inventory.v1.ReceivePurchaseOrderResponse\x12s\n" +
+	"\x16ExportStockAdjustments\x12+.inventory.v1.ExportStockAdjustmentsRequest\x1a,.inventory.v1.ExportStockAdjustmentsResponse\x12p\n" +
+	"\x15ReserveWithAllocation\x12*.inventory.v1.ReserveWithAllocationRequest\x1a+.inventory.v1.ReserveWithAllocationResponse\x12X\n" +
+	"\rTransferStock\x12\".inventory.v1.TransferStockRequest\x1a#.inventory.v1.TransferStockResponse\x12R\n" +
+	"\vBatchAdjust\x12 .inventory.v1.BatchAdjustRequest\x1a!.inventory.v1.BatchAdjustResponse\x12m\n" +
+	"\x14GetReservationStatus\x12).inventory.v1.GetReservationStatusRequest\x1a*.inventory.v1.GetReservationStatusResponse\x12m\n" +
+	"\x14SetReservationStatus\x12).inventory.v1.SetReservationStatusRequest\x1a*.inventory.v1.SetReservationStatusResponseBMZKgithub.com/leonvanderhaeghen/stockplatform/pkg/gen/inventory/v1;inventoryv1b\x06proto3"
 
 var (
 	file_inventory_v1_inventory_proto_rawDescOnce sync.Once
@@ -4587,7 +8196,7 @@ func file_inventory_v1_inventory_proto_rawDescGZIP() []byte {
 	return file_inventory_v1_inventory_proto_rawDescData
 }
 
-var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
+var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 114)
 var file_inventory_v1_inventory_proto_goTypes = []any{
 	(*InventoryItem)(nil),                   // 0: inventory.v1.InventoryItem
 	(*StoreLocation)(nil),                   // 1: inventory.v1.StoreLocation
@@ -4654,93 +8263,215 @@ var file_inventory_v1_inventory_proto_goTypes = []any{
 	(*InventoryAdjustmentItem)(nil),         // 62: inventory.v1.InventoryAdjustmentItem
 	(*InventoryAdjustmentResult)(nil),       // 63: inventory.v1.InventoryAdjustmentResult
 	(*AdjustInventoryForOrderResponse)(nil), // 64: inventory.v1.AdjustInventoryForOrderResponse
+	(*OrderReservation)(nil),                // 65: inventory.v1.OrderReservation
+	(*GetReservationsForOrderRequest)(nil),  // 66: inventory.v1.GetReservationsForOrderRequest
+	(*GetReservationsForOrderResponse)(nil), // 67: inventory.v1.GetReservationsForOrderResponse
+	(*ReleaseAllForOrderRequest)(nil),       // 68: inventory.v1.ReleaseAllForOrderRequest
+	(*ReleaseAllForOrderResponse)(nil),      // 69: inventory.v1.ReleaseAllForOrderResponse
+	(*ReconcileReservationsRequest)(nil),    // 70: inventory.v1.ReconcileReservationsRequest
+	(*ReservationCorrection)(nil),           // 71: inventory.v1.ReservationCorrection
+	(*ReconcileReservationsResponse)(nil),   // 72: inventory.v1.ReconcileReservationsResponse
+	(*BackInStockSubscription)(nil),         // 73: inventory.v1.BackInStockSubscription
+	(*SubscribeBackInStockRequest)(nil),     // 74: inventory.v1.SubscribeBackInStockRequest
+	(*SubscribeBackInStockResponse)(nil),    // 75: inventory.v1.SubscribeBackInStockResponse
+	(*UnsubscribeBackInStockRequest)(nil),   // 76: inventory.v1.UnsubscribeBackInStockRequest
+	(*UnsubscribeBackInStockResponse)(nil),  // 77: inventory.v1.UnsubscribeBackInStockResponse
+	(*NotifyBackInStockRequest)(nil),        // 78: inventory.v1.NotifyBackInStockRequest
+	(*NotifyBackInStockResponse)(nil),       // 79: inventory.v1.NotifyBackInStockResponse
+	(*RestockReturnRequest)(nil),            // 80: inventory.v1.RestockReturnRequest
+	(*RestockReturnResponse)(nil),           // 81: inventory.v1.RestockReturnResponse
+	(*ListLowStockItemsRequest)(nil),        // 82: inventory.v1.ListLowStockItemsRequest
+	(*ListDueCountsRequest)(nil),            // 83: inventory.v1.ListDueCountsRequest
+	(*CountLowStockRequest)(nil),            // 84: inventory.v1.CountLowStockRequest
+	(*CountLowStockResponse)(nil),           // 85: inventory.v1.CountLowStockResponse
+	(*SetUnitOfMeasureRequest)(nil),         // 86: inventory.v1.SetUnitOfMeasureRequest
+	(*SetUnitOfMeasureResponse)(nil),        // 87: inventory.v1.SetUnitOfMeasureResponse
+	(*SetBackorderPolicyRequest)(nil),       // 88: inventory.v1.SetBackorderPolicyRequest
+	(*SetBackorderPolicyResponse)(nil),      // 89: inventory.v1.SetBackorderPolicyResponse
+	(*UpdateInventoryTagsRequest)(nil),      // 90: inventory.v1.UpdateInventoryTagsRequest
+	(*UpdateInventoryTagsResponse)(nil),     // 91: inventory.v1.UpdateInventoryTagsResponse
+	(*MergeDuplicateInventoryRequest)(nil),  // 92: inventory.v1.MergeDuplicateInventoryRequest
+	(*DuplicateMerge)(nil),                  // 93: inventory.v1.DuplicateMerge
+	(*MergeDuplicateInventoryResponse)(nil), // 94: inventory.v1.MergeDuplicateInventoryResponse
+	(*PurchaseOrderLine)(nil),               // 95: inventory.v1.PurchaseOrderLine
+	(*ReceivePurchaseOrderRequest)(nil),     // 96: inventory.v1.ReceivePurchaseOrderRequest
+	(*ReceivePurchaseOrderResponse)(nil),    // 97: inventory.v1.ReceivePurchaseOrderResponse
+	(*ExportStockAdjustmentsRequest)(nil),   // 98: inventory.v1.ExportStockAdjustmentsRequest
+	(*ExportStockAdjustmentsResponse)(nil),  // 99: inventory.v1.ExportStockAdjustmentsResponse
+	(*AllocationLine)(nil),                  // 100: inventory.v1.AllocationLine
+	(*ReserveWithAllocationRequest)(nil),    // 101: inventory.v1.ReserveWithAllocationRequest
+	(*Allocation)(nil),                      // 102: inventory.v1.Allocation
+	(*ReserveWithAllocationResponse)(nil),   // 103: inventory.v1.ReserveWithAllocationResponse
+	(*TransferStockRequest)(nil),            // 104: inventory.v1.TransferStockRequest
+	(*TransferStockResponse)(nil),           // 105: inventory.v1.TransferStockResponse
+	(*BatchAdjustLine)(nil),                 // 106: inventory.v1.BatchAdjustLine
+	(*BatchAdjustRequest)(nil),              // 107: inventory.v1.BatchAdjustRequest
+	(*BatchAdjustLineResult)(nil),           // 108: inventory.v1.BatchAdjustLineResult
+	(*BatchAdjustResponse)(nil),             // 109: inventory.v1.BatchAdjustResponse
+	(*GetReservationStatusRequest)(nil),     // 110: inventory.v1.GetReservationStatusRequest
+	(*GetReservationStatusResponse)(nil),    // 111: inventory.v1.GetReservationStatusResponse
+	(*SetReservationStatusRequest)(nil),     // 112: inventory.v1.SetReservationStatusRequest
+	(*SetReservationStatusResponse)(nil),    // 113: inventory.v1.SetReservationStatusResponse
+	(*timestamppb.Timestamp)(nil),           // 114: google.protobuf.Timestamp
 }
 var file_inventory_v1_inventory_proto_depIdxs = []int32{
-	0,  // 0: inventory.v1.CreateInventoryResponse.inventory:type_name -> inventory.v1.InventoryItem
-	0,  // 1: inventory.v1.GetInventoryResponse.inventory:type_name -> inventory.v1.InventoryItem
-	0,  // 2: inventory.v1.UpdateInventoryRequest.inventory:type_name -> inventory.v1.InventoryItem
-	0,  // 3: inventory.v1.ListInventoryResponse.inventories:type_name -> inventory.v1.InventoryItem
-	1,  // 4: inventory.v1.CreateLocationResponse.location:type_name -> inventory.v1.StoreLocation
-	1,  // 5: inventory.v1.GetLocationResponse.location:type_name -> inventory.v1.StoreLocation
-	1,  // 6: inventory.v1.UpdateLocationRequest.location:type_name -> inventory.v1.StoreLocation
-	1,  // 7: inventory.v1.ListLocationsResponse.locations:type_name -> inventory.v1.StoreLocation
-	2,  // 8: inventory.v1.CreateTransferResponse.transfer:type_name -> inventory.v1.InventoryTransfer
-	2,  // 9: inventory.v1.GetTransferResponse.transfer:type_name -> inventory.v1.InventoryTransfer
-	2,  // 10: inventory.v1.UpdateTransferStatusResponse.transfer:type_name -> inventory.v1.InventoryTransfer
-	2,  // 11: inventory.v1.ListTransfersResponse.transfers:type_name -> inventory.v1.InventoryTransfer
-	44, // 12: inventory.v1.CheckAvailabilityRequest.items:type_name -> inventory.v1.InventoryRequestItem
-	46, // 13: inventory.v1.CheckAvailabilityResponse.items:type_name -> inventory.v1.ItemAvailability
-	44, // 14: inventory.v1.GetNearbyInventoryRequest.items:type_name -> inventory.v1.InventoryRequestItem
-	46, // 15: inventory.v1.NearbyLocationInventory.items:type_name -> inventory.v1.ItemAvailability
-	49, // 16: inventory.v1.GetNearbyInventoryResponse.locations:type_name -> inventory.v1.NearbyLocationInventory
-	44, // 17: inventory.v1.ReserveForPickupRequest.items:type_name -> inventory.v1.InventoryRequestItem
-	52, // 18: inventory.v1.ReserveForPickupResponse.items:type_name -> inventory.v1.InventoryReservationResult
-	59, // 19: inventory.v1.GetInventoryHistoryResponse.entries:type_name -> inventory.v1.InventoryHistoryEntry
-	62, // 20: inventory.v1.AdjustInventoryForOrderRequest.items:type_name -> inventory.v1.InventoryAdjustmentItem
-	63, // 21: inventory.v1.AdjustInventoryForOrderResponse.items:type_name -> inventory.v1.InventoryAdjustmentResult
-	3,  // 22: inventory.v1.InventoryService.CreateInventory:input_type -> inventory.v1.CreateInventoryRequest
-	5,  // 23: inventory.v1.InventoryService.GetInventory:input_type -> inventory.v1.GetInventoryRequest
-	6,  // 24: inventory.v1.InventoryService.GetInventoryByProductID:input_type -> inventory.v1.GetInventoryByProductIDRequest
-	7,  // 25: inventory.v1.InventoryService.GetInventoryBySKU:input_type -> inventory.v1.GetInventoryBySKURequest
-	9,  // 26: inventory.v1.InventoryService.UpdateInventory:input_type -> inventory.v1.UpdateInventoryRequest
-	11, // 27: inventory.v1.InventoryService.DeleteInventory:input_type -> inventory.v1.DeleteInventoryRequest
-	13, // 28: inventory.v1.InventoryService.ListInventory:input_type -> inventory.v1.ListInventoryRequest
-	14, // 29: inventory.v1.InventoryService.ListInventoryByLocation:input_type -> inventory.v1.ListInventoryByLocationRequest
-	16, // 30: inventory.v1.InventoryService.AddStock:input_type -> inventory.v1.AddStockRequest
-	18, // 31: inventory.v1.InventoryService.RemoveStock:input_type -> inventory.v1.RemoveStockRequest
-	20, // 32: inventory.v1.InventoryService.ReserveStock:input_type -> inventory.v1.ReserveStockRequest
-	22, // 33: inventory.v1.InventoryService.ReleaseReservation:input_type -> inventory.v1.ReleaseReservationRequest
-	24, // 34: inventory.v1.InventoryService.FulfillReservation:input_type -> inventory.v1.FulfillReservationRequest
-	26, // 35: inventory.v1.InventoryService.CreateLocation:input_type -> inventory.v1.CreateLocationRequest
-	28, // 36: inventory.v1.InventoryService.GetLocation:input_type -> inventory.v1.GetLocationRequest
-	30, // 37: inventory.v1.InventoryService.UpdateLocation:input_type -> inventory.v1.UpdateLocationRequest
-	32, // 38: inventory.v1.InventoryService.DeleteLocation:input_type -> inventory.v1.DeleteLocationRequest
-	34, // 39: inventory.v1.InventoryService.ListLocations:input_type -> inventory.v1.ListLocationsRequest
-	36, // 40: inventory.v1.InventoryService.CreateTransfer:input_type -> inventory.v1.CreateTransferRequest
-	38, // 41: inventory.v1.InventoryService.GetTransfer:input_type -> inventory.v1.GetTransferRequest
-	40, // 42: inventory.v1.InventoryService.UpdateTransferStatus:input_type -> inventory.v1.UpdateTransferStatusRequest
-	42, // 43: inventory.v1.InventoryService.ListTransfers:input_type -> inventory.v1.ListTransfersRequest
-	45, // 44: inventory.v1.InventoryService.CheckAvailability:input_type -> inventory.v1.CheckAvailabilityRequest
-	48, // 45: inventory.v1.InventoryService.GetNearbyInventory:input_type -> inventory.v1.GetNearbyInventoryRequest
-	51, // 46: inventory.v1.InventoryService.ReserveForPickup:input_type -> inventory.v1.ReserveForPickupRequest
-	54, // 47: inventory.v1.InventoryService.CompletePickup:input_type -> inventory.v1.CompletePickupRequest
-	56, // 48: inventory.v1.InventoryService.CancelPickup:input_type -> inventory.v1.CancelPickupRequest
-	61, // 49: inventory.v1.InventoryService.AdjustInventoryForOrder:input_type -> inventory.v1.AdjustInventoryForOrderRequest
-	58, // 50: inventory.v1.InventoryService.GetInventoryHistory:input_type -> inventory.v1.GetInventoryHistoryRequest
-	4,  // 51: inventory.v1.InventoryService.CreateInventory:output_type -> inventory.v1.CreateInventoryResponse
-	8,  // 52: inventory.v1.InventoryService.GetInventory:output_type -> inventory.v1.GetInventoryResponse
-	8,  // 53: inventory.v1.InventoryService.GetInventoryByProductID:output_type -> inventory.v1.GetInventoryResponse
-	8,  // 54: inventory.v1.InventoryService.GetInventoryBySKU:output_type -> inventory.v1.GetInventoryResponse
-	10, // 55: inventory.v1.InventoryService.UpdateInventory:output_type -> inventory.v1.UpdateInventoryResponse
-	12, // 56: inventory.v1.InventoryService.DeleteInventory:output_type -> inventory.v1.DeleteInventoryResponse
-	15, // 57: inventory.v1.InventoryService.ListInventory:output_type -> inventory.v1.ListInventoryResponse
-	15, // 58: inventory.v1.InventoryService.ListInventoryByLocation:output_type -> inventory.v1.ListInventoryResponse
-	17, // 59: inventory.v1.InventoryService.AddStock:output_type -> inventory.v1.AddStockResponse
-	19, // 60: inventory.v1.InventoryService.RemoveStock:output_type -> inventory.v1.RemoveStockResponse
-	21, // 61: inventory.v1.InventoryService.ReserveStock:output_type -> inventory.v1.ReserveStockResponse
-	23, // 62: inventory.v1.InventoryService.ReleaseReservation:output_type -> inventory.v1.ReleaseReservationResponse
-	25, // 63: inventory.v1.InventoryService.FulfillReservation:output_type -> inventory.v1.FulfillReservationResponse
-	27, // 64: inventory.v1.InventoryService.CreateLocation:output_type -> inventory.v1.CreateLocationResponse
-	29, // 65: inventory.v1.InventoryService.GetLocation:output_type -> inventory.v1.GetLocationResponse
-	31, // 66: inventory.v1.InventoryService.UpdateLocation:output_type -> inventory.v1.UpdateLocationResponse
-	33, // 67: inventory.v1.InventoryService.DeleteLocation:output_type -> inventory.v1.DeleteLocationResponse
-	35, // 68: inventory.v1.InventoryService.ListLocations:output_type -> inventory.v1.ListLocationsResponse
-	37, // 69: inventory.v1.InventoryService.CreateTransfer:output_type -> inventory.v1.CreateTransferResponse
-	39, // 70: inventory.v1.InventoryService.GetTransfer:output_type -> inventory.v1.GetTransferResponse
-	41, // 71: inventory.v1.InventoryService.UpdateTransferStatus:output_type -> inventory.v1.UpdateTransferStatusResponse
-	43, // 72: inventory.v1.InventoryService.ListTransfers:output_type -> inventory.v1.ListTransfersResponse
-	47, // 73: inventory.v1.InventoryService.CheckAvailability:output_type -> inventory.v1.CheckAvailabilityResponse
-	50, // 74: inventory.v1.InventoryService.GetNearbyInventory:output_type -> inventory.v1.GetNearbyInventoryResponse
-	53, // 75: inventory.v1.InventoryService.ReserveForPickup:output_type -> inventory.v1.ReserveForPickupResponse
-	55, // 76: inventory.v1.InventoryService.CompletePickup:output_type -> inventory.v1.CompletePickupResponse
-	57, // 77: inventory.v1.InventoryService.CancelPickup:output_type -> inventory.v1.CancelPickupResponse
-	64, // 78: inventory.v1.InventoryService.AdjustInventoryForOrder:output_type -> inventory.v1.AdjustInventoryForOrderResponse
-	60, // 79: inventory.v1.InventoryService.GetInventoryHistory:output_type -> inventory.v1.GetInventoryHistoryResponse
-	51, // [51:80] is the sub-list for method output_type
-	22, // [22:51] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	114, // 0: inventory.v1.InventoryItem.last_updated_ts:type_name -> google.protobuf.Timestamp
+	114, // 1: inventory.v1.InventoryItem.created_at_ts:type_name -> google.protobuf.Timestamp
+	114, // 2: inventory.v1.StoreLocation.created_at_ts:type_name -> google.protobuf.Timestamp
+	114, // 3: inventory.v1.StoreLocation.updated_at_ts:type_name -> google.protobuf.Timestamp
+	114, // 4: inventory.v1.InventoryTransfer.created_at_ts:type_name -> google.protobuf.Timestamp
+	114, // 5: inventory.v1.InventoryTransfer.updated_at_ts:type_name -> google.protobuf.Timestamp
+	0,   // 6: inventory.v1.CreateInventoryResponse.inventory:type_name -> inventory.v1.InventoryItem
+	0,   // 7: inventory.v1.GetInventoryResponse.inventory:type_name -> inventory.v1.InventoryItem
+	0,   // 8: inventory.v1.UpdateInventoryRequest.inventory:type_name -> inventory.v1.InventoryItem
+	0,   // 9: inventory.v1.ListInventoryResponse.inventories:type_name -> inventory.v1.InventoryItem
+	1,   // 10: inventory.v1.CreateLocationResponse.location:type_name -> inventory.v1.StoreLocation
+	1,   // 11: inventory.v1.GetLocationResponse.location:type_name -> inventory.v1.StoreLocation
+	1,   // 12: inventory.v1.UpdateLocationRequest.location:type_name -> inventory.v1.StoreLocation
+	1,   // 13: inventory.v1.ListLocationsResponse.locations:type_name -> inventory.v1.StoreLocation
+	2,   // 14: inventory.v1.CreateTransferResponse.transfer:type_name -> inventory.v1.InventoryTransfer
+	2,   // 15: inventory.v1.GetTransferResponse.transfer:type_name -> inventory.v1.InventoryTransfer
+	2,   // 16: inventory.v1.UpdateTransferStatusResponse.transfer:type_name -> inventory.v1.InventoryTransfer
+	2,   // 17: inventory.v1.ListTransfersResponse.transfers:type_name -> inventory.v1.InventoryTransfer
+	44,  // 18: inventory.v1.CheckAvailabilityRequest.items:type_name -> inventory.v1.InventoryRequestItem
+	46,  // 19: inventory.v1.CheckAvailabilityResponse.items:type_name -> inventory.v1.ItemAvailability
+	44,  // 20: inventory.v1.GetNearbyInventoryRequest.items:type_name -> inventory.v1.InventoryRequestItem
+	46,  // 21: inventory.v1.NearbyLocationInventory.items:type_name -> inventory.v1.ItemAvailability
+	49,  // 22: inventory.v1.GetNearbyInventoryResponse.locations:type_name -> inventory.v1.NearbyLocationInventory
+	44,  // 23: inventory.v1.ReserveForPickupRequest.items:type_name -> inventory.v1.InventoryRequestItem
+	52,  // 24: inventory.v1.ReserveForPickupResponse.items:type_name -> inventory.v1.InventoryReservationResult
+	114, // 25: inventory.v1.CompletePickupResponse.completed_at_ts:type_name -> google.protobuf.Timestamp
+	114, // 26: inventory.v1.InventoryHistoryEntry.created_at_ts:type_name -> google.protobuf.Timestamp
+	59,  // 27: inventory.v1.GetInventoryHistoryResponse.entries:type_name -> inventory.v1.InventoryHistoryEntry
+	62,  // 28: inventory.v1.AdjustInventoryForOrderRequest.items:type_name -> inventory.v1.InventoryAdjustmentItem
+	63,  // 29: inventory.v1.AdjustInventoryForOrderResponse.items:type_name -> inventory.v1.InventoryAdjustmentResult
+	114, // 30: inventory.v1.OrderReservation.updated_at_ts:type_name -> google.protobuf.Timestamp
+	65,  // 31: inventory.v1.GetReservationsForOrderResponse.reservations:type_name -> inventory.v1.OrderReservation
+	65,  // 32: inventory.v1.ReleaseAllForOrderResponse.released:type_name -> inventory.v1.OrderReservation
+	65,  // 33: inventory.v1.ReservationCorrection.reservations:type_name -> inventory.v1.OrderReservation
+	71,  // 34: inventory.v1.ReconcileReservationsResponse.corrections:type_name -> inventory.v1.ReservationCorrection
+	114, // 35: inventory.v1.BackInStockSubscription.created_at_ts:type_name -> google.protobuf.Timestamp
+	73,  // 36: inventory.v1.SubscribeBackInStockResponse.subscription:type_name -> inventory.v1.BackInStockSubscription
+	0,   // 37: inventory.v1.RestockReturnResponse.inventory:type_name -> inventory.v1.InventoryItem
+	0,   // 38: inventory.v1.SetUnitOfMeasureResponse.inventory:type_name -> inventory.v1.InventoryItem
+	0,   // 39: inventory.v1.SetBackorderPolicyResponse.inventory:type_name -> inventory.v1.InventoryItem
+	93,  // 40: inventory.v1.MergeDuplicateInventoryResponse.merges:type_name -> inventory.v1.DuplicateMerge
+	95,  // 41: inventory.v1.ReceivePurchaseOrderRequest.lines:type_name -> inventory.v1.PurchaseOrderLine
+	95,  // 42: inventory.v1.ReceivePurchaseOrderResponse.lines:type_name -> inventory.v1.PurchaseOrderLine
+	114, // 43: inventory.v1.ReceivePurchaseOrderResponse.updated_at_ts:type_name -> google.protobuf.Timestamp
+	100, // 44: inventory.v1.ReserveWithAllocationRequest.lines:type_name -> inventory.v1.AllocationLine
+	102, // 45: inventory.v1.ReserveWithAllocationResponse.allocations:type_name -> inventory.v1.Allocation
+	0,   // 46: inventory.v1.TransferStockResponse.source:type_name -> inventory.v1.InventoryItem
+	0,   // 47: inventory.v1.TransferStockResponse.destination:type_name -> inventory.v1.InventoryItem
+	106, // 48: inventory.v1.BatchAdjustRequest.lines:type_name -> inventory.v1.BatchAdjustLine
+	108, // 49: inventory.v1.BatchAdjustResponse.results:type_name -> inventory.v1.BatchAdjustLineResult
+	65,  // 50: inventory.v1.GetReservationStatusResponse.reservation:type_name -> inventory.v1.OrderReservation
+	65,  // 51: inventory.v1.SetReservationStatusResponse.reservation:type_name -> inventory.v1.OrderReservation
+	3,   // 52: inventory.v1.InventoryService.CreateInventory:input_type -> inventory.v1.CreateInventoryRequest
+	5,   // 53: inventory.v1.InventoryService.GetInventory:input_type -> inventory.v1.GetInventoryRequest
+	6,   // 54: inventory.v1.InventoryService.GetInventoryByProductID:input_type -> inventory.v1.GetInventoryByProductIDRequest
+	7,   // 55: inventory.v1.InventoryService.GetInventoryBySKU:input_type -> inventory.v1.GetInventoryBySKURequest
+	9,   // 56: inventory.v1.InventoryService.UpdateInventory:input_type -> inventory.v1.UpdateInventoryRequest
+	11,  // 57: inventory.v1.InventoryService.DeleteInventory:input_type -> inventory.v1.DeleteInventoryRequest
+	13,  // 58: inventory.v1.InventoryService.ListInventory:input_type -> inventory.v1.ListInventoryRequest
+	14,  // 59: inventory.v1.InventoryService.ListInventoryByLocation:input_type -> inventory.v1.ListInventoryByLocationRequest
+	16,  // 60: inventory.v1.InventoryService.AddStock:input_type -> inventory.v1.AddStockRequest
+	18,  // 61: inventory.v1.InventoryService.RemoveStock:input_type -> inventory.v1.RemoveStockRequest
+	20,  // 62: inventory.v1.InventoryService.ReserveStock:input_type -> inventory.v1.ReserveStockRequest
+	22,  // 63: inventory.v1.InventoryService.ReleaseReservation:input_type -> inventory.v1.ReleaseReservationRequest
+	24,  // 64: inventory.v1.InventoryService.FulfillReservation:input_type -> inventory.v1.FulfillReservationRequest
+	26,  // 65: inventory.v1.InventoryService.CreateLocation:input_type -> inventory.v1.CreateLocationRequest
+	28,  // 66: inventory.v1.InventoryService.GetLocation:input_type -> inventory.v1.GetLocationRequest
+	30,  // 67: inventory.v1.InventoryService.UpdateLocation:input_type -> inventory.v1.UpdateLocationRequest
+	32,  // 68: inventory.v1.InventoryService.DeleteLocation:input_type -> inventory.v1.DeleteLocationRequest
+	34,  // 69: inventory.v1.InventoryService.ListLocations:input_type -> inventory.v1.ListLocationsRequest
+	36,  // 70: inventory.v1.InventoryService.CreateTransfer:input_type -> inventory.v1.CreateTransferRequest
+	38,  // 71: inventory.v1.InventoryService.GetTransfer:input_type -> inventory.v1.GetTransferRequest
+	40,  // 72: inventory.v1.InventoryService.UpdateTransferStatus:input_type -> inventory.v1.UpdateTransferStatusRequest
+	42,  // 73: inventory.v1.InventoryService.ListTransfers:input_type -> inventory.v1.ListTransfersRequest
+	45,  // 74: inventory.v1.InventoryService.CheckAvailability:input_type -> inventory.v1.CheckAvailabilityRequest
+	48,  // 75: inventory.v1.InventoryService.GetNearbyInventory:input_type -> inventory.v1.GetNearbyInventoryRequest
+	51,  // 76: inventory.v1.InventoryService.ReserveForPickup:input_type -> inventory.v1.ReserveForPickupRequest
+	54,  // 77: inventory.v1.InventoryService.CompletePickup:input_type -> inventory.v1.CompletePickupRequest
+	56,  // 78: inventory.v1.InventoryService.CancelPickup:input_type -> inventory.v1.CancelPickupRequest
+	61,  // 79: inventory.v1.InventoryService.AdjustInventoryForOrder:input_type -> inventory.v1.AdjustInventoryForOrderRequest
+	58,  // 80: inventory.v1.InventoryService.GetInventoryHistory:input_type -> inventory.v1.GetInventoryHistoryRequest
+	66,  // 81: inventory.v1.InventoryService.GetReservationsForOrder:input_type -> inventory.v1.GetReservationsForOrderRequest
+	68,  // 82: inventory.v1.InventoryService.ReleaseAllForOrder:input_type -> inventory.v1.ReleaseAllForOrderRequest
+	70,  // 83: inventory.v1.InventoryService.ReconcileReservations:input_type -> inventory.v1.ReconcileReservationsRequest
+	74,  // 84: inventory.v1.InventoryService.SubscribeBackInStock:input_type -> inventory.v1.SubscribeBackInStockRequest
+	76,  // 85: inventory.v1.InventoryService.UnsubscribeBackInStock:input_type -> inventory.v1.UnsubscribeBackInStockRequest
+	78,  // 86: inventory.v1.InventoryService.NotifyBackInStock:input_type -> inventory.v1.NotifyBackInStockRequest
+	80,  // 87: inventory.v1.InventoryService.RestockReturn:input_type -> inventory.v1.RestockReturnRequest
+	82,  // 88: inventory.v1.InventoryService.ListLowStockItems:input_type -> inventory.v1.ListLowStockItemsRequest
+	84,  // 89: inventory.v1.InventoryService.CountLowStock:input_type -> inventory.v1.CountLowStockRequest
+	83,  // 90: inventory.v1.InventoryService.ListDueCounts:input_type -> inventory.v1.ListDueCountsRequest
+	90,  // 91: inventory.v1.InventoryService.UpdateInventoryTags:input_type -> inventory.v1.UpdateInventoryTagsRequest
+	86,  // 92: inventory.v1.InventoryService.SetUnitOfMeasure:input_type -> inventory.v1.SetUnitOfMeasureRequest
+	88,  // 93: inventory.v1.InventoryService.SetBackorderPolicy:input_type -> inventory.v1.SetBackorderPolicyRequest
+	92,  // 94: inventory.v1.InventoryService.MergeDuplicateInventory:input_type -> inventory.v1.MergeDuplicateInventoryRequest
+	96,  // 95: inventory.v1.InventoryService.ReceivePurchaseOrder:input_type -> inventory.v1.ReceivePurchaseOrderRequest
+	98,  // 96: inventory.v1.InventoryService.ExportStockAdjustments:input_type -> inventory.v1.ExportStockAdjustmentsRequest
+	101, // 97: inventory.v1.InventoryService.ReserveWithAllocation:input_type -> inventory.v1.ReserveWithAllocationRequest
+	104, // 98: inventory.v1.InventoryService.TransferStock:input_type -> inventory.v1.TransferStockRequest
+	107, // 99: inventory.v1.InventoryService.BatchAdjust:input_type -> inventory.v1.BatchAdjustRequest
+	110, // 100: inventory.v1.InventoryService.GetReservationStatus:input_type -> inventory.v1.GetReservationStatusRequest
+	112, // 101: inventory.v1.InventoryService.SetReservationStatus:input_type -> inventory.v1.SetReservationStatusRequest
+	4,   // 102: inventory.v1.InventoryService.CreateInventory:output_type -> inventory.v1.CreateInventoryResponse
+	8,   // 103: inventory.v1.InventoryService.GetInventory:output_type -> inventory.v1.GetInventoryResponse
+	8,   // 104: inventory.v1.InventoryService.GetInventoryByProductID:output_type -> inventory.v1.GetInventoryResponse
+	8,   // 105: inventory.v1.InventoryService.GetInventoryBySKU:output_type -> inventory.v1.GetInventoryResponse
+	10,  // 106: inventory.v1.InventoryService.UpdateInventory:output_type -> inventory.v1.UpdateInventoryResponse
+	12,  // 107: inventory.v1.InventoryService.DeleteInventory:output_type -> inventory.v1.DeleteInventoryResponse
+	15,  // 108: inventory.v1.InventoryService.ListInventory:output_type -> inventory.v1.ListInventoryResponse
+	15,  // 109: inventory.v1.InventoryService.ListInventoryByLocation:output_type -> inventory.v1.ListInventoryResponse
+	17,  // 110: inventory.v1.InventoryService.AddStock:output_type -> inventory.v1.AddStockResponse
+	19,  // 111: inventory.v1.InventoryService.RemoveStock:output_type -> inventory.v1.RemoveStockResponse
+	21,  // 112: inventory.v1.InventoryService.ReserveStock:output_type -> inventory.v1.ReserveStockResponse
+	23,  // 113: inventory.v1.InventoryService.ReleaseReservation:output_type -> inventory.v1.ReleaseReservationResponse
+	25,  // 114: inventory.v1.InventoryService.FulfillReservation:output_type -> inventory.v1.FulfillReservationResponse
+	27,  // 115: inventory.v1.InventoryService.CreateLocation:output_type -> inventory.v1.CreateLocationResponse
+	29,  // 116: inventory.v1.InventoryService.GetLocation:output_type -> inventory.v1.GetLocationResponse
+	31,  // 117: inventory.v1.InventoryService.UpdateLocation:output_type -> inventory.v1.UpdateLocationResponse
+	33,  // 118: inventory.v1.InventoryService.DeleteLocation:output_type -> inventory.v1.DeleteLocationResponse
+	35,  // 119: inventory.v1.InventoryService.ListLocations:output_type -> inventory.v1.ListLocationsResponse
+	37,  // 120: inventory.v1.InventoryService.CreateTransfer:output_type -> inventory.v1.CreateTransferResponse
+	39,  // 121: inventory.v1.InventoryService.GetTransfer:output_type -> inventory.v1.GetTransferResponse
+	41,  // 122: inventory.v1.InventoryService.UpdateTransferStatus:output_type -> inventory.v1.UpdateTransferStatusResponse
+	43,  // 123: inventory.v1.InventoryService.ListTransfers:output_type -> inventory.v1.ListTransfersResponse
+	47,  // 124: inventory.v1.InventoryService.CheckAvailability:output_type -> inventory.v1.CheckAvailabilityResponse
+	50,  // 125: inventory.v1.InventoryService.GetNearbyInventory:output_type -> inventory.v1.GetNearbyInventoryResponse
+	53,  // 126: inventory.v1.InventoryService.ReserveForPickup:output_type -> inventory.v1.ReserveForPickupResponse
+	55,  // 127: inventory.v1.InventoryService.CompletePickup:output_type -> inventory.v1.CompletePickupResponse
+	57,  // 128: inventory.v1.InventoryService.CancelPickup:output_type -> inventory.v1.CancelPickupResponse
+	64,  // 129: inventory.v1.InventoryService.AdjustInventoryForOrder:output_type -> inventory.v1.AdjustInventoryForOrderResponse
+	60,  // 130: inventory.v1.InventoryService.GetInventoryHistory:output_type -> inventory.v1.GetInventoryHistoryResponse
+	67,  // 131: inventory.v1.InventoryService.GetReservationsForOrder:output_type -> inventory.v1.GetReservationsForOrderResponse
+	69,  // 132: inventory.v1.InventoryService.ReleaseAllForOrder:output_type -> inventory.v1.ReleaseAllForOrderResponse
+	72,  // 133: inventory.v1.InventoryService.ReconcileReservations:output_type -> inventory.v1.ReconcileReservationsResponse
+	75,  // 134: inventory.v1.InventoryService.SubscribeBackInStock:output_type -> inventory.v1.SubscribeBackInStockResponse
+	77,  // 135: inventory.v1.InventoryService.UnsubscribeBackInStock:output_type -> inventory.v1.UnsubscribeBackInStockResponse
+	79,  // 136: inventory.v1.InventoryService.NotifyBackInStock:output_type -> inventory.v1.NotifyBackInStockResponse
+	81,  // 137: inventory.v1.InventoryService.RestockReturn:output_type -> inventory.v1.RestockReturnResponse
+	15,  // 138: inventory.v1.InventoryService.ListLowStockItems:output_type -> inventory.v1.ListInventoryResponse
+	85,  // 139: inventory.v1.InventoryService.CountLowStock:output_type -> inventory.v1.CountLowStockResponse
+	15,  // 140: inventory.v1.InventoryService.ListDueCounts:output_type -> inventory.v1.ListInventoryResponse
+	91,  // 141: inventory.v1.InventoryService.UpdateInventoryTags:output_type -> inventory.v1.UpdateInventoryTagsResponse
+	87,  // 142: inventory.v1.InventoryService.SetUnitOfMeasure:output_type -> inventory.v1.SetUnitOfMeasureResponse
+	89,  // 143: inventory.v1.InventoryService.SetBackorderPolicy:output_type -> inventory.v1.SetBackorderPolicyResponse
+	94,  // 144: inventory.v1.InventoryService.MergeDuplicateInventory:output_type -> inventory.v1.MergeDuplicateInventoryResponse
+	97,  // 145: inventory.v1.InventoryService.ReceivePurchaseOrder:output_type -> inventory.v1.ReceivePurchaseOrderResponse
+	99,  // 146: inventory.v1.InventoryService.ExportStockAdjustments:output_type -> inventory.v1.ExportStockAdjustmentsResponse
+	103, // 147: inventory.v1.InventoryService.ReserveWithAllocation:output_type -> inventory.v1.ReserveWithAllocationResponse
+	105, // 148: inventory.v1.InventoryService.TransferStock:output_type -> inventory.v1.TransferStockResponse
+	109, // 149: inventory.v1.InventoryService.BatchAdjust:output_type -> inventory.v1.BatchAdjustResponse
+	111, // 150: inventory.v1.InventoryService.GetReservationStatus:output_type -> inventory.v1.GetReservationStatusResponse
+	113, // 151: inventory.v1.InventoryService.SetReservationStatus:output_type -> inventory.v1.SetReservationStatusResponse
+	102, // [102:152] is the sub-list for method output_type
+	52,  // [52:102] is the sub-list for method input_type
+	52,  // [52:52] is the sub-list for extension type_name
+	52,  // [52:52] is the sub-list for extension extendee
+	0,   // [0:52] is the sub-list for field type_name
 }
 
 func init() { file_inventory_v1_inventory_proto_init() }
@@ -4754,7 +8485,7 @@ func file_inventory_v1_inventory_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_v1_inventory_proto_rawDesc), len(file_inventory_v1_inventory_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   65,
+			NumMessages:   114,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
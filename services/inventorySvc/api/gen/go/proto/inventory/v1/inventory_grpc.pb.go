@@ -48,6 +48,27 @@ const (
 	InventoryService_CancelPickup_FullMethodName            = "/inventory.v1.InventoryService/CancelPickup"
 	InventoryService_AdjustInventoryForOrder_FullMethodName = "/inventory.v1.InventoryService/AdjustInventoryForOrder"
 	InventoryService_GetInventoryHistory_FullMethodName     = "/inventory.v1.InventoryService/GetInventoryHistory"
+	InventoryService_GetReservationsForOrder_FullMethodName = "/inventory.v1.InventoryService/GetReservationsForOrder"
+	InventoryService_ReleaseAllForOrder_FullMethodName      = "/inventory.v1.InventoryService/ReleaseAllForOrder"
+	InventoryService_ReconcileReservations_FullMethodName   = "/inventory.v1.InventoryService/ReconcileReservations"
+	InventoryService_SubscribeBackInStock_FullMethodName    = "/inventory.v1.InventoryService/SubscribeBackInStock"
+	InventoryService_UnsubscribeBackInStock_FullMethodName  = "/inventory.v1.InventoryService/UnsubscribeBackInStock"
+	InventoryService_NotifyBackInStock_FullMethodName       = "/inventory.v1.InventoryService/NotifyBackInStock"
+	InventoryService_RestockReturn_FullMethodName           = "/inventory.v1.InventoryService/RestockReturn"
+	InventoryService_ListLowStockItems_FullMethodName       = "/inventory.v1.InventoryService/ListLowStockItems"
+	InventoryService_CountLowStock_FullMethodName           = "/inventory.v1.InventoryService/CountLowStock"
+	InventoryService_ListDueCounts_FullMethodName           = "/inventory.v1.InventoryService/ListDueCounts"
+	InventoryService_UpdateInventoryTags_FullMethodName     = "/inventory.v1.InventoryService/UpdateInventoryTags"
+	InventoryService_SetUnitOfMeasure_FullMethodName        = "/inventory.v1.InventoryService/SetUnitOfMeasure"
+	InventoryService_SetBackorderPolicy_FullMethodName      = "/inventory.v1.InventoryService/SetBackorderPolicy"
+	InventoryService_MergeDuplicateInventory_FullMethodName = "/inventory.v1.InventoryService/MergeDuplicateInventory"
+	InventoryService_ReceivePurchaseOrder_FullMethodName    = "/inventory.v1.InventoryService/ReceivePurchaseOrder"
+	InventoryService_ExportStockAdjustments_FullMethodName  = "/inventory.v1.InventoryService/ExportStockAdjustments"
+	InventoryService_ReserveWithAllocation_FullMethodName   = "/inventory.v1.InventoryService/ReserveWithAllocation"
+	InventoryService_TransferStock_FullMethodName           = "/inventory.v1.InventoryService/TransferStock"
+	InventoryService_BatchAdjust_FullMethodName             = "/inventory.v1.InventoryService/BatchAdjust"
+	InventoryService_GetReservationStatus_FullMethodName    = "/inventory.v1.InventoryService/GetReservationStatus"
+	InventoryService_SetReservationStatus_FullMethodName    = "/inventory.v1.InventoryService/SetReservationStatus"
 )
 
 // InventoryServiceClient is the client API for InventoryService service.
@@ -114,6 +135,49 @@ type InventoryServiceClient interface {
 	AdjustInventoryForOrder(ctx context.Context, in *AdjustInventoryForOrderRequest, opts ...grpc.CallOption) (*AdjustInventoryForOrderResponse, error)
 	// GetInventoryHistory retrieves the history of changes for a specific inventory item
 	GetInventoryHistory(ctx context.Context, in *GetInventoryHistoryRequest, opts ...grpc.CallOption) (*GetInventoryHistoryResponse, error)
+	// Get the reservations held for an order across all locations
+	GetReservationsForOrder(ctx context.Context, in *GetReservationsForOrderRequest, opts ...grpc.CallOption) (*GetReservationsForOrderResponse, error)
+	// Release every active reservation of an order, at all locations
+	ReleaseAllForOrder(ctx context.Context, in *ReleaseAllForOrderRequest, opts ...grpc.CallOption) (*ReleaseAllForOrderResponse, error)
+	// Release active reservations whose orders were cancelled, completed or no
+	// longer exist in the order service
+	ReconcileReservations(ctx context.Context, in *ReconcileReservationsRequest, opts ...grpc.CallOption) (*ReconcileReservationsResponse, error)
+	// Subscribe a user to a back-in-stock alert for a product
+	SubscribeBackInStock(ctx context.Context, in *SubscribeBackInStockRequest, opts ...grpc.CallOption) (*SubscribeBackInStockResponse, error)
+	// Remove a user's back-in-stock alert for a product
+	UnsubscribeBackInStock(ctx context.Context, in *UnsubscribeBackInStockRequest, opts ...grpc.CallOption) (*UnsubscribeBackInStockResponse, error)
+	// Queue notifications for a product's subscribers once it is available again
+	NotifyBackInStock(ctx context.Context, in *NotifyBackInStockRequest, opts ...grpc.CallOption) (*NotifyBackInStockResponse, error)
+	// Put returned units back into inventory, as sellable or damaged stock
+	RestockReturn(ctx context.Context, in *RestockReturnRequest, opts ...grpc.CallOption) (*RestockReturnResponse, error)
+	// List inventory items at or below their reorder point
+	ListLowStockItems(ctx context.Context, in *ListLowStockItemsRequest, opts ...grpc.CallOption) (*ListInventoryResponse, error)
+	// Count inventory items at or below their reorder point
+	CountLowStock(ctx context.Context, in *CountLowStockRequest, opts ...grpc.CallOption) (*CountLowStockResponse, error)
+	// List inventory items due for counting, earliest count date first
+	ListDueCounts(ctx context.Context, in *ListDueCountsRequest, opts ...grpc.CallOption) (*ListInventoryResponse, error)
+	// Add and remove tags on inventory items at a location
+	UpdateInventoryTags(ctx context.Context, in *UpdateInventoryTagsRequest, opts ...grpc.CallOption) (*UpdateInventoryTagsResponse, error)
+	// Set the units an inventory item is sold and stocked in
+	SetUnitOfMeasure(ctx context.Context, in *SetUnitOfMeasureRequest, opts ...grpc.CallOption) (*SetUnitOfMeasureResponse, error)
+	// Set whether removing stock may take an inventory item into backorder
+	SetBackorderPolicy(ctx context.Context, in *SetBackorderPolicyRequest, opts ...grpc.CallOption) (*SetBackorderPolicyResponse, error)
+	// Consolidate inventory items that share a SKU at a location (admin)
+	MergeDuplicateInventory(ctx context.Context, in *MergeDuplicateInventoryRequest, opts ...grpc.CallOption) (*MergeDuplicateInventoryResponse, error)
+	// Book the stock of a purchase order delivery; re-receiving the same totals is a no-op
+	ReceivePurchaseOrder(ctx context.Context, in *ReceivePurchaseOrderRequest, opts ...grpc.CallOption) (*ReceivePurchaseOrderResponse, error)
+	// Export stock adjustments as CSV, filtered by location, reason and date
+	ExportStockAdjustments(ctx context.Context, in *ExportStockAdjustmentsRequest, opts ...grpc.CallOption) (*ExportStockAdjustmentsResponse, error)
+	// Reserve an order across locations, all lines or none, and return where each line was reserved
+	ReserveWithAllocation(ctx context.Context, in *ReserveWithAllocationRequest, opts ...grpc.CallOption) (*ReserveWithAllocationResponse, error)
+	// Move stock of a SKU from one location to another in one step
+	TransferStock(ctx context.Context, in *TransferStockRequest, opts ...grpc.CallOption) (*TransferStockResponse, error)
+	// Apply the adjustments of a cycle count at one location; lines succeed or fail independently
+	BatchAdjust(ctx context.Context, in *BatchAdjustRequest, opts ...grpc.CallOption) (*BatchAdjustResponse, error)
+	// Get the status of the order reservation an inventory item holds
+	GetReservationStatus(ctx context.Context, in *GetReservationStatusRequest, opts ...grpc.CallOption) (*GetReservationStatusResponse, error)
+	// Move an active order reservation to fulfilled, cancelled or expired
+	SetReservationStatus(ctx context.Context, in *SetReservationStatusRequest, opts ...grpc.CallOption) (*SetReservationStatusResponse, error)
 }
 
 type inventoryServiceClient struct {
@@ -414,6 +478,216 @@ func (c *inventoryServiceClient) GetInventoryHistory(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *inventoryServiceClient) GetReservationsForOrder(ctx context.Context, in *GetReservationsForOrderRequest, opts ...grpc.CallOption) (*GetReservationsForOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReservationsForOrderResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetReservationsForOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReleaseAllForOrder(ctx context.Context, in *ReleaseAllForOrderRequest, opts ...grpc.CallOption) (*ReleaseAllForOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseAllForOrderResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ReleaseAllForOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReconcileReservations(ctx context.Context, in *ReconcileReservationsRequest, opts ...grpc.CallOption) (*ReconcileReservationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileReservationsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ReconcileReservations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SubscribeBackInStock(ctx context.Context, in *SubscribeBackInStockRequest, opts ...grpc.CallOption) (*SubscribeBackInStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscribeBackInStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SubscribeBackInStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UnsubscribeBackInStock(ctx context.Context, in *UnsubscribeBackInStockRequest, opts ...grpc.CallOption) (*UnsubscribeBackInStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsubscribeBackInStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_UnsubscribeBackInStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) NotifyBackInStock(ctx context.Context, in *NotifyBackInStockRequest, opts ...grpc.CallOption) (*NotifyBackInStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotifyBackInStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_NotifyBackInStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) RestockReturn(ctx context.Context, in *RestockReturnRequest, opts ...grpc.CallOption) (*RestockReturnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestockReturnResponse)
+	err := c.cc.Invoke(ctx, InventoryService_RestockReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListLowStockItems(ctx context.Context, in *ListLowStockItemsRequest, opts ...grpc.CallOption) (*ListInventoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInventoryResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListLowStockItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CountLowStock(ctx context.Context, in *CountLowStockRequest, opts ...grpc.CallOption) (*CountLowStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountLowStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CountLowStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListDueCounts(ctx context.Context, in *ListDueCountsRequest, opts ...grpc.CallOption) (*ListInventoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInventoryResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListDueCounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UpdateInventoryTags(ctx context.Context, in *UpdateInventoryTagsRequest, opts ...grpc.CallOption) (*UpdateInventoryTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateInventoryTagsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_UpdateInventoryTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SetUnitOfMeasure(ctx context.Context, in *SetUnitOfMeasureRequest, opts ...grpc.CallOption) (*SetUnitOfMeasureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUnitOfMeasureResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SetUnitOfMeasure_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SetBackorderPolicy(ctx context.Context, in *SetBackorderPolicyRequest, opts ...grpc.CallOption) (*SetBackorderPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBackorderPolicyResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SetBackorderPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) MergeDuplicateInventory(ctx context.Context, in *MergeDuplicateInventoryRequest, opts ...grpc.CallOption) (*MergeDuplicateInventoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeDuplicateInventoryResponse)
+	err := c.cc.Invoke(ctx, InventoryService_MergeDuplicateInventory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReceivePurchaseOrder(ctx context.Context, in *ReceivePurchaseOrderRequest, opts ...grpc.CallOption) (*ReceivePurchaseOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReceivePurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ReceivePurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ExportStockAdjustments(ctx context.Context, in *ExportStockAdjustmentsRequest, opts ...grpc.CallOption) (*ExportStockAdjustmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportStockAdjustmentsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ExportStockAdjustments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReserveWithAllocation(ctx context.Context, in *ReserveWithAllocationRequest, opts ...grpc.CallOption) (*ReserveWithAllocationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveWithAllocationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ReserveWithAllocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) TransferStock(ctx context.Context, in *TransferStockRequest, opts ...grpc.CallOption) (*TransferStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_TransferStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) BatchAdjust(ctx context.Context, in *BatchAdjustRequest, opts ...grpc.CallOption) (*BatchAdjustResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchAdjustResponse)
+	err := c.cc.Invoke(ctx, InventoryService_BatchAdjust_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetReservationStatus(ctx context.Context, in *GetReservationStatusRequest, opts ...grpc.CallOption) (*GetReservationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReservationStatusResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetReservationStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SetReservationStatus(ctx context.Context, in *SetReservationStatusRequest, opts ...grpc.CallOption) (*SetReservationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetReservationStatusResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SetReservationStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InventoryServiceServer is the server API for InventoryService service.
 // All implementations should embed UnimplementedInventoryServiceServer
 // for forward compatibility.
@@ -478,6 +752,49 @@ type InventoryServiceServer interface {
 	AdjustInventoryForOrder(context.Context, *AdjustInventoryForOrderRequest) (*AdjustInventoryForOrderResponse, error)
 	// GetInventoryHistory retrieves the history of changes for a specific inventory item
 	GetInventoryHistory(context.Context, *GetInventoryHistoryRequest) (*GetInventoryHistoryResponse, error)
+	// Get the reservations held for an order across all locations
+	GetReservationsForOrder(context.Context, *GetReservationsForOrderRequest) (*GetReservationsForOrderResponse, error)
+	// Release every active reservation of an order, at all locations
+	ReleaseAllForOrder(context.Context, *ReleaseAllForOrderRequest) (*ReleaseAllForOrderResponse, error)
+	// Release active reservations whose orders were cancelled, completed or no
+	// longer exist in the order service
+	ReconcileReservations(context.Context, *ReconcileReservationsRequest) (*ReconcileReservationsResponse, error)
+	// Subscribe a user to a back-in-stock alert for a product
+	SubscribeBackInStock(context.Context, *SubscribeBackInStockRequest) (*SubscribeBackInStockResponse, error)
+	// Remove a user's back-in-stock alert for a product
+	UnsubscribeBackInStock(context.Context, *UnsubscribeBackInStockRequest) (*UnsubscribeBackInStockResponse, error)
+	// Queue notifications for a product's subscribers once it is available again
+	NotifyBackInStock(context.Context, *NotifyBackInStockRequest) (*NotifyBackInStockResponse, error)
+	// Put returned units back into inventory, as sellable or damaged stock
+	RestockReturn(context.Context, *RestockReturnRequest) (*RestockReturnResponse, error)
+	// List inventory items at or below their reorder point
+	ListLowStockItems(context.Context, *ListLowStockItemsRequest) (*ListInventoryResponse, error)
+	// Count inventory items at or below their reorder point
+	CountLowStock(context.Context, *CountLowStockRequest) (*CountLowStockResponse, error)
+	// List inventory items due for counting, earliest count date first
+	ListDueCounts(context.Context, *ListDueCountsRequest) (*ListInventoryResponse, error)
+	// Add and remove tags on inventory items at a location
+	UpdateInventoryTags(context.Context, *UpdateInventoryTagsRequest) (*UpdateInventoryTagsResponse, error)
+	// Set the units an inventory item is sold and stocked in
+	SetUnitOfMeasure(context.Context, *SetUnitOfMeasureRequest) (*SetUnitOfMeasureResponse, error)
+	// Set whether removing stock may take an inventory item into backorder
+	SetBackorderPolicy(context.Context, *SetBackorderPolicyRequest) (*SetBackorderPolicyResponse, error)
+	// Consolidate inventory items that share a SKU at a location (admin)
+	MergeDuplicateInventory(context.Context, *MergeDuplicateInventoryRequest) (*MergeDuplicateInventoryResponse, error)
+	// Book the stock of a purchase order delivery; re-receiving the same totals is a no-op
+	ReceivePurchaseOrder(context.Context, *ReceivePurchaseOrderRequest) (*ReceivePurchaseOrderResponse, error)
+	// Export stock adjustments as CSV, filtered by location, reason and date
+	ExportStockAdjustments(context.Context, *ExportStockAdjustmentsRequest) (*ExportStockAdjustmentsResponse, error)
+	// Reserve an order across locations, all lines or none, and return where each line was reserved
+	ReserveWithAllocation(context.Context, *ReserveWithAllocationRequest) (*ReserveWithAllocationResponse, error)
+	// Move stock of a SKU from one location to another in one step
+	TransferStock(context.Context, *TransferStockRequest) (*TransferStockResponse, error)
+	// Apply the adjustments of a cycle count at one location; lines succeed or fail independently
+	BatchAdjust(context.Context, *BatchAdjustRequest) (*BatchAdjustResponse, error)
+	// Get the status of the order reservation an inventory item holds
+	GetReservationStatus(context.Context, *GetReservationStatusRequest) (*GetReservationStatusResponse, error)
+	// Move an active order reservation to fulfilled, cancelled or expired
+	SetReservationStatus(context.Context, *SetReservationStatusRequest) (*SetReservationStatusResponse, error)
 }
 
 // UnimplementedInventoryServiceServer should be embedded to have
@@ -574,6 +891,69 @@ func (UnimplementedInventoryServiceServer) AdjustInventoryForOrder(context.Conte
 func (UnimplementedInventoryServiceServer) GetInventoryHistory(context.Context, *GetInventoryHistoryRequest) (*GetInventoryHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInventoryHistory not implemented")
 }
+func (UnimplementedInventoryServiceServer) GetReservationsForOrder(context.Context, *GetReservationsForOrderRequest) (*GetReservationsForOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReservationsForOrder not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReleaseAllForOrder(context.Context, *ReleaseAllForOrderRequest) (*ReleaseAllForOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseAllForOrder not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReconcileReservations(context.Context, *ReconcileReservationsRequest) (*ReconcileReservationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileReservations not implemented")
+}
+func (UnimplementedInventoryServiceServer) SubscribeBackInStock(context.Context, *SubscribeBackInStockRequest) (*SubscribeBackInStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeBackInStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) UnsubscribeBackInStock(context.Context, *UnsubscribeBackInStockRequest) (*UnsubscribeBackInStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeBackInStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) NotifyBackInStock(context.Context, *NotifyBackInStockRequest) (*NotifyBackInStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyBackInStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) RestockReturn(context.Context, *RestockReturnRequest) (*RestockReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestockReturn not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListLowStockItems(context.Context, *ListLowStockItemsRequest) (*ListInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLowStockItems not implemented")
+}
+func (UnimplementedInventoryServiceServer) CountLowStock(context.Context, *CountLowStockRequest) (*CountLowStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountLowStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListDueCounts(context.Context, *ListDueCountsRequest) (*ListInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDueCounts not implemented")
+}
+func (UnimplementedInventoryServiceServer) UpdateInventoryTags(context.Context, *UpdateInventoryTagsRequest) (*UpdateInventoryTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInventoryTags not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetUnitOfMeasure(context.Context, *SetUnitOfMeasureRequest) (*SetUnitOfMeasureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUnitOfMeasure not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetBackorderPolicy(context.Context, *SetBackorderPolicyRequest) (*SetBackorderPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBackorderPolicy not implemented")
+}
+func (UnimplementedInventoryServiceServer) MergeDuplicateInventory(context.Context, *MergeDuplicateInventoryRequest) (*MergeDuplicateInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeDuplicateInventory not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReceivePurchaseOrder(context.Context, *ReceivePurchaseOrderRequest) (*ReceivePurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceivePurchaseOrder not implemented")
+}
+func (UnimplementedInventoryServiceServer) ExportStockAdjustments(context.Context, *ExportStockAdjustmentsRequest) (*ExportStockAdjustmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportStockAdjustments not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReserveWithAllocation(context.Context, *ReserveWithAllocationRequest) (*ReserveWithAllocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveWithAllocation not implemented")
+}
+func (UnimplementedInventoryServiceServer) TransferStock(context.Context, *TransferStockRequest) (*TransferStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) BatchAdjust(context.Context, *BatchAdjustRequest) (*BatchAdjustResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchAdjust not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetReservationStatus(context.Context, *GetReservationStatusRequest) (*GetReservationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReservationStatus not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetReservationStatus(context.Context, *SetReservationStatusRequest) (*SetReservationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReservationStatus not implemented")
+}
 func (UnimplementedInventoryServiceServer) testEmbeddedByValue() {}
 
 // UnsafeInventoryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1116,6 +1496,384 @@ func _InventoryService_GetInventoryHistory_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_GetReservationsForOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReservationsForOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetReservationsForOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetReservationsForOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetReservationsForOrder(ctx, req.(*GetReservationsForOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReleaseAllForOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseAllForOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReleaseAllForOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReleaseAllForOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReleaseAllForOrder(ctx, req.(*ReleaseAllForOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReconcileReservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileReservationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReconcileReservations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReconcileReservations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReconcileReservations(ctx, req.(*ReconcileReservationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SubscribeBackInStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeBackInStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SubscribeBackInStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SubscribeBackInStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SubscribeBackInStock(ctx, req.(*SubscribeBackInStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UnsubscribeBackInStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeBackInStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UnsubscribeBackInStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UnsubscribeBackInStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UnsubscribeBackInStock(ctx, req.(*UnsubscribeBackInStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_NotifyBackInStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifyBackInStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).NotifyBackInStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_NotifyBackInStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).NotifyBackInStock(ctx, req.(*NotifyBackInStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_RestockReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestockReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).RestockReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_RestockReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).RestockReturn(ctx, req.(*RestockReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListLowStockItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLowStockItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListLowStockItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListLowStockItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListLowStockItems(ctx, req.(*ListLowStockItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CountLowStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountLowStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CountLowStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CountLowStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CountLowStock(ctx, req.(*CountLowStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListDueCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDueCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListDueCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListDueCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListDueCounts(ctx, req.(*ListDueCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UpdateInventoryTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInventoryTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdateInventoryTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdateInventoryTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdateInventoryTags(ctx, req.(*UpdateInventoryTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SetUnitOfMeasure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUnitOfMeasureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SetUnitOfMeasure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SetUnitOfMeasure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SetUnitOfMeasure(ctx, req.(*SetUnitOfMeasureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SetBackorderPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBackorderPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SetBackorderPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SetBackorderPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SetBackorderPolicy(ctx, req.(*SetBackorderPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_MergeDuplicateInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeDuplicateInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).MergeDuplicateInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_MergeDuplicateInventory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).MergeDuplicateInventory(ctx, req.(*MergeDuplicateInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReceivePurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceivePurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReceivePurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReceivePurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReceivePurchaseOrder(ctx, req.(*ReceivePurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ExportStockAdjustments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportStockAdjustmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ExportStockAdjustments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ExportStockAdjustments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ExportStockAdjustments(ctx, req.(*ExportStockAdjustmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReserveWithAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveWithAllocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReserveWithAllocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReserveWithAllocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReserveWithAllocation(ctx, req.(*ReserveWithAllocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_TransferStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).TransferStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_TransferStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).TransferStock(ctx, req.(*TransferStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_BatchAdjust_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchAdjustRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).BatchAdjust(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_BatchAdjust_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).BatchAdjust(ctx, req.(*BatchAdjustRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetReservationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReservationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetReservationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetReservationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetReservationStatus(ctx, req.(*GetReservationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SetReservationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReservationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SetReservationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SetReservationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SetReservationStatus(ctx, req.(*SetReservationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InventoryService_ServiceDesc is the grpc.ServiceDesc for InventoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1239,6 +1997,90 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInventoryHistory",
 			Handler:    _InventoryService_GetInventoryHistory_Handler,
 		},
+		{
+			MethodName: "GetReservationsForOrder",
+			Handler:    _InventoryService_GetReservationsForOrder_Handler,
+		},
+		{
+			MethodName: "ReleaseAllForOrder",
+			Handler:    _InventoryService_ReleaseAllForOrder_Handler,
+		},
+		{
+			MethodName: "ReconcileReservations",
+			Handler:    _InventoryService_ReconcileReservations_Handler,
+		},
+		{
+			MethodName: "SubscribeBackInStock",
+			Handler:    _InventoryService_SubscribeBackInStock_Handler,
+		},
+		{
+			MethodName: "UnsubscribeBackInStock",
+			Handler:    _InventoryService_UnsubscribeBackInStock_Handler,
+		},
+		{
+			MethodName: "NotifyBackInStock",
+			Handler:    _InventoryService_NotifyBackInStock_Handler,
+		},
+		{
+			MethodName: "RestockReturn",
+			Handler:    _InventoryService_RestockReturn_Handler,
+		},
+		{
+			MethodName: "ListLowStockItems",
+			Handler:    _InventoryService_ListLowStockItems_Handler,
+		},
+		{
+			MethodName: "CountLowStock",
+			Handler:    _InventoryService_CountLowStock_Handler,
+		},
+		{
+			MethodName: "ListDueCounts",
+			Handler:    _InventoryService_ListDueCounts_Handler,
+		},
+		{
+			MethodName: "UpdateInventoryTags",
+			Handler:    _InventoryService_UpdateInventoryTags_Handler,
+		},
+		{
+			MethodName: "SetUnitOfMeasure",
+			Handler:    _InventoryService_SetUnitOfMeasure_Handler,
+		},
+		{
+			MethodName: "SetBackorderPolicy",
+			Handler:    _InventoryService_SetBackorderPolicy_Handler,
+		},
+		{
+			MethodName: "MergeDuplicateInventory",
+			Handler:    _InventoryService_MergeDuplicateInventory_Handler,
+		},
+		{
+			MethodName: "ReceivePurchaseOrder",
+			Handler:    _InventoryService_ReceivePurchaseOrder_Handler,
+		},
+		{
+			MethodName: "ExportStockAdjustments",
+			Handler:    _InventoryService_ExportStockAdjustments_Handler,
+		},
+		{
+			MethodName: "ReserveWithAllocation",
+			Handler:    _InventoryService_ReserveWithAllocation_Handler,
+		},
+		{
+			MethodName: "TransferStock",
+			Handler:    _InventoryService_TransferStock_Handler,
+		},
+		{
+			MethodName: "BatchAdjust",
+			Handler:    _InventoryService_BatchAdjust_Handler,
+		},
+		{
+			MethodName: "GetReservationStatus",
+			Handler:    _InventoryService_GetReservationStatus_Handler,
+		},
+		{
+			MethodName: "SetReservationStatus",
+			Handler:    _InventoryService_SetReservationStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inventory/v1/inventory.proto",
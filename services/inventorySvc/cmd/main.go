@@ -5,6 +5,8 @@ import (
 
 	"go.uber.org/zap"
 
+	logging "github.com/leonvanderhaeghen/stockplatform/pkg/logger"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/version"
 	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/config"
 	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/database"
 	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/server"
@@ -12,13 +14,13 @@ import (
 
 func main() {
 	// Initialize logger
-	logger, err := zap.NewDevelopment()
+	logger, err := logging.FromEnv("inventory-service")
 	if err != nil {
 		log.Fatalf("Failed to initialize logger: %v", err)
 	}
 	defer logger.Sync()
 
-	logger.Info("Starting inventory service...")
+	logger.Info("Starting inventory service...", version.LogFields()...)
 
 	// Load configuration
 	cfg := config.Load(logger)
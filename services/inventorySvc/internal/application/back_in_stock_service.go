@@ -0,0 +1,100 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// BackInStockService manages back-in-stock subscriptions and turns them into
+// queued notifications once a product is available again
+type BackInStockService struct {
+	repo   domain.BackInStockRepository
+	logger *zap.Logger
+}
+
+// NewBackInStockService creates a new back-in-stock service
+func NewBackInStockService(repo domain.BackInStockRepository, logger *zap.Logger) *BackInStockService {
+	return &BackInStockService{
+		repo:   repo,
+		logger: logger.Named("back_in_stock_service"),
+	}
+}
+
+// Subscribe registers a user for a back-in-stock alert on a product. Subscribing
+// again while a subscription is pending returns the existing one; the boolean
+// reports whether a new subscription was created.
+func (s *BackInStockService) Subscribe(ctx context.Context, userID, productID string) (*domain.BackInStockSubscription, bool, error) {
+	s.logger.Info("Subscribing to back-in-stock alert",
+		zap.String("user_id", userID),
+		zap.String("product_id", productID),
+	)
+
+	if userID == "" || productID == "" {
+		return nil, false, errors.New("user ID and product ID are required")
+	}
+
+	return s.repo.Subscribe(ctx, domain.NewBackInStockSubscription(userID, productID))
+}
+
+// Unsubscribe removes a user's back-in-stock alert for a product
+func (s *BackInStockService) Unsubscribe(ctx context.Context, userID, productID string) error {
+	s.logger.Info("Unsubscribing from back-in-stock alert",
+		zap.String("user_id", userID),
+		zap.String("product_id", productID),
+	)
+
+	if userID == "" || productID == "" {
+		return errors.New("user ID and product ID are required")
+	}
+
+	return s.repo.Unsubscribe(ctx, userID, productID)
+}
+
+// HandleStockChanged queues a notification for every subscriber of a product
+// whose available-to-promise quantity is above zero, then clears those
+// subscriptions. It returns the number of notifications queued. A notification
+// that was already queued for a subscription is not queued again, so replaying
+// the same stock event is harmless.
+func (s *BackInStockService) HandleStockChanged(ctx context.Context, productID string, available int32) (int, error) {
+	if productID == "" {
+		return 0, errors.New("product ID is required")
+	}
+	if available <= 0 {
+		return 0, nil
+	}
+
+	subs, err := s.repo.ListByProduct(ctx, productID)
+	if err != nil {
+		return 0, err
+	}
+
+	queued := 0
+	for _, sub := range subs {
+		err := s.repo.EnqueueNotification(ctx, domain.NewBackInStockNotification(sub, available))
+		switch {
+		case err == nil:
+			queued++
+		case errors.Is(err, domain.ErrDuplicateEntity):
+			// Already fired by an earlier delivery of this event; just clean up
+		default:
+			return queued, err
+		}
+
+		if err := s.repo.Delete(ctx, sub); err != nil {
+			return queued, err
+		}
+	}
+
+	if queued > 0 {
+		s.logger.Info("Queued back-in-stock notifications",
+			zap.String("product_id", productID),
+			zap.Int32("available", available),
+			zap.Int("count", queued),
+		)
+	}
+	return queued, nil
+}
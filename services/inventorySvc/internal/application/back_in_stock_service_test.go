@@ -0,0 +1,143 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// memoryBackInStockRepository keeps subscriptions and queued notifications in memory
+type memoryBackInStockRepository struct {
+	mu            sync.Mutex
+	subs          map[string]*domain.BackInStockSubscription
+	notifications map[string]*domain.BackInStockNotification
+}
+
+func newMemoryBackInStockRepository() *memoryBackInStockRepository {
+	return &memoryBackInStockRepository{
+		subs:          make(map[string]*domain.BackInStockSubscription),
+		notifications: make(map[string]*domain.BackInStockNotification),
+	}
+}
+
+func (r *memoryBackInStockRepository) Subscribe(ctx context.Context, sub *domain.BackInStockSubscription) (*domain.BackInStockSubscription, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if existing, ok := r.subs[sub.ID]; ok {
+		return existing, false, nil
+	}
+	r.subs[sub.ID] = sub
+	return sub, true, nil
+}
+
+func (r *memoryBackInStockRepository) Unsubscribe(ctx context.Context, userID, productID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.subs, domain.NewBackInStockSubscription(userID, productID).ID)
+	return nil
+}
+
+func (r *memoryBackInStockRepository) ListByProduct(ctx context.Context, productID string) ([]*domain.BackInStockSubscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var subs []*domain.BackInStockSubscription
+	for _, sub := range r.subs {
+		if sub.ProductID == productID {
+			subs = append(subs, sub)
+		}
+	}
+	return subs, nil
+}
+
+func (r *memoryBackInStockRepository) Delete(ctx context.Context, sub *domain.BackInStockSubscription) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if existing, ok := r.subs[sub.ID]; ok && existing.CreatedAt.Equal(sub.CreatedAt) {
+		delete(r.subs, sub.ID)
+	}
+	return nil
+}
+
+func (r *memoryBackInStockRepository) EnqueueNotification(ctx context.Context, notification *domain.BackInStockNotification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.notifications[notification.ID]; ok {
+		return domain.ErrDuplicateEntity
+	}
+	r.notifications[notification.ID] = notification
+	return nil
+}
+
+func TestBackInStockCrossingZeroNotifiesOnce(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemoryBackInStockRepository()
+	service := NewBackInStockService(repo, zap.NewNop())
+
+	_, created, err := service.Subscribe(ctx, "user-1", "product-1")
+	require.NoError(t, err)
+	assert.True(t, created)
+	_, created, err = service.Subscribe(ctx, "user-1", "product-1")
+	require.NoError(t, err)
+	assert.False(t, created, "subscribing twice keeps one subscription")
+
+	queued, err := service.HandleStockChanged(ctx, "product-1", 0)
+	require.NoError(t, err)
+	assert.Zero(t, queued, "still out of stock")
+
+	queued, err = service.HandleStockChanged(ctx, "product-1", 3)
+	require.NoError(t, err)
+	assert.Equal(t, 1, queued)
+
+	// Further stock changes, or the same event seen again, notify no one
+	for _, available := range []int32{3, 5} {
+		queued, err = service.HandleStockChanged(ctx, "product-1", available)
+		require.NoError(t, err)
+		assert.Zero(t, queued)
+	}
+
+	assert.Len(t, repo.notifications, 1)
+	assert.Empty(t, repo.subs, "a fired subscription is cleared")
+}
+
+func TestBackInStockReplayAfterFailedCleanupDoesNotNotifyTwice(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemoryBackInStockRepository()
+	service := NewBackInStockService(repo, zap.NewNop())
+
+	sub, _, err := service.Subscribe(ctx, "user-1", "product-1")
+	require.NoError(t, err)
+	// The notification was queued but the subscription survived, e.g. the
+	// service stopped before deleting it
+	require.NoError(t, repo.EnqueueNotification(ctx, domain.NewBackInStockNotification(sub, 2)))
+
+	queued, err := service.HandleStockChanged(ctx, "product-1", 2)
+	require.NoError(t, err)
+	assert.Zero(t, queued)
+	assert.Len(t, repo.notifications, 1)
+	assert.Empty(t, repo.subs)
+}
+
+func TestBackInStockOnlyNotifiesTheProductsSubscribers(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemoryBackInStockRepository()
+	service := NewBackInStockService(repo, zap.NewNop())
+
+	for _, userID := range []string{"user-1", "user-2"} {
+		_, _, err := service.Subscribe(ctx, userID, "product-1")
+		require.NoError(t, err)
+	}
+	_, _, err := service.Subscribe(ctx, "user-3", "product-2")
+	require.NoError(t, err)
+	require.NoError(t, service.Unsubscribe(ctx, "user-2", "product-1"))
+
+	queued, err := service.HandleStockChanged(ctx, "product-1", 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, queued)
+	assert.Len(t, repo.subs, 1, "product-2's subscription is left")
+}
@@ -0,0 +1,41 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// SetBackorderPolicy sets whether an inventory item may be backordered,
+// overriding the service-wide setting unless policy is the default. Items
+// already backordered stay so when backorders are denied; only further
+// removals are refused.
+func (s *InventoryService) SetBackorderPolicy(ctx context.Context, id string, policy domain.BackorderPolicy) (*domain.InventoryItem, error) {
+	item, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inventory item: %w", err)
+	}
+	if item == nil {
+		return nil, domain.ErrNotFound
+	}
+
+	item.BackorderPolicy = policy
+	if err := s.repo.Update(ctx, item); err != nil {
+		return nil, fmt.Errorf("failed to update inventory item: %w", err)
+	}
+
+	s.logger.Info("Set inventory backorder policy",
+		zap.String("id", id),
+		zap.String("policy", string(policy)),
+		zap.Bool("allows_backorder", item.AllowsBackorder(s.allowBackorder)),
+	)
+	return item, nil
+}
+
+// backorderNote adds the backordered units to the description of a history entry
+func backorderNote(description string, backordered int32) string {
+	return fmt.Sprintf("%s (%d units backordered)", description, backordered)
+}
@@ -0,0 +1,97 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+func newBackorderService(repo domain.InventoryRepository, allowBackorder bool) *InventoryService {
+	return NewInventoryService(repo, nil, nil, domain.ReservationTTL{}, allowBackorder, zap.NewNop())
+}
+
+// stockChanges are the ways of taking stock off an item the backorder policy
+// applies to
+var stockChanges = []struct {
+	name   string
+	remove func(s *InventoryService, id string, quantity int32) error
+}{
+	{name: "remove", remove: func(s *InventoryService, id string, quantity int32) error {
+		return s.RemoveStock(context.Background(), id, quantity)
+	}},
+	{name: "adjust", remove: func(s *InventoryService, id string, quantity int32) error {
+		return s.AdjustStock(context.Background(), id, -quantity, "Damaged", "staff-1")
+	}},
+}
+
+func TestBackorderPolicyAtTheBoundary(t *testing.T) {
+	tests := []struct {
+		name            string
+		allowByDefault  bool
+		policy          domain.BackorderPolicy
+		quantity        int32
+		wantErr         bool
+		wantQuantity    int32
+		wantBackordered int32
+	}{
+		{name: "all stock while denied", quantity: 5, wantQuantity: 0},
+		{name: "one more while denied", quantity: 6, wantErr: true, wantQuantity: 5},
+		{name: "all stock while allowed", allowByDefault: true, quantity: 5, wantQuantity: 0},
+		{name: "one more while allowed", allowByDefault: true, quantity: 6, wantQuantity: -1, wantBackordered: 1},
+		{name: "item allows against the default", policy: domain.BackorderPolicyAllow, quantity: 6, wantQuantity: -1, wantBackordered: 1},
+		{name: "item denies against the default", allowByDefault: true, policy: domain.BackorderPolicyDeny, quantity: 6, wantErr: true, wantQuantity: 5},
+	}
+	for _, change := range stockChanges {
+		for _, tt := range tests {
+			t.Run(change.name+"/"+tt.name, func(t *testing.T) {
+				item := domain.NewInventoryItem("product-1", 5, "LAMP-01", "warehouse-1")
+				item.BackorderPolicy = tt.policy
+				repo := newMemoryRepository(item)
+				service := newBackorderService(repo, tt.allowByDefault)
+
+				err := change.remove(service, item.ID, tt.quantity)
+
+				stored := repo.get(item.ID)
+				assert.Equal(t, tt.wantQuantity, stored.Quantity)
+				assert.Equal(t, tt.wantBackordered, stored.Backordered())
+				if tt.wantErr {
+					require.ErrorIs(t, err, domain.ErrInsufficientStock)
+					assert.Empty(t, repo.history, "a refused removal records no history")
+					return
+				}
+				require.NoError(t, err)
+				require.Len(t, repo.history, 1)
+				if tt.wantBackordered > 0 {
+					assert.Contains(t, repo.history[0].Description, "(1 units backordered)")
+				} else {
+					assert.NotContains(t, repo.history[0].Description, "backordered")
+				}
+			})
+		}
+	}
+}
+
+func TestSetBackorderPolicy(t *testing.T) {
+	item := domain.NewInventoryItem("product-1", 1, "LAMP-01", "warehouse-1")
+	repo := newMemoryRepository(item)
+	service := newBackorderService(repo, false)
+	ctx := context.Background()
+
+	require.ErrorIs(t, service.RemoveStock(ctx, item.ID, 2), domain.ErrInsufficientStock)
+
+	updated, err := service.SetBackorderPolicy(ctx, item.ID, domain.BackorderPolicyAllow)
+	require.NoError(t, err)
+	assert.Equal(t, domain.BackorderPolicyAllow, updated.BackorderPolicy)
+	require.NoError(t, service.RemoveStock(ctx, item.ID, 2))
+	assert.Equal(t, int32(1), repo.get(item.ID).Backordered())
+
+	_, err = service.SetBackorderPolicy(ctx, item.ID, domain.BackorderPolicyDeny)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), repo.get(item.ID).Backordered(), "denying backorders keeps the existing backorder")
+	require.ErrorIs(t, service.RemoveStock(ctx, item.ID, 1), domain.ErrInsufficientStock)
+}
@@ -0,0 +1,57 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+func countItem(id, locationID string, nextCount time.Time) *domain.InventoryItem {
+	item := domain.NewInventoryItem("product-"+id, 5, "SKU-"+id, locationID)
+	item.ID = id
+	if !nextCount.IsZero() {
+		item.ScheduleInventoryCount(nextCount)
+	}
+	return item
+}
+
+func TestListDueCountsReturnsOnlyDueItems(t *testing.T) {
+	asOf := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	repo := newMemoryRepository(
+		countItem("due-today", "warehouse-1", asOf),
+		countItem("overdue", "warehouse-1", asOf.AddDate(0, 0, -3)),
+		countItem("next-week", "warehouse-1", asOf.AddDate(0, 0, 7)),
+		countItem("never-scheduled", "warehouse-1", time.Time{}),
+		countItem("other-location", "warehouse-2", asOf.AddDate(0, 0, -1)),
+	)
+	service := newTestInventoryService(repo)
+
+	items, total, err := service.ListDueCounts(context.Background(), "warehouse-1", asOf, 10, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(2), total)
+	require.Len(t, items, 2)
+	assert.Equal(t, "overdue", items[0].ID, "the longest overdue count comes first")
+	assert.Equal(t, "due-today", items[1].ID)
+}
+
+func TestListDueCountsPaginates(t *testing.T) {
+	asOf := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	repo := newMemoryRepository(
+		countItem("a", "warehouse-1", asOf.AddDate(0, 0, -3)),
+		countItem("b", "warehouse-1", asOf.AddDate(0, 0, -2)),
+		countItem("c", "warehouse-1", asOf.AddDate(0, 0, -1)),
+	)
+	service := newTestInventoryService(repo)
+
+	items, total, err := service.ListDueCounts(context.Background(), "", asOf, 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	require.Len(t, items, 1)
+	assert.Equal(t, "c", items[0].ID)
+}
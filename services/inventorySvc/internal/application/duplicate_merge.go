@@ -0,0 +1,73 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// MergeDuplicates consolidates inventory items that share a SKU at a location,
+// which legacy data migrations left behind. The oldest item of each SKU is
+// kept; the quantities, reservations and order reservations of the others are
+// added to it and they are deleted, one transaction per SKU.
+func (s *InventoryService) MergeDuplicates(ctx context.Context, locationID string) ([]*domain.DuplicateMerge, error) {
+	s.logger.Info("Merging duplicate inventory items", zap.String("location_id", locationID))
+
+	if locationID == "" {
+		return nil, errors.New("location ID is required")
+	}
+
+	groups, err := s.repo.ListDuplicates(ctx, locationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find duplicate inventory items: %w", err)
+	}
+
+	merges := make([]*domain.DuplicateMerge, 0, len(groups))
+	for _, group := range groups {
+		kept := group[0]
+		merge := &domain.DuplicateMerge{SKU: kept.SKU, KeptItemID: kept.ID}
+		merges = append(merges, merge)
+
+		before := kept.Quantity
+		mergedIDs := make([]string, 0, len(group)-1)
+		for _, dup := range group[1:] {
+			kept.Absorb(dup)
+			mergedIDs = append(mergedIDs, dup.ID)
+		}
+
+		if err := s.repo.MergeItems(ctx, kept, mergedIDs); err != nil {
+			return merges, fmt.Errorf("failed to merge inventory items for SKU %s: %w", kept.SKU, err)
+		}
+
+		merge.MergedItemIDs = mergedIDs
+		merge.Quantity = kept.Quantity
+		merge.Reserved = kept.Reserved
+		merge.Damaged = kept.Damaged
+		for _, r := range kept.ActiveReservations() {
+			merge.OrderIDs = append(merge.OrderIDs, r.OrderID)
+		}
+
+		description := "Merged duplicate items " + strings.Join(mergedIDs, ", ")
+		if err := s.recordInventoryHistory(ctx, kept.ID, "DUPLICATES_MERGED", description, before, kept.Quantity, "", "MERGE", "system"); err != nil {
+			s.logger.Error("Failed to record inventory history after merging duplicates",
+				zap.String("inventory_id", kept.ID),
+				zap.Error(err),
+			)
+		}
+
+		s.logger.Info("Merged duplicate inventory items",
+			zap.String("sku", kept.SKU),
+			zap.String("kept_id", kept.ID),
+			zap.Strings("merged_ids", mergedIDs),
+			zap.Int32("quantity", kept.Quantity),
+			zap.Int32("reserved", kept.Reserved),
+		)
+	}
+
+	return merges, nil
+}
@@ -0,0 +1,72 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+func duplicateItem(t *testing.T, id, sku, locationID string, quantity int32, created time.Time, reservations map[string]int32) *domain.InventoryItem {
+	t.Helper()
+	item := domain.NewInventoryItem("product-"+sku, quantity, sku, locationID)
+	item.ID = id
+	item.CreatedAt = created
+	for orderID, reserved := range reservations {
+		require.True(t, item.ReserveForOrder(reserved, orderID, time.Time{}))
+	}
+	return item
+}
+
+func TestMergeDuplicatesConsolidatesItems(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := newMemoryRepository(
+		duplicateItem(t, "item-b", "SKU-1", "store-1", 4, base.Add(time.Hour), map[string]int32{"order-a": 1, "order-b": 2}),
+		duplicateItem(t, "item-a", "SKU-1", "store-1", 10, base, map[string]int32{"order-a": 3}),
+		duplicateItem(t, "item-c", "SKU-1", "store-1", 1, base.Add(2*time.Hour), nil),
+		duplicateItem(t, "item-d", "SKU-2", "store-1", 7, base, nil),
+		duplicateItem(t, "item-e", "SKU-1", "store-2", 5, base, nil),
+	)
+	service := newTestInventoryService(repo)
+
+	merges, err := service.MergeDuplicates(context.Background(), "store-1")
+	require.NoError(t, err)
+	require.Len(t, merges, 1)
+
+	merge := merges[0]
+	assert.Equal(t, "SKU-1", merge.SKU)
+	assert.Equal(t, "item-a", merge.KeptItemID, "the oldest item is kept")
+	assert.ElementsMatch(t, []string{"item-b", "item-c"}, merge.MergedItemIDs)
+	assert.Equal(t, int32(15), merge.Quantity)
+	assert.Equal(t, int32(6), merge.Reserved)
+	assert.ElementsMatch(t, []string{"order-a", "order-b"}, merge.OrderIDs)
+
+	assert.Nil(t, repo.get("item-b"))
+	assert.Nil(t, repo.get("item-c"))
+	kept := repo.get("item-a")
+	require.NotNil(t, kept)
+	assert.Equal(t, int32(15), kept.Quantity)
+	assert.Equal(t, int32(6), kept.Reserved)
+	assert.Equal(t, int32(4), kept.ReservationFor("order-a").Quantity, "both items' units for the same order are combined")
+	assert.Equal(t, int32(2), kept.ReservationFor("order-b").Quantity)
+
+	// Other SKUs and other locations are untouched
+	assert.Equal(t, int32(7), repo.get("item-d").Quantity)
+	assert.Equal(t, int32(5), repo.get("item-e").Quantity)
+}
+
+func TestMergeDuplicatesWithoutDuplicates(t *testing.T) {
+	repo := newMemoryRepository(duplicateItem(t, "item-a", "SKU-1", "store-1", 10, time.Now(), nil))
+	service := newTestInventoryService(repo)
+
+	merges, err := service.MergeDuplicates(context.Background(), "store-1")
+	require.NoError(t, err)
+	assert.Empty(t, merges)
+
+	_, err = service.MergeDuplicates(context.Background(), "")
+	assert.Error(t, err)
+}
@@ -101,7 +101,7 @@ func (s *InventoryOrderService) ProcessOrderUpdate(
 			}
 			
 			// Fulfill reservation
-			err = s.inventoryService.FulfillReservation(ctx, inventory.ID, int32(item.Quantity))
+			err = s.inventoryService.FulfillReservation(ctx, inventory.ID, int32(item.Quantity), orderID)
 			if err != nil {
 				return fmt.Errorf("failed to fulfill reservation for product %s: %w", 
 					item.ProductID, err)
@@ -120,7 +120,7 @@ func (s *InventoryOrderService) ProcessOrderUpdate(
 			}
 			
 			// Release reservation
-			err = s.inventoryService.ReleaseReservation(ctx, inventory.ID, int32(item.Quantity))
+			err = s.inventoryService.ReleaseReservation(ctx, inventory.ID, int32(item.Quantity), "")
 			if err != nil {
 				return fmt.Errorf("failed to release reservation for product %s: %w", 
 					item.ProductID, err)
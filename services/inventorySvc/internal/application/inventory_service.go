@@ -4,36 +4,59 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/dates"
 	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
 )
 
 // InventoryService handles business logic for inventory operations
 type InventoryService struct {
-	repo   domain.InventoryRepository
-	logger *zap.Logger
+	repo     domain.InventoryRepository
+	receipts domain.PurchaseOrderReceiptRepository
+	products domain.ProductCatalog
+	// reservationTTL sets when order reservations expire
+	reservationTTL domain.ReservationTTL
+	// allowBackorder lets removals take items without a backorder policy of
+	// their own below zero available
+	allowBackorder bool
+	logger         *zap.Logger
 }
 
-// NewInventoryService creates a new inventory service
-func NewInventoryService(repo domain.InventoryRepository, logger *zap.Logger) *InventoryService {
+// NewInventoryService creates a new inventory service. When products is nil,
+// inventory items are created without checking that their product exists.
+func NewInventoryService(repo domain.InventoryRepository, receipts domain.PurchaseOrderReceiptRepository, products domain.ProductCatalog, reservationTTL domain.ReservationTTL, allowBackorder bool, logger *zap.Logger) *InventoryService {
 	return &InventoryService{
-		repo:   repo,
-		logger: logger.Named("inventory_service"),
+		repo:           repo,
+		receipts:       receipts,
+		products:       products,
+		reservationTTL: reservationTTL,
+		allowBackorder: allowBackorder,
+		logger:         logger.Named("inventory_service"),
 	}
 }
 
-// CreateInventoryItem creates a new inventory item
-func (s *InventoryService) CreateInventoryItem(ctx context.Context, productID string, quantity int32, sku string, locationID string) (*domain.InventoryItem, error) {
+// CreateInventoryItem creates a new inventory item. With checkProduct, the
+// product must exist and have the given SKU; bulk imports that run without
+// the product service turn the check off.
+func (s *InventoryService) CreateInventoryItem(ctx context.Context, productID string, quantity int32, sku string, locationID string, tags []string, checkProduct bool) (*domain.InventoryItem, error) {
 	s.logger.Info("Creating inventory item",
 		zap.String("product_id", productID),
 		zap.Int32("quantity", quantity),
 		zap.String("sku", sku),
 		zap.String("location_id", locationID),
+		zap.Bool("check_product", checkProduct),
 	)
 
+	if checkProduct {
+		if err := s.checkProductSKU(ctx, productID, sku); err != nil {
+			return nil, err
+		}
+	}
+
 	// Check if inventory item with this SKU already exists at this location
 	existingItem, err := s.repo.GetBySKUAndLocation(ctx, sku, locationID)
 	if err != nil && !errors.Is(err, domain.ErrNotFound) {
@@ -49,6 +72,7 @@ func (s *InventoryService) CreateInventoryItem(ctx context.Context, productID st
 	}
 
 	item := domain.NewInventoryItem(productID, quantity, sku, locationID)
+	item.Tags = domain.NormalizeTags(tags)
 	if err := s.repo.Create(ctx, item); err != nil {
 		return nil, err
 	}
@@ -56,6 +80,30 @@ func (s *InventoryService) CreateInventoryItem(ctx context.Context, productID st
 	return item, nil
 }
 
+// checkProductSKU rejects a product ID that matches no product, or a SKU
+// that is not the product's, with ErrInvalidInput. Nothing is checked when
+// the service runs without a product catalog.
+func (s *InventoryService) checkProductSKU(ctx context.Context, productID, sku string) error {
+	if s.products == nil {
+		return nil
+	}
+	productSKU, err := s.products.ProductSKU(ctx, productID)
+	if errors.Is(err, domain.ErrProductNotFound) {
+		return fmt.Errorf("%w: product %s does not exist", domain.ErrInvalidInput, productID)
+	}
+	if err != nil {
+		s.logger.Error("Failed to look up product of new inventory item",
+			zap.String("product_id", productID),
+			zap.Error(err),
+		)
+		return err
+	}
+	if !strings.EqualFold(productSKU, sku) {
+		return fmt.Errorf("%w: SKU %s does not belong to product %s, whose SKU is %s", domain.ErrInvalidInput, sku, productID, productSKU)
+	}
+	return nil
+}
+
 // GetInventoryItem retrieves an inventory item by ID
 func (s *InventoryService) GetInventoryItem(ctx context.Context, id string) (*domain.InventoryItem, error) {
 	s.logger.Debug("Getting inventory item", zap.String("id", id))
@@ -163,35 +211,84 @@ func (s *InventoryService) DeleteInventoryItem(ctx context.Context, id string) e
 	return s.repo.Delete(ctx, id)
 }
 
-// ListInventoryItems returns all inventory items with pagination
-func (s *InventoryService) ListInventoryItems(ctx context.Context, limit, offset int) ([]*domain.InventoryItem, error) {
+// ListInventoryItems returns a page of inventory items matching the filter
+// along with the total number of matches
+func (s *InventoryService) ListInventoryItems(ctx context.Context, filter domain.InventoryFilter, limit, offset int) ([]*domain.InventoryItem, int64, error) {
 	s.logger.Debug("Listing inventory items",
+		zap.String("location_id", filter.LocationID),
+		zap.String("stock_status", filter.StockStatus),
+		zap.Strings("tags", filter.Tags),
+		zap.Time("updated_since", filter.UpdatedSince),
 		zap.Int("limit", limit),
 		zap.Int("offset", offset),
 	)
 	
-	return s.repo.List(ctx, limit, offset)
+	switch filter.StockStatus {
+	case "", domain.StockStatusAll, domain.StockStatusInStock, domain.StockStatusLowStock, domain.StockStatusOutOfStock:
+	default:
+		return nil, 0, fmt.Errorf("%w: unknown stock status %q", domain.ErrInvalidInput, filter.StockStatus)
+	}
+	filter.Tags = domain.NormalizeTags(filter.Tags)
+	
+	return s.repo.ListFiltered(ctx, filter, limit, offset)
 }
 
-// ListInventoryItemsByLocation returns inventory items for a specific location
-func (s *InventoryService) ListInventoryItemsByLocation(ctx context.Context, locationID string, limit, offset int) ([]*domain.InventoryItem, error) {
-	s.logger.Debug("Listing inventory items by location",
-		zap.String("location_id", locationID),
-		zap.Int("limit", limit),
-		zap.Int("offset", offset),
-	)
+// ListInventoryItemsByLocation returns a page of inventory items for the
+// filter's location, which is required, with the total count
+func (s *InventoryService) ListInventoryItemsByLocation(ctx context.Context, filter domain.InventoryFilter, limit, offset int) ([]*domain.InventoryItem, int64, error) {
+	if filter.LocationID == "" {
+		return nil, 0, fmt.Errorf("%w: location ID is required", domain.ErrInvalidInput)
+	}
 	
-	return s.repo.ListByLocation(ctx, locationID, limit, offset)
+	return s.ListInventoryItems(ctx, filter, limit, offset)
 }
 
-// ListLowStockItems returns inventory items that are below their reorder point
-func (s *InventoryService) ListLowStockItems(ctx context.Context, limit, offset int) ([]*domain.InventoryItem, error) {
-	s.logger.Debug("Listing low stock items",
-		zap.Int("limit", limit),
-		zap.Int("offset", offset),
+// UpdateItemTags adds and removes tags on inventory items at a location; with
+// no item IDs every item at the location is updated. It returns the number of
+// items matched.
+func (s *InventoryService) UpdateItemTags(ctx context.Context, locationID string, itemIDs []string, add, remove []string) (int64, error) {
+	s.logger.Info("Updating inventory item tags",
+		zap.String("location_id", locationID),
+		zap.Int("item_count", len(itemIDs)),
 	)
 	
-	return s.repo.ListLowStock(ctx, limit, offset)
+	if locationID == "" {
+		return 0, errors.New("location ID is required")
+	}
+	add, remove = domain.NormalizeTags(add), domain.NormalizeTags(remove)
+	if len(add) == 0 && len(remove) == 0 {
+		return 0, errors.New("at least one tag to add or remove is required")
+	}
+	
+	return s.repo.UpdateTags(ctx, locationID, itemIDs, add, remove)
+}
+
+// ListLowStockItems returns a page of inventory items at or below their
+// reorder point, optionally at one location, with the total count
+func (s *InventoryService) ListLowStockItems(ctx context.Context, locationID string, limit, offset int) ([]*domain.InventoryItem, int64, error) {
+	return s.ListInventoryItems(ctx, domain.InventoryFilter{
+		LocationID:  locationID,
+		StockStatus: domain.StockStatusLowStock,
+	}, limit, offset)
+}
+
+// ListDueCounts returns a page of inventory items whose next count date is on
+// or before asOf, earliest first, optionally at one location. A zero asOf
+// means now.
+func (s *InventoryService) ListDueCounts(ctx context.Context, locationID string, asOf time.Time, limit, offset int) ([]*domain.InventoryItem, int64, error) {
+	if asOf.IsZero() {
+		asOf = time.Now()
+	}
+	return s.ListInventoryItems(ctx, domain.InventoryFilter{
+		LocationID: locationID,
+		CountDueBy: asOf,
+	}, limit, offset)
+}
+
+// CountLowStockItems counts inventory items that need reordering; an empty
+// location counts across all locations
+func (s *InventoryService) CountLowStockItems(ctx context.Context, locationID string) (int64, error) {
+	return s.repo.CountLowStock(ctx, locationID)
 }
 
 // SetReorderParameters sets inventory reordering parameters
@@ -254,9 +351,9 @@ func (s *InventoryService) ScheduleInventoryCount(ctx context.Context, id string
 	}
 	
 	// Parse the date string
-	parsedDate, err := time.Parse(time.RFC3339, nextCountDate)
+	parsedDate, err := dates.Parse(nextCountDate)
 	if err != nil {
-		return errors.New("invalid date format, use RFC3339")
+		return err
 	}
 	
 	item.ScheduleInventoryCount(parsedDate)
@@ -270,17 +367,29 @@ func (s *InventoryService) AdjustStock(ctx context.Context, id string, quantity
 		return fmt.Errorf("failed to get inventory item: %w", err)
 	}
 
+	return s.adjustItem(ctx, item, quantity, reason, performedBy, "", "MANUAL")
+}
+
+// adjustItem changes the quantity of item by quantity and records an
+// adjustment history entry with the given reference
+func (s *InventoryService) adjustItem(ctx context.Context, item *domain.InventoryItem, quantity int32, reason, performedBy, referenceID, referenceType string) error {
+	id := item.ID
 	oldQuantity := item.Quantity
 	newQuantity := oldQuantity + quantity
-	if newQuantity < 0 {
-		return fmt.Errorf("insufficient stock: cannot adjust to negative quantity")
+	if newQuantity < 0 && !item.AllowsBackorder(s.allowBackorder) {
+		return fmt.Errorf("%w: cannot adjust to negative quantity", domain.ErrInsufficientStock)
 	}
 
+	backorderedBefore := item.Backordered()
 	item.Quantity = newQuantity
 	item.LastUpdated = time.Now()
+	if backordered := item.Backordered() - backorderedBefore; backordered > 0 {
+		reason = backorderNote(reason, backordered)
+	}
 
 	// Update the item
 	if err := s.repo.Update(ctx, item); err != nil {
+		item.Quantity = oldQuantity
 		return fmt.Errorf("failed to update inventory item: %w", err)
 	}
 
@@ -288,12 +397,12 @@ func (s *InventoryService) AdjustStock(ctx context.Context, id string, quantity
 	historyErr := s.recordInventoryHistory(
 		ctx,
 		id,
-		"ADJUSTMENT",
+		domain.ChangeTypeAdjustment,
 		reason,
 		oldQuantity,
 		newQuantity,
-		"", // No reference ID for manual adjustments
-		"MANUAL",
+		referenceID,
+		referenceType,
 		performedBy,
 	)
 
@@ -356,6 +465,74 @@ func (s *InventoryService) AddStock(ctx context.Context, id string, quantity int
 	return nil
 }
 
+// RestockReturn puts returned units back into inventory for a product. Sellable
+// units are added to the available quantity; damaged units go to the damaged
+// bucket. The item at locationID is used when given, otherwise the product's
+// first inventory item.
+func (s *InventoryService) RestockReturn(ctx context.Context, productID, locationID string, quantity int32, damaged bool, referenceID, performedBy string) (*domain.InventoryItem, error) {
+	s.logger.Info("Restocking returned units",
+		zap.String("product_id", productID),
+		zap.String("location_id", locationID),
+		zap.Int32("quantity", quantity),
+		zap.Bool("damaged", damaged),
+		zap.String("reference_id", referenceID),
+	)
+
+	if quantity <= 0 {
+		return nil, errors.New("quantity must be positive")
+	}
+
+	var item *domain.InventoryItem
+	if locationID != "" {
+		found, err := s.repo.GetByProductAndLocation(ctx, productID, locationID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get inventory item: %w", err)
+		}
+		item = found
+	} else {
+		items, err := s.repo.GetByProductID(ctx, productID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get inventory items: %w", err)
+		}
+		if len(items) > 0 {
+			item = items[0]
+		}
+	}
+	if item == nil {
+		return nil, domain.ErrNotFound
+	}
+
+	changeType, description := "RETURN_RESTOCKED", "Returned units restocked as sellable"
+	before := item.Quantity
+	if damaged {
+		changeType, description = "RETURN_DAMAGED", "Returned units moved to the damaged bucket"
+		before = item.Damaged
+		item.AddDamagedStock(quantity)
+	} else {
+		item.AddStock(quantity)
+	}
+
+	if err := s.repo.Update(ctx, item); err != nil {
+		return nil, fmt.Errorf("failed to update inventory item: %w", err)
+	}
+
+	after := item.Quantity
+	if damaged {
+		after = item.Damaged
+	}
+	if performedBy == "" {
+		performedBy = "system"
+	}
+	if err := s.recordInventoryHistory(ctx, item.ID, changeType, description, before, after, referenceID, "RETURN", performedBy); err != nil {
+		s.logger.Error("Failed to record inventory history after restocking return",
+			zap.String("inventory_id", item.ID),
+			zap.Error(err),
+		)
+	}
+
+	return item, nil
+}
+
 // RemoveStock decreases the quantity of an inventory item
 func (s *InventoryService) RemoveStock(ctx context.Context, id string, quantity int32) error {
 	s.logger.Info("Removing stock",
@@ -374,8 +551,13 @@ func (s *InventoryService) RemoveStock(ctx context.Context, id string, quantity
 
 	oldQuantity := item.Quantity
 	
-	if !item.RemoveStock(quantity) {
-		return fmt.Errorf("insufficient stock: requested %d, available %d", quantity, item.Quantity)
+	backordered, ok := item.RemoveStockOrBackorder(quantity, item.AllowsBackorder(s.allowBackorder))
+	if !ok {
+		return fmt.Errorf("%w: requested %d, available %d", domain.ErrInsufficientStock, quantity, item.Quantity)
+	}
+	description := "Manual stock removal"
+	if backordered > 0 {
+		description = backorderNote(description, backordered)
 	}
 	
 	// Update the item
@@ -388,7 +570,7 @@ func (s *InventoryService) RemoveStock(ctx context.Context, id string, quantity
 		ctx,
 		id,
 		"STOCK_REMOVED",
-		"Manual stock removal",
+		description,
 		oldQuantity,
 		item.Quantity,
 		"", // No reference ID for manual removals
@@ -414,27 +596,24 @@ func (s *InventoryService) ReserveStock(ctx context.Context, id string, quantity
 		zap.Int32("quantity", quantity),
 	)
 	
-	item, err := s.repo.GetByID(ctx, id)
-	if err != nil {
-		return err
-	}
-	
-	if item == nil {
-		return errors.New("inventory item not found")
-	}
-	
-	if !item.Reserve(quantity) {
-		return errors.New("insufficient stock available")
+	if quantity <= 0 {
+		return errors.New("quantity must be positive")
 	}
 	
-	return s.repo.Update(ctx, item)
+	// Reserve checks availability and reserves in one write; reading the
+	// item and saving it back would let concurrent reservations oversell
+	return s.repo.Reserve(ctx, id, quantity)
 }
 
-// ReleaseReservation releases a reservation without fulfilling it
-func (s *InventoryService) ReleaseReservation(ctx context.Context, id string, quantity int32) error {
+// ReleaseReservation releases a reservation without fulfilling it. When the
+// item holds a reservation for orderID, only that order's units are released
+// and its record is cancelled once nothing of it is left. Otherwise only units
+// reserved without an order are released, so other orders keep their stock.
+func (s *InventoryService) ReleaseReservation(ctx context.Context, id string, quantity int32, orderID string) error {
 	s.logger.Info("Releasing reservation",
 		zap.String("id", id),
 		zap.Int32("quantity", quantity),
+		zap.String("order_id", orderID),
 	)
 	
 	item, err := s.repo.GetByID(ctx, id)
@@ -446,15 +625,25 @@ func (s *InventoryService) ReleaseReservation(ctx context.Context, id string, qu
 		return errors.New("inventory item not found")
 	}
 	
-	item.ReleaseReservation(quantity)
+	if r := item.ReservationFor(orderID); orderID != "" && r != nil && r.Active() {
+		item.CancelOrderReservation(quantity, orderID)
+	} else {
+		if unassigned := item.UnassignedReserved(); quantity > unassigned {
+			quantity = unassigned
+		}
+		item.ReleaseReservation(quantity)
+	}
 	return s.repo.Update(ctx, item)
 }
 
-// FulfillReservation completes a reservation and deducts from stock
-func (s *InventoryService) FulfillReservation(ctx context.Context, id string, quantity int32) error {
+// FulfillReservation completes a reservation and deducts from stock. When the
+// item holds a reservation for orderID the units come out of that order's
+// record; otherwise only units reserved without an order can be fulfilled.
+func (s *InventoryService) FulfillReservation(ctx context.Context, id string, quantity int32, orderID string) error {
 	s.logger.Info("Fulfilling reservation",
 		zap.String("id", id),
 		zap.Int32("quantity", quantity),
+		zap.String("order_id", orderID),
 	)
 	
 	item, err := s.repo.GetByID(ctx, id)
@@ -466,8 +655,15 @@ func (s *InventoryService) FulfillReservation(ctx context.Context, id string, qu
 		return errors.New("inventory item not found")
 	}
 	
-	if !item.FulfillReservation(quantity) {
-		return errors.New("insufficient reserved quantity")
+	if r := item.ReservationFor(orderID); orderID != "" && r != nil && r.Active() {
+		if quantity <= 0 {
+			return domain.ErrInsufficientReservation
+		}
+		if _, err := item.FulfillOrderReservation(orderID, quantity); err != nil {
+			return err
+		}
+	} else if quantity > item.UnassignedReserved() || !item.FulfillReservation(quantity) {
+		return domain.ErrInsufficientReservation
 	}
 	
 	return s.repo.Update(ctx, item)
@@ -491,9 +687,16 @@ func (s *InventoryService) CancelPickupReservation(ctx context.Context, orderID
 		return errors.New("no reserved inventory items found for this order at this location")
 	}
 
-	// Release the reservations for all items
+	// Release the order's reservations on all items
 	for _, item := range inventoryItems {
-		item.SetReservationStatus(domain.ReservationStatusCancelled)
+		r := item.ReservationFor(orderID)
+		if r == nil || !r.Active() {
+			continue
+		}
+		released := item.CancelOrderReservation(r.Quantity, orderID)
+		if reason != "" {
+			item.AddNote(fmt.Sprintf("Pickup of %d units cancelled: %s", released, reason))
+		}
 		err := s.repo.Update(ctx, item)
 		if err != nil {
 			return err
@@ -503,6 +706,27 @@ func (s *InventoryService) CancelPickupReservation(ctx context.Context, orderID
 	return nil
 }
 
+// GetReservationsForOrder returns every reservation held for an order, across all locations
+func (s *InventoryService) GetReservationsForOrder(ctx context.Context, orderID string) ([]*domain.OrderReservation, error) {
+	if orderID == "" {
+		return nil, errors.New("order ID is required")
+	}
+
+	items, err := s.repo.GetByOrder(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reservations for order: %w", err)
+	}
+
+	reservations := make([]*domain.OrderReservation, 0, len(items))
+	for _, item := range items {
+		if reservation := item.OrderReservation(orderID); reservation != nil {
+			reservations = append(reservations, reservation)
+		}
+	}
+
+	return reservations, nil
+}
+
 // CompletePickup completes an in-store pickup reservation
 func (s *InventoryService) CompletePickup(ctx context.Context, orderID string, locationID string, staffID string, notes string) error {
 	s.logger.Info("Completing pickup reservation",
@@ -523,13 +747,11 @@ func (s *InventoryService) CompletePickup(ctx context.Context, orderID string, l
 
 	// Process each item for fulfillment
 	for _, item := range inventoryItems {
-		// Mark as fulfilled and update the inventory
-		if !item.FulfillReservation(item.Reserved) {
-			return errors.New("insufficient reserved quantity for item: " + item.ID)
+		// Deduct the order's reserved units from stock
+		if _, err := item.FulfillOrderReservation(orderID, 0); err != nil {
+			return fmt.Errorf("failed to fulfill reservation on item %s: %w", item.ID, err)
 		}
 		
-		// Set reservation status to fulfilled
-		item.SetReservationStatus(domain.ReservationStatusFulfilled)
 		if notes != "" {
 			item.AddNote(fmt.Sprintf("Pickup completed by staff %s: %s", staffID, notes))
 		}
@@ -604,17 +826,21 @@ func (s *InventoryService) PerformPOSInventoryCheck(
 	return results, nil
 }
 
-// ReserveForPOSTransaction reserves inventory items for a POS transaction
+// ReserveForPOSTransaction reserves inventory items for a POS transaction.
+// The reservation expires after ttl, or the configured default when ttl is
+// zero.
 func (s *InventoryService) ReserveForPOSTransaction(
 	ctx context.Context, 
 	orderID string,
 	locationID string,
 	items []domain.ReservationItem,
+	ttl time.Duration,
 ) error {
 	s.logger.Info("Reserving inventory for POS transaction",
 		zap.String("order_id", orderID),
 		zap.String("location_id", locationID),
 		zap.Int("item_count", len(items)),
+		zap.Duration("ttl", ttl),
 	)
 
 	if orderID == "" {
@@ -626,7 +852,15 @@ func (s *InventoryService) ReserveForPOSTransaction(
 	if len(items) == 0 {
 		return errors.New("at least one item must be specified")
 	}
+	expiresAt, err := s.reservationTTL.ExpiresAt(ttl, time.Now())
+	if err != nil {
+		return err
+	}
 
+	// Resolve every item before reserving any, so a bad item fails the
+	// transaction without touching stock
+	byID := make(map[string]int, len(items))
+	reservations := make([]pendingReservation, 0, len(items))
 	for _, reserveItem := range items {
 		// Find the inventory item
 		var inventoryItem *domain.InventoryItem
@@ -644,13 +878,23 @@ func (s *InventoryService) ReserveForPOSTransaction(
 			return err
 		}
 
-		// Reserve the stock
-		if !inventoryItem.ReserveForOrder(reserveItem.Quantity, orderID) {
-			return errors.New("insufficient stock available for item: " + inventoryItem.ProductID)
+		if i, ok := byID[inventoryItem.ID]; ok {
+			reservations[i].quantity += reserveItem.Quantity
+			continue
 		}
+		byID[inventoryItem.ID] = len(reservations)
+		reservations = append(reservations, pendingReservation{item: inventoryItem, quantity: reserveItem.Quantity})
+	}
+	sortReservations(reservations)
 
-		// Update the inventory
-		if err := s.repo.Update(ctx, inventoryItem); err != nil {
+	for i, r := range reservations {
+		// Reserve the stock
+		err := errors.New("insufficient stock available for item: " + r.item.ProductID)
+		if r.item.ReserveForOrder(r.quantity, orderID, expiresAt) {
+			err = s.repo.Update(ctx, r.item)
+		}
+		if err != nil {
+			s.cancelOrderReservations(ctx, orderID, reservations[:i])
 			return err
 		}
 	}
@@ -658,6 +902,27 @@ func (s *InventoryService) ReserveForPOSTransaction(
 	return nil
 }
 
+// cancelOrderReservations rolls back reservations made for orderID, reading
+// each item again so that concurrent changes to it are kept
+func (s *InventoryService) cancelOrderReservations(ctx context.Context, orderID string, reservations []pendingReservation) {
+	for i := len(reservations) - 1; i >= 0; i-- {
+		r := reservations[i]
+		item, err := s.repo.GetByID(ctx, r.item.ID)
+		if err == nil && item != nil {
+			item.CancelOrderReservation(r.quantity, orderID)
+			err = s.repo.Update(ctx, item)
+		}
+		if err != nil {
+			s.logger.Error("Failed to cancel reservation during rollback",
+				zap.String("order_id", orderID),
+				zap.String("inventory_item_id", r.item.ID),
+				zap.Int32("quantity", r.quantity),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
 // DeductForDirectPOSTransaction immediately deducts inventory for direct POS sales (no reservation)
 func (s *InventoryService) DeductForDirectPOSTransaction(
 	ctx context.Context,
@@ -0,0 +1,59 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+func taggedItem(id, locationID string, tags ...string) *domain.InventoryItem {
+	item := domain.NewInventoryItem("product-"+id, 5, "SKU-"+id, locationID)
+	item.ID = id
+	item.Tags = tags
+	return item
+}
+
+func TestListInventoryItemsReturnsOnlyItemsWithEveryTag(t *testing.T) {
+	repo := newMemoryRepository(
+		taggedItem("item-1", "store-1", "fragile", "cold-chain"),
+		taggedItem("item-2", "store-1", "fragile"),
+		taggedItem("item-3", "store-1", "cold-chain", "hazmat", "fragile"),
+		taggedItem("item-4", "store-1"),
+		taggedItem("item-5", "store-2", "fragile", "cold-chain"),
+	)
+	service := newTestInventoryService(repo)
+
+	items, total, err := service.ListInventoryItems(context.Background(), domain.InventoryFilter{
+		LocationID: "store-1",
+		Tags:       []string{" Fragile", "COLD-CHAIN", "fragile"},
+	}, 10, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(2), total)
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	assert.Equal(t, []string{"item-1", "item-3"}, ids)
+}
+
+func TestListInventoryItemsWithoutTagsReturnsEveryItem(t *testing.T) {
+	repo := newMemoryRepository(
+		taggedItem("item-1", "store-1", "fragile"),
+		taggedItem("item-2", "store-1"),
+	)
+	service := newTestInventoryService(repo)
+
+	items, total, err := service.ListInventoryItems(context.Background(), domain.InventoryFilter{
+		LocationID: "store-1",
+		Tags:       []string{"  "},
+	}, 10, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(2), total)
+	assert.Len(t, items, 2)
+}
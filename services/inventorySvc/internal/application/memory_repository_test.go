@@ -0,0 +1,318 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// memoryRepository is an in-memory inventory repository for service tests.
+// Items are copied in and out so tests see only what was stored.
+type memoryRepository struct {
+	domain.InventoryRepository
+
+	mu      sync.Mutex
+	items   map[string]*domain.InventoryItem
+	history []*domain.InventoryHistory
+}
+
+func newMemoryRepository(items ...*domain.InventoryItem) *memoryRepository {
+	r := &memoryRepository{items: make(map[string]*domain.InventoryItem)}
+	for _, item := range items {
+		r.put(item)
+	}
+	return r
+}
+
+func newTestInventoryService(repo domain.InventoryRepository) *InventoryService {
+	return NewInventoryService(repo, nil, nil, domain.ReservationTTL{}, false, zap.NewNop())
+}
+
+func (r *memoryRepository) put(item *domain.InventoryItem) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.items[item.ID] = cloneItem(item)
+}
+
+func (r *memoryRepository) get(id string) *domain.InventoryItem {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	item, ok := r.items[id]
+	if !ok {
+		return nil
+	}
+	return cloneItem(item)
+}
+
+// cloneItem copies an item, including the slices it holds
+func cloneItem(item *domain.InventoryItem) *domain.InventoryItem {
+	copied := *item
+	copied.Tags = append([]string(nil), item.Tags...)
+	copied.Reservations = append([]domain.ItemReservation(nil), item.Reservations...)
+	return &copied
+}
+
+func (r *memoryRepository) Create(ctx context.Context, item *domain.InventoryItem) error {
+	r.put(item)
+	return nil
+}
+
+func (r *memoryRepository) GetByID(ctx context.Context, id string) (*domain.InventoryItem, error) {
+	if item := r.get(id); item != nil {
+		return item, nil
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (r *memoryRepository) GetByProductID(ctx context.Context, productID string) ([]*domain.InventoryItem, error) {
+	return r.filter(func(item *domain.InventoryItem) bool { return item.ProductID == productID }), nil
+}
+
+func (r *memoryRepository) GetByProductAndLocation(ctx context.Context, productID, locationID string) (*domain.InventoryItem, error) {
+	items := r.filter(func(item *domain.InventoryItem) bool {
+		return item.ProductID == productID && item.LocationID == locationID
+	})
+	if len(items) == 0 {
+		return nil, domain.ErrNotFound
+	}
+	return items[0], nil
+}
+
+func (r *memoryRepository) GetBySKUAndLocation(ctx context.Context, sku, locationID string) (*domain.InventoryItem, error) {
+	items := r.filter(func(item *domain.InventoryItem) bool {
+		return item.SKU == sku && item.LocationID == locationID
+	})
+	if len(items) == 0 {
+		return nil, domain.ErrNotFound
+	}
+	return items[0], nil
+}
+
+func (r *memoryRepository) GetByOrder(ctx context.Context, orderID string) ([]*domain.InventoryItem, error) {
+	return r.filter(func(item *domain.InventoryItem) bool { return item.ReservationFor(orderID) != nil }), nil
+}
+
+func (r *memoryRepository) GetByOrderAndLocation(ctx context.Context, orderID, locationID string) ([]*domain.InventoryItem, error) {
+	return r.filter(func(item *domain.InventoryItem) bool {
+		return item.ReservationFor(orderID) != nil && item.LocationID == locationID
+	}), nil
+}
+
+func (r *memoryRepository) Update(ctx context.Context, item *domain.InventoryItem) error {
+	if r.get(item.ID) == nil {
+		return domain.ErrNotFound
+	}
+	r.put(item)
+	return nil
+}
+
+func (r *memoryRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.items[id]; !ok {
+		return domain.ErrNotFound
+	}
+	delete(r.items, id)
+	return nil
+}
+
+func (r *memoryRepository) AdjustStock(ctx context.Context, itemID string, quantity int32, reason string, performedBy string) error {
+	item := r.get(itemID)
+	if item == nil {
+		return domain.ErrNotFound
+	}
+	item.Quantity += quantity
+	r.put(item)
+	return nil
+}
+
+// Reserve checks and reserves under the lock, as the MongoDB repository does
+// in one conditional update
+func (r *memoryRepository) Reserve(ctx context.Context, itemID string, quantity int32) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	item, ok := r.items[itemID]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	if !item.Reserve(quantity) {
+		return domain.ErrInsufficientStock
+	}
+	return nil
+}
+
+// TransferStock moves the stock like the MongoDB repository does, checking
+// the source before the destination is touched
+func (r *memoryRepository) TransferStock(ctx context.Context, transfer *domain.StockTransfer) error {
+	source, err := r.GetBySKUAndLocation(ctx, transfer.SKU, transfer.FromLocationID)
+	if err != nil {
+		return err
+	}
+	if !source.IsAvailable(transfer.Quantity) {
+		return domain.ErrInsufficientStock
+	}
+	destination, err := r.GetBySKUAndLocation(ctx, transfer.SKU, transfer.ToLocationID)
+	created := errors.Is(err, domain.ErrNotFound)
+	if created {
+		destination = domain.NewInventoryItem(source.ProductID, 0, source.SKU, transfer.ToLocationID)
+	}
+	sourceBefore, destinationBefore := source.Quantity, destination.Quantity
+	if err := source.TransferStock(transfer.Quantity, destination); err != nil {
+		return err
+	}
+	r.put(source)
+	r.put(destination)
+
+	for _, side := range []struct {
+		item   *domain.InventoryItem
+		before int32
+	}{{source, sourceBefore}, {destination, destinationBefore}} {
+		r.RecordHistory(ctx, &domain.InventoryHistory{
+			InventoryID:    side.item.ID,
+			ChangeType:     domain.HistoryChangeTransfer,
+			QuantityBefore: side.before,
+			QuantityAfter:  side.item.Quantity,
+			ReferenceID:    transfer.ID,
+			ReferenceType:  "TRANSFER",
+			PerformedBy:    transfer.PerformedBy,
+		})
+	}
+	transfer.Source = source
+	transfer.Destination = destination
+	transfer.DestinationCreated = created
+	return nil
+}
+
+func (r *memoryRepository) MergeItems(ctx context.Context, kept *domain.InventoryItem, mergedIDs []string) error {
+	r.put(kept)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, id := range mergedIDs {
+		delete(r.items, id)
+	}
+	return nil
+}
+
+func (r *memoryRepository) RecordHistory(ctx context.Context, history *domain.InventoryHistory) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.history = append(r.history, history)
+	return nil
+}
+
+// ListActiveOrderReservations returns the items holding an active order
+// reservation last touched before updatedBefore
+func (r *memoryRepository) ListActiveOrderReservations(ctx context.Context, updatedBefore time.Time) ([]*domain.InventoryItem, error) {
+	return r.filter(func(item *domain.InventoryItem) bool {
+		for _, reservation := range item.ActiveReservations() {
+			if reservation.UpdatedAt.Before(updatedBefore) {
+				return true
+			}
+		}
+		return false
+	}), nil
+}
+
+// ListExpiredOrderReservations returns the items holding an active order
+// reservation that expired at or before now
+func (r *memoryRepository) ListExpiredOrderReservations(ctx context.Context, now time.Time) ([]*domain.InventoryItem, error) {
+	return r.filter(func(item *domain.InventoryItem) bool {
+		for _, reservation := range item.ActiveReservations() {
+			if !reservation.ExpiresAt.IsZero() && !reservation.ExpiresAt.After(now) {
+				return true
+			}
+		}
+		return false
+	}), nil
+}
+
+// filter returns copies of the matching items ordered by ID
+func (r *memoryRepository) filter(match func(*domain.InventoryItem) bool) []*domain.InventoryItem {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var items []*domain.InventoryItem
+	for _, item := range r.items {
+		if match(item) {
+			items = append(items, cloneItem(item))
+		}
+	}
+	sort.Slice(items, func(a, b int) bool { return items[a].ID < items[b].ID })
+	return items
+}
+
+// ListFiltered supports the location, tag, count date and last update parts
+// of a filter
+func (r *memoryRepository) ListFiltered(ctx context.Context, filter domain.InventoryFilter, limit, offset int) ([]*domain.InventoryItem, int64, error) {
+	items := r.filter(func(item *domain.InventoryItem) bool {
+		if filter.LocationID != "" && item.LocationID != filter.LocationID {
+			return false
+		}
+		if !filter.CountDueBy.IsZero() && (item.NextCountDate.IsZero() || item.NextCountDate.After(filter.CountDueBy)) {
+			return false
+		}
+		if !filter.UpdatedSince.IsZero() && !item.LastUpdated.After(filter.UpdatedSince) {
+			return false
+		}
+		for _, want := range filter.Tags {
+			found := false
+			for _, tag := range item.Tags {
+				found = found || tag == want
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	})
+	if !filter.CountDueBy.IsZero() {
+		sort.SliceStable(items, func(i, j int) bool {
+			return items[i].NextCountDate.Before(items[j].NextCountDate)
+		})
+	}
+	if !filter.UpdatedSince.IsZero() {
+		sort.SliceStable(items, func(i, j int) bool {
+			return items[i].LastUpdated.Before(items[j].LastUpdated)
+		})
+	}
+	total := int64(len(items))
+	if offset >= len(items) {
+		return nil, total, nil
+	}
+	items = items[offset:]
+	if limit > 0 && limit < len(items) {
+		items = items[:limit]
+	}
+	return items, total, nil
+}
+
+// ListDuplicates groups the items at a location by SKU, oldest first
+func (r *memoryRepository) ListDuplicates(ctx context.Context, locationID string) ([][]*domain.InventoryItem, error) {
+	items := r.filter(func(item *domain.InventoryItem) bool {
+		return item.LocationID == locationID && item.SKU != ""
+	})
+	sort.SliceStable(items, func(a, b int) bool { return items[a].CreatedAt.Before(items[b].CreatedAt) })
+
+	bySKU := make(map[string][]*domain.InventoryItem)
+	var skus []string
+	for _, item := range items {
+		if _, seen := bySKU[item.SKU]; !seen {
+			skus = append(skus, item.SKU)
+		}
+		bySKU[item.SKU] = append(bySKU[item.SKU], item)
+	}
+	sort.Strings(skus)
+
+	var groups [][]*domain.InventoryItem
+	for _, sku := range skus {
+		if len(bySKU[sku]) > 1 {
+			groups = append(groups, bySKU[sku])
+		}
+	}
+	return groups, nil
+}
@@ -0,0 +1,96 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// fakeProductCatalog knows the SKU of each product by ID
+type fakeProductCatalog struct {
+	skus  map[string]string
+	err   error
+	calls int
+}
+
+func (c *fakeProductCatalog) ProductSKU(ctx context.Context, productID string) (string, error) {
+	c.calls++
+	if c.err != nil {
+		return "", c.err
+	}
+	sku, ok := c.skus[productID]
+	if !ok {
+		return "", domain.ErrProductNotFound
+	}
+	return sku, nil
+}
+
+func newProductCheckingService(repo domain.InventoryRepository, catalog domain.ProductCatalog) *InventoryService {
+	return NewInventoryService(repo, nil, catalog, domain.ReservationTTL{}, false, zap.NewNop())
+}
+
+func TestCreateInventoryItemChecksProduct(t *testing.T) {
+	catalog := &fakeProductCatalog{skus: map[string]string{"product-1": "LAMP-01"}}
+
+	tests := []struct {
+		name      string
+		productID string
+		sku       string
+		wantErr   error
+	}{
+		{name: "valid product", productID: "product-1", sku: "LAMP-01"},
+		{name: "SKU case differs", productID: "product-1", sku: "lamp-01"},
+		{name: "nonexistent product", productID: "product-404", sku: "LAMP-01", wantErr: domain.ErrInvalidInput},
+		{name: "SKU of another product", productID: "product-1", sku: "DESK-01", wantErr: domain.ErrInvalidInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMemoryRepository()
+			service := newProductCheckingService(repo, catalog)
+
+			item, err := service.CreateInventoryItem(context.Background(), tt.productID, 5, tt.sku, "warehouse-1", nil, true)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, repo.filter(func(*domain.InventoryItem) bool { return true }), "no item is stored")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.productID, item.ProductID)
+			assert.NotNil(t, repo.get(item.ID))
+		})
+	}
+}
+
+func TestCreateInventoryItemWithoutProductCheck(t *testing.T) {
+	catalog := &fakeProductCatalog{}
+	service := newProductCheckingService(newMemoryRepository(), catalog)
+
+	_, err := service.CreateInventoryItem(context.Background(), "product-404", 5, "LAMP-01", "warehouse-1", nil, false)
+
+	require.NoError(t, err, "bulk imports skip the check")
+	assert.Zero(t, catalog.calls)
+}
+
+func TestCreateInventoryItemWithoutProductCatalog(t *testing.T) {
+	service := newProductCheckingService(newMemoryRepository(), nil)
+
+	_, err := service.CreateInventoryItem(context.Background(), "product-404", 5, "LAMP-01", "warehouse-1", nil, true)
+
+	require.NoError(t, err)
+}
+
+func TestCreateInventoryItemProductServiceDown(t *testing.T) {
+	catalog := &fakeProductCatalog{err: domain.ErrProductCatalogUnavailable}
+	service := newProductCheckingService(newMemoryRepository(), catalog)
+
+	_, err := service.CreateInventoryItem(context.Background(), "product-1", 5, "LAMP-01", "warehouse-1", nil, true)
+
+	require.ErrorIs(t, err, domain.ErrProductCatalogUnavailable)
+	assert.NotErrorIs(t, err, domain.ErrInvalidInput, "an outage is not the caller's mistake")
+}
@@ -0,0 +1,99 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// ReceivePurchaseOrder books the stock of a purchase order delivery. Each line
+// reports the total received so far, and only the part not booked by an earlier
+// receipt of the same purchase order and line is added, so a double submit is
+// a no-op and a later partial receipt adds just the difference.
+//
+// The receipt is saved before any stock moves, which makes a concurrent second
+// submit fail with ErrConcurrentUpdate instead of booking the stock twice.
+func (s *InventoryService) ReceivePurchaseOrder(ctx context.Context, poID string, lines []domain.ReceiveLine, receivedBy string) (*domain.PurchaseOrderReceipt, error) {
+	logger := s.logger.With(zap.String("po_id", poID))
+	logger.Info("Receiving purchase order", zap.Int("lines", len(lines)))
+
+	if poID == "" {
+		return nil, fmt.Errorf("%w: purchase order ID is required", domain.ErrInvalidInput)
+	}
+	if receivedBy == "" {
+		receivedBy = "system"
+	}
+
+	receipt, err := s.receipts.GetByPurchaseOrder(ctx, poID)
+	if errors.Is(err, domain.ErrNotFound) {
+		receipt = domain.NewPurchaseOrderReceipt(poID)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get purchase order receipt: %w", err)
+	}
+
+	stockIns, err := receipt.Receive(lines, receivedBy)
+	if err != nil {
+		return nil, err
+	}
+	if len(stockIns) == 0 {
+		logger.Info("Purchase order already received, nothing to book")
+		return receipt, nil
+	}
+
+	for _, in := range stockIns {
+		if _, err := s.repo.GetByID(ctx, in.InventoryItemID); err != nil {
+			return nil, fmt.Errorf("failed to get inventory item %s for line %s: %w", in.InventoryItemID, in.LineID, err)
+		}
+	}
+
+	if err := s.receipts.Save(ctx, receipt); err != nil {
+		return nil, fmt.Errorf("failed to save purchase order receipt: %w", err)
+	}
+
+	for _, in := range stockIns {
+		if err := s.bookStockIn(ctx, poID, in, receivedBy); err != nil {
+			// The receipt already counts this line as booked; retrying would
+			// skip it, so it has to be corrected by hand
+			logger.Error("Purchase order line recorded as received but stock was not added",
+				zap.String("line_id", in.LineID),
+				zap.String("inventory_id", in.InventoryItemID),
+				zap.Int32("quantity", in.Quantity),
+				zap.Error(err),
+			)
+			return receipt, fmt.Errorf("failed to add stock for line %s: %w", in.LineID, err)
+		}
+	}
+
+	logger.Info("Purchase order received",
+		zap.String("status", string(receipt.Status)),
+		zap.Int("lines_booked", len(stockIns)),
+	)
+	return receipt, nil
+}
+
+// bookStockIn adds the stock of one received purchase order line to its item
+func (s *InventoryService) bookStockIn(ctx context.Context, poID string, in domain.StockIn, receivedBy string) error {
+	item, err := s.repo.GetByID(ctx, in.InventoryItemID)
+	if err != nil {
+		return fmt.Errorf("failed to get inventory item: %w", err)
+	}
+
+	before := item.Quantity
+	item.AddStock(in.Quantity)
+	if err := s.repo.Update(ctx, item); err != nil {
+		return fmt.Errorf("failed to update inventory item: %w", err)
+	}
+
+	description := fmt.Sprintf("Received %d units on purchase order %s, line %s", in.Quantity, poID, in.LineID)
+	if err := s.recordInventoryHistory(ctx, item.ID, "PO_RECEIVED", description, before, item.Quantity, poID, "PURCHASE_ORDER", receivedBy); err != nil {
+		s.logger.Error("Failed to record inventory history after purchase order receipt",
+			zap.String("inventory_id", item.ID),
+			zap.Error(err),
+		)
+	}
+	return nil
+}
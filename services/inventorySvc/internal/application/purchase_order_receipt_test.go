@@ -0,0 +1,125 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// memoryReceiptRepository keeps purchase order receipts in memory with the
+// same version check as the MongoDB repository
+type memoryReceiptRepository struct {
+	mu       sync.Mutex
+	receipts map[string]domain.PurchaseOrderReceipt
+	saves    int
+}
+
+func newMemoryReceiptRepository() *memoryReceiptRepository {
+	return &memoryReceiptRepository{receipts: make(map[string]domain.PurchaseOrderReceipt)}
+}
+
+func (r *memoryReceiptRepository) GetByPurchaseOrder(ctx context.Context, poID string) (*domain.PurchaseOrderReceipt, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	receipt, ok := r.receipts[poID]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	receipt.Lines = append([]domain.PurchaseOrderLine(nil), receipt.Lines...)
+	return &receipt, nil
+}
+
+func (r *memoryReceiptRepository) Save(ctx context.Context, receipt *domain.PurchaseOrderReceipt) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if stored, ok := r.receipts[receipt.ID]; ok && stored.Version != receipt.Version || !ok && receipt.Version != 0 {
+		return domain.ErrConcurrentUpdate
+	}
+	receipt.Version++
+	stored := *receipt
+	stored.Lines = append([]domain.PurchaseOrderLine(nil), receipt.Lines...)
+	r.receipts[receipt.ID] = stored
+	r.saves++
+	return nil
+}
+
+func newReceivingTestService(repo *memoryRepository, receipts *memoryReceiptRepository) *InventoryService {
+	return NewInventoryService(repo, receipts, nil, domain.ReservationTTL{}, false, zap.NewNop())
+}
+
+func TestReceivePurchaseOrderTwiceAddsStockOnce(t *testing.T) {
+	item := domain.NewInventoryItem("product-1", 5, "SKU-1", "warehouse-1")
+	repo := newMemoryRepository(item)
+	receipts := newMemoryReceiptRepository()
+	service := newReceivingTestService(repo, receipts)
+
+	lines := []domain.ReceiveLine{{LineID: "line-1", InventoryItemID: item.ID, OrderedQuantity: 10, ReceivedQuantity: 10}}
+
+	first, err := service.ReceivePurchaseOrder(context.Background(), "po-1", lines, "clerk-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.PurchaseOrderReceived, first.Status)
+	assert.Equal(t, int32(15), repo.get(item.ID).Quantity)
+
+	second, err := service.ReceivePurchaseOrder(context.Background(), "po-1", lines, "clerk-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.PurchaseOrderReceived, second.Status)
+	assert.Equal(t, int32(15), repo.get(item.ID).Quantity, "a repeated receipt must not add stock again")
+	assert.Equal(t, 1, receipts.saves, "nothing new to book, so the receipt is not saved again")
+
+	require.Len(t, repo.history, 1)
+	assert.Equal(t, "PO_RECEIVED", repo.history[0].ChangeType)
+	assert.Equal(t, "po-1", repo.history[0].ReferenceID)
+}
+
+func TestReceivePurchaseOrderPartialThenFull(t *testing.T) {
+	item := domain.NewInventoryItem("product-1", 0, "SKU-1", "warehouse-1")
+	repo := newMemoryRepository(item)
+	service := newReceivingTestService(repo, newMemoryReceiptRepository())
+
+	partial, err := service.ReceivePurchaseOrder(context.Background(), "po-1",
+		[]domain.ReceiveLine{{LineID: "line-1", InventoryItemID: item.ID, OrderedQuantity: 10, ReceivedQuantity: 4}}, "clerk-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.PurchaseOrderPartiallyReceived, partial.Status)
+	assert.Equal(t, int32(4), repo.get(item.ID).Quantity)
+
+	full, err := service.ReceivePurchaseOrder(context.Background(), "po-1",
+		[]domain.ReceiveLine{{LineID: "line-1", InventoryItemID: item.ID, OrderedQuantity: 10, ReceivedQuantity: 10}}, "clerk-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.PurchaseOrderReceived, full.Status)
+	assert.Equal(t, int32(10), repo.get(item.ID).Quantity, "only the 6 units not received before are added")
+
+	_, err = service.ReceivePurchaseOrder(context.Background(), "po-1",
+		[]domain.ReceiveLine{{LineID: "line-1", InventoryItemID: item.ID, OrderedQuantity: 10, ReceivedQuantity: 8}}, "clerk-1")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput, "the received total cannot go down")
+	assert.Equal(t, int32(10), repo.get(item.ID).Quantity)
+}
+
+func TestReceivePurchaseOrderConcurrentSubmitIsRejected(t *testing.T) {
+	item := domain.NewInventoryItem("product-1", 0, "SKU-1", "warehouse-1")
+	repo := newMemoryRepository(item)
+	receipts := newMemoryReceiptRepository()
+	service := newReceivingTestService(repo, receipts)
+	lines := []domain.ReceiveLine{{LineID: "line-1", InventoryItemID: item.ID, OrderedQuantity: 10, ReceivedQuantity: 10}}
+
+	// Another submit saved the receipt after this one read it as new
+	stale := domain.NewPurchaseOrderReceipt("po-1")
+	_, err := stale.Receive(lines, "clerk-2")
+	require.NoError(t, err)
+	require.NoError(t, receipts.Save(context.Background(), stale))
+
+	lost := domain.NewPurchaseOrderReceipt("po-1")
+	_, err = lost.Receive(lines, "clerk-1")
+	require.NoError(t, err)
+	assert.ErrorIs(t, receipts.Save(context.Background(), lost), domain.ErrConcurrentUpdate)
+
+	// Going through the service, the saved receipt is found and nothing is booked
+	_, err = service.ReceivePurchaseOrder(context.Background(), "po-1", lines, "clerk-1")
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), repo.get(item.ID).Quantity)
+}
@@ -0,0 +1,94 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// ReserveWithAllocation reserves an order that no single location may be able
+// to fill, spreading each line over the locations holding its product as
+// domain.PlanAllocation decides. The order is reserved in full or not at all:
+// an order the locations cannot cover returns a *domain.AllocationError
+// without reserving anything, and a reservation failing part-way releases
+// those already made. The plan returned says what was reserved where. The
+// reservations expire after ttl, or the configured default when ttl is zero.
+func (s *InventoryService) ReserveWithAllocation(
+	ctx context.Context,
+	orderID string,
+	lines []domain.AllocationLine,
+	strategy domain.AllocationStrategy,
+	preferredLocationID string,
+	ttl time.Duration,
+) (*domain.AllocationPlan, error) {
+	s.logger.Info("Reserving order across locations",
+		zap.String("order_id", orderID),
+		zap.String("strategy", string(strategy)),
+		zap.Int("line_count", len(lines)),
+		zap.Duration("ttl", ttl),
+	)
+
+	if orderID == "" {
+		return nil, fmt.Errorf("%w: order ID is required", domain.ErrInvalidInput)
+	}
+	expiresAt, err := s.reservationTTL.ExpiresAt(ttl, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if strategy == "" {
+		strategy = domain.AllocationMinimizeShipments
+	}
+
+	var items []*domain.InventoryItem
+	loaded := make(map[string]bool, len(lines))
+	for _, line := range lines {
+		if line.ProductID == "" || loaded[line.ProductID] {
+			continue
+		}
+		loaded[line.ProductID] = true
+		stock, err := s.repo.GetByProductID(ctx, line.ProductID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get inventory for product %s: %w", line.ProductID, err)
+		}
+		items = append(items, stock...)
+	}
+
+	plan, err := domain.PlanAllocation(lines, items, strategy, preferredLocationID)
+	if err != nil {
+		return nil, err
+	}
+	plan.OrderID = orderID
+	plan.ExpiresAt = expiresAt
+
+	byID := make(map[string]*domain.InventoryItem, len(items))
+	for _, item := range items {
+		byID[item.ID] = item
+	}
+	reservations := make([]pendingReservation, 0, len(plan.Allocations))
+	for _, a := range plan.Allocations {
+		reservations = append(reservations, pendingReservation{item: byID[a.InventoryItemID], quantity: a.Quantity})
+	}
+	sortReservations(reservations)
+
+	for i, r := range reservations {
+		err := fmt.Errorf("%w: for item %s", domain.ErrInsufficientStock, r.item.ID)
+		if r.item.ReserveForOrder(r.quantity, orderID, expiresAt) {
+			err = s.repo.Update(ctx, r.item)
+		}
+		if err != nil {
+			s.cancelOrderReservations(ctx, orderID, reservations[:i])
+			return nil, &domain.ReservationError{InventoryItemID: r.item.ID, Err: err}
+		}
+	}
+
+	s.logger.Info("Order reserved across locations",
+		zap.String("order_id", orderID),
+		zap.Int("shipments", plan.Shipments),
+		zap.Int("allocations", len(plan.Allocations)),
+	)
+	return plan, nil
+}
@@ -0,0 +1,146 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// failingUpdateRepository fails every update of one item
+type failingUpdateRepository struct {
+	*memoryRepository
+	failItemID string
+}
+
+func (r *failingUpdateRepository) Update(ctx context.Context, item *domain.InventoryItem) error {
+	if item.ID == r.failItemID {
+		return errors.New("write conflict")
+	}
+	return r.memoryRepository.Update(ctx, item)
+}
+
+// reservedFor returns how many units of item are reserved for orderID
+func reservedFor(repo *memoryRepository, item *domain.InventoryItem, orderID string) int32 {
+	reservation := repo.get(item.ID).ReservationFor(orderID)
+	if reservation == nil || reservation.Status != domain.ReservationStatusActive {
+		return 0
+	}
+	return reservation.Quantity
+}
+
+func TestReserveWithAllocationFromOneLocation(t *testing.T) {
+	ctx := context.Background()
+	store1Shirts := domain.NewInventoryItem("shirt", 5, "SHIRT-1", "store-1")
+	store1Caps := domain.NewInventoryItem("cap", 5, "CAP-1", "store-1")
+	store2Shirts := domain.NewInventoryItem("shirt", 10, "SHIRT-2", "store-2")
+	store2Caps := domain.NewInventoryItem("cap", 1, "CAP-2", "store-2")
+	repo := newMemoryRepository(store1Shirts, store1Caps, store2Shirts, store2Caps)
+	service := newTestInventoryService(repo)
+
+	plan, err := service.ReserveWithAllocation(ctx, "order-1", []domain.AllocationLine{
+		{ProductID: "shirt", Quantity: 3},
+		{ProductID: "cap", Quantity: 2},
+	}, domain.AllocationMinimizeShipments, "", 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, "order-1", plan.OrderID)
+	assert.Equal(t, 1, plan.Shipments, "store-1 can ship the whole order")
+	require.Len(t, plan.Allocations, 2)
+	for _, a := range plan.Allocations {
+		assert.Equal(t, "store-1", a.LocationID)
+	}
+	assert.Equal(t, int32(3), reservedFor(repo, store1Shirts, "order-1"))
+	assert.Equal(t, int32(2), reservedFor(repo, store1Caps, "order-1"))
+	assert.Equal(t, int32(0), repo.get(store2Shirts.ID).Reserved)
+	assert.Equal(t, int32(0), repo.get(store2Caps.ID).Reserved)
+}
+
+func TestReserveWithAllocationSplitsAcrossLocations(t *testing.T) {
+	ctx := context.Background()
+	store1 := domain.NewInventoryItem("shirt", 4, "SHIRT-1", "store-1")
+	store2 := domain.NewInventoryItem("shirt", 6, "SHIRT-2", "store-2")
+	repo := newMemoryRepository(store1, store2)
+	service := newTestInventoryService(repo)
+
+	plan, err := service.ReserveWithAllocation(ctx, "order-1", []domain.AllocationLine{
+		{ProductID: "shirt", Quantity: 8},
+	}, domain.AllocationMinimizeShipments, "", 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, plan.Shipments)
+	var planned int32
+	for _, a := range plan.Allocations {
+		planned += a.Quantity
+	}
+	assert.Equal(t, int32(8), planned)
+	assert.Equal(t, int32(6), reservedFor(repo, store2, "order-1"), "the larger location is drained first")
+	assert.Equal(t, int32(2), reservedFor(repo, store1, "order-1"))
+}
+
+func TestReserveWithAllocationPrefersLocation(t *testing.T) {
+	ctx := context.Background()
+	store1 := domain.NewInventoryItem("shirt", 4, "SHIRT-1", "store-1")
+	store2 := domain.NewInventoryItem("shirt", 10, "SHIRT-2", "store-2")
+	repo := newMemoryRepository(store1, store2)
+	service := newTestInventoryService(repo)
+
+	plan, err := service.ReserveWithAllocation(ctx, "order-1", []domain.AllocationLine{
+		{ProductID: "shirt", Quantity: 6},
+	}, domain.AllocationPreferLocation, "store-1", 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, plan.Shipments)
+	assert.Equal(t, int32(4), reservedFor(repo, store1, "order-1"))
+	assert.Equal(t, int32(2), reservedFor(repo, store2, "order-1"))
+}
+
+func TestReserveWithAllocationInfeasibleReservesNothing(t *testing.T) {
+	ctx := context.Background()
+	store1 := domain.NewInventoryItem("shirt", 4, "SHIRT-1", "store-1")
+	store2 := domain.NewInventoryItem("shirt", 2, "SHIRT-2", "store-2")
+	caps := domain.NewInventoryItem("cap", 5, "CAP-1", "store-1")
+	repo := newMemoryRepository(store1, store2, caps)
+	service := newTestInventoryService(repo)
+
+	_, err := service.ReserveWithAllocation(ctx, "order-1", []domain.AllocationLine{
+		{ProductID: "shirt", Quantity: 8},
+		{ProductID: "cap", Quantity: 1},
+	}, domain.AllocationMinimizeShipments, "", 0)
+
+	var allocationErr *domain.AllocationError
+	require.ErrorAs(t, err, &allocationErr)
+	assert.ErrorIs(t, err, domain.ErrInsufficientStock)
+	require.Len(t, allocationErr.Shortfalls, 1)
+	assert.Equal(t, domain.AllocationShortfall{ProductID: "shirt", Requested: 8, Available: 6}, allocationErr.Shortfalls[0])
+	for _, item := range []*domain.InventoryItem{store1, store2, caps} {
+		assert.Equal(t, int32(0), repo.get(item.ID).Reserved, "item %s", item.SKU)
+	}
+}
+
+func TestReserveWithAllocationRollsBackOnFailure(t *testing.T) {
+	ctx := context.Background()
+	store1 := domain.NewInventoryItem("shirt", 4, "SHIRT-1", "store-1")
+	store2 := domain.NewInventoryItem("shirt", 6, "SHIRT-2", "store-2")
+	memory := newMemoryRepository(store1, store2)
+	repo := &failingUpdateRepository{memoryRepository: memory}
+	service := newTestInventoryService(repo)
+
+	// Whichever item is reserved second fails, so the first must be released
+	for _, failing := range []*domain.InventoryItem{store1, store2} {
+		repo.failItemID = failing.ID
+		_, err := service.ReserveWithAllocation(ctx, "order-1", []domain.AllocationLine{
+			{ProductID: "shirt", Quantity: 8},
+		}, domain.AllocationMinimizeShipments, "", 0)
+
+		var reservationErr *domain.ReservationError
+		require.ErrorAs(t, err, &reservationErr)
+		assert.Equal(t, failing.ID, reservationErr.InventoryItemID)
+		assert.Equal(t, int32(0), memory.get(store1.ID).Reserved)
+		assert.Equal(t, int32(0), memory.get(store2.ID).Reserved)
+	}
+}
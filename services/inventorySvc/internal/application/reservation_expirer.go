@@ -0,0 +1,154 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// ReservationExpirer gives the units of lapsed order reservations back to
+// available stock. Reservations expire when the TTL they were made with, or
+// the configured default, runs out.
+type ReservationExpirer struct {
+	inventory *InventoryService
+	interval  time.Duration
+	logger    *zap.Logger
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	mu        sync.Mutex // One pass at a time
+}
+
+// NewReservationExpirer creates an expirer that Start runs every interval
+func NewReservationExpirer(inventory *InventoryService, interval time.Duration, logger *zap.Logger) *ReservationExpirer {
+	return &ReservationExpirer{
+		inventory: inventory,
+		interval:  interval,
+		logger:    logger.Named("reservation_expirer"),
+	}
+}
+
+// Start runs a pass on every interval until Stop. It does nothing when no
+// interval is configured, in which case reservations are held until released.
+func (e *ReservationExpirer) Start() {
+	if e.interval <= 0 {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	e.cancel = cancel
+
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		ticker := time.NewTicker(e.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if _, err := e.Expire(ctx); err != nil && ctx.Err() == nil {
+				e.logger.Error("Reservation expiry failed", zap.Error(err))
+			}
+		}
+	}()
+}
+
+// Stop stops the periodic run and waits for a running pass to finish
+func (e *ReservationExpirer) Stop() {
+	if e.cancel != nil {
+		e.cancel()
+	}
+	e.wg.Wait()
+}
+
+// Expire releases every active order reservation whose expiry has passed and
+// returns the reservations released. Each item is read again before it is
+// released, so one fulfilled or re-reserved since it was listed is left alone.
+func (e *ReservationExpirer) Expire(ctx context.Context) ([]*domain.OrderReservation, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := time.Now()
+	items, err := e.inventory.repo.ListExpiredOrderReservations(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var expired []*domain.OrderReservation
+	failed := 0
+	for _, listed := range items {
+		if err := ctx.Err(); err != nil {
+			return expired, err
+		}
+
+		for _, r := range listed.ActiveReservations() {
+			if r.ExpiresAt.IsZero() || r.ExpiresAt.After(now) {
+				continue
+			}
+			reservation, err := e.inventory.expireOrderReservation(ctx, listed.ID, r.OrderID, now)
+			if err != nil {
+				failed++
+				e.logger.Error("Failed to expire reservation",
+					zap.String("inventory_item_id", listed.ID),
+					zap.String("order_id", r.OrderID),
+					zap.Error(err),
+				)
+				continue
+			}
+			if reservation != nil {
+				expired = append(expired, reservation)
+			}
+		}
+	}
+
+	if len(items) > 0 {
+		e.logger.Info("Reservation expiry finished",
+			zap.Int("reservations_checked", len(items)),
+			zap.Int("reservations_expired", len(expired)),
+			zap.Int("failed", failed),
+		)
+	}
+	return expired, nil
+}
+
+// expireOrderReservation releases the reservation an item holds for orderID if
+// it is still active and expired at now, recording the release in its
+// history. It returns nil when there was nothing to expire.
+func (s *InventoryService) expireOrderReservation(ctx context.Context, itemID, orderID string, now time.Time) (*domain.OrderReservation, error) {
+	item, err := s.repo.GetByID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, nil
+	}
+	r := item.ReservationFor(orderID)
+	if r == nil || !r.Active() || r.ExpiresAt.IsZero() || r.ExpiresAt.After(now) {
+		return nil, nil
+	}
+
+	reservation := item.OrderReservation(orderID)
+	reservation.Quantity = item.ExpireOrderReservation(orderID)
+	if err := s.repo.Update(ctx, item); err != nil {
+		return nil, fmt.Errorf("failed to release expired reservation on item %s: %w", item.ID, err)
+	}
+
+	description := fmt.Sprintf("Released %d units reserved for order %s after the reservation expired", reservation.Quantity, orderID)
+	if err := s.recordInventoryHistory(ctx, item.ID, "RESERVATION_EXPIRED", description, item.Quantity, item.Quantity, orderID, "ORDER", "system"); err != nil {
+		s.logger.Error("Failed to record inventory history after expiring reservation",
+			zap.String("inventory_id", item.ID),
+			zap.Error(err),
+		)
+	}
+
+	reservation.Status = domain.ReservationStatusExpired
+	reservation.UpdatedAt = item.LastUpdated
+	return reservation, nil
+}
@@ -0,0 +1,74 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+func reserveWithTTL(t *testing.T, service *InventoryService, orderID string, quantity int32, ttl time.Duration) {
+	t.Helper()
+	require.NoError(t, service.ReserveForPOSTransaction(context.Background(), orderID, "store-1",
+		[]domain.ReservationItem{{ProductID: "product-1", Quantity: quantity}}, ttl))
+}
+
+func TestExpireReleasesShortTTLBeforeLongTTL(t *testing.T) {
+	ctx := context.Background()
+	item := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	repo := newMemoryRepository(item)
+	service := NewInventoryService(repo, nil, nil, domain.ReservationTTL{Max: 30 * 24 * time.Hour}, false, zap.NewNop())
+	expirer := NewReservationExpirer(service, 0, zap.NewNop())
+
+	reserveWithTTL(t, service, "checkout", 2, time.Millisecond)
+	reserveWithTTL(t, service, "layaway", 3, 7*24*time.Hour)
+	reserveWithTTL(t, service, "no-expiry", 1, 0)
+	time.Sleep(10 * time.Millisecond)
+
+	expired, err := expirer.Expire(ctx)
+	require.NoError(t, err)
+
+	require.Len(t, expired, 1, "only the checkout hold has run out")
+	assert.Equal(t, "checkout", expired[0].OrderID)
+	assert.Equal(t, int32(2), expired[0].Quantity)
+	assert.Equal(t, domain.ReservationStatusExpired, expired[0].Status)
+
+	stored := repo.get(item.ID)
+	assert.Equal(t, int32(4), stored.Reserved, "the layaway and unexpiring holds keep their units")
+	assert.Equal(t, domain.ReservationStatusExpired, stored.ReservationFor("checkout").Status)
+	assert.True(t, stored.ReservationFor("layaway").Active())
+	assert.True(t, stored.ReservationFor("no-expiry").Active())
+
+	again, err := expirer.Expire(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, again, "an expired reservation is released once")
+}
+
+func TestReservationsWithoutTTLTakeTheDefault(t *testing.T) {
+	item := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	repo := newMemoryRepository(item)
+	service := NewInventoryService(repo, nil, nil, domain.ReservationTTL{Default: 15 * time.Minute}, false, zap.NewNop())
+
+	before := time.Now()
+	reserveWithTTL(t, service, "checkout", 1, 0)
+
+	expiresAt := repo.get(item.ID).ReservationFor("checkout").ExpiresAt
+	assert.WithinDuration(t, before.Add(15*time.Minute), expiresAt, time.Second)
+}
+
+func TestReserveRejectsTTLOverTheMaximum(t *testing.T) {
+	item := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	repo := newMemoryRepository(item)
+	service := NewInventoryService(repo, nil, nil, domain.ReservationTTL{Max: 7 * 24 * time.Hour}, false, zap.NewNop())
+
+	err := service.ReserveForPOSTransaction(context.Background(), "layaway", "store-1",
+		[]domain.ReservationItem{{ProductID: "product-1", Quantity: 1}}, 8*24*time.Hour)
+
+	require.ErrorIs(t, err, domain.ErrInvalidInput)
+	assert.Zero(t, repo.get(item.ID).Reserved, "nothing is reserved")
+}
@@ -0,0 +1,132 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// pendingReservation is an inventory item and the quantity to reserve on it
+type pendingReservation struct {
+	item     *domain.InventoryItem
+	quantity int32
+}
+
+// sortReservations puts reservations in SKU order, then inventory item ID for
+// items without a SKU. Reserving every multi-item request in the same order
+// keeps two requests for overlapping items from each holding part of what the
+// other needs.
+func sortReservations(reservations []pendingReservation) {
+	sort.SliceStable(reservations, func(a, b int) bool {
+		ia, ib := reservations[a].item, reservations[b].item
+		if ia.SKU != ib.SKU {
+			return ia.SKU < ib.SKU
+		}
+		return ia.ID < ib.ID
+	})
+}
+
+// ReserveStockAll reserves every request or none of them. Items are reserved
+// in SKU order; when one cannot be reserved, the reservations already made are
+// released and a *domain.ReservationError names the item that failed.
+// Requests for the same inventory item are combined.
+func (s *InventoryService) ReserveStockAll(ctx context.Context, requests []domain.StockRequest) error {
+	s.logger.Info("Reserving stock for multiple items", zap.Int("item_count", len(requests)))
+
+	if len(requests) == 0 {
+		return errors.New("at least one item must be specified")
+	}
+
+	byID := make(map[string]int, len(requests))
+	reservations := make([]pendingReservation, 0, len(requests))
+	for _, req := range requests {
+		if req.Quantity <= 0 {
+			return &domain.ReservationError{InventoryItemID: req.InventoryItemID, Err: errors.New("quantity must be positive")}
+		}
+		if i, ok := byID[req.InventoryItemID]; ok {
+			reservations[i].quantity += req.Quantity
+			continue
+		}
+		item, err := s.repo.GetByID(ctx, req.InventoryItemID)
+		if err == nil && item == nil {
+			err = errors.New("inventory item not found")
+		}
+		if err != nil {
+			return &domain.ReservationError{InventoryItemID: req.InventoryItemID, Err: err}
+		}
+		byID[req.InventoryItemID] = len(reservations)
+		reservations = append(reservations, pendingReservation{item: item, quantity: req.Quantity})
+	}
+	sortReservations(reservations)
+
+	for i, r := range reservations {
+		if err := s.ReserveStock(ctx, r.item.ID, r.quantity); err != nil {
+			for j := i - 1; j >= 0; j-- {
+				done := reservations[j]
+				if releaseErr := s.ReleaseReservation(ctx, done.item.ID, done.quantity, ""); releaseErr != nil {
+					s.logger.Error("Failed to release reservation during rollback",
+						zap.String("inventory_item_id", done.item.ID),
+						zap.Int32("quantity", done.quantity),
+						zap.Error(releaseErr),
+					)
+				}
+			}
+			return &domain.ReservationError{InventoryItemID: r.item.ID, Err: err}
+		}
+	}
+
+	return nil
+}
+
+// ReleaseAllForOrder releases every active reservation held for an order, at
+// whatever locations they are, e.g. when the order is cancelled. Each released
+// item gets a history entry. It returns the reservations released, with the
+// quantity each held; on error, those released before it.
+func (s *InventoryService) ReleaseAllForOrder(ctx context.Context, orderID string) ([]*domain.OrderReservation, error) {
+	s.logger.Info("Releasing all reservations for order", zap.String("order_id", orderID))
+
+	if orderID == "" {
+		return nil, errors.New("order ID is required")
+	}
+
+	items, err := s.repo.GetByOrder(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reservations for order: %w", err)
+	}
+
+	var released []*domain.OrderReservation
+	for _, item := range items {
+		r := item.ReservationFor(orderID)
+		if r == nil || !r.Active() {
+			continue
+		}
+		reservation := item.OrderReservation(orderID)
+		item.CancelOrderReservation(r.Quantity, orderID)
+		if err := s.repo.Update(ctx, item); err != nil {
+			return released, fmt.Errorf("failed to release reservation on item %s: %w", item.ID, err)
+		}
+
+		description := fmt.Sprintf("Released %d reserved units for order %s", reservation.Quantity, orderID)
+		if err := s.recordInventoryHistory(ctx, item.ID, "RESERVATION_RELEASED", description, item.Quantity, item.Quantity, orderID, "ORDER", "system"); err != nil {
+			s.logger.Error("Failed to record inventory history after releasing reservation",
+				zap.String("inventory_id", item.ID),
+				zap.Error(err),
+			)
+		}
+
+		reservation.Status = domain.ReservationStatusCancelled
+		reservation.UpdatedAt = item.LastUpdated
+		released = append(released, reservation)
+	}
+
+	s.logger.Info("Released reservations for order",
+		zap.String("order_id", orderID),
+		zap.Int("count", len(released)),
+	)
+	return released, nil
+}
@@ -0,0 +1,272 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+func reserveForOrder(t *testing.T, service *InventoryService, orderID, locationID string, quantity int32) {
+	t.Helper()
+	require.NoError(t, service.ReserveForPOSTransaction(context.Background(), orderID, locationID,
+		[]domain.ReservationItem{{ProductID: "product-1", Quantity: quantity}}, 0))
+}
+
+func TestGetReservationsForOrderAcrossLocations(t *testing.T) {
+	ctx := context.Background()
+	store1 := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	store2 := domain.NewInventoryItem("product-1", 10, "SKU-1-B", "store-2")
+	repo := newMemoryRepository(store1, store2)
+	service := newTestInventoryService(repo)
+
+	reserveForOrder(t, service, "order-a", "store-1", 3)
+	reserveForOrder(t, service, "order-a", "store-2", 2)
+	reserveForOrder(t, service, "order-b", "store-1", 4)
+
+	reservations, err := service.GetReservationsForOrder(ctx, "order-a")
+	require.NoError(t, err)
+	require.Len(t, reservations, 2)
+
+	byLocation := map[string]*domain.OrderReservation{}
+	for _, r := range reservations {
+		assert.Equal(t, "order-a", r.OrderID)
+		assert.Equal(t, domain.ReservationStatusActive, r.Status)
+		byLocation[r.LocationID] = r
+	}
+	require.Contains(t, byLocation, "store-1")
+	require.Contains(t, byLocation, "store-2")
+	assert.Equal(t, int32(3), byLocation["store-1"].Quantity, "order-b's units on the same item are not order-a's")
+	assert.Equal(t, int32(2), byLocation["store-2"].Quantity)
+}
+
+func TestReleaseReservationOnlyReleasesTheOrdersUnits(t *testing.T) {
+	ctx := context.Background()
+	item := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	repo := newMemoryRepository(item)
+	service := newTestInventoryService(repo)
+
+	reserveForOrder(t, service, "order-a", "store-1", 3)
+	reserveForOrder(t, service, "order-b", "store-1", 4)
+
+	// Asking for more than the order holds releases only what it holds
+	require.NoError(t, service.ReleaseReservation(ctx, item.ID, 10, "order-a"))
+
+	stored := repo.get(item.ID)
+	assert.Equal(t, int32(4), stored.Reserved)
+	assert.Equal(t, domain.ReservationStatusCancelled, stored.ReservationFor("order-a").Status)
+	b := stored.ReservationFor("order-b")
+	assert.Equal(t, domain.ReservationStatusActive, b.Status)
+	assert.Equal(t, int32(4), b.Quantity)
+
+	// Without a matching order only unassigned units can be released
+	require.NoError(t, service.ReleaseReservation(ctx, item.ID, 4, "order-unknown"))
+	assert.Equal(t, int32(4), repo.get(item.ID).Reserved)
+	assert.ErrorIs(t, service.FulfillReservation(ctx, item.ID, 1, ""), domain.ErrInsufficientReservation)
+}
+
+func TestReleaseAllForOrderLeavesOtherOrders(t *testing.T) {
+	ctx := context.Background()
+	store1 := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	store2 := domain.NewInventoryItem("product-1", 10, "SKU-1-B", "store-2")
+	repo := newMemoryRepository(store1, store2)
+	service := newTestInventoryService(repo)
+
+	reserveForOrder(t, service, "order-a", "store-1", 3)
+	reserveForOrder(t, service, "order-a", "store-2", 2)
+	reserveForOrder(t, service, "order-b", "store-1", 4)
+
+	released, err := service.ReleaseAllForOrder(ctx, "order-a")
+	require.NoError(t, err)
+	require.Len(t, released, 2)
+
+	assert.Equal(t, int32(4), repo.get(store1.ID).Reserved)
+	assert.Equal(t, int32(0), repo.get(store2.ID).Reserved)
+	assert.Equal(t, int32(4), repo.get(store1.ID).ReservationFor("order-b").Quantity)
+}
+
+func TestReleaseAllForOrderAcrossLocationsInOneCall(t *testing.T) {
+	ctx := context.Background()
+	store1 := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	store2 := domain.NewInventoryItem("product-2", 10, "SKU-2", "store-2")
+	repo := newMemoryRepository(store1, store2)
+	service := newTestInventoryService(repo)
+
+	reserveForOrder(t, service, "order-a", "store-1", 3)
+	require.NoError(t, service.ReserveForPOSTransaction(ctx, "order-a", "store-2",
+		[]domain.ReservationItem{{ProductID: "product-2", Quantity: 5}}, 0))
+
+	released, err := service.ReleaseAllForOrder(ctx, "order-a")
+	require.NoError(t, err)
+	require.Len(t, released, 2)
+
+	byLocation := map[string]*domain.OrderReservation{}
+	for _, r := range released {
+		assert.Equal(t, domain.ReservationStatusCancelled, r.Status)
+		byLocation[r.LocationID] = r
+	}
+	assert.Equal(t, int32(3), byLocation["store-1"].Quantity)
+	assert.Equal(t, int32(5), byLocation["store-2"].Quantity)
+
+	for _, id := range []string{store1.ID, store2.ID} {
+		item := repo.get(id)
+		assert.Zero(t, item.Reserved)
+		assert.Equal(t, int32(10), item.Quantity, "releasing gives the units back without moving stock")
+	}
+
+	var history []string
+	for _, h := range repo.history {
+		if h.ChangeType == "RESERVATION_RELEASED" {
+			assert.Equal(t, "order-a", h.ReferenceID)
+			history = append(history, h.InventoryID)
+		}
+	}
+	assert.ElementsMatch(t, []string{store1.ID, store2.ID}, history)
+
+	// Nothing is left to release the second time
+	again, err := service.ReleaseAllForOrder(ctx, "order-a")
+	require.NoError(t, err)
+	assert.Empty(t, again)
+}
+
+func TestCompletePickupFulfillsOnlyTheOrder(t *testing.T) {
+	ctx := context.Background()
+	item := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	repo := newMemoryRepository(item)
+	service := newTestInventoryService(repo)
+
+	reserveForOrder(t, service, "order-a", "store-1", 3)
+	reserveForOrder(t, service, "order-b", "store-1", 4)
+
+	require.NoError(t, service.CompletePickup(ctx, "order-a", "store-1", "staff-1", ""))
+
+	stored := repo.get(item.ID)
+	assert.Equal(t, int32(7), stored.Quantity)
+	assert.Equal(t, int32(4), stored.Reserved)
+	assert.Equal(t, domain.ReservationStatusFulfilled, stored.ReservationFor("order-a").Status)
+	assert.Equal(t, domain.ReservationStatusActive, stored.ReservationFor("order-b").Status)
+}
+
+type pauseKey struct{}
+
+// pausingRepository lets a test hold a request after its first reservation: a
+// request whose context carries a channel from pauseAfterFirstReservation
+// signals reached once its first item is reserved and waits for resume before
+// going on
+type pausingRepository struct {
+	*memoryRepository
+}
+
+type pause struct {
+	reached chan struct{}
+	resume  chan struct{}
+	once    sync.Once
+}
+
+func pauseAfterFirstReservation(ctx context.Context) (context.Context, *pause) {
+	p := &pause{reached: make(chan struct{}), resume: make(chan struct{})}
+	return context.WithValue(ctx, pauseKey{}, p), p
+}
+
+func (r pausingRepository) Reserve(ctx context.Context, itemID string, quantity int32) error {
+	err := r.memoryRepository.Reserve(ctx, itemID, quantity)
+	if p, ok := ctx.Value(pauseKey{}).(*pause); ok {
+		p.once.Do(func() {
+			close(p.reached)
+			<-p.resume
+		})
+	}
+	return err
+}
+
+func TestReserveStockAllOverlappingRequestsInOppositeOrder(t *testing.T) {
+	a := domain.NewInventoryItem("product-a", 1, "SKU-A", "store-1")
+	b := domain.NewInventoryItem("product-b", 1, "SKU-B", "store-1")
+	repo := newMemoryRepository(a, b)
+	service := newTestInventoryService(pausingRepository{repo})
+
+	// The first request holds after its first reservation while the second,
+	// listing the same items the other way round, runs in full. Reserving in
+	// request order would leave each holding the item the other still needs.
+	ctx, p := pauseAfterFirstReservation(context.Background())
+	first := make(chan error, 1)
+	go func() {
+		first <- service.ReserveStockAll(ctx, []domain.StockRequest{
+			{InventoryItemID: b.ID, Quantity: 1},
+			{InventoryItemID: a.ID, Quantity: 1},
+		})
+	}()
+	<-p.reached
+
+	second := service.ReserveStockAll(context.Background(), []domain.StockRequest{
+		{InventoryItemID: a.ID, Quantity: 1},
+		{InventoryItemID: b.ID, Quantity: 1},
+	})
+	close(p.resume)
+
+	require.NoError(t, <-first)
+	var reservationErr *domain.ReservationError
+	require.ErrorAs(t, second, &reservationErr)
+	assert.Equal(t, a.ID, reservationErr.InventoryItemID, "the second request stops at the first SKU")
+
+	assert.Equal(t, int32(1), repo.get(a.ID).Reserved)
+	assert.Equal(t, int32(1), repo.get(b.ID).Reserved)
+}
+
+func TestReserveStockAllReleasesEarlierItemsOnFailure(t *testing.T) {
+	a := domain.NewInventoryItem("product-a", 5, "SKU-A", "store-1")
+	b := domain.NewInventoryItem("product-b", 5, "SKU-B", "store-1")
+	c := domain.NewInventoryItem("product-c", 1, "SKU-C", "store-1")
+	repo := newMemoryRepository(a, b, c)
+	service := newTestInventoryService(repo)
+
+	err := service.ReserveStockAll(context.Background(), []domain.StockRequest{
+		{InventoryItemID: c.ID, Quantity: 2},
+		{InventoryItemID: a.ID, Quantity: 2},
+		{InventoryItemID: b.ID, Quantity: 1},
+		{InventoryItemID: a.ID, Quantity: 1},
+	})
+
+	var reservationErr *domain.ReservationError
+	require.ErrorAs(t, err, &reservationErr)
+	assert.Equal(t, c.ID, reservationErr.InventoryItemID)
+	assert.ErrorIs(t, err, domain.ErrInsufficientStock)
+	for _, item := range []*domain.InventoryItem{a, b, c} {
+		assert.Equal(t, int32(0), repo.get(item.ID).Reserved, "no units of %s should stay reserved", item.SKU)
+	}
+}
+
+func TestReserveStockConcurrentCallersCannotOversell(t *testing.T) {
+	ctx := context.Background()
+	item := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	repo := newMemoryRepository(item)
+	service := newTestInventoryService(repo)
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		reserved int
+	)
+	for i := 0; i < 25; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := service.ReserveStock(ctx, item.ID, 1)
+			if err == nil {
+				mu.Lock()
+				reserved++
+				mu.Unlock()
+				return
+			}
+			assert.ErrorIs(t, err, domain.ErrInsufficientStock)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 10, reserved, "exactly the 10 units in stock can be reserved")
+	assert.Equal(t, int32(10), repo.get(item.ID).Reserved)
+}
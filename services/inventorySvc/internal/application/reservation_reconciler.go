@@ -0,0 +1,175 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// ReservationReconciler releases order reservations that leaked: those still
+// active for orders the order service reports as cancelled, completed or
+// unknown, e.g. because the release call failed when the order was cancelled
+type ReservationReconciler struct {
+	inventory *InventoryService
+	orders    domain.OrderLookup
+	// minAge keeps the reconciler off reservations made moments ago, whose
+	// order may not be visible in the order service yet
+	minAge   time.Duration
+	interval time.Duration
+	logger   *zap.Logger
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+	mu       sync.Mutex // One pass at a time
+}
+
+// NewReservationReconciler creates a reconciler that only looks at
+// reservations untouched for at least minAge. With a positive interval,
+// Start runs it periodically.
+func NewReservationReconciler(inventory *InventoryService, orders domain.OrderLookup, minAge, interval time.Duration, logger *zap.Logger) *ReservationReconciler {
+	return &ReservationReconciler{
+		inventory: inventory,
+		orders:    orders,
+		minAge:    minAge,
+		interval:  interval,
+		logger:    logger.Named("reservation_reconciler"),
+	}
+}
+
+// Start runs a pass on every interval until Stop. It does nothing when no
+// interval is configured, leaving reconciliation to explicit requests.
+func (r *ReservationReconciler) Start() {
+	if r.interval <= 0 {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if _, err := r.Reconcile(ctx, false); err != nil && ctx.Err() == nil {
+				r.logger.Error("Reservation reconciliation failed", zap.Error(err))
+			}
+		}
+	}()
+}
+
+// Stop stops the periodic run and waits for a running pass to finish
+func (r *ReservationReconciler) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+}
+
+// Reconcile checks every active order reservation against its order and
+// releases those of orders that no longer need them. With dryRun nothing is
+// released; the report lists what would have been. Orders that cannot be
+// looked up are skipped and counted as failed.
+func (r *ReservationReconciler) Reconcile(ctx context.Context, dryRun bool) (*domain.ReservationReconcileReport, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cutoff := time.Now().Add(-r.minAge)
+	items, err := r.inventory.repo.ListActiveOrderReservations(ctx, cutoff)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.ReservationReconcileReport{DryRun: dryRun}
+	byOrder := make(map[string][]*domain.InventoryItem)
+	var orderIDs []string
+	for _, item := range items {
+		for _, reservation := range item.ActiveReservations() {
+			if !reservation.UpdatedAt.Before(cutoff) {
+				continue
+			}
+			report.ReservationsChecked++
+			if _, seen := byOrder[reservation.OrderID]; !seen {
+				orderIDs = append(orderIDs, reservation.OrderID)
+			}
+			byOrder[reservation.OrderID] = append(byOrder[reservation.OrderID], item)
+		}
+	}
+	report.OrdersChecked = len(orderIDs)
+
+	for _, orderID := range orderIDs {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+
+		state, err := r.orders.OrderState(ctx, orderID)
+		if err != nil {
+			report.Failed++
+			if errors.Is(err, domain.ErrOrderLookupUnavailable) {
+				// Every further lookup would fail the same way
+				return report, err
+			}
+			r.logger.Warn("Failed to look up order of reservation",
+				zap.String("order_id", orderID),
+				zap.Error(err),
+			)
+			continue
+		}
+		if state.HoldsStock() {
+			continue
+		}
+
+		correction := domain.ReservationCorrection{OrderID: orderID, OrderState: state}
+		if dryRun {
+			for _, item := range byOrder[orderID] {
+				correction.Reservations = append(correction.Reservations, item.OrderReservation(orderID))
+			}
+		} else {
+			// Stopping ends the pass between orders; an order's release
+			// is always completed
+			released, err := r.inventory.ReleaseAllForOrder(context.WithoutCancel(ctx), orderID)
+			correction.Reservations = released
+			if err != nil {
+				report.Failed++
+				r.logger.Error("Failed to release leaked reservations",
+					zap.String("order_id", orderID),
+					zap.Error(err),
+				)
+			}
+		}
+		if len(correction.Reservations) == 0 {
+			continue
+		}
+
+		msg := "Released leaked reservations"
+		if dryRun {
+			msg = "Found leaked reservations"
+		}
+		r.logger.Info(msg,
+			zap.String("order_id", orderID),
+			zap.String("order_state", string(state)),
+			zap.Int("reservations", len(correction.Reservations)),
+			zap.Bool("dry_run", dryRun),
+		)
+		report.Corrections = append(report.Corrections, correction)
+	}
+
+	r.logger.Info("Reservation reconciliation finished",
+		zap.Int("reservations_checked", report.ReservationsChecked),
+		zap.Int("orders_checked", report.OrdersChecked),
+		zap.Int("orders_corrected", len(report.Corrections)),
+		zap.Int("failed", report.Failed),
+		zap.Bool("dry_run", dryRun),
+	)
+	return report, nil
+}
@@ -0,0 +1,130 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// fixedOrderLookup reports the states in its map and fails with err when set
+type fixedOrderLookup struct {
+	states map[string]domain.OrderState
+	err    error
+}
+
+func (l fixedOrderLookup) OrderState(ctx context.Context, orderID string) (domain.OrderState, error) {
+	if l.err != nil {
+		return "", l.err
+	}
+	return l.states[orderID], nil
+}
+
+// seedAgedReservations reserves quantities of item for orders and backdates
+// the reservations past the reconciler's minimum age
+func seedAgedReservations(t *testing.T, item *domain.InventoryItem, quantities map[string]int32) {
+	t.Helper()
+	for orderID, quantity := range quantities {
+		require.True(t, item.ReserveForOrder(quantity, orderID, time.Time{}))
+	}
+	for i := range item.Reservations {
+		item.Reservations[i].UpdatedAt = time.Now().Add(-time.Hour)
+	}
+}
+
+func TestReconcileReleasesReservationOfCancelledOrder(t *testing.T) {
+	item := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	seedAgedReservations(t, item, map[string]int32{"order-cancelled": 3, "order-open": 2})
+	repo := newMemoryRepository(item)
+	lookup := fixedOrderLookup{states: map[string]domain.OrderState{
+		"order-cancelled": domain.OrderStateCancelled,
+		"order-open":      domain.OrderStateOpen,
+	}}
+	reconciler := NewReservationReconciler(newTestInventoryService(repo), lookup, 10*time.Minute, 0, zap.NewNop())
+
+	report, err := reconciler.Reconcile(context.Background(), false)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, report.ReservationsChecked)
+	assert.Equal(t, 2, report.OrdersChecked)
+	require.Len(t, report.Corrections, 1)
+	assert.Equal(t, "order-cancelled", report.Corrections[0].OrderID)
+	assert.Equal(t, domain.OrderStateCancelled, report.Corrections[0].OrderState)
+
+	stored := repo.get(item.ID)
+	assert.Equal(t, int32(2), stored.Reserved, "only the open order keeps its units")
+	assert.Equal(t, domain.ReservationStatusCancelled, stored.ReservationFor("order-cancelled").Status)
+	assert.Equal(t, domain.ReservationStatusActive, stored.ReservationFor("order-open").Status)
+
+	// A second pass finds nothing left to release
+	report, err = reconciler.Reconcile(context.Background(), false)
+	require.NoError(t, err)
+	assert.Empty(t, report.Corrections)
+}
+
+func TestReconcileReleasesCompletedAndMissingOrders(t *testing.T) {
+	item := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	seedAgedReservations(t, item, map[string]int32{"order-shipped": 1, "order-gone": 4})
+	repo := newMemoryRepository(item)
+	lookup := fixedOrderLookup{states: map[string]domain.OrderState{
+		"order-shipped": domain.OrderStateCompleted,
+		"order-gone":    domain.OrderStateMissing,
+	}}
+	reconciler := NewReservationReconciler(newTestInventoryService(repo), lookup, 10*time.Minute, 0, zap.NewNop())
+
+	report, err := reconciler.Reconcile(context.Background(), false)
+	require.NoError(t, err)
+
+	assert.Len(t, report.Corrections, 2)
+	assert.Equal(t, int32(0), repo.get(item.ID).Reserved)
+}
+
+func TestReconcileDryRunReleasesNothing(t *testing.T) {
+	item := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	seedAgedReservations(t, item, map[string]int32{"order-cancelled": 3})
+	repo := newMemoryRepository(item)
+	lookup := fixedOrderLookup{states: map[string]domain.OrderState{"order-cancelled": domain.OrderStateCancelled}}
+	reconciler := NewReservationReconciler(newTestInventoryService(repo), lookup, 10*time.Minute, 0, zap.NewNop())
+
+	report, err := reconciler.Reconcile(context.Background(), true)
+	require.NoError(t, err)
+
+	assert.True(t, report.DryRun)
+	require.Len(t, report.Corrections, 1)
+	assert.Equal(t, int32(3), report.Corrections[0].Reservations[0].Quantity)
+	assert.Equal(t, int32(3), repo.get(item.ID).Reserved)
+}
+
+func TestReconcileLeavesRecentReservations(t *testing.T) {
+	item := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	require.True(t, item.ReserveForOrder(3, "order-new", time.Time{}))
+	repo := newMemoryRepository(item)
+	// The order may not be visible in the order service yet
+	lookup := fixedOrderLookup{states: map[string]domain.OrderState{"order-new": domain.OrderStateMissing}}
+	reconciler := NewReservationReconciler(newTestInventoryService(repo), lookup, 10*time.Minute, 0, zap.NewNop())
+
+	report, err := reconciler.Reconcile(context.Background(), false)
+	require.NoError(t, err)
+
+	assert.Zero(t, report.ReservationsChecked)
+	assert.Equal(t, int32(3), repo.get(item.ID).Reserved)
+}
+
+func TestReconcileStopsWhenOrderServiceIsUnavailable(t *testing.T) {
+	item := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	seedAgedReservations(t, item, map[string]int32{"order-a": 3, "order-b": 2})
+	repo := newMemoryRepository(item)
+	lookup := fixedOrderLookup{err: domain.ErrOrderLookupUnavailable}
+	reconciler := NewReservationReconciler(newTestInventoryService(repo), lookup, 10*time.Minute, 0, zap.NewNop())
+
+	report, err := reconciler.Reconcile(context.Background(), false)
+	require.ErrorIs(t, err, domain.ErrOrderLookupUnavailable)
+
+	assert.Equal(t, 1, report.Failed, "the pass stops at the first unreachable lookup")
+	assert.Equal(t, int32(5), repo.get(item.ID).Reserved)
+}
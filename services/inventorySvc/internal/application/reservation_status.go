@@ -0,0 +1,113 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// GetReservationStatus returns the reservation an inventory item holds for
+// orderID, along with the order it is held for. orderID may be left empty
+// when the item has only ever been reserved for one order.
+func (s *InventoryService) GetReservationStatus(ctx context.Context, itemID, orderID string) (*domain.OrderReservation, string, error) {
+	item, orderID, err := s.getReservedItem(ctx, itemID, orderID)
+	if err != nil {
+		return nil, "", err
+	}
+	return item.OrderReservation(orderID), orderID, nil
+}
+
+// SetReservationStatus moves an item's reservation for orderID to status on
+// behalf of support staff, settling its units the way the pickup and expiry
+// flows would. Asking for the status the reservation already has changes
+// nothing; a reservation that is no longer active cannot change at all, so a
+// fulfilled one is never taken back. Each transition is recorded in the
+// item's history.
+func (s *InventoryService) SetReservationStatus(ctx context.Context, itemID, orderID, status, reason, performedBy string) (*domain.OrderReservation, string, error) {
+	status = strings.ToLower(strings.TrimSpace(status))
+	if !domain.ValidReservationStatus(status) {
+		return nil, "", fmt.Errorf("%w: unknown reservation status %q", domain.ErrInvalidInput, status)
+	}
+	if performedBy == "" {
+		performedBy = "system"
+	}
+
+	item, orderID, err := s.getReservedItem(ctx, itemID, orderID)
+	if err != nil {
+		return nil, "", err
+	}
+	reservation := item.OrderReservation(orderID)
+	if reservation.Status == status {
+		return reservation, orderID, nil
+	}
+
+	previous := reservation.Status
+	quantityBefore := item.Quantity
+	if _, err := item.TransitionReservation(orderID, status); err != nil {
+		return nil, "", err
+	}
+	if reason = strings.TrimSpace(reason); reason != "" {
+		item.AddNote(fmt.Sprintf("Reservation set to %s by %s: %s", status, performedBy, reason))
+	}
+	if err := s.repo.Update(ctx, item); err != nil {
+		return nil, "", fmt.Errorf("failed to update inventory item: %w", err)
+	}
+
+	description := fmt.Sprintf("Reservation of %d units for order %s changed from %s to %s", reservation.Quantity, orderID, previous, status)
+	if reason != "" {
+		description += ": " + reason
+	}
+	changeType := "RESERVATION_" + strings.ToUpper(status)
+	if err := s.recordInventoryHistory(ctx, item.ID, changeType, description, quantityBefore, item.Quantity, orderID, "ORDER", performedBy); err != nil {
+		s.logger.Error("Failed to record inventory history after changing reservation status",
+			zap.String("inventory_id", item.ID),
+			zap.Error(err),
+		)
+	}
+
+	s.logger.Info("Reservation status changed",
+		zap.String("inventory_id", item.ID),
+		zap.String("order_id", orderID),
+		zap.String("from", previous),
+		zap.String("to", status),
+		zap.String("performed_by", performedBy),
+	)
+
+	reservation.Status = status
+	reservation.UpdatedAt = item.LastUpdated
+	return reservation, orderID, nil
+}
+
+// getReservedItem loads an inventory item that holds a reservation for
+// orderID and returns it with the order ID. Without an order ID the item's
+// only reservation is used; an item reserved for several orders needs one.
+func (s *InventoryService) getReservedItem(ctx context.Context, itemID, orderID string) (*domain.InventoryItem, string, error) {
+	if itemID == "" {
+		return nil, "", fmt.Errorf("%w: inventory item ID is required", domain.ErrInvalidInput)
+	}
+	item, err := s.repo.GetByID(ctx, itemID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get inventory item: %w", err)
+	}
+	if item == nil {
+		return nil, "", domain.ErrNotFound
+	}
+	if orderID == "" {
+		switch len(item.Reservations) {
+		case 0:
+			return nil, "", domain.ErrReservationNotFound
+		case 1:
+			orderID = item.Reservations[0].OrderID
+		default:
+			return nil, "", fmt.Errorf("%w: order ID is required, the item is reserved for several orders", domain.ErrInvalidInput)
+		}
+	}
+	if item.ReservationFor(orderID) == nil {
+		return nil, "", domain.ErrReservationNotFound
+	}
+	return item, orderID, nil
+}
@@ -0,0 +1,134 @@
+package application
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// newReservedItem returns an item of 10 units, 3 of them reserved for order-a
+func newReservedItem(t *testing.T) *domain.InventoryItem {
+	t.Helper()
+	item := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	require.True(t, item.ReserveForOrder(3, "order-a", time.Time{}))
+	return item
+}
+
+func TestSetReservationStatusLegalTransitions(t *testing.T) {
+	tests := []struct {
+		status       string
+		wantQuantity int32
+	}{
+		{status: domain.ReservationStatusFulfilled, wantQuantity: 7},
+		{status: domain.ReservationStatusCancelled, wantQuantity: 10},
+		{status: domain.ReservationStatusExpired, wantQuantity: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			item := newReservedItem(t)
+			repo := newMemoryRepository(item)
+			service := newTestInventoryService(repo)
+
+			reservation, orderID, err := service.SetReservationStatus(context.Background(), item.ID, "", " "+tt.status+" ", "customer called", "staff-1")
+			require.NoError(t, err)
+
+			assert.Equal(t, "order-a", orderID)
+			assert.Equal(t, tt.status, reservation.Status)
+			stored := repo.get(item.ID)
+			assert.Equal(t, tt.wantQuantity, stored.Quantity)
+			assert.Equal(t, int32(0), stored.Reserved, "the units are no longer held")
+			assert.Equal(t, tt.status, stored.ReservationFor("order-a").Status)
+
+			require.Len(t, repo.history, 1)
+			h := repo.history[0]
+			assert.Equal(t, "RESERVATION_"+strings.ToUpper(tt.status), h.ChangeType)
+			assert.Equal(t, int32(10), h.QuantityBefore)
+			assert.Equal(t, tt.wantQuantity, h.QuantityAfter)
+			assert.Equal(t, "order-a", h.ReferenceID)
+			assert.Equal(t, "staff-1", h.PerformedBy)
+			assert.Contains(t, h.Description, "from active to "+tt.status)
+			assert.Contains(t, h.Description, "customer called")
+		})
+	}
+}
+
+func TestSetReservationStatusCannotUnfulfill(t *testing.T) {
+	item := newReservedItem(t)
+	repo := newMemoryRepository(item)
+	service := newTestInventoryService(repo)
+	ctx := context.Background()
+
+	_, _, err := service.SetReservationStatus(ctx, item.ID, "order-a", domain.ReservationStatusFulfilled, "", "staff-1")
+	require.NoError(t, err)
+	fulfilled := repo.get(item.ID)
+
+	for _, status := range []string{domain.ReservationStatusActive, domain.ReservationStatusCancelled} {
+		_, _, err := service.SetReservationStatus(ctx, item.ID, "order-a", status, "", "staff-1")
+		assert.ErrorIs(t, err, domain.ErrInvalidOperation, status)
+	}
+
+	stored := repo.get(item.ID)
+	assert.Equal(t, fulfilled.Quantity, stored.Quantity, "a refused transition leaves the stock alone")
+	assert.Equal(t, domain.ReservationStatusFulfilled, stored.ReservationFor("order-a").Status)
+	assert.Len(t, repo.history, 1, "only the legal transition is recorded")
+}
+
+func TestSetReservationStatusToCurrentStatusChangesNothing(t *testing.T) {
+	item := newReservedItem(t)
+	repo := newMemoryRepository(item)
+	service := newTestInventoryService(repo)
+
+	reservation, _, err := service.SetReservationStatus(context.Background(), item.ID, "", domain.ReservationStatusActive, "", "staff-1")
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.ReservationStatusActive, reservation.Status)
+	assert.Equal(t, int32(3), repo.get(item.ID).Reserved)
+	assert.Empty(t, repo.history)
+}
+
+func TestSetReservationStatusErrors(t *testing.T) {
+	reserved := newReservedItem(t)
+	shared := newReservedItem(t)
+	require.True(t, shared.ReserveForOrder(2, "order-b", time.Time{}))
+	unreserved := domain.NewInventoryItem("product-2", 5, "SKU-2", "store-1")
+	service := newTestInventoryService(newMemoryRepository(reserved, shared, unreserved))
+
+	tests := []struct {
+		name    string
+		itemID  string
+		orderID string
+		status  string
+		wantErr error
+	}{
+		{name: "unknown status", itemID: reserved.ID, status: "lost", wantErr: domain.ErrInvalidInput},
+		{name: "no item ID", status: domain.ReservationStatusCancelled, wantErr: domain.ErrInvalidInput},
+		{name: "unknown item", itemID: "missing", status: domain.ReservationStatusCancelled, wantErr: domain.ErrNotFound},
+		{name: "item not reserved", itemID: unreserved.ID, status: domain.ReservationStatusCancelled, wantErr: domain.ErrReservationNotFound},
+		{name: "other order", itemID: reserved.ID, orderID: "order-b", status: domain.ReservationStatusCancelled, wantErr: domain.ErrReservationNotFound},
+		{name: "several orders without an order ID", itemID: shared.ID, status: domain.ReservationStatusCancelled, wantErr: domain.ErrInvalidInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := service.SetReservationStatus(context.Background(), tt.itemID, tt.orderID, tt.status, "", "staff-1")
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestGetReservationStatus(t *testing.T) {
+	item := newReservedItem(t)
+	service := newTestInventoryService(newMemoryRepository(item))
+
+	reservation, orderID, err := service.GetReservationStatus(context.Background(), item.ID, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "order-a", orderID)
+	assert.Equal(t, domain.ReservationStatusActive, reservation.Status)
+	assert.Equal(t, int32(3), reservation.Quantity)
+}
@@ -0,0 +1,106 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// BatchAdjust applies the adjustments of a cycle count at one location, each
+// through the same path as AdjustStock. Lines are applied independently: a
+// line whose SKU is not stocked there, or that would take the stock below
+// zero, is reported in its result and the others still go through. Every
+// applied line gets an adjustment history entry referencing the batch ID.
+// The batch itself is refused as a whole when it is empty, too large, lists
+// a SKU twice or has no valid reason.
+func (s *InventoryService) BatchAdjust(ctx context.Context, locationID string, adjustments []domain.AdjustLine, reason string, performedBy string) (*domain.BatchAdjustment, error) {
+	logger := s.logger.With(zap.String("location_id", locationID))
+	logger.Info("Applying batch adjustment", zap.Int("lines", len(adjustments)))
+
+	switch {
+	case locationID == "":
+		return nil, fmt.Errorf("%w: location ID is required", domain.ErrInvalidInput)
+	case len(adjustments) == 0:
+		return nil, fmt.Errorf("%w: at least one adjustment is required", domain.ErrInvalidInput)
+	case len(adjustments) > domain.MaxBatchAdjustLines:
+		return nil, fmt.Errorf("%w: at most %d adjustments can be applied at once", domain.ErrInvalidInput, domain.MaxBatchAdjustLines)
+	}
+	reason, err := domain.NormalizeAdjustmentReason(reason)
+	if err != nil {
+		return nil, err
+	}
+	if performedBy == "" {
+		performedBy = "system"
+	}
+
+	lines := make([]domain.AdjustLine, len(adjustments))
+	seen := make(map[string]bool, len(adjustments))
+	for i, line := range adjustments {
+		line.SKU = strings.TrimSpace(line.SKU)
+		switch {
+		case line.SKU == "":
+			return nil, fmt.Errorf("%w: adjustment %d has no SKU", domain.ErrInvalidInput, i+1)
+		case seen[line.SKU]:
+			return nil, fmt.Errorf("%w: SKU %s is listed twice", domain.ErrInvalidInput, line.SKU)
+		}
+		seen[line.SKU] = true
+		lines[i] = line
+	}
+
+	batch := &domain.BatchAdjustment{
+		ID:         uuid.New().String(),
+		LocationID: locationID,
+		Reason:     reason,
+		Results:    make([]domain.AdjustLineResult, 0, len(lines)),
+	}
+	for _, line := range lines {
+		batch.Results = append(batch.Results, s.applyAdjustLine(ctx, batch, line, performedBy))
+	}
+
+	logger.Info("Batch adjustment applied",
+		zap.String("batch_id", batch.ID),
+		zap.Int("applied", batch.Applied()),
+		zap.Int("failed", len(batch.Results)-batch.Applied()),
+	)
+	return batch, nil
+}
+
+// applyAdjustLine applies one line of a batch adjustment and reports how it went
+func (s *InventoryService) applyAdjustLine(ctx context.Context, batch *domain.BatchAdjustment, line domain.AdjustLine, performedBy string) domain.AdjustLineResult {
+	result := domain.AdjustLineResult{SKU: line.SKU}
+
+	item, err := s.repo.GetBySKUAndLocation(ctx, line.SKU, batch.LocationID)
+	if err != nil {
+		result.Err = fmt.Errorf("failed to get inventory item: %w", err)
+		return result
+	}
+	if item == nil {
+		result.Err = fmt.Errorf("%w: SKU %s is not stocked at location %s", domain.ErrNotFound, line.SKU, batch.LocationID)
+		return result
+	}
+
+	result.InventoryItemID = item.ID
+	result.QuantityBefore = item.Quantity
+	result.QuantityAfter = item.Quantity
+	if line.Quantity == 0 {
+		return result
+	}
+
+	if err := s.adjustItem(ctx, item, line.Quantity, batch.Reason, performedBy, batch.ID, domain.ReferenceTypeBatchAdjustment); err != nil {
+		s.logger.Warn("Batch adjustment line not applied",
+			zap.String("batch_id", batch.ID),
+			zap.String("sku", line.SKU),
+			zap.Int32("quantity", line.Quantity),
+			zap.Error(err),
+		)
+		result.Err = err
+		return result
+	}
+	result.QuantityAfter = item.Quantity
+	return result
+}
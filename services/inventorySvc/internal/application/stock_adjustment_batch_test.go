@@ -0,0 +1,106 @@
+package application
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+func TestBatchAdjustAppliesValidLinesAndReportsNegative(t *testing.T) {
+	counted := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	short := domain.NewInventoryItem("product-2", 2, "SKU-2", "store-1")
+	found := domain.NewInventoryItem("product-3", 0, "SKU-3", "store-1")
+	repo := newMemoryRepository(counted, short, found)
+	service := newTestInventoryService(repo)
+
+	batch, err := service.BatchAdjust(context.Background(), "store-1", []domain.AdjustLine{
+		{SKU: "SKU-1", Quantity: -3},
+		{SKU: "SKU-2", Quantity: -5},
+		{SKU: " SKU-3 ", Quantity: 4},
+		{SKU: "SKU-4", Quantity: 1},
+	}, " cycle count ", "staff-1")
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, batch.ID)
+	assert.Equal(t, "cycle count", batch.Reason)
+	assert.Equal(t, 2, batch.Applied())
+	require.Len(t, batch.Results, 4, "one result per line, in order")
+
+	assert.NoError(t, batch.Results[0].Err)
+	assert.Equal(t, counted.ID, batch.Results[0].InventoryItemID)
+	assert.Equal(t, int32(10), batch.Results[0].QuantityBefore)
+	assert.Equal(t, int32(7), batch.Results[0].QuantityAfter)
+
+	assert.ErrorIs(t, batch.Results[1].Err, domain.ErrInsufficientStock)
+	assert.Equal(t, int32(2), batch.Results[1].QuantityBefore)
+	assert.Equal(t, int32(2), batch.Results[1].QuantityAfter, "the negative line is not applied")
+
+	assert.NoError(t, batch.Results[2].Err)
+	assert.Equal(t, "SKU-3", batch.Results[2].SKU)
+	assert.Equal(t, int32(4), batch.Results[2].QuantityAfter)
+
+	assert.ErrorIs(t, batch.Results[3].Err, domain.ErrNotFound)
+	assert.Empty(t, batch.Results[3].InventoryItemID)
+
+	assert.Equal(t, int32(7), repo.get(counted.ID).Quantity)
+	assert.Equal(t, int32(2), repo.get(short.ID).Quantity)
+	assert.Equal(t, int32(4), repo.get(found.ID).Quantity)
+
+	require.Len(t, repo.history, 2, "history only for the applied lines")
+	for _, h := range repo.history {
+		assert.Equal(t, domain.ChangeTypeAdjustment, h.ChangeType)
+		assert.Equal(t, batch.ID, h.ReferenceID, "every line references the batch")
+		assert.Equal(t, domain.ReferenceTypeBatchAdjustment, h.ReferenceType)
+		assert.Equal(t, "cycle count", h.Description)
+		assert.Equal(t, "staff-1", h.PerformedBy)
+	}
+}
+
+func TestBatchAdjustZeroLineLeavesStock(t *testing.T) {
+	item := domain.NewInventoryItem("product-1", 5, "SKU-1", "store-1")
+	repo := newMemoryRepository(item)
+	service := newTestInventoryService(repo)
+
+	batch, err := service.BatchAdjust(context.Background(), "store-1", []domain.AdjustLine{{SKU: "SKU-1"}}, "cycle count", "")
+	require.NoError(t, err)
+
+	require.Len(t, batch.Results, 1)
+	assert.NoError(t, batch.Results[0].Err)
+	assert.Equal(t, int32(5), batch.Results[0].QuantityAfter)
+	assert.Empty(t, repo.history, "a count matching the stock changes nothing")
+}
+
+func TestBatchAdjustValidatesBatch(t *testing.T) {
+	item := domain.NewInventoryItem("product-1", 5, "SKU-1", "store-1")
+	repo := newMemoryRepository(item)
+	service := newTestInventoryService(repo)
+	line := []domain.AdjustLine{{SKU: "SKU-1", Quantity: 1}}
+
+	tests := []struct {
+		name       string
+		locationID string
+		lines      []domain.AdjustLine
+		reason     string
+	}{
+		{name: "no location", lines: line, reason: "cycle count"},
+		{name: "no lines", locationID: "store-1", reason: "cycle count"},
+		{name: "too many lines", locationID: "store-1", lines: make([]domain.AdjustLine, domain.MaxBatchAdjustLines+1), reason: "cycle count"},
+		{name: "no reason", locationID: "store-1", lines: line, reason: "  "},
+		{name: "reason too long", locationID: "store-1", lines: line, reason: strings.Repeat("x", domain.MaxAdjustmentReasonLength+1)},
+		{name: "no SKU", locationID: "store-1", lines: []domain.AdjustLine{{SKU: " ", Quantity: 1}}, reason: "cycle count"},
+		{name: "SKU twice", locationID: "store-1", lines: []domain.AdjustLine{{SKU: "SKU-1", Quantity: 1}, {SKU: "SKU-1 ", Quantity: 2}}, reason: "cycle count"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.BatchAdjust(context.Background(), tt.locationID, tt.lines, tt.reason, "staff-1")
+			assert.ErrorIs(t, err, domain.ErrInvalidInput)
+		})
+	}
+	assert.Equal(t, int32(5), repo.get(item.ID).Quantity, "a refused batch applies nothing")
+	assert.Empty(t, repo.history)
+}
@@ -0,0 +1,53 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// ExportStockAdjustments writes the stock adjustments matching filter as CSV,
+// oldest first, and returns the data and the number of adjustments in it
+func (s *InventoryService) ExportStockAdjustments(ctx context.Context, filter domain.StockAdjustmentFilter) ([]byte, int, error) {
+	s.logger.Info("Exporting stock adjustments",
+		zap.String("location_id", filter.LocationID),
+		zap.String("reason", filter.Reason),
+		zap.Time("from", filter.From),
+		zap.Time("to", filter.To),
+	)
+
+	adjustments, err := s.repo.ListAdjustments(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list stock adjustments: %w", err)
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	writer.Write([]string{"Timestamp", "Inventory ID", "SKU", "Location ID", "Adjustment", "Quantity Before", "Quantity After", "Reason", "Performed By"})
+	for _, adj := range adjustments {
+		writer.Write([]string{
+			adj.CreatedAt.Format(time.RFC3339),
+			adj.InventoryID,
+			adj.SKU,
+			adj.LocationID,
+			strconv.Itoa(int(adj.QuantityAfter - adj.QuantityBefore)),
+			strconv.Itoa(int(adj.QuantityBefore)),
+			strconv.Itoa(int(adj.QuantityAfter)),
+			adj.Description,
+			adj.PerformedBy,
+		})
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, 0, fmt.Errorf("failed to write stock adjustments: %w", err)
+	}
+
+	return buf.Bytes(), len(adjustments), nil
+}
@@ -0,0 +1,152 @@
+package application
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// StockEventRepository wraps an inventory repository and publishes a stock
+// changed event after every write that can move an item's stock. Publishing is
+// best effort: a failed publish is logged and the write still succeeds, since
+// consumers fall back to reloading once their cached entries expire. Items are
+// read before writes that can restock them, so events can tell consumers when
+// an item leaves zero; new items are never reported as restocked.
+type StockEventRepository struct {
+	domain.InventoryRepository
+	events domain.StockEventPublisher
+	logger *zap.Logger
+}
+
+// NewStockEventRepository wraps repo so that stock writes are published to events
+func NewStockEventRepository(repo domain.InventoryRepository, events domain.StockEventPublisher, logger *zap.Logger) *StockEventRepository {
+	return &StockEventRepository{
+		InventoryRepository: repo,
+		events:              events,
+		logger:              logger.Named("stock_events"),
+	}
+}
+
+// Create stores a new item and publishes its stock
+func (r *StockEventRepository) Create(ctx context.Context, item *domain.InventoryItem) error {
+	if err := r.InventoryRepository.Create(ctx, item); err != nil {
+		return err
+	}
+	r.publish(ctx, domain.NewStockChangedEvent(item))
+	return nil
+}
+
+// Update stores an item and publishes its stock
+func (r *StockEventRepository) Update(ctx context.Context, item *domain.InventoryItem) error {
+	previous, known := r.availableBefore(ctx, item.ID)
+	if err := r.InventoryRepository.Update(ctx, item); err != nil {
+		return err
+	}
+	r.publish(ctx, withPrevious(domain.NewStockChangedEvent(item), previous, known))
+	return nil
+}
+
+// Delete removes an item and tells consumers to drop the product's stock
+func (r *StockEventRepository) Delete(ctx context.Context, id string) error {
+	item, err := r.InventoryRepository.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := r.InventoryRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	r.publish(ctx, domain.NewStockInvalidatedEvent(item.ProductID))
+	return nil
+}
+
+// AdjustStock adjusts an item's quantity and publishes the adjusted stock
+func (r *StockEventRepository) AdjustStock(ctx context.Context, itemID string, quantity int32, reason string, performedBy string) error {
+	previous, known := r.availableBefore(ctx, itemID)
+	if err := r.InventoryRepository.AdjustStock(ctx, itemID, quantity, reason, performedBy); err != nil {
+		return err
+	}
+	r.publishItem(ctx, itemID, previous, known)
+	return nil
+}
+
+// Reserve reserves stock of an item and publishes what is left available
+func (r *StockEventRepository) Reserve(ctx context.Context, itemID string, quantity int32) error {
+	if err := r.InventoryRepository.Reserve(ctx, itemID, quantity); err != nil {
+		return err
+	}
+	r.publishItem(ctx, itemID, 0, false)
+	return nil
+}
+
+// TransferStock moves stock between locations and publishes both sides
+func (r *StockEventRepository) TransferStock(ctx context.Context, transfer *domain.StockTransfer) error {
+	if err := r.InventoryRepository.TransferStock(ctx, transfer); err != nil {
+		return err
+	}
+	if transfer.Source != nil {
+		r.publish(ctx, domain.NewStockChangedEvent(transfer.Source))
+	}
+	if transfer.Destination != nil {
+		// The destination gained the moved quantity, unless it was created
+		// for the transfer
+		event := domain.NewStockChangedEvent(transfer.Destination)
+		r.publish(ctx, withPrevious(event, *event.Available-transfer.Quantity, !transfer.DestinationCreated))
+	}
+	return nil
+}
+
+// MergeItems folds duplicate items into kept and publishes the merged stock
+func (r *StockEventRepository) MergeItems(ctx context.Context, kept *domain.InventoryItem, mergedIDs []string) error {
+	previous, known := r.availableBefore(ctx, kept.ID)
+	if err := r.InventoryRepository.MergeItems(ctx, kept, mergedIDs); err != nil {
+		return err
+	}
+	r.publish(ctx, withPrevious(domain.NewStockChangedEvent(kept), previous, known))
+	return nil
+}
+
+// availableBefore reads an item's available stock ahead of a write. known is
+// false when the item cannot be read.
+func (r *StockEventRepository) availableBefore(ctx context.Context, itemID string) (available int32, known bool) {
+	item, err := r.InventoryRepository.GetByID(ctx, itemID)
+	if err != nil || item == nil {
+		return 0, false
+	}
+	return item.GetAvailable(), true
+}
+
+// withPrevious marks event as a restock from previous when previous is known
+func withPrevious(event *domain.StockChangedEvent, previous int32, known bool) *domain.StockChangedEvent {
+	if !known {
+		return event
+	}
+	return event.WithPrevious(previous)
+}
+
+// publishItem reads an item back and publishes its stock
+func (r *StockEventRepository) publishItem(ctx context.Context, itemID string, previous int32, known bool) {
+	item, err := r.InventoryRepository.GetByID(ctx, itemID)
+	if err != nil {
+		r.logger.Warn("Failed to read item back for stock event",
+			zap.String("inventory_id", itemID),
+			zap.Error(err),
+		)
+		return
+	}
+	r.publish(ctx, withPrevious(domain.NewStockChangedEvent(item), previous, known))
+}
+
+func (r *StockEventRepository) publish(ctx context.Context, event *domain.StockChangedEvent) {
+	if event.ProductID == "" {
+		return
+	}
+	if err := r.events.PublishStockChanged(ctx, event); err != nil {
+		r.logger.Error("Failed to publish stock changed event",
+			zap.String("product_id", event.ProductID),
+			zap.String("event_id", event.ID),
+			zap.Error(err),
+		)
+	}
+}
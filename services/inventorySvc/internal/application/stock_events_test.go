@@ -0,0 +1,151 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+type recordingPublisher struct {
+	mu     sync.Mutex
+	events []*domain.StockChangedEvent
+	err    error
+}
+
+func (p *recordingPublisher) PublishStockChanged(ctx context.Context, event *domain.StockChangedEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, event)
+	return p.err
+}
+
+func (p *recordingPublisher) last(t *testing.T) *domain.StockChangedEvent {
+	t.Helper()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	require.NotEmpty(t, p.events)
+	return p.events[len(p.events)-1]
+}
+
+func TestStockEventRepositoryPublishesStockChanges(t *testing.T) {
+	ctx := context.Background()
+	item := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	item.ReorderPoint = 3
+	events := &recordingPublisher{}
+	repo := NewStockEventRepository(newMemoryRepository(item), events, zap.NewNop())
+	service := newTestInventoryService(repo)
+
+	require.NoError(t, service.RemoveStock(ctx, item.ID, 4))
+
+	event := events.last(t)
+	assert.Equal(t, domain.EventTypeStockChanged, event.Type)
+	assert.Equal(t, "product-1", event.ProductID)
+	assert.Equal(t, item.ID, event.InventoryID)
+	require.NotNil(t, event.Available)
+	assert.Equal(t, int32(6), *event.Available)
+	require.NotNil(t, event.ReorderAt)
+	assert.Equal(t, int32(3), *event.ReorderAt)
+
+	require.NoError(t, service.ReserveStock(ctx, item.ID, 2))
+	assert.Equal(t, int32(4), *events.last(t).Available, "reservations move available stock")
+}
+
+func TestStockEventRepositoryReadsAdjustedItemBack(t *testing.T) {
+	ctx := context.Background()
+	item := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	events := &recordingPublisher{}
+	repo := NewStockEventRepository(newMemoryRepository(item), events, zap.NewNop())
+
+	require.NoError(t, repo.AdjustStock(ctx, item.ID, 5, "count", "staff-1"))
+
+	assert.Equal(t, int32(15), *events.last(t).Available)
+}
+
+func TestStockEventRepositoryInvalidatesDeletedProduct(t *testing.T) {
+	ctx := context.Background()
+	item := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	events := &recordingPublisher{}
+	repo := NewStockEventRepository(newMemoryRepository(item), events, zap.NewNop())
+
+	require.NoError(t, repo.Delete(ctx, item.ID))
+
+	event := events.last(t)
+	assert.Equal(t, "product-1", event.ProductID)
+	assert.Nil(t, event.Available, "consumers must reload a deleted item's product")
+}
+
+func TestStockEventRepositoryPublishesBothSidesOfTransfer(t *testing.T) {
+	ctx := context.Background()
+	source := domain.NewInventoryItem("product-1", 4, "SKU-1", "store-1")
+	destination := domain.NewInventoryItem("product-1", 6, "SKU-1", "store-2")
+	events := &recordingPublisher{}
+	repo := NewStockEventRepository(newMemoryRepository(source, destination), events, zap.NewNop())
+
+	require.NoError(t, repo.TransferStock(ctx, &domain.StockTransfer{SKU: "SKU-1", FromLocationID: "store-1", ToLocationID: "store-2", Quantity: 1}))
+
+	require.Len(t, events.events, 2)
+	assert.Equal(t, "store-1", events.events[0].LocationID)
+	assert.Equal(t, "store-2", events.events[1].LocationID)
+}
+
+func TestStockEventRepositoryWriteSurvivesPublishFailure(t *testing.T) {
+	ctx := context.Background()
+	memory := newMemoryRepository()
+	events := &recordingPublisher{err: errors.New("broker down")}
+	repo := NewStockEventRepository(memory, events, zap.NewNop())
+	item := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+
+	require.NoError(t, repo.Create(ctx, item))
+
+	assert.NotNil(t, memory.get(item.ID))
+	assert.Len(t, events.events, 1)
+}
+
+func TestStockEventRepositorySkipsFailedWrites(t *testing.T) {
+	ctx := context.Background()
+	events := &recordingPublisher{}
+	repo := NewStockEventRepository(newMemoryRepository(), events, zap.NewNop())
+
+	err := repo.Update(ctx, domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1"))
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.Empty(t, events.events)
+}
+
+func TestStockEventRepositoryFlagsItemsLeavingZero(t *testing.T) {
+	ctx := context.Background()
+	item := domain.NewInventoryItem("product-1", 0, "SKU-1", "store-1")
+	events := &recordingPublisher{}
+	repo := NewStockEventRepository(newMemoryRepository(item), events, zap.NewNop())
+
+	require.NoError(t, repo.AdjustStock(ctx, item.ID, 3, "delivery", "staff-1"))
+	assert.True(t, events.last(t).Restocked, "0 -> 3 is a restock")
+
+	require.NoError(t, repo.AdjustStock(ctx, item.ID, 2, "delivery", "staff-1"))
+	assert.False(t, events.last(t).Restocked, "3 -> 5 is not a restock")
+
+	created := domain.NewInventoryItem("product-2", 4, "SKU-2", "store-1")
+	require.NoError(t, repo.Create(ctx, created))
+	assert.False(t, events.last(t).Restocked, "a new item has no known previous stock")
+}
+
+func TestStockEventRepositoryFlagsTransferIntoEmptyLocation(t *testing.T) {
+	ctx := context.Background()
+	source := domain.NewInventoryItem("product-1", 4, "SKU-1", "store-1")
+	destination := domain.NewInventoryItem("product-1", 0, "SKU-1", "store-2")
+	events := &recordingPublisher{}
+	repo := NewStockEventRepository(newMemoryRepository(source, destination), events, zap.NewNop())
+
+	require.NoError(t, repo.TransferStock(ctx, &domain.StockTransfer{SKU: "SKU-1", FromLocationID: "store-1", ToLocationID: "store-2", Quantity: 1}))
+
+	require.Len(t, events.events, 2)
+	assert.False(t, events.events[0].Restocked)
+	assert.True(t, events.events[1].Restocked)
+}
@@ -0,0 +1,62 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// TransferStock moves quantity units of a SKU from one location to another at
+// once, creating the item at the destination if it has none. Both sides change
+// in one transaction and get a "transfer" history entry carrying the same
+// transfer ID. A source without enough available stock fails with
+// domain.ErrInsufficientStock and leaves the destination untouched.
+func (s *InventoryService) TransferStock(ctx context.Context, sku, fromLocationID, toLocationID string, quantity int32, performedBy string) (*domain.StockTransfer, error) {
+	sku = strings.TrimSpace(sku)
+	s.logger.Info("Transferring stock",
+		zap.String("sku", sku),
+		zap.String("from_location_id", fromLocationID),
+		zap.String("to_location_id", toLocationID),
+		zap.Int32("quantity", quantity),
+		zap.String("performed_by", performedBy),
+	)
+
+	switch {
+	case sku == "":
+		return nil, fmt.Errorf("%w: SKU is required", domain.ErrInvalidInput)
+	case fromLocationID == "" || toLocationID == "":
+		return nil, fmt.Errorf("%w: source and destination locations are required", domain.ErrInvalidInput)
+	case fromLocationID == toLocationID:
+		return nil, fmt.Errorf("%w: source and destination locations must differ", domain.ErrInvalidInput)
+	case quantity <= 0:
+		return nil, fmt.Errorf("%w: quantity must be positive", domain.ErrInvalidInput)
+	}
+	if performedBy == "" {
+		performedBy = "system"
+	}
+
+	transfer := &domain.StockTransfer{
+		ID:             uuid.New().String(),
+		SKU:            sku,
+		FromLocationID: fromLocationID,
+		ToLocationID:   toLocationID,
+		Quantity:       quantity,
+		PerformedBy:    performedBy,
+	}
+	if err := s.repo.TransferStock(ctx, transfer); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Stock transferred",
+		zap.String("transfer_id", transfer.ID),
+		zap.String("source_id", transfer.Source.ID),
+		zap.String("destination_id", transfer.Destination.ID),
+		zap.Bool("destination_created", transfer.DestinationCreated),
+	)
+	return transfer, nil
+}
@@ -0,0 +1,102 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+func TestTransferStockMovesStockToExistingItem(t *testing.T) {
+	source := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	destination := domain.NewInventoryItem("product-1", 2, "SKU-1", "store-2")
+	repo := newMemoryRepository(source, destination)
+	service := newTestInventoryService(repo)
+
+	transfer, err := service.TransferStock(context.Background(), " SKU-1 ", "store-1", "store-2", 4, "staff-1")
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, transfer.ID)
+	assert.False(t, transfer.DestinationCreated)
+	assert.Equal(t, int32(6), repo.get(source.ID).Quantity)
+	assert.Equal(t, int32(6), repo.get(destination.ID).Quantity)
+
+	require.Len(t, repo.history, 2, "one history entry per side")
+	sides := map[string]*domain.InventoryHistory{}
+	for _, h := range repo.history {
+		assert.Equal(t, domain.HistoryChangeTransfer, h.ChangeType)
+		assert.Equal(t, transfer.ID, h.ReferenceID, "both sides share the transfer ID")
+		assert.Equal(t, "staff-1", h.PerformedBy)
+		sides[h.InventoryID] = h
+	}
+	assert.Equal(t, int32(10), sides[source.ID].QuantityBefore)
+	assert.Equal(t, int32(6), sides[source.ID].QuantityAfter)
+	assert.Equal(t, int32(2), sides[destination.ID].QuantityBefore)
+	assert.Equal(t, int32(6), sides[destination.ID].QuantityAfter)
+}
+
+func TestTransferStockCreatesDestinationItem(t *testing.T) {
+	source := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	repo := newMemoryRepository(source)
+	service := newTestInventoryService(repo)
+
+	transfer, err := service.TransferStock(context.Background(), "SKU-1", "store-1", "store-2", 3, "")
+	require.NoError(t, err)
+
+	assert.True(t, transfer.DestinationCreated)
+	created := repo.get(transfer.Destination.ID)
+	require.NotNil(t, created)
+	assert.Equal(t, "store-2", created.LocationID)
+	assert.Equal(t, "product-1", created.ProductID)
+	assert.Equal(t, int32(3), created.Quantity)
+	assert.Equal(t, "system", repo.history[0].PerformedBy, "transfers without a performer are the system's")
+}
+
+func TestTransferStockInsufficientStock(t *testing.T) {
+	source := domain.NewInventoryItem("product-1", 5, "SKU-1", "store-1")
+	require.True(t, source.Reserve(3))
+	destination := domain.NewInventoryItem("product-1", 2, "SKU-1", "store-2")
+	repo := newMemoryRepository(source, destination)
+	service := newTestInventoryService(repo)
+
+	// Five on hand but three reserved: only two are available
+	_, err := service.TransferStock(context.Background(), "SKU-1", "store-1", "store-2", 3, "staff-1")
+
+	require.ErrorIs(t, err, domain.ErrInsufficientStock)
+	assert.Equal(t, int32(5), repo.get(source.ID).Quantity)
+	assert.Equal(t, int32(2), repo.get(destination.ID).Quantity, "the destination is left untouched")
+	assert.Empty(t, repo.history)
+}
+
+func TestTransferStockValidatesInput(t *testing.T) {
+	service := newTestInventoryService(newMemoryRepository())
+
+	tests := []struct {
+		name     string
+		sku      string
+		from, to string
+		quantity int32
+	}{
+		{name: "no SKU", sku: " ", from: "store-1", to: "store-2", quantity: 1},
+		{name: "no source", sku: "SKU-1", to: "store-2", quantity: 1},
+		{name: "same location", sku: "SKU-1", from: "store-1", to: "store-1", quantity: 1},
+		{name: "zero quantity", sku: "SKU-1", from: "store-1", to: "store-2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.TransferStock(context.Background(), tt.sku, tt.from, tt.to, tt.quantity, "staff-1")
+			assert.ErrorIs(t, err, domain.ErrInvalidInput)
+		})
+	}
+}
+
+func TestTransferStockUnknownSource(t *testing.T) {
+	service := newTestInventoryService(newMemoryRepository())
+
+	_, err := service.TransferStock(context.Background(), "SKU-1", "store-1", "store-2", 1, "staff-1")
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
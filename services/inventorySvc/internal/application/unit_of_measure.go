@@ -0,0 +1,52 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// SetUnitOfMeasure sets the units an inventory item is sold and stocked in.
+// The item's quantities stay as they are: they are counted in the selling
+// unit, so changing the selling unit of an item that holds stock changes what
+// its counts mean and should be followed by a recount.
+func (s *InventoryService) SetUnitOfMeasure(ctx context.Context, id, sellingUnit, stockingUnit string, unitsPerStockingUnit int32) (*domain.InventoryItem, error) {
+	item, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inventory item: %w", err)
+	}
+	if item == nil {
+		return nil, domain.ErrNotFound
+	}
+
+	if err := item.SetUnitOfMeasure(sellingUnit, stockingUnit, unitsPerStockingUnit); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, item); err != nil {
+		return nil, fmt.Errorf("failed to update inventory item: %w", err)
+	}
+
+	s.logger.Info("Set inventory unit of measure",
+		zap.String("id", id),
+		zap.String("selling_unit", item.SellingUnit),
+		zap.String("stocking_unit", item.StockingUnit),
+		zap.Int32("units_per_stocking_unit", item.UnitsPerStockingUnit),
+	)
+	return item, nil
+}
+
+// ToSellingUnits converts a quantity of an item given in unit to the selling
+// units its stock is counted in
+func (s *InventoryService) ToSellingUnits(ctx context.Context, id string, quantity int32, unit string) (int32, error) {
+	item, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get inventory item: %w", err)
+	}
+	if item == nil {
+		return 0, domain.ErrNotFound
+	}
+	return item.ToSellingUnits(quantity, unit)
+}
@@ -0,0 +1,55 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+func updatedItem(id, locationID string, lastUpdated time.Time) *domain.InventoryItem {
+	item := domain.NewInventoryItem("product-"+id, 5, "SKU-"+id, locationID)
+	item.ID = id
+	item.LastUpdated = lastUpdated
+	return item
+}
+
+func TestListInventoryReturnsOnlyRecentlyUpdatedItems(t *testing.T) {
+	since := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	repo := newMemoryRepository(
+		updatedItem("stale", "warehouse-1", since.Add(-time.Hour)),
+		updatedItem("at-since", "warehouse-1", since),
+		updatedItem("later", "warehouse-1", since.Add(2*time.Hour)),
+		updatedItem("recent", "warehouse-1", since.Add(time.Hour)),
+		updatedItem("other-location", "warehouse-2", since.Add(time.Hour)),
+	)
+	service := newTestInventoryService(repo)
+
+	items, total, err := service.ListInventoryItemsByLocation(context.Background(), domain.InventoryFilter{
+		LocationID:   "warehouse-1",
+		UpdatedSince: since,
+	}, 10, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(2), total)
+	require.Len(t, items, 2)
+	assert.Equal(t, "recent", items[0].ID, "the oldest change comes first")
+	assert.Equal(t, "later", items[1].ID)
+}
+
+func TestListInventoryWithoutUpdatedSinceReturnsAllItems(t *testing.T) {
+	since := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	repo := newMemoryRepository(
+		updatedItem("stale", "warehouse-1", since.Add(-time.Hour)),
+		updatedItem("recent", "warehouse-1", since.Add(time.Hour)),
+	)
+	service := newTestInventoryService(repo)
+
+	_, total, err := service.ListInventoryItemsByLocation(context.Background(), domain.InventoryFilter{LocationID: "warehouse-1"}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+}
@@ -2,35 +2,109 @@ package config
 
 import (
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/grpclimits"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/mongoclient"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/shutdown"
 )
 
 // Config holds the application configuration
 type Config struct {
-	GRPCPort          string
-	MongoURI          string
-	Database          string
-	OrderSvcURL       string
+	GRPCPort      string
+	MongoURI      string
+	Database      string
+	OrderSvcURL   string
+	ProductSvcURL string
+	// ValidateProducts checks that new inventory items are for a real
+	// product with the given SKU
+	ValidateProducts  bool
 	DefaultLocationID string
+	// AllowBackorder lets stock removals take items below zero available
+	// unless the item's own backorder policy denies it
+	AllowBackorder bool
+	// ReservationReconcileInterval is how often order reservations are
+	// reconciled against the order service; zero leaves it to explicit requests
+	ReservationReconcileInterval time.Duration
+	// ReservationReconcileMinAge spares reservations updated more recently,
+	// whose orders may still be in the middle of being created
+	ReservationReconcileMinAge time.Duration
+	// ReservationTTL applies to order reservations made without a TTL; zero
+	// keeps them until released
+	ReservationTTL time.Duration
+	// ReservationMaxTTL is the longest TTL a reservation may ask for
+	ReservationMaxTTL time.Duration
+	// ReservationExpiryInterval is how often expired reservations are
+	// released; zero turns expiry off
+	ReservationExpiryInterval time.Duration
+	// KafkaBrokers enables stock changed events when set
+	KafkaBrokers []string
+	// StockEventsTopic is the topic stock changed events are published to
+	StockEventsTopic     string
+	Mongo                mongoclient.ConcernConfig
+	MongoPool            mongoclient.PoolConfig
+	GRPCLimits           grpclimits.MessageLimits
+	ShutdownDrainTimeout time.Duration // How long background workers get to finish on shutdown
 }
 
 // Load loads configuration from environment variables
 func Load(logger *zap.Logger) *Config {
 	cfg := &Config{
-		GRPCPort:          getEnv("GRPC_PORT", "50054"),
-		MongoURI:          getEnv("MONGO_URI", "mongodb://localhost:27017"),
-		Database:          getEnv("DATABASE_NAME", "stockplatform"),
-		OrderSvcURL:       getEnv("ORDER_SERVICE_URL", "order-service:50052"),
-		DefaultLocationID: getEnv("DEFAULT_LOCATION_ID", "store-001"),
+		GRPCPort:                     getEnv("GRPC_PORT", "50054"),
+		MongoURI:                     getEnv("MONGO_URI", "mongodb://localhost:27017"),
+		Database:                     getEnv("DATABASE_NAME", "stockplatform"),
+		OrderSvcURL:                  getEnv("ORDER_SERVICE_URL", "order-service:50052"),
+		ProductSvcURL:                getEnv("PRODUCT_SERVICE_URL", "product-service:50053"),
+		ValidateProducts:             getEnvBool("VALIDATE_INVENTORY_PRODUCTS", true),
+		DefaultLocationID:            getEnv("DEFAULT_LOCATION_ID", "store-001"),
+		AllowBackorder:               getEnvBool("INVENTORY_ALLOW_BACKORDER", false),
+		ReservationReconcileInterval: getEnvDuration(logger, "RESERVATION_RECONCILE_INTERVAL", 0),
+		ReservationReconcileMinAge:   getEnvDuration(logger, "RESERVATION_RECONCILE_MIN_AGE", 15*time.Minute),
+		ReservationTTL:               getEnvDuration(logger, "RESERVATION_TTL", 0),
+		ReservationMaxTTL:            getEnvDuration(logger, "RESERVATION_MAX_TTL", 7*24*time.Hour),
+		ReservationExpiryInterval:    getEnvDuration(logger, "RESERVATION_EXPIRY_INTERVAL", time.Minute),
+		KafkaBrokers:                 getEnvList("KAFKA_BROKERS"),
+		StockEventsTopic:             getEnv("STOCK_EVENTS_TOPIC", "inventory-events"),
+		Mongo:                        mongoclient.ConcernConfigFromEnv(),
+		MongoPool:                    mongoclient.PoolConfigFromEnv(mongoclient.DefaultPoolConfig()),
+		GRPCLimits:                   grpclimits.MessageLimitsFromEnv(grpclimits.DefaultMessageLimits()),
+		ShutdownDrainTimeout:         shutdown.DrainTimeoutFromEnv(shutdown.DefaultDrainTimeout),
+	}
+
+	if cfg.ReservationMaxTTL > 0 && cfg.ReservationTTL > cfg.ReservationMaxTTL {
+		logger.Warn("Default reservation TTL exceeds the maximum, using the maximum",
+			zap.Duration("reservation_ttl", cfg.ReservationTTL),
+			zap.Duration("reservation_max_ttl", cfg.ReservationMaxTTL),
+		)
+		cfg.ReservationTTL = cfg.ReservationMaxTTL
 	}
 
 	logger.Info("Configuration loaded",
 		zap.String("grpc_port", cfg.GRPCPort),
 		zap.String("mongo_uri", maskSensitive(cfg.MongoURI)),
 		zap.String("database", cfg.Database),
+		zap.String("mongo_critical_write_concern", cfg.Mongo.CriticalWriteConcern),
+		zap.String("mongo_report_read_preference", cfg.Mongo.ReportReadPreference),
+		zap.Uint64("mongo_max_pool_size", cfg.MongoPool.MaxPoolSize),
+		zap.Int("grpc_max_recv_msg_size", cfg.GRPCLimits.MaxRecvMsgSize),
+		zap.Int("grpc_max_send_msg_size", cfg.GRPCLimits.MaxSendMsgSize),
+		zap.Duration("shutdown_drain_timeout", cfg.ShutdownDrainTimeout),
 		zap.String("order_service_url", cfg.OrderSvcURL),
+		zap.String("product_service_url", cfg.ProductSvcURL),
+		zap.Bool("validate_products", cfg.ValidateProducts),
 		zap.String("default_location_id", cfg.DefaultLocationID),
+		zap.Bool("allow_backorder", cfg.AllowBackorder),
+		zap.Duration("reservation_reconcile_interval", cfg.ReservationReconcileInterval),
+		zap.Duration("reservation_reconcile_min_age", cfg.ReservationReconcileMinAge),
+		zap.Duration("reservation_ttl", cfg.ReservationTTL),
+		zap.Duration("reservation_max_ttl", cfg.ReservationMaxTTL),
+		zap.Duration("reservation_expiry_interval", cfg.ReservationExpiryInterval),
+		zap.Strings("kafka_brokers", cfg.KafkaBrokers),
+		zap.String("stock_events_topic", cfg.StockEventsTopic),
 	)
 
 	return cfg
@@ -44,6 +118,45 @@ func getEnv(key, fallback string) string {
 	return fallback
 }
 
+// getEnvList gets a comma-separated environment variable, skipping empty entries
+func getEnvList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// getEnvBool gets a boolean environment variable, falling back on an unset
+// or malformed value
+func getEnvBool(key string, fallback bool) bool {
+	if value, err := strconv.ParseBool(os.Getenv(key)); err == nil {
+		return value
+	}
+	return fallback
+}
+
+// getEnvDuration gets a duration environment variable, falling back on an
+// unset or malformed value
+func getEnvDuration(logger *zap.Logger, key string, fallback time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		logger.Warn("Invalid duration, using default",
+			zap.String("key", key),
+			zap.String("value", value),
+			zap.Duration("default", fallback),
+		)
+		return fallback
+	}
+	return d
+}
+
 // maskSensitive masks sensitive information for logging
 func maskSensitive(value string) string {
 	if len(value) > 20 {
@@ -9,6 +9,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.uber.org/zap"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/mongoclient"
 	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/config"
 	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
 	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/infrastructure/mongodb"
@@ -21,13 +22,17 @@ type Database struct {
 	InventoryRepo domain.InventoryRepository
 	LocationRepo  domain.LocationRepository
 	TransferRepo  domain.TransferRepository
-	logger        *zap.Logger
+	// BackInStockRepo stores back-in-stock subscriptions and queued notifications
+	BackInStockRepo domain.BackInStockRepository
+	// ReceiptRepo tracks what has been received against each purchase order
+	ReceiptRepo domain.PurchaseOrderReceiptRepository
+	logger      *zap.Logger
 }
 
 // Initialize creates and initializes the database layer
 func Initialize(cfg *config.Config, logger *zap.Logger) (*Database, error) {
 	// Create MongoDB client
-	client, err := createMongoClient(cfg.MongoURI, logger)
+	client, err := createMongoClient(cfg.MongoURI, cfg.Mongo, cfg.MongoPool, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -52,13 +57,47 @@ func Initialize(cfg *config.Config, logger *zap.Logger) (*Database, error) {
 
 	logger.Info("Successfully connected to MongoDB", zap.String("database", cfg.Database))
 
-	// Get database instance
+	// Get database instances. Critical writes go through a handle with a
+	// stronger write concern, report queries through one that may read from
+	// secondaries.
 	database := client.Database(cfg.Database)
 
+	criticalOpts, err := cfg.Mongo.CriticalDatabaseOptions()
+	if err != nil {
+		return nil, err
+	}
+	critical := client.Database(cfg.Database, criticalOpts)
+
+	reportOpts, err := cfg.Mongo.ReportDatabaseOptions()
+	if err != nil {
+		return nil, err
+	}
+	reports := client.Database(cfg.Database, reportOpts)
+
 	// Initialize repositories
-	inventoryRepo := mongodb.NewInventoryRepository(database, "inventory", logger)
+	inventoryRepo := mongodb.NewInventoryRepository(critical, reports, "inventory", logger)
 	locationRepo := mongodb.NewLocationRepository(database, "locations", logger)
 	transferRepo := mongodb.NewTransferRepository(database, "transfers", logger)
+	backInStockRepo := mongodb.NewBackInStockRepository(database, logger)
+	receiptRepo := mongodb.NewPurchaseOrderReceiptRepository(critical, "purchase_order_receipts", logger)
+
+	// Older versions of AdjustStock kept adjustments inside the item documents
+	migrateCtx, cancelMigrate := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancelMigrate()
+	migrated, err := mongodb.MigrateEmbeddedAdjustments(migrateCtx, critical, "inventory", logger)
+	if err != nil {
+		logger.Error("Failed to migrate embedded stock adjustments", zap.Error(err))
+	} else if migrated > 0 {
+		logger.Info("Migrated embedded stock adjustments to the history collection", zap.Int("items", migrated))
+	}
+
+	// Older versions kept a single order reservation slot per item
+	migrated, err = mongodb.MigrateOrderReservations(migrateCtx, critical, "inventory", logger)
+	if err != nil {
+		logger.Error("Failed to migrate order reservations", zap.Error(err))
+	} else if migrated > 0 {
+		logger.Info("Migrated order reservations to per-order records", zap.Int("items", migrated))
+	}
 
 	return &Database{
 		Client:        client,
@@ -66,7 +105,10 @@ func Initialize(cfg *config.Config, logger *zap.Logger) (*Database, error) {
 		InventoryRepo: inventoryRepo,
 		LocationRepo:  locationRepo,
 		TransferRepo:  transferRepo,
-		logger:        logger,
+
+		BackInStockRepo: backInStockRepo,
+		ReceiptRepo:     receiptRepo,
+		logger:          logger,
 	}, nil
 }
 
@@ -86,7 +128,7 @@ func (d *Database) Close() error {
 }
 
 // createMongoClient creates a MongoDB client with proper configuration
-func createMongoClient(mongoURI string, logger *zap.Logger) (*mongo.Client, error) {
+func createMongoClient(mongoURI string, mongoCfg mongoclient.ConcernConfig, pool mongoclient.PoolConfig, logger *zap.Logger) (*mongo.Client, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -95,10 +137,11 @@ func createMongoClient(mongoURI string, logger *zap.Logger) (*mongo.Client, erro
 	// Set up client options with timeouts and retry settings
 	clientOptions := options.Client()
 	clientOptions.ApplyURI(mongoURI)
-	clientOptions.SetConnectTimeout(10 * time.Second)
-	clientOptions.SetSocketTimeout(30 * time.Second)
-	clientOptions.SetServerSelectionTimeout(10 * time.Second)
-	clientOptions.SetMaxPoolSize(10)
+	pool.Apply(clientOptions)
+	if err := mongoCfg.Apply(clientOptions); err != nil {
+		logger.Error("Invalid MongoDB concern configuration", zap.Error(err))
+		return nil, err
+	}
 
 	client, err := mongo.Connect(ctx, clientOptions)
 	if err != nil {
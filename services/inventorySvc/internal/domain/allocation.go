@@ -0,0 +1,197 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// AllocationStrategy selects how an order's lines are spread over locations
+type AllocationStrategy string
+
+const (
+	// AllocationMinimizeShipments reserves from as few locations as possible
+	AllocationMinimizeShipments AllocationStrategy = "MINIMIZE_SHIPMENTS"
+	// AllocationPreferLocation reserves as much as possible at a preferred
+	// location and covers the rest from as few other locations as possible
+	AllocationPreferLocation AllocationStrategy = "PREFER_LOCATION"
+)
+
+// AllocationLine is a quantity of one product an order needs
+type AllocationLine struct {
+	ProductID string
+	Quantity  int32
+}
+
+// Allocation is the part of a product's demand reserved on one inventory item
+type Allocation struct {
+	ProductID       string
+	LocationID      string
+	InventoryItemID string
+	Quantity        int32
+}
+
+// AllocationPlan is where each line of an order is reserved
+type AllocationPlan struct {
+	OrderID     string
+	Strategy    AllocationStrategy
+	Allocations []Allocation
+	// Shipments is the number of distinct locations the order ships from
+	Shipments int
+	// ExpiresAt is when the reservations lapse; zero when they do not
+	ExpiresAt time.Time
+}
+
+// AllocationShortfall is the demand for a product no location can cover
+type AllocationShortfall struct {
+	ProductID string
+	Requested int32
+	Available int32
+}
+
+// AllocationError is returned when an order cannot be allocated in full.
+// Nothing is reserved when it is returned.
+type AllocationError struct {
+	Shortfalls []AllocationShortfall
+}
+
+func (e *AllocationError) Error() string {
+	parts := make([]string, len(e.Shortfalls))
+	for i, s := range e.Shortfalls {
+		parts[i] = fmt.Sprintf("%s (requested %d, available %d)", s.ProductID, s.Requested, s.Available)
+	}
+	return "insufficient stock across locations for " + strings.Join(parts, ", ")
+}
+
+func (e *AllocationError) Unwrap() error {
+	return ErrInsufficientStock
+}
+
+// PlanAllocation decides where to reserve lines given the inventory items that
+// stock their products. It does not change the items.
+//
+// Locations are picked greedily: each round takes the location that completes
+// the most of the remaining lines, then the one that covers the most remaining
+// units, and reserves everything it can there. A location that can ship the
+// whole order is therefore always picked alone. With AllocationPreferLocation
+// the preferred location is taken first whatever it covers.
+func PlanAllocation(lines []AllocationLine, items []*InventoryItem, strategy AllocationStrategy, preferredLocationID string) (*AllocationPlan, error) {
+	switch strategy {
+	case AllocationMinimizeShipments:
+	case AllocationPreferLocation:
+		if preferredLocationID == "" {
+			return nil, fmt.Errorf("%w: a preferred location is required for %s", ErrInvalidInput, strategy)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown allocation strategy %q", ErrInvalidInput, strategy)
+	}
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("%w: at least one line is required", ErrInvalidInput)
+	}
+
+	// Combine lines for the same product, keeping their order
+	remaining := make(map[string]int32, len(lines))
+	var products []string
+	for _, line := range lines {
+		if line.ProductID == "" {
+			return nil, fmt.Errorf("%w: product ID is required for every line", ErrInvalidInput)
+		}
+		if line.Quantity <= 0 {
+			return nil, fmt.Errorf("%w: quantity must be positive for product %s", ErrInvalidInput, line.ProductID)
+		}
+		if _, ok := remaining[line.ProductID]; !ok {
+			products = append(products, line.ProductID)
+		}
+		remaining[line.ProductID] += line.Quantity
+	}
+	requested := make(map[string]int32, len(remaining))
+	for productID, quantity := range remaining {
+		requested[productID] = quantity
+	}
+
+	// stock[location][product] is the item holding the product there
+	stock := make(map[string]map[string]*InventoryItem)
+	for _, item := range items {
+		if _, wanted := remaining[item.ProductID]; !wanted || item.GetAvailable() <= 0 {
+			continue
+		}
+		if stock[item.LocationID] == nil {
+			stock[item.LocationID] = make(map[string]*InventoryItem)
+		}
+		// Duplicate rows for a product at a location: use the fullest
+		if current := stock[item.LocationID][item.ProductID]; current == nil || item.GetAvailable() > current.GetAvailable() {
+			stock[item.LocationID][item.ProductID] = item
+		}
+	}
+
+	plan := &AllocationPlan{Strategy: strategy}
+	take := func(locationID string) {
+		for _, productID := range products {
+			item := stock[locationID][productID]
+			if item == nil || remaining[productID] == 0 {
+				continue
+			}
+			quantity := min(remaining[productID], item.GetAvailable())
+			remaining[productID] -= quantity
+			plan.Allocations = append(plan.Allocations, Allocation{
+				ProductID:       productID,
+				LocationID:      locationID,
+				InventoryItemID: item.ID,
+				Quantity:        quantity,
+			})
+		}
+		delete(stock, locationID)
+		plan.Shipments++
+	}
+
+	if strategy == AllocationPreferLocation && stock[preferredLocationID] != nil {
+		take(preferredLocationID)
+	}
+	for {
+		best, bestLines, bestUnits := "", 0, int32(0)
+		for locationID, held := range stock {
+			lines, units := 0, int32(0)
+			for productID, item := range held {
+				if remaining[productID] == 0 {
+					continue
+				}
+				covered := min(remaining[productID], item.GetAvailable())
+				units += covered
+				if covered == remaining[productID] {
+					lines++
+				}
+			}
+			if units == 0 {
+				continue
+			}
+			if lines > bestLines || (lines == bestLines && units > bestUnits) ||
+				(lines == bestLines && units == bestUnits && locationID < best) {
+				best, bestLines, bestUnits = locationID, lines, units
+			}
+		}
+		if best == "" {
+			break
+		}
+		take(best)
+	}
+
+	var shortfalls []AllocationShortfall
+	for _, productID := range products {
+		if remaining[productID] > 0 {
+			shortfalls = append(shortfalls, AllocationShortfall{
+				ProductID: productID,
+				Requested: requested[productID],
+				Available: requested[productID] - remaining[productID],
+			})
+		}
+	}
+	if len(shortfalls) > 0 {
+		return nil, &AllocationError{Shortfalls: shortfalls}
+	}
+
+	sort.SliceStable(plan.Allocations, func(a, b int) bool {
+		return plan.Allocations[a].LocationID < plan.Allocations[b].LocationID
+	})
+	return plan, nil
+}
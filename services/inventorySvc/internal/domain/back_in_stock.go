@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// BackInStockSubscription records that a user wants to hear when a product is available again
+type BackInStockSubscription struct {
+	ID        string    `bson:"_id" json:"id"`
+	UserID    string    `bson:"user_id" json:"user_id"`
+	ProductID string    `bson:"product_id" json:"product_id"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+}
+
+// NewBackInStockSubscription creates a subscription. The ID is derived from the
+// user and product so that subscribing twice resolves to the same record.
+func NewBackInStockSubscription(userID, productID string) *BackInStockSubscription {
+	return &BackInStockSubscription{
+		ID:        userID + ":" + productID,
+		UserID:    userID,
+		ProductID: productID,
+		CreatedAt: time.Now(),
+	}
+}
+
+// NotificationStatusPending marks a notification that has not been sent yet
+const NotificationStatusPending = "PENDING"
+
+// BackInStockNotification is a queued back-in-stock alert for a user
+type BackInStockNotification struct {
+	ID             string    `bson:"_id" json:"id"`
+	SubscriptionID string    `bson:"subscription_id" json:"subscription_id"`
+	UserID         string    `bson:"user_id" json:"user_id"`
+	ProductID      string    `bson:"product_id" json:"product_id"`
+	Available      int32     `bson:"available" json:"available"`
+	Status         string    `bson:"status" json:"status"`
+	CreatedAt      time.Time `bson:"created_at" json:"created_at"`
+}
+
+// NewBackInStockNotification creates a pending notification for a subscription.
+// The ID is tied to the subscription instance, so a subscription can only ever
+// be turned into one notification even if the same stock event is seen twice.
+func NewBackInStockNotification(sub *BackInStockSubscription, available int32) *BackInStockNotification {
+	return &BackInStockNotification{
+		ID:             fmt.Sprintf("%s:%d", sub.ID, sub.CreatedAt.UnixNano()),
+		SubscriptionID: sub.ID,
+		UserID:         sub.UserID,
+		ProductID:      sub.ProductID,
+		Available:      available,
+		Status:         NotificationStatusPending,
+		CreatedAt:      time.Now(),
+	}
+}
+
+// BackInStockRepository persists back-in-stock subscriptions and the notification queue
+type BackInStockRepository interface {
+	// Subscribe stores a subscription unless one already exists for the user and
+	// product. It returns the stored subscription and whether it was newly created.
+	Subscribe(ctx context.Context, sub *BackInStockSubscription) (*BackInStockSubscription, bool, error)
+
+	// Unsubscribe removes the subscription of a user to a product
+	Unsubscribe(ctx context.Context, userID, productID string) error
+
+	// ListByProduct returns all subscriptions for a product
+	ListByProduct(ctx context.Context, productID string) ([]*BackInStockSubscription, error)
+
+	// Delete removes a fired subscription. Only the given instance is removed, so
+	// a user who subscribed again in the meantime keeps the new subscription.
+	Delete(ctx context.Context, sub *BackInStockSubscription) error
+
+	// EnqueueNotification queues a notification. It returns ErrDuplicateEntity
+	// when the notification was already queued.
+	EnqueueNotification(ctx context.Context, notification *BackInStockNotification) error
+}
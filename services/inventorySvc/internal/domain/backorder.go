@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// BackorderPolicy decides whether removing stock may take an item's available
+// quantity below zero. The shortfall is the item's backordered quantity, which
+// incoming stock fills first.
+type BackorderPolicy string
+
+const (
+	// BackorderPolicyDefault follows the service-wide setting
+	BackorderPolicyDefault BackorderPolicy = ""
+	// BackorderPolicyAllow lets the item be backordered
+	BackorderPolicyAllow BackorderPolicy = "allow"
+	// BackorderPolicyDeny rejects removals the item does not hold the stock for
+	BackorderPolicyDeny BackorderPolicy = "deny"
+)
+
+// ParseBackorderPolicy reads a backorder policy; empty means the default
+func ParseBackorderPolicy(s string) (BackorderPolicy, error) {
+	switch policy := BackorderPolicy(strings.ToLower(strings.TrimSpace(s))); policy {
+	case BackorderPolicyDefault, BackorderPolicyAllow, BackorderPolicyDeny:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("%w: unknown backorder policy %q", ErrInvalidInput, s)
+	}
+}
+
+// AllowsBackorder reports whether the item may be backordered, given whether
+// items without a policy of their own may be
+func (i *InventoryItem) AllowsBackorder(allowByDefault bool) bool {
+	switch i.BackorderPolicy {
+	case BackorderPolicyAllow:
+		return true
+	case BackorderPolicyDeny:
+		return false
+	default:
+		return allowByDefault
+	}
+}
+
+// Backordered returns how many units were removed beyond the item's
+// available stock and are still owed
+func (i *InventoryItem) Backordered() int32 {
+	if available := i.GetAvailable(); available < 0 {
+		return -available
+	}
+	return 0
+}
+
+// RemoveStockOrBackorder removes stock like RemoveStock but, with
+// allowBackorder, takes the item into backorder rather than refusing a
+// removal it does not hold the stock for. It returns how many of the units
+// were backordered, and false when the removal was refused.
+func (i *InventoryItem) RemoveStockOrBackorder(quantity int32, allowBackorder bool) (int32, bool) {
+	if !allowBackorder {
+		return 0, i.RemoveStock(quantity)
+	}
+	before := i.Backordered()
+	i.Quantity -= quantity
+	i.LastUpdated = time.Now()
+	return i.Backordered() - before, true
+}
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllowsBackorder(t *testing.T) {
+	tests := []struct {
+		policy         BackorderPolicy
+		allowByDefault bool
+		want           bool
+	}{
+		{policy: BackorderPolicyDefault, allowByDefault: true, want: true},
+		{policy: BackorderPolicyDefault, allowByDefault: false, want: false},
+		{policy: BackorderPolicyAllow, allowByDefault: false, want: true},
+		{policy: BackorderPolicyDeny, allowByDefault: true, want: false},
+	}
+	for _, tt := range tests {
+		item := &InventoryItem{BackorderPolicy: tt.policy}
+		assert.Equal(t, tt.want, item.AllowsBackorder(tt.allowByDefault), "policy %q, allowed by default %v", tt.policy, tt.allowByDefault)
+	}
+}
+
+func TestRemoveStockOrBackorderAtTheBoundary(t *testing.T) {
+	tests := []struct {
+		name            string
+		quantity        int32
+		allowBackorder  bool
+		wantOK          bool
+		wantQuantity    int32
+		wantBackordered int32
+	}{
+		{name: "all stock, denied", quantity: 5, wantOK: true, wantQuantity: 0},
+		{name: "all stock, allowed", quantity: 5, allowBackorder: true, wantOK: true, wantQuantity: 0},
+		{name: "one more, denied", quantity: 6, wantQuantity: 5},
+		{name: "one more, allowed", quantity: 6, allowBackorder: true, wantOK: true, wantQuantity: -1, wantBackordered: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := NewInventoryItem("product-1", 5, "LAMP-01", "warehouse-1")
+
+			backordered, ok := item.RemoveStockOrBackorder(tt.quantity, tt.allowBackorder)
+
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantBackordered, backordered)
+			assert.Equal(t, tt.wantQuantity, item.Quantity)
+			assert.Equal(t, tt.wantBackordered, item.Backordered())
+		})
+	}
+}
+
+func TestRemoveStockOrBackorderCountsOnlyNewShortfall(t *testing.T) {
+	item := NewInventoryItem("product-1", 2, "LAMP-01", "warehouse-1")
+
+	backordered, ok := item.RemoveStockOrBackorder(3, true)
+	require.True(t, ok)
+	assert.Equal(t, int32(1), backordered)
+
+	backordered, ok = item.RemoveStockOrBackorder(2, true)
+	require.True(t, ok)
+	assert.Equal(t, int32(2), backordered, "only the units added to the backorder count")
+	assert.Equal(t, int32(3), item.Backordered())
+}
+
+func TestParseBackorderPolicy(t *testing.T) {
+	for input, want := range map[string]BackorderPolicy{"": BackorderPolicyDefault, " Allow ": BackorderPolicyAllow, "DENY": BackorderPolicyDeny} {
+		policy, err := ParseBackorderPolicy(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, want, policy, input)
+	}
+
+	_, err := ParseBackorderPolicy("sometimes")
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
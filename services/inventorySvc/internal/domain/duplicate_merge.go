@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// DuplicateMerge reports how the inventory items sharing one SKU at a location
+// were consolidated into a single item
+type DuplicateMerge struct {
+	SKU           string
+	KeptItemID    string
+	MergedItemIDs []string
+	Quantity      int32
+	Reserved      int32
+	Damaged       int32
+	OrderIDs      []string // Orders whose active reservations now point at the kept item
+}
+
+// Absorb adds the stock, reservations and handling details of dup to the item
+// so that dup can be deleted. The active order reservations of dup move to
+// the item, adding to the item's own record when both hold stock for the
+// same order.
+func (i *InventoryItem) Absorb(dup *InventoryItem) {
+	i.Quantity += dup.Quantity
+	i.Reserved += dup.Reserved
+	i.Damaged += dup.Damaged
+
+	for _, moved := range dup.ActiveReservations() {
+		r := i.ReservationFor(moved.OrderID)
+		switch {
+		case r == nil:
+			i.Reservations = append(i.Reservations, moved)
+		case !r.Active():
+			*r = moved
+		default:
+			r.Quantity += moved.Quantity
+			// The merged reservation lapses when the later of the two does
+			if r.ExpiresAt.IsZero() || moved.ExpiresAt.IsZero() {
+				r.ExpiresAt = time.Time{}
+			} else if moved.ExpiresAt.After(r.ExpiresAt) {
+				r.ExpiresAt = moved.ExpiresAt
+			}
+			if moved.UpdatedAt.After(r.UpdatedAt) {
+				r.UpdatedAt = moved.UpdatedAt
+			}
+		}
+	}
+
+	if i.ShelfLocation == "" {
+		i.ShelfLocation = dup.ShelfLocation
+	}
+	for _, tag := range dup.Tags {
+		if !containsTag(i.Tags, tag) {
+			i.Tags = append(i.Tags, tag)
+		}
+	}
+
+	i.AddNote(fmt.Sprintf("Merged duplicate item %s (%d units, %d reserved)", dup.ID, dup.Quantity, dup.Reserved))
+	i.LastUpdated = time.Now()
+}
+
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
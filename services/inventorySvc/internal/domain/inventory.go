@@ -2,6 +2,7 @@ package domain
 
 import (
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -122,19 +123,28 @@ type InventoryItem struct {
 	ProductID         string    `bson:"product_id"`
 	Quantity          int32     `bson:"quantity"`
 	Reserved          int32     `bson:"reserved"`
+	Damaged           int32     `bson:"damaged,omitempty"` // Returned stock held back from sale because of its condition
 	SKU               string    `bson:"sku"`
 	LocationID        string    `bson:"location_id"`
 	ShelfLocation     string    `bson:"shelf_location,omitempty"` // For precise in-store location (aisle/shelf/bin)
+	Tags              []string  `bson:"tags,omitempty"`           // Handling tags such as "hazmat", "fragile" or "cold-chain"
+	// Unit of measure; see unit_of_measure.go. Quantities are in SellingUnit.
+	SellingUnit          string `bson:"selling_unit,omitempty"`
+	StockingUnit         string `bson:"stocking_unit,omitempty"`
+	UnitsPerStockingUnit int32  `bson:"units_per_stocking_unit,omitempty"`
 	MinimumStock      int32     `bson:"minimum_stock,omitempty"`
 	MaximumStock      int32     `bson:"maximum_stock,omitempty"`
 	ReorderPoint      int32     `bson:"reorder_point,omitempty"`
 	ReorderQuantity   int32     `bson:"reorder_quantity,omitempty"`
 	LastCountDate     time.Time `bson:"last_count_date,omitempty"`
 	NextCountDate     time.Time `bson:"next_count_date,omitempty"`
-	OrderID           string    `bson:"order_id,omitempty"`       // Order ID if this item is reserved for an order
-	ReservedQuantity  int32     `bson:"reserved_quantity,omitempty"` // Quantity reserved for a specific order
-	ReservationStatus string    `bson:"reservation_status,omitempty"` // Status of reservation: active, fulfilled, cancelled, expired
+	// Reservations holds one record per order the item has reserved stock
+	// for; see ItemReservation. Reserved also counts units reserved without
+	// an order.
+	Reservations      []ItemReservation `bson:"reservations,omitempty"`
 	ReservationNotes  string    `bson:"reservation_notes,omitempty"` // Notes related to the reservation
+	// BackorderPolicy overrides whether the item may be backordered; see backorder.go
+	BackorderPolicy BackorderPolicy `bson:"backorder_policy,omitempty"`
 	LastUpdated       time.Time `bson:"last_updated"`
 	CreatedAt         time.Time `bson:"created_at"`
 }
@@ -158,6 +168,45 @@ func NewInventoryItem(productID string, quantity int32, sku string, locationID s
 	}
 }
 
+// Stock statuses accepted by InventoryFilter
+const (
+	StockStatusAll        = "all"
+	StockStatusInStock    = "in_stock"
+	StockStatusLowStock   = "low_stock"
+	StockStatusOutOfStock = "out_of_stock"
+)
+
+// InventoryFilter narrows an inventory listing; zero values match everything
+type InventoryFilter struct {
+	LocationID  string
+	StockStatus string   // One of the StockStatus constants
+	Tags        []string // Items must carry all of these tags
+	// CountDueBy, when set, keeps only items whose next count date is on or
+	// before it; they are listed by count date instead of SKU
+	CountDueBy time.Time
+	// UpdatedSince, when set, keeps only items modified after it; they are
+	// listed oldest change first so an incremental sync can page through them
+	UpdatedSince time.Time
+}
+
+// NormalizeTags trims and lowercases tags, dropping empty and duplicate ones
+func NormalizeTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
+
 // IsAvailable checks if there's enough quantity available
 func (i *InventoryItem) IsAvailable(requestedQuantity int32) bool {
 	return (i.Quantity - i.Reserved) >= requestedQuantity
@@ -190,6 +239,13 @@ func (i *InventoryItem) AddStock(quantity int32) {
 	i.LastUpdated = time.Now()
 }
 
+// AddDamagedStock puts stock in the damaged bucket, where it does not count
+// towards the quantity available for sale
+func (i *InventoryItem) AddDamagedStock(quantity int32) {
+	i.Damaged += quantity
+	i.LastUpdated = time.Now()
+}
+
 // RemoveStock removes stock from inventory
 // Returns true if successful, false if not enough inventory
 func (i *InventoryItem) RemoveStock(quantity int32) bool {
@@ -240,12 +296,6 @@ func (i *InventoryItem) ScheduleInventoryCount(nextCountDate time.Time) {
 	i.LastUpdated = time.Now()
 }
 
-// SetReservationStatus updates the reservation status of an inventory item
-func (i *InventoryItem) SetReservationStatus(status string) {
-	i.ReservationStatus = status
-	i.LastUpdated = time.Now()
-}
-
 // TransferStock transfers quantity to another inventory item
 // Returns error if not enough available stock
 func (i *InventoryItem) TransferStock(quantity int32, destination *InventoryItem) error {
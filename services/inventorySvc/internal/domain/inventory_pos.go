@@ -41,24 +41,227 @@ func (i *InventoryItem) AddNote(note string) {
 	i.LastUpdated = time.Now()
 }
 
-// ReserveForOrder reserves inventory for a specific order ID
+// ItemReservation is the stock an inventory item holds for one order. An
+// item keeps a record per order, so reservations of different orders never
+// overwrite each other. Records that are no longer active hold no units and
+// are kept for ReservationRecordRetention.
+type ItemReservation struct {
+	OrderID  string `bson:"order_id"`
+	Quantity int32  `bson:"quantity"`
+	Status   string `bson:"status"` // active, fulfilled, cancelled or expired
+	// ExpiresAt is when an active reservation lapses and its units go back to
+	// available stock; zero for reservations that never do
+	ExpiresAt time.Time `bson:"expires_at,omitempty"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+// ReservationRecordRetention is how long a fulfilled, cancelled or expired
+// reservation record stays on its item
+const ReservationRecordRetention = 30 * 24 * time.Hour
+
+// Active reports whether the reservation still holds stock
+func (r *ItemReservation) Active() bool {
+	return r.Status == ReservationStatusActive
+}
+
+// ReservationFor returns the item's reservation record for orderID, or nil
+// when the item never reserved stock for that order
+func (i *InventoryItem) ReservationFor(orderID string) *ItemReservation {
+	for idx := range i.Reservations {
+		if i.Reservations[idx].OrderID == orderID {
+			return &i.Reservations[idx]
+		}
+	}
+	return nil
+}
+
+// ActiveReservations returns the reservation records still holding stock
+func (i *InventoryItem) ActiveReservations() []ItemReservation {
+	var active []ItemReservation
+	for _, r := range i.Reservations {
+		if r.Active() {
+			active = append(active, r)
+		}
+	}
+	return active
+}
+
+// UnassignedReserved returns the reserved units that belong to no order,
+// i.e. those reserved through ReserveStock rather than ReserveForOrder
+func (i *InventoryItem) UnassignedReserved() int32 {
+	unassigned := i.Reserved
+	for _, r := range i.ActiveReservations() {
+		unassigned -= r.Quantity
+	}
+	if unassigned < 0 {
+		return 0
+	}
+	return unassigned
+}
+
+// ReserveForOrder reserves inventory for a specific order ID until expiresAt,
+// or indefinitely when expiresAt is zero. Reserving more for the same order
+// adds to its record and moves the expiry to expiresAt.
 // Returns true if successful, false if not enough inventory
-func (i *InventoryItem) ReserveForOrder(quantity int32, orderID string) bool {
+func (i *InventoryItem) ReserveForOrder(quantity int32, orderID string, expiresAt time.Time) bool {
 	if !i.IsAvailable(quantity) {
 		return false
 	}
-	
+
+	now := time.Now()
+	i.pruneReservations(now)
+	r := i.ReservationFor(orderID)
+	if r == nil {
+		i.Reservations = append(i.Reservations, ItemReservation{OrderID: orderID})
+		r = &i.Reservations[len(i.Reservations)-1]
+	} else if !r.Active() {
+		r.Quantity = 0
+	}
+	r.Quantity += quantity
+	r.Status = ReservationStatusActive
+	r.ExpiresAt = expiresAt
+	r.UpdatedAt = now
 	i.Reserved += quantity
-	i.ReservationStatus = ReservationStatusActive
-	i.OrderID = orderID
-	i.LastUpdated = time.Now()
-	
+	i.LastUpdated = now
+
 	// Add a note about the reservation
 	i.AddNote(fmt.Sprintf("Reserved %d units for order %s", quantity, orderID))
-	
+
 	return true
 }
 
+// CancelOrderReservation takes back up to quantity units that ReserveForOrder
+// reserved for orderID, e.g. when a multi-item reservation is rolled back.
+// Only the order's own units are released; the record becomes cancelled once
+// it holds none. It returns the quantity released.
+func (i *InventoryItem) CancelOrderReservation(quantity int32, orderID string) int32 {
+	r := i.ReservationFor(orderID)
+	if r == nil || !r.Active() || quantity <= 0 {
+		return 0
+	}
+	if quantity > r.Quantity {
+		quantity = r.Quantity
+	}
+
+	i.ReleaseReservation(quantity)
+	r.Quantity -= quantity
+	if r.Quantity == 0 {
+		r.Status = ReservationStatusCancelled
+	}
+	r.UpdatedAt = i.LastUpdated
+	i.AddNote(fmt.Sprintf("Cancelled reservation of %d units for order %s", quantity, orderID))
+	return quantity
+}
+
+// ExpireOrderReservation gives the units the item holds for orderID back to
+// available stock because the reservation lapsed. It returns the quantity
+// released.
+func (i *InventoryItem) ExpireOrderReservation(orderID string) int32 {
+	r := i.ReservationFor(orderID)
+	if r == nil || !r.Active() {
+		return 0
+	}
+
+	quantity := r.Quantity
+	i.ReleaseReservation(quantity)
+	r.Quantity = 0
+	r.Status = ReservationStatusExpired
+	r.UpdatedAt = i.LastUpdated
+	i.AddNote(fmt.Sprintf("Reservation of %d units for order %s expired", quantity, orderID))
+	return quantity
+}
+
+// FulfillOrderReservation deducts quantity units of the order's reservation
+// from stock, or all of them when quantity is zero. The record becomes
+// fulfilled once it holds no units. It returns the quantity fulfilled.
+func (i *InventoryItem) FulfillOrderReservation(orderID string, quantity int32) (int32, error) {
+	r := i.ReservationFor(orderID)
+	if r == nil || !r.Active() {
+		return 0, ErrReservationNotFound
+	}
+	if quantity == 0 {
+		quantity = r.Quantity
+	}
+	if quantity < 0 || quantity > r.Quantity || !i.FulfillReservation(quantity) {
+		return 0, ErrInsufficientReservation
+	}
+
+	r.Quantity -= quantity
+	if r.Quantity == 0 {
+		r.Status = ReservationStatusFulfilled
+	}
+	r.UpdatedAt = i.LastUpdated
+	i.AddNote(fmt.Sprintf("Fulfilled reservation of %d units for order %s", quantity, orderID))
+	return quantity, nil
+}
+
+// pruneReservations drops records that stopped holding stock more than
+// ReservationRecordRetention ago
+func (i *InventoryItem) pruneReservations(now time.Time) {
+	kept := i.Reservations[:0]
+	for _, r := range i.Reservations {
+		if r.Active() || now.Sub(r.UpdatedAt) < ReservationRecordRetention {
+			kept = append(kept, r)
+		}
+	}
+	i.Reservations = kept
+}
+
+// StockRequest is a quantity of one inventory item to reserve
+type StockRequest struct {
+	InventoryItemID string
+	Quantity        int32
+}
+
+// ReservationError reports the item that stopped a multi-item reservation.
+// Nothing of the reservation is held when it is returned.
+type ReservationError struct {
+	InventoryItemID string
+	Err             error
+}
+
+func (e *ReservationError) Error() string {
+	return fmt.Sprintf("failed to reserve inventory item %s: %v", e.InventoryItemID, e.Err)
+}
+
+func (e *ReservationError) Unwrap() error {
+	return e.Err
+}
+
+// OrderReservation describes the stock an inventory item holds for an order
+type OrderReservation struct {
+	InventoryItemID string
+	ProductID       string
+	SKU             string
+	LocationID      string
+	OrderID         string
+	Quantity        int32
+	Status          string
+	UpdatedAt       time.Time
+	ExpiresAt       time.Time // Zero when the reservation does not expire
+}
+
+// OrderReservation returns the reservation this item holds for orderID, or
+// nil when the item was never reserved for that order
+func (i *InventoryItem) OrderReservation(orderID string) *OrderReservation {
+	r := i.ReservationFor(orderID)
+	if r == nil {
+		return nil
+	}
+
+	return &OrderReservation{
+		InventoryItemID: i.ID,
+		ProductID:       i.ProductID,
+		SKU:             i.SKU,
+		LocationID:      i.LocationID,
+		OrderID:         r.OrderID,
+		Quantity:        r.Quantity,
+		Status:          r.Status,
+		UpdatedAt:       r.UpdatedAt,
+		ExpiresAt:       r.ExpiresAt,
+	}
+}
+
 // GetAvailable returns the available quantity (total minus reserved)
 func (i *InventoryItem) GetAvailable() int32 {
 	return i.Quantity - i.Reserved
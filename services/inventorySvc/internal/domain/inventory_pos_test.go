@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReserveForOrderKeepsARecordPerOrder(t *testing.T) {
+	item := NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+
+	require.True(t, item.ReserveForOrder(3, "order-a", time.Time{}))
+	require.True(t, item.ReserveForOrder(4, "order-b", time.Time{}))
+	require.True(t, item.ReserveForOrder(1, "order-a", time.Time{}))
+	require.True(t, item.Reserve(2))
+
+	assert.Equal(t, int32(10), item.Reserved)
+	assert.Equal(t, int32(4), item.ReservationFor("order-a").Quantity)
+	assert.Equal(t, int32(4), item.ReservationFor("order-b").Quantity)
+	assert.Equal(t, int32(2), item.UnassignedReserved())
+	assert.False(t, item.ReserveForOrder(1, "order-c", time.Time{}))
+}
+
+func TestCancelOrderReservationIsCappedToTheOrder(t *testing.T) {
+	item := NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	item.ReserveForOrder(3, "order-a", time.Time{})
+	item.ReserveForOrder(4, "order-b", time.Time{})
+
+	assert.Equal(t, int32(3), item.CancelOrderReservation(10, "order-a"))
+	assert.Equal(t, int32(0), item.CancelOrderReservation(1, "order-a"), "a cancelled record holds nothing")
+	assert.Equal(t, int32(0), item.CancelOrderReservation(1, "order-unknown"))
+	assert.Equal(t, int32(4), item.Reserved)
+	assert.Equal(t, ReservationStatusCancelled, item.ReservationFor("order-a").Status)
+}
+
+func TestExpireOrderReservationLeavesOtherOrders(t *testing.T) {
+	item := NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	item.ReserveForOrder(3, "order-a", time.Now().Add(-time.Minute))
+	item.ReserveForOrder(4, "order-b", time.Now().Add(time.Hour))
+
+	assert.Equal(t, int32(3), item.ExpireOrderReservation("order-a"))
+	assert.Equal(t, int32(4), item.Reserved)
+	assert.Equal(t, ReservationStatusExpired, item.ReservationFor("order-a").Status)
+	assert.Len(t, item.ActiveReservations(), 1)
+}
+
+func TestReserveForOrderPrunesOldRecords(t *testing.T) {
+	item := NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	item.ReserveForOrder(3, "order-a", time.Time{})
+	item.CancelOrderReservation(3, "order-a")
+	item.Reservations[0].UpdatedAt = time.Now().Add(-ReservationRecordRetention - time.Hour)
+
+	item.ReserveForOrder(1, "order-b", time.Time{})
+
+	assert.Nil(t, item.ReservationFor("order-a"))
+	assert.NotNil(t, item.ReservationFor("order-b"))
+}
+
+func TestAbsorbMergesReservationsPerOrder(t *testing.T) {
+	kept := NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	kept.ReserveForOrder(3, "order-a", time.Time{})
+	dup := NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	dup.ReserveForOrder(2, "order-a", time.Time{})
+	dup.ReserveForOrder(4, "order-b", time.Time{})
+
+	kept.Absorb(dup)
+
+	assert.Equal(t, int32(20), kept.Quantity)
+	assert.Equal(t, int32(9), kept.Reserved)
+	assert.Equal(t, int32(5), kept.ReservationFor("order-a").Quantity)
+	assert.Equal(t, int32(4), kept.ReservationFor("order-b").Quantity)
+}
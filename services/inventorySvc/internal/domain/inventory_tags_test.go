@@ -0,0 +1,13 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	assert.Equal(t, []string{"fragile", "cold-chain"}, NormalizeTags([]string{" Fragile ", "", "COLD-CHAIN", "fragile"}))
+	assert.Empty(t, NormalizeTags([]string{" ", ""}))
+	assert.Nil(t, NormalizeTags(nil))
+}
@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
 	"github.com/stretchr/testify/mock"
@@ -99,6 +100,24 @@ func (m *MockInventoryRepository) ListLowStock(ctx context.Context, limit int, o
 	return args.Get(0).([]*domain.InventoryItem), args.Error(1)
 }
 
+func (m *MockInventoryRepository) ListFiltered(ctx context.Context, filter domain.InventoryFilter, limit int, offset int) ([]*domain.InventoryItem, int64, error) {
+	args := m.Called(ctx, filter, limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*domain.InventoryItem), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockInventoryRepository) UpdateTags(ctx context.Context, locationID string, itemIDs []string, add []string, remove []string) (int64, error) {
+	args := m.Called(ctx, locationID, itemIDs, add, remove)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockInventoryRepository) CountLowStock(ctx context.Context, locationID string) (int64, error) {
+	args := m.Called(ctx, locationID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func (m *MockInventoryRepository) AdjustStock(ctx context.Context, id string, quantity int32, reason string, performedBy string) error {
 	args := m.Called(ctx, id, quantity, reason, performedBy)
 	return args.Error(0)
@@ -119,6 +138,14 @@ func (m *MockInventoryRepository) FulfillReservation(ctx context.Context, id str
 	return args.Error(0)
 }
 
+func (m *MockInventoryRepository) GetByOrder(ctx context.Context, orderID string) ([]*domain.InventoryItem, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.InventoryItem), args.Error(1)
+}
+
 func (m *MockInventoryRepository) GetByOrderAndLocation(ctx context.Context, orderID string, locationID string) ([]*domain.InventoryItem, error) {
 	args := m.Called(ctx, orderID, locationID)
 	if args.Get(0) == nil {
@@ -126,3 +153,45 @@ func (m *MockInventoryRepository) GetByOrderAndLocation(ctx context.Context, ord
 	}
 	return args.Get(0).([]*domain.InventoryItem), args.Error(1)
 }
+
+func (m *MockInventoryRepository) ListActiveOrderReservations(ctx context.Context, updatedBefore time.Time) ([]*domain.InventoryItem, error) {
+	args := m.Called(ctx, updatedBefore)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.InventoryItem), args.Error(1)
+}
+
+func (m *MockInventoryRepository) ListExpiredOrderReservations(ctx context.Context, now time.Time) ([]*domain.InventoryItem, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.InventoryItem), args.Error(1)
+}
+
+func (m *MockInventoryRepository) TransferStock(ctx context.Context, transfer *domain.StockTransfer) error {
+	args := m.Called(ctx, transfer)
+	return args.Error(0)
+}
+
+func (m *MockInventoryRepository) ListDuplicates(ctx context.Context, locationID string) ([][]*domain.InventoryItem, error) {
+	args := m.Called(ctx, locationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([][]*domain.InventoryItem), args.Error(1)
+}
+
+func (m *MockInventoryRepository) MergeItems(ctx context.Context, kept *domain.InventoryItem, mergedIDs []string) error {
+	args := m.Called(ctx, kept, mergedIDs)
+	return args.Error(0)
+}
+
+func (m *MockInventoryRepository) ListAdjustments(ctx context.Context, filter domain.StockAdjustmentFilter) ([]*domain.StockAdjustment, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.StockAdjustment), args.Error(1)
+}
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrProductNotFound is returned by a ProductCatalog for products that do not exist
+var ErrProductNotFound = errors.New("product not found")
+
+// ErrProductCatalogUnavailable is returned by a ProductCatalog that cannot
+// reach the product service
+var ErrProductCatalogUnavailable = errors.New("product service unavailable")
+
+// ProductCatalog tells the inventory service which products exist, so stock
+// is not created for a mistyped product ID or SKU
+type ProductCatalog interface {
+	// ProductSKU returns the SKU of a product, or ErrProductNotFound
+	ProductSKU(ctx context.Context, productID string) (string, error)
+}
@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrConcurrentUpdate is returned when a record changed between being read and saved
+var ErrConcurrentUpdate = errors.New("record was modified concurrently")
+
+// PurchaseOrderStatus is the receiving status of a purchase order
+type PurchaseOrderStatus string
+
+const (
+	// PurchaseOrderPartiallyReceived means some ordered units are still outstanding
+	PurchaseOrderPartiallyReceived PurchaseOrderStatus = "PARTIALLY_RECEIVED"
+	// PurchaseOrderReceived means every line has been received in full
+	PurchaseOrderReceived PurchaseOrderStatus = "RECEIVED"
+)
+
+// ReceiveLine is one purchase order line as reported at receipt. ReceivedQuantity
+// is the total received for the line so far, not the units in this delivery, so
+// submitting the same receipt twice changes nothing.
+type ReceiveLine struct {
+	LineID           string
+	InventoryItemID  string
+	OrderedQuantity  int32
+	ReceivedQuantity int32
+}
+
+// PurchaseOrderLine is the receiving state of one purchase order line
+type PurchaseOrderLine struct {
+	LineID           string `bson:"line_id" json:"line_id"`
+	InventoryItemID  string `bson:"inventory_item_id" json:"inventory_item_id"`
+	OrderedQuantity  int32  `bson:"ordered_quantity" json:"ordered_quantity"`
+	ReceivedQuantity int32  `bson:"received_quantity" json:"received_quantity"`
+}
+
+// PurchaseOrderReceipt tracks what has been received against a purchase order.
+// It is keyed by the purchase order ID and guards stock-in against double
+// submits.
+type PurchaseOrderReceipt struct {
+	ID         string              `bson:"_id" json:"id"`
+	Status     PurchaseOrderStatus `bson:"status" json:"status"`
+	Lines      []PurchaseOrderLine `bson:"lines" json:"lines"`
+	ReceivedBy string              `bson:"received_by" json:"received_by"`
+	CreatedAt  time.Time           `bson:"created_at" json:"created_at"`
+	UpdatedAt  time.Time           `bson:"updated_at" json:"updated_at"`
+	// Version is incremented on every save and guards against lost updates
+	Version int64 `bson:"version" json:"version"`
+}
+
+// StockIn is the stock a receipt adds to one inventory item
+type StockIn struct {
+	LineID          string
+	InventoryItemID string
+	Quantity        int32
+}
+
+// NewPurchaseOrderReceipt creates an empty receipt for a purchase order
+func NewPurchaseOrderReceipt(poID string) *PurchaseOrderReceipt {
+	now := time.Now()
+	return &PurchaseOrderReceipt{
+		ID:        poID,
+		Status:    PurchaseOrderPartiallyReceived,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Receive records the reported lines and returns the stock that still has to
+// be added: for each line, the received total minus what was recorded before.
+// Lines already recorded at the reported total yield nothing. Nothing is
+// changed when a line is invalid.
+func (r *PurchaseOrderReceipt) Receive(lines []ReceiveLine, receivedBy string) ([]StockIn, error) {
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("%w: at least one line is required", ErrInvalidInput)
+	}
+
+	updated := make([]PurchaseOrderLine, len(r.Lines))
+	copy(updated, r.Lines)
+	index := make(map[string]int, len(updated))
+	for i, line := range updated {
+		index[line.LineID] = i
+	}
+
+	var stockIns []StockIn
+	seen := make(map[string]bool, len(lines))
+	for _, line := range lines {
+		switch {
+		case line.LineID == "":
+			return nil, fmt.Errorf("%w: line ID is required", ErrInvalidInput)
+		case seen[line.LineID]:
+			return nil, fmt.Errorf("%w: line %s is listed twice", ErrInvalidInput, line.LineID)
+		case line.InventoryItemID == "":
+			return nil, fmt.Errorf("%w: line %s has no inventory item", ErrInvalidInput, line.LineID)
+		case line.ReceivedQuantity < 0 || line.OrderedQuantity < 0:
+			return nil, fmt.Errorf("%w: line %s has a negative quantity", ErrInvalidInput, line.LineID)
+		}
+		seen[line.LineID] = true
+
+		i, ok := index[line.LineID]
+		if !ok {
+			updated = append(updated, PurchaseOrderLine{LineID: line.LineID, InventoryItemID: line.InventoryItemID})
+			i = len(updated) - 1
+			index[line.LineID] = i
+		}
+		recorded := &updated[i]
+		if recorded.InventoryItemID != line.InventoryItemID {
+			return nil, fmt.Errorf("%w: line %s was received into item %s, not %s",
+				ErrInvalidInput, line.LineID, recorded.InventoryItemID, line.InventoryItemID)
+		}
+		if line.ReceivedQuantity < recorded.ReceivedQuantity {
+			return nil, fmt.Errorf("%w: line %s already has %d units received, cannot lower it to %d",
+				ErrInvalidInput, line.LineID, recorded.ReceivedQuantity, line.ReceivedQuantity)
+		}
+
+		if delta := line.ReceivedQuantity - recorded.ReceivedQuantity; delta > 0 {
+			stockIns = append(stockIns, StockIn{
+				LineID:          line.LineID,
+				InventoryItemID: line.InventoryItemID,
+				Quantity:        delta,
+			})
+		}
+		recorded.ReceivedQuantity = line.ReceivedQuantity
+		if line.OrderedQuantity > 0 {
+			recorded.OrderedQuantity = line.OrderedQuantity
+		}
+	}
+
+	if len(stockIns) == 0 {
+		return nil, nil
+	}
+
+	r.Lines = updated
+	r.Status = PurchaseOrderReceived
+	for _, line := range r.Lines {
+		if line.ReceivedQuantity < line.OrderedQuantity {
+			r.Status = PurchaseOrderPartiallyReceived
+			break
+		}
+	}
+	r.ReceivedBy = receivedBy
+	r.UpdatedAt = time.Now()
+	return stockIns, nil
+}
+
+// PurchaseOrderReceiptRepository persists purchase order receipts
+type PurchaseOrderReceiptRepository interface {
+	// GetByPurchaseOrder returns the receipt of a purchase order, or ErrNotFound
+	GetByPurchaseOrder(ctx context.Context, poID string) (*PurchaseOrderReceipt, error)
+
+	// Save stores the receipt if it is still at the version it was read at,
+	// then increments its version. A receipt with version 0 is inserted. It
+	// returns ErrConcurrentUpdate when another save got there first.
+	Save(ctx context.Context, receipt *PurchaseOrderReceipt) error
+}
@@ -81,12 +81,55 @@ type InventoryRepository interface {
 	// ListLowStock returns inventory items that are below their reorder point
 	ListLowStock(ctx context.Context, limit, offset int) ([]*InventoryItem, error)
 	
+	// ListFiltered returns a page of inventory items matching a filter and the total number of matches
+	ListFiltered(ctx context.Context, filter InventoryFilter, limit, offset int) ([]*InventoryItem, int64, error)
+	
+	// UpdateTags adds and removes tags on inventory items at a location. When
+	// itemIDs is empty every item at the location is updated. It returns the
+	// number of items matched.
+	UpdateTags(ctx context.Context, locationID string, itemIDs []string, add, remove []string) (int64, error)
+	
+	// CountLowStock counts inventory items that need reordering, optionally at one location
+	CountLowStock(ctx context.Context, locationID string) (int64, error)
+	
 	// ListByStockStatus returns inventory items based on stock status (in stock, low stock, out of stock)
 	ListByStockStatus(ctx context.Context, status string, limit, offset int) ([]*InventoryItem, error)
 	
+	// GetByOrder finds inventory items reserved for a specific order across all locations
+	GetByOrder(ctx context.Context, orderID string) ([]*InventoryItem, error)
+	
 	// GetByOrderAndLocation finds inventory items reserved for a specific order at a specific location
 	GetByOrderAndLocation(ctx context.Context, orderID, locationID string) ([]*InventoryItem, error)
 	
+	// ListActiveOrderReservations finds the items holding an active order
+	// reservation last updated before updatedBefore, oldest first
+	ListActiveOrderReservations(ctx context.Context, updatedBefore time.Time) ([]*InventoryItem, error)
+	
+	// ListExpiredOrderReservations finds the items holding an active order
+	// reservation that expired at or before now, soonest expired first
+	ListExpiredOrderReservations(ctx context.Context, now time.Time) ([]*InventoryItem, error)
+	
+	// ListDuplicates returns the items at a location grouped by SKU, for every
+	// SKU held by more than one item there. Each group is oldest first.
+	ListDuplicates(ctx context.Context, locationID string) ([][]*InventoryItem, error)
+	
+	// MergeItems saves kept and deletes the items in mergedIDs in one
+	// transaction, pointing their history at kept
+	MergeItems(ctx context.Context, kept *InventoryItem, mergedIDs []string) error
+	
+	// TransferStock moves transfer.Quantity of transfer.SKU between the
+	// transfer's locations in one transaction, creating the destination item
+	// when there is none, and records a history entry on both items. It fills
+	// in the items and returns ErrNotFound when the source holds no item for
+	// the SKU, ErrInsufficientStock when it has too little available.
+	TransferStock(ctx context.Context, transfer *StockTransfer) error
+	
+	// Reserve reserves quantity units of an item without an order in one
+	// conditional write, so concurrent reservations cannot both take the same
+	// units. It returns ErrNotFound for an unknown item and
+	// ErrInsufficientStock when fewer than quantity units are available.
+	Reserve(ctx context.Context, itemID string, quantity int32) error
+	
 	// AdjustStock adjusts inventory quantity and records reason
 	AdjustStock(ctx context.Context, itemID string, quantity int32, reason string, performedBy string) error
 	
@@ -95,4 +138,7 @@ type InventoryRepository interface {
 	
 	// RecordHistory adds a new history entry for an inventory item
 	RecordHistory(ctx context.Context, history *InventoryHistory) error
+	
+	// ListAdjustments returns the stock adjustments matching filter, oldest first
+	ListAdjustments(ctx context.Context, filter StockAdjustmentFilter) ([]*StockAdjustment, error)
 }
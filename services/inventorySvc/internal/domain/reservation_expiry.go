@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// ReservationTTL bounds how long order reservations are held before the
+// expiry sweeper gives their units back
+type ReservationTTL struct {
+	// Default applies to reservations made without a TTL of their own; zero
+	// leaves them without an expiry
+	Default time.Duration
+	// Max is the longest TTL a caller may ask for; zero means no limit
+	Max time.Duration
+}
+
+// ExpiresAt returns when a reservation made at now with the requested TTL
+// expires. A zero TTL takes the default; a negative one, or one over the
+// maximum, is rejected. The zero time means the reservation does not expire.
+func (t ReservationTTL) ExpiresAt(requested time.Duration, now time.Time) (time.Time, error) {
+	if requested < 0 {
+		return time.Time{}, fmt.Errorf("%w: reservation TTL must not be negative", ErrInvalidInput)
+	}
+	if t.Max > 0 && requested > t.Max {
+		return time.Time{}, fmt.Errorf("%w: reservation TTL %s exceeds the maximum of %s", ErrInvalidInput, requested, t.Max)
+	}
+	ttl := requested
+	if ttl == 0 {
+		ttl = t.Default
+	}
+	if ttl == 0 {
+		return time.Time{}, nil
+	}
+	return now.Add(ttl), nil
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReservationTTLExpiresAt(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	ttl := ReservationTTL{Default: 15 * time.Minute, Max: 7 * 24 * time.Hour}
+
+	tests := []struct {
+		name      string
+		ttl       ReservationTTL
+		requested time.Duration
+		want      time.Time
+		wantErr   bool
+	}{
+		{name: "requested", ttl: ttl, requested: time.Hour, want: now.Add(time.Hour)},
+		{name: "default", ttl: ttl, want: now.Add(15 * time.Minute)},
+		{name: "at the maximum", ttl: ttl, requested: 7 * 24 * time.Hour, want: now.Add(7 * 24 * time.Hour)},
+		{name: "over the maximum", ttl: ttl, requested: 8 * 24 * time.Hour, wantErr: true},
+		{name: "negative", ttl: ttl, requested: -time.Minute, wantErr: true},
+		{name: "no default never expires", ttl: ReservationTTL{}, want: time.Time{}},
+		{name: "no maximum", ttl: ReservationTTL{}, requested: 365 * 24 * time.Hour, want: now.Add(365 * 24 * time.Hour)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.ttl.ExpiresAt(tt.requested, now)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidInput)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "ExpiresAt = %s, want %s", got, tt.want)
+		})
+	}
+}
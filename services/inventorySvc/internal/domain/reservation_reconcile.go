@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// OrderState is what the order service reports about an order holding stock
+type OrderState string
+
+const (
+	// OrderStateOpen orders still need their reservations
+	OrderStateOpen OrderState = "open"
+	// OrderStateCancelled orders were cancelled or failed
+	OrderStateCancelled OrderState = "cancelled"
+	// OrderStateCompleted orders have shipped or been delivered
+	OrderStateCompleted OrderState = "completed"
+	// OrderStateMissing orders do not exist in the order service
+	OrderStateMissing OrderState = "missing"
+)
+
+// HoldsStock reports whether an order in this state may keep its reservations
+func (s OrderState) HoldsStock() bool {
+	return s == OrderStateOpen
+}
+
+// ErrOrderLookupUnavailable is returned by an OrderLookup that cannot reach
+// the order service; reservations are then left alone
+var ErrOrderLookupUnavailable = errors.New("order service unavailable")
+
+// OrderLookup tells the reservation reconciler what became of an order
+type OrderLookup interface {
+	OrderState(ctx context.Context, orderID string) (OrderState, error)
+}
+
+// ReservationCorrection is one order whose leaked reservations were released
+type ReservationCorrection struct {
+	OrderID      string
+	OrderState   OrderState
+	Reservations []*OrderReservation
+}
+
+// ReservationReconcileReport sums up one reconciliation pass. Corrections in
+// a dry run list what would have been released.
+type ReservationReconcileReport struct {
+	ReservationsChecked int
+	OrdersChecked       int
+	Corrections         []ReservationCorrection
+	// Failed counts the orders that could not be looked up or released; they
+	// are retried on the next pass
+	Failed int
+	DryRun bool
+}
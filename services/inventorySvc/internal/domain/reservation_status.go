@@ -0,0 +1,41 @@
+package domain
+
+import "fmt"
+
+// ValidReservationStatus reports whether status is one an order reservation can have
+func ValidReservationStatus(status string) bool {
+	switch status {
+	case ReservationStatusActive, ReservationStatusFulfilled, ReservationStatusCancelled, ReservationStatusExpired:
+		return true
+	}
+	return false
+}
+
+// TransitionReservation moves the item's reservation for orderID to status
+// and settles the units it held: cancelling or expiring gives them back to
+// available stock, fulfilling deducts them from stock. Only an active
+// reservation can change status; fulfilled, cancelled and expired are final.
+// It returns the quantity the reservation held.
+func (i *InventoryItem) TransitionReservation(orderID, status string) (int32, error) {
+	r := i.ReservationFor(orderID)
+	if r == nil {
+		return 0, ErrReservationNotFound
+	}
+	if !ValidReservationStatus(status) {
+		return 0, fmt.Errorf("%w: unknown reservation status %q", ErrInvalidInput, status)
+	}
+	if !r.Active() {
+		return 0, fmt.Errorf("%w: reservation for order %s is %s and cannot become %s", ErrInvalidOperation, orderID, r.Status, status)
+	}
+
+	switch status {
+	case ReservationStatusCancelled:
+		return i.CancelOrderReservation(r.Quantity, orderID), nil
+	case ReservationStatusExpired:
+		return i.ExpireOrderReservation(orderID), nil
+	case ReservationStatusFulfilled:
+		return i.FulfillOrderReservation(orderID, 0)
+	default:
+		return 0, fmt.Errorf("%w: reservation for order %s is already %s", ErrInvalidOperation, orderID, status)
+	}
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ChangeTypeAdjustment is the history change type of a manual stock adjustment
+const ChangeTypeAdjustment = "ADJUSTMENT"
+
+// ReferenceTypeBatchAdjustment is the history reference type of adjustments
+// made by a batch; their reference ID is the batch ID
+const ReferenceTypeBatchAdjustment = "BATCH_ADJUSTMENT"
+
+// MaxBatchAdjustLines bounds the lines of one batch adjustment
+const MaxBatchAdjustLines = 500
+
+// MaxAdjustmentReasonLength bounds an adjustment reason, in characters
+const MaxAdjustmentReasonLength = 200
+
+// AdjustLine is one line of a batch adjustment: the change to the stock of a
+// SKU at the batch's location, negative for a loss
+type AdjustLine struct {
+	SKU      string
+	Quantity int32
+}
+
+// AdjustLineResult is the outcome of one line of a batch adjustment. Err is
+// set when the line was not applied; the quantities are then those the item
+// had, or zero when it was not found.
+type AdjustLineResult struct {
+	SKU             string
+	InventoryItemID string
+	QuantityBefore  int32
+	QuantityAfter   int32
+	Err             error
+}
+
+// BatchAdjustment is the outcome of a batch adjustment. Its ID is the
+// reference ID of the history entries of all lines applied.
+type BatchAdjustment struct {
+	ID         string
+	LocationID string
+	Reason     string
+	Results    []AdjustLineResult
+}
+
+// Applied returns how many lines were applied
+func (b *BatchAdjustment) Applied() int {
+	applied := 0
+	for _, r := range b.Results {
+		if r.Err == nil {
+			applied++
+		}
+	}
+	return applied
+}
+
+// NormalizeAdjustmentReason trims reason and checks that it is present and
+// not longer than MaxAdjustmentReasonLength
+func NormalizeAdjustmentReason(reason string) (string, error) {
+	reason = strings.TrimSpace(reason)
+	switch {
+	case reason == "":
+		return "", fmt.Errorf("%w: adjustment reason is required", ErrInvalidInput)
+	case utf8.RuneCountInString(reason) > MaxAdjustmentReasonLength:
+		return "", fmt.Errorf("%w: adjustment reason is longer than %d characters", ErrInvalidInput, MaxAdjustmentReasonLength)
+	}
+	return reason, nil
+}
+
+// StockAdjustmentFilter selects the stock adjustments to export. Zero fields
+// do not filter.
+type StockAdjustmentFilter struct {
+	LocationID string
+	// Reason matches the adjustment reason exactly, ignoring case
+	Reason string
+	From   time.Time
+	To     time.Time
+}
+
+// StockAdjustment is an adjustment history entry together with the SKU and
+// location of the item it was made on
+type StockAdjustment struct {
+	InventoryHistory `bson:",inline"`
+	SKU              string `bson:"sku"`
+	LocationID       string `bson:"location_id"`
+}
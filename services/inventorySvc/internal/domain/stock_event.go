@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventTypeStockChanged is the event type published whenever an item's stock
+// level or reorder point may have moved
+const EventTypeStockChanged = "inventory.stock_changed"
+
+// StockChangedEvent tells downstream caches that a product's stock moved. The
+// quantities are left out when the item could not be read back, in which case
+// consumers should drop what they hold for the product. Restocked is set when
+// the write took an item that was known to have no available stock to some.
+type StockChangedEvent struct {
+	ID          string    `json:"id"`
+	Type        string    `json:"type"`
+	ProductID   string    `json:"product_id"`
+	InventoryID string    `json:"inventory_id,omitempty"`
+	LocationID  string    `json:"location_id,omitempty"`
+	Available   *int32    `json:"available,omitempty"`
+	ReorderAt   *int32    `json:"reorder_at,omitempty"`
+	Restocked   bool      `json:"restocked,omitempty"`
+	Timestamp   time.Time `json:"timestamp"`
+}
+
+// NewStockChangedEvent builds a stock changed event from an item's current state
+func NewStockChangedEvent(item *InventoryItem) *StockChangedEvent {
+	available := item.GetAvailable()
+	reorderAt := item.ReorderPoint
+	return &StockChangedEvent{
+		ID:          uuid.New().String(),
+		Type:        EventTypeStockChanged,
+		ProductID:   item.ProductID,
+		InventoryID: item.ID,
+		LocationID:  item.LocationID,
+		Available:   &available,
+		ReorderAt:   &reorderAt,
+		Timestamp:   time.Now(),
+	}
+}
+
+// WithPrevious marks the event as a restock when previousAvailable, the item's
+// available stock before the write, was zero or less and now is positive
+func (e *StockChangedEvent) WithPrevious(previousAvailable int32) *StockChangedEvent {
+	e.Restocked = previousAvailable <= 0 && e.Available != nil && *e.Available > 0
+	return e
+}
+
+// NewStockInvalidatedEvent builds a stock changed event without quantities
+func NewStockInvalidatedEvent(productID string) *StockChangedEvent {
+	return &StockChangedEvent{
+		ID:        uuid.New().String(),
+		Type:      EventTypeStockChanged,
+		ProductID: productID,
+		Timestamp: time.Now(),
+	}
+}
+
+// StockEventPublisher publishes stock changed events
+type StockEventPublisher interface {
+	PublishStockChanged(ctx context.Context, event *StockChangedEvent) error
+}
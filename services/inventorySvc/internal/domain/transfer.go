@@ -109,3 +109,24 @@ func (t *Transfer) Reject() {
 
 // Note: TransferRepository for Transfer objects is defined in a separate file
 // to avoid redeclaration conflicts with the InventoryTransfer repository
+
+// HistoryChangeTransfer is the change type of the history entries a direct
+// stock transfer records, one on each side sharing the transfer ID
+const HistoryChangeTransfer = "transfer"
+
+// StockTransfer moves stock of a SKU from one location straight to another,
+// without the request and approval steps of a Transfer
+type StockTransfer struct {
+	ID             string
+	SKU            string
+	FromLocationID string
+	ToLocationID   string
+	Quantity       int32
+	PerformedBy    string
+	// Source and Destination are the items after the move
+	Source      *InventoryItem
+	Destination *InventoryItem
+	// DestinationCreated is set when the destination held no item for the SKU
+	DestinationCreated bool
+	CreatedAt          time.Time
+}
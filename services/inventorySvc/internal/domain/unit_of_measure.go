@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// DefaultUnit is the selling unit of items that do not set one
+const DefaultUnit = "each"
+
+// An item's unit of measure says what it is sold in and what it is stocked
+// in. Quantity, Reserved and Damaged are always counted in the selling unit,
+// the smaller of the two, so reservations and deductions for orders move whole
+// selling units and never split one. The stocking unit is what stock is
+// received and counted in: an item stocked as cases of 12 and sold per piece
+// has SellingUnit "each", StockingUnit "case" and UnitsPerStockingUnit 12, and
+// reserving 3 each out of 2 full cases (24 each) leaves 21 each available,
+// which is 1.75 cases or 1 full case and 9 loose each.
+//
+// Items created before units of measure existed leave the fields empty and are
+// stocked and sold in DefaultUnit.
+
+// SetUnitOfMeasure sets the units an item is sold and stocked in. An empty
+// stocking unit means the item is stocked in its selling unit, in which case
+// unitsPerStockingUnit must be 1.
+func (i *InventoryItem) SetUnitOfMeasure(sellingUnit, stockingUnit string, unitsPerStockingUnit int32) error {
+	sellingUnit = normalizeUnit(sellingUnit)
+	if sellingUnit == "" {
+		sellingUnit = DefaultUnit
+	}
+	stockingUnit = normalizeUnit(stockingUnit)
+	if stockingUnit == "" {
+		stockingUnit = sellingUnit
+	}
+	if unitsPerStockingUnit <= 0 {
+		return fmt.Errorf("%w: units per stocking unit must be positive", ErrInvalidInput)
+	}
+	if stockingUnit == sellingUnit && unitsPerStockingUnit != 1 {
+		return fmt.Errorf("%w: an item stocked in its selling unit has 1 unit per stocking unit", ErrInvalidInput)
+	}
+
+	i.SellingUnit = sellingUnit
+	i.StockingUnit = stockingUnit
+	i.UnitsPerStockingUnit = unitsPerStockingUnit
+	i.LastUpdated = time.Now()
+	return nil
+}
+
+// SellingUnitName returns the unit quantities are counted in
+func (i *InventoryItem) SellingUnitName() string {
+	if i.SellingUnit == "" {
+		return DefaultUnit
+	}
+	return i.SellingUnit
+}
+
+// StockingUnitName returns the unit stock is received in
+func (i *InventoryItem) StockingUnitName() string {
+	if i.StockingUnit == "" {
+		return i.SellingUnitName()
+	}
+	return i.StockingUnit
+}
+
+// ConversionFactor returns the number of selling units in one stocking unit
+func (i *InventoryItem) ConversionFactor() int32 {
+	if i.UnitsPerStockingUnit <= 0 {
+		return 1
+	}
+	return i.UnitsPerStockingUnit
+}
+
+// ToSellingUnits converts a quantity in unit, either the selling or the
+// stocking unit, to selling units. An empty unit is the selling unit.
+func (i *InventoryItem) ToSellingUnits(quantity int32, unit string) (int32, error) {
+	switch normalizeUnit(unit) {
+	case "", i.SellingUnitName():
+		return quantity, nil
+	case i.StockingUnitName():
+		converted := int64(quantity) * int64(i.ConversionFactor())
+		if converted > math.MaxInt32 || converted < math.MinInt32 {
+			return 0, fmt.Errorf("%w: %d %s is too large", ErrInvalidInput, quantity, unit)
+		}
+		return int32(converted), nil
+	default:
+		return 0, fmt.Errorf("%w: item %s is counted in %s or %s, not %s",
+			ErrInvalidInput, i.ID, i.SellingUnitName(), i.StockingUnitName(), unit)
+	}
+}
+
+// AvailableIn returns the available quantity in unit, either the selling or
+// the stocking unit. In the stocking unit it can be fractional: 21 each of an
+// item stocked in cases of 12 is 1.75 cases.
+func (i *InventoryItem) AvailableIn(unit string) (float64, error) {
+	switch normalizeUnit(unit) {
+	case "", i.SellingUnitName():
+		return float64(i.GetAvailable()), nil
+	case i.StockingUnitName():
+		return float64(i.GetAvailable()) / float64(i.ConversionFactor()), nil
+	default:
+		return 0, fmt.Errorf("%w: item %s is counted in %s or %s, not %s",
+			ErrInvalidInput, i.ID, i.SellingUnitName(), i.StockingUnitName(), unit)
+	}
+}
+
+// AvailableStockingUnits splits the available quantity into full stocking
+// units and the selling units left over from opened ones
+func (i *InventoryItem) AvailableStockingUnits() (full, loose int32) {
+	available := i.GetAvailable()
+	if available <= 0 {
+		return 0, available
+	}
+	factor := i.ConversionFactor()
+	return available / factor, available % factor
+}
+
+func normalizeUnit(unit string) string {
+	return strings.ToLower(strings.TrimSpace(unit))
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// caseOfTwelve returns an item sold per piece and stocked as cases of 12,
+// holding 2 full cases
+func caseOfTwelve(t *testing.T) *InventoryItem {
+	item := NewInventoryItem("product-1", 24, "SKU-1", "store-1")
+	require.NoError(t, item.SetUnitOfMeasure("each", "case", 12))
+	return item
+}
+
+func TestReservingEachFromACaseOfTwelve(t *testing.T) {
+	item := caseOfTwelve(t)
+
+	require.True(t, item.Reserve(3))
+
+	assert.Equal(t, int32(21), item.GetAvailable(), "quantities are counted in each")
+	each, err := item.AvailableIn("each")
+	require.NoError(t, err)
+	assert.Equal(t, 21.0, each)
+	cases, err := item.AvailableIn("case")
+	require.NoError(t, err)
+	assert.Equal(t, 1.75, cases)
+
+	full, loose := item.AvailableStockingUnits()
+	assert.Equal(t, int32(1), full)
+	assert.Equal(t, int32(9), loose)
+}
+
+func TestToSellingUnits(t *testing.T) {
+	item := caseOfTwelve(t)
+
+	quantity, err := item.ToSellingUnits(2, "Case")
+	require.NoError(t, err)
+	assert.Equal(t, int32(24), quantity)
+
+	quantity, err = item.ToSellingUnits(3, "")
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), quantity, "an empty unit is the selling unit")
+
+	_, err = item.ToSellingUnits(1, "pallet")
+	assert.ErrorIs(t, err, ErrInvalidInput)
+
+	_, err = item.ToSellingUnits(1<<30, "case")
+	assert.ErrorIs(t, err, ErrInvalidInput, "overflowing conversions are rejected")
+}
+
+func TestSetUnitOfMeasureValidatesTheFactor(t *testing.T) {
+	item := NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+
+	assert.ErrorIs(t, item.SetUnitOfMeasure("each", "case", 0), ErrInvalidInput)
+	assert.ErrorIs(t, item.SetUnitOfMeasure("each", "case", -12), ErrInvalidInput)
+	assert.ErrorIs(t, item.SetUnitOfMeasure("each", "each", 12), ErrInvalidInput)
+
+	require.NoError(t, item.SetUnitOfMeasure("kg", "", 1))
+	assert.Equal(t, "kg", item.StockingUnitName(), "an empty stocking unit is the selling unit")
+}
+
+func TestItemsWithoutUnitOfMeasureAreSoldEach(t *testing.T) {
+	item := NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+
+	assert.Equal(t, DefaultUnit, item.SellingUnitName())
+	assert.Equal(t, DefaultUnit, item.StockingUnitName())
+	assert.Equal(t, int32(1), item.ConversionFactor())
+}
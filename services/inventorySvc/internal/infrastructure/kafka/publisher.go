@@ -0,0 +1,91 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// Publisher implements the domain.StockEventPublisher interface using Kafka
+type Publisher struct {
+	producer sarama.SyncProducer
+	topic    string
+	logger   *zap.Logger
+}
+
+// Config holds Kafka configuration
+type Config struct {
+	Brokers []string
+	Topic   string
+}
+
+// NewPublisher creates a new Kafka publisher
+func NewPublisher(config Config, logger *zap.Logger) (*Publisher, error) {
+	saramaConfig := sarama.NewConfig()
+	saramaConfig.Producer.Return.Successes = true
+	saramaConfig.Producer.Return.Errors = true
+	saramaConfig.Producer.Retry.Max = 3
+	saramaConfig.Producer.Retry.Backoff = 100 * time.Millisecond
+	saramaConfig.Producer.RequiredAcks = sarama.WaitForAll
+
+	producer, err := sarama.NewSyncProducer(config.Brokers, saramaConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kafka producer: %w", err)
+	}
+
+	return newPublisher(producer, config.Topic, logger), nil
+}
+
+func newPublisher(producer sarama.SyncProducer, topic string, logger *zap.Logger) *Publisher {
+	return &Publisher{
+		producer: producer,
+		topic:    topic,
+		logger:   logger.Named("kafka_publisher"),
+	}
+}
+
+// PublishStockChanged publishes a stock changed event keyed by product, so
+// the changes of one product stay in order
+func (p *Publisher) PublishStockChanged(ctx context.Context, event *domain.StockChangedEvent) error {
+	eventData, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to serialize event: %w", err)
+	}
+
+	message := &sarama.ProducerMessage{
+		Topic:     p.topic,
+		Key:       sarama.StringEncoder(event.ProductID),
+		Value:     sarama.ByteEncoder(eventData),
+		Timestamp: event.Timestamp,
+		Headers: []sarama.RecordHeader{
+			{Key: []byte("event_type"), Value: []byte(event.Type)},
+			{Key: []byte("event_id"), Value: []byte(event.ID)},
+			{Key: []byte("product_id"), Value: []byte(event.ProductID)},
+		},
+	}
+
+	partition, offset, err := p.producer.SendMessage(message)
+	if err != nil {
+		return fmt.Errorf("failed to publish event: %w", err)
+	}
+
+	p.logger.Debug("Published stock changed event",
+		zap.String("topic", p.topic),
+		zap.String("event_id", event.ID),
+		zap.String("product_id", event.ProductID),
+		zap.Int32("partition", partition),
+		zap.Int64("offset", offset),
+	)
+	return nil
+}
+
+// Close closes the Kafka producer
+func (p *Publisher) Close() error {
+	return p.producer.Close()
+}
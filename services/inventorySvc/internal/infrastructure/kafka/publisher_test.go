@@ -0,0 +1,63 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+func TestPublishStockChanged(t *testing.T) {
+	producer := mocks.NewSyncProducer(t, nil)
+	publisher := newPublisher(producer, "inventory-events", zap.NewNop())
+	defer publisher.Close()
+
+	item := domain.NewInventoryItem("product-1", 8, "SKU-1", "store-1")
+	item.Reserved = 3
+	event := domain.NewStockChangedEvent(item)
+
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+		if msg.Topic != "inventory-events" {
+			return errors.New("wrong topic " + msg.Topic)
+		}
+		key, _ := msg.Key.Encode()
+		if string(key) != "product-1" {
+			return errors.New("events must be keyed by product")
+		}
+		return nil
+	})
+	require.NoError(t, publisher.PublishStockChanged(context.Background(), event))
+
+	// The payload is what the gateway's availability consumer decodes
+	var decoded struct {
+		Type      string `json:"type"`
+		ProductID string `json:"product_id"`
+		Available *int32 `json:"available"`
+	}
+	data, err := json.Marshal(event)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "inventory.stock_changed", decoded.Type)
+	assert.Equal(t, "product-1", decoded.ProductID)
+	require.NotNil(t, decoded.Available)
+	assert.Equal(t, int32(5), *decoded.Available)
+}
+
+func TestPublishStockChangedReportsSendFailure(t *testing.T) {
+	producer := mocks.NewSyncProducer(t, nil)
+	publisher := newPublisher(producer, "inventory-events", zap.NewNop())
+	defer publisher.Close()
+
+	producer.ExpectSendMessageAndFail(sarama.ErrOutOfBrokers)
+
+	err := publisher.PublishStockChanged(context.Background(), domain.NewStockInvalidatedEvent("product-1"))
+	assert.ErrorIs(t, err, sarama.ErrOutOfBrokers)
+}
@@ -0,0 +1,135 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// BackInStockRepository implements the domain.BackInStockRepository interface using MongoDB
+type BackInStockRepository struct {
+	subscriptions *mongo.Collection
+	notifications *mongo.Collection
+	logger        *zap.Logger
+}
+
+// NewBackInStockRepository creates a new BackInStockRepository
+func NewBackInStockRepository(db *mongo.Database, logger *zap.Logger) domain.BackInStockRepository {
+	subscriptions := db.Collection("back_in_stock_subscriptions")
+	notifications := db.Collection("back_in_stock_notifications")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := subscriptions.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "product_id", Value: 1}},
+	})
+	if err != nil {
+		logger.Warn("Failed to create back-in-stock subscription index", zap.Error(err))
+	}
+
+	_, err = notifications.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "status", Value: 1}, {Key: "created_at", Value: 1}},
+	})
+	if err != nil {
+		logger.Warn("Failed to create back-in-stock notification index", zap.Error(err))
+	}
+
+	return &BackInStockRepository{
+		subscriptions: subscriptions,
+		notifications: notifications,
+		logger:        logger.Named("back_in_stock_repository"),
+	}
+}
+
+// Subscribe stores a subscription unless the user is already subscribed to the product
+func (r *BackInStockRepository) Subscribe(ctx context.Context, sub *domain.BackInStockSubscription) (*domain.BackInStockSubscription, bool, error) {
+	r.logger.Debug("Subscribing to back-in-stock alert",
+		zap.String("user_id", sub.UserID),
+		zap.String("product_id", sub.ProductID),
+	)
+
+	result, err := r.subscriptions.UpdateOne(ctx,
+		bson.M{"_id": sub.ID},
+		bson.M{"$setOnInsert": sub},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		r.logger.Error("Failed to store back-in-stock subscription", zap.Error(err))
+		return nil, false, err
+	}
+	if result.UpsertedCount > 0 {
+		return sub, true, nil
+	}
+
+	var existing domain.BackInStockSubscription
+	if err := r.subscriptions.FindOne(ctx, bson.M{"_id": sub.ID}).Decode(&existing); err != nil {
+		r.logger.Error("Failed to load back-in-stock subscription", zap.Error(err))
+		return nil, false, err
+	}
+	return &existing, false, nil
+}
+
+// Unsubscribe removes the subscription of a user to a product
+func (r *BackInStockRepository) Unsubscribe(ctx context.Context, userID, productID string) error {
+	result, err := r.subscriptions.DeleteOne(ctx, bson.M{"user_id": userID, "product_id": productID})
+	if err != nil {
+		r.logger.Error("Failed to delete back-in-stock subscription", zap.Error(err))
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// ListByProduct returns all subscriptions for a product
+func (r *BackInStockRepository) ListByProduct(ctx context.Context, productID string) ([]*domain.BackInStockSubscription, error) {
+	cursor, err := r.subscriptions.Find(ctx, bson.M{"product_id": productID})
+	if err != nil {
+		r.logger.Error("Failed to list back-in-stock subscriptions", zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var subs []*domain.BackInStockSubscription
+	if err := cursor.All(ctx, &subs); err != nil {
+		return nil, err
+	}
+	return subs, nil
+}
+
+// Delete removes a fired subscription instance
+func (r *BackInStockRepository) Delete(ctx context.Context, sub *domain.BackInStockSubscription) error {
+	_, err := r.subscriptions.DeleteOne(ctx, bson.M{"_id": sub.ID, "created_at": sub.CreatedAt})
+	if err != nil {
+		r.logger.Error("Failed to delete back-in-stock subscription",
+			zap.Error(err),
+			zap.String("id", sub.ID),
+		)
+		return err
+	}
+	return nil
+}
+
+// EnqueueNotification queues a back-in-stock notification
+func (r *BackInStockRepository) EnqueueNotification(ctx context.Context, notification *domain.BackInStockNotification) error {
+	_, err := r.notifications.InsertOne(ctx, notification)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return domain.ErrDuplicateEntity
+		}
+		r.logger.Error("Failed to enqueue back-in-stock notification",
+			zap.Error(err),
+			zap.String("id", notification.ID),
+		)
+		return err
+	}
+	return nil
+}
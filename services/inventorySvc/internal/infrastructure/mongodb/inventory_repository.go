@@ -3,6 +3,7 @@ package mongodb
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -16,11 +17,19 @@ import (
 // InventoryRepository implements the domain.InventoryRepository interface
 type InventoryRepository struct {
 	collection *mongo.Collection
-	logger     *zap.Logger
+	// reports reads the same collection for low-stock and history reports,
+	// which may be served by a secondary
+	reports *mongo.Collection
+	// history holds the change history of inventory items, including stock
+	// adjustments; historyReports reads it for reports
+	history        *mongo.Collection
+	historyReports *mongo.Collection
+	logger         *zap.Logger
 }
 
-// NewInventoryRepository creates a new MongoDB inventory repository
-func NewInventoryRepository(db *mongo.Database, collectionName string, logger *zap.Logger) domain.InventoryRepository {
+// NewInventoryRepository creates a new MongoDB inventory repository. Stock
+// changes are written through db; reports is used for report queries.
+func NewInventoryRepository(db, reports *mongo.Database, collectionName string, logger *zap.Logger) domain.InventoryRepository {
 	collection := db.Collection(collectionName)
 	
 	// Create indexes for improved query performance
@@ -34,9 +43,30 @@ func NewInventoryRepository(db *mongo.Database, collectionName string, logger *z
 			Options: options.Index().SetUnique(true),
 		},
 		{
-			Keys:    bson.D{{Key: "inventory_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Keys:    bson.D{{Key: "location_id", Value: 1}, {Key: "tags", Value: 1}},
 			Options: options.Index().SetUnique(false),
 		},
+		{
+			Keys:    bson.D{{Key: "location_id", Value: 1}, {Key: "next_count_date", Value: 1}},
+			Options: options.Index().SetUnique(false),
+		},
+		{
+			Keys:    bson.D{{Key: "last_updated", Value: 1}},
+			Options: options.Index().SetUnique(false),
+		},
+		{
+			Keys:    bson.D{{Key: "location_id", Value: 1}, {Key: "last_updated", Value: 1}},
+			Options: options.Index().SetUnique(false),
+		},
+		{
+			Keys:    bson.D{{Key: "reservations.order_id", Value: 1}},
+			Options: options.Index().SetUnique(false),
+		},
+		{
+			// Sparse: only reservations with an expiry are swept
+			Keys:    bson.D{{Key: "reservations.expires_at", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
 	}
 	
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -47,9 +77,21 @@ func NewInventoryRepository(db *mongo.Database, collectionName string, logger *z
 		logger.Warn("Failed to create indexes", zap.Error(err))
 	}
 	
+	history := db.Collection(HistoryCollectionName)
+	_, err = history.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "inventory_id", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "change_type", Value: 1}, {Key: "created_at", Value: 1}}},
+	})
+	if err != nil {
+		logger.Warn("Failed to create inventory history indexes", zap.Error(err))
+	}
+	
 	return &InventoryRepository{
-		collection: collection,
-		logger:     logger.Named("inventory_repository"),
+		collection:     collection,
+		reports:        reports.Collection(collectionName),
+		history:        history,
+		historyReports: reports.Collection(HistoryCollectionName),
+		logger:         logger.Named("inventory_repository"),
 	}
 }
 
@@ -429,16 +471,9 @@ func (r *InventoryRepository) ListLowStock(ctx context.Context, limit, offset in
 	findOptions.SetLimit(int64(limit))
 	findOptions.SetSkip(int64(offset))
 	
-	filter := bson.M{
-		"$expr": bson.M{
-			"$lt": []interface{}{
-				"$quantity", 
-				"$reorder_threshold",
-			},
-		},
-	}
+	filter := lowStockFilter()
 	
-	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	cursor, err := r.reports.Find(ctx, filter, findOptions)
 	if err != nil {
 		r.logger.Error("Failed to list low stock inventory items", zap.Error(err))
 		return nil, err
@@ -463,6 +498,157 @@ func (r *InventoryRepository) ListLowStock(ctx context.Context, limit, offset in
 	return items, nil
 }
 
+// UpdateTags adds and removes tags on inventory items at a location. MongoDB
+// cannot $addToSet and $pull the same field in one update, so additions and
+// removals are applied as two updates.
+func (r *InventoryRepository) UpdateTags(ctx context.Context, locationID string, itemIDs []string, add, remove []string) (int64, error) {
+	r.logger.Debug("Updating inventory item tags",
+		zap.String("location_id", locationID),
+		zap.Int("item_count", len(itemIDs)),
+		zap.Strings("add", add),
+		zap.Strings("remove", remove),
+	)
+	
+	filter := bson.M{"location_id": locationID}
+	if len(itemIDs) > 0 {
+		filter["_id"] = bson.M{"$in": itemIDs}
+	}
+	
+	var matched int64
+	if len(add) > 0 {
+		result, err := r.collection.UpdateMany(ctx, filter, bson.M{
+			"$addToSet": bson.M{"tags": bson.M{"$each": add}},
+			"$set":      bson.M{"last_updated": time.Now()},
+		})
+		if err != nil {
+			r.logger.Error("Failed to add inventory item tags", zap.Error(err))
+			return 0, err
+		}
+		matched = result.MatchedCount
+	}
+	if len(remove) > 0 {
+		result, err := r.collection.UpdateMany(ctx, filter, bson.M{
+			"$pull": bson.M{"tags": bson.M{"$in": remove}},
+			"$set":  bson.M{"last_updated": time.Now()},
+		})
+		if err != nil {
+			r.logger.Error("Failed to remove inventory item tags", zap.Error(err))
+			return 0, err
+		}
+		matched = result.MatchedCount
+	}
+	
+	return matched, nil
+}
+
+// lowStockFilter matches inventory items that need reordering, i.e. that have
+// a reorder point and a quantity at or below it (see InventoryItem.NeedsReorder)
+func lowStockFilter() bson.M {
+	return bson.M{
+		"reorder_point": bson.M{"$gt": 0},
+		"$expr": bson.M{
+			"$lte": []interface{}{"$quantity", "$reorder_point"},
+		},
+	}
+}
+
+// listFilteredQuery builds the query and sort order ListFiltered uses for a
+// filter. Items must carry every tag of the filter.
+func listFilteredQuery(filter domain.InventoryFilter) (bson.M, bson.D, error) {
+	query := bson.M{}
+	switch filter.StockStatus {
+	case "", domain.StockStatusAll:
+	case domain.StockStatusInStock:
+		query["quantity"] = bson.M{"$gt": 0}
+	case domain.StockStatusLowStock:
+		query = lowStockFilter()
+	case domain.StockStatusOutOfStock:
+		query["quantity"] = bson.M{"$lte": 0}
+	default:
+		return nil, nil, domain.ErrInvalidInput
+	}
+	if filter.LocationID != "" {
+		query["location_id"] = filter.LocationID
+	}
+	if len(filter.Tags) > 0 {
+		query["tags"] = bson.M{"$all": filter.Tags}
+	}
+	sort := bson.D{{Key: "sku", Value: 1}}
+	if !filter.CountDueBy.IsZero() {
+		// Items that were never scheduled have no count date
+		query["next_count_date"] = bson.M{"$gt": time.Time{}, "$lte": filter.CountDueBy}
+		sort = bson.D{{Key: "next_count_date", Value: 1}, {Key: "sku", Value: 1}}
+	}
+	if !filter.UpdatedSince.IsZero() {
+		query["last_updated"] = bson.M{"$gt": filter.UpdatedSince}
+		sort = bson.D{{Key: "last_updated", Value: 1}, {Key: "sku", Value: 1}}
+	}
+	return query, sort, nil
+}
+
+// ListFiltered returns a page of inventory items matching a filter along with
+// the total number of matching items
+func (r *InventoryRepository) ListFiltered(ctx context.Context, filter domain.InventoryFilter, limit, offset int) ([]*domain.InventoryItem, int64, error) {
+	r.logger.Debug("Listing filtered inventory items",
+		zap.String("location_id", filter.LocationID),
+		zap.String("stock_status", filter.StockStatus),
+		zap.Strings("tags", filter.Tags),
+		zap.Time("count_due_by", filter.CountDueBy),
+		zap.Time("updated_since", filter.UpdatedSince),
+		zap.Int("limit", limit),
+		zap.Int("offset", offset),
+	)
+	
+	query, sort, err := listFilteredQuery(filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	
+	total, err := r.reports.CountDocuments(ctx, query)
+	if err != nil {
+		r.logger.Error("Failed to count filtered inventory items", zap.Error(err))
+		return nil, 0, err
+	}
+	
+	findOptions := options.Find()
+	findOptions.SetSort(sort)
+	findOptions.SetLimit(int64(limit))
+	findOptions.SetSkip(int64(offset))
+	
+	cursor, err := r.reports.Find(ctx, query, findOptions)
+	if err != nil {
+		r.logger.Error("Failed to list filtered inventory items", zap.Error(err))
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+	
+	var items []*domain.InventoryItem
+	if err := cursor.All(ctx, &items); err != nil {
+		r.logger.Error("Failed to decode inventory items", zap.Error(err))
+		return nil, 0, err
+	}
+	
+	return items, total, nil
+}
+
+// CountLowStock counts inventory items that need reordering
+func (r *InventoryRepository) CountLowStock(ctx context.Context, locationID string) (int64, error) {
+	r.logger.Debug("Counting low stock inventory items", zap.String("location_id", locationID))
+	
+	filter := lowStockFilter()
+	if locationID != "" {
+		filter["location_id"] = locationID
+	}
+	
+	count, err := r.reports.CountDocuments(ctx, filter)
+	if err != nil {
+		r.logger.Error("Failed to count low stock inventory items", zap.Error(err))
+		return 0, err
+	}
+	
+	return count, nil
+}
+
 // ListByStockStatus returns inventory items based on stock status
 func (r *InventoryRepository) ListByStockStatus(ctx context.Context, status string, limit, offset int) ([]*domain.InventoryItem, error) {
 	r.logger.Debug("Listing inventory items by stock status", 
@@ -483,16 +669,9 @@ func (r *InventoryRepository) ListByStockStatus(ctx context.Context, status stri
 		filter = bson.M{"quantity": bson.M{"$gt": 0}}
 		
 	case "low_stock":
-		// Items below reorder threshold but not zero
-		filter = bson.M{
-			"quantity": bson.M{"$gt": 0},
-			"$expr": bson.M{
-				"$lt": []interface{}{
-					"$quantity", 
-					"$reorder_threshold",
-				},
-			},
-		}
+		// Items at or below their reorder point but not zero
+		filter = lowStockFilter()
+		filter["quantity"] = bson.M{"$gt": 0}
 		
 	case "out_of_stock":
 		// Items with zero quantity
@@ -503,7 +682,7 @@ func (r *InventoryRepository) ListByStockStatus(ctx context.Context, status stri
 		return nil, domain.ErrInvalidInput
 	}
 	
-	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	cursor, err := r.reports.Find(ctx, filter, findOptions)
 	if err != nil {
 		r.logger.Error("Failed to list inventory items by stock status", 
 			zap.Error(err),
@@ -531,30 +710,93 @@ func (r *InventoryRepository) ListByStockStatus(ctx context.Context, status stri
 	return items, nil
 }
 
+// GetByOrder finds inventory items reserved for a specific order across all locations
+func (r *InventoryRepository) GetByOrder(ctx context.Context, orderID string) ([]*domain.InventoryItem, error) {
+	r.logger.Debug("Getting inventory items by order",
+		zap.String("order_id", orderID),
+	)
+
+	return r.findReservedForOrder(ctx, bson.M{"reservations.order_id": orderID})
+}
+
 // GetByOrderAndLocation finds inventory items reserved for a specific order at a location
 func (r *InventoryRepository) GetByOrderAndLocation(ctx context.Context, orderID, locationID string) ([]*domain.InventoryItem, error) {
 	r.logger.Debug("Getting inventory items by order and location",
 		zap.String("order_id", orderID),
 		zap.String("location_id", locationID),
 	)
-	
-	// Find items that have reservations for this order at this location
-	filter := bson.M{
-		"location_id": locationID,
+
+	return r.findReservedForOrder(ctx, bson.M{
 		"reservations.order_id": orderID,
+		"location_id":           locationID,
+	})
+}
+
+// ListActiveOrderReservations finds items holding at least one active order
+// reservation that has not been touched since updatedBefore
+func (r *InventoryRepository) ListActiveOrderReservations(ctx context.Context, updatedBefore time.Time) ([]*domain.InventoryItem, error) {
+	r.logger.Debug("Listing active order reservations",
+		zap.Time("updated_before", updatedBefore),
+	)
+
+	cursor, err := r.collection.Find(ctx, bson.M{
+		"reservations": bson.M{"$elemMatch": bson.M{
+			"status":     domain.ReservationStatusActive,
+			"updated_at": bson.M{"$lt": updatedBefore},
+		}},
+	}, options.Find().SetSort(bson.D{{Key: "last_updated", Value: 1}}))
+	if err != nil {
+		r.logger.Error("Failed to list active order reservations", zap.Error(err))
+		return nil, err
 	}
-	
-	cursor, err := r.collection.Find(ctx, filter)
+	defer cursor.Close(ctx)
+
+	var items []*domain.InventoryItem
+	if err := cursor.All(ctx, &items); err != nil {
+		r.logger.Error("Failed to decode active order reservations", zap.Error(err))
+		return nil, err
+	}
+	return items, nil
+}
+
+// ListExpiredOrderReservations finds items holding at least one active order
+// reservation whose expiry has passed. Reservations without an expiry never
+// match.
+func (r *InventoryRepository) ListExpiredOrderReservations(ctx context.Context, now time.Time) ([]*domain.InventoryItem, error) {
+	r.logger.Debug("Listing expired order reservations", zap.Time("now", now))
+
+	cursor, err := r.collection.Find(ctx, bson.M{
+		"reservations": bson.M{"$elemMatch": bson.M{
+			"status":     domain.ReservationStatusActive,
+			"expires_at": bson.M{"$lte": now},
+		}},
+	}, options.Find().SetSort(bson.D{{Key: "reservations.expires_at", Value: 1}}))
 	if err != nil {
-		r.logger.Error("Failed to get inventory items by order and location",
+		r.logger.Error("Failed to list expired order reservations", zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var items []*domain.InventoryItem
+	if err := cursor.All(ctx, &items); err != nil {
+		r.logger.Error("Failed to decode expired order reservations", zap.Error(err))
+		return nil, err
+	}
+	return items, nil
+}
+
+// findReservedForOrder returns the inventory items matching an order reservation filter
+func (r *InventoryRepository) findReservedForOrder(ctx context.Context, filter bson.M) ([]*domain.InventoryItem, error) {
+	cursor, err := r.collection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "location_id", Value: 1}}))
+	if err != nil {
+		r.logger.Error("Failed to get inventory items reserved for order",
 			zap.Error(err),
-			zap.String("order_id", orderID),
-			zap.String("location_id", locationID),
+			zap.Any("filter", filter),
 		)
 		return nil, err
 	}
 	defer cursor.Close(ctx)
-	
+
 	var items []*domain.InventoryItem
 	for cursor.Next(ctx) {
 		var item domain.InventoryItem
@@ -564,15 +806,207 @@ func (r *InventoryRepository) GetByOrderAndLocation(ctx context.Context, orderID
 		}
 		items = append(items, &item)
 	}
-	
+
 	if err := cursor.Err(); err != nil {
-		r.logger.Error("Cursor error while getting inventory items by order and location", zap.Error(err))
+		r.logger.Error("Cursor error while getting inventory items reserved for order", zap.Error(err))
 		return nil, err
 	}
-	
+
 	return items, nil
 }
 
+// ListDuplicates returns the items at a location grouped by SKU, for every SKU
+// held by more than one item there. Each group is oldest first.
+func (r *InventoryRepository) ListDuplicates(ctx context.Context, locationID string) ([][]*domain.InventoryItem, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"location_id": locationID, "sku": bson.M{"$nin": bson.A{"", nil}}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   "$sku",
+			"items": bson.M{"$push": "$$ROOT"},
+			"count": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$match", Value: bson.M{"count": bson.M{"$gt": 1}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id", Value: 1}}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		r.logger.Error("Failed to find duplicate inventory items",
+			zap.String("location_id", locationID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var groups []struct {
+		Items []*domain.InventoryItem `bson:"items"`
+	}
+	if err := cursor.All(ctx, &groups); err != nil {
+		r.logger.Error("Failed to decode duplicate inventory items", zap.Error(err))
+		return nil, err
+	}
+
+	duplicates := make([][]*domain.InventoryItem, 0, len(groups))
+	for _, group := range groups {
+		duplicates = append(duplicates, group.Items)
+	}
+	return duplicates, nil
+}
+
+// MergeItems saves kept and deletes the items in mergedIDs in one transaction.
+// History recorded against the deleted items is moved to kept. Transactions
+// need MongoDB to run as a replica set.
+func (r *InventoryRepository) MergeItems(ctx context.Context, kept *domain.InventoryItem, mergedIDs []string) error {
+	session, err := r.collection.Database().Client().StartSession()
+	if err != nil {
+		r.logger.Error("Failed to start session for inventory merge", zap.Error(err))
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	kept.LastUpdated = time.Now()
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		result, err := r.collection.ReplaceOne(sc, bson.M{"_id": kept.ID}, kept)
+		if err != nil {
+			return nil, err
+		}
+		if result.MatchedCount == 0 {
+			return nil, errors.New("inventory item not found")
+		}
+
+		deleted, err := r.collection.DeleteMany(sc, bson.M{"_id": bson.M{"$in": mergedIDs}})
+		if err != nil {
+			return nil, err
+		}
+		if deleted.DeletedCount != int64(len(mergedIDs)) {
+			return nil, errors.New("duplicate inventory items changed during merge")
+		}
+
+		_, err = r.history.UpdateMany(sc,
+			bson.M{"inventory_id": bson.M{"$in": mergedIDs}},
+			bson.M{"$set": bson.M{"inventory_id": kept.ID}},
+		)
+		return nil, err
+	})
+	if err != nil {
+		r.logger.Error("Failed to merge inventory items",
+			zap.String("kept_id", kept.ID),
+			zap.Strings("merged_ids", mergedIDs),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
+
+// TransferStock moves stock between two locations in one transaction: the
+// source is read and checked before the destination is touched, and a write
+// to either item that races with the transfer makes it retry. Transactions
+// need MongoDB to run as a replica set.
+func (r *InventoryRepository) TransferStock(ctx context.Context, transfer *domain.StockTransfer) error {
+	session, err := r.collection.Database().Client().StartSession()
+	if err != nil {
+		r.logger.Error("Failed to start session for stock transfer", zap.Error(err))
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		var source domain.InventoryItem
+		err := r.collection.FindOne(sc, bson.M{"sku": transfer.SKU, "location_id": transfer.FromLocationID}).Decode(&source)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%w: no inventory for SKU %s at location %s", domain.ErrNotFound, transfer.SKU, transfer.FromLocationID)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !source.IsAvailable(transfer.Quantity) {
+			return nil, fmt.Errorf("%w: %d of SKU %s available at location %s", domain.ErrInsufficientStock, source.GetAvailable(), transfer.SKU, transfer.FromLocationID)
+		}
+
+		var destination domain.InventoryItem
+		created := false
+		err = r.collection.FindOne(sc, bson.M{"sku": transfer.SKU, "location_id": transfer.ToLocationID}).Decode(&destination)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			destination = *domain.NewInventoryItem(source.ProductID, 0, source.SKU, transfer.ToLocationID)
+			destination.SellingUnit = source.SellingUnit
+			destination.StockingUnit = source.StockingUnit
+			destination.UnitsPerStockingUnit = source.UnitsPerStockingUnit
+			created = true
+		} else if err != nil {
+			return nil, err
+		}
+
+		sourceBefore, destinationBefore := source.Quantity, destination.Quantity
+		if err := source.TransferStock(transfer.Quantity, &destination); err != nil {
+			return nil, fmt.Errorf("%w: %v", domain.ErrInsufficientStock, err)
+		}
+
+		if _, err := r.collection.ReplaceOne(sc, bson.M{"_id": source.ID}, &source); err != nil {
+			return nil, err
+		}
+		if created {
+			_, err = r.collection.InsertOne(sc, &destination)
+		} else {
+			_, err = r.collection.ReplaceOne(sc, bson.M{"_id": destination.ID}, &destination)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		now := time.Now()
+		_, err = r.history.InsertMany(sc, []interface{}{
+			&domain.InventoryHistory{
+				InventoryID:    source.ID,
+				ChangeType:     domain.HistoryChangeTransfer,
+				Description:    fmt.Sprintf("Transferred %d units to location %s", transfer.Quantity, transfer.ToLocationID),
+				QuantityBefore: sourceBefore,
+				QuantityAfter:  source.Quantity,
+				ReferenceID:    transfer.ID,
+				ReferenceType:  "TRANSFER",
+				PerformedBy:    transfer.PerformedBy,
+				CreatedAt:      now,
+			},
+			&domain.InventoryHistory{
+				InventoryID:    destination.ID,
+				ChangeType:     domain.HistoryChangeTransfer,
+				Description:    fmt.Sprintf("Received %d units from location %s", transfer.Quantity, transfer.FromLocationID),
+				QuantityBefore: destinationBefore,
+				QuantityAfter:  destination.Quantity,
+				ReferenceID:    transfer.ID,
+				ReferenceType:  "TRANSFER",
+				PerformedBy:    transfer.PerformedBy,
+				CreatedAt:      now,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		transfer.Source = &source
+		transfer.Destination = &destination
+		transfer.DestinationCreated = created
+		transfer.CreatedAt = now
+		return nil, nil
+	})
+	if errors.Is(err, domain.ErrNotFound) || errors.Is(err, domain.ErrInsufficientStock) {
+		return err
+	}
+	if err != nil {
+		r.logger.Error("Failed to transfer stock",
+			zap.String("transfer_id", transfer.ID),
+			zap.String("sku", transfer.SKU),
+			zap.String("from_location_id", transfer.FromLocationID),
+			zap.String("to_location_id", transfer.ToLocationID),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
+
 // GetHistory retrieves the history of changes for a specific inventory item
 func (r *InventoryRepository) GetHistory(ctx context.Context, inventoryID string, limit, offset int32) ([]*domain.InventoryHistory, int32, error) {
 	r.logger.Debug("Getting inventory history", 
@@ -582,7 +1016,7 @@ func (r *InventoryRepository) GetHistory(ctx context.Context, inventoryID string
 	)
 
 	// First, get the total count for pagination
-	totalCount, err := r.collection.CountDocuments(ctx, bson.M{"inventory_id": inventoryID})
+	totalCount, err := r.historyReports.CountDocuments(ctx, bson.M{"inventory_id": inventoryID})
 	if err != nil {
 		r.logger.Error("Failed to count inventory history", 
 			zap.String("inventory_id", inventoryID),
@@ -601,7 +1035,7 @@ func (r *InventoryRepository) GetHistory(ctx context.Context, inventoryID string
 		opts.SetSkip(int64(offset))
 	}
 
-	cursor, err := r.collection.Find(ctx, bson.M{"inventory_id": inventoryID}, opts)
+	cursor, err := r.historyReports.Find(ctx, bson.M{"inventory_id": inventoryID}, opts)
 	if err != nil {
 		r.logger.Error("Failed to find inventory history", 
 			zap.String("inventory_id", inventoryID),
@@ -626,7 +1060,7 @@ func (r *InventoryRepository) GetHistory(ctx context.Context, inventoryID string
 // RecordHistory adds a new history entry for an inventory item
 func (r *InventoryRepository) RecordHistory(ctx context.Context, history *domain.InventoryHistory) error {
 	history.CreatedAt = time.Now()
-	_, err := r.collection.InsertOne(ctx, history)
+	_, err := r.history.InsertOne(ctx, history)
 	if err != nil {
 		r.logger.Error("Failed to record inventory history", 
 			zap.String("inventory_id", history.InventoryID),
@@ -643,6 +1077,45 @@ func (r *InventoryRepository) RecordHistory(ctx context.Context, history *domain
 	return nil
 }
 
+// Reserve reserves quantity units of an item. The availability check is part
+// of the update filter, so the check and the increment are one atomic write.
+func (r *InventoryRepository) Reserve(ctx context.Context, id string, quantity int32) error {
+	r.logger.Debug("Reserving stock",
+		zap.String("id", id),
+		zap.Int32("quantity", quantity),
+	)
+
+	filter := bson.M{
+		"_id": id,
+		"$expr": bson.M{"$gte": bson.A{bson.M{"$subtract": bson.A{"$quantity", "$reserved"}}, quantity}},
+	}
+	update := bson.M{
+		"$inc": bson.M{"reserved": quantity},
+		"$set": bson.M{"last_updated": time.Now()},
+	}
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		r.logger.Error("Failed to reserve stock",
+			zap.Error(err),
+			zap.String("id", id),
+		)
+		return err
+	}
+	if result.MatchedCount > 0 {
+		return nil
+	}
+
+	// Tell a missing item from one without enough stock
+	count, err := r.collection.CountDocuments(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.ErrNotFound
+	}
+	return domain.ErrInsufficientStock
+}
+
 // AdjustStock adjusts stock with a reason and user identification
 func (r *InventoryRepository) AdjustStock(ctx context.Context, id string, quantity int32, reason, performedBy string) error {
 	r.logger.Debug("Adjusting stock",
@@ -684,22 +1157,13 @@ func (r *InventoryRepository) AdjustStock(ctx context.Context, id string, quanti
 		return domain.ErrInsufficientStock
 	}
 	
-	// Create update document
+	// Create update document. The adjustment itself goes to the history
+	// collection so the item document does not grow with every adjustment.
 	update := bson.M{
 		"$set": bson.M{
 			"quantity": item.Quantity,
 			"last_updated": item.LastUpdated,
 		},
-		"$push": bson.M{
-			"stock_adjustments": bson.M{
-				"adjustment": quantity,
-				"previous_quantity": prevQuantity,
-				"new_quantity": item.Quantity,
-				"reason": reason,
-				"performed_by": performedBy,
-				"timestamp": item.LastUpdated,
-			},
-		},
 	}
 	
 	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, update)
@@ -718,6 +1182,24 @@ func (r *InventoryRepository) AdjustStock(ctx context.Context, id string, quanti
 		return domain.ErrNotFound
 	}
 	
+	err = r.RecordHistory(ctx, &domain.InventoryHistory{
+		InventoryID:    id,
+		ChangeType:     domain.ChangeTypeAdjustment,
+		Description:    reason,
+		QuantityBefore: prevQuantity,
+		QuantityAfter:  item.Quantity,
+		ReferenceType:  "MANUAL",
+		PerformedBy:    performedBy,
+	})
+	if err != nil {
+		// The stock change is already applied; losing its history entry
+		// must not make the caller retry it
+		r.logger.Error("Failed to record stock adjustment history",
+			zap.Error(err),
+			zap.String("id", id),
+		)
+	}
+	
 	r.logger.Info("Stock adjustment completed",
 		zap.String("id", id),
 		zap.Int32("prev_quantity", prevQuantity),
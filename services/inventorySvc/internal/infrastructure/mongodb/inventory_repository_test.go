@@ -0,0 +1,69 @@
+package mongodb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+func TestListFilteredQueryRequiresEveryTag(t *testing.T) {
+	query, sort, err := listFilteredQuery(domain.InventoryFilter{
+		LocationID:  "store-1",
+		StockStatus: domain.StockStatusInStock,
+		Tags:        []string{"fragile", "cold-chain"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, bson.M{"$all": []string{"fragile", "cold-chain"}}, query["tags"])
+	assert.Equal(t, "store-1", query["location_id"])
+	assert.Equal(t, bson.M{"$gt": 0}, query["quantity"])
+	assert.Equal(t, bson.D{{Key: "sku", Value: 1}}, sort)
+}
+
+func TestListFilteredQueryWithoutTags(t *testing.T) {
+	query, _, err := listFilteredQuery(domain.InventoryFilter{LocationID: "store-1"})
+	require.NoError(t, err)
+
+	_, ok := query["tags"]
+	assert.False(t, ok, "no tag condition expected")
+}
+
+func TestListFilteredQueryRejectsUnknownStockStatus(t *testing.T) {
+	_, _, err := listFilteredQuery(domain.InventoryFilter{StockStatus: "SOMETIMES"})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestListFilteredQueryForDueCounts(t *testing.T) {
+	asOf := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	query, sort, err := listFilteredQuery(domain.InventoryFilter{LocationID: "warehouse-1", CountDueBy: asOf})
+	require.NoError(t, err)
+
+	assert.Equal(t, bson.M{"$gt": time.Time{}, "$lte": asOf}, query["next_count_date"], "unscheduled items are left out")
+	assert.Equal(t, "warehouse-1", query["location_id"])
+	assert.Equal(t, bson.D{{Key: "next_count_date", Value: 1}, {Key: "sku", Value: 1}}, sort)
+}
+
+func TestListFilteredQueryUpdatedSince(t *testing.T) {
+	since := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	query, sort, err := listFilteredQuery(domain.InventoryFilter{LocationID: "warehouse-1", UpdatedSince: since})
+	require.NoError(t, err)
+
+	assert.Equal(t, bson.M{"$gt": since}, query["last_updated"], "items changed at exactly since were already synced")
+	assert.Equal(t, "warehouse-1", query["location_id"])
+	assert.Equal(t, bson.D{{Key: "last_updated", Value: 1}, {Key: "sku", Value: 1}}, sort, "oldest change first")
+}
+
+func TestListFilteredQueryWithoutUpdatedSince(t *testing.T) {
+	query, _, err := listFilteredQuery(domain.InventoryFilter{LocationID: "warehouse-1"})
+	require.NoError(t, err)
+
+	_, ok := query["last_updated"]
+	assert.False(t, ok, "a zero time must not filter on last_updated")
+}
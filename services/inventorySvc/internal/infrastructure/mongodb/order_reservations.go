@@ -0,0 +1,83 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// legacyOrderReservation is the single order reservation slot inventory items
+// had before they kept one reservation record per order
+type legacyOrderReservation struct {
+	ID          string    `bson:"_id"`
+	OrderID     string    `bson:"order_id"`
+	Quantity    int32     `bson:"reserved_quantity"`
+	Status      string    `bson:"reservation_status"`
+	ExpiresAt   time.Time `bson:"reservation_expires_at"`
+	LastUpdated time.Time `bson:"last_updated"`
+}
+
+// MigrateOrderReservations turns the single order reservation slot of older
+// inventory items into a reservation record and removes the slot's fields.
+// An active slot that never tracked its own quantity becomes no record: its
+// units stay reserved but belong to no order, since guessing would hand
+// another order's units to it. It returns the number of items migrated.
+func MigrateOrderReservations(ctx context.Context, db *mongo.Database, collectionName string, logger *zap.Logger) (int, error) {
+	items := db.Collection(collectionName)
+
+	cursor, err := items.Find(ctx, bson.M{"order_id": bson.M{"$exists": true}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to find items with a legacy order reservation: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	migrated := 0
+	for cursor.Next(ctx) {
+		var legacy legacyOrderReservation
+		if err := cursor.Decode(&legacy); err != nil {
+			return migrated, fmt.Errorf("failed to decode inventory item: %w", err)
+		}
+
+		update := bson.M{"$unset": bson.M{
+			"order_id":               "",
+			"reserved_quantity":      "",
+			"reservation_status":     "",
+			"reservation_expires_at": "",
+		}}
+		unknownQuantity := legacy.Status == domain.ReservationStatusActive && legacy.Quantity <= 0
+		if legacy.OrderID != "" && !unknownQuantity {
+			status := legacy.Status
+			if status == "" {
+				status = domain.ReservationStatusActive
+			}
+			update["$push"] = bson.M{"reservations": domain.ItemReservation{
+				OrderID:   legacy.OrderID,
+				Quantity:  legacy.Quantity,
+				Status:    status,
+				ExpiresAt: legacy.ExpiresAt,
+				UpdatedAt: legacy.LastUpdated,
+			}}
+		}
+		if unknownQuantity && legacy.OrderID != "" {
+			logger.Warn("Legacy order reservation has no quantity; its units are left unassigned",
+				zap.String("inventory_id", legacy.ID),
+				zap.String("order_id", legacy.OrderID),
+			)
+		}
+
+		if _, err := items.UpdateOne(ctx, bson.M{"_id": legacy.ID}, update); err != nil {
+			return migrated, fmt.Errorf("failed to migrate order reservation of item %s: %w", legacy.ID, err)
+		}
+		migrated++
+	}
+	if err := cursor.Err(); err != nil {
+		return migrated, fmt.Errorf("failed to read inventory items: %w", err)
+	}
+	return migrated, nil
+}
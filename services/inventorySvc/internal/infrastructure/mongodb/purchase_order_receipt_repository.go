@@ -0,0 +1,71 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// PurchaseOrderReceiptRepository implements the domain.PurchaseOrderReceiptRepository interface using MongoDB
+type PurchaseOrderReceiptRepository struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewPurchaseOrderReceiptRepository creates a new PurchaseOrderReceiptRepository.
+// Receipts are keyed by purchase order ID, so no extra index is needed.
+func NewPurchaseOrderReceiptRepository(db *mongo.Database, collectionName string, logger *zap.Logger) domain.PurchaseOrderReceiptRepository {
+	return &PurchaseOrderReceiptRepository{
+		collection: db.Collection(collectionName),
+		logger:     logger.Named("purchase_order_receipt_repository"),
+	}
+}
+
+// GetByPurchaseOrder returns the receipt of a purchase order
+func (r *PurchaseOrderReceiptRepository) GetByPurchaseOrder(ctx context.Context, poID string) (*domain.PurchaseOrderReceipt, error) {
+	var receipt domain.PurchaseOrderReceipt
+	err := r.collection.FindOne(ctx, bson.M{"_id": poID}).Decode(&receipt)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, domain.ErrNotFound
+		}
+		r.logger.Error("Failed to get purchase order receipt", zap.String("po_id", poID), zap.Error(err))
+		return nil, err
+	}
+	return &receipt, nil
+}
+
+// Save inserts a new receipt or replaces the stored one if its version has not moved
+func (r *PurchaseOrderReceiptRepository) Save(ctx context.Context, receipt *domain.PurchaseOrderReceipt) error {
+	expected := receipt.Version
+	receipt.Version++
+
+	if expected == 0 {
+		if _, err := r.collection.InsertOne(ctx, receipt); err != nil {
+			receipt.Version = expected
+			if mongo.IsDuplicateKeyError(err) {
+				return domain.ErrConcurrentUpdate
+			}
+			r.logger.Error("Failed to insert purchase order receipt", zap.String("po_id", receipt.ID), zap.Error(err))
+			return err
+		}
+		return nil
+	}
+
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": receipt.ID, "version": expected}, receipt)
+	if err != nil {
+		receipt.Version = expected
+		r.logger.Error("Failed to update purchase order receipt", zap.String("po_id", receipt.ID), zap.Error(err))
+		return err
+	}
+	if result.MatchedCount == 0 {
+		receipt.Version = expected
+		return domain.ErrConcurrentUpdate
+	}
+	return nil
+}
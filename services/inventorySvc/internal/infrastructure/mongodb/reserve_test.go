@@ -0,0 +1,53 @@
+package mongodb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+func TestReserveChecksAvailabilityInTheUpdate(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("available", func(mt *mtest.T) {
+		repo := newTransferTestRepository(mt)
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+
+		require.NoError(t, repo.Reserve(context.Background(), "item-1", 3))
+
+		events := mt.GetAllStartedEvents()
+		require.Len(t, events, 1, "the check and the reservation must be one write")
+		update := events[0].Command.Lookup("updates").Array().Index(0).Value().Document()
+		_, err := update.LookupErr("q", "$expr", "$gte")
+		assert.NoError(t, err, "filter %v must require enough available stock", update.Lookup("q"))
+		assert.Equal(t, int32(3), update.Lookup("u", "$inc", "reserved").Int32())
+	})
+
+	mt.Run("insufficient", func(mt *mtest.T) {
+		repo := newTransferTestRepository(mt)
+		ns := mt.Coll.Database().Name() + "." + mt.Coll.Name()
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}),
+			mtest.CreateCursorResponse(0, ns, mtest.FirstBatch, bson.D{{Key: "n", Value: 1}}),
+		)
+
+		assert.ErrorIs(t, repo.Reserve(context.Background(), "item-1", 3), domain.ErrInsufficientStock)
+	})
+
+	mt.Run("missing item", func(mt *mtest.T) {
+		repo := newTransferTestRepository(mt)
+		ns := mt.Coll.Database().Name() + "." + mt.Coll.Name()
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}),
+			mtest.CreateCursorResponse(0, ns, mtest.FirstBatch),
+		)
+
+		assert.ErrorIs(t, repo.Reserve(context.Background(), "item-1", 3), domain.ErrNotFound)
+	})
+}
@@ -0,0 +1,143 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// HistoryCollectionName is the collection holding inventory history entries
+const HistoryCollectionName = "inventory_history"
+
+// ListAdjustments returns the stock adjustments matching filter, oldest first.
+// The SKU and location come from the adjusted item; adjustments of items that
+// no longer exist are only returned when no location is asked for.
+func (r *InventoryRepository) ListAdjustments(ctx context.Context, filter domain.StockAdjustmentFilter) ([]*domain.StockAdjustment, error) {
+	match := bson.M{"change_type": domain.ChangeTypeAdjustment}
+	if filter.Reason != "" {
+		match["description"] = primitive.Regex{Pattern: "^" + regexp.QuoteMeta(filter.Reason) + "$", Options: "i"}
+	}
+	created := bson.M{}
+	if !filter.From.IsZero() {
+		created["$gte"] = filter.From
+	}
+	if !filter.To.IsZero() {
+		created["$lt"] = filter.To
+	}
+	if len(created) > 0 {
+		match["created_at"] = created
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$sort", Value: bson.D{{Key: "created_at", Value: 1}}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         r.collection.Name(),
+			"localField":   "inventory_id",
+			"foreignField": "_id",
+			"as":           "item",
+		}}},
+		{{Key: "$addFields", Value: bson.M{
+			"sku":         bson.M{"$arrayElemAt": bson.A{"$item.sku", 0}},
+			"location_id": bson.M{"$arrayElemAt": bson.A{"$item.location_id", 0}},
+		}}},
+		{{Key: "$project", Value: bson.M{"item": 0}}},
+	}
+	if filter.LocationID != "" {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M{"location_id": filter.LocationID}}})
+	}
+
+	cursor, err := r.historyReports.Aggregate(ctx, pipeline)
+	if err != nil {
+		r.logger.Error("Failed to list stock adjustments", zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var adjustments []*domain.StockAdjustment
+	if err := cursor.All(ctx, &adjustments); err != nil {
+		r.logger.Error("Failed to decode stock adjustments", zap.Error(err))
+		return nil, err
+	}
+	return adjustments, nil
+}
+
+// embeddedAdjustment is the shape AdjustStock used to push into the
+// stock_adjustments array of an inventory item
+type embeddedAdjustment struct {
+	Adjustment       int32     `bson:"adjustment"`
+	PreviousQuantity int32     `bson:"previous_quantity"`
+	NewQuantity      int32     `bson:"new_quantity"`
+	Reason           string    `bson:"reason"`
+	PerformedBy      string    `bson:"performed_by"`
+	Timestamp        time.Time `bson:"timestamp"`
+}
+
+// MigrateEmbeddedAdjustments moves the stock adjustments embedded in inventory
+// items into the history collection and removes the embedded arrays. Entries
+// get IDs derived from the item and their position, so a migration that was
+// interrupted can simply be run again. It returns the number of items migrated.
+func MigrateEmbeddedAdjustments(ctx context.Context, db *mongo.Database, collectionName string, logger *zap.Logger) (int, error) {
+	items := db.Collection(collectionName)
+	history := db.Collection(HistoryCollectionName)
+
+	cursor, err := items.Find(ctx, bson.M{"stock_adjustments.0": bson.M{"$exists": true}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to find items with embedded adjustments: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	migrated := 0
+	for cursor.Next(ctx) {
+		var item struct {
+			ID          string               `bson:"_id"`
+			Adjustments []embeddedAdjustment `bson:"stock_adjustments"`
+		}
+		if err := cursor.Decode(&item); err != nil {
+			return migrated, fmt.Errorf("failed to decode inventory item: %w", err)
+		}
+
+		entries := make([]interface{}, 0, len(item.Adjustments))
+		for i, adj := range item.Adjustments {
+			entries = append(entries, &domain.InventoryHistory{
+				ID:             fmt.Sprintf("%s-adjustment-%d", item.ID, i),
+				InventoryID:    item.ID,
+				ChangeType:     domain.ChangeTypeAdjustment,
+				Description:    adj.Reason,
+				QuantityBefore: adj.PreviousQuantity,
+				QuantityAfter:  adj.NewQuantity,
+				ReferenceType:  "MANUAL",
+				PerformedBy:    adj.PerformedBy,
+				CreatedAt:      adj.Timestamp,
+			})
+		}
+		// Entries left behind by an earlier, interrupted run are duplicates
+		// and can be skipped
+		_, err := history.InsertMany(ctx, entries, options.InsertMany().SetOrdered(false))
+		if err != nil && !mongo.IsDuplicateKeyError(err) {
+			return migrated, fmt.Errorf("failed to copy adjustments of item %s: %w", item.ID, err)
+		}
+
+		if _, err := items.UpdateOne(ctx, bson.M{"_id": item.ID}, bson.M{"$unset": bson.M{"stock_adjustments": ""}}); err != nil {
+			return migrated, fmt.Errorf("failed to remove embedded adjustments of item %s: %w", item.ID, err)
+		}
+		migrated++
+		logger.Debug("Migrated embedded stock adjustments",
+			zap.String("inventory_id", item.ID),
+			zap.Int("adjustments", len(entries)),
+		)
+	}
+	if err := cursor.Err(); err != nil {
+		return migrated, fmt.Errorf("failed to read inventory items: %w", err)
+	}
+	return migrated, nil
+}
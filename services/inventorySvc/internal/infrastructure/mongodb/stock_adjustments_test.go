@@ -0,0 +1,114 @@
+package mongodb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// itemWithEmbeddedAdjustments is an inventory item as AdjustStock used to store it
+func itemWithEmbeddedAdjustments(id string, at time.Time) bson.D {
+	return bson.D{
+		{Key: "_id", Value: id},
+		{Key: "sku", Value: "SKU-1"},
+		{Key: "stock_adjustments", Value: bson.A{
+			bson.D{
+				{Key: "adjustment", Value: int32(5)},
+				{Key: "previous_quantity", Value: int32(10)},
+				{Key: "new_quantity", Value: int32(15)},
+				{Key: "reason", Value: "Recount"},
+				{Key: "performed_by", Value: "clerk-1"},
+				{Key: "timestamp", Value: at},
+			},
+			bson.D{
+				{Key: "adjustment", Value: int32(-3)},
+				{Key: "previous_quantity", Value: int32(15)},
+				{Key: "new_quantity", Value: int32(12)},
+				{Key: "reason", Value: "Damaged"},
+				{Key: "performed_by", Value: "clerk-2"},
+				{Key: "timestamp", Value: at.Add(time.Hour)},
+			},
+		}},
+	}
+}
+
+func TestMigrateEmbeddedAdjustments(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	at := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	mt.Run("moves adjustments to history", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "test.inventory_items", mtest.FirstBatch, itemWithEmbeddedAdjustments("item-1", at)),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 2}),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}),
+		)
+
+		migrated, err := MigrateEmbeddedAdjustments(context.Background(), mt.DB, "inventory_items", zap.NewNop())
+		require.NoError(mt, err)
+		assert.Equal(mt, 1, migrated)
+
+		started := mt.GetAllStartedEvents()
+		require.Len(mt, started, 3)
+
+		insert := started[1].Command
+		assert.Equal(mt, "insert", started[1].CommandName)
+		assert.Equal(mt, HistoryCollectionName, insert.Lookup("insert").StringValue())
+		docs, err := insert.Lookup("documents").Array().Values()
+		require.NoError(mt, err)
+		require.Len(mt, docs, 2)
+
+		var first domain.InventoryHistory
+		require.NoError(mt, bson.Unmarshal(docs[0].Document(), &first))
+		assert.Equal(mt, "item-1-adjustment-0", first.ID)
+		assert.Equal(mt, "item-1", first.InventoryID)
+		assert.Equal(mt, domain.ChangeTypeAdjustment, first.ChangeType)
+		assert.Equal(mt, "Recount", first.Description)
+		assert.Equal(mt, int32(10), first.QuantityBefore)
+		assert.Equal(mt, int32(15), first.QuantityAfter)
+		assert.Equal(mt, "clerk-1", first.PerformedBy)
+		assert.True(mt, at.Equal(first.CreatedAt))
+
+		update := started[2].Command
+		assert.Equal(mt, "update", started[2].CommandName)
+		updates, err := update.Lookup("updates").Array().Values()
+		require.NoError(mt, err)
+		require.Len(mt, updates, 1)
+		assert.Equal(mt, "item-1", updates[0].Document().Lookup("q", "_id").StringValue())
+		_, err = updates[0].Document().LookupErr("u", "$unset", "stock_adjustments")
+		assert.NoError(mt, err, "the embedded array should be removed")
+	})
+
+	mt.Run("rerun after interruption", func(mt *mtest.T) {
+		// The history entries were copied before the run stopped, so the
+		// insert hits their IDs again
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "test.inventory_items", mtest.FirstBatch, itemWithEmbeddedAdjustments("item-1", at)),
+			mtest.CreateWriteErrorsResponse(
+				mtest.WriteError{Index: 0, Code: 11000, Message: "duplicate key"},
+				mtest.WriteError{Index: 1, Code: 11000, Message: "duplicate key"},
+			),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}),
+		)
+
+		migrated, err := MigrateEmbeddedAdjustments(context.Background(), mt.DB, "inventory_items", zap.NewNop())
+		require.NoError(mt, err)
+		assert.Equal(mt, 1, migrated)
+		assert.Len(mt, mt.GetAllStartedEvents(), 3, "the embedded array is still removed")
+	})
+
+	mt.Run("nothing to migrate", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "test.inventory_items", mtest.FirstBatch))
+
+		migrated, err := MigrateEmbeddedAdjustments(context.Background(), mt.DB, "inventory_items", zap.NewNop())
+		require.NoError(mt, err)
+		assert.Zero(mt, migrated)
+	})
+}
@@ -0,0 +1,109 @@
+package mongodb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// transferCommands returns the names of the commands mt sent, in order
+func transferCommands(mt *mtest.T) []string {
+	var names []string
+	for _, e := range mt.GetAllStartedEvents() {
+		names = append(names, e.CommandName)
+	}
+	return names
+}
+
+func newTransferTestRepository(mt *mtest.T) *InventoryRepository {
+	return &InventoryRepository{
+		collection: mt.Coll,
+		history:    mt.DB.Collection("inventory_history"),
+		logger:     zap.NewNop(),
+	}
+}
+
+func inventoryDocument(item *domain.InventoryItem) bson.D {
+	raw, err := bson.Marshal(item)
+	if err != nil {
+		panic(err)
+	}
+	var doc bson.D
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		panic(err)
+	}
+	return doc
+}
+
+func TestTransferStockInsufficientStockLeavesDestinationUntouched(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("insufficient", func(mt *mtest.T) {
+		repo := newTransferTestRepository(mt)
+		ns := mt.Coll.Database().Name() + "." + mt.Coll.Name()
+		source := domain.NewInventoryItem("product-1", 2, "SKU-1", "store-1")
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, ns, mtest.FirstBatch, inventoryDocument(source)),
+			mtest.CreateSuccessResponse(), // abortTransaction
+		)
+
+		err := repo.TransferStock(context.Background(), &domain.StockTransfer{
+			ID: "transfer-1", SKU: "SKU-1", FromLocationID: "store-1", ToLocationID: "store-2", Quantity: 5,
+		})
+
+		require.ErrorIs(t, err, domain.ErrInsufficientStock)
+		for _, name := range transferCommands(mt) {
+			assert.NotContains(t, []string{"insert", "update"}, name, "nothing may be written")
+		}
+	})
+}
+
+func TestTransferStockWritesBothSidesInOneTransaction(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("new destination", func(mt *mtest.T) {
+		repo := newTransferTestRepository(mt)
+		ns := mt.Coll.Database().Name() + "." + mt.Coll.Name()
+		source := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, ns, mtest.FirstBatch, inventoryDocument(source)),
+			mtest.CreateCursorResponse(0, ns, mtest.FirstBatch),     // no item at the destination yet
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}), // replace source
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}), // insert destination
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 2}), // history
+			mtest.CreateSuccessResponse(),                           // commitTransaction
+		)
+		transfer := &domain.StockTransfer{
+			ID: "transfer-1", SKU: "SKU-1", FromLocationID: "store-1", ToLocationID: "store-2", Quantity: 4, PerformedBy: "staff-1",
+		}
+
+		require.NoError(t, repo.TransferStock(context.Background(), transfer))
+
+		assert.Equal(t, []string{"find", "find", "update", "insert", "insert", "commitTransaction"}, transferCommands(mt))
+		for _, e := range mt.GetAllStartedEvents()[:5] {
+			_, err := e.Command.LookupErr("txnNumber")
+			assert.NoError(t, err, "%s must run in the transaction", e.CommandName)
+		}
+		assert.Equal(t, int32(6), transfer.Source.Quantity)
+		assert.Equal(t, int32(4), transfer.Destination.Quantity)
+		assert.Equal(t, "store-2", transfer.Destination.LocationID)
+		assert.True(t, transfer.DestinationCreated)
+
+		history := mt.GetAllStartedEvents()[4].Command.Lookup("documents").Array()
+		values, err := history.Values()
+		require.NoError(t, err)
+		require.Len(t, values, 2)
+		for _, v := range values {
+			entry := v.Document()
+			assert.Equal(t, domain.HistoryChangeTransfer, entry.Lookup("change_type").StringValue())
+			assert.Equal(t, "transfer-1", entry.Lookup("reference_id").StringValue(), "both sides share the transfer ID")
+		}
+	})
+}
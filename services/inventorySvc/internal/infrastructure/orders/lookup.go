@@ -0,0 +1,57 @@
+package orders
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	orderclient "github.com/leonvanderhaeghen/stockplatform/pkg/clients/order"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// Lookup implements domain.OrderLookup on top of the order service
+type Lookup struct {
+	client *orderclient.Client
+}
+
+// NewLookup creates an order lookup connected to the order service
+func NewLookup(orderServiceAddr string, logger *zap.Logger) (*Lookup, error) {
+	client, err := orderclient.New(orderclient.Config{Address: orderServiceAddr}, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create order client: %w", err)
+	}
+	return &Lookup{client: client}, nil
+}
+
+// OrderState fetches the order and classifies its status. Only a NotFound
+// answer makes an order missing; an unreachable order service is reported
+// as domain.ErrOrderLookupUnavailable.
+func (l *Lookup) OrderState(ctx context.Context, orderID string) (domain.OrderState, error) {
+	order, err := l.client.GetOrder(ctx, orderID)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			return domain.OrderStateMissing, nil
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return "", fmt.Errorf("%w: %v", domain.ErrOrderLookupUnavailable, err)
+		}
+		return "", err
+	}
+
+	switch order.Status {
+	case models.OrderStatusCancelled, models.OrderStatusFailed:
+		return domain.OrderStateCancelled, nil
+	case models.OrderStatusShipped, models.OrderStatusDelivered:
+		return domain.OrderStateCompleted, nil
+	}
+	return domain.OrderStateOpen, nil
+}
+
+// Close closes the order service connection
+func (l *Lookup) Close() error {
+	return l.client.Close()
+}
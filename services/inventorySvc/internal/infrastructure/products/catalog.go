@@ -0,0 +1,48 @@
+package products
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	productclient "github.com/leonvanderhaeghen/stockplatform/pkg/clients/product"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// Catalog implements domain.ProductCatalog on top of the product service
+type Catalog struct {
+	client *productclient.Client
+}
+
+// NewCatalog creates a product catalog connected to the product service
+func NewCatalog(productServiceAddr string, logger *zap.Logger) (*Catalog, error) {
+	client, err := productclient.New(productclient.Config{Address: productServiceAddr}, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create product client: %w", err)
+	}
+	return &Catalog{client: client}, nil
+}
+
+// ProductSKU fetches the product and returns its SKU. Malformed IDs are
+// reported as missing products, like IDs no product has.
+func (c *Catalog) ProductSKU(ctx context.Context, productID string) (string, error) {
+	product, err := c.client.GetProduct(ctx, productID)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound, codes.InvalidArgument:
+			return "", domain.ErrProductNotFound
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return "", fmt.Errorf("%w: %v", domain.ErrProductCatalogUnavailable, err)
+		}
+		return "", err
+	}
+	return product.SKU, nil
+}
+
+// Close closes the product service connection
+func (c *Catalog) Close() error {
+	return c.client.Close()
+}
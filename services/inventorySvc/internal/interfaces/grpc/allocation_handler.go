@@ -0,0 +1,70 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// ReserveWithAllocation reserves an order across locations, all lines or none
+func (s *InventoryServer) ReserveWithAllocation(ctx context.Context, req *inventoryv1.ReserveWithAllocationRequest) (*inventoryv1.ReserveWithAllocationResponse, error) {
+	logger := s.logger.With(
+		zap.String("handler", "ReserveWithAllocation"),
+		zap.String("order_id", req.OrderId),
+		zap.String("strategy", req.Strategy),
+	)
+
+	lines := make([]domain.AllocationLine, 0, len(req.Lines))
+	for _, line := range req.Lines {
+		lines = append(lines, domain.AllocationLine{ProductID: line.ProductId, Quantity: line.Quantity})
+	}
+	strategy := domain.AllocationStrategy(strings.ToUpper(req.Strategy))
+
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+
+	plan, err := s.service.ReserveWithAllocation(ctx, req.OrderId, lines, strategy, req.PreferredLocationId, ttl)
+	if err != nil {
+		var reservationErr *domain.ReservationError
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.As(err, &reservationErr):
+			// Stock changed between planning and reserving; retrying re-plans
+			logger.Warn("Allocated reservation rolled back", zap.Error(err))
+			return nil, status.Error(codes.Aborted, err.Error())
+		case errors.Is(err, domain.ErrInsufficientStock):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		logger.Error("Failed to reserve order across locations", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to reserve order")
+	}
+
+	resp := &inventoryv1.ReserveWithAllocationResponse{
+		OrderId:     plan.OrderID,
+		Strategy:    string(plan.Strategy),
+		Allocations: make([]*inventoryv1.Allocation, 0, len(plan.Allocations)),
+		Shipments:   int32(plan.Shipments),
+	}
+	if !plan.ExpiresAt.IsZero() {
+		resp.ExpiresAt = plan.ExpiresAt.Format(time.RFC3339)
+	}
+	for _, a := range plan.Allocations {
+		resp.Allocations = append(resp.Allocations, &inventoryv1.Allocation{
+			ProductId:       a.ProductID,
+			LocationId:      a.LocationID,
+			InventoryItemId: a.InventoryItemID,
+			Quantity:        a.Quantity,
+		})
+	}
+
+	logger.Info("Order reserved across locations", zap.Int32("shipments", resp.Shipments))
+	return resp, nil
+}
@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// SubscribeBackInStock subscribes a user to a back-in-stock alert for a product
+func (s *InventoryServer) SubscribeBackInStock(ctx context.Context, req *inventoryv1.SubscribeBackInStockRequest) (*inventoryv1.SubscribeBackInStockResponse, error) {
+	logger := s.logger.With(
+		zap.String("handler", "SubscribeBackInStock"),
+		zap.String("user_id", req.UserId),
+		zap.String("product_id", req.ProductId),
+	)
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user ID is required")
+	}
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product ID is required")
+	}
+
+	sub, created, err := s.backInStock.Subscribe(ctx, req.UserId, req.ProductId)
+	if err != nil {
+		logger.Error("Failed to subscribe to back-in-stock alert", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to subscribe to back-in-stock alert")
+	}
+
+	return &inventoryv1.SubscribeBackInStockResponse{
+		Subscription: &inventoryv1.BackInStockSubscription{
+			Id:          sub.ID,
+			UserId:      sub.UserID,
+			ProductId:   sub.ProductID,
+			CreatedAt:   sub.CreatedAt.Format(time.RFC3339),
+			CreatedAtTs: timestamppb.New(sub.CreatedAt),
+		},
+		AlreadySubscribed: !created,
+	}, nil
+}
+
+// UnsubscribeBackInStock removes a user's back-in-stock alert for a product
+func (s *InventoryServer) UnsubscribeBackInStock(ctx context.Context, req *inventoryv1.UnsubscribeBackInStockRequest) (*inventoryv1.UnsubscribeBackInStockResponse, error) {
+	logger := s.logger.With(
+		zap.String("handler", "UnsubscribeBackInStock"),
+		zap.String("user_id", req.UserId),
+		zap.String("product_id", req.ProductId),
+	)
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user ID is required")
+	}
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product ID is required")
+	}
+
+	if err := s.backInStock.Unsubscribe(ctx, req.UserId, req.ProductId); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "back-in-stock subscription not found")
+		}
+		logger.Error("Failed to unsubscribe from back-in-stock alert", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to unsubscribe from back-in-stock alert")
+	}
+
+	return &inventoryv1.UnsubscribeBackInStockResponse{Success: true}, nil
+}
+
+// NotifyBackInStock queues notifications for a product's subscribers when it is available again
+func (s *InventoryServer) NotifyBackInStock(ctx context.Context, req *inventoryv1.NotifyBackInStockRequest) (*inventoryv1.NotifyBackInStockResponse, error) {
+	logger := s.logger.With(
+		zap.String("handler", "NotifyBackInStock"),
+		zap.String("product_id", req.ProductId),
+		zap.Int32("available", req.Available),
+	)
+
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product ID is required")
+	}
+
+	count, err := s.backInStock.HandleStockChanged(ctx, req.ProductId, req.Available)
+	if err != nil {
+		logger.Error("Failed to queue back-in-stock notifications", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to queue back-in-stock notifications")
+	}
+
+	return &inventoryv1.NotifyBackInStockResponse{NotifiedCount: int32(count)}, nil
+}
@@ -0,0 +1,41 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// SetBackorderPolicy sets whether removing stock may take an inventory item
+// into backorder
+func (s *InventoryServer) SetBackorderPolicy(ctx context.Context, req *inventoryv1.SetBackorderPolicyRequest) (*inventoryv1.SetBackorderPolicyResponse, error) {
+	logger := s.logger.With(
+		zap.String("handler", "SetBackorderPolicy"),
+		zap.String("id", req.Id),
+	)
+
+	if err := validateItemID(req.Id); err != nil {
+		return nil, err
+	}
+	policy, err := domain.ParseBackorderPolicy(req.Policy)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	item, err := s.service.SetBackorderPolicy(ctx, req.Id, policy)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "inventory item not found")
+		}
+		logger.Error("Failed to set backorder policy", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to set backorder policy")
+	}
+
+	return &inventoryv1.SetBackorderPolicyResponse{Inventory: toProtoInventoryItem(item)}, nil
+}
@@ -0,0 +1,76 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/application"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// singleItemRepository holds one inventory item
+type singleItemRepository struct {
+	domain.InventoryRepository
+	item *domain.InventoryItem
+}
+
+func (r *singleItemRepository) GetByID(ctx context.Context, id string) (*domain.InventoryItem, error) {
+	if id != r.item.ID {
+		return nil, domain.ErrNotFound
+	}
+	copied := *r.item
+	return &copied, nil
+}
+
+func (r *singleItemRepository) Update(ctx context.Context, item *domain.InventoryItem) error {
+	copied := *item
+	r.item = &copied
+	return nil
+}
+
+func TestReadsExposeBackorderedQuantity(t *testing.T) {
+	item := domain.NewInventoryItem("product-1", -3, "LAMP-01", "warehouse-1")
+	item.Reserved = 1
+	repo := &singleItemRepository{item: item}
+	service := application.NewInventoryService(repo, nil, nil, domain.ReservationTTL{}, false, zap.NewNop())
+	server := NewInventoryServer(service, nil, nil, nil, nil, zap.NewNop())
+
+	resp, err := server.GetInventory(context.Background(), &inventoryv1.GetInventoryRequest{Id: item.ID})
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), resp.GetInventory().GetBackordered())
+
+	set, err := server.SetBackorderPolicy(context.Background(), &inventoryv1.SetBackorderPolicyRequest{Id: item.ID, Policy: "allow"})
+	require.NoError(t, err)
+	assert.Equal(t, "allow", set.GetInventory().GetBackorderPolicy())
+	assert.Equal(t, int32(4), set.GetInventory().GetBackordered())
+	assert.Equal(t, domain.BackorderPolicyAllow, repo.item.BackorderPolicy)
+}
+
+func TestSetBackorderPolicyErrors(t *testing.T) {
+	item := domain.NewInventoryItem("product-1", 5, "LAMP-01", "warehouse-1")
+	service := application.NewInventoryService(&singleItemRepository{item: item}, nil, nil, domain.ReservationTTL{}, false, zap.NewNop())
+	server := NewInventoryServer(service, nil, nil, nil, nil, zap.NewNop())
+
+	tests := []struct {
+		name     string
+		req      *inventoryv1.SetBackorderPolicyRequest
+		wantCode codes.Code
+	}{
+		{name: "unknown policy", req: &inventoryv1.SetBackorderPolicyRequest{Id: item.ID, Policy: "sometimes"}, wantCode: codes.InvalidArgument},
+		{name: "invalid ID", req: &inventoryv1.SetBackorderPolicyRequest{Id: "item-1", Policy: "deny"}, wantCode: codes.InvalidArgument},
+		{name: "unknown item", req: &inventoryv1.SetBackorderPolicyRequest{Id: domain.NewInventoryItem("p", 0, "", "").ID, Policy: "deny"}, wantCode: codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := server.SetBackorderPolicy(context.Background(), tt.req)
+			assert.Equal(t, tt.wantCode, status.Code(err), "error: %v", err)
+		})
+	}
+}
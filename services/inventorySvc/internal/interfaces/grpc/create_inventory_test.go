@@ -0,0 +1,98 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/application"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// emptyInventoryRepository holds no items and accepts every new one
+type emptyInventoryRepository struct {
+	domain.InventoryRepository
+}
+
+func (emptyInventoryRepository) GetBySKUAndLocation(ctx context.Context, sku, locationID string) (*domain.InventoryItem, error) {
+	return nil, domain.ErrNotFound
+}
+
+func (emptyInventoryRepository) Create(ctx context.Context, item *domain.InventoryItem) error {
+	return nil
+}
+
+// staticProductCatalog knows one product, or fails every lookup with err
+type staticProductCatalog struct {
+	productID, sku string
+	err            error
+}
+
+func (c staticProductCatalog) ProductSKU(ctx context.Context, productID string) (string, error) {
+	if c.err != nil {
+		return "", c.err
+	}
+	if productID != c.productID {
+		return "", domain.ErrProductNotFound
+	}
+	return c.sku, nil
+}
+
+func TestCreateInventoryProductCheckStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		catalog  staticProductCatalog
+		req      *inventoryv1.CreateInventoryRequest
+		wantCode codes.Code
+	}{
+		{
+			name:     "valid product",
+			catalog:  staticProductCatalog{productID: "product-1", sku: "LAMP-01"},
+			req:      &inventoryv1.CreateInventoryRequest{ProductId: "product-1", Sku: "LAMP-01", Quantity: 5},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "nonexistent product",
+			catalog:  staticProductCatalog{productID: "product-1", sku: "LAMP-01"},
+			req:      &inventoryv1.CreateInventoryRequest{ProductId: "product-404", Sku: "LAMP-01", Quantity: 5},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "SKU mismatch",
+			catalog:  staticProductCatalog{productID: "product-1", sku: "LAMP-01"},
+			req:      &inventoryv1.CreateInventoryRequest{ProductId: "product-1", Sku: "DESK-01", Quantity: 5},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "check skipped for bulk import",
+			catalog:  staticProductCatalog{productID: "product-1", sku: "LAMP-01"},
+			req:      &inventoryv1.CreateInventoryRequest{ProductId: "product-404", Sku: "LAMP-01", Quantity: 5, SkipProductCheck: true},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "product service down",
+			catalog:  staticProductCatalog{err: domain.ErrProductCatalogUnavailable},
+			req:      &inventoryv1.CreateInventoryRequest{ProductId: "product-1", Sku: "LAMP-01", Quantity: 5},
+			wantCode: codes.Unavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := application.NewInventoryService(emptyInventoryRepository{}, nil, tt.catalog, domain.ReservationTTL{}, false, zap.NewNop())
+			server := NewInventoryServer(service, nil, nil, nil, nil, zap.NewNop())
+
+			resp, err := server.CreateInventory(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err), "error: %v", err)
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, tt.req.ProductId, resp.GetInventory().GetProductId())
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/dates"
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+)
+
+// ListDueCounts lists the inventory items due for counting as of the given
+// time, optionally at a single location: the daily count worklist
+func (s *InventoryServer) ListDueCounts(ctx context.Context, req *inventoryv1.ListDueCountsRequest) (*inventoryv1.ListInventoryResponse, error) {
+	var asOf time.Time
+	if req.AsOf != "" {
+		var err error
+		if asOf, err = dates.Parse(req.AsOf); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "as_of: "+err.Error())
+		}
+	}
+
+	limit, offset := pageParams(req.Limit, req.Offset)
+	items, total, err := s.service.ListDueCounts(ctx, req.LocationId, asOf, limit, offset)
+	if err != nil {
+		return nil, listInventoryError(s.logger, err)
+	}
+
+	return toListInventoryResponse(items, total), nil
+}
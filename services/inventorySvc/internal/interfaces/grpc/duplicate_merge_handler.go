@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+)
+
+// MergeDuplicateInventory consolidates inventory items that share a SKU at a location
+func (s *InventoryServer) MergeDuplicateInventory(ctx context.Context, req *inventoryv1.MergeDuplicateInventoryRequest) (*inventoryv1.MergeDuplicateInventoryResponse, error) {
+	logger := s.logger.With(
+		zap.String("handler", "MergeDuplicateInventory"),
+		zap.String("location_id", req.LocationId),
+	)
+
+	if req.LocationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "location ID is required")
+	}
+
+	merges, err := s.service.MergeDuplicates(ctx, req.LocationId)
+	if err != nil {
+		logger.Error("Failed to merge duplicate inventory items", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to merge duplicate inventory items: %v", err)
+	}
+
+	resp := &inventoryv1.MergeDuplicateInventoryResponse{
+		Merges: make([]*inventoryv1.DuplicateMerge, 0, len(merges)),
+	}
+	for _, m := range merges {
+		resp.Merges = append(resp.Merges, &inventoryv1.DuplicateMerge{
+			Sku:           m.SKU,
+			KeptItemId:    m.KeptItemID,
+			MergedItemIds: m.MergedItemIDs,
+			Quantity:      m.Quantity,
+			Reserved:      m.Reserved,
+			Damaged:       m.Damaged,
+			OrderIds:      m.OrderIDs,
+		})
+		if len(m.OrderIDs) > 0 {
+			resp.Merges[len(resp.Merges)-1].OrderId = m.OrderIDs[0]
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,20 @@
+package grpc
+
+import (
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validateItemID rejects a missing or malformed inventory item ID with
+// InvalidArgument. Item IDs are UUIDs; anything else is refused before it
+// reaches the database.
+func validateItemID(id string) error {
+	if id == "" {
+		return status.Error(codes.InvalidArgument, "id is required")
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%q is not a valid inventory item ID", id)
+	}
+	return nil
+}
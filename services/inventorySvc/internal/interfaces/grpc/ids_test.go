@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+)
+
+func TestGetInventoryRejectsMalformedIDs(t *testing.T) {
+	// Without a service behind it, any ID that got past validation would panic
+	server := NewInventoryServer(nil, nil, nil, nil, nil, zap.NewNop())
+
+	for _, id := range []string{"", "not-an-id", "507f1f77bcf86cd799439011", "{\"$ne\": null}"} {
+		_, err := server.GetInventory(context.Background(), &inventoryv1.GetInventoryRequest{Id: id})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "GetInventory(%q)", id)
+	}
+}
+
+func TestValidateItemIDAcceptsUUIDs(t *testing.T) {
+	assert.NoError(t, validateItemID("6f1c2a8e-1d3b-4c5e-9f70-2a4b6c8d0e1f"))
+}
+
+func TestUpdateInventoryRejectsMissingInventory(t *testing.T) {
+	server := NewInventoryServer(nil, nil, nil, nil, nil, zap.NewNop())
+
+	_, err := server.UpdateInventory(context.Background(), &inventoryv1.UpdateInventoryRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
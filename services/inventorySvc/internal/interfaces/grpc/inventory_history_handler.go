@@ -7,6 +7,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/application"
 	inventorypb "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
@@ -77,6 +78,7 @@ func (s *InventoryHistoryServer) GetInventoryHistory(
 			ReferenceType: h.ReferenceType,
 			PerformedBy:   h.PerformedBy,
 			CreatedAt:     h.CreatedAt.Format(time.RFC3339),
+			CreatedAtTs:   timestamppb.New(h.CreatedAt),
 		})
 	}
 
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/application"
@@ -9,7 +10,9 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/dates"
 	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
 )
 
@@ -19,15 +22,19 @@ type InventoryServer struct {
 	service         *application.InventoryService
 	transferService *application.TransferService
 	locationService *application.LocationService
+	backInStock     *application.BackInStockService
+	reconciler      *application.ReservationReconciler
 	logger          *zap.Logger
 }
 
 // NewInventoryServer creates a new inventory gRPC server
-func NewInventoryServer(service *application.InventoryService, transferService *application.TransferService, locationService *application.LocationService, logger *zap.Logger) inventoryv1.InventoryServiceServer {
+func NewInventoryServer(service *application.InventoryService, transferService *application.TransferService, locationService *application.LocationService, backInStock *application.BackInStockService, reconciler *application.ReservationReconciler, logger *zap.Logger) inventoryv1.InventoryServiceServer {
 	return &InventoryServer{
 		service:         service,
 		transferService:  transferService,
 		locationService: locationService,
+		backInStock:     backInStock,
+		reconciler:      reconciler,
 		logger:          logger.Named("inventory_grpc_server"),
 	}
 }
@@ -50,9 +57,15 @@ func (s *InventoryServer) CreateInventory(ctx context.Context, req *inventoryv1.
 		return nil, status.Error(codes.InvalidArgument, "sku is required")
 	}
 
-	item, err := s.service.CreateInventoryItem(ctx, req.ProductId, req.Quantity, req.Sku, req.LocationId)
+	item, err := s.service.CreateInventoryItem(ctx, req.ProductId, req.Quantity, req.Sku, req.LocationId, req.Tags, !req.SkipProductCheck)
 	if err != nil {
 		s.logger.Error("Failed to create inventory item", zap.Error(err))
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, domain.ErrProductCatalogUnavailable):
+			return nil, status.Error(codes.Unavailable, "product service unavailable, cannot check the product")
+		}
 		return nil, status.Error(codes.Internal, "failed to create inventory item: "+err.Error())
 	}
 
@@ -65,8 +78,8 @@ func (s *InventoryServer) CreateInventory(ctx context.Context, req *inventoryv1.
 func (s *InventoryServer) GetInventory(ctx context.Context, req *inventoryv1.GetInventoryRequest) (*inventoryv1.GetInventoryResponse, error) {
 	s.logger.Debug("gRPC GetInventory called", zap.String("id", req.Id))
 
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
+	if err := validateItemID(req.Id); err != nil {
+		return nil, err
 	}
 
 	item, err := s.service.GetInventoryItem(ctx, req.Id)
@@ -154,11 +167,11 @@ func (s *InventoryServer) GetInventoryBySKU(ctx context.Context, req *inventoryv
 
 // UpdateInventory updates an existing inventory item
 func (s *InventoryServer) UpdateInventory(ctx context.Context, req *inventoryv1.UpdateInventoryRequest) (*inventoryv1.UpdateInventoryResponse, error) {
-	s.logger.Info("gRPC UpdateInventory called", zap.String("id", req.Inventory.Id))
-
 	if req.Inventory == nil {
 		return nil, status.Error(codes.InvalidArgument, "inventory is required")
 	}
+	s.logger.Info("gRPC UpdateInventory called", zap.String("id", req.Inventory.Id))
+
 	if req.Inventory.Id == "" {
 		return nil, status.Error(codes.InvalidArgument, "inventory.id is required")
 	}
@@ -193,8 +206,8 @@ func (s *InventoryServer) UpdateInventory(ctx context.Context, req *inventoryv1.
 func (s *InventoryServer) DeleteInventory(ctx context.Context, req *inventoryv1.DeleteInventoryRequest) (*inventoryv1.DeleteInventoryResponse, error) {
 	s.logger.Info("gRPC DeleteInventory called", zap.String("id", req.Id))
 
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
+	if err := validateItemID(req.Id); err != nil {
+		return nil, err
 	}
 
 	if err := s.service.DeleteInventoryItem(ctx, req.Id); err != nil {
@@ -207,37 +220,110 @@ func (s *InventoryServer) DeleteInventory(ctx context.Context, req *inventoryv1.
 	}, nil
 }
 
-// ListInventory lists all inventory items with pagination
+// ListInventory lists inventory items with pagination, optionally filtered by stock status and tags
 func (s *InventoryServer) ListInventory(ctx context.Context, req *inventoryv1.ListInventoryRequest) (*inventoryv1.ListInventoryResponse, error) {
 	s.logger.Debug("gRPC ListInventory called",
+		zap.String("stock_status", req.StockStatus),
+		zap.Strings("tags", req.Tags),
+		zap.String("updated_since", req.UpdatedSince),
+		zap.Int32("limit", req.Limit),
+		zap.Int32("offset", req.Offset),
+	)
+
+	updatedSince, err := parseUpdatedSince(req.UpdatedSince)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, offset := pageParams(req.Limit, req.Offset)
+	items, total, err := s.service.ListInventoryItems(ctx, domain.InventoryFilter{
+		StockStatus:  req.StockStatus,
+		Tags:         req.Tags,
+		UpdatedSince: updatedSince,
+	}, limit, offset)
+	if err != nil {
+		return nil, listInventoryError(s.logger, err)
+	}
+
+	return toListInventoryResponse(items, total), nil
+}
+
+// ListInventoryByLocation lists inventory items at a location with pagination,
+// optionally filtered by stock status, tags and last update
+func (s *InventoryServer) ListInventoryByLocation(ctx context.Context, req *inventoryv1.ListInventoryByLocationRequest) (*inventoryv1.ListInventoryResponse, error) {
+	s.logger.Debug("gRPC ListInventoryByLocation called",
+		zap.String("location_id", req.LocationId),
+		zap.String("stock_status", req.StockStatus),
+		zap.Strings("tags", req.Tags),
+		zap.String("updated_since", req.UpdatedSince),
 		zap.Int32("limit", req.Limit),
 		zap.Int32("offset", req.Offset),
 	)
 
-	limit := int(req.Limit)
+	if req.LocationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "location ID is required")
+	}
+	updatedSince, err := parseUpdatedSince(req.UpdatedSince)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, offset := pageParams(req.Limit, req.Offset)
+	items, total, err := s.service.ListInventoryItemsByLocation(ctx, domain.InventoryFilter{
+		LocationID:   req.LocationId,
+		StockStatus:  req.StockStatus,
+		Tags:         req.Tags,
+		UpdatedSince: updatedSince,
+	}, limit, offset)
+	if err != nil {
+		return nil, listInventoryError(s.logger, err)
+	}
+
+	return toListInventoryResponse(items, total), nil
+}
+
+// parseUpdatedSince parses the optional updated_since filter of a listing
+func parseUpdatedSince(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	since, err := dates.Parse(value)
+	if err != nil {
+		return time.Time{}, status.Error(codes.InvalidArgument, "updated_since: "+err.Error())
+	}
+	return since, nil
+}
+
+// pageParams applies the default limit and clamps a negative offset
+func pageParams(limit, offset int32) (int, int) {
 	if limit <= 0 {
 		limit = 10 // Default limit
 	}
-	offset := int(req.Offset)
 	if offset < 0 {
 		offset = 0
 	}
+	return int(limit), int(offset)
+}
 
-	items, err := s.service.ListInventoryItems(ctx, limit, offset)
-	if err != nil {
-		s.logger.Error("Failed to list inventory items", zap.Error(err))
-		return nil, status.Error(codes.Internal, "failed to list inventory items: "+err.Error())
+// listInventoryError maps a listing failure to a gRPC status
+func listInventoryError(logger *zap.Logger, err error) error {
+	if errors.Is(err, domain.ErrInvalidInput) {
+		return status.Error(codes.InvalidArgument, err.Error())
 	}
+	logger.Error("Failed to list inventory items", zap.Error(err))
+	return status.Error(codes.Internal, "failed to list inventory items: "+err.Error())
+}
 
+// toListInventoryResponse converts a page of inventory items to a list response
+func toListInventoryResponse(items []*domain.InventoryItem, total int64) *inventoryv1.ListInventoryResponse {
 	response := &inventoryv1.ListInventoryResponse{
 		Inventories: make([]*inventoryv1.InventoryItem, 0, len(items)),
+		TotalCount:  int32(total),
 	}
-
 	for _, item := range items {
 		response.Inventories = append(response.Inventories, toProtoInventoryItem(item))
 	}
-
-	return response, nil
+	return response
 }
 
 // AddStock adds stock to an inventory item
@@ -247,14 +333,18 @@ func (s *InventoryServer) AddStock(ctx context.Context, req *inventoryv1.AddStoc
 		zap.Int32("quantity", req.Quantity),
 	)
 
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
+	if err := validateItemID(req.Id); err != nil {
+		return nil, err
 	}
 	if req.Quantity <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "quantity must be positive")
 	}
+	quantity, err := s.toSellingUnits(ctx, req.Id, req.Quantity, req.Unit)
+	if err != nil {
+		return nil, err
+	}
 
-	if err := s.service.AddStock(ctx, req.Id, req.Quantity); err != nil {
+	if err := s.service.AddStock(ctx, req.Id, quantity); err != nil {
 		s.logger.Error("Failed to add stock", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to add stock: "+err.Error())
 	}
@@ -271,14 +361,21 @@ func (s *InventoryServer) RemoveStock(ctx context.Context, req *inventoryv1.Remo
 		zap.Int32("quantity", req.Quantity),
 	)
 
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
+	if err := validateItemID(req.Id); err != nil {
+		return nil, err
 	}
 	if req.Quantity <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "quantity must be positive")
 	}
+	quantity, err := s.toSellingUnits(ctx, req.Id, req.Quantity, req.Unit)
+	if err != nil {
+		return nil, err
+	}
 
-	if err := s.service.RemoveStock(ctx, req.Id, req.Quantity); err != nil {
+	if err := s.service.RemoveStock(ctx, req.Id, quantity); err != nil {
+		if errors.Is(err, domain.ErrInsufficientStock) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		s.logger.Error("Failed to remove stock", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to remove stock: "+err.Error())
 	}
@@ -295,8 +392,8 @@ func (s *InventoryServer) ReserveStock(ctx context.Context, req *inventoryv1.Res
 		zap.Int32("quantity", req.Quantity),
 	)
 
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
+	if err := validateItemID(req.Id); err != nil {
+		return nil, err
 	}
 	if req.Quantity <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "quantity must be positive")
@@ -304,6 +401,12 @@ func (s *InventoryServer) ReserveStock(ctx context.Context, req *inventoryv1.Res
 
 	if err := s.service.ReserveStock(ctx, req.Id, req.Quantity); err != nil {
 		s.logger.Error("Failed to reserve stock", zap.Error(err))
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "inventory item not found")
+		}
+		if errors.Is(err, domain.ErrInsufficientStock) {
+			return nil, status.Error(codes.FailedPrecondition, "failed to reserve stock: "+err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to reserve stock: "+err.Error())
 	}
 
@@ -317,16 +420,17 @@ func (s *InventoryServer) ReleaseReservation(ctx context.Context, req *inventory
 	s.logger.Info("gRPC ReleaseReservation called",
 		zap.String("id", req.Id),
 		zap.Int32("quantity", req.Quantity),
+		zap.String("order_id", req.OrderId),
 	)
 
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
+	if err := validateItemID(req.Id); err != nil {
+		return nil, err
 	}
 	if req.Quantity <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "quantity must be positive")
 	}
 
-	if err := s.service.ReleaseReservation(ctx, req.Id, req.Quantity); err != nil {
+	if err := s.service.ReleaseReservation(ctx, req.Id, req.Quantity, req.OrderId); err != nil {
 		s.logger.Error("Failed to release reservation", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to release reservation: "+err.Error())
 	}
@@ -341,17 +445,21 @@ func (s *InventoryServer) FulfillReservation(ctx context.Context, req *inventory
 	s.logger.Info("gRPC FulfillReservation called",
 		zap.String("id", req.Id),
 		zap.Int32("quantity", req.Quantity),
+		zap.String("order_id", req.OrderId),
 	)
 
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
+	if err := validateItemID(req.Id); err != nil {
+		return nil, err
 	}
 	if req.Quantity <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "quantity must be positive")
 	}
 
-	if err := s.service.FulfillReservation(ctx, req.Id, req.Quantity); err != nil {
+	if err := s.service.FulfillReservation(ctx, req.Id, req.Quantity, req.OrderId); err != nil {
 		s.logger.Error("Failed to fulfill reservation", zap.Error(err))
+		if errors.Is(err, domain.ErrInsufficientReservation) {
+			return nil, status.Error(codes.FailedPrecondition, "failed to fulfill reservation: "+err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to fulfill reservation: "+err.Error())
 	}
 
@@ -362,14 +470,31 @@ func (s *InventoryServer) FulfillReservation(ctx context.Context, req *inventory
 
 // toProtoInventoryItem converts a domain inventory item to a proto inventory item
 func toProtoInventoryItem(item *domain.InventoryItem) *inventoryv1.InventoryItem {
-	return &inventoryv1.InventoryItem{
+	pb := &inventoryv1.InventoryItem{
 		Id:          item.ID,
 		ProductId:   item.ProductID,
 		Quantity:    item.Quantity,
 		Reserved:    item.Reserved,
+		Damaged:     item.Damaged,
 		Sku:         item.SKU,
 		LocationId:  item.LocationID,
 		LastUpdated: item.LastUpdated.Format(time.RFC3339),
 		CreatedAt:   item.CreatedAt.Format(time.RFC3339),
+		Tags:        item.Tags,
+
+		LastUpdatedTs: timestamppb.New(item.LastUpdated),
+		CreatedAtTs:   timestamppb.New(item.CreatedAt),
+
+		SellingUnit:          item.SellingUnitName(),
+		StockingUnit:         item.StockingUnitName(),
+		UnitsPerStockingUnit: item.ConversionFactor(),
+
+		Backordered:     item.Backordered(),
+		BackorderPolicy: string(item.BackorderPolicy),
+	}
+	pb.AvailableStockingUnits, _ = item.AvailableIn(item.StockingUnitName())
+	if !item.NextCountDate.IsZero() {
+		pb.NextCountDate = item.NextCountDate.Format(time.RFC3339)
 	}
+	return pb
 }
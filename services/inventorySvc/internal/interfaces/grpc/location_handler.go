@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// GetLocation retrieves a store location from the location registry
+func (s *InventoryServer) GetLocation(ctx context.Context, req *inventoryv1.GetLocationRequest) (*inventoryv1.GetLocationResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "location ID is required")
+	}
+
+	location, err := s.locationService.GetLocation(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, domain.ErrLocationNotFound) {
+			return nil, status.Error(codes.NotFound, "location not found")
+		}
+		s.logger.Error("Failed to get location",
+			zap.String("location_id", req.Id),
+			zap.Error(err),
+		)
+		return nil, status.Error(codes.Internal, "failed to get location")
+	}
+
+	return &inventoryv1.GetLocationResponse{
+		Location: toProtoStoreLocation(location),
+	}, nil
+}
+
+// toProtoStoreLocation converts a domain store location to its protobuf form
+func toProtoStoreLocation(location *domain.StoreLocation) *inventoryv1.StoreLocation {
+	return &inventoryv1.StoreLocation{
+		Id:           location.ID,
+		Name:         location.Name,
+		Type:         location.Type,
+		AddressLine1: location.Address,
+		City:         location.City,
+		State:        location.State,
+		PostalCode:   location.PostalCode,
+		Country:      location.Country,
+		Phone:        location.PhoneNumber,
+		Email:        location.Email,
+		Active:       location.IsActive,
+		CreatedAt:    location.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    location.UpdatedAt.Format(time.RFC3339),
+		CreatedAtTs:  timestamppb.New(location.CreatedAt),
+		UpdatedAtTs:  timestamppb.New(location.UpdatedAt),
+	}
+}
@@ -0,0 +1,37 @@
+package grpc
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+)
+
+// ListLowStockItems lists inventory items at or below their reorder point,
+// optionally at a single location
+func (s *InventoryServer) ListLowStockItems(ctx context.Context, req *inventoryv1.ListLowStockItemsRequest) (*inventoryv1.ListInventoryResponse, error) {
+	limit, offset := pageParams(req.Limit, req.Offset)
+	items, total, err := s.service.ListLowStockItems(ctx, req.LocationId, limit, offset)
+	if err != nil {
+		return nil, listInventoryError(s.logger, err)
+	}
+
+	return toListInventoryResponse(items, total), nil
+}
+
+// CountLowStock counts inventory items at or below their reorder point
+func (s *InventoryServer) CountLowStock(ctx context.Context, req *inventoryv1.CountLowStockRequest) (*inventoryv1.CountLowStockResponse, error) {
+	count, err := s.service.CountLowStockItems(ctx, req.LocationId)
+	if err != nil {
+		s.logger.Error("Failed to count low stock items",
+			zap.String("location_id", req.LocationId),
+			zap.Error(err),
+		)
+		return nil, status.Error(codes.Internal, "failed to count low stock items")
+	}
+
+	return &inventoryv1.CountLowStockResponse{Count: count}, nil
+}
@@ -0,0 +1,134 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// GetReservationsForOrder returns the reservations held for an order across all locations
+func (s *InventoryServer) GetReservationsForOrder(ctx context.Context, req *inventoryv1.GetReservationsForOrderRequest) (*inventoryv1.GetReservationsForOrderResponse, error) {
+	logger := s.logger.With(
+		zap.String("handler", "GetReservationsForOrder"),
+		zap.String("order_id", req.OrderId),
+	)
+
+	if req.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "order ID is required")
+	}
+
+	reservations, err := s.service.GetReservationsForOrder(ctx, req.OrderId)
+	if err != nil {
+		logger.Error("Failed to get reservations for order", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get reservations for order")
+	}
+
+	resp := &inventoryv1.GetReservationsForOrderResponse{
+		OrderId:      req.OrderId,
+		Reservations: make([]*inventoryv1.OrderReservation, 0, len(reservations)),
+	}
+	for _, r := range reservations {
+		resp.Reservations = append(resp.Reservations, toProtoOrderReservation(r))
+	}
+
+	logger.Debug("Reservations for order retrieved", zap.Int("count", len(resp.Reservations)))
+	return resp, nil
+}
+
+// ReleaseAllForOrder releases every active reservation of an order, at all locations
+func (s *InventoryServer) ReleaseAllForOrder(ctx context.Context, req *inventoryv1.ReleaseAllForOrderRequest) (*inventoryv1.ReleaseAllForOrderResponse, error) {
+	logger := s.logger.With(
+		zap.String("handler", "ReleaseAllForOrder"),
+		zap.String("order_id", req.OrderId),
+	)
+
+	if req.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "order ID is required")
+	}
+
+	released, err := s.service.ReleaseAllForOrder(ctx, req.OrderId)
+	if err != nil {
+		logger.Error("Failed to release reservations for order",
+			zap.Int("released", len(released)),
+			zap.Error(err),
+		)
+		return nil, status.Error(codes.Internal, "failed to release reservations for order")
+	}
+
+	resp := &inventoryv1.ReleaseAllForOrderResponse{
+		OrderId:  req.OrderId,
+		Released: make([]*inventoryv1.OrderReservation, 0, len(released)),
+	}
+	for _, r := range released {
+		resp.Released = append(resp.Released, toProtoOrderReservation(r))
+	}
+
+	logger.Info("Reservations for order released", zap.Int("count", len(resp.Released)))
+	return resp, nil
+}
+
+// ReconcileReservations releases reservations held for orders the order
+// service reports as cancelled, completed or missing
+func (s *InventoryServer) ReconcileReservations(ctx context.Context, req *inventoryv1.ReconcileReservationsRequest) (*inventoryv1.ReconcileReservationsResponse, error) {
+	logger := s.logger.With(
+		zap.String("handler", "ReconcileReservations"),
+		zap.Bool("dry_run", req.DryRun),
+	)
+
+	report, err := s.reconciler.Reconcile(ctx, req.DryRun)
+	if err != nil {
+		logger.Error("Failed to reconcile reservations", zap.Error(err))
+		if errors.Is(err, domain.ErrOrderLookupUnavailable) {
+			return nil, status.Error(codes.Unavailable, "order service unavailable")
+		}
+		return nil, status.Error(codes.Internal, "failed to reconcile reservations")
+	}
+
+	resp := &inventoryv1.ReconcileReservationsResponse{
+		ReservationsChecked: int32(report.ReservationsChecked),
+		OrdersChecked:       int32(report.OrdersChecked),
+		Corrections:         make([]*inventoryv1.ReservationCorrection, 0, len(report.Corrections)),
+		Failed:              int32(report.Failed),
+		DryRun:              report.DryRun,
+	}
+	for _, c := range report.Corrections {
+		correction := &inventoryv1.ReservationCorrection{
+			OrderId:    c.OrderID,
+			OrderState: string(c.OrderState),
+		}
+		for _, r := range c.Reservations {
+			correction.Reservations = append(correction.Reservations, toProtoOrderReservation(r))
+		}
+		resp.Corrections = append(resp.Corrections, correction)
+	}
+	return resp, nil
+}
+
+// toProtoOrderReservation converts an order reservation to its protobuf form
+func toProtoOrderReservation(r *domain.OrderReservation) *inventoryv1.OrderReservation {
+	reservation := &inventoryv1.OrderReservation{
+		InventoryItemId: r.InventoryItemID,
+		ProductId:       r.ProductID,
+		Sku:             r.SKU,
+		LocationId:      r.LocationID,
+		OrderId:         r.OrderID,
+		Quantity:        r.Quantity,
+		Status:          r.Status,
+	}
+	if !r.UpdatedAt.IsZero() {
+		reservation.UpdatedAt = r.UpdatedAt.Format(time.RFC3339)
+		reservation.UpdatedAtTs = timestamppb.New(r.UpdatedAt)
+	}
+	if !r.ExpiresAt.IsZero() {
+		reservation.ExpiresAt = r.ExpiresAt.Format(time.RFC3339)
+	}
+	return reservation
+}
@@ -2,12 +2,15 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"strings"
 	"time"
 )
@@ -36,50 +39,51 @@ func (s *InventoryServer) ReserveForPickup(ctx context.Context, req *inventoryv1
 		return nil, status.Error(codes.InvalidArgument, "at least one item is required")
 	}
 
-	// Process reservation for each item
+	// Reserve all items or none; the service reserves them in SKU order so
+	// concurrent pickups for the same products cannot each hold part of them
 	reservationResults := make([]*inventoryv1.InventoryReservationResult, 0, len(req.Items))
-	allSuccess := true
-	resStatus := "success"
-
+	requests := make([]domain.StockRequest, 0, len(req.Items))
 	for _, item := range req.Items {
-		result := &inventoryv1.InventoryReservationResult{
+		reservationResults = append(reservationResults, &inventoryv1.InventoryReservationResult{
 			ProductId:         item.ProductId,
 			Sku:               item.Sku,
 			RequestedQuantity: item.Quantity,
 			ReservedQuantity:  0,
 			Status:            "unavailable",
 			InventoryItemId:   item.InventoryItemId,
-		}
+		})
+		requests = append(requests, domain.StockRequest{
+			InventoryItemID: item.InventoryItemId,
+			Quantity:        item.Quantity,
+		})
+	}
 
-		// Validate item
-		if item.Quantity <= 0 {
-			result.ErrorMessage = "quantity must be positive"
-			reservationResults = append(reservationResults, result)
-			allSuccess = false
-			resStatus = "failed"
-			continue
-		}
+	allSuccess := true
+	resStatus := "success"
 
-		// Reserve the inventory
-		err := s.service.ReserveStock(ctx, item.InventoryItemId, item.Quantity)
-		if err != nil {
-			logger.Error("Failed to reserve stock", 
-				zap.Error(err),
-				zap.String("product_id", item.ProductId),
-				zap.String("inventory_item_id", item.InventoryItemId),
-				zap.Int32("quantity", item.Quantity),
-			)
-
-			result.Status = "unavailable"
-			result.ErrorMessage = err.Error()
-			allSuccess = false
-			resStatus = "failed"
-		} else {
+	err := s.service.ReserveStockAll(ctx, requests)
+	if err != nil {
+		logger.Error("Failed to reserve stock", zap.Error(err))
+		allSuccess = false
+		resStatus = "failed"
+
+		var resErr *domain.ReservationError
+		failedID := ""
+		if errors.As(err, &resErr) {
+			failedID = resErr.InventoryItemID
+		}
+		for _, result := range reservationResults {
+			if failedID == "" || result.InventoryItemId == failedID {
+				result.ErrorMessage = err.Error()
+			} else {
+				result.ErrorMessage = fmt.Sprintf("not reserved: inventory item %s could not be reserved", failedID)
+			}
+		}
+	} else {
+		for _, result := range reservationResults {
 			result.Status = "reserved"
-			result.ReservedQuantity = item.Quantity
+			result.ReservedQuantity = result.RequestedQuantity
 		}
-
-		reservationResults = append(reservationResults, result)
 	}
 
 	logger.Info("ReserveForPickup request completed",
@@ -187,9 +191,11 @@ func (s *InventoryServer) CompletePickup(ctx context.Context, req *inventoryv1.C
 
 	transactionID := "TRX-" + req.ReservationId + "-" + time.Now().Format("20060102150405")
 
+	completedAt := time.Now()
 	return &inventoryv1.CompletePickupResponse{
-		Success:      true,
+		Success:       true,
 		TransactionId: transactionID,
-		CompletedAt:  time.Now().Format(time.RFC3339),
+		CompletedAt:   completedAt.Format(time.RFC3339),
+		CompletedAtTs: timestamppb.New(completedAt),
 	}, nil
 }
@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// ReceivePurchaseOrder books the stock of a purchase order delivery
+func (s *InventoryServer) ReceivePurchaseOrder(ctx context.Context, req *inventoryv1.ReceivePurchaseOrderRequest) (*inventoryv1.ReceivePurchaseOrderResponse, error) {
+	logger := s.logger.With(
+		zap.String("handler", "ReceivePurchaseOrder"),
+		zap.String("po_id", req.PurchaseOrderId),
+	)
+
+	if req.PurchaseOrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "purchase order ID is required")
+	}
+
+	lines := make([]domain.ReceiveLine, 0, len(req.Lines))
+	for _, l := range req.Lines {
+		lines = append(lines, domain.ReceiveLine{
+			LineID:           l.LineId,
+			InventoryItemID:  l.InventoryItemId,
+			OrderedQuantity:  l.OrderedQuantity,
+			ReceivedQuantity: l.ReceivedQuantity,
+		})
+	}
+
+	receipt, err := s.service.ReceivePurchaseOrder(ctx, req.PurchaseOrderId, lines, req.ReceivedBy)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, domain.ErrNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrConcurrentUpdate):
+			return nil, status.Error(codes.Aborted, "purchase order is being received concurrently, retry")
+		}
+		logger.Error("Failed to receive purchase order", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to receive purchase order")
+	}
+
+	resp := &inventoryv1.ReceivePurchaseOrderResponse{
+		PurchaseOrderId: receipt.ID,
+		Status:          string(receipt.Status),
+		Lines:           make([]*inventoryv1.PurchaseOrderLine, 0, len(receipt.Lines)),
+		UpdatedAt:       receipt.UpdatedAt.Format(time.RFC3339),
+		UpdatedAtTs:     timestamppb.New(receipt.UpdatedAt),
+	}
+	for _, l := range receipt.Lines {
+		resp.Lines = append(resp.Lines, &inventoryv1.PurchaseOrderLine{
+			LineId:           l.LineID,
+			InventoryItemId:  l.InventoryItemID,
+			OrderedQuantity:  l.OrderedQuantity,
+			ReceivedQuantity: l.ReceivedQuantity,
+		})
+	}
+	return resp, nil
+}
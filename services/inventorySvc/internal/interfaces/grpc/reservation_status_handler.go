@@ -0,0 +1,78 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// GetReservationStatus returns the reservation an inventory item holds for an order
+func (s *InventoryServer) GetReservationStatus(ctx context.Context, req *inventoryv1.GetReservationStatusRequest) (*inventoryv1.GetReservationStatusResponse, error) {
+	logger := s.logger.With(
+		zap.String("handler", "GetReservationStatus"),
+		zap.String("inventory_item_id", req.InventoryItemId),
+		zap.String("order_id", req.OrderId),
+	)
+
+	reservation, orderID, err := s.service.GetReservationStatus(ctx, req.InventoryItemId, req.OrderId)
+	if err != nil {
+		if st := reservationStatusError(err); st != nil {
+			return nil, st
+		}
+		logger.Error("Failed to get reservation status", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get reservation status")
+	}
+
+	return &inventoryv1.GetReservationStatusResponse{
+		OrderId:     orderID,
+		Reservation: toProtoOrderReservation(reservation),
+	}, nil
+}
+
+// SetReservationStatus moves an item's active reservation for an order to
+// another status. A reservation that is no longer active is reported as
+// FailedPrecondition.
+func (s *InventoryServer) SetReservationStatus(ctx context.Context, req *inventoryv1.SetReservationStatusRequest) (*inventoryv1.SetReservationStatusResponse, error) {
+	logger := s.logger.With(
+		zap.String("handler", "SetReservationStatus"),
+		zap.String("inventory_item_id", req.InventoryItemId),
+		zap.String("order_id", req.OrderId),
+		zap.String("status", req.Status),
+	)
+
+	reservation, orderID, err := s.service.SetReservationStatus(ctx, req.InventoryItemId, req.OrderId, req.Status, req.Reason, req.PerformedBy)
+	if err != nil {
+		if st := reservationStatusError(err); st != nil {
+			return nil, st
+		}
+		logger.Error("Failed to set reservation status", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to set reservation status")
+	}
+
+	return &inventoryv1.SetReservationStatusResponse{
+		OrderId:     orderID,
+		Reservation: toProtoOrderReservation(reservation),
+	}, nil
+}
+
+// reservationStatusError maps the expected errors of reading or changing a
+// reservation's status to a gRPC status, or returns nil for anything else
+func reservationStatusError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrNotFound):
+		return status.Error(codes.NotFound, "inventory item not found")
+	case errors.Is(err, domain.ErrReservationNotFound):
+		return status.Error(codes.NotFound, "inventory item holds no order reservation")
+	case errors.Is(err, domain.ErrInvalidOperation), errors.Is(err, domain.ErrInsufficientReservation):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return nil
+}
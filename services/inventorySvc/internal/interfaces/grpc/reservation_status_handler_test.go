@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// reservedItemRepository holds a single inventory item and accepts its updates
+// and history
+type reservedItemRepository struct {
+	domain.InventoryRepository
+	item *domain.InventoryItem
+}
+
+func (r *reservedItemRepository) GetByID(ctx context.Context, id string) (*domain.InventoryItem, error) {
+	if id != r.item.ID {
+		return nil, domain.ErrNotFound
+	}
+	return r.item, nil
+}
+
+func (r *reservedItemRepository) Update(ctx context.Context, item *domain.InventoryItem) error {
+	r.item = item
+	return nil
+}
+
+func (r *reservedItemRepository) RecordHistory(ctx context.Context, history *domain.InventoryHistory) error {
+	return nil
+}
+
+func TestSetReservationStatusHandler(t *testing.T) {
+	item := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	require.True(t, item.ReserveForOrder(3, "order-a", time.Time{}))
+	server := newTransferTestServer(&reservedItemRepository{item: item})
+	ctx := context.Background()
+
+	resp, err := server.SetReservationStatus(ctx, &inventoryv1.SetReservationStatusRequest{
+		InventoryItemId: item.ID, Status: "fulfilled", Reason: "collected at the counter", PerformedBy: "staff-1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "order-a", resp.GetOrderId())
+	assert.Equal(t, "fulfilled", resp.GetReservation().GetStatus())
+
+	// A fulfilled reservation cannot be taken back
+	_, err = server.SetReservationStatus(ctx, &inventoryv1.SetReservationStatusRequest{
+		InventoryItemId: item.ID, Status: "active", PerformedBy: "staff-1",
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "error: %v", err)
+
+	_, err = server.SetReservationStatus(ctx, &inventoryv1.SetReservationStatusRequest{
+		InventoryItemId: item.ID, Status: "lost",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "error: %v", err)
+}
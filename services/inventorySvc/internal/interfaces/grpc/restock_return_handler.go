@@ -0,0 +1,42 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// RestockReturn puts returned units back into inventory as sellable or damaged stock
+func (s *InventoryServer) RestockReturn(ctx context.Context, req *inventoryv1.RestockReturnRequest) (*inventoryv1.RestockReturnResponse, error) {
+	logger := s.logger.With(
+		zap.String("handler", "RestockReturn"),
+		zap.String("product_id", req.ProductId),
+		zap.String("reference_id", req.ReferenceId),
+	)
+
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product ID is required")
+	}
+	if req.Quantity <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "quantity must be positive")
+	}
+
+	item, err := s.service.RestockReturn(ctx, req.ProductId, req.LocationId, req.Quantity, req.Damaged, req.ReferenceId, req.PerformedBy)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "inventory item not found")
+		}
+		logger.Error("Failed to restock returned units", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to restock returned units")
+	}
+
+	return &inventoryv1.RestockReturnResponse{
+		Inventory: toProtoInventoryItem(item),
+	}, nil
+}
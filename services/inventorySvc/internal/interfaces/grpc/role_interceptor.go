@@ -0,0 +1,88 @@
+package grpc
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/identity"
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+)
+
+// stockMutatingMethods are the RPCs that move or adjust stock directly and are
+// therefore restricted to stockRoles
+var stockMutatingMethods = map[string]bool{
+	inventoryv1.InventoryService_AddStock_FullMethodName:                true,
+	inventoryv1.InventoryService_RemoveStock_FullMethodName:             true,
+	inventoryv1.InventoryService_AdjustInventoryForOrder_FullMethodName: true,
+	inventoryv1.InventoryService_CreateTransfer_FullMethodName:          true,
+	inventoryv1.InventoryService_UpdateTransferStatus_FullMethodName:    true,
+	inventoryv1.InventoryService_ReceivePurchaseOrder_FullMethodName:    true,
+	inventoryv1.InventoryService_SetUnitOfMeasure_FullMethodName:        true,
+	inventoryv1.InventoryService_SetBackorderPolicy_FullMethodName:      true,
+	inventoryv1.InventoryService_ReconcileReservations_FullMethodName:   true,
+	inventoryv1.InventoryService_TransferStock_FullMethodName:           true,
+	inventoryv1.InventoryService_BatchAdjust_FullMethodName:             true,
+	inventoryv1.InventoryService_UpdateInventory_FullMethodName:         true,
+	inventoryv1.InventoryService_MergeDuplicateInventory_FullMethodName: true,
+	inventoryv1.InventoryService_DeleteInventory_FullMethodName:         true,
+	inventoryv1.InventoryService_RestockReturn_FullMethodName:           true,
+}
+
+// stockRoles are the roles allowed to call stockMutatingMethods
+var stockRoles = map[string]bool{
+	"ADMIN":     true,
+	"STAFF":     true,
+	"WAREHOUSE": true,
+}
+
+// staffOnlyMethods are the RPCs for support intervention, restricted to
+// staffRoles
+var staffOnlyMethods = map[string]bool{
+	inventoryv1.InventoryService_GetReservationStatus_FullMethodName: true,
+	inventoryv1.InventoryService_SetReservationStatus_FullMethodName: true,
+}
+
+// staffRoles are the roles allowed to call staffOnlyMethods
+var staffRoles = map[string]bool{
+	"ADMIN": true,
+	"STAFF": true,
+}
+
+// RequireStockRole returns an interceptor that rejects calls to stock-mutating
+// and staff-only RPCs with PermissionDenied unless the caller's role allows
+// them. The gateway enforces the same rule per route; this keeps direct gRPC
+// callers in line.
+func RequireStockRole(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	logger = logger.Named("role_interceptor")
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if staffOnlyMethods[info.FullMethod] {
+			role := identity.Role(ctx)
+			if !staffRoles[role] {
+				logger.Warn("Staff-only call denied",
+					zap.String("method", info.FullMethod),
+					zap.String("role", role),
+				)
+				return nil, status.Error(codes.PermissionDenied, "staff role required")
+			}
+			return handler(ctx, req)
+		}
+		if !stockMutatingMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		role := identity.Role(ctx)
+		if !stockRoles[role] {
+			logger.Warn("Stock mutation denied",
+				zap.String("method", info.FullMethod),
+				zap.String("role", role),
+			)
+			return nil, status.Error(codes.PermissionDenied, "insufficient role to modify stock")
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,75 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/identity"
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+)
+
+// callAs runs the role interceptor for method with role in the incoming
+// metadata, an empty role sending none, and reports whether the handler ran
+func callAs(role, method string) (bool, error) {
+	ctx := context.Background()
+	if role != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(identity.RoleMetadataKey, role))
+	}
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return nil, nil
+	}
+	_, err := RequireStockRole(zap.NewNop())(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	return called, err
+}
+
+func TestRequireStockRoleDeniesUnauthorizedRoles(t *testing.T) {
+	methods := []string{
+		inventoryv1.InventoryService_AddStock_FullMethodName,
+		inventoryv1.InventoryService_TransferStock_FullMethodName,
+		inventoryv1.InventoryService_BatchAdjust_FullMethodName,
+		inventoryv1.InventoryService_UpdateInventory_FullMethodName,
+		inventoryv1.InventoryService_MergeDuplicateInventory_FullMethodName,
+		inventoryv1.InventoryService_DeleteInventory_FullMethodName,
+		inventoryv1.InventoryService_RestockReturn_FullMethodName,
+	}
+	for _, method := range methods {
+		for _, role := range []string{"", "CUSTOMER", "SUPPLIER", "staff"} {
+			called, err := callAs(role, method)
+			assert.False(t, called, "%s as %q should not reach the handler", method, role)
+			assert.Equal(t, codes.PermissionDenied, status.Code(err), "%s as %q", method, role)
+		}
+	}
+}
+
+func TestRequireStockRoleAllowsStockRoles(t *testing.T) {
+	for _, role := range []string{"ADMIN", "STAFF", "WAREHOUSE"} {
+		called, err := callAs(role, inventoryv1.InventoryService_AddStock_FullMethodName)
+		assert.NoError(t, err, role)
+		assert.True(t, called, role)
+	}
+}
+
+func TestRequireStockRoleLeavesOtherMethodsOpen(t *testing.T) {
+	called, err := callAs("CUSTOMER", inventoryv1.InventoryService_CheckAvailability_FullMethodName)
+	assert.NoError(t, err)
+	assert.True(t, called)
+}
+
+func TestRequireStockRoleKeepsStaffOnlyMethodsFromWarehouse(t *testing.T) {
+	called, err := callAs("WAREHOUSE", inventoryv1.InventoryService_SetReservationStatus_FullMethodName)
+	assert.False(t, called)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	called, err = callAs("STAFF", inventoryv1.InventoryService_SetReservationStatus_FullMethodName)
+	assert.NoError(t, err)
+	assert.True(t, called)
+}
@@ -0,0 +1,60 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// BatchAdjust applies the adjustments of a cycle count at one location. Lines
+// that cannot be applied are reported in their result rather than failing the
+// call.
+func (s *InventoryServer) BatchAdjust(ctx context.Context, req *inventoryv1.BatchAdjustRequest) (*inventoryv1.BatchAdjustResponse, error) {
+	logger := s.logger.With(
+		zap.String("handler", "BatchAdjust"),
+		zap.String("location_id", req.LocationId),
+		zap.Int("lines", len(req.Lines)),
+	)
+
+	lines := make([]domain.AdjustLine, 0, len(req.Lines))
+	for _, l := range req.Lines {
+		lines = append(lines, domain.AdjustLine{SKU: l.Sku, Quantity: l.Quantity})
+	}
+
+	batch, err := s.service.BatchAdjust(ctx, req.LocationId, lines, req.Reason, req.PerformedBy)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		logger.Error("Failed to apply batch adjustment", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to apply batch adjustment")
+	}
+
+	applied := batch.Applied()
+	resp := &inventoryv1.BatchAdjustResponse{
+		BatchId: batch.ID,
+		Results: make([]*inventoryv1.BatchAdjustLineResult, 0, len(batch.Results)),
+		Applied: int32(applied),
+		Failed:  int32(len(batch.Results) - applied),
+	}
+	for _, r := range batch.Results {
+		result := &inventoryv1.BatchAdjustLineResult{
+			Sku:             r.SKU,
+			InventoryItemId: r.InventoryItemID,
+			QuantityBefore:  r.QuantityBefore,
+			QuantityAfter:   r.QuantityAfter,
+			Success:         r.Err == nil,
+		}
+		if r.Err != nil {
+			result.ErrorMessage = r.Err.Error()
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	return resp, nil
+}
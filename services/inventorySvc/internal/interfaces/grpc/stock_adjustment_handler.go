@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/dates"
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// ExportStockAdjustments exports the stock adjustments matching the request as CSV
+func (s *InventoryServer) ExportStockAdjustments(ctx context.Context, req *inventoryv1.ExportStockAdjustmentsRequest) (*inventoryv1.ExportStockAdjustmentsResponse, error) {
+	logger := s.logger.With(
+		zap.String("handler", "ExportStockAdjustments"),
+		zap.String("location_id", req.LocationId),
+	)
+
+	filter := domain.StockAdjustmentFilter{
+		LocationID: req.LocationId,
+		Reason:     req.Reason,
+	}
+	var err error
+	if req.From != "" {
+		if filter.From, err = dates.Parse(req.From); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "from: "+err.Error())
+		}
+	}
+	if req.To != "" {
+		if filter.To, err = dates.Parse(req.To); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "to: "+err.Error())
+		}
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return nil, status.Error(codes.InvalidArgument, "from must be before to")
+	}
+
+	data, count, err := s.service.ExportStockAdjustments(ctx, filter)
+	if err != nil {
+		logger.Error("Failed to export stock adjustments", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to export stock adjustments")
+	}
+
+	filename := fmt.Sprintf("stock_adjustments_%s.csv", time.Now().Format("20060102_150405"))
+	if req.LocationId != "" {
+		filename = fmt.Sprintf("stock_adjustments_%s_%s.csv", req.LocationId, time.Now().Format("20060102_150405"))
+	}
+	return &inventoryv1.ExportStockAdjustmentsResponse{
+		Data:        data,
+		Filename:    filename,
+		ContentType: "text/csv",
+		Count:       int32(count),
+	}, nil
+}
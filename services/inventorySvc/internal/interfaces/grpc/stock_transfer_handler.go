@@ -0,0 +1,44 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// TransferStock moves stock of a SKU between two locations in one step
+func (s *InventoryServer) TransferStock(ctx context.Context, req *inventoryv1.TransferStockRequest) (*inventoryv1.TransferStockResponse, error) {
+	logger := s.logger.With(
+		zap.String("handler", "TransferStock"),
+		zap.String("sku", req.Sku),
+		zap.String("from_location_id", req.FromLocationId),
+		zap.String("to_location_id", req.ToLocationId),
+	)
+
+	transfer, err := s.service.TransferStock(ctx, req.Sku, req.FromLocationId, req.ToLocationId, req.Quantity, req.PerformedBy)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, domain.ErrNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrInsufficientStock):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		logger.Error("Failed to transfer stock", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to transfer stock")
+	}
+
+	return &inventoryv1.TransferStockResponse{
+		TransferId:         transfer.ID,
+		Source:             toProtoInventoryItem(transfer.Source),
+		Destination:        toProtoInventoryItem(transfer.Destination),
+		DestinationCreated: transfer.DestinationCreated,
+	}, nil
+}
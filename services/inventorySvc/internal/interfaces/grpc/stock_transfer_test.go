@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/application"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// transferringRepository moves stock between two fixed items, or fails every
+// transfer with err
+type transferringRepository struct {
+	domain.InventoryRepository
+	source, destination *domain.InventoryItem
+	err                 error
+}
+
+func (r *transferringRepository) TransferStock(ctx context.Context, transfer *domain.StockTransfer) error {
+	if r.err != nil {
+		return r.err
+	}
+	if err := r.source.TransferStock(transfer.Quantity, r.destination); err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrInsufficientStock, err)
+	}
+	transfer.Source, transfer.Destination = r.source, r.destination
+	return nil
+}
+
+func newTransferTestServer(repo domain.InventoryRepository) inventoryv1.InventoryServiceServer {
+	service := application.NewInventoryService(repo, nil, nil, domain.ReservationTTL{}, false, zap.NewNop())
+	return NewInventoryServer(service, nil, nil, nil, nil, zap.NewNop())
+}
+
+func TestTransferStockHandler(t *testing.T) {
+	source := domain.NewInventoryItem("product-1", 10, "SKU-1", "store-1")
+	destination := domain.NewInventoryItem("product-1", 0, "SKU-1", "store-2")
+	server := newTransferTestServer(&transferringRepository{source: source, destination: destination})
+
+	resp, err := server.TransferStock(context.Background(), &inventoryv1.TransferStockRequest{
+		Sku: "SKU-1", FromLocationId: "store-1", ToLocationId: "store-2", Quantity: 4, PerformedBy: "staff-1",
+	})
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, resp.GetTransferId())
+	assert.Equal(t, int32(6), resp.GetSource().GetQuantity())
+	assert.Equal(t, int32(4), resp.GetDestination().GetQuantity())
+}
+
+func TestTransferStockHandlerErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		repoErr  error
+		quantity int32
+		wantCode codes.Code
+	}{
+		{name: "insufficient stock", repoErr: fmt.Errorf("%w: 2 available", domain.ErrInsufficientStock), quantity: 5, wantCode: codes.FailedPrecondition},
+		{name: "unknown source", repoErr: fmt.Errorf("%w: no inventory", domain.ErrNotFound), quantity: 1, wantCode: codes.NotFound},
+		{name: "invalid quantity", quantity: 0, wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTransferTestServer(&transferringRepository{err: tt.repoErr})
+
+			_, err := server.TransferStock(context.Background(), &inventoryv1.TransferStockRequest{
+				Sku: "SKU-1", FromLocationId: "store-1", ToLocationId: "store-2", Quantity: tt.quantity,
+			})
+
+			assert.Equal(t, tt.wantCode, status.Code(err), "error: %v", err)
+		})
+	}
+}
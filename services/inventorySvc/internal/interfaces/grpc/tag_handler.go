@@ -0,0 +1,35 @@
+package grpc
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+)
+
+// UpdateInventoryTags adds and removes tags on inventory items at a location
+func (s *InventoryServer) UpdateInventoryTags(ctx context.Context, req *inventoryv1.UpdateInventoryTagsRequest) (*inventoryv1.UpdateInventoryTagsResponse, error) {
+	logger := s.logger.With(
+		zap.String("handler", "UpdateInventoryTags"),
+		zap.String("location_id", req.LocationId),
+		zap.Int("item_count", len(req.ItemIds)),
+	)
+
+	if req.LocationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "location ID is required")
+	}
+	if len(req.AddTags) == 0 && len(req.RemoveTags) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one tag to add or remove is required")
+	}
+
+	matched, err := s.service.UpdateItemTags(ctx, req.LocationId, req.ItemIds, req.AddTags, req.RemoveTags)
+	if err != nil {
+		logger.Error("Failed to update inventory item tags", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to update inventory item tags")
+	}
+
+	return &inventoryv1.UpdateInventoryTagsResponse{MatchedCount: matched}, nil
+}
@@ -178,12 +178,15 @@ func mapDomainTransferToProto(t *domain.Transfer) *inventoryv1.InventoryTransfer
 		RequestedDate:         timestampToString(t.RequestedAt),
 		CreatedAt:             timestampToString(t.RequestedAt),
 		UpdatedAt:             timestampToString(t.RequestedAt), // Default to requested time
+		CreatedAtTs:           timestamppb.New(t.RequestedAt),
+		UpdatedAtTs:           timestamppb.New(t.RequestedAt),
 		Notes:                 "", // Not mapped in domain model
 	}
 
 	// Set optional fields if available
 	if t.ApprovedAt != nil {
 		result.UpdatedAt = timestampToString(*t.ApprovedAt)
+		result.UpdatedAtTs = timestamppb.New(*t.ApprovedAt)
 	}
 
 	if t.EstimatedArrival != nil {
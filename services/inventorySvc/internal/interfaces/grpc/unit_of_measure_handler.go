@@ -0,0 +1,58 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+)
+
+// SetUnitOfMeasure sets the units an inventory item is sold and stocked in
+func (s *InventoryServer) SetUnitOfMeasure(ctx context.Context, req *inventoryv1.SetUnitOfMeasureRequest) (*inventoryv1.SetUnitOfMeasureResponse, error) {
+	logger := s.logger.With(
+		zap.String("handler", "SetUnitOfMeasure"),
+		zap.String("id", req.Id),
+	)
+
+	if err := validateItemID(req.Id); err != nil {
+		return nil, err
+	}
+
+	item, err := s.service.SetUnitOfMeasure(ctx, req.Id, req.SellingUnit, req.StockingUnit, req.UnitsPerStockingUnit)
+	if err != nil {
+		return nil, unitOfMeasureError(logger, err)
+	}
+
+	return &inventoryv1.SetUnitOfMeasureResponse{Inventory: toProtoInventoryItem(item)}, nil
+}
+
+// toSellingUnits converts the quantity of a stock request given in unit to the
+// item's selling units; requests without a unit are already in selling units
+func (s *InventoryServer) toSellingUnits(ctx context.Context, id string, quantity int32, unit string) (int32, error) {
+	if unit == "" {
+		return quantity, nil
+	}
+	converted, err := s.service.ToSellingUnits(ctx, id, quantity, unit)
+	if err != nil {
+		return 0, unitOfMeasureError(s.logger, err)
+	}
+	return converted, nil
+}
+
+// unitOfMeasureError maps unit of measure errors to gRPC status errors
+func unitOfMeasureError(logger *zap.Logger, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrNotFound):
+		return status.Error(codes.NotFound, "inventory item not found")
+	default:
+		logger.Error("Failed to apply unit of measure", zap.Error(err))
+		return status.Error(codes.Internal, "failed to apply unit of measure")
+	}
+}
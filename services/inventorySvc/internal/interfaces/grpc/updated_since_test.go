@@ -0,0 +1,24 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseUpdatedSince(t *testing.T) {
+	since, err := parseUpdatedSince("")
+	require.NoError(t, err)
+	assert.True(t, since.IsZero(), "no filter without a value")
+
+	since, err = parseUpdatedSince("2024-05-10T12:00:00Z")
+	require.NoError(t, err)
+	assert.True(t, since.Equal(time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)))
+
+	_, err = parseUpdatedSince("yesterday")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -3,20 +3,22 @@ package server
 import (
 	"context"
 	"net"
-	"os"
-	"os/signal"
-	"syscall"
-	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/shutdown"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/version"
 	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
 	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/application"
 	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/config"
 	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/database"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/domain"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/infrastructure/kafka"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/infrastructure/orders"
+	"github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/infrastructure/products"
 	grpchandlers "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/internal/interfaces/grpc"
 )
 
@@ -26,6 +28,9 @@ type Server struct {
 	config     *config.Config
 	database   *database.Database
 	logger     *zap.Logger
+	reconciler *application.ReservationReconciler
+	expirer    *application.ReservationExpirer
+	shutdown   *shutdown.Coordinator
 }
 
 // New creates a new server instance
@@ -40,17 +45,54 @@ func New(cfg *config.Config, db *database.Database, logger *zap.Logger) *Server
 // Initialize sets up the gRPC server with all services
 func (s *Server) Initialize() error {
 	// Create gRPC server
-	s.grpcServer = grpc.NewServer()
+	s.grpcServer = grpc.NewServer(append(s.config.GRPCLimits.ServerOptions(),
+		grpc.UnaryInterceptor(grpchandlers.RequireStockRole(s.logger)),
+	)...)
+
+	// New inventory items are checked against the product catalog unless
+	// that is turned off
+	var productCatalog domain.ProductCatalog
+	var catalog *products.Catalog
+	if s.config.ValidateProducts {
+		var err error
+		if catalog, err = products.NewCatalog(s.config.ProductSvcURL, s.logger); err != nil {
+			return err
+		}
+		productCatalog = catalog
+	}
+
+	// Publish stock changes so the gateway can keep its availability cache
+	// fresh; without brokers it relies on its cache TTL
+	var inventoryRepo domain.InventoryRepository = s.database.InventoryRepo
+	var stockEvents *kafka.Publisher
+	if len(s.config.KafkaBrokers) > 0 {
+		var err error
+		stockEvents, err = kafka.NewPublisher(kafka.Config{
+			Brokers: s.config.KafkaBrokers,
+			Topic:   s.config.StockEventsTopic,
+		}, s.logger)
+		if err != nil {
+			return err
+		}
+		inventoryRepo = application.NewStockEventRepository(inventoryRepo, stockEvents, s.logger)
+	} else {
+		s.logger.Info("No Kafka brokers configured, stock changed events are not published")
+	}
 
 	// Initialize services
-	inventoryService := application.NewInventoryService(s.database.InventoryRepo, s.logger)
+	reservationTTL := domain.ReservationTTL{
+		Default: s.config.ReservationTTL,
+		Max:     s.config.ReservationMaxTTL,
+	}
+	inventoryService := application.NewInventoryService(inventoryRepo, s.database.ReceiptRepo, productCatalog, reservationTTL, s.config.AllowBackorder, s.logger)
 	locationService := application.NewLocationService(s.database.LocationRepo, s.logger)
 	transferService := application.NewTransferService(
 		s.database.TransferRepo,
-		s.database.InventoryRepo,
+		inventoryRepo,
 		s.database.LocationRepo,
 		s.logger,
 	)
+	backInStockService := application.NewBackInStockService(s.database.BackInStockRepo, s.logger)
 
 	// Initialize inventory order service
 	inventoryOrderService, err := application.NewInventoryOrderService(
@@ -67,11 +109,43 @@ func (s *Server) Initialize() error {
 	// Store for cleanup
 	defer inventoryOrderService.Close()
 
+	// Reconcile order reservations against the order service
+	orderLookup, err := orders.NewLookup(s.config.OrderSvcURL, s.logger)
+	if err != nil {
+		return err
+	}
+	s.reconciler = application.NewReservationReconciler(
+		inventoryService,
+		orderLookup,
+		s.config.ReservationReconcileMinAge,
+		s.config.ReservationReconcileInterval,
+		s.logger,
+	)
+	s.reconciler.Start()
+
+	// Give the units of lapsed reservations back
+	s.expirer = application.NewReservationExpirer(inventoryService, s.config.ReservationExpiryInterval, s.logger)
+	s.expirer.Start()
+
+	s.shutdown = shutdown.New(s.config.ShutdownDrainTimeout, s.logger)
+	s.shutdown.AddWorker("reservation_reconciler", s.reconciler.Stop)
+	s.shutdown.AddWorker("reservation_expirer", s.expirer.Stop)
+	s.shutdown.AddServer("grpc", shutdown.GRPCServer(s.grpcServer))
+	s.shutdown.AddCloser("order_lookup", orderLookup.Close)
+	if catalog != nil {
+		s.shutdown.AddCloser("product_catalog", catalog.Close)
+	}
+	if stockEvents != nil {
+		s.shutdown.AddCloser("stock_events", stockEvents.Close)
+	}
+
 	// Initialize gRPC handlers
 	inventoryServer := grpchandlers.NewInventoryServer(
 		inventoryService,
 		transferService,
 		locationService,
+		backInStockService,
+		s.reconciler,
 		s.logger,
 	)
 
@@ -80,7 +154,7 @@ func (s *Server) Initialize() error {
 
 	// Register health check service
 	healthServer := grpchandlers.NewHealthServer(s.logger)
-	grpc_health_v1.RegisterHealthServer(s.grpcServer, healthServer)
+	grpc_health_v1.RegisterHealthServer(s.grpcServer, version.NewHealthServer(healthServer))
 
 	// Enable reflection for development
 	reflection.Register(s.grpcServer)
@@ -89,7 +163,8 @@ func (s *Server) Initialize() error {
 	return nil
 }
 
-// Start starts the gRPC server with graceful shutdown
+// Start starts the gRPC server and blocks until the process is told to
+// stop, then shuts down through the coordinator
 func (s *Server) Start() error {
 	// Create listener
 	lis, err := net.Listen("tcp", "0.0.0.0:"+s.config.GRPCPort)
@@ -105,47 +180,12 @@ func (s *Server) Start() error {
 		}
 	}()
 
-	// Wait for interrupt signal
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
-
-	s.logger.Info("Shutting down gRPC server...")
-
-	// Graceful shutdown with timeout
-	done := make(chan struct{})
-	go func() {
-		s.grpcServer.GracefulStop()
-		close(done)
-	}()
-
-	// Wait for graceful shutdown or timeout
-	select {
-	case <-done:
-		s.logger.Info("gRPC server stopped gracefully")
-	case <-time.After(10 * time.Second):
-		s.logger.Warn("Graceful shutdown timed out, forcing stop")
-		s.grpcServer.Stop()
-	}
-
+	s.shutdown.ShutdownOnSignal()
 	return nil
 }
 
-// Shutdown gracefully shuts down the server
+// Shutdown drains the reservation reconciler, then stops the gRPC server
+// within ctx and closes the order service connection
 func (s *Server) Shutdown(ctx context.Context) error {
-	done := make(chan struct{})
-	go func() {
-		s.grpcServer.GracefulStop()
-		close(done)
-	}()
-
-	select {
-	case <-done:
-		s.logger.Info("gRPC server stopped gracefully")
-		return nil
-	case <-ctx.Done():
-		s.logger.Warn("Graceful shutdown timed out, forcing stop")
-		s.grpcServer.Stop()
-		return ctx.Err()
-	}
+	return s.shutdown.Shutdown(ctx)
 }
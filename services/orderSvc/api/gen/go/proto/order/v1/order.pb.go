@@ -9,6 +9,7 @@ package orderv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -139,6 +140,60 @@ func (OrderSource) EnumDescriptor() ([]byte, []int) {
 	return file_order_v1_order_proto_rawDescGZIP(), []int{1}
 }
 
+// FulfillmentStatus rolls the shipped quantities of an order's items up.
+// Shipped and delivered orders are always complete.
+type FulfillmentStatus int32
+
+const (
+	FulfillmentStatus_FULFILLMENT_STATUS_UNSPECIFIED FulfillmentStatus = 0
+	FulfillmentStatus_FULFILLMENT_STATUS_NONE        FulfillmentStatus = 1 // Nothing shipped yet
+	FulfillmentStatus_FULFILLMENT_STATUS_PARTIAL     FulfillmentStatus = 2 // Some items or units shipped
+	FulfillmentStatus_FULFILLMENT_STATUS_COMPLETE    FulfillmentStatus = 3 // Every item shipped
+)
+
+// Enum value maps for FulfillmentStatus.
+var (
+	FulfillmentStatus_name = map[int32]string{
+		0: "FULFILLMENT_STATUS_UNSPECIFIED",
+		1: "FULFILLMENT_STATUS_NONE",
+		2: "FULFILLMENT_STATUS_PARTIAL",
+		3: "FULFILLMENT_STATUS_COMPLETE",
+	}
+	FulfillmentStatus_value = map[string]int32{
+		"FULFILLMENT_STATUS_UNSPECIFIED": 0,
+		"FULFILLMENT_STATUS_NONE":        1,
+		"FULFILLMENT_STATUS_PARTIAL":     2,
+		"FULFILLMENT_STATUS_COMPLETE":    3,
+	}
+)
+
+func (x FulfillmentStatus) Enum() *FulfillmentStatus {
+	p := new(FulfillmentStatus)
+	*p = x
+	return p
+}
+
+func (x FulfillmentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FulfillmentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_v1_order_proto_enumTypes[2].Descriptor()
+}
+
+func (FulfillmentStatus) Type() protoreflect.EnumType {
+	return &file_order_v1_order_proto_enumTypes[2]
+}
+
+func (x FulfillmentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FulfillmentStatus.Descriptor instead.
+func (FulfillmentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{2}
+}
+
 // OrderItem represents an item in an order
 type OrderItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -148,7 +203,8 @@ type OrderItem struct {
 	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
 	Subtotal      float64                `protobuf:"fixed64,6,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
-	StoreId       string                 `protobuf:"bytes,7,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"` // Store where item was sourced from (optional)
+	StoreId       string                 `protobuf:"bytes,7,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`                 // Store where item was sourced from (optional)
+	FulfilledQty  int32                  `protobuf:"varint,8,opt,name=fulfilled_qty,json=fulfilledQty,proto3" json:"fulfilled_qty,omitempty"` // Units shipped so far
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -232,6 +288,13 @@ func (x *OrderItem) GetStoreId() string {
 	return ""
 }
 
+func (x *OrderItem) GetFulfilledQty() int32 {
+	if x != nil {
+		return x.FulfilledQty
+	}
+	return 0
+}
+
 // Address represents a shipping or billing address
 type Address struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -398,17 +461,29 @@ type Order struct {
 	BillingAddress  *Address               `protobuf:"bytes,7,opt,name=billing_address,json=billingAddress,proto3" json:"billing_address,omitempty"`
 	Payment         *Payment               `protobuf:"bytes,8,opt,name=payment,proto3" json:"payment,omitempty"`
 	TrackingCode    string                 `protobuf:"bytes,9,opt,name=tracking_code,json=trackingCode,proto3" json:"tracking_code,omitempty"`
-	Notes           string                 `protobuf:"bytes,10,opt,name=notes,proto3" json:"notes,omitempty"`
-	CreatedAt       string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt       string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CompletedAt     string                 `protobuf:"bytes,13,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
-	Source          OrderSource            `protobuf:"varint,14,opt,name=source,proto3,enum=order.v1.OrderSource" json:"source,omitempty"`         // Where the order came from
-	StoreId         string                 `protobuf:"bytes,15,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`                   // Store ID if order is from/for a store
-	SalesUserId     string                 `protobuf:"bytes,16,opt,name=sales_user_id,json=salesUserId,proto3" json:"sales_user_id,omitempty"`     // Employee who processed the sale (for store orders)
-	ReservationId   string                 `protobuf:"bytes,17,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"` // Reservation ID if order is from a reservation
-	Version         int32                  `protobuf:"varint,18,opt,name=version,proto3" json:"version,omitempty"`                                 // Version field for optimistic locking
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	Notes           string                 `protobuf:"bytes,10,opt,name=notes,proto3" json:"notes,omitempty"` // Latest note's text; see note_log for every note
+	// RFC3339 forms of the *_ts fields, still populated until clients have moved over
+	//
+	// Deprecated: Marked as deprecated in order/v1/order.proto.
+	CreatedAt string `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Deprecated: Marked as deprecated in order/v1/order.proto.
+	UpdatedAt string `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Deprecated: Marked as deprecated in order/v1/order.proto.
+	CompletedAt       string                 `protobuf:"bytes,13,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Source            OrderSource            `protobuf:"varint,14,opt,name=source,proto3,enum=order.v1.OrderSource" json:"source,omitempty"`                                                      // Where the order came from
+	StoreId           string                 `protobuf:"bytes,15,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`                                                                // Store ID if order is from/for a store
+	SalesUserId       string                 `protobuf:"bytes,16,opt,name=sales_user_id,json=salesUserId,proto3" json:"sales_user_id,omitempty"`                                                  // Employee who processed the sale (for store orders)
+	ReservationId     string                 `protobuf:"bytes,17,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`                                              // Reservation ID if order is from a reservation
+	Version           int32                  `protobuf:"varint,18,opt,name=version,proto3" json:"version,omitempty"`                                                                              // Version field for optimistic locking
+	NoteLog           []*OrderNote           `protobuf:"bytes,19,rep,name=note_log,json=noteLog,proto3" json:"note_log,omitempty"`                                                                // Every note added to the order, oldest first
+	FulfillmentStatus FulfillmentStatus      `protobuf:"varint,20,opt,name=fulfillment_status,json=fulfillmentStatus,proto3,enum=order.v1.FulfillmentStatus" json:"fulfillment_status,omitempty"` // How much of the order has shipped
+	Shipments         []*Shipment            `protobuf:"bytes,21,rep,name=shipments,proto3" json:"shipments,omitempty"`                                                                           // Shipments sent for the order, oldest first
+	Guest             *GuestContact          `protobuf:"bytes,22,opt,name=guest,proto3" json:"guest,omitempty"`                                                                                   // Contact of a buyer who ordered without an account
+	CreatedAtTs       *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=created_at_ts,json=createdAtTs,proto3" json:"created_at_ts,omitempty"`
+	UpdatedAtTs       *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=updated_at_ts,json=updatedAtTs,proto3" json:"updated_at_ts,omitempty"`
+	CompletedAtTs     *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=completed_at_ts,json=completedAtTs,proto3" json:"completed_at_ts,omitempty"` // Unset until the order is completed
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Order) Reset() {
@@ -511,6 +586,7 @@ func (x *Order) GetNotes() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in order/v1/order.proto.
 func (x *Order) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
@@ -518,6 +594,7 @@ func (x *Order) GetCreatedAt() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in order/v1/order.proto.
 func (x *Order) GetUpdatedAt() string {
 	if x != nil {
 		return x.UpdatedAt
@@ -525,6 +602,7 @@ func (x *Order) GetUpdatedAt() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in order/v1/order.proto.
 func (x *Order) GetCompletedAt() string {
 	if x != nil {
 		return x.CompletedAt
@@ -567,129 +645,131 @@ func (x *Order) GetVersion() int32 {
 	return 0
 }
 
-// CreateOrderRequest is the request for creating an order
-type CreateOrderRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Items           []*OrderItem           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-	ShippingAddress *Address               `protobuf:"bytes,3,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
-	BillingAddress  *Address               `protobuf:"bytes,4,opt,name=billing_address,json=billingAddress,proto3" json:"billing_address,omitempty"`
-	Source          OrderSource            `protobuf:"varint,5,opt,name=source,proto3,enum=order.v1.OrderSource" json:"source,omitempty"`         // Source of the order
-	StoreId         string                 `protobuf:"bytes,6,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`                   // Store ID if order is from/for a store
-	SalesUserId     string                 `protobuf:"bytes,7,opt,name=sales_user_id,json=salesUserId,proto3" json:"sales_user_id,omitempty"`     // Employee processing the sale (for store orders)
-	ReservationId   string                 `protobuf:"bytes,8,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"` // Reservation ID if order is from a reservation
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
-}
-
-func (x *CreateOrderRequest) Reset() {
-	*x = CreateOrderRequest{}
-	mi := &file_order_v1_order_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateOrderRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateOrderRequest) ProtoMessage() {}
-
-func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[4]
+func (x *Order) GetNoteLog() []*OrderNote {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.NoteLog
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
-func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{4}
+func (x *Order) GetFulfillmentStatus() FulfillmentStatus {
+	if x != nil {
+		return x.FulfillmentStatus
+	}
+	return FulfillmentStatus_FULFILLMENT_STATUS_UNSPECIFIED
 }
 
-func (x *CreateOrderRequest) GetUserId() string {
+func (x *Order) GetShipments() []*Shipment {
 	if x != nil {
-		return x.UserId
+		return x.Shipments
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateOrderRequest) GetItems() []*OrderItem {
+func (x *Order) GetGuest() *GuestContact {
 	if x != nil {
-		return x.Items
+		return x.Guest
 	}
 	return nil
 }
 
-func (x *CreateOrderRequest) GetShippingAddress() *Address {
+func (x *Order) GetCreatedAtTs() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ShippingAddress
+		return x.CreatedAtTs
 	}
 	return nil
 }
 
-func (x *CreateOrderRequest) GetBillingAddress() *Address {
+func (x *Order) GetUpdatedAtTs() *timestamppb.Timestamp {
 	if x != nil {
-		return x.BillingAddress
+		return x.UpdatedAtTs
 	}
 	return nil
 }
 
-func (x *CreateOrderRequest) GetSource() OrderSource {
+func (x *Order) GetCompletedAtTs() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Source
+		return x.CompletedAtTs
 	}
-	return OrderSource_ORDER_SOURCE_UNSPECIFIED
+	return nil
 }
 
-func (x *CreateOrderRequest) GetStoreId() string {
+// GuestContact is how the buyer of a guest order is reached
+type GuestContact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuestContact) Reset() {
+	*x = GuestContact{}
+	mi := &file_order_v1_order_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuestContact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestContact) ProtoMessage() {}
+
+func (x *GuestContact) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[4]
 	if x != nil {
-		return x.StoreId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateOrderRequest) GetSalesUserId() string {
+// Deprecated: Use GuestContact.ProtoReflect.Descriptor instead.
+func (*GuestContact) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GuestContact) GetEmail() string {
 	if x != nil {
-		return x.SalesUserId
+		return x.Email
 	}
 	return ""
 }
 
-func (x *CreateOrderRequest) GetReservationId() string {
+func (x *GuestContact) GetPhone() string {
 	if x != nil {
-		return x.ReservationId
+		return x.Phone
 	}
 	return ""
 }
 
-// CreateOrderResponse is the response for creating an order
-type CreateOrderResponse struct {
+// ShipmentItem is a quantity of one of the order's products in a shipment
+type ShipmentItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOrderResponse) Reset() {
-	*x = CreateOrderResponse{}
+func (x *ShipmentItem) Reset() {
+	*x = ShipmentItem{}
 	mi := &file_order_v1_order_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOrderResponse) String() string {
+func (x *ShipmentItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrderResponse) ProtoMessage() {}
+func (*ShipmentItem) ProtoMessage() {}
 
-func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
+func (x *ShipmentItem) ProtoReflect() protoreflect.Message {
 	mi := &file_order_v1_order_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -701,40 +781,53 @@ func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
-func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShipmentItem.ProtoReflect.Descriptor instead.
+func (*ShipmentItem) Descriptor() ([]byte, []int) {
 	return file_order_v1_order_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateOrderResponse) GetOrder() *Order {
+func (x *ShipmentItem) GetProductId() string {
 	if x != nil {
-		return x.Order
+		return x.ProductId
 	}
-	return nil
+	return ""
 }
 
-// GetOrderRequest is the request for retrieving an order
-type GetOrderRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *ShipmentItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Shipment is a parcel sent for part or all of an order
+type Shipment struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TrackingCode string                 `protobuf:"bytes,2,opt,name=tracking_code,json=trackingCode,proto3" json:"tracking_code,omitempty"`
+	Items        []*ShipmentItem        `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	// Deprecated: Marked as deprecated in order/v1/order.proto.
+	ShippedAt     string                 `protobuf:"bytes,4,opt,name=shipped_at,json=shippedAt,proto3" json:"shipped_at,omitempty"` // RFC3339 form of shipped_at_ts
+	ShippedBy     string                 `protobuf:"bytes,5,opt,name=shipped_by,json=shippedBy,proto3" json:"shipped_by,omitempty"`
+	ShippedAtTs   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=shipped_at_ts,json=shippedAtTs,proto3" json:"shipped_at_ts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderRequest) Reset() {
-	*x = GetOrderRequest{}
+func (x *Shipment) Reset() {
+	*x = Shipment{}
 	mi := &file_order_v1_order_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderRequest) String() string {
+func (x *Shipment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderRequest) ProtoMessage() {}
+func (*Shipment) ProtoMessage() {}
 
-func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+func (x *Shipment) ProtoReflect() protoreflect.Message {
 	mi := &file_order_v1_order_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -746,88 +839,83 @@ func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Shipment.ProtoReflect.Descriptor instead.
+func (*Shipment) Descriptor() ([]byte, []int) {
 	return file_order_v1_order_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetOrderRequest) GetId() string {
+func (x *Shipment) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-// GetOrderResponse is the response for retrieving an order
-type GetOrderResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetOrderResponse) Reset() {
-	*x = GetOrderResponse{}
-	mi := &file_order_v1_order_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Shipment) GetTrackingCode() string {
+	if x != nil {
+		return x.TrackingCode
+	}
+	return ""
 }
 
-func (x *GetOrderResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Shipment) GetItems() []*ShipmentItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
 }
 
-func (*GetOrderResponse) ProtoMessage() {}
-
-func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[7]
+// Deprecated: Marked as deprecated in order/v1/order.proto.
+func (x *Shipment) GetShippedAt() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ShippedAt
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
-func (*GetOrderResponse) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{7}
+func (x *Shipment) GetShippedBy() string {
+	if x != nil {
+		return x.ShippedBy
+	}
+	return ""
 }
 
-func (x *GetOrderResponse) GetOrder() *Order {
+func (x *Shipment) GetShippedAtTs() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Order
+		return x.ShippedAtTs
 	}
 	return nil
 }
 
-// GetUserOrdersRequest is the request for retrieving a user's orders
-type GetUserOrdersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+// OrderNote is an entry in an order's append-only note log
+type OrderNote struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AuthorId  string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Text      string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	ProductId string                 `protobuf:"bytes,4,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"` // Set when the note is about a single item
+	// Deprecated: Marked as deprecated in order/v1/order.proto.
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339 form of created_at_ts
+	CreatedAtTs   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at_ts,json=createdAtTs,proto3" json:"created_at_ts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserOrdersRequest) Reset() {
-	*x = GetUserOrdersRequest{}
-	mi := &file_order_v1_order_proto_msgTypes[8]
+func (x *OrderNote) Reset() {
+	*x = OrderNote{}
+	mi := &file_order_v1_order_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserOrdersRequest) String() string {
+func (x *OrderNote) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserOrdersRequest) ProtoMessage() {}
+func (*OrderNote) ProtoMessage() {}
 
-func (x *GetUserOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[8]
+func (x *OrderNote) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -838,57 +926,87 @@ func (x *GetUserOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserOrdersRequest.ProtoReflect.Descriptor instead.
-func (*GetUserOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use OrderNote.ProtoReflect.Descriptor instead.
+func (*OrderNote) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetUserOrdersRequest) GetUserId() string {
+func (x *OrderNote) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetUserOrdersRequest) GetLimit() int32 {
+func (x *OrderNote) GetAuthorId() string {
 	if x != nil {
-		return x.Limit
+		return x.AuthorId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetUserOrdersRequest) GetOffset() int32 {
+func (x *OrderNote) GetText() string {
 	if x != nil {
-		return x.Offset
+		return x.Text
 	}
-	return 0
+	return ""
 }
 
-// GetUserOrdersResponse is the response for retrieving a user's orders
-type GetUserOrdersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *OrderNote) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
 }
 
-func (x *GetUserOrdersResponse) Reset() {
-	*x = GetUserOrdersResponse{}
-	mi := &file_order_v1_order_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+// Deprecated: Marked as deprecated in order/v1/order.proto.
+func (x *OrderNote) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
 }
 
-func (x *GetUserOrdersResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OrderNote) GetCreatedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtTs
+	}
+	return nil
 }
 
-func (*GetUserOrdersResponse) ProtoMessage() {}
-
-func (x *GetUserOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[9]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+// CreateOrderRequest is the request for creating an order
+type CreateOrderRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items           []*OrderItem           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	ShippingAddress *Address               `protobuf:"bytes,3,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
+	BillingAddress  *Address               `protobuf:"bytes,4,opt,name=billing_address,json=billingAddress,proto3" json:"billing_address,omitempty"`
+	Source          OrderSource            `protobuf:"varint,5,opt,name=source,proto3,enum=order.v1.OrderSource" json:"source,omitempty"`         // Source of the order
+	StoreId         string                 `protobuf:"bytes,6,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`                   // Store ID if order is from/for a store
+	SalesUserId     string                 `protobuf:"bytes,7,opt,name=sales_user_id,json=salesUserId,proto3" json:"sales_user_id,omitempty"`     // Employee processing the sale (for store orders)
+	ReservationId   string                 `protobuf:"bytes,8,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"` // Reservation ID if order is from a reservation
+	Guest           *GuestContact          `protobuf:"bytes,9,opt,name=guest,proto3" json:"guest,omitempty"`                                      // Set instead of user_id for guest checkout
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateOrderRequest) Reset() {
+	*x = CreateOrderRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderRequest) ProtoMessage() {}
+
+func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
@@ -897,40 +1015,149 @@ func (x *GetUserOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserOrdersResponse.ProtoReflect.Descriptor instead.
-func (*GetUserOrdersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateOrderRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetShippingAddress() *Address {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetBillingAddress() *Address {
+	if x != nil {
+		return x.BillingAddress
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetSource() OrderSource {
+	if x != nil {
+		return x.Source
+	}
+	return OrderSource_ORDER_SOURCE_UNSPECIFIED
+}
+
+func (x *CreateOrderRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetSalesUserId() string {
+	if x != nil {
+		return x.SalesUserId
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetGuest() *GuestContact {
+	if x != nil {
+		return x.Guest
+	}
+	return nil
+}
+
+// CreateOrderResponse is the response for creating an order
+type CreateOrderResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Order            *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	GuestAccessToken string                 `protobuf:"bytes,2,opt,name=guest_access_token,json=guestAccessToken,proto3" json:"guest_access_token,omitempty"` // Token of the guest's order link; empty for user orders
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateOrderResponse) Reset() {
+	*x = CreateOrderResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderResponse) ProtoMessage() {}
+
+func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
 	return file_order_v1_order_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetUserOrdersResponse) GetOrders() []*Order {
+func (x *CreateOrderResponse) GetOrder() *Order {
 	if x != nil {
-		return x.Orders
+		return x.Order
 	}
 	return nil
 }
 
-// UpdateOrderRequest is the request for updating an order
-type UpdateOrderRequest struct {
+func (x *CreateOrderResponse) GetGuestAccessToken() string {
+	if x != nil {
+		return x.GuestAccessToken
+	}
+	return ""
+}
+
+// GetOrderRequest is the request for retrieving an order
+type GetOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOrderRequest) Reset() {
-	*x = UpdateOrderRequest{}
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
 	mi := &file_order_v1_order_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderRequest) String() string {
+func (x *GetOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderRequest) ProtoMessage() {}
+func (*GetOrderRequest) ProtoMessage() {}
 
-func (x *UpdateOrderRequest) ProtoReflect() protoreflect.Message {
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_order_v1_order_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -942,40 +1169,40 @@ func (x *UpdateOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrderRequest.ProtoReflect.Descriptor instead.
-func (*UpdateOrderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
 	return file_order_v1_order_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UpdateOrderRequest) GetOrder() *Order {
+func (x *GetOrderRequest) GetId() string {
 	if x != nil {
-		return x.Order
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// UpdateOrderResponse is the response for updating an order
-type UpdateOrderResponse struct {
+// GetOrderResponse is the response for retrieving an order
+type GetOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOrderResponse) Reset() {
-	*x = UpdateOrderResponse{}
+func (x *GetOrderResponse) Reset() {
+	*x = GetOrderResponse{}
 	mi := &file_order_v1_order_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderResponse) String() string {
+func (x *GetOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderResponse) ProtoMessage() {}
+func (*GetOrderResponse) ProtoMessage() {}
 
-func (x *UpdateOrderResponse) ProtoReflect() protoreflect.Message {
+func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_order_v1_order_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -987,41 +1214,2243 @@ func (x *UpdateOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrderResponse.ProtoReflect.Descriptor instead.
-func (*UpdateOrderResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderResponse) Descriptor() ([]byte, []int) {
 	return file_order_v1_order_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *UpdateOrderResponse) GetSuccess() bool {
+func (x *GetOrderResponse) GetOrder() *Order {
 	if x != nil {
-		return x.Success
+		return x.Order
 	}
-	return false
+	return nil
 }
 
-// DeleteOrderRequest is the request for deleting an order
-type DeleteOrderRequest struct {
+// GetGuestOrderRequest is the request for reading a guest order
+type GetGuestOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"` // guest_access_token returned when the order was created
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteOrderRequest) Reset() {
-	*x = DeleteOrderRequest{}
+func (x *GetGuestOrderRequest) Reset() {
+	*x = GetGuestOrderRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGuestOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuestOrderRequest) ProtoMessage() {}
+
+func (x *GetGuestOrderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_order_v1_order_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuestOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetGuestOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetGuestOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *GetGuestOrderRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// GetGuestOrderResponse is the response for reading a guest order
+type GetGuestOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGuestOrderResponse) Reset() {
+	*x = GetGuestOrderResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGuestOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuestOrderResponse) ProtoMessage() {}
+
+func (x *GetGuestOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuestOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetGuestOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetGuestOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// LinkGuestOrdersRequest is the request for linking guest orders to a user.
+// The email must have been verified to belong to the user.
+type LinkGuestOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkGuestOrdersRequest) Reset() {
+	*x = LinkGuestOrdersRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkGuestOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkGuestOrdersRequest) ProtoMessage() {}
+
+func (x *LinkGuestOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkGuestOrdersRequest.ProtoReflect.Descriptor instead.
+func (*LinkGuestOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LinkGuestOrdersRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LinkGuestOrdersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// LinkGuestOrdersResponse is the response for linking guest orders
+type LinkGuestOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrdersLinked  int64                  `protobuf:"varint,1,opt,name=orders_linked,json=ordersLinked,proto3" json:"orders_linked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkGuestOrdersResponse) Reset() {
+	*x = LinkGuestOrdersResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkGuestOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkGuestOrdersResponse) ProtoMessage() {}
+
+func (x *LinkGuestOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkGuestOrdersResponse.ProtoReflect.Descriptor instead.
+func (*LinkGuestOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LinkGuestOrdersResponse) GetOrdersLinked() int64 {
+	if x != nil {
+		return x.OrdersLinked
+	}
+	return 0
+}
+
+// GetUserOrdersRequest is the request for retrieving a user's orders
+type GetUserOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserOrdersRequest) Reset() {
+	*x = GetUserOrdersRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserOrdersRequest) ProtoMessage() {}
+
+func (x *GetUserOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserOrdersRequest.ProtoReflect.Descriptor instead.
+func (*GetUserOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetUserOrdersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserOrdersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetUserOrdersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// GetUserOrdersResponse is the response for retrieving a user's orders
+type GetUserOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserOrdersResponse) Reset() {
+	*x = GetUserOrdersResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserOrdersResponse) ProtoMessage() {}
+
+func (x *GetUserOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserOrdersResponse.ProtoReflect.Descriptor instead.
+func (*GetUserOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetUserOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+// UpdateOrderRequest is the request for updating an order
+type UpdateOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderRequest) Reset() {
+	*x = UpdateOrderRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderRequest) ProtoMessage() {}
+
+func (x *UpdateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateOrderRequest) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// UpdateOrderResponse is the response for updating an order
+type UpdateOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderResponse) Reset() {
+	*x = UpdateOrderResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderResponse) ProtoMessage() {}
+
+func (x *UpdateOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateOrderResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// DeleteOrderRequest is the request for deleting an order
+type DeleteOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOrderRequest) Reset() {
+	*x = DeleteOrderRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrderRequest) ProtoMessage() {}
+
+func (x *DeleteOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteOrderResponse is the response for deleting an order
+type DeleteOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOrderResponse) Reset() {
+	*x = DeleteOrderResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrderResponse) ProtoMessage() {}
+
+func (x *DeleteOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteOrderResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ListOrdersRequest is the request for listing orders
+type ListOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListOrdersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// ListOrdersResponse is the response for listing orders
+type ListOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Number of matching orders across all pages
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                             // Effective page size
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListOrdersResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListOrdersResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListOrdersResponse) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// UpdateOrderStatusRequest is the request for updating an order's status
+type UpdateOrderStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        OrderStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=order.v1.OrderStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusRequest) Reset() {
+	*x = UpdateOrderStatusRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusRequest) ProtoMessage() {}
+
+func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateOrderStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusRequest) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+// UpdateOrderStatusResponse is the response for updating an order's status
+type UpdateOrderStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusResponse) Reset() {
+	*x = UpdateOrderStatusResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusResponse) ProtoMessage() {}
+
+func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateOrderStatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// BulkUpdateOrderStatusRequest is the request for moving many orders to one status
+type BulkUpdateOrderStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Status        OrderStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=order.v1.OrderStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateOrderStatusRequest) Reset() {
+	*x = BulkUpdateOrderStatusRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateOrderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateOrderStatusRequest) ProtoMessage() {}
+
+func (x *BulkUpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BulkUpdateOrderStatusRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BulkUpdateOrderStatusRequest) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+// OrderStatusUpdateResult is the outcome for one order of a bulk status update
+type OrderStatusUpdateResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"` // Why the order was not updated, e.g. an invalid transition
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderStatusUpdateResult) Reset() {
+	*x = OrderStatusUpdateResult{}
+	mi := &file_order_v1_order_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderStatusUpdateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusUpdateResult) ProtoMessage() {}
+
+func (x *OrderStatusUpdateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusUpdateResult.ProtoReflect.Descriptor instead.
+func (*OrderStatusUpdateResult) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *OrderStatusUpdateResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OrderStatusUpdateResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *OrderStatusUpdateResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// BulkUpdateOrderStatusResponse holds one result per requested order, in request order
+type BulkUpdateOrderStatusResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Results       []*OrderStatusUpdateResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateOrderStatusResponse) Reset() {
+	*x = BulkUpdateOrderStatusResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateOrderStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateOrderStatusResponse) ProtoMessage() {}
+
+func (x *BulkUpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BulkUpdateOrderStatusResponse) GetResults() []*OrderStatusUpdateResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// AddPaymentRequest is the request for adding payment to an order
+type AddPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	TransactionId string                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPaymentRequest) Reset() {
+	*x = AddPaymentRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPaymentRequest) ProtoMessage() {}
+
+func (x *AddPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPaymentRequest.ProtoReflect.Descriptor instead.
+func (*AddPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AddPaymentRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AddPaymentRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AddPaymentRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *AddPaymentRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// AddPaymentResponse is the response for adding payment to an order
+type AddPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPaymentResponse) Reset() {
+	*x = AddPaymentResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPaymentResponse) ProtoMessage() {}
+
+func (x *AddPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPaymentResponse.ProtoReflect.Descriptor instead.
+func (*AddPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AddPaymentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// AddTrackingCodeRequest is the request for adding a tracking code
+type AddTrackingCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	TrackingCode  string                 `protobuf:"bytes,2,opt,name=tracking_code,json=trackingCode,proto3" json:"tracking_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTrackingCodeRequest) Reset() {
+	*x = AddTrackingCodeRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTrackingCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTrackingCodeRequest) ProtoMessage() {}
+
+func (x *AddTrackingCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTrackingCodeRequest.ProtoReflect.Descriptor instead.
+func (*AddTrackingCodeRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AddTrackingCodeRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AddTrackingCodeRequest) GetTrackingCode() string {
+	if x != nil {
+		return x.TrackingCode
+	}
+	return ""
+}
+
+// AddTrackingCodeResponse is the response for adding a tracking code
+type AddTrackingCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTrackingCodeResponse) Reset() {
+	*x = AddTrackingCodeResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTrackingCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTrackingCodeResponse) ProtoMessage() {}
+
+func (x *AddTrackingCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTrackingCodeResponse.ProtoReflect.Descriptor instead.
+func (*AddTrackingCodeResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AddTrackingCodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// AddOrderNoteRequest is the request for adding a note to an order. The
+// author defaults to the authenticated caller.
+type AddOrderNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	ProductId     string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"` // Optional; attaches the note to this item of the order
+	AuthorId      string                 `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddOrderNoteRequest) Reset() {
+	*x = AddOrderNoteRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOrderNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOrderNoteRequest) ProtoMessage() {}
+
+func (x *AddOrderNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOrderNoteRequest.ProtoReflect.Descriptor instead.
+func (*AddOrderNoteRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AddOrderNoteRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AddOrderNoteRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *AddOrderNoteRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AddOrderNoteRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+// AddOrderNoteResponse returns the order with its updated note log
+type AddOrderNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddOrderNoteResponse) Reset() {
+	*x = AddOrderNoteResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOrderNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOrderNoteResponse) ProtoMessage() {}
+
+func (x *AddOrderNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOrderNoteResponse.ProtoReflect.Descriptor instead.
+func (*AddOrderNoteResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AddOrderNoteResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// RecordShipmentRequest is the request for recording a shipment of an order's items
+type RecordShipmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	TrackingCode  string                 `protobuf:"bytes,2,opt,name=tracking_code,json=trackingCode,proto3" json:"tracking_code,omitempty"`
+	Items         []*ShipmentItem        `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordShipmentRequest) Reset() {
+	*x = RecordShipmentRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordShipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordShipmentRequest) ProtoMessage() {}
+
+func (x *RecordShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordShipmentRequest.ProtoReflect.Descriptor instead.
+func (*RecordShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RecordShipmentRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RecordShipmentRequest) GetTrackingCode() string {
+	if x != nil {
+		return x.TrackingCode
+	}
+	return ""
+}
+
+func (x *RecordShipmentRequest) GetItems() []*ShipmentItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// RecordShipmentResponse returns the shipment and the updated order
+type RecordShipmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Shipment      *Shipment              `protobuf:"bytes,2,opt,name=shipment,proto3" json:"shipment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordShipmentResponse) Reset() {
+	*x = RecordShipmentResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordShipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordShipmentResponse) ProtoMessage() {}
+
+func (x *RecordShipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordShipmentResponse.ProtoReflect.Descriptor instead.
+func (*RecordShipmentResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RecordShipmentResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *RecordShipmentResponse) GetShipment() *Shipment {
+	if x != nil {
+		return x.Shipment
+	}
+	return nil
+}
+
+// CancelOrderRequest is the request for cancelling an order
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CancelOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// CancelOrderResponse is the response for cancelling an order
+type CancelOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderResponse) Reset() {
+	*x = CancelOrderResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderResponse) ProtoMessage() {}
+
+func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CancelOrderResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// CancelOrderItemsRequest is the request for cancelling lines of an order
+type CancelOrderItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductIds    []string               `protobuf:"bytes,2,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"` // Products whose lines are cancelled
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderItemsRequest) Reset() {
+	*x = CancelOrderItemsRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderItemsRequest) ProtoMessage() {}
+
+func (x *CancelOrderItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderItemsRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderItemsRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CancelOrderItemsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelOrderItemsRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+// CancelOrderItemsResponse returns the order with its new total
+type CancelOrderItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderItemsResponse) Reset() {
+	*x = CancelOrderItemsResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderItemsResponse) ProtoMessage() {}
+
+func (x *CancelOrderItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderItemsResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrderItemsResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CancelOrderItemsResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// GetStoreOrdersRequest is the request for retrieving orders for a specific store
+type GetStoreOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StoreId       string                 `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                     // Optional status filter
+	FromDate      string                 `protobuf:"bytes,3,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"` // Optional date range filter
+	ToDate        string                 `protobuf:"bytes,4,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStoreOrdersRequest) Reset() {
+	*x = GetStoreOrdersRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStoreOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoreOrdersRequest) ProtoMessage() {}
+
+func (x *GetStoreOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoreOrdersRequest.ProtoReflect.Descriptor instead.
+func (*GetStoreOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetStoreOrdersRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *GetStoreOrdersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetStoreOrdersRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *GetStoreOrdersRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *GetStoreOrdersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetStoreOrdersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// GetStoreOrdersResponse is the response for retrieving store orders
+type GetStoreOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	TotalRevenue  float64                `protobuf:"fixed64,3,opt,name=total_revenue,json=totalRevenue,proto3" json:"total_revenue,omitempty"` // Total revenue for the filtered orders
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStoreOrdersResponse) Reset() {
+	*x = GetStoreOrdersResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStoreOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoreOrdersResponse) ProtoMessage() {}
+
+func (x *GetStoreOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoreOrdersResponse.ProtoReflect.Descriptor instead.
+func (*GetStoreOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetStoreOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *GetStoreOrdersResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetStoreOrdersResponse) GetTotalRevenue() float64 {
+	if x != nil {
+		return x.TotalRevenue
+	}
+	return 0
+}
+
+// ExportOrdersRequest is the request for exporting orders to CSV
+type ExportOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StoreId       string                 `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`           // Optional: filter by store
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`              // Optional: filter by user
+	Source        OrderSource            `protobuf:"varint,3,opt,name=source,proto3,enum=order.v1.OrderSource" json:"source,omitempty"` // Optional: filter by source
+	FromDate      string                 `protobuf:"bytes,4,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`        // Optional date range
+	ToDate        string                 `protobuf:"bytes,5,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	Format        string                 `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"` // "csv" for now, could support others later
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportOrdersRequest) Reset() {
+	*x = ExportOrdersRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOrdersRequest) ProtoMessage() {}
+
+func (x *ExportOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ExportOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ExportOrdersRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *ExportOrdersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExportOrdersRequest) GetSource() OrderSource {
+	if x != nil {
+		return x.Source
+	}
+	return OrderSource_ORDER_SOURCE_UNSPECIFIED
+}
+
+func (x *ExportOrdersRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *ExportOrdersRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *ExportOrdersRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// ExportOrdersResponse is the response for exporting orders
+type ExportOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // CSV data
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportOrdersResponse) Reset() {
+	*x = ExportOrdersResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOrdersResponse) ProtoMessage() {}
+
+func (x *ExportOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ExportOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ExportOrdersResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportOrdersResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportOrdersResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// WebhookDelivery is the delivery state of one order event to one webhook subscriber
+type WebhookDelivery struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId      string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType    string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	OrderId      string                 `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	SubscriberId string                 `protobuf:"bytes,5,opt,name=subscriber_id,json=subscriberId,proto3" json:"subscriber_id,omitempty"`
+	Url          string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	Status       string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // PENDING, DELIVERED or DEAD_LETTERED
+	Attempts     int32                  `protobuf:"varint,8,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError    string                 `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Payload      string                 `protobuf:"bytes,10,opt,name=payload,proto3" json:"payload,omitempty"` // JSON encoded event
+	// RFC3339 forms of the *_ts fields, still populated until clients have moved over
+	//
+	// Deprecated: Marked as deprecated in order/v1/order.proto.
+	CreatedAt string `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Deprecated: Marked as deprecated in order/v1/order.proto.
+	UpdatedAt string `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Deprecated: Marked as deprecated in order/v1/order.proto.
+	LastAttemptAt string `protobuf:"bytes,13,opt,name=last_attempt_at,json=lastAttemptAt,proto3" json:"last_attempt_at,omitempty"`
+	// Deprecated: Marked as deprecated in order/v1/order.proto.
+	DeliveredAt     string                 `protobuf:"bytes,14,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	CreatedAtTs     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at_ts,json=createdAtTs,proto3" json:"created_at_ts,omitempty"`
+	UpdatedAtTs     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at_ts,json=updatedAtTs,proto3" json:"updated_at_ts,omitempty"`
+	LastAttemptAtTs *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=last_attempt_at_ts,json=lastAttemptAtTs,proto3" json:"last_attempt_at_ts,omitempty"`
+	DeliveredAtTs   *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=delivered_at_ts,json=deliveredAtTs,proto3" json:"delivered_at_ts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_order_v1_order_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetSubscriberId() string {
+	if x != nil {
+		return x.SubscriberId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order/v1/order.proto.
+func (x *WebhookDelivery) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order/v1/order.proto.
+func (x *WebhookDelivery) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order/v1/order.proto.
+func (x *WebhookDelivery) GetLastAttemptAt() string {
+	if x != nil {
+		return x.LastAttemptAt
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order/v1/order.proto.
+func (x *WebhookDelivery) GetDeliveredAt() string {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCreatedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtTs
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetUpdatedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAtTs
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetLastAttemptAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAttemptAtTs
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetDeliveredAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAtTs
+	}
+	return nil
+}
+
+// ListWebhookDeliveriesRequest is the request for listing webhook deliveries
+type ListWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubscriberId  string                 `protobuf:"bytes,1,opt,name=subscriber_id,json=subscriberId,proto3" json:"subscriber_id,omitempty"` // Optional subscriber filter
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                                 // Optional status filter
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetSubscriberId() string {
+	if x != nil {
+		return x.SubscriberId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// ListWebhookDeliveriesResponse is the response for listing webhook deliveries
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+// ListDeadLetteredWebhooksRequest is the request for listing dead-lettered webhook deliveries
+type ListDeadLetteredWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubscriberId  string                 `protobuf:"bytes,1,opt,name=subscriber_id,json=subscriberId,proto3" json:"subscriber_id,omitempty"` // Optional subscriber filter
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLetteredWebhooksRequest) Reset() {
+	*x = ListDeadLetteredWebhooksRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLetteredWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLetteredWebhooksRequest) ProtoMessage() {}
+
+func (x *ListDeadLetteredWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLetteredWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLetteredWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListDeadLetteredWebhooksRequest) GetSubscriberId() string {
+	if x != nil {
+		return x.SubscriberId
+	}
+	return ""
+}
+
+func (x *ListDeadLetteredWebhooksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDeadLetteredWebhooksRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// ListDeadLetteredWebhooksResponse is the response for listing dead-lettered webhook deliveries
+type ListDeadLetteredWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLetteredWebhooksResponse) Reset() {
+	*x = ListDeadLetteredWebhooksResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLetteredWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLetteredWebhooksResponse) ProtoMessage() {}
+
+func (x *ListDeadLetteredWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLetteredWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLetteredWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListDeadLetteredWebhooksResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+// ReplayDeadLetteredWebhookRequest is the request for replaying a dead-lettered delivery
+type ReplayDeadLetteredWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLetteredWebhookRequest) Reset() {
+	*x = ReplayDeadLetteredWebhookRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteOrderRequest) String() string {
+func (x *ReplayDeadLetteredWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteOrderRequest) ProtoMessage() {}
+func (*ReplayDeadLetteredWebhookRequest) ProtoMessage() {}
 
-func (x *DeleteOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[12]
+func (x *ReplayDeadLetteredWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1032,41 +3461,42 @@ func (x *DeleteOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteOrderRequest.ProtoReflect.Descriptor instead.
-func (*DeleteOrderRequest) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ReplayDeadLetteredWebhookRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLetteredWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *DeleteOrderRequest) GetId() string {
+func (x *ReplayDeadLetteredWebhookRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-// DeleteOrderResponse is the response for deleting an order
-type DeleteOrderResponse struct {
+// ReplayDeadLetteredWebhookResponse is the response for replaying a dead-lettered delivery
+type ReplayDeadLetteredWebhookResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Delivery      *WebhookDelivery       `protobuf:"bytes,2,opt,name=delivery,proto3" json:"delivery,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteOrderResponse) Reset() {
-	*x = DeleteOrderResponse{}
-	mi := &file_order_v1_order_proto_msgTypes[13]
+func (x *ReplayDeadLetteredWebhookResponse) Reset() {
+	*x = ReplayDeadLetteredWebhookResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteOrderResponse) String() string {
+func (x *ReplayDeadLetteredWebhookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteOrderResponse) ProtoMessage() {}
+func (*ReplayDeadLetteredWebhookResponse) ProtoMessage() {}
 
-func (x *DeleteOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[13]
+func (x *ReplayDeadLetteredWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1077,43 +3507,52 @@ func (x *DeleteOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteOrderResponse.ProtoReflect.Descriptor instead.
-func (*DeleteOrderResponse) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ReplayDeadLetteredWebhookResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLetteredWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *DeleteOrderResponse) GetSuccess() bool {
+func (x *ReplayDeadLetteredWebhookResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-// ListOrdersRequest is the request for listing orders
-type ListOrdersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+func (x *ReplayDeadLetteredWebhookResponse) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+// ReturnLine is a product and quantity being returned
+type ReturnLine struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity  int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// SELLABLE or DAMAGED; defaults to SELLABLE
+	Condition     string `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`
+	Restocked     bool   `protobuf:"varint,4,opt,name=restocked,proto3" json:"restocked,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOrdersRequest) Reset() {
-	*x = ListOrdersRequest{}
-	mi := &file_order_v1_order_proto_msgTypes[14]
+func (x *ReturnLine) Reset() {
+	*x = ReturnLine{}
+	mi := &file_order_v1_order_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersRequest) String() string {
+func (x *ReturnLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersRequest) ProtoMessage() {}
+func (*ReturnLine) ProtoMessage() {}
 
-func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[14]
+func (x *ReturnLine) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1124,55 +3563,82 @@ func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
-func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ReturnLine.ProtoReflect.Descriptor instead.
+func (*ReturnLine) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ListOrdersRequest) GetStatus() string {
+func (x *ReturnLine) GetProductId() string {
 	if x != nil {
-		return x.Status
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *ListOrdersRequest) GetLimit() int32 {
+func (x *ReturnLine) GetQuantity() int32 {
 	if x != nil {
-		return x.Limit
+		return x.Quantity
 	}
 	return 0
 }
 
-func (x *ListOrdersRequest) GetOffset() int32 {
+func (x *ReturnLine) GetCondition() string {
 	if x != nil {
-		return x.Offset
+		return x.Condition
 	}
-	return 0
+	return ""
 }
 
-// ListOrdersResponse is the response for listing orders
-type ListOrdersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+func (x *ReturnLine) GetRestocked() bool {
+	if x != nil {
+		return x.Restocked
+	}
+	return false
+}
+
+// Return is a return merchandise authorization (RMA)
+type Return struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId  string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Lines   []*ReturnLine          `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
+	Reason  string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// REQUESTED, APPROVED, RECEIVED, REFUNDED or REJECTED
+	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	// RFC3339 forms of the *_ts fields, still populated until clients have moved over
+	//
+	// Deprecated: Marked as deprecated in order/v1/order.proto.
+	CreatedAt string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Deprecated: Marked as deprecated in order/v1/order.proto.
+	UpdatedAt string `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Deprecated: Marked as deprecated in order/v1/order.proto.
+	ReceivedAt string `protobuf:"bytes,9,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	// Deprecated: Marked as deprecated in order/v1/order.proto.
+	RefundedAt    string                 `protobuf:"bytes,10,opt,name=refunded_at,json=refundedAt,proto3" json:"refunded_at,omitempty"`
+	CreatedAtTs   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at_ts,json=createdAtTs,proto3" json:"created_at_ts,omitempty"`
+	UpdatedAtTs   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at_ts,json=updatedAtTs,proto3" json:"updated_at_ts,omitempty"`
+	ReceivedAtTs  *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=received_at_ts,json=receivedAtTs,proto3" json:"received_at_ts,omitempty"`
+	RefundedAtTs  *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=refunded_at_ts,json=refundedAtTs,proto3" json:"refunded_at_ts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOrdersResponse) Reset() {
-	*x = ListOrdersResponse{}
-	mi := &file_order_v1_order_proto_msgTypes[15]
+func (x *Return) Reset() {
+	*x = Return{}
+	mi := &file_order_v1_order_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersResponse) String() string {
+func (x *Return) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersResponse) ProtoMessage() {}
+func (*Return) ProtoMessage() {}
 
-func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[15]
+func (x *Return) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1183,42 +3649,137 @@ func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
-func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use Return.ProtoReflect.Descriptor instead.
+func (*Return) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ListOrdersResponse) GetOrders() []*Order {
+func (x *Return) GetId() string {
 	if x != nil {
-		return x.Orders
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Return) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Return) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Return) GetLines() []*ReturnLine {
+	if x != nil {
+		return x.Lines
 	}
 	return nil
 }
 
-// UpdateOrderStatusRequest is the request for updating an order's status
-type UpdateOrderStatusRequest struct {
+func (x *Return) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Return) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order/v1/order.proto.
+func (x *Return) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order/v1/order.proto.
+func (x *Return) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order/v1/order.proto.
+func (x *Return) GetReceivedAt() string {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order/v1/order.proto.
+func (x *Return) GetRefundedAt() string {
+	if x != nil {
+		return x.RefundedAt
+	}
+	return ""
+}
+
+func (x *Return) GetCreatedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtTs
+	}
+	return nil
+}
+
+func (x *Return) GetUpdatedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAtTs
+	}
+	return nil
+}
+
+func (x *Return) GetReceivedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAtTs
+	}
+	return nil
+}
+
+func (x *Return) GetRefundedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefundedAtTs
+	}
+	return nil
+}
+
+type CreateReturnRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status        OrderStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=order.v1.OrderStatus" json:"status,omitempty"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Lines         []*ReturnLine          `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOrderStatusRequest) Reset() {
-	*x = UpdateOrderStatusRequest{}
-	mi := &file_order_v1_order_proto_msgTypes[16]
+func (x *CreateReturnRequest) Reset() {
+	*x = CreateReturnRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderStatusRequest) String() string {
+func (x *CreateReturnRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderStatusRequest) ProtoMessage() {}
+func (*CreateReturnRequest) ProtoMessage() {}
 
-func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[16]
+func (x *CreateReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1229,48 +3790,54 @@ func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use CreateReturnRequest.ProtoReflect.Descriptor instead.
+func (*CreateReturnRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *UpdateOrderStatusRequest) GetId() string {
+func (x *CreateReturnRequest) GetOrderId() string {
 	if x != nil {
-		return x.Id
+		return x.OrderId
 	}
 	return ""
 }
 
-func (x *UpdateOrderStatusRequest) GetStatus() OrderStatus {
+func (x *CreateReturnRequest) GetLines() []*ReturnLine {
 	if x != nil {
-		return x.Status
+		return x.Lines
 	}
-	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+	return nil
 }
 
-// UpdateOrderStatusResponse is the response for updating an order's status
-type UpdateOrderStatusResponse struct {
+func (x *CreateReturnRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CreateReturnResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Return        *Return                `protobuf:"bytes,1,opt,name=return,proto3" json:"return,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOrderStatusResponse) Reset() {
-	*x = UpdateOrderStatusResponse{}
-	mi := &file_order_v1_order_proto_msgTypes[17]
+func (x *CreateReturnResponse) Reset() {
+	*x = CreateReturnResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderStatusResponse) String() string {
+func (x *CreateReturnResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderStatusResponse) ProtoMessage() {}
+func (*CreateReturnResponse) ProtoMessage() {}
 
-func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[17]
+func (x *CreateReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1281,44 +3848,40 @@ func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CreateReturnResponse.ProtoReflect.Descriptor instead.
+func (*CreateReturnResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *UpdateOrderStatusResponse) GetSuccess() bool {
+func (x *CreateReturnResponse) GetReturn() *Return {
 	if x != nil {
-		return x.Success
+		return x.Return
 	}
-	return false
+	return nil
 }
 
-// AddPaymentRequest is the request for adding payment to an order
-type AddPaymentRequest struct {
+type GetReturnRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
-	TransactionId string                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddPaymentRequest) Reset() {
-	*x = AddPaymentRequest{}
-	mi := &file_order_v1_order_proto_msgTypes[18]
+func (x *GetReturnRequest) Reset() {
+	*x = GetReturnRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddPaymentRequest) String() string {
+func (x *GetReturnRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPaymentRequest) ProtoMessage() {}
+func (*GetReturnRequest) ProtoMessage() {}
 
-func (x *AddPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[18]
+func (x *GetReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1329,62 +3892,40 @@ func (x *AddPaymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPaymentRequest.ProtoReflect.Descriptor instead.
-func (*AddPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *AddPaymentRequest) GetOrderId() string {
-	if x != nil {
-		return x.OrderId
-	}
-	return ""
-}
-
-func (x *AddPaymentRequest) GetMethod() string {
-	if x != nil {
-		return x.Method
-	}
-	return ""
+// Deprecated: Use GetReturnRequest.ProtoReflect.Descriptor instead.
+func (*GetReturnRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *AddPaymentRequest) GetTransactionId() string {
+func (x *GetReturnRequest) GetId() string {
 	if x != nil {
-		return x.TransactionId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AddPaymentRequest) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-// AddPaymentResponse is the response for adding payment to an order
-type AddPaymentResponse struct {
+type GetReturnResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Return        *Return                `protobuf:"bytes,1,opt,name=return,proto3" json:"return,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddPaymentResponse) Reset() {
-	*x = AddPaymentResponse{}
-	mi := &file_order_v1_order_proto_msgTypes[19]
+func (x *GetReturnResponse) Reset() {
+	*x = GetReturnResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddPaymentResponse) String() string {
+func (x *GetReturnResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPaymentResponse) ProtoMessage() {}
+func (*GetReturnResponse) ProtoMessage() {}
 
-func (x *AddPaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[19]
+func (x *GetReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1395,42 +3936,40 @@ func (x *AddPaymentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPaymentResponse.ProtoReflect.Descriptor instead.
-func (*AddPaymentResponse) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetReturnResponse.ProtoReflect.Descriptor instead.
+func (*GetReturnResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *AddPaymentResponse) GetSuccess() bool {
+func (x *GetReturnResponse) GetReturn() *Return {
 	if x != nil {
-		return x.Success
+		return x.Return
 	}
-	return false
+	return nil
 }
 
-// AddTrackingCodeRequest is the request for adding a tracking code
-type AddTrackingCodeRequest struct {
+type ListOrderReturnsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	TrackingCode  string                 `protobuf:"bytes,2,opt,name=tracking_code,json=trackingCode,proto3" json:"tracking_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
-}
-
-func (x *AddTrackingCodeRequest) Reset() {
-	*x = AddTrackingCodeRequest{}
-	mi := &file_order_v1_order_proto_msgTypes[20]
+}
+
+func (x *ListOrderReturnsRequest) Reset() {
+	*x = ListOrderReturnsRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddTrackingCodeRequest) String() string {
+func (x *ListOrderReturnsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTrackingCodeRequest) ProtoMessage() {}
+func (*ListOrderReturnsRequest) ProtoMessage() {}
 
-func (x *AddTrackingCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[20]
+func (x *ListOrderReturnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1441,48 +3980,40 @@ func (x *AddTrackingCodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTrackingCodeRequest.ProtoReflect.Descriptor instead.
-func (*AddTrackingCodeRequest) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListOrderReturnsRequest.ProtoReflect.Descriptor instead.
+func (*ListOrderReturnsRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *AddTrackingCodeRequest) GetOrderId() string {
+func (x *ListOrderReturnsRequest) GetOrderId() string {
 	if x != nil {
 		return x.OrderId
 	}
 	return ""
 }
 
-func (x *AddTrackingCodeRequest) GetTrackingCode() string {
-	if x != nil {
-		return x.TrackingCode
-	}
-	return ""
-}
-
-// AddTrackingCodeResponse is the response for adding a tracking code
-type AddTrackingCodeResponse struct {
+type ListOrderReturnsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Returns       []*Return              `protobuf:"bytes,1,rep,name=returns,proto3" json:"returns,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddTrackingCodeResponse) Reset() {
-	*x = AddTrackingCodeResponse{}
-	mi := &file_order_v1_order_proto_msgTypes[21]
+func (x *ListOrderReturnsResponse) Reset() {
+	*x = ListOrderReturnsResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddTrackingCodeResponse) String() string {
+func (x *ListOrderReturnsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTrackingCodeResponse) ProtoMessage() {}
+func (*ListOrderReturnsResponse) ProtoMessage() {}
 
-func (x *AddTrackingCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[21]
+func (x *ListOrderReturnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1493,41 +4024,43 @@ func (x *AddTrackingCodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTrackingCodeResponse.ProtoReflect.Descriptor instead.
-func (*AddTrackingCodeResponse) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ListOrderReturnsResponse.ProtoReflect.Descriptor instead.
+func (*ListOrderReturnsResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *AddTrackingCodeResponse) GetSuccess() bool {
+func (x *ListOrderReturnsResponse) GetReturns() []*Return {
 	if x != nil {
-		return x.Success
+		return x.Returns
 	}
-	return false
+	return nil
 }
 
-// CancelOrderRequest is the request for cancelling an order
-type CancelOrderRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type UpdateReturnStatusRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Condition per product found on inspection, applied when the return is received
+	Conditions    map[string]string `protobuf:"bytes,3,rep,name=conditions,proto3" json:"conditions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelOrderRequest) Reset() {
-	*x = CancelOrderRequest{}
-	mi := &file_order_v1_order_proto_msgTypes[22]
+func (x *UpdateReturnStatusRequest) Reset() {
+	*x = UpdateReturnStatusRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelOrderRequest) String() string {
+func (x *UpdateReturnStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelOrderRequest) ProtoMessage() {}
+func (*UpdateReturnStatusRequest) ProtoMessage() {}
 
-func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[22]
+func (x *UpdateReturnStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1538,41 +4071,54 @@ func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
-func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use UpdateReturnStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReturnStatusRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *CancelOrderRequest) GetId() string {
+func (x *UpdateReturnStatusRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-// CancelOrderResponse is the response for cancelling an order
-type CancelOrderResponse struct {
+func (x *UpdateReturnStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateReturnStatusRequest) GetConditions() map[string]string {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+type UpdateReturnStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Return        *Return                `protobuf:"bytes,1,opt,name=return,proto3" json:"return,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelOrderResponse) Reset() {
-	*x = CancelOrderResponse{}
-	mi := &file_order_v1_order_proto_msgTypes[23]
+func (x *UpdateReturnStatusResponse) Reset() {
+	*x = UpdateReturnStatusResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelOrderResponse) String() string {
+func (x *UpdateReturnStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelOrderResponse) ProtoMessage() {}
+func (*UpdateReturnStatusResponse) ProtoMessage() {}
 
-func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[23]
+func (x *UpdateReturnStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1583,46 +4129,42 @@ func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
-func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use UpdateReturnStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateReturnStatusResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *CancelOrderResponse) GetSuccess() bool {
+func (x *UpdateReturnStatusResponse) GetReturn() *Return {
 	if x != nil {
-		return x.Success
+		return x.Return
 	}
-	return false
+	return nil
 }
 
-// GetStoreOrdersRequest is the request for retrieving orders for a specific store
-type GetStoreOrdersRequest struct {
+// GetOrderSummaryRequest selects the period to summarize (RFC3339, to_date exclusive)
+type GetOrderSummaryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StoreId       string                 `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                     // Optional status filter
-	FromDate      string                 `protobuf:"bytes,3,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"` // Optional date range filter
-	ToDate        string                 `protobuf:"bytes,4,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
-	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	FromDate      string                 `protobuf:"bytes,1,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate        string                 `protobuf:"bytes,2,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStoreOrdersRequest) Reset() {
-	*x = GetStoreOrdersRequest{}
-	mi := &file_order_v1_order_proto_msgTypes[24]
+func (x *GetOrderSummaryRequest) Reset() {
+	*x = GetOrderSummaryRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStoreOrdersRequest) String() string {
+func (x *GetOrderSummaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStoreOrdersRequest) ProtoMessage() {}
+func (*GetOrderSummaryRequest) ProtoMessage() {}
 
-func (x *GetStoreOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[24]
+func (x *GetOrderSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1633,78 +4175,100 @@ func (x *GetStoreOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStoreOrdersRequest.ProtoReflect.Descriptor instead.
-func (*GetStoreOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GetOrderSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetStoreOrdersRequest) GetStoreId() string {
+func (x *GetOrderSummaryRequest) GetFromDate() string {
 	if x != nil {
-		return x.StoreId
+		return x.FromDate
 	}
 	return ""
 }
 
-func (x *GetStoreOrdersRequest) GetStatus() string {
+func (x *GetOrderSummaryRequest) GetToDate() string {
 	if x != nil {
-		return x.Status
+		return x.ToDate
 	}
 	return ""
 }
 
-func (x *GetStoreOrdersRequest) GetFromDate() string {
-	if x != nil {
-		return x.FromDate
-	}
-	return ""
+// GetOrderSummaryResponse holds the order count and revenue of a period; cancelled orders are excluded
+type GetOrderSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderCount    int64                  `protobuf:"varint,1,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
+	Revenue       float64                `protobuf:"fixed64,2,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStoreOrdersRequest) GetToDate() string {
+func (x *GetOrderSummaryResponse) Reset() {
+	*x = GetOrderSummaryResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderSummaryResponse) ProtoMessage() {}
+
+func (x *GetOrderSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[63]
 	if x != nil {
-		return x.ToDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetStoreOrdersRequest) GetLimit() int32 {
+// Deprecated: Use GetOrderSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetOrderSummaryResponse) GetOrderCount() int64 {
 	if x != nil {
-		return x.Limit
+		return x.OrderCount
 	}
 	return 0
 }
 
-func (x *GetStoreOrdersRequest) GetOffset() int32 {
+func (x *GetOrderSummaryResponse) GetRevenue() float64 {
 	if x != nil {
-		return x.Offset
+		return x.Revenue
 	}
 	return 0
 }
 
-// GetStoreOrdersResponse is the response for retrieving store orders
-type GetStoreOrdersResponse struct {
+type GetOrderTimelineRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	TotalRevenue  float64                `protobuf:"fixed64,3,opt,name=total_revenue,json=totalRevenue,proto3" json:"total_revenue,omitempty"` // Total revenue for the filtered orders
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStoreOrdersResponse) Reset() {
-	*x = GetStoreOrdersResponse{}
-	mi := &file_order_v1_order_proto_msgTypes[25]
+func (x *GetOrderTimelineRequest) Reset() {
+	*x = GetOrderTimelineRequest{}
+	mi := &file_order_v1_order_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStoreOrdersResponse) String() string {
+func (x *GetOrderTimelineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStoreOrdersResponse) ProtoMessage() {}
+func (*GetOrderTimelineRequest) ProtoMessage() {}
 
-func (x *GetStoreOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[25]
+func (x *GetOrderTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1715,60 +4279,49 @@ func (x *GetStoreOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStoreOrdersResponse.ProtoReflect.Descriptor instead.
-func (*GetStoreOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *GetStoreOrdersResponse) GetOrders() []*Order {
-	if x != nil {
-		return x.Orders
-	}
-	return nil
-}
-
-func (x *GetStoreOrdersResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
+// Deprecated: Use GetOrderTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *GetStoreOrdersResponse) GetTotalRevenue() float64 {
+func (x *GetOrderTimelineRequest) GetOrderId() string {
 	if x != nil {
-		return x.TotalRevenue
+		return x.OrderId
 	}
-	return 0
+	return ""
 }
 
-// ExportOrdersRequest is the request for exporting orders to CSV
-type ExportOrdersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StoreId       string                 `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`           // Optional: filter by store
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`              // Optional: filter by user
-	Source        OrderSource            `protobuf:"varint,3,opt,name=source,proto3,enum=order.v1.OrderSource" json:"source,omitempty"` // Optional: filter by source
-	FromDate      string                 `protobuf:"bytes,4,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`        // Optional date range
-	ToDate        string                 `protobuf:"bytes,5,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
-	Format        string                 `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"` // "csv" for now, could support others later
+// TimelineEntry is one event in the history of an order
+type TimelineEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ORDER_CREATED, STATUS_CHANGED, PAYMENT_RECORDED, SHIPMENT_SENT, NOTE_ADDED,
+	// RETURN_REQUESTED, RETURN_RECEIVED, RETURN_REFUNDED or RETURN_REJECTED
+	Type      string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Timestamp string `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// User behind the event; empty for the system
+	Actor   string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Summary string `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	// ID of the note, shipment or return, or the payment's transaction ID
+	ReferenceId   string `protobuf:"bytes,5,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExportOrdersRequest) Reset() {
-	*x = ExportOrdersRequest{}
-	mi := &file_order_v1_order_proto_msgTypes[26]
+func (x *TimelineEntry) Reset() {
+	*x = TimelineEntry{}
+	mi := &file_order_v1_order_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExportOrdersRequest) String() string {
+func (x *TimelineEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportOrdersRequest) ProtoMessage() {}
+func (*TimelineEntry) ProtoMessage() {}
 
-func (x *ExportOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[26]
+func (x *TimelineEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1779,78 +4332,69 @@ func (x *ExportOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportOrdersRequest.ProtoReflect.Descriptor instead.
-func (*ExportOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use TimelineEntry.ProtoReflect.Descriptor instead.
+func (*TimelineEntry) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ExportOrdersRequest) GetStoreId() string {
+func (x *TimelineEntry) GetType() string {
 	if x != nil {
-		return x.StoreId
+		return x.Type
 	}
 	return ""
 }
 
-func (x *ExportOrdersRequest) GetUserId() string {
+func (x *TimelineEntry) GetTimestamp() string {
 	if x != nil {
-		return x.UserId
+		return x.Timestamp
 	}
 	return ""
 }
 
-func (x *ExportOrdersRequest) GetSource() OrderSource {
-	if x != nil {
-		return x.Source
-	}
-	return OrderSource_ORDER_SOURCE_UNSPECIFIED
-}
-
-func (x *ExportOrdersRequest) GetFromDate() string {
+func (x *TimelineEntry) GetActor() string {
 	if x != nil {
-		return x.FromDate
+		return x.Actor
 	}
 	return ""
 }
 
-func (x *ExportOrdersRequest) GetToDate() string {
+func (x *TimelineEntry) GetSummary() string {
 	if x != nil {
-		return x.ToDate
+		return x.Summary
 	}
 	return ""
 }
 
-func (x *ExportOrdersRequest) GetFormat() string {
+func (x *TimelineEntry) GetReferenceId() string {
 	if x != nil {
-		return x.Format
+		return x.ReferenceId
 	}
 	return ""
 }
 
-// ExportOrdersResponse is the response for exporting orders
-type ExportOrdersResponse struct {
+type GetOrderTimelineResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // CSV data
-	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
-	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Entries       []*TimelineEntry       `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExportOrdersResponse) Reset() {
-	*x = ExportOrdersResponse{}
-	mi := &file_order_v1_order_proto_msgTypes[27]
+func (x *GetOrderTimelineResponse) Reset() {
+	*x = GetOrderTimelineResponse{}
+	mi := &file_order_v1_order_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExportOrdersResponse) String() string {
+func (x *GetOrderTimelineResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportOrdersResponse) ProtoMessage() {}
+func (*GetOrderTimelineResponse) ProtoMessage() {}
 
-func (x *ExportOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_order_proto_msgTypes[27]
+func (x *GetOrderTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_order_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1861,37 +4405,30 @@ func (x *ExportOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportOrdersResponse.ProtoReflect.Descriptor instead.
-func (*ExportOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_order_v1_order_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *ExportOrdersResponse) GetData() []byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
+// Deprecated: Use GetOrderTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_order_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *ExportOrdersResponse) GetFilename() string {
+func (x *GetOrderTimelineResponse) GetOrderId() string {
 	if x != nil {
-		return x.Filename
+		return x.OrderId
 	}
 	return ""
 }
 
-func (x *ExportOrdersResponse) GetContentType() string {
+func (x *GetOrderTimelineResponse) GetEntries() []*TimelineEntry {
 	if x != nil {
-		return x.ContentType
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
 var File_order_v1_order_proto protoreflect.FileDescriptor
 
 const file_order_v1_order_proto_rawDesc = "" +
 	"\n" +
-	"\x14order/v1/order.proto\x12\border.v1\"\xc8\x01\n" +
+	"\x14order/v1/order.proto\x12\border.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xed\x01\n" +
 	"\tOrderItem\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1f\n" +
@@ -1901,7 +4438,8 @@ const file_order_v1_order_proto_rawDesc = "" +
 	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x14\n" +
 	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x1a\n" +
 	"\bsubtotal\x18\x06 \x01(\x01R\bsubtotal\x12\x19\n" +
-	"\bstore_id\x18\a \x01(\tR\astoreId\"\x86\x01\n" +
+	"\bstore_id\x18\a \x01(\tR\astoreId\x12#\n" +
+	"\rfulfilled_qty\x18\b \x01(\x05R\ffulfilledQty\"\x86\x01\n" +
 	"\aAddress\x12\x16\n" +
 	"\x06street\x18\x01 \x01(\tR\x06street\x12\x12\n" +
 	"\x04city\x18\x02 \x01(\tR\x04city\x12\x14\n" +
@@ -1914,7 +4452,7 @@ const file_order_v1_order_proto_rawDesc = "" +
 	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x16\n" +
 	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1c\n" +
-	"\ttimestamp\x18\x05 \x01(\tR\ttimestamp\"\x9f\x05\n" +
+	"\ttimestamp\x18\x05 \x01(\tR\ttimestamp\"\xcb\b\n" +
 	"\x05Order\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12)\n" +
@@ -1926,17 +4464,49 @@ const file_order_v1_order_proto_rawDesc = "" +
 	"\apayment\x18\b \x01(\v2\x11.order.v1.PaymentR\apayment\x12#\n" +
 	"\rtracking_code\x18\t \x01(\tR\ftrackingCode\x12\x14\n" +
 	"\x05notes\x18\n" +
-	" \x01(\tR\x05notes\x12\x1d\n" +
+	" \x01(\tR\x05notes\x12!\n" +
 	"\n" +
-	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"created_at\x18\v \x01(\tB\x02\x18\x01R\tcreatedAt\x12!\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\tR\tupdatedAt\x12!\n" +
-	"\fcompleted_at\x18\r \x01(\tR\vcompletedAt\x12-\n" +
+	"updated_at\x18\f \x01(\tB\x02\x18\x01R\tupdatedAt\x12%\n" +
+	"\fcompleted_at\x18\r \x01(\tB\x02\x18\x01R\vcompletedAt\x12-\n" +
 	"\x06source\x18\x0e \x01(\x0e2\x15.order.v1.OrderSourceR\x06source\x12\x19\n" +
 	"\bstore_id\x18\x0f \x01(\tR\astoreId\x12\"\n" +
 	"\rsales_user_id\x18\x10 \x01(\tR\vsalesUserId\x12%\n" +
 	"\x0ereservation_id\x18\x11 \x01(\tR\rreservationId\x12\x18\n" +
-	"\aversion\x18\x12 \x01(\x05R\aversion\"\xe7\x02\n" +
+	"\aversion\x18\x12 \x01(\x05R\aversion\x12.\n" +
+	"\bnote_log\x18\x13 \x03(\v2\x13.order.v1.OrderNoteR\anoteLog\x12J\n" +
+	"\x12fulfillment_status\x18\x14 \x01(\x0e2\x1b.order.v1.FulfillmentStatusR\x11fulfillmentStatus\x120\n" +
+	"\tshipments\x18\x15 \x03(\v2\x12.order.v1.ShipmentR\tshipments\x12,\n" +
+	"\x05guest\x18\x16 \x01(\v2\x16.order.v1.GuestContactR\x05guest\x12>\n" +
+	"\rcreated_at_ts\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedAtTs\x12>\n" +
+	"\rupdated_at_ts\x18\x18 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedAtTs\x12B\n" +
+	"\x0fcompleted_at_ts\x18\x19 \x01(\v2\x1a.google.protobuf.TimestampR\rcompletedAtTs\":\n" +
+	"\fGuestContact\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x02 \x01(\tR\x05phone\"I\n" +
+	"\fShipmentItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\xef\x01\n" +
+	"\bShipment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rtracking_code\x18\x02 \x01(\tR\ftrackingCode\x12,\n" +
+	"\x05items\x18\x03 \x03(\v2\x16.order.v1.ShipmentItemR\x05items\x12!\n" +
+	"\n" +
+	"shipped_at\x18\x04 \x01(\tB\x02\x18\x01R\tshippedAt\x12\x1d\n" +
+	"\n" +
+	"shipped_by\x18\x05 \x01(\tR\tshippedBy\x12>\n" +
+	"\rshipped_at_ts\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vshippedAtTs\"\xce\x01\n" +
+	"\tOrderNote\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\tR\bauthorId\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x04 \x01(\tR\tproductId\x12!\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tB\x02\x18\x01R\tcreatedAt\x12>\n" +
+	"\rcreated_at_ts\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedAtTs\"\x95\x03\n" +
 	"\x12CreateOrderRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12)\n" +
 	"\x05items\x18\x02 \x03(\v2\x13.order.v1.OrderItemR\x05items\x12<\n" +
@@ -1945,13 +4515,25 @@ const file_order_v1_order_proto_rawDesc = "" +
 	"\x06source\x18\x05 \x01(\x0e2\x15.order.v1.OrderSourceR\x06source\x12\x19\n" +
 	"\bstore_id\x18\x06 \x01(\tR\astoreId\x12\"\n" +
 	"\rsales_user_id\x18\a \x01(\tR\vsalesUserId\x12%\n" +
-	"\x0ereservation_id\x18\b \x01(\tR\rreservationId\"<\n" +
+	"\x0ereservation_id\x18\b \x01(\tR\rreservationId\x12,\n" +
+	"\x05guest\x18\t \x01(\v2\x16.order.v1.GuestContactR\x05guest\"j\n" +
 	"\x13CreateOrderResponse\x12%\n" +
-	"\x05order\x18\x01 \x01(\v2\x0f.order.v1.OrderR\x05order\"!\n" +
+	"\x05order\x18\x01 \x01(\v2\x0f.order.v1.OrderR\x05order\x12,\n" +
+	"\x12guest_access_token\x18\x02 \x01(\tR\x10guestAccessToken\"!\n" +
 	"\x0fGetOrderRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"9\n" +
 	"\x10GetOrderResponse\x12%\n" +
-	"\x05order\x18\x01 \x01(\v2\x0f.order.v1.OrderR\x05order\"]\n" +
+	"\x05order\x18\x01 \x01(\v2\x0f.order.v1.OrderR\x05order\"G\n" +
+	"\x14GetGuestOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\">\n" +
+	"\x15GetGuestOrderResponse\x12%\n" +
+	"\x05order\x18\x01 \x01(\v2\x0f.order.v1.OrderR\x05order\"G\n" +
+	"\x16LinkGuestOrdersRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\">\n" +
+	"\x17LinkGuestOrdersResponse\x12#\n" +
+	"\rorders_linked\x18\x01 \x01(\x03R\fordersLinked\"]\n" +
 	"\x14GetUserOrdersRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
@@ -1969,14 +4551,27 @@ const file_order_v1_order_proto_rawDesc = "" +
 	"\x11ListOrdersRequest\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"=\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"\x8c\x01\n" +
 	"\x12ListOrdersResponse\x12'\n" +
-	"\x06orders\x18\x01 \x03(\v2\x0f.order.v1.OrderR\x06orders\"Y\n" +
+	"\x06orders\x18\x01 \x03(\v2\x0f.order.v1.OrderR\x06orders\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"Y\n" +
 	"\x18UpdateOrderStatusRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x15.order.v1.OrderStatusR\x06status\"5\n" +
 	"\x19UpdateOrderStatusResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x85\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"_\n" +
+	"\x1cBulkUpdateOrderStatusRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12-\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x15.order.v1.OrderStatusR\x06status\"Y\n" +
+	"\x17OrderStatusUpdateResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\\\n" +
+	"\x1dBulkUpdateOrderStatusResponse\x12;\n" +
+	"\aresults\x18\x01 \x03(\v2!.order.v1.OrderStatusUpdateResultR\aresults\"\x85\x01\n" +
 	"\x11AddPaymentRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x16\n" +
 	"\x06method\x18\x02 \x01(\tR\x06method\x12%\n" +
@@ -1988,11 +4583,32 @@ const file_order_v1_order_proto_rawDesc = "" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12#\n" +
 	"\rtracking_code\x18\x02 \x01(\tR\ftrackingCode\"3\n" +
 	"\x17AddTrackingCodeResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"$\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x80\x01\n" +
+	"\x13AddOrderNoteRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\tR\bauthorId\"=\n" +
+	"\x14AddOrderNoteResponse\x12%\n" +
+	"\x05order\x18\x01 \x01(\v2\x0f.order.v1.OrderR\x05order\"\x85\x01\n" +
+	"\x15RecordShipmentRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12#\n" +
+	"\rtracking_code\x18\x02 \x01(\tR\ftrackingCode\x12,\n" +
+	"\x05items\x18\x03 \x03(\v2\x16.order.v1.ShipmentItemR\x05items\"o\n" +
+	"\x16RecordShipmentResponse\x12%\n" +
+	"\x05order\x18\x01 \x01(\v2\x0f.order.v1.OrderR\x05order\x12.\n" +
+	"\bshipment\x18\x02 \x01(\v2\x12.order.v1.ShipmentR\bshipment\"$\n" +
 	"\x12CancelOrderRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
 	"\x13CancelOrderResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xae\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"J\n" +
+	"\x17CancelOrderItemsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vproduct_ids\x18\x02 \x03(\tR\n" +
+	"productIds\"A\n" +
+	"\x18CancelOrderItemsResponse\x12%\n" +
+	"\x05order\x18\x01 \x01(\v2\x0f.order.v1.OrderR\x05order\"\xae\x01\n" +
 	"\x15GetStoreOrdersRequest\x12\x19\n" +
 	"\bstore_id\x18\x01 \x01(\tR\astoreId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
@@ -2015,7 +4631,123 @@ const file_order_v1_order_proto_rawDesc = "" +
 	"\x14ExportOrdersResponse\x12\x12\n" +
 	"\x04data\x18\x01 \x01(\fR\x04data\x12\x1a\n" +
 	"\bfilename\x18\x02 \x01(\tR\bfilename\x12!\n" +
-	"\fcontent_type\x18\x03 \x01(\tR\vcontentType*\xe1\x01\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\"\xc0\x05\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\x12\x19\n" +
+	"\border_id\x18\x04 \x01(\tR\aorderId\x12#\n" +
+	"\rsubscriber_id\x18\x05 \x01(\tR\fsubscriberId\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\b \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x12\x18\n" +
+	"\apayload\x18\n" +
+	" \x01(\tR\apayload\x12!\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tB\x02\x18\x01R\tcreatedAt\x12!\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\tB\x02\x18\x01R\tupdatedAt\x12*\n" +
+	"\x0flast_attempt_at\x18\r \x01(\tB\x02\x18\x01R\rlastAttemptAt\x12%\n" +
+	"\fdelivered_at\x18\x0e \x01(\tB\x02\x18\x01R\vdeliveredAt\x12>\n" +
+	"\rcreated_at_ts\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedAtTs\x12>\n" +
+	"\rupdated_at_ts\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedAtTs\x12G\n" +
+	"\x12last_attempt_at_ts\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\x0flastAttemptAtTs\x12B\n" +
+	"\x0fdelivered_at_ts\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\rdeliveredAtTs\"\x89\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x12#\n" +
+	"\rsubscriber_id\x18\x01 \x01(\tR\fsubscriberId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"Z\n" +
+	"\x1dListWebhookDeliveriesResponse\x129\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x19.order.v1.WebhookDeliveryR\n" +
+	"deliveries\"t\n" +
+	"\x1fListDeadLetteredWebhooksRequest\x12#\n" +
+	"\rsubscriber_id\x18\x01 \x01(\tR\fsubscriberId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"]\n" +
+	" ListDeadLetteredWebhooksResponse\x129\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x19.order.v1.WebhookDeliveryR\n" +
+	"deliveries\"2\n" +
+	" ReplayDeadLetteredWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"t\n" +
+	"!ReplayDeadLetteredWebhookResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x125\n" +
+	"\bdelivery\x18\x02 \x01(\v2\x19.order.v1.WebhookDeliveryR\bdelivery\"\x83\x01\n" +
+	"\n" +
+	"ReturnLine\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x1c\n" +
+	"\tcondition\x18\x03 \x01(\tR\tcondition\x12\x1c\n" +
+	"\trestocked\x18\x04 \x01(\bR\trestocked\"\xbc\x04\n" +
+	"\x06Return\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12*\n" +
+	"\x05lines\x18\x04 \x03(\v2\x14.order.v1.ReturnLineR\x05lines\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12!\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tB\x02\x18\x01R\tcreatedAt\x12!\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tB\x02\x18\x01R\tupdatedAt\x12#\n" +
+	"\vreceived_at\x18\t \x01(\tB\x02\x18\x01R\n" +
+	"receivedAt\x12#\n" +
+	"\vrefunded_at\x18\n" +
+	" \x01(\tB\x02\x18\x01R\n" +
+	"refundedAt\x12>\n" +
+	"\rcreated_at_ts\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedAtTs\x12>\n" +
+	"\rupdated_at_ts\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedAtTs\x12@\n" +
+	"\x0ereceived_at_ts\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\freceivedAtTs\x12@\n" +
+	"\x0erefunded_at_ts\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\frefundedAtTs\"t\n" +
+	"\x13CreateReturnRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12*\n" +
+	"\x05lines\x18\x02 \x03(\v2\x14.order.v1.ReturnLineR\x05lines\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"@\n" +
+	"\x14CreateReturnResponse\x12(\n" +
+	"\x06return\x18\x01 \x01(\v2\x10.order.v1.ReturnR\x06return\"\"\n" +
+	"\x10GetReturnRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"=\n" +
+	"\x11GetReturnResponse\x12(\n" +
+	"\x06return\x18\x01 \x01(\v2\x10.order.v1.ReturnR\x06return\"4\n" +
+	"\x17ListOrderReturnsRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"F\n" +
+	"\x18ListOrderReturnsResponse\x12*\n" +
+	"\areturns\x18\x01 \x03(\v2\x10.order.v1.ReturnR\areturns\"\xd7\x01\n" +
+	"\x19UpdateReturnStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12S\n" +
+	"\n" +
+	"conditions\x18\x03 \x03(\v23.order.v1.UpdateReturnStatusRequest.ConditionsEntryR\n" +
+	"conditions\x1a=\n" +
+	"\x0fConditionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"F\n" +
+	"\x1aUpdateReturnStatusResponse\x12(\n" +
+	"\x06return\x18\x01 \x01(\v2\x10.order.v1.ReturnR\x06return\"N\n" +
+	"\x16GetOrderSummaryRequest\x12\x1b\n" +
+	"\tfrom_date\x18\x01 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x02 \x01(\tR\x06toDate\"T\n" +
+	"\x17GetOrderSummaryResponse\x12\x1f\n" +
+	"\vorder_count\x18\x01 \x01(\x03R\n" +
+	"orderCount\x12\x18\n" +
+	"\arevenue\x18\x02 \x01(\x01R\arevenue\"4\n" +
+	"\x17GetOrderTimelineRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"\x94\x01\n" +
+	"\rTimelineEntry\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\tR\ttimestamp\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x18\n" +
+	"\asummary\x18\x04 \x01(\tR\asummary\x12!\n" +
+	"\freference_id\x18\x05 \x01(\tR\vreferenceId\"h\n" +
+	"\x18GetOrderTimelineResponse\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x121\n" +
+	"\aentries\x18\x02 \x03(\v2\x17.order.v1.TimelineEntryR\aentries*\xe1\x01\n" +
 	"\vOrderStatus\x12\x1c\n" +
 	"\x18ORDER_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14ORDER_STATUS_CREATED\x10\x01\x12\x18\n" +
@@ -2029,7 +4761,12 @@ const file_order_v1_order_proto_rawDesc = "" +
 	"\x18ORDER_SOURCE_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13ORDER_SOURCE_ONLINE\x10\x01\x12\x16\n" +
 	"\x12ORDER_SOURCE_STORE\x10\x02\x12\x1c\n" +
-	"\x18ORDER_SOURCE_RESERVATION\x10\x032\xbf\a\n" +
+	"\x18ORDER_SOURCE_RESERVATION\x10\x03*\x95\x01\n" +
+	"\x11FulfillmentStatus\x12\"\n" +
+	"\x1eFULFILLMENT_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17FULFILLMENT_STATUS_NONE\x10\x01\x12\x1e\n" +
+	"\x1aFULFILLMENT_STATUS_PARTIAL\x10\x02\x12\x1f\n" +
+	"\x1bFULFILLMENT_STATUS_COMPLETE\x10\x032\xa9\x12\n" +
 	"\fOrderService\x12J\n" +
 	"\vCreateOrder\x12\x1c.order.v1.CreateOrderRequest\x1a\x1d.order.v1.CreateOrderResponse\x12A\n" +
 	"\bGetOrder\x12\x19.order.v1.GetOrderRequest\x1a\x1a.order.v1.GetOrderResponse\x12P\n" +
@@ -2038,13 +4775,28 @@ const file_order_v1_order_proto_rawDesc = "" +
 	"\vDeleteOrder\x12\x1c.order.v1.DeleteOrderRequest\x1a\x1d.order.v1.DeleteOrderResponse\x12G\n" +
 	"\n" +
 	"ListOrders\x12\x1b.order.v1.ListOrdersRequest\x1a\x1c.order.v1.ListOrdersResponse\x12\\\n" +
-	"\x11UpdateOrderStatus\x12\".order.v1.UpdateOrderStatusRequest\x1a#.order.v1.UpdateOrderStatusResponse\x12G\n" +
+	"\x11UpdateOrderStatus\x12\".order.v1.UpdateOrderStatusRequest\x1a#.order.v1.UpdateOrderStatusResponse\x12h\n" +
+	"\x15BulkUpdateOrderStatus\x12&.order.v1.BulkUpdateOrderStatusRequest\x1a'.order.v1.BulkUpdateOrderStatusResponse\x12G\n" +
 	"\n" +
 	"AddPayment\x12\x1b.order.v1.AddPaymentRequest\x1a\x1c.order.v1.AddPaymentResponse\x12V\n" +
-	"\x0fAddTrackingCode\x12 .order.v1.AddTrackingCodeRequest\x1a!.order.v1.AddTrackingCodeResponse\x12J\n" +
-	"\vCancelOrder\x12\x1c.order.v1.CancelOrderRequest\x1a\x1d.order.v1.CancelOrderResponse\x12S\n" +
+	"\x0fAddTrackingCode\x12 .order.v1.AddTrackingCodeRequest\x1a!.order.v1.AddTrackingCodeResponse\x12M\n" +
+	"\fAddOrderNote\x12\x1d.order.v1.AddOrderNoteRequest\x1a\x1e.order.v1.AddOrderNoteResponse\x12S\n" +
+	"\x0eRecordShipment\x12\x1f.order.v1.RecordShipmentRequest\x1a .order.v1.RecordShipmentResponse\x12J\n" +
+	"\vCancelOrder\x12\x1c.order.v1.CancelOrderRequest\x1a\x1d.order.v1.CancelOrderResponse\x12Y\n" +
+	"\x10CancelOrderItems\x12!.order.v1.CancelOrderItemsRequest\x1a\".order.v1.CancelOrderItemsResponse\x12S\n" +
 	"\x0eGetStoreOrders\x12\x1f.order.v1.GetStoreOrdersRequest\x1a .order.v1.GetStoreOrdersResponse\x12M\n" +
-	"\fExportOrders\x12\x1d.order.v1.ExportOrdersRequest\x1a\x1e.order.v1.ExportOrdersResponseB`Z^github.com/leonvanderhaeghen/stockplatform/services/orderSvc/api/gen/go/proto/order/v1;orderv1b\x06proto3"
+	"\fExportOrders\x12\x1d.order.v1.ExportOrdersRequest\x1a\x1e.order.v1.ExportOrdersResponse\x12h\n" +
+	"\x15ListWebhookDeliveries\x12&.order.v1.ListWebhookDeliveriesRequest\x1a'.order.v1.ListWebhookDeliveriesResponse\x12q\n" +
+	"\x18ListDeadLetteredWebhooks\x12).order.v1.ListDeadLetteredWebhooksRequest\x1a*.order.v1.ListDeadLetteredWebhooksResponse\x12t\n" +
+	"\x19ReplayDeadLetteredWebhook\x12*.order.v1.ReplayDeadLetteredWebhookRequest\x1a+.order.v1.ReplayDeadLetteredWebhookResponse\x12M\n" +
+	"\fCreateReturn\x12\x1d.order.v1.CreateReturnRequest\x1a\x1e.order.v1.CreateReturnResponse\x12D\n" +
+	"\tGetReturn\x12\x1a.order.v1.GetReturnRequest\x1a\x1b.order.v1.GetReturnResponse\x12Y\n" +
+	"\x10ListOrderReturns\x12!.order.v1.ListOrderReturnsRequest\x1a\".order.v1.ListOrderReturnsResponse\x12_\n" +
+	"\x12UpdateReturnStatus\x12#.order.v1.UpdateReturnStatusRequest\x1a$.order.v1.UpdateReturnStatusResponse\x12V\n" +
+	"\x0fGetOrderSummary\x12 .order.v1.GetOrderSummaryRequest\x1a!.order.v1.GetOrderSummaryResponse\x12Y\n" +
+	"\x10GetOrderTimeline\x12!.order.v1.GetOrderTimelineRequest\x1a\".order.v1.GetOrderTimelineResponse\x12P\n" +
+	"\rGetGuestOrder\x12\x1e.order.v1.GetGuestOrderRequest\x1a\x1f.order.v1.GetGuestOrderResponse\x12V\n" +
+	"\x0fLinkGuestOrders\x12 .order.v1.LinkGuestOrdersRequest\x1a!.order.v1.LinkGuestOrdersResponseB`Z^github.com/leonvanderhaeghen/stockplatform/services/orderSvc/api/gen/go/proto/order/v1;orderv1b\x06proto3"
 
 var (
 	file_order_v1_order_proto_rawDescOnce sync.Once
@@ -2058,88 +4810,198 @@ func file_order_v1_order_proto_rawDescGZIP() []byte {
 	return file_order_v1_order_proto_rawDescData
 }
 
-var file_order_v1_order_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_order_v1_order_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_order_v1_order_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_order_v1_order_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
 var file_order_v1_order_proto_goTypes = []any{
-	(OrderStatus)(0),                  // 0: order.v1.OrderStatus
-	(OrderSource)(0),                  // 1: order.v1.OrderSource
-	(*OrderItem)(nil),                 // 2: order.v1.OrderItem
-	(*Address)(nil),                   // 3: order.v1.Address
-	(*Payment)(nil),                   // 4: order.v1.Payment
-	(*Order)(nil),                     // 5: order.v1.Order
-	(*CreateOrderRequest)(nil),        // 6: order.v1.CreateOrderRequest
-	(*CreateOrderResponse)(nil),       // 7: order.v1.CreateOrderResponse
-	(*GetOrderRequest)(nil),           // 8: order.v1.GetOrderRequest
-	(*GetOrderResponse)(nil),          // 9: order.v1.GetOrderResponse
-	(*GetUserOrdersRequest)(nil),      // 10: order.v1.GetUserOrdersRequest
-	(*GetUserOrdersResponse)(nil),     // 11: order.v1.GetUserOrdersResponse
-	(*UpdateOrderRequest)(nil),        // 12: order.v1.UpdateOrderRequest
-	(*UpdateOrderResponse)(nil),       // 13: order.v1.UpdateOrderResponse
-	(*DeleteOrderRequest)(nil),        // 14: order.v1.DeleteOrderRequest
-	(*DeleteOrderResponse)(nil),       // 15: order.v1.DeleteOrderResponse
-	(*ListOrdersRequest)(nil),         // 16: order.v1.ListOrdersRequest
-	(*ListOrdersResponse)(nil),        // 17: order.v1.ListOrdersResponse
-	(*UpdateOrderStatusRequest)(nil),  // 18: order.v1.UpdateOrderStatusRequest
-	(*UpdateOrderStatusResponse)(nil), // 19: order.v1.UpdateOrderStatusResponse
-	(*AddPaymentRequest)(nil),         // 20: order.v1.AddPaymentRequest
-	(*AddPaymentResponse)(nil),        // 21: order.v1.AddPaymentResponse
-	(*AddTrackingCodeRequest)(nil),    // 22: order.v1.AddTrackingCodeRequest
-	(*AddTrackingCodeResponse)(nil),   // 23: order.v1.AddTrackingCodeResponse
-	(*CancelOrderRequest)(nil),        // 24: order.v1.CancelOrderRequest
-	(*CancelOrderResponse)(nil),       // 25: order.v1.CancelOrderResponse
-	(*GetStoreOrdersRequest)(nil),     // 26: order.v1.GetStoreOrdersRequest
-	(*GetStoreOrdersResponse)(nil),    // 27: order.v1.GetStoreOrdersResponse
-	(*ExportOrdersRequest)(nil),       // 28: order.v1.ExportOrdersRequest
-	(*ExportOrdersResponse)(nil),      // 29: order.v1.ExportOrdersResponse
+	(OrderStatus)(0),                          // 0: order.v1.OrderStatus
+	(OrderSource)(0),                          // 1: order.v1.OrderSource
+	(FulfillmentStatus)(0),                    // 2: order.v1.FulfillmentStatus
+	(*OrderItem)(nil),                         // 3: order.v1.OrderItem
+	(*Address)(nil),                           // 4: order.v1.Address
+	(*Payment)(nil),                           // 5: order.v1.Payment
+	(*Order)(nil),                             // 6: order.v1.Order
+	(*GuestContact)(nil),                      // 7: order.v1.GuestContact
+	(*ShipmentItem)(nil),                      // 8: order.v1.ShipmentItem
+	(*Shipment)(nil),                          // 9: order.v1.Shipment
+	(*OrderNote)(nil),                         // 10: order.v1.OrderNote
+	(*CreateOrderRequest)(nil),                // 11: order.v1.CreateOrderRequest
+	(*CreateOrderResponse)(nil),               // 12: order.v1.CreateOrderResponse
+	(*GetOrderRequest)(nil),                   // 13: order.v1.GetOrderRequest
+	(*GetOrderResponse)(nil),                  // 14: order.v1.GetOrderResponse
+	(*GetGuestOrderRequest)(nil),              // 15: order.v1.GetGuestOrderRequest
+	(*GetGuestOrderResponse)(nil),             // 16: order.v1.GetGuestOrderResponse
+	(*LinkGuestOrdersRequest)(nil),            // 17: order.v1.LinkGuestOrdersRequest
+	(*LinkGuestOrdersResponse)(nil),           // 18: order.v1.LinkGuestOrdersResponse
+	(*GetUserOrdersRequest)(nil),              // 19: order.v1.GetUserOrdersRequest
+	(*GetUserOrdersResponse)(nil),             // 20: order.v1.GetUserOrdersResponse
+	(*UpdateOrderRequest)(nil),                // 21: order.v1.UpdateOrderRequest
+	(*UpdateOrderResponse)(nil),               // 22: order.v1.UpdateOrderResponse
+	(*DeleteOrderRequest)(nil),                // 23: order.v1.DeleteOrderRequest
+	(*DeleteOrderResponse)(nil),               // 24: order.v1.DeleteOrderResponse
+	(*ListOrdersRequest)(nil),                 // 25: order.v1.ListOrdersRequest
+	(*ListOrdersResponse)(nil),                // 26: order.v1.ListOrdersResponse
+	(*UpdateOrderStatusRequest)(nil),          // 27: order.v1.UpdateOrderStatusRequest
+	(*UpdateOrderStatusResponse)(nil),         // 28: order.v1.UpdateOrderStatusResponse
+	(*BulkUpdateOrderStatusRequest)(nil),      // 29: order.v1.BulkUpdateOrderStatusRequest
+	(*OrderStatusUpdateResult)(nil),           // 30: order.v1.OrderStatusUpdateResult
+	(*BulkUpdateOrderStatusResponse)(nil),     // 31: order.v1.BulkUpdateOrderStatusResponse
+	(*AddPaymentRequest)(nil),                 // 32: order.v1.AddPaymentRequest
+	(*AddPaymentResponse)(nil),                // 33: order.v1.AddPaymentResponse
+	(*AddTrackingCodeRequest)(nil),            // 34: order.v1.AddTrackingCodeRequest
+	(*AddTrackingCodeResponse)(nil),           // 35: order.v1.AddTrackingCodeResponse
+	(*AddOrderNoteRequest)(nil),               // 36: order.v1.AddOrderNoteRequest
+	(*AddOrderNoteResponse)(nil),              // 37: order.v1.AddOrderNoteResponse
+	(*RecordShipmentRequest)(nil),             // 38: order.v1.RecordShipmentRequest
+	(*RecordShipmentResponse)(nil),            // 39: order.v1.RecordShipmentResponse
+	(*CancelOrderRequest)(nil),                // 40: order.v1.CancelOrderRequest
+	(*CancelOrderResponse)(nil),               // 41: order.v1.CancelOrderResponse
+	(*CancelOrderItemsRequest)(nil),           // 42: order.v1.CancelOrderItemsRequest
+	(*CancelOrderItemsResponse)(nil),          // 43: order.v1.CancelOrderItemsResponse
+	(*GetStoreOrdersRequest)(nil),             // 44: order.v1.GetStoreOrdersRequest
+	(*GetStoreOrdersResponse)(nil),            // 45: order.v1.GetStoreOrdersResponse
+	(*ExportOrdersRequest)(nil),               // 46: order.v1.ExportOrdersRequest
+	(*ExportOrdersResponse)(nil),              // 47: order.v1.ExportOrdersResponse
+	(*WebhookDelivery)(nil),                   // 48: order.v1.WebhookDelivery
+	(*ListWebhookDeliveriesRequest)(nil),      // 49: order.v1.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),     // 50: order.v1.ListWebhookDeliveriesResponse
+	(*ListDeadLetteredWebhooksRequest)(nil),   // 51: order.v1.ListDeadLetteredWebhooksRequest
+	(*ListDeadLetteredWebhooksResponse)(nil),  // 52: order.v1.ListDeadLetteredWebhooksResponse
+	(*ReplayDeadLetteredWebhookRequest)(nil),  // 53: order.v1.ReplayDeadLetteredWebhookRequest
+	(*ReplayDeadLetteredWebhookResponse)(nil), // 54: order.v1.ReplayDeadLetteredWebhookResponse
+	(*ReturnLine)(nil),                        // 55: order.v1.ReturnLine
+	(*Return)(nil),                            // 56: order.v1.Return
+	(*CreateReturnRequest)(nil),               // 57: order.v1.CreateReturnRequest
+	(*CreateReturnResponse)(nil),              // 58: order.v1.CreateReturnResponse
+	(*GetReturnRequest)(nil),                  // 59: order.v1.GetReturnRequest
+	(*GetReturnResponse)(nil),                 // 60: order.v1.GetReturnResponse
+	(*ListOrderReturnsRequest)(nil),           // 61: order.v1.ListOrderReturnsRequest
+	(*ListOrderReturnsResponse)(nil),          // 62: order.v1.ListOrderReturnsResponse
+	(*UpdateReturnStatusRequest)(nil),         // 63: order.v1.UpdateReturnStatusRequest
+	(*UpdateReturnStatusResponse)(nil),        // 64: order.v1.UpdateReturnStatusResponse
+	(*GetOrderSummaryRequest)(nil),            // 65: order.v1.GetOrderSummaryRequest
+	(*GetOrderSummaryResponse)(nil),           // 66: order.v1.GetOrderSummaryResponse
+	(*GetOrderTimelineRequest)(nil),           // 67: order.v1.GetOrderTimelineRequest
+	(*TimelineEntry)(nil),                     // 68: order.v1.TimelineEntry
+	(*GetOrderTimelineResponse)(nil),          // 69: order.v1.GetOrderTimelineResponse
+	nil,                                       // 70: order.v1.UpdateReturnStatusRequest.ConditionsEntry
+	(*timestamppb.Timestamp)(nil),             // 71: google.protobuf.Timestamp
 }
 var file_order_v1_order_proto_depIdxs = []int32{
-	2,  // 0: order.v1.Order.items:type_name -> order.v1.OrderItem
+	3,  // 0: order.v1.Order.items:type_name -> order.v1.OrderItem
 	0,  // 1: order.v1.Order.status:type_name -> order.v1.OrderStatus
-	3,  // 2: order.v1.Order.shipping_address:type_name -> order.v1.Address
-	3,  // 3: order.v1.Order.billing_address:type_name -> order.v1.Address
-	4,  // 4: order.v1.Order.payment:type_name -> order.v1.Payment
+	4,  // 2: order.v1.Order.shipping_address:type_name -> order.v1.Address
+	4,  // 3: order.v1.Order.billing_address:type_name -> order.v1.Address
+	5,  // 4: order.v1.Order.payment:type_name -> order.v1.Payment
 	1,  // 5: order.v1.Order.source:type_name -> order.v1.OrderSource
-	2,  // 6: order.v1.CreateOrderRequest.items:type_name -> order.v1.OrderItem
-	3,  // 7: order.v1.CreateOrderRequest.shipping_address:type_name -> order.v1.Address
-	3,  // 8: order.v1.CreateOrderRequest.billing_address:type_name -> order.v1.Address
-	1,  // 9: order.v1.CreateOrderRequest.source:type_name -> order.v1.OrderSource
-	5,  // 10: order.v1.CreateOrderResponse.order:type_name -> order.v1.Order
-	5,  // 11: order.v1.GetOrderResponse.order:type_name -> order.v1.Order
-	5,  // 12: order.v1.GetUserOrdersResponse.orders:type_name -> order.v1.Order
-	5,  // 13: order.v1.UpdateOrderRequest.order:type_name -> order.v1.Order
-	5,  // 14: order.v1.ListOrdersResponse.orders:type_name -> order.v1.Order
-	0,  // 15: order.v1.UpdateOrderStatusRequest.status:type_name -> order.v1.OrderStatus
-	5,  // 16: order.v1.GetStoreOrdersResponse.orders:type_name -> order.v1.Order
-	1,  // 17: order.v1.ExportOrdersRequest.source:type_name -> order.v1.OrderSource
-	6,  // 18: order.v1.OrderService.CreateOrder:input_type -> order.v1.CreateOrderRequest
-	8,  // 19: order.v1.OrderService.GetOrder:input_type -> order.v1.GetOrderRequest
-	10, // 20: order.v1.OrderService.GetUserOrders:input_type -> order.v1.GetUserOrdersRequest
-	12, // 21: order.v1.OrderService.UpdateOrder:input_type -> order.v1.UpdateOrderRequest
-	14, // 22: order.v1.OrderService.DeleteOrder:input_type -> order.v1.DeleteOrderRequest
-	16, // 23: order.v1.OrderService.ListOrders:input_type -> order.v1.ListOrdersRequest
-	18, // 24: order.v1.OrderService.UpdateOrderStatus:input_type -> order.v1.UpdateOrderStatusRequest
-	20, // 25: order.v1.OrderService.AddPayment:input_type -> order.v1.AddPaymentRequest
-	22, // 26: order.v1.OrderService.AddTrackingCode:input_type -> order.v1.AddTrackingCodeRequest
-	24, // 27: order.v1.OrderService.CancelOrder:input_type -> order.v1.CancelOrderRequest
-	26, // 28: order.v1.OrderService.GetStoreOrders:input_type -> order.v1.GetStoreOrdersRequest
-	28, // 29: order.v1.OrderService.ExportOrders:input_type -> order.v1.ExportOrdersRequest
-	7,  // 30: order.v1.OrderService.CreateOrder:output_type -> order.v1.CreateOrderResponse
-	9,  // 31: order.v1.OrderService.GetOrder:output_type -> order.v1.GetOrderResponse
-	11, // 32: order.v1.OrderService.GetUserOrders:output_type -> order.v1.GetUserOrdersResponse
-	13, // 33: order.v1.OrderService.UpdateOrder:output_type -> order.v1.UpdateOrderResponse
-	15, // 34: order.v1.OrderService.DeleteOrder:output_type -> order.v1.DeleteOrderResponse
-	17, // 35: order.v1.OrderService.ListOrders:output_type -> order.v1.ListOrdersResponse
-	19, // 36: order.v1.OrderService.UpdateOrderStatus:output_type -> order.v1.UpdateOrderStatusResponse
-	21, // 37: order.v1.OrderService.AddPayment:output_type -> order.v1.AddPaymentResponse
-	23, // 38: order.v1.OrderService.AddTrackingCode:output_type -> order.v1.AddTrackingCodeResponse
-	25, // 39: order.v1.OrderService.CancelOrder:output_type -> order.v1.CancelOrderResponse
-	27, // 40: order.v1.OrderService.GetStoreOrders:output_type -> order.v1.GetStoreOrdersResponse
-	29, // 41: order.v1.OrderService.ExportOrders:output_type -> order.v1.ExportOrdersResponse
-	30, // [30:42] is the sub-list for method output_type
-	18, // [18:30] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	10, // 6: order.v1.Order.note_log:type_name -> order.v1.OrderNote
+	2,  // 7: order.v1.Order.fulfillment_status:type_name -> order.v1.FulfillmentStatus
+	9,  // 8: order.v1.Order.shipments:type_name -> order.v1.Shipment
+	7,  // 9: order.v1.Order.guest:type_name -> order.v1.GuestContact
+	71, // 10: order.v1.Order.created_at_ts:type_name -> google.protobuf.Timestamp
+	71, // 11: order.v1.Order.updated_at_ts:type_name -> google.protobuf.Timestamp
+	71, // 12: order.v1.Order.completed_at_ts:type_name -> google.protobuf.Timestamp
+	8,  // 13: order.v1.Shipment.items:type_name -> order.v1.ShipmentItem
+	71, // 14: order.v1.Shipment.shipped_at_ts:type_name -> google.protobuf.Timestamp
+	71, // 15: order.v1.OrderNote.created_at_ts:type_name -> google.protobuf.Timestamp
+	3,  // 16: order.v1.CreateOrderRequest.items:type_name -> order.v1.OrderItem
+	4,  // 17: order.v1.CreateOrderRequest.shipping_address:type_name -> order.v1.Address
+	4,  // 18: order.v1.CreateOrderRequest.billing_address:type_name -> order.v1.Address
+	1,  // 19: order.v1.CreateOrderRequest.source:type_name -> order.v1.OrderSource
+	7,  // 20: order.v1.CreateOrderRequest.guest:type_name -> order.v1.GuestContact
+	6,  // 21: order.v1.CreateOrderResponse.order:type_name -> order.v1.Order
+	6,  // 22: order.v1.GetOrderResponse.order:type_name -> order.v1.Order
+	6,  // 23: order.v1.GetGuestOrderResponse.order:type_name -> order.v1.Order
+	6,  // 24: order.v1.GetUserOrdersResponse.orders:type_name -> order.v1.Order
+	6,  // 25: order.v1.UpdateOrderRequest.order:type_name -> order.v1.Order
+	6,  // 26: order.v1.ListOrdersResponse.orders:type_name -> order.v1.Order
+	0,  // 27: order.v1.UpdateOrderStatusRequest.status:type_name -> order.v1.OrderStatus
+	0,  // 28: order.v1.BulkUpdateOrderStatusRequest.status:type_name -> order.v1.OrderStatus
+	30, // 29: order.v1.BulkUpdateOrderStatusResponse.results:type_name -> order.v1.OrderStatusUpdateResult
+	6,  // 30: order.v1.AddOrderNoteResponse.order:type_name -> order.v1.Order
+	8,  // 31: order.v1.RecordShipmentRequest.items:type_name -> order.v1.ShipmentItem
+	6,  // 32: order.v1.RecordShipmentResponse.order:type_name -> order.v1.Order
+	9,  // 33: order.v1.RecordShipmentResponse.shipment:type_name -> order.v1.Shipment
+	6,  // 34: order.v1.CancelOrderItemsResponse.order:type_name -> order.v1.Order
+	6,  // 35: order.v1.GetStoreOrdersResponse.orders:type_name -> order.v1.Order
+	1,  // 36: order.v1.ExportOrdersRequest.source:type_name -> order.v1.OrderSource
+	71, // 37: order.v1.WebhookDelivery.created_at_ts:type_name -> google.protobuf.Timestamp
+	71, // 38: order.v1.WebhookDelivery.updated_at_ts:type_name -> google.protobuf.Timestamp
+	71, // 39: order.v1.WebhookDelivery.last_attempt_at_ts:type_name -> google.protobuf.Timestamp
+	71, // 40: order.v1.WebhookDelivery.delivered_at_ts:type_name -> google.protobuf.Timestamp
+	48, // 41: order.v1.ListWebhookDeliveriesResponse.deliveries:type_name -> order.v1.WebhookDelivery
+	48, // 42: order.v1.ListDeadLetteredWebhooksResponse.deliveries:type_name -> order.v1.WebhookDelivery
+	48, // 43: order.v1.ReplayDeadLetteredWebhookResponse.delivery:type_name -> order.v1.WebhookDelivery
+	55, // 44: order.v1.Return.lines:type_name -> order.v1.ReturnLine
+	71, // 45: order.v1.Return.created_at_ts:type_name -> google.protobuf.Timestamp
+	71, // 46: order.v1.Return.updated_at_ts:type_name -> google.protobuf.Timestamp
+	71, // 47: order.v1.Return.received_at_ts:type_name -> google.protobuf.Timestamp
+	71, // 48: order.v1.Return.refunded_at_ts:type_name -> google.protobuf.Timestamp
+	55, // 49: order.v1.CreateReturnRequest.lines:type_name -> order.v1.ReturnLine
+	56, // 50: order.v1.CreateReturnResponse.return:type_name -> order.v1.Return
+	56, // 51: order.v1.GetReturnResponse.return:type_name -> order.v1.Return
+	56, // 52: order.v1.ListOrderReturnsResponse.returns:type_name -> order.v1.Return
+	70, // 53: order.v1.UpdateReturnStatusRequest.conditions:type_name -> order.v1.UpdateReturnStatusRequest.ConditionsEntry
+	56, // 54: order.v1.UpdateReturnStatusResponse.return:type_name -> order.v1.Return
+	68, // 55: order.v1.GetOrderTimelineResponse.entries:type_name -> order.v1.TimelineEntry
+	11, // 56: order.v1.OrderService.CreateOrder:input_type -> order.v1.CreateOrderRequest
+	13, // 57: order.v1.OrderService.GetOrder:input_type -> order.v1.GetOrderRequest
+	19, // 58: order.v1.OrderService.GetUserOrders:input_type -> order.v1.GetUserOrdersRequest
+	21, // 59: order.v1.OrderService.UpdateOrder:input_type -> order.v1.UpdateOrderRequest
+	23, // 60: order.v1.OrderService.DeleteOrder:input_type -> order.v1.DeleteOrderRequest
+	25, // 61: order.v1.OrderService.ListOrders:input_type -> order.v1.ListOrdersRequest
+	27, // 62: order.v1.OrderService.UpdateOrderStatus:input_type -> order.v1.UpdateOrderStatusRequest
+	29, // 63: order.v1.OrderService.BulkUpdateOrderStatus:input_type -> order.v1.BulkUpdateOrderStatusRequest
+	32, // 64: order.v1.OrderService.AddPayment:input_type -> order.v1.AddPaymentRequest
+	34, // 65: order.v1.OrderService.AddTrackingCode:input_type -> order.v1.AddTrackingCodeRequest
+	36, // 66: order.v1.OrderService.AddOrderNote:input_type -> order.v1.AddOrderNoteRequest
+	38, // 67: order.v1.OrderService.RecordShipment:input_type -> order.v1.RecordShipmentRequest
+	40, // 68: order.v1.OrderService.CancelOrder:input_type -> order.v1.CancelOrderRequest
+	42, // 69: order.v1.OrderService.CancelOrderItems:input_type -> order.v1.CancelOrderItemsRequest
+	44, // 70: order.v1.OrderService.GetStoreOrders:input_type -> order.v1.GetStoreOrdersRequest
+	46, // 71: order.v1.OrderService.ExportOrders:input_type -> order.v1.ExportOrdersRequest
+	49, // 72: order.v1.OrderService.ListWebhookDeliveries:input_type -> order.v1.ListWebhookDeliveriesRequest
+	51, // 73: order.v1.OrderService.ListDeadLetteredWebhooks:input_type -> order.v1.ListDeadLetteredWebhooksRequest
+	53, // 74: order.v1.OrderService.ReplayDeadLetteredWebhook:input_type -> order.v1.ReplayDeadLetteredWebhookRequest
+	57, // 75: order.v1.OrderService.CreateReturn:input_type -> order.v1.CreateReturnRequest
+	59, // 76: order.v1.OrderService.GetReturn:input_type -> order.v1.GetReturnRequest
+	61, // 77: order.v1.OrderService.ListOrderReturns:input_type -> order.v1.ListOrderReturnsRequest
+	63, // 78: order.v1.OrderService.UpdateReturnStatus:input_type -> order.v1.UpdateReturnStatusRequest
+	65, // 79: order.v1.OrderService.GetOrderSummary:input_type -> order.v1.GetOrderSummaryRequest
+	67, // 80: order.v1.OrderService.GetOrderTimeline:input_type -> order.v1.GetOrderTimelineRequest
+	15, // 81: order.v1.OrderService.GetGuestOrder:input_type -> order.v1.GetGuestOrderRequest
+	17, // 82: order.v1.OrderService.LinkGuestOrders:input_type -> order.v1.LinkGuestOrdersRequest
+	12, // 83: order.v1.OrderService.CreateOrder:output_type -> order.v1.CreateOrderResponse
+	14, // 84: order.v1.OrderService.GetOrder:output_type -> order.v1.GetOrderResponse
+	20, // 85: order.v1.OrderService.GetUserOrders:output_type -> order.v1.GetUserOrdersResponse
+	22, // 86: order.v1.OrderService.UpdateOrder:output_type -> order.v1.UpdateOrderResponse
+	24, // 87: order.v1.OrderService.DeleteOrder:output_type -> order.v1.DeleteOrderResponse
+	26, // 88: order.v1.OrderService.ListOrders:output_type -> order.v1.ListOrdersResponse
+	28, // 89: order.v1.OrderService.UpdateOrderStatus:output_type -> order.v1.UpdateOrderStatusResponse
+	31, // 90: order.v1.OrderService.BulkUpdateOrderStatus:output_type -> order.v1.BulkUpdateOrderStatusResponse
+	33, // 91: order.v1.OrderService.AddPayment:output_type -> order.v1.AddPaymentResponse
+	35, // 92: order.v1.OrderService.AddTrackingCode:output_type -> order.v1.AddTrackingCodeResponse
+	37, // 93: order.v1.OrderService.AddOrderNote:output_type -> order.v1.AddOrderNoteResponse
+	39, // 94: order.v1.OrderService.RecordShipment:output_type -> order.v1.RecordShipmentResponse
+	41, // 95: order.v1.OrderService.CancelOrder:output_type -> order.v1.CancelOrderResponse
+	43, // 96: order.v1.OrderService.CancelOrderItems:output_type -> order.v1.CancelOrderItemsResponse
+	45, // 97: order.v1.OrderService.GetStoreOrders:output_type -> order.v1.GetStoreOrdersResponse
+	47, // 98: order.v1.OrderService.ExportOrders:output_type -> order.v1.ExportOrdersResponse
+	50, // 99: order.v1.OrderService.ListWebhookDeliveries:output_type -> order.v1.ListWebhookDeliveriesResponse
+	52, // 100: order.v1.OrderService.ListDeadLetteredWebhooks:output_type -> order.v1.ListDeadLetteredWebhooksResponse
+	54, // 101: order.v1.OrderService.ReplayDeadLetteredWebhook:output_type -> order.v1.ReplayDeadLetteredWebhookResponse
+	58, // 102: order.v1.OrderService.CreateReturn:output_type -> order.v1.CreateReturnResponse
+	60, // 103: order.v1.OrderService.GetReturn:output_type -> order.v1.GetReturnResponse
+	62, // 104: order.v1.OrderService.ListOrderReturns:output_type -> order.v1.ListOrderReturnsResponse
+	64, // 105: order.v1.OrderService.UpdateReturnStatus:output_type -> order.v1.UpdateReturnStatusResponse
+	66, // 106: order.v1.OrderService.GetOrderSummary:output_type -> order.v1.GetOrderSummaryResponse
+	69, // 107: order.v1.OrderService.GetOrderTimeline:output_type -> order.v1.GetOrderTimelineResponse
+	16, // 108: order.v1.OrderService.GetGuestOrder:output_type -> order.v1.GetGuestOrderResponse
+	18, // 109: order.v1.OrderService.LinkGuestOrders:output_type -> order.v1.LinkGuestOrdersResponse
+	83, // [83:110] is the sub-list for method output_type
+	56, // [56:83] is the sub-list for method input_type
+	56, // [56:56] is the sub-list for extension type_name
+	56, // [56:56] is the sub-list for extension extendee
+	0,  // [0:56] is the sub-list for field type_name
 }
 
 func init() { file_order_v1_order_proto_init() }
@@ -2152,8 +5014,8 @@ func file_order_v1_order_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_v1_order_proto_rawDesc), len(file_order_v1_order_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   28,
+			NumEnums:      3,
+			NumMessages:   68,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,18 +19,33 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrderService_CreateOrder_FullMethodName       = "/order.v1.OrderService/CreateOrder"
-	OrderService_GetOrder_FullMethodName          = "/order.v1.OrderService/GetOrder"
-	OrderService_GetUserOrders_FullMethodName     = "/order.v1.OrderService/GetUserOrders"
-	OrderService_UpdateOrder_FullMethodName       = "/order.v1.OrderService/UpdateOrder"
-	OrderService_DeleteOrder_FullMethodName       = "/order.v1.OrderService/DeleteOrder"
-	OrderService_ListOrders_FullMethodName        = "/order.v1.OrderService/ListOrders"
-	OrderService_UpdateOrderStatus_FullMethodName = "/order.v1.OrderService/UpdateOrderStatus"
-	OrderService_AddPayment_FullMethodName        = "/order.v1.OrderService/AddPayment"
-	OrderService_AddTrackingCode_FullMethodName   = "/order.v1.OrderService/AddTrackingCode"
-	OrderService_CancelOrder_FullMethodName       = "/order.v1.OrderService/CancelOrder"
-	OrderService_GetStoreOrders_FullMethodName    = "/order.v1.OrderService/GetStoreOrders"
-	OrderService_ExportOrders_FullMethodName      = "/order.v1.OrderService/ExportOrders"
+	OrderService_CreateOrder_FullMethodName               = "/order.v1.OrderService/CreateOrder"
+	OrderService_GetOrder_FullMethodName                  = "/order.v1.OrderService/GetOrder"
+	OrderService_GetUserOrders_FullMethodName             = "/order.v1.OrderService/GetUserOrders"
+	OrderService_UpdateOrder_FullMethodName               = "/order.v1.OrderService/UpdateOrder"
+	OrderService_DeleteOrder_FullMethodName               = "/order.v1.OrderService/DeleteOrder"
+	OrderService_ListOrders_FullMethodName                = "/order.v1.OrderService/ListOrders"
+	OrderService_UpdateOrderStatus_FullMethodName         = "/order.v1.OrderService/UpdateOrderStatus"
+	OrderService_BulkUpdateOrderStatus_FullMethodName     = "/order.v1.OrderService/BulkUpdateOrderStatus"
+	OrderService_AddPayment_FullMethodName                = "/order.v1.OrderService/AddPayment"
+	OrderService_AddTrackingCode_FullMethodName           = "/order.v1.OrderService/AddTrackingCode"
+	OrderService_AddOrderNote_FullMethodName              = "/order.v1.OrderService/AddOrderNote"
+	OrderService_RecordShipment_FullMethodName            = "/order.v1.OrderService/RecordShipment"
+	OrderService_CancelOrder_FullMethodName               = "/order.v1.OrderService/CancelOrder"
+	OrderService_CancelOrderItems_FullMethodName          = "/order.v1.OrderService/CancelOrderItems"
+	OrderService_GetStoreOrders_FullMethodName            = "/order.v1.OrderService/GetStoreOrders"
+	OrderService_ExportOrders_FullMethodName              = "/order.v1.OrderService/ExportOrders"
+	OrderService_ListWebhookDeliveries_FullMethodName     = "/order.v1.OrderService/ListWebhookDeliveries"
+	OrderService_ListDeadLetteredWebhooks_FullMethodName  = "/order.v1.OrderService/ListDeadLetteredWebhooks"
+	OrderService_ReplayDeadLetteredWebhook_FullMethodName = "/order.v1.OrderService/ReplayDeadLetteredWebhook"
+	OrderService_CreateReturn_FullMethodName              = "/order.v1.OrderService/CreateReturn"
+	OrderService_GetReturn_FullMethodName                 = "/order.v1.OrderService/GetReturn"
+	OrderService_ListOrderReturns_FullMethodName          = "/order.v1.OrderService/ListOrderReturns"
+	OrderService_UpdateReturnStatus_FullMethodName        = "/order.v1.OrderService/UpdateReturnStatus"
+	OrderService_GetOrderSummary_FullMethodName           = "/order.v1.OrderService/GetOrderSummary"
+	OrderService_GetOrderTimeline_FullMethodName          = "/order.v1.OrderService/GetOrderTimeline"
+	OrderService_GetGuestOrder_FullMethodName             = "/order.v1.OrderService/GetGuestOrder"
+	OrderService_LinkGuestOrders_FullMethodName           = "/order.v1.OrderService/LinkGuestOrders"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -53,16 +68,46 @@ type OrderServiceClient interface {
 	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
 	// UpdateOrderStatus updates the status of an order
 	UpdateOrderStatus(ctx context.Context, in *UpdateOrderStatusRequest, opts ...grpc.CallOption) (*UpdateOrderStatusResponse, error)
+	// BulkUpdateOrderStatus moves many orders to the same status; each order succeeds or fails on its own
+	BulkUpdateOrderStatus(ctx context.Context, in *BulkUpdateOrderStatusRequest, opts ...grpc.CallOption) (*BulkUpdateOrderStatusResponse, error)
 	// AddPayment adds payment information to an order
 	AddPayment(ctx context.Context, in *AddPaymentRequest, opts ...grpc.CallOption) (*AddPaymentResponse, error)
 	// AddTrackingCode adds a tracking code to an order
 	AddTrackingCode(ctx context.Context, in *AddTrackingCodeRequest, opts ...grpc.CallOption) (*AddTrackingCodeResponse, error)
+	// AddOrderNote appends a note to an order or one of its items
+	AddOrderNote(ctx context.Context, in *AddOrderNoteRequest, opts ...grpc.CallOption) (*AddOrderNoteResponse, error)
+	// Record a shipment of some or all of a paid order's items
+	RecordShipment(ctx context.Context, in *RecordShipmentRequest, opts ...grpc.CallOption) (*RecordShipmentResponse, error)
 	// CancelOrder cancels an order
 	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
+	// CancelOrderItems cancels some lines of an order before it ships
+	CancelOrderItems(ctx context.Context, in *CancelOrderItemsRequest, opts ...grpc.CallOption) (*CancelOrderItemsResponse, error)
 	// GetStoreOrders retrieves orders for a specific store
 	GetStoreOrders(ctx context.Context, in *GetStoreOrdersRequest, opts ...grpc.CallOption) (*GetStoreOrdersResponse, error)
 	// ExportOrders exports orders to CSV format
 	ExportOrders(ctx context.Context, in *ExportOrdersRequest, opts ...grpc.CallOption) (*ExportOrdersResponse, error)
+	// ListWebhookDeliveries lists per-subscriber webhook delivery status
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	// ListDeadLetteredWebhooks lists webhook deliveries that exhausted their retries
+	ListDeadLetteredWebhooks(ctx context.Context, in *ListDeadLetteredWebhooksRequest, opts ...grpc.CallOption) (*ListDeadLetteredWebhooksResponse, error)
+	// ReplayDeadLetteredWebhook retries a dead-lettered webhook delivery
+	ReplayDeadLetteredWebhook(ctx context.Context, in *ReplayDeadLetteredWebhookRequest, opts ...grpc.CallOption) (*ReplayDeadLetteredWebhookResponse, error)
+	// Open a return (RMA) for items of an order
+	CreateReturn(ctx context.Context, in *CreateReturnRequest, opts ...grpc.CallOption) (*CreateReturnResponse, error)
+	// Get a return by ID
+	GetReturn(ctx context.Context, in *GetReturnRequest, opts ...grpc.CallOption) (*GetReturnResponse, error)
+	// List the returns of an order
+	ListOrderReturns(ctx context.Context, in *ListOrderReturnsRequest, opts ...grpc.CallOption) (*ListOrderReturnsResponse, error)
+	// Move a return through its workflow; receiving it restocks inventory
+	UpdateReturnStatus(ctx context.Context, in *UpdateReturnStatusRequest, opts ...grpc.CallOption) (*UpdateReturnStatusResponse, error)
+	// Count orders and sum their revenue over a period
+	GetOrderSummary(ctx context.Context, in *GetOrderSummaryRequest, opts ...grpc.CallOption) (*GetOrderSummaryResponse, error)
+	// List everything that happened to an order, oldest first
+	GetOrderTimeline(ctx context.Context, in *GetOrderTimelineRequest, opts ...grpc.CallOption) (*GetOrderTimelineResponse, error)
+	// Read a guest order through the token of its signed link
+	GetGuestOrder(ctx context.Context, in *GetGuestOrderRequest, opts ...grpc.CallOption) (*GetGuestOrderResponse, error)
+	// Attach the guest orders placed with an email to a registered user
+	LinkGuestOrders(ctx context.Context, in *LinkGuestOrdersRequest, opts ...grpc.CallOption) (*LinkGuestOrdersResponse, error)
 }
 
 type orderServiceClient struct {
@@ -143,6 +188,16 @@ func (c *orderServiceClient) UpdateOrderStatus(ctx context.Context, in *UpdateOr
 	return out, nil
 }
 
+func (c *orderServiceClient) BulkUpdateOrderStatus(ctx context.Context, in *BulkUpdateOrderStatusRequest, opts ...grpc.CallOption) (*BulkUpdateOrderStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUpdateOrderStatusResponse)
+	err := c.cc.Invoke(ctx, OrderService_BulkUpdateOrderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderServiceClient) AddPayment(ctx context.Context, in *AddPaymentRequest, opts ...grpc.CallOption) (*AddPaymentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddPaymentResponse)
@@ -163,6 +218,26 @@ func (c *orderServiceClient) AddTrackingCode(ctx context.Context, in *AddTrackin
 	return out, nil
 }
 
+func (c *orderServiceClient) AddOrderNote(ctx context.Context, in *AddOrderNoteRequest, opts ...grpc.CallOption) (*AddOrderNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddOrderNoteResponse)
+	err := c.cc.Invoke(ctx, OrderService_AddOrderNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) RecordShipment(ctx context.Context, in *RecordShipmentRequest, opts ...grpc.CallOption) (*RecordShipmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordShipmentResponse)
+	err := c.cc.Invoke(ctx, OrderService_RecordShipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderServiceClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CancelOrderResponse)
@@ -173,6 +248,16 @@ func (c *orderServiceClient) CancelOrder(ctx context.Context, in *CancelOrderReq
 	return out, nil
 }
 
+func (c *orderServiceClient) CancelOrderItems(ctx context.Context, in *CancelOrderItemsRequest, opts ...grpc.CallOption) (*CancelOrderItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelOrderItemsResponse)
+	err := c.cc.Invoke(ctx, OrderService_CancelOrderItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderServiceClient) GetStoreOrders(ctx context.Context, in *GetStoreOrdersRequest, opts ...grpc.CallOption) (*GetStoreOrdersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetStoreOrdersResponse)
@@ -193,6 +278,116 @@ func (c *orderServiceClient) ExportOrders(ctx context.Context, in *ExportOrdersR
 	return out, nil
 }
 
+func (c *orderServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListDeadLetteredWebhooks(ctx context.Context, in *ListDeadLetteredWebhooksRequest, opts ...grpc.CallOption) (*ListDeadLetteredWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeadLetteredWebhooksResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListDeadLetteredWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ReplayDeadLetteredWebhook(ctx context.Context, in *ReplayDeadLetteredWebhookRequest, opts ...grpc.CallOption) (*ReplayDeadLetteredWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayDeadLetteredWebhookResponse)
+	err := c.cc.Invoke(ctx, OrderService_ReplayDeadLetteredWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CreateReturn(ctx context.Context, in *CreateReturnRequest, opts ...grpc.CallOption) (*CreateReturnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReturnResponse)
+	err := c.cc.Invoke(ctx, OrderService_CreateReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetReturn(ctx context.Context, in *GetReturnRequest, opts ...grpc.CallOption) (*GetReturnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReturnResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListOrderReturns(ctx context.Context, in *ListOrderReturnsRequest, opts ...grpc.CallOption) (*ListOrderReturnsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrderReturnsResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListOrderReturns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) UpdateReturnStatus(ctx context.Context, in *UpdateReturnStatusRequest, opts ...grpc.CallOption) (*UpdateReturnStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateReturnStatusResponse)
+	err := c.cc.Invoke(ctx, OrderService_UpdateReturnStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetOrderSummary(ctx context.Context, in *GetOrderSummaryRequest, opts ...grpc.CallOption) (*GetOrderSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderSummaryResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetOrderSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetOrderTimeline(ctx context.Context, in *GetOrderTimelineRequest, opts ...grpc.CallOption) (*GetOrderTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderTimelineResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetOrderTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetGuestOrder(ctx context.Context, in *GetGuestOrderRequest, opts ...grpc.CallOption) (*GetGuestOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGuestOrderResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetGuestOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) LinkGuestOrders(ctx context.Context, in *LinkGuestOrdersRequest, opts ...grpc.CallOption) (*LinkGuestOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkGuestOrdersResponse)
+	err := c.cc.Invoke(ctx, OrderService_LinkGuestOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations should embed UnimplementedOrderServiceServer
 // for forward compatibility.
@@ -213,16 +408,46 @@ type OrderServiceServer interface {
 	ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
 	// UpdateOrderStatus updates the status of an order
 	UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*UpdateOrderStatusResponse, error)
+	// BulkUpdateOrderStatus moves many orders to the same status; each order succeeds or fails on its own
+	BulkUpdateOrderStatus(context.Context, *BulkUpdateOrderStatusRequest) (*BulkUpdateOrderStatusResponse, error)
 	// AddPayment adds payment information to an order
 	AddPayment(context.Context, *AddPaymentRequest) (*AddPaymentResponse, error)
 	// AddTrackingCode adds a tracking code to an order
 	AddTrackingCode(context.Context, *AddTrackingCodeRequest) (*AddTrackingCodeResponse, error)
+	// AddOrderNote appends a note to an order or one of its items
+	AddOrderNote(context.Context, *AddOrderNoteRequest) (*AddOrderNoteResponse, error)
+	// Record a shipment of some or all of a paid order's items
+	RecordShipment(context.Context, *RecordShipmentRequest) (*RecordShipmentResponse, error)
 	// CancelOrder cancels an order
 	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+	// CancelOrderItems cancels some lines of an order before it ships
+	CancelOrderItems(context.Context, *CancelOrderItemsRequest) (*CancelOrderItemsResponse, error)
 	// GetStoreOrders retrieves orders for a specific store
 	GetStoreOrders(context.Context, *GetStoreOrdersRequest) (*GetStoreOrdersResponse, error)
 	// ExportOrders exports orders to CSV format
 	ExportOrders(context.Context, *ExportOrdersRequest) (*ExportOrdersResponse, error)
+	// ListWebhookDeliveries lists per-subscriber webhook delivery status
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	// ListDeadLetteredWebhooks lists webhook deliveries that exhausted their retries
+	ListDeadLetteredWebhooks(context.Context, *ListDeadLetteredWebhooksRequest) (*ListDeadLetteredWebhooksResponse, error)
+	// ReplayDeadLetteredWebhook retries a dead-lettered webhook delivery
+	ReplayDeadLetteredWebhook(context.Context, *ReplayDeadLetteredWebhookRequest) (*ReplayDeadLetteredWebhookResponse, error)
+	// Open a return (RMA) for items of an order
+	CreateReturn(context.Context, *CreateReturnRequest) (*CreateReturnResponse, error)
+	// Get a return by ID
+	GetReturn(context.Context, *GetReturnRequest) (*GetReturnResponse, error)
+	// List the returns of an order
+	ListOrderReturns(context.Context, *ListOrderReturnsRequest) (*ListOrderReturnsResponse, error)
+	// Move a return through its workflow; receiving it restocks inventory
+	UpdateReturnStatus(context.Context, *UpdateReturnStatusRequest) (*UpdateReturnStatusResponse, error)
+	// Count orders and sum their revenue over a period
+	GetOrderSummary(context.Context, *GetOrderSummaryRequest) (*GetOrderSummaryResponse, error)
+	// List everything that happened to an order, oldest first
+	GetOrderTimeline(context.Context, *GetOrderTimelineRequest) (*GetOrderTimelineResponse, error)
+	// Read a guest order through the token of its signed link
+	GetGuestOrder(context.Context, *GetGuestOrderRequest) (*GetGuestOrderResponse, error)
+	// Attach the guest orders placed with an email to a registered user
+	LinkGuestOrders(context.Context, *LinkGuestOrdersRequest) (*LinkGuestOrdersResponse, error)
 }
 
 // UnimplementedOrderServiceServer should be embedded to have
@@ -253,21 +478,66 @@ func (UnimplementedOrderServiceServer) ListOrders(context.Context, *ListOrdersRe
 func (UnimplementedOrderServiceServer) UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*UpdateOrderStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderStatus not implemented")
 }
+func (UnimplementedOrderServiceServer) BulkUpdateOrderStatus(context.Context, *BulkUpdateOrderStatusRequest) (*BulkUpdateOrderStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdateOrderStatus not implemented")
+}
 func (UnimplementedOrderServiceServer) AddPayment(context.Context, *AddPaymentRequest) (*AddPaymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddPayment not implemented")
 }
 func (UnimplementedOrderServiceServer) AddTrackingCode(context.Context, *AddTrackingCodeRequest) (*AddTrackingCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddTrackingCode not implemented")
 }
+func (UnimplementedOrderServiceServer) AddOrderNote(context.Context, *AddOrderNoteRequest) (*AddOrderNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOrderNote not implemented")
+}
+func (UnimplementedOrderServiceServer) RecordShipment(context.Context, *RecordShipmentRequest) (*RecordShipmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordShipment not implemented")
+}
 func (UnimplementedOrderServiceServer) CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
 }
+func (UnimplementedOrderServiceServer) CancelOrderItems(context.Context, *CancelOrderItemsRequest) (*CancelOrderItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrderItems not implemented")
+}
 func (UnimplementedOrderServiceServer) GetStoreOrders(context.Context, *GetStoreOrdersRequest) (*GetStoreOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStoreOrders not implemented")
 }
 func (UnimplementedOrderServiceServer) ExportOrders(context.Context, *ExportOrdersRequest) (*ExportOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExportOrders not implemented")
 }
+func (UnimplementedOrderServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedOrderServiceServer) ListDeadLetteredWebhooks(context.Context, *ListDeadLetteredWebhooksRequest) (*ListDeadLetteredWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetteredWebhooks not implemented")
+}
+func (UnimplementedOrderServiceServer) ReplayDeadLetteredWebhook(context.Context, *ReplayDeadLetteredWebhookRequest) (*ReplayDeadLetteredWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetteredWebhook not implemented")
+}
+func (UnimplementedOrderServiceServer) CreateReturn(context.Context, *CreateReturnRequest) (*CreateReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReturn not implemented")
+}
+func (UnimplementedOrderServiceServer) GetReturn(context.Context, *GetReturnRequest) (*GetReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReturn not implemented")
+}
+func (UnimplementedOrderServiceServer) ListOrderReturns(context.Context, *ListOrderReturnsRequest) (*ListOrderReturnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrderReturns not implemented")
+}
+func (UnimplementedOrderServiceServer) UpdateReturnStatus(context.Context, *UpdateReturnStatusRequest) (*UpdateReturnStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReturnStatus not implemented")
+}
+func (UnimplementedOrderServiceServer) GetOrderSummary(context.Context, *GetOrderSummaryRequest) (*GetOrderSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderSummary not implemented")
+}
+func (UnimplementedOrderServiceServer) GetOrderTimeline(context.Context, *GetOrderTimelineRequest) (*GetOrderTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderTimeline not implemented")
+}
+func (UnimplementedOrderServiceServer) GetGuestOrder(context.Context, *GetGuestOrderRequest) (*GetGuestOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuestOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) LinkGuestOrders(context.Context, *LinkGuestOrdersRequest) (*LinkGuestOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkGuestOrders not implemented")
+}
 func (UnimplementedOrderServiceServer) testEmbeddedByValue() {}
 
 // UnsafeOrderServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -414,6 +684,24 @@ func _OrderService_UpdateOrderStatus_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_BulkUpdateOrderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdateOrderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).BulkUpdateOrderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_BulkUpdateOrderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).BulkUpdateOrderStatus(ctx, req.(*BulkUpdateOrderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_AddPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddPaymentRequest)
 	if err := dec(in); err != nil {
@@ -450,6 +738,42 @@ func _OrderService_AddTrackingCode_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_AddOrderNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOrderNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).AddOrderNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_AddOrderNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).AddOrderNote(ctx, req.(*AddOrderNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_RecordShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).RecordShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_RecordShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).RecordShipment(ctx, req.(*RecordShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CancelOrderRequest)
 	if err := dec(in); err != nil {
@@ -468,6 +792,24 @@ func _OrderService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_CancelOrderItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CancelOrderItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CancelOrderItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CancelOrderItems(ctx, req.(*CancelOrderItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_GetStoreOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetStoreOrdersRequest)
 	if err := dec(in); err != nil {
@@ -504,6 +846,204 @@ func _OrderService_ExportOrders_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListDeadLetteredWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLetteredWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListDeadLetteredWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListDeadLetteredWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListDeadLetteredWebhooks(ctx, req.(*ListDeadLetteredWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ReplayDeadLetteredWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLetteredWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ReplayDeadLetteredWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ReplayDeadLetteredWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ReplayDeadLetteredWebhook(ctx, req.(*ReplayDeadLetteredWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CreateReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CreateReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CreateReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CreateReturn(ctx, req.(*CreateReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetReturn(ctx, req.(*GetReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListOrderReturns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrderReturnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListOrderReturns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListOrderReturns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListOrderReturns(ctx, req.(*ListOrderReturnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_UpdateReturnStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReturnStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).UpdateReturnStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_UpdateReturnStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).UpdateReturnStatus(ctx, req.(*UpdateReturnStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetOrderSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrderSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetOrderSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrderSummary(ctx, req.(*GetOrderSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetOrderTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrderTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetOrderTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrderTimeline(ctx, req.(*GetOrderTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetGuestOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuestOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetGuestOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetGuestOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetGuestOrder(ctx, req.(*GetGuestOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_LinkGuestOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkGuestOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).LinkGuestOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_LinkGuestOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).LinkGuestOrders(ctx, req.(*LinkGuestOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -539,6 +1079,10 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateOrderStatus",
 			Handler:    _OrderService_UpdateOrderStatus_Handler,
 		},
+		{
+			MethodName: "BulkUpdateOrderStatus",
+			Handler:    _OrderService_BulkUpdateOrderStatus_Handler,
+		},
 		{
 			MethodName: "AddPayment",
 			Handler:    _OrderService_AddPayment_Handler,
@@ -547,10 +1091,22 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddTrackingCode",
 			Handler:    _OrderService_AddTrackingCode_Handler,
 		},
+		{
+			MethodName: "AddOrderNote",
+			Handler:    _OrderService_AddOrderNote_Handler,
+		},
+		{
+			MethodName: "RecordShipment",
+			Handler:    _OrderService_RecordShipment_Handler,
+		},
 		{
 			MethodName: "CancelOrder",
 			Handler:    _OrderService_CancelOrder_Handler,
 		},
+		{
+			MethodName: "CancelOrderItems",
+			Handler:    _OrderService_CancelOrderItems_Handler,
+		},
 		{
 			MethodName: "GetStoreOrders",
 			Handler:    _OrderService_GetStoreOrders_Handler,
@@ -559,6 +1115,50 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExportOrders",
 			Handler:    _OrderService_ExportOrders_Handler,
 		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _OrderService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "ListDeadLetteredWebhooks",
+			Handler:    _OrderService_ListDeadLetteredWebhooks_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetteredWebhook",
+			Handler:    _OrderService_ReplayDeadLetteredWebhook_Handler,
+		},
+		{
+			MethodName: "CreateReturn",
+			Handler:    _OrderService_CreateReturn_Handler,
+		},
+		{
+			MethodName: "GetReturn",
+			Handler:    _OrderService_GetReturn_Handler,
+		},
+		{
+			MethodName: "ListOrderReturns",
+			Handler:    _OrderService_ListOrderReturns_Handler,
+		},
+		{
+			MethodName: "UpdateReturnStatus",
+			Handler:    _OrderService_UpdateReturnStatus_Handler,
+		},
+		{
+			MethodName: "GetOrderSummary",
+			Handler:    _OrderService_GetOrderSummary_Handler,
+		},
+		{
+			MethodName: "GetOrderTimeline",
+			Handler:    _OrderService_GetOrderTimeline_Handler,
+		},
+		{
+			MethodName: "GetGuestOrder",
+			Handler:    _OrderService_GetGuestOrder_Handler,
+		},
+		{
+			MethodName: "LinkGuestOrders",
+			Handler:    _OrderService_LinkGuestOrders_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "order/v1/order.proto",
@@ -0,0 +1,91 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// recordingPublisher records the order events it is asked to publish
+type recordingPublisher struct {
+	domain.EventPublisher
+	orderEvents []*domain.OrderEvent
+}
+
+func (p *recordingPublisher) PublishOrderEvent(event *domain.OrderEvent) error {
+	p.orderEvents = append(p.orderEvents, event)
+	return nil
+}
+
+func newOrderWithStatus(repo *memoryOrderRepository, status domain.OrderStatus) *domain.Order {
+	order := domain.NewOrder("user-1", []domain.OrderItem{{ProductID: "product-1", Quantity: 1, Price: 10}}, domain.Address{}, domain.Address{})
+	order.Status = status
+	repo.put(order)
+	return order
+}
+
+func TestBulkUpdateStatusMixedTransitions(t *testing.T) {
+	repo := newMemoryOrderRepository()
+	paid := newOrderWithStatus(repo, domain.StatusPaid)
+	pending := newOrderWithStatus(repo, domain.StatusPending)
+	alsoPaid := newOrderWithStatus(repo, domain.StatusPaid)
+	delivered := newOrderWithStatus(repo, domain.StatusDelivered)
+
+	publisher := &recordingPublisher{}
+	events := NewEventService(publisher, zap.NewNop())
+	service := NewOrderService(repo, events, nil, nil, domain.GuestLinkSigner{}, false, zap.NewNop())
+
+	ids := []string{paid.ID, pending.ID, "missing", alsoPaid.ID, delivered.ID}
+	results := service.BulkUpdateStatus(context.Background(), ids, domain.StatusShipped, "staff-1")
+
+	if len(results) != len(ids) {
+		t.Fatalf("got %d results, want one per order", len(results))
+	}
+	for i, result := range results {
+		if result.OrderID != ids[i] {
+			t.Fatalf("result %d is for %s, want %s", i, result.OrderID, ids[i])
+		}
+	}
+
+	for _, id := range []string{paid.ID, alsoPaid.ID} {
+		if err := resultFor(results, id); err != nil {
+			t.Errorf("order %s: %v", id, err)
+		}
+		if got := repo.get(id).Status; got != domain.StatusShipped {
+			t.Errorf("order %s status = %s, want SHIPPED", id, got)
+		}
+	}
+	for _, order := range []*domain.Order{pending, delivered} {
+		if err := resultFor(results, order.ID); !errors.Is(err, domain.ErrInvalidStatusTransition) {
+			t.Errorf("order %s err = %v, want ErrInvalidStatusTransition", order.ID, err)
+		}
+		if got := repo.get(order.ID).Status; got != order.Status {
+			t.Errorf("order %s status = %s, should be left at %s", order.ID, got, order.Status)
+		}
+	}
+	if resultFor(results, "missing") == nil {
+		t.Error("an unknown order should fail")
+	}
+
+	if len(publisher.orderEvents) != 2 {
+		t.Fatalf("published %d events, want one per shipped order", len(publisher.orderEvents))
+	}
+	for _, event := range publisher.orderEvents {
+		if event.Type != domain.EventOrderShipped {
+			t.Errorf("event type = %s, want %s", event.Type, domain.EventOrderShipped)
+		}
+	}
+}
+
+func resultFor(results []BulkStatusResult, orderID string) error {
+	for _, result := range results {
+		if result.OrderID == orderID {
+			return result.Err
+		}
+	}
+	return errors.New("no result")
+}
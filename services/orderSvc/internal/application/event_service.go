@@ -86,18 +86,16 @@ func (s *EventService) PublishOrderStatusChanged(ctx context.Context, order *dom
 		return s.publishGenericStatusChange(ctx, order, previousStatus)
 	}
 
-	event := domain.NewOrderEvent(
-		eventType,
-		order.ID,
-		order.UserID,
-		order.Version,
-		map[string]interface{}{
-			"previous_status": string(previousStatus),
-			"new_status":      string(order.Status),
-			"total_amount":    order.TotalAmount,
-			"tracking_code":   order.TrackingCode,
-		},
-	)
+	data := map[string]interface{}{
+		"previous_status": string(previousStatus),
+		"new_status":      string(order.Status),
+		"total_amount":    order.TotalAmount,
+		"tracking_code":   order.TrackingCode,
+	}
+	if order.Status == domain.StatusCancelled && order.CancelReason != "" {
+		data["reason"] = order.CancelReason
+	}
+	event := domain.NewOrderEvent(eventType, order.ID, order.UserID, order.Version, data)
 
 	// Check if publisher is available (prevent nil pointer panic)
 	if s.publisher == nil {
@@ -226,9 +224,20 @@ func (s *EventService) PublishInventoryReserved(ctx context.Context, order *doma
 
 // PublishInventoryReleased publishes an inventory released event
 func (s *EventService) PublishInventoryReleased(ctx context.Context, order *domain.Order) error {
+	return s.publishInventoryReleased(ctx, order, order.Items, "order_cancelled")
+}
+
+// PublishItemsReleased publishes an inventory released event for lines
+// cancelled from an order that goes on
+func (s *EventService) PublishItemsReleased(ctx context.Context, order *domain.Order, items []domain.OrderItem) error {
+	return s.publishInventoryReleased(ctx, order, items, "items_cancelled")
+}
+
+// publishInventoryReleased publishes the release of items of an order
+func (s *EventService) publishInventoryReleased(ctx context.Context, order *domain.Order, items []domain.OrderItem, reason string) error {
 	// Create inventory release data
 	var releases []map[string]interface{}
-	for _, item := range order.Items {
+	for _, item := range items {
 		releases = append(releases, map[string]interface{}{
 			"product_id": item.ProductID,
 			"quantity":   item.Quantity,
@@ -242,7 +251,7 @@ func (s *EventService) PublishInventoryReleased(ctx context.Context, order *doma
 		order.Version,
 		map[string]interface{}{
 			"releases": releases,
-			"reason":   "order_cancelled",
+			"reason":   reason,
 		},
 	)
 
@@ -250,7 +259,7 @@ func (s *EventService) PublishInventoryReleased(ctx context.Context, order *doma
 	if s.publisher == nil {
 		s.logger.Warn("No event publisher configured, skipping inventory released event",
 			zap.String("order_id", order.ID),
-			zap.Int("items_count", len(order.Items)),
+			zap.Int("items_count", len(items)),
 		)
 		return nil // Don't fail the operation
 	}
@@ -260,14 +269,14 @@ func (s *EventService) PublishInventoryReleased(ctx context.Context, order *doma
 		s.logger.Error("Failed to publish inventory released event",
 			zap.Error(err),
 			zap.String("order_id", order.ID),
-			zap.Int("items_count", len(order.Items)),
+			zap.Int("items_count", len(items)),
 		)
 		return fmt.Errorf("failed to publish inventory released event: %w", err)
 	}
 
 	s.logger.Info("Published inventory released event",
 		zap.String("order_id", order.ID),
-		zap.Int("items_count", len(order.Items)),
+		zap.Int("items_count", len(items)),
 	)
 
 	return nil
@@ -0,0 +1,82 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// CreateGuestOrder creates an order for a buyer without an account. Besides
+// the order it returns the token of the link the guest reads the order
+// through, which is empty when guest links are not configured.
+func (s *OrderService) CreateGuestOrder(ctx context.Context, contact domain.GuestContact, items []domain.OrderItem, shippingAddr, billingAddr domain.Address) (*domain.Order, string, error) {
+	contact.Normalize()
+	if err := contact.Validate(); err != nil {
+		return nil, "", err
+	}
+	if len(items) == 0 {
+		return nil, "", errors.New("order must have at least one item")
+	}
+
+	s.logger.Info("Creating guest order", zap.Int("item_count", len(items)))
+
+	if err := s.validateProducts(ctx, items); err != nil {
+		return nil, "", err
+	}
+
+	order := domain.NewGuestOrder(contact, items, shippingAddr, billingAddr)
+	if err := s.placeOrder(ctx, order); err != nil {
+		return nil, "", err
+	}
+	return order, s.guestLinks.Sign(order.ID, contact.Email), nil
+}
+
+// GetGuestOrder retrieves a guest order through the token of its link. A
+// token that does not match the order reads as the order not existing, so
+// links cannot be used to probe for order IDs. Once the order is linked to an
+// account its owner reads it like any other order.
+func (s *OrderService) GetGuestOrder(ctx context.Context, orderID, token string) (*domain.Order, error) {
+	if !s.guestLinks.Enabled() {
+		return nil, domain.ErrGuestLinksDisabled
+	}
+	if orderID == "" {
+		return nil, errors.New("order ID is required")
+	}
+
+	order, err := s.repo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil || !order.IsGuest() || !s.guestLinks.Verify(order.ID, order.Guest.Email, token) {
+		return nil, errors.New("order not found")
+	}
+	return order, nil
+}
+
+// LinkGuestOrders attaches the guest orders placed with email to the account
+// of userID and returns how many it attached. The email must be verified to
+// belong to the user: whoever holds an account with the address gets its
+// orders.
+func (s *OrderService) LinkGuestOrders(ctx context.Context, email, userID string) (int64, error) {
+	email = domain.NormalizeEmail(email)
+	if email == "" {
+		return 0, errors.New("email is required")
+	}
+	if userID == "" {
+		return 0, errors.New("user ID is required")
+	}
+
+	linked, err := s.repo.LinkGuestOrders(ctx, email, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	s.logger.Info("Linked guest orders to user",
+		zap.String("user_id", userID),
+		zap.Int64("linked", linked),
+	)
+	return linked, nil
+}
@@ -0,0 +1,154 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+func newGuestOrderService(repo domain.OrderRepository, secret string) *OrderService {
+	return NewOrderService(repo, nil, nil, nil, domain.NewGuestLinkSigner(secret, time.Hour), false, zap.NewNop())
+}
+
+func guestItems() []domain.OrderItem {
+	return []domain.OrderItem{{ProductID: "product-1", Quantity: 1, Price: 20}}
+}
+
+func TestCreateGuestOrder(t *testing.T) {
+	repo := newMemoryOrderRepository()
+	service := newGuestOrderService(repo, "secret")
+	ctx := context.Background()
+
+	contact := domain.GuestContact{Email: " Jane@Example.com ", Phone: " +32 470 12 34 56 "}
+	order, token, err := service.CreateGuestOrder(ctx, contact, guestItems(), domain.Address{}, domain.Address{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stored := repo.get(order.ID)
+	if stored == nil {
+		t.Fatal("guest order was not stored")
+	}
+	if stored.UserID != "" || !stored.IsGuest() {
+		t.Fatalf("stored order has user %q, want a guest order", stored.UserID)
+	}
+	if stored.Guest.Email != "jane@example.com" || stored.Guest.Phone != "+32 470 12 34 56" {
+		t.Fatalf("guest contact = %+v, want it normalized", *stored.Guest)
+	}
+	if token == "" {
+		t.Fatal("no guest access token returned")
+	}
+
+	got, err := service.GetGuestOrder(ctx, order.ID, token)
+	if err != nil {
+		t.Fatalf("GetGuestOrder with the returned token = %v", err)
+	}
+	if got.ID != order.ID {
+		t.Fatalf("read order %s, want %s", got.ID, order.ID)
+	}
+}
+
+func TestCreateGuestOrderRejectsInvalidContact(t *testing.T) {
+	repo := newMemoryOrderRepository()
+	service := newGuestOrderService(repo, "secret")
+
+	_, _, err := service.CreateGuestOrder(context.Background(), domain.GuestContact{Email: "not-an-email"}, guestItems(), domain.Address{}, domain.Address{})
+	if !errors.Is(err, domain.ErrInvalidGuestContact) {
+		t.Fatalf("err = %v, want ErrInvalidGuestContact", err)
+	}
+	if orders, _ := repo.List(context.Background(), nil, 0, 0); len(orders) != 0 {
+		t.Fatalf("stored %d orders, want none", len(orders))
+	}
+}
+
+func TestGetGuestOrderRejectsOtherTokens(t *testing.T) {
+	repo := newMemoryOrderRepository()
+	service := newGuestOrderService(repo, "secret")
+	ctx := context.Background()
+
+	first, firstToken, err := service.CreateGuestOrder(ctx, domain.GuestContact{Email: "jane@example.com"}, guestItems(), domain.Address{}, domain.Address{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, _, err := service.CreateGuestOrder(ctx, domain.GuestContact{Email: "jane@example.com"}, guestItems(), domain.Address{}, domain.Address{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.GetGuestOrder(ctx, second.ID, firstToken); err == nil {
+		t.Fatal("the token of one order must not open another")
+	}
+	otherSecret := newGuestOrderService(repo, "other")
+	if _, err := otherSecret.GetGuestOrder(ctx, first.ID, firstToken); err == nil {
+		t.Fatal("a token signed with another secret must not verify")
+	}
+	disabled := newGuestOrderService(repo, "")
+	if _, err := disabled.GetGuestOrder(ctx, first.ID, firstToken); !errors.Is(err, domain.ErrGuestLinksDisabled) {
+		t.Fatalf("err = %v, want ErrGuestLinksDisabled", err)
+	}
+}
+
+func TestLinkGuestOrdersAfterRegistration(t *testing.T) {
+	repo := newMemoryOrderRepository()
+	service := newGuestOrderService(repo, "secret")
+	ctx := context.Background()
+
+	var janeOrders []*domain.Order
+	var janeTokens []string
+	for _, email := range []string{"jane@example.com", "JANE@example.com"} {
+		order, token, err := service.CreateGuestOrder(ctx, domain.GuestContact{Email: email}, guestItems(), domain.Address{}, domain.Address{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		janeOrders = append(janeOrders, order)
+		janeTokens = append(janeTokens, token)
+	}
+	john, _, err := service.CreateGuestOrder(ctx, domain.GuestContact{Email: "john@example.com"}, guestItems(), domain.Address{}, domain.Address{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	linked, err := service.LinkGuestOrders(ctx, " Jane@Example.com", "user-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if linked != 2 {
+		t.Fatalf("linked %d orders, want 2", linked)
+	}
+	for i, order := range janeOrders {
+		stored := repo.get(order.ID)
+		if stored.UserID != "user-1" || stored.IsGuest() {
+			t.Errorf("order %s belongs to %q, want user-1", order.ID, stored.UserID)
+		}
+		if _, err := service.GetGuestOrder(ctx, order.ID, janeTokens[i]); err == nil {
+			t.Errorf("guest link of linked order %s still works", order.ID)
+		}
+	}
+	if stored := repo.get(john.ID); !stored.IsGuest() {
+		t.Errorf("order of another guest was linked to %q", stored.UserID)
+	}
+
+	again, err := service.LinkGuestOrders(ctx, "jane@example.com", "user-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again != 0 {
+		t.Fatalf("linked %d already linked orders again, want 0", again)
+	}
+}
+
+func TestLinkGuestOrdersRequiresEmailAndUser(t *testing.T) {
+	service := newGuestOrderService(newMemoryOrderRepository(), "secret")
+
+	if _, err := service.LinkGuestOrders(context.Background(), " ", "user-1"); err == nil {
+		t.Error("linking without an email should fail")
+	}
+	if _, err := service.LinkGuestOrders(context.Background(), "jane@example.com", ""); err == nil {
+		t.Error("linking without a user should fail")
+	}
+}
@@ -0,0 +1,77 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// CancelOrderItems cancels the lines of the given products from an order
+// before it ships, releasing the stock reserved for them, and returns the
+// updated order. Cancelling every line cancels the whole order.
+func (s *OrderService) CancelOrderItems(ctx context.Context, orderID string, productIDs []string, changedBy string) (*domain.Order, error) {
+	s.logger.Info("Cancelling order items",
+		zap.String("id", orderID),
+		zap.Strings("product_ids", productIDs),
+		zap.String("changed_by", changedBy),
+	)
+
+	order, err := s.repo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil {
+		return nil, errors.New("order not found")
+	}
+
+	removed, cancelsOrder, err := order.CancelItems(productIDs)
+	if err != nil {
+		return nil, err
+	}
+	if cancelsOrder {
+		if err := s.cancel(ctx, order, "", changedBy); err != nil {
+			return nil, err
+		}
+		return order, nil
+	}
+
+	expectedVersion := order.Version - 1 // Version was incremented by CancelItems
+	if err := s.repo.UpdateWithOptimisticLock(ctx, order, expectedVersion); err != nil {
+		return nil, err
+	}
+
+	s.releaseItems(ctx, order, removed)
+
+	if s.eventService != nil {
+		if err := s.eventService.PublishItemsReleased(ctx, order, removed); err != nil {
+			s.logger.Warn("Failed to publish inventory released event", zap.Error(err))
+		}
+	}
+
+	return order, nil
+}
+
+// releaseItems gives back the stock reserved for lines cancelled from an
+// order. Like releaseStock it only logs failures, since the lines are gone
+// from the order either way; stock a failed call leaves held stays so until
+// the order's reservations are released as a whole.
+func (s *OrderService) releaseItems(ctx context.Context, order *domain.Order, items []domain.OrderItem) {
+	if s.stock == nil || order.IsPOSOrder() {
+		return
+	}
+	released, err := s.stock.ReleaseItems(ctx, order, items)
+	if err != nil {
+		s.logger.Warn("Failed to release stock of cancelled order items",
+			zap.String("order_id", order.ID),
+			zap.Error(err),
+		)
+		return
+	}
+	s.logger.Info("Released stock of cancelled order items",
+		zap.String("order_id", order.ID),
+		zap.Int("reservations", released),
+	)
+}
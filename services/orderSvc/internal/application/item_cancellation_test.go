@@ -0,0 +1,106 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+func newItemCancellationOrder() *domain.Order {
+	return domain.NewOrder("customer-1", []domain.OrderItem{
+		{ProductID: "lamp", Quantity: 2, Price: 15, Subtotal: 30},
+		{ProductID: "desk", Quantity: 1, Price: 120, Subtotal: 120},
+	}, domain.Address{}, domain.Address{})
+}
+
+func TestCancelOrderItemsRecomputesTotal(t *testing.T) {
+	order := newItemCancellationOrder()
+	repo := newMemoryOrderRepository(order)
+	stock := &reservingStock{}
+	service := NewOrderService(repo, nil, nil, stock, domain.GuestLinkSigner{}, false, zap.NewNop())
+
+	updated, err := service.CancelOrderItems(context.Background(), order.ID, []string{"lamp"}, "customer-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updated.TotalAmount != 120 || len(updated.Items) != 1 {
+		t.Fatalf("returned order has %d items totalling %v, want the desk at 120", len(updated.Items), updated.TotalAmount)
+	}
+	stored := repo.get(order.ID)
+	if stored.TotalAmount != 120 || len(stored.Items) != 1 || stored.Items[0].ProductID != "desk" {
+		t.Fatalf("stored order has items %+v totalling %v, want the desk at 120", stored.Items, stored.TotalAmount)
+	}
+	if stored.Status != domain.StatusCreated {
+		t.Fatalf("status = %s, want the order to go on", stored.Status)
+	}
+	if len(stock.releasedItems) != 1 || stock.releasedItems[0].ProductID != "lamp" {
+		t.Fatalf("released items = %+v, want the lamp line", stock.releasedItems)
+	}
+	if len(stock.released) != 0 {
+		t.Fatalf("released whole orders %v, want none", stock.released)
+	}
+}
+
+func TestCancelOrderItemsOfEveryLineCancelsOrder(t *testing.T) {
+	order := newItemCancellationOrder()
+	repo := newMemoryOrderRepository(order)
+	stock := &reservingStock{}
+	service := NewOrderService(repo, nil, nil, stock, domain.GuestLinkSigner{}, false, zap.NewNop())
+
+	updated, err := service.CancelOrderItems(context.Background(), order.ID, []string{"lamp", "desk"}, "customer-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updated.Status != domain.StatusCancelled || repo.get(order.ID).Status != domain.StatusCancelled {
+		t.Fatalf("status = %s, want the whole order cancelled", repo.get(order.ID).Status)
+	}
+	if len(stock.released) != 1 || stock.released[0] != order.ID {
+		t.Fatalf("released orders = %v, want the order's reservations released", stock.released)
+	}
+}
+
+func TestCancelOrderItemsRejectsShippedOrders(t *testing.T) {
+	for _, status := range []domain.OrderStatus{domain.StatusShipped, domain.StatusDelivered} {
+		t.Run(string(status), func(t *testing.T) {
+			order := newItemCancellationOrder()
+			order.Status = status
+			repo := newMemoryOrderRepository(order)
+			stock := &reservingStock{}
+			service := NewOrderService(repo, nil, nil, stock, domain.GuestLinkSigner{}, false, zap.NewNop())
+
+			_, err := service.CancelOrderItems(context.Background(), order.ID, []string{"lamp"}, "customer-1")
+
+			if !errors.Is(err, domain.ErrInvalidStatusTransition) {
+				t.Fatalf("err = %v, want ErrInvalidStatusTransition", err)
+			}
+			stored := repo.get(order.ID)
+			if len(stored.Items) != 2 || stored.TotalAmount != 150 || stored.Status != status {
+				t.Fatalf("stored order has %d items totalling %v in %s, want it unchanged", len(stored.Items), stored.TotalAmount, stored.Status)
+			}
+			if len(stock.releasedItems) != 0 || len(stock.released) != 0 {
+				t.Fatal("no stock should be released for a rejected cancellation")
+			}
+		})
+	}
+}
+
+func TestCancelOrderItemsOfPOSOrderReleasesNothing(t *testing.T) {
+	order := newItemCancellationOrder()
+	order.Source = domain.SourcePOS
+	repo := newMemoryOrderRepository(order)
+	stock := &reservingStock{}
+	service := NewOrderService(repo, nil, nil, stock, domain.GuestLinkSigner{}, false, zap.NewNop())
+
+	if _, err := service.CancelOrderItems(context.Background(), order.ID, []string{"lamp"}, "staff-1"); err != nil {
+		t.Fatal(err)
+	}
+	if len(stock.releasedItems) != 0 {
+		t.Fatalf("released %+v, want nothing for a POS order", stock.releasedItems)
+	}
+}
@@ -0,0 +1,166 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// memoryOrderRepository is an in-memory order repository for service tests.
+// Orders are copied in and out so tests see only what was stored.
+type memoryOrderRepository struct {
+	domain.OrderRepository
+
+	mu     sync.Mutex
+	orders map[string]*domain.Order
+}
+
+func newMemoryOrderRepository(orders ...*domain.Order) *memoryOrderRepository {
+	r := &memoryOrderRepository{orders: make(map[string]*domain.Order)}
+	for _, order := range orders {
+		r.put(order)
+	}
+	return r
+}
+
+func (r *memoryOrderRepository) put(order *domain.Order) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *order
+	copied.Items = append([]domain.OrderItem(nil), order.Items...)
+	copied.NoteLog = append([]domain.OrderNote(nil), order.NoteLog...)
+	r.orders[order.ID] = &copied
+}
+
+func (r *memoryOrderRepository) get(id string) *domain.Order {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	order, ok := r.orders[id]
+	if !ok {
+		return nil
+	}
+	copied := *order
+	copied.Items = append([]domain.OrderItem(nil), order.Items...)
+	copied.NoteLog = append([]domain.OrderNote(nil), order.NoteLog...)
+	return &copied
+}
+
+func (r *memoryOrderRepository) Create(ctx context.Context, order *domain.Order) error {
+	r.put(order)
+	return nil
+}
+
+// CreateWithStep stores order and deletes it again when step fails, as the
+// MongoDB repository does without transactions
+func (r *memoryOrderRepository) CreateWithStep(ctx context.Context, order *domain.Order, step func(ctx context.Context) error) error {
+	r.put(order)
+	if err := step(ctx); err != nil {
+		r.mu.Lock()
+		delete(r.orders, order.ID)
+		r.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+func (r *memoryOrderRepository) GetByID(ctx context.Context, id string) (*domain.Order, error) {
+	return r.get(id), nil
+}
+
+// LinkGuestOrders links the unlinked guest orders placed with email to userID
+func (r *memoryOrderRepository) LinkGuestOrders(ctx context.Context, email, userID string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var linked int64
+	for _, order := range r.orders {
+		if order.IsGuest() && order.Guest.Email == email {
+			order.UserID = userID
+			order.Version++
+			linked++
+		}
+	}
+	return linked, nil
+}
+
+func (r *memoryOrderRepository) Update(ctx context.Context, order *domain.Order) error {
+	r.put(order)
+	return nil
+}
+
+// List returns a page of the orders matching filter, which may only hold a
+// status, ordered by ID
+func (r *memoryOrderRepository) List(ctx context.Context, filter map[string]interface{}, limit, offset int) ([]*domain.Order, error) {
+	orders := r.matching(filter)
+	if offset >= len(orders) {
+		return nil, nil
+	}
+	orders = orders[offset:]
+	if limit > 0 && limit < len(orders) {
+		orders = orders[:limit]
+	}
+	return orders, nil
+}
+
+func (r *memoryOrderRepository) Count(ctx context.Context, filter map[string]interface{}) (int64, error) {
+	return int64(len(r.matching(filter))), nil
+}
+
+func (r *memoryOrderRepository) matching(filter map[string]interface{}) []*domain.Order {
+	r.mu.Lock()
+	ids := make([]string, 0, len(r.orders))
+	for id, order := range r.orders {
+		if status, ok := filter["status"]; ok && string(order.Status) != status {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	r.mu.Unlock()
+
+	sort.Strings(ids)
+	orders := make([]*domain.Order, 0, len(ids))
+	for _, id := range ids {
+		orders = append(orders, r.get(id))
+	}
+	return orders
+}
+
+// ListAwaitingPayment returns up to limit online orders in CREATED or PENDING
+// created before createdBefore, oldest first
+func (r *memoryOrderRepository) ListAwaitingPayment(ctx context.Context, createdBefore time.Time, limit int) ([]*domain.Order, error) {
+	var orders []*domain.Order
+	for _, order := range r.matching(nil) {
+		if order.IsAwaitingPayment() && !order.IsPOSOrder() && order.CreatedAt.Before(createdBefore) {
+			orders = append(orders, order)
+		}
+	}
+	sort.Slice(orders, func(i, j int) bool { return orders[i].CreatedAt.Before(orders[j].CreatedAt) })
+	if limit > 0 && limit < len(orders) {
+		orders = orders[:limit]
+	}
+	return orders, nil
+}
+
+func (r *memoryOrderRepository) UpdateWithOptimisticLock(ctx context.Context, order *domain.Order, expectedVersion int32) error {
+	if stored := r.get(order.ID); stored == nil || stored.Version != expectedVersion {
+		return domain.ErrOptimisticLockFailed
+	}
+	r.put(order)
+	return nil
+}
+
+func (r *memoryOrderRepository) AppendNote(ctx context.Context, orderID string, note *domain.OrderNote) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	order, ok := r.orders[orderID]
+	if !ok {
+		return errors.New("order not found")
+	}
+	order.NoteLog = append(append([]domain.OrderNote(nil), order.NoteLog...), *note)
+	order.Notes = note.Text
+	order.Version++
+	return nil
+}
@@ -0,0 +1,71 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+func TestAddOrderNoteKeepsEarlierNotes(t *testing.T) {
+	repo := newMemoryOrderRepository()
+	order := newOrderWithStatus(repo, domain.StatusPaid)
+	service := newTestOrderService(repo)
+	ctx := context.Background()
+
+	if _, err := service.AddOrderNote(ctx, order.ID, "staff-1", "Customer asked for gift wrap", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.AddOrderNote(ctx, order.ID, "staff-2", "Box dented", "product-1"); err != nil {
+		t.Fatal(err)
+	}
+	updated, err := service.AddOrderNote(ctx, order.ID, "", "Shipped with carrier B", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []domain.OrderNote{
+		{AuthorID: "staff-1", Text: "Customer asked for gift wrap"},
+		{AuthorID: "staff-2", Text: "Box dented", ProductID: "product-1"},
+		{AuthorID: "", Text: "Shipped with carrier B"},
+	}
+	if len(updated.NoteLog) != len(want) {
+		t.Fatalf("order has %d notes, want %d", len(updated.NoteLog), len(want))
+	}
+	for i, w := range want {
+		got := updated.NoteLog[i]
+		if got.AuthorID != w.AuthorID || got.Text != w.Text || got.ProductID != w.ProductID {
+			t.Errorf("note %d = %+v, want %+v", i, got, w)
+		}
+		if got.ID == "" || got.CreatedAt.IsZero() {
+			t.Errorf("note %d should have an ID and a creation time", i)
+		}
+	}
+	if updated.Notes != "Shipped with carrier B" {
+		t.Errorf("latest note = %q, want the last one added", updated.Notes)
+	}
+}
+
+func TestAddOrderNoteRejectsInvalidNotes(t *testing.T) {
+	repo := newMemoryOrderRepository()
+	order := newOrderWithStatus(repo, domain.StatusPaid)
+	service := newTestOrderService(repo)
+	ctx := context.Background()
+
+	if _, err := service.AddOrderNote(ctx, order.ID, "staff-1", "First", ""); err != nil {
+		t.Fatal(err)
+	}
+	for name, note := range map[string]struct{ text, productID string }{
+		"blank text":       {text: "  "},
+		"item not ordered": {text: "Damaged", productID: "product-9"},
+	} {
+		if _, err := service.AddOrderNote(ctx, order.ID, "staff-1", note.text, note.productID); !errors.Is(err, domain.ErrInvalidNote) {
+			t.Errorf("%s: err = %v, want ErrInvalidNote", name, err)
+		}
+	}
+
+	if got := repo.get(order.ID).NoteLog; len(got) != 1 || got[0].Text != "First" {
+		t.Fatalf("notes = %+v, rejected notes should leave the log alone", got)
+	}
+}
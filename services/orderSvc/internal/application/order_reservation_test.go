@@ -0,0 +1,121 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// reservingStock is a stock fulfiller that reserves new orders, failing with
+// reserveErr, and records the orders it reserves and releases and the lines it
+// releases on their own
+type reservingStock struct {
+	domain.StockFulfiller
+	reserved      []string
+	released      []string
+	releasedItems []domain.OrderItem
+	reserveErr    error
+}
+
+func (s *reservingStock) ReserveOrder(ctx context.Context, order *domain.Order) error {
+	if s.reserveErr != nil {
+		return s.reserveErr
+	}
+	s.reserved = append(s.reserved, order.ID)
+	return nil
+}
+
+func (s *reservingStock) ReleaseOrder(ctx context.Context, order *domain.Order) (int, error) {
+	s.released = append(s.released, order.ID)
+	return 1, nil
+}
+
+func (s *reservingStock) ReleaseItems(ctx context.Context, order *domain.Order, items []domain.OrderItem) (int, error) {
+	s.releasedItems = append(s.releasedItems, items...)
+	return len(items), nil
+}
+
+// failingCreateRepository fails to store any order before reaching the step
+type failingCreateRepository struct {
+	*memoryOrderRepository
+}
+
+func (r failingCreateRepository) CreateWithStep(ctx context.Context, order *domain.Order, step func(ctx context.Context) error) error {
+	return errors.New("insert failed")
+}
+
+var reservationTestItems = []domain.OrderItem{{ProductID: "product-1", Quantity: 2, Price: 5}}
+
+func TestCreateOrderReservesStock(t *testing.T) {
+	repo := newMemoryOrderRepository()
+	stock := &reservingStock{}
+	service := NewOrderService(repo, nil, nil, stock, domain.GuestLinkSigner{}, false, zap.NewNop())
+
+	order, err := service.CreateOrder(context.Background(), "user-1", reservationTestItems, domain.Address{}, domain.Address{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if repo.get(order.ID) == nil {
+		t.Fatal("order was not stored")
+	}
+	if len(stock.reserved) != 1 || stock.reserved[0] != order.ID {
+		t.Errorf("reserved orders = %v, want [%s]", stock.reserved, order.ID)
+	}
+	if len(stock.released) != 0 {
+		t.Errorf("released orders = %v, want none", stock.released)
+	}
+}
+
+func TestCreateOrderRollsBackOnReservationFailure(t *testing.T) {
+	tests := []struct {
+		name       string
+		reserveErr error
+		shortage   bool
+	}{
+		{name: "insufficient stock", reserveErr: fmt.Errorf("%w: product-1", domain.ErrInsufficientStock), shortage: true},
+		{name: "unknown outcome", reserveErr: errors.New("inventory unavailable")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMemoryOrderRepository()
+			stock := &reservingStock{reserveErr: tt.reserveErr}
+			service := NewOrderService(repo, nil, nil, stock, domain.GuestLinkSigner{}, false, zap.NewNop())
+
+			order, err := service.CreateOrder(context.Background(), "user-1", reservationTestItems, domain.Address{}, domain.Address{})
+			if err == nil {
+				t.Fatalf("created order %s, want the reservation failure", order.ID)
+			}
+			if got := errors.Is(err, domain.ErrInsufficientStock); got != tt.shortage {
+				t.Errorf("err = %v, ErrInsufficientStock = %t, want %t", err, got, tt.shortage)
+			}
+
+			if n, _ := repo.Count(context.Background(), nil); n != 0 {
+				t.Errorf("orders stored = %d, want the order rolled back", n)
+			}
+			// Whatever the inventory service may hold for the order is released
+			if len(stock.released) != 1 {
+				t.Errorf("released orders = %v, want the failed order released once", stock.released)
+			}
+		})
+	}
+}
+
+func TestCreateOrderDoesNotReleaseWhenNothingWasReserved(t *testing.T) {
+	repo := failingCreateRepository{newMemoryOrderRepository()}
+	stock := &reservingStock{}
+	service := NewOrderService(repo, nil, nil, stock, domain.GuestLinkSigner{}, false, zap.NewNop())
+
+	if _, err := service.CreateOrder(context.Background(), "user-1", reservationTestItems, domain.Address{}, domain.Address{}); err == nil {
+		t.Fatal("expected the insert failure")
+	}
+	if len(stock.reserved) != 0 || len(stock.released) != 0 {
+		t.Errorf("reserved %v, released %v, want no inventory calls", stock.reserved, stock.released)
+	}
+}
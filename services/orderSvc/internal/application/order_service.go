@@ -3,6 +3,8 @@ package application
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -13,16 +15,110 @@ import (
 type OrderService struct {
 	repo         domain.OrderRepository
 	eventService *EventService
+	products     domain.ProductCatalog
+	stock        domain.StockFulfiller
+	guestLinks   domain.GuestLinkSigner
 	logger       *zap.Logger
+
+	// validatePOSProducts enables the product existence check for POS orders,
+	// whose items were usually just scanned and are known to exist
+	validatePOSProducts bool
 }
 
-// NewOrderService creates a new order service
-func NewOrderService(repo domain.OrderRepository, eventService *EventService, logger *zap.Logger) *OrderService {
+// NewOrderService creates a new order service. When products is nil, order
+// items are not checked against the product catalog; when stock is nil,
+// creating and paying an order do not touch inventory. guestLinks signs the
+// links guests read their orders through.
+func NewOrderService(repo domain.OrderRepository, eventService *EventService, products domain.ProductCatalog, stock domain.StockFulfiller, guestLinks domain.GuestLinkSigner, validatePOSProducts bool, logger *zap.Logger) *OrderService {
 	return &OrderService{
-		repo:         repo,
-		eventService: eventService,
-		logger:       logger.Named("order_service"),
+		repo:                repo,
+		eventService:        eventService,
+		products:            products,
+		stock:               stock,
+		guestLinks:          guestLinks,
+		validatePOSProducts: validatePOSProducts,
+		logger:              logger.Named("order_service"),
+	}
+}
+
+// validateProducts rejects items whose product does not exist, listing every
+// unknown product ID in an *UnknownProductsError, then items whose quantity
+// breaks the product's order quantity rule with an *OrderQuantityError. Items
+// for bundles get the bundle's components, so their stock is what is reserved.
+func (s *OrderService) validateProducts(ctx context.Context, items []domain.OrderItem) error {
+	if s.products == nil {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(items))
+	productIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		if item.ProductID == "" {
+			return errors.New("product ID is required for every item")
+		}
+		if !seen[item.ProductID] {
+			seen[item.ProductID] = true
+			productIDs = append(productIDs, item.ProductID)
+		}
+	}
+
+	lookup, err := s.products.LookupProducts(ctx, productIDs)
+	if err != nil {
+		return fmt.Errorf("failed to validate products: %w", err)
+	}
+	if len(lookup.MissingIDs) > 0 {
+		return &domain.UnknownProductsError{ProductIDs: lookup.MissingIDs}
+	}
+	for i, item := range items {
+		if err := lookup.QuantityRules[item.ProductID].Check(item.ProductID, item.Quantity); err != nil {
+			return err
+		}
+		items[i].Components = lookup.Bundles[item.ProductID]
+	}
+	return nil
+}
+
+// createAndReserve stores a new order and reserves its stock as one saga.
+// The order insert and the reservation run in the same MongoDB transaction,
+// which only commits once the inventory service has reserved every item, so
+// a failed reservation leaves no order behind. The reservation itself lives
+// in another service and cannot join the transaction: if it fails with an
+// unknown outcome, or the commit fails after it succeeded, it is compensated
+// by releasing whatever the inventory service holds for the order.
+func (s *OrderService) createAndReserve(ctx context.Context, order *domain.Order) error {
+	if s.stock == nil {
+		return s.repo.Create(ctx, order)
+	}
+
+	reserveAttempted := false
+	err := s.repo.CreateWithStep(ctx, order, func(ctx context.Context) error {
+		reserveAttempted = true
+		return s.stock.ReserveOrder(ctx, order)
+	})
+	if err == nil {
+		return nil
 	}
+
+	if reserveAttempted {
+		// Run the compensation even if the caller has gone away, so stock is
+		// not left held for an order that does not exist
+		releaseCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+		defer cancel()
+		released, releaseErr := s.stock.ReleaseOrder(releaseCtx, order)
+		if releaseErr != nil {
+			s.logger.Error("Failed to release stock of an order that was not created",
+				zap.String("order_id", order.ID),
+				zap.Error(releaseErr),
+			)
+		} else if released > 0 {
+			s.logger.Info("Released stock of an order that was not created",
+				zap.String("order_id", order.ID),
+				zap.Int("reservations", released),
+			)
+		}
+	}
+
+	return err
 }
 
 // CreateOrder creates a new order
@@ -39,10 +135,22 @@ func (s *OrderService) CreateOrder(ctx context.Context, userID string, items []d
 		return nil, errors.New("order must have at least one item")
 	}
 
+	if err := s.validateProducts(ctx, items); err != nil {
+		return nil, err
+	}
+
 	order := domain.NewOrder(userID, items, shippingAddr, billingAddr)
-	if err := s.repo.Create(ctx, order); err != nil {
+	if err := s.placeOrder(ctx, order); err != nil {
 		return nil, err
 	}
+	return order, nil
+}
+
+// placeOrder stores a validated order with its stock reserved and announces it
+func (s *OrderService) placeOrder(ctx context.Context, order *domain.Order) error {
+	if err := s.createAndReserve(ctx, order); err != nil {
+		return err
+	}
 
 	// Publish order created event
 	if s.eventService != nil {
@@ -57,7 +165,7 @@ func (s *OrderService) CreateOrder(ctx context.Context, userID string, items []d
 		}
 	}
 
-	return order, nil
+	return nil
 }
 
 // CreatePOSOrder creates a new order from a Point of Sale terminal
@@ -80,8 +188,14 @@ func (s *OrderService) CreatePOSOrder(ctx context.Context, userID string, items
 		return nil, errors.New("location ID is required for POS orders")
 	}
 
+	if s.validatePOSProducts {
+		if err := s.validateProducts(ctx, items); err != nil {
+			return nil, err
+		}
+	}
+
 	order := domain.NewOrderWithSource(userID, items, shippingAddr, billingAddr, domain.SourcePOS, locationID, staffID)
-	if err := s.repo.Create(ctx, order); err != nil {
+	if err := s.createAndReserve(ctx, order); err != nil {
 		return nil, err
 	}
 
@@ -119,7 +233,7 @@ func (s *OrderService) ProcessQuickPOSTransaction(ctx context.Context, userID st
 	}
 
 	// Add payment immediately
-	order.AddPayment(paymentMethod, paymentTransactionID, paymentAmount)
+	order.AddPayment(paymentMethod, paymentTransactionID, paymentAmount, staffID)
 	
 	// Update the order with payment information
 	if err := s.repo.Update(ctx, order); err != nil {
@@ -199,8 +313,9 @@ func (s *OrderService) DeleteOrder(ctx context.Context, id string) error {
 	return s.repo.Delete(ctx, id)
 }
 
-// ListOrders returns all orders with optional filtering and pagination
-func (s *OrderService) ListOrders(ctx context.Context, status string, limit, offset int) ([]*domain.Order, error) {
+// ListOrders returns a page of orders with optional filtering, along with the
+// total number of matching orders
+func (s *OrderService) ListOrders(ctx context.Context, status string, limit, offset int) ([]*domain.Order, int64, error) {
 	s.logger.Debug("Listing orders",
 		zap.String("status", status),
 		zap.Int("limit", limit),
@@ -216,14 +331,23 @@ func (s *OrderService) ListOrders(ctx context.Context, status string, limit, off
 		filter["status"] = status
 	}
 	
-	return s.repo.List(ctx, filter, limit, offset)
+	orders, err := s.repo.List(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := s.repo.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	return orders, total, nil
 }
 
-// UpdateOrderStatus updates the status of an order
-func (s *OrderService) UpdateOrderStatus(ctx context.Context, orderID string, status domain.OrderStatus) error {
+// UpdateOrderStatus updates the status of an order on behalf of changedBy
+func (s *OrderService) UpdateOrderStatus(ctx context.Context, orderID string, status domain.OrderStatus, changedBy string) error {
 	s.logger.Info("Updating order status",
 		zap.String("id", orderID),
 		zap.String("status", string(status)),
+		zap.String("changed_by", changedBy),
 	)
 	
 	order, err := s.repo.GetByID(ctx, orderID)
@@ -236,10 +360,16 @@ func (s *OrderService) UpdateOrderStatus(ctx context.Context, orderID string, st
 	}
 	
 	previousStatus := order.Status
-	err = order.UpdateStatus(status)
+	err = order.UpdateStatus(status, changedBy)
 	if err != nil {
 		return err
 	}
+
+	if status == domain.StatusPaid {
+		if err := s.fulfillStock(ctx, order); err != nil {
+			return err
+		}
+	}
 	
 	// Use optimistic locking for concurrent updates
 	expectedVersion := order.Version - 1 // Version was incremented by UpdateStatus
@@ -247,6 +377,9 @@ func (s *OrderService) UpdateOrderStatus(ctx context.Context, orderID string, st
 	if err != nil {
 		return err
 	}
+	if status == domain.StatusCancelled {
+		s.releaseStock(ctx, order)
+	}
 	
 	// Publish status change event
 	if s.eventService != nil {
@@ -258,8 +391,37 @@ func (s *OrderService) UpdateOrderStatus(ctx context.Context, orderID string, st
 	return nil
 }
 
-// AddPaymentToOrder adds payment information to an order
-func (s *OrderService) AddPaymentToOrder(ctx context.Context, orderID, method, transactionID string, amount float64) error {
+// BulkStatusResult is the outcome of one order in a bulk status update
+type BulkStatusResult struct {
+	OrderID string
+	Err     error
+}
+
+// BulkUpdateStatus moves each order to status independently, so orders in an
+// incompatible state fail without affecting the rest. A status change event is
+// published for every order that was updated.
+func (s *OrderService) BulkUpdateStatus(ctx context.Context, orderIDs []string, status domain.OrderStatus, changedBy string) []BulkStatusResult {
+	s.logger.Info("Bulk updating order status",
+		zap.Int("order_count", len(orderIDs)),
+		zap.String("status", string(status)),
+	)
+
+	results := make([]BulkStatusResult, 0, len(orderIDs))
+	for _, orderID := range orderIDs {
+		err := s.UpdateOrderStatus(ctx, orderID, status, changedBy)
+		if err != nil {
+			s.logger.Warn("Bulk status update failed for order",
+				zap.String("id", orderID),
+				zap.Error(err),
+			)
+		}
+		results = append(results, BulkStatusResult{OrderID: orderID, Err: err})
+	}
+	return results
+}
+
+// AddPaymentToOrder adds payment information, recorded by recordedBy, to an order
+func (s *OrderService) AddPaymentToOrder(ctx context.Context, orderID, method, transactionID string, amount float64, recordedBy string) error {
 	s.logger.Info("Adding payment to order",
 		zap.String("id", orderID),
 		zap.String("method", method),
@@ -276,10 +438,14 @@ func (s *OrderService) AddPaymentToOrder(ctx context.Context, orderID, method, t
 		return errors.New("order not found")
 	}
 	
-	err = order.AddPayment(method, transactionID, amount)
+	err = order.AddPayment(method, transactionID, amount, recordedBy)
 	if err != nil {
 		return err
 	}
+
+	if err := s.fulfillStock(ctx, order); err != nil {
+		return err
+	}
 	
 	// Use optimistic locking for concurrent updates
 	expectedVersion := order.Version - 1 // Version was incremented by AddPayment
@@ -298,8 +464,77 @@ func (s *OrderService) AddPaymentToOrder(ctx context.Context, orderID, method, t
 	return nil
 }
 
-// AddTrackingCodeToOrder adds a tracking code to an order
-func (s *OrderService) AddTrackingCodeToOrder(ctx context.Context, orderID, trackingCode string) error {
+// fulfillStock deducts a newly paid order's items from inventory. A shortage
+// fails the payment so it is not confirmed for stock that no longer exists.
+// POS orders are left alone: their stock is adjusted at the till.
+func (s *OrderService) fulfillStock(ctx context.Context, order *domain.Order) error {
+	if s.stock == nil || order.IsPOSOrder() {
+		return nil
+	}
+	if err := s.stock.FulfillOrder(ctx, order); err != nil {
+		s.logger.Error("Failed to fulfill order stock",
+			zap.String("order_id", order.ID),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
+
+// releaseStock gives back the stock reserved for a cancelled order. A failure
+// is only logged: the order is cancelled either way, and reservations that
+// are not released expire.
+func (s *OrderService) releaseStock(ctx context.Context, order *domain.Order) {
+	if s.stock == nil || order.IsPOSOrder() {
+		return
+	}
+	released, err := s.stock.ReleaseOrder(ctx, order)
+	if err != nil {
+		s.logger.Warn("Failed to release stock of cancelled order",
+			zap.String("order_id", order.ID),
+			zap.Error(err),
+		)
+		return
+	}
+	s.logger.Info("Released stock of cancelled order",
+		zap.String("order_id", order.ID),
+		zap.Int("reservations", released),
+	)
+}
+
+// AddOrderNote appends a note by authorID to an order, attached to one of its
+// items when productID is set. Earlier notes are kept.
+func (s *OrderService) AddOrderNote(ctx context.Context, orderID, authorID, text, productID string) (*domain.Order, error) {
+	s.logger.Info("Adding note to order",
+		zap.String("id", orderID),
+		zap.String("author_id", authorID),
+		zap.String("product_id", productID),
+	)
+	
+	order, err := s.repo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	
+	if order == nil {
+		return nil, errors.New("order not found")
+	}
+	
+	note, err := order.AddNote(authorID, text, productID)
+	if err != nil {
+		return nil, err
+	}
+	
+	if err := s.repo.AppendNote(ctx, orderID, note); err != nil {
+		return nil, err
+	}
+	
+	return s.repo.GetByID(ctx, orderID)
+}
+
+// AddTrackingCodeToOrder adds a tracking code to an order, marking it
+// shipped on behalf of changedBy
+func (s *OrderService) AddTrackingCodeToOrder(ctx context.Context, orderID, trackingCode, changedBy string) error {
 	s.logger.Info("Adding tracking code to order",
 		zap.String("id", orderID),
 		zap.String("tracking_code", trackingCode),
@@ -314,7 +549,7 @@ func (s *OrderService) AddTrackingCodeToOrder(ctx context.Context, orderID, trac
 		return errors.New("order not found")
 	}
 	
-	err = order.AddTrackingCode(trackingCode)
+	err = order.AddTrackingCode(trackingCode, changedBy)
 	if err != nil {
 		return err
 	}
@@ -336,9 +571,50 @@ func (s *OrderService) AddTrackingCodeToOrder(ctx context.Context, orderID, trac
 	return nil
 }
 
-// CancelOrder cancels an order
-func (s *OrderService) CancelOrder(ctx context.Context, orderID string) error {
-	s.logger.Info("Cancelling order", zap.String("id", orderID))
+// RecordShipment records a shipment of some or all of a paid order's items.
+// The order moves to SHIPPED once every item has shipped.
+func (s *OrderService) RecordShipment(ctx context.Context, orderID, trackingCode string, items []domain.ShipmentItem, shippedBy string) (*domain.Order, *domain.Shipment, error) {
+	s.logger.Info("Recording shipment for order",
+		zap.String("id", orderID),
+		zap.String("tracking_code", trackingCode),
+		zap.Int("item_count", len(items)),
+	)
+
+	order, err := s.repo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if order == nil {
+		return nil, nil, errors.New("order not found")
+	}
+
+	previousStatus := order.Status
+	shipment, err := order.AddShipment(trackingCode, items, shippedBy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	expectedVersion := order.Version - 1 // Version was incremented by AddShipment
+	if err := s.repo.UpdateWithOptimisticLock(ctx, order, expectedVersion); err != nil {
+		return nil, nil, err
+	}
+
+	if order.Status != previousStatus && s.eventService != nil {
+		if err := s.eventService.PublishOrderStatusChanged(ctx, order, previousStatus); err != nil {
+			s.logger.Warn("Failed to publish order status changed event", zap.Error(err))
+		}
+	}
+
+	return order, shipment, nil
+}
+
+// CancelOrder cancels an order on behalf of changedBy
+func (s *OrderService) CancelOrder(ctx context.Context, orderID, changedBy string) error {
+	s.logger.Info("Cancelling order",
+		zap.String("id", orderID),
+		zap.String("changed_by", changedBy),
+	)
 	
 	order, err := s.repo.GetByID(ctx, orderID)
 	if err != nil {
@@ -349,19 +625,26 @@ func (s *OrderService) CancelOrder(ctx context.Context, orderID string) error {
 		return errors.New("order not found")
 	}
 	
+	return s.cancel(ctx, order, "", changedBy)
+}
+
+// cancel cancels a loaded order, records the reason when one is given,
+// releases its stock and publishes the cancellation
+func (s *OrderService) cancel(ctx context.Context, order *domain.Order, reason, changedBy string) error {
 	previousStatus := order.Status
-	err = order.Cancel()
-	if err != nil {
+	if err := order.Cancel(changedBy); err != nil {
 		return err
 	}
+	order.CancelReason = reason
 	
 	// Use optimistic locking for concurrent updates
 	expectedVersion := order.Version - 1 // Version was incremented by Cancel
-	err = s.repo.UpdateWithOptimisticLock(ctx, order, expectedVersion)
-	if err != nil {
+	if err := s.repo.UpdateWithOptimisticLock(ctx, order, expectedVersion); err != nil {
 		return err
 	}
 	
+	s.releaseStock(ctx, order)
+	
 	// Publish order cancellation events
 	if s.eventService != nil {
 		// Publish status changed event
@@ -378,6 +661,46 @@ func (s *OrderService) CancelOrder(ctx context.Context, orderID string) error {
 	return nil
 }
 
+// CancelUnpaidOrders cancels online orders still awaiting payment that were
+// created before cutoff, at most limit of them, releasing their reserved
+// stock. Orders paid or changed in the meantime are skipped. It returns the
+// number of orders cancelled.
+func (s *OrderService) CancelUnpaidOrders(ctx context.Context, cutoff time.Time, limit int) (int, error) {
+	orders, err := s.repo.ListAwaitingPayment(ctx, cutoff, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list unpaid orders: %w", err)
+	}
+	
+	cancelled := 0
+	for _, order := range orders {
+		if ctx.Err() != nil {
+			return cancelled, ctx.Err()
+		}
+		
+		// Shutdown stops the pass between orders, never halfway through
+		// cancelling one and releasing its stock
+		if err := s.cancel(context.WithoutCancel(ctx), order, domain.CancelReasonPaymentTimeout, ""); err != nil {
+			if errors.Is(err, domain.ErrOptimisticLockFailed) {
+				// Paid or otherwise changed since it was listed
+				continue
+			}
+			s.logger.Error("Failed to cancel unpaid order",
+				zap.String("order_id", order.ID),
+				zap.Error(err),
+			)
+			continue
+		}
+		
+		s.logger.Info("Cancelled unpaid order",
+			zap.String("order_id", order.ID),
+			zap.Time("created_at", order.CreatedAt),
+		)
+		cancelled++
+	}
+	
+	return cancelled, nil
+}
+
 // CountOrdersByStatus counts orders with a specific status
 func (s *OrderService) CountOrdersByStatus(ctx context.Context, status string) (int64, error) {
 	s.logger.Debug("Counting orders by status", zap.String("status", status))
@@ -389,3 +712,11 @@ func (s *OrderService) CountOrdersByStatus(ctx context.Context, status string) (
 	
 	return s.repo.Count(ctx, filter)
 }
+
+// GetOrderSummary counts the non-cancelled orders created in [from, to) and sums their revenue
+func (s *OrderService) GetOrderSummary(ctx context.Context, from, to time.Time) (*domain.OrderSummary, error) {
+	if !to.After(from) {
+		return nil, errors.New("end of period must be after its start")
+	}
+	return s.repo.Summarize(ctx, from, to)
+}
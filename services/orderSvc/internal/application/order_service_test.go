@@ -0,0 +1,60 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// newTestOrderService returns an order service over repo without events,
+// product validation or stock handling
+func newTestOrderService(repo domain.OrderRepository) *OrderService {
+	return NewOrderService(repo, nil, nil, nil, domain.GuestLinkSigner{}, false, zap.NewNop())
+}
+
+func TestListOrdersTotalMatchesAcrossPages(t *testing.T) {
+	repo := newMemoryOrderRepository()
+	for i := 0; i < 7; i++ {
+		status := domain.StatusPending
+		if i%3 == 0 {
+			status = domain.StatusShipped
+		}
+		repo.put(&domain.Order{ID: fmt.Sprintf("order-%d", i), Status: status})
+	}
+	service := newTestOrderService(repo)
+
+	seen := make(map[string]bool)
+	for offset := 0; offset < 6; offset += 2 {
+		orders, total, err := service.ListOrders(context.Background(), string(domain.StatusPending), 2, offset)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if total != 4 {
+			t.Fatalf("offset %d: total = %d, want 4", offset, total)
+		}
+		for _, order := range orders {
+			if order.Status != domain.StatusPending {
+				t.Fatalf("order %s has status %s", order.ID, order.Status)
+			}
+			if seen[order.ID] {
+				t.Fatalf("order %s listed twice", order.ID)
+			}
+			seen[order.ID] = true
+		}
+	}
+	if len(seen) != 4 {
+		t.Fatalf("pages held %d orders, want 4", len(seen))
+	}
+
+	_, total, err := service.ListOrders(context.Background(), "", 2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 7 {
+		t.Fatalf("unfiltered total = %d, want 7", total)
+	}
+}
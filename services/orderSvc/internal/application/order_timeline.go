@@ -0,0 +1,51 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// TimelineService assembles the history of an order from the records kept
+// by the order and return services
+type TimelineService struct {
+	orders  domain.OrderRepository
+	returns domain.ReturnRepository
+	logger  *zap.Logger
+}
+
+// NewTimelineService creates a new timeline service
+func NewTimelineService(orders domain.OrderRepository, returns domain.ReturnRepository, logger *zap.Logger) *TimelineService {
+	return &TimelineService{
+		orders:  orders,
+		returns: returns,
+		logger:  logger.Named("timeline_service"),
+	}
+}
+
+// GetOrderTimeline returns the order and everything that happened to it,
+// oldest first: its creation, status changes, payment, shipments, notes and
+// returns
+func (s *TimelineService) GetOrderTimeline(ctx context.Context, orderID string) (*domain.Order, []domain.TimelineEntry, error) {
+	order, err := s.orders.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if order == nil {
+		return nil, nil, errors.New("order not found")
+	}
+
+	returns, err := s.returns.ListByOrderID(ctx, orderID)
+	if err != nil {
+		s.logger.Error("Failed to list returns of order",
+			zap.String("order_id", orderID),
+			zap.Error(err),
+		)
+		return nil, nil, err
+	}
+
+	return order, domain.BuildOrderTimeline(order, returns), nil
+}
@@ -0,0 +1,74 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+func TestGetOrderTimelineMergesReturnsInOrder(t *testing.T) {
+	ctx := context.Background()
+	created := time.Date(2024, 5, 10, 9, 0, 0, 0, time.UTC)
+	orders := newMemoryOrderRepository(&domain.Order{
+		ID:        "order-1",
+		UserID:    "customer-1",
+		CreatedAt: created,
+		StatusHistory: []domain.StatusChange{
+			{From: domain.StatusCreated, To: domain.StatusPending, ChangedAt: created.Add(time.Minute)},
+		},
+		NoteLog: []domain.OrderNote{
+			{ID: "note-1", AuthorID: "staff-1", Text: "Gift wrap", CreatedAt: created.Add(3 * time.Hour)},
+		},
+	})
+	returns := newMemoryReturnRepository()
+	for _, ret := range []*domain.Return{
+		{ID: "return-1", OrderID: "order-1", Status: domain.ReturnStatusRequested, CreatedAt: created.Add(2 * time.Hour)},
+		{ID: "return-2", OrderID: "order-2", Status: domain.ReturnStatusRequested, CreatedAt: created.Add(time.Hour)},
+	} {
+		if err := returns.Create(ctx, ret); err != nil {
+			t.Fatalf("Create return: %v", err)
+		}
+	}
+	svc := NewTimelineService(orders, returns, zap.NewNop())
+
+	order, timeline, err := svc.GetOrderTimeline(ctx, "order-1")
+	if err != nil {
+		t.Fatalf("GetOrderTimeline: %v", err)
+	}
+	if order.ID != "order-1" {
+		t.Errorf("order = %q, want order-1", order.ID)
+	}
+
+	want := []struct {
+		entryType   domain.TimelineEntryType
+		referenceID string
+	}{
+		{domain.TimelineOrderCreated, ""},
+		{domain.TimelineStatusChanged, ""},
+		{domain.TimelineReturnRequested, "return-1"},
+		{domain.TimelineNoteAdded, "note-1"},
+	}
+	if len(timeline) != len(want) {
+		t.Fatalf("timeline = %+v, want %d entries", timeline, len(want))
+	}
+	for i, w := range want {
+		if timeline[i].Type != w.entryType {
+			t.Errorf("entry %d type = %s, want %s", i, timeline[i].Type, w.entryType)
+		}
+		if w.referenceID != "" && timeline[i].ReferenceID != w.referenceID {
+			t.Errorf("entry %d reference = %q, want %q", i, timeline[i].ReferenceID, w.referenceID)
+		}
+	}
+}
+
+func TestGetOrderTimelineUnknownOrder(t *testing.T) {
+	svc := NewTimelineService(newMemoryOrderRepository(), newMemoryReturnRepository(), zap.NewNop())
+
+	if _, _, err := svc.GetOrderTimeline(context.Background(), "missing"); err == nil {
+		t.Fatal("GetOrderTimeline of an unknown order succeeded")
+	}
+}
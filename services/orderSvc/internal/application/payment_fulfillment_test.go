@@ -0,0 +1,95 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// recordingStock is a stock fulfiller that records the orders it fulfils and
+// releases, and fails fulfilment with fulfillErr
+type recordingStock struct {
+	domain.StockFulfiller
+	fulfilled  []string
+	released   []string
+	fulfillErr error
+}
+
+func (s *recordingStock) FulfillOrder(ctx context.Context, order *domain.Order) error {
+	if s.fulfillErr != nil {
+		return s.fulfillErr
+	}
+	s.fulfilled = append(s.fulfilled, order.ID)
+	return nil
+}
+
+func (s *recordingStock) ReleaseOrder(ctx context.Context, order *domain.Order) (int, error) {
+	s.released = append(s.released, order.ID)
+	return 1, nil
+}
+
+func newPendingOrder(t *testing.T, repo *memoryOrderRepository) *domain.Order {
+	t.Helper()
+	order := domain.NewOrder("user-1", []domain.OrderItem{{ProductID: "product-1", Quantity: 2, Price: 5}}, domain.Address{}, domain.Address{})
+	if err := order.UpdateStatus(domain.StatusPending, "user-1"); err != nil {
+		t.Fatal(err)
+	}
+	repo.put(order)
+	return order
+}
+
+func TestPaymentFulfilsOrderStock(t *testing.T) {
+	repo := newMemoryOrderRepository()
+	order := newPendingOrder(t, repo)
+	stock := &recordingStock{}
+	service := NewOrderService(repo, nil, nil, stock, domain.GuestLinkSigner{}, false, zap.NewNop())
+
+	if err := service.AddPaymentToOrder(context.Background(), order.ID, "card", "tx-1", order.TotalAmount, "user-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(stock.fulfilled) != 1 || stock.fulfilled[0] != order.ID {
+		t.Fatalf("fulfilled orders = %v, want [%s]", stock.fulfilled, order.ID)
+	}
+	if got := repo.get(order.ID).Status; got != domain.StatusPaid {
+		t.Fatalf("status = %s, want %s", got, domain.StatusPaid)
+	}
+}
+
+func TestPaymentFailsWhenStockIsGone(t *testing.T) {
+	repo := newMemoryOrderRepository()
+	order := newPendingOrder(t, repo)
+	stock := &recordingStock{fulfillErr: fmt.Errorf("%w: product-1 at store-1", domain.ErrInsufficientStock)}
+	service := NewOrderService(repo, nil, nil, stock, domain.GuestLinkSigner{}, false, zap.NewNop())
+
+	err := service.AddPaymentToOrder(context.Background(), order.ID, "card", "tx-1", order.TotalAmount, "user-1")
+	if !errors.Is(err, domain.ErrInsufficientStock) {
+		t.Fatalf("err = %v, want ErrInsufficientStock", err)
+	}
+	if got := repo.get(order.ID).Status; got != domain.StatusPending {
+		t.Fatalf("status = %s, want the order left %s", got, domain.StatusPending)
+	}
+}
+
+func TestCancelOrderReleasesStockOnce(t *testing.T) {
+	repo := newMemoryOrderRepository()
+	order := newPendingOrder(t, repo)
+	stock := &recordingStock{}
+	service := NewOrderService(repo, nil, nil, stock, domain.GuestLinkSigner{}, false, zap.NewNop())
+
+	if err := service.CancelOrder(context.Background(), order.ID, "user-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(stock.released) != 1 || stock.released[0] != order.ID {
+		t.Fatalf("released orders = %v, want one release call for %s", stock.released, order.ID)
+	}
+	if got := repo.get(order.ID).Status; got != domain.StatusCancelled {
+		t.Fatalf("status = %s, want %s", got, domain.StatusCancelled)
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"time"
 	"math/rand"
 
+
 	inventoryclient "github.com/leonvanderhaeghen/stockplatform/pkg/clients/inventory"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/identity"
 	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
 )
 
@@ -95,6 +97,10 @@ func (s *POSTransactionService) ProcessTransaction(
 	}
 	defer inventoryClient.Close()
 
+	// The inventory service only accepts stock adjustments from staff roles, so
+	// pass on the role of the staff member running the transaction
+	ctx = identity.ForwardRole(ctx)
+
 	// Process each adjustment item using proper client abstraction with primitive parameters
 	allSuccess := true
 	adjustmentResults := make([]*ProcessedItem, 0, len(adjustmentItems))
@@ -0,0 +1,199 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// fakeCatalog knows the products in rules and reports the others missing.
+// Products in bundles are bundles of the listed components.
+type fakeCatalog struct {
+	rules   map[string]domain.OrderQuantityRule
+	bundles map[string][]domain.OrderItemComponent
+	lookups int
+}
+
+func (c *fakeCatalog) LookupProducts(ctx context.Context, productIDs []string) (*domain.ProductLookup, error) {
+	c.lookups++
+	lookup := &domain.ProductLookup{QuantityRules: make(map[string]domain.OrderQuantityRule)}
+	for _, id := range productIDs {
+		rule, ok := c.rules[id]
+		if !ok {
+			lookup.MissingIDs = append(lookup.MissingIDs, id)
+			continue
+		}
+		lookup.QuantityRules[id] = rule
+		if components, ok := c.bundles[id]; ok {
+			if lookup.Bundles == nil {
+				lookup.Bundles = make(map[string][]domain.OrderItemComponent)
+			}
+			lookup.Bundles[id] = components
+		}
+	}
+	return lookup, nil
+}
+
+func newCatalogOrderService(repo domain.OrderRepository, catalog domain.ProductCatalog, validatePOSProducts bool) *OrderService {
+	return NewOrderService(repo, nil, catalog, nil, domain.GuestLinkSigner{}, validatePOSProducts, zap.NewNop())
+}
+
+func mixedItems() []domain.OrderItem {
+	return []domain.OrderItem{
+		{ProductID: "product-1", Quantity: 1, Price: 10},
+		{ProductID: "product-typo", Quantity: 2, Price: 5},
+	}
+}
+
+func TestCreateOrderRejectsUnknownProducts(t *testing.T) {
+	repo := newMemoryOrderRepository()
+	catalog := &fakeCatalog{rules: map[string]domain.OrderQuantityRule{"product-1": {}}}
+	service := newCatalogOrderService(repo, catalog, false)
+
+	_, err := service.CreateOrder(context.Background(), "user-1", mixedItems(), domain.Address{}, domain.Address{})
+
+	var unknown *domain.UnknownProductsError
+	if !errors.As(err, &unknown) {
+		t.Fatalf("err = %v, want *UnknownProductsError", err)
+	}
+	if !reflect.DeepEqual(unknown.ProductIDs, []string{"product-typo"}) {
+		t.Fatalf("unknown products = %v, want [product-typo]", unknown.ProductIDs)
+	}
+	if len(repo.orders) != 0 {
+		t.Fatal("no order should be stored")
+	}
+}
+
+func TestCreateOrderWithKnownProducts(t *testing.T) {
+	repo := newMemoryOrderRepository()
+	catalog := &fakeCatalog{rules: map[string]domain.OrderQuantityRule{"product-1": {}, "product-2": {}}}
+	service := newCatalogOrderService(repo, catalog, false)
+
+	order, err := service.CreateOrder(context.Background(), "user-1", []domain.OrderItem{
+		{ProductID: "product-1", Quantity: 1, Price: 10},
+		{ProductID: "product-2", Quantity: 1, Price: 5},
+		{ProductID: "product-1", Quantity: 1, Price: 10},
+	}, domain.Address{}, domain.Address{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if repo.get(order.ID) == nil {
+		t.Fatal("order should be stored")
+	}
+}
+
+func TestCreatePOSOrderProductValidationIsToggleable(t *testing.T) {
+	catalog := &fakeCatalog{rules: map[string]domain.OrderQuantityRule{"product-1": {}}}
+
+	repo := newMemoryOrderRepository()
+	service := newCatalogOrderService(repo, catalog, false)
+	if _, err := service.CreatePOSOrder(context.Background(), "user-1", mixedItems(), domain.Address{}, domain.Address{}, "store-1", "staff-1"); err != nil {
+		t.Fatalf("unvalidated POS order: %v", err)
+	}
+	if catalog.lookups != 0 {
+		t.Fatal("the catalog should not be asked when POS validation is off")
+	}
+
+	service = newCatalogOrderService(newMemoryOrderRepository(), catalog, true)
+	_, err := service.CreatePOSOrder(context.Background(), "user-1", mixedItems(), domain.Address{}, domain.Address{}, "store-1", "staff-1")
+	var unknown *domain.UnknownProductsError
+	if !errors.As(err, &unknown) {
+		t.Fatalf("err = %v, want *UnknownProductsError", err)
+	}
+}
+
+func TestCreateOrderEnforcesOrderQuantityRules(t *testing.T) {
+	catalog := &fakeCatalog{rules: map[string]domain.OrderQuantityRule{
+		"case-pack": {Min: 12, Max: 120, Increment: 6},
+	}}
+
+	tests := []struct {
+		name     string
+		quantity int32
+		wantErr  bool
+	}{
+		{name: "below minimum", quantity: 6, wantErr: true},
+		{name: "above maximum", quantity: 126, wantErr: true},
+		{name: "not a multiple", quantity: 15, wantErr: true},
+		{name: "at minimum", quantity: 12},
+		{name: "at maximum", quantity: 120},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMemoryOrderRepository()
+			service := newCatalogOrderService(repo, catalog, false)
+
+			_, err := service.CreateOrder(context.Background(), "user-1", []domain.OrderItem{
+				{ProductID: "case-pack", Quantity: tt.quantity, Price: 2},
+			}, domain.Address{}, domain.Address{})
+
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("quantity %d: %v", tt.quantity, err)
+				}
+				return
+			}
+			var quantityErr *domain.OrderQuantityError
+			if !errors.As(err, &quantityErr) {
+				t.Fatalf("err = %v, want *OrderQuantityError", err)
+			}
+			if quantityErr.ProductID != "case-pack" || quantityErr.Quantity != tt.quantity {
+				t.Fatalf("error names product %s quantity %d", quantityErr.ProductID, quantityErr.Quantity)
+			}
+			if len(repo.orders) != 0 {
+				t.Fatal("no order should be stored")
+			}
+		})
+	}
+}
+
+func TestCreateOrderReservesBundleComponents(t *testing.T) {
+	repo := newMemoryOrderRepository()
+	components := []domain.OrderItemComponent{
+		{ProductID: "frame", ProductSKU: "FRAME", Quantity: 1},
+		{ProductID: "screw", ProductSKU: "SCREW", Quantity: 8},
+	}
+	catalog := &fakeCatalog{
+		rules:   map[string]domain.OrderQuantityRule{"kit": {}, "lamp": {}},
+		bundles: map[string][]domain.OrderItemComponent{"kit": components},
+	}
+	stock := &bundleRecordingStock{}
+	service := NewOrderService(repo, nil, catalog, stock, domain.GuestLinkSigner{}, false, zap.NewNop())
+
+	order, err := service.CreateOrder(context.Background(), "user-1", []domain.OrderItem{
+		{ProductID: "kit", Quantity: 2, Price: 30},
+		{ProductID: "lamp", Quantity: 1, Price: 20},
+	}, domain.Address{}, domain.Address{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stored := repo.get(order.ID)
+	if !reflect.DeepEqual(stored.Items[0].Components, components) || stored.Items[1].Components != nil {
+		t.Fatalf("stored components = %+v / %+v, want the kit's only", stored.Items[0].Components, stored.Items[1].Components)
+	}
+	want := []domain.OrderItem{
+		{ProductID: "frame", ProductSKU: "FRAME", Quantity: 2},
+		{ProductID: "screw", ProductSKU: "SCREW", Quantity: 16},
+		{ProductID: "lamp", Quantity: 1},
+	}
+	if !reflect.DeepEqual(stock.items, want) {
+		t.Fatalf("reserved %+v, want %+v", stock.items, want)
+	}
+}
+
+// bundleRecordingStock records the stock items of the orders it reserves
+type bundleRecordingStock struct {
+	domain.StockFulfiller
+	items []domain.OrderItem
+}
+
+func (s *bundleRecordingStock) ReserveOrder(ctx context.Context, order *domain.Order) error {
+	s.items = order.StockItems()
+	return nil
+}
@@ -0,0 +1,172 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/identity"
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// ReturnService handles returns (RMAs) of delivered order items
+type ReturnService struct {
+	returns   domain.ReturnRepository
+	orders    domain.OrderRepository
+	restocker domain.ReturnRestocker
+	logger    *zap.Logger
+}
+
+// NewReturnService creates a new return service
+func NewReturnService(returns domain.ReturnRepository, orders domain.OrderRepository, restocker domain.ReturnRestocker, logger *zap.Logger) *ReturnService {
+	return &ReturnService{
+		returns:   returns,
+		orders:    orders,
+		restocker: restocker,
+		logger:    logger.Named("return_service"),
+	}
+}
+
+// CreateReturn opens a return for items of a shipped or delivered order. Every
+// line must be a product on the order, and the quantity per product may not
+// exceed what was ordered minus what earlier returns already cover.
+func (s *ReturnService) CreateReturn(ctx context.Context, orderID string, lines []domain.ReturnLine, reason string) (*domain.Return, error) {
+	s.logger.Info("Creating return",
+		zap.String("order_id", orderID),
+		zap.Int("line_count", len(lines)),
+	)
+
+	if orderID == "" {
+		return nil, errors.New("order ID is required")
+	}
+
+	order, err := s.orders.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil {
+		return nil, errors.New("order not found")
+	}
+	if order.Status != domain.StatusShipped && order.Status != domain.StatusDelivered {
+		return nil, fmt.Errorf("order in status %s cannot be returned", order.Status)
+	}
+
+	existing, err := s.returns.ListByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	alreadyReturned := make(map[string]int32)
+	for _, ret := range existing {
+		if ret.Status == domain.ReturnStatusRejected {
+			continue
+		}
+		for _, line := range ret.Lines {
+			alreadyReturned[line.ProductID] += line.Quantity
+		}
+	}
+
+	ret, err := domain.NewReturn(order, lines, reason, alreadyReturned)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.returns.Create(ctx, ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// GetReturn retrieves a return by ID
+func (s *ReturnService) GetReturn(ctx context.Context, id string) (*domain.Return, error) {
+	if id == "" {
+		return nil, errors.New("return ID is required")
+	}
+	return s.returns.GetByID(ctx, id)
+}
+
+// ListOrderReturns lists the returns of an order
+func (s *ReturnService) ListOrderReturns(ctx context.Context, orderID string) ([]*domain.Return, error) {
+	if orderID == "" {
+		return nil, errors.New("order ID is required")
+	}
+	return s.returns.ListByOrderID(ctx, orderID)
+}
+
+// UpdateReturnStatus moves a return through its workflow. Marking a return as
+// received restocks its lines, sellable or damaged according to their condition;
+// conditions, keyed by product ID, overrides the condition given when the return
+// was requested with what was found on inspection. The status only changes once
+// every line has been restocked.
+func (s *ReturnService) UpdateReturnStatus(ctx context.Context, id string, status domain.ReturnStatus, conditions map[string]domain.ItemCondition) (*domain.Return, error) {
+	s.logger.Info("Updating return status",
+		zap.String("id", id),
+		zap.String("status", string(status)),
+	)
+
+	ret, err := s.GetReturn(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := domain.ValidateReturnTransition(ret.Status, status); err != nil {
+		return nil, err
+	}
+
+	if status == domain.ReturnStatusReceived {
+		for i := range ret.Lines {
+			condition, ok := conditions[ret.Lines[i].ProductID]
+			if !ok || ret.Lines[i].Restocked {
+				continue
+			}
+			if condition != domain.ConditionSellable && condition != domain.ConditionDamaged {
+				return nil, fmt.Errorf("invalid condition %q for product %s", condition, ret.Lines[i].ProductID)
+			}
+			ret.Lines[i].Condition = condition
+		}
+		if err := s.restock(ctx, ret); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ret.UpdateStatus(status); err != nil {
+		return nil, err
+	}
+	if err := s.returns.Update(ctx, ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// restock puts every line that has not been restocked yet back into inventory.
+// Progress is saved even on failure so a retry skips lines already done.
+func (s *ReturnService) restock(ctx context.Context, ret *domain.Return) error {
+	// The inventory service only accepts restocks from staff roles, so pass on
+	// the role of the staff member receiving the return
+	ctx = identity.ForwardRole(ctx)
+
+	var restockErr error
+	for i := range ret.Lines {
+		line := &ret.Lines[i]
+		if line.Restocked {
+			continue
+		}
+		if err := s.restocker.RestockReturn(ctx, ret, *line); err != nil {
+			restockErr = fmt.Errorf("failed to restock product %s: %w", line.ProductID, err)
+			break
+		}
+		line.Restocked = true
+	}
+
+	if restockErr != nil {
+		if err := s.returns.Update(ctx, ret); err != nil {
+			s.logger.Error("Failed to save restock progress",
+				zap.String("return_id", ret.ID),
+				zap.Error(err),
+			)
+		}
+		return restockErr
+	}
+	return nil
+}
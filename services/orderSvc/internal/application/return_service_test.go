@@ -0,0 +1,237 @@
+package application
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/identity"
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// memoryReturnRepository keeps returns in memory
+type memoryReturnRepository struct {
+	mu      sync.Mutex
+	returns map[string]*domain.Return
+	order   []string
+}
+
+func newMemoryReturnRepository() *memoryReturnRepository {
+	return &memoryReturnRepository{returns: make(map[string]*domain.Return)}
+}
+
+func (r *memoryReturnRepository) Create(ctx context.Context, ret *domain.Return) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.returns[ret.ID] = cloneReturn(ret)
+	r.order = append(r.order, ret.ID)
+	return nil
+}
+
+func (r *memoryReturnRepository) GetByID(ctx context.Context, id string) (*domain.Return, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ret, ok := r.returns[id]
+	if !ok {
+		return nil, domain.ErrReturnNotFound
+	}
+	return cloneReturn(ret), nil
+}
+
+func (r *memoryReturnRepository) ListByOrderID(ctx context.Context, orderID string) ([]*domain.Return, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var returns []*domain.Return
+	for _, id := range r.order {
+		if ret := r.returns[id]; ret.OrderID == orderID {
+			returns = append(returns, cloneReturn(ret))
+		}
+	}
+	return returns, nil
+}
+
+func (r *memoryReturnRepository) Update(ctx context.Context, ret *domain.Return) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.returns[ret.ID] = cloneReturn(ret)
+	return nil
+}
+
+func cloneReturn(ret *domain.Return) *domain.Return {
+	copied := *ret
+	copied.Lines = append([]domain.ReturnLine(nil), ret.Lines...)
+	return &copied
+}
+
+// recordingRestocker records restocked lines and the role forwarded with
+// each; failProduct makes that product's restock fail once
+type recordingRestocker struct {
+	restocked   []domain.ReturnLine
+	roles       []string
+	failProduct string
+}
+
+func (r *recordingRestocker) RestockReturn(ctx context.Context, ret *domain.Return, line domain.ReturnLine) error {
+	if line.ProductID == r.failProduct {
+		r.failProduct = ""
+		return context.DeadlineExceeded
+	}
+	r.restocked = append(r.restocked, line)
+	md, _ := metadata.FromOutgoingContext(ctx)
+	r.roles = append(r.roles, md.Get(identity.RoleMetadataKey)...)
+	return nil
+}
+
+func newDeliveredOrder() *domain.Order {
+	order := domain.NewOrder("user-1", []domain.OrderItem{
+		{ProductID: "product-1", Quantity: 2, Price: 10},
+		{ProductID: "product-2", Quantity: 1, Price: 5},
+	}, domain.Address{}, domain.Address{})
+	order.Status = domain.StatusDelivered
+	return order
+}
+
+func TestCreateReturnRejectsOverReturn(t *testing.T) {
+	ctx := context.Background()
+	order := newDeliveredOrder()
+	service := NewReturnService(newMemoryReturnRepository(), newMemoryOrderRepository(order), &recordingRestocker{}, zap.NewNop())
+
+	if _, err := service.CreateReturn(ctx, order.ID, []domain.ReturnLine{{ProductID: "product-1", Quantity: 3}}, "too many"); err == nil {
+		t.Fatal("returning more than was ordered: want an error")
+	}
+
+	if _, err := service.CreateReturn(ctx, order.ID, []domain.ReturnLine{{ProductID: "product-1", Quantity: 1}}, "broken"); err != nil {
+		t.Fatalf("CreateReturn: %v", err)
+	}
+	_, err := service.CreateReturn(ctx, order.ID, []domain.ReturnLine{
+		{ProductID: "product-1", Quantity: 1},
+		{ProductID: "product-1", Quantity: 1},
+	}, "second return")
+	if err == nil || !strings.Contains(err.Error(), "only 1 left to return") {
+		t.Fatalf("err = %v, want the quantity already returned counted", err)
+	}
+
+	if _, err := service.CreateReturn(ctx, order.ID, []domain.ReturnLine{{ProductID: "product-9", Quantity: 1}}, "not ordered"); err == nil {
+		t.Fatal("returning a product that was not ordered: want an error")
+	}
+}
+
+func TestCreateReturnIgnoresRejectedReturns(t *testing.T) {
+	ctx := context.Background()
+	order := newDeliveredOrder()
+	service := NewReturnService(newMemoryReturnRepository(), newMemoryOrderRepository(order), &recordingRestocker{}, zap.NewNop())
+
+	ret, err := service.CreateReturn(ctx, order.ID, []domain.ReturnLine{{ProductID: "product-1", Quantity: 2}}, "unwanted")
+	if err != nil {
+		t.Fatalf("CreateReturn: %v", err)
+	}
+	if _, err := service.UpdateReturnStatus(ctx, ret.ID, domain.ReturnStatusRejected, nil); err != nil {
+		t.Fatalf("reject: %v", err)
+	}
+
+	if _, err := service.CreateReturn(ctx, order.ID, []domain.ReturnLine{{ProductID: "product-1", Quantity: 2}}, "unwanted"); err != nil {
+		t.Fatalf("CreateReturn after a rejected return: %v", err)
+	}
+}
+
+func TestReceivingReturnRestocksByCondition(t *testing.T) {
+	ctx := context.Background()
+	order := newDeliveredOrder()
+	restocker := &recordingRestocker{}
+	service := NewReturnService(newMemoryReturnRepository(), newMemoryOrderRepository(order), restocker, zap.NewNop())
+
+	ret, err := service.CreateReturn(ctx, order.ID, []domain.ReturnLine{
+		{ProductID: "product-1", Quantity: 2},
+		{ProductID: "product-2", Quantity: 1},
+	}, "changed mind")
+	if err != nil {
+		t.Fatalf("CreateReturn: %v", err)
+	}
+	if _, err := service.UpdateReturnStatus(ctx, ret.ID, domain.ReturnStatusApproved, nil); err != nil {
+		t.Fatalf("approve: %v", err)
+	}
+	if len(restocker.restocked) != 0 {
+		t.Fatal("approving must not restock")
+	}
+
+	received, err := service.UpdateReturnStatus(ctx, ret.ID, domain.ReturnStatusReceived, map[string]domain.ItemCondition{
+		"product-2": domain.ConditionDamaged,
+	})
+	if err != nil {
+		t.Fatalf("receive: %v", err)
+	}
+
+	if received.Status != domain.ReturnStatusReceived || received.ReceivedAt == nil {
+		t.Errorf("status = %s, received at %v", received.Status, received.ReceivedAt)
+	}
+	if len(restocker.restocked) != 2 {
+		t.Fatalf("restocked %d lines, want 2", len(restocker.restocked))
+	}
+	conditions := map[string]domain.ItemCondition{}
+	for _, line := range restocker.restocked {
+		conditions[line.ProductID] = line.Condition
+	}
+	if conditions["product-1"] != domain.ConditionSellable || conditions["product-2"] != domain.ConditionDamaged {
+		t.Errorf("restocked conditions = %v, want product-1 sellable and product-2 damaged", conditions)
+	}
+}
+
+func TestReceivingReturnForwardsStaffRole(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(identity.RoleMetadataKey, "STAFF"))
+	order := newDeliveredOrder()
+	restocker := &recordingRestocker{}
+	service := NewReturnService(newMemoryReturnRepository(), newMemoryOrderRepository(order), restocker, zap.NewNop())
+
+	ret, err := service.CreateReturn(ctx, order.ID, []domain.ReturnLine{{ProductID: "product-1", Quantity: 1}}, "changed mind")
+	if err != nil {
+		t.Fatalf("CreateReturn: %v", err)
+	}
+	if _, err := service.UpdateReturnStatus(ctx, ret.ID, domain.ReturnStatusApproved, nil); err != nil {
+		t.Fatalf("approve: %v", err)
+	}
+	if _, err := service.UpdateReturnStatus(ctx, ret.ID, domain.ReturnStatusReceived, nil); err != nil {
+		t.Fatalf("receive: %v", err)
+	}
+
+	if len(restocker.roles) != 1 || restocker.roles[0] != "STAFF" {
+		t.Fatalf("forwarded roles = %v, want the receiving staff member's STAFF", restocker.roles)
+	}
+}
+
+func TestReceivingReturnRetriesOnlyLinesNotRestocked(t *testing.T) {
+	ctx := context.Background()
+	order := newDeliveredOrder()
+	restocker := &recordingRestocker{failProduct: "product-2"}
+	returns := newMemoryReturnRepository()
+	service := NewReturnService(returns, newMemoryOrderRepository(order), restocker, zap.NewNop())
+
+	ret, err := service.CreateReturn(ctx, order.ID, []domain.ReturnLine{
+		{ProductID: "product-1", Quantity: 1},
+		{ProductID: "product-2", Quantity: 1},
+	}, "changed mind")
+	if err != nil {
+		t.Fatalf("CreateReturn: %v", err)
+	}
+	if _, err := service.UpdateReturnStatus(ctx, ret.ID, domain.ReturnStatusApproved, nil); err != nil {
+		t.Fatalf("approve: %v", err)
+	}
+
+	if _, err := service.UpdateReturnStatus(ctx, ret.ID, domain.ReturnStatusReceived, nil); err == nil {
+		t.Fatal("a failed restock must fail the receipt")
+	}
+	stored, _ := returns.GetByID(ctx, ret.ID)
+	if stored.Status != domain.ReturnStatusApproved {
+		t.Errorf("status = %s, want approved until every line is restocked", stored.Status)
+	}
+
+	if _, err := service.UpdateReturnStatus(ctx, ret.ID, domain.ReturnStatusReceived, nil); err != nil {
+		t.Fatalf("retry receive: %v", err)
+	}
+	if len(restocker.restocked) != 2 {
+		t.Errorf("restocked %v, want product-1 restocked only once", restocker.restocked)
+	}
+}
@@ -0,0 +1,81 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// unpaidOrderBatchSize caps the orders cancelled in one pass; a backlog is
+// worked off over consecutive passes
+const unpaidOrderBatchSize = 100
+
+// UnpaidOrderCanceller periodically cancels orders that were not paid within
+// the payment window, so their reservations do not hold stock forever
+type UnpaidOrderCanceller struct {
+	orders   *OrderService
+	window   time.Duration
+	interval time.Duration
+	logger   *zap.Logger
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+// NewUnpaidOrderCanceller creates a canceller that runs every interval and
+// cancels orders still unpaid window after they were created
+func NewUnpaidOrderCanceller(orders *OrderService, window, interval time.Duration, logger *zap.Logger) *UnpaidOrderCanceller {
+	return &UnpaidOrderCanceller{
+		orders:   orders,
+		window:   window,
+		interval: interval,
+		logger:   logger.Named("unpaid_order_canceller"),
+	}
+}
+
+// Start runs a pass immediately and then on every interval until Stop
+func (c *UnpaidOrderCanceller) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+
+		for {
+			c.RunOnce(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop stops the canceller and waits for a running pass to finish
+func (c *UnpaidOrderCanceller) Stop() {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	c.wg.Wait()
+}
+
+// RunOnce cancels the orders whose payment window has passed and returns how
+// many were cancelled
+func (c *UnpaidOrderCanceller) RunOnce(ctx context.Context) int {
+	cancelled, err := c.orders.CancelUnpaidOrders(ctx, time.Now().Add(-c.window), unpaidOrderBatchSize)
+	if err != nil && ctx.Err() == nil {
+		c.logger.Error("Cancelling unpaid orders failed", zap.Error(err))
+	}
+	if cancelled > 0 {
+		c.logger.Info("Cancelled unpaid orders",
+			zap.Int("cancelled", cancelled),
+			zap.Duration("payment_window", c.window),
+		)
+	}
+	return cancelled
+}
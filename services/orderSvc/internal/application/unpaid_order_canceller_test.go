@@ -0,0 +1,98 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// newAgedOrder stores a pending online order created age ago
+func newAgedOrder(t *testing.T, repo *memoryOrderRepository, age time.Duration) *domain.Order {
+	t.Helper()
+	order := domain.NewOrder("user-1", []domain.OrderItem{{ProductID: "product-1", Quantity: 2, Price: 5}}, domain.Address{}, domain.Address{})
+	if err := order.UpdateStatus(domain.StatusPending, "user-1"); err != nil {
+		t.Fatal(err)
+	}
+	order.CreatedAt = time.Now().Add(-age)
+	repo.put(order)
+	return order
+}
+
+func TestUnpaidOrderCancellerCancelsAgedOrders(t *testing.T) {
+	repo := newMemoryOrderRepository()
+	aged := newAgedOrder(t, repo, 2*time.Hour)
+	fresh := newAgedOrder(t, repo, 10*time.Minute)
+	stock := &recordingStock{}
+	service := NewOrderService(repo, nil, nil, stock, domain.GuestLinkSigner{}, false, zap.NewNop())
+	canceller := NewUnpaidOrderCanceller(service, time.Hour, time.Minute, zap.NewNop())
+
+	if cancelled := canceller.RunOnce(context.Background()); cancelled != 1 {
+		t.Fatalf("cancelled = %d, want 1", cancelled)
+	}
+
+	got := repo.get(aged.ID)
+	if got.Status != domain.StatusCancelled {
+		t.Fatalf("aged order status = %s, want %s", got.Status, domain.StatusCancelled)
+	}
+	if got.CancelReason != domain.CancelReasonPaymentTimeout {
+		t.Fatalf("cancel reason = %q, want %q", got.CancelReason, domain.CancelReasonPaymentTimeout)
+	}
+	if len(stock.released) != 1 || stock.released[0] != aged.ID {
+		t.Fatalf("released orders = %v, want [%s]", stock.released, aged.ID)
+	}
+	if status := repo.get(fresh.ID).Status; status != domain.StatusPending {
+		t.Fatalf("order inside the window status = %s, want %s", status, domain.StatusPending)
+	}
+
+	// A second pass finds nothing left to cancel
+	if cancelled := canceller.RunOnce(context.Background()); cancelled != 0 {
+		t.Fatalf("second pass cancelled = %d, want 0", cancelled)
+	}
+	if len(stock.released) != 1 {
+		t.Fatalf("released orders = %v, want stock released once", stock.released)
+	}
+}
+
+func TestUnpaidOrderCancellerSkipsPOSOrders(t *testing.T) {
+	repo := newMemoryOrderRepository()
+	order := newAgedOrder(t, repo, 2*time.Hour)
+	order.SetPOSInfo("store-1", "staff-1")
+	repo.put(order)
+	service := NewOrderService(repo, nil, nil, &recordingStock{}, domain.GuestLinkSigner{}, false, zap.NewNop())
+
+	if cancelled := NewUnpaidOrderCanceller(service, time.Hour, time.Minute, zap.NewNop()).RunOnce(context.Background()); cancelled != 0 {
+		t.Fatalf("cancelled = %d, want POS orders left alone", cancelled)
+	}
+}
+
+func TestUnpaidOrderCancellerRunsUntilStopped(t *testing.T) {
+	repo := newMemoryOrderRepository()
+	aged := newAgedOrder(t, repo, 2*time.Hour)
+	service := NewOrderService(repo, nil, nil, &recordingStock{}, domain.GuestLinkSigner{}, false, zap.NewNop())
+	canceller := NewUnpaidOrderCanceller(service, time.Hour, 5*time.Millisecond, zap.NewNop())
+
+	canceller.Start()
+	deadline := time.Now().Add(time.Second)
+	for repo.get(aged.ID).Status != domain.StatusCancelled {
+		if time.Now().After(deadline) {
+			canceller.Stop()
+			t.Fatal("aged order was not cancelled by the background job")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		canceller.Stop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Stop did not return")
+	}
+}
@@ -0,0 +1,77 @@
+package application
+
+import (
+	"sync"
+	"time"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// defaultWebhookBatchMaxSize caps a batch when the policy sets no size
+const defaultWebhookBatchMaxSize = 100
+
+// WebhookBatchPolicy controls how inventory events are coalesced into batched
+// webhook deliveries. A zero Window disables batching.
+type WebhookBatchPolicy struct {
+	// Window is how long events are collected after the first one arrives
+	Window time.Duration
+	// MaxSize is the most events in one batch; a full batch is sent at once
+	MaxSize int
+}
+
+// webhookBatcher collects events and hands them to flush in arrival order,
+// either when the window that started with the first event ends or as soon
+// as the batch is full
+type webhookBatcher struct {
+	policy  WebhookBatchPolicy
+	flush   func([]*domain.OrderEvent)
+	mu      sync.Mutex
+	pending []*domain.OrderEvent
+	timer   *time.Timer
+}
+
+func newWebhookBatcher(policy WebhookBatchPolicy, flush func([]*domain.OrderEvent)) *webhookBatcher {
+	if policy.MaxSize < 1 {
+		policy.MaxSize = defaultWebhookBatchMaxSize
+	}
+	return &webhookBatcher{policy: policy, flush: flush}
+}
+
+// Add queues event for the current batch
+func (b *webhookBatcher) Add(event *domain.OrderEvent) {
+	b.mu.Lock()
+	b.pending = append(b.pending, event)
+	if len(b.pending) < b.policy.MaxSize {
+		if b.timer == nil {
+			b.timer = time.AfterFunc(b.policy.Window, b.Flush)
+		}
+		b.mu.Unlock()
+		return
+	}
+	batch := b.take()
+	b.mu.Unlock()
+
+	b.flush(batch)
+}
+
+// Flush sends the events collected so far, if any
+func (b *webhookBatcher) Flush() {
+	b.mu.Lock()
+	batch := b.take()
+	b.mu.Unlock()
+
+	if len(batch) > 0 {
+		b.flush(batch)
+	}
+}
+
+// take empties the current batch; b.mu must be held
+func (b *webhookBatcher) take() []*domain.OrderEvent {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	batch := b.pending
+	b.pending = nil
+	return batch
+}
@@ -0,0 +1,130 @@
+package application
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// batchedEvents returns the events carried by the batch deliveries sent to
+// subscriber and the number of deliveries. Batches are delivered
+// independently, so they are put back in the order they were collected, by
+// the version of their first event.
+func batchedEvents(t *testing.T, sender *scriptedSender, subscriber string) ([]*domain.OrderEvent, int) {
+	t.Helper()
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+
+	deliveries := append([]*domain.OrderEvent(nil), sender.sent[subscriber]...)
+	sort.Slice(deliveries, func(a, b int) bool {
+		first := func(i int) int32 { return deliveries[i].Data["events"].([]*domain.OrderEvent)[0].Version }
+		return first(a) < first(b)
+	})
+
+	var events []*domain.OrderEvent
+	batchIDs := make(map[string]bool)
+	for _, delivery := range deliveries {
+		if delivery.Type != domain.EventInventoryBatch {
+			t.Fatalf("delivered a %s event, want %s", delivery.Type, domain.EventInventoryBatch)
+		}
+		batchID := delivery.Metadata["batch_id"]
+		if batchID == "" || batchIDs[batchID] {
+			t.Fatalf("batch ID %q is missing or reused", batchID)
+		}
+		batchIDs[batchID] = true
+		batch := delivery.Data["events"].([]*domain.OrderEvent)
+		if delivery.Data["count"] != len(batch) {
+			t.Fatalf("count = %v, want %d", delivery.Data["count"], len(batch))
+		}
+		events = append(events, batch...)
+	}
+	return events, len(sender.sent[subscriber])
+}
+
+func TestBurstOfInventoryEventsIsBatched(t *testing.T) {
+	sender := newScriptedSender(nil)
+	sub := domain.WebhookSubscriber{ID: "shop", URL: "http://shop.test"}
+	dispatcher := NewWebhookDispatcher([]domain.WebhookSubscriber{sub}, sender, newMemoryWebhookRepository(),
+		testRetryPolicy(), WebhookBatchPolicy{Window: time.Minute, MaxSize: 50}, zap.NewNop())
+
+	var published []*domain.OrderEvent
+	for i := 0; i < 100; i++ {
+		// Changes alternate between two items, so ordering per item matters
+		event := domain.NewOrderEvent(domain.EventInventoryReserved, fmt.Sprintf("order-%d", i%2), "", int32(i), nil)
+		published = append(published, event)
+		if err := dispatcher.PublishInventoryEvent(event); err != nil {
+			t.Fatal(err)
+		}
+	}
+	dispatcher.Close()
+
+	events, deliveries := batchedEvents(t, sender, "shop")
+	if deliveries != 2 {
+		t.Fatalf("deliveries = %d, want 2 full batches for 100 events", deliveries)
+	}
+	if len(events) != len(published) {
+		t.Fatalf("delivered %d events, want %d", len(events), len(published))
+	}
+	for i := range published {
+		if events[i].ID != published[i].ID {
+			t.Fatalf("event %d is %s, want %s: batches must keep arrival order", i, events[i].ID, published[i].ID)
+		}
+	}
+}
+
+func TestInventoryBatchIsSentWhenWindowEnds(t *testing.T) {
+	sender := newScriptedSender(nil)
+	repo := newMemoryWebhookRepository()
+	sub := domain.WebhookSubscriber{ID: "shop", URL: "http://shop.test"}
+	dispatcher := NewWebhookDispatcher([]domain.WebhookSubscriber{sub}, sender, repo,
+		testRetryPolicy(), WebhookBatchPolicy{Window: 10 * time.Millisecond, MaxSize: 50}, zap.NewNop())
+	defer dispatcher.Close()
+
+	for i := 0; i < 3; i++ {
+		dispatcher.PublishInventoryEvent(domain.NewOrderEvent(domain.EventInventoryReleased, "order-1", "", int32(i), nil))
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		sender.mu.Lock()
+		sent := len(sender.sent["shop"])
+		sender.mu.Unlock()
+		if sent > 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the batch was not sent after its window")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	events, deliveries := batchedEvents(t, sender, "shop")
+	if deliveries != 1 || len(events) != 3 {
+		t.Fatalf("got %d deliveries with %d events, want 1 with 3", deliveries, len(events))
+	}
+}
+
+func TestInventoryBatchOnlyHoldsSubscribedEvents(t *testing.T) {
+	sender := newScriptedSender(nil)
+	all := domain.WebhookSubscriber{ID: "all", URL: "http://all.test"}
+	releases := domain.WebhookSubscriber{ID: "releases", URL: "http://releases.test", EventTypes: []domain.EventType{domain.EventInventoryReleased}}
+	dispatcher := NewWebhookDispatcher([]domain.WebhookSubscriber{all, releases}, sender, newMemoryWebhookRepository(),
+		testRetryPolicy(), WebhookBatchPolicy{Window: time.Minute}, zap.NewNop())
+
+	dispatcher.PublishInventoryEvent(domain.NewOrderEvent(domain.EventInventoryReserved, "order-1", "", 1, nil))
+	dispatcher.PublishInventoryEvent(domain.NewOrderEvent(domain.EventInventoryReleased, "order-1", "", 2, nil))
+	dispatcher.Close()
+
+	if events, _ := batchedEvents(t, sender, "all"); len(events) != 2 {
+		t.Fatalf("all: %d events, want 2", len(events))
+	}
+	events, _ := batchedEvents(t, sender, "releases")
+	if len(events) != 1 || events[0].Type != domain.EventInventoryReleased {
+		t.Fatalf("releases: got %d events, want only the release", len(events))
+	}
+}
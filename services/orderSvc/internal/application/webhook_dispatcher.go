@@ -0,0 +1,343 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// WebhookRetryPolicy controls how failed webhook deliveries are retried
+type WebhookRetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// Backoff returns the delay before the given retry (1-based), doubling each time up to MaxBackoff
+func (p WebhookRetryPolicy) Backoff(retry int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < retry; i++ {
+		delay *= 2
+		if delay >= p.MaxBackoff {
+			return p.MaxBackoff
+		}
+	}
+	return delay
+}
+
+// WebhookDispatcher delivers order events to webhook subscribers. It implements
+// domain.EventPublisher so it can be plugged into the EventService directly.
+// Each delivery is recorded before it is sent, retried with exponential
+// backoff and moved to the dead-letter store once retries are exhausted.
+// Deliveries left pending by a restart are picked up again by Resume.
+type WebhookDispatcher struct {
+	subscribers map[string]domain.WebhookSubscriber
+	sender      domain.WebhookSender
+	repo        domain.WebhookDeliveryRepository
+	policy      WebhookRetryPolicy
+	inventory   *webhookBatcher
+	logger      *zap.Logger
+	ctx         context.Context
+	cancel      context.CancelFunc
+	wg          sync.WaitGroup
+}
+
+// NewWebhookDispatcher creates a new webhook dispatcher
+func NewWebhookDispatcher(
+	subscribers []domain.WebhookSubscriber,
+	sender domain.WebhookSender,
+	repo domain.WebhookDeliveryRepository,
+	policy WebhookRetryPolicy,
+	batching WebhookBatchPolicy,
+	logger *zap.Logger,
+) *WebhookDispatcher {
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
+	}
+
+	byID := make(map[string]domain.WebhookSubscriber, len(subscribers))
+	for _, sub := range subscribers {
+		byID[sub.ID] = sub
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	d := &WebhookDispatcher{
+		subscribers: byID,
+		sender:      sender,
+		repo:        repo,
+		policy:      policy,
+		logger:      logger.Named("webhook_dispatcher"),
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+	if batching.Window > 0 {
+		d.inventory = newWebhookBatcher(batching, d.dispatchInventoryBatch)
+	}
+	return d
+}
+
+// PublishOrderEvent delivers an order lifecycle event to subscribers
+func (d *WebhookDispatcher) PublishOrderEvent(event *domain.OrderEvent) error {
+	d.Dispatch(event)
+	return nil
+}
+
+// PublishInventoryEvent delivers an inventory-related event to subscribers.
+// With batching enabled the event is held back and delivered with the other
+// inventory events of its batch.
+func (d *WebhookDispatcher) PublishInventoryEvent(event *domain.OrderEvent) error {
+	if d.inventory != nil {
+		d.inventory.Add(event)
+		return nil
+	}
+	d.Dispatch(event)
+	return nil
+}
+
+// PublishPaymentEvent delivers a payment-related event to subscribers
+func (d *WebhookDispatcher) PublishPaymentEvent(event *domain.OrderEvent) error {
+	d.Dispatch(event)
+	return nil
+}
+
+// Dispatch starts an asynchronous delivery of event to every interested subscriber
+func (d *WebhookDispatcher) Dispatch(event *domain.OrderEvent) {
+	for _, sub := range d.subscribers {
+		if !sub.Wants(event.Type) {
+			continue
+		}
+
+		delivery := domain.NewWebhookDelivery(event, sub)
+		d.save(delivery)
+
+		d.start(sub, delivery)
+	}
+}
+
+// dispatchInventoryBatch delivers a batch of inventory events to every
+// subscriber that wants at least one of them. Each subscriber's payload only
+// holds the events it subscribed to, under the batch's shared ID.
+func (d *WebhookDispatcher) dispatchInventoryBatch(events []*domain.OrderEvent) {
+	batchID := uuid.New().String()
+	for _, sub := range d.subscribers {
+		var wanted []*domain.OrderEvent
+		for _, event := range events {
+			if sub.Wants(event.Type) {
+				wanted = append(wanted, event)
+			}
+		}
+		if len(wanted) == 0 {
+			continue
+		}
+
+		delivery := domain.NewWebhookDelivery(domain.NewInventoryBatchEvent(batchID, wanted), sub)
+		d.save(delivery)
+		d.start(sub, delivery)
+	}
+}
+
+// Resume restarts every delivery that was still pending when the service last
+// stopped, keeping the attempts already made and the backoff of the next one.
+// Deliveries whose subscriber is no longer configured, or that already used
+// all their attempts, are dead-lettered. It returns the number resumed.
+func (d *WebhookDispatcher) Resume(ctx context.Context) (int, error) {
+	pending, err := d.repo.ListPendingDeliveries(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list pending webhook deliveries: %w", err)
+	}
+
+	resumed := 0
+	for _, delivery := range pending {
+		sub, ok := d.subscribers[delivery.SubscriberID]
+		switch {
+		case !ok:
+			delivery.LastError = fmt.Sprintf("%v: %s", domain.ErrUnknownSubscriber, delivery.SubscriberID)
+			d.deadLetter(delivery)
+		case int(delivery.Attempts) >= d.policy.MaxAttempts:
+			d.deadLetter(delivery)
+		default:
+			d.start(sub, delivery)
+			resumed++
+		}
+	}
+
+	if len(pending) > 0 {
+		d.logger.Info("Resumed pending webhook deliveries",
+			zap.Int("pending", len(pending)),
+			zap.Int("resumed", resumed),
+		)
+	}
+	return resumed, nil
+}
+
+// start delivers in the background, tracked so Close can wait for it. Once
+// Close has been called the delivery is only recorded as pending.
+func (d *WebhookDispatcher) start(sub domain.WebhookSubscriber, delivery *domain.WebhookDelivery) {
+	if d.ctx.Err() != nil {
+		d.logger.Warn("Shutting down, webhook delivery left pending",
+			zap.String("delivery_id", delivery.ID),
+			zap.String("subscriber_id", sub.ID),
+		)
+		return
+	}
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		d.deliver(d.ctx, sub, delivery)
+	}()
+}
+
+// deliver attempts a delivery until it succeeds or the retry policy is
+// exhausted. A resumed delivery carries on from the attempts it already made.
+func (d *WebhookDispatcher) deliver(ctx context.Context, sub domain.WebhookSubscriber, delivery *domain.WebhookDelivery) {
+	log := d.logger.With(
+		zap.String("delivery_id", delivery.ID),
+		zap.String("subscriber_id", sub.ID),
+		zap.String("event_type", string(delivery.EventType)),
+	)
+
+	for attempt := int(delivery.Attempts) + 1; attempt <= d.policy.MaxAttempts; attempt++ {
+		if wait := delivery.RetryDelay(time.Now()); wait > 0 {
+			select {
+			case <-time.After(wait):
+			case <-ctx.Done():
+				// Shutting down: leave the delivery pending for Resume rather than dead-lettering it
+				log.Warn("Webhook delivery interrupted by shutdown", zap.Int32("attempts", delivery.Attempts))
+				return
+			}
+		}
+
+		// An attempt that has started is finished even when shutdown begins,
+		// so its outcome is recorded; only the retries are given up
+		err := d.attempt(context.WithoutCancel(ctx), sub, delivery)
+		if err == nil {
+			log.Info("Webhook delivered", zap.Int32("attempts", delivery.Attempts))
+			return
+		}
+
+		log.Warn("Webhook delivery attempt failed",
+			zap.Int("attempt", attempt),
+			zap.Error(err),
+		)
+		if attempt < d.policy.MaxAttempts {
+			next := time.Now().Add(d.policy.Backoff(attempt))
+			delivery.NextAttemptAt = &next
+			d.save(delivery)
+		}
+	}
+
+	d.deadLetter(delivery)
+}
+
+// deadLetter marks a delivery as dead-lettered and copies it into the dead-letter store
+func (d *WebhookDispatcher) deadLetter(delivery *domain.WebhookDelivery) {
+	log := d.logger.With(
+		zap.String("delivery_id", delivery.ID),
+		zap.String("subscriber_id", delivery.SubscriberID),
+		zap.String("event_type", string(delivery.EventType)),
+	)
+
+	delivery.Status = domain.DeliveryStatusDeadLettered
+	delivery.NextAttemptAt = nil
+	delivery.UpdatedAt = time.Now()
+	if err := d.repo.AddDeadLetter(context.Background(), delivery); err != nil {
+		// Left pending, so the next Resume tries again
+		log.Error("Failed to dead-letter webhook delivery", zap.Error(err))
+		return
+	}
+	d.save(delivery)
+
+	log.Error("Webhook delivery exhausted retries, moved to dead-letter store",
+		zap.Int32("attempts", delivery.Attempts),
+		zap.String("last_error", delivery.LastError),
+	)
+}
+
+// attempt performs one delivery attempt and records the outcome on delivery
+func (d *WebhookDispatcher) attempt(ctx context.Context, sub domain.WebhookSubscriber, delivery *domain.WebhookDelivery) error {
+	err := d.sender.Send(ctx, sub, delivery.Event)
+
+	now := time.Now()
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+	delivery.UpdatedAt = now
+	if err != nil {
+		delivery.LastError = err.Error()
+	} else {
+		delivery.Status = domain.DeliveryStatusDelivered
+		delivery.LastError = ""
+		delivery.DeliveredAt = &now
+		delivery.NextAttemptAt = nil
+	}
+	d.save(delivery)
+
+	return err
+}
+
+// ListDeliveries returns per-subscriber delivery status records
+func (d *WebhookDispatcher) ListDeliveries(ctx context.Context, subscriberID string, status domain.DeliveryStatus, limit, offset int) ([]*domain.WebhookDelivery, error) {
+	return d.repo.ListDeliveries(ctx, subscriberID, status, limit, offset)
+}
+
+// ListDeadLetters returns deliveries that exhausted their retries
+func (d *WebhookDispatcher) ListDeadLetters(ctx context.Context, subscriberID string, limit, offset int) ([]*domain.WebhookDelivery, error) {
+	return d.repo.ListDeadLetters(ctx, subscriberID, limit, offset)
+}
+
+// ReplayDeadLetter makes one more synchronous delivery attempt for a dead-lettered
+// delivery. On success the delivery is removed from the dead-letter store.
+func (d *WebhookDispatcher) ReplayDeadLetter(ctx context.Context, id string) (*domain.WebhookDelivery, error) {
+	delivery, err := d.repo.GetDeadLetter(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	sub, ok := d.subscribers[delivery.SubscriberID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", domain.ErrUnknownSubscriber, delivery.SubscriberID)
+	}
+
+	if err := d.attempt(ctx, sub, delivery); err != nil {
+		// Keep the dead-letter copy in sync with the latest attempt
+		if saveErr := d.repo.AddDeadLetter(ctx, delivery); saveErr != nil {
+			d.logger.Error("Failed to update dead-lettered delivery", zap.Error(saveErr))
+		}
+		return delivery, fmt.Errorf("replay failed: %w", err)
+	}
+
+	if err := d.repo.DeleteDeadLetter(ctx, id); err != nil {
+		return delivery, fmt.Errorf("delivered but failed to remove dead letter: %w", err)
+	}
+
+	d.logger.Info("Replayed dead-lettered webhook delivery",
+		zap.String("delivery_id", id),
+		zap.String("subscriber_id", sub.ID),
+	)
+	return delivery, nil
+}
+
+// Close sends any inventory batch still being collected, then stops pending
+// retries and waits for in-flight delivery attempts to finish. Events
+// dispatched after Close are recorded as pending and not sent.
+func (d *WebhookDispatcher) Close() {
+	if d.inventory != nil {
+		d.inventory.Flush()
+	}
+	d.cancel()
+	d.wg.Wait()
+}
+
+func (d *WebhookDispatcher) save(delivery *domain.WebhookDelivery) {
+	if err := d.repo.SaveDelivery(context.Background(), delivery); err != nil {
+		d.logger.Error("Failed to record webhook delivery status",
+			zap.String("delivery_id", delivery.ID),
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,287 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// memoryWebhookRepository keeps delivery records and dead letters in memory
+type memoryWebhookRepository struct {
+	mu          sync.Mutex
+	deliveries  map[string]domain.WebhookDelivery
+	deadLetters map[string]domain.WebhookDelivery
+}
+
+func newMemoryWebhookRepository(pending ...*domain.WebhookDelivery) *memoryWebhookRepository {
+	r := &memoryWebhookRepository{
+		deliveries:  make(map[string]domain.WebhookDelivery),
+		deadLetters: make(map[string]domain.WebhookDelivery),
+	}
+	for _, delivery := range pending {
+		r.deliveries[delivery.ID] = *delivery
+	}
+	return r
+}
+
+func (r *memoryWebhookRepository) SaveDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.deliveries[delivery.ID] = *delivery
+	return nil
+}
+
+func (r *memoryWebhookRepository) ListDeliveries(ctx context.Context, subscriberID string, status domain.DeliveryStatus, limit, offset int) ([]*domain.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var deliveries []*domain.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if (subscriberID == "" || delivery.SubscriberID == subscriberID) && (status == "" || delivery.Status == status) {
+			copied := delivery
+			deliveries = append(deliveries, &copied)
+		}
+	}
+	sort.Slice(deliveries, func(a, b int) bool { return deliveries[a].CreatedAt.Before(deliveries[b].CreatedAt) })
+	return deliveries, nil
+}
+
+func (r *memoryWebhookRepository) ListPendingDeliveries(ctx context.Context) ([]*domain.WebhookDelivery, error) {
+	return r.ListDeliveries(ctx, "", domain.DeliveryStatusPending, 0, 0)
+}
+
+func (r *memoryWebhookRepository) AddDeadLetter(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.deadLetters[delivery.ID] = *delivery
+	return nil
+}
+
+func (r *memoryWebhookRepository) GetDeadLetter(ctx context.Context, id string) (*domain.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delivery, ok := r.deadLetters[id]
+	if !ok {
+		return nil, domain.ErrDeadLetterNotFound
+	}
+	return &delivery, nil
+}
+
+func (r *memoryWebhookRepository) ListDeadLetters(ctx context.Context, subscriberID string, limit, offset int) ([]*domain.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var deliveries []*domain.WebhookDelivery
+	for _, delivery := range r.deadLetters {
+		if subscriberID == "" || delivery.SubscriberID == subscriberID {
+			copied := delivery
+			deliveries = append(deliveries, &copied)
+		}
+	}
+	return deliveries, nil
+}
+
+func (r *memoryWebhookRepository) DeleteDeadLetter(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.deadLetters[id]; !ok {
+		return domain.ErrDeadLetterNotFound
+	}
+	delete(r.deadLetters, id)
+	return nil
+}
+
+func (r *memoryWebhookRepository) delivery(id string) domain.WebhookDelivery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.deliveries[id]
+}
+
+// scriptedSender fails the first failures[subscriber] sends to a subscriber
+// and succeeds afterwards; a negative count fails forever
+type scriptedSender struct {
+	mu       sync.Mutex
+	failures map[string]int
+	sent     map[string][]*domain.OrderEvent
+	attempts map[string]int
+}
+
+func newScriptedSender(failures map[string]int) *scriptedSender {
+	return &scriptedSender{
+		failures: failures,
+		sent:     make(map[string][]*domain.OrderEvent),
+		attempts: make(map[string]int),
+	}
+}
+
+func (s *scriptedSender) Send(ctx context.Context, sub domain.WebhookSubscriber, event *domain.OrderEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attempts[sub.ID]++
+	if remaining := s.failures[sub.ID]; remaining != 0 {
+		if remaining > 0 {
+			s.failures[sub.ID]--
+		}
+		return errors.New("subscriber unavailable")
+	}
+	s.sent[sub.ID] = append(s.sent[sub.ID], event)
+	return nil
+}
+
+func (s *scriptedSender) attemptsFor(id string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.attempts[id]
+}
+
+// waitForStatus polls until a delivery reaches status, since deliveries run in the background
+func waitForStatus(t *testing.T, repo *memoryWebhookRepository, id string, status domain.DeliveryStatus) domain.WebhookDelivery {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		delivery := repo.delivery(id)
+		if delivery.Status == status {
+			return delivery
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("delivery %s is %s after %d attempts, want %s", id, delivery.Status, delivery.Attempts, status)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func testRetryPolicy() WebhookRetryPolicy {
+	return WebhookRetryPolicy{MaxAttempts: 4, InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+}
+
+func TestWebhookDispatcherRetriesUntilDelivered(t *testing.T) {
+	repo := newMemoryWebhookRepository()
+	sender := newScriptedSender(map[string]int{"flaky": 2})
+	sub := domain.WebhookSubscriber{ID: "flaky", URL: "http://flaky.test"}
+	dispatcher := NewWebhookDispatcher([]domain.WebhookSubscriber{sub}, sender, repo, testRetryPolicy(), WebhookBatchPolicy{}, zap.NewNop())
+
+	event := domain.NewOrderEvent(domain.EventOrderCreated, "order-1", "user-1", 1, nil)
+	dispatcher.Dispatch(event)
+	delivery := waitForStatus(t, repo, domain.NewWebhookDelivery(event, sub).ID, domain.DeliveryStatusDelivered)
+	dispatcher.Close()
+
+	if delivery.Attempts != 3 {
+		t.Errorf("attempts = %d, want 3", delivery.Attempts)
+	}
+	if delivery.NextAttemptAt != nil || delivery.LastError != "" {
+		t.Errorf("a delivered record keeps retry state: next %v, error %q", delivery.NextAttemptAt, delivery.LastError)
+	}
+	if len(repo.deadLetters) != 0 {
+		t.Errorf("dead letters = %d, want none", len(repo.deadLetters))
+	}
+}
+
+func TestWebhookDispatcherDeadLettersExhaustedDelivery(t *testing.T) {
+	repo := newMemoryWebhookRepository()
+	sender := newScriptedSender(map[string]int{"down": -1})
+	sub := domain.WebhookSubscriber{ID: "down", URL: "http://down.test"}
+	dispatcher := NewWebhookDispatcher([]domain.WebhookSubscriber{sub}, sender, repo, testRetryPolicy(), WebhookBatchPolicy{}, zap.NewNop())
+
+	event := domain.NewOrderEvent(domain.EventOrderPaid, "order-1", "user-1", 1, nil)
+	dispatcher.Dispatch(event)
+	id := domain.NewWebhookDelivery(event, sub).ID
+	waitForStatus(t, repo, id, domain.DeliveryStatusDeadLettered)
+	dispatcher.Close()
+
+	if got := sender.attemptsFor("down"); got != 4 {
+		t.Errorf("send attempts = %d, want 4", got)
+	}
+	deadLetters, err := dispatcher.ListDeadLetters(context.Background(), "down", 10, 0)
+	if err != nil {
+		t.Fatalf("ListDeadLetters: %v", err)
+	}
+	if len(deadLetters) != 1 || deadLetters[0].ID != id {
+		t.Fatalf("dead letters = %v, want the exhausted delivery", deadLetters)
+	}
+
+	// Once the subscriber is back, a replay delivers it and clears the dead letter
+	sender.mu.Lock()
+	sender.failures["down"] = 0
+	sender.mu.Unlock()
+	if _, err := dispatcher.ReplayDeadLetter(context.Background(), id); err != nil {
+		t.Fatalf("ReplayDeadLetter: %v", err)
+	}
+	if _, err := repo.GetDeadLetter(context.Background(), id); !errors.Is(err, domain.ErrDeadLetterNotFound) {
+		t.Errorf("dead letter still stored after replay: %v", err)
+	}
+}
+
+func TestWebhookDispatcherResumesPendingDeliveries(t *testing.T) {
+	sub := domain.WebhookSubscriber{ID: "shop", URL: "http://shop.test"}
+	event := domain.NewOrderEvent(domain.EventOrderShipped, "order-1", "user-1", 1, nil)
+
+	// A previous run made two attempts before it stopped
+	interrupted := domain.NewWebhookDelivery(event, sub)
+	interrupted.Attempts = 2
+	next := time.Now().Add(-time.Second)
+	interrupted.NextAttemptAt = &next
+
+	exhausted := domain.NewWebhookDelivery(domain.NewOrderEvent(domain.EventOrderPaid, "order-2", "user-1", 1, nil), sub)
+	exhausted.Attempts = 4
+
+	orphaned := domain.NewWebhookDelivery(event, domain.WebhookSubscriber{ID: "removed"})
+
+	repo := newMemoryWebhookRepository(interrupted, exhausted, orphaned)
+	sender := newScriptedSender(map[string]int{})
+	dispatcher := NewWebhookDispatcher([]domain.WebhookSubscriber{sub}, sender, repo, testRetryPolicy(), WebhookBatchPolicy{}, zap.NewNop())
+
+	resumed, err := dispatcher.Resume(context.Background())
+	if err != nil {
+		t.Fatalf("Resume: %v", err)
+	}
+	waitForStatus(t, repo, interrupted.ID, domain.DeliveryStatusDelivered)
+	dispatcher.Close()
+
+	if resumed != 1 {
+		t.Errorf("resumed = %d, want 1", resumed)
+	}
+	if got := repo.delivery(interrupted.ID); got.Status != domain.DeliveryStatusDelivered || got.Attempts != 3 {
+		t.Errorf("interrupted delivery = %s after %d attempts, want delivered on the third", got.Status, got.Attempts)
+	}
+	for _, id := range []string{exhausted.ID, orphaned.ID} {
+		if got := repo.delivery(id).Status; got != domain.DeliveryStatusDeadLettered {
+			t.Errorf("delivery %s status = %s, want %s", id, got, domain.DeliveryStatusDeadLettered)
+		}
+		if _, err := repo.GetDeadLetter(context.Background(), id); err != nil {
+			t.Errorf("delivery %s not dead-lettered: %v", id, err)
+		}
+	}
+	if got := sender.attemptsFor("shop"); got != 1 {
+		t.Errorf("send attempts = %d, want only the resumed delivery sent", got)
+	}
+}
+
+func TestWebhookDispatcherLeavesDeliveryPendingOnShutdown(t *testing.T) {
+	repo := newMemoryWebhookRepository()
+	sender := newScriptedSender(map[string]int{"slow": -1})
+	sub := domain.WebhookSubscriber{ID: "slow", URL: "http://slow.test"}
+	policy := WebhookRetryPolicy{MaxAttempts: 3, InitialBackoff: time.Hour, MaxBackoff: time.Hour}
+	dispatcher := NewWebhookDispatcher([]domain.WebhookSubscriber{sub}, sender, repo, policy, WebhookBatchPolicy{}, zap.NewNop())
+
+	event := domain.NewOrderEvent(domain.EventOrderCreated, "order-1", "user-1", 1, nil)
+	dispatcher.Dispatch(event)
+	id := domain.NewWebhookDelivery(event, sub).ID
+	deadline := time.Now().Add(time.Second)
+	for repo.delivery(id).NextAttemptAt == nil && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	dispatcher.Close()
+
+	delivery := repo.delivery(id)
+	if delivery.Status != domain.DeliveryStatusPending || delivery.Attempts != 1 {
+		t.Fatalf("delivery = %s after %d attempts, want pending after 1", delivery.Status, delivery.Attempts)
+	}
+	if delivery.NextAttemptAt == nil || delivery.NextAttemptAt.Before(time.Now()) {
+		t.Errorf("next attempt = %v, want the stored backoff kept for the next run", delivery.NextAttemptAt)
+	}
+}
@@ -2,8 +2,15 @@ package config
 
 import (
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/grpclimits"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/mongoclient"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/shutdown"
 )
 
 // Config holds the application configuration
@@ -13,6 +20,43 @@ type Config struct {
 	Database             string
 	ProductServiceAddr   string
 	InventoryServiceAddr string
+	ValidatePOSProducts  bool          // Check POS order items against the product catalog
+	DefaultLocationID    string        // Location stock is reserved at when a paid order's reservation lapsed
+	GuestOrderLinkSecret string        // Signs the links guests read their orders through; unset disables them
+	GuestOrderLinkTTL    time.Duration // How long a guest order link stays valid
+	Payment              PaymentConfig
+	Webhooks             WebhookConfig
+	Mongo                mongoclient.ConcernConfig
+	MongoPool            mongoclient.PoolConfig
+	GRPCLimits           grpclimits.MessageLimits
+	ShutdownDrainTimeout time.Duration // How long background workers get to finish on shutdown
+}
+
+// PaymentConfig holds settings for orders that are never paid
+type PaymentConfig struct {
+	// Timeout is how long an order may stay unpaid before it is cancelled and
+	// its stock released; zero disables auto-cancellation
+	Timeout time.Duration
+	// CheckInterval is how often unpaid orders are looked for
+	CheckInterval time.Duration
+}
+
+// WebhookConfig holds settings for order event webhook delivery
+type WebhookConfig struct {
+	// Subscribers maps subscriber IDs to endpoint URLs. Parsed from
+	// WEBHOOK_SUBSCRIBERS as a comma separated list of id=url pairs.
+	Subscribers    map[string]string
+	Secret         string
+	Timeout        time.Duration
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// InventoryBatchWindow is how long inventory events are collected into
+	// one delivery; zero sends each event on its own
+	InventoryBatchWindow time.Duration
+	// InventoryBatchMaxSize is the most events one batch carries; a full
+	// batch is sent without waiting for the window to end
+	InventoryBatchMaxSize int
 }
 
 // Load loads configuration from environment variables
@@ -23,14 +67,49 @@ func Load(logger *zap.Logger) *Config {
 		Database:             getEnv("DATABASE_NAME", "stockplatform"),
 		ProductServiceAddr:   getEnv("PRODUCT_SERVICE_ADDR", "product-service:50053"),
 		InventoryServiceAddr: getEnv("INVENTORY_SERVICE_ADDR", "inventory-service:50054"),
+		ValidatePOSProducts:  getEnvBool("VALIDATE_POS_PRODUCTS", true),
+		DefaultLocationID:    getEnv("DEFAULT_LOCATION_ID", "default"),
+		GuestOrderLinkSecret: getEnv("GUEST_ORDER_LINK_SECRET", ""),
+		GuestOrderLinkTTL:    getEnvDuration("GUEST_ORDER_LINK_TTL", 30*24*time.Hour),
+		Payment: PaymentConfig{
+			Timeout:       getEnvDuration("ORDER_PAYMENT_TIMEOUT", 24*time.Hour),
+			CheckInterval: getEnvDuration("ORDER_PAYMENT_TIMEOUT_CHECK_INTERVAL", 10*time.Minute),
+		},
+		Webhooks: WebhookConfig{
+			Subscribers:           parseSubscribers(getEnv("WEBHOOK_SUBSCRIBERS", "")),
+			Secret:                getEnv("WEBHOOK_SECRET", ""),
+			Timeout:               getEnvDuration("WEBHOOK_TIMEOUT", 10*time.Second),
+			MaxAttempts:           getEnvInt("WEBHOOK_MAX_ATTEMPTS", 5),
+			InitialBackoff:        getEnvDuration("WEBHOOK_INITIAL_BACKOFF", time.Second),
+			MaxBackoff:            getEnvDuration("WEBHOOK_MAX_BACKOFF", 5*time.Minute),
+			InventoryBatchWindow:  getEnvDuration("WEBHOOK_INVENTORY_BATCH_WINDOW", 2*time.Second),
+			InventoryBatchMaxSize: getEnvInt("WEBHOOK_INVENTORY_BATCH_MAX_SIZE", 100),
+		},
+		Mongo:                mongoclient.ConcernConfigFromEnv(),
+		MongoPool:            mongoclient.PoolConfigFromEnv(mongoclient.DefaultPoolConfig()),
+		GRPCLimits:           grpclimits.MessageLimitsFromEnv(grpclimits.DefaultMessageLimits()),
+		ShutdownDrainTimeout: shutdown.DrainTimeoutFromEnv(shutdown.DefaultDrainTimeout),
 	}
 
 	logger.Info("Configuration loaded",
 		zap.String("grpc_port", cfg.GRPCPort),
 		zap.String("mongo_uri", maskSensitive(cfg.MongoURI)),
 		zap.String("database", cfg.Database),
+		zap.String("mongo_critical_write_concern", cfg.Mongo.CriticalWriteConcern),
+		zap.String("mongo_report_read_preference", cfg.Mongo.ReportReadPreference),
+		zap.Uint64("mongo_max_pool_size", cfg.MongoPool.MaxPoolSize),
+		zap.Int("grpc_max_recv_msg_size", cfg.GRPCLimits.MaxRecvMsgSize),
+		zap.Int("grpc_max_send_msg_size", cfg.GRPCLimits.MaxSendMsgSize),
+		zap.Duration("shutdown_drain_timeout", cfg.ShutdownDrainTimeout),
 		zap.String("product_service_addr", cfg.ProductServiceAddr),
 		zap.String("inventory_service_addr", cfg.InventoryServiceAddr),
+		zap.Bool("validate_pos_products", cfg.ValidatePOSProducts),
+		zap.String("default_location_id", cfg.DefaultLocationID),
+		zap.Bool("guest_order_links", cfg.GuestOrderLinkSecret != ""),
+		zap.Duration("guest_order_link_ttl", cfg.GuestOrderLinkTTL),
+		zap.Duration("order_payment_timeout", cfg.Payment.Timeout),
+		zap.Int("webhook_subscribers", len(cfg.Webhooks.Subscribers)),
+		zap.Int("webhook_max_attempts", cfg.Webhooks.MaxAttempts),
 	)
 
 	return cfg
@@ -44,6 +123,49 @@ func getEnv(key, fallback string) string {
 	return fallback
 }
 
+// getEnvInt gets an integer environment variable with fallback
+func getEnvInt(key string, fallback int) int {
+	if value := os.Getenv(key); value != "" {
+		if parsed, err := strconv.Atoi(value); err == nil {
+			return parsed
+		}
+	}
+	return fallback
+}
+
+// getEnvBool gets a boolean environment variable (e.g. "false") with fallback
+func getEnvBool(key string, fallback bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if parsed, err := strconv.ParseBool(value); err == nil {
+			return parsed
+		}
+	}
+	return fallback
+}
+
+// getEnvDuration gets a duration environment variable (e.g. "30s") with fallback
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if parsed, err := time.ParseDuration(value); err == nil {
+			return parsed
+		}
+	}
+	return fallback
+}
+
+// parseSubscribers parses "id=url,id2=url2" into a map of subscriber URLs
+func parseSubscribers(value string) map[string]string {
+	subscribers := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		id, url, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || id == "" || url == "" {
+			continue
+		}
+		subscribers[id] = url
+	}
+	return subscribers
+}
+
 // maskSensitive masks sensitive information for logging
 func maskSensitive(value string) string {
 	if len(value) > 20 {
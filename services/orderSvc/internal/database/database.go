@@ -9,6 +9,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.uber.org/zap"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/mongoclient"
 	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/config"
 	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
 	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/infrastructure/mongodb"
@@ -16,16 +17,18 @@ import (
 
 // Database holds database connections and repositories
 type Database struct {
-	Client    *mongo.Client
-	Database  *mongo.Database
-	OrderRepo domain.OrderRepository
-	logger    *zap.Logger
+	Client      *mongo.Client
+	Database    *mongo.Database
+	OrderRepo   domain.OrderRepository
+	WebhookRepo domain.WebhookDeliveryRepository
+	ReturnRepo  domain.ReturnRepository
+	logger      *zap.Logger
 }
 
 // Initialize creates and initializes the database layer
 func Initialize(cfg *config.Config, logger *zap.Logger) (*Database, error) {
 	// Create MongoDB client
-	client, err := createMongoClient(cfg.MongoURI, logger)
+	client, err := createMongoClient(cfg.MongoURI, cfg.Mongo, cfg.MongoPool, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -50,17 +53,35 @@ func Initialize(cfg *config.Config, logger *zap.Logger) (*Database, error) {
 
 	logger.Info("Successfully connected to MongoDB", zap.String("database", cfg.Database))
 
-	// Get database instance
+	// Get database instances. Critical writes go through a handle with a
+	// stronger write concern, report queries through one that may read from
+	// secondaries.
 	database := client.Database(cfg.Database)
 
+	criticalOpts, err := cfg.Mongo.CriticalDatabaseOptions()
+	if err != nil {
+		return nil, err
+	}
+	critical := client.Database(cfg.Database, criticalOpts)
+
+	reportOpts, err := cfg.Mongo.ReportDatabaseOptions()
+	if err != nil {
+		return nil, err
+	}
+	reports := client.Database(cfg.Database, reportOpts)
+
 	// Initialize repositories
-	orderRepo := mongodb.NewOrderRepository(database, "orders", logger)
+	orderRepo := mongodb.NewOrderRepository(critical, reports, "orders", logger)
+	webhookRepo := mongodb.NewWebhookRepository(database, logger)
+	returnRepo := mongodb.NewReturnRepository(critical, logger)
 
 	return &Database{
-		Client:    client,
-		Database:  database,
-		OrderRepo: orderRepo,
-		logger:    logger,
+		Client:      client,
+		Database:    database,
+		OrderRepo:   orderRepo,
+		WebhookRepo: webhookRepo,
+		ReturnRepo:  returnRepo,
+		logger:      logger,
 	}, nil
 }
 
@@ -80,7 +101,7 @@ func (d *Database) Close() error {
 }
 
 // createMongoClient creates a MongoDB client with proper configuration
-func createMongoClient(mongoURI string, logger *zap.Logger) (*mongo.Client, error) {
+func createMongoClient(mongoURI string, mongoCfg mongoclient.ConcernConfig, pool mongoclient.PoolConfig, logger *zap.Logger) (*mongo.Client, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -89,10 +110,11 @@ func createMongoClient(mongoURI string, logger *zap.Logger) (*mongo.Client, erro
 	// Set up client options with timeouts and retry settings
 	clientOptions := options.Client()
 	clientOptions.ApplyURI(mongoURI)
-	clientOptions.SetConnectTimeout(10 * time.Second)
-	clientOptions.SetSocketTimeout(30 * time.Second)
-	clientOptions.SetServerSelectionTimeout(10 * time.Second)
-	clientOptions.SetMaxPoolSize(10)
+	pool.Apply(clientOptions)
+	if err := mongoCfg.Apply(clientOptions); err != nil {
+		logger.Error("Invalid MongoDB concern configuration", zap.Error(err))
+		return nil, err
+	}
 
 	client, err := mongo.Connect(ctx, clientOptions)
 	if err != nil {
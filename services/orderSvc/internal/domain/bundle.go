@@ -0,0 +1,42 @@
+package domain
+
+// OrderItemComponent is a product that goes into every unit of a bundle
+// ordered on an order line
+type OrderItemComponent struct {
+	ProductID  string `bson:"product_id"`
+	ProductSKU string `bson:"product_sku"`
+	Quantity   int32  `bson:"quantity"` // Units per bundle
+}
+
+// StockItems returns the order's items as inventory sees them: a bundle line
+// becomes a line per component, for the line quantity times the component's,
+// since bundles have no stock of their own. Lines for the same product are
+// combined, in the order the product first appears.
+func (o *Order) StockItems() []OrderItem {
+	return StockItemsOf(o.Items)
+}
+
+// StockItemsOf returns order lines as inventory sees them, like StockItems
+func StockItemsOf(lines []OrderItem) []OrderItem {
+	items := make([]OrderItem, 0, len(lines))
+	index := make(map[string]int, len(lines))
+	add := func(productID, sku string, quantity int32) {
+		if i, ok := index[productID]; ok {
+			items[i].Quantity += quantity
+			return
+		}
+		index[productID] = len(items)
+		items = append(items, OrderItem{ProductID: productID, ProductSKU: sku, Quantity: quantity})
+	}
+
+	for _, item := range lines {
+		if len(item.Components) == 0 {
+			add(item.ProductID, item.ProductSKU, item.Quantity)
+			continue
+		}
+		for _, c := range item.Components {
+			add(c.ProductID, c.ProductSKU, item.Quantity*c.Quantity)
+		}
+	}
+	return items
+}
@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStockItemsExpandsBundles(t *testing.T) {
+	order := &Order{Items: []OrderItem{
+		{ProductID: "kit", ProductSKU: "KIT", Quantity: 2, Components: []OrderItemComponent{
+			{ProductID: "frame", ProductSKU: "FRAME", Quantity: 1},
+			{ProductID: "screw", ProductSKU: "SCREW", Quantity: 8},
+		}},
+		{ProductID: "screw", ProductSKU: "SCREW", Quantity: 4},
+		{ProductID: "lamp", ProductSKU: "LAMP", Quantity: 1},
+	}}
+
+	want := []OrderItem{
+		{ProductID: "frame", ProductSKU: "FRAME", Quantity: 2},
+		{ProductID: "screw", ProductSKU: "SCREW", Quantity: 20},
+		{ProductID: "lamp", ProductSKU: "LAMP", Quantity: 1},
+	}
+	if got := order.StockItems(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("stock items = %+v, want %+v", got, want)
+	}
+	if order.Items[0].Quantity != 2 || len(order.Items) != 3 {
+		t.Fatal("the order's own lines must not change")
+	}
+}
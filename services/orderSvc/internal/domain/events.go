@@ -29,6 +29,9 @@ const (
 	// Inventory events
 	EventInventoryReserved EventType = "inventory.reserved"
 	EventInventoryReleased EventType = "inventory.released"
+	// EventInventoryBatch carries several inventory events coalesced into one
+	// webhook delivery
+	EventInventoryBatch EventType = "inventory.batch"
 )
 
 // OrderEvent represents an event in the order lifecycle
@@ -57,6 +60,19 @@ func NewOrderEvent(eventType EventType, orderID, userID string, version int32, d
 	}
 }
 
+// NewInventoryBatchEvent wraps inventory events into a single batch event.
+// The events keep their order, so changes to the same item arrive in the
+// order they happened; batchID identifies the batch across subscribers.
+func NewInventoryBatchEvent(batchID string, events []*OrderEvent) *OrderEvent {
+	event := NewOrderEvent(EventInventoryBatch, "", "", 0, map[string]interface{}{
+		"batch_id": batchID,
+		"count":    len(events),
+		"events":   events,
+	})
+	event.Metadata["batch_id"] = batchID
+	return event
+}
+
 // ToJSON serializes the event to JSON
 func (e *OrderEvent) ToJSON() ([]byte, error) {
 	return json.Marshal(e)
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrInsufficientStock is returned when an order's items cannot be reserved,
+// at creation or again at payment, because the stock has gone
+var ErrInsufficientStock = errors.New("insufficient stock")
+
+// StockFulfiller reserves a new order's items and deducts them once it is paid
+type StockFulfiller interface {
+	// ReserveOrder reserves all of a new order's items, linked to the order,
+	// or none of them. A shortage returns an error wrapping
+	// ErrInsufficientStock. Any other error leaves the outcome unknown, so
+	// callers undo a failed reservation with ReleaseOrder.
+	ReserveOrder(ctx context.Context, order *Order) error
+
+	// FulfillOrder converts the order's reservations into stock deductions.
+	// Items whose reservation expired or was released are reserved again
+	// first; if that is not possible the error wraps ErrInsufficientStock and
+	// nothing is deducted.
+	FulfillOrder(ctx context.Context, order *Order) error
+
+	// ReleaseOrder releases the stock still reserved for the order, at every
+	// location, and returns the number of reservations released
+	ReleaseOrder(ctx context.Context, order *Order) (int, error)
+
+	// ReleaseItems releases the stock reserved for lines cancelled from the
+	// order, leaving the rest of its reservations in place, and returns the
+	// number of reservations it released from
+	ReleaseItems(ctx context.Context, order *Order, items []OrderItem) (int, error)
+}
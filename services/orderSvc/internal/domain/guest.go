@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidGuestContact is returned for guest orders without a usable contact
+var ErrInvalidGuestContact = errors.New("invalid guest contact")
+
+// ErrGuestLinksDisabled is returned when no secret is configured to sign
+// guest order links with
+var ErrGuestLinksDisabled = errors.New("guest order links are not configured")
+
+// maxGuestPhoneLength bounds the phone number of a guest contact
+const maxGuestPhoneLength = 32
+
+// GuestContact is how the buyer of an order placed without an account is
+// reached. The email identifies the guest: it signs their order links and
+// links their orders to the account they register later.
+type GuestContact struct {
+	Email string `bson:"email"`
+	Phone string `bson:"phone,omitempty"`
+}
+
+// Normalize trims the contact and lowercases the email, so the same address
+// always identifies the same guest
+func (c *GuestContact) Normalize() {
+	c.Email = NormalizeEmail(c.Email)
+	c.Phone = strings.TrimSpace(c.Phone)
+}
+
+// Validate checks that the contact has a plain email address and, if given, a
+// plausible phone number
+func (c GuestContact) Validate() error {
+	if c.Email == "" {
+		return fmt.Errorf("%w: email is required", ErrInvalidGuestContact)
+	}
+	addr, err := mail.ParseAddress(c.Email)
+	if err != nil || addr.Address != c.Email {
+		return fmt.Errorf("%w: email %q is not a valid address", ErrInvalidGuestContact, c.Email)
+	}
+	if len(c.Phone) > maxGuestPhoneLength {
+		return fmt.Errorf("%w: phone is longer than %d characters", ErrInvalidGuestContact, maxGuestPhoneLength)
+	}
+	for _, r := range c.Phone {
+		if !strings.ContainsRune("0123456789+-() ", r) {
+			return fmt.Errorf("%w: phone may only hold digits, spaces and + - ( )", ErrInvalidGuestContact)
+		}
+	}
+	return nil
+}
+
+// NormalizeEmail trims and lowercases an email address
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// DefaultGuestLinkTTL is how long a guest order link opens the order when no
+// other lifetime is configured
+const DefaultGuestLinkTTL = 30 * 24 * time.Hour
+
+// GuestLinkSigner signs and checks the tokens in the links guests read their
+// orders through. A token binds an order ID to the guest's email and to the
+// time it expires, so it only opens that order, only while the order belongs
+// to that guest and only until it expires.
+type GuestLinkSigner struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+// NewGuestLinkSigner creates a signer whose tokens expire after ttl, or after
+// DefaultGuestLinkTTL when ttl is not positive; an empty secret disables
+// guest links
+func NewGuestLinkSigner(secret string, ttl time.Duration) GuestLinkSigner {
+	if ttl <= 0 {
+		ttl = DefaultGuestLinkTTL
+	}
+	return GuestLinkSigner{secret: []byte(secret), ttl: ttl}
+}
+
+// Enabled reports whether a secret is configured
+func (s GuestLinkSigner) Enabled() bool {
+	return len(s.secret) > 0
+}
+
+// Sign returns the token for an order of the guest with email, or "" when
+// guest links are disabled
+func (s GuestLinkSigner) Sign(orderID, email string) string {
+	return s.sign(orderID, email, time.Now().Add(s.ttl))
+}
+
+// Verify reports whether token was signed for the order and email and has
+// not expired
+func (s GuestLinkSigner) Verify(orderID, email, token string) bool {
+	return s.verify(orderID, email, token, time.Now())
+}
+
+// sign returns the token for the order and email expiring at expiresAt. The
+// expiry, in Unix seconds, comes first and is covered by the MAC.
+func (s GuestLinkSigner) sign(orderID, email string, expiresAt time.Time) string {
+	if !s.Enabled() {
+		return ""
+	}
+	exp := expiresAt.Unix()
+	return strconv.FormatInt(exp, 10) + "." + base64.RawURLEncoding.EncodeToString(s.mac(orderID, email, exp))
+}
+
+// verify reports whether token was signed for the order and email and is
+// still valid at now
+func (s GuestLinkSigner) verify(orderID, email, token string, now time.Time) bool {
+	if !s.Enabled() || token == "" {
+		return false
+	}
+	expPart, macPart, ok := strings.Cut(token, ".")
+	if !ok {
+		return false
+	}
+	exp, err := strconv.ParseInt(expPart, 10, 64)
+	if err != nil {
+		return false
+	}
+	got, err := base64.RawURLEncoding.DecodeString(macPart)
+	if err != nil || !hmac.Equal(got, s.mac(orderID, email, exp)) {
+		return false
+	}
+	return now.Unix() < exp
+}
+
+func (s GuestLinkSigner) mac(orderID, email string, exp int64) []byte {
+	h := hmac.New(sha256.New, s.secret)
+	h.Write([]byte(orderID))
+	h.Write([]byte{0})
+	h.Write([]byte(NormalizeEmail(email)))
+	h.Write([]byte{0})
+	h.Write([]byte(strconv.FormatInt(exp, 10)))
+	return h.Sum(nil)
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGuestContactValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		contact GuestContact
+		wantErr bool
+	}{
+		{name: "email only", contact: GuestContact{Email: " Jane@Example.com "}},
+		{name: "email and phone", contact: GuestContact{Email: "jane@example.com", Phone: "+32 (0)470-12 34 56"}},
+		{name: "no email", contact: GuestContact{Phone: "+32470123456"}, wantErr: true},
+		{name: "display name", contact: GuestContact{Email: "Jane <jane@example.com>"}, wantErr: true},
+		{name: "not an address", contact: GuestContact{Email: "jane"}, wantErr: true},
+		{name: "letters in phone", contact: GuestContact{Email: "jane@example.com", Phone: "call me"}, wantErr: true},
+		{name: "phone too long", contact: GuestContact{Email: "jane@example.com", Phone: strings.Repeat("1", maxGuestPhoneLength+1)}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contact := tt.contact
+			contact.Normalize()
+			err := contact.Validate()
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Validate() = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidGuestContact) {
+				t.Fatalf("Validate() = %v, want ErrInvalidGuestContact", err)
+			}
+		})
+	}
+}
+
+func TestGuestLinkSigner(t *testing.T) {
+	signer := NewGuestLinkSigner("secret", time.Hour)
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	token := signer.sign("order-1", "jane@example.com", now.Add(time.Hour))
+
+	tests := []struct {
+		name    string
+		signer  GuestLinkSigner
+		orderID string
+		email   string
+		token   string
+		at      time.Time
+		want    bool
+	}{
+		{name: "valid", signer: signer, orderID: "order-1", email: "jane@example.com", token: token, at: now, want: true},
+		{name: "email case differs", signer: signer, orderID: "order-1", email: " JANE@example.com", token: token, at: now, want: true},
+		{name: "just before expiry", signer: signer, orderID: "order-1", email: "jane@example.com", token: token, at: now.Add(time.Hour - time.Second), want: true},
+		{name: "at expiry", signer: signer, orderID: "order-1", email: "jane@example.com", token: token, at: now.Add(time.Hour)},
+		{name: "expired", signer: signer, orderID: "order-1", email: "jane@example.com", token: token, at: now.Add(48 * time.Hour)},
+		{name: "other order", signer: signer, orderID: "order-2", email: "jane@example.com", token: token, at: now},
+		{name: "other email", signer: signer, orderID: "order-1", email: "john@example.com", token: token, at: now},
+		{name: "other secret", signer: NewGuestLinkSigner("other", time.Hour), orderID: "order-1", email: "jane@example.com", token: token, at: now},
+		{name: "disabled", signer: GuestLinkSigner{}, orderID: "order-1", email: "jane@example.com", token: token, at: now},
+		{name: "empty token", signer: signer, orderID: "order-1", email: "jane@example.com", at: now},
+		{name: "no expiry", signer: signer, orderID: "order-1", email: "jane@example.com", token: token[strings.Index(token, ".")+1:], at: now},
+		{name: "not base64", signer: signer, orderID: "order-1", email: "jane@example.com", token: strings.Split(token, ".")[0] + ".!!", at: now},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.signer.verify(tt.orderID, tt.email, tt.token, tt.at); got != tt.want {
+				t.Fatalf("verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGuestLinkSignerRejectsExtendedExpiry(t *testing.T) {
+	signer := NewGuestLinkSigner("secret", time.Hour)
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	token := signer.sign("order-1", "jane@example.com", now.Add(-time.Minute))
+	if signer.verify("order-1", "jane@example.com", token, now) {
+		t.Fatal("an expired token must not verify")
+	}
+
+	_, mac, _ := strings.Cut(token, ".")
+	extended := "9999999999." + mac
+	if signer.verify("order-1", "jane@example.com", extended, now) {
+		t.Fatal("a token whose expiry was moved must not verify")
+	}
+}
+
+func TestGuestLinkSignerTTL(t *testing.T) {
+	tests := []struct {
+		name string
+		ttl  time.Duration
+		want time.Duration
+	}{
+		{name: "configured", ttl: time.Hour, want: time.Hour},
+		{name: "unset", want: DefaultGuestLinkTTL},
+		{name: "negative", ttl: -time.Hour, want: DefaultGuestLinkTTL},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer := NewGuestLinkSigner("secret", tt.ttl)
+			before := time.Now()
+			token := signer.Sign("order-1", "jane@example.com")
+
+			if !signer.Verify("order-1", "jane@example.com", token) {
+				t.Fatal("a fresh token must verify")
+			}
+			if signer.verify("order-1", "jane@example.com", token, before.Add(tt.want+time.Second)) {
+				t.Fatalf("token still valid after %v", tt.want)
+			}
+		})
+	}
+}
+
+func TestGuestLinkSignerDisabled(t *testing.T) {
+	if token := NewGuestLinkSigner("", time.Hour).Sign("order-1", "jane@example.com"); token != "" {
+		t.Fatalf("Sign() = %q, want no token without a secret", token)
+	}
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidItemCancellation is returned when lines of an order cannot be cancelled
+var ErrInvalidItemCancellation = errors.New("invalid item cancellation")
+
+// CancelItems removes the lines of the given products from the order and
+// recalculates its total, returning the removed lines. Items can only be
+// cancelled while the whole order could be, and not once any of their units
+// have shipped. When the products cover every line nothing is removed and
+// cancelsOrder is true: the order is to be cancelled as a whole instead.
+func (o *Order) CancelItems(productIDs []string) (removed []OrderItem, cancelsOrder bool, err error) {
+	if len(productIDs) == 0 {
+		return nil, false, fmt.Errorf("%w: no products given", ErrInvalidItemCancellation)
+	}
+	if err := ValidateStatusTransition(o.Status, StatusCancelled); err != nil {
+		return nil, false, err
+	}
+
+	cancel := make(map[string]bool, len(productIDs))
+	fulfilled := o.FulfilledQuantities()
+	for _, productID := range productIDs {
+		if !o.hasProduct(productID) {
+			return nil, false, fmt.Errorf("%w: product %s is not part of order %s", ErrInvalidItemCancellation, productID, o.ID)
+		}
+		if fulfilled[productID] > 0 {
+			return nil, false, fmt.Errorf("%w: product %s has already shipped", ErrInvalidItemCancellation, productID)
+		}
+		cancel[productID] = true
+	}
+
+	kept := make([]OrderItem, 0, len(o.Items))
+	for _, item := range o.Items {
+		if cancel[item.ProductID] {
+			removed = append(removed, item)
+		} else {
+			kept = append(kept, item)
+		}
+	}
+	if len(kept) == 0 {
+		return nil, true, nil
+	}
+
+	o.Items = kept
+	o.Recalculate()
+	return removed, false, nil
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func newCancellableOrder() *Order {
+	return NewOrder("customer-1", []OrderItem{
+		{ProductID: "lamp", Quantity: 2, Price: 15, Subtotal: 30},
+		{ProductID: "desk", Quantity: 1, Price: 120, Subtotal: 120},
+		{ProductID: "chair", Quantity: 4, Price: 12.5, Subtotal: 50},
+	}, Address{}, Address{})
+}
+
+func TestCancelItemsRecomputesTotal(t *testing.T) {
+	order := newCancellableOrder()
+	version := order.Version
+
+	removed, cancelsOrder, err := order.CancelItems([]string{"lamp", "chair"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cancelsOrder {
+		t.Fatal("cancelling two of three lines should not cancel the order")
+	}
+	if len(removed) != 2 || removed[0].ProductID != "lamp" || removed[1].ProductID != "chair" {
+		t.Fatalf("removed = %+v, want the lamp and chair lines", removed)
+	}
+	if len(order.Items) != 1 || order.Items[0].ProductID != "desk" {
+		t.Fatalf("items = %+v, want only the desk", order.Items)
+	}
+	if order.TotalAmount != 120 {
+		t.Fatalf("total = %v, want 120", order.TotalAmount)
+	}
+	if order.Version != version+1 {
+		t.Fatalf("version = %d, want %d", order.Version, version+1)
+	}
+	if order.Status != StatusCreated {
+		t.Fatalf("status = %s, want the order to go on", order.Status)
+	}
+}
+
+func TestCancelItemsOfEveryLineCancelsOrder(t *testing.T) {
+	order := newCancellableOrder()
+
+	removed, cancelsOrder, err := order.CancelItems([]string{"lamp", "desk", "chair"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cancelsOrder || removed != nil {
+		t.Fatalf("cancelsOrder = %v, removed = %v, want the whole order cancelled instead", cancelsOrder, removed)
+	}
+	if len(order.Items) != 3 || order.TotalAmount != 200 {
+		t.Fatalf("items %d, total %v: the order should be left for the full cancellation", len(order.Items), order.TotalAmount)
+	}
+}
+
+func TestCancelItemsRejected(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     OrderStatus
+		shipped    map[string]int32
+		productIDs []string
+		wantErr    error
+	}{
+		{name: "shipped order", status: StatusShipped, productIDs: []string{"lamp"}, wantErr: ErrInvalidStatusTransition},
+		{name: "delivered order", status: StatusDelivered, productIDs: []string{"lamp"}, wantErr: ErrInvalidStatusTransition},
+		{name: "cancelled order", status: StatusCancelled, productIDs: []string{"lamp"}, wantErr: ErrInvalidStatusTransition},
+		{name: "line partly shipped", status: StatusPaid, shipped: map[string]int32{"lamp": 1}, productIDs: []string{"lamp"}, wantErr: ErrInvalidItemCancellation},
+		{name: "product not in order", status: StatusPaid, productIDs: []string{"sofa"}, wantErr: ErrInvalidItemCancellation},
+		{name: "no products", status: StatusPaid, wantErr: ErrInvalidItemCancellation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := newCancellableOrder()
+			order.Status = tt.status
+			for productID, quantity := range tt.shipped {
+				order.Shipments = append(order.Shipments, Shipment{ID: "shipment-1", Items: []ShipmentItem{{ProductID: productID, Quantity: quantity}}})
+			}
+			version := order.Version
+
+			_, _, err := order.CancelItems(tt.productIDs)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if len(order.Items) != 3 || order.TotalAmount != 200 || order.Version != version {
+				t.Fatalf("items %d, total %v, version %d: a rejected cancellation must leave the order unchanged",
+					len(order.Items), order.TotalAmount, order.Version)
+			}
+		})
+	}
+}
@@ -2,9 +2,13 @@ package domain
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/countries"
 )
 
 // OrderSourceType represents the source of an order
@@ -41,31 +45,44 @@ const (
 	StatusFailed OrderStatus = "FAILED"
 )
 
-// ValidateStatusTransition checks if a status transition is valid
-func ValidateStatusTransition(current, new OrderStatus) error {
-	// Define valid status transitions
-	validTransitions := map[OrderStatus][]OrderStatus{
-		StatusCreated:   {StatusPending, StatusPaid, StatusCancelled, StatusFailed},
-		StatusPending:   {StatusPaid, StatusCancelled, StatusFailed},
-		StatusPaid:      {StatusShipped, StatusCancelled},
-		StatusShipped:   {StatusDelivered, StatusFailed},
-		StatusDelivered: {}, // Terminal state
-		StatusCancelled: {}, // Terminal state
-		StatusFailed:    {StatusPending}, // Can retry failed orders
-	}
+// ErrInvalidStatusTransition is returned when an order cannot move from its
+// current status to the requested one
+var ErrInvalidStatusTransition = errors.New("invalid status transition")
 
-	allowed, exists := validTransitions[current]
-	if !exists {
-		return errors.New("invalid current status")
-	}
+// statusTransitions is the order state machine: the statuses each status can
+// move to. Orders go CREATED → PENDING → PAID → SHIPPED → DELIVERED. Payment
+// may also be taken straight from CREATED, as at a POS terminal. Orders can
+// only be cancelled before they ship, and failed orders can be retried.
+var statusTransitions = map[OrderStatus][]OrderStatus{
+	StatusCreated:   {StatusPending, StatusPaid, StatusCancelled, StatusFailed},
+	StatusPending:   {StatusPaid, StatusCancelled, StatusFailed},
+	StatusPaid:      {StatusShipped, StatusCancelled},
+	StatusShipped:   {StatusDelivered, StatusFailed},
+	StatusDelivered: {}, // Terminal state
+	StatusCancelled: {}, // Terminal state
+	StatusFailed:    {StatusPending},
+}
 
-	for _, allowedStatus := range allowed {
-		if allowedStatus == new {
-			return nil
+// CanTransitionTo reports whether an order in status current may move to next
+func CanTransitionTo(current, next OrderStatus) bool {
+	for _, allowed := range statusTransitions[current] {
+		if allowed == next {
+			return true
 		}
 	}
+	return false
+}
 
-	return errors.New("invalid status transition from " + string(current) + " to " + string(new))
+// ValidateStatusTransition checks a status transition against the order
+// state machine. Illegal transitions fail with ErrInvalidStatusTransition.
+func ValidateStatusTransition(current, next OrderStatus) error {
+	if _, known := statusTransitions[current]; !known {
+		return fmt.Errorf("%w: unknown current status %q", ErrInvalidStatusTransition, current)
+	}
+	if !CanTransitionTo(current, next) {
+		return fmt.Errorf("%w from %s to %s", ErrInvalidStatusTransition, current, next)
+	}
+	return nil
 }
 
 // IsTerminalStatus returns true if the status is a terminal state
@@ -81,6 +98,9 @@ type OrderItem struct {
 	Quantity   int32   `bson:"quantity"`
 	Price      float64 `bson:"price"`
 	Subtotal   float64 `bson:"subtotal"`
+	// Components is set when the product is a bundle: the products each unit
+	// is made of, as the catalog listed them when the order was placed
+	Components []OrderItemComponent `bson:"components,omitempty"`
 }
 
 // Address represents a shipping or billing address
@@ -92,6 +112,62 @@ type Address struct {
 	Country    string `bson:"country"`
 }
 
+// IsEmpty returns true if no address field has been set. Clients that always
+// send an address message send an empty one when there is none.
+func (a Address) IsEmpty() bool {
+	for _, field := range []string{a.Street, a.City, a.State, a.PostalCode, a.Country} {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// ResolveOrderAddresses validates the addresses of a new order. The shipping
+// address may only be left empty when shippingRequired is false, e.g. for
+// store orders handed over in person; an empty billing address defaults to
+// the shipping address.
+func ResolveOrderAddresses(shipping, billing Address, shippingRequired bool) (Address, Address, error) {
+	if shipping.IsEmpty() {
+		if shippingRequired {
+			return Address{}, Address{}, errors.New("shipping_address is required")
+		}
+		shipping = Address{}
+	} else if err := shipping.Validate(); err != nil {
+		return Address{}, Address{}, fmt.Errorf("invalid shipping_address: %w", err)
+	}
+
+	if billing.IsEmpty() {
+		return shipping, shipping, nil
+	}
+	if err := billing.Validate(); err != nil {
+		return Address{}, Address{}, fmt.Errorf("invalid billing_address: %w", err)
+	}
+	return shipping, billing, nil
+}
+
+// Validate checks that the address has the fields required for delivery,
+// mirroring the required fields of user addresses, and that the country is
+// an assigned ISO 3166-1 alpha-2 code
+func (a Address) Validate() error {
+	if strings.TrimSpace(a.Street) == "" {
+		return errors.New("street is required")
+	}
+	if strings.TrimSpace(a.City) == "" {
+		return errors.New("city is required")
+	}
+	if strings.TrimSpace(a.PostalCode) == "" {
+		return errors.New("postal_code is required")
+	}
+	if strings.TrimSpace(a.Country) == "" {
+		return errors.New("country is required")
+	}
+	if !countries.IsCode(a.Country) {
+		return errors.New("country must be an ISO 3166-1 alpha-2 code")
+	}
+	return nil
+}
+
 // Payment represents payment information for an order
 type Payment struct {
 	Method        string    `bson:"method"`
@@ -99,12 +175,15 @@ type Payment struct {
 	Amount        float64   `bson:"amount"`
 	Status        string    `bson:"status"`
 	Timestamp     time.Time `bson:"timestamp,omitempty"`
+	RecordedBy    string    `bson:"recorded_by,omitempty"`
 }
 
 // Order represents a customer order
 type Order struct {
 	ID            string          `bson:"_id,omitempty"`
-	UserID        string          `bson:"user_id"`
+	UserID        string          `bson:"user_id"` // Empty for guest orders until LinkGuestOrders links them
+	// Guest is the contact of a buyer who ordered without an account
+	Guest         *GuestContact   `bson:"guest,omitempty"`
 	Items         []OrderItem     `bson:"items"`
 	TotalAmount   float64         `bson:"total_amount"`
 	Status        OrderStatus     `bson:"status"`
@@ -113,13 +192,29 @@ type Order struct {
 	BillingAddr   Address         `bson:"billing_address"`
 	Payment       Payment         `bson:"payment,omitempty"`
 	TrackingCode  string          `bson:"tracking_code,omitempty"`
-	Notes         string          `bson:"notes,omitempty"`
+	Notes         string          `bson:"notes,omitempty"`     // Latest note's text, kept for clients that predate NoteLog
+	NoteLog       []OrderNote     `bson:"note_log,omitempty"`  // Every note added to the order, oldest first
 	Version       int32           `bson:"version"`           // For optimistic locking
 	CreatedAt     time.Time       `bson:"created_at"`
 	UpdatedAt     time.Time       `bson:"updated_at"`
 	CompletedAt   time.Time       `bson:"completed_at,omitempty"`
 	LocationID    string          `bson:"location_id,omitempty"` // Store location for POS orders
 	StaffID       string          `bson:"staff_id,omitempty"`    // Staff member who processed the POS order
+	CancelReason  string          `bson:"cancel_reason,omitempty"` // Why the order was cancelled, when the system cancelled it
+	// Every status change of the order, oldest first
+	StatusHistory []StatusChange `bson:"status_history,omitempty"`
+
+	// Shipments sent for the order, oldest first; see FulfillmentStatus
+	Shipments []Shipment `bson:"shipments,omitempty"`
+}
+
+// CancelReasonPaymentTimeout marks orders cancelled because they were not paid in time
+const CancelReasonPaymentTimeout = "payment-timeout"
+
+// OrderSummary aggregates the orders of a period
+type OrderSummary struct {
+	OrderCount int64
+	Revenue    float64
 }
 
 // NewOrder creates a new order
@@ -155,6 +250,20 @@ func NewOrderWithSource(
 	return order
 }
 
+// NewGuestOrder creates an order for a buyer without an account, reached
+// through contact
+func NewGuestOrder(contact GuestContact, items []OrderItem, shippingAddr, billingAddr Address) *Order {
+	order := NewOrder("", items, shippingAddr, billingAddr)
+	order.Guest = &contact
+	return order
+}
+
+// IsGuest reports whether the order was placed by a guest and is not yet
+// linked to an account
+func (o *Order) IsGuest() bool {
+	return o.Guest != nil && o.UserID == ""
+}
+
 // CalculateTotal calculates the total amount for the order
 func calculateTotal(items []OrderItem) float64 {
 	var total float64
@@ -170,11 +279,23 @@ func (o *Order) IncrementVersion() {
 	o.UpdatedAt = time.Now()
 }
 
-// UpdateStatus updates the order status
-func (o *Order) UpdateStatus(status OrderStatus) error {
+// UpdateStatus updates the order status and records the change in the
+// status history. changedBy is the user making the change, or empty when the
+// system makes it.
+func (o *Order) UpdateStatus(status OrderStatus, changedBy string) error {
 	if err := ValidateStatusTransition(o.Status, status); err != nil {
 		return err
 	}
+	// Stock that has left cannot be released again
+	if status == StatusCancelled && len(o.Shipments) > 0 {
+		return fmt.Errorf("%w: cannot cancel an order that has partly shipped", ErrInvalidStatusTransition)
+	}
+	o.StatusHistory = append(o.StatusHistory, StatusChange{
+		From:      o.Status,
+		To:        status,
+		ChangedBy: changedBy,
+		ChangedAt: time.Now(),
+	})
 	o.Status = status
 	o.IncrementVersion()
 	if status == StatusDelivered {
@@ -184,7 +305,7 @@ func (o *Order) UpdateStatus(status OrderStatus) error {
 }
 
 // AddPayment adds payment information to the order
-func (o *Order) AddPayment(method string, transactionID string, amount float64) error {
+func (o *Order) AddPayment(method string, transactionID string, amount float64, changedBy string) error {
 	if err := ValidateStatusTransition(o.Status, StatusPaid); err != nil {
 		return err
 	}
@@ -194,8 +315,9 @@ func (o *Order) AddPayment(method string, transactionID string, amount float64)
 		Amount:        amount,
 		Status:        "COMPLETED",
 		Timestamp:     time.Now(),
+		RecordedBy:    changedBy,
 	}
-	if err := o.UpdateStatus(StatusPaid); err != nil {
+	if err := o.UpdateStatus(StatusPaid, changedBy); err != nil {
 		return err
 	}
 	return nil
@@ -215,39 +337,61 @@ func (o *Order) SetPOSInfo(locationID, staffID string) {
 }
 
 // AddTrackingCode adds a tracking code to the order
-func (o *Order) AddTrackingCode(code string) error {
+func (o *Order) AddTrackingCode(code, changedBy string) error {
 	if err := ValidateStatusTransition(o.Status, StatusShipped); err != nil {
 		return err
 	}
 	o.TrackingCode = code
-	if err := o.UpdateStatus(StatusShipped); err != nil {
+	if err := o.UpdateStatus(StatusShipped, changedBy); err != nil {
 		return err
 	}
 	return nil
 }
 
 // Cancel cancels the order
-func (o *Order) Cancel() error {
+func (o *Order) Cancel(changedBy string) error {
 	if err := ValidateStatusTransition(o.Status, StatusCancelled); err != nil {
 		return err
 	}
-	if err := o.UpdateStatus(StatusCancelled); err != nil {
+	if err := o.UpdateStatus(StatusCancelled, changedBy); err != nil {
 		return err
 	}
 	return nil
 }
 
+// IsAwaitingPayment reports whether the order was placed but not paid yet
+func (o *Order) IsAwaitingPayment() bool {
+	return o.Status == StatusCreated || o.Status == StatusPending
+}
+
 // Recalculate recalculates the order total
 func (o *Order) Recalculate() {
 	o.TotalAmount = calculateTotal(o.Items)
 	o.IncrementVersion()
 }
 
-// AddNote adds a note to the order
-func (o *Order) AddNote(note string) {
-	if o.Notes != "" {
-		o.Notes += "\n"
+// AddNote appends a note to the order's note log and makes it the latest
+// note. A product ID attaches the note to that item of the order.
+func (o *Order) AddNote(authorID, text, productID string) (*OrderNote, error) {
+	note, err := NewOrderNote(authorID, text, productID)
+	if err != nil {
+		return nil, err
 	}
-	o.Notes += note
+	if productID != "" && !o.hasProduct(productID) {
+		return nil, fmt.Errorf("%w: product %s is not part of order %s", ErrInvalidNote, productID, o.ID)
+	}
+	o.NoteLog = append(o.NoteLog, *note)
+	o.Notes = note.Text
 	o.IncrementVersion()
+	return note, nil
+}
+
+// hasProduct reports whether productID is one of the order's items
+func (o *Order) hasProduct(productID string) bool {
+	for _, item := range o.Items {
+		if item.ProductID == productID {
+			return true
+		}
+	}
+	return false
 }
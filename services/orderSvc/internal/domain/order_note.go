@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidNote is returned for notes that cannot be added to an order
+var ErrInvalidNote = errors.New("invalid order note")
+
+// maxOrderNoteLength bounds the text of a single order note
+const maxOrderNoteLength = 2000
+
+// OrderNote is an entry in an order's append-only note log. Notes with a
+// product ID are about that item only, e.g. "item damaged in transit".
+type OrderNote struct {
+	ID        string    `bson:"id"`
+	AuthorID  string    `bson:"author_id,omitempty"` // Empty for notes added by the system
+	Text      string    `bson:"text"`
+	ProductID string    `bson:"product_id,omitempty"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// NewOrderNote validates the text and creates a note
+func NewOrderNote(authorID, text, productID string) (*OrderNote, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, fmt.Errorf("%w: text is required", ErrInvalidNote)
+	}
+	if len(text) > maxOrderNoteLength {
+		return nil, fmt.Errorf("%w: text is longer than %d characters", ErrInvalidNote, maxOrderNoteLength)
+	}
+	return &OrderNote{
+		ID:        uuid.New().String(),
+		AuthorID:  authorID,
+		Text:      text,
+		ProductID: productID,
+		CreatedAt: time.Now(),
+	}, nil
+}
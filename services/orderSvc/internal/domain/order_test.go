@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func validAddress() Address {
+	return Address{
+		Street:     "1 Main Street",
+		City:       "Ghent",
+		PostalCode: "9000",
+		Country:    "BE",
+	}
+}
+
+func TestAddressValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(*Address)
+		wantErr string
+	}{
+		{name: "valid", mutate: func(*Address) {}},
+		{name: "missing street", mutate: func(a *Address) { a.Street = " " }, wantErr: "street is required"},
+		{name: "missing city", mutate: func(a *Address) { a.City = "" }, wantErr: "city is required"},
+		{name: "missing postal code", mutate: func(a *Address) { a.PostalCode = "" }, wantErr: "postal_code is required"},
+		{name: "missing country", mutate: func(a *Address) { a.Country = "" }, wantErr: "country is required"},
+		{name: "three-letter country", mutate: func(a *Address) { a.Country = "BEL" }, wantErr: "ISO 3166-1"},
+		{name: "numeric country", mutate: func(a *Address) { a.Country = "12" }, wantErr: "ISO 3166-1"},
+		{name: "unassigned country", mutate: func(a *Address) { a.Country = "XX" }, wantErr: "ISO 3166-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr := validAddress()
+			tt.mutate(&addr)
+			err := addr.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Validate() = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAddressIsEmpty(t *testing.T) {
+	if !(Address{}).IsEmpty() {
+		t.Error("zero address should be empty")
+	}
+	if !(Address{Street: "  ", Country: " "}).IsEmpty() {
+		t.Error("address of blanks should be empty")
+	}
+	if (Address{City: "Ghent"}).IsEmpty() {
+		t.Error("address with a city should not be empty")
+	}
+}
+
+func TestResolveOrderAddresses(t *testing.T) {
+	shipping := validAddress()
+	billing := validAddress()
+	billing.Street = "2 Station Road"
+
+	t.Run("missing shipping address is rejected", func(t *testing.T) {
+		_, _, err := ResolveOrderAddresses(Address{}, billing, true)
+		if err == nil || !strings.Contains(err.Error(), "shipping_address is required") {
+			t.Fatalf("err = %v, want shipping_address is required", err)
+		}
+	})
+
+	t.Run("missing shipping address is allowed when not required", func(t *testing.T) {
+		gotShipping, gotBilling, err := ResolveOrderAddresses(Address{}, Address{}, false)
+		if err != nil {
+			t.Fatalf("err = %v", err)
+		}
+		if !gotShipping.IsEmpty() || !gotBilling.IsEmpty() {
+			t.Fatalf("got %+v / %+v, want empty addresses", gotShipping, gotBilling)
+		}
+	})
+
+	t.Run("billing defaults to shipping", func(t *testing.T) {
+		gotShipping, gotBilling, err := ResolveOrderAddresses(shipping, Address{}, true)
+		if err != nil {
+			t.Fatalf("err = %v", err)
+		}
+		if gotShipping != shipping || gotBilling != shipping {
+			t.Fatalf("got %+v / %+v, want both %+v", gotShipping, gotBilling, shipping)
+		}
+	})
+
+	t.Run("explicit billing is kept", func(t *testing.T) {
+		_, gotBilling, err := ResolveOrderAddresses(shipping, billing, true)
+		if err != nil {
+			t.Fatalf("err = %v", err)
+		}
+		if gotBilling != billing {
+			t.Fatalf("billing = %+v, want %+v", gotBilling, billing)
+		}
+	})
+
+	t.Run("invalid shipping is rejected", func(t *testing.T) {
+		bad := shipping
+		bad.City = ""
+		_, _, err := ResolveOrderAddresses(bad, Address{}, true)
+		if err == nil || !strings.HasPrefix(err.Error(), "invalid shipping_address") {
+			t.Fatalf("err = %v, want invalid shipping_address", err)
+		}
+	})
+
+	t.Run("invalid billing is rejected", func(t *testing.T) {
+		bad := billing
+		bad.Country = "Belgium"
+		_, _, err := ResolveOrderAddresses(shipping, bad, true)
+		if err == nil || !strings.HasPrefix(err.Error(), "invalid billing_address") {
+			t.Fatalf("err = %v, want invalid billing_address", err)
+		}
+	})
+}
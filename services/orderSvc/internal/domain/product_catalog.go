@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// UnknownProductsError is returned when an order references products that do
+// not exist in the product catalog
+type UnknownProductsError struct {
+	ProductIDs []string
+}
+
+func (e *UnknownProductsError) Error() string {
+	return "unknown product IDs: " + strings.Join(e.ProductIDs, ", ")
+}
+
+// OrderQuantityRule limits the quantity of a product on one order line: at
+// least Min, at most Max, in multiples of Increment. Zero means no limit.
+type OrderQuantityRule struct {
+	Min       int32
+	Max       int32
+	Increment int32
+}
+
+// Check returns an *OrderQuantityError when quantity breaks the rule
+func (r OrderQuantityRule) Check(productID string, quantity int32) error {
+	var reason string
+	switch {
+	case r.Min > 0 && quantity < r.Min:
+		reason = fmt.Sprintf("is below the minimum order quantity of %d", r.Min)
+	case r.Max > 0 && quantity > r.Max:
+		reason = fmt.Sprintf("exceeds the maximum order quantity of %d", r.Max)
+	case r.Increment > 1 && quantity%r.Increment != 0:
+		reason = fmt.Sprintf("is not a multiple of %d", r.Increment)
+	default:
+		return nil
+	}
+	return &OrderQuantityError{ProductID: productID, Quantity: quantity, Reason: reason}
+}
+
+// OrderQuantityError is returned when an order line's quantity breaks its
+// product's OrderQuantityRule
+type OrderQuantityError struct {
+	ProductID string
+	Quantity  int32
+	Reason    string
+}
+
+func (e *OrderQuantityError) Error() string {
+	return fmt.Sprintf("quantity %d of product %s %s", e.Quantity, e.ProductID, e.Reason)
+}
+
+// ProductLookup is what the catalog knows about the products of an order
+type ProductLookup struct {
+	// MissingIDs lists the looked-up IDs that do not match a product
+	MissingIDs []string
+	// QuantityRules holds the order quantity rule of every product found
+	QuantityRules map[string]OrderQuantityRule
+	// Bundles holds the components of the products found that are bundles
+	Bundles map[string][]OrderItemComponent
+}
+
+// ProductCatalog checks order items against the product service
+type ProductCatalog interface {
+	// LookupProducts looks up all of productIDs
+	LookupProducts(ctx context.Context, productIDs []string) (*ProductLookup, error)
+}
@@ -3,6 +3,7 @@ package domain
 import (
 	"context"
 	"errors"
+	"time"
 )
 
 // ErrOptimisticLockFailed is returned when an optimistic lock update fails due to version mismatch
@@ -13,18 +14,32 @@ type OrderRepository interface {
 	// Create adds a new order
 	Create(ctx context.Context, order *Order) error
 	
+	// CreateWithStep adds a new order and runs step, keeping the order only
+	// if step succeeds. Where MongoDB supports transactions the insert and
+	// step share one, and step receives the transaction's context; otherwise
+	// the order is inserted first and deleted again when step fails.
+	CreateWithStep(ctx context.Context, order *Order, step func(ctx context.Context) error) error
+	
 	// GetByID finds an order by its ID
 	GetByID(ctx context.Context, id string) (*Order, error)
 	
 	// GetByUserID finds orders for a specific user
 	GetByUserID(ctx context.Context, userID string, limit, offset int) ([]*Order, error)
 	
+	// LinkGuestOrders gives the guest orders placed with email that are not
+	// linked yet to userID and returns how many it linked
+	LinkGuestOrders(ctx context.Context, email, userID string) (int64, error)
+	
 	// Update updates an existing order
 	Update(ctx context.Context, order *Order) error
 	
 	// UpdateWithOptimisticLock updates an order with version checking to prevent concurrent modifications
 	UpdateWithOptimisticLock(ctx context.Context, order *Order, expectedVersion int32) error
 	
+	// AppendNote adds a note to an order's note log and makes it the latest
+	// note, without touching the rest of the order
+	AppendNote(ctx context.Context, orderID string, note *OrderNote) error
+	
 	// Delete removes an order
 	Delete(ctx context.Context, id string) error
 	
@@ -33,4 +48,11 @@ type OrderRepository interface {
 	
 	// Count returns the number of orders matching a filter
 	Count(ctx context.Context, filter map[string]interface{}) (int64, error)
+	
+	// ListAwaitingPayment returns up to limit online orders in CREATED or
+	// PENDING that were created before createdBefore, oldest first
+	ListAwaitingPayment(ctx context.Context, createdBefore time.Time, limit int) ([]*Order, error)
+	
+	// Summarize counts the non-cancelled orders created in [from, to) and sums their totals
+	Summarize(ctx context.Context, from, to time.Time) (*OrderSummary, error)
 }
@@ -0,0 +1,175 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrReturnNotFound is returned when a return (RMA) does not exist
+var ErrReturnNotFound = errors.New("return not found")
+
+// ReturnStatus represents the state of a return (RMA)
+type ReturnStatus string
+
+const (
+	// ReturnStatusRequested is a return the customer has asked for
+	ReturnStatusRequested ReturnStatus = "REQUESTED"
+	// ReturnStatusApproved is a return that may be shipped back
+	ReturnStatusApproved ReturnStatus = "APPROVED"
+	// ReturnStatusReceived is a return whose items arrived and were restocked
+	ReturnStatusReceived ReturnStatus = "RECEIVED"
+	// ReturnStatusRefunded is a received return that has been refunded
+	ReturnStatusRefunded ReturnStatus = "REFUNDED"
+	// ReturnStatusRejected is a return that was refused
+	ReturnStatusRejected ReturnStatus = "REJECTED"
+)
+
+// ValidateReturnTransition checks if a return status transition is valid
+func ValidateReturnTransition(current, next ReturnStatus) error {
+	validTransitions := map[ReturnStatus][]ReturnStatus{
+		ReturnStatusRequested: {ReturnStatusApproved, ReturnStatusRejected},
+		ReturnStatusApproved:  {ReturnStatusReceived, ReturnStatusRejected},
+		ReturnStatusReceived:  {ReturnStatusRefunded},
+		ReturnStatusRefunded:  {}, // Terminal state
+		ReturnStatusRejected:  {}, // Terminal state
+	}
+
+	allowed, exists := validTransitions[current]
+	if !exists {
+		return errors.New("invalid current return status")
+	}
+	for _, s := range allowed {
+		if s == next {
+			return nil
+		}
+	}
+	return errors.New("invalid return status transition from " + string(current) + " to " + string(next))
+}
+
+// ItemCondition describes the state a returned item arrived in
+type ItemCondition string
+
+const (
+	// ConditionSellable items go back into sellable stock
+	ConditionSellable ItemCondition = "SELLABLE"
+	// ConditionDamaged items are restocked into the damaged bucket
+	ConditionDamaged ItemCondition = "DAMAGED"
+)
+
+// ReturnLine is a product and quantity being returned
+type ReturnLine struct {
+	ProductID string        `bson:"product_id"`
+	Quantity  int32         `bson:"quantity"`
+	Condition ItemCondition `bson:"condition"`
+	// Restocked is set once the line has been put back into inventory, so a
+	// receipt that failed halfway can be retried without restocking twice
+	Restocked bool `bson:"restocked"`
+}
+
+// Return is a return merchandise authorization (RMA) for part or all of an order
+type Return struct {
+	ID         string       `bson:"_id"`
+	OrderID    string       `bson:"order_id"`
+	UserID     string       `bson:"user_id"`
+	LocationID string       `bson:"location_id,omitempty"` // Store a POS order was sold from; restocked there
+	Lines      []ReturnLine `bson:"lines"`
+	Reason     string       `bson:"reason"`
+	Status     ReturnStatus `bson:"status"`
+	CreatedAt  time.Time    `bson:"created_at"`
+	UpdatedAt  time.Time    `bson:"updated_at"`
+	ReceivedAt *time.Time   `bson:"received_at,omitempty"`
+	RefundedAt *time.Time   `bson:"refunded_at,omitempty"`
+}
+
+// NewReturn validates the lines against the order and creates a requested
+// return. alreadyReturned holds the quantity per product covered by earlier,
+// non-rejected returns of the same order.
+func NewReturn(order *Order, lines []ReturnLine, reason string, alreadyReturned map[string]int32) (*Return, error) {
+	if len(lines) == 0 {
+		return nil, errors.New("a return must have at least one item")
+	}
+
+	ordered := make(map[string]int32, len(order.Items))
+	for _, item := range order.Items {
+		ordered[item.ProductID] += item.Quantity
+	}
+
+	requested := make(map[string]int32, len(lines))
+	for i, line := range lines {
+		if line.Quantity <= 0 {
+			return nil, fmt.Errorf("return line %d: quantity must be positive", i)
+		}
+		switch line.Condition {
+		case "":
+			lines[i].Condition = ConditionSellable
+		case ConditionSellable, ConditionDamaged:
+		default:
+			return nil, fmt.Errorf("return line %d: invalid condition %q", i, line.Condition)
+		}
+		if _, ok := ordered[line.ProductID]; !ok {
+			return nil, fmt.Errorf("product %s is not part of order %s", line.ProductID, order.ID)
+		}
+		requested[line.ProductID] += line.Quantity
+	}
+
+	for productID, qty := range requested {
+		remaining := ordered[productID] - alreadyReturned[productID]
+		if qty > remaining {
+			return nil, fmt.Errorf("cannot return %d of product %s: only %d left to return", qty, productID, remaining)
+		}
+	}
+
+	now := time.Now()
+	return &Return{
+		ID:         uuid.New().String(),
+		OrderID:    order.ID,
+		UserID:     order.UserID,
+		LocationID: order.LocationID,
+		Lines:      lines,
+		Reason:     reason,
+		Status:     ReturnStatusRequested,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
+
+// UpdateStatus moves the return to a new status
+func (r *Return) UpdateStatus(status ReturnStatus) error {
+	if err := ValidateReturnTransition(r.Status, status); err != nil {
+		return err
+	}
+	now := time.Now()
+	r.Status = status
+	r.UpdatedAt = now
+	switch status {
+	case ReturnStatusReceived:
+		r.ReceivedAt = &now
+	case ReturnStatusRefunded:
+		r.RefundedAt = &now
+	}
+	return nil
+}
+
+// ReturnRepository defines the interface for return (RMA) persistence
+type ReturnRepository interface {
+	// Create adds a new return
+	Create(ctx context.Context, ret *Return) error
+
+	// GetByID finds a return by its ID
+	GetByID(ctx context.Context, id string) (*Return, error)
+
+	// ListByOrderID returns all returns of an order
+	ListByOrderID(ctx context.Context, orderID string) ([]*Return, error)
+
+	// Update updates an existing return
+	Update(ctx context.Context, ret *Return) error
+}
+
+// ReturnRestocker puts returned items back into inventory
+type ReturnRestocker interface {
+	RestockReturn(ctx context.Context, ret *Return, line ReturnLine) error
+}
@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidShipment is returned when a shipment cannot be recorded against an order
+var ErrInvalidShipment = errors.New("invalid shipment")
+
+// FulfillmentStatus summarizes how much of an order has shipped
+type FulfillmentStatus string
+
+const (
+	// FulfillmentNone means no item has shipped yet
+	FulfillmentNone FulfillmentStatus = "NONE"
+	// FulfillmentPartial means some, but not all, items have shipped
+	FulfillmentPartial FulfillmentStatus = "PARTIAL"
+	// FulfillmentComplete means every item has shipped
+	FulfillmentComplete FulfillmentStatus = "COMPLETE"
+)
+
+// ShipmentItem is a quantity of one of the order's products sent in a shipment
+type ShipmentItem struct {
+	ProductID string `bson:"product_id"`
+	Quantity  int32  `bson:"quantity"`
+}
+
+// Shipment is a parcel sent for part or all of an order
+type Shipment struct {
+	ID           string         `bson:"id"`
+	TrackingCode string         `bson:"tracking_code,omitempty"`
+	Items        []ShipmentItem `bson:"items"`
+	ShippedAt    time.Time      `bson:"shipped_at"`
+	ShippedBy    string         `bson:"shipped_by,omitempty"`
+}
+
+// FulfilledQuantities returns how many units of each product have shipped.
+// Shipped and delivered orders count as fully shipped, also when the order
+// was marked shipped as a whole rather than through shipments.
+func (o *Order) FulfilledQuantities() map[string]int32 {
+	ordered := o.orderedQuantities()
+	if o.Status == StatusShipped || o.Status == StatusDelivered {
+		return ordered
+	}
+
+	fulfilled := make(map[string]int32, len(ordered))
+	for _, shipment := range o.Shipments {
+		for _, item := range shipment.Items {
+			fulfilled[item.ProductID] += item.Quantity
+		}
+	}
+	for productID, quantity := range fulfilled {
+		fulfilled[productID] = min(quantity, ordered[productID])
+	}
+	return fulfilled
+}
+
+// FulfillmentStatus rolls the fulfilled quantities of the order's items up
+// into none, partial or complete
+func (o *Order) FulfillmentStatus() FulfillmentStatus {
+	ordered := o.orderedQuantities()
+	fulfilled := o.FulfilledQuantities()
+
+	var shipped, complete int
+	for productID, quantity := range ordered {
+		if fulfilled[productID] > 0 {
+			shipped++
+		}
+		if fulfilled[productID] >= quantity {
+			complete++
+		}
+	}
+	switch {
+	case len(ordered) > 0 && complete == len(ordered):
+		return FulfillmentComplete
+	case shipped > 0:
+		return FulfillmentPartial
+	default:
+		return FulfillmentNone
+	}
+}
+
+// AddShipment records a shipment of some of a paid order's items. Once every
+// item has shipped the order moves to SHIPPED, with the last shipment's
+// tracking code, so the status and the fulfillment rollup agree. shippedBy is
+// the user recording the shipment.
+func (o *Order) AddShipment(trackingCode string, items []ShipmentItem, shippedBy string) (*Shipment, error) {
+	if o.Status != StatusPaid {
+		return nil, fmt.Errorf("%w: only paid orders can be shipped, order %s is %s", ErrInvalidShipment, o.ID, o.Status)
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%w: at least one item is required", ErrInvalidShipment)
+	}
+
+	ordered := o.orderedQuantities()
+	fulfilled := o.FulfilledQuantities()
+	shipping := make(map[string]int32, len(items))
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return nil, fmt.Errorf("%w: quantity must be positive for product %s", ErrInvalidShipment, item.ProductID)
+		}
+		if _, ok := ordered[item.ProductID]; !ok {
+			return nil, fmt.Errorf("%w: product %s is not part of order %s", ErrInvalidShipment, item.ProductID, o.ID)
+		}
+		shipping[item.ProductID] += item.Quantity
+		if remaining := ordered[item.ProductID] - fulfilled[item.ProductID]; shipping[item.ProductID] > remaining {
+			return nil, fmt.Errorf("%w: only %d of product %s are left to ship", ErrInvalidShipment, remaining, item.ProductID)
+		}
+	}
+
+	shipment := Shipment{
+		ID:           uuid.New().String(),
+		TrackingCode: trackingCode,
+		Items:        items,
+		ShippedAt:    time.Now(),
+		ShippedBy:    shippedBy,
+	}
+	o.Shipments = append(o.Shipments, shipment)
+
+	if o.FulfillmentStatus() == FulfillmentComplete {
+		if trackingCode != "" {
+			o.TrackingCode = trackingCode
+		}
+		if err := o.UpdateStatus(StatusShipped, shippedBy); err != nil {
+			return nil, err
+		}
+	} else {
+		o.IncrementVersion()
+	}
+	return &shipment, nil
+}
+
+// orderedQuantities returns the quantity ordered of each product
+func (o *Order) orderedQuantities() map[string]int32 {
+	ordered := make(map[string]int32, len(o.Items))
+	for _, item := range o.Items {
+		ordered[item.ProductID] += item.Quantity
+	}
+	return ordered
+}
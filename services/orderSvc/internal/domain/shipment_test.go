@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// newPaidOrder returns a paid order for 2 lamps and 1 shade
+func newPaidOrder(t *testing.T) *Order {
+	t.Helper()
+	order := NewOrder("user-1", []OrderItem{
+		{ProductID: "lamp", Quantity: 2, Price: 20},
+		{ProductID: "shade", Quantity: 1, Price: 5},
+	}, Address{}, Address{})
+	if err := order.UpdateStatus(StatusPaid, "user-1"); err != nil {
+		t.Fatal(err)
+	}
+	return order
+}
+
+func TestFulfillmentStatusRollup(t *testing.T) {
+	tests := []struct {
+		name          string
+		shipments     [][]ShipmentItem
+		wantStatus    FulfillmentStatus
+		wantFulfilled map[string]int32
+		wantOrder     OrderStatus
+	}{
+		{
+			name:          "none",
+			wantStatus:    FulfillmentNone,
+			wantFulfilled: map[string]int32{},
+			wantOrder:     StatusPaid,
+		},
+		{
+			name:          "partial units of one item",
+			shipments:     [][]ShipmentItem{{{ProductID: "lamp", Quantity: 1}}},
+			wantStatus:    FulfillmentPartial,
+			wantFulfilled: map[string]int32{"lamp": 1},
+			wantOrder:     StatusPaid,
+		},
+		{
+			name:          "one item complete, another not started",
+			shipments:     [][]ShipmentItem{{{ProductID: "lamp", Quantity: 2}}},
+			wantStatus:    FulfillmentPartial,
+			wantFulfilled: map[string]int32{"lamp": 2},
+			wantOrder:     StatusPaid,
+		},
+		{
+			name: "complete over two shipments",
+			shipments: [][]ShipmentItem{
+				{{ProductID: "lamp", Quantity: 1}},
+				{{ProductID: "lamp", Quantity: 1}, {ProductID: "shade", Quantity: 1}},
+			},
+			wantStatus:    FulfillmentComplete,
+			wantFulfilled: map[string]int32{"lamp": 2, "shade": 1},
+			wantOrder:     StatusShipped,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := newPaidOrder(t)
+			for i, items := range tt.shipments {
+				if _, err := order.AddShipment("TRACK-1", items, "staff-1"); err != nil {
+					t.Fatalf("shipment %d: %v", i, err)
+				}
+			}
+
+			if got := order.FulfillmentStatus(); got != tt.wantStatus {
+				t.Errorf("FulfillmentStatus() = %s, want %s", got, tt.wantStatus)
+			}
+			if got := order.FulfilledQuantities(); !reflect.DeepEqual(got, tt.wantFulfilled) {
+				t.Errorf("FulfilledQuantities() = %v, want %v", got, tt.wantFulfilled)
+			}
+			if order.Status != tt.wantOrder {
+				t.Errorf("order status = %s, want %s", order.Status, tt.wantOrder)
+			}
+		})
+	}
+}
+
+func TestOrderShippedWithoutShipmentsIsComplete(t *testing.T) {
+	order := newPaidOrder(t)
+	if err := order.UpdateStatus(StatusShipped, "staff-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := order.FulfillmentStatus(); got != FulfillmentComplete {
+		t.Fatalf("FulfillmentStatus() = %s, want %s", got, FulfillmentComplete)
+	}
+}
+
+func TestAddShipmentRejectsInvalidShipments(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []ShipmentItem
+	}{
+		{name: "no items"},
+		{name: "unknown product", items: []ShipmentItem{{ProductID: "bulb", Quantity: 1}}},
+		{name: "more than ordered", items: []ShipmentItem{{ProductID: "lamp", Quantity: 3}}},
+		{name: "more than ordered across lines", items: []ShipmentItem{{ProductID: "lamp", Quantity: 2}, {ProductID: "lamp", Quantity: 1}}},
+		{name: "zero quantity", items: []ShipmentItem{{ProductID: "lamp", Quantity: 0}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := newPaidOrder(t)
+			if _, err := order.AddShipment("", tt.items, "staff-1"); !errors.Is(err, ErrInvalidShipment) {
+				t.Fatalf("err = %v, want ErrInvalidShipment", err)
+			}
+			if len(order.Shipments) != 0 {
+				t.Fatal("a rejected shipment must not be recorded")
+			}
+		})
+	}
+}
+
+func TestPartlyShippedOrderCannotBeCancelled(t *testing.T) {
+	order := newPaidOrder(t)
+	if _, err := order.AddShipment("", []ShipmentItem{{ProductID: "lamp", Quantity: 1}}, "staff-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := order.Cancel("staff-1"); err == nil {
+		t.Fatal("cancelling a partly shipped order should fail")
+	}
+	if order.Status != StatusPaid {
+		t.Fatalf("status = %s, want %s", order.Status, StatusPaid)
+	}
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestValidateStatusTransition(t *testing.T) {
+	statuses := []OrderStatus{
+		StatusCreated, StatusPending, StatusPaid, StatusShipped,
+		StatusDelivered, StatusCancelled, StatusFailed,
+	}
+	legal := map[[2]OrderStatus]bool{
+		{StatusCreated, StatusPending}:   true,
+		{StatusCreated, StatusPaid}:      true,
+		{StatusCreated, StatusCancelled}: true,
+		{StatusCreated, StatusFailed}:    true,
+		{StatusPending, StatusPaid}:      true,
+		{StatusPending, StatusCancelled}: true,
+		{StatusPending, StatusFailed}:    true,
+		{StatusPaid, StatusShipped}:      true,
+		{StatusPaid, StatusCancelled}:    true,
+		{StatusShipped, StatusDelivered}: true,
+		{StatusShipped, StatusFailed}:    true,
+		{StatusFailed, StatusPending}:    true,
+	}
+
+	// Every pair of statuses, so a transition added to or dropped from the
+	// state machine without updating legal fails here
+	for _, from := range statuses {
+		for _, to := range statuses {
+			wantLegal := legal[[2]OrderStatus{from, to}]
+			t.Run(fmt.Sprintf("%s to %s", from, to), func(t *testing.T) {
+				err := ValidateStatusTransition(from, to)
+				if wantLegal {
+					if err != nil {
+						t.Fatalf("ValidateStatusTransition = %v, want nil", err)
+					}
+					return
+				}
+				if !errors.Is(err, ErrInvalidStatusTransition) {
+					t.Fatalf("ValidateStatusTransition = %v, want %v", err, ErrInvalidStatusTransition)
+				}
+				if CanTransitionTo(from, to) {
+					t.Fatal("CanTransitionTo = true for an illegal transition")
+				}
+			})
+		}
+	}
+}
+
+func TestValidateStatusTransitionUnknownStatus(t *testing.T) {
+	if err := ValidateStatusTransition("ON_HOLD", StatusPending); !errors.Is(err, ErrInvalidStatusTransition) {
+		t.Fatalf("ValidateStatusTransition = %v, want %v", err, ErrInvalidStatusTransition)
+	}
+}
+
+func TestUpdateStatusSetsCompletedAtOnlyOnDelivery(t *testing.T) {
+	order := NewOrder("customer-1", []OrderItem{{ProductID: "product-1", Quantity: 1, Price: 10}}, Address{}, Address{})
+
+	for _, status := range []OrderStatus{StatusPending, StatusPaid, StatusShipped} {
+		if err := order.UpdateStatus(status, "staff-1"); err != nil {
+			t.Fatalf("UpdateStatus(%s): %v", status, err)
+		}
+		if !order.CompletedAt.IsZero() {
+			t.Fatalf("CompletedAt set after moving to %s", status)
+		}
+	}
+	if err := order.UpdateStatus(StatusDelivered, "staff-1"); err != nil {
+		t.Fatalf("UpdateStatus(%s): %v", StatusDelivered, err)
+	}
+	if order.CompletedAt.IsZero() {
+		t.Fatal("CompletedAt not set on delivery")
+	}
+	if len(order.StatusHistory) != 4 {
+		t.Fatalf("%d status changes recorded, want 4", len(order.StatusHistory))
+	}
+}
+
+func TestUpdateStatusRejectedLeavesOrderUnchanged(t *testing.T) {
+	order := NewOrder("customer-1", []OrderItem{{ProductID: "product-1", Quantity: 1, Price: 10}}, Address{}, Address{})
+	if err := order.UpdateStatus(StatusCancelled, "customer-1"); err != nil {
+		t.Fatal(err)
+	}
+	version := order.Version
+
+	if err := order.UpdateStatus(StatusPaid, "staff-1"); !errors.Is(err, ErrInvalidStatusTransition) {
+		t.Fatalf("UpdateStatus = %v, want %v", err, ErrInvalidStatusTransition)
+	}
+	if order.Status != StatusCancelled || order.Version != version || len(order.StatusHistory) != 1 {
+		t.Fatalf("order changed by a rejected transition: status %s, version %d, %d changes",
+			order.Status, order.Version, len(order.StatusHistory))
+	}
+}
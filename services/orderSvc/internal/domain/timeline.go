@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// StatusChange is an entry in an order's status history
+type StatusChange struct {
+	From      OrderStatus `bson:"from"`
+	To        OrderStatus `bson:"to"`
+	ChangedBy string      `bson:"changed_by,omitempty"` // Empty for changes made by the system
+	ChangedAt time.Time   `bson:"changed_at"`
+}
+
+// TimelineEntryType is the kind of event a timeline entry describes
+type TimelineEntryType string
+
+const (
+	// TimelineOrderCreated is the order being placed
+	TimelineOrderCreated TimelineEntryType = "ORDER_CREATED"
+	// TimelineStatusChanged is a move from one order status to another
+	TimelineStatusChanged TimelineEntryType = "STATUS_CHANGED"
+	// TimelinePaymentRecorded is the payment of the order
+	TimelinePaymentRecorded TimelineEntryType = "PAYMENT_RECORDED"
+	// TimelineShipmentSent is a parcel sent for the order
+	TimelineShipmentSent TimelineEntryType = "SHIPMENT_SENT"
+	// TimelineNoteAdded is a note added to the order
+	TimelineNoteAdded TimelineEntryType = "NOTE_ADDED"
+	// TimelineReturnRequested is a return opened for the order's items
+	TimelineReturnRequested TimelineEntryType = "RETURN_REQUESTED"
+	// TimelineReturnReceived is returned items arriving back
+	TimelineReturnReceived TimelineEntryType = "RETURN_RECEIVED"
+	// TimelineReturnRefunded is a return being refunded
+	TimelineReturnRefunded TimelineEntryType = "RETURN_REFUNDED"
+	// TimelineReturnRejected is a return being refused
+	TimelineReturnRejected TimelineEntryType = "RETURN_REJECTED"
+)
+
+// TimelineEntry is one event in the history of an order
+type TimelineEntry struct {
+	Type      TimelineEntryType
+	Timestamp time.Time
+	// Actor is the user behind the event, or empty for the system and for
+	// events recorded before actors were kept
+	Actor   string
+	Summary string
+	// ReferenceID identifies the note, shipment or return the entry is about
+	ReferenceID string
+}
+
+// BuildOrderTimeline merges the status history, payment, shipments and notes
+// of an order and its returns into one list, oldest first. Entries with the
+// same timestamp keep the order they are listed in here, so an order's
+// creation always comes before anything else that happened at that instant.
+func BuildOrderTimeline(order *Order, returns []*Return) []TimelineEntry {
+	createdBy := order.UserID
+	if order.StaffID != "" {
+		createdBy = order.StaffID
+	}
+	entries := []TimelineEntry{{
+		Type:      TimelineOrderCreated,
+		Timestamp: order.CreatedAt,
+		Actor:     createdBy,
+		Summary:   fmt.Sprintf("%s order created with %d items, total %.2f", order.Source, len(order.Items), order.TotalAmount),
+	}}
+
+	for _, change := range order.StatusHistory {
+		summary := fmt.Sprintf("Status changed from %s to %s", change.From, change.To)
+		if change.To == StatusCancelled && order.CancelReason != "" {
+			summary += " (" + order.CancelReason + ")"
+		}
+		entries = append(entries, TimelineEntry{
+			Type:      TimelineStatusChanged,
+			Timestamp: change.ChangedAt,
+			Actor:     change.ChangedBy,
+			Summary:   summary,
+		})
+	}
+
+	if !order.Payment.Timestamp.IsZero() {
+		entries = append(entries, TimelineEntry{
+			Type:        TimelinePaymentRecorded,
+			Timestamp:   order.Payment.Timestamp,
+			Actor:       order.Payment.RecordedBy,
+			Summary:     fmt.Sprintf("Payment of %.2f by %s %s", order.Payment.Amount, order.Payment.Method, order.Payment.Status),
+			ReferenceID: order.Payment.TransactionID,
+		})
+	}
+
+	for _, shipment := range order.Shipments {
+		var units int32
+		for _, item := range shipment.Items {
+			units += item.Quantity
+		}
+		summary := fmt.Sprintf("Shipped %d units", units)
+		if shipment.TrackingCode != "" {
+			summary += ", tracking code " + shipment.TrackingCode
+		}
+		entries = append(entries, TimelineEntry{
+			Type:        TimelineShipmentSent,
+			Timestamp:   shipment.ShippedAt,
+			Actor:       shipment.ShippedBy,
+			Summary:     summary,
+			ReferenceID: shipment.ID,
+		})
+	}
+
+	for _, note := range order.NoteLog {
+		entries = append(entries, TimelineEntry{
+			Type:        TimelineNoteAdded,
+			Timestamp:   note.CreatedAt,
+			Actor:       note.AuthorID,
+			Summary:     note.Text,
+			ReferenceID: note.ID,
+		})
+	}
+
+	for _, ret := range returns {
+		entries = append(entries, returnTimelineEntries(ret)...)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp.Before(entries[j].Timestamp)
+	})
+	return entries
+}
+
+// returnTimelineEntries lists the steps a return has gone through
+func returnTimelineEntries(ret *Return) []TimelineEntry {
+	var units int32
+	for _, line := range ret.Lines {
+		units += line.Quantity
+	}
+	summary := fmt.Sprintf("Return of %d units requested", units)
+	if ret.Reason != "" {
+		summary += ": " + ret.Reason
+	}
+	entries := []TimelineEntry{{
+		Type:        TimelineReturnRequested,
+		Timestamp:   ret.CreatedAt,
+		Actor:       ret.UserID,
+		Summary:     summary,
+		ReferenceID: ret.ID,
+	}}
+
+	if ret.ReceivedAt != nil {
+		entries = append(entries, TimelineEntry{
+			Type:        TimelineReturnReceived,
+			Timestamp:   *ret.ReceivedAt,
+			Summary:     fmt.Sprintf("Returned %d units received", units),
+			ReferenceID: ret.ID,
+		})
+	}
+	if ret.RefundedAt != nil {
+		entries = append(entries, TimelineEntry{
+			Type:        TimelineReturnRefunded,
+			Timestamp:   *ret.RefundedAt,
+			Summary:     "Return refunded",
+			ReferenceID: ret.ID,
+		})
+	}
+	if ret.Status == ReturnStatusRejected {
+		entries = append(entries, TimelineEntry{
+			Type:        TimelineReturnRejected,
+			Timestamp:   ret.UpdatedAt,
+			Summary:     "Return rejected",
+			ReferenceID: ret.ID,
+		})
+	}
+	return entries
+}
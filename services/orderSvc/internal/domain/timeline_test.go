@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBuildOrderTimelineIsChronological(t *testing.T) {
+	created := time.Date(2024, 5, 10, 9, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return created.Add(time.Duration(minutes) * time.Minute) }
+	received, refunded := at(60), at(90)
+
+	order := &Order{
+		ID:          "order-1",
+		UserID:      "customer-1",
+		Source:      SourceOnline,
+		Items:       []OrderItem{{ProductID: "lamp", Quantity: 2}},
+		TotalAmount: 40,
+		CreatedAt:   created,
+		// Each record is kept in its own list; none of them is in time order
+		// relative to the others
+		NoteLog: []OrderNote{
+			{ID: "note-2", AuthorID: "staff-1", Text: "Customer called", CreatedAt: at(45)},
+			{ID: "note-1", Text: "Fraud check passed", CreatedAt: created},
+		},
+		Shipments: []Shipment{
+			{ID: "shipment-1", TrackingCode: "TRACK-1", Items: []ShipmentItem{{ProductID: "lamp", Quantity: 2}}, ShippedAt: at(30), ShippedBy: "staff-2"},
+		},
+		Payment: Payment{Method: "card", Amount: 40, Status: "completed", Timestamp: at(5), RecordedBy: "customer-1"},
+		StatusHistory: []StatusChange{
+			{From: StatusCreated, To: StatusPending, ChangedAt: at(1)},
+			{From: StatusPending, To: StatusPaid, ChangedBy: "customer-1", ChangedAt: at(5)},
+			{From: StatusPaid, To: StatusShipped, ChangedBy: "staff-2", ChangedAt: at(30)},
+		},
+	}
+	returns := []*Return{{
+		ID: "return-1", OrderID: "order-1", UserID: "customer-1",
+		Lines:     []ReturnLine{{ProductID: "lamp", Quantity: 1}},
+		Reason:    "broken",
+		Status:    ReturnStatusRefunded,
+		CreatedAt: at(50), ReceivedAt: &received, RefundedAt: &refunded,
+	}}
+
+	timeline := BuildOrderTimeline(order, returns)
+
+	wantTypes := []TimelineEntryType{
+		TimelineOrderCreated,
+		TimelineNoteAdded, // same instant as the creation, listed after it
+		TimelineStatusChanged,
+		TimelineStatusChanged,
+		TimelinePaymentRecorded,
+		TimelineStatusChanged,
+		TimelineShipmentSent,
+		TimelineNoteAdded,
+		TimelineReturnRequested,
+		TimelineReturnReceived,
+		TimelineReturnRefunded,
+	}
+	var gotTypes []TimelineEntryType
+	for i, entry := range timeline {
+		gotTypes = append(gotTypes, entry.Type)
+		if i > 0 && entry.Timestamp.Before(timeline[i-1].Timestamp) {
+			t.Errorf("entry %d (%s at %s) comes before entry %d (%s at %s)",
+				i, entry.Type, entry.Timestamp, i-1, timeline[i-1].Type, timeline[i-1].Timestamp)
+		}
+		if entry.Summary == "" {
+			t.Errorf("entry %d (%s) has no summary", i, entry.Type)
+		}
+	}
+	if !reflect.DeepEqual(gotTypes, wantTypes) {
+		t.Fatalf("timeline types = %v, want %v", gotTypes, wantTypes)
+	}
+
+	if timeline[0].Actor != "customer-1" {
+		t.Errorf("creation actor = %q, want the customer", timeline[0].Actor)
+	}
+	if ship := timeline[6]; ship.Actor != "staff-2" || ship.ReferenceID != "shipment-1" {
+		t.Errorf("shipment entry = %+v, want staff-2 and shipment-1", ship)
+	}
+}
+
+func TestBuildOrderTimelineCreditsStaffForPOSOrders(t *testing.T) {
+	order := &Order{UserID: "customer-1", StaffID: "cashier-1", Source: SourcePOS, CreatedAt: time.Now()}
+
+	timeline := BuildOrderTimeline(order, nil)
+
+	if len(timeline) != 1 || timeline[0].Actor != "cashier-1" {
+		t.Fatalf("timeline = %+v, want one creation entry by the cashier", timeline)
+	}
+}
@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrDeadLetterNotFound is returned when a dead-lettered webhook delivery does not exist
+var ErrDeadLetterNotFound = errors.New("dead-lettered delivery not found")
+
+// ErrUnknownSubscriber is returned when a delivery references a subscriber that is no longer configured
+var ErrUnknownSubscriber = errors.New("webhook subscriber not configured")
+
+// WebhookSubscriber is an external endpoint that receives order events over HTTP
+type WebhookSubscriber struct {
+	ID     string
+	URL    string
+	Secret string
+	// EventTypes restricts which events are delivered; empty means all events
+	EventTypes []EventType
+}
+
+// Wants reports whether the subscriber is interested in the given event type
+func (s WebhookSubscriber) Wants(eventType EventType) bool {
+	if len(s.EventTypes) == 0 {
+		return true
+	}
+	for _, t := range s.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// DeliveryStatus represents the state of a webhook delivery to a single subscriber
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending      DeliveryStatus = "PENDING"
+	DeliveryStatusDelivered    DeliveryStatus = "DELIVERED"
+	DeliveryStatusDeadLettered DeliveryStatus = "DEAD_LETTERED"
+)
+
+// WebhookDelivery tracks delivery of one event to one subscriber
+type WebhookDelivery struct {
+	ID            string         `bson:"_id" json:"id"`
+	EventID       string         `bson:"event_id" json:"event_id"`
+	EventType     EventType      `bson:"event_type" json:"event_type"`
+	OrderID       string         `bson:"order_id" json:"order_id"`
+	SubscriberID  string         `bson:"subscriber_id" json:"subscriber_id"`
+	URL           string         `bson:"url" json:"url"`
+	Status        DeliveryStatus `bson:"status" json:"status"`
+	Attempts      int32          `bson:"attempts" json:"attempts"`
+	LastError     string         `bson:"last_error,omitempty" json:"last_error,omitempty"`
+	Event         *OrderEvent    `bson:"event" json:"event"`
+	CreatedAt     time.Time      `bson:"created_at" json:"created_at"`
+	UpdatedAt     time.Time      `bson:"updated_at" json:"updated_at"`
+	LastAttemptAt *time.Time     `bson:"last_attempt_at,omitempty" json:"last_attempt_at,omitempty"`
+	DeliveredAt   *time.Time     `bson:"delivered_at,omitempty" json:"delivered_at,omitempty"`
+	// NextAttemptAt is when a pending delivery that failed is due to be
+	// retried, so retries keep their backoff across restarts
+	NextAttemptAt *time.Time `bson:"next_attempt_at,omitempty" json:"next_attempt_at,omitempty"`
+}
+
+// NewWebhookDelivery creates a pending delivery of event to subscriber
+func NewWebhookDelivery(event *OrderEvent, subscriber WebhookSubscriber) *WebhookDelivery {
+	now := time.Now()
+	return &WebhookDelivery{
+		ID:           event.ID + ":" + subscriber.ID,
+		EventID:      event.ID,
+		EventType:    event.Type,
+		OrderID:      event.OrderID,
+		SubscriberID: subscriber.ID,
+		URL:          subscriber.URL,
+		Status:       DeliveryStatusPending,
+		Event:        event,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+}
+
+// RetryDelay returns how long to wait before the next attempt, zero when it is due
+func (d *WebhookDelivery) RetryDelay(now time.Time) time.Duration {
+	if d.NextAttemptAt == nil || !d.NextAttemptAt.After(now) {
+		return 0
+	}
+	return d.NextAttemptAt.Sub(now)
+}
+
+// WebhookSender performs a single HTTP delivery attempt
+type WebhookSender interface {
+	Send(ctx context.Context, subscriber WebhookSubscriber, event *OrderEvent) error
+}
+
+// WebhookDeliveryRepository persists webhook delivery status and the dead-letter store
+type WebhookDeliveryRepository interface {
+	// SaveDelivery creates or replaces the status record of a delivery
+	SaveDelivery(ctx context.Context, delivery *WebhookDelivery) error
+
+	// ListDeliveries returns delivery records, optionally filtered by subscriber and status
+	ListDeliveries(ctx context.Context, subscriberID string, status DeliveryStatus, limit, offset int) ([]*WebhookDelivery, error)
+
+	// ListPendingDeliveries returns every delivery that has not finished, oldest first
+	ListPendingDeliveries(ctx context.Context) ([]*WebhookDelivery, error)
+
+	// AddDeadLetter stores a delivery that exhausted its retries
+	AddDeadLetter(ctx context.Context, delivery *WebhookDelivery) error
+
+	// GetDeadLetter finds a dead-lettered delivery by ID
+	GetDeadLetter(ctx context.Context, id string) (*WebhookDelivery, error)
+
+	// ListDeadLetters returns dead-lettered deliveries, optionally filtered by subscriber
+	ListDeadLetters(ctx context.Context, subscriberID string, limit, offset int) ([]*WebhookDelivery, error)
+
+	// DeleteDeadLetter removes a delivery from the dead-letter store
+	DeleteDeadLetter(ctx context.Context, id string) error
+}
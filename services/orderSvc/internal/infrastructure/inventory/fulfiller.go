@@ -0,0 +1,262 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inventoryclient "github.com/leonvanderhaeghen/stockplatform/pkg/clients/inventory"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// reservationActive is the inventory service status of a reservation still holding stock
+const reservationActive = "active"
+
+// Fulfiller implements domain.StockFulfiller on top of the inventory service
+type Fulfiller struct {
+	client            *inventoryclient.Client
+	defaultLocationID string
+	logger            *zap.Logger
+}
+
+// NewFulfiller creates a fulfiller connected to the inventory service. Items
+// that have to be reserved afresh and have no known location are taken from
+// defaultLocationID.
+func NewFulfiller(inventoryServiceAddr, defaultLocationID string, logger *zap.Logger) (*Fulfiller, error) {
+	client, err := inventoryclient.New(inventoryclient.Config{Address: inventoryServiceAddr}, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create inventory client: %w", err)
+	}
+	return &Fulfiller{
+		client:            client,
+		defaultLocationID: defaultLocationID,
+		logger:            logger.Named("stock_fulfiller"),
+	}, nil
+}
+
+// ReserveOrder reserves the order's items in one inventory call, preferring
+// the order's location; bundles are reserved as their components. The
+// inventory service rolls back a reservation that fails part-way, so a
+// shortage leaves nothing held.
+func (f *Fulfiller) ReserveOrder(ctx context.Context, order *domain.Order) error {
+	stockItems := order.StockItems()
+	items := make([]*models.InventoryRequestItem, 0, len(stockItems))
+	for _, item := range stockItems {
+		items = append(items, &models.InventoryRequestItem{
+			ProductID: item.ProductID,
+			SKU:       item.ProductSKU,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	reservations, err := f.client.ReserveForOrder(ctx, order.ID, order.LocationID, items, 0)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.FailedPrecondition, codes.Aborted:
+			return fmt.Errorf("%w: %v", domain.ErrInsufficientStock, err)
+		}
+		return fmt.Errorf("failed to reserve order stock: %w", err)
+	}
+
+	f.logger.Info("Order stock reserved",
+		zap.String("order_id", order.ID),
+		zap.Int("reservations", len(reservations)),
+	)
+	return nil
+}
+
+// fulfillment is stock held on one inventory item that is to be deducted
+type fulfillment struct {
+	inventoryItemID string
+	productID       string
+	quantity        int32
+	fresh           bool // Reserved by this fulfillment rather than by the order
+}
+
+// FulfillOrder deducts the order's items in two phases: every quantity not
+// covered by an active reservation is reserved first, at the location of the
+// lapsed reservation, the order's location or the default location. Only when
+// all of it is held is any stock deducted, so a stock shortage leaves
+// inventory untouched. Bundles are deducted as their components. Items are
+// handled in SKU order, the order the inventory service reserves multi-item
+// requests in.
+func (f *Fulfiller) FulfillOrder(ctx context.Context, order *domain.Order) error {
+	reservations, err := f.client.GetReservationsForOrder(ctx, order.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get reservations: %w", err)
+	}
+
+	active := make(map[string][]fulfillment)
+	lapsedLocation := make(map[string]string)
+	for _, r := range reservations {
+		if r.Status == reservationActive || r.Status == "" {
+			active[r.ProductID] = append(active[r.ProductID], fulfillment{
+				inventoryItemID: r.InventoryItemID,
+				productID:       r.ProductID,
+				quantity:        r.Quantity,
+			})
+			continue
+		}
+		lapsedLocation[r.ProductID] = r.LocationID
+	}
+
+	items := order.StockItems()
+	sort.SliceStable(items, func(a, b int) bool {
+		if items[a].ProductSKU != items[b].ProductSKU {
+			return items[a].ProductSKU < items[b].ProductSKU
+		}
+		return items[a].ProductID < items[b].ProductID
+	})
+
+	var plan []fulfillment
+	for _, item := range items {
+		needed := item.Quantity
+		held := active[item.ProductID]
+		for len(held) > 0 && needed > 0 {
+			take := held[0]
+			if take.quantity > needed {
+				take.quantity = needed
+			}
+			plan = append(plan, take)
+			needed -= take.quantity
+			held[0].quantity -= take.quantity
+			if held[0].quantity == 0 {
+				held = held[1:]
+			}
+		}
+		active[item.ProductID] = held
+		if needed == 0 {
+			continue
+		}
+
+		reserved, err := f.reserve(ctx, order, item.ProductID, needed, lapsedLocation[item.ProductID])
+		if err != nil {
+			f.releaseFresh(ctx, plan)
+			return err
+		}
+		plan = append(plan, reserved)
+	}
+
+	for _, step := range plan {
+		if err := f.client.FulfillReservation(ctx, step.inventoryItemID, step.quantity, order.ID); err != nil {
+			return fmt.Errorf("failed to fulfill product %s: %w", step.productID, err)
+		}
+	}
+
+	f.logger.Info("Order stock fulfilled",
+		zap.String("order_id", order.ID),
+		zap.Int("inventory_items", len(plan)),
+	)
+	return nil
+}
+
+// reserve holds quantity of a product whose reservation is missing or lapsed
+func (f *Fulfiller) reserve(ctx context.Context, order *domain.Order, productID string, quantity int32, locationID string) (fulfillment, error) {
+	if locationID == "" {
+		locationID = order.LocationID
+	}
+	if locationID == "" {
+		locationID = f.defaultLocationID
+	}
+
+	f.logger.Info("Reserving stock for order without an active reservation",
+		zap.String("order_id", order.ID),
+		zap.String("product_id", productID),
+		zap.String("location_id", locationID),
+		zap.Int32("quantity", quantity),
+	)
+
+	item, err := f.client.GetInventoryByProductID(ctx, productID, locationID)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return fulfillment{}, fmt.Errorf("%w: product %s is not stocked at %s", domain.ErrInsufficientStock, productID, locationID)
+		}
+		return fulfillment{}, fmt.Errorf("failed to get inventory for product %s: %w", productID, err)
+	}
+	if _, err := f.client.ReserveStock(ctx, item.ID, quantity); err != nil {
+		if status.Code(err) == codes.FailedPrecondition {
+			return fulfillment{}, fmt.Errorf("%w: product %s at %s", domain.ErrInsufficientStock, productID, locationID)
+		}
+		return fulfillment{}, fmt.Errorf("failed to reserve product %s: %w", productID, err)
+	}
+
+	return fulfillment{
+		inventoryItemID: item.ID,
+		productID:       productID,
+		quantity:        quantity,
+		fresh:           true,
+	}, nil
+}
+
+// releaseFresh gives back the stock reserved by an aborted fulfillment
+func (f *Fulfiller) releaseFresh(ctx context.Context, plan []fulfillment) {
+	for _, step := range plan {
+		if !step.fresh {
+			continue
+		}
+		if err := f.client.ReleaseReservation(ctx, step.inventoryItemID, step.quantity, ""); err != nil {
+			f.logger.Error("Failed to release reservation after aborted fulfillment",
+				zap.String("inventory_item_id", step.inventoryItemID),
+				zap.Int32("quantity", step.quantity),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// ReleaseOrder releases all of the order's reservations in one call
+func (f *Fulfiller) ReleaseOrder(ctx context.Context, order *domain.Order) (int, error) {
+	released, err := f.client.ReleaseAllForOrder(ctx, order.ID)
+	if err != nil {
+		return 0, err
+	}
+	return len(released), nil
+}
+
+// ReleaseItems takes the stock of cancelled lines off the order's active
+// reservations. Bundles are released as their components; other lines of the
+// order that share a component keep their part of its reservation.
+func (f *Fulfiller) ReleaseItems(ctx context.Context, order *domain.Order, items []domain.OrderItem) (int, error) {
+	reservations, err := f.client.GetReservationsForOrder(ctx, order.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get reservations: %w", err)
+	}
+
+	active := make(map[string][]*models.InventoryReservation)
+	for _, r := range reservations {
+		if r.Status == reservationActive || r.Status == "" {
+			active[r.ProductID] = append(active[r.ProductID], r)
+		}
+	}
+
+	released := 0
+	for _, item := range domain.StockItemsOf(items) {
+		remaining := item.Quantity
+		for _, r := range active[item.ProductID] {
+			if remaining == 0 {
+				break
+			}
+			quantity := min(r.Quantity, remaining)
+			if quantity == 0 {
+				continue
+			}
+			if err := f.client.ReleaseReservation(ctx, r.InventoryItemID, quantity, order.ID); err != nil {
+				return released, fmt.Errorf("failed to release product %s: %w", item.ProductID, err)
+			}
+			r.Quantity -= quantity
+			remaining -= quantity
+			released++
+		}
+	}
+	return released, nil
+}
+
+// Close closes the inventory connection
+func (f *Fulfiller) Close() error {
+	return f.client.Close()
+}
@@ -0,0 +1,227 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	inventoryclient "github.com/leonvanderhaeghen/stockplatform/pkg/clients/inventory"
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// stockItem is an inventory item of the fake inventory service
+type stockItem struct {
+	id, productID, locationID string
+	quantity, reserved        int32
+}
+
+// fakeInventory is an in-memory inventory service holding items and the
+// reservations of one order
+type fakeInventory struct {
+	inventoryv1.UnimplementedInventoryServiceServer
+
+	mu           sync.Mutex
+	items        map[string]*stockItem
+	reservations []*inventoryv1.OrderReservation
+	reserved     []string // Item IDs ReserveStock was called for
+	fulfilled    []string // Item IDs FulfillReservation was called for
+	released     []string // Item IDs ReleaseReservation was called for
+}
+
+func newFakeInventory(items ...*stockItem) *fakeInventory {
+	f := &fakeInventory{items: make(map[string]*stockItem)}
+	for _, item := range items {
+		f.items[item.id] = item
+	}
+	return f
+}
+
+func (f *fakeInventory) GetReservationsForOrder(ctx context.Context, req *inventoryv1.GetReservationsForOrderRequest) (*inventoryv1.GetReservationsForOrderResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return &inventoryv1.GetReservationsForOrderResponse{OrderId: req.GetOrderId(), Reservations: f.reservations}, nil
+}
+
+func (f *fakeInventory) GetInventoryByProductID(ctx context.Context, req *inventoryv1.GetInventoryByProductIDRequest) (*inventoryv1.GetInventoryResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, item := range f.items {
+		if item.productID == req.GetProductId() && item.locationID == req.GetLocationId() {
+			return &inventoryv1.GetInventoryResponse{Inventory: &inventoryv1.InventoryItem{
+				Id:         item.id,
+				ProductId:  item.productID,
+				LocationId: item.locationID,
+			}}, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "inventory item not found")
+}
+
+func (f *fakeInventory) ReserveStock(ctx context.Context, req *inventoryv1.ReserveStockRequest) (*inventoryv1.ReserveStockResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	item := f.items[req.GetId()]
+	if item.quantity-item.reserved < req.GetQuantity() {
+		return nil, status.Error(codes.FailedPrecondition, "insufficient stock")
+	}
+	item.reserved += req.GetQuantity()
+	f.reserved = append(f.reserved, item.id)
+	return &inventoryv1.ReserveStockResponse{Success: true}, nil
+}
+
+func (f *fakeInventory) FulfillReservation(ctx context.Context, req *inventoryv1.FulfillReservationRequest) (*inventoryv1.FulfillReservationResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	item := f.items[req.GetId()]
+	item.quantity -= req.GetQuantity()
+	item.reserved -= req.GetQuantity()
+	f.fulfilled = append(f.fulfilled, item.id)
+	return &inventoryv1.FulfillReservationResponse{Success: true}, nil
+}
+
+func (f *fakeInventory) ReleaseReservation(ctx context.Context, req *inventoryv1.ReleaseReservationRequest) (*inventoryv1.ReleaseReservationResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	item := f.items[req.GetId()]
+	item.reserved -= req.GetQuantity()
+	f.released = append(f.released, item.id)
+	return &inventoryv1.ReleaseReservationResponse{Success: true}, nil
+}
+
+// newTestFulfiller returns a fulfiller talking to backend over an in-memory
+// connection
+func newTestFulfiller(t *testing.T, backend *fakeInventory) *Fulfiller {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	inventoryv1.RegisterInventoryServiceServer(server, backend)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	client, err := inventoryclient.New(inventoryclient.Config{
+		Address: "passthrough:///inventory",
+		DialOptions: []grpc.DialOption{grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		})},
+	}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return &Fulfiller{client: client, defaultLocationID: "warehouse", logger: zap.NewNop()}
+}
+
+func paidOrder(items ...domain.OrderItem) *domain.Order {
+	return &domain.Order{ID: "order-1", Status: domain.StatusPaid, Items: items}
+}
+
+func TestFulfillOrderDeductsReservedStock(t *testing.T) {
+	item := &stockItem{id: "inv-1", productID: "product-1", locationID: "store-1", quantity: 10, reserved: 3}
+	backend := newFakeInventory(item)
+	backend.reservations = []*inventoryv1.OrderReservation{
+		{InventoryItemId: "inv-1", ProductId: "product-1", LocationId: "store-1", Quantity: 3, Status: "active"},
+	}
+	fulfiller := newTestFulfiller(t, backend)
+
+	if err := fulfiller.FulfillOrder(context.Background(), paidOrder(domain.OrderItem{ProductID: "product-1", Quantity: 3})); err != nil {
+		t.Fatal(err)
+	}
+
+	if item.quantity != 7 || item.reserved != 0 {
+		t.Fatalf("item quantity/reserved = %d/%d, want 7/0", item.quantity, item.reserved)
+	}
+	if len(backend.reserved) != 0 {
+		t.Fatalf("nothing should be reserved afresh, got %v", backend.reserved)
+	}
+}
+
+func TestFulfillOrderReservesExpiredReservationAgain(t *testing.T) {
+	item := &stockItem{id: "inv-1", productID: "product-1", locationID: "store-1", quantity: 10}
+	backend := newFakeInventory(item)
+	backend.reservations = []*inventoryv1.OrderReservation{
+		{InventoryItemId: "inv-1", ProductId: "product-1", LocationId: "store-1", Quantity: 3, Status: "expired"},
+	}
+	fulfiller := newTestFulfiller(t, backend)
+
+	if err := fulfiller.FulfillOrder(context.Background(), paidOrder(domain.OrderItem{ProductID: "product-1", Quantity: 3})); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(backend.reserved) != 1 || backend.reserved[0] != "inv-1" {
+		t.Fatalf("the lapsed item should be reserved again at its location, got %v", backend.reserved)
+	}
+	if item.quantity != 7 || item.reserved != 0 {
+		t.Fatalf("item quantity/reserved = %d/%d, want 7/0", item.quantity, item.reserved)
+	}
+}
+
+func TestFulfillOrderFailsWhenExpiredStockIsGone(t *testing.T) {
+	lamp := &stockItem{id: "inv-1", productID: "product-1", locationID: "store-1", quantity: 5}
+	desk := &stockItem{id: "inv-2", productID: "product-2", locationID: "store-1", quantity: 1}
+	backend := newFakeInventory(lamp, desk)
+	backend.reservations = []*inventoryv1.OrderReservation{
+		{InventoryItemId: "inv-1", ProductId: "product-1", LocationId: "store-1", Quantity: 2, Status: "expired"},
+		{InventoryItemId: "inv-2", ProductId: "product-2", LocationId: "store-1", Quantity: 2, Status: "expired"},
+	}
+	fulfiller := newTestFulfiller(t, backend)
+
+	err := fulfiller.FulfillOrder(context.Background(), paidOrder(
+		domain.OrderItem{ProductID: "product-1", ProductSKU: "A", Quantity: 2},
+		domain.OrderItem{ProductID: "product-2", ProductSKU: "B", Quantity: 2},
+	))
+	if !errors.Is(err, domain.ErrInsufficientStock) {
+		t.Fatalf("err = %v, want ErrInsufficientStock", err)
+	}
+
+	if len(backend.fulfilled) != 0 {
+		t.Fatalf("nothing should be deducted, got %v", backend.fulfilled)
+	}
+	if lamp.quantity != 5 || lamp.reserved != 0 || desk.quantity != 1 || desk.reserved != 0 {
+		t.Fatalf("stock should be untouched, got lamp %d/%d desk %d/%d", lamp.quantity, lamp.reserved, desk.quantity, desk.reserved)
+	}
+	if len(backend.released) != 1 || backend.released[0] != "inv-1" {
+		t.Fatalf("the fresh reservation of the first item should be released, got %v", backend.released)
+	}
+}
+
+func TestFulfillOrderDeductsBundleComponents(t *testing.T) {
+	frame := &stockItem{id: "inv-frame", productID: "frame", locationID: "store-1", quantity: 10, reserved: 2}
+	screw := &stockItem{id: "inv-screw", productID: "screw", locationID: "store-1", quantity: 40, reserved: 16}
+	backend := newFakeInventory(frame, screw)
+	backend.reservations = []*inventoryv1.OrderReservation{
+		{InventoryItemId: "inv-frame", ProductId: "frame", LocationId: "store-1", Quantity: 2, Status: "active"},
+		{InventoryItemId: "inv-screw", ProductId: "screw", LocationId: "store-1", Quantity: 16, Status: "active"},
+	}
+	fulfiller := newTestFulfiller(t, backend)
+
+	// The bundle has no inventory item; only its components are deducted
+	err := fulfiller.FulfillOrder(context.Background(), paidOrder(domain.OrderItem{
+		ProductID: "kit", ProductSKU: "KIT", Quantity: 2,
+		Components: []domain.OrderItemComponent{
+			{ProductID: "frame", ProductSKU: "FRAME", Quantity: 1},
+			{ProductID: "screw", ProductSKU: "SCREW", Quantity: 8},
+		},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if frame.quantity != 8 || frame.reserved != 0 {
+		t.Errorf("frame quantity/reserved = %d/%d, want 8/0", frame.quantity, frame.reserved)
+	}
+	if screw.quantity != 24 || screw.reserved != 0 {
+		t.Errorf("screw quantity/reserved = %d/%d, want 24/0", screw.quantity, screw.reserved)
+	}
+	if len(backend.fulfilled) != 2 {
+		t.Errorf("fulfilled items = %v, want both components", backend.fulfilled)
+	}
+}
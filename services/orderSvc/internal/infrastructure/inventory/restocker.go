@@ -0,0 +1,45 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	inventoryclient "github.com/leonvanderhaeghen/stockplatform/pkg/clients/inventory"
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// Restocker implements domain.ReturnRestocker on top of the inventory service
+type Restocker struct {
+	client *inventoryclient.Client
+}
+
+// NewRestocker creates a restocker connected to the inventory service
+func NewRestocker(inventoryServiceAddr string, logger *zap.Logger) (*Restocker, error) {
+	client, err := inventoryclient.New(inventoryclient.Config{Address: inventoryServiceAddr}, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create inventory client: %w", err)
+	}
+	return &Restocker{client: client}, nil
+}
+
+// RestockReturn puts a returned line back into inventory, into the damaged
+// bucket when the items came back damaged
+func (r *Restocker) RestockReturn(ctx context.Context, ret *domain.Return, line domain.ReturnLine) error {
+	_, err := r.client.RestockReturn(
+		ctx,
+		line.ProductID,
+		ret.LocationID,
+		line.Quantity,
+		line.Condition == domain.ConditionDamaged,
+		ret.ID,
+		"",
+	)
+	return err
+}
+
+// Close closes the inventory connection
+func (r *Restocker) Close() error {
+	return r.client.Close()
+}
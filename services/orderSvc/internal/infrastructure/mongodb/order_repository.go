@@ -16,11 +16,15 @@ import (
 // OrderRepository implements the domain.OrderRepository interface
 type OrderRepository struct {
 	collection *mongo.Collection
-	logger     *zap.Logger
+	// reports reads the same collection for listing and counting queries,
+	// which may be served by a secondary
+	reports *mongo.Collection
+	logger  *zap.Logger
 }
 
-// NewOrderRepository creates a new MongoDB order repository
-func NewOrderRepository(db *mongo.Database, collectionName string, logger *zap.Logger) domain.OrderRepository {
+// NewOrderRepository creates a new MongoDB order repository. Orders and
+// payments are written through db; reports is used for list and count queries.
+func NewOrderRepository(db, reports *mongo.Database, collectionName string, logger *zap.Logger) domain.OrderRepository {
 	collection := db.Collection(collectionName)
 	
 	// Create indexes for improved query performance
@@ -37,6 +41,15 @@ func NewOrderRepository(db *mongo.Database, collectionName string, logger *zap.L
 			Keys:    bson.D{{Key: "created_at", Value: -1}},
 			Options: options.Index().SetUnique(false),
 		},
+		mongo.IndexModel{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "created_at", Value: 1}},
+			Options: options.Index().SetUnique(false),
+		},
+		mongo.IndexModel{
+			// Sparse: only guest orders carry a contact
+			Keys:    bson.D{{Key: "guest.email", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
 	}
 	
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -49,6 +62,7 @@ func NewOrderRepository(db *mongo.Database, collectionName string, logger *zap.L
 	
 	return &OrderRepository{
 		collection: collection,
+		reports:    reports.Collection(collectionName),
 		logger:     logger.Named("order_repository"),
 	}
 }
@@ -72,6 +86,82 @@ func (r *OrderRepository) Create(ctx context.Context, order *domain.Order) error
 	return nil
 }
 
+// illegalOperationCode is the server error code for a transaction started
+// on a standalone server, which does not support them
+const illegalOperationCode = 20
+
+// CreateWithStep inserts an order in a transaction that commits only when
+// step succeeds. On a standalone server, which has no transactions, the
+// order is inserted on its own and deleted again if step fails.
+func (r *OrderRepository) CreateWithStep(ctx context.Context, order *domain.Order, step func(ctx context.Context) error) error {
+	r.logger.Debug("Creating order in a transaction",
+		zap.String("id", order.ID),
+		zap.String("user_id", order.UserID),
+	)
+
+	session, err := r.collection.Database().Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	// The callback runs once: step may call other services, so it must not
+	// be retried the way WithTransaction retries transient errors
+	supported := true
+	err = mongo.WithSession(ctx, session, func(sc mongo.SessionContext) error {
+		if err := sc.StartTransaction(); err != nil {
+			return err
+		}
+		if _, err := r.collection.InsertOne(sc, order); err != nil {
+			_ = sc.AbortTransaction(context.WithoutCancel(sc))
+			var serverErr mongo.ServerError
+			if errors.As(err, &serverErr) && serverErr.HasErrorCode(illegalOperationCode) {
+				supported = false
+				return nil
+			}
+			return err
+		}
+		if err := step(sc); err != nil {
+			if abortErr := sc.AbortTransaction(context.WithoutCancel(sc)); abortErr != nil {
+				r.logger.Error("Failed to abort order transaction",
+					zap.Error(abortErr),
+					zap.String("id", order.ID),
+				)
+			}
+			return err
+		}
+		return sc.CommitTransaction(sc)
+	})
+	if !supported {
+		r.logger.Debug("Transactions are not supported, creating order without one", zap.String("id", order.ID))
+		return r.createThenStep(ctx, order, step)
+	}
+	if err != nil {
+		r.logger.Error("Failed to create order",
+			zap.Error(err),
+			zap.String("id", order.ID),
+		)
+	}
+	return err
+}
+
+// createThenStep inserts an order and runs step, deleting the order when step fails
+func (r *OrderRepository) createThenStep(ctx context.Context, order *domain.Order, step func(ctx context.Context) error) error {
+	if err := r.Create(ctx, order); err != nil {
+		return err
+	}
+	if err := step(ctx); err != nil {
+		if _, delErr := r.collection.DeleteOne(context.WithoutCancel(ctx), bson.M{"_id": order.ID}); delErr != nil {
+			r.logger.Error("Failed to delete order after a failed step",
+				zap.Error(delErr),
+				zap.String("id", order.ID),
+			)
+		}
+		return err
+	}
+	return nil
+}
+
 // GetByID finds an order by its ID
 func (r *OrderRepository) GetByID(ctx context.Context, id string) (*domain.Order, error) {
 	r.logger.Debug("Getting order by ID", zap.String("id", id))
@@ -134,6 +224,31 @@ func (r *OrderRepository) GetByUserID(ctx context.Context, userID string, limit,
 	return orders, nil
 }
 
+// LinkGuestOrders gives the guest orders placed with email that are not linked
+// yet to userID
+func (r *OrderRepository) LinkGuestOrders(ctx context.Context, email, userID string) (int64, error) {
+	filter := bson.M{"user_id": "", "guest.email": email}
+	update := bson.M{
+		"$set": bson.M{"user_id": userID, "updated_at": time.Now()},
+		"$inc": bson.M{"version": 1},
+	}
+	
+	result, err := r.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		r.logger.Error("Failed to link guest orders",
+			zap.Error(err),
+			zap.String("user_id", userID),
+		)
+		return 0, err
+	}
+	
+	r.logger.Info("Linked guest orders",
+		zap.String("user_id", userID),
+		zap.Int64("linked", result.ModifiedCount),
+	)
+	return result.ModifiedCount, nil
+}
+
 // Update updates an existing order
 func (r *OrderRepository) Update(ctx context.Context, order *domain.Order) error {
 	r.logger.Debug("Updating order", 
@@ -160,6 +275,32 @@ func (r *OrderRepository) Update(ctx context.Context, order *domain.Order) error
 	return nil
 }
 
+// AppendNote pushes a note onto an order's note log. Concurrent notes are all
+// kept because the log is appended to rather than replaced.
+func (r *OrderRepository) AppendNote(ctx context.Context, orderID string, note *domain.OrderNote) error {
+	result, err := r.collection.UpdateOne(ctx,
+		bson.M{"_id": orderID},
+		bson.M{
+			"$push": bson.M{"note_log": note},
+			"$set":  bson.M{"notes": note.Text, "updated_at": time.Now()},
+			"$inc":  bson.M{"version": 1},
+		},
+	)
+	if err != nil {
+		r.logger.Error("Failed to append order note",
+			zap.Error(err),
+			zap.String("id", orderID),
+		)
+		return err
+	}
+	
+	if result.MatchedCount == 0 {
+		return errors.New("order not found")
+	}
+	
+	return nil
+}
+
 // UpdateWithOptimisticLock updates an order with version checking to prevent concurrent modifications
 func (r *OrderRepository) UpdateWithOptimisticLock(ctx context.Context, order *domain.Order, expectedVersion int32) error {
 	r.logger.Debug("Updating order with optimistic lock", 
@@ -258,7 +399,7 @@ func (r *OrderRepository) List(ctx context.Context, filter map[string]interface{
 		bsonFilter[k] = v
 	}
 	
-	cursor, err := r.collection.Find(ctx, bsonFilter, findOptions)
+	cursor, err := r.reports.Find(ctx, bsonFilter, findOptions)
 	if err != nil {
 		r.logger.Error("Failed to list orders", zap.Error(err))
 		return nil, err
@@ -293,7 +434,7 @@ func (r *OrderRepository) Count(ctx context.Context, filter map[string]interface
 		bsonFilter[k] = v
 	}
 	
-	count, err := r.collection.CountDocuments(ctx, bsonFilter)
+	count, err := r.reports.CountDocuments(ctx, bsonFilter)
 	if err != nil {
 		r.logger.Error("Failed to count orders", zap.Error(err))
 		return 0, err
@@ -301,3 +442,74 @@ func (r *OrderRepository) Count(ctx context.Context, filter map[string]interface
 	
 	return count, nil
 }
+
+// ListAwaitingPayment returns up to limit online orders in CREATED or PENDING
+// created before createdBefore, oldest first. It reads from the primary since
+// the orders are about to be cancelled.
+func (r *OrderRepository) ListAwaitingPayment(ctx context.Context, createdBefore time.Time, limit int) ([]*domain.Order, error) {
+	filter := bson.M{
+		"status":     bson.M{"$in": []domain.OrderStatus{domain.StatusCreated, domain.StatusPending}},
+		"source":     bson.M{"$ne": domain.SourcePOS},
+		"created_at": bson.M{"$lt": createdBefore},
+	}
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: 1}}).
+		SetLimit(int64(limit))
+	
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		r.logger.Error("Failed to list orders awaiting payment", zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	
+	var orders []*domain.Order
+	if err := cursor.All(ctx, &orders); err != nil {
+		r.logger.Error("Failed to decode orders awaiting payment", zap.Error(err))
+		return nil, err
+	}
+	return orders, nil
+}
+
+// Summarize counts the non-cancelled orders created in [from, to) and sums their totals
+func (r *OrderRepository) Summarize(ctx context.Context, from, to time.Time) (*domain.OrderSummary, error) {
+	r.logger.Debug("Summarizing orders",
+		zap.Time("from", from),
+		zap.Time("to", to),
+	)
+	
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"created_at": bson.M{"$gte": from, "$lt": to},
+			"status":     bson.M{"$ne": domain.StatusCancelled},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":     nil,
+			"count":   bson.M{"$sum": 1},
+			"revenue": bson.M{"$sum": "$total_amount"},
+		}}},
+	}
+	
+	cursor, err := r.reports.Aggregate(ctx, pipeline)
+	if err != nil {
+		r.logger.Error("Failed to summarize orders", zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	
+	var results []struct {
+		Count   int64   `bson:"count"`
+		Revenue float64 `bson:"revenue"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		r.logger.Error("Failed to decode order summary", zap.Error(err))
+		return nil, err
+	}
+	
+	summary := &domain.OrderSummary{}
+	if len(results) > 0 {
+		summary.OrderCount = results[0].Count
+		summary.Revenue = results[0].Revenue
+	}
+	return summary, nil
+}
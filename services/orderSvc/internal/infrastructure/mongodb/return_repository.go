@@ -0,0 +1,97 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// ReturnRepository implements the domain.ReturnRepository interface
+type ReturnRepository struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewReturnRepository creates a new MongoDB return repository
+func NewReturnRepository(db *mongo.Database, logger *zap.Logger) domain.ReturnRepository {
+	collection := db.Collection("returns")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "order_id", Value: 1}, {Key: "created_at", Value: 1}},
+	})
+	if err != nil {
+		logger.Warn("Failed to create return indexes", zap.Error(err))
+	}
+
+	return &ReturnRepository{
+		collection: collection,
+		logger:     logger.Named("return_repository"),
+	}
+}
+
+// Create adds a new return
+func (r *ReturnRepository) Create(ctx context.Context, ret *domain.Return) error {
+	if _, err := r.collection.InsertOne(ctx, ret); err != nil {
+		r.logger.Error("Failed to create return",
+			zap.Error(err),
+			zap.String("order_id", ret.OrderID),
+		)
+		return err
+	}
+	return nil
+}
+
+// GetByID finds a return by its ID
+func (r *ReturnRepository) GetByID(ctx context.Context, id string) (*domain.Return, error) {
+	var ret domain.Return
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&ret)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, domain.ErrReturnNotFound
+		}
+		r.logger.Error("Failed to get return", zap.Error(err), zap.String("id", id))
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// ListByOrderID returns all returns of an order, oldest first
+func (r *ReturnRepository) ListByOrderID(ctx context.Context, orderID string) ([]*domain.Return, error) {
+	findOptions := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{"order_id": orderID}, findOptions)
+	if err != nil {
+		r.logger.Error("Failed to list returns", zap.Error(err), zap.String("order_id", orderID))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var returns []*domain.Return
+	if err := cursor.All(ctx, &returns); err != nil {
+		return nil, err
+	}
+	return returns, nil
+}
+
+// Update updates an existing return
+func (r *ReturnRepository) Update(ctx context.Context, ret *domain.Return) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": ret.ID}, ret)
+	if err != nil {
+		r.logger.Error("Failed to update return", zap.Error(err), zap.String("id", ret.ID))
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrReturnNotFound
+	}
+	return nil
+}
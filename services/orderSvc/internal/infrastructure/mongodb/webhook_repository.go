@@ -0,0 +1,166 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// WebhookRepository implements the domain.WebhookDeliveryRepository interface.
+// Delivery status lives in one collection and exhausted deliveries are copied
+// into a separate dead-letter collection.
+type WebhookRepository struct {
+	deliveries  *mongo.Collection
+	deadLetters *mongo.Collection
+	logger      *zap.Logger
+}
+
+// NewWebhookRepository creates a new MongoDB webhook delivery repository
+func NewWebhookRepository(db *mongo.Database, logger *zap.Logger) domain.WebhookDeliveryRepository {
+	deliveries := db.Collection("webhook_deliveries")
+	deadLetters := db.Collection("webhook_dead_letters")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := deliveries.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "subscriber_id", Value: 1}, {Key: "status", Value: 1}}},
+		{Keys: bson.D{{Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "created_at", Value: 1}}},
+	})
+	if err != nil {
+		logger.Warn("Failed to create webhook delivery indexes", zap.Error(err))
+	}
+
+	return &WebhookRepository{
+		deliveries:  deliveries,
+		deadLetters: deadLetters,
+		logger:      logger.Named("webhook_repository"),
+	}
+}
+
+// SaveDelivery creates or replaces the status record of a delivery
+func (r *WebhookRepository) SaveDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	_, err := r.deliveries.ReplaceOne(ctx,
+		bson.M{"_id": delivery.ID},
+		delivery,
+		options.Replace().SetUpsert(true),
+	)
+	if err != nil {
+		r.logger.Error("Failed to save webhook delivery",
+			zap.Error(err),
+			zap.String("id", delivery.ID),
+		)
+		return err
+	}
+	return nil
+}
+
+// ListDeliveries returns delivery records, optionally filtered by subscriber and status
+func (r *WebhookRepository) ListDeliveries(ctx context.Context, subscriberID string, status domain.DeliveryStatus, limit, offset int) ([]*domain.WebhookDelivery, error) {
+	filter := bson.M{}
+	if subscriberID != "" {
+		filter["subscriber_id"] = subscriberID
+	}
+	if status != "" {
+		filter["status"] = status
+	}
+	return r.find(ctx, r.deliveries, filter, limit, offset)
+}
+
+// ListPendingDeliveries returns every delivery that has not finished, oldest first
+func (r *WebhookRepository) ListPendingDeliveries(ctx context.Context) ([]*domain.WebhookDelivery, error) {
+	cursor, err := r.deliveries.Find(ctx,
+		bson.M{"status": domain.DeliveryStatusPending},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}),
+	)
+	if err != nil {
+		r.logger.Error("Failed to list pending webhook deliveries", zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var deliveries []*domain.WebhookDelivery
+	if err := cursor.All(ctx, &deliveries); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// AddDeadLetter stores a delivery that exhausted its retries
+func (r *WebhookRepository) AddDeadLetter(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	_, err := r.deadLetters.ReplaceOne(ctx,
+		bson.M{"_id": delivery.ID},
+		delivery,
+		options.Replace().SetUpsert(true),
+	)
+	if err != nil {
+		r.logger.Error("Failed to store dead-lettered delivery",
+			zap.Error(err),
+			zap.String("id", delivery.ID),
+		)
+		return err
+	}
+	return nil
+}
+
+// GetDeadLetter finds a dead-lettered delivery by ID
+func (r *WebhookRepository) GetDeadLetter(ctx context.Context, id string) (*domain.WebhookDelivery, error) {
+	var delivery domain.WebhookDelivery
+	err := r.deadLetters.FindOne(ctx, bson.M{"_id": id}).Decode(&delivery)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, domain.ErrDeadLetterNotFound
+		}
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// ListDeadLetters returns dead-lettered deliveries, optionally filtered by subscriber
+func (r *WebhookRepository) ListDeadLetters(ctx context.Context, subscriberID string, limit, offset int) ([]*domain.WebhookDelivery, error) {
+	filter := bson.M{}
+	if subscriberID != "" {
+		filter["subscriber_id"] = subscriberID
+	}
+	return r.find(ctx, r.deadLetters, filter, limit, offset)
+}
+
+// DeleteDeadLetter removes a delivery from the dead-letter store
+func (r *WebhookRepository) DeleteDeadLetter(ctx context.Context, id string) error {
+	result, err := r.deadLetters.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return domain.ErrDeadLetterNotFound
+	}
+	return nil
+}
+
+func (r *WebhookRepository) find(ctx context.Context, collection *mongo.Collection, filter bson.M, limit, offset int) ([]*domain.WebhookDelivery, error) {
+	findOptions := options.Find()
+	findOptions.SetSort(bson.D{{Key: "created_at", Value: -1}})
+	findOptions.SetLimit(int64(limit))
+	findOptions.SetSkip(int64(offset))
+
+	cursor, err := collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		r.logger.Error("Failed to list webhook deliveries", zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var deliveries []*domain.WebhookDelivery
+	if err := cursor.All(ctx, &deliveries); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
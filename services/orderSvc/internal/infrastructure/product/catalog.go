@@ -0,0 +1,60 @@
+package product
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	productclient "github.com/leonvanderhaeghen/stockplatform/pkg/clients/product"
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// Catalog implements domain.ProductCatalog on top of the product service
+type Catalog struct {
+	client *productclient.Client
+}
+
+// NewCatalog creates a product catalog connected to the product service
+func NewCatalog(productServiceAddr string, logger *zap.Logger) (*Catalog, error) {
+	client, err := productclient.New(productclient.Config{Address: productServiceAddr}, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create product client: %w", err)
+	}
+	return &Catalog{client: client}, nil
+}
+
+// LookupProducts looks all product IDs up in a single BatchGetProducts call,
+// along with the components of bundles
+func (c *Catalog) LookupProducts(ctx context.Context, productIDs []string) (*domain.ProductLookup, error) {
+	resp, err := c.client.BatchGetProducts(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	lookup := &domain.ProductLookup{
+		MissingIDs:    resp.MissingIDs,
+		QuantityRules: make(map[string]domain.OrderQuantityRule, len(resp.Products)),
+		Bundles:       make(map[string][]domain.OrderItemComponent),
+	}
+	for _, p := range resp.Products {
+		lookup.QuantityRules[p.ID] = domain.OrderQuantityRule{
+			Min:       p.MinOrderQty,
+			Max:       p.MaxOrderQty,
+			Increment: p.OrderQtyIncrement,
+		}
+		for _, c := range p.BundleComponents {
+			lookup.Bundles[p.ID] = append(lookup.Bundles[p.ID], domain.OrderItemComponent{
+				ProductID:  c.ProductID,
+				ProductSKU: c.SKU,
+				Quantity:   c.Quantity,
+			})
+		}
+	}
+	return lookup, nil
+}
+
+// Close closes the product service connection
+func (c *Catalog) Close() error {
+	return c.client.Close()
+}
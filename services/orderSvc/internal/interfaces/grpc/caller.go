@@ -0,0 +1,21 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/identity"
+)
+
+// canReadUserOrders reports whether the caller may read the orders of userID:
+// internal callers and staff may read anyone's, customers only their own
+func canReadUserOrders(ctx context.Context, userID string) bool {
+	caller := identity.FromIncomingContext(ctx)
+	return caller.UserID == "" || caller.UserID == userID || caller.IsStaff()
+}
+
+// errOrderNotFound is returned for orders that do not exist and for orders the
+// caller may not read, so customers cannot probe for other customers' orders
+var errOrderNotFound = status.Error(codes.NotFound, "order not found")
@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/identity"
+	orderv1 "github.com/leonvanderhaeghen/stockplatform/services/orderSvc/api/gen/go/proto/order/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// GetGuestOrder reads a guest order through the token of its signed link.
+// The token stands in for the caller's identity, so anyone holding the link
+// may read the order, just as its owner could.
+func (s *OrderServer) GetGuestOrder(ctx context.Context, req *orderv1.GetGuestOrderRequest) (*orderv1.GetGuestOrderResponse, error) {
+	s.logger.Debug("gRPC GetGuestOrder called", zap.String("order_id", req.OrderId))
+
+	if err := validateOrderID("order_id", req.OrderId); err != nil {
+		return nil, err
+	}
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	order, err := s.service.GetGuestOrder(ctx, req.OrderId, req.Token)
+	if err != nil {
+		if err.Error() == "order not found" {
+			return nil, errOrderNotFound
+		}
+		if errors.Is(err, domain.ErrGuestLinksDisabled) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		s.logger.Error("Failed to get guest order", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get guest order")
+	}
+
+	return &orderv1.GetGuestOrderResponse{
+		Order: toProtoOrder(order),
+	}, nil
+}
+
+// LinkGuestOrders attaches the guest orders placed with an email to a user.
+// Only authenticated staff may link orders: they are the ones who know the
+// user has verified the email, which a customer's own claim does not show.
+func (s *OrderServer) LinkGuestOrders(ctx context.Context, req *orderv1.LinkGuestOrdersRequest) (*orderv1.LinkGuestOrdersResponse, error) {
+	s.logger.Info("gRPC LinkGuestOrders called", zap.String("user_id", req.UserId))
+
+	if req.Email == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if identity.UserID(ctx) == "" {
+		return nil, status.Error(codes.Unauthenticated, "an authenticated caller is required")
+	}
+	if !identity.IsStaff(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "only staff may link guest orders")
+	}
+
+	linked, err := s.service.LinkGuestOrders(ctx, req.Email, req.UserId)
+	if err != nil {
+		s.logger.Error("Failed to link guest orders", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to link guest orders")
+	}
+
+	return &orderv1.LinkGuestOrdersResponse{
+		OrdersLinked: linked,
+	}, nil
+}
+
+// toProtoGuestContact converts a guest contact to protobuf
+func toProtoGuestContact(contact *domain.GuestContact) *orderv1.GuestContact {
+	if contact == nil {
+		return nil
+	}
+	return &orderv1.GuestContact{
+		Email: contact.Email,
+		Phone: contact.Phone,
+	}
+}
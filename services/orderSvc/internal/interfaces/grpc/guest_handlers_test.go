@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	orderv1 "github.com/leonvanderhaeghen/stockplatform/services/orderSvc/api/gen/go/proto/order/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/application"
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// linkingOrderRepository records the guest orders it is asked to link
+type linkingOrderRepository struct {
+	domain.OrderRepository
+	linkedEmail, linkedUser string
+}
+
+func (r *linkingOrderRepository) LinkGuestOrders(ctx context.Context, email, userID string) (int64, error) {
+	r.linkedEmail, r.linkedUser = email, userID
+	return 2, nil
+}
+
+func TestLinkGuestOrdersCallers(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantCode codes.Code
+	}{
+		{name: "staff", ctx: callerContext("staff-1", "STAFF"), wantCode: codes.OK},
+		{name: "admin", ctx: callerContext("admin-1", "ADMIN"), wantCode: codes.OK},
+		{name: "customer", ctx: callerContext("user-1", "CUSTOMER"), wantCode: codes.PermissionDenied},
+		{name: "no caller", ctx: callerContext("", ""), wantCode: codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &linkingOrderRepository{}
+			service := application.NewOrderService(repo, nil, nil, nil, domain.NewGuestLinkSigner("secret", time.Hour), false, zap.NewNop())
+			server := NewOrderServer(service, nil, nil, nil, nil, zap.NewNop())
+
+			resp, err := server.LinkGuestOrders(tt.ctx, &orderv1.LinkGuestOrdersRequest{Email: "Jane@Example.com", UserId: "user-1"})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("LinkGuestOrders = %v, want %v", err, tt.wantCode)
+			}
+			if tt.wantCode != codes.OK {
+				if repo.linkedUser != "" {
+					t.Fatal("orders were linked for a refused caller")
+				}
+				return
+			}
+			if resp.GetOrdersLinked() != 2 {
+				t.Errorf("orders linked = %d, want 2", resp.GetOrdersLinked())
+			}
+			if repo.linkedEmail != "jane@example.com" || repo.linkedUser != "user-1" {
+				t.Errorf("linked %q to %q, want jane@example.com to user-1", repo.linkedEmail, repo.linkedUser)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package grpc
+
+import (
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validateOrderID rejects a missing or malformed order ID with InvalidArgument.
+// Order IDs are UUIDs, so anything else cannot match an order and is refused
+// before it reaches the database.
+func validateOrderID(field, id string) error {
+	if id == "" {
+		return status.Error(codes.InvalidArgument, field+" is required")
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%s %q is not a valid order ID", field, id)
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package grpc
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	orderv1 "github.com/leonvanderhaeghen/stockplatform/services/orderSvc/api/gen/go/proto/order/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+func TestGetOrderRejectsMalformedIDs(t *testing.T) {
+	order := domain.NewOrder("customer-1", []domain.OrderItem{{ProductID: "product-1", Quantity: 1, Price: 10}}, domain.Address{}, domain.Address{})
+	server := newOwnershipTestServer(order)
+
+	for _, id := range []string{"", "not-an-id", "507f1f77bcf86cd799439011", "'; DROP TABLE orders;--", order.ID + "x"} {
+		_, err := server.GetOrder(callerContext("", ""), &orderv1.GetOrderRequest{Id: id})
+		if code := status.Code(err); code != codes.InvalidArgument {
+			t.Errorf("GetOrder(%q): code = %s, want InvalidArgument (err %v)", id, code, err)
+		}
+	}
+}
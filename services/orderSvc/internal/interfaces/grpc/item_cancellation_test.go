@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	orderv1 "github.com/leonvanderhaeghen/stockplatform/services/orderSvc/api/gen/go/proto/order/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/application"
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+func TestCancelOrderItemsHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     domain.OrderStatus
+		caller     string
+		productIDs []string
+		wantCode   codes.Code
+		wantTotal  float64
+	}{
+		{name: "owner cancels a line", status: domain.StatusPaid, caller: "customer-1", productIDs: []string{"lamp"}, wantCode: codes.OK, wantTotal: 120},
+		{name: "shipped order", status: domain.StatusShipped, caller: "customer-1", productIDs: []string{"lamp"}, wantCode: codes.FailedPrecondition},
+		{name: "product not in order", status: domain.StatusPaid, caller: "customer-1", productIDs: []string{"sofa"}, wantCode: codes.InvalidArgument},
+		{name: "no products", status: domain.StatusPaid, caller: "customer-1", wantCode: codes.InvalidArgument},
+		{name: "another customer", status: domain.StatusPaid, caller: "customer-2", productIDs: []string{"lamp"}, wantCode: codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := domain.NewOrder("customer-1", []domain.OrderItem{
+				{ProductID: "lamp", Quantity: 2, Price: 15, Subtotal: 30},
+				{ProductID: "desk", Quantity: 1, Price: 120, Subtotal: 120},
+			}, domain.Address{}, domain.Address{})
+			order.Status = tt.status
+			repo := &updatableOrderRepository{singleOrderRepository{order: order}}
+			service := application.NewOrderService(repo, nil, nil, nil, domain.GuestLinkSigner{}, false, zap.NewNop())
+			server := NewOrderServer(service, nil, nil, nil, nil, zap.NewNop())
+
+			resp, err := server.CancelOrderItems(callerContext(tt.caller, "CUSTOMER"), &orderv1.CancelOrderItemsRequest{Id: order.ID, ProductIds: tt.productIDs})
+
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("code = %s, want %s (err %v)", code, tt.wantCode, err)
+			}
+			if tt.wantCode != codes.OK {
+				return
+			}
+			if got := resp.GetOrder().GetTotalAmount(); got != tt.wantTotal {
+				t.Fatalf("total = %v, want %v", got, tt.wantTotal)
+			}
+			if len(resp.GetOrder().GetItems()) != 1 {
+				t.Fatalf("items = %v, want one line left", resp.GetOrder().GetItems())
+			}
+		})
+	}
+}
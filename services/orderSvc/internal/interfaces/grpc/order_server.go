@@ -2,12 +2,17 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/dates"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/identity"
 	orderv1 "github.com/leonvanderhaeghen/stockplatform/services/orderSvc/api/gen/go/proto/order/v1"
 	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/application"
 	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
@@ -18,14 +23,20 @@ type OrderServer struct {
 	orderv1.UnimplementedOrderServiceServer
 	service              *application.OrderService
 	posTransactionService *application.POSTransactionService
+	webhooks             *application.WebhookDispatcher
+	returns              *application.ReturnService
+	timeline             *application.TimelineService
 	logger               *zap.Logger
 }
 
 // NewOrderServer creates a new order gRPC server
-func NewOrderServer(service *application.OrderService, posService *application.POSTransactionService, logger *zap.Logger) orderv1.OrderServiceServer {
+func NewOrderServer(service *application.OrderService, posService *application.POSTransactionService, webhooks *application.WebhookDispatcher, returns *application.ReturnService, timeline *application.TimelineService, logger *zap.Logger) orderv1.OrderServiceServer {
 	return &OrderServer{
 		service:              service,
 		posTransactionService: posService,
+		webhooks:             webhooks,
+		returns:              returns,
+		timeline:             timeline,
 		logger:               logger.Named("order_grpc_server"),
 	}
 }
@@ -37,8 +48,12 @@ func (s *OrderServer) CreateOrder(ctx context.Context, req *orderv1.CreateOrderR
 		zap.Int("item_count", len(req.Items)),
 	)
 
-	if req.UserId == "" {
-		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	// Guests order without an account, reached through their contact instead
+	if req.UserId == "" && req.Guest == nil {
+		return nil, status.Error(codes.InvalidArgument, "user_id or guest is required")
+	}
+	if req.UserId != "" && req.Guest != nil {
+		return nil, status.Error(codes.InvalidArgument, "user_id and guest are mutually exclusive")
 	}
 	if len(req.Items) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "at least one item is required")
@@ -57,43 +72,44 @@ func (s *OrderServer) CreateOrder(ctx context.Context, req *orderv1.CreateOrderR
 		})
 	}
 
-	// Convert proto addresses to domain addresses (with nil checks for POS orders)
-	var shippingAddr domain.Address
-	if req.ShippingAddress != nil {
-		shippingAddr = domain.Address{
-			Street:     req.ShippingAddress.Street,
-			City:       req.ShippingAddress.City,
-			State:      req.ShippingAddress.State,
-			PostalCode: req.ShippingAddress.PostalCode,
-			Country:    req.ShippingAddress.Country,
-		}
-	} else {
-		// For POS orders or orders without shipping address
-		shippingAddr = domain.Address{}
-	}
-
-	var billingAddr domain.Address
-	if req.BillingAddress != nil {
-		billingAddr = domain.Address{
-			Street:     req.BillingAddress.Street,
-			City:       req.BillingAddress.City,
-			State:      req.BillingAddress.State,
-			PostalCode: req.BillingAddress.PostalCode,
-			Country:    req.BillingAddress.Country,
-		}
-	} else {
-		// For POS orders or orders without billing address
-		billingAddr = domain.Address{}
+	// Store orders are handed over in person, so addresses are optional for them.
+	// All other orders need a valid shipping address; billing defaults to shipping.
+	isStoreOrder := req.Source == orderv1.OrderSource_ORDER_SOURCE_STORE
+	shippingAddr, billingAddr, err := domain.ResolveOrderAddresses(
+		toDomainAddress(req.ShippingAddress),
+		toDomainAddress(req.BillingAddress),
+		!isStoreOrder,
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	order, err := s.service.CreateOrder(ctx, req.UserId, items, shippingAddr, billingAddr)
+	var (
+		order       *domain.Order
+		accessToken string
+	)
+	if req.Guest != nil {
+		contact := domain.GuestContact{Email: req.Guest.Email, Phone: req.Guest.Phone}
+		order, accessToken, err = s.service.CreateGuestOrder(ctx, contact, items, shippingAddr, billingAddr)
+	} else {
+		order, err = s.service.CreateOrder(ctx, req.UserId, items, shippingAddr, billingAddr)
+	}
 	if err != nil {
 		s.logger.Error("Failed to create order", zap.Error(err))
+		var unknown *domain.UnknownProductsError
+		var quantity *domain.OrderQuantityError
+		if errors.As(err, &unknown) || errors.As(err, &quantity) || errors.Is(err, domain.ErrInvalidGuestContact) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, domain.ErrInsufficientStock) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to create order: "+err.Error())
 	}
 
 	return &orderv1.CreateOrderResponse{
-		Order: toProtoOrder(order),
+		Order:            toProtoOrder(order),
+		GuestAccessToken: accessToken,
 	}, nil
 }
 
@@ -101,14 +117,26 @@ func (s *OrderServer) CreateOrder(ctx context.Context, req *orderv1.CreateOrderR
 func (s *OrderServer) GetOrder(ctx context.Context, req *orderv1.GetOrderRequest) (*orderv1.GetOrderResponse, error) {
 	s.logger.Debug("gRPC GetOrder called", zap.String("id", req.Id))
 
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
+	if err := validateOrderID("id", req.Id); err != nil {
+		return nil, err
 	}
 
 	order, err := s.service.GetOrder(ctx, req.Id)
 	if err != nil {
 		s.logger.Error("Failed to get order", zap.Error(err))
-		return nil, status.Error(codes.NotFound, "order not found")
+		if err.Error() == "order not found" {
+			return nil, errOrderNotFound
+		}
+		// Callers such as the inventory reconciler treat NotFound as final,
+		// so a lookup failure must not look like a missing order
+		return nil, status.Error(codes.Internal, "failed to get order")
+	}
+	if !canReadUserOrders(ctx, order.UserID) {
+		s.logger.Warn("Order read denied to non-owner",
+			zap.String("id", req.Id),
+			zap.String("caller_id", identity.UserID(ctx)),
+		)
+		return nil, errOrderNotFound
 	}
 
 	return &orderv1.GetOrderResponse{
@@ -127,6 +155,13 @@ func (s *OrderServer) GetUserOrders(ctx context.Context, req *orderv1.GetUserOrd
 	if req.UserId == "" {
 		return nil, status.Error(codes.InvalidArgument, "user_id is required")
 	}
+	if !canReadUserOrders(ctx, req.UserId) {
+		s.logger.Warn("Order list denied to other user",
+			zap.String("user_id", req.UserId),
+			zap.String("caller_id", identity.UserID(ctx)),
+		)
+		return nil, status.Error(codes.PermissionDenied, "cannot list another user's orders")
+	}
 
 	limit := int(req.Limit)
 	if limit <= 0 {
@@ -157,7 +192,7 @@ func (s *OrderServer) GetUserOrders(ctx context.Context, req *orderv1.GetUserOrd
 
 // UpdateOrder updates an existing order
 func (s *OrderServer) UpdateOrder(ctx context.Context, req *orderv1.UpdateOrderRequest) (*orderv1.UpdateOrderResponse, error) {
-	s.logger.Info("gRPC UpdateOrder called", zap.String("id", req.Order.Id))
+	s.logger.Info("gRPC UpdateOrder called", zap.String("id", req.GetOrder().GetId()))
 
 	if req.Order == nil {
 		return nil, status.Error(codes.InvalidArgument, "order is required")
@@ -175,28 +210,28 @@ func (s *OrderServer) UpdateOrder(ctx context.Context, req *orderv1.UpdateOrderR
 
 	// Update order fields
 	existingOrder.Status = domain.OrderStatus(req.Order.Status.String())
-	existingOrder.Notes = req.Order.Notes
+	// Notes are append-only: a changed note is added to the log rather than
+	// replacing the ones before it
+	if req.Order.Notes != "" && req.Order.Notes != existingOrder.Notes {
+		if _, err := existingOrder.AddNote(identity.UserID(ctx), req.Order.Notes, ""); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
 	existingOrder.TrackingCode = req.Order.TrackingCode
 
 	// Update addresses if provided
-	if req.Order.ShippingAddress != nil {
-		existingOrder.ShippingAddr = domain.Address{
-			Street:     req.Order.ShippingAddress.Street,
-			City:       req.Order.ShippingAddress.City,
-			State:      req.Order.ShippingAddress.State,
-			PostalCode: req.Order.ShippingAddress.PostalCode,
-			Country:    req.Order.ShippingAddress.Country,
+	if shippingAddr := toDomainAddress(req.Order.ShippingAddress); !shippingAddr.IsEmpty() {
+		if err := shippingAddr.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid shipping_address: "+err.Error())
 		}
+		existingOrder.ShippingAddr = shippingAddr
 	}
 
-	if req.Order.BillingAddress != nil {
-		existingOrder.BillingAddr = domain.Address{
-			Street:     req.Order.BillingAddress.Street,
-			City:       req.Order.BillingAddress.City,
-			State:      req.Order.BillingAddress.State,
-			PostalCode: req.Order.BillingAddress.PostalCode,
-			Country:    req.Order.BillingAddress.Country,
+	if billingAddr := toDomainAddress(req.Order.BillingAddress); !billingAddr.IsEmpty() {
+		if err := billingAddr.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid billing_address: "+err.Error())
 		}
+		existingOrder.BillingAddr = billingAddr
 	}
 
 	if err := s.service.UpdateOrder(ctx, existingOrder); err != nil {
@@ -213,8 +248,8 @@ func (s *OrderServer) UpdateOrder(ctx context.Context, req *orderv1.UpdateOrderR
 func (s *OrderServer) DeleteOrder(ctx context.Context, req *orderv1.DeleteOrderRequest) (*orderv1.DeleteOrderResponse, error) {
 	s.logger.Info("gRPC DeleteOrder called", zap.String("id", req.Id))
 
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
+	if err := validateOrderID("id", req.Id); err != nil {
+		return nil, err
 	}
 
 	if err := s.service.DeleteOrder(ctx, req.Id); err != nil {
@@ -245,7 +280,7 @@ func (s *OrderServer) ListOrders(ctx context.Context, req *orderv1.ListOrdersReq
 		offset = 0
 	}
 
-	orders, err := s.service.ListOrders(ctx, req.Status, limit, offset)
+	orders, total, err := s.service.ListOrders(ctx, req.Status, limit, offset)
 	if err != nil {
 		s.logger.Error("Failed to list orders", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to list orders: "+err.Error())
@@ -258,7 +293,10 @@ func (s *OrderServer) ListOrders(ctx context.Context, req *orderv1.ListOrdersReq
 	}
 
 	return &orderv1.ListOrdersResponse{
-		Orders: protoOrders,
+		Orders:     protoOrders,
+		TotalCount: int32(total),
+		Limit:      int32(limit),
+		Offset:     int32(offset),
 	}, nil
 }
 
@@ -269,31 +307,20 @@ func (s *OrderServer) UpdateOrderStatus(ctx context.Context, req *orderv1.Update
 		zap.String("status", req.Status.String()),
 	)
 
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
+	if err := validateOrderID("id", req.Id); err != nil {
+		return nil, err
 	}
 
-	// Convert proto status to domain status
-	var domainStatus domain.OrderStatus
-	switch req.Status {
-	case orderv1.OrderStatus_ORDER_STATUS_CREATED:
-		domainStatus = domain.StatusCreated
-	case orderv1.OrderStatus_ORDER_STATUS_PENDING:
-		domainStatus = domain.StatusPending
-	case orderv1.OrderStatus_ORDER_STATUS_PAID:
-		domainStatus = domain.StatusPaid
-	case orderv1.OrderStatus_ORDER_STATUS_SHIPPED:
-		domainStatus = domain.StatusShipped
-	case orderv1.OrderStatus_ORDER_STATUS_DELIVERED:
-		domainStatus = domain.StatusDelivered
-	case orderv1.OrderStatus_ORDER_STATUS_CANCELLED:
-		domainStatus = domain.StatusCancelled
-	default:
+	domainStatus, ok := toDomainOrderStatus(req.Status)
+	if !ok {
 		return nil, status.Error(codes.InvalidArgument, "invalid status")
 	}
 
-	if err := s.service.UpdateOrderStatus(ctx, req.Id, domainStatus); err != nil {
+	if err := s.service.UpdateOrderStatus(ctx, req.Id, domainStatus, identity.UserID(ctx)); err != nil {
 		s.logger.Error("Failed to update order status", zap.Error(err))
+		if errors.Is(err, domain.ErrInsufficientStock) || errors.Is(err, domain.ErrInvalidStatusTransition) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to update order status: "+err.Error())
 	}
 
@@ -302,6 +329,72 @@ func (s *OrderServer) UpdateOrderStatus(ctx context.Context, req *orderv1.Update
 	}, nil
 }
 
+// maxBulkStatusOrders caps the number of orders in one bulk status update
+const maxBulkStatusOrders = 500
+
+// BulkUpdateOrderStatus moves many orders to the same status
+func (s *OrderServer) BulkUpdateOrderStatus(ctx context.Context, req *orderv1.BulkUpdateOrderStatusRequest) (*orderv1.BulkUpdateOrderStatusResponse, error) {
+	s.logger.Info("gRPC BulkUpdateOrderStatus called",
+		zap.Int("order_count", len(req.Ids)),
+		zap.String("status", req.Status.String()),
+	)
+
+	if len(req.Ids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ids are required")
+	}
+	if len(req.Ids) > maxBulkStatusOrders {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d orders can be updated at once", maxBulkStatusOrders)
+	}
+	for _, id := range req.Ids {
+		if id == "" {
+			return nil, status.Error(codes.InvalidArgument, "ids must not be empty")
+		}
+	}
+
+	domainStatus, ok := toDomainOrderStatus(req.Status)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid status")
+	}
+
+	results := s.service.BulkUpdateStatus(ctx, req.Ids, domainStatus, identity.UserID(ctx))
+
+	protoResults := make([]*orderv1.OrderStatusUpdateResult, 0, len(results))
+	for _, result := range results {
+		protoResult := &orderv1.OrderStatusUpdateResult{
+			Id:      result.OrderID,
+			Success: result.Err == nil,
+		}
+		if result.Err != nil {
+			protoResult.Error = result.Err.Error()
+		}
+		protoResults = append(protoResults, protoResult)
+	}
+
+	return &orderv1.BulkUpdateOrderStatusResponse{
+		Results: protoResults,
+	}, nil
+}
+
+// toDomainOrderStatus converts a proto order status to the domain status
+func toDomainOrderStatus(s orderv1.OrderStatus) (domain.OrderStatus, bool) {
+	switch s {
+	case orderv1.OrderStatus_ORDER_STATUS_CREATED:
+		return domain.StatusCreated, true
+	case orderv1.OrderStatus_ORDER_STATUS_PENDING:
+		return domain.StatusPending, true
+	case orderv1.OrderStatus_ORDER_STATUS_PAID:
+		return domain.StatusPaid, true
+	case orderv1.OrderStatus_ORDER_STATUS_SHIPPED:
+		return domain.StatusShipped, true
+	case orderv1.OrderStatus_ORDER_STATUS_DELIVERED:
+		return domain.StatusDelivered, true
+	case orderv1.OrderStatus_ORDER_STATUS_CANCELLED:
+		return domain.StatusCancelled, true
+	default:
+		return "", false
+	}
+}
+
 // AddPayment adds payment information to an order
 func (s *OrderServer) AddPayment(ctx context.Context, req *orderv1.AddPaymentRequest) (*orderv1.AddPaymentResponse, error) {
 	s.logger.Info("gRPC AddPayment called",
@@ -311,8 +404,8 @@ func (s *OrderServer) AddPayment(ctx context.Context, req *orderv1.AddPaymentReq
 		zap.Float64("amount", req.Amount),
 	)
 
-	if req.OrderId == "" {
-		return nil, status.Error(codes.InvalidArgument, "order_id is required")
+	if err := validateOrderID("order_id", req.OrderId); err != nil {
+		return nil, err
 	}
 	if req.Method == "" {
 		return nil, status.Error(codes.InvalidArgument, "method is required")
@@ -321,8 +414,11 @@ func (s *OrderServer) AddPayment(ctx context.Context, req *orderv1.AddPaymentReq
 		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
 	}
 
-	if err := s.service.AddPaymentToOrder(ctx, req.OrderId, req.Method, req.TransactionId, req.Amount); err != nil {
+	if err := s.service.AddPaymentToOrder(ctx, req.OrderId, req.Method, req.TransactionId, req.Amount, identity.UserID(ctx)); err != nil {
 		s.logger.Error("Failed to add payment", zap.Error(err))
+		if errors.Is(err, domain.ErrInsufficientStock) || errors.Is(err, domain.ErrInvalidStatusTransition) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to add payment: "+err.Error())
 	}
 
@@ -338,15 +434,18 @@ func (s *OrderServer) AddTrackingCode(ctx context.Context, req *orderv1.AddTrack
 		zap.String("tracking_code", req.TrackingCode),
 	)
 
-	if req.OrderId == "" {
-		return nil, status.Error(codes.InvalidArgument, "order_id is required")
+	if err := validateOrderID("order_id", req.OrderId); err != nil {
+		return nil, err
 	}
 	if req.TrackingCode == "" {
 		return nil, status.Error(codes.InvalidArgument, "tracking_code is required")
 	}
 
-	if err := s.service.AddTrackingCodeToOrder(ctx, req.OrderId, req.TrackingCode); err != nil {
+	if err := s.service.AddTrackingCodeToOrder(ctx, req.OrderId, req.TrackingCode, identity.UserID(ctx)); err != nil {
 		s.logger.Error("Failed to add tracking code", zap.Error(err))
+		if errors.Is(err, domain.ErrInvalidStatusTransition) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to add tracking code: "+err.Error())
 	}
 
@@ -355,16 +454,91 @@ func (s *OrderServer) AddTrackingCode(ctx context.Context, req *orderv1.AddTrack
 	}, nil
 }
 
+// AddOrderNote appends a note to an order or one of its items. The author
+// defaults to the authenticated caller the gateway forwarded.
+func (s *OrderServer) AddOrderNote(ctx context.Context, req *orderv1.AddOrderNoteRequest) (*orderv1.AddOrderNoteResponse, error) {
+	s.logger.Info("gRPC AddOrderNote called",
+		zap.String("order_id", req.OrderId),
+		zap.String("product_id", req.ProductId),
+	)
+
+	if err := validateOrderID("order_id", req.OrderId); err != nil {
+		return nil, err
+	}
+
+	authorID := req.AuthorId
+	if authorID == "" {
+		authorID = identity.UserID(ctx)
+	}
+
+	order, err := s.service.AddOrderNote(ctx, req.OrderId, authorID, req.Text, req.ProductId)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidNote) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		s.logger.Error("Failed to add order note", zap.Error(err))
+		if err.Error() == "order not found" {
+			return nil, status.Error(codes.NotFound, "order not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to add order note: "+err.Error())
+	}
+
+	return &orderv1.AddOrderNoteResponse{
+		Order: toProtoOrder(order),
+	}, nil
+}
+
+// RecordShipment records a shipment of some or all of a paid order's items
+func (s *OrderServer) RecordShipment(ctx context.Context, req *orderv1.RecordShipmentRequest) (*orderv1.RecordShipmentResponse, error) {
+	s.logger.Info("gRPC RecordShipment called",
+		zap.String("order_id", req.OrderId),
+		zap.String("tracking_code", req.TrackingCode),
+		zap.Int("item_count", len(req.Items)),
+	)
+
+	if err := validateOrderID("order_id", req.OrderId); err != nil {
+		return nil, err
+	}
+	if len(req.Items) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "items are required")
+	}
+
+	items := make([]domain.ShipmentItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		items = append(items, domain.ShipmentItem{ProductID: item.ProductId, Quantity: item.Quantity})
+	}
+
+	order, shipment, err := s.service.RecordShipment(ctx, req.OrderId, req.TrackingCode, items, identity.UserID(ctx))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidShipment) || errors.Is(err, domain.ErrInvalidStatusTransition) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		s.logger.Error("Failed to record shipment", zap.Error(err))
+		if err.Error() == "order not found" {
+			return nil, status.Error(codes.NotFound, "order not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to record shipment: "+err.Error())
+	}
+
+	return &orderv1.RecordShipmentResponse{
+		Order:    toProtoOrder(order),
+		Shipment: toProtoShipment(*shipment),
+	}, nil
+}
+
 // CancelOrder cancels an order
 func (s *OrderServer) CancelOrder(ctx context.Context, req *orderv1.CancelOrderRequest) (*orderv1.CancelOrderResponse, error) {
 	s.logger.Info("gRPC CancelOrder called", zap.String("id", req.Id))
 
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
+	if err := validateOrderID("id", req.Id); err != nil {
+		return nil, err
 	}
 
-	if err := s.service.CancelOrder(ctx, req.Id); err != nil {
+	if err := s.service.CancelOrder(ctx, req.Id, identity.UserID(ctx)); err != nil {
 		s.logger.Error("Failed to cancel order", zap.Error(err))
+		if errors.Is(err, domain.ErrInvalidStatusTransition) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to cancel order: "+err.Error())
 	}
 
@@ -373,6 +547,159 @@ func (s *OrderServer) CancelOrder(ctx context.Context, req *orderv1.CancelOrderR
 	}, nil
 }
 
+// CancelOrderItems cancels lines of an order and returns the updated order.
+// Customers may only cancel items of their own orders.
+func (s *OrderServer) CancelOrderItems(ctx context.Context, req *orderv1.CancelOrderItemsRequest) (*orderv1.CancelOrderItemsResponse, error) {
+	s.logger.Info("gRPC CancelOrderItems called",
+		zap.String("id", req.Id),
+		zap.Strings("product_ids", req.ProductIds),
+	)
+
+	if err := validateOrderID("id", req.Id); err != nil {
+		return nil, err
+	}
+	if len(req.ProductIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one product_id is required")
+	}
+
+	order, err := s.service.GetOrder(ctx, req.Id)
+	if err != nil {
+		if err.Error() == "order not found" {
+			return nil, errOrderNotFound
+		}
+		s.logger.Error("Failed to get order", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get order")
+	}
+	if !canReadUserOrders(ctx, order.UserID) {
+		return nil, errOrderNotFound
+	}
+
+	order, err = s.service.CancelOrderItems(ctx, req.Id, req.ProductIds, identity.UserID(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidItemCancellation):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, domain.ErrInvalidStatusTransition):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, domain.ErrOptimisticLockFailed):
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		s.logger.Error("Failed to cancel order items", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to cancel order items: "+err.Error())
+	}
+
+	return &orderv1.CancelOrderItemsResponse{
+		Order: toProtoOrder(order),
+	}, nil
+}
+
+// GetOrderSummary counts the orders of a period and sums their revenue
+func (s *OrderServer) GetOrderSummary(ctx context.Context, req *orderv1.GetOrderSummaryRequest) (*orderv1.GetOrderSummaryResponse, error) {
+	s.logger.Debug("gRPC GetOrderSummary called",
+		zap.String("from_date", req.FromDate),
+		zap.String("to_date", req.ToDate),
+	)
+
+	from, err := dates.Parse(req.FromDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "from_date: "+err.Error())
+	}
+	to, err := dates.Parse(req.ToDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "to_date: "+err.Error())
+	}
+	if !to.After(from) {
+		return nil, status.Error(codes.InvalidArgument, "to_date must be after from_date")
+	}
+
+	summary, err := s.service.GetOrderSummary(ctx, from, to)
+	if err != nil {
+		s.logger.Error("Failed to summarize orders", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to summarize orders: "+err.Error())
+	}
+
+	return &orderv1.GetOrderSummaryResponse{
+		OrderCount: summary.OrderCount,
+		Revenue:    summary.Revenue,
+	}, nil
+}
+
+// toProtoOrderNotes converts an order's note log to proto notes
+func toProtoOrderNotes(notes []domain.OrderNote) []*orderv1.OrderNote {
+	if len(notes) == 0 {
+		return nil
+	}
+	protoNotes := make([]*orderv1.OrderNote, 0, len(notes))
+	for _, n := range notes {
+		protoNotes = append(protoNotes, &orderv1.OrderNote{
+			Id:        n.ID,
+			AuthorId:  n.AuthorID,
+			Text:      n.Text,
+			ProductId:   n.ProductID,
+			CreatedAt:   n.CreatedAt.Format(time.RFC3339),
+			CreatedAtTs: timestamppb.New(n.CreatedAt),
+		})
+	}
+	return protoNotes
+}
+
+// toProtoFulfillmentStatus converts a fulfillment rollup to its proto enum
+func toProtoFulfillmentStatus(f domain.FulfillmentStatus) orderv1.FulfillmentStatus {
+	switch f {
+	case domain.FulfillmentNone:
+		return orderv1.FulfillmentStatus_FULFILLMENT_STATUS_NONE
+	case domain.FulfillmentPartial:
+		return orderv1.FulfillmentStatus_FULFILLMENT_STATUS_PARTIAL
+	case domain.FulfillmentComplete:
+		return orderv1.FulfillmentStatus_FULFILLMENT_STATUS_COMPLETE
+	default:
+		return orderv1.FulfillmentStatus_FULFILLMENT_STATUS_UNSPECIFIED
+	}
+}
+
+// toProtoShipments converts an order's shipments to proto shipments
+func toProtoShipments(shipments []domain.Shipment) []*orderv1.Shipment {
+	if len(shipments) == 0 {
+		return nil
+	}
+	protoShipments := make([]*orderv1.Shipment, 0, len(shipments))
+	for _, shipment := range shipments {
+		protoShipments = append(protoShipments, toProtoShipment(shipment))
+	}
+	return protoShipments
+}
+
+// toProtoShipment converts a shipment to its proto form
+func toProtoShipment(shipment domain.Shipment) *orderv1.Shipment {
+	items := make([]*orderv1.ShipmentItem, 0, len(shipment.Items))
+	for _, item := range shipment.Items {
+		items = append(items, &orderv1.ShipmentItem{ProductId: item.ProductID, Quantity: item.Quantity})
+	}
+	return &orderv1.Shipment{
+		Id:           shipment.ID,
+		TrackingCode: shipment.TrackingCode,
+		Items:        items,
+		ShippedAt:    shipment.ShippedAt.Format(time.RFC3339),
+		ShippedAtTs:  timestamppb.New(shipment.ShippedAt),
+		ShippedBy:    shipment.ShippedBy,
+	}
+}
+
+// toDomainAddress converts a proto address to a domain address; a missing
+// address converts to an empty one
+func toDomainAddress(addr *orderv1.Address) domain.Address {
+	if addr == nil {
+		return domain.Address{}
+	}
+	return domain.Address{
+		Street:     addr.Street,
+		City:       addr.City,
+		State:      addr.State,
+		PostalCode: addr.PostalCode,
+		Country:    strings.ToUpper(strings.TrimSpace(addr.Country)),
+	}
+}
+
 // toProtoOrder converts a domain order to a proto order
 func toProtoOrder(order *domain.Order) *orderv1.Order {
 	protoOrder := &orderv1.Order{
@@ -380,9 +707,12 @@ func toProtoOrder(order *domain.Order) *orderv1.Order {
 		UserId:       order.UserID,
 		TotalAmount:  order.TotalAmount,
 		Notes:        order.Notes,
+		NoteLog:      toProtoOrderNotes(order.NoteLog),
 		TrackingCode: order.TrackingCode,
 		CreatedAt:    order.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:    order.UpdatedAt.Format(time.RFC3339),
+		CreatedAtTs:  timestamppb.New(order.CreatedAt),
+		UpdatedAtTs:  timestamppb.New(order.UpdatedAt),
 	}
 
 	// Convert status
@@ -403,18 +733,26 @@ func toProtoOrder(order *domain.Order) *orderv1.Order {
 		protoOrder.Status = orderv1.OrderStatus_ORDER_STATUS_UNSPECIFIED
 	}
 
-	// Convert items
+	// Convert items. Fulfilled quantities are per product, so they are
+	// handed out across lines for the same product in order.
+	fulfilled := order.FulfilledQuantities()
 	protoOrder.Items = make([]*orderv1.OrderItem, 0, len(order.Items))
 	for _, item := range order.Items {
+		fulfilledQty := min(item.Quantity, fulfilled[item.ProductID])
+		fulfilled[item.ProductID] -= fulfilledQty
 		protoOrder.Items = append(protoOrder.Items, &orderv1.OrderItem{
-			ProductId:  item.ProductID,
-			ProductSku: item.ProductSKU,
-			Name:       item.Name,
-			Quantity:   item.Quantity,
-			Price:      item.Price,
-			Subtotal:   item.Subtotal,
+			ProductId:    item.ProductID,
+			ProductSku:   item.ProductSKU,
+			Name:         item.Name,
+			Quantity:     item.Quantity,
+			Price:        item.Price,
+			Subtotal:     item.Subtotal,
+			FulfilledQty: fulfilledQty,
 		})
 	}
+	protoOrder.FulfillmentStatus = toProtoFulfillmentStatus(order.FulfillmentStatus())
+	protoOrder.Shipments = toProtoShipments(order.Shipments)
+	protoOrder.Guest = toProtoGuestContact(order.Guest)
 
 	// Convert addresses
 	protoOrder.ShippingAddress = &orderv1.Address{
@@ -449,6 +787,7 @@ func toProtoOrder(order *domain.Order) *orderv1.Order {
 	// Set completed at if it exists
 	if !order.CompletedAt.IsZero() {
 		protoOrder.CompletedAt = order.CompletedAt.Format(time.RFC3339)
+		protoOrder.CompletedAtTs = timestamppb.New(order.CompletedAt)
 	}
 
 	return protoOrder
@@ -0,0 +1,144 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/identity"
+	orderv1 "github.com/leonvanderhaeghen/stockplatform/services/orderSvc/api/gen/go/proto/order/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/application"
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// singleOrderRepository holds one order
+type singleOrderRepository struct {
+	domain.OrderRepository
+	order *domain.Order
+}
+
+func (r *singleOrderRepository) GetByID(ctx context.Context, id string) (*domain.Order, error) {
+	if id != r.order.ID {
+		return nil, nil
+	}
+	return r.order, nil
+}
+
+func (r *singleOrderRepository) GetByUserID(ctx context.Context, userID string, limit, offset int) ([]*domain.Order, error) {
+	if userID != r.order.UserID {
+		return nil, nil
+	}
+	return []*domain.Order{r.order}, nil
+}
+
+func newOwnershipTestServer(order *domain.Order) orderv1.OrderServiceServer {
+	service := application.NewOrderService(&singleOrderRepository{order: order}, nil, nil, nil, domain.GuestLinkSigner{}, false, zap.NewNop())
+	return NewOrderServer(service, nil, nil, nil, nil, zap.NewNop())
+}
+
+// callerContext returns an incoming context as the gateway forwards it for a
+// user with role; an empty user ID gives an internal caller
+func callerContext(userID, role string) context.Context {
+	if userID == "" {
+		return context.Background()
+	}
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		identity.UserIDMetadataKey, userID,
+		identity.RoleMetadataKey, role,
+	))
+}
+
+func TestGetOrderOwnership(t *testing.T) {
+	order := domain.NewOrder("customer-1", []domain.OrderItem{{ProductID: "product-1", Quantity: 1, Price: 10}}, domain.Address{}, domain.Address{})
+	server := newOwnershipTestServer(order)
+
+	tests := []struct {
+		name     string
+		userID   string
+		role     string
+		wantCode codes.Code
+	}{
+		{name: "owner", userID: "customer-1", role: "CUSTOMER", wantCode: codes.OK},
+		{name: "staff", userID: "staff-1", role: "STAFF", wantCode: codes.OK},
+		{name: "admin", userID: "admin-1", role: "ADMIN", wantCode: codes.OK},
+		{name: "internal caller", wantCode: codes.OK},
+		{name: "other customer", userID: "customer-2", role: "CUSTOMER", wantCode: codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := server.GetOrder(callerContext(tt.userID, tt.role), &orderv1.GetOrderRequest{Id: order.ID})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("code = %s, want %s (err %v)", code, tt.wantCode, err)
+			}
+			if err == nil && resp.GetOrder().GetId() != order.ID {
+				t.Fatalf("got order %q, want %s", resp.GetOrder().GetId(), order.ID)
+			}
+		})
+	}
+}
+
+func TestGetUserOrdersOwnership(t *testing.T) {
+	order := domain.NewOrder("customer-1", []domain.OrderItem{{ProductID: "product-1", Quantity: 1, Price: 10}}, domain.Address{}, domain.Address{})
+	server := newOwnershipTestServer(order)
+
+	tests := []struct {
+		name     string
+		userID   string
+		role     string
+		wantCode codes.Code
+	}{
+		{name: "owner", userID: "customer-1", role: "CUSTOMER", wantCode: codes.OK},
+		{name: "staff", userID: "staff-1", role: "STAFF", wantCode: codes.OK},
+		{name: "other customer", userID: "customer-2", role: "CUSTOMER", wantCode: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := server.GetUserOrders(callerContext(tt.userID, tt.role), &orderv1.GetUserOrdersRequest{UserId: "customer-1"})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("code = %s, want %s (err %v)", code, tt.wantCode, err)
+			}
+			if err == nil && len(resp.GetOrders()) != 1 {
+				t.Fatalf("got %d orders, want 1", len(resp.GetOrders()))
+			}
+		})
+	}
+}
+
+func TestGetOrderReportsFulfillment(t *testing.T) {
+	order := domain.NewOrder("customer-1", []domain.OrderItem{
+		{ProductID: "lamp", Quantity: 2, Price: 20},
+		{ProductID: "shade", Quantity: 1, Price: 5},
+	}, domain.Address{}, domain.Address{})
+	if err := order.UpdateStatus(domain.StatusPaid, "customer-1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := order.AddShipment("TRACK-1", []domain.ShipmentItem{{ProductID: "lamp", Quantity: 1}}, "staff-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := newOwnershipTestServer(order).GetOrder(callerContext("", ""), &orderv1.GetOrderRequest{Id: order.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := resp.GetOrder()
+	if got.GetFulfillmentStatus() != orderv1.FulfillmentStatus_FULFILLMENT_STATUS_PARTIAL {
+		t.Fatalf("fulfillment status = %s, want PARTIAL", got.GetFulfillmentStatus())
+	}
+	fulfilled := map[string]int32{}
+	for _, item := range got.GetItems() {
+		fulfilled[item.GetProductId()] = item.GetFulfilledQty()
+	}
+	if fulfilled["lamp"] != 1 || fulfilled["shade"] != 0 {
+		t.Fatalf("fulfilled quantities = %v, want lamp 1, shade 0", fulfilled)
+	}
+	if len(got.GetShipments()) != 1 {
+		t.Fatalf("shipments = %d, want 1", len(got.GetShipments()))
+	}
+}
@@ -0,0 +1,156 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	orderv1 "github.com/leonvanderhaeghen/stockplatform/services/orderSvc/api/gen/go/proto/order/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// CreateReturn opens a return (RMA) for items of an order
+func (s *OrderServer) CreateReturn(ctx context.Context, req *orderv1.CreateReturnRequest) (*orderv1.CreateReturnResponse, error) {
+	s.logger.Info("gRPC CreateReturn called",
+		zap.String("order_id", req.OrderId),
+		zap.Int("line_count", len(req.Lines)),
+	)
+
+	if err := validateOrderID("order_id", req.OrderId); err != nil {
+		return nil, err
+	}
+	if len(req.Lines) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one line is required")
+	}
+
+	lines := make([]domain.ReturnLine, 0, len(req.Lines))
+	for _, line := range req.Lines {
+		lines = append(lines, domain.ReturnLine{
+			ProductID: line.ProductId,
+			Quantity:  line.Quantity,
+			Condition: domain.ItemCondition(line.Condition),
+		})
+	}
+
+	ret, err := s.returns.CreateReturn(ctx, req.OrderId, lines, req.Reason)
+	if err != nil {
+		s.logger.Error("Failed to create return", zap.Error(err))
+		// Validation failures (unknown products, over-returns, wrong order status)
+		// are reported to the caller as such
+		return nil, status.Error(codes.FailedPrecondition, "failed to create return: "+err.Error())
+	}
+
+	return &orderv1.CreateReturnResponse{Return: toProtoReturn(ret)}, nil
+}
+
+// GetReturn retrieves a return by ID
+func (s *OrderServer) GetReturn(ctx context.Context, req *orderv1.GetReturnRequest) (*orderv1.GetReturnResponse, error) {
+	s.logger.Debug("gRPC GetReturn called", zap.String("id", req.Id))
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	ret, err := s.returns.GetReturn(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, domain.ErrReturnNotFound) {
+			return nil, status.Error(codes.NotFound, "return not found")
+		}
+		s.logger.Error("Failed to get return", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get return: "+err.Error())
+	}
+
+	return &orderv1.GetReturnResponse{Return: toProtoReturn(ret)}, nil
+}
+
+// ListOrderReturns lists the returns of an order
+func (s *OrderServer) ListOrderReturns(ctx context.Context, req *orderv1.ListOrderReturnsRequest) (*orderv1.ListOrderReturnsResponse, error) {
+	s.logger.Debug("gRPC ListOrderReturns called", zap.String("order_id", req.OrderId))
+
+	if err := validateOrderID("order_id", req.OrderId); err != nil {
+		return nil, err
+	}
+
+	returns, err := s.returns.ListOrderReturns(ctx, req.OrderId)
+	if err != nil {
+		s.logger.Error("Failed to list returns", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list returns: "+err.Error())
+	}
+
+	resp := &orderv1.ListOrderReturnsResponse{
+		Returns: make([]*orderv1.Return, 0, len(returns)),
+	}
+	for _, ret := range returns {
+		resp.Returns = append(resp.Returns, toProtoReturn(ret))
+	}
+	return resp, nil
+}
+
+// UpdateReturnStatus moves a return through its workflow
+func (s *OrderServer) UpdateReturnStatus(ctx context.Context, req *orderv1.UpdateReturnStatusRequest) (*orderv1.UpdateReturnStatusResponse, error) {
+	s.logger.Info("gRPC UpdateReturnStatus called",
+		zap.String("id", req.Id),
+		zap.String("status", req.Status),
+	)
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.Status == "" {
+		return nil, status.Error(codes.InvalidArgument, "status is required")
+	}
+
+	conditions := make(map[string]domain.ItemCondition, len(req.Conditions))
+	for productID, condition := range req.Conditions {
+		conditions[productID] = domain.ItemCondition(condition)
+	}
+
+	ret, err := s.returns.UpdateReturnStatus(ctx, req.Id, domain.ReturnStatus(req.Status), conditions)
+	if err != nil {
+		if errors.Is(err, domain.ErrReturnNotFound) {
+			return nil, status.Error(codes.NotFound, "return not found")
+		}
+		s.logger.Error("Failed to update return status", zap.Error(err))
+		return nil, status.Error(codes.FailedPrecondition, "failed to update return status: "+err.Error())
+	}
+
+	return &orderv1.UpdateReturnStatusResponse{Return: toProtoReturn(ret)}, nil
+}
+
+// toProtoReturn converts a domain return to a proto message
+func toProtoReturn(ret *domain.Return) *orderv1.Return {
+	pb := &orderv1.Return{
+		Id:          ret.ID,
+		OrderId:     ret.OrderID,
+		UserId:      ret.UserID,
+		Lines:       make([]*orderv1.ReturnLine, 0, len(ret.Lines)),
+		Reason:      ret.Reason,
+		Status:      string(ret.Status),
+		CreatedAt:   ret.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   ret.UpdatedAt.Format(time.RFC3339),
+		CreatedAtTs: timestamppb.New(ret.CreatedAt),
+		UpdatedAtTs: timestamppb.New(ret.UpdatedAt),
+	}
+	for _, line := range ret.Lines {
+		pb.Lines = append(pb.Lines, &orderv1.ReturnLine{
+			ProductId: line.ProductID,
+			Quantity:  line.Quantity,
+			Condition: string(line.Condition),
+			Restocked: line.Restocked,
+		})
+	}
+	if ret.ReceivedAt != nil {
+		pb.ReceivedAt = ret.ReceivedAt.Format(time.RFC3339)
+		pb.ReceivedAtTs = timestamppb.New(*ret.ReceivedAt)
+	}
+	if ret.RefundedAt != nil {
+		pb.RefundedAt = ret.RefundedAt.Format(time.RFC3339)
+		pb.RefundedAtTs = timestamppb.New(*ret.RefundedAt)
+	}
+	return pb
+}
@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	orderv1 "github.com/leonvanderhaeghen/stockplatform/services/orderSvc/api/gen/go/proto/order/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/application"
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// updatableOrderRepository holds one order and accepts updates to it
+type updatableOrderRepository struct {
+	singleOrderRepository
+}
+
+func (r *updatableOrderRepository) UpdateWithOptimisticLock(ctx context.Context, order *domain.Order, expectedVersion int32) error {
+	r.order = order
+	return nil
+}
+
+func TestUpdateOrderStatusTransitions(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     domain.OrderStatus
+		to       orderv1.OrderStatus
+		wantCode codes.Code
+	}{
+		{name: "created to pending", from: domain.StatusCreated, to: orderv1.OrderStatus_ORDER_STATUS_PENDING, wantCode: codes.OK},
+		{name: "paid to cancelled", from: domain.StatusPaid, to: orderv1.OrderStatus_ORDER_STATUS_CANCELLED, wantCode: codes.OK},
+		{name: "cancelled to paid", from: domain.StatusCancelled, to: orderv1.OrderStatus_ORDER_STATUS_PAID, wantCode: codes.FailedPrecondition},
+		{name: "delivered to pending", from: domain.StatusDelivered, to: orderv1.OrderStatus_ORDER_STATUS_PENDING, wantCode: codes.FailedPrecondition},
+		{name: "shipped to cancelled", from: domain.StatusShipped, to: orderv1.OrderStatus_ORDER_STATUS_CANCELLED, wantCode: codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := domain.NewOrder("customer-1", []domain.OrderItem{{ProductID: "product-1", Quantity: 1, Price: 10}}, domain.Address{}, domain.Address{})
+			order.Status = tt.from
+			repo := &updatableOrderRepository{singleOrderRepository{order: order}}
+			service := application.NewOrderService(repo, nil, nil, nil, domain.GuestLinkSigner{}, false, zap.NewNop())
+			server := NewOrderServer(service, nil, nil, nil, nil, zap.NewNop())
+
+			_, err := server.UpdateOrderStatus(context.Background(), &orderv1.UpdateOrderStatusRequest{Id: order.ID, Status: tt.to})
+
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("code = %s, want %s (err %v)", code, tt.wantCode, err)
+			}
+			if tt.wantCode != codes.OK && repo.order.Status != tt.from {
+				t.Fatalf("status = %s after a rejected transition, want %s", repo.order.Status, tt.from)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/identity"
+	orderv1 "github.com/leonvanderhaeghen/stockplatform/services/orderSvc/api/gen/go/proto/order/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// GetOrderTimeline lists everything that happened to an order, oldest first.
+// Customers may only read the timeline of their own orders.
+func (s *OrderServer) GetOrderTimeline(ctx context.Context, req *orderv1.GetOrderTimelineRequest) (*orderv1.GetOrderTimelineResponse, error) {
+	s.logger.Debug("gRPC GetOrderTimeline called", zap.String("order_id", req.OrderId))
+
+	if err := validateOrderID("order_id", req.OrderId); err != nil {
+		return nil, err
+	}
+
+	order, entries, err := s.timeline.GetOrderTimeline(ctx, req.OrderId)
+	if err != nil {
+		if err.Error() == "order not found" {
+			return nil, errOrderNotFound
+		}
+		s.logger.Error("Failed to get order timeline", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get order timeline")
+	}
+	if !canReadUserOrders(ctx, order.UserID) {
+		s.logger.Warn("Order timeline read denied to non-owner",
+			zap.String("order_id", req.OrderId),
+			zap.String("caller_id", identity.UserID(ctx)),
+		)
+		return nil, errOrderNotFound
+	}
+
+	return &orderv1.GetOrderTimelineResponse{
+		OrderId: order.ID,
+		Entries: toProtoTimelineEntries(entries),
+	}, nil
+}
+
+// toProtoTimelineEntries converts timeline entries to protobuf
+func toProtoTimelineEntries(entries []domain.TimelineEntry) []*orderv1.TimelineEntry {
+	protoEntries := make([]*orderv1.TimelineEntry, 0, len(entries))
+	for _, e := range entries {
+		protoEntries = append(protoEntries, &orderv1.TimelineEntry{
+			Type:        string(e.Type),
+			Timestamp:   e.Timestamp.Format(time.RFC3339),
+			Actor:       e.Actor,
+			Summary:     e.Summary,
+			ReferenceId: e.ReferenceID,
+		})
+	}
+	return protoEntries
+}
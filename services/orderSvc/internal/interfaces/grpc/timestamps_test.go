@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+func TestOrderTimestampsRoundTrip(t *testing.T) {
+	order := domain.NewOrder("user-1", nil, domain.Address{}, domain.Address{})
+	order.CreatedAt = time.Date(2024, 5, 1, 9, 30, 15, 123456789, time.UTC)
+	order.UpdatedAt = order.CreatedAt.Add(time.Hour)
+	order.CompletedAt = order.CreatedAt.Add(2 * time.Hour)
+	order.NoteLog = []domain.OrderNote{{ID: "note-1", Text: "left at the door", CreatedAt: order.UpdatedAt}}
+
+	pb := toProtoOrder(order)
+
+	for name, tt := range map[string]struct {
+		got  time.Time
+		want time.Time
+	}{
+		"created_at_ts":   {pb.GetCreatedAtTs().AsTime(), order.CreatedAt},
+		"updated_at_ts":   {pb.GetUpdatedAtTs().AsTime(), order.UpdatedAt},
+		"completed_at_ts": {pb.GetCompletedAtTs().AsTime(), order.CompletedAt},
+		"note created_at": {pb.GetNoteLog()[0].GetCreatedAtTs().AsTime(), order.UpdatedAt},
+	} {
+		if !tt.got.Equal(tt.want) {
+			t.Errorf("%s = %v, want %v to the nanosecond", name, tt.got, tt.want)
+		}
+	}
+
+	// The deprecated string forms are still sent, to the second
+	if pb.GetCreatedAt() != "2024-05-01T09:30:15Z" || pb.GetCompletedAt() != "2024-05-01T11:30:15Z" {
+		t.Errorf("string timestamps = %q, %q, want RFC3339", pb.GetCreatedAt(), pb.GetCompletedAt())
+	}
+}
+
+func TestOpenOrderHasNoCompletedTimestamp(t *testing.T) {
+	order := domain.NewOrder("user-1", nil, domain.Address{}, domain.Address{})
+
+	pb := toProtoOrder(order)
+
+	if pb.GetCompletedAtTs() != nil || pb.GetCompletedAt() != "" {
+		t.Fatalf("completed at = %v / %q, want neither form set for an open order", pb.GetCompletedAtTs(), pb.GetCompletedAt())
+	}
+}
@@ -0,0 +1,136 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	orderv1 "github.com/leonvanderhaeghen/stockplatform/services/orderSvc/api/gen/go/proto/order/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+)
+
+// ListWebhookDeliveries lists per-subscriber webhook delivery status
+func (s *OrderServer) ListWebhookDeliveries(ctx context.Context, req *orderv1.ListWebhookDeliveriesRequest) (*orderv1.ListWebhookDeliveriesResponse, error) {
+	s.logger.Info("gRPC ListWebhookDeliveries called",
+		zap.String("subscriber_id", req.SubscriberId),
+		zap.String("status", req.Status),
+	)
+
+	limit, offset := webhookPage(req.Limit, req.Offset)
+	deliveries, err := s.webhooks.ListDeliveries(ctx, req.SubscriberId, domain.DeliveryStatus(req.Status), limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list webhook deliveries", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list webhook deliveries: "+err.Error())
+	}
+
+	return &orderv1.ListWebhookDeliveriesResponse{
+		Deliveries: toProtoWebhookDeliveries(deliveries),
+	}, nil
+}
+
+// ListDeadLetteredWebhooks lists webhook deliveries that exhausted their retries
+func (s *OrderServer) ListDeadLetteredWebhooks(ctx context.Context, req *orderv1.ListDeadLetteredWebhooksRequest) (*orderv1.ListDeadLetteredWebhooksResponse, error) {
+	s.logger.Info("gRPC ListDeadLetteredWebhooks called", zap.String("subscriber_id", req.SubscriberId))
+
+	limit, offset := webhookPage(req.Limit, req.Offset)
+	deliveries, err := s.webhooks.ListDeadLetters(ctx, req.SubscriberId, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list dead-lettered webhooks", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list dead-lettered webhooks: "+err.Error())
+	}
+
+	return &orderv1.ListDeadLetteredWebhooksResponse{
+		Deliveries: toProtoWebhookDeliveries(deliveries),
+	}, nil
+}
+
+// ReplayDeadLetteredWebhook retries a dead-lettered webhook delivery
+func (s *OrderServer) ReplayDeadLetteredWebhook(ctx context.Context, req *orderv1.ReplayDeadLetteredWebhookRequest) (*orderv1.ReplayDeadLetteredWebhookResponse, error) {
+	s.logger.Info("gRPC ReplayDeadLetteredWebhook called", zap.String("id", req.Id))
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	delivery, err := s.webhooks.ReplayDeadLetter(ctx, req.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrDeadLetterNotFound):
+			return nil, status.Error(codes.NotFound, "dead-lettered delivery not found")
+		case errors.Is(err, domain.ErrUnknownSubscriber):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case delivery != nil:
+			// The attempt itself failed; report the updated delivery rather than an RPC error
+			return &orderv1.ReplayDeadLetteredWebhookResponse{
+				Success:  false,
+				Delivery: toProtoWebhookDelivery(delivery),
+			}, nil
+		default:
+			s.logger.Error("Failed to replay webhook delivery", zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to replay webhook delivery: "+err.Error())
+		}
+	}
+
+	return &orderv1.ReplayDeadLetteredWebhookResponse{
+		Success:  true,
+		Delivery: toProtoWebhookDelivery(delivery),
+	}, nil
+}
+
+// webhookPage applies default pagination for webhook listings
+func webhookPage(limit, offset int32) (int, int) {
+	if limit <= 0 {
+		limit = 50
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return int(limit), int(offset)
+}
+
+// toProtoWebhookDeliveries converts domain webhook deliveries to proto messages
+func toProtoWebhookDeliveries(deliveries []*domain.WebhookDelivery) []*orderv1.WebhookDelivery {
+	result := make([]*orderv1.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		result = append(result, toProtoWebhookDelivery(d))
+	}
+	return result
+}
+
+// toProtoWebhookDelivery converts a domain webhook delivery to a proto message
+func toProtoWebhookDelivery(d *domain.WebhookDelivery) *orderv1.WebhookDelivery {
+	delivery := &orderv1.WebhookDelivery{
+		Id:           d.ID,
+		EventId:      d.EventID,
+		EventType:    string(d.EventType),
+		OrderId:      d.OrderID,
+		SubscriberId: d.SubscriberID,
+		Url:          d.URL,
+		Status:       string(d.Status),
+		Attempts:     d.Attempts,
+		LastError:    d.LastError,
+		CreatedAt:    d.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    d.UpdatedAt.Format(time.RFC3339),
+		CreatedAtTs:  timestamppb.New(d.CreatedAt),
+		UpdatedAtTs:  timestamppb.New(d.UpdatedAt),
+	}
+	if d.Event != nil {
+		if payload, err := d.Event.ToJSON(); err == nil {
+			delivery.Payload = string(payload)
+		}
+	}
+	if d.LastAttemptAt != nil {
+		delivery.LastAttemptAt = d.LastAttemptAt.Format(time.RFC3339)
+		delivery.LastAttemptAtTs = timestamppb.New(*d.LastAttemptAt)
+	}
+	if d.DeliveredAt != nil {
+		delivery.DeliveredAt = d.DeliveredAt.Format(time.RFC3339)
+		delivery.DeliveredAtTs = timestamppb.New(*d.DeliveredAt)
+	}
+	return delivery
+}
@@ -3,9 +3,6 @@ package server
 import (
 	"context"
 	"net"
-	"os"
-	"os/signal"
-	"syscall"
 	"time"
 
 	"go.uber.org/zap"
@@ -13,11 +10,16 @@ import (
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/shutdown"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/version"
 	orderv1 "github.com/leonvanderhaeghen/stockplatform/services/orderSvc/api/gen/go/proto/order/v1"
 	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/application"
 	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/config"
 	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/database"
 	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/domain"
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/infrastructure/inventory"
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/infrastructure/product"
+	"github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/infrastructure/webhook"
 	grpcintf "github.com/leonvanderhaeghen/stockplatform/services/orderSvc/internal/interfaces/grpc"
 )
 
@@ -26,6 +28,9 @@ type Server struct {
 	grpcServer *grpc.Server
 	config     *config.Config
 	database   *database.Database
+	webhooks   *application.WebhookDispatcher
+	unpaid     *application.UnpaidOrderCanceller
+	shutdown   *shutdown.Coordinator
 	logger     *zap.Logger
 }
 
@@ -41,15 +46,62 @@ func New(cfg *config.Config, db *database.Database, logger *zap.Logger) *Server
 // Initialize sets up the gRPC server with all services
 func (s *Server) Initialize() error {
 	// Create gRPC server
-	s.grpcServer = grpc.NewServer()
+	s.grpcServer = grpc.NewServer(s.config.GRPCLimits.ServerOptions()...)
+
+	// Order events are delivered to webhook subscribers
+	subscribers := make([]domain.WebhookSubscriber, 0, len(s.config.Webhooks.Subscribers))
+	for id, url := range s.config.Webhooks.Subscribers {
+		subscribers = append(subscribers, domain.WebhookSubscriber{
+			ID:     id,
+			URL:    url,
+			Secret: s.config.Webhooks.Secret,
+		})
+	}
+	s.webhooks = application.NewWebhookDispatcher(
+		subscribers,
+		webhook.NewHTTPSender(s.config.Webhooks.Timeout),
+		s.database.WebhookRepo,
+		application.WebhookRetryPolicy{
+			MaxAttempts:    s.config.Webhooks.MaxAttempts,
+			InitialBackoff: s.config.Webhooks.InitialBackoff,
+			MaxBackoff:     s.config.Webhooks.MaxBackoff,
+		},
+		application.WebhookBatchPolicy{
+			Window:  s.config.Webhooks.InventoryBatchWindow,
+			MaxSize: s.config.Webhooks.InventoryBatchMaxSize,
+		},
+		s.logger,
+	)
+
+	// Pick up the deliveries a previous run left unfinished
+	resumeCtx, cancelResume := context.WithTimeout(context.Background(), 10*time.Second)
+	if _, err := s.webhooks.Resume(resumeCtx); err != nil {
+		s.logger.Error("Failed to resume pending webhook deliveries", zap.Error(err))
+	}
+	cancelResume()
 
-	// Initialize event publisher (could be Kafka or in-memory; nil for now)
-	var publisher domain.EventPublisher
 	// Create event service
-	eventService := application.NewEventService(publisher, s.logger)
+	eventService := application.NewEventService(s.webhooks, s.logger)
+
+	// Order items are checked against the product catalog before an order is created
+	catalog, err := product.NewCatalog(s.config.ProductServiceAddr, s.logger)
+	if err != nil {
+		return err
+	}
+
+	// Paying an order deducts its reserved stock
+	fulfiller, err := inventory.NewFulfiller(s.config.InventoryServiceAddr, s.config.DefaultLocationID, s.logger)
+	if err != nil {
+		return err
+	}
 
 	// Initialize order service
-	orderService := application.NewOrderService(s.database.OrderRepo, eventService, s.logger)
+	orderService := application.NewOrderService(s.database.OrderRepo, eventService, catalog, fulfiller, domain.NewGuestLinkSigner(s.config.GuestOrderLinkSecret, s.config.GuestOrderLinkTTL), s.config.ValidatePOSProducts, s.logger)
+
+	// Orders left unpaid too long are cancelled and their stock released
+	if s.config.Payment.Timeout > 0 {
+		s.unpaid = application.NewUnpaidOrderCanceller(orderService, s.config.Payment.Timeout, s.config.Payment.CheckInterval, s.logger)
+	}
 
 	// Create service config for POS transactions
 	serviceConfig := &domain.ServiceConfig{
@@ -70,15 +122,37 @@ func (s *Server) Initialize() error {
 	// Initialize POS transaction service
 	posTransactionService := application.NewPOSTransactionService(orderService, serviceConfig)
 
+	// Returns restock inventory when they are received
+	restocker, err := inventory.NewRestocker(s.config.InventoryServiceAddr, s.logger)
+	if err != nil {
+		return err
+	}
+	returnService := application.NewReturnService(s.database.ReturnRepo, s.database.OrderRepo, restocker, s.logger)
+
+	// Support reads an order's history from its own records and its returns
+	timelineService := application.NewTimelineService(s.database.OrderRepo, s.database.ReturnRepo, s.logger)
+
 	// Initialize gRPC handlers
-	orderServer := grpcintf.NewOrderServer(orderService, posTransactionService, s.logger)
+	orderServer := grpcintf.NewOrderServer(orderService, posTransactionService, s.webhooks, returnService, timelineService, s.logger)
 
 	// Register gRPC services
 	orderv1.RegisterOrderServiceServer(s.grpcServer, orderServer)
 
+	// Workers drain before the gRPC server stops, so that the stock release
+	// of an order being cancelled still reaches the inventory service
+	s.shutdown = shutdown.New(s.config.ShutdownDrainTimeout, s.logger)
+	if s.unpaid != nil {
+		s.shutdown.AddWorker("unpaid_order_canceller", s.unpaid.Stop)
+	}
+	s.shutdown.AddWorker("webhook_dispatcher", s.webhooks.Close)
+	s.shutdown.AddServer("grpc", shutdown.GRPCServer(s.grpcServer))
+	s.shutdown.AddCloser("product_catalog", catalog.Close)
+	s.shutdown.AddCloser("inventory_fulfiller", fulfiller.Close)
+	s.shutdown.AddCloser("inventory_restocker", restocker.Close)
+
 	// Register health check service
 	healthServer := grpcintf.NewHealthServer(s.logger)
-	grpc_health_v1.RegisterHealthServer(s.grpcServer, healthServer)
+	grpc_health_v1.RegisterHealthServer(s.grpcServer, version.NewHealthServer(healthServer))
 
 	// Enable reflection for development
 	reflection.Register(s.grpcServer)
@@ -87,7 +161,8 @@ func (s *Server) Initialize() error {
 	return nil
 }
 
-// Start starts the gRPC server with graceful shutdown
+// Start starts the gRPC server and blocks until the process is told to
+// stop, then shuts down through the coordinator
 func (s *Server) Start() error {
 	// Create listener
 	lis, err := net.Listen("tcp", ":"+s.config.GRPCPort)
@@ -95,6 +170,10 @@ func (s *Server) Start() error {
 		return err
 	}
 
+	if s.unpaid != nil {
+		s.unpaid.Start()
+	}
+
 	// Start server in a goroutine
 	go func() {
 		s.logger.Info("Starting gRPC server", zap.String("port", s.config.GRPCPort))
@@ -103,47 +182,12 @@ func (s *Server) Start() error {
 		}
 	}()
 
-	// Wait for interrupt signal
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
-
-	s.logger.Info("Shutting down gRPC server...")
-
-	// Graceful shutdown with timeout
-	done := make(chan struct{})
-	go func() {
-		s.grpcServer.GracefulStop()
-		close(done)
-	}()
-
-	// Wait for graceful shutdown or timeout
-	select {
-	case <-done:
-		s.logger.Info("gRPC server stopped gracefully")
-	case <-time.After(10 * time.Second):
-		s.logger.Warn("Graceful shutdown timed out, forcing stop")
-		s.grpcServer.Stop()
-	}
-
+	s.shutdown.ShutdownOnSignal()
 	return nil
 }
 
-// Shutdown gracefully shuts down the server
+// Shutdown drains the background workers, then stops the gRPC server within
+// ctx and closes the service's connections
 func (s *Server) Shutdown(ctx context.Context) error {
-	done := make(chan struct{})
-	go func() {
-		s.grpcServer.GracefulStop()
-		close(done)
-	}()
-
-	select {
-	case <-done:
-		s.logger.Info("gRPC server stopped gracefully")
-		return nil
-	case <-ctx.Done():
-		s.logger.Warn("Graceful shutdown timed out, forcing stop")
-		s.grpcServer.Stop()
-		return ctx.Err()
-	}
+	return s.shutdown.Shutdown(ctx)
 }
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.7
+// 	protoc-gen-go v1.36.6
 // 	protoc        (unknown)
 // source: product/v1/product.proto
 
@@ -74,7 +74,7 @@ func (x ProductSort_SortField) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ProductSort_SortField.Descriptor instead.
 func (ProductSort_SortField) EnumDescriptor() ([]byte, []int) {
-	return file_product_v1_product_proto_rawDescGZIP(), []int{7, 0}
+	return file_product_v1_product_proto_rawDescGZIP(), []int{17, 0}
 }
 
 type ProductSort_SortOrder int32
@@ -123,20 +123,23 @@ func (x ProductSort_SortOrder) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ProductSort_SortOrder.Descriptor instead.
 func (ProductSort_SortOrder) EnumDescriptor() ([]byte, []int) {
-	return file_product_v1_product_proto_rawDescGZIP(), []int{7, 1}
+	return file_product_v1_product_proto_rawDescGZIP(), []int{17, 1}
 }
 
 // Category represents a product category
 type Category struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	ParentId      string                 `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // Optional parent category ID
-	Level         int32                  `protobuf:"varint,5,opt,name=level,proto3" json:"level,omitempty"`                      // Category level in the hierarchy (0 for root categories)
-	Path          string                 `protobuf:"bytes,6,opt,name=path,proto3" json:"path,omitempty"`                         // Path in the category tree (e.g., "electronics/computers/laptops")
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ParentId     string                 `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // Optional parent category ID
+	Level        int32                  `protobuf:"varint,5,opt,name=level,proto3" json:"level,omitempty"`                      // Category level in the hierarchy (0 for root categories)
+	Path         string                 `protobuf:"bytes,6,opt,name=path,proto3" json:"path,omitempty"`                         // Path in the category tree (e.g., "electronics/computers/laptops")
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ProductCount int64                  `protobuf:"varint,9,opt,name=product_count,json=productCount,proto3" json:"product_count,omitempty"` // Number of products in the category
+	// Schema of the metadata products in the category carry
+	Attributes    []*CategoryAttribute `protobuf:"bytes,10,rep,name=attributes,proto3" json:"attributes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -227,6 +230,238 @@ func (x *Category) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Category) GetProductCount() int64 {
+	if x != nil {
+		return x.ProductCount
+	}
+	return 0
+}
+
+func (x *Category) GetAttributes() []*CategoryAttribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// CategoryAttribute describes an attribute products in a category carry in
+// their metadata, e.g. the size of shoes
+type CategoryAttribute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // "string", "number" or "boolean"; empty means string
+	Required      bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	AllowedValues []string               `protobuf:"bytes,4,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"` // Optional fixed set of values
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryAttribute) Reset() {
+	*x = CategoryAttribute{}
+	mi := &file_product_v1_product_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryAttribute) ProtoMessage() {}
+
+func (x *CategoryAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryAttribute.ProtoReflect.Descriptor instead.
+func (*CategoryAttribute) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CategoryAttribute) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryAttribute) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CategoryAttribute) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *CategoryAttribute) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+// ProductImage is a product image with its display position
+type ProductImage struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Url       string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Position  int32                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	IsPrimary bool                   `protobuf:"varint,3,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"` // Exactly one image of a product is primary
+	// Probed from the URL in the background after the image is added; unset
+	// until the probe succeeds. Ignored on create and update.
+	Metadata      *MediaMetadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductImage) Reset() {
+	*x = ProductImage{}
+	mi := &file_product_v1_product_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductImage) ProtoMessage() {}
+
+func (x *ProductImage) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductImage.ProtoReflect.Descriptor instead.
+func (*ProductImage) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProductImage) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ProductImage) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ProductImage) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+func (x *ProductImage) GetMetadata() *MediaMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// MediaMetadata describes the file behind a media URL
+type MediaMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   string                 `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Width         int32                  `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"` // 0 when the dimensions could not be read
+	Height        int32                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	ByteSize      int64                  `protobuf:"varint,4,opt,name=byte_size,json=byteSize,proto3" json:"byte_size,omitempty"`
+	ProbedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=probed_at,json=probedAt,proto3" json:"probed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MediaMetadata) Reset() {
+	*x = MediaMetadata{}
+	mi := &file_product_v1_product_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaMetadata) ProtoMessage() {}
+
+func (x *MediaMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaMetadata.ProtoReflect.Descriptor instead.
+func (*MediaMetadata) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MediaMetadata) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *MediaMetadata) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *MediaMetadata) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *MediaMetadata) GetByteSize() int64 {
+	if x != nil {
+		return x.ByteSize
+	}
+	return 0
+}
+
+func (x *MediaMetadata) GetProbedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ProbedAt
+	}
+	return nil
+}
+
 // Product represents an item in the inventory
 type Product struct {
 	state        protoimpl.MessageState `protogen:"open.v1"`
@@ -251,14 +486,36 @@ type Product struct {
 	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	DeletedAt    *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // For soft deletes
 	// Enriched categories returned to clients (server should populate from category_ids)
-	Categories    []*Category `protobuf:"bytes,21,rep,name=categories,proto3" json:"categories,omitempty"`
+	Categories []*Category `protobuf:"bytes,21,rep,name=categories,proto3" json:"categories,omitempty"`
+	// Ordered images; image_urls mirrors this list in the same order
+	Images []*ProductImage `protobuf:"bytes,22,rep,name=images,proto3" json:"images,omitempty"`
+	// User IDs of whoever created and last edited the product; staff only
+	CreatedBy string `protobuf:"bytes,23,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy string `protobuf:"bytes,24,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// Order line quantity limits; zero means no limit. A line must order at
+	// least min_order_qty, at most max_order_qty, in multiples of order_qty_increment.
+	MinOrderQty       int32 `protobuf:"varint,25,opt,name=min_order_qty,json=minOrderQty,proto3" json:"min_order_qty,omitempty"`
+	MaxOrderQty       int32 `protobuf:"varint,26,opt,name=max_order_qty,json=maxOrderQty,proto3" json:"max_order_qty,omitempty"`
+	OrderQtyIncrement int32 `protobuf:"varint,27,opt,name=order_qty_increment,json=orderQtyIncrement,proto3" json:"order_qty_increment,omitempty"`
+	// Lifecycle state: unpublished products are drafts, and soft-deleted ones
+	// (deleted_at set) are only listed for staff who ask for them
+	IsPublished bool `protobuf:"varint,28,opt,name=is_published,json=isPublished,proto3" json:"is_published,omitempty"`
+	IsDeleted   bool `protobuf:"varint,29,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+	// Set on bundles: the products every unit of the bundle is made of. Selling
+	// a bundle deducts its components' stock rather than its own.
+	BundleComponents []*BundleComponent `protobuf:"bytes,30,rep,name=bundle_components,json=bundleComponents,proto3" json:"bundle_components,omitempty"`
+	// Average rating and number of the product's approved reviews
+	RatingAverage float64 `protobuf:"fixed64,31,opt,name=rating_average,json=ratingAverage,proto3" json:"rating_average,omitempty"`
+	RatingCount   int64   `protobuf:"varint,32,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	// Incremented by every change; send it back in UpdateProductRequest
+	Version       int64 `protobuf:"varint,33,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Product) Reset() {
 	*x = Product{}
-	mi := &file_product_v1_product_proto_msgTypes[1]
+	mi := &file_product_v1_product_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -270,7 +527,7 @@ func (x *Product) String() string {
 func (*Product) ProtoMessage() {}
 
 func (x *Product) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_proto_msgTypes[1]
+	mi := &file_product_v1_product_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -283,7 +540,7 @@ func (x *Product) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Product.ProtoReflect.Descriptor instead.
 func (*Product) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_proto_rawDescGZIP(), []int{1}
+	return file_product_v1_product_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Product) GetId() string {
@@ -433,194 +690,3073 @@ func (x *Product) GetCategories() []*Category {
 	return nil
 }
 
-// Request to create a new product
-type CreateProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	CostPrice     string                 `protobuf:"bytes,3,opt,name=cost_price,json=costPrice,proto3" json:"cost_price,omitempty"`          // Cost price as a string for decimal precision
-	SellingPrice  string                 `protobuf:"bytes,4,opt,name=selling_price,json=sellingPrice,proto3" json:"selling_price,omitempty"` // Selling price as a string for decimal precision
-	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`                             // ISO 4217 currency code
-	Sku           string                 `protobuf:"bytes,6,opt,name=sku,proto3" json:"sku,omitempty"`
-	Barcode       string                 `protobuf:"bytes,7,opt,name=barcode,proto3" json:"barcode,omitempty"`
-	CategoryIds   []string               `protobuf:"bytes,8,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"` // Multiple category support
-	SupplierId    string                 `protobuf:"bytes,9,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
-	IsActive      bool                   `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	InStock       bool                   `protobuf:"varint,11,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
-	StockQty      int32                  `protobuf:"varint,12,opt,name=stock_qty,json=stockQty,proto3" json:"stock_qty,omitempty"`
-	LowStockAt    int32                  `protobuf:"varint,13,opt,name=low_stock_at,json=lowStockAt,proto3" json:"low_stock_at,omitempty"`
-	ImageUrls     []string               `protobuf:"bytes,14,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
-	VideoUrls     []string               `protobuf:"bytes,15,rep,name=video_urls,json=videoUrls,proto3" json:"video_urls,omitempty"`
-	Metadata      map[string]string      `protobuf:"bytes,16,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Flexible metadata field
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateProductRequest) Reset() {
-	*x = CreateProductRequest{}
-	mi := &file_product_v1_product_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateProductRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateProductRequest) ProtoMessage() {}
-
-func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_proto_msgTypes[2]
+func (x *Product) GetImages() []*ProductImage {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Images
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
-func (*CreateProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_proto_rawDescGZIP(), []int{2}
+	return nil
 }
 
-func (x *CreateProductRequest) GetName() string {
+func (x *Product) GetCreatedBy() string {
 	if x != nil {
-		return x.Name
+		return x.CreatedBy
 	}
 	return ""
 }
 
-func (x *CreateProductRequest) GetDescription() string {
+func (x *Product) GetUpdatedBy() string {
 	if x != nil {
-		return x.Description
+		return x.UpdatedBy
 	}
 	return ""
 }
 
-func (x *CreateProductRequest) GetCostPrice() string {
+func (x *Product) GetMinOrderQty() int32 {
 	if x != nil {
-		return x.CostPrice
+		return x.MinOrderQty
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateProductRequest) GetSellingPrice() string {
+func (x *Product) GetMaxOrderQty() int32 {
 	if x != nil {
-		return x.SellingPrice
+		return x.MaxOrderQty
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateProductRequest) GetCurrency() string {
+func (x *Product) GetOrderQtyIncrement() int32 {
 	if x != nil {
-		return x.Currency
+		return x.OrderQtyIncrement
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateProductRequest) GetSku() string {
+func (x *Product) GetIsPublished() bool {
 	if x != nil {
-		return x.Sku
+		return x.IsPublished
 	}
-	return ""
+	return false
 }
 
-func (x *CreateProductRequest) GetBarcode() string {
+func (x *Product) GetIsDeleted() bool {
 	if x != nil {
-		return x.Barcode
+		return x.IsDeleted
 	}
-	return ""
+	return false
 }
 
-func (x *CreateProductRequest) GetCategoryIds() []string {
+func (x *Product) GetBundleComponents() []*BundleComponent {
 	if x != nil {
-		return x.CategoryIds
+		return x.BundleComponents
 	}
 	return nil
 }
 
-func (x *CreateProductRequest) GetSupplierId() string {
+func (x *Product) GetRatingAverage() float64 {
 	if x != nil {
-		return x.SupplierId
+		return x.RatingAverage
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateProductRequest) GetIsActive() bool {
+func (x *Product) GetRatingCount() int64 {
 	if x != nil {
-		return x.IsActive
+		return x.RatingCount
 	}
-	return false
+	return 0
 }
 
-func (x *CreateProductRequest) GetInStock() bool {
+func (x *Product) GetVersion() int64 {
 	if x != nil {
-		return x.InStock
+		return x.Version
 	}
-	return false
+	return 0
 }
 
-func (x *CreateProductRequest) GetStockQty() int32 {
-	if x != nil {
-		return x.StockQty
-	}
-	return 0
+// BundleComponent is a product, or one variant option of it, in a bundle
+type BundleComponent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantOptionId string                 `protobuf:"bytes,2,opt,name=variant_option_id,json=variantOptionId,proto3" json:"variant_option_id,omitempty"` // Optional
+	Sku             string                 `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`                                                  // Resolved when the bundle is saved; ignored on input
+	Quantity        int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`                                       // Units per bundle
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CreateProductRequest) GetLowStockAt() int32 {
-	if x != nil {
-		return x.LowStockAt
+func (x *BundleComponent) Reset() {
+	*x = BundleComponent{}
+	mi := &file_product_v1_product_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleComponent) ProtoMessage() {}
+
+func (x *BundleComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleComponent.ProtoReflect.Descriptor instead.
+func (*BundleComponent) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BundleComponent) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *BundleComponent) GetVariantOptionId() string {
+	if x != nil {
+		return x.VariantOptionId
+	}
+	return ""
+}
+
+func (x *BundleComponent) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *BundleComponent) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Request to create a new product
+type CreateProductRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Name         string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CostPrice    string                 `protobuf:"bytes,3,opt,name=cost_price,json=costPrice,proto3" json:"cost_price,omitempty"`          // Cost price as a string for decimal precision
+	SellingPrice string                 `protobuf:"bytes,4,opt,name=selling_price,json=sellingPrice,proto3" json:"selling_price,omitempty"` // Selling price as a string for decimal precision
+	Currency     string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`                             // ISO 4217 currency code
+	Sku          string                 `protobuf:"bytes,6,opt,name=sku,proto3" json:"sku,omitempty"`
+	Barcode      string                 `protobuf:"bytes,7,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	CategoryIds  []string               `protobuf:"bytes,8,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"` // Multiple category support
+	SupplierId   string                 `protobuf:"bytes,9,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	IsActive     bool                   `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	InStock      bool                   `protobuf:"varint,11,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	StockQty     int32                  `protobuf:"varint,12,opt,name=stock_qty,json=stockQty,proto3" json:"stock_qty,omitempty"`
+	LowStockAt   int32                  `protobuf:"varint,13,opt,name=low_stock_at,json=lowStockAt,proto3" json:"low_stock_at,omitempty"`
+	ImageUrls    []string               `protobuf:"bytes,14,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
+	VideoUrls    []string               `protobuf:"bytes,15,rep,name=video_urls,json=videoUrls,proto3" json:"video_urls,omitempty"`
+	Metadata     map[string]string      `protobuf:"bytes,16,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Flexible metadata field
+	// Ordered images; when empty, image_urls is used and the first URL becomes primary
+	Images []*ProductImage `protobuf:"bytes,17,rep,name=images,proto3" json:"images,omitempty"`
+	// Location the product's inventory row is created at; the service's
+	// configured default location is used when empty
+	PrimaryLocationId string `protobuf:"bytes,18,opt,name=primary_location_id,json=primaryLocationId,proto3" json:"primary_location_id,omitempty"`
+	// Order line quantity limits; zero means no limit
+	MinOrderQty       int32 `protobuf:"varint,19,opt,name=min_order_qty,json=minOrderQty,proto3" json:"min_order_qty,omitempty"`
+	MaxOrderQty       int32 `protobuf:"varint,20,opt,name=max_order_qty,json=maxOrderQty,proto3" json:"max_order_qty,omitempty"`
+	OrderQtyIncrement int32 `protobuf:"varint,21,opt,name=order_qty_increment,json=orderQtyIncrement,proto3" json:"order_qty_increment,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateProductRequest) Reset() {
+	*x = CreateProductRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductRequest) ProtoMessage() {}
+
+func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetCostPrice() string {
+	if x != nil {
+		return x.CostPrice
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetSellingPrice() string {
+	if x != nil {
+		return x.SellingPrice
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetSupplierId() string {
+	if x != nil {
+		return x.SupplierId
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *CreateProductRequest) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *CreateProductRequest) GetStockQty() int32 {
+	if x != nil {
+		return x.StockQty
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetLowStockAt() int32 {
+	if x != nil {
+		return x.LowStockAt
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetImageUrls() []string {
+	if x != nil {
+		return x.ImageUrls
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetVideoUrls() []string {
+	if x != nil {
+		return x.VideoUrls
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetImages() []*ProductImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetPrimaryLocationId() string {
+	if x != nil {
+		return x.PrimaryLocationId
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetMinOrderQty() int32 {
+	if x != nil {
+		return x.MinOrderQty
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetMaxOrderQty() int32 {
+	if x != nil {
+		return x.MaxOrderQty
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetOrderQtyIncrement() int32 {
+	if x != nil {
+		return x.OrderQtyIncrement
+	}
+	return 0
+}
+
+// Response containing the created product
+type CreateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductResponse) Reset() {
+	*x = CreateProductResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductResponse) ProtoMessage() {}
+
+func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// Request to replace the editable fields of a product. Fields left empty are
+// cleared, except supplier_id, which keeps the current supplier.
+type UpdateProductRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CostPrice    string                 `protobuf:"bytes,4,opt,name=cost_price,json=costPrice,proto3" json:"cost_price,omitempty"`
+	SellingPrice string                 `protobuf:"bytes,5,opt,name=selling_price,json=sellingPrice,proto3" json:"selling_price,omitempty"`
+	Currency     string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	Sku          string                 `protobuf:"bytes,7,opt,name=sku,proto3" json:"sku,omitempty"`
+	Barcode      string                 `protobuf:"bytes,8,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	CategoryIds  []string               `protobuf:"bytes,9,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	SupplierId   string                 `protobuf:"bytes,10,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	IsActive     bool                   `protobuf:"varint,11,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	ImageUrls    []string               `protobuf:"bytes,12,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
+	VideoUrls    []string               `protobuf:"bytes,13,rep,name=video_urls,json=videoUrls,proto3" json:"video_urls,omitempty"`
+	Metadata     map[string]string      `protobuf:"bytes,14,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Images       []*ProductImage        `protobuf:"bytes,15,rep,name=images,proto3" json:"images,omitempty"`
+	// Version of the product the edit was made on; when it is no longer the
+	// current one the update fails with ABORTED. Zero skips the check.
+	Version       int64 `protobuf:"varint,16,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetCostPrice() string {
+	if x != nil {
+		return x.CostPrice
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetSellingPrice() string {
+	if x != nil {
+		return x.SellingPrice
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetSupplierId() string {
+	if x != nil {
+		return x.SupplierId
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateProductRequest) GetImageUrls() []string {
+	if x != nil {
+		return x.ImageUrls
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetVideoUrls() []string {
+	if x != nil {
+		return x.VideoUrls
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetImages() []*ProductImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Response containing the updated product
+type UpdateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductResponse) Reset() {
+	*x = UpdateProductResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductResponse) ProtoMessage() {}
+
+func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// Request to create a new product as a copy of an existing one. Fields left
+// empty keep the source product's value; the name defaults to the source's
+// name with " (copy)" appended and the SKU is generated.
+type CloneProductRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SourceId     string                 `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CostPrice    string                 `protobuf:"bytes,4,opt,name=cost_price,json=costPrice,proto3" json:"cost_price,omitempty"`
+	SellingPrice string                 `protobuf:"bytes,5,opt,name=selling_price,json=sellingPrice,proto3" json:"selling_price,omitempty"`
+	Currency     string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	Sku          string                 `protobuf:"bytes,7,opt,name=sku,proto3" json:"sku,omitempty"`
+	Barcode      string                 `protobuf:"bytes,8,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	CategoryIds  []string               `protobuf:"bytes,9,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	SupplierId   string                 `protobuf:"bytes,10,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	// Copy the source's variants, with new IDs and without option SKUs and barcodes
+	CopyVariants  bool `protobuf:"varint,11,opt,name=copy_variants,json=copyVariants,proto3" json:"copy_variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneProductRequest) Reset() {
+	*x = CloneProductRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneProductRequest) ProtoMessage() {}
+
+func (x *CloneProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneProductRequest.ProtoReflect.Descriptor instead.
+func (*CloneProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CloneProductRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *CloneProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CloneProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CloneProductRequest) GetCostPrice() string {
+	if x != nil {
+		return x.CostPrice
+	}
+	return ""
+}
+
+func (x *CloneProductRequest) GetSellingPrice() string {
+	if x != nil {
+		return x.SellingPrice
+	}
+	return ""
+}
+
+func (x *CloneProductRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CloneProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CloneProductRequest) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *CloneProductRequest) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *CloneProductRequest) GetSupplierId() string {
+	if x != nil {
+		return x.SupplierId
+	}
+	return ""
+}
+
+func (x *CloneProductRequest) GetCopyVariants() bool {
+	if x != nil {
+		return x.CopyVariants
+	}
+	return false
+}
+
+// Response containing the cloned product
+type CloneProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneProductResponse) Reset() {
+	*x = CloneProductResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneProductResponse) ProtoMessage() {}
+
+func (x *CloneProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneProductResponse.ProtoReflect.Descriptor instead.
+func (*CloneProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CloneProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// Request to get a product by ID
+type GetProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response containing the requested product
+type GetProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductResponse) Reset() {
+	*x = GetProductResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductResponse) ProtoMessage() {}
+
+func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
+func (*GetProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// Request message for fetching several products at once
+type BatchGetProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetProductsRequest) Reset() {
+	*x = BatchGetProductsRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetProductsRequest) ProtoMessage() {}
+
+func (x *BatchGetProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetProductsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BatchGetProductsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// Response message for BatchGetProducts. missing_ids lists the requested IDs
+// that do not match an existing product.
+type BatchGetProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	MissingIds    []string               `protobuf:"bytes,2,rep,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetProductsResponse) Reset() {
+	*x = BatchGetProductsResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetProductsResponse) ProtoMessage() {}
+
+func (x *BatchGetProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetProductsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BatchGetProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *BatchGetProductsResponse) GetMissingIds() []string {
+	if x != nil {
+		return x.MissingIds
+	}
+	return nil
+}
+
+// Filter conditions for listing products
+type ProductFilter struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Ids                  []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`                                                                    // Filter by product IDs
+	CategoryIds          []string               `protobuf:"bytes,2,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`                                 // Filter by category IDs
+	MinPrice             float64                `protobuf:"fixed64,3,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`                                        // Minimum price (inclusive)
+	MaxPrice             float64                `protobuf:"fixed64,4,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`                                        // Maximum price (inclusive)
+	SearchTerm           string                 `protobuf:"bytes,5,opt,name=search_term,json=searchTerm,proto3" json:"search_term,omitempty"`                                    // Search term for name or description
+	StoreId              string                 `protobuf:"bytes,6,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`                                             // Filter by store availability
+	AvailableInStoreOnly bool                   `protobuf:"varint,7,opt,name=available_in_store_only,json=availableInStoreOnly,proto3" json:"available_in_store_only,omitempty"` // Only show products available in stores
+	SupplierId           string                 `protobuf:"bytes,8,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`                                    // Filter by supplier
+	IsActive             *bool                  `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`                                   // Filter by active flag; both when unset
+	CreatedAfter         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`                             // Created at or after (inclusive)
+	CreatedBefore        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`                          // Created before (exclusive)
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ProductFilter) Reset() {
+	*x = ProductFilter{}
+	mi := &file_product_v1_product_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductFilter) ProtoMessage() {}
+
+func (x *ProductFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductFilter.ProtoReflect.Descriptor instead.
+func (*ProductFilter) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ProductFilter) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ProductFilter) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *ProductFilter) GetMinPrice() float64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *ProductFilter) GetMaxPrice() float64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *ProductFilter) GetSearchTerm() string {
+	if x != nil {
+		return x.SearchTerm
+	}
+	return ""
+}
+
+func (x *ProductFilter) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *ProductFilter) GetAvailableInStoreOnly() bool {
+	if x != nil {
+		return x.AvailableInStoreOnly
+	}
+	return false
+}
+
+func (x *ProductFilter) GetSupplierId() string {
+	if x != nil {
+		return x.SupplierId
+	}
+	return ""
+}
+
+func (x *ProductFilter) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *ProductFilter) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ProductFilter) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+// Sorting options for listing products
+type ProductSort struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         ProductSort_SortField  `protobuf:"varint,1,opt,name=field,proto3,enum=product.v1.ProductSort_SortField" json:"field,omitempty"`
+	Order         ProductSort_SortOrder  `protobuf:"varint,2,opt,name=order,proto3,enum=product.v1.ProductSort_SortOrder" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductSort) Reset() {
+	*x = ProductSort{}
+	mi := &file_product_v1_product_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductSort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductSort) ProtoMessage() {}
+
+func (x *ProductSort) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductSort.ProtoReflect.Descriptor instead.
+func (*ProductSort) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ProductSort) GetField() ProductSort_SortField {
+	if x != nil {
+		return x.Field
+	}
+	return ProductSort_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ProductSort) GetOrder() ProductSort_SortOrder {
+	if x != nil {
+		return x.Order
+	}
+	return ProductSort_SORT_ORDER_UNSPECIFIED
+}
+
+// Pagination parameters
+type Pagination struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                         // Page number (1-based)
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Number of items per page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pagination) Reset() {
+	*x = Pagination{}
+	mi := &file_product_v1_product_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pagination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pagination) ProtoMessage() {}
+
+func (x *Pagination) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
+func (*Pagination) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Pagination) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *Pagination) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Request to list products with filtering and sorting
+type ListProductsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Filter           *ProductFilter         `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`                                               // Optional filter criteria
+	Sort             *ProductSort           `protobuf:"bytes,2,opt,name=sort,proto3" json:"sort,omitempty"`                                                   // Optional sorting criteria
+	Pagination       *Pagination            `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`                                       // Optional pagination
+	RequestingUserId string                 `protobuf:"bytes,4,opt,name=requesting_user_id,json=requestingUserId,proto3" json:"requesting_user_id,omitempty"` // User making the request (for store-specific access)
+	IncludeDeleted   bool                   `protobuf:"varint,5,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`        // Also list soft-deleted products; honoured for staff callers only
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListProductsRequest) GetFilter() *ProductFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetSort() *ProductSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetRequestingUserId() string {
+	if x != nil {
+		return x.RequestingUserId
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+// Response containing a list of products with pagination info
+type ListProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`                        // List of products
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Total number of products matching the filter
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                               // Current page number
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`       // Number of items per page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListProductsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProductsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Request to stream every product matching a filter
+type StreamProductsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Filter         *ProductFilter         `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`                                        // Optional filter criteria
+	Sort           *ProductSort           `protobuf:"bytes,2,opt,name=sort,proto3" json:"sort,omitempty"`                                            // Optional sorting criteria
+	IncludeDeleted bool                   `protobuf:"varint,3,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // Also stream soft-deleted products; honoured for staff callers only
+	BatchSize      int32                  `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`                // Products per response message; defaults to 100, at most 500
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StreamProductsRequest) Reset() {
+	*x = StreamProductsRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamProductsRequest) ProtoMessage() {}
+
+func (x *StreamProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamProductsRequest.ProtoReflect.Descriptor instead.
+func (*StreamProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *StreamProductsRequest) GetFilter() *ProductFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *StreamProductsRequest) GetSort() *ProductSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *StreamProductsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *StreamProductsRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+// One batch of streamed products
+type StreamProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamProductsResponse) Reset() {
+	*x = StreamProductsResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamProductsResponse) ProtoMessage() {}
+
+func (x *StreamProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamProductsResponse.ProtoReflect.Descriptor instead.
+func (*StreamProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *StreamProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+// Request to list all categories
+type ListCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParentId      string                 `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // Optional parent category ID to filter by
+	Depth         int32                  `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`                      // Maximum depth of subcategories to return (0 for all)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListCategoriesRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *ListCategoriesRequest) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+// Response containing a list of categories
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// Request to create a new category
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                          // Required category name
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`            // Optional description
+	ParentId      string                 `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`  // Optional parent category ID
+	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"` // Whether the category is active
+	Attributes    []*CategoryAttribute   `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty"`              // Optional attribute schema
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *CreateCategoryRequest) GetAttributes() []*CategoryAttribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// Response containing the created category
+type CreateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// Request to change a category's name or description
+type UpdateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                   // Category ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`               // New name
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // New description
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Response containing the updated category
+type UpdateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryResponse) Reset() {
+	*x = UpdateCategoryResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryResponse) ProtoMessage() {}
+
+func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// Request to get a single category
+type GetCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryRequest) Reset() {
+	*x = GetCategoryRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryRequest) ProtoMessage() {}
+
+func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response containing the category
+type GetCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryResponse) Reset() {
+	*x = GetCategoryResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryResponse) ProtoMessage() {}
+
+func (x *GetCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// Request to replace the attribute schema of a category; no attributes
+// removes the schema
+type SetCategoryAttributesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Attributes    []*CategoryAttribute   `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCategoryAttributesRequest) Reset() {
+	*x = SetCategoryAttributesRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCategoryAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCategoryAttributesRequest) ProtoMessage() {}
+
+func (x *SetCategoryAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCategoryAttributesRequest.ProtoReflect.Descriptor instead.
+func (*SetCategoryAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetCategoryAttributesRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *SetCategoryAttributesRequest) GetAttributes() []*CategoryAttribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// Response containing the updated category
+type SetCategoryAttributesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCategoryAttributesResponse) Reset() {
+	*x = SetCategoryAttributesResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCategoryAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCategoryAttributesResponse) ProtoMessage() {}
+
+func (x *SetCategoryAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCategoryAttributesResponse.ProtoReflect.Descriptor instead.
+func (*SetCategoryAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetCategoryAttributesResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// Request to move a category, with everything below it, under another parent
+type MoveCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                        // Category to move
+	NewParentId   string                 `protobuf:"bytes,2,opt,name=new_parent_id,json=newParentId,proto3" json:"new_parent_id,omitempty"` // New parent category ID; empty makes it a root category
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCategoryRequest) Reset() {
+	*x = MoveCategoryRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCategoryRequest) ProtoMessage() {}
+
+func (x *MoveCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCategoryRequest.ProtoReflect.Descriptor instead.
+func (*MoveCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *MoveCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveCategoryRequest) GetNewParentId() string {
+	if x != nil {
+		return x.NewParentId
+	}
+	return ""
+}
+
+// Response containing the moved category
+type MoveCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCategoryResponse) Reset() {
+	*x = MoveCategoryResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCategoryResponse) ProtoMessage() {}
+
+func (x *MoveCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCategoryResponse.ProtoReflect.Descriptor instead.
+func (*MoveCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MoveCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// ExportProductsRequest is the request for exporting products to CSV
+type ExportProductsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Filter           *ProductFilter         `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`                                               // Optional filter criteria
+	Format           string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`                                               // "csv" for now, could support others later
+	RequestingUserId string                 `protobuf:"bytes,3,opt,name=requesting_user_id,json=requestingUserId,proto3" json:"requesting_user_id,omitempty"` // User making the request (for store-specific access)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ExportProductsRequest) Reset() {
+	*x = ExportProductsRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProductsRequest) ProtoMessage() {}
+
+func (x *ExportProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProductsRequest.ProtoReflect.Descriptor instead.
+func (*ExportProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ExportProductsRequest) GetFilter() *ProductFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ExportProductsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportProductsRequest) GetRequestingUserId() string {
+	if x != nil {
+		return x.RequestingUserId
+	}
+	return ""
+}
+
+// ExportProductsResponse is the response for exporting products
+type ExportProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // CSV data
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportProductsResponse) Reset() {
+	*x = ExportProductsResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProductsResponse) ProtoMessage() {}
+
+func (x *ExportProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProductsResponse.ProtoReflect.Descriptor instead.
+func (*ExportProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ExportProductsResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportProductsResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportProductsResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// GetStoreAvailableProductsRequest is the request for getting products available in a store
+type GetStoreAvailableProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StoreId       string                 `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	Filter        *ProductFilter         `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"` // Additional filtering
+	Sort          *ProductSort           `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStoreAvailableProductsRequest) Reset() {
+	*x = GetStoreAvailableProductsRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStoreAvailableProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoreAvailableProductsRequest) ProtoMessage() {}
+
+func (x *GetStoreAvailableProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoreAvailableProductsRequest.ProtoReflect.Descriptor instead.
+func (*GetStoreAvailableProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetStoreAvailableProductsRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *GetStoreAvailableProductsRequest) GetFilter() *ProductFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetStoreAvailableProductsRequest) GetSort() *ProductSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *GetStoreAvailableProductsRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// GetStoreAvailableProductsResponse is the response for store available products
+type GetStoreAvailableProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStoreAvailableProductsResponse) Reset() {
+	*x = GetStoreAvailableProductsResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStoreAvailableProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoreAvailableProductsResponse) ProtoMessage() {}
+
+func (x *GetStoreAvailableProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoreAvailableProductsResponse.ProtoReflect.Descriptor instead.
+func (*GetStoreAvailableProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetStoreAvailableProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *GetStoreAvailableProductsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetStoreAvailableProductsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetStoreAvailableProductsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// RebuildSearchIndexRequest is the request for rebuilding the product search index
+type RebuildSearchIndexRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebuildSearchIndexRequest) Reset() {
+	*x = RebuildSearchIndexRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebuildSearchIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebuildSearchIndexRequest) ProtoMessage() {}
+
+func (x *RebuildSearchIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebuildSearchIndexRequest.ProtoReflect.Descriptor instead.
+func (*RebuildSearchIndexRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{39}
+}
+
+// RebuildSearchIndexResponse reports how many products were covered by the rebuilt index
+type RebuildSearchIndexResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductsIndexed int64                  `protobuf:"varint,1,opt,name=products_indexed,json=productsIndexed,proto3" json:"products_indexed,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RebuildSearchIndexResponse) Reset() {
+	*x = RebuildSearchIndexResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebuildSearchIndexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebuildSearchIndexResponse) ProtoMessage() {}
+
+func (x *RebuildSearchIndexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebuildSearchIndexResponse.ProtoReflect.Descriptor instead.
+func (*RebuildSearchIndexResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RebuildSearchIndexResponse) GetProductsIndexed() int64 {
+	if x != nil {
+		return x.ProductsIndexed
+	}
+	return 0
+}
+
+// VariantOption is one choice of a product variant, such as a size or a color
+type VariantOption struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value           string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Sku             string                 `protobuf:"bytes,4,opt,name=sku,proto3" json:"sku,omitempty"`
+	Barcode         string                 `protobuf:"bytes,5,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	PriceAdjustment string                 `protobuf:"bytes,6,opt,name=price_adjustment,json=priceAdjustment,proto3" json:"price_adjustment,omitempty"` // Added to the product's selling price, as a decimal string
+	IsDefault       bool                   `protobuf:"varint,7,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	ImageUrl        string                 `protobuf:"bytes,8,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VariantOption) Reset() {
+	*x = VariantOption{}
+	mi := &file_product_v1_product_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariantOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantOption) ProtoMessage() {}
+
+func (x *VariantOption) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariantOption.ProtoReflect.Descriptor instead.
+func (*VariantOption) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *VariantOption) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VariantOption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VariantOption) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *VariantOption) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *VariantOption) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *VariantOption) GetPriceAdjustment() string {
+	if x != nil {
+		return x.PriceAdjustment
+	}
+	return ""
+}
+
+func (x *VariantOption) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *VariantOption) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+// Variant groups the options of one dimension of a product, such as "Size"
+type Variant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Options       []*VariantOption       `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Variant) Reset() {
+	*x = Variant{}
+	mi := &file_product_v1_product_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Variant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Variant) ProtoMessage() {}
+
+func (x *Variant) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Variant.ProtoReflect.Descriptor instead.
+func (*Variant) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Variant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Variant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Variant) GetOptions() []*VariantOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Variant) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Variant) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// GetVariantRequest identifies a single variant of a product
+type GetVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     string                 `protobuf:"bytes,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVariantRequest) Reset() {
+	*x = GetVariantRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVariantRequest) ProtoMessage() {}
+
+func (x *GetVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVariantRequest.ProtoReflect.Descriptor instead.
+func (*GetVariantRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetVariantRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetVariantRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+// GetVariantResponse contains the requested variant
+type GetVariantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variant       *Variant               `protobuf:"bytes,1,opt,name=variant,proto3" json:"variant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVariantResponse) Reset() {
+	*x = GetVariantResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVariantResponse) ProtoMessage() {}
+
+func (x *GetVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVariantResponse.ProtoReflect.Descriptor instead.
+func (*GetVariantResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetVariantResponse) GetVariant() *Variant {
+	if x != nil {
+		return x.Variant
+	}
+	return nil
+}
+
+// ListVariantsRequest identifies the product whose variants to list
+type ListVariantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVariantsRequest) Reset() {
+	*x = ListVariantsRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVariantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVariantsRequest) ProtoMessage() {}
+
+func (x *ListVariantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVariantsRequest.ProtoReflect.Descriptor instead.
+func (*ListVariantsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListVariantsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+// ListVariantsResponse contains the variants of a product
+type ListVariantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variants      []*Variant             `protobuf:"bytes,1,rep,name=variants,proto3" json:"variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVariantsResponse) Reset() {
+	*x = ListVariantsResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVariantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVariantsResponse) ProtoMessage() {}
+
+func (x *ListVariantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CreateProductRequest) GetImageUrls() []string {
+// Deprecated: Use ListVariantsResponse.ProtoReflect.Descriptor instead.
+func (*ListVariantsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListVariantsResponse) GetVariants() []*Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+// ReorderProductImagesRequest sets the display order of a product's images
+type ReorderProductImagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ImageUrls     []string               `protobuf:"bytes,2,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"` // Every current image URL, in the new order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderProductImagesRequest) Reset() {
+	*x = ReorderProductImagesRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderProductImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderProductImagesRequest) ProtoMessage() {}
+
+func (x *ReorderProductImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderProductImagesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderProductImagesRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ReorderProductImagesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ReorderProductImagesRequest) GetImageUrls() []string {
 	if x != nil {
 		return x.ImageUrls
 	}
 	return nil
 }
 
-func (x *CreateProductRequest) GetVideoUrls() []string {
+// ReorderProductImagesResponse contains the updated product
+type ReorderProductImagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderProductImagesResponse) Reset() {
+	*x = ReorderProductImagesResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderProductImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderProductImagesResponse) ProtoMessage() {}
+
+func (x *ReorderProductImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[48]
 	if x != nil {
-		return x.VideoUrls
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderProductImagesResponse.ProtoReflect.Descriptor instead.
+func (*ReorderProductImagesResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ReorderProductImagesResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
 	}
 	return nil
 }
 
-func (x *CreateProductRequest) GetMetadata() map[string]string {
+// SetPrimaryProductImageRequest selects a product's primary image
+type SetPrimaryProductImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,2,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPrimaryProductImageRequest) Reset() {
+	*x = SetPrimaryProductImageRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrimaryProductImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrimaryProductImageRequest) ProtoMessage() {}
+
+func (x *SetPrimaryProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[49]
 	if x != nil {
-		return x.Metadata
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrimaryProductImageRequest.ProtoReflect.Descriptor instead.
+func (*SetPrimaryProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SetPrimaryProductImageRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetPrimaryProductImageRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+// SetPrimaryProductImageResponse contains the updated product
+type SetPrimaryProductImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPrimaryProductImageResponse) Reset() {
+	*x = SetPrimaryProductImageResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrimaryProductImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrimaryProductImageResponse) ProtoMessage() {}
+
+func (x *SetPrimaryProductImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrimaryProductImageResponse.ProtoReflect.Descriptor instead.
+func (*SetPrimaryProductImageResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SetPrimaryProductImageResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
 	}
 	return nil
 }
 
-// Response containing the created product
-type CreateProductResponse struct {
+// SetBundleComponentsRequest replaces the components of a product. Components
+// must be existing products that are not bundles themselves.
+type SetBundleComponentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Components    []*BundleComponent     `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBundleComponentsRequest) Reset() {
+	*x = SetBundleComponentsRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBundleComponentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBundleComponentsRequest) ProtoMessage() {}
+
+func (x *SetBundleComponentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBundleComponentsRequest.ProtoReflect.Descriptor instead.
+func (*SetBundleComponentsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SetBundleComponentsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetBundleComponentsRequest) GetComponents() []*BundleComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+// SetBundleComponentsResponse contains the updated bundle
+type SetBundleComponentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProductResponse) Reset() {
-	*x = CreateProductResponse{}
-	mi := &file_product_v1_product_proto_msgTypes[3]
+func (x *SetBundleComponentsResponse) Reset() {
+	*x = SetBundleComponentsResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductResponse) String() string {
+func (x *SetBundleComponentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductResponse) ProtoMessage() {}
+func (*SetBundleComponentsResponse) ProtoMessage() {}
 
-func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_proto_msgTypes[3]
+func (x *SetBundleComponentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -631,41 +3767,41 @@ func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
-func (*CreateProductResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use SetBundleComponentsResponse.ProtoReflect.Descriptor instead.
+func (*SetBundleComponentsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *CreateProductResponse) GetProduct() *Product {
+func (x *SetBundleComponentsResponse) GetProduct() *Product {
 	if x != nil {
 		return x.Product
 	}
 	return nil
 }
 
-// Request to get a product by ID
-type GetProductRequest struct {
+// RemoveBundleRequest drops the components of a bundle
+type RemoveBundleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductRequest) Reset() {
-	*x = GetProductRequest{}
-	mi := &file_product_v1_product_proto_msgTypes[4]
+func (x *RemoveBundleRequest) Reset() {
+	*x = RemoveBundleRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductRequest) String() string {
+func (x *RemoveBundleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductRequest) ProtoMessage() {}
+func (*RemoveBundleRequest) ProtoMessage() {}
 
-func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_proto_msgTypes[4]
+func (x *RemoveBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -676,41 +3812,41 @@ func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
-func (*GetProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use RemoveBundleRequest.ProtoReflect.Descriptor instead.
+func (*RemoveBundleRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetProductRequest) GetId() string {
+func (x *RemoveBundleRequest) GetProductId() string {
 	if x != nil {
-		return x.Id
+		return x.ProductId
 	}
 	return ""
 }
 
-// Response containing the requested product
-type GetProductResponse struct {
+// RemoveBundleResponse contains the updated product
+type RemoveBundleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductResponse) Reset() {
-	*x = GetProductResponse{}
-	mi := &file_product_v1_product_proto_msgTypes[5]
+func (x *RemoveBundleResponse) Reset() {
+	*x = RemoveBundleResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductResponse) String() string {
+func (x *RemoveBundleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductResponse) ProtoMessage() {}
+func (*RemoveBundleResponse) ProtoMessage() {}
 
-func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_proto_msgTypes[5]
+func (x *RemoveBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -721,47 +3857,97 @@ func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
-func (*GetProductResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use RemoveBundleResponse.ProtoReflect.Descriptor instead.
+func (*RemoveBundleResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetProductResponse) GetProduct() *Product {
+func (x *RemoveBundleResponse) GetProduct() *Product {
 	if x != nil {
 		return x.Product
 	}
 	return nil
 }
 
-// Filter conditions for listing products
-type ProductFilter struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Ids                  []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`                                                                    // Filter by product IDs
-	CategoryIds          []string               `protobuf:"bytes,2,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`                                 // Filter by category IDs
-	MinPrice             float64                `protobuf:"fixed64,3,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`                                        // Minimum price (inclusive)
-	MaxPrice             float64                `protobuf:"fixed64,4,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`                                        // Maximum price (inclusive)
-	SearchTerm           string                 `protobuf:"bytes,5,opt,name=search_term,json=searchTerm,proto3" json:"search_term,omitempty"`                                    // Search term for name or description
-	StoreId              string                 `protobuf:"bytes,6,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`                                             // Filter by store availability
-	AvailableInStoreOnly bool                   `protobuf:"varint,7,opt,name=available_in_store_only,json=availableInStoreOnly,proto3" json:"available_in_store_only,omitempty"` // Only show products available in stores
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// GetBundleAvailabilityRequest asks for a bundle's availability at a location;
+// the service's default location is used when location_id is empty
+type GetBundleAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	LocationId    string                 `protobuf:"bytes,2,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBundleAvailabilityRequest) Reset() {
+	*x = GetBundleAvailabilityRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBundleAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBundleAvailabilityRequest) ProtoMessage() {}
+
+func (x *GetBundleAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBundleAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetBundleAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetBundleAvailabilityRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
 }
 
-func (x *ProductFilter) Reset() {
-	*x = ProductFilter{}
-	mi := &file_product_v1_product_proto_msgTypes[6]
+func (x *GetBundleAvailabilityRequest) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+// BundleComponentAvailability is the stock behind one bundle component
+type BundleComponentAvailability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Component     *BundleComponent       `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	Available     int32                  `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"` // Available-to-promise quantity of the component's product
+	Bundles       int32                  `protobuf:"varint,3,opt,name=bundles,proto3" json:"bundles,omitempty"`     // Bundles that quantity covers
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BundleComponentAvailability) Reset() {
+	*x = BundleComponentAvailability{}
+	mi := &file_product_v1_product_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProductFilter) String() string {
+func (x *BundleComponentAvailability) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductFilter) ProtoMessage() {}
+func (*BundleComponentAvailability) ProtoMessage() {}
 
-func (x *ProductFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_proto_msgTypes[6]
+func (x *BundleComponentAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -772,84 +3958,126 @@ func (x *ProductFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductFilter.ProtoReflect.Descriptor instead.
-func (*ProductFilter) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use BundleComponentAvailability.ProtoReflect.Descriptor instead.
+func (*BundleComponentAvailability) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *ProductFilter) GetIds() []string {
+func (x *BundleComponentAvailability) GetComponent() *BundleComponent {
 	if x != nil {
-		return x.Ids
+		return x.Component
 	}
 	return nil
 }
 
-func (x *ProductFilter) GetCategoryIds() []string {
+func (x *BundleComponentAvailability) GetAvailable() int32 {
 	if x != nil {
-		return x.CategoryIds
+		return x.Available
 	}
-	return nil
+	return 0
 }
 
-func (x *ProductFilter) GetMinPrice() float64 {
+func (x *BundleComponentAvailability) GetBundles() int32 {
 	if x != nil {
-		return x.MinPrice
+		return x.Bundles
 	}
 	return 0
 }
 
-func (x *ProductFilter) GetMaxPrice() float64 {
+// GetBundleAvailabilityResponse reports the bundle's availability: the
+// lowest number of bundles any component covers
+type GetBundleAvailabilityResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	ProductId     string                         `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	LocationId    string                         `protobuf:"bytes,2,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Available     int32                          `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
+	Components    []*BundleComponentAvailability `protobuf:"bytes,4,rep,name=components,proto3" json:"components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBundleAvailabilityResponse) Reset() {
+	*x = GetBundleAvailabilityResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBundleAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBundleAvailabilityResponse) ProtoMessage() {}
+
+func (x *GetBundleAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[57]
 	if x != nil {
-		return x.MaxPrice
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ProductFilter) GetSearchTerm() string {
+// Deprecated: Use GetBundleAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*GetBundleAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetBundleAvailabilityResponse) GetProductId() string {
 	if x != nil {
-		return x.SearchTerm
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *ProductFilter) GetStoreId() string {
+func (x *GetBundleAvailabilityResponse) GetLocationId() string {
 	if x != nil {
-		return x.StoreId
+		return x.LocationId
 	}
 	return ""
 }
 
-func (x *ProductFilter) GetAvailableInStoreOnly() bool {
+func (x *GetBundleAvailabilityResponse) GetAvailable() int32 {
 	if x != nil {
-		return x.AvailableInStoreOnly
+		return x.Available
 	}
-	return false
+	return 0
 }
 
-// Sorting options for listing products
-type ProductSort struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Field         ProductSort_SortField  `protobuf:"varint,1,opt,name=field,proto3,enum=product.v1.ProductSort_SortField" json:"field,omitempty"`
-	Order         ProductSort_SortOrder  `protobuf:"varint,2,opt,name=order,proto3,enum=product.v1.ProductSort_SortOrder" json:"order,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetBundleAvailabilityResponse) GetComponents() []*BundleComponentAvailability {
+	if x != nil {
+		return x.Components
+	}
+	return nil
 }
 
-func (x *ProductSort) Reset() {
-	*x = ProductSort{}
-	mi := &file_product_v1_product_proto_msgTypes[7]
+// ReassignSupplierProductsRequest moves every product of a supplier to another
+type ReassignSupplierProductsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FromSupplierId string                 `protobuf:"bytes,1,opt,name=from_supplier_id,json=fromSupplierId,proto3" json:"from_supplier_id,omitempty"`
+	ToSupplierId   string                 `protobuf:"bytes,2,opt,name=to_supplier_id,json=toSupplierId,proto3" json:"to_supplier_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReassignSupplierProductsRequest) Reset() {
+	*x = ReassignSupplierProductsRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProductSort) String() string {
+func (x *ReassignSupplierProductsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductSort) ProtoMessage() {}
+func (*ReassignSupplierProductsRequest) ProtoMessage() {}
 
-func (x *ProductSort) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_proto_msgTypes[7]
+func (x *ReassignSupplierProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -860,49 +4088,48 @@ func (x *ProductSort) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductSort.ProtoReflect.Descriptor instead.
-func (*ProductSort) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ReassignSupplierProductsRequest.ProtoReflect.Descriptor instead.
+func (*ReassignSupplierProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *ProductSort) GetField() ProductSort_SortField {
+func (x *ReassignSupplierProductsRequest) GetFromSupplierId() string {
 	if x != nil {
-		return x.Field
+		return x.FromSupplierId
 	}
-	return ProductSort_SORT_FIELD_UNSPECIFIED
+	return ""
 }
 
-func (x *ProductSort) GetOrder() ProductSort_SortOrder {
+func (x *ReassignSupplierProductsRequest) GetToSupplierId() string {
 	if x != nil {
-		return x.Order
+		return x.ToSupplierId
 	}
-	return ProductSort_SORT_ORDER_UNSPECIFIED
+	return ""
 }
 
-// Pagination parameters
-type Pagination struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                         // Page number (1-based)
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Number of items per page
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// ReassignSupplierProductsResponse reports how many products were moved
+type ReassignSupplierProductsResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ProductsReassigned int64                  `protobuf:"varint,1,opt,name=products_reassigned,json=productsReassigned,proto3" json:"products_reassigned,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *Pagination) Reset() {
-	*x = Pagination{}
-	mi := &file_product_v1_product_proto_msgTypes[8]
+func (x *ReassignSupplierProductsResponse) Reset() {
+	*x = ReassignSupplierProductsResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Pagination) String() string {
+func (x *ReassignSupplierProductsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pagination) ProtoMessage() {}
+func (*ReassignSupplierProductsResponse) ProtoMessage() {}
 
-func (x *Pagination) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_proto_msgTypes[8]
+func (x *ReassignSupplierProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -913,51 +4140,44 @@ func (x *Pagination) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
-func (*Pagination) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *Pagination) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+// Deprecated: Use ReassignSupplierProductsResponse.ProtoReflect.Descriptor instead.
+func (*ReassignSupplierProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *Pagination) GetPageSize() int32 {
+func (x *ReassignSupplierProductsResponse) GetProductsReassigned() int64 {
 	if x != nil {
-		return x.PageSize
+		return x.ProductsReassigned
 	}
 	return 0
 }
 
-// Request to list products with filtering and sorting
-type ListProductsRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Filter           *ProductFilter         `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`                                               // Optional filter criteria
-	Sort             *ProductSort           `protobuf:"bytes,2,opt,name=sort,proto3" json:"sort,omitempty"`                                                   // Optional sorting criteria
-	Pagination       *Pagination            `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`                                       // Optional pagination
-	RequestingUserId string                 `protobuf:"bytes,4,opt,name=requesting_user_id,json=requestingUserId,proto3" json:"requesting_user_id,omitempty"` // User making the request (for store-specific access)
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// BulkSetCategoriesRequest changes the categories of several products
+type BulkSetCategoriesRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds  []string               `protobuf:"bytes,1,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	CategoryIds []string               `protobuf:"bytes,2,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	// add, remove or replace
+	Mode          string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductsRequest) Reset() {
-	*x = ListProductsRequest{}
-	mi := &file_product_v1_product_proto_msgTypes[9]
+func (x *BulkSetCategoriesRequest) Reset() {
+	*x = BulkSetCategoriesRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsRequest) String() string {
+func (x *BulkSetCategoriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsRequest) ProtoMessage() {}
+func (*BulkSetCategoriesRequest) ProtoMessage() {}
 
-func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_proto_msgTypes[9]
+func (x *BulkSetCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -968,65 +4188,55 @@ func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
-func (*ListProductsRequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *ListProductsRequest) GetFilter() *ProductFilter {
-	if x != nil {
-		return x.Filter
-	}
-	return nil
+// Deprecated: Use BulkSetCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*BulkSetCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ListProductsRequest) GetSort() *ProductSort {
+func (x *BulkSetCategoriesRequest) GetProductIds() []string {
 	if x != nil {
-		return x.Sort
+		return x.ProductIds
 	}
 	return nil
 }
 
-func (x *ListProductsRequest) GetPagination() *Pagination {
+func (x *BulkSetCategoriesRequest) GetCategoryIds() []string {
 	if x != nil {
-		return x.Pagination
+		return x.CategoryIds
 	}
 	return nil
 }
 
-func (x *ListProductsRequest) GetRequestingUserId() string {
+func (x *BulkSetCategoriesRequest) GetMode() string {
 	if x != nil {
-		return x.RequestingUserId
+		return x.Mode
 	}
 	return ""
 }
 
-// Response containing a list of products with pagination info
-type ListProductsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`                        // List of products
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Total number of products matching the filter
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                               // Current page number
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`       // Number of items per page
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// BulkSetCategoriesResponse reports how many products changed
+type BulkSetCategoriesResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductsUpdated int64                  `protobuf:"varint,1,opt,name=products_updated,json=productsUpdated,proto3" json:"products_updated,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListProductsResponse) Reset() {
-	*x = ListProductsResponse{}
-	mi := &file_product_v1_product_proto_msgTypes[10]
+func (x *BulkSetCategoriesResponse) Reset() {
+	*x = BulkSetCategoriesResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsResponse) String() string {
+func (x *BulkSetCategoriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsResponse) ProtoMessage() {}
+func (*BulkSetCategoriesResponse) ProtoMessage() {}
 
-func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_proto_msgTypes[10]
+func (x *BulkSetCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1037,63 +4247,51 @@ func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
-func (*ListProductsResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *ListProductsResponse) GetProducts() []*Product {
-	if x != nil {
-		return x.Products
-	}
-	return nil
-}
-
-func (x *ListProductsResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-func (x *ListProductsResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+// Deprecated: Use BulkSetCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*BulkSetCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ListProductsResponse) GetPageSize() int32 {
+func (x *BulkSetCategoriesResponse) GetProductsUpdated() int64 {
 	if x != nil {
-		return x.PageSize
+		return x.ProductsUpdated
 	}
 	return 0
 }
 
-// Request to list all categories
-type ListCategoriesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ParentId      string                 `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // Optional parent category ID to filter by
-	Depth         int32                  `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`                      // Maximum depth of subcategories to return (0 for all)
+// ProductReview is a customer's rating of a product
+type ProductReview struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	UserId    string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Rating    int32                  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"` // 1 to 5
+	Text      string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	// Set when the user had received the product when writing the review
+	VerifiedPurchase bool `protobuf:"varint,6,opt,name=verified_purchase,json=verifiedPurchase,proto3" json:"verified_purchase,omitempty"`
+	// Only approved reviews are shown to customers and counted in the rating
+	Approved      bool                   `protobuf:"varint,7,opt,name=approved,proto3" json:"approved,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCategoriesRequest) Reset() {
-	*x = ListCategoriesRequest{}
-	mi := &file_product_v1_product_proto_msgTypes[11]
+func (x *ProductReview) Reset() {
+	*x = ProductReview{}
+	mi := &file_product_v1_product_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCategoriesRequest) String() string {
+func (x *ProductReview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCategoriesRequest) ProtoMessage() {}
+func (*ProductReview) ProtoMessage() {}
 
-func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_proto_msgTypes[11]
+func (x *ProductReview) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1104,96 +4302,100 @@ func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
-func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ProductReview.ProtoReflect.Descriptor instead.
+func (*ProductReview) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *ListCategoriesRequest) GetParentId() string {
+func (x *ProductReview) GetId() string {
 	if x != nil {
-		return x.ParentId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ListCategoriesRequest) GetDepth() int32 {
+func (x *ProductReview) GetProductId() string {
 	if x != nil {
-		return x.Depth
+		return x.ProductId
 	}
-	return 0
+	return ""
 }
 
-// Response containing a list of categories
-type ListCategoriesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ProductReview) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
 }
 
-func (x *ListCategoriesResponse) Reset() {
-	*x = ListCategoriesResponse{}
-	mi := &file_product_v1_product_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ProductReview) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
 }
 
-func (x *ListCategoriesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ProductReview) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
 }
 
-func (*ListCategoriesResponse) ProtoMessage() {}
+func (x *ProductReview) GetVerifiedPurchase() bool {
+	if x != nil {
+		return x.VerifiedPurchase
+	}
+	return false
+}
 
-func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_proto_msgTypes[12]
+func (x *ProductReview) GetApproved() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Approved
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
-func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_proto_rawDescGZIP(), []int{12}
+func (x *ProductReview) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *ListCategoriesResponse) GetCategories() []*Category {
+func (x *ProductReview) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Categories
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-// Request to create a new category
-type CreateCategoryRequest struct {
+// CreateProductReviewRequest reviews a product as the user the gateway
+// forwards in x-user-id; a user can review a product once
+type CreateProductReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                          // Required category name
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`            // Optional description
-	ParentId      string                 `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`  // Optional parent category ID
-	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"` // Whether the category is active
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCategoryRequest) Reset() {
-	*x = CreateCategoryRequest{}
-	mi := &file_product_v1_product_proto_msgTypes[13]
+func (x *CreateProductReviewRequest) Reset() {
+	*x = CreateProductReviewRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCategoryRequest) String() string {
+func (x *CreateProductReviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCategoryRequest) ProtoMessage() {}
+func (*CreateProductReviewRequest) ProtoMessage() {}
 
-func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_proto_msgTypes[13]
+func (x *CreateProductReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1204,62 +4406,54 @@ func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
-func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CreateProductReviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductReviewRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *CreateCategoryRequest) GetName() string {
+func (x *CreateProductReviewRequest) GetProductId() string {
 	if x != nil {
-		return x.Name
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *CreateCategoryRequest) GetDescription() string {
+func (x *CreateProductReviewRequest) GetRating() int32 {
 	if x != nil {
-		return x.Description
+		return x.Rating
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateCategoryRequest) GetParentId() string {
+func (x *CreateProductReviewRequest) GetText() string {
 	if x != nil {
-		return x.ParentId
+		return x.Text
 	}
 	return ""
 }
 
-func (x *CreateCategoryRequest) GetIsActive() bool {
-	if x != nil {
-		return x.IsActive
-	}
-	return false
-}
-
-// Response containing the created category
-type CreateCategoryResponse struct {
+type CreateProductReviewResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Review        *ProductReview         `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCategoryResponse) Reset() {
-	*x = CreateCategoryResponse{}
-	mi := &file_product_v1_product_proto_msgTypes[14]
+func (x *CreateProductReviewResponse) Reset() {
+	*x = CreateProductReviewResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCategoryResponse) String() string {
+func (x *CreateProductReviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCategoryResponse) ProtoMessage() {}
+func (*CreateProductReviewResponse) ProtoMessage() {}
 
-func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_proto_msgTypes[14]
+func (x *CreateProductReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1270,43 +4464,45 @@ func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
-func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use CreateProductReviewResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductReviewResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *CreateCategoryResponse) GetCategory() *Category {
+func (x *CreateProductReviewResponse) GetReview() *ProductReview {
 	if x != nil {
-		return x.Category
+		return x.Review
 	}
 	return nil
 }
 
-// ExportProductsRequest is the request for exporting products to CSV
-type ExportProductsRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Filter           *ProductFilter         `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`                                               // Optional filter criteria
-	Format           string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`                                               // "csv" for now, could support others later
-	RequestingUserId string                 `protobuf:"bytes,3,opt,name=requesting_user_id,json=requestingUserId,proto3" json:"requesting_user_id,omitempty"` // User making the request (for store-specific access)
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// ListProductReviewsRequest lists a page of a product's reviews. Reviews
+// waiting for moderation are only listed for staff who ask for them.
+type ListProductReviewsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProductId         string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Limit             int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	IncludeUnapproved bool                   `protobuf:"varint,4,opt,name=include_unapproved,json=includeUnapproved,proto3" json:"include_unapproved,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *ExportProductsRequest) Reset() {
-	*x = ExportProductsRequest{}
-	mi := &file_product_v1_product_proto_msgTypes[15]
+func (x *ListProductReviewsRequest) Reset() {
+	*x = ListProductReviewsRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExportProductsRequest) String() string {
+func (x *ListProductReviewsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportProductsRequest) ProtoMessage() {}
+func (*ListProductReviewsRequest) ProtoMessage() {}
 
-func (x *ExportProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_proto_msgTypes[15]
+func (x *ListProductReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1317,57 +4513,62 @@ func (x *ExportProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportProductsRequest.ProtoReflect.Descriptor instead.
-func (*ExportProductsRequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListProductReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ExportProductsRequest) GetFilter() *ProductFilter {
+func (x *ListProductReviewsRequest) GetProductId() string {
 	if x != nil {
-		return x.Filter
+		return x.ProductId
 	}
-	return nil
+	return ""
 }
 
-func (x *ExportProductsRequest) GetFormat() string {
+func (x *ListProductReviewsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Format
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *ExportProductsRequest) GetRequestingUserId() string {
+func (x *ListProductReviewsRequest) GetOffset() int32 {
 	if x != nil {
-		return x.RequestingUserId
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-// ExportProductsResponse is the response for exporting products
-type ExportProductsResponse struct {
+func (x *ListProductReviewsRequest) GetIncludeUnapproved() bool {
+	if x != nil {
+		return x.IncludeUnapproved
+	}
+	return false
+}
+
+type ListProductReviewsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // CSV data
-	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
-	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Reviews       []*ProductReview       `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExportProductsResponse) Reset() {
-	*x = ExportProductsResponse{}
-	mi := &file_product_v1_product_proto_msgTypes[16]
+func (x *ListProductReviewsResponse) Reset() {
+	*x = ListProductReviewsResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExportProductsResponse) String() string {
+func (x *ListProductReviewsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportProductsResponse) ProtoMessage() {}
+func (*ListProductReviewsResponse) ProtoMessage() {}
 
-func (x *ExportProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_proto_msgTypes[16]
+func (x *ListProductReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1378,58 +4579,49 @@ func (x *ExportProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportProductsResponse.ProtoReflect.Descriptor instead.
-func (*ExportProductsResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListProductReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *ExportProductsResponse) GetData() []byte {
+func (x *ListProductReviewsResponse) GetReviews() []*ProductReview {
 	if x != nil {
-		return x.Data
+		return x.Reviews
 	}
 	return nil
 }
 
-func (x *ExportProductsResponse) GetFilename() string {
-	if x != nil {
-		return x.Filename
-	}
-	return ""
-}
-
-func (x *ExportProductsResponse) GetContentType() string {
+func (x *ListProductReviewsResponse) GetTotalCount() int64 {
 	if x != nil {
-		return x.ContentType
+		return x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-// GetStoreAvailableProductsRequest is the request for getting products available in a store
-type GetStoreAvailableProductsRequest struct {
+// ModerateProductReviewRequest approves or rejects a review
+type ModerateProductReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StoreId       string                 `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
-	Filter        *ProductFilter         `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"` // Additional filtering
-	Sort          *ProductSort           `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
-	Pagination    *Pagination            `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ReviewId      string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Approved      bool                   `protobuf:"varint,2,opt,name=approved,proto3" json:"approved,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStoreAvailableProductsRequest) Reset() {
-	*x = GetStoreAvailableProductsRequest{}
-	mi := &file_product_v1_product_proto_msgTypes[17]
+func (x *ModerateProductReviewRequest) Reset() {
+	*x = ModerateProductReviewRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStoreAvailableProductsRequest) String() string {
+func (x *ModerateProductReviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStoreAvailableProductsRequest) ProtoMessage() {}
+func (*ModerateProductReviewRequest) ProtoMessage() {}
 
-func (x *GetStoreAvailableProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_proto_msgTypes[17]
+func (x *ModerateProductReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1440,65 +4632,47 @@ func (x *GetStoreAvailableProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStoreAvailableProductsRequest.ProtoReflect.Descriptor instead.
-func (*GetStoreAvailableProductsRequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ModerateProductReviewRequest.ProtoReflect.Descriptor instead.
+func (*ModerateProductReviewRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *GetStoreAvailableProductsRequest) GetStoreId() string {
+func (x *ModerateProductReviewRequest) GetReviewId() string {
 	if x != nil {
-		return x.StoreId
+		return x.ReviewId
 	}
 	return ""
 }
 
-func (x *GetStoreAvailableProductsRequest) GetFilter() *ProductFilter {
-	if x != nil {
-		return x.Filter
-	}
-	return nil
-}
-
-func (x *GetStoreAvailableProductsRequest) GetSort() *ProductSort {
-	if x != nil {
-		return x.Sort
-	}
-	return nil
-}
-
-func (x *GetStoreAvailableProductsRequest) GetPagination() *Pagination {
+func (x *ModerateProductReviewRequest) GetApproved() bool {
 	if x != nil {
-		return x.Pagination
+		return x.Approved
 	}
-	return nil
+	return false
 }
 
-// GetStoreAvailableProductsResponse is the response for store available products
-type GetStoreAvailableProductsResponse struct {
+type ModerateProductReviewResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Review        *ProductReview         `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStoreAvailableProductsResponse) Reset() {
-	*x = GetStoreAvailableProductsResponse{}
-	mi := &file_product_v1_product_proto_msgTypes[18]
+func (x *ModerateProductReviewResponse) Reset() {
+	*x = ModerateProductReviewResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStoreAvailableProductsResponse) String() string {
+func (x *ModerateProductReviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStoreAvailableProductsResponse) ProtoMessage() {}
+func (*ModerateProductReviewResponse) ProtoMessage() {}
 
-func (x *GetStoreAvailableProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_proto_msgTypes[18]
+func (x *ModerateProductReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1509,45 +4683,24 @@ func (x *GetStoreAvailableProductsResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStoreAvailableProductsResponse.ProtoReflect.Descriptor instead.
-func (*GetStoreAvailableProductsResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ModerateProductReviewResponse.ProtoReflect.Descriptor instead.
+func (*ModerateProductReviewResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GetStoreAvailableProductsResponse) GetProducts() []*Product {
+func (x *ModerateProductReviewResponse) GetReview() *ProductReview {
 	if x != nil {
-		return x.Products
+		return x.Review
 	}
 	return nil
 }
 
-func (x *GetStoreAvailableProductsResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-func (x *GetStoreAvailableProductsResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetStoreAvailableProductsResponse) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
 var File_product_v1_product_proto protoreflect.FileDescriptor
 
 const file_product_v1_product_proto_rawDesc = "" +
 	"\n" +
 	"\x18product/v1/product.proto\x12\n" +
-	"product.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8d\x02\n" +
+	"product.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf1\x02\n" +
 	"\bCategory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -1558,7 +4711,30 @@ const file_product_v1_product_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb7\x06\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12#\n" +
+	"\rproduct_count\x18\t \x01(\x03R\fproductCount\x12=\n" +
+	"\n" +
+	"attributes\x18\n" +
+	" \x03(\v2\x1d.product.v1.CategoryAttributeR\n" +
+	"attributes\"~\n" +
+	"\x11CategoryAttribute\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\x12%\n" +
+	"\x0eallowed_values\x18\x04 \x03(\tR\rallowedValues\"\x92\x01\n" +
+	"\fProductImage\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x03 \x01(\bR\tisPrimary\x125\n" +
+	"\bmetadata\x18\x04 \x01(\v2\x19.product.v1.MediaMetadataR\bmetadata\"\xb6\x01\n" +
+	"\rMediaMetadata\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x05R\x06height\x12\x1b\n" +
+	"\tbyte_size\x18\x04 \x01(\x03R\bbyteSize\x127\n" +
+	"\tprobed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bprobedAt\"\x8f\n" +
+	"\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -1591,10 +4767,31 @@ const file_product_v1_product_proto_rawDesc = "" +
 	"deleted_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x124\n" +
 	"\n" +
 	"categories\x18\x15 \x03(\v2\x14.product.v1.CategoryR\n" +
-	"categories\x1a;\n" +
+	"categories\x120\n" +
+	"\x06images\x18\x16 \x03(\v2\x18.product.v1.ProductImageR\x06images\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x17 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x18 \x01(\tR\tupdatedBy\x12\"\n" +
+	"\rmin_order_qty\x18\x19 \x01(\x05R\vminOrderQty\x12\"\n" +
+	"\rmax_order_qty\x18\x1a \x01(\x05R\vmaxOrderQty\x12.\n" +
+	"\x13order_qty_increment\x18\x1b \x01(\x05R\x11orderQtyIncrement\x12!\n" +
+	"\fis_published\x18\x1c \x01(\bR\visPublished\x12\x1d\n" +
+	"\n" +
+	"is_deleted\x18\x1d \x01(\bR\tisDeleted\x12H\n" +
+	"\x11bundle_components\x18\x1e \x03(\v2\x1b.product.v1.BundleComponentR\x10bundleComponents\x12%\n" +
+	"\x0erating_average\x18\x1f \x01(\x01R\rratingAverage\x12!\n" +
+	"\frating_count\x18  \x01(\x03R\vratingCount\x12\x18\n" +
+	"\aversion\x18! \x01(\x03R\aversion\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xda\x04\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8a\x01\n" +
+	"\x0fBundleComponent\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12*\n" +
+	"\x11variant_option_id\x18\x02 \x01(\tR\x0fvariantOptionId\x12\x10\n" +
+	"\x03sku\x18\x03 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\"\xb4\x06\n" +
 	"\x14CreateProductRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1d\n" +
@@ -1617,16 +4814,71 @@ const file_product_v1_product_proto_rawDesc = "" +
 	"image_urls\x18\x0e \x03(\tR\timageUrls\x12\x1d\n" +
 	"\n" +
 	"video_urls\x18\x0f \x03(\tR\tvideoUrls\x12J\n" +
-	"\bmetadata\x18\x10 \x03(\v2..product.v1.CreateProductRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\bmetadata\x18\x10 \x03(\v2..product.v1.CreateProductRequest.MetadataEntryR\bmetadata\x120\n" +
+	"\x06images\x18\x11 \x03(\v2\x18.product.v1.ProductImageR\x06images\x12.\n" +
+	"\x13primary_location_id\x18\x12 \x01(\tR\x11primaryLocationId\x12\"\n" +
+	"\rmin_order_qty\x18\x13 \x01(\x05R\vminOrderQty\x12\"\n" +
+	"\rmax_order_qty\x18\x14 \x01(\x05R\vmaxOrderQty\x12.\n" +
+	"\x13order_qty_increment\x18\x15 \x01(\x05R\x11orderQtyIncrement\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"F\n" +
 	"\x15CreateProductResponse\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\"\xdc\x04\n" +
+	"\x14UpdateProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"cost_price\x18\x04 \x01(\tR\tcostPrice\x12#\n" +
+	"\rselling_price\x18\x05 \x01(\tR\fsellingPrice\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x10\n" +
+	"\x03sku\x18\a \x01(\tR\x03sku\x12\x18\n" +
+	"\abarcode\x18\b \x01(\tR\abarcode\x12!\n" +
+	"\fcategory_ids\x18\t \x03(\tR\vcategoryIds\x12\x1f\n" +
+	"\vsupplier_id\x18\n" +
+	" \x01(\tR\n" +
+	"supplierId\x12\x1b\n" +
+	"\tis_active\x18\v \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"image_urls\x18\f \x03(\tR\timageUrls\x12\x1d\n" +
+	"\n" +
+	"video_urls\x18\r \x03(\tR\tvideoUrls\x12J\n" +
+	"\bmetadata\x18\x0e \x03(\v2..product.v1.UpdateProductRequest.MetadataEntryR\bmetadata\x120\n" +
+	"\x06images\x18\x0f \x03(\v2\x18.product.v1.ProductImageR\x06images\x12\x18\n" +
+	"\aversion\x18\x10 \x01(\x03R\aversion\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"F\n" +
+	"\x15UpdateProductResponse\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\"\xdd\x02\n" +
+	"\x13CloneProductRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"cost_price\x18\x04 \x01(\tR\tcostPrice\x12#\n" +
+	"\rselling_price\x18\x05 \x01(\tR\fsellingPrice\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x10\n" +
+	"\x03sku\x18\a \x01(\tR\x03sku\x12\x18\n" +
+	"\abarcode\x18\b \x01(\tR\abarcode\x12!\n" +
+	"\fcategory_ids\x18\t \x03(\tR\vcategoryIds\x12\x1f\n" +
+	"\vsupplier_id\x18\n" +
+	" \x01(\tR\n" +
+	"supplierId\x12#\n" +
+	"\rcopy_variants\x18\v \x01(\bR\fcopyVariants\"E\n" +
+	"\x14CloneProductResponse\x12-\n" +
 	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\"#\n" +
 	"\x11GetProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"C\n" +
 	"\x12GetProductResponse\x12-\n" +
-	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\"\xf1\x01\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\"+\n" +
+	"\x17BatchGetProductsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"l\n" +
+	"\x18BatchGetProductsResponse\x12/\n" +
+	"\bproducts\x18\x01 \x03(\v2\x13.product.v1.ProductR\bproducts\x12\x1f\n" +
+	"\vmissing_ids\x18\x02 \x03(\tR\n" +
+	"missingIds\"\xc6\x03\n" +
 	"\rProductFilter\x12\x10\n" +
 	"\x03ids\x18\x01 \x03(\tR\x03ids\x12!\n" +
 	"\fcategory_ids\x18\x02 \x03(\tR\vcategoryIds\x12\x1b\n" +
@@ -1635,7 +4887,15 @@ const file_product_v1_product_proto_rawDesc = "" +
 	"\vsearch_term\x18\x05 \x01(\tR\n" +
 	"searchTerm\x12\x19\n" +
 	"\bstore_id\x18\x06 \x01(\tR\astoreId\x125\n" +
-	"\x17available_in_store_only\x18\a \x01(\bR\x14availableInStoreOnly\"\xdc\x02\n" +
+	"\x17available_in_store_only\x18\a \x01(\bR\x14availableInStoreOnly\x12\x1f\n" +
+	"\vsupplier_id\x18\b \x01(\tR\n" +
+	"supplierId\x12 \n" +
+	"\tis_active\x18\t \x01(\bH\x00R\bisActive\x88\x01\x01\x12?\n" +
+	"\rcreated_after\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBeforeB\f\n" +
+	"\n" +
+	"_is_active\"\xdc\x02\n" +
 	"\vProductSort\x127\n" +
 	"\x05field\x18\x01 \x01(\x0e2!.product.v1.ProductSort.SortFieldR\x05field\x127\n" +
 	"\x05order\x18\x02 \x01(\x0e2!.product.v1.ProductSort.SortOrderR\x05order\"\x88\x01\n" +
@@ -1652,33 +4912,68 @@ const file_product_v1_product_proto_rawDesc = "" +
 	"\n" +
 	"Pagination\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"\xdb\x01\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"\x84\x02\n" +
 	"\x13ListProductsRequest\x121\n" +
 	"\x06filter\x18\x01 \x01(\v2\x19.product.v1.ProductFilterR\x06filter\x12+\n" +
 	"\x04sort\x18\x02 \x01(\v2\x17.product.v1.ProductSortR\x04sort\x126\n" +
 	"\n" +
 	"pagination\x18\x03 \x01(\v2\x16.product.v1.PaginationR\n" +
 	"pagination\x12,\n" +
-	"\x12requesting_user_id\x18\x04 \x01(\tR\x10requestingUserId\"\x99\x01\n" +
+	"\x12requesting_user_id\x18\x04 \x01(\tR\x10requestingUserId\x12'\n" +
+	"\x0finclude_deleted\x18\x05 \x01(\bR\x0eincludeDeleted\"\x99\x01\n" +
 	"\x14ListProductsResponse\x12/\n" +
 	"\bproducts\x18\x01 \x03(\v2\x13.product.v1.ProductR\bproducts\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"J\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xbf\x01\n" +
+	"\x15StreamProductsRequest\x121\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.product.v1.ProductFilterR\x06filter\x12+\n" +
+	"\x04sort\x18\x02 \x01(\v2\x17.product.v1.ProductSortR\x04sort\x12'\n" +
+	"\x0finclude_deleted\x18\x03 \x01(\bR\x0eincludeDeleted\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x04 \x01(\x05R\tbatchSize\"I\n" +
+	"\x16StreamProductsResponse\x12/\n" +
+	"\bproducts\x18\x01 \x03(\v2\x13.product.v1.ProductR\bproducts\"J\n" +
 	"\x15ListCategoriesRequest\x12\x1b\n" +
 	"\tparent_id\x18\x01 \x01(\tR\bparentId\x12\x14\n" +
 	"\x05depth\x18\x02 \x01(\x05R\x05depth\"N\n" +
 	"\x16ListCategoriesResponse\x124\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x14.product.v1.CategoryR\n" +
-	"categories\"\x87\x01\n" +
+	"categories\"\xc6\x01\n" +
 	"\x15CreateCategoryRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1b\n" +
 	"\tparent_id\x18\x03 \x01(\tR\bparentId\x12\x1b\n" +
-	"\tis_active\x18\x04 \x01(\bR\bisActive\"J\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\x12=\n" +
+	"\n" +
+	"attributes\x18\x05 \x03(\v2\x1d.product.v1.CategoryAttributeR\n" +
+	"attributes\"J\n" +
 	"\x16CreateCategoryResponse\x120\n" +
+	"\bcategory\x18\x01 \x01(\v2\x14.product.v1.CategoryR\bcategory\"]\n" +
+	"\x15UpdateCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"J\n" +
+	"\x16UpdateCategoryResponse\x120\n" +
+	"\bcategory\x18\x01 \x01(\v2\x14.product.v1.CategoryR\bcategory\"$\n" +
+	"\x12GetCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"G\n" +
+	"\x13GetCategoryResponse\x120\n" +
+	"\bcategory\x18\x01 \x01(\v2\x14.product.v1.CategoryR\bcategory\"~\n" +
+	"\x1cSetCategoryAttributesRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\x12=\n" +
+	"\n" +
+	"attributes\x18\x02 \x03(\v2\x1d.product.v1.CategoryAttributeR\n" +
+	"attributes\"Q\n" +
+	"\x1dSetCategoryAttributesResponse\x120\n" +
+	"\bcategory\x18\x01 \x01(\v2\x14.product.v1.CategoryR\bcategory\"I\n" +
+	"\x13MoveCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\rnew_parent_id\x18\x02 \x01(\tR\vnewParentId\"H\n" +
+	"\x14MoveCategoryResponse\x120\n" +
 	"\bcategory\x18\x01 \x01(\v2\x14.product.v1.CategoryR\bcategory\"\x90\x01\n" +
 	"\x15ExportProductsRequest\x121\n" +
 	"\x06filter\x18\x01 \x01(\v2\x19.product.v1.ProductFilterR\x06filter\x12\x16\n" +
@@ -1700,16 +4995,162 @@ const file_product_v1_product_proto_rawDesc = "" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize2\x8b\x05\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x1b\n" +
+	"\x19RebuildSearchIndexRequest\"G\n" +
+	"\x1aRebuildSearchIndexResponse\x12)\n" +
+	"\x10products_indexed\x18\x01 \x01(\x03R\x0fproductsIndexed\"\xdc\x01\n" +
+	"\rVariantOption\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x10\n" +
+	"\x03sku\x18\x04 \x01(\tR\x03sku\x12\x18\n" +
+	"\abarcode\x18\x05 \x01(\tR\abarcode\x12)\n" +
+	"\x10price_adjustment\x18\x06 \x01(\tR\x0fpriceAdjustment\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\a \x01(\bR\tisDefault\x12\x1b\n" +
+	"\timage_url\x18\b \x01(\tR\bimageUrl\"\xd8\x01\n" +
+	"\aVariant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x123\n" +
+	"\aoptions\x18\x03 \x03(\v2\x19.product.v1.VariantOptionR\aoptions\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"Q\n" +
+	"\x11GetVariantRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\tR\tvariantId\"C\n" +
+	"\x12GetVariantResponse\x12-\n" +
+	"\avariant\x18\x01 \x01(\v2\x13.product.v1.VariantR\avariant\"4\n" +
+	"\x13ListVariantsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"G\n" +
+	"\x14ListVariantsResponse\x12/\n" +
+	"\bvariants\x18\x01 \x03(\v2\x13.product.v1.VariantR\bvariants\"[\n" +
+	"\x1bReorderProductImagesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"image_urls\x18\x02 \x03(\tR\timageUrls\"M\n" +
+	"\x1cReorderProductImagesResponse\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\"[\n" +
+	"\x1dSetPrimaryProductImageRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1b\n" +
+	"\timage_url\x18\x02 \x01(\tR\bimageUrl\"O\n" +
+	"\x1eSetPrimaryProductImageResponse\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\"x\n" +
+	"\x1aSetBundleComponentsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12;\n" +
+	"\n" +
+	"components\x18\x02 \x03(\v2\x1b.product.v1.BundleComponentR\n" +
+	"components\"L\n" +
+	"\x1bSetBundleComponentsResponse\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\"4\n" +
+	"\x13RemoveBundleRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"E\n" +
+	"\x14RemoveBundleResponse\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\"^\n" +
+	"\x1cGetBundleAvailabilityRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1f\n" +
+	"\vlocation_id\x18\x02 \x01(\tR\n" +
+	"locationId\"\x90\x01\n" +
+	"\x1bBundleComponentAvailability\x129\n" +
+	"\tcomponent\x18\x01 \x01(\v2\x1b.product.v1.BundleComponentR\tcomponent\x12\x1c\n" +
+	"\tavailable\x18\x02 \x01(\x05R\tavailable\x12\x18\n" +
+	"\abundles\x18\x03 \x01(\x05R\abundles\"\xc6\x01\n" +
+	"\x1dGetBundleAvailabilityResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1f\n" +
+	"\vlocation_id\x18\x02 \x01(\tR\n" +
+	"locationId\x12\x1c\n" +
+	"\tavailable\x18\x03 \x01(\x05R\tavailable\x12G\n" +
+	"\n" +
+	"components\x18\x04 \x03(\v2'.product.v1.BundleComponentAvailabilityR\n" +
+	"components\"q\n" +
+	"\x1fReassignSupplierProductsRequest\x12(\n" +
+	"\x10from_supplier_id\x18\x01 \x01(\tR\x0efromSupplierId\x12$\n" +
+	"\x0eto_supplier_id\x18\x02 \x01(\tR\ftoSupplierId\"S\n" +
+	" ReassignSupplierProductsResponse\x12/\n" +
+	"\x13products_reassigned\x18\x01 \x01(\x03R\x12productsReassigned\"r\n" +
+	"\x18BulkSetCategoriesRequest\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\tR\n" +
+	"productIds\x12!\n" +
+	"\fcategory_ids\x18\x02 \x03(\tR\vcategoryIds\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\"F\n" +
+	"\x19BulkSetCategoriesResponse\x12)\n" +
+	"\x10products_updated\x18\x01 \x01(\x03R\x0fproductsUpdated\"\xc2\x02\n" +
+	"\rProductReview\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06rating\x18\x04 \x01(\x05R\x06rating\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text\x12+\n" +
+	"\x11verified_purchase\x18\x06 \x01(\bR\x10verifiedPurchase\x12\x1a\n" +
+	"\bapproved\x18\a \x01(\bR\bapproved\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"g\n" +
+	"\x1aCreateProductReviewRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\x05R\x06rating\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"P\n" +
+	"\x1bCreateProductReviewResponse\x121\n" +
+	"\x06review\x18\x01 \x01(\v2\x19.product.v1.ProductReviewR\x06review\"\x97\x01\n" +
+	"\x19ListProductReviewsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12-\n" +
+	"\x12include_unapproved\x18\x04 \x01(\bR\x11includeUnapproved\"r\n" +
+	"\x1aListProductReviewsResponse\x123\n" +
+	"\areviews\x18\x01 \x03(\v2\x19.product.v1.ProductReviewR\areviews\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x03R\n" +
+	"totalCount\"W\n" +
+	"\x1cModerateProductReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\tR\breviewId\x12\x1a\n" +
+	"\bapproved\x18\x02 \x01(\bR\bapproved\"R\n" +
+	"\x1dModerateProductReviewResponse\x121\n" +
+	"\x06review\x18\x01 \x01(\v2\x19.product.v1.ProductReviewR\x06review2\xf6\x14\n" +
 	"\x0eProductService\x12T\n" +
-	"\rCreateProduct\x12 .product.v1.CreateProductRequest\x1a!.product.v1.CreateProductResponse\x12K\n" +
+	"\rCreateProduct\x12 .product.v1.CreateProductRequest\x1a!.product.v1.CreateProductResponse\x12Q\n" +
+	"\fCloneProduct\x12\x1f.product.v1.CloneProductRequest\x1a .product.v1.CloneProductResponse\x12T\n" +
+	"\rUpdateProduct\x12 .product.v1.UpdateProductRequest\x1a!.product.v1.UpdateProductResponse\x12K\n" +
 	"\n" +
-	"GetProduct\x12\x1d.product.v1.GetProductRequest\x1a\x1e.product.v1.GetProductResponse\x12Q\n" +
-	"\fListProducts\x12\x1f.product.v1.ListProductsRequest\x1a .product.v1.ListProductsResponse\x12W\n" +
+	"GetProduct\x12\x1d.product.v1.GetProductRequest\x1a\x1e.product.v1.GetProductResponse\x12]\n" +
+	"\x10BatchGetProducts\x12#.product.v1.BatchGetProductsRequest\x1a$.product.v1.BatchGetProductsResponse\x12Q\n" +
+	"\fListProducts\x12\x1f.product.v1.ListProductsRequest\x1a .product.v1.ListProductsResponse\x12Y\n" +
+	"\x0eStreamProducts\x12!.product.v1.StreamProductsRequest\x1a\".product.v1.StreamProductsResponse0\x01\x12W\n" +
 	"\x0eListCategories\x12!.product.v1.ListCategoriesRequest\x1a\".product.v1.ListCategoriesResponse\x12W\n" +
 	"\x0eCreateCategory\x12!.product.v1.CreateCategoryRequest\x1a\".product.v1.CreateCategoryResponse\x12W\n" +
+	"\x0eUpdateCategory\x12!.product.v1.UpdateCategoryRequest\x1a\".product.v1.UpdateCategoryResponse\x12Q\n" +
+	"\fMoveCategory\x12\x1f.product.v1.MoveCategoryRequest\x1a .product.v1.MoveCategoryResponse\x12N\n" +
+	"\vGetCategory\x12\x1e.product.v1.GetCategoryRequest\x1a\x1f.product.v1.GetCategoryResponse\x12l\n" +
+	"\x15SetCategoryAttributes\x12(.product.v1.SetCategoryAttributesRequest\x1a).product.v1.SetCategoryAttributesResponse\x12W\n" +
 	"\x0eExportProducts\x12!.product.v1.ExportProductsRequest\x1a\".product.v1.ExportProductsResponse\x12x\n" +
-	"\x19GetStoreAvailableProducts\x12,.product.v1.GetStoreAvailableProductsRequest\x1a-.product.v1.GetStoreAvailableProductsResponseBHZFgithub.com/leonvanderhaeghen/stockplatform/gen/go/product/v1;productv1b\x06proto3"
+	"\x19GetStoreAvailableProducts\x12,.product.v1.GetStoreAvailableProductsRequest\x1a-.product.v1.GetStoreAvailableProductsResponse\x12c\n" +
+	"\x12RebuildSearchIndex\x12%.product.v1.RebuildSearchIndexRequest\x1a&.product.v1.RebuildSearchIndexResponse\x12u\n" +
+	"\x18ReassignSupplierProducts\x12+.product.v1.ReassignSupplierProductsRequest\x1a,.product.v1.ReassignSupplierProductsResponse\x12`\n" +
+	"\x11BulkSetCategories\x12$.product.v1.BulkSetCategoriesRequest\x1a%.product.v1.BulkSetCategoriesResponse\x12i\n" +
+	"\x14ReorderProductImages\x12'.product.v1.ReorderProductImagesRequest\x1a(.product.v1.ReorderProductImagesResponse\x12o\n" +
+	"\x16SetPrimaryProductImage\x12).product.v1.SetPrimaryProductImageRequest\x1a*.product.v1.SetPrimaryProductImageResponse\x12K\n" +
+	"\n" +
+	"GetVariant\x12\x1d.product.v1.GetVariantRequest\x1a\x1e.product.v1.GetVariantResponse\x12Q\n" +
+	"\fListVariants\x12\x1f.product.v1.ListVariantsRequest\x1a .product.v1.ListVariantsResponse\x12f\n" +
+	"\x13SetBundleComponents\x12&.product.v1.SetBundleComponentsRequest\x1a'.product.v1.SetBundleComponentsResponse\x12Q\n" +
+	"\fRemoveBundle\x12\x1f.product.v1.RemoveBundleRequest\x1a .product.v1.RemoveBundleResponse\x12l\n" +
+	"\x15GetBundleAvailability\x12(.product.v1.GetBundleAvailabilityRequest\x1a).product.v1.GetBundleAvailabilityResponse\x12f\n" +
+	"\x13CreateProductReview\x12&.product.v1.CreateProductReviewRequest\x1a'.product.v1.CreateProductReviewResponse\x12c\n" +
+	"\x12ListProductReviews\x12%.product.v1.ListProductReviewsRequest\x1a&.product.v1.ListProductReviewsResponse\x12l\n" +
+	"\x15ModerateProductReview\x12(.product.v1.ModerateProductReviewRequest\x1a).product.v1.ModerateProductReviewResponseBHZFgithub.com/leonvanderhaeghen/stockplatform/gen/go/product/v1;productv1b\x06proto3"
 
 var (
 	file_product_v1_product_proto_rawDescOnce sync.Once
@@ -1724,76 +5165,208 @@ func file_product_v1_product_proto_rawDescGZIP() []byte {
 }
 
 var file_product_v1_product_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_product_v1_product_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_product_v1_product_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
 var file_product_v1_product_proto_goTypes = []any{
 	(ProductSort_SortField)(0),                // 0: product.v1.ProductSort.SortField
 	(ProductSort_SortOrder)(0),                // 1: product.v1.ProductSort.SortOrder
 	(*Category)(nil),                          // 2: product.v1.Category
-	(*Product)(nil),                           // 3: product.v1.Product
-	(*CreateProductRequest)(nil),              // 4: product.v1.CreateProductRequest
-	(*CreateProductResponse)(nil),             // 5: product.v1.CreateProductResponse
-	(*GetProductRequest)(nil),                 // 6: product.v1.GetProductRequest
-	(*GetProductResponse)(nil),                // 7: product.v1.GetProductResponse
-	(*ProductFilter)(nil),                     // 8: product.v1.ProductFilter
-	(*ProductSort)(nil),                       // 9: product.v1.ProductSort
-	(*Pagination)(nil),                        // 10: product.v1.Pagination
-	(*ListProductsRequest)(nil),               // 11: product.v1.ListProductsRequest
-	(*ListProductsResponse)(nil),              // 12: product.v1.ListProductsResponse
-	(*ListCategoriesRequest)(nil),             // 13: product.v1.ListCategoriesRequest
-	(*ListCategoriesResponse)(nil),            // 14: product.v1.ListCategoriesResponse
-	(*CreateCategoryRequest)(nil),             // 15: product.v1.CreateCategoryRequest
-	(*CreateCategoryResponse)(nil),            // 16: product.v1.CreateCategoryResponse
-	(*ExportProductsRequest)(nil),             // 17: product.v1.ExportProductsRequest
-	(*ExportProductsResponse)(nil),            // 18: product.v1.ExportProductsResponse
-	(*GetStoreAvailableProductsRequest)(nil),  // 19: product.v1.GetStoreAvailableProductsRequest
-	(*GetStoreAvailableProductsResponse)(nil), // 20: product.v1.GetStoreAvailableProductsResponse
-	nil,                           // 21: product.v1.Product.MetadataEntry
-	nil,                           // 22: product.v1.CreateProductRequest.MetadataEntry
-	(*timestamppb.Timestamp)(nil), // 23: google.protobuf.Timestamp
+	(*CategoryAttribute)(nil),                 // 3: product.v1.CategoryAttribute
+	(*ProductImage)(nil),                      // 4: product.v1.ProductImage
+	(*MediaMetadata)(nil),                     // 5: product.v1.MediaMetadata
+	(*Product)(nil),                           // 6: product.v1.Product
+	(*BundleComponent)(nil),                   // 7: product.v1.BundleComponent
+	(*CreateProductRequest)(nil),              // 8: product.v1.CreateProductRequest
+	(*CreateProductResponse)(nil),             // 9: product.v1.CreateProductResponse
+	(*UpdateProductRequest)(nil),              // 10: product.v1.UpdateProductRequest
+	(*UpdateProductResponse)(nil),             // 11: product.v1.UpdateProductResponse
+	(*CloneProductRequest)(nil),               // 12: product.v1.CloneProductRequest
+	(*CloneProductResponse)(nil),              // 13: product.v1.CloneProductResponse
+	(*GetProductRequest)(nil),                 // 14: product.v1.GetProductRequest
+	(*GetProductResponse)(nil),                // 15: product.v1.GetProductResponse
+	(*BatchGetProductsRequest)(nil),           // 16: product.v1.BatchGetProductsRequest
+	(*BatchGetProductsResponse)(nil),          // 17: product.v1.BatchGetProductsResponse
+	(*ProductFilter)(nil),                     // 18: product.v1.ProductFilter
+	(*ProductSort)(nil),                       // 19: product.v1.ProductSort
+	(*Pagination)(nil),                        // 20: product.v1.Pagination
+	(*ListProductsRequest)(nil),               // 21: product.v1.ListProductsRequest
+	(*ListProductsResponse)(nil),              // 22: product.v1.ListProductsResponse
+	(*StreamProductsRequest)(nil),             // 23: product.v1.StreamProductsRequest
+	(*StreamProductsResponse)(nil),            // 24: product.v1.StreamProductsResponse
+	(*ListCategoriesRequest)(nil),             // 25: product.v1.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),            // 26: product.v1.ListCategoriesResponse
+	(*CreateCategoryRequest)(nil),             // 27: product.v1.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),            // 28: product.v1.CreateCategoryResponse
+	(*UpdateCategoryRequest)(nil),             // 29: product.v1.UpdateCategoryRequest
+	(*UpdateCategoryResponse)(nil),            // 30: product.v1.UpdateCategoryResponse
+	(*GetCategoryRequest)(nil),                // 31: product.v1.GetCategoryRequest
+	(*GetCategoryResponse)(nil),               // 32: product.v1.GetCategoryResponse
+	(*SetCategoryAttributesRequest)(nil),      // 33: product.v1.SetCategoryAttributesRequest
+	(*SetCategoryAttributesResponse)(nil),     // 34: product.v1.SetCategoryAttributesResponse
+	(*MoveCategoryRequest)(nil),               // 35: product.v1.MoveCategoryRequest
+	(*MoveCategoryResponse)(nil),              // 36: product.v1.MoveCategoryResponse
+	(*ExportProductsRequest)(nil),             // 37: product.v1.ExportProductsRequest
+	(*ExportProductsResponse)(nil),            // 38: product.v1.ExportProductsResponse
+	(*GetStoreAvailableProductsRequest)(nil),  // 39: product.v1.GetStoreAvailableProductsRequest
+	(*GetStoreAvailableProductsResponse)(nil), // 40: product.v1.GetStoreAvailableProductsResponse
+	(*RebuildSearchIndexRequest)(nil),         // 41: product.v1.RebuildSearchIndexRequest
+	(*RebuildSearchIndexResponse)(nil),        // 42: product.v1.RebuildSearchIndexResponse
+	(*VariantOption)(nil),                     // 43: product.v1.VariantOption
+	(*Variant)(nil),                           // 44: product.v1.Variant
+	(*GetVariantRequest)(nil),                 // 45: product.v1.GetVariantRequest
+	(*GetVariantResponse)(nil),                // 46: product.v1.GetVariantResponse
+	(*ListVariantsRequest)(nil),               // 47: product.v1.ListVariantsRequest
+	(*ListVariantsResponse)(nil),              // 48: product.v1.ListVariantsResponse
+	(*ReorderProductImagesRequest)(nil),       // 49: product.v1.ReorderProductImagesRequest
+	(*ReorderProductImagesResponse)(nil),      // 50: product.v1.ReorderProductImagesResponse
+	(*SetPrimaryProductImageRequest)(nil),     // 51: product.v1.SetPrimaryProductImageRequest
+	(*SetPrimaryProductImageResponse)(nil),    // 52: product.v1.SetPrimaryProductImageResponse
+	(*SetBundleComponentsRequest)(nil),        // 53: product.v1.SetBundleComponentsRequest
+	(*SetBundleComponentsResponse)(nil),       // 54: product.v1.SetBundleComponentsResponse
+	(*RemoveBundleRequest)(nil),               // 55: product.v1.RemoveBundleRequest
+	(*RemoveBundleResponse)(nil),              // 56: product.v1.RemoveBundleResponse
+	(*GetBundleAvailabilityRequest)(nil),      // 57: product.v1.GetBundleAvailabilityRequest
+	(*BundleComponentAvailability)(nil),       // 58: product.v1.BundleComponentAvailability
+	(*GetBundleAvailabilityResponse)(nil),     // 59: product.v1.GetBundleAvailabilityResponse
+	(*ReassignSupplierProductsRequest)(nil),   // 60: product.v1.ReassignSupplierProductsRequest
+	(*ReassignSupplierProductsResponse)(nil),  // 61: product.v1.ReassignSupplierProductsResponse
+	(*BulkSetCategoriesRequest)(nil),          // 62: product.v1.BulkSetCategoriesRequest
+	(*BulkSetCategoriesResponse)(nil),         // 63: product.v1.BulkSetCategoriesResponse
+	(*ProductReview)(nil),                     // 64: product.v1.ProductReview
+	(*CreateProductReviewRequest)(nil),        // 65: product.v1.CreateProductReviewRequest
+	(*CreateProductReviewResponse)(nil),       // 66: product.v1.CreateProductReviewResponse
+	(*ListProductReviewsRequest)(nil),         // 67: product.v1.ListProductReviewsRequest
+	(*ListProductReviewsResponse)(nil),        // 68: product.v1.ListProductReviewsResponse
+	(*ModerateProductReviewRequest)(nil),      // 69: product.v1.ModerateProductReviewRequest
+	(*ModerateProductReviewResponse)(nil),     // 70: product.v1.ModerateProductReviewResponse
+	nil,                                       // 71: product.v1.Product.MetadataEntry
+	nil,                                       // 72: product.v1.CreateProductRequest.MetadataEntry
+	nil,                                       // 73: product.v1.UpdateProductRequest.MetadataEntry
+	(*timestamppb.Timestamp)(nil),             // 74: google.protobuf.Timestamp
 }
 var file_product_v1_product_proto_depIdxs = []int32{
-	23, // 0: product.v1.Category.created_at:type_name -> google.protobuf.Timestamp
-	23, // 1: product.v1.Category.updated_at:type_name -> google.protobuf.Timestamp
-	21, // 2: product.v1.Product.metadata:type_name -> product.v1.Product.MetadataEntry
-	23, // 3: product.v1.Product.created_at:type_name -> google.protobuf.Timestamp
-	23, // 4: product.v1.Product.updated_at:type_name -> google.protobuf.Timestamp
-	23, // 5: product.v1.Product.deleted_at:type_name -> google.protobuf.Timestamp
-	2,  // 6: product.v1.Product.categories:type_name -> product.v1.Category
-	22, // 7: product.v1.CreateProductRequest.metadata:type_name -> product.v1.CreateProductRequest.MetadataEntry
-	3,  // 8: product.v1.CreateProductResponse.product:type_name -> product.v1.Product
-	3,  // 9: product.v1.GetProductResponse.product:type_name -> product.v1.Product
-	0,  // 10: product.v1.ProductSort.field:type_name -> product.v1.ProductSort.SortField
-	1,  // 11: product.v1.ProductSort.order:type_name -> product.v1.ProductSort.SortOrder
-	8,  // 12: product.v1.ListProductsRequest.filter:type_name -> product.v1.ProductFilter
-	9,  // 13: product.v1.ListProductsRequest.sort:type_name -> product.v1.ProductSort
-	10, // 14: product.v1.ListProductsRequest.pagination:type_name -> product.v1.Pagination
-	3,  // 15: product.v1.ListProductsResponse.products:type_name -> product.v1.Product
-	2,  // 16: product.v1.ListCategoriesResponse.categories:type_name -> product.v1.Category
-	2,  // 17: product.v1.CreateCategoryResponse.category:type_name -> product.v1.Category
-	8,  // 18: product.v1.ExportProductsRequest.filter:type_name -> product.v1.ProductFilter
-	8,  // 19: product.v1.GetStoreAvailableProductsRequest.filter:type_name -> product.v1.ProductFilter
-	9,  // 20: product.v1.GetStoreAvailableProductsRequest.sort:type_name -> product.v1.ProductSort
-	10, // 21: product.v1.GetStoreAvailableProductsRequest.pagination:type_name -> product.v1.Pagination
-	3,  // 22: product.v1.GetStoreAvailableProductsResponse.products:type_name -> product.v1.Product
-	4,  // 23: product.v1.ProductService.CreateProduct:input_type -> product.v1.CreateProductRequest
-	6,  // 24: product.v1.ProductService.GetProduct:input_type -> product.v1.GetProductRequest
-	11, // 25: product.v1.ProductService.ListProducts:input_type -> product.v1.ListProductsRequest
-	13, // 26: product.v1.ProductService.ListCategories:input_type -> product.v1.ListCategoriesRequest
-	15, // 27: product.v1.ProductService.CreateCategory:input_type -> product.v1.CreateCategoryRequest
-	17, // 28: product.v1.ProductService.ExportProducts:input_type -> product.v1.ExportProductsRequest
-	19, // 29: product.v1.ProductService.GetStoreAvailableProducts:input_type -> product.v1.GetStoreAvailableProductsRequest
-	5,  // 30: product.v1.ProductService.CreateProduct:output_type -> product.v1.CreateProductResponse
-	7,  // 31: product.v1.ProductService.GetProduct:output_type -> product.v1.GetProductResponse
-	12, // 32: product.v1.ProductService.ListProducts:output_type -> product.v1.ListProductsResponse
-	14, // 33: product.v1.ProductService.ListCategories:output_type -> product.v1.ListCategoriesResponse
-	16, // 34: product.v1.ProductService.CreateCategory:output_type -> product.v1.CreateCategoryResponse
-	18, // 35: product.v1.ProductService.ExportProducts:output_type -> product.v1.ExportProductsResponse
-	20, // 36: product.v1.ProductService.GetStoreAvailableProducts:output_type -> product.v1.GetStoreAvailableProductsResponse
-	30, // [30:37] is the sub-list for method output_type
-	23, // [23:30] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	74, // 0: product.v1.Category.created_at:type_name -> google.protobuf.Timestamp
+	74, // 1: product.v1.Category.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 2: product.v1.Category.attributes:type_name -> product.v1.CategoryAttribute
+	5,  // 3: product.v1.ProductImage.metadata:type_name -> product.v1.MediaMetadata
+	74, // 4: product.v1.MediaMetadata.probed_at:type_name -> google.protobuf.Timestamp
+	71, // 5: product.v1.Product.metadata:type_name -> product.v1.Product.MetadataEntry
+	74, // 6: product.v1.Product.created_at:type_name -> google.protobuf.Timestamp
+	74, // 7: product.v1.Product.updated_at:type_name -> google.protobuf.Timestamp
+	74, // 8: product.v1.Product.deleted_at:type_name -> google.protobuf.Timestamp
+	2,  // 9: product.v1.Product.categories:type_name -> product.v1.Category
+	4,  // 10: product.v1.Product.images:type_name -> product.v1.ProductImage
+	7,  // 11: product.v1.Product.bundle_components:type_name -> product.v1.BundleComponent
+	72, // 12: product.v1.CreateProductRequest.metadata:type_name -> product.v1.CreateProductRequest.MetadataEntry
+	4,  // 13: product.v1.CreateProductRequest.images:type_name -> product.v1.ProductImage
+	6,  // 14: product.v1.CreateProductResponse.product:type_name -> product.v1.Product
+	73, // 15: product.v1.UpdateProductRequest.metadata:type_name -> product.v1.UpdateProductRequest.MetadataEntry
+	4,  // 16: product.v1.UpdateProductRequest.images:type_name -> product.v1.ProductImage
+	6,  // 17: product.v1.UpdateProductResponse.product:type_name -> product.v1.Product
+	6,  // 18: product.v1.CloneProductResponse.product:type_name -> product.v1.Product
+	6,  // 19: product.v1.GetProductResponse.product:type_name -> product.v1.Product
+	6,  // 20: product.v1.BatchGetProductsResponse.products:type_name -> product.v1.Product
+	74, // 21: product.v1.ProductFilter.created_after:type_name -> google.protobuf.Timestamp
+	74, // 22: product.v1.ProductFilter.created_before:type_name -> google.protobuf.Timestamp
+	0,  // 23: product.v1.ProductSort.field:type_name -> product.v1.ProductSort.SortField
+	1,  // 24: product.v1.ProductSort.order:type_name -> product.v1.ProductSort.SortOrder
+	18, // 25: product.v1.ListProductsRequest.filter:type_name -> product.v1.ProductFilter
+	19, // 26: product.v1.ListProductsRequest.sort:type_name -> product.v1.ProductSort
+	20, // 27: product.v1.ListProductsRequest.pagination:type_name -> product.v1.Pagination
+	6,  // 28: product.v1.ListProductsResponse.products:type_name -> product.v1.Product
+	18, // 29: product.v1.StreamProductsRequest.filter:type_name -> product.v1.ProductFilter
+	19, // 30: product.v1.StreamProductsRequest.sort:type_name -> product.v1.ProductSort
+	6,  // 31: product.v1.StreamProductsResponse.products:type_name -> product.v1.Product
+	2,  // 32: product.v1.ListCategoriesResponse.categories:type_name -> product.v1.Category
+	3,  // 33: product.v1.CreateCategoryRequest.attributes:type_name -> product.v1.CategoryAttribute
+	2,  // 34: product.v1.CreateCategoryResponse.category:type_name -> product.v1.Category
+	2,  // 35: product.v1.UpdateCategoryResponse.category:type_name -> product.v1.Category
+	2,  // 36: product.v1.GetCategoryResponse.category:type_name -> product.v1.Category
+	3,  // 37: product.v1.SetCategoryAttributesRequest.attributes:type_name -> product.v1.CategoryAttribute
+	2,  // 38: product.v1.SetCategoryAttributesResponse.category:type_name -> product.v1.Category
+	2,  // 39: product.v1.MoveCategoryResponse.category:type_name -> product.v1.Category
+	18, // 40: product.v1.ExportProductsRequest.filter:type_name -> product.v1.ProductFilter
+	18, // 41: product.v1.GetStoreAvailableProductsRequest.filter:type_name -> product.v1.ProductFilter
+	19, // 42: product.v1.GetStoreAvailableProductsRequest.sort:type_name -> product.v1.ProductSort
+	20, // 43: product.v1.GetStoreAvailableProductsRequest.pagination:type_name -> product.v1.Pagination
+	6,  // 44: product.v1.GetStoreAvailableProductsResponse.products:type_name -> product.v1.Product
+	43, // 45: product.v1.Variant.options:type_name -> product.v1.VariantOption
+	74, // 46: product.v1.Variant.created_at:type_name -> google.protobuf.Timestamp
+	74, // 47: product.v1.Variant.updated_at:type_name -> google.protobuf.Timestamp
+	44, // 48: product.v1.GetVariantResponse.variant:type_name -> product.v1.Variant
+	44, // 49: product.v1.ListVariantsResponse.variants:type_name -> product.v1.Variant
+	6,  // 50: product.v1.ReorderProductImagesResponse.product:type_name -> product.v1.Product
+	6,  // 51: product.v1.SetPrimaryProductImageResponse.product:type_name -> product.v1.Product
+	7,  // 52: product.v1.SetBundleComponentsRequest.components:type_name -> product.v1.BundleComponent
+	6,  // 53: product.v1.SetBundleComponentsResponse.product:type_name -> product.v1.Product
+	6,  // 54: product.v1.RemoveBundleResponse.product:type_name -> product.v1.Product
+	7,  // 55: product.v1.BundleComponentAvailability.component:type_name -> product.v1.BundleComponent
+	58, // 56: product.v1.GetBundleAvailabilityResponse.components:type_name -> product.v1.BundleComponentAvailability
+	74, // 57: product.v1.ProductReview.created_at:type_name -> google.protobuf.Timestamp
+	74, // 58: product.v1.ProductReview.updated_at:type_name -> google.protobuf.Timestamp
+	64, // 59: product.v1.CreateProductReviewResponse.review:type_name -> product.v1.ProductReview
+	64, // 60: product.v1.ListProductReviewsResponse.reviews:type_name -> product.v1.ProductReview
+	64, // 61: product.v1.ModerateProductReviewResponse.review:type_name -> product.v1.ProductReview
+	8,  // 62: product.v1.ProductService.CreateProduct:input_type -> product.v1.CreateProductRequest
+	12, // 63: product.v1.ProductService.CloneProduct:input_type -> product.v1.CloneProductRequest
+	10, // 64: product.v1.ProductService.UpdateProduct:input_type -> product.v1.UpdateProductRequest
+	14, // 65: product.v1.ProductService.GetProduct:input_type -> product.v1.GetProductRequest
+	16, // 66: product.v1.ProductService.BatchGetProducts:input_type -> product.v1.BatchGetProductsRequest
+	21, // 67: product.v1.ProductService.ListProducts:input_type -> product.v1.ListProductsRequest
+	23, // 68: product.v1.ProductService.StreamProducts:input_type -> product.v1.StreamProductsRequest
+	25, // 69: product.v1.ProductService.ListCategories:input_type -> product.v1.ListCategoriesRequest
+	27, // 70: product.v1.ProductService.CreateCategory:input_type -> product.v1.CreateCategoryRequest
+	29, // 71: product.v1.ProductService.UpdateCategory:input_type -> product.v1.UpdateCategoryRequest
+	35, // 72: product.v1.ProductService.MoveCategory:input_type -> product.v1.MoveCategoryRequest
+	31, // 73: product.v1.ProductService.GetCategory:input_type -> product.v1.GetCategoryRequest
+	33, // 74: product.v1.ProductService.SetCategoryAttributes:input_type -> product.v1.SetCategoryAttributesRequest
+	37, // 75: product.v1.ProductService.ExportProducts:input_type -> product.v1.ExportProductsRequest
+	39, // 76: product.v1.ProductService.GetStoreAvailableProducts:input_type -> product.v1.GetStoreAvailableProductsRequest
+	41, // 77: product.v1.ProductService.RebuildSearchIndex:input_type -> product.v1.RebuildSearchIndexRequest
+	60, // 78: product.v1.ProductService.ReassignSupplierProducts:input_type -> product.v1.ReassignSupplierProductsRequest
+	62, // 79: product.v1.ProductService.BulkSetCategories:input_type -> product.v1.BulkSetCategoriesRequest
+	49, // 80: product.v1.ProductService.ReorderProductImages:input_type -> product.v1.ReorderProductImagesRequest
+	51, // 81: product.v1.ProductService.SetPrimaryProductImage:input_type -> product.v1.SetPrimaryProductImageRequest
+	45, // 82: product.v1.ProductService.GetVariant:input_type -> product.v1.GetVariantRequest
+	47, // 83: product.v1.ProductService.ListVariants:input_type -> product.v1.ListVariantsRequest
+	53, // 84: product.v1.ProductService.SetBundleComponents:input_type -> product.v1.SetBundleComponentsRequest
+	55, // 85: product.v1.ProductService.RemoveBundle:input_type -> product.v1.RemoveBundleRequest
+	57, // 86: product.v1.ProductService.GetBundleAvailability:input_type -> product.v1.GetBundleAvailabilityRequest
+	65, // 87: product.v1.ProductService.CreateProductReview:input_type -> product.v1.CreateProductReviewRequest
+	67, // 88: product.v1.ProductService.ListProductReviews:input_type -> product.v1.ListProductReviewsRequest
+	69, // 89: product.v1.ProductService.ModerateProductReview:input_type -> product.v1.ModerateProductReviewRequest
+	9,  // 90: product.v1.ProductService.CreateProduct:output_type -> product.v1.CreateProductResponse
+	13, // 91: product.v1.ProductService.CloneProduct:output_type -> product.v1.CloneProductResponse
+	11, // 92: product.v1.ProductService.UpdateProduct:output_type -> product.v1.UpdateProductResponse
+	15, // 93: product.v1.ProductService.GetProduct:output_type -> product.v1.GetProductResponse
+	17, // 94: product.v1.ProductService.BatchGetProducts:output_type -> product.v1.BatchGetProductsResponse
+	22, // 95: product.v1.ProductService.ListProducts:output_type -> product.v1.ListProductsResponse
+	24, // 96: product.v1.ProductService.StreamProducts:output_type -> product.v1.StreamProductsResponse
+	26, // 97: product.v1.ProductService.ListCategories:output_type -> product.v1.ListCategoriesResponse
+	28, // 98: product.v1.ProductService.CreateCategory:output_type -> product.v1.CreateCategoryResponse
+	30, // 99: product.v1.ProductService.UpdateCategory:output_type -> product.v1.UpdateCategoryResponse
+	36, // 100: product.v1.ProductService.MoveCategory:output_type -> product.v1.MoveCategoryResponse
+	32, // 101: product.v1.ProductService.GetCategory:output_type -> product.v1.GetCategoryResponse
+	34, // 102: product.v1.ProductService.SetCategoryAttributes:output_type -> product.v1.SetCategoryAttributesResponse
+	38, // 103: product.v1.ProductService.ExportProducts:output_type -> product.v1.ExportProductsResponse
+	40, // 104: product.v1.ProductService.GetStoreAvailableProducts:output_type -> product.v1.GetStoreAvailableProductsResponse
+	42, // 105: product.v1.ProductService.RebuildSearchIndex:output_type -> product.v1.RebuildSearchIndexResponse
+	61, // 106: product.v1.ProductService.ReassignSupplierProducts:output_type -> product.v1.ReassignSupplierProductsResponse
+	63, // 107: product.v1.ProductService.BulkSetCategories:output_type -> product.v1.BulkSetCategoriesResponse
+	50, // 108: product.v1.ProductService.ReorderProductImages:output_type -> product.v1.ReorderProductImagesResponse
+	52, // 109: product.v1.ProductService.SetPrimaryProductImage:output_type -> product.v1.SetPrimaryProductImageResponse
+	46, // 110: product.v1.ProductService.GetVariant:output_type -> product.v1.GetVariantResponse
+	48, // 111: product.v1.ProductService.ListVariants:output_type -> product.v1.ListVariantsResponse
+	54, // 112: product.v1.ProductService.SetBundleComponents:output_type -> product.v1.SetBundleComponentsResponse
+	56, // 113: product.v1.ProductService.RemoveBundle:output_type -> product.v1.RemoveBundleResponse
+	59, // 114: product.v1.ProductService.GetBundleAvailability:output_type -> product.v1.GetBundleAvailabilityResponse
+	66, // 115: product.v1.ProductService.CreateProductReview:output_type -> product.v1.CreateProductReviewResponse
+	68, // 116: product.v1.ProductService.ListProductReviews:output_type -> product.v1.ListProductReviewsResponse
+	70, // 117: product.v1.ProductService.ModerateProductReview:output_type -> product.v1.ModerateProductReviewResponse
+	90, // [90:118] is the sub-list for method output_type
+	62, // [62:90] is the sub-list for method input_type
+	62, // [62:62] is the sub-list for extension type_name
+	62, // [62:62] is the sub-list for extension extendee
+	0,  // [0:62] is the sub-list for field type_name
 }
 
 func init() { file_product_v1_product_proto_init() }
@@ -1801,13 +5374,14 @@ func file_product_v1_product_proto_init() {
 	if File_product_v1_product_proto != nil {
 		return
 	}
+	file_product_v1_product_proto_msgTypes[16].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_v1_product_proto_rawDesc), len(file_product_v1_product_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   21,
+			NumMessages:   72,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,12 +20,33 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	ProductService_CreateProduct_FullMethodName             = "/product.v1.ProductService/CreateProduct"
+	ProductService_CloneProduct_FullMethodName              = "/product.v1.ProductService/CloneProduct"
+	ProductService_UpdateProduct_FullMethodName             = "/product.v1.ProductService/UpdateProduct"
 	ProductService_GetProduct_FullMethodName                = "/product.v1.ProductService/GetProduct"
+	ProductService_BatchGetProducts_FullMethodName          = "/product.v1.ProductService/BatchGetProducts"
 	ProductService_ListProducts_FullMethodName              = "/product.v1.ProductService/ListProducts"
+	ProductService_StreamProducts_FullMethodName            = "/product.v1.ProductService/StreamProducts"
 	ProductService_ListCategories_FullMethodName            = "/product.v1.ProductService/ListCategories"
 	ProductService_CreateCategory_FullMethodName            = "/product.v1.ProductService/CreateCategory"
+	ProductService_UpdateCategory_FullMethodName            = "/product.v1.ProductService/UpdateCategory"
+	ProductService_MoveCategory_FullMethodName              = "/product.v1.ProductService/MoveCategory"
+	ProductService_GetCategory_FullMethodName               = "/product.v1.ProductService/GetCategory"
+	ProductService_SetCategoryAttributes_FullMethodName     = "/product.v1.ProductService/SetCategoryAttributes"
 	ProductService_ExportProducts_FullMethodName            = "/product.v1.ProductService/ExportProducts"
 	ProductService_GetStoreAvailableProducts_FullMethodName = "/product.v1.ProductService/GetStoreAvailableProducts"
+	ProductService_RebuildSearchIndex_FullMethodName        = "/product.v1.ProductService/RebuildSearchIndex"
+	ProductService_ReassignSupplierProducts_FullMethodName  = "/product.v1.ProductService/ReassignSupplierProducts"
+	ProductService_BulkSetCategories_FullMethodName         = "/product.v1.ProductService/BulkSetCategories"
+	ProductService_ReorderProductImages_FullMethodName      = "/product.v1.ProductService/ReorderProductImages"
+	ProductService_SetPrimaryProductImage_FullMethodName    = "/product.v1.ProductService/SetPrimaryProductImage"
+	ProductService_GetVariant_FullMethodName                = "/product.v1.ProductService/GetVariant"
+	ProductService_ListVariants_FullMethodName              = "/product.v1.ProductService/ListVariants"
+	ProductService_SetBundleComponents_FullMethodName       = "/product.v1.ProductService/SetBundleComponents"
+	ProductService_RemoveBundle_FullMethodName              = "/product.v1.ProductService/RemoveBundle"
+	ProductService_GetBundleAvailability_FullMethodName     = "/product.v1.ProductService/GetBundleAvailability"
+	ProductService_CreateProductReview_FullMethodName       = "/product.v1.ProductService/CreateProductReview"
+	ProductService_ListProductReviews_FullMethodName        = "/product.v1.ProductService/ListProductReviews"
+	ProductService_ModerateProductReview_FullMethodName     = "/product.v1.ProductService/ModerateProductReview"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -36,18 +57,60 @@ const (
 type ProductServiceClient interface {
 	// Create a new product
 	CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*CreateProductResponse, error)
+	// Create a draft copy of an existing product
+	CloneProduct(ctx context.Context, in *CloneProductRequest, opts ...grpc.CallOption) (*CloneProductResponse, error)
+	// Replace the editable fields of a product; fails with ABORTED when it changed since it was read
+	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductResponse, error)
 	// Get a product by ID
 	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductResponse, error)
+	// Get several products by ID, reporting the IDs that do not exist
+	BatchGetProducts(ctx context.Context, in *BatchGetProductsRequest, opts ...grpc.CallOption) (*BatchGetProductsResponse, error)
 	// List products with filtering and sorting
 	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
+	// Stream every product matching a filter in batches, for large exports
+	StreamProducts(ctx context.Context, in *StreamProductsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamProductsResponse], error)
 	// List all product categories
 	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
 	// Create a new product category
 	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error)
+	// Change the name or description of a category
+	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryResponse, error)
+	// Move a category and its subcategories under another parent
+	MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*MoveCategoryResponse, error)
+	// Get a category with its attribute schema
+	GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*GetCategoryResponse, error)
+	// Replace the attribute schema products in a category are validated against
+	SetCategoryAttributes(ctx context.Context, in *SetCategoryAttributesRequest, opts ...grpc.CallOption) (*SetCategoryAttributesResponse, error)
 	// Export products to CSV format
 	ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...grpc.CallOption) (*ExportProductsResponse, error)
 	// Get products available in a specific store
 	GetStoreAvailableProducts(ctx context.Context, in *GetStoreAvailableProductsRequest, opts ...grpc.CallOption) (*GetStoreAvailableProductsResponse, error)
+	// Drop and recreate the product text search index
+	RebuildSearchIndex(ctx context.Context, in *RebuildSearchIndexRequest, opts ...grpc.CallOption) (*RebuildSearchIndexResponse, error)
+	// Move every product of one supplier to another (admin)
+	ReassignSupplierProducts(ctx context.Context, in *ReassignSupplierProductsRequest, opts ...grpc.CallOption) (*ReassignSupplierProductsResponse, error)
+	// Add, remove or replace the categories of many products at once
+	BulkSetCategories(ctx context.Context, in *BulkSetCategoriesRequest, opts ...grpc.CallOption) (*BulkSetCategoriesResponse, error)
+	// Change the display order of a product's images
+	ReorderProductImages(ctx context.Context, in *ReorderProductImagesRequest, opts ...grpc.CallOption) (*ReorderProductImagesResponse, error)
+	// Select the primary image of a product
+	SetPrimaryProductImage(ctx context.Context, in *SetPrimaryProductImageRequest, opts ...grpc.CallOption) (*SetPrimaryProductImageResponse, error)
+	// Get a single variant of a product
+	GetVariant(ctx context.Context, in *GetVariantRequest, opts ...grpc.CallOption) (*GetVariantResponse, error)
+	// List the variants of a product
+	ListVariants(ctx context.Context, in *ListVariantsRequest, opts ...grpc.CallOption) (*ListVariantsResponse, error)
+	// Make a product a bundle of other products, or replace its components
+	SetBundleComponents(ctx context.Context, in *SetBundleComponentsRequest, opts ...grpc.CallOption) (*SetBundleComponentsResponse, error)
+	// Turn a bundle back into a plain product
+	RemoveBundle(ctx context.Context, in *RemoveBundleRequest, opts ...grpc.CallOption) (*RemoveBundleResponse, error)
+	// Get how many units of a bundle its components' stock covers at a location
+	GetBundleAvailability(ctx context.Context, in *GetBundleAvailabilityRequest, opts ...grpc.CallOption) (*GetBundleAvailabilityResponse, error)
+	// Review a product as the calling user; the review waits for moderation
+	CreateProductReview(ctx context.Context, in *CreateProductReviewRequest, opts ...grpc.CallOption) (*CreateProductReviewResponse, error)
+	// List a product's reviews, newest first
+	ListProductReviews(ctx context.Context, in *ListProductReviewsRequest, opts ...grpc.CallOption) (*ListProductReviewsResponse, error)
+	// Approve or reject a review and recompute its product's rating; staff only
+	ModerateProductReview(ctx context.Context, in *ModerateProductReviewRequest, opts ...grpc.CallOption) (*ModerateProductReviewResponse, error)
 }
 
 type productServiceClient struct {
@@ -68,6 +131,26 @@ func (c *productServiceClient) CreateProduct(ctx context.Context, in *CreateProd
 	return out, nil
 }
 
+func (c *productServiceClient) CloneProduct(ctx context.Context, in *CloneProductRequest, opts ...grpc.CallOption) (*CloneProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneProductResponse)
+	err := c.cc.Invoke(ctx, ProductService_CloneProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProductResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpdateProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetProductResponse)
@@ -78,6 +161,16 @@ func (c *productServiceClient) GetProduct(ctx context.Context, in *GetProductReq
 	return out, nil
 }
 
+func (c *productServiceClient) BatchGetProducts(ctx context.Context, in *BatchGetProductsRequest, opts ...grpc.CallOption) (*BatchGetProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_BatchGetProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListProductsResponse)
@@ -88,6 +181,25 @@ func (c *productServiceClient) ListProducts(ctx context.Context, in *ListProduct
 	return out, nil
 }
 
+func (c *productServiceClient) StreamProducts(ctx context.Context, in *StreamProductsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamProductsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProductService_ServiceDesc.Streams[0], ProductService_StreamProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamProductsRequest, StreamProductsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_StreamProductsClient = grpc.ServerStreamingClient[StreamProductsResponse]
+
 func (c *productServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListCategoriesResponse)
@@ -108,6 +220,46 @@ func (c *productServiceClient) CreateCategory(ctx context.Context, in *CreateCat
 	return out, nil
 }
 
+func (c *productServiceClient) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCategoryResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpdateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*MoveCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveCategoryResponse)
+	err := c.cc.Invoke(ctx, ProductService_MoveCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*GetCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetCategoryAttributes(ctx context.Context, in *SetCategoryAttributesRequest, opts ...grpc.CallOption) (*SetCategoryAttributesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCategoryAttributesResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetCategoryAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...grpc.CallOption) (*ExportProductsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExportProductsResponse)
@@ -128,6 +280,136 @@ func (c *productServiceClient) GetStoreAvailableProducts(ctx context.Context, in
 	return out, nil
 }
 
+func (c *productServiceClient) RebuildSearchIndex(ctx context.Context, in *RebuildSearchIndexRequest, opts ...grpc.CallOption) (*RebuildSearchIndexResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RebuildSearchIndexResponse)
+	err := c.cc.Invoke(ctx, ProductService_RebuildSearchIndex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReassignSupplierProducts(ctx context.Context, in *ReassignSupplierProductsRequest, opts ...grpc.CallOption) (*ReassignSupplierProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReassignSupplierProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ReassignSupplierProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) BulkSetCategories(ctx context.Context, in *BulkSetCategoriesRequest, opts ...grpc.CallOption) (*BulkSetCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkSetCategoriesResponse)
+	err := c.cc.Invoke(ctx, ProductService_BulkSetCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReorderProductImages(ctx context.Context, in *ReorderProductImagesRequest, opts ...grpc.CallOption) (*ReorderProductImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderProductImagesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ReorderProductImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetPrimaryProductImage(ctx context.Context, in *SetPrimaryProductImageRequest, opts ...grpc.CallOption) (*SetPrimaryProductImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPrimaryProductImageResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetPrimaryProductImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetVariant(ctx context.Context, in *GetVariantRequest, opts ...grpc.CallOption) (*GetVariantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVariantResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListVariants(ctx context.Context, in *ListVariantsRequest, opts ...grpc.CallOption) (*ListVariantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVariantsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListVariants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetBundleComponents(ctx context.Context, in *SetBundleComponentsRequest, opts ...grpc.CallOption) (*SetBundleComponentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBundleComponentsResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetBundleComponents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RemoveBundle(ctx context.Context, in *RemoveBundleRequest, opts ...grpc.CallOption) (*RemoveBundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveBundleResponse)
+	err := c.cc.Invoke(ctx, ProductService_RemoveBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetBundleAvailability(ctx context.Context, in *GetBundleAvailabilityRequest, opts ...grpc.CallOption) (*GetBundleAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBundleAvailabilityResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetBundleAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateProductReview(ctx context.Context, in *CreateProductReviewRequest, opts ...grpc.CallOption) (*CreateProductReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateProductReviewResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateProductReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListProductReviews(ctx context.Context, in *ListProductReviewsRequest, opts ...grpc.CallOption) (*ListProductReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductReviewsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListProductReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ModerateProductReview(ctx context.Context, in *ModerateProductReviewRequest, opts ...grpc.CallOption) (*ModerateProductReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerateProductReviewResponse)
+	err := c.cc.Invoke(ctx, ProductService_ModerateProductReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations should embed UnimplementedProductServiceServer
 // for forward compatibility.
@@ -136,18 +418,60 @@ func (c *productServiceClient) GetStoreAvailableProducts(ctx context.Context, in
 type ProductServiceServer interface {
 	// Create a new product
 	CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error)
+	// Create a draft copy of an existing product
+	CloneProduct(context.Context, *CloneProductRequest) (*CloneProductResponse, error)
+	// Replace the editable fields of a product; fails with ABORTED when it changed since it was read
+	UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error)
 	// Get a product by ID
 	GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error)
+	// Get several products by ID, reporting the IDs that do not exist
+	BatchGetProducts(context.Context, *BatchGetProductsRequest) (*BatchGetProductsResponse, error)
 	// List products with filtering and sorting
 	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
+	// Stream every product matching a filter in batches, for large exports
+	StreamProducts(*StreamProductsRequest, grpc.ServerStreamingServer[StreamProductsResponse]) error
 	// List all product categories
 	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
 	// Create a new product category
 	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error)
+	// Change the name or description of a category
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error)
+	// Move a category and its subcategories under another parent
+	MoveCategory(context.Context, *MoveCategoryRequest) (*MoveCategoryResponse, error)
+	// Get a category with its attribute schema
+	GetCategory(context.Context, *GetCategoryRequest) (*GetCategoryResponse, error)
+	// Replace the attribute schema products in a category are validated against
+	SetCategoryAttributes(context.Context, *SetCategoryAttributesRequest) (*SetCategoryAttributesResponse, error)
 	// Export products to CSV format
 	ExportProducts(context.Context, *ExportProductsRequest) (*ExportProductsResponse, error)
 	// Get products available in a specific store
 	GetStoreAvailableProducts(context.Context, *GetStoreAvailableProductsRequest) (*GetStoreAvailableProductsResponse, error)
+	// Drop and recreate the product text search index
+	RebuildSearchIndex(context.Context, *RebuildSearchIndexRequest) (*RebuildSearchIndexResponse, error)
+	// Move every product of one supplier to another (admin)
+	ReassignSupplierProducts(context.Context, *ReassignSupplierProductsRequest) (*ReassignSupplierProductsResponse, error)
+	// Add, remove or replace the categories of many products at once
+	BulkSetCategories(context.Context, *BulkSetCategoriesRequest) (*BulkSetCategoriesResponse, error)
+	// Change the display order of a product's images
+	ReorderProductImages(context.Context, *ReorderProductImagesRequest) (*ReorderProductImagesResponse, error)
+	// Select the primary image of a product
+	SetPrimaryProductImage(context.Context, *SetPrimaryProductImageRequest) (*SetPrimaryProductImageResponse, error)
+	// Get a single variant of a product
+	GetVariant(context.Context, *GetVariantRequest) (*GetVariantResponse, error)
+	// List the variants of a product
+	ListVariants(context.Context, *ListVariantsRequest) (*ListVariantsResponse, error)
+	// Make a product a bundle of other products, or replace its components
+	SetBundleComponents(context.Context, *SetBundleComponentsRequest) (*SetBundleComponentsResponse, error)
+	// Turn a bundle back into a plain product
+	RemoveBundle(context.Context, *RemoveBundleRequest) (*RemoveBundleResponse, error)
+	// Get how many units of a bundle its components' stock covers at a location
+	GetBundleAvailability(context.Context, *GetBundleAvailabilityRequest) (*GetBundleAvailabilityResponse, error)
+	// Review a product as the calling user; the review waits for moderation
+	CreateProductReview(context.Context, *CreateProductReviewRequest) (*CreateProductReviewResponse, error)
+	// List a product's reviews, newest first
+	ListProductReviews(context.Context, *ListProductReviewsRequest) (*ListProductReviewsResponse, error)
+	// Approve or reject a review and recompute its product's rating; staff only
+	ModerateProductReview(context.Context, *ModerateProductReviewRequest) (*ModerateProductReviewResponse, error)
 }
 
 // UnimplementedProductServiceServer should be embedded to have
@@ -160,24 +484,87 @@ type UnimplementedProductServiceServer struct{}
 func (UnimplementedProductServiceServer) CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
 }
+func (UnimplementedProductServiceServer) CloneProduct(context.Context, *CloneProductRequest) (*CloneProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneProduct not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+}
 func (UnimplementedProductServiceServer) GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
 }
+func (UnimplementedProductServiceServer) BatchGetProducts(context.Context, *BatchGetProductsRequest) (*BatchGetProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetProducts not implemented")
+}
 func (UnimplementedProductServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
 }
+func (UnimplementedProductServiceServer) StreamProducts(*StreamProductsRequest, grpc.ServerStreamingServer[StreamProductsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamProducts not implemented")
+}
 func (UnimplementedProductServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
 }
 func (UnimplementedProductServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
 }
+func (UnimplementedProductServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedProductServiceServer) MoveCategory(context.Context, *MoveCategoryRequest) (*MoveCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCategory not implemented")
+}
+func (UnimplementedProductServiceServer) GetCategory(context.Context, *GetCategoryRequest) (*GetCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategory not implemented")
+}
+func (UnimplementedProductServiceServer) SetCategoryAttributes(context.Context, *SetCategoryAttributesRequest) (*SetCategoryAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCategoryAttributes not implemented")
+}
 func (UnimplementedProductServiceServer) ExportProducts(context.Context, *ExportProductsRequest) (*ExportProductsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExportProducts not implemented")
 }
 func (UnimplementedProductServiceServer) GetStoreAvailableProducts(context.Context, *GetStoreAvailableProductsRequest) (*GetStoreAvailableProductsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStoreAvailableProducts not implemented")
 }
+func (UnimplementedProductServiceServer) RebuildSearchIndex(context.Context, *RebuildSearchIndexRequest) (*RebuildSearchIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildSearchIndex not implemented")
+}
+func (UnimplementedProductServiceServer) ReassignSupplierProducts(context.Context, *ReassignSupplierProductsRequest) (*ReassignSupplierProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignSupplierProducts not implemented")
+}
+func (UnimplementedProductServiceServer) BulkSetCategories(context.Context, *BulkSetCategoriesRequest) (*BulkSetCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkSetCategories not implemented")
+}
+func (UnimplementedProductServiceServer) ReorderProductImages(context.Context, *ReorderProductImagesRequest) (*ReorderProductImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderProductImages not implemented")
+}
+func (UnimplementedProductServiceServer) SetPrimaryProductImage(context.Context, *SetPrimaryProductImageRequest) (*SetPrimaryProductImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrimaryProductImage not implemented")
+}
+func (UnimplementedProductServiceServer) GetVariant(context.Context, *GetVariantRequest) (*GetVariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVariant not implemented")
+}
+func (UnimplementedProductServiceServer) ListVariants(context.Context, *ListVariantsRequest) (*ListVariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVariants not implemented")
+}
+func (UnimplementedProductServiceServer) SetBundleComponents(context.Context, *SetBundleComponentsRequest) (*SetBundleComponentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBundleComponents not implemented")
+}
+func (UnimplementedProductServiceServer) RemoveBundle(context.Context, *RemoveBundleRequest) (*RemoveBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBundle not implemented")
+}
+func (UnimplementedProductServiceServer) GetBundleAvailability(context.Context, *GetBundleAvailabilityRequest) (*GetBundleAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBundleAvailability not implemented")
+}
+func (UnimplementedProductServiceServer) CreateProductReview(context.Context, *CreateProductReviewRequest) (*CreateProductReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProductReview not implemented")
+}
+func (UnimplementedProductServiceServer) ListProductReviews(context.Context, *ListProductReviewsRequest) (*ListProductReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductReviews not implemented")
+}
+func (UnimplementedProductServiceServer) ModerateProductReview(context.Context, *ModerateProductReviewRequest) (*ModerateProductReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateProductReview not implemented")
+}
 func (UnimplementedProductServiceServer) testEmbeddedByValue() {}
 
 // UnsafeProductServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -216,6 +603,42 @@ func _ProductService_CreateProduct_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_CloneProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CloneProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CloneProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CloneProduct(ctx, req.(*CloneProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProductRequest)
 	if err := dec(in); err != nil {
@@ -234,6 +657,24 @@ func _ProductService_GetProduct_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_BatchGetProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).BatchGetProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_BatchGetProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).BatchGetProducts(ctx, req.(*BatchGetProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListProductsRequest)
 	if err := dec(in); err != nil {
@@ -252,6 +693,17 @@ func _ProductService_ListProducts_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_StreamProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamProductsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProductServiceServer).StreamProducts(m, &grpc.GenericServerStream[StreamProductsRequest, StreamProductsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_StreamProductsServer = grpc.ServerStreamingServer[StreamProductsResponse]
+
 func _ProductService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListCategoriesRequest)
 	if err := dec(in); err != nil {
@@ -288,6 +740,78 @@ func _ProductService_CreateCategory_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_MoveCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).MoveCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_MoveCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).MoveCategory(ctx, req.(*MoveCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetCategory(ctx, req.(*GetCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetCategoryAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCategoryAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetCategoryAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetCategoryAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetCategoryAttributes(ctx, req.(*SetCategoryAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_ExportProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExportProductsRequest)
 	if err := dec(in); err != nil {
@@ -324,6 +848,240 @@ func _ProductService_GetStoreAvailableProducts_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_RebuildSearchIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildSearchIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RebuildSearchIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RebuildSearchIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RebuildSearchIndex(ctx, req.(*RebuildSearchIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReassignSupplierProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignSupplierProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReassignSupplierProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ReassignSupplierProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReassignSupplierProducts(ctx, req.(*ReassignSupplierProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_BulkSetCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkSetCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).BulkSetCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_BulkSetCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).BulkSetCategories(ctx, req.(*BulkSetCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReorderProductImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderProductImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReorderProductImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ReorderProductImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReorderProductImages(ctx, req.(*ReorderProductImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetPrimaryProductImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPrimaryProductImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetPrimaryProductImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetPrimaryProductImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetPrimaryProductImage(ctx, req.(*SetPrimaryProductImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetVariant(ctx, req.(*GetVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListVariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListVariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListVariants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListVariants(ctx, req.(*ListVariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetBundleComponents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBundleComponentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetBundleComponents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetBundleComponents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetBundleComponents(ctx, req.(*SetBundleComponentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RemoveBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RemoveBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RemoveBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RemoveBundle(ctx, req.(*RemoveBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetBundleAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBundleAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetBundleAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetBundleAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetBundleAvailability(ctx, req.(*GetBundleAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateProductReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateProductReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateProductReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateProductReview(ctx, req.(*CreateProductReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListProductReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListProductReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListProductReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListProductReviews(ctx, req.(*ListProductReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ModerateProductReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateProductReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ModerateProductReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ModerateProductReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ModerateProductReview(ctx, req.(*ModerateProductReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -335,10 +1093,22 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateProduct",
 			Handler:    _ProductService_CreateProduct_Handler,
 		},
+		{
+			MethodName: "CloneProduct",
+			Handler:    _ProductService_CloneProduct_Handler,
+		},
+		{
+			MethodName: "UpdateProduct",
+			Handler:    _ProductService_UpdateProduct_Handler,
+		},
 		{
 			MethodName: "GetProduct",
 			Handler:    _ProductService_GetProduct_Handler,
 		},
+		{
+			MethodName: "BatchGetProducts",
+			Handler:    _ProductService_BatchGetProducts_Handler,
+		},
 		{
 			MethodName: "ListProducts",
 			Handler:    _ProductService_ListProducts_Handler,
@@ -351,6 +1121,22 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateCategory",
 			Handler:    _ProductService_CreateCategory_Handler,
 		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _ProductService_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "MoveCategory",
+			Handler:    _ProductService_MoveCategory_Handler,
+		},
+		{
+			MethodName: "GetCategory",
+			Handler:    _ProductService_GetCategory_Handler,
+		},
+		{
+			MethodName: "SetCategoryAttributes",
+			Handler:    _ProductService_SetCategoryAttributes_Handler,
+		},
 		{
 			MethodName: "ExportProducts",
 			Handler:    _ProductService_ExportProducts_Handler,
@@ -359,7 +1145,65 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStoreAvailableProducts",
 			Handler:    _ProductService_GetStoreAvailableProducts_Handler,
 		},
+		{
+			MethodName: "RebuildSearchIndex",
+			Handler:    _ProductService_RebuildSearchIndex_Handler,
+		},
+		{
+			MethodName: "ReassignSupplierProducts",
+			Handler:    _ProductService_ReassignSupplierProducts_Handler,
+		},
+		{
+			MethodName: "BulkSetCategories",
+			Handler:    _ProductService_BulkSetCategories_Handler,
+		},
+		{
+			MethodName: "ReorderProductImages",
+			Handler:    _ProductService_ReorderProductImages_Handler,
+		},
+		{
+			MethodName: "SetPrimaryProductImage",
+			Handler:    _ProductService_SetPrimaryProductImage_Handler,
+		},
+		{
+			MethodName: "GetVariant",
+			Handler:    _ProductService_GetVariant_Handler,
+		},
+		{
+			MethodName: "ListVariants",
+			Handler:    _ProductService_ListVariants_Handler,
+		},
+		{
+			MethodName: "SetBundleComponents",
+			Handler:    _ProductService_SetBundleComponents_Handler,
+		},
+		{
+			MethodName: "RemoveBundle",
+			Handler:    _ProductService_RemoveBundle_Handler,
+		},
+		{
+			MethodName: "GetBundleAvailability",
+			Handler:    _ProductService_GetBundleAvailability_Handler,
+		},
+		{
+			MethodName: "CreateProductReview",
+			Handler:    _ProductService_CreateProductReview_Handler,
+		},
+		{
+			MethodName: "ListProductReviews",
+			Handler:    _ProductService_ListProductReviews_Handler,
+		},
+		{
+			MethodName: "ModerateProductReview",
+			Handler:    _ProductService_ModerateProductReview_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamProducts",
+			Handler:       _ProductService_StreamProducts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "product/v1/product.proto",
 }
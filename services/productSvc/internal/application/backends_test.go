@@ -0,0 +1,69 @@
+package application
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	inventoryclient "github.com/leonvanderhaeghen/stockplatform/pkg/clients/inventory"
+	supplierclient "github.com/leonvanderhaeghen/stockplatform/pkg/clients/supplier"
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+	supplierv1 "github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/api/gen/go/proto/supplier/v1"
+)
+
+// serveInMemory starts a gRPC server registered by register on an in-memory
+// listener and returns a dial option that connects to it
+func serveInMemory(t *testing.T, register func(*grpc.Server)) grpc.DialOption {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	register(server)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+	return grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return listener.DialContext(ctx)
+	})
+}
+
+// newInventoryClient returns an inventory client talking to backend
+func newInventoryClient(t *testing.T, backend inventoryv1.InventoryServiceServer) *inventoryclient.Client {
+	t.Helper()
+	dialer := serveInMemory(t, func(s *grpc.Server) { inventoryv1.RegisterInventoryServiceServer(s, backend) })
+	client, err := inventoryclient.New(inventoryclient.Config{
+		Address:     "passthrough:///inventory",
+		DialOptions: []grpc.DialOption{dialer},
+	}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// newSupplierClient returns a supplier client talking to backend
+func newSupplierClient(t *testing.T, backend supplierv1.SupplierServiceServer) *supplierclient.Client {
+	t.Helper()
+	dialer := serveInMemory(t, func(s *grpc.Server) { supplierv1.RegisterSupplierServiceServer(s, backend) })
+	client, err := supplierclient.New(supplierclient.Config{
+		Address:     "passthrough:///supplier",
+		DialOptions: []grpc.DialOption{dialer},
+	}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// stubSupplierBackend knows every supplier it is asked for
+type stubSupplierBackend struct {
+	supplierv1.UnimplementedSupplierServiceServer
+}
+
+func (stubSupplierBackend) GetSupplier(ctx context.Context, req *supplierv1.GetSupplierRequest) (*supplierv1.GetSupplierResponse, error) {
+	return &supplierv1.GetSupplierResponse{Supplier: &supplierv1.Supplier{Id: req.GetId(), Name: "Acme"}}, nil
+}
@@ -0,0 +1,71 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// CategoryCountReconciler periodically recounts the products in each category
+// so that the denormalized product counts cannot drift for long, e.g. after a
+// counter update failed or two writers raced
+type CategoryCountReconciler struct {
+	categories *CategoryService
+	interval   time.Duration
+	logger     *zap.Logger
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+// NewCategoryCountReconciler creates a reconciler that runs every interval
+func NewCategoryCountReconciler(categories *CategoryService, interval time.Duration, logger *zap.Logger) *CategoryCountReconciler {
+	return &CategoryCountReconciler{
+		categories: categories,
+		interval:   interval,
+		logger:     logger.Named("category_count_reconciler"),
+	}
+}
+
+// Start runs a reconciliation immediately and then on every interval until Stop
+func (r *CategoryCountReconciler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		for {
+			r.reconcile(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop stops the reconciler and waits for a running pass to finish
+func (r *CategoryCountReconciler) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+}
+
+// reconcile runs one reconciliation pass
+func (r *CategoryCountReconciler) reconcile(ctx context.Context) {
+	fixed, err := r.categories.ReconcileProductCounts(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			r.logger.Error("Category product count reconciliation failed", zap.Error(err))
+		}
+		return
+	}
+	r.logger.Debug("Category product counts reconciled", zap.Int("fixed", fixed))
+}
@@ -0,0 +1,82 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+func newTestCategory(name string) *domain.Category {
+	return &domain.Category{ID: primitive.NewObjectID(), Name: name}
+}
+
+func TestProductChangesAdjustCategoryCounts(t *testing.T) {
+	ctx := context.Background()
+	lamps, desks, lights := newTestCategory("Lamps"), newTestCategory("Desks"), newTestCategory("Lights")
+	categories := newMemoryCategoryRepository(lamps, desks, lights)
+	repo := newMemoryProductRepository()
+	service := newTestProductService(t, repo, categories, &recordingInventoryBackend{})
+
+	expectCounts := func(step string, want map[*domain.Category]int64) {
+		t.Helper()
+		for category, count := range want {
+			if got := categories.count(category.ID); got != count {
+				t.Errorf("%s: %s count = %d, want %d", step, category.Name, got, count)
+			}
+		}
+	}
+
+	input := newTestProduct("LAMP-1")
+	input.CategoryIDs = []string{lamps.ID.Hex(), desks.ID.Hex()}
+	product, err := service.CreateProduct(ctx, input, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectCounts("create", map[*domain.Category]int64{lamps: 1, desks: 1, lights: 0})
+
+	update := *product
+	update.CategoryIDs = []string{desks.ID.Hex(), lights.ID.Hex()}
+	if err := service.UpdateProduct(ctx, &update); err != nil {
+		t.Fatal(err)
+	}
+	expectCounts("recategorize", map[*domain.Category]int64{lamps: 0, desks: 1, lights: 1})
+
+	if err := service.DeleteProduct(ctx, product.ID.Hex()); err != nil {
+		t.Fatal(err)
+	}
+	expectCounts("delete", map[*domain.Category]int64{lamps: 0, desks: 0, lights: 0})
+}
+
+func TestReconcileProductCountsFixesDrift(t *testing.T) {
+	lamps, desks := newTestCategory("Lamps"), newTestCategory("Desks")
+	lamps.ProductCount = 7 // drifted; one product is really in it
+	desks.ProductCount = 0
+	categories := newMemoryCategoryRepository(lamps, desks)
+
+	product := newTestProduct("LAMP-1")
+	product.ID = primitive.NewObjectID()
+	product.CategoryIDs = []string{lamps.ID.Hex()}
+	service := NewCategoryService(categories, newMemoryProductRepository(product), zap.NewNop())
+
+	fixed, err := service.ReconcileProductCounts(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fixed != 1 {
+		t.Fatalf("fixed = %d, want 1", fixed)
+	}
+	if got := categories.count(lamps.ID); got != 1 {
+		t.Fatalf("lamps count = %d, want 1", got)
+	}
+	if got := categories.count(desks.ID); got != 0 {
+		t.Fatalf("desks count = %d, want 0", got)
+	}
+
+	if fixed, err := service.ReconcileProductCounts(context.Background()); err != nil || fixed != 0 {
+		t.Fatalf("second pass fixed %d (%v), want 0", fixed, err)
+	}
+}
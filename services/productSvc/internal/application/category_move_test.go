@@ -0,0 +1,136 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// categoryTree stores Home > Lighting > Lamps and a separate Office root
+type categoryTree struct {
+	home, lighting, lamps, office *domain.Category
+	repo                          *memoryCategoryRepository
+	service                       *CategoryService
+}
+
+func newCategoryTree() *categoryTree {
+	home, office := newTestCategory("Home"), newTestCategory("Office")
+	lighting := newTestCategory("Lighting")
+	lighting.ParentID, lighting.Level, lighting.Path = home.ID.Hex(), 1, home.ChildPath()
+	lamps := newTestCategory("Lamps")
+	lamps.ParentID, lamps.Level, lamps.Path = lighting.ID.Hex(), 2, lighting.ChildPath()
+
+	repo := newMemoryCategoryRepository(home, lighting, lamps, office)
+	return &categoryTree{
+		home: home, lighting: lighting, lamps: lamps, office: office,
+		repo:    repo,
+		service: NewCategoryService(repo, nil, zap.NewNop()),
+	}
+}
+
+func (tree *categoryTree) stored(t *testing.T, c *domain.Category) *domain.Category {
+	t.Helper()
+	stored, err := tree.repo.GetByID(context.Background(), c.ID.Hex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stored
+}
+
+func TestMoveCategoryRecomputesSubtree(t *testing.T) {
+	tree := newCategoryTree()
+
+	moved, err := tree.service.MoveCategory(context.Background(), tree.lighting.ID.Hex(), tree.office.ID.Hex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if moved.ParentID != tree.office.ID.Hex() {
+		t.Fatalf("parent = %s, want Office", moved.ParentID)
+	}
+
+	lighting := tree.stored(t, tree.lighting)
+	if lighting.Level != 1 || lighting.Path != tree.office.ID.Hex() {
+		t.Errorf("Lighting: level %d path %q, want 1 %q", lighting.Level, lighting.Path, tree.office.ID.Hex())
+	}
+	lamps := tree.stored(t, tree.lamps)
+	wantPath := tree.office.ID.Hex() + "/" + tree.lighting.ID.Hex()
+	if lamps.Level != 2 || lamps.Path != wantPath || lamps.ParentID != tree.lighting.ID.Hex() {
+		t.Errorf("Lamps: level %d path %q parent %s, want 2 %q under Lighting", lamps.Level, lamps.Path, lamps.ParentID, wantPath)
+	}
+	if lamps.HasAncestor(tree.home.ID.Hex()) {
+		t.Error("Lamps still lists Home as an ancestor")
+	}
+}
+
+func TestMoveCategoryToRoot(t *testing.T) {
+	tree := newCategoryTree()
+
+	if _, err := tree.service.MoveCategory(context.Background(), tree.lighting.ID.Hex(), ""); err != nil {
+		t.Fatal(err)
+	}
+
+	lighting := tree.stored(t, tree.lighting)
+	if lighting.Level != 0 || lighting.Path != "" || lighting.ParentID != "" {
+		t.Errorf("Lighting: level %d path %q parent %q, want a root category", lighting.Level, lighting.Path, lighting.ParentID)
+	}
+	lamps := tree.stored(t, tree.lamps)
+	if lamps.Level != 1 || lamps.Path != tree.lighting.ID.Hex() {
+		t.Errorf("Lamps: level %d path %q, want 1 %q", lamps.Level, lamps.Path, tree.lighting.ID.Hex())
+	}
+}
+
+func TestMoveCategoryRejectsCycles(t *testing.T) {
+	tests := []struct {
+		name     string
+		category func(*categoryTree) *domain.Category
+		parent   func(*categoryTree) *domain.Category
+	}{
+		{name: "under itself", category: func(tr *categoryTree) *domain.Category { return tr.home }, parent: func(tr *categoryTree) *domain.Category { return tr.home }},
+		{name: "under its child", category: func(tr *categoryTree) *domain.Category { return tr.home }, parent: func(tr *categoryTree) *domain.Category { return tr.lighting }},
+		{name: "under a deeper descendant", category: func(tr *categoryTree) *domain.Category { return tr.home }, parent: func(tr *categoryTree) *domain.Category { return tr.lamps }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := newCategoryTree()
+			category, parent := tt.category(tree), tt.parent(tree)
+
+			_, err := tree.service.MoveCategory(context.Background(), category.ID.Hex(), parent.ID.Hex())
+			if !errors.Is(err, domain.ErrCategoryCycle) {
+				t.Fatalf("err = %v, want ErrCategoryCycle", err)
+			}
+			if stored := tree.stored(t, category); stored.ParentID != category.ParentID || stored.Path != category.Path {
+				t.Fatal("a rejected move must not change the category")
+			}
+		})
+	}
+}
+
+func TestMoveCategoryUnderUnknownParent(t *testing.T) {
+	tree := newCategoryTree()
+
+	_, err := tree.service.MoveCategory(context.Background(), tree.lamps.ID.Hex(), "000000000000000000000000")
+	if !errors.Is(err, domain.ErrParentCategoryNotFound) {
+		t.Fatalf("err = %v, want ErrParentCategoryNotFound", err)
+	}
+}
+
+func TestUpdateCategoryKeepsHierarchy(t *testing.T) {
+	tree := newCategoryTree()
+
+	update := &domain.Category{ID: tree.lamps.ID, Name: "Desk lamps", Description: "Lamps for desks"}
+	if err := tree.service.UpdateCategory(context.Background(), update); err != nil {
+		t.Fatal(err)
+	}
+
+	lamps := tree.stored(t, tree.lamps)
+	if lamps.Name != "Desk lamps" || lamps.Description != "Lamps for desks" {
+		t.Errorf("name %q description %q not updated", lamps.Name, lamps.Description)
+	}
+	if lamps.ParentID != tree.lighting.ID.Hex() || lamps.Level != 2 || lamps.Path != tree.lamps.Path {
+		t.Errorf("hierarchy changed: parent %s level %d path %q", lamps.ParentID, lamps.Level, lamps.Path)
+	}
+}
@@ -2,6 +2,8 @@ package application
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
@@ -11,20 +13,26 @@ import (
 
 // CategoryService implements the business logic for category operations
 type CategoryService struct {
-	repo   domain.CategoryRepository
-	logger *zap.Logger
+	repo     domain.CategoryRepository
+	products domain.ProductRepository
+	logger   *zap.Logger
 }
 
 // NewCategoryService creates a new category service
-func NewCategoryService(repo domain.CategoryRepository, logger *zap.Logger) *CategoryService {
+func NewCategoryService(repo domain.CategoryRepository, products domain.ProductRepository, logger *zap.Logger) *CategoryService {
 	return &CategoryService{
-		repo:   repo,
-		logger: logger.Named("category_service"),
+		repo:     repo,
+		products: products,
+		logger:   logger.Named("category_service"),
 	}
 }
 
 // CreateCategory creates a new category
 func (s *CategoryService) CreateCategory(ctx context.Context, category *domain.Category) (*domain.Category, error) {
+	if err := domain.ValidateAttributeSchema(category.Attributes); err != nil {
+		return nil, err
+	}
+
 	// Set timestamps
 	now := time.Now()
 	category.CreatedAt = now
@@ -39,11 +47,7 @@ func (s *CategoryService) CreateCategory(ctx context.Context, category *domain.C
 		}
 
 		category.Level = parent.Level + 1
-		if parent.Path != "" {
-			category.Path = parent.Path + "/" + parent.ID.Hex()
-		} else {
-			category.Path = parent.ID.Hex()
-		}
+		category.Path = parent.ChildPath()
 	} else {
 		category.Level = 0
 		category.Path = ""
@@ -57,7 +61,8 @@ func (s *CategoryService) GetCategory(ctx context.Context, id string) (*domain.C
 	return s.repo.GetByID(ctx, id)
 }
 
-// UpdateCategory updates an existing category
+// UpdateCategory updates an existing category. Its place in the hierarchy is
+// kept; use MoveCategory to change the parent.
 func (s *CategoryService) UpdateCategory(ctx context.Context, category *domain.Category) error {
 	// Get the existing category to preserve some fields
 	existing, err := s.repo.GetByID(ctx, category.ID.Hex())
@@ -65,13 +70,111 @@ func (s *CategoryService) UpdateCategory(ctx context.Context, category *domain.C
 		return err
 	}
 
-	// Preserve created_at and update updated_at
+	// Attributes left out keep the current schema
+	if category.Attributes == nil {
+		category.Attributes = existing.Attributes
+	} else if err := domain.ValidateAttributeSchema(category.Attributes); err != nil {
+		return err
+	}
+
+	// Preserve created_at, the hierarchy and the product count, and update updated_at
 	category.CreatedAt = existing.CreatedAt
+	category.ParentID = existing.ParentID
+	category.Level = existing.Level
+	category.Path = existing.Path
+	category.ProductCount = existing.ProductCount
 	category.UpdatedAt = time.Now()
 
 	return s.repo.Update(ctx, category)
 }
 
+// MoveCategory makes newParentID the parent of a category, or makes it a root
+// category when newParentID is empty. The level and path of the category and
+// of every category below it are recomputed. Moving a category under itself or
+// one of its subcategories fails with ErrCategoryCycle.
+//
+// Product counts are per category and not rolled up, so they are unaffected.
+func (s *CategoryService) MoveCategory(ctx context.Context, id, newParentID string) (*domain.Category, error) {
+	category, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if category.ParentID == newParentID {
+		return category, nil
+	}
+
+	level, path := int32(0), ""
+	if newParentID != "" {
+		if newParentID == id {
+			return nil, domain.ErrCategoryCycle
+		}
+		parent, err := s.repo.GetByID(ctx, newParentID)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) || errors.Is(err, domain.ErrInvalidID) {
+				return nil, domain.ErrParentCategoryNotFound
+			}
+			return nil, err
+		}
+		if parent.HasAncestor(id) {
+			return nil, domain.ErrCategoryCycle
+		}
+		level, path = parent.Level+1, parent.ChildPath()
+	}
+
+	descendants, err := s.repo.ListDescendants(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	oldChildPath, shift := category.ChildPath(), level-category.Level
+	category.ParentID = newParentID
+	category.Level = level
+	category.Path = path
+	category.UpdatedAt = now
+
+	newChildPath := category.ChildPath()
+	for _, descendant := range descendants {
+		descendant.Level += shift
+		descendant.Path = newChildPath + strings.TrimPrefix(descendant.Path, oldChildPath)
+		descendant.UpdatedAt = now
+	}
+
+	if err := s.repo.UpdateHierarchy(ctx, append([]*domain.Category{category}, descendants...)); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Moved category",
+		zap.String("category_id", id),
+		zap.String("parent_id", newParentID),
+		zap.Int("descendants", len(descendants)))
+	return category, nil
+}
+
+// SetCategoryAttributes replaces the attribute schema of a category. No
+// attributes removes the schema. Products already in the category are not
+// rechecked; the schema applies the next time one of them is saved.
+func (s *CategoryService) SetCategoryAttributes(ctx context.Context, id string, attributes []domain.AttributeDefinition) (*domain.Category, error) {
+	if err := domain.ValidateAttributeSchema(attributes); err != nil {
+		return nil, err
+	}
+
+	category, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	category.Attributes = attributes
+	category.UpdatedAt = time.Now()
+	if err := s.repo.Update(ctx, category); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Set category attributes",
+		zap.String("category_id", id),
+		zap.Int("attributes", len(attributes)))
+	return category, nil
+}
+
 // DeleteCategory deletes a category by ID
 func (s *CategoryService) DeleteCategory(ctx context.Context, id string) error {
 	// Check if category has any products
@@ -85,3 +188,35 @@ func (s *CategoryService) DeleteCategory(ctx context.Context, id string) error {
 func (s *CategoryService) ListCategories(ctx context.Context, parentID string, depth int32) ([]*domain.Category, error) {
 	return s.repo.List(ctx, parentID, depth)
 }
+
+// ReconcileProductCounts recounts the products in every category and corrects
+// the stored counts that have drifted. It returns the number of categories fixed.
+func (s *CategoryService) ReconcileProductCounts(ctx context.Context) (int, error) {
+	counts, err := s.products.CountByCategory(ctx)
+	if err != nil {
+		return 0, err
+	}
+	categories, err := s.repo.ListAll(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	fixed := 0
+	for _, category := range categories {
+		id := category.ID.Hex()
+		actual := counts[id]
+		if category.ProductCount == actual {
+			continue
+		}
+		if err := s.repo.SetProductCount(ctx, id, actual); err != nil {
+			return fixed, err
+		}
+		s.logger.Info("Corrected category product count",
+			zap.String("category_id", id),
+			zap.Int64("stored", category.ProductCount),
+			zap.Int64("actual", actual))
+		fixed++
+	}
+
+	return fixed, nil
+}
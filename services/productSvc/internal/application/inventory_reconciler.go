@@ -0,0 +1,72 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// InventoryReconciler periodically creates the inventory rows of products
+// whose inventory creation still failed after its retries
+type InventoryReconciler struct {
+	products *ProductService
+	interval time.Duration
+	logger   *zap.Logger
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+// NewInventoryReconciler creates a reconciler that runs every interval
+func NewInventoryReconciler(products *ProductService, interval time.Duration, logger *zap.Logger) *InventoryReconciler {
+	return &InventoryReconciler{
+		products: products,
+		interval: interval,
+		logger:   logger.Named("inventory_reconciler"),
+	}
+}
+
+// Start runs a reconciliation immediately and then on every interval until Stop
+func (r *InventoryReconciler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		for {
+			r.reconcile(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop stops the reconciler and waits for a running pass to finish
+func (r *InventoryReconciler) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+}
+
+// reconcile runs one reconciliation pass
+func (r *InventoryReconciler) reconcile(ctx context.Context) {
+	created, err := r.products.ReconcilePendingInventory(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			r.logger.Error("Pending inventory reconciliation failed", zap.Error(err))
+		}
+		return
+	}
+	if created > 0 {
+		r.logger.Info("Created pending inventory rows", zap.Int("created", created))
+	}
+}
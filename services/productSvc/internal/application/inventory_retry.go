@@ -0,0 +1,163 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// pendingInventoryBatchSize is the number of queued products handled per
+// reconciliation pass
+const pendingInventoryBatchSize = 100
+
+// isRetryableInventoryError reports whether a failed inventory call may
+// succeed when simply tried again
+func isRetryableInventoryError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
+
+// createInventory creates the inventory row of a new product once. A row that
+// already exists, e.g. because an earlier attempt timed out after succeeding,
+// counts as created.
+func (s *ProductService) createInventory(ctx context.Context, productID, sku, locationID string) error {
+	_, err := s.inventoryClient.CreateInventory(ctx, productID, sku, locationID, 0)
+	if err != nil && status.Code(err) == codes.AlreadyExists {
+		return nil
+	}
+	return err
+}
+
+// createInventoryWithRetry creates the inventory row of a new product, retrying
+// transient failures under the inventory retry policy. When every attempt
+// fails the product is queued for the inventory reconciler, so the product is
+// never left without stock tracking. It returns the last error.
+func (s *ProductService) createInventoryWithRetry(ctx context.Context, product *domain.Product, locationID string) error {
+	productID := product.ID.Hex()
+	attempts := s.inventoryRetry.MaxAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var err error
+	failed := 0
+	for failed < attempts {
+		if err = s.createInventory(ctx, productID, product.SKU, locationID); err == nil {
+			return nil
+		}
+		failed++
+		if !isRetryableInventoryError(err) || failed == attempts {
+			break
+		}
+
+		delay := s.inventoryRetry.Delay(failed)
+		s.logger.Warn("Inventory creation failed, retrying",
+			zap.String("product_id", productID),
+			zap.Int("attempt", failed),
+			zap.Duration("delay", delay),
+			zap.Error(err))
+		select {
+		case <-ctx.Done():
+			failed = attempts
+		case <-time.After(delay):
+		}
+	}
+
+	s.enqueueInventory(ctx, product, locationID, failed, err)
+	return err
+}
+
+// enqueueInventory hands a product whose inventory row could not be created to
+// the inventory reconciler. The product has already been stored, so the
+// request being cancelled must not lose the entry.
+func (s *ProductService) enqueueInventory(ctx context.Context, product *domain.Product, locationID string, attempts int, cause error) {
+	if s.pendingInventory == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+
+	err := s.pendingInventory.Enqueue(ctx, &domain.PendingInventory{
+		ProductID:  product.ID.Hex(),
+		SKU:        product.SKU,
+		LocationID: locationID,
+		Attempts:   attempts,
+		LastError:  cause.Error(),
+	})
+	if err != nil {
+		s.logger.Error("Failed to queue product for inventory creation",
+			zap.String("product_id", product.ID.Hex()),
+			zap.Error(err))
+		return
+	}
+	s.logger.Warn("Queued product for inventory creation",
+		zap.String("product_id", product.ID.Hex()),
+		zap.String("location_id", locationID),
+		zap.Int("attempts", attempts))
+}
+
+// ReconcilePendingInventory tries once more to create the inventory rows of
+// queued products. Products whose row is created, or that were deleted in the
+// meantime, leave the queue; the others stay queued with one more attempt
+// counted. It returns the number of rows created.
+func (s *ProductService) ReconcilePendingInventory(ctx context.Context) (int, error) {
+	if s.pendingInventory == nil {
+		return 0, nil
+	}
+	items, err := s.pendingInventory.List(ctx, pendingInventoryBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	created := 0
+	for _, item := range items {
+		if ctx.Err() != nil {
+			return created, ctx.Err()
+		}
+		// A product that is being handled is finished even when the pass
+		// is stopped, so it never leaves the queue without its row
+		itemCtx := context.WithoutCancel(ctx)
+
+		if _, err := s.repo.GetByID(itemCtx, item.ProductID); err != nil {
+			if !errors.Is(err, domain.ErrNotFound) {
+				return created, err
+			}
+			if err := s.pendingInventory.Remove(itemCtx, item.ProductID); err != nil {
+				return created, err
+			}
+			continue
+		}
+
+		if err := s.createInventory(itemCtx, item.ProductID, item.SKU, item.LocationID); err != nil {
+			s.logger.Warn("Queued inventory creation failed again",
+				zap.String("product_id", item.ProductID),
+				zap.Int("attempts", item.Attempts+1),
+				zap.Error(err))
+			if err := s.pendingInventory.Enqueue(itemCtx, &domain.PendingInventory{
+				ProductID:  item.ProductID,
+				SKU:        item.SKU,
+				LocationID: item.LocationID,
+				Attempts:   1,
+				LastError:  err.Error(),
+			}); err != nil {
+				return created, err
+			}
+			continue
+		}
+
+		if err := s.pendingInventory.Remove(itemCtx, item.ProductID); err != nil {
+			return created, err
+		}
+		created++
+	}
+	return created, nil
+}
@@ -0,0 +1,163 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// flakyInventoryBackend fails the first failures inventory creations with
+// code, then creates them like recordingInventoryBackend
+type flakyInventoryBackend struct {
+	recordingInventoryBackend
+	failures int
+	code     codes.Code
+	calls    int
+}
+
+func (b *flakyInventoryBackend) CreateInventory(ctx context.Context, req *inventoryv1.CreateInventoryRequest) (*inventoryv1.CreateInventoryResponse, error) {
+	b.mu.Lock()
+	b.calls++
+	fail := b.calls <= b.failures
+	b.mu.Unlock()
+	if fail {
+		return nil, status.Error(b.code, "inventory service unavailable")
+	}
+	return b.recordingInventoryBackend.CreateInventory(ctx, req)
+}
+
+// memoryPendingInventory is an in-memory PendingInventoryQueue
+type memoryPendingInventory struct {
+	mu    sync.Mutex
+	items map[string]*domain.PendingInventory
+}
+
+func newMemoryPendingInventory() *memoryPendingInventory {
+	return &memoryPendingInventory{items: make(map[string]*domain.PendingInventory)}
+}
+
+func (q *memoryPendingInventory) Enqueue(ctx context.Context, item *domain.PendingInventory) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if queued, ok := q.items[item.ProductID]; ok {
+		queued.Attempts += item.Attempts
+		queued.LastError = item.LastError
+		return nil
+	}
+	copied := *item
+	q.items[item.ProductID] = &copied
+	return nil
+}
+
+func (q *memoryPendingInventory) List(ctx context.Context, limit int) ([]*domain.PendingInventory, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var items []*domain.PendingInventory
+	for _, item := range q.items {
+		copied := *item
+		items = append(items, &copied)
+	}
+	return items, nil
+}
+
+func (q *memoryPendingInventory) Remove(ctx context.Context, productID string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.items, productID)
+	return nil
+}
+
+func newRetryTestService(t *testing.T, repo domain.ProductRepository, inventory inventoryv1.InventoryServiceServer, pending domain.PendingInventoryQueue) *ProductService {
+	t.Helper()
+	return NewProductService(repo, nil, newSupplierClient(t, stubSupplierBackend{}), newInventoryClient(t, inventory),
+		testDefaultLocation, "", nil, domain.SearchPolicy{}, domain.SortOption{}, domain.MediaPolicy{},
+		domain.NewPricePolicy(nil), nil, domain.RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond}, pending, zap.NewNop())
+}
+
+func TestCreateProductRetriesUnavailableInventory(t *testing.T) {
+	inventory := &flakyInventoryBackend{failures: 1, code: codes.Unavailable}
+	pending := newMemoryPendingInventory()
+	service := newRetryTestService(t, newMemoryProductRepository(), inventory, pending)
+
+	product, err := service.CreateProduct(context.Background(), newTestProduct("LAMP-1"), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if inventory.calls != 2 {
+		t.Fatalf("inventory calls = %d, want a retry after the first failure", inventory.calls)
+	}
+	if got := inventory.createdLocations(); len(got) != 1 {
+		t.Fatalf("created %d inventory rows, want 1", len(got))
+	}
+	if len(pending.items) != 0 {
+		t.Fatalf("product %s should not be queued once the retry succeeded", product.ID.Hex())
+	}
+}
+
+func TestCreateProductQueuesInventoryAfterLastAttempt(t *testing.T) {
+	inventory := &flakyInventoryBackend{failures: 10, code: codes.Unavailable}
+	pending := newMemoryPendingInventory()
+	repo := newMemoryProductRepository()
+	service := newRetryTestService(t, repo, inventory, pending)
+
+	product, err := service.CreateProduct(context.Background(), newTestProduct("LAMP-1"), "")
+	if err != nil {
+		t.Fatalf("product creation should not fail on inventory errors: %v", err)
+	}
+
+	if inventory.calls != 3 {
+		t.Fatalf("inventory calls = %d, want MaxAttempts", inventory.calls)
+	}
+	queued, ok := pending.items[product.ID.Hex()]
+	if !ok {
+		t.Fatal("product should be queued for the reconciler")
+	}
+	if queued.Attempts != 3 || queued.LocationID != testDefaultLocation || queued.SKU != "LAMP-1" {
+		t.Fatalf("queued = %+v", queued)
+	}
+
+	// The inventory service is back; the reconciler creates the row
+	inventory.failures = 0
+	created, err := service.ReconcilePendingInventory(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created != 1 || len(pending.items) != 0 {
+		t.Fatalf("created = %d, still queued = %d, want the row created and the queue empty", created, len(pending.items))
+	}
+}
+
+func TestCreateProductDoesNotRetryPermanentInventoryErrors(t *testing.T) {
+	inventory := &flakyInventoryBackend{failures: 10, code: codes.InvalidArgument}
+	pending := newMemoryPendingInventory()
+	service := newRetryTestService(t, newMemoryProductRepository(), inventory, pending)
+
+	if _, err := service.CreateProduct(context.Background(), newTestProduct("LAMP-1"), ""); err != nil {
+		t.Fatal(err)
+	}
+	if inventory.calls != 1 {
+		t.Fatalf("inventory calls = %d, a permanent error should not be retried", inventory.calls)
+	}
+	if len(pending.items) != 1 {
+		t.Fatal("the product should still be queued for the reconciler")
+	}
+}
+
+func TestRetryPolicyDelayDoubles(t *testing.T) {
+	policy := domain.RetryPolicy{MaxAttempts: 4, Backoff: 100 * time.Millisecond}
+	want := []time.Duration{0, 100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond}
+	for failed, delay := range want {
+		if got := policy.Delay(failed); got != delay {
+			t.Errorf("Delay(%d) = %v, want %v", failed, got, delay)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// mediaProbe is one image waiting to be probed
+type mediaProbe struct {
+	productID string
+	imageURL  string
+}
+
+// MediaProbeWorker probes newly added product images in the background and
+// stores their metadata on the product. Probes are spaced at least interval
+// apart so that a large import does not hammer the media hosts. A failed
+// probe only leaves the image without metadata.
+type MediaProbeWorker struct {
+	repo     domain.ProductRepository
+	prober   domain.MediaProber
+	interval time.Duration
+	timeout  time.Duration
+	queue    chan mediaProbe
+	logger   *zap.Logger
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+// Ensure MediaProbeWorker implements MediaProbeQueue
+var _ domain.MediaProbeQueue = (*MediaProbeWorker)(nil)
+
+// NewMediaProbeWorker creates a worker that holds up to queueSize pending
+// probes and gives each one timeout to finish
+func NewMediaProbeWorker(repo domain.ProductRepository, prober domain.MediaProber, interval, timeout time.Duration, queueSize int, logger *zap.Logger) *MediaProbeWorker {
+	return &MediaProbeWorker{
+		repo:     repo,
+		prober:   prober,
+		interval: interval,
+		timeout:  timeout,
+		queue:    make(chan mediaProbe, queueSize),
+		logger:   logger.Named("media_prober"),
+	}
+}
+
+// Enqueue schedules a probe of an image. When the queue is full the probe
+// is dropped; the image is queued again the next time its product is saved.
+func (w *MediaProbeWorker) Enqueue(productID, imageURL string) {
+	select {
+	case w.queue <- mediaProbe{productID: productID, imageURL: imageURL}:
+	default:
+		w.logger.Warn("Media probe queue full, dropping probe",
+			zap.String("product_id", productID),
+			zap.String("image_url", imageURL),
+		)
+	}
+}
+
+// Start processes queued probes until Stop
+func (w *MediaProbeWorker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			var probe mediaProbe
+			select {
+			case <-ctx.Done():
+				return
+			case probe = <-w.queue:
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			w.probe(ctx, probe)
+		}
+	}()
+}
+
+// Stop stops the worker and waits for a running probe to finish. Probes
+// still queued are dropped.
+func (w *MediaProbeWorker) Stop() {
+	if w.cancel != nil {
+		w.cancel()
+	}
+	w.wg.Wait()
+}
+
+// probe probes one image and stores its metadata
+func (w *MediaProbeWorker) probe(ctx context.Context, probe mediaProbe) {
+	logger := w.logger.With(
+		zap.String("product_id", probe.productID),
+		zap.String("image_url", probe.imageURL),
+	)
+
+	// A started probe runs to the end even when the worker is stopped, so
+	// its result is not lost; the timeout still bounds it
+	probeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), w.timeout)
+	defer cancel()
+
+	metadata, err := w.prober.Probe(probeCtx, probe.imageURL)
+	if err != nil {
+		logger.Warn("Failed to probe product image", zap.Error(err))
+		return
+	}
+	if err := w.repo.SetImageMetadata(probeCtx, probe.productID, probe.imageURL, metadata); err != nil {
+		logger.Error("Failed to store product image metadata", zap.Error(err))
+		return
+	}
+	logger.Debug("Product image probed",
+		zap.String("content_type", metadata.ContentType),
+		zap.Int32("width", metadata.Width),
+		zap.Int32("height", metadata.Height),
+		zap.Int64("byte_size", metadata.ByteSize),
+	)
+}
@@ -0,0 +1,147 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// stubProber returns fixed metadata for every URL and fails the URLs in fail
+type stubProber struct {
+	mu     sync.Mutex
+	probed []string
+	fail   map[string]bool
+}
+
+func (p *stubProber) Probe(ctx context.Context, url string) (*domain.MediaMetadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.probed = append(p.probed, url)
+	if p.fail[url] {
+		return nil, errors.New("connection refused")
+	}
+	return &domain.MediaMetadata{ContentType: "image/jpeg", Width: 800, Height: 600, ByteSize: 52431, ProbedAt: time.Now()}, nil
+}
+
+// recordingProbeQueue records the probes it is asked to schedule
+type recordingProbeQueue struct {
+	urls []string
+}
+
+func (q *recordingProbeQueue) Enqueue(productID, imageURL string) {
+	q.urls = append(q.urls, imageURL)
+}
+
+// newProbingProductService returns a product service that schedules image
+// probes on probes
+func newProbingProductService(t *testing.T, repo domain.ProductRepository, probes domain.MediaProbeQueue) *ProductService {
+	t.Helper()
+	return NewProductService(repo, nil, newSupplierClient(t, stubSupplierBackend{}), newInventoryClient(t, &recordingInventoryBackend{}),
+		testDefaultLocation, "", nil, domain.SearchPolicy{}, domain.SortOption{}, domain.MediaPolicy{},
+		domain.NewPricePolicy(nil), probes, domain.RetryPolicy{}, nil, zap.NewNop())
+}
+
+// waitForImageMetadata polls until the image with url of a product has
+// metadata, failing the test after a second
+func waitForImageMetadata(t *testing.T, repo domain.ProductRepository, productID, url string) *domain.MediaMetadata {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		product, err := repo.GetByID(context.Background(), productID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, img := range product.Images {
+			if img.URL == url && img.Metadata != nil {
+				return img.Metadata
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("image %s never got metadata", url)
+	return nil
+}
+
+func TestAddingImagesEnqueuesProbes(t *testing.T) {
+	repo := newMemoryProductRepository()
+	queue := &recordingProbeQueue{}
+	service := newProbingProductService(t, repo, queue)
+
+	input := newTestProduct("LAMP-PROBE-1")
+	input.ImageURLs = []string{"https://images.example.com/front.jpg"}
+	product, err := service.CreateProduct(context.Background(), input, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queue.urls) != 1 || queue.urls[0] != "https://images.example.com/front.jpg" {
+		t.Fatalf("probes = %v, want the new image", queue.urls)
+	}
+
+	// An update probes only images without metadata yet
+	if err := repo.SetImageMetadata(context.Background(), product.ID.Hex(), "https://images.example.com/front.jpg", &domain.MediaMetadata{ContentType: "image/jpeg"}); err != nil {
+		t.Fatal(err)
+	}
+	update := newTestProduct("LAMP-PROBE-1")
+	update.ID = product.ID
+	update.ImageURLs = []string{"https://images.example.com/front.jpg", "https://images.example.com/back.jpg"}
+	if err := service.UpdateProduct(context.Background(), update); err != nil {
+		t.Fatal(err)
+	}
+	if len(queue.urls) != 2 || queue.urls[1] != "https://images.example.com/back.jpg" {
+		t.Fatalf("probes = %v, want only the added image probed again", queue.urls)
+	}
+}
+
+func TestMediaProbeWorkerStoresMetadata(t *testing.T) {
+	repo := newMemoryProductRepository()
+	prober := &stubProber{fail: map[string]bool{"https://images.example.com/broken.jpg": true}}
+	worker := NewMediaProbeWorker(repo, prober, time.Millisecond, time.Second, 10, zap.NewNop())
+	service := newProbingProductService(t, repo, worker)
+	worker.Start()
+	defer worker.Stop()
+
+	input := newTestProduct("LAMP-PROBE-2")
+	input.ImageURLs = []string{"https://images.example.com/broken.jpg", "https://images.example.com/front.jpg"}
+	product, err := service.CreateProduct(context.Background(), input, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	metadata := waitForImageMetadata(t, repo, product.ID.Hex(), "https://images.example.com/front.jpg")
+	if metadata.ContentType != "image/jpeg" || metadata.Width != 800 || metadata.Height != 600 || metadata.ByteSize != 52431 {
+		t.Errorf("metadata = %+v, want the stub's", metadata)
+	}
+
+	// The failed probe leaves its image without metadata and the product intact
+	stored, err := repo.GetByID(context.Background(), product.ID.Hex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, img := range stored.Images {
+		if img.URL == "https://images.example.com/broken.jpg" && img.Metadata != nil {
+			t.Errorf("broken image has metadata %+v, want none", img.Metadata)
+		}
+	}
+	if len(stored.Images) != 2 {
+		t.Errorf("images = %d, want both kept", len(stored.Images))
+	}
+}
+
+func TestMediaProbeWorkerDropsProbesWhenFull(t *testing.T) {
+	prober := &stubProber{}
+	worker := NewMediaProbeWorker(newMemoryProductRepository(), prober, time.Millisecond, time.Second, 1, zap.NewNop())
+
+	// Enqueue never blocks, even before the worker runs
+	worker.Enqueue("product-1", "https://images.example.com/a.jpg")
+	worker.Enqueue("product-1", "https://images.example.com/b.jpg")
+
+	if got := len(worker.queue); got != 1 {
+		t.Fatalf("queued probes = %d, want 1", got)
+	}
+}
@@ -0,0 +1,423 @@
+package application
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// memoryProductRepository keeps products in memory. Only the methods the tests
+// need are implemented; anything else panics on the nil embedded interface.
+type memoryProductRepository struct {
+	domain.ProductRepository
+	mu       sync.Mutex
+	products map[primitive.ObjectID]*domain.Product
+}
+
+func newMemoryProductRepository(products ...*domain.Product) *memoryProductRepository {
+	r := &memoryProductRepository{products: make(map[primitive.ObjectID]*domain.Product)}
+	for _, p := range products {
+		r.products[p.ID] = p
+	}
+	return r
+}
+
+func (r *memoryProductRepository) Create(ctx context.Context, product *domain.Product) (*domain.Product, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, p := range r.products {
+		if p.SKU == product.SKU {
+			return nil, domain.ErrSKUAlreadyExists
+		}
+	}
+	stored := *product
+	stored.ID = primitive.NewObjectID()
+	stored.Version = 1
+	r.products[stored.ID] = &stored
+	created := stored
+	return &created, nil
+}
+
+func (r *memoryProductRepository) GetByID(ctx context.Context, id string) (*domain.Product, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, domain.ErrInvalidID
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p, ok := r.products[objectID]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	found := *p
+	return &found, nil
+}
+
+func (r *memoryProductRepository) GetBySKU(ctx context.Context, sku string) (*domain.Product, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, p := range r.products {
+		if p.SKU == sku && p.DeletedAt == nil {
+			found := *p
+			return &found, nil
+		}
+	}
+	return nil, domain.ErrProductNotFound
+}
+
+// Update saves product only while it is still at the stored version, like
+// the MongoDB repository, and increments the version
+func (r *memoryProductRepository) Update(ctx context.Context, product *domain.Product) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	current, ok := r.products[product.ID]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	if current.Version != product.Version {
+		return domain.ErrConflict
+	}
+	product.Version++
+	stored := *product
+	r.products[product.ID] = &stored
+	return nil
+}
+
+func (r *memoryProductRepository) SetImageMetadata(ctx context.Context, productID, imageURL string, metadata *domain.MediaMetadata) error {
+	objectID, err := primitive.ObjectIDFromHex(productID)
+	if err != nil {
+		return domain.ErrInvalidID
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p, ok := r.products[objectID]
+	if !ok {
+		return nil
+	}
+	images := append([]domain.ProductImage(nil), p.Images...)
+	for i := range images {
+		if images[i].URL == imageURL {
+			images[i].Metadata = metadata
+		}
+	}
+	stored := *p
+	stored.Images = images
+	r.products[objectID] = &stored
+	return nil
+}
+
+func (r *memoryProductRepository) SoftDelete(ctx context.Context, id string) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return domain.ErrInvalidID
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p, ok := r.products[objectID]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	now := time.Now()
+	p.DeletedAt = &now
+	return nil
+}
+
+func (r *memoryProductRepository) SetRating(ctx context.Context, productID string, rating domain.RatingSummary) error {
+	objectID, err := primitive.ObjectIDFromHex(productID)
+	if err != nil {
+		return domain.ErrInvalidID
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p, ok := r.products[objectID]
+	if !ok {
+		return domain.ErrProductNotFound
+	}
+	p.Rating = rating
+	return nil
+}
+
+func (r *memoryProductRepository) GetByIDs(ctx context.Context, ids []string) ([]*domain.Product, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var products []*domain.Product
+	for _, id := range ids {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			continue
+		}
+		if p, ok := r.products[objectID]; ok && p.DeletedAt == nil {
+			found := *p
+			products = append(products, &found)
+		}
+	}
+	return products, nil
+}
+
+// BulkSetCategories applies mode like the MongoDB update does, counting only
+// the products whose categories change
+func (r *memoryProductRepository) BulkSetCategories(ctx context.Context, productIDs, categoryIDs []string, mode string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var changed int64
+	for _, id := range productIDs {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return 0, domain.ErrInvalidID
+		}
+		p, ok := r.products[objectID]
+		if !ok || p.DeletedAt != nil {
+			continue
+		}
+		after := applyCategoryMode(p.CategoryIDs, categoryIDs, mode)
+		if removed, added := diffCategoryIDs(p.CategoryIDs, after); len(removed) == 0 && len(added) == 0 {
+			continue
+		}
+		p.CategoryIDs = after
+		changed++
+	}
+	return changed, nil
+}
+
+// List pages through the products matching the filter. Like MongoDB it
+// returns them in no particular order without a sort; with one it sorts on
+// the creation date, update date or name and breaks ties by ID.
+func (r *memoryProductRepository) List(ctx context.Context, opts *domain.ListOptions) ([]*domain.Product, int64, error) {
+	filter := &domain.ProductFilter{}
+	if opts != nil && opts.Filter != nil {
+		filter = opts.Filter
+	}
+	r.mu.Lock()
+	var matched []*domain.Product
+	for _, p := range r.products {
+		if productMatches(p, filter) {
+			found := *p
+			matched = append(matched, &found)
+		}
+	}
+	r.mu.Unlock()
+
+	if opts != nil && opts.Sort != nil {
+		desc := opts.Sort.Order == domain.SortOrderDesc
+		sort.Slice(matched, func(a, b int) bool {
+			x, y := matched[a], matched[b]
+			if desc {
+				x, y = y, x
+			}
+			switch {
+			case opts.Sort.Field == domain.SortFieldName && x.Name != y.Name:
+				return x.Name < y.Name
+			case opts.Sort.Field == domain.SortFieldUpdatedAt && !x.UpdatedAt.Equal(y.UpdatedAt):
+				return x.UpdatedAt.Before(y.UpdatedAt)
+			case opts.Sort.Field == domain.SortFieldCreatedAt && !x.CreatedAt.Equal(y.CreatedAt):
+				return x.CreatedAt.Before(y.CreatedAt)
+			}
+			return x.ID.Hex() < y.ID.Hex()
+		})
+	}
+
+	total := int64(len(matched))
+	if opts != nil && opts.Pagination != nil {
+		start := (opts.Pagination.Page - 1) * opts.Pagination.PageSize
+		if start >= len(matched) {
+			return nil, total, nil
+		}
+		matched = matched[start:]
+		if opts.Pagination.PageSize < len(matched) {
+			matched = matched[:opts.Pagination.PageSize]
+		}
+	}
+	return matched, total, nil
+}
+
+func (r *memoryProductRepository) CountByCategory(ctx context.Context) (map[string]int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	counts := make(map[string]int64)
+	for _, p := range r.products {
+		if p.DeletedAt != nil {
+			continue
+		}
+		for _, id := range p.CategoryIDs {
+			counts[id]++
+		}
+	}
+	return counts, nil
+}
+
+// memoryCategoryRepository keeps categories in memory. Only the methods the
+// tests need are implemented.
+type memoryCategoryRepository struct {
+	domain.CategoryRepository
+	mu         sync.Mutex
+	categories map[string]*domain.Category
+}
+
+func newMemoryCategoryRepository(categories ...*domain.Category) *memoryCategoryRepository {
+	r := &memoryCategoryRepository{categories: make(map[string]*domain.Category)}
+	for _, c := range categories {
+		r.categories[c.ID.Hex()] = c
+	}
+	return r
+}
+
+// count returns the stored product count of a category
+func (r *memoryCategoryRepository) count(id primitive.ObjectID) int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.categories[id.Hex()].ProductCount
+}
+
+func (r *memoryCategoryRepository) GetByID(ctx context.Context, id string) (*domain.Category, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.categories[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	found := *c
+	return &found, nil
+}
+
+func (r *memoryCategoryRepository) ListAll(ctx context.Context) ([]*domain.Category, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	categories := make([]*domain.Category, 0, len(r.categories))
+	for _, c := range r.categories {
+		copied := *c
+		categories = append(categories, &copied)
+	}
+	return categories, nil
+}
+
+func (r *memoryCategoryRepository) Update(ctx context.Context, category *domain.Category) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.categories[category.ID.Hex()]; !ok {
+		return domain.ErrNotFound
+	}
+	copied := *category
+	r.categories[category.ID.Hex()] = &copied
+	return nil
+}
+
+func (r *memoryCategoryRepository) ListDescendants(ctx context.Context, id string) ([]*domain.Category, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var descendants []*domain.Category
+	for _, c := range r.categories {
+		if c.HasAncestor(id) {
+			copied := *c
+			descendants = append(descendants, &copied)
+		}
+	}
+	return descendants, nil
+}
+
+func (r *memoryCategoryRepository) UpdateHierarchy(ctx context.Context, categories []*domain.Category) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, c := range categories {
+		stored, ok := r.categories[c.ID.Hex()]
+		if !ok {
+			return domain.ErrNotFound
+		}
+		stored.ParentID, stored.Level, stored.Path = c.ParentID, c.Level, c.Path
+	}
+	return nil
+}
+
+func (r *memoryCategoryRepository) AdjustProductCounts(ctx context.Context, categoryIDs []string, delta int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, id := range categoryIDs {
+		if c, ok := r.categories[id]; ok {
+			c.ProductCount += delta
+		}
+	}
+	return nil
+}
+
+func (r *memoryCategoryRepository) SetProductCount(ctx context.Context, id string, count int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.categories[id]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	c.ProductCount = count
+	return nil
+}
+
+func (r *memoryProductRepository) GetBySupplier(ctx context.Context, supplierID string, opts *domain.ListOptions) ([]*domain.Product, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var products []*domain.Product
+	for _, p := range r.products {
+		if p.SupplierID == supplierID && p.DeletedAt == nil {
+			found := *p
+			products = append(products, &found)
+		}
+	}
+	return products, int64(len(products)), nil
+}
+
+// StreamList supports the supplier, category, active, creation date and
+// deleted parts of a filter and passes products oldest first
+func (r *memoryProductRepository) StreamList(ctx context.Context, opts *domain.ListOptions, fn func(*domain.Product) error) error {
+	filter := &domain.ProductFilter{}
+	if opts != nil && opts.Filter != nil {
+		filter = opts.Filter
+	}
+	r.mu.Lock()
+	var matched []*domain.Product
+	for _, p := range r.products {
+		if productMatches(p, filter) {
+			found := *p
+			matched = append(matched, &found)
+		}
+	}
+	r.mu.Unlock()
+	sort.Slice(matched, func(a, b int) bool { return matched[a].CreatedAt.Before(matched[b].CreatedAt) })
+
+	for _, p := range matched {
+		if err := fn(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func productMatches(p *domain.Product, filter *domain.ProductFilter) bool {
+	if p.DeletedAt != nil && !filter.IncludeDeleted {
+		return false
+	}
+	if filter.SupplierID != "" && p.SupplierID != filter.SupplierID {
+		return false
+	}
+	if filter.IsActive != nil && p.IsActive != *filter.IsActive {
+		return false
+	}
+	if !filter.CreatedAfter.IsZero() && p.CreatedAt.Before(filter.CreatedAfter) {
+		return false
+	}
+	if !filter.CreatedBefore.IsZero() && !p.CreatedAt.Before(filter.CreatedBefore) {
+		return false
+	}
+	if len(filter.CategoryIDs) == 0 {
+		return true
+	}
+	for _, want := range filter.CategoryIDs {
+		for _, id := range p.CategoryIDs {
+			if id == want {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+func newShoeCategory() *domain.Category {
+	shoes := newTestCategory("Shoes")
+	shoes.Attributes = []domain.AttributeDefinition{
+		{Name: "size", Type: domain.AttributeTypeNumber, Required: true},
+	}
+	return shoes
+}
+
+func TestCreateProductRejectsMissingRequiredAttribute(t *testing.T) {
+	ctx := context.Background()
+	shoes := newShoeCategory()
+	repo := newMemoryProductRepository()
+	service := newTestProductService(t, repo, newMemoryCategoryRepository(shoes), &recordingInventoryBackend{})
+
+	input := newTestProduct("SHOE-1")
+	input.CategoryIDs = []string{shoes.ID.Hex()}
+	input.Metadata = map[string]interface{}{"color": "black"}
+
+	_, err := service.CreateProduct(ctx, input, "")
+
+	if !errors.Is(err, domain.ErrMissingAttribute) {
+		t.Fatalf("error = %v, want %v", err, domain.ErrMissingAttribute)
+	}
+	if len(repo.products) != 0 {
+		t.Fatalf("%d products stored, want none", len(repo.products))
+	}
+}
+
+func TestCreateProductWithRequiredAttribute(t *testing.T) {
+	shoes := newShoeCategory()
+	service := newTestProductService(t, newMemoryProductRepository(), newMemoryCategoryRepository(shoes), &recordingInventoryBackend{})
+
+	input := newTestProduct("SHOE-1")
+	input.CategoryIDs = []string{shoes.ID.Hex()}
+	input.Metadata = map[string]interface{}{"size": "42"}
+
+	if _, err := service.CreateProduct(context.Background(), input, ""); err != nil {
+		t.Fatalf("CreateProduct: %v", err)
+	}
+}
+
+func TestUpdateProductRejectsRemovedRequiredAttribute(t *testing.T) {
+	ctx := context.Background()
+	shoes := newShoeCategory()
+	service := newTestProductService(t, newMemoryProductRepository(), newMemoryCategoryRepository(shoes), &recordingInventoryBackend{})
+
+	input := newTestProduct("SHOE-1")
+	input.CategoryIDs = []string{shoes.ID.Hex()}
+	input.Metadata = map[string]interface{}{"size": "42"}
+	product, err := service.CreateProduct(ctx, input, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	update := *product
+	update.Metadata = map[string]interface{}{}
+	if err := service.UpdateProduct(ctx, &update); !errors.Is(err, domain.ErrMissingAttribute) {
+		t.Fatalf("UpdateProduct error = %v, want %v", err, domain.ErrMissingAttribute)
+	}
+}
+
+func TestSetCategoryAttributes(t *testing.T) {
+	ctx := context.Background()
+	lamps := newTestCategory("Lamps")
+	categories := newMemoryCategoryRepository(lamps)
+	service := NewCategoryService(categories, newMemoryProductRepository(), zap.NewNop())
+
+	updated, err := service.SetCategoryAttributes(ctx, lamps.ID.Hex(), []domain.AttributeDefinition{
+		{Name: " wattage ", Type: domain.AttributeTypeNumber, Required: true},
+	})
+	if err != nil {
+		t.Fatalf("SetCategoryAttributes: %v", err)
+	}
+	if len(updated.Attributes) != 1 || updated.Attributes[0].Name != "wattage" {
+		t.Fatalf("attributes = %+v, want wattage", updated.Attributes)
+	}
+	stored, _ := categories.GetByID(ctx, lamps.ID.Hex())
+	if len(stored.Attributes) != 1 {
+		t.Fatalf("stored attributes = %+v, want the new schema", stored.Attributes)
+	}
+
+	_, err = service.SetCategoryAttributes(ctx, lamps.ID.Hex(), []domain.AttributeDefinition{{Name: "wattage", Type: "date"}})
+	if !errors.Is(err, domain.ErrInvalidAttributeSchema) {
+		t.Fatalf("error = %v, want %v", err, domain.ErrInvalidAttributeSchema)
+	}
+}
@@ -0,0 +1,144 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// SetBundleComponents makes a product a bundle of the given components, or
+// replaces the components of a bundle. Every component must be an existing
+// product that is not a bundle itself, and a product that already goes into
+// another bundle cannot become one, so bundles never nest and never contain
+// themselves.
+func (s *ProductService) SetBundleComponents(ctx context.Context, productID string, components []domain.BundleComponent, updatedBy string) (*domain.Product, error) {
+	if productID == "" {
+		return nil, domain.ErrInvalidID
+	}
+	if err := domain.ValidateBundleComponents(productID, components); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.repo.GetByID(ctx, productID); err != nil {
+		return nil, err
+	}
+	inUse, err := s.repo.IsBundleComponent(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if inUse {
+		return nil, domain.ErrBundleComponentInUse
+	}
+
+	ids := make([]string, 0, len(components))
+	for _, c := range components {
+		ids = append(ids, c.ProductID)
+	}
+	found, err := s.repo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*domain.Product, len(found))
+	for _, p := range found {
+		byID[p.ID.Hex()] = p
+	}
+
+	resolved := make([]domain.BundleComponent, len(components))
+	for i, c := range components {
+		p, ok := byID[c.ProductID]
+		if !ok {
+			return nil, fmt.Errorf("%w: component product %s not found", domain.ErrInvalidBundle, c.ProductID)
+		}
+		if err := domain.ResolveBundleComponent(&c, p); err != nil {
+			return nil, err
+		}
+		resolved[i] = c
+	}
+
+	product, err := s.repo.SetBundleComponents(ctx, productID, resolved, updatedBy)
+	if err != nil {
+		s.logger.Error("Failed to set bundle components",
+			zap.String("id", productID),
+			zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("Bundle components set",
+		zap.String("id", productID),
+		zap.Int("components", len(resolved)),
+	)
+	return product, nil
+}
+
+// RemoveBundle drops the components of a bundle, leaving a plain product
+func (s *ProductService) RemoveBundle(ctx context.Context, productID, updatedBy string) (*domain.Product, error) {
+	if productID == "" {
+		return nil, domain.ErrInvalidID
+	}
+
+	product, err := s.repo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if !product.IsBundle() {
+		return nil, domain.ErrNotABundle
+	}
+
+	product, err = s.repo.SetBundleComponents(ctx, productID, nil, updatedBy)
+	if err != nil {
+		s.logger.Error("Failed to remove bundle components",
+			zap.String("id", productID),
+			zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("Bundle removed", zap.String("id", productID))
+	return product, nil
+}
+
+// GetBundleAvailability returns how many units of a bundle the stock of its
+// components covers at a location, the default location when none is given
+func (s *ProductService) GetBundleAvailability(ctx context.Context, productID, locationID string) (*domain.BundleAvailability, error) {
+	if productID == "" {
+		return nil, domain.ErrInvalidID
+	}
+	if locationID == "" {
+		locationID = s.defaultLocationID
+	}
+	if locationID == "" {
+		return nil, fmt.Errorf("%w: location ID is required", domain.ErrValidation)
+	}
+
+	product, err := s.repo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if !product.IsBundle() {
+		return nil, domain.ErrNotABundle
+	}
+
+	seen := make(map[string]bool, len(product.BundleComponents))
+	items := make([]*models.InventoryRequestItem, 0, len(product.BundleComponents))
+	for _, c := range product.BundleComponents {
+		if seen[c.ProductID] {
+			continue
+		}
+		seen[c.ProductID] = true
+		items = append(items, &models.InventoryRequestItem{ProductID: c.ProductID, Quantity: c.Quantity})
+	}
+
+	resp, err := s.inventoryClient.CheckAvailability(ctx, locationID, items)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check component availability: %w", err)
+	}
+	available := make(map[string]int32, len(resp.Items))
+	for _, item := range resp.Items {
+		available[item.ProductID] = item.AvailableQty
+	}
+
+	return domain.ComputeBundleAvailability(productID, locationID, product.BundleComponents, available), nil
+}
@@ -0,0 +1,151 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// BulkSetCategories adds categories to, removes them from, or replaces the
+// categories of many products at once, as mode (add, remove or replace) says,
+// and returns how many products changed. Added categories must exist and the
+// products gaining them must carry the attributes they require; no product may
+// be left without a category. Nothing is changed when any check fails.
+// Category product counts follow the change; a failure there is logged and
+// left to the periodic reconciliation.
+func (s *ProductService) BulkSetCategories(ctx context.Context, productIDs, categoryIDs []string, mode string) (int64, error) {
+	mode = strings.ToLower(strings.TrimSpace(mode))
+	switch mode {
+	case domain.CategoryModeAdd, domain.CategoryModeRemove, domain.CategoryModeReplace:
+	default:
+		return 0, fmt.Errorf("%w: mode must be add, remove or replace", domain.ErrValidation)
+	}
+	productIDs = distinctIDs(productIDs)
+	categoryIDs = distinctIDs(categoryIDs)
+	if len(productIDs) == 0 {
+		return 0, fmt.Errorf("%w: at least one product ID is required", domain.ErrValidation)
+	}
+	if len(categoryIDs) == 0 {
+		return 0, fmt.Errorf("%w: at least one category ID is required", domain.ErrValidation)
+	}
+
+	s.logger.Info("Setting categories of products",
+		zap.String("mode", mode),
+		zap.Int("product_count", len(productIDs)),
+		zap.Strings("category_ids", categoryIDs),
+	)
+
+	var categories []*domain.Category
+	if mode != domain.CategoryModeRemove {
+		for _, id := range categoryIDs {
+			category, err := s.categories.GetByID(ctx, id)
+			if err != nil {
+				if errors.Is(err, domain.ErrNotFound) || errors.Is(err, domain.ErrInvalidID) {
+					return 0, fmt.Errorf("%w: category %s does not exist", domain.ErrValidation, id)
+				}
+				return 0, fmt.Errorf("failed to get category %s: %w", id, err)
+			}
+			categories = append(categories, category)
+		}
+	}
+
+	products, err := s.repo.GetByIDs(ctx, productIDs)
+	if err != nil {
+		return 0, err
+	}
+	deltas := make(map[string]int64)
+	for _, product := range products {
+		after := applyCategoryMode(product.CategoryIDs, categoryIDs, mode)
+		if len(after) == 0 {
+			return 0, fmt.Errorf("%w: product %s would be left without a category", domain.ErrValidation, product.ID.Hex())
+		}
+		if err := domain.ValidateAttributes(product.Metadata, categories); err != nil {
+			return 0, fmt.Errorf("validation failed for product %s: %w", product.ID.Hex(), err)
+		}
+		removed, added := diffCategoryIDs(product.CategoryIDs, after)
+		for _, id := range removed {
+			deltas[id]--
+		}
+		for _, id := range added {
+			deltas[id]++
+		}
+	}
+
+	count, err := s.repo.BulkSetCategories(ctx, productIDs, categoryIDs, mode)
+	if err != nil {
+		s.logger.Error("Failed to set categories of products", zap.Error(err))
+		return 0, err
+	}
+
+	byDelta := make(map[int64][]string)
+	for id, delta := range deltas {
+		if delta != 0 {
+			byDelta[delta] = append(byDelta[delta], id)
+		}
+	}
+	for delta, ids := range byDelta {
+		if err := s.categories.AdjustProductCounts(ctx, ids, delta); err != nil {
+			s.logger.Warn("Failed to adjust category product counts",
+				zap.Strings("category_ids", ids),
+				zap.Int64("delta", delta),
+				zap.Error(err))
+		}
+	}
+
+	s.logger.Info("Categories of products set", zap.String("mode", mode), zap.Int64("products_changed", count))
+	return count, nil
+}
+
+// applyCategoryMode returns the categories a product in current ends up in
+// after BulkSetCategories applies ids with mode
+func applyCategoryMode(current, ids []string, mode string) []string {
+	switch mode {
+	case domain.CategoryModeReplace:
+		return ids
+	case domain.CategoryModeRemove:
+		drop := make(map[string]bool, len(ids))
+		for _, id := range ids {
+			drop[id] = true
+		}
+		kept := make([]string, 0, len(current))
+		for _, id := range current {
+			if !drop[id] {
+				kept = append(kept, id)
+			}
+		}
+		return kept
+	default:
+		after := append([]string(nil), current...)
+		has := make(map[string]bool, len(current))
+		for _, id := range current {
+			has[id] = true
+		}
+		for _, id := range ids {
+			if !has[id] {
+				has[id] = true
+				after = append(after, id)
+			}
+		}
+		return after
+	}
+}
+
+// distinctIDs trims ids and drops empty and repeated ones, keeping their order
+func distinctIDs(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		out = append(out, id)
+	}
+	return out
+}
@@ -0,0 +1,184 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+func TestBulkSetCategories(t *testing.T) {
+	tests := []struct {
+		name       string
+		products   []int // indexes into the seeded products
+		categories []string
+		mode       string
+		wantCount  int64
+		// wantIn holds the categories of each seeded product afterwards
+		wantIn     [3][]string
+		wantCounts map[string]int64
+	}{
+		{
+			name:       "add",
+			products:   []int{0, 1},
+			categories: []string{"lights"},
+			mode:       domain.CategoryModeAdd,
+			wantCount:  2,
+			wantIn:     [3][]string{{"lamps", "lights"}, {"lamps", "desks", "lights"}, {"desks"}},
+			wantCounts: map[string]int64{"lamps": 2, "desks": 2, "lights": 2},
+		},
+		{
+			name:       "add to products already in the category",
+			products:   []int{0, 1, 2},
+			categories: []string{"desks"},
+			mode:       "ADD",
+			wantCount:  1,
+			wantIn:     [3][]string{{"lamps", "desks"}, {"lamps", "desks"}, {"desks"}},
+			wantCounts: map[string]int64{"lamps": 2, "desks": 3, "lights": 0},
+		},
+		{
+			name:       "remove",
+			products:   []int{1, 2},
+			categories: []string{"lamps"},
+			mode:       domain.CategoryModeRemove,
+			wantCount:  1,
+			wantIn:     [3][]string{{"lamps"}, {"desks"}, {"desks"}},
+			wantCounts: map[string]int64{"lamps": 1, "desks": 2, "lights": 0},
+		},
+		{
+			name:       "replace",
+			products:   []int{0, 1},
+			categories: []string{"lights"},
+			mode:       domain.CategoryModeReplace,
+			wantCount:  2,
+			wantIn:     [3][]string{{"lights"}, {"lights"}, {"desks"}},
+			wantCounts: map[string]int64{"lamps": 0, "desks": 1, "lights": 2},
+		},
+		{
+			name:       "replace with the same categories",
+			products:   []int{1},
+			categories: []string{"desks", "lamps"},
+			mode:       domain.CategoryModeReplace,
+			wantCount:  0,
+			wantIn:     [3][]string{{"lamps"}, {"lamps", "desks"}, {"desks"}},
+			wantCounts: map[string]int64{"lamps": 2, "desks": 2, "lights": 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			categories, byName, repo, products := seedBulkCategories()
+			service := newTestProductService(t, repo, categories, &recordingInventoryBackend{})
+
+			var productIDs, categoryIDs []string
+			for _, i := range tt.products {
+				productIDs = append(productIDs, products[i].ID.Hex())
+			}
+			for _, name := range tt.categories {
+				categoryIDs = append(categoryIDs, byName[name].ID.Hex())
+			}
+
+			count, err := service.BulkSetCategories(context.Background(), productIDs, categoryIDs, tt.mode)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if count != tt.wantCount {
+				t.Errorf("products changed = %d, want %d", count, tt.wantCount)
+			}
+			for i, p := range products {
+				var want []string
+				for _, name := range tt.wantIn[i] {
+					want = append(want, byName[name].ID.Hex())
+				}
+				if got := repo.products[p.ID].CategoryIDs; !reflect.DeepEqual(got, want) {
+					t.Errorf("product %d categories = %v, want %v", i, got, want)
+				}
+			}
+			for name, want := range tt.wantCounts {
+				if got := categories.count(byName[name].ID); got != want {
+					t.Errorf("%s count = %d, want %d", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestBulkSetCategoriesRejectsWithoutChanging(t *testing.T) {
+	unknown := primitive.NewObjectID().Hex()
+	tests := []struct {
+		name       string
+		categories func(byName map[string]*domain.Category) []string
+		mode       string
+	}{
+		{
+			name:       "unknown mode",
+			categories: func(byName map[string]*domain.Category) []string { return []string{byName["lights"].ID.Hex()} },
+			mode:       "merge",
+		},
+		{
+			name:       "category that does not exist",
+			categories: func(map[string]*domain.Category) []string { return []string{unknown} },
+			mode:       domain.CategoryModeAdd,
+		},
+		{
+			name:       "product left without a category",
+			categories: func(byName map[string]*domain.Category) []string { return []string{byName["lamps"].ID.Hex()} },
+			mode:       domain.CategoryModeRemove,
+		},
+		{
+			name:       "no categories",
+			categories: func(map[string]*domain.Category) []string { return []string{" "} },
+			mode:       domain.CategoryModeReplace,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			categories, byName, repo, products := seedBulkCategories()
+			service := newTestProductService(t, repo, categories, &recordingInventoryBackend{})
+			productIDs := []string{products[0].ID.Hex(), products[1].ID.Hex()}
+
+			count, err := service.BulkSetCategories(context.Background(), productIDs, tt.categories(byName), tt.mode)
+			if !errors.Is(err, domain.ErrValidation) {
+				t.Fatalf("err = %v, want ErrValidation", err)
+			}
+			if count != 0 {
+				t.Errorf("products changed = %d, want 0", count)
+			}
+			if got := repo.products[products[0].ID].CategoryIDs; !reflect.DeepEqual(got, []string{byName["lamps"].ID.Hex()}) {
+				t.Errorf("product categories = %v, should be unchanged", got)
+			}
+			for name, want := range map[string]int64{"lamps": 2, "desks": 2, "lights": 0} {
+				if got := categories.count(byName[name].ID); got != want {
+					t.Errorf("%s count = %d, want %d", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+// seedBulkCategories stores the categories lamps, desks and lights and three
+// products: one in lamps, one in lamps and desks and one in desks
+func seedBulkCategories() (*memoryCategoryRepository, map[string]*domain.Category, *memoryProductRepository, []*domain.Product) {
+	byName := map[string]*domain.Category{
+		"lamps":  newTestCategory("Lamps"),
+		"desks":  newTestCategory("Desks"),
+		"lights": newTestCategory("Lights"),
+	}
+	byName["lamps"].ProductCount = 2
+	byName["desks"].ProductCount = 2
+	categories := newMemoryCategoryRepository(byName["lamps"], byName["desks"], byName["lights"])
+
+	var products []*domain.Product
+	for i, in := range [][]string{{"lamps"}, {"lamps", "desks"}, {"desks"}} {
+		p := newTestProduct([]string{"LAMP-1", "LAMP-2", "DESK-1"}[i])
+		p.ID = primitive.NewObjectID()
+		for _, name := range in {
+			p.CategoryIDs = append(p.CategoryIDs, byName[name].ID.Hex())
+		}
+		products = append(products, p)
+	}
+	return categories, byName, newMemoryProductRepository(products...), products
+}
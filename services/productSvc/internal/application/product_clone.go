@@ -0,0 +1,41 @@
+package application
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// CloneProduct creates a new draft product from an existing one. The clone
+// gets a new ID, a generated SKU unless overrides names one, no barcode and
+// "(copy)" appended to its name unless overrides names it. Any other field set
+// on overrides replaces the copied value. The clone goes through the same
+// validation and inventory setup as CreateProduct, at the default location.
+func (s *ProductService) CloneProduct(ctx context.Context, sourceID string, overrides *domain.Product, copyVariants bool) (*domain.Product, error) {
+	if sourceID == "" {
+		return nil, domain.ErrInvalidID
+	}
+
+	source, err := s.repo.GetByID(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	clone := source.Clone(copyVariants)
+	clone.ApplyCloneOverrides(overrides)
+
+	created, err := s.CreateProduct(ctx, clone, "")
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Product cloned",
+		zap.String("source_id", sourceID),
+		zap.String("id", created.ID.Hex()),
+		zap.String("sku", created.SKU),
+		zap.Bool("copy_variants", copyVariants),
+	)
+	return created, nil
+}
@@ -0,0 +1,104 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+func newCloneSource() *domain.Product {
+	source := newTestProduct("ACM000100")
+	source.ID = primitive.NewObjectID()
+	source.Barcode = "5400000000017"
+	source.IsActive = true
+	source.IsPublished = true
+	source.CreatedAt = time.Now().Add(-24 * time.Hour)
+	source.Variants = []domain.Variant{{
+		ID:      "variant-1",
+		Name:    "Colour",
+		Options: []domain.VariantOption{{ID: "option-1", Name: "Colour", Value: "Red", SKU: "ACM000100-RED", Barcode: "5400000000024"}},
+	}}
+	return source
+}
+
+func TestCloneProductIsANewUnpublishedProduct(t *testing.T) {
+	source := newCloneSource()
+	repo := newMemoryProductRepository(source)
+	service := newSKUTestService(t, domain.SKUStrategySupplier, repo, nil)
+
+	clone, err := service.CloneProduct(context.Background(), source.ID.Hex(), nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if clone.ID.IsZero() || clone.ID == source.ID {
+		t.Errorf("clone ID = %s, want a new ID", clone.ID.Hex())
+	}
+	if clone.SKU == "" || clone.SKU == source.SKU {
+		t.Errorf("clone SKU = %q, want a newly generated SKU", clone.SKU)
+	}
+	if clone.Name != "Desk lamp (copy)" {
+		t.Errorf("clone name = %q", clone.Name)
+	}
+	if clone.Barcode != "" {
+		t.Errorf("clone barcode = %q, should be cleared", clone.Barcode)
+	}
+	if clone.IsPublished {
+		t.Error("clone should start unpublished")
+	}
+	if !clone.CreatedAt.After(source.CreatedAt) {
+		t.Errorf("clone CreatedAt = %v, should be reset", clone.CreatedAt)
+	}
+
+	if len(clone.Variants) != 1 || len(clone.Variants[0].Options) != 1 {
+		t.Fatalf("variants = %+v, want the source variant copied", clone.Variants)
+	}
+	option := clone.Variants[0].Options[0]
+	if clone.Variants[0].ID == "variant-1" || option.ID == "option-1" {
+		t.Error("copied variants should get new IDs")
+	}
+	if option.SKU != "" || option.Barcode != "" {
+		t.Errorf("copied option keeps SKU %q, barcode %q", option.SKU, option.Barcode)
+	}
+
+	stored, err := repo.GetByID(context.Background(), source.ID.Hex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.SKU != source.SKU || !stored.IsPublished {
+		t.Error("the source product should be left untouched")
+	}
+}
+
+func TestCloneProductAppliesOverrides(t *testing.T) {
+	source := newCloneSource()
+	service := newSKUTestService(t, domain.SKUStrategySupplier, newMemoryProductRepository(source), nil)
+
+	clone, err := service.CloneProduct(context.Background(), source.ID.Hex(),
+		&domain.Product{Name: "Floor lamp", SellingPrice: "49.99"}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if clone.Name != "Floor lamp" || clone.SellingPrice != "49.99" {
+		t.Errorf("clone = %q at %s, want the overrides applied", clone.Name, clone.SellingPrice)
+	}
+	if clone.Currency != source.Currency {
+		t.Errorf("currency = %q, want it copied from the source", clone.Currency)
+	}
+	if len(clone.Variants) != 0 {
+		t.Errorf("variants = %d, should not be copied", len(clone.Variants))
+	}
+}
+
+func TestCloneProductUnknownSource(t *testing.T) {
+	service := newSKUTestService(t, domain.SKUStrategySupplier, newMemoryProductRepository(), nil)
+
+	if _, err := service.CloneProduct(context.Background(), primitive.NewObjectID().Hex(), nil, false); err == nil {
+		t.Fatal("cloning an unknown product should fail")
+	}
+}
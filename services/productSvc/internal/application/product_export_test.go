@@ -0,0 +1,178 @@
+package application
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+func exportedProduct(sku, supplierID, categoryID string, active bool, created time.Time) *domain.Product {
+	return &domain.Product{
+		ID:           primitive.NewObjectID(),
+		Name:         "Product " + sku,
+		SKU:          sku,
+		SellingPrice: "10.00",
+		Currency:     "EUR",
+		SupplierID:   supplierID,
+		CategoryIDs:  []string{categoryID},
+		IsActive:     active,
+		CreatedAt:    created,
+	}
+}
+
+func newExportTestRepository() *memoryProductRepository {
+	base := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	deleted := exportedProduct("ACME-4", "acme", "lamps", true, base.Add(4*time.Hour))
+	deletedAt := base.Add(5 * time.Hour)
+	deleted.DeletedAt = &deletedAt
+	return newMemoryProductRepository(
+		exportedProduct("ACME-2", "acme", "chairs", true, base.Add(2*time.Hour)),
+		exportedProduct("ACME-1", "acme", "lamps", true, base.Add(time.Hour)),
+		exportedProduct("ACME-3", "acme", "lamps", false, base.Add(3*time.Hour)),
+		exportedProduct("GLOBEX-1", "globex", "lamps", true, base),
+		deleted,
+	)
+}
+
+// exportedSKUs returns the SKU column of a CSV product report
+func exportedSKUs(t *testing.T, report []byte) []string {
+	t.Helper()
+	rows, err := csv.NewReader(strings.NewReader(string(report))).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) == 0 || strings.Join(rows[0], ",") != strings.Join(productReportHeader, ",") {
+		t.Fatalf("report should start with the header, got %v", rows)
+	}
+	skus := make([]string, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		skus = append(skus, row[0])
+	}
+	return skus
+}
+
+func TestGenerateProductReportFilter(t *testing.T) {
+	service := newTestProductService(t, newExportTestRepository(), nil, &recordingInventoryBackend{})
+	active := true
+
+	tests := []struct {
+		name   string
+		filter *domain.ProductFilter
+		want   string
+	}{
+		{name: "everything", filter: nil, want: "GLOBEX-1,ACME-1,ACME-2,ACME-3"},
+		{name: "supplier", filter: &domain.ProductFilter{SupplierID: "acme"}, want: "ACME-1,ACME-2,ACME-3"},
+		{name: "category and active", filter: &domain.ProductFilter{CategoryIDs: []string{"lamps"}, IsActive: &active}, want: "GLOBEX-1,ACME-1"},
+		{name: "date range", filter: &domain.ProductFilter{
+			CreatedAfter:  time.Date(2024, 3, 1, 1, 0, 0, 0, time.UTC),
+			CreatedBefore: time.Date(2024, 3, 1, 3, 0, 0, 0, time.UTC),
+		}, want: "ACME-1,ACME-2"},
+		{name: "deleted products stay out", filter: &domain.ProductFilter{IncludeDeleted: true, SupplierID: "acme"}, want: "ACME-1,ACME-2,ACME-3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := service.GenerateProductReport(context.Background(), "csv", tt.filter, domain.Caller{Role: "ADMIN"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := strings.Join(exportedSKUs(t, report), ","); got != tt.want {
+				t.Fatalf("exported %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateProductReportSupplierScope(t *testing.T) {
+	service := newTestProductService(t, newExportTestRepository(), nil, &recordingInventoryBackend{})
+	supplier := domain.Caller{UserID: "user-1", Role: domain.RoleSupplier, SupplierID: "globex"}
+
+	t.Run("own products", func(t *testing.T) {
+		for _, filter := range []*domain.ProductFilter{nil, {SupplierID: "globex"}} {
+			report, err := service.GenerateProductReport(context.Background(), "csv", filter, supplier)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := strings.Join(exportedSKUs(t, report), ","); got != "GLOBEX-1" {
+				t.Fatalf("exported %s, want only the supplier's own GLOBEX-1", got)
+			}
+		}
+	})
+
+	t.Run("other supplier", func(t *testing.T) {
+		_, err := service.GenerateProductReport(context.Background(), "csv", &domain.ProductFilter{SupplierID: "acme"}, supplier)
+		if !errors.Is(err, domain.ErrSupplierScope) {
+			t.Fatalf("err = %v, want ErrSupplierScope", err)
+		}
+	})
+
+	t.Run("no supplier", func(t *testing.T) {
+		_, err := service.GenerateProductReport(context.Background(), "csv", nil, domain.Caller{Role: domain.RoleSupplier})
+		if !errors.Is(err, domain.ErrSupplierScope) {
+			t.Fatalf("err = %v, want ErrSupplierScope", err)
+		}
+	})
+}
+
+func TestGenerateProductReportRejectsUnknownFormat(t *testing.T) {
+	service := newTestProductService(t, newExportTestRepository(), nil, &recordingInventoryBackend{})
+
+	_, err := service.GenerateProductReport(context.Background(), "xlsx", nil, domain.Caller{})
+	if !errors.Is(err, domain.ErrUnsupportedReportFormat) || !errors.Is(err, domain.ErrValidation) {
+		t.Fatalf("err = %v, want ErrUnsupportedReportFormat, a validation error", err)
+	}
+}
+
+func TestGenerateProductReportRows(t *testing.T) {
+	base := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	lamp := exportedProduct("LAMP-1", "acme", "lamps", true, base)
+	lamp.Name = "Desk lamp, brass"
+	lamp.CostPrice = "12.50"
+	lamp.SellingPrice = "29.99"
+	lamp.CategoryIDs = []string{"lamps", "office"}
+	chair := exportedProduct("CHAIR-1", "globex", "chairs", false, base.Add(time.Hour))
+	chair.Name = "Chair"
+	service := newTestProductService(t, newMemoryProductRepository(chair, lamp), nil, &recordingInventoryBackend{})
+
+	report, err := service.GenerateProductReport(context.Background(), "CSV", nil, domain.Caller{Role: "ADMIN"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := csv.NewReader(strings.NewReader(string(report))).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{
+		{"SKU", "Name", "Cost Price", "Selling Price", "Currency", "Category IDs", "Supplier ID", "Active"},
+		{"LAMP-1", "Desk lamp, brass", "12.50", "29.99", "EUR", "lamps;office", "acme", "true"},
+		{"CHAIR-1", "Chair", "", "10.00", "EUR", "chairs", "globex", "false"},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("report has %d rows, want %d: %q", len(rows), len(want), rows)
+	}
+	for i := range want {
+		if strings.Join(rows[i], "|") != strings.Join(want[i], "|") {
+			t.Errorf("row %d = %q, want %q", i, rows[i], want[i])
+		}
+	}
+}
+
+func TestGenerateProductReportEmpty(t *testing.T) {
+	service := newTestProductService(t, newMemoryProductRepository(), nil, &recordingInventoryBackend{})
+
+	report, err := service.GenerateProductReport(context.Background(), "csv", nil, domain.Caller{Role: "ADMIN"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if skus := exportedSKUs(t, report); len(skus) != 0 {
+		t.Fatalf("exported %v, want only the header", skus)
+	}
+}
@@ -53,7 +53,7 @@ func (s *ProductInventoryService) CreateProductWithInventory(
 	initialStock int32,
 ) (*domain.Product, error) {
 	// First, create the product
-	product, err := s.productService.CreateProduct(ctx, input)
+	product, err := s.productService.CreateProduct(ctx, input, "")
 	if err != nil {
 		s.logger.Error("Failed to create product", zap.Error(err))
 		return nil, fmt.Errorf("failed to create product: %w", err)
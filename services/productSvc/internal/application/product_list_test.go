@@ -0,0 +1,119 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+func newSortTestService(t *testing.T, repo domain.ProductRepository) *ProductService {
+	t.Helper()
+	return NewProductService(repo, nil, newSupplierClient(t, stubSupplierBackend{}), newInventoryClient(t, &recordingInventoryBackend{}),
+		testDefaultLocation, "", nil, domain.SearchPolicy{}, domain.DefaultSort, domain.MediaPolicy{},
+		domain.NewPricePolicy(nil), nil, domain.RetryPolicy{}, nil, zap.NewNop())
+}
+
+// listPage returns the IDs of one page of an unsorted listing
+func listPage(t *testing.T, service *ProductService, page int) []string {
+	t.Helper()
+	products, _, err := service.ListProducts(context.Background(), &domain.ListOptions{
+		Pagination: &domain.Pagination{Page: page, PageSize: 3},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := make([]string, 0, len(products))
+	for _, p := range products {
+		ids = append(ids, p.ID.Hex())
+	}
+	return ids
+}
+
+func TestUnsortedListingsPageConsistently(t *testing.T) {
+	// Half of the products share a creation date, so the order between them
+	// comes from the tie break alone
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var products []*domain.Product
+	for i := 0; i < 6; i++ {
+		p := newTestProduct(fmt.Sprintf("SKU-%d", i))
+		p.ID = primitive.NewObjectID()
+		p.CreatedAt = created.Add(time.Duration(i/2) * time.Hour)
+		products = append(products, p)
+	}
+	service := newSortTestService(t, newMemoryProductRepository(products...))
+
+	first := append(listPage(t, service, 1), listPage(t, service, 2)...)
+	second := append(listPage(t, service, 1), listPage(t, service, 2)...)
+
+	if len(first) != len(products) {
+		t.Fatalf("listed %d products over two pages, want %d", len(first), len(products))
+	}
+	seen := make(map[string]bool)
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("second listing = %v, want the order of the first %v", second, first)
+		}
+		if seen[first[i]] {
+			t.Fatalf("product %s is on both pages of %v", first[i], first)
+		}
+		seen[first[i]] = true
+	}
+	if newest := products[len(products)-1].ID.Hex(); first[0] != newest && first[1] != newest {
+		t.Fatalf("listing = %v, want the newest products first", first)
+	}
+}
+
+func TestUnsortedListingsUseDefaultSort(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     *domain.ListOptions
+		wantSort *domain.SortOption
+	}{
+		{name: "no options", wantSort: &domain.DefaultSort},
+		{name: "no sort", opts: &domain.ListOptions{Filter: &domain.ProductFilter{SupplierID: "supplier-1"}}, wantSort: &domain.DefaultSort},
+		{
+			name:     "explicit sort",
+			opts:     &domain.ListOptions{Sort: &domain.SortOption{Field: domain.SortFieldPrice, Order: domain.SortOrderAsc}},
+			wantSort: &domain.SortOption{Field: domain.SortFieldPrice, Order: domain.SortOrderAsc},
+		},
+		{name: "text search", opts: &domain.ListOptions{Filter: &domain.ProductFilter{SearchTerm: "desk lamp"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &sortRecordingRepository{memoryProductRepository: newMemoryProductRepository()}
+			var callerSort *domain.SortOption
+			if tt.opts != nil {
+				callerSort = tt.opts.Sort
+			}
+			if _, _, err := newSortTestService(t, repo).ListProducts(context.Background(), tt.opts); err != nil {
+				t.Fatal(err)
+			}
+			if tt.opts != nil && tt.opts.Sort != callerSort {
+				t.Fatalf("caller's sort = %+v, want it left as %v", tt.opts.Sort, callerSort)
+			}
+			switch {
+			case tt.wantSort == nil && repo.sort != nil:
+				t.Fatalf("sort = %+v, want text searches left to rank by relevance", *repo.sort)
+			case tt.wantSort != nil && (repo.sort == nil || *repo.sort != *tt.wantSort):
+				t.Fatalf("sort = %v, want %+v", repo.sort, *tt.wantSort)
+			}
+		})
+	}
+}
+
+// sortRecordingRepository records the sort of the last List call
+type sortRecordingRepository struct {
+	*memoryProductRepository
+	sort *domain.SortOption
+}
+
+func (r *sortRecordingRepository) List(ctx context.Context, opts *domain.ListOptions) ([]*domain.Product, int64, error) {
+	r.sort = opts.Sort
+	return nil, 0, nil
+}
@@ -0,0 +1,56 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// filterRecordingRepository records the filter of the last List call
+type filterRecordingRepository struct {
+	*memoryProductRepository
+	filter domain.ProductFilter
+}
+
+func (r *filterRecordingRepository) List(ctx context.Context, opts *domain.ListOptions) ([]*domain.Product, int64, error) {
+	if opts != nil && opts.Filter != nil {
+		r.filter = *opts.Filter
+	}
+	return nil, 0, nil
+}
+
+func TestSearchProductsFallsBackToPrefix(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantSearch string
+		wantPrefix string
+	}{
+		{name: "too short", query: "la", wantPrefix: "la"},
+		{name: "stop words only", query: "the of", wantPrefix: "the of"},
+		{name: "normal query", query: "desk lamp", wantSearch: "desk lamp"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &filterRecordingRepository{memoryProductRepository: newMemoryProductRepository()}
+			service := NewProductService(repo, nil, newSupplierClient(t, stubSupplierBackend{}),
+				newInventoryClient(t, &recordingInventoryBackend{}), testDefaultLocation, "", nil,
+				domain.NewSearchPolicy(3, domain.DefaultStopWords), domain.SortOption{}, domain.MediaPolicy{},
+				domain.NewPricePolicy(nil), nil, domain.RetryPolicy{}, nil, zap.NewNop())
+
+			if _, _, err := service.SearchProducts(context.Background(), tt.query, nil); err != nil {
+				t.Fatal(err)
+			}
+			if repo.filter.SearchTerm != tt.wantSearch {
+				t.Errorf("SearchTerm = %q, want %q", repo.filter.SearchTerm, tt.wantSearch)
+			}
+			if repo.filter.NamePrefix != tt.wantPrefix {
+				t.Errorf("NamePrefix = %q, want %q", repo.filter.NamePrefix, tt.wantPrefix)
+			}
+		})
+	}
+}
@@ -1,16 +1,20 @@
 package application
 
 import (
+	"bytes"
 	"context"
-
+	"encoding/csv"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
-	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
 	supplierclient "github.com/leonvanderhaeghen/stockplatform/pkg/clients/supplier"
@@ -20,9 +24,38 @@ import (
 // ProductService implements the business logic for product operations
 type ProductService struct {
 	repo           domain.ProductRepository
+	categories     domain.CategoryRepository
 	supplierClient *supplierclient.Client
 	inventoryClient *inventoryclient.Client
 	logger         *zap.Logger
+
+	// defaultLocationID is where inventory rows of new products are created
+	// when no primary location is requested
+	defaultLocationID string
+
+	// skuStrategy and skuSequence generate SKUs for products created without one
+	skuStrategy domain.SKUStrategy
+	skuSequence domain.SKUSequence
+
+	// search rewrites free-text queries that are too short or all stop words
+	search domain.SearchPolicy
+
+	// defaultSort orders lists that ask for no sort and are not text searches
+	defaultSort domain.SortOption
+
+	// media restricts the hosts product images and videos may point at
+	media domain.MediaPolicy
+
+	// prices decides the decimal places prices are stored and returned with
+	prices domain.PricePolicy
+
+	// mediaProbes reads the metadata of newly added images in the background
+	mediaProbes domain.MediaProbeQueue
+
+	// inventoryRetry bounds the retries of a new product's inventory row;
+	// products still without one are queued in pendingInventory
+	inventoryRetry   domain.RetryPolicy
+	pendingInventory domain.PendingInventoryQueue
 }
 
 // Ensure ProductService implements ProductUseCase
@@ -87,6 +120,18 @@ func (s *ProductService) ListProducts(ctx context.Context, opts *domain.ListOpti
 		opts.Pagination.PageSize = 20
 	}
 
+	s.search.Apply(opts.Filter)
+
+	// Natural order is not stable across pages. Text searches keep their
+	// ranking by relevance. The default goes on a copy so the caller's
+	// options still read as unsorted.
+	if opts.Sort == nil && (opts.Filter == nil || opts.Filter.SearchTerm == "") {
+		sorted := *opts
+		sort := s.defaultSort
+		sorted.Sort = &sort
+		opts = &sorted
+	}
+
 	// Call the repository to get the paginated list of products
 	products, total, err := s.repo.List(ctx, opts)
 	if err != nil {
@@ -103,25 +148,78 @@ func (s *ProductService) ListProducts(ctx context.Context, opts *domain.ListOpti
 	return products, total, nil
 }
 
+// StreamProducts passes every product matching the filter and sort of opts to
+// fn as it is read, for exports too large to page through. Pagination is
+// ignored. It stops at the first error from fn or when ctx is cancelled.
+func (s *ProductService) StreamProducts(ctx context.Context, opts *domain.ListOptions, fn func(*domain.Product) error) error {
+	if opts == nil {
+		opts = &domain.ListOptions{}
+	}
+	s.search.Apply(opts.Filter)
+
+	if err := s.repo.StreamList(ctx, opts, fn); err != nil {
+		if ctx.Err() == nil {
+			s.logger.Error("Failed to stream products", zap.Error(err))
+		}
+		return err
+	}
+	return nil
+}
+
 // NewProductService creates a new product service
-func NewProductService(repo domain.ProductRepository, supplierClient *supplierclient.Client, inventoryClient *inventoryclient.Client, logger *zap.Logger) *ProductService {
+func NewProductService(repo domain.ProductRepository, categories domain.CategoryRepository, supplierClient *supplierclient.Client, inventoryClient *inventoryclient.Client, defaultLocationID string, skuStrategy domain.SKUStrategy, skuSequence domain.SKUSequence, search domain.SearchPolicy, defaultSort domain.SortOption, media domain.MediaPolicy, prices domain.PricePolicy, mediaProbes domain.MediaProbeQueue, inventoryRetry domain.RetryPolicy, pendingInventory domain.PendingInventoryQueue, logger *zap.Logger) *ProductService {
 	return &ProductService{
 		repo:           repo,
+		categories:     categories,
 		supplierClient: supplierClient,
 		inventoryClient: inventoryClient,
 		logger:         logger.Named("product_service"),
+		defaultLocationID: defaultLocationID,
+		skuStrategy:       skuStrategy,
+		skuSequence:       skuSequence,
+		search:            search,
+		defaultSort:       defaultSort,
+		media:             media,
+		prices:            prices,
+		mediaProbes:       mediaProbes,
+		inventoryRetry:    inventoryRetry,
+		pendingInventory:  pendingInventory,
+	}
+}
+
+// DefaultLocationID returns the location new products are stocked at by default
+func (s *ProductService) DefaultLocationID() string {
+	return s.defaultLocationID
+}
+
+// Prices returns the policy prices are normalized and formatted with
+func (s *ProductService) Prices() domain.PricePolicy {
+	return s.prices
+}
+
+// CheckLocation verifies that a location exists in the inventory service's
+// location registry. Only a definite "not found" is reported as
+// ErrLocationNotFound; other failures are returned as they are.
+func (s *ProductService) CheckLocation(ctx context.Context, locationID string) error {
+	if _, err := s.inventoryClient.GetLocation(ctx, locationID); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return fmt.Errorf("%w: %s", domain.ErrLocationNotFound, locationID)
+		}
+		return err
 	}
+	return nil
 }
 
-// CreateProduct creates a new product
-func (s *ProductService) CreateProduct(ctx context.Context, input *domain.Product) (*domain.Product, error) {
+// CreateProduct creates a new product and its inventory row at locationID,
+// or at the configured default location when locationID is empty
+func (s *ProductService) CreateProduct(ctx context.Context, input *domain.Product, locationID string) (*domain.Product, error) {
 	// Validate supplier exists
 	if input.SupplierID == "" {
 		return nil, domain.ErrSupplierRequired
 	}
 
 	// Use client abstraction instead of direct protobuf
-	_, err := s.supplierClient.GetSupplier(ctx, input.SupplierID)
+	supplier, err := s.supplierClient.GetSupplier(ctx, input.SupplierID)
 	if err != nil {
 		s.logger.Error("Invalid supplier ID", 
 			zap.String("supplierID", input.SupplierID), 
@@ -129,9 +227,12 @@ func (s *ProductService) CreateProduct(ctx context.Context, input *domain.Produc
 		return nil, domain.ErrSupplierNotFound
 	}
 
-	// Generate a new UUID for the product if not provided
-	if input.SKU == "" {
-		input.SKU = strings.ToUpper(uuid.New().String()[:8]) // Use first 8 chars of UUID as SKU
+	// Generate a SKU with the configured strategy if not provided
+	generatedSKU := input.SKU == ""
+	if generatedSKU {
+		if input.SKU, err = s.generateSKU(ctx, input, supplier.Name); err != nil {
+			return nil, fmt.Errorf("failed to generate SKU: %w", err)
+		}
 	} else {
 		// Ensure SKU is uppercase and trimmed
 		input.SKU = strings.TrimSpace(strings.ToUpper(input.SKU))
@@ -141,6 +242,7 @@ func (s *ProductService) CreateProduct(ctx context.Context, input *domain.Produc
 	now := time.Now()
 	input.CreatedAt = now
 	input.UpdatedAt = now
+	input.UpdatedBy = input.CreatedBy
 
 	// Set default values
 	if input.Currency == "" {
@@ -154,6 +256,7 @@ func (s *ProductService) CreateProduct(ctx context.Context, input *domain.Produc
 	if input.ImageURLs == nil {
 		input.ImageURLs = []string{}
 	}
+	input.NormalizeImages()
 	if input.VideoURLs == nil {
 		input.VideoURLs = []string{}
 	}
@@ -179,30 +282,63 @@ func (s *ProductService) CreateProduct(ctx context.Context, input *domain.Produc
 			zap.Error(err))
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
+	if err := s.prices.NormalizeProduct(input); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if err := s.checkAttributes(ctx, input); err != nil {
+		return nil, err
+	}
+
+	// A requested primary location must exist; the default location is
+	// checked once at startup
+	if locationID == "" {
+		locationID = s.defaultLocationID
+	} else if err := s.CheckLocation(ctx, locationID); err != nil {
+		if errors.Is(err, domain.ErrLocationNotFound) {
+			return nil, err
+		}
+		// The inventory row below will fail as well; keep the product
+		s.logger.Warn("Could not verify primary location",
+			zap.String("location_id", locationID),
+			zap.Error(err))
+	}
 
 	// Rely on MongoDB unique indexes for duplicate SKU / barcode detection.
 	// Attempting a pre-check with text search fails before the collection and its text index exist.
 	// Duplicate errors will surface as mongo.IsDuplicateKeyError during the insert below.
 
-	// Create the product
+	// Create the product. A generated SKU can still collide with one entered
+	// by hand, so generate a new one and try again.
 	product, err := s.repo.Create(ctx, input)
+	for attempt := 1; generatedSKU && errors.Is(err, domain.ErrSKUAlreadyExists) && attempt < maxSKUAttempts; attempt++ {
+		s.logger.Warn("Generated SKU already exists, retrying",
+			zap.String("sku", input.SKU),
+			zap.Int("attempt", attempt))
+		if input.SKU, err = s.generateSKU(ctx, input, supplier.Name); err != nil {
+			return nil, fmt.Errorf("failed to generate SKU: %w", err)
+		}
+		product, err = s.repo.Create(ctx, input)
+	}
 	if err != nil {
 		s.logger.Error("Failed to create product", 
 			zap.String("sku", input.SKU), 
 			zap.Error(err))
 		return nil, fmt.Errorf("failed to create product: %w", err)
 	}
+	s.updateCategoryCounts(ctx, nil, product.CategoryIDs)
 
 	// Create inventory item for the product using client abstraction
-	_, err = s.inventoryClient.CreateInventory(ctx, product.ID.Hex(), product.SKU, "default", 0)
-	if err != nil {
+	if err := s.createInventoryWithRetry(ctx, product, locationID); err != nil {
 		s.logger.Error("Failed to create inventory item", 
 			zap.String("product_id", product.ID.Hex()),
+			zap.String("location_id", locationID),
 			zap.Error(err))
-		// Don't fail product creation if inventory creation fails
-		// Log the error and continue
+		// Don't fail product creation if inventory creation fails; the
+		// inventory reconciler creates the row later
 	}
 
+	s.probeImages(product)
+
 	s.logger.Info("Product created successfully", 
 		zap.String("id", product.ID.Hex()),
 		zap.String("sku", product.SKU))
@@ -225,7 +361,38 @@ func (s *ProductService) GetProduct(ctx context.Context, id string) (*domain.Pro
 	return product, nil
 }
 
-// UpdateProduct updates an existing product
+// BatchGetProducts retrieves several products at once. IDs that do not match
+// a live product are returned in missing, in request order and without
+// duplicates, so callers can tell exactly which references are invalid.
+func (s *ProductService) BatchGetProducts(ctx context.Context, ids []string) ([]*domain.Product, []string, error) {
+	if len(ids) == 0 {
+		return nil, nil, fmt.Errorf("%w: at least one product ID is required", domain.ErrValidation)
+	}
+
+	products, err := s.repo.GetByIDs(ctx, ids)
+	if err != nil {
+		s.logger.Error("Failed to batch get products", zap.Int("count", len(ids)), zap.Error(err))
+		return nil, nil, err
+	}
+
+	found := make(map[string]bool, len(products))
+	for _, p := range products {
+		found[p.ID.Hex()] = true
+	}
+	var missing []string
+	for _, id := range ids {
+		if !found[id] {
+			missing = append(missing, id)
+			found[id] = true
+		}
+	}
+
+	return products, missing, nil
+}
+
+// UpdateProduct updates an existing product. The save fails with
+// domain.ErrConflict when input names a version other than the stored one, or
+// when the product changes between being read and saved.
 func (s *ProductService) UpdateProduct(ctx context.Context, input *domain.Product) error {
 	if input == nil || input.ID.IsZero() {
 		return fmt.Errorf("invalid product")
@@ -241,6 +408,12 @@ func (s *ProductService) UpdateProduct(ctx context.Context, input *domain.Produc
 			zap.Error(err))
 		return fmt.Errorf("failed to get product: %w", err)
 	}
+	// A caller that names the version it edited must still be editing the
+	// current one
+	if input.Version != 0 && input.Version != existing.Version {
+		return fmt.Errorf("%w: product %s is at version %d, not %d", domain.ErrConflict, id, existing.Version, input.Version)
+	}
+	previousCategoryIDs := existing.CategoryIDs
 
 	// If supplier ID is being updated, validate the new supplier exists
 	if input.SupplierID != "" && input.SupplierID != existing.SupplierID {
@@ -261,6 +434,7 @@ func (s *ProductService) UpdateProduct(ctx context.Context, input *domain.Produc
 	// Preserve immutable fields
 	input.ID = existing.ID
 	input.CreatedAt = existing.CreatedAt
+	input.CreatedBy = existing.CreatedBy
 
 	// Update timestamps
 	input.UpdatedAt = time.Now()
@@ -275,6 +449,12 @@ func (s *ProductService) UpdateProduct(ctx context.Context, input *domain.Produc
 			zap.Error(err))
 		return fmt.Errorf("validation failed: %w", err)
 	}
+	if err := s.prices.NormalizeProduct(input); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	if err := s.checkAttributes(ctx, input); err != nil {
+		return err
+	}
 
 	// Check for duplicate SKU or barcode if they are being updated
 	if input.SKU != existing.SKU || input.Barcode != existing.Barcode {
@@ -327,9 +507,14 @@ func (s *ProductService) UpdateProduct(ctx context.Context, input *domain.Produc
 	existing.Barcode = input.Barcode
 	existing.CategoryIDs = input.CategoryIDs
 	existing.IsActive = input.IsActive
+	images := mergeImages(existing, input)
+	existing.KeepImageMetadata(images)
+	existing.Images = images
 	existing.ImageURLs = input.ImageURLs
+	existing.NormalizeImages()
 	existing.VideoURLs = input.VideoURLs
 	existing.Metadata = input.Metadata
+	existing.UpdatedBy = input.UpdatedBy
 
 	// Update timestamps
 	existing.UpdatedAt = now
@@ -342,6 +527,8 @@ func (s *ProductService) UpdateProduct(ctx context.Context, input *domain.Produc
 			zap.Error(err))
 		return fmt.Errorf("failed to update product: %w", err)
 	}
+	s.updateCategoryCounts(ctx, previousCategoryIDs, existing.CategoryIDs)
+	s.probeImages(existing)
 
 	s.logger.Info("Product updated successfully", 
 		zap.String("id", id),
@@ -357,7 +544,7 @@ func (s *ProductService) DeleteProduct(ctx context.Context, id string) error {
 	}
 
 	// Check if product exists
-	_, err := s.repo.GetByID(ctx, id)
+	product, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		s.logger.Error("Failed to get product for deletion", 
 			zap.String("id", id), 
@@ -373,6 +560,7 @@ func (s *ProductService) DeleteProduct(ctx context.Context, id string) error {
 			zap.Error(err))
 		return fmt.Errorf("failed to delete product: %w", err)
 	}
+	s.updateCategoryCounts(ctx, product.CategoryIDs, nil)
 
 	s.logger.Info("Product soft deleted successfully", 
 		zap.String("id", id))
@@ -434,12 +622,81 @@ func (s *ProductService) SoftDeleteProduct(ctx context.Context, id string) error
 	}
 
 	// Check if product exists
-	_, err := s.repo.GetByID(ctx, id)
+	product, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
 
-	return s.repo.SoftDelete(ctx, id)
+	if err := s.repo.SoftDelete(ctx, id); err != nil {
+		return err
+	}
+	s.updateCategoryCounts(ctx, product.CategoryIDs, nil)
+	return nil
+}
+
+// checkAttributes validates the metadata of a product against the attribute
+// schemas of its categories. Categories that no longer exist have no schema
+// to check against.
+func (s *ProductService) checkAttributes(ctx context.Context, product *domain.Product) error {
+	categories := make([]*domain.Category, 0, len(product.CategoryIDs))
+	for _, id := range product.CategoryIDs {
+		category, err := s.categories.GetByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) || errors.Is(err, domain.ErrInvalidID) {
+				continue
+			}
+			return fmt.Errorf("failed to get category %s: %w", id, err)
+		}
+		categories = append(categories, category)
+	}
+
+	if err := domain.ValidateAttributes(product.Metadata, categories); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	return nil
+}
+
+// updateCategoryCounts moves a product's contribution to the category product
+// counts from the categories it was in to the ones it is in now. The product
+// change is already saved, so a failure here is only logged; the periodic
+// reconciliation corrects the drift.
+func (s *ProductService) updateCategoryCounts(ctx context.Context, before, after []string) {
+	removed, added := diffCategoryIDs(before, after)
+	if len(removed) > 0 {
+		if err := s.categories.AdjustProductCounts(ctx, removed, -1); err != nil {
+			s.logger.Warn("Failed to decrement category product counts",
+				zap.Strings("category_ids", removed),
+				zap.Error(err))
+		}
+	}
+	if len(added) > 0 {
+		if err := s.categories.AdjustProductCounts(ctx, added, 1); err != nil {
+			s.logger.Warn("Failed to increment category product counts",
+				zap.Strings("category_ids", added),
+				zap.Error(err))
+		}
+	}
+}
+
+// diffCategoryIDs returns the distinct IDs only in before and only in after
+func diffCategoryIDs(before, after []string) (removed, added []string) {
+	inBefore := make(map[string]bool, len(before))
+	for _, id := range before {
+		inBefore[id] = true
+	}
+	inAfter := make(map[string]bool, len(after))
+	for _, id := range after {
+		if !inAfter[id] && !inBefore[id] {
+			added = append(added, id)
+		}
+		inAfter[id] = true
+	}
+	for id := range inBefore {
+		if !inAfter[id] {
+			removed = append(removed, id)
+		}
+	}
+	return removed, added
 }
 
 // UpdateProductStock updates the stock quantity for a product
@@ -514,8 +771,12 @@ func (s *ProductService) UpdateProductPricing(ctx context.Context, id string, co
 	}
 
 	// Update prices
-	product.CostPrice = costPrice
-	product.SellingPrice = sellingPrice
+	if product.CostPrice, err = s.prices.Normalize(costPrice, product.Currency); err != nil {
+		return fmt.Errorf("invalid cost price: %w", err)
+	}
+	if product.SellingPrice, err = s.prices.Normalize(sellingPrice, product.Currency); err != nil {
+		return fmt.Errorf("invalid selling price: %w", err)
+	}
 
 	return s.repo.Update(ctx, product)
 }
@@ -585,6 +846,42 @@ func (s *ProductService) CalculateMarkup(productID string) (decimal.Decimal, dec
 	return domain.CalculateMarkup(costPrice, sellingPrice)
 }
 
+// GetVariant retrieves a single variant of a product
+func (s *ProductService) GetVariant(ctx context.Context, productID, variantID string) (*domain.Variant, error) {
+	if productID == "" {
+		return nil, domain.ErrInvalidID
+	}
+	if variantID == "" {
+		return nil, fmt.Errorf("%w: variant ID is required", domain.ErrValidation)
+	}
+
+	product, err := s.repo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range product.Variants {
+		if product.Variants[i].ID == variantID {
+			return &product.Variants[i], nil
+		}
+	}
+	return nil, domain.ErrVariantNotFound
+}
+
+// ListVariants retrieves the variants of a product in the order they were added
+func (s *ProductService) ListVariants(ctx context.Context, productID string) ([]domain.Variant, error) {
+	if productID == "" {
+		return nil, domain.ErrInvalidID
+	}
+
+	product, err := s.repo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	return product.Variants, nil
+}
+
 // AddVariant adds a variant to a product
 func (s *ProductService) AddVariant(ctx context.Context, productID string, variant *domain.Variant) error {
 	if productID == "" {
@@ -605,6 +902,10 @@ func (s *ProductService) AddVariant(ctx context.Context, productID string, varia
 		product.Variants = []domain.Variant{}
 	}
 
+	if err := s.prices.NormalizeOptions(variant.Options, product.Currency); err != nil {
+		return err
+	}
+
 	// Set timestamps
 	now := time.Now()
 	variant.ID = primitive.NewObjectID().Hex()
@@ -629,6 +930,10 @@ func (s *ProductService) UpdateVariant(ctx context.Context, productID string, va
 		return err
 	}
 
+	if err := s.prices.NormalizeOptions(variant.Options, product.Currency); err != nil {
+		return err
+	}
+
 	// Find and update variant
 	found := false
 	for i, v := range product.Variants {
@@ -712,6 +1017,93 @@ func (s *ProductService) UpdateVariantStock(ctx context.Context, productID, vari
 	return s.repo.Update(ctx, product)
 }
 
+// ReorderProductImages changes the display order of a product's images
+func (s *ProductService) ReorderProductImages(ctx context.Context, productID string, imageURLs []string) (*domain.Product, error) {
+	if productID == "" {
+		return nil, domain.ErrInvalidID
+	}
+
+	product, err := s.repo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := product.ReorderImages(imageURLs); err != nil {
+		return nil, err
+	}
+	product.UpdatedAt = time.Now()
+
+	if err := s.repo.Update(ctx, product); err != nil {
+		s.logger.Error("Failed to reorder product images",
+			zap.String("id", productID),
+			zap.Error(err))
+		return nil, fmt.Errorf("failed to reorder product images: %w", err)
+	}
+
+	return product, nil
+}
+
+// SetPrimaryProductImage selects the image used as a product's thumbnail
+func (s *ProductService) SetPrimaryProductImage(ctx context.Context, productID, imageURL string) (*domain.Product, error) {
+	if productID == "" {
+		return nil, domain.ErrInvalidID
+	}
+	if imageURL == "" {
+		return nil, domain.ErrImageURLRequired
+	}
+
+	product, err := s.repo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := product.SetPrimaryImage(imageURL); err != nil {
+		return nil, err
+	}
+	product.UpdatedAt = time.Now()
+
+	if err := s.repo.Update(ctx, product); err != nil {
+		s.logger.Error("Failed to set primary product image",
+			zap.String("id", productID),
+			zap.Error(err))
+		return nil, fmt.Errorf("failed to set primary product image: %w", err)
+	}
+
+	return product, nil
+}
+
+// probeImages queues a metadata probe for every image of a product that has
+// none yet, which also retries images whose earlier probe failed
+func (s *ProductService) probeImages(product *domain.Product) {
+	if s.mediaProbes == nil {
+		return
+	}
+	for _, url := range product.UnprobedImages() {
+		s.mediaProbes.Enqueue(product.ID.Hex(), url)
+	}
+}
+
+// mergeImages resolves the image list for an update. Explicit images win;
+// a plain URL list keeps the current primary image when it is still present.
+func mergeImages(existing, input *domain.Product) []domain.ProductImage {
+	if len(input.Images) > 0 {
+		return input.Images
+	}
+
+	images := domain.NewProductImages(input.ImageURLs)
+	if current := existing.PrimaryImage(); current != nil {
+		for i := range images {
+			if images[i].URL == current.URL {
+				for j := range images {
+					images[j].IsPrimary = j == i
+				}
+				break
+			}
+		}
+	}
+	return images
+}
+
 // BulkUpdateProductVisibility updates visibility for multiple products
 func (s *ProductService) BulkUpdateProductVisibility(ctx context.Context, supplierID string, productIDs []string, isVisible bool) error {
 	if supplierID == "" {
@@ -742,7 +1134,8 @@ func (s *ProductService) PublishProducts(ctx context.Context, productIDs []strin
 	return s.repo.PublishProducts(ctx, productIDs, publish)
 }
 
-// SearchProducts searches for products by query
+// SearchProducts searches for products by query. Short and stop-word-only
+// queries fall back to prefix matching on name and SKU (see SearchPolicy).
 func (s *ProductService) SearchProducts(
 	ctx context.Context,
 	query string,
@@ -751,32 +1144,47 @@ func (s *ProductService) SearchProducts(
 	if query == "" {
 		return s.List(ctx, opts)
 	}
-	return s.repo.Search(ctx, query, opts)
+
+	filter := domain.ProductFilter{}
+	searchOpts := &domain.ListOptions{Filter: &filter}
+	if opts != nil {
+		if opts.Filter != nil {
+			filter = *opts.Filter
+		}
+		searchOpts.Pagination = opts.Pagination
+		searchOpts.Sort = opts.Sort
+	}
+	filter.SearchTerm = query
+	s.search.Apply(&filter)
+
+	if filter.NamePrefix != "" {
+		s.logger.Debug("Search query matched by prefix",
+			zap.String("query", query),
+			zap.Int("min_query_length", s.search.MinQueryLength),
+		)
+	}
+	return s.List(ctx, searchOpts)
 }
 
-// GetProductBySKU retrieves a product by SKU
+// GetProductBySKU retrieves a product by its exact SKU. The SKU is matched
+// case-insensitively, the way it is normalized when products are saved.
 func (s *ProductService) GetProductBySKU(ctx context.Context, sku string) (*domain.Product, error) {
+	sku = strings.TrimSpace(strings.ToUpper(sku))
 	if sku == "" {
 		return nil, fmt.Errorf("SKU is required")
 	}
-	products, _, err := s.repo.Search(ctx, sku, &domain.ListOptions{
-		Pagination: &domain.Pagination{
-			Page:     1,
-			PageSize: 1,
-		},
-	})
-	if err != nil {
-		s.logger.Error("Failed to search product by SKU",
-			zap.String("sku", sku),
-			zap.Error(err))
-		return nil, fmt.Errorf("failed to search product by SKU: %w", err)
-	}
 
-	if len(products) == 0 {
-		return nil, domain.ErrProductNotFound
+	product, err := s.repo.GetBySKU(ctx, sku)
+	if err != nil {
+		if !errors.Is(err, domain.ErrNotFound) {
+			s.logger.Error("Failed to get product by SKU",
+				zap.String("sku", sku),
+				zap.Error(err))
+		}
+		return nil, err
 	}
 
-	return products[0], nil
+	return product, nil
 }
 
 // GetProductByBarcode retrieves a product by barcode
@@ -806,7 +1214,8 @@ func (s *ProductService) GetProductByBarcode(ctx context.Context, barcode string
 	return products[0], nil
 }
 
-// GetProductsBySupplier retrieves products by supplier ID
+// GetProductsBySupplier retrieves products by supplier ID. Unlike writes, it
+// still lists the products when the supplier service cannot be reached.
 func (s *ProductService) GetProductsBySupplier(
 	ctx context.Context,
 	supplierID string,
@@ -816,13 +1225,19 @@ func (s *ProductService) GetProductsBySupplier(
 		return nil, 0, domain.ErrSupplierRequired
 	}
 
-	// Check if supplier exists using client abstraction
+	// Listing is a read path, so an unreachable supplier service must not stop
+	// customers from browsing; only a definite "not found" is reported
 	_, err := s.supplierClient.GetSupplier(ctx, supplierID)
 	if err != nil {
-		s.logger.Error("Invalid supplier ID",
+		if status.Code(err) == codes.NotFound {
+			s.logger.Error("Invalid supplier ID",
+				zap.String("supplierID", supplierID),
+				zap.Error(err))
+			return nil, 0, domain.ErrSupplierNotFound
+		}
+		s.logger.Warn("Supplier service unavailable, listing products without supplier validation",
 			zap.String("supplierID", supplierID),
 			zap.Error(err))
-		return nil, 0, domain.ErrSupplierNotFound
 	}
 
 	return s.repo.GetBySupplier(ctx, supplierID, opts)
@@ -881,6 +1296,16 @@ func (s *ProductService) ValidateProduct(p *domain.Product) error {
 		return fmt.Errorf("currency must be a 3-letter ISO 4217 code")
 	}
 
+	if err := p.ValidateImages(); err != nil {
+		return err
+	}
+	if err := s.media.CheckProduct(p); err != nil {
+		return err
+	}
+	if err := p.ValidateOrderQuantities(); err != nil {
+		return err
+	}
+
 	// Validate variants
 	variantNames := make(map[string]bool)
 	for i, variant := range p.Variants {
@@ -928,10 +1353,116 @@ func (s *ProductService) ValidateProduct(p *domain.Product) error {
 	return nil
 }
 
-// GenerateProductReport generates a report for products
-func (s *ProductService) GenerateProductReport(ctx context.Context, format string) ([]byte, error) {
-	// This is a placeholder implementation
-	// In a real implementation, this would generate a report in the specified format (e.g., CSV, PDF, Excel)
-	// containing product details, stock levels, etc.
-	return []byte("Product report in " + format + " format"), nil
+// GenerateProductReport generates a report of the products matching filter,
+// oldest first. Only the "csv" format is supported; other formats fail with
+// ErrUnsupportedReportFormat. Soft-deleted products are left out. Supplier
+// callers only get their own products: the filter is scoped to their
+// supplier, and asking for another supplier's products fails with
+// ErrSupplierScope.
+func (s *ProductService) GenerateProductReport(ctx context.Context, format string, filter *domain.ProductFilter, caller domain.Caller) ([]byte, error) {
+	if !strings.EqualFold(format, "csv") {
+		return nil, fmt.Errorf("%w, got %q", domain.ErrUnsupportedReportFormat, format)
+	}
+
+	scoped, err := scopeFilterToCaller(filter, caller)
+	if err != nil {
+		s.logger.Warn("Rejected product report outside caller's supplier",
+			zap.String("caller_supplier_id", caller.SupplierID),
+		)
+		return nil, err
+	}
+	scoped.IncludeDeleted = false
+
+	var report bytes.Buffer
+	writer := csv.NewWriter(&report)
+	if err := writer.Write(productReportHeader); err != nil {
+		return nil, fmt.Errorf("failed to write product report: %w", err)
+	}
+
+	opts := &domain.ListOptions{
+		Filter: scoped,
+		Sort:   &domain.SortOption{Field: domain.SortFieldCreatedAt, Order: domain.SortOrderAsc},
+	}
+	rows := 0
+	err = s.StreamProducts(ctx, opts, func(p *domain.Product) error {
+		rows++
+		return writer.Write([]string{
+			p.SKU,
+			p.Name,
+			p.CostPrice,
+			p.SellingPrice,
+			p.Currency,
+			strings.Join(p.CategoryIDs, ";"),
+			p.SupplierID,
+			strconv.FormatBool(p.IsActive),
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate product report: %w", err)
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, fmt.Errorf("failed to write product report: %w", err)
+	}
+
+	s.logger.Info("Generated product report",
+		zap.String("format", format),
+		zap.Int("products", rows),
+	)
+	return report.Bytes(), nil
+}
+
+// productReportHeader is the header row of CSV product reports. Category IDs
+// are joined with semicolons.
+var productReportHeader = []string{"SKU", "Name", "Cost Price", "Selling Price", "Currency", "Category IDs", "Supplier ID", "Active"}
+
+// scopeFilterToCaller returns a copy of filter that a supplier caller is
+// limited to their own products in. Other callers get the filter unchanged.
+func scopeFilterToCaller(filter *domain.ProductFilter, caller domain.Caller) (*domain.ProductFilter, error) {
+	scoped := domain.ProductFilter{}
+	if filter != nil {
+		scoped = *filter
+	}
+	if !caller.IsSupplier() {
+		return &scoped, nil
+	}
+
+	if caller.SupplierID == "" {
+		return nil, domain.ErrSupplierScope
+	}
+	if scoped.SupplierID != "" && scoped.SupplierID != caller.SupplierID {
+		return nil, domain.ErrSupplierScope
+	}
+	scoped.SupplierID = caller.SupplierID
+	return &scoped, nil
+}
+
+// ReassignSupplierProducts moves every product of one supplier to another,
+// e.g. before the first supplier is deleted
+func (s *ProductService) ReassignSupplierProducts(ctx context.Context, fromSupplierID, toSupplierID string) (int64, error) {
+	if fromSupplierID == "" || toSupplierID == "" {
+		return 0, fmt.Errorf("%w: both supplier IDs are required", domain.ErrValidation)
+	}
+	if fromSupplierID == toSupplierID {
+		return 0, fmt.Errorf("%w: cannot reassign products to the same supplier", domain.ErrValidation)
+	}
+
+	s.logger.Info("Reassigning supplier products",
+		zap.String("from_supplier_id", fromSupplierID),
+		zap.String("to_supplier_id", toSupplierID),
+	)
+	return s.repo.ReassignSupplier(ctx, fromSupplierID, toSupplierID)
+}
+
+// RebuildSearchIndex recreates the product text index and reports how many products it covers
+func (s *ProductService) RebuildSearchIndex(ctx context.Context) (int64, error) {
+	s.logger.Info("Rebuilding product search index")
+
+	count, err := s.repo.RebuildSearchIndex(ctx)
+	if err != nil {
+		s.logger.Error("Failed to rebuild product search index", zap.Error(err))
+		return 0, fmt.Errorf("failed to rebuild search index: %w", err)
+	}
+
+	return count, nil
 }
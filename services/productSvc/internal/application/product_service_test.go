@@ -0,0 +1,265 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inventoryv1 "github.com/leonvanderhaeghen/stockplatform/services/inventorySvc/api/gen/go/proto/inventory/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+const testDefaultLocation = "warehouse-main"
+
+// recordingInventoryBackend records the inventory rows it is asked to create
+// and knows the locations in locations
+type recordingInventoryBackend struct {
+	inventoryv1.UnimplementedInventoryServiceServer
+	mu        sync.Mutex
+	locations map[string]bool
+	created   []*inventoryv1.CreateInventoryRequest
+}
+
+func (b *recordingInventoryBackend) CreateInventory(ctx context.Context, req *inventoryv1.CreateInventoryRequest) (*inventoryv1.CreateInventoryResponse, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.created = append(b.created, req)
+	return &inventoryv1.CreateInventoryResponse{Inventory: &inventoryv1.InventoryItem{
+		Id:         "inventory-1",
+		ProductId:  req.GetProductId(),
+		Sku:        req.GetSku(),
+		LocationId: req.GetLocationId(),
+	}}, nil
+}
+
+func (b *recordingInventoryBackend) GetLocation(ctx context.Context, req *inventoryv1.GetLocationRequest) (*inventoryv1.GetLocationResponse, error) {
+	if !b.locations[req.GetId()] {
+		return nil, status.Error(codes.NotFound, "location not found")
+	}
+	return &inventoryv1.GetLocationResponse{Location: &inventoryv1.StoreLocation{Id: req.GetId()}}, nil
+}
+
+func (b *recordingInventoryBackend) createdLocations() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	locations := make([]string, 0, len(b.created))
+	for _, req := range b.created {
+		locations = append(locations, req.GetLocationId())
+	}
+	return locations
+}
+
+// newTestProductService returns a product service over repo and categories,
+// which may be nil when no product has categories, whose inventory and
+// supplier clients talk to in-memory backends
+func newTestProductService(t *testing.T, repo domain.ProductRepository, categories domain.CategoryRepository, inventory *recordingInventoryBackend) *ProductService {
+	t.Helper()
+	return NewProductService(repo, categories, newSupplierClient(t, stubSupplierBackend{}), newInventoryClient(t, inventory),
+		testDefaultLocation, "", nil, domain.SearchPolicy{}, domain.SortOption{}, domain.MediaPolicy{},
+		domain.NewPricePolicy(nil), nil, domain.RetryPolicy{}, nil, zap.NewNop())
+}
+
+func newTestProduct(sku string) *domain.Product {
+	return &domain.Product{
+		Name:         "Desk lamp",
+		SKU:          sku,
+		SellingPrice: "29.99",
+		Currency:     "EUR",
+		SupplierID:   "supplier-1",
+	}
+}
+
+func TestCreateProductStocksAtDefaultLocation(t *testing.T) {
+	inventory := &recordingInventoryBackend{}
+	service := newTestProductService(t, newMemoryProductRepository(), nil, inventory)
+
+	product, err := service.CreateProduct(context.Background(), newTestProduct("LAMP-1"), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := inventory.createdLocations()
+	if len(got) != 1 || got[0] != testDefaultLocation {
+		t.Fatalf("inventory rows created at %v, want [%s]", got, testDefaultLocation)
+	}
+	if inventory.created[0].GetProductId() != product.ID.Hex() {
+		t.Fatalf("inventory row is for product %s, want %s", inventory.created[0].GetProductId(), product.ID.Hex())
+	}
+}
+
+func TestCreateProductStocksAtRequestedLocation(t *testing.T) {
+	inventory := &recordingInventoryBackend{locations: map[string]bool{"store-7": true}}
+	service := newTestProductService(t, newMemoryProductRepository(), nil, inventory)
+
+	if _, err := service.CreateProduct(context.Background(), newTestProduct("LAMP-2"), "store-7"); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := inventory.createdLocations(); len(got) != 1 || got[0] != "store-7" {
+		t.Fatalf("inventory rows created at %v, want [store-7]", got)
+	}
+}
+
+func TestCreateProductRejectsUnknownLocation(t *testing.T) {
+	inventory := &recordingInventoryBackend{}
+	repo := newMemoryProductRepository()
+	service := newTestProductService(t, repo, nil, inventory)
+
+	_, err := service.CreateProduct(context.Background(), newTestProduct("LAMP-3"), "nowhere")
+	if !errors.Is(err, domain.ErrLocationNotFound) {
+		t.Fatalf("err = %v, want ErrLocationNotFound", err)
+	}
+	if len(repo.products) != 0 || len(inventory.createdLocations()) != 0 {
+		t.Fatal("nothing should be created for an unknown location")
+	}
+}
+
+func TestProductCreatorIsKeptAcrossUpdates(t *testing.T) {
+	repo := newMemoryProductRepository()
+	service := newTestProductService(t, repo, nil, &recordingInventoryBackend{})
+
+	input := newTestProduct("LAMP-4")
+	input.CreatedBy = "staff-1"
+	product, err := service.CreateProduct(context.Background(), input, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if product.CreatedBy != "staff-1" || product.UpdatedBy != "staff-1" {
+		t.Fatalf("created by %q, updated by %q, want staff-1 for both", product.CreatedBy, product.UpdatedBy)
+	}
+
+	edit := newTestProduct("LAMP-4")
+	edit.ID = product.ID
+	edit.CreatedBy = "staff-2"
+	edit.UpdatedBy = "staff-2"
+	if err := service.UpdateProduct(context.Background(), edit); err != nil {
+		t.Fatal(err)
+	}
+
+	stored, err := repo.GetByID(context.Background(), product.ID.Hex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.CreatedBy != "staff-1" {
+		t.Errorf("created by = %q, should stay staff-1", stored.CreatedBy)
+	}
+	if stored.UpdatedBy != "staff-2" {
+		t.Errorf("updated by = %q, want staff-2", stored.UpdatedBy)
+	}
+}
+
+func TestUpdateProductRejectsStaleVersion(t *testing.T) {
+	repo := newMemoryProductRepository()
+	service := newTestProductService(t, repo, nil, &recordingInventoryBackend{})
+	product, err := service.CreateProduct(context.Background(), newTestProduct("LAMP-5"), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Two editors read version 1; the first one to save wins
+	first := newTestProduct("LAMP-5")
+	first.ID, first.Version, first.Name = product.ID, product.Version, "Desk lamp, brass"
+	if err := service.UpdateProduct(context.Background(), first); err != nil {
+		t.Fatal(err)
+	}
+	second := newTestProduct("LAMP-5")
+	second.ID, second.Version, second.Name = product.ID, product.Version, "Desk lamp, steel"
+	if err := service.UpdateProduct(context.Background(), second); !errors.Is(err, domain.ErrConflict) {
+		t.Fatalf("err = %v, want ErrConflict for the stale version", err)
+	}
+
+	stored, err := repo.GetByID(context.Background(), product.ID.Hex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Name != "Desk lamp, brass" || stored.Version != product.Version+1 {
+		t.Fatalf("stored %q at version %d, want the first edit at version %d", stored.Name, stored.Version, product.Version+1)
+	}
+}
+
+// racingRepository has another editor save each product right after it is read
+type racingRepository struct {
+	*memoryProductRepository
+}
+
+func (r *racingRepository) GetByID(ctx context.Context, id string) (*domain.Product, error) {
+	product, err := r.memoryProductRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	r.products[product.ID].Version++
+	r.mu.Unlock()
+	return product, nil
+}
+
+func TestUpdateProductConflictsWhenChangedWhileSaving(t *testing.T) {
+	product := newTestProduct("LAMP-6")
+	product.ID = primitive.NewObjectID()
+	product.Version = 3
+	repo := &racingRepository{memoryProductRepository: newMemoryProductRepository(product)}
+	service := newTestProductService(t, repo, nil, &recordingInventoryBackend{})
+
+	edit := newTestProduct("LAMP-6")
+	edit.ID = product.ID
+	edit.Name = "Desk lamp, brass"
+
+	if err := service.UpdateProduct(context.Background(), edit); !errors.Is(err, domain.ErrConflict) {
+		t.Fatalf("err = %v, want ErrConflict even without a version in the edit", err)
+	}
+}
+
+func TestCreateProductChecksMediaHosts(t *testing.T) {
+	repo := newMemoryProductRepository()
+	service := NewProductService(repo, nil, newSupplierClient(t, stubSupplierBackend{}), newInventoryClient(t, &recordingInventoryBackend{}),
+		testDefaultLocation, "", nil, domain.SearchPolicy{}, domain.SortOption{}, domain.NewMediaPolicy([]string{"images.example.com"}),
+		domain.NewPricePolicy(nil), nil, domain.RetryPolicy{}, nil, zap.NewNop())
+
+	allowed := newTestProduct("LAMP-MEDIA-1")
+	allowed.ImageURLs = []string{"https://images.example.com/lamp.jpg"}
+	if _, err := service.CreateProduct(context.Background(), allowed, ""); err != nil {
+		t.Fatalf("CreateProduct = %v, want media on an allowed host accepted", err)
+	}
+
+	disallowed := newTestProduct("LAMP-MEDIA-2")
+	disallowed.ImageURLs = []string{"https://evil.example.org/lamp.jpg"}
+	_, err := service.CreateProduct(context.Background(), disallowed, "")
+	if !errors.Is(err, domain.ErrMediaHostNotAllowed) {
+		t.Fatalf("CreateProduct = %v, want ErrMediaHostNotAllowed", err)
+	}
+	if len(repo.products) != 1 {
+		t.Fatalf("stored products = %d, want only the allowed one", len(repo.products))
+	}
+}
+
+func TestCreateProductNormalizesPrices(t *testing.T) {
+	repo := newMemoryProductRepository()
+	service := newTestProductService(t, repo, nil, &recordingInventoryBackend{})
+
+	input := newTestProduct("LAMP-PRICE-1")
+	input.Currency = "USD"
+	input.SellingPrice = "9.9"
+	product, err := service.CreateProduct(context.Background(), input, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if product.SellingPrice != "9.90" {
+		t.Fatalf("selling price = %q, want 9.90", product.SellingPrice)
+	}
+
+	input = newTestProduct("LAMP-PRICE-2")
+	input.Currency = "USD"
+	input.SellingPrice = "9.999"
+	if _, err := service.CreateProduct(context.Background(), input, ""); !errors.Is(err, domain.ErrPricePrecision) {
+		t.Fatalf("err = %v, want ErrPricePrecision", err)
+	}
+	if len(repo.products) != 1 {
+		t.Fatalf("stored products = %d, want the rejected one left out", len(repo.products))
+	}
+}
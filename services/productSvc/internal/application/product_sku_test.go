@@ -0,0 +1,81 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+func TestGetProductBySKU(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemoryProductRepository()
+	service := newTestProductService(t, repo, nil, &recordingInventoryBackend{})
+	lamp, err := repo.Create(ctx, newTestProduct("LAMP-01"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A product whose SKU merely contains the one looked up must not match
+	if _, err := repo.Create(ctx, newTestProduct("LAMP-01-XL")); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		sku     string
+		wantErr error
+	}{
+		{name: "found", sku: "LAMP-01"},
+		{name: "lower case", sku: "lamp-01"},
+		{name: "surrounding spaces", sku: "  Lamp-01 "},
+		{name: "not found", sku: "LAMP-02", wantErr: domain.ErrProductNotFound},
+		{name: "prefix of a SKU", sku: "LAMP", wantErr: domain.ErrProductNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product, err := service.GetProductBySKU(ctx, tt.sku)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("GetProductBySKU(%q) error = %v, want %v", tt.sku, err, tt.wantErr)
+				}
+				if product != nil {
+					t.Fatalf("GetProductBySKU(%q) = %+v, want no product", tt.sku, product)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetProductBySKU(%q): %v", tt.sku, err)
+			}
+			if product.ID != lamp.ID {
+				t.Fatalf("GetProductBySKU(%q) = %s, want %s", tt.sku, product.SKU, lamp.SKU)
+			}
+		})
+	}
+}
+
+func TestGetProductBySKUSkipsDeletedProducts(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemoryProductRepository()
+	service := newTestProductService(t, repo, nil, &recordingInventoryBackend{})
+	lamp, err := repo.Create(ctx, newTestProduct("LAMP-01"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.SoftDelete(ctx, lamp.ID.Hex()); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.GetProductBySKU(ctx, "LAMP-01"); !errors.Is(err, domain.ErrProductNotFound) {
+		t.Fatalf("error = %v, want %v", err, domain.ErrProductNotFound)
+	}
+}
+
+func TestGetProductBySKURequiresSKU(t *testing.T) {
+	service := newTestProductService(t, newMemoryProductRepository(), nil, &recordingInventoryBackend{})
+
+	if _, err := service.GetProductBySKU(context.Background(), "  "); err == nil {
+		t.Fatal("GetProductBySKU of a blank SKU succeeded")
+	}
+}
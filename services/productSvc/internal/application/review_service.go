@@ -0,0 +1,131 @@
+package application
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// defaultReviewPageSize and maxReviewPageSize bound a page of reviews
+const (
+	defaultReviewPageSize = 20
+	maxReviewPageSize     = 100
+)
+
+// ReviewService implements product reviews and keeps the rating summary on
+// each product in step with its approved reviews
+type ReviewService struct {
+	reviews   domain.ReviewRepository
+	products  domain.ProductRepository
+	purchases domain.PurchaseVerifier
+	logger    *zap.Logger
+}
+
+// NewReviewService creates a new review service
+func NewReviewService(reviews domain.ReviewRepository, products domain.ProductRepository, purchases domain.PurchaseVerifier, logger *zap.Logger) *ReviewService {
+	return &ReviewService{
+		reviews:   reviews,
+		products:  products,
+		purchases: purchases,
+		logger:    logger.Named("review_service"),
+	}
+}
+
+// CreateReview stores a user's review of a product, waiting for moderation.
+// The review is marked as a verified purchase when the user received the
+// product; when orders cannot be checked it is stored unverified rather than
+// refused. A user can review a product once.
+func (s *ReviewService) CreateReview(ctx context.Context, productID, userID string, rating int32, text string) (*domain.Review, error) {
+	review, err := domain.NewReview(productID, userID, rating, text)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.products.GetByID(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	verified, err := s.purchases.HasPurchased(ctx, userID, productID)
+	if err != nil {
+		s.logger.Warn("Failed to verify purchase; storing review unverified",
+			zap.String("product_id", productID),
+			zap.String("user_id", userID),
+			zap.Error(err),
+		)
+	}
+	review.VerifiedPurchase = verified
+
+	if err := s.reviews.Create(ctx, review); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Review created",
+		zap.String("id", review.ID),
+		zap.String("product_id", productID),
+		zap.Bool("verified_purchase", verified),
+	)
+	return review, nil
+}
+
+// ListReviews returns a page of a product's reviews, newest first, and how
+// many there are in total. Only approved reviews are listed unless
+// includeUnapproved is set.
+func (s *ReviewService) ListReviews(ctx context.Context, productID string, includeUnapproved bool, limit, offset int) ([]*domain.Review, int64, error) {
+	if productID == "" {
+		return nil, 0, domain.ErrInvalidID
+	}
+	if limit <= 0 {
+		limit = defaultReviewPageSize
+	}
+	if limit > maxReviewPageSize {
+		limit = maxReviewPageSize
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	return s.reviews.ListByProduct(ctx, productID, domain.ReviewListOptions{
+		IncludeUnapproved: includeUnapproved,
+		Limit:             limit,
+		Offset:            offset,
+	})
+}
+
+// ModerateReview approves or rejects a review and recomputes the rating of
+// its product, so the product's average and count only ever cover the
+// reviews customers can see
+func (s *ReviewService) ModerateReview(ctx context.Context, reviewID string, approved bool, moderatorID string) (*domain.Review, error) {
+	if reviewID == "" {
+		return nil, domain.ErrInvalidID
+	}
+
+	review, err := s.reviews.SetApproved(ctx, reviewID, approved, moderatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.refreshRating(ctx, review.ProductID); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Review moderated",
+		zap.String("id", reviewID),
+		zap.String("product_id", review.ProductID),
+		zap.Bool("approved", approved),
+		zap.String("moderated_by", moderatorID),
+	)
+	return review, nil
+}
+
+// refreshRating recomputes the product's rating from its approved reviews.
+// Recomputing rather than adjusting the stored average keeps it correct when
+// two reviews are moderated at once.
+func (s *ReviewService) refreshRating(ctx context.Context, productID string) error {
+	summary, err := s.reviews.Summarize(ctx, productID)
+	if err != nil {
+		return err
+	}
+	return s.products.SetRating(ctx, productID, summary)
+}
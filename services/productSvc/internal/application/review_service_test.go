@@ -0,0 +1,263 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// memoryReviewRepository stores reviews in memory and, like the unique index
+// of the MongoDB repository, refuses a second review of a product by a user
+type memoryReviewRepository struct {
+	mu      sync.Mutex
+	reviews []*domain.Review
+}
+
+func (r *memoryReviewRepository) Create(ctx context.Context, review *domain.Review) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, stored := range r.reviews {
+		if stored.ProductID == review.ProductID && stored.UserID == review.UserID {
+			return domain.ErrDuplicateReview
+		}
+	}
+	review.ID = "review-" + strconv.Itoa(len(r.reviews)+1)
+	stored := *review
+	r.reviews = append(r.reviews, &stored)
+	return nil
+}
+
+func (r *memoryReviewRepository) GetByID(ctx context.Context, id string) (*domain.Review, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, stored := range r.reviews {
+		if stored.ID == id {
+			found := *stored
+			return &found, nil
+		}
+	}
+	return nil, domain.ErrReviewNotFound
+}
+
+func (r *memoryReviewRepository) ListByProduct(ctx context.Context, productID string, opts domain.ReviewListOptions) ([]*domain.Review, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var reviews []*domain.Review
+	for _, stored := range r.reviews {
+		if stored.ProductID == productID && (stored.Approved || opts.IncludeUnapproved) {
+			found := *stored
+			reviews = append(reviews, &found)
+		}
+	}
+	return reviews, int64(len(reviews)), nil
+}
+
+func (r *memoryReviewRepository) SetApproved(ctx context.Context, id string, approved bool, moderatedBy string) (*domain.Review, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, stored := range r.reviews {
+		if stored.ID == id {
+			now := time.Now()
+			stored.Approved = approved
+			stored.ModeratedBy = moderatedBy
+			stored.ModeratedAt = &now
+			found := *stored
+			return &found, nil
+		}
+	}
+	return nil, domain.ErrReviewNotFound
+}
+
+func (r *memoryReviewRepository) Summarize(ctx context.Context, productID string) (domain.RatingSummary, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var summary domain.RatingSummary
+	var total int32
+	for _, stored := range r.reviews {
+		if stored.ProductID == productID && stored.Approved {
+			summary.Count++
+			total += stored.Rating
+		}
+	}
+	if summary.Count > 0 {
+		summary.Average = float64(total) / float64(summary.Count)
+	}
+	return summary, nil
+}
+
+// stubPurchaseVerifier reports the purchases in bought, or err
+type stubPurchaseVerifier struct {
+	bought map[string]bool
+	err    error
+}
+
+func (v stubPurchaseVerifier) HasPurchased(ctx context.Context, userID, productID string) (bool, error) {
+	return v.bought[userID+"/"+productID], v.err
+}
+
+// newTestReviewService returns a review service over one product and the
+// repositories it keeps its reviews and rating in
+func newTestReviewService(purchases domain.PurchaseVerifier) (*ReviewService, *memoryReviewRepository, *memoryProductRepository, string) {
+	product := &domain.Product{ID: primitive.NewObjectID(), Name: "Desk lamp", SKU: "LAMP-REVIEW-1"}
+	products := newMemoryProductRepository(product)
+	reviews := &memoryReviewRepository{}
+	return NewReviewService(reviews, products, purchases, zap.NewNop()), reviews, products, product.ID.Hex()
+}
+
+func productRating(t *testing.T, products *memoryProductRepository, productID string) domain.RatingSummary {
+	t.Helper()
+	product, err := products.GetByID(context.Background(), productID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return product.Rating
+}
+
+func TestModerateReviewRecomputesRating(t *testing.T) {
+	service, _, products, productID := newTestReviewService(stubPurchaseVerifier{})
+	ctx := context.Background()
+
+	ids := map[string]string{}
+	for _, r := range []struct {
+		user   string
+		rating int32
+	}{{"user-1", 5}, {"user-2", 4}, {"user-3", 1}} {
+		review, err := service.CreateReview(ctx, productID, r.user, r.rating, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[r.user] = review.ID
+	}
+	if got := productRating(t, products, productID); got.Count != 0 {
+		t.Fatalf("rating = %+v before moderation, want unapproved reviews left out", got)
+	}
+
+	steps := []struct {
+		name        string
+		user        string
+		approve     bool
+		wantAverage float64
+		wantCount   int64
+	}{
+		{name: "first approval", user: "user-1", approve: true, wantAverage: 5, wantCount: 1},
+		{name: "second approval", user: "user-2", approve: true, wantAverage: 4.5, wantCount: 2},
+		{name: "third approval", user: "user-3", approve: true, wantAverage: 10.0 / 3, wantCount: 3},
+		{name: "approved review rejected", user: "user-1", approve: false, wantAverage: 2.5, wantCount: 2},
+		{name: "rejected review approved again", user: "user-1", approve: true, wantAverage: 10.0 / 3, wantCount: 3},
+	}
+	for _, step := range steps {
+		if _, err := service.ModerateReview(ctx, ids[step.user], step.approve, "staff-1"); err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		got := productRating(t, products, productID)
+		if got.Count != step.wantCount || math.Abs(got.Average-step.wantAverage) > 1e-9 {
+			t.Fatalf("%s: rating = %+v, want average %v over %d reviews", step.name, got, step.wantAverage, step.wantCount)
+		}
+	}
+}
+
+func TestModerateUnknownReviewLeavesRating(t *testing.T) {
+	service, _, products, productID := newTestReviewService(stubPurchaseVerifier{})
+
+	_, err := service.ModerateReview(context.Background(), "review-404", true, "staff-1")
+
+	if !errors.Is(err, domain.ErrReviewNotFound) {
+		t.Fatalf("err = %v, want ErrReviewNotFound", err)
+	}
+	if got := productRating(t, products, productID); got != (domain.RatingSummary{}) {
+		t.Fatalf("rating = %+v, want it untouched", got)
+	}
+}
+
+func TestCreateReviewRejectsDuplicate(t *testing.T) {
+	service, reviews, _, productID := newTestReviewService(stubPurchaseVerifier{})
+	ctx := context.Background()
+
+	if _, err := service.CreateReview(ctx, productID, "user-1", 4, "Bright enough"); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := service.CreateReview(ctx, productID, "user-1", 1, "Changed my mind")
+	if !errors.Is(err, domain.ErrDuplicateReview) || !errors.Is(err, domain.ErrAlreadyExists) {
+		t.Fatalf("second review by the same user: err = %v, want ErrDuplicateReview", err)
+	}
+
+	if _, err := service.CreateReview(ctx, productID, "user-2", 1, ""); err != nil {
+		t.Fatalf("review by another user: %v", err)
+	}
+	listed, _, err := service.ListReviews(ctx, productID, true, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listed) != 2 || len(reviews.reviews) != 2 {
+		t.Fatalf("stored %d reviews, want 2", len(reviews.reviews))
+	}
+	if listed[0].Rating != 4 {
+		t.Fatalf("first review rating = %d, want the original 4 kept", listed[0].Rating)
+	}
+}
+
+func TestCreateReviewMarksVerifiedPurchase(t *testing.T) {
+	tests := []struct {
+		name         string
+		bought       bool
+		verifyErr    error
+		wantVerified bool
+	}{
+		{name: "bought", bought: true, wantVerified: true},
+		{name: "not bought"},
+		{name: "orders unavailable", verifyErr: errors.New("order service down")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _, _, productID := newTestReviewService(nil)
+			service.purchases = stubPurchaseVerifier{bought: map[string]bool{"user-1/" + productID: tt.bought}, err: tt.verifyErr}
+
+			review, err := service.CreateReview(context.Background(), productID, "user-1", 5, "")
+			if err != nil {
+				t.Fatalf("err = %v, want the review stored", err)
+			}
+			if review.VerifiedPurchase != tt.wantVerified {
+				t.Fatalf("verified purchase = %v, want %v", review.VerifiedPurchase, tt.wantVerified)
+			}
+			if review.Approved {
+				t.Fatal("new reviews wait for moderation")
+			}
+		})
+	}
+}
+
+func TestCreateReviewValidation(t *testing.T) {
+	service, reviews, _, productID := newTestReviewService(stubPurchaseVerifier{})
+
+	tests := []struct {
+		name      string
+		productID string
+		rating    int32
+		wantErr   error
+	}{
+		{name: "rating too low", productID: productID, rating: 0, wantErr: domain.ErrInvalidRating},
+		{name: "rating too high", productID: productID, rating: 6, wantErr: domain.ErrInvalidRating},
+		{name: "unknown product", productID: primitive.NewObjectID().Hex(), rating: 5, wantErr: domain.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.CreateReview(context.Background(), tt.productID, "user-1", tt.rating, "")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+	if len(reviews.reviews) != 0 {
+		t.Fatalf("stored %d reviews, want none", len(reviews.reviews))
+	}
+}
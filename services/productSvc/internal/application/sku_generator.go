@@ -0,0 +1,82 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+const (
+	// maxSKUAttempts bounds how often CreateProduct regenerates a SKU that
+	// collided with an existing product
+	maxSKUAttempts = 5
+	// skuPrefixLength is the number of characters taken from a category or
+	// supplier name for sequence-based SKUs
+	skuPrefixLength = 3
+	// fallbackSKUPrefix is used when no usable name is available for a prefix
+	fallbackSKUPrefix = "GEN"
+)
+
+// generateSKU creates a SKU for a product created without one, using the
+// configured strategy. supplierName is the name of the product's supplier.
+func (s *ProductService) generateSKU(ctx context.Context, p *domain.Product, supplierName string) (string, error) {
+	switch s.skuStrategy {
+	case domain.SKUStrategyCategory:
+		return s.sequenceSKU(ctx, s.categorySKUPrefix(ctx, p))
+	case domain.SKUStrategySupplier:
+		return s.sequenceSKU(ctx, skuPrefix(supplierName))
+	default:
+		return strings.ToUpper(uuid.New().String()[:8]), nil
+	}
+}
+
+// sequenceSKU appends the next number of prefix's sequence to the prefix
+func (s *ProductService) sequenceSKU(ctx context.Context, prefix string) (string, error) {
+	n, err := s.skuSequence.Next(ctx, prefix)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s%06d", prefix, n), nil
+}
+
+// categorySKUPrefix derives a prefix from the name of the product's first
+// category. A missing category falls back to the generic prefix rather than
+// failing product creation.
+func (s *ProductService) categorySKUPrefix(ctx context.Context, p *domain.Product) string {
+	if len(p.CategoryIDs) == 0 {
+		return fallbackSKUPrefix
+	}
+	category, err := s.categories.GetByID(ctx, p.CategoryIDs[0])
+	if err != nil || category == nil {
+		s.logger.Warn("Could not load category for SKU prefix",
+			zap.String("category_id", p.CategoryIDs[0]),
+			zap.Error(err))
+		return fallbackSKUPrefix
+	}
+	return skuPrefix(category.Name)
+}
+
+// skuPrefix keeps the first letters and digits of name, uppercased, so that
+// the generated SKU stays alphanumeric
+func skuPrefix(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(name) {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			continue
+		}
+		b.WriteRune(r)
+		if b.Len() == skuPrefixLength {
+			break
+		}
+	}
+	if b.Len() == 0 {
+		return fallbackSKUPrefix
+	}
+	return b.String()
+}
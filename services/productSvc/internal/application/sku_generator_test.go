@@ -0,0 +1,130 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"sync"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// memorySKUSequence counts per prefix in memory
+type memorySKUSequence struct {
+	mu     sync.Mutex
+	counts map[string]int64
+}
+
+func newMemorySKUSequence() *memorySKUSequence {
+	return &memorySKUSequence{counts: make(map[string]int64)}
+}
+
+func (s *memorySKUSequence) Next(ctx context.Context, prefix string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counts[prefix]++
+	return s.counts[prefix], nil
+}
+
+func newSKUTestService(t *testing.T, strategy domain.SKUStrategy, repo domain.ProductRepository, categories domain.CategoryRepository) *ProductService {
+	t.Helper()
+	return NewProductService(repo, categories, newSupplierClient(t, stubSupplierBackend{}),
+		newInventoryClient(t, &recordingInventoryBackend{}), testDefaultLocation, strategy, newMemorySKUSequence(),
+		domain.SearchPolicy{}, domain.SortOption{}, domain.MediaPolicy{}, domain.NewPricePolicy(nil),
+		nil, domain.RetryPolicy{}, nil, zap.NewNop())
+}
+
+func TestGenerateSKUStrategies(t *testing.T) {
+	category := &domain.Category{ID: primitive.NewObjectID(), Name: "Home & Garden"}
+
+	tests := []struct {
+		name        string
+		strategy    domain.SKUStrategy
+		categoryIDs []string
+		want        *regexp.Regexp
+	}{
+		{name: "uuid", strategy: domain.SKUStrategyUUID, want: regexp.MustCompile(`^[0-9A-F]{8}$`)},
+		{name: "category", strategy: domain.SKUStrategyCategory, categoryIDs: []string{category.ID.Hex()}, want: regexp.MustCompile(`^HOM00000[12]$`)},
+		{name: "category without categories", strategy: domain.SKUStrategyCategory, want: regexp.MustCompile(`^GEN00000[12]$`)},
+		{name: "supplier", strategy: domain.SKUStrategySupplier, want: regexp.MustCompile(`^ACM00000[12]$`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := newSKUTestService(t, tt.strategy, newMemoryProductRepository(), newMemoryCategoryRepository(category))
+
+			var skus []string
+			for i := 0; i < 2; i++ {
+				input := newTestProduct("")
+				input.CategoryIDs = tt.categoryIDs
+				product, err := service.CreateProduct(context.Background(), input, "")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !tt.want.MatchString(product.SKU) {
+					t.Fatalf("SKU = %q, want %s", product.SKU, tt.want)
+				}
+				skus = append(skus, product.SKU)
+			}
+			if skus[0] == skus[1] {
+				t.Fatalf("both products got SKU %q", skus[0])
+			}
+		})
+	}
+}
+
+func TestCreateProductRetriesCollidingGeneratedSKU(t *testing.T) {
+	// A SKU entered by hand already holds the first number of the sequence
+	taken := newTestProduct("ACM000001")
+	taken.ID = primitive.NewObjectID()
+	repo := newMemoryProductRepository(taken)
+	service := newSKUTestService(t, domain.SKUStrategySupplier, repo, nil)
+
+	product, err := service.CreateProduct(context.Background(), newTestProduct(""), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if product.SKU != "ACM000002" {
+		t.Fatalf("SKU = %q, want the next number ACM000002", product.SKU)
+	}
+}
+
+func TestCreateProductDoesNotRetryEnteredSKU(t *testing.T) {
+	taken := newTestProduct("LAMP-1")
+	taken.ID = primitive.NewObjectID()
+	service := newSKUTestService(t, domain.SKUStrategySupplier, newMemoryProductRepository(taken), nil)
+
+	_, err := service.CreateProduct(context.Background(), newTestProduct("lamp-1"), "")
+	if !errors.Is(err, domain.ErrSKUAlreadyExists) {
+		t.Fatalf("err = %v, want ErrSKUAlreadyExists", err)
+	}
+}
+
+func TestSKUPrefix(t *testing.T) {
+	tests := map[string]string{
+		"Acme":     "ACM",
+		"3M Co":    "3MC",
+		"É-lectro": "LEC",
+		"!!":       fallbackSKUPrefix,
+		"":         fallbackSKUPrefix,
+		"Ox":       "OX",
+	}
+	for name, want := range tests {
+		if got := skuPrefix(name); got != want {
+			t.Errorf("skuPrefix(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestParseSKUStrategy(t *testing.T) {
+	if _, err := domain.ParseSKUStrategy("random"); err == nil {
+		t.Fatal("unknown strategy should be rejected")
+	}
+	if got, err := domain.ParseSKUStrategy("category"); err != nil || got != domain.SKUStrategyCategory {
+		t.Fatalf("ParseSKUStrategy(category) = %q, %v", got, err)
+	}
+}
@@ -0,0 +1,75 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+	supplierv1 "github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/api/gen/go/proto/supplier/v1"
+)
+
+// failingSupplierBackend answers every supplier lookup with code
+type failingSupplierBackend struct {
+	supplierv1.UnimplementedSupplierServiceServer
+	code codes.Code
+}
+
+func (b failingSupplierBackend) GetSupplier(ctx context.Context, req *supplierv1.GetSupplierRequest) (*supplierv1.GetSupplierResponse, error) {
+	return nil, status.Error(b.code, "supplier lookup failed")
+}
+
+func newSupplierProductsService(t *testing.T, supplier supplierv1.SupplierServiceServer, products ...*domain.Product) *ProductService {
+	t.Helper()
+	return NewProductService(newMemoryProductRepository(products...), nil, newSupplierClient(t, supplier), nil,
+		testDefaultLocation, "", nil, domain.SearchPolicy{}, domain.SortOption{}, domain.MediaPolicy{},
+		domain.NewPricePolicy(nil), nil, domain.RetryPolicy{}, nil, zap.NewNop())
+}
+
+func supplierProducts() []*domain.Product {
+	var products []*domain.Product
+	for _, sku := range []string{"LAMP-1", "LAMP-2"} {
+		p := newTestProduct(sku)
+		p.ID = primitive.NewObjectID()
+		products = append(products, p)
+	}
+	other := newTestProduct("DESK-1")
+	other.ID = primitive.NewObjectID()
+	other.SupplierID = "supplier-2"
+	return append(products, other)
+}
+
+func TestGetProductsBySupplierWhileSupplierServiceIsDown(t *testing.T) {
+	service := newSupplierProductsService(t, failingSupplierBackend{code: codes.Unavailable}, supplierProducts()...)
+
+	products, total, err := service.GetProductsBySupplier(context.Background(), "supplier-1", &domain.ListOptions{})
+	if err != nil {
+		t.Fatalf("listing should not fail while the supplier service is down: %v", err)
+	}
+	if total != 2 || len(products) != 2 {
+		t.Fatalf("got %d products (total %d), want 2", len(products), total)
+	}
+}
+
+func TestGetProductsBySupplierRejectsUnknownSupplier(t *testing.T) {
+	service := newSupplierProductsService(t, failingSupplierBackend{code: codes.NotFound}, supplierProducts()...)
+
+	_, _, err := service.GetProductsBySupplier(context.Background(), "supplier-9", &domain.ListOptions{})
+	if !errors.Is(err, domain.ErrSupplierNotFound) {
+		t.Fatalf("err = %v, want ErrSupplierNotFound", err)
+	}
+}
+
+func TestCreateProductStillValidatesSupplierWhileItIsDown(t *testing.T) {
+	service := newSupplierProductsService(t, failingSupplierBackend{code: codes.Unavailable})
+
+	_, err := service.CreateProduct(context.Background(), newTestProduct("LAMP-3"), "")
+	if !errors.Is(err, domain.ErrSupplierNotFound) {
+		t.Fatalf("err = %v, want the write to be refused", err)
+	}
+}
@@ -2,8 +2,16 @@ package config
 
 import (
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/grpclimits"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/mongoclient"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/shutdown"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
 )
 
 // Config holds the application configuration
@@ -14,6 +22,66 @@ type Config struct {
 	Database            string
 	SupplierServiceAddr string
 	InventoryServiceAddr string
+	// OrderServiceAddr is asked whether reviewers bought the product
+	OrderServiceAddr string
+
+	// DefaultLocationID is the inventory location new products are stocked at
+	// when the create request names no primary location
+	DefaultLocationID string
+
+	// CategoryCountReconcileInterval is how often category product counts are recounted
+	CategoryCountReconcileInterval time.Duration
+
+	// MongoPool sizes the MongoDB connection pool and sets its timeouts
+	MongoPool mongoclient.PoolConfig
+
+	// GRPCLimits bounds the size of the messages the gRPC server accepts and sends
+	GRPCLimits grpclimits.MessageLimits
+
+	// ShutdownDrainTimeout is how long background workers get to finish the
+	// item they are working on when the service shuts down
+	ShutdownDrainTimeout time.Duration
+
+	// SKUStrategy is how SKUs are generated for products created without one:
+	// uuid, category or supplier
+	SKUStrategy string
+
+	// SearchMinQueryLength is the shortest query run against the text index;
+	// shorter ones are matched as a name or SKU prefix
+	SearchMinQueryLength int
+
+	// SearchStopWords are removed from text search queries
+	SearchStopWords []string
+
+	// DefaultSort orders product lists whose request asks for no sort, so
+	// that their pages do not overlap
+	DefaultSort domain.SortOption
+
+	// MediaAllowedHosts restricts the hosts product image and video URLs may
+	// point at; empty allows any host
+	MediaAllowedHosts []string
+
+	// CurrencyMinorUnits overrides the ISO 4217 number of decimal places of
+	// currencies, keyed by currency code
+	CurrencyMinorUnits map[string]int32
+
+	// MediaProbeInterval is the least time between two probes of product
+	// image metadata, MediaProbeTimeout bounds a single probe and
+	// MediaProbeQueueSize is how many probes may wait at once
+	MediaProbeInterval  time.Duration
+	MediaProbeTimeout   time.Duration
+	MediaProbeQueueSize int
+
+	// SearchWeights rank text search matches by the field they are found in.
+	// They take effect when the text index is created or rebuilt.
+	SearchWeights domain.SearchWeights
+
+	// InventoryCreateRetry bounds the retries of a new product's inventory row
+	InventoryCreateRetry domain.RetryPolicy
+
+	// InventoryReconcileInterval is how often products whose inventory row
+	// could not be created are retried
+	InventoryReconcileInterval time.Duration
 }
 
 // Load loads configuration from environment variables with defaults
@@ -25,6 +93,52 @@ func Load(logger *zap.Logger) *Config {
 		Database:            getEnvWithDefault("DATABASE_NAME", "productdb"),
 		SupplierServiceAddr: getEnvWithDefault("SUPPLIER_SERVICE_ADDR", "localhost:50057"),
 		InventoryServiceAddr: getEnvWithDefault("INVENTORY_SERVICE_ADDR", "localhost:50052"),
+		OrderServiceAddr:     getEnvWithDefault("ORDER_SERVICE_ADDR", "localhost:50055"),
+
+		DefaultLocationID: getEnvWithDefault("DEFAULT_LOCATION_ID", "default"),
+
+		CategoryCountReconcileInterval: getEnvDuration("CATEGORY_COUNT_RECONCILE_INTERVAL", time.Hour),
+
+		MongoPool: mongoclient.PoolConfigFromEnv(productPoolDefaults()),
+		GRPCLimits: grpclimits.MessageLimitsFromEnv(grpclimits.DefaultMessageLimits()),
+
+		ShutdownDrainTimeout: shutdown.DrainTimeoutFromEnv(shutdown.DefaultDrainTimeout),
+
+		SKUStrategy: getEnvWithDefault("SKU_STRATEGY", "uuid"),
+
+		SearchMinQueryLength: getEnvInt("SEARCH_MIN_QUERY_LENGTH", 3),
+		SearchStopWords:      getEnvList("SEARCH_STOP_WORDS", domain.DefaultStopWords),
+		MediaAllowedHosts:    getEnvList("MEDIA_ALLOWED_HOSTS", nil),
+		MediaProbeInterval:   getEnvDuration("MEDIA_PROBE_INTERVAL", 200*time.Millisecond),
+		MediaProbeTimeout:    getEnvDuration("MEDIA_PROBE_TIMEOUT", 10*time.Second),
+		MediaProbeQueueSize:  getEnvInt("MEDIA_PROBE_QUEUE_SIZE", 1000),
+		SearchWeights: domain.SearchWeights{
+			Name:        getEnvInt("SEARCH_WEIGHT_NAME", domain.DefaultSearchWeights.Name),
+			SKU:         getEnvInt("SEARCH_WEIGHT_SKU", domain.DefaultSearchWeights.SKU),
+			Description: getEnvInt("SEARCH_WEIGHT_DESCRIPTION", domain.DefaultSearchWeights.Description),
+		},
+
+		InventoryCreateRetry: domain.RetryPolicy{
+			MaxAttempts: getEnvInt("INVENTORY_CREATE_MAX_ATTEMPTS", 3),
+			Backoff:     getEnvDuration("INVENTORY_CREATE_BACKOFF", 200*time.Millisecond),
+		},
+		InventoryReconcileInterval: getEnvDuration("INVENTORY_RECONCILE_INTERVAL", 5*time.Minute),
+	}
+
+	minorUnits, err := domain.ParseMinorUnits(getEnvList("CURRENCY_MINOR_UNITS", nil))
+	if err != nil {
+		logger.Warn("Ignoring CURRENCY_MINOR_UNITS", zap.Error(err))
+	}
+	config.CurrencyMinorUnits = minorUnits
+
+	config.DefaultSort = domain.DefaultSort
+	if value := os.Getenv("PRODUCT_DEFAULT_SORT"); value != "" {
+		sort, err := domain.ParseSortOption(value)
+		if err != nil {
+			logger.Warn("Ignoring PRODUCT_DEFAULT_SORT", zap.Error(err))
+		} else {
+			config.DefaultSort = sort
+		}
 	}
 
 	// Log configuration (mask sensitive data)
@@ -33,13 +147,42 @@ func Load(logger *zap.Logger) *Config {
 		zap.String("http_port", config.HTTPPort),
 		zap.String("mongo_uri", maskSensitiveData(config.MongoURI)),
 		zap.String("database", config.Database),
+		zap.Uint64("mongo_max_pool_size", config.MongoPool.MaxPoolSize),
+		zap.Int("grpc_max_recv_msg_size", config.GRPCLimits.MaxRecvMsgSize),
+		zap.Int("grpc_max_send_msg_size", config.GRPCLimits.MaxSendMsgSize),
+		zap.Duration("shutdown_drain_timeout", config.ShutdownDrainTimeout),
 		zap.String("supplier_service_addr", config.SupplierServiceAddr),
 		zap.String("inventory_service_addr", config.InventoryServiceAddr),
+		zap.String("order_service_addr", config.OrderServiceAddr),
+		zap.String("default_location_id", config.DefaultLocationID),
+		zap.Duration("category_count_reconcile_interval", config.CategoryCountReconcileInterval),
+		zap.String("sku_strategy", config.SKUStrategy),
+		zap.Int("search_min_query_length", config.SearchMinQueryLength),
+		zap.Int("search_stop_words", len(config.SearchStopWords)),
+		zap.Any("default_sort", config.DefaultSort),
+		zap.Any("search_weights", config.SearchWeights),
+		zap.Strings("media_allowed_hosts", config.MediaAllowedHosts),
+		zap.Any("currency_minor_units", config.CurrencyMinorUnits),
+		zap.Duration("media_probe_interval", config.MediaProbeInterval),
+		zap.Duration("media_probe_timeout", config.MediaProbeTimeout),
+		zap.Int("media_probe_queue_size", config.MediaProbeQueueSize),
+		zap.Int("inventory_create_max_attempts", config.InventoryCreateRetry.MaxAttempts),
+		zap.Duration("inventory_create_backoff", config.InventoryCreateRetry.Backoff),
+		zap.Duration("inventory_reconcile_interval", config.InventoryReconcileInterval),
 	)
 
 	return config
 }
 
+// productPoolDefaults differs from the shared defaults in what the product
+// service has always used: one idle connection kept open and no socket timeout
+func productPoolDefaults() mongoclient.PoolConfig {
+	pool := mongoclient.DefaultPoolConfig()
+	pool.MinPoolSize = 1
+	pool.SocketTimeout = 0
+	return pool
+}
+
 // getEnvWithDefault gets environment variable or returns default value
 func getEnvWithDefault(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -48,6 +191,47 @@ func getEnvWithDefault(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getEnvDuration gets a duration environment variable (e.g. "30m") or returns
+// the default value when it is unset, invalid or not positive
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if d, err := time.ParseDuration(value); err == nil && d > 0 {
+			return d
+		}
+	}
+	return defaultValue
+}
+
+// getEnvInt gets a non-negative integer environment variable or returns the
+// default value when it is unset or invalid
+func getEnvInt(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		if n, err := strconv.Atoi(value); err == nil && n >= 0 {
+			return n
+		}
+	}
+	return defaultValue
+}
+
+// getEnvList gets a comma-separated list environment variable or returns the
+// default value when it is unset. Set it to "-" for an empty list.
+func getEnvList(key string, defaultValue []string) []string {
+	value := os.Getenv(key)
+	switch value {
+	case "":
+		return defaultValue
+	case "-":
+		return nil
+	}
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
 // maskSensitiveData masks sensitive information in connection strings
 func maskSensitiveData(data string) string {
 	if len(data) > 20 {
@@ -8,6 +8,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.uber.org/zap"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/mongoclient"
 	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/config"
 	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
 	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/infrastructure/mongodb"
@@ -19,13 +20,17 @@ type Database struct {
 	Database        *mongo.Database
 	ProductRepo     *mongodb.ProductRepository
 	CategoryRepo    domain.CategoryRepository
+	SKUSequence     domain.SKUSequence
+	// PendingInventory queues products whose inventory row is still missing
+	PendingInventory domain.PendingInventoryQueue
+	ReviewRepo       *mongodb.ReviewRepository
 	logger          *zap.Logger
 }
 
 // Initialize creates and initializes the database layer
 func Initialize(cfg *config.Config, logger *zap.Logger) (*Database, error) {
 	// Create MongoDB client
-	client, err := createMongoClient(cfg.MongoURI, logger)
+	client, err := createMongoClient(cfg.MongoURI, cfg.MongoPool, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +49,7 @@ func Initialize(cfg *config.Config, logger *zap.Logger) (*Database, error) {
 	database := client.Database(cfg.Database)
 
 	// Initialize repositories
-	productRepo := mongodb.NewProductRepository(database, logger)
+	productRepo := mongodb.NewProductRepository(database, cfg.SearchWeights, logger)
 	categoryRepo := mongodb.NewCategoryRepository(database, logger)
 
 	return &Database{
@@ -52,6 +57,9 @@ func Initialize(cfg *config.Config, logger *zap.Logger) (*Database, error) {
 		Database:     database,
 		ProductRepo:  productRepo,
 		CategoryRepo: categoryRepo,
+		SKUSequence:  mongodb.NewSKUSequenceRepository(database),
+		PendingInventory: mongodb.NewPendingInventoryRepository(database),
+		ReviewRepo:       mongodb.NewReviewRepository(database, logger),
 		logger:       logger,
 	}, nil
 }
@@ -71,16 +79,11 @@ func (db *Database) Close() error {
 }
 
 // createMongoClient creates a new MongoDB client with proper configuration
-func createMongoClient(mongoURI string, logger *zap.Logger) (*mongo.Client, error) {
+func createMongoClient(mongoURI string, pool mongoclient.PoolConfig, logger *zap.Logger) (*mongo.Client, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	clientOptions := options.Client().
-		ApplyURI(mongoURI).
-		SetConnectTimeout(10 * time.Second).
-		SetServerSelectionTimeout(10 * time.Second).
-		SetMaxPoolSize(10).
-		SetMinPoolSize(1)
+	clientOptions := pool.Apply(options.Client().ApplyURI(mongoURI))
 
 	client, err := mongo.Connect(ctx, clientOptions)
 	if err != nil {
@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MaxCategoryAttributes bounds the number of attributes in one category schema
+const MaxCategoryAttributes = 100
+
+// AttributeType is the type of the values a category attribute takes
+type AttributeType string
+
+const (
+	AttributeTypeString  AttributeType = "string"
+	AttributeTypeNumber  AttributeType = "number"
+	AttributeTypeBoolean AttributeType = "boolean"
+)
+
+// AttributeDefinition describes an attribute that products in a category
+// carry in their metadata, e.g. the size of shoes or the wattage of lamps
+type AttributeDefinition struct {
+	Name     string        `bson:"name" json:"name"`
+	Type     AttributeType `bson:"type" json:"type"`
+	Required bool          `bson:"required" json:"required"`
+	// AllowedValues optionally restricts the attribute to a fixed set of values
+	AllowedValues []string `bson:"allowed_values,omitempty" json:"allowed_values,omitempty"`
+}
+
+// ValidateAttributeSchema checks the attribute schema of a category and
+// normalizes it in place: names are trimmed and an empty type means string.
+// Names must be unique, types known, and allowed values of the attribute's type.
+func ValidateAttributeSchema(attributes []AttributeDefinition) error {
+	if len(attributes) > MaxCategoryAttributes {
+		return fmt.Errorf("%w: a category has at most %d attributes", ErrInvalidAttributeSchema, MaxCategoryAttributes)
+	}
+
+	seen := make(map[string]bool, len(attributes))
+	for i := range attributes {
+		a := &attributes[i]
+		a.Name = strings.TrimSpace(a.Name)
+		if a.Name == "" {
+			return fmt.Errorf("%w: attribute at index %d: name is required", ErrInvalidAttributeSchema, i)
+		}
+		if seen[a.Name] {
+			return fmt.Errorf("%w: attribute %s is defined twice", ErrInvalidAttributeSchema, a.Name)
+		}
+		seen[a.Name] = true
+
+		a.Type = AttributeType(strings.ToLower(strings.TrimSpace(string(a.Type))))
+		switch a.Type {
+		case "":
+			a.Type = AttributeTypeString
+		case AttributeTypeString, AttributeTypeNumber, AttributeTypeBoolean:
+		default:
+			return fmt.Errorf("%w: attribute %s: unknown type %q", ErrInvalidAttributeSchema, a.Name, a.Type)
+		}
+
+		for _, v := range a.AllowedValues {
+			if !a.hasType(v) {
+				return fmt.Errorf("%w: attribute %s: allowed value %q is not a %s", ErrInvalidAttributeSchema, a.Name, v, a.Type)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateAttributes checks product metadata against the attribute schemas of
+// the product's categories: every required attribute must be present and
+// every attribute that is present must have a valid value. Metadata keys no
+// schema defines are left alone.
+func ValidateAttributes(metadata map[string]interface{}, categories []*Category) error {
+	for _, category := range categories {
+		for _, a := range category.Attributes {
+			raw, ok := metadata[a.Name]
+			value := ""
+			if ok && raw != nil {
+				value = strings.TrimSpace(fmt.Sprint(raw))
+			}
+			if value == "" {
+				if a.Required {
+					return fmt.Errorf("%w: %s is required by category %s", ErrMissingAttribute, a.Name, category.Name)
+				}
+				continue
+			}
+			if err := a.check(value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// check validates one value of the attribute
+func (a AttributeDefinition) check(value string) error {
+	if !a.hasType(value) {
+		return fmt.Errorf("%w: %s must be a %s, got %q", ErrInvalidAttribute, a.Name, a.Type, value)
+	}
+	if len(a.AllowedValues) == 0 {
+		return nil
+	}
+	for _, allowed := range a.AllowedValues {
+		if a.equal(allowed, value) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s must be one of %s, got %q", ErrInvalidAttribute, a.Name, strings.Join(a.AllowedValues, ", "), value)
+}
+
+// hasType reports whether value can be read as the attribute's type
+func (a AttributeDefinition) hasType(value string) bool {
+	switch a.Type {
+	case AttributeTypeNumber:
+		_, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		return err == nil
+	case AttributeTypeBoolean:
+		_, err := strconv.ParseBool(strings.TrimSpace(value))
+		return err == nil
+	default:
+		return true
+	}
+}
+
+// equal compares two values of the attribute: numbers and booleans by value,
+// strings case-insensitively
+func (a AttributeDefinition) equal(x, y string) bool {
+	x, y = strings.TrimSpace(x), strings.TrimSpace(y)
+	switch a.Type {
+	case AttributeTypeNumber:
+		fx, _ := strconv.ParseFloat(x, 64)
+		fy, _ := strconv.ParseFloat(y, 64)
+		return fx == fy
+	case AttributeTypeBoolean:
+		bx, _ := strconv.ParseBool(x)
+		by, _ := strconv.ParseBool(y)
+		return bx == by
+	default:
+		return strings.EqualFold(x, y)
+	}
+}
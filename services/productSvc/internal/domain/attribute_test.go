@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateAttributes(t *testing.T) {
+	shoes := &Category{Name: "Shoes", Attributes: []AttributeDefinition{
+		{Name: "size", Type: AttributeTypeNumber, Required: true},
+		{Name: "color", Type: AttributeTypeString, AllowedValues: []string{"black", "white"}},
+		{Name: "waterproof", Type: AttributeTypeBoolean},
+	}}
+
+	tests := []struct {
+		name     string
+		metadata map[string]interface{}
+		wantErr  error
+	}{
+		{name: "valid", metadata: map[string]interface{}{"size": 42, "color": "Black", "waterproof": "true"}},
+		{name: "only required", metadata: map[string]interface{}{"size": "42.5"}},
+		{name: "unknown keys are ignored", metadata: map[string]interface{}{"size": 42, "brand": "Acme"}},
+		{name: "missing required", metadata: map[string]interface{}{"color": "black"}, wantErr: ErrMissingAttribute},
+		{name: "no metadata", metadata: nil, wantErr: ErrMissingAttribute},
+		{name: "blank required", metadata: map[string]interface{}{"size": "  "}, wantErr: ErrMissingAttribute},
+		{name: "wrong type", metadata: map[string]interface{}{"size": "large"}, wantErr: ErrInvalidAttribute},
+		{name: "value not allowed", metadata: map[string]interface{}{"size": 42, "color": "red"}, wantErr: ErrInvalidAttribute},
+		{name: "not a boolean", metadata: map[string]interface{}{"size": 42, "waterproof": "mostly"}, wantErr: ErrInvalidAttribute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAttributes(tt.metadata, []*Category{shoes})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ValidateAttributes(%v) error = %v, want %v", tt.metadata, err, tt.wantErr)
+			}
+			if tt.wantErr != nil && !errors.Is(err, ErrValidation) {
+				t.Errorf("error %v is not a validation error", err)
+			}
+		})
+	}
+}
+
+func TestValidateAttributesChecksEveryCategory(t *testing.T) {
+	shoes := &Category{Name: "Shoes", Attributes: []AttributeDefinition{{Name: "size", Type: AttributeTypeNumber, Required: true}}}
+	lamps := &Category{Name: "Lamps", Attributes: []AttributeDefinition{{Name: "wattage", Type: AttributeTypeNumber, Required: true}}}
+
+	err := ValidateAttributes(map[string]interface{}{"size": 42}, []*Category{shoes, lamps})
+
+	if !errors.Is(err, ErrMissingAttribute) {
+		t.Fatalf("error = %v, want %v for the lamp wattage", err, ErrMissingAttribute)
+	}
+}
+
+func TestValidateAttributesMatchesAllowedNumbersByValue(t *testing.T) {
+	sizes := &Category{Name: "Shoes", Attributes: []AttributeDefinition{
+		{Name: "size", Type: AttributeTypeNumber, AllowedValues: []string{"41", "42"}},
+	}}
+
+	if err := ValidateAttributes(map[string]interface{}{"size": 42.0}, []*Category{sizes}); err != nil {
+		t.Fatalf("ValidateAttributes: %v", err)
+	}
+}
+
+func TestValidateAttributeSchema(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes []AttributeDefinition
+		wantErr    bool
+	}{
+		{name: "valid", attributes: []AttributeDefinition{{Name: "size", Type: AttributeTypeNumber, AllowedValues: []string{"41", "42"}}}},
+		{name: "empty", attributes: nil},
+		{name: "no name", attributes: []AttributeDefinition{{Name: " ", Type: AttributeTypeString}}, wantErr: true},
+		{name: "duplicate", attributes: []AttributeDefinition{{Name: "size"}, {Name: " size "}}, wantErr: true},
+		{name: "unknown type", attributes: []AttributeDefinition{{Name: "size", Type: "date"}}, wantErr: true},
+		{name: "allowed value of the wrong type", attributes: []AttributeDefinition{{Name: "size", Type: AttributeTypeNumber, AllowedValues: []string{"XL"}}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAttributeSchema(tt.attributes)
+			if tt.wantErr != errors.Is(err, ErrInvalidAttributeSchema) {
+				t.Fatalf("ValidateAttributeSchema error = %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateAttributeSchemaNormalizes(t *testing.T) {
+	attributes := []AttributeDefinition{{Name: " color "}, {Name: "size", Type: " Number "}}
+
+	if err := ValidateAttributeSchema(attributes); err != nil {
+		t.Fatal(err)
+	}
+
+	if attributes[0].Name != "color" || attributes[0].Type != AttributeTypeString {
+		t.Errorf("attribute = %+v, want a trimmed string attribute", attributes[0])
+	}
+	if attributes[1].Type != AttributeTypeNumber {
+		t.Errorf("type = %q, want %q", attributes[1].Type, AttributeTypeNumber)
+	}
+}
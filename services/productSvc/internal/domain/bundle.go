@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"fmt"
+)
+
+// MaxBundleComponents bounds the number of components of one bundle
+const MaxBundleComponents = 50
+
+// BundleComponent is one product, or one variant option of it, that goes into
+// every unit of a bundle. Stock is kept per product, so selling a bundle
+// deducts Quantity units of ProductID for each bundle sold.
+type BundleComponent struct {
+	ProductID string `bson:"product_id" json:"product_id"`
+	// VariantOptionID optionally narrows the component to one variant option
+	// of the product, e.g. the size that goes into the kit
+	VariantOptionID string `bson:"variant_option_id,omitempty" json:"variant_option_id,omitempty"`
+	// SKU is the SKU of the option, or else of the product, copied when the
+	// bundle is saved so order lines can be picked without another lookup
+	SKU      string `bson:"sku" json:"sku"`
+	Quantity int32  `bson:"quantity" json:"quantity"`
+}
+
+// IsBundle reports whether the product is a bundle: a kit sold as one item
+// whose stock is that of its components
+func (p *Product) IsBundle() bool {
+	return len(p.BundleComponents) > 0
+}
+
+// ValidateBundleComponents checks the components of bundle bundleID: at least
+// one, no more than MaxBundleComponents, each with a product and a positive
+// quantity, none listed twice. A bundle containing itself fails with
+// ErrCircularBundle.
+func ValidateBundleComponents(bundleID string, components []BundleComponent) error {
+	if len(components) == 0 {
+		return fmt.Errorf("%w: a bundle needs at least one component", ErrInvalidBundle)
+	}
+	if len(components) > MaxBundleComponents {
+		return fmt.Errorf("%w: a bundle has at most %d components", ErrInvalidBundle, MaxBundleComponents)
+	}
+
+	seen := make(map[BundleComponent]bool, len(components))
+	for _, c := range components {
+		if c.ProductID == "" {
+			return fmt.Errorf("%w: component product ID is required", ErrInvalidBundle)
+		}
+		if c.ProductID == bundleID {
+			return fmt.Errorf("%w: bundle %s lists itself as a component", ErrCircularBundle, bundleID)
+		}
+		if c.Quantity <= 0 {
+			return fmt.Errorf("%w: quantity of component %s must be greater than zero", ErrInvalidBundle, c.ProductID)
+		}
+		key := BundleComponent{ProductID: c.ProductID, VariantOptionID: c.VariantOptionID}
+		if seen[key] {
+			return fmt.Errorf("%w: component %s is listed twice", ErrInvalidBundle, c.ProductID)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// ResolveBundleComponent checks that component product p can go into a
+// bundle and fills in the component's SKU. Bundles cannot be components:
+// keeping bundles one level deep is what rules out a bundle that contains
+// itself through another one.
+func ResolveBundleComponent(c *BundleComponent, p *Product) error {
+	if p.IsBundle() {
+		return fmt.Errorf("%w: component %s is itself a bundle", ErrCircularBundle, c.ProductID)
+	}
+	if c.VariantOptionID == "" {
+		c.SKU = p.SKU
+		return nil
+	}
+	for _, v := range p.Variants {
+		for _, o := range v.Options {
+			if o.ID != c.VariantOptionID {
+				continue
+			}
+			c.SKU = o.SKU
+			if c.SKU == "" {
+				c.SKU = p.SKU
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: product %s has no variant option %s", ErrInvalidBundle, c.ProductID, c.VariantOptionID)
+}
+
+// ComponentAvailability is the stock of one bundle component at a location
+type ComponentAvailability struct {
+	Component BundleComponent
+	// Available is the available-to-promise quantity of the component's product
+	Available int32
+	// Bundles is how many bundles that quantity covers
+	Bundles int32
+}
+
+// BundleAvailability is how many units of a bundle can be sold at a location
+type BundleAvailability struct {
+	BundleID   string
+	LocationID string
+	// Available is the lowest Bundles of any component
+	Available  int32
+	Components []ComponentAvailability
+}
+
+// ComputeBundleAvailability works out how many bundles the available-to-
+// promise quantities of the component products cover: the minimum over the
+// components. available is keyed by product ID; a product missing from it has
+// no stock. Components sharing a product, such as two options of it, draw on
+// the same stock, so each bundle needs their quantities combined.
+func ComputeBundleAvailability(bundleID, locationID string, components []BundleComponent, available map[string]int32) *BundleAvailability {
+	result := &BundleAvailability{
+		BundleID:   bundleID,
+		LocationID: locationID,
+		Components: make([]ComponentAvailability, 0, len(components)),
+	}
+	perBundle := make(map[string]int32, len(components))
+	for _, c := range components {
+		perBundle[c.ProductID] += c.Quantity
+	}
+	for i, c := range components {
+		atp := max(available[c.ProductID], 0)
+		bundles := atp / perBundle[c.ProductID]
+		if i == 0 || bundles < result.Available {
+			result.Available = bundles
+		}
+		result.Components = append(result.Components, ComponentAvailability{
+			Component: c,
+			Available: atp,
+			Bundles:   bundles,
+		})
+	}
+	return result
+}
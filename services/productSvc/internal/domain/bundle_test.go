@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestComputeBundleAvailability(t *testing.T) {
+	components := []BundleComponent{
+		{ProductID: "frame", Quantity: 1},
+		{ProductID: "screw", Quantity: 8},
+		{ProductID: "shelf", Quantity: 4},
+	}
+
+	tests := []struct {
+		name      string
+		available map[string]int32
+		want      int32
+	}{
+		{name: "limited by the scarcest component", available: map[string]int32{"frame": 10, "screw": 40, "shelf": 100}, want: 5},
+		{name: "partial units do not count", available: map[string]int32{"frame": 10, "screw": 100, "shelf": 11}, want: 2},
+		{name: "a component without stock", available: map[string]int32{"frame": 10, "screw": 100}, want: 0},
+		{name: "oversold component", available: map[string]int32{"frame": -3, "screw": 100, "shelf": 100}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ComputeBundleAvailability("kit", "store-1", components, tt.available)
+			if got.Available != tt.want {
+				t.Fatalf("available = %d, want %d", got.Available, tt.want)
+			}
+			if len(got.Components) != len(components) {
+				t.Fatalf("components = %d, want %d", len(got.Components), len(components))
+			}
+			for _, c := range got.Components {
+				if c.Bundles < got.Available {
+					t.Errorf("component %s covers %d bundles, below the bundle's %d", c.Component.ProductID, c.Bundles, got.Available)
+				}
+			}
+		})
+	}
+}
+
+func TestComputeBundleAvailabilityCombinesSharedProducts(t *testing.T) {
+	// Two options of the same product draw on one stock of 9
+	components := []BundleComponent{
+		{ProductID: "sock", VariantOptionID: "left", Quantity: 1},
+		{ProductID: "sock", VariantOptionID: "right", Quantity: 2},
+	}
+
+	got := ComputeBundleAvailability("pair", "store-1", components, map[string]int32{"sock": 9})
+	if got.Available != 3 {
+		t.Fatalf("available = %d, want 3 bundles of 3 socks", got.Available)
+	}
+}
+
+func TestValidateBundleComponents(t *testing.T) {
+	tests := []struct {
+		name       string
+		components []BundleComponent
+		want       error
+	}{
+		{name: "valid", components: []BundleComponent{{ProductID: "a", Quantity: 1}, {ProductID: "b", Quantity: 2}}},
+		{name: "two options of one product", components: []BundleComponent{{ProductID: "a", VariantOptionID: "s", Quantity: 1}, {ProductID: "a", VariantOptionID: "m", Quantity: 1}}},
+		{name: "no components", want: ErrInvalidBundle},
+		{name: "itself", components: []BundleComponent{{ProductID: "kit", Quantity: 1}}, want: ErrCircularBundle},
+		{name: "zero quantity", components: []BundleComponent{{ProductID: "a"}}, want: ErrInvalidBundle},
+		{name: "missing product", components: []BundleComponent{{Quantity: 1}}, want: ErrInvalidBundle},
+		{name: "listed twice", components: []BundleComponent{{ProductID: "a", Quantity: 1}, {ProductID: "a", Quantity: 2}}, want: ErrInvalidBundle},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBundleComponents("kit", tt.components)
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("err = %v, want none", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveBundleComponent(t *testing.T) {
+	shirt := &Product{SKU: "SHIRT", Variants: []Variant{{Name: "Size", Options: []VariantOption{
+		{ID: "m", SKU: "SHIRT-M"},
+		{ID: "l"},
+	}}}}
+
+	c := BundleComponent{ProductID: "shirt", VariantOptionID: "m", Quantity: 1}
+	if err := ResolveBundleComponent(&c, shirt); err != nil || c.SKU != "SHIRT-M" {
+		t.Errorf("option with a SKU: sku %q, err %v, want SHIRT-M", c.SKU, err)
+	}
+	c = BundleComponent{ProductID: "shirt", VariantOptionID: "l", Quantity: 1}
+	if err := ResolveBundleComponent(&c, shirt); err != nil || c.SKU != "SHIRT" {
+		t.Errorf("option without a SKU: sku %q, err %v, want the product's SHIRT", c.SKU, err)
+	}
+	c = BundleComponent{ProductID: "shirt", VariantOptionID: "xl", Quantity: 1}
+	if err := ResolveBundleComponent(&c, shirt); !errors.Is(err, ErrInvalidBundle) {
+		t.Errorf("unknown option: err %v, want ErrInvalidBundle", err)
+	}
+
+	kit := &Product{BundleComponents: []BundleComponent{{ProductID: "shirt", Quantity: 1}}}
+	c = BundleComponent{ProductID: "kit", Quantity: 1}
+	if err := ResolveBundleComponent(&c, kit); !errors.Is(err, ErrCircularBundle) {
+		t.Errorf("bundle as component: err %v, want ErrCircularBundle", err)
+	}
+}
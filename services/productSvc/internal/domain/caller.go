@@ -0,0 +1,20 @@
+package domain
+
+// RoleSupplier is the role of users acting for a supplier, e.g. in the
+// supplier portal
+const RoleSupplier = "SUPPLIER"
+
+// Caller identifies who a request is made for, as forwarded by the gateway.
+// It is empty for internal callers.
+type Caller struct {
+	UserID string
+	Role   string
+	// SupplierID is the supplier a supplier-role caller acts for
+	SupplierID string
+}
+
+// IsSupplier reports whether the caller acts for a supplier and must be kept
+// to that supplier's products
+func (c Caller) IsSupplier() bool {
+	return c.Role == RoleSupplier
+}
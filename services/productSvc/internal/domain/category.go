@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -17,8 +18,45 @@ type Category struct {
 	Path        string             `bson:"path" json:"path"`
 	CreatedAt   time.Time          `bson:"created_at" json:"created_at"`
 	UpdatedAt   time.Time          `bson:"updated_at" json:"updated_at"`
+
+	// ProductCount is the number of non-deleted products in the category. It is
+	// maintained as products change and corrected periodically by reconciliation.
+	ProductCount int64 `bson:"product_count" json:"product_count"`
+
+	// Attributes is the schema of the metadata products in the category carry
+	Attributes []AttributeDefinition `bson:"attributes,omitempty" json:"attributes,omitempty"`
 }
 
+// ChildPath is the Path of the category's direct children: its own path
+// followed by its ID
+func (c *Category) ChildPath() string {
+	if c.Path == "" {
+		return c.ID.Hex()
+	}
+	return c.Path + "/" + c.ID.Hex()
+}
+
+// HasAncestor reports whether the category with the given ID is an ancestor
+// of c, i.e. appears in its path
+func (c *Category) HasAncestor(id string) bool {
+	for _, ancestor := range strings.Split(c.Path, "/") {
+		if ancestor == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Modes of ProductUseCase.BulkSetCategories
+const (
+	// CategoryModeAdd adds the categories to those a product is in
+	CategoryModeAdd = "add"
+	// CategoryModeRemove takes the categories off a product
+	CategoryModeRemove = "remove"
+	// CategoryModeReplace makes the categories the only ones of a product
+	CategoryModeReplace = "replace"
+)
+
 // CategoryRepository defines the interface for category data operations
 type CategoryRepository interface {
 	Create(ctx context.Context, category *Category) (*Category, error)
@@ -26,6 +64,17 @@ type CategoryRepository interface {
 	Update(ctx context.Context, category *Category) error
 	Delete(ctx context.Context, id string) error
 	List(ctx context.Context, parentID string, depth int32) ([]*Category, error)
+	ListAll(ctx context.Context) ([]*Category, error)
+	// ListDescendants returns every category below the given one, at any depth
+	ListDescendants(ctx context.Context, id string) ([]*Category, error)
+	// UpdateHierarchy saves the parent, level and path of the given categories
+	// in one batch
+	UpdateHierarchy(ctx context.Context, categories []*Category) error
+
+	// AdjustProductCounts adds delta to the product count of each category
+	AdjustProductCounts(ctx context.Context, categoryIDs []string, delta int64) error
+	// SetProductCount overwrites the product count of a category
+	SetProductCount(ctx context.Context, id string, count int64) error
 }
 
 // CategoryUseCase defines the business logic for category operations
@@ -33,6 +82,8 @@ type CategoryUseCase interface {
 	CreateCategory(ctx context.Context, category *Category) (*Category, error)
 	GetCategory(ctx context.Context, id string) (*Category, error)
 	UpdateCategory(ctx context.Context, category *Category) error
+	MoveCategory(ctx context.Context, id, newParentID string) (*Category, error)
 	DeleteCategory(ctx context.Context, id string) error
 	ListCategories(ctx context.Context, parentID string, depth int32) ([]*Category, error)
+	SetCategoryAttributes(ctx context.Context, id string, attributes []AttributeDefinition) (*Category, error)
 }
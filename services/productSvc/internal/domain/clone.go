@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// cloneNameSuffix is appended to the name of a cloned product unless the
+// clone is given a name of its own
+const cloneNameSuffix = " (copy)"
+
+// Clone returns a draft copy of the product to be created as a new product.
+// Identity and unique fields (ID, SKU, barcode) are cleared, the clone starts
+// inactive and unpublished, and timestamps are reset. Variants are only copied
+// when copyVariants is set; copied variants get new IDs and their options lose
+// their SKUs and barcodes, which must be unique.
+func (p *Product) Clone(copyVariants bool) *Product {
+	clone := &Product{
+		Name:         p.Name + cloneNameSuffix,
+		Description:  p.Description,
+		CostPrice:    p.CostPrice,
+		SellingPrice: p.SellingPrice,
+		Currency:     p.Currency,
+		CategoryIDs:  append([]string(nil), p.CategoryIDs...),
+		SupplierID:   p.SupplierID,
+		Images:       append([]ProductImage(nil), p.Images...),
+		ImageURLs:    append([]string(nil), p.ImageURLs...),
+		VideoURLs:    append([]string(nil), p.VideoURLs...),
+	}
+
+	if p.Metadata != nil {
+		clone.Metadata = make(map[string]interface{}, len(p.Metadata))
+		for k, v := range p.Metadata {
+			clone.Metadata[k] = v
+		}
+	}
+
+	if copyVariants {
+		now := time.Now()
+		clone.Variants = make([]Variant, 0, len(p.Variants))
+		for _, v := range p.Variants {
+			variant := Variant{
+				ID:        primitive.NewObjectID().Hex(),
+				Name:      v.Name,
+				Options:   make([]VariantOption, 0, len(v.Options)),
+				CreatedAt: now,
+				UpdatedAt: now,
+			}
+			for _, o := range v.Options {
+				o.ID = primitive.NewObjectID().Hex()
+				o.SKU = ""
+				o.Barcode = ""
+				o.CreatedAt = now
+				o.UpdatedAt = now
+				variant.Options = append(variant.Options, o)
+			}
+			clone.Variants = append(clone.Variants, variant)
+		}
+	}
+	return clone
+}
+
+// ApplyCloneOverrides copies the fields set on overrides onto a clone. Empty
+// strings and nil slices and maps leave the cloned value in place.
+func (p *Product) ApplyCloneOverrides(overrides *Product) {
+	if overrides == nil {
+		return
+	}
+	setString := func(dst *string, src string) {
+		if src != "" {
+			*dst = src
+		}
+	}
+	setString(&p.Name, overrides.Name)
+	setString(&p.Description, overrides.Description)
+	setString(&p.CostPrice, overrides.CostPrice)
+	setString(&p.SellingPrice, overrides.SellingPrice)
+	setString(&p.Currency, overrides.Currency)
+	setString(&p.SKU, overrides.SKU)
+	setString(&p.Barcode, overrides.Barcode)
+	setString(&p.SupplierID, overrides.SupplierID)
+	setString(&p.CreatedBy, overrides.CreatedBy)
+
+	if overrides.CategoryIDs != nil {
+		p.CategoryIDs = overrides.CategoryIDs
+	}
+	if overrides.Images != nil {
+		p.Images = overrides.Images
+	}
+	if overrides.ImageURLs != nil {
+		p.ImageURLs = overrides.ImageURLs
+	}
+	if overrides.VideoURLs != nil {
+		p.VideoURLs = overrides.VideoURLs
+	}
+	if overrides.Metadata != nil {
+		p.Metadata = overrides.Metadata
+	}
+}
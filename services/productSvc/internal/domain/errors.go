@@ -14,18 +14,24 @@ var (
 	ErrValidation      = errors.New("validation error")
 	ErrAlreadyExists   = errors.New("resource already exists")
 	ErrInvalidArgument = errors.New("invalid argument")
+	ErrForbidden       = errors.New("permission denied")
+	ErrConflict        = errors.New("resource was modified concurrently")
 
 	// Product errors
 	ErrProductNotFound           = fmt.Errorf("%w: product not found", ErrNotFound)
 	ErrProductAlreadyExists      = fmt.Errorf("%w: product with same SKU or barcode already exists", ErrAlreadyExists)
 	ErrProductNameRequired       = fmt.Errorf("%w: product name is required", ErrValidation)
 	ErrProductSKURequired        = fmt.Errorf("%w: product SKU is required", ErrValidation)
+	ErrSKUAlreadyExists          = fmt.Errorf("%w: product with same SKU already exists", ErrAlreadyExists)
 	ErrInvalidCostPrice         = fmt.Errorf("%w: invalid cost price", ErrValidation)
 	ErrSellingPriceRequired     = fmt.Errorf("%w: selling price is required", ErrValidation)
 	ErrInvalidSellingPrice      = fmt.Errorf("%w: invalid selling price", ErrValidation)
 	ErrInvalidStockQuantity     = fmt.Errorf("%w: stock quantity cannot be negative", ErrValidation)
 	ErrInvalidCurrency          = fmt.Errorf("%w: currency must be a 3-letter ISO code", ErrValidation)
+	ErrInvalidPrice             = fmt.Errorf("%w: invalid price", ErrValidation)
+	ErrPricePrecision           = fmt.Errorf("%w: price has more decimal places than its currency", ErrValidation)
 	ErrProductNotActive         = errors.New("product is not active")
+	ErrUnsupportedReportFormat  = fmt.Errorf("%w: unsupported report format, expected csv", ErrValidation)
 	ErrInsufficientStock        = errors.New("insufficient stock")
 
 	// Variant errors
@@ -34,6 +40,21 @@ var (
 	ErrVariantSKURequired       = fmt.Errorf("%w: variant SKU is required", ErrValidation)
 	ErrVariantOptionRequired    = fmt.Errorf("%w: at least one variant option is required", ErrValidation)
 
+	// Image errors
+	ErrImageNotFound            = fmt.Errorf("%w: image not found", ErrNotFound)
+	ErrImageURLRequired         = fmt.Errorf("%w: image URL is required", ErrValidation)
+	ErrDuplicateImage           = fmt.Errorf("%w: duplicate image URL", ErrValidation)
+	ErrInvalidPrimaryImage      = fmt.Errorf("%w: exactly one image must be primary", ErrValidation)
+	ErrInvalidImageOrder        = fmt.Errorf("%w: image order must list every product image exactly once", ErrValidation)
+	ErrInvalidMediaURL          = fmt.Errorf("%w: media URL must be an absolute http or https URL", ErrValidation)
+	ErrMediaHostNotAllowed      = fmt.Errorf("%w: media host is not in the allow-list", ErrValidation)
+
+	// Bundle errors
+	ErrNotABundle               = fmt.Errorf("%w: product is not a bundle", ErrNotFound)
+	ErrInvalidBundle            = fmt.Errorf("%w: invalid bundle", ErrValidation)
+	ErrCircularBundle           = fmt.Errorf("%w: bundles cannot contain bundles", ErrInvalidBundle)
+	ErrBundleComponentInUse     = fmt.Errorf("%w: product is a component of another bundle", ErrCircularBundle)
+
 	// Variant option errors
 	ErrOptionNameRequired       = fmt.Errorf("%w: option name is required", ErrValidation)
 	ErrOptionValueRequired      = fmt.Errorf("%w: option value is required", ErrValidation)
@@ -45,6 +66,12 @@ var (
 	ErrParentCategoryNotFound   = fmt.Errorf("%w: parent category not found", ErrValidation)
 	ErrCategoryInUse            = errors.New("category is in use by one or more products")
 	ErrInvalidCategoryHierarchy = errors.New("invalid category hierarchy")
+	ErrCategoryCycle            = fmt.Errorf("%w: a category cannot be moved under itself or one of its subcategories", ErrInvalidCategoryHierarchy)
+
+	// Attribute errors
+	ErrInvalidAttributeSchema   = fmt.Errorf("%w: invalid attribute schema", ErrValidation)
+	ErrMissingAttribute         = fmt.Errorf("%w: required attribute is missing", ErrValidation)
+	ErrInvalidAttribute         = fmt.Errorf("%w: invalid attribute value", ErrValidation)
 
 	// Supplier errors
 	ErrSupplierNotFound         = fmt.Errorf("%w: supplier not found", ErrNotFound)
@@ -53,11 +80,13 @@ var (
 	ErrInvalidSupplierID        = fmt.Errorf("%w: invalid supplier ID", ErrValidation)
 	ErrNoProductsProvided       = fmt.Errorf("%w: no products provided", ErrValidation)
 	ErrNoProductsUpdated        = fmt.Errorf("%w: no products were updated", ErrValidation)
+	ErrSupplierScope            = fmt.Errorf("%w: suppliers can only access their own products", ErrForbidden)
 
 	// Inventory errors
 	ErrInvalidQuantity          = fmt.Errorf("%w: quantity must be greater than zero", ErrValidation)
 	ErrStockAdjustmentFailed    = errors.New("failed to adjust stock")
 	ErrStockTransferFailed      = errors.New("failed to transfer stock")
+	ErrLocationNotFound         = fmt.Errorf("%w: location not found", ErrValidation)
 
 	// Search errors
 	ErrInvalidSearchQuery       = fmt.Errorf("%w: invalid search query", ErrValidation)
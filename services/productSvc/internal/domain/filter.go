@@ -1,5 +1,11 @@
 package domain
 
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
 // ProductFilter defines the filter criteria for listing products
 type ProductFilter struct {
 	IDs         []string
@@ -7,6 +13,19 @@ type ProductFilter struct {
 	MinPrice    float64
 	MaxPrice    float64
 	SearchTerm  string
+	// NamePrefix matches products whose name or SKU starts with it, ignoring
+	// case. SearchPolicy sets it for queries too short for the text index.
+	NamePrefix string
+	SupplierID string
+	// IsActive restricts the list to active or inactive products; nil matches both
+	IsActive *bool
+	// CreatedAfter (inclusive) and CreatedBefore (exclusive) bound the creation
+	// date; zero values leave that side open
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+
+	// IncludeDeleted also matches soft-deleted products, for admin views
+	IncludeDeleted bool
 }
 
 // SortField defines the field to sort by
@@ -35,6 +54,37 @@ type SortOption struct {
 	Order SortOrder
 }
 
+// DefaultSort orders product lists that ask for no sort: newest first
+var DefaultSort = SortOption{Field: SortFieldCreatedAt, Order: SortOrderDesc}
+
+// sortFieldNames are the names of the sort fields in configuration
+var sortFieldNames = map[string]SortField{
+	"name":       SortFieldName,
+	"price":      SortFieldPrice,
+	"created_at": SortFieldCreatedAt,
+	"updated_at": SortFieldUpdatedAt,
+}
+
+// ParseSortOption parses a sort written as "<field>:<asc|desc>", e.g.
+// "created_at:desc". Without an order the field is sorted ascending.
+func ParseSortOption(value string) (SortOption, error) {
+	name, order, _ := strings.Cut(strings.ToLower(strings.TrimSpace(value)), ":")
+	field, ok := sortFieldNames[strings.TrimSpace(name)]
+	if !ok {
+		return SortOption{}, fmt.Errorf("invalid sort %q, expected a field of name, price, created_at or updated_at", value)
+	}
+
+	sort := SortOption{Field: field, Order: SortOrderAsc}
+	switch strings.TrimSpace(order) {
+	case "", "asc":
+	case "desc":
+		sort.Order = SortOrderDesc
+	default:
+		return SortOption{}, fmt.Errorf("invalid sort %q, expected asc or desc after the field", value)
+	}
+	return sort, nil
+}
+
 // Pagination defines the pagination options for listing products
 type Pagination struct {
 	Page     int
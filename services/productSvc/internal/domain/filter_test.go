@@ -0,0 +1,37 @@
+package domain
+
+import "testing"
+
+func TestParseSortOption(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    SortOption
+		wantErr bool
+	}{
+		{value: "created_at:desc", want: SortOption{Field: SortFieldCreatedAt, Order: SortOrderDesc}},
+		{value: " Name : ASC ", want: SortOption{Field: SortFieldName, Order: SortOrderAsc}},
+		{value: "price", want: SortOption{Field: SortFieldPrice, Order: SortOrderAsc}},
+		{value: "updated_at:desc", want: SortOption{Field: SortFieldUpdatedAt, Order: SortOrderDesc}},
+		{value: "rating:desc", wantErr: true},
+		{value: "created_at:newest", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseSortOption(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseSortOption(%q) = %+v, want an error", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Fatalf("ParseSortOption(%q) = %+v, want %+v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
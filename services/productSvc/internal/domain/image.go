@@ -0,0 +1,201 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// ProductImage is a single product image. Images are kept in display order and
+// exactly one image of a product with images is marked as primary.
+type ProductImage struct {
+	URL       string `bson:"url" json:"url"`
+	Position  int32  `bson:"position" json:"position"`
+	IsPrimary bool   `bson:"is_primary" json:"is_primary"`
+	// Metadata is filled in by the media prober some time after the image is
+	// added; it stays nil while the probe is pending or when it failed
+	Metadata *MediaMetadata `bson:"metadata,omitempty" json:"metadata,omitempty"`
+}
+
+// MediaMetadata describes the file behind a media URL. Width and Height are
+// zero when the dimensions could not be read, e.g. for unsupported formats.
+type MediaMetadata struct {
+	ContentType string    `bson:"content_type,omitempty" json:"content_type,omitempty"`
+	Width       int32     `bson:"width,omitempty" json:"width,omitempty"`
+	Height      int32     `bson:"height,omitempty" json:"height,omitempty"`
+	ByteSize    int64     `bson:"byte_size,omitempty" json:"byte_size,omitempty"`
+	ProbedAt    time.Time `bson:"probed_at" json:"probed_at"`
+}
+
+// MediaProber reads the metadata of a media URL without downloading more of
+// the file than it needs
+type MediaProber interface {
+	Probe(ctx context.Context, url string) (*MediaMetadata, error)
+}
+
+// MediaProbeQueue schedules probes of product images. Enqueue must not block
+// the caller; probes that cannot be scheduled are dropped.
+type MediaProbeQueue interface {
+	Enqueue(productID, imageURL string)
+}
+
+// NewProductImages builds an ordered image list from plain URLs, marking the first as primary
+func NewProductImages(urls []string) []ProductImage {
+	images := make([]ProductImage, 0, len(urls))
+	for i, url := range urls {
+		images = append(images, ProductImage{
+			URL:       url,
+			Position:  int32(i),
+			IsPrimary: i == 0,
+		})
+	}
+	return images
+}
+
+// OrderedImages returns a copy of the product images in display order.
+// Products stored before images were ordered only carry ImageURLs; for those
+// the first URL is treated as the primary image.
+func (p *Product) OrderedImages() []ProductImage {
+	if len(p.Images) > 0 {
+		return append([]ProductImage(nil), p.Images...)
+	}
+	return NewProductImages(p.ImageURLs)
+}
+
+// PrimaryImage returns the primary image, or nil when the product has no images
+func (p *Product) PrimaryImage() *ProductImage {
+	images := p.OrderedImages()
+	for i := range images {
+		if images[i].IsPrimary {
+			return &images[i]
+		}
+	}
+	return nil
+}
+
+// NormalizeImages fills Images from ImageURLs when needed, renumbers positions,
+// defaults the first image to primary if none is set and keeps ImageURLs in
+// the same order as Images.
+func (p *Product) NormalizeImages() {
+	images := p.OrderedImages()
+
+	hasPrimary := false
+	for i := range images {
+		images[i].Position = int32(i)
+		if images[i].IsPrimary {
+			hasPrimary = true
+		}
+	}
+	if !hasPrimary && len(images) > 0 {
+		images[0].IsPrimary = true
+	}
+
+	p.Images = images
+	p.ImageURLs = make([]string, 0, len(images))
+	for _, img := range images {
+		p.ImageURLs = append(p.ImageURLs, img.URL)
+	}
+}
+
+// ValidateImages checks that image URLs are present and unique and that
+// exactly one image is primary
+func (p *Product) ValidateImages() error {
+	images := p.OrderedImages()
+	if len(images) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(images))
+	primaries := 0
+	for _, img := range images {
+		if img.URL == "" {
+			return ErrImageURLRequired
+		}
+		if seen[img.URL] {
+			return ErrDuplicateImage
+		}
+		seen[img.URL] = true
+		if img.IsPrimary {
+			primaries++
+		}
+	}
+
+	if primaries != 1 {
+		return ErrInvalidPrimaryImage
+	}
+	return nil
+}
+
+// ReorderImages puts the images in the order given by urls, which must list
+// every current image URL exactly once. The primary image is unchanged.
+func (p *Product) ReorderImages(urls []string) error {
+	images := p.OrderedImages()
+	if len(urls) != len(images) {
+		return ErrInvalidImageOrder
+	}
+
+	byURL := make(map[string]ProductImage, len(images))
+	for _, img := range images {
+		byURL[img.URL] = img
+	}
+
+	reordered := make([]ProductImage, 0, len(urls))
+	for i, url := range urls {
+		img, ok := byURL[url]
+		if !ok {
+			return ErrInvalidImageOrder
+		}
+		delete(byURL, url)
+		img.Position = int32(i)
+		reordered = append(reordered, img)
+	}
+
+	p.Images = reordered
+	p.NormalizeImages()
+	return p.ValidateImages()
+}
+
+// SetPrimaryImage marks the image with the given URL as primary and clears
+// the flag on every other image
+func (p *Product) SetPrimaryImage(url string) error {
+	images := p.OrderedImages()
+
+	found := false
+	for i := range images {
+		images[i].IsPrimary = images[i].URL == url
+		if images[i].IsPrimary {
+			found = true
+		}
+	}
+	if !found {
+		return ErrImageNotFound
+	}
+
+	p.Images = images
+	p.NormalizeImages()
+	return nil
+}
+
+// UnprobedImages returns the URLs of the images that have no metadata yet
+func (p *Product) UnprobedImages() []string {
+	var urls []string
+	for _, img := range p.OrderedImages() {
+		if img.Metadata == nil {
+			urls = append(urls, img.URL)
+		}
+	}
+	return urls
+}
+
+// KeepImageMetadata copies the probed metadata of p's images onto the images
+// in images with the same URL, so that an update does not throw it away
+func (p *Product) KeepImageMetadata(images []ProductImage) {
+	probed := make(map[string]*MediaMetadata, len(p.Images))
+	for _, img := range p.Images {
+		if img.Metadata != nil {
+			probed[img.URL] = img.Metadata
+		}
+	}
+	for i := range images {
+		images[i].Metadata = probed[images[i].URL]
+	}
+}
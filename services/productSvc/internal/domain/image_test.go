@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// primaryURLs returns the URLs of the primary images
+func primaryURLs(images []ProductImage) []string {
+	var urls []string
+	for _, img := range images {
+		if img.IsPrimary {
+			urls = append(urls, img.URL)
+		}
+	}
+	return urls
+}
+
+func checkImageInvariants(t *testing.T, p *Product) {
+	t.Helper()
+	if err := p.ValidateImages(); err != nil {
+		t.Fatalf("ValidateImages: %v", err)
+	}
+	if len(p.Images) != len(p.ImageURLs) {
+		t.Fatalf("ImageURLs has %d entries, Images %d", len(p.ImageURLs), len(p.Images))
+	}
+	for i, img := range p.Images {
+		if img.Position != int32(i) {
+			t.Errorf("image %s at index %d has position %d", img.URL, i, img.Position)
+		}
+		if p.ImageURLs[i] != img.URL {
+			t.Errorf("ImageURLs[%d] = %s, want %s", i, p.ImageURLs[i], img.URL)
+		}
+	}
+}
+
+func TestNormalizeImagesDefaultsFirstURLToPrimary(t *testing.T) {
+	p := &Product{ImageURLs: []string{"a.jpg", "b.jpg", "c.jpg"}}
+
+	p.NormalizeImages()
+
+	checkImageInvariants(t, p)
+	if got := primaryURLs(p.Images); !reflect.DeepEqual(got, []string{"a.jpg"}) {
+		t.Errorf("primary = %v, want [a.jpg]", got)
+	}
+}
+
+func TestReorderImages(t *testing.T) {
+	tests := []struct {
+		name    string
+		order   []string
+		want    []string
+		wantErr error
+	}{
+		{name: "reversed", order: []string{"c.jpg", "b.jpg", "a.jpg"}, want: []string{"c.jpg", "b.jpg", "a.jpg"}},
+		{name: "unchanged", order: []string{"a.jpg", "b.jpg", "c.jpg"}, want: []string{"a.jpg", "b.jpg", "c.jpg"}},
+		{name: "missing image", order: []string{"c.jpg", "a.jpg"}, wantErr: ErrInvalidImageOrder},
+		{name: "unknown image", order: []string{"c.jpg", "b.jpg", "x.jpg"}, wantErr: ErrInvalidImageOrder},
+		{name: "image listed twice", order: []string{"a.jpg", "a.jpg", "b.jpg"}, wantErr: ErrInvalidImageOrder},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Product{ImageURLs: []string{"a.jpg", "b.jpg", "c.jpg"}}
+			p.NormalizeImages()
+			if err := p.SetPrimaryImage("b.jpg"); err != nil {
+				t.Fatalf("SetPrimaryImage: %v", err)
+			}
+
+			err := p.ReorderImages(tt.order)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				if got := p.ImageURLs; !reflect.DeepEqual(got, []string{"a.jpg", "b.jpg", "c.jpg"}) {
+					t.Errorf("a rejected reorder changed the images to %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReorderImages: %v", err)
+			}
+
+			checkImageInvariants(t, p)
+			if !reflect.DeepEqual(p.ImageURLs, tt.want) {
+				t.Errorf("order = %v, want %v", p.ImageURLs, tt.want)
+			}
+			if got := primaryURLs(p.Images); !reflect.DeepEqual(got, []string{"b.jpg"}) {
+				t.Errorf("primary = %v, want the primary kept on b.jpg", got)
+			}
+		})
+	}
+}
+
+func TestSetPrimaryImageKeepsExactlyOnePrimary(t *testing.T) {
+	p := &Product{ImageURLs: []string{"a.jpg", "b.jpg", "c.jpg"}}
+	p.NormalizeImages()
+
+	for _, url := range []string{"c.jpg", "a.jpg", "c.jpg"} {
+		if err := p.SetPrimaryImage(url); err != nil {
+			t.Fatalf("SetPrimaryImage(%s): %v", url, err)
+		}
+		checkImageInvariants(t, p)
+		if got := primaryURLs(p.Images); !reflect.DeepEqual(got, []string{url}) {
+			t.Errorf("primary = %v, want [%s]", got, url)
+		}
+		if primary := p.PrimaryImage(); primary == nil || primary.URL != url {
+			t.Errorf("PrimaryImage = %v, want %s", primary, url)
+		}
+	}
+
+	if err := p.SetPrimaryImage("missing.jpg"); !errors.Is(err, ErrImageNotFound) {
+		t.Errorf("err = %v, want %v", err, ErrImageNotFound)
+	}
+	if got := primaryURLs(p.Images); !reflect.DeepEqual(got, []string{"c.jpg"}) {
+		t.Errorf("primary = %v after a failed change, want [c.jpg]", got)
+	}
+}
+
+func TestValidateImages(t *testing.T) {
+	tests := []struct {
+		name    string
+		images  []ProductImage
+		wantErr error
+	}{
+		{name: "no images", images: nil},
+		{name: "one primary", images: []ProductImage{{URL: "a.jpg", IsPrimary: true}, {URL: "b.jpg"}}},
+		{name: "no primary", images: []ProductImage{{URL: "a.jpg"}, {URL: "b.jpg"}}, wantErr: ErrInvalidPrimaryImage},
+		{name: "two primaries", images: []ProductImage{{URL: "a.jpg", IsPrimary: true}, {URL: "b.jpg", IsPrimary: true}}, wantErr: ErrInvalidPrimaryImage},
+		{name: "duplicate URL", images: []ProductImage{{URL: "a.jpg", IsPrimary: true}, {URL: "a.jpg"}}, wantErr: ErrDuplicateImage},
+		{name: "empty URL", images: []ProductImage{{URL: "", IsPrimary: true}}, wantErr: ErrImageURLRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&Product{Images: tt.images}).ValidateImages()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
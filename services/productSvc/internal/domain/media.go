@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// MediaPolicy decides which hosts product images and videos may be served
+// from. Entries are host names, matched exactly, or "*." wildcards that match
+// any subdomain, e.g. "*.cloudfront.net". An empty allow-list accepts every
+// host, so the policy only restricts media once hosts are configured.
+type MediaPolicy struct {
+	hosts     map[string]bool
+	wildcards []string
+}
+
+// NewMediaPolicy creates a media policy from an allow-list of hosts. Entries
+// are matched case-insensitively; ports are not part of the match.
+func NewMediaPolicy(allowedHosts []string) MediaPolicy {
+	policy := MediaPolicy{hosts: make(map[string]bool, len(allowedHosts))}
+	for _, host := range allowedHosts {
+		host = strings.ToLower(strings.TrimSpace(host))
+		switch {
+		case host == "":
+		case strings.HasPrefix(host, "*."):
+			policy.wildcards = append(policy.wildcards, host[1:])
+		default:
+			policy.hosts[host] = true
+		}
+	}
+	return policy
+}
+
+// Restricted reports whether the policy has an allow-list to enforce
+func (p MediaPolicy) Restricted() bool {
+	return len(p.hosts) > 0 || len(p.wildcards) > 0
+}
+
+// CheckProduct checks every image and video URL of a product
+func (p MediaPolicy) CheckProduct(product *Product) error {
+	for _, img := range product.OrderedImages() {
+		if err := p.CheckURL(img.URL); err != nil {
+			return err
+		}
+	}
+	for _, u := range product.VideoURLs {
+		if err := p.CheckURL(u); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckURL rejects media URLs that are not absolute http(s) URLs with
+// ErrInvalidMediaURL, and URLs on hosts outside the allow-list with
+// ErrMediaHostNotAllowed
+func (p MediaPolicy) CheckURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return fmt.Errorf("%w: %q", ErrInvalidMediaURL, raw)
+	}
+	if !p.Restricted() {
+		return nil
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if p.hosts[host] {
+		return nil
+	}
+	for _, suffix := range p.wildcards {
+		if strings.HasSuffix(host, suffix) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrMediaHostNotAllowed, host)
+}
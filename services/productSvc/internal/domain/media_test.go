@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMediaPolicyCheckURL(t *testing.T) {
+	policy := NewMediaPolicy([]string{"images.example.com", " *.CloudFront.net "})
+
+	tests := []struct {
+		url  string
+		want error
+	}{
+		{url: "https://images.example.com/lamp.jpg"},
+		{url: "https://IMAGES.example.com:8443/lamp.jpg"},
+		{url: "https://d1234.cloudfront.net/lamp.mp4"},
+		{url: "https://evil.example.org/lamp.jpg", want: ErrMediaHostNotAllowed},
+		{url: "https://example.com/lamp.jpg", want: ErrMediaHostNotAllowed},
+		{url: "https://evilcloudfront.net/lamp.jpg", want: ErrMediaHostNotAllowed},
+		{url: "http://169.254.169.254/latest/meta-data", want: ErrMediaHostNotAllowed},
+		{url: "ftp://images.example.com/lamp.jpg", want: ErrInvalidMediaURL},
+		{url: "/lamp.jpg", want: ErrInvalidMediaURL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			err := policy.CheckURL(tt.url)
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("CheckURL = %v, want the host allowed", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("CheckURL = %v, want %v", err, tt.want)
+			}
+			if !errors.Is(err, ErrValidation) {
+				t.Errorf("CheckURL = %v, want a validation error", err)
+			}
+		})
+	}
+}
+
+func TestMediaPolicyWithoutAllowListAcceptsAnyHost(t *testing.T) {
+	policy := NewMediaPolicy(nil)
+
+	if policy.Restricted() {
+		t.Fatal("an empty allow-list should not restrict media")
+	}
+	if err := policy.CheckURL("https://anywhere.example.org/lamp.jpg"); err != nil {
+		t.Fatalf("CheckURL = %v, want any host allowed", err)
+	}
+	if err := policy.CheckURL("javascript:alert(1)"); !errors.Is(err, ErrInvalidMediaURL) {
+		t.Fatalf("CheckURL = %v, want URL format still checked", err)
+	}
+}
+
+func TestMediaPolicyCheckProduct(t *testing.T) {
+	policy := NewMediaPolicy([]string{"images.example.com"})
+
+	product := &Product{
+		ImageURLs: []string{"https://images.example.com/lamp.jpg"},
+		VideoURLs: []string{"https://images.example.com/lamp.mp4"},
+	}
+	if err := policy.CheckProduct(product); err != nil {
+		t.Fatalf("CheckProduct = %v, want allowed media accepted", err)
+	}
+
+	product.VideoURLs = append(product.VideoURLs, "https://videos.example.org/lamp.mp4")
+	if err := policy.CheckProduct(product); !errors.Is(err, ErrMediaHostNotAllowed) {
+		t.Fatalf("CheckProduct = %v, want the video host rejected", err)
+	}
+}
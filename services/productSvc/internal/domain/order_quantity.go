@@ -0,0 +1,24 @@
+package domain
+
+import "fmt"
+
+// ValidateOrderQuantities checks that the product's order quantity limits are
+// consistent: none negative, the maximum not below the minimum, and, for
+// case-packed products, both limits whole multiples of the increment
+func (p *Product) ValidateOrderQuantities() error {
+	switch {
+	case p.MinOrderQty < 0 || p.MaxOrderQty < 0 || p.OrderQtyIncrement < 0:
+		return fmt.Errorf("%w: order quantity limits cannot be negative", ErrValidation)
+	case p.MaxOrderQty > 0 && p.MaxOrderQty < p.MinOrderQty:
+		return fmt.Errorf("%w: maximum order quantity %d is below the minimum %d", ErrValidation, p.MaxOrderQty, p.MinOrderQty)
+	}
+	if inc := p.OrderQtyIncrement; inc > 1 {
+		if p.MinOrderQty%inc != 0 {
+			return fmt.Errorf("%w: minimum order quantity %d is not a multiple of the increment %d", ErrValidation, p.MinOrderQty, inc)
+		}
+		if p.MaxOrderQty%inc != 0 {
+			return fmt.Errorf("%w: maximum order quantity %d is not a multiple of the increment %d", ErrValidation, p.MaxOrderQty, inc)
+		}
+	}
+	return nil
+}
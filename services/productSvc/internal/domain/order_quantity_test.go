@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateOrderQuantities(t *testing.T) {
+	tests := []struct {
+		name                string
+		min, max, increment int32
+		wantErr             bool
+	}{
+		{name: "no limits"},
+		{name: "case pack", min: 12, max: 120, increment: 6},
+		{name: "negative", min: -1, wantErr: true},
+		{name: "maximum below minimum", min: 10, max: 5, wantErr: true},
+		{name: "minimum not a multiple", min: 10, increment: 6, wantErr: true},
+		{name: "maximum not a multiple", min: 12, max: 100, increment: 6, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Product{MinOrderQty: tt.min, MaxOrderQty: tt.max, OrderQtyIncrement: tt.increment}
+			err := p.ValidateOrderQuantities()
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("err = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrValidation) {
+				t.Fatalf("err = %v, want ErrValidation", err)
+			}
+		})
+	}
+}
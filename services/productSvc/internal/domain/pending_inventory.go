@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// RetryPolicy bounds the retries of a call to another service
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first
+	MaxAttempts int
+	// Backoff is the wait before the second attempt; it doubles for every
+	// further attempt
+	Backoff time.Duration
+}
+
+// Delay returns the wait after the given number of failed attempts
+func (p RetryPolicy) Delay(failed int) time.Duration {
+	if failed < 1 {
+		return 0
+	}
+	return p.Backoff << (failed - 1)
+}
+
+// PendingInventory is a product whose inventory row could not be created when
+// the product was, waiting to be created by the inventory reconciler
+type PendingInventory struct {
+	ProductID  string    `bson:"_id"`
+	SKU        string    `bson:"sku"`
+	LocationID string    `bson:"location_id"`
+	Attempts   int       `bson:"attempts"`
+	LastError  string    `bson:"last_error"`
+	CreatedAt  time.Time `bson:"created_at"`
+	UpdatedAt  time.Time `bson:"updated_at"`
+}
+
+// PendingInventoryQueue holds the products still waiting for an inventory row
+type PendingInventoryQueue interface {
+	// Enqueue adds the product to the queue, or records another failed
+	// attempt when it is queued already
+	Enqueue(ctx context.Context, item *PendingInventory) error
+
+	// List returns up to limit queued products, oldest first
+	List(ctx context.Context, limit int) ([]*PendingInventory, error)
+
+	// Remove takes the product off the queue
+	Remove(ctx context.Context, productID string) error
+}
@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// DefaultMinorUnits is the number of decimal places of currencies that are
+// not listed in the ISO 4217 exceptions below or configured otherwise
+const DefaultMinorUnits = 2
+
+// isoMinorUnits lists the ISO 4217 currencies whose minor unit is not a
+// hundredth
+var isoMinorUnits = map[string]int32{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0,
+	"KRW": 0, "PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0,
+	"XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"CLF": 4, "UYW": 4,
+}
+
+// PricePolicy decides how many decimal places a price in each currency has.
+// Prices are stored with exactly that many, so "9.9", "9.90" and "9.900"
+// are all kept as "9.90" for USD.
+type PricePolicy struct {
+	minorUnits map[string]int32
+}
+
+// NewPricePolicy creates a price policy from the ISO 4217 minor units with
+// overrides, keyed by currency code, taking precedence
+func NewPricePolicy(overrides map[string]int32) PricePolicy {
+	units := make(map[string]int32, len(isoMinorUnits)+len(overrides))
+	for code, places := range isoMinorUnits {
+		units[code] = places
+	}
+	for code, places := range overrides {
+		units[strings.ToUpper(strings.TrimSpace(code))] = places
+	}
+	return PricePolicy{minorUnits: units}
+}
+
+// ParseMinorUnits parses currency precision overrides written as
+// "CODE:places" entries, e.g. ["JPY:0", "KWD:3"]
+func ParseMinorUnits(entries []string) (map[string]int32, error) {
+	overrides := make(map[string]int32, len(entries))
+	for _, entry := range entries {
+		code, places, ok := strings.Cut(entry, ":")
+		code = strings.ToUpper(strings.TrimSpace(code))
+		n, err := strconv.ParseInt(strings.TrimSpace(places), 10, 32)
+		if !ok || len(code) != 3 || err != nil || n < 0 || n > 8 {
+			return nil, fmt.Errorf("invalid currency precision %q, expected e.g. JPY:0", entry)
+		}
+		overrides[code] = int32(n)
+	}
+	return overrides, nil
+}
+
+// MinorUnits returns the number of decimal places of currency
+func (p PricePolicy) MinorUnits(currency string) int32 {
+	if places, ok := p.minorUnits[strings.ToUpper(currency)]; ok {
+		return places
+	}
+	return DefaultMinorUnits
+}
+
+// Normalize rewrites a price with exactly the currency's number of decimal
+// places. Prices that need more places, such as "9.999" for USD, fail with
+// ErrPricePrecision; trailing zeros beyond them are dropped.
+func (p PricePolicy) Normalize(price, currency string) (string, error) {
+	value, err := parseDecimal(price)
+	if err != nil {
+		return "", err
+	}
+	places := p.MinorUnits(currency)
+	if !value.Equal(value.Round(places)) {
+		return "", fmt.Errorf("%w: %s allows %d decimal places, got %q", ErrPricePrecision, strings.ToUpper(currency), places, price)
+	}
+	return value.StringFixed(places), nil
+}
+
+// Format returns a stored price with the currency's number of decimal places
+// for a response. Prices written before they were normalized are rounded;
+// values that are not numbers are returned unchanged.
+func (p PricePolicy) Format(price, currency string) string {
+	value, err := parseDecimal(price)
+	if err != nil {
+		return price
+	}
+	return value.StringFixed(p.MinorUnits(currency))
+}
+
+// NormalizeProduct normalizes the cost and selling price of a product and the
+// price adjustments of its variant options to the product's currency
+func (p PricePolicy) NormalizeProduct(product *Product) error {
+	var err error
+	if product.CostPrice != "" {
+		if product.CostPrice, err = p.Normalize(product.CostPrice, product.Currency); err != nil {
+			return fmt.Errorf("cost price: %w", err)
+		}
+	}
+	if product.SellingPrice != "" {
+		if product.SellingPrice, err = p.Normalize(product.SellingPrice, product.Currency); err != nil {
+			return fmt.Errorf("selling price: %w", err)
+		}
+	}
+	for i := range product.Variants {
+		if err := p.NormalizeOptions(product.Variants[i].Options, product.Currency); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NormalizeOptions normalizes the price adjustments of variant options
+func (p PricePolicy) NormalizeOptions(options []VariantOption, currency string) error {
+	for i := range options {
+		if options[i].PriceAdjustment == "" {
+			continue
+		}
+		adjusted, err := p.Normalize(options[i].PriceAdjustment, currency)
+		if err != nil {
+			return fmt.Errorf("price adjustment of option %s: %w", options[i].Name, err)
+		}
+		options[i].PriceAdjustment = adjusted
+	}
+	return nil
+}
+
+// parseDecimal parses a non-negative price the way ParsePrice does, without
+// rounding it
+func parseDecimal(price string) (decimal.Decimal, error) {
+	price = strings.TrimSpace(price)
+	if price == "" {
+		return decimal.Zero, fmt.Errorf("%w: price cannot be empty", ErrInvalidPrice)
+	}
+	price = strings.ReplaceAll(price, "$", "")
+	price = strings.ReplaceAll(price, ",", "")
+
+	value, err := decimal.NewFromString(price)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("%w: %q", ErrInvalidPrice, price)
+	}
+	if value.IsNegative() {
+		return decimal.Zero, fmt.Errorf("%w: price cannot be negative", ErrInvalidPrice)
+	}
+	return value, nil
+}
@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPricePolicyNormalize(t *testing.T) {
+	policy := NewPricePolicy(map[string]int32{"xts": 1})
+
+	tests := []struct {
+		price, currency string
+		want            string
+		wantErr         error
+	}{
+		{price: "9.9", currency: "USD", want: "9.90"},
+		{price: "9.900", currency: "usd", want: "9.90"},
+		{price: "9", currency: "EUR", want: "9.00"},
+		{price: "$1,299.5", currency: "USD", want: "1299.50"},
+		{price: "9.999", currency: "USD", wantErr: ErrPricePrecision},
+		{price: "1500", currency: "JPY", want: "1500"},
+		{price: "1500.00", currency: "JPY", want: "1500"},
+		{price: "1500.5", currency: "JPY", wantErr: ErrPricePrecision},
+		{price: "1.234", currency: "KWD", want: "1.234"},
+		{price: "2.5", currency: "XTS", want: "2.5"},
+		{price: "2.55", currency: "XTS", wantErr: ErrPricePrecision},
+		{price: "abc", currency: "USD", wantErr: ErrInvalidPrice},
+		{price: "-1.00", currency: "USD", wantErr: ErrInvalidPrice},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.price+" "+tt.currency, func(t *testing.T) {
+			got, err := policy.Normalize(tt.price, tt.currency)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Normalize = %q, %v, want %v", got, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Fatalf("Normalize = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPricePolicyFormat(t *testing.T) {
+	policy := NewPricePolicy(nil)
+
+	if got := policy.Format("9.9", "USD"); got != "9.90" {
+		t.Errorf("Format(9.9 USD) = %q, want 9.90", got)
+	}
+	// Prices stored before normalization may carry too many places
+	if got := policy.Format("9.999", "USD"); got != "10.00" {
+		t.Errorf("Format(9.999 USD) = %q, want 10.00", got)
+	}
+	if got := policy.Format("not a price", "USD"); got != "not a price" {
+		t.Errorf("Format(not a price) = %q, want it unchanged", got)
+	}
+}
+
+func TestPricePolicyNormalizeProduct(t *testing.T) {
+	policy := NewPricePolicy(nil)
+	product := &Product{
+		Currency:     "USD",
+		CostPrice:    "4.5",
+		SellingPrice: "9.9",
+		Variants: []Variant{{Name: "Size", Options: []VariantOption{
+			{Name: "L", PriceAdjustment: "1"},
+			{Name: "M"},
+		}}},
+	}
+
+	if err := policy.NormalizeProduct(product); err != nil {
+		t.Fatal(err)
+	}
+	if product.CostPrice != "4.50" || product.SellingPrice != "9.90" || product.Variants[0].Options[0].PriceAdjustment != "1.00" {
+		t.Fatalf("prices = %s / %s / %s, want 4.50 / 9.90 / 1.00",
+			product.CostPrice, product.SellingPrice, product.Variants[0].Options[0].PriceAdjustment)
+	}
+	if product.Variants[0].Options[1].PriceAdjustment != "" {
+		t.Error("an option without an adjustment should keep none")
+	}
+
+	product.SellingPrice = "9.999"
+	if err := policy.NormalizeProduct(product); !errors.Is(err, ErrPricePrecision) {
+		t.Fatalf("err = %v, want ErrPricePrecision", err)
+	}
+}
+
+func TestParseMinorUnits(t *testing.T) {
+	got, err := ParseMinorUnits([]string{"jpy:0", " KWD : 3 "})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]int32{"JPY": 0, "KWD": 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("overrides = %v, want %v", got, want)
+	}
+
+	for _, entry := range []string{"JPY", "YENS:0", "USD:-1", "USD:two", "USD:9"} {
+		if _, err := ParseMinorUnits([]string{entry}); err == nil {
+			t.Errorf("ParseMinorUnits(%q) should fail", entry)
+		}
+	}
+}
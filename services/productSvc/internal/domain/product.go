@@ -25,14 +25,41 @@ type Product struct {
 	SupplierID    string                 `bson:"supplier_id" json:"supplier_id" validate:"required"`
 	IsActive      bool                   `bson:"is_active" json:"is_active"`
 	IsVisible     map[string]bool        `bson:"is_visible,omitempty" json:"is_visible,omitempty"`
+	// IsPublished is managed by PublishProducts; new products start unpublished
+	IsPublished   bool                   `bson:"is_published" json:"is_published"`
 	Variants      []Variant              `bson:"variants,omitempty" json:"variants,omitempty"`
+	// BundleComponents makes the product a bundle; it is managed by
+	// SetBundleComponents and ignored by Update
+	BundleComponents []BundleComponent `bson:"bundle_components,omitempty" json:"bundle_components,omitempty"`
 
+	Images        []ProductImage         `bson:"images,omitempty" json:"images,omitempty"`
 	ImageURLs     []string               `bson:"image_urls,omitempty" json:"image_urls,omitempty"`
 	VideoURLs     []string               `bson:"video_urls,omitempty" json:"video_urls,omitempty"`
 	Metadata      map[string]interface{} `bson:"metadata,omitempty" json:"metadata,omitempty"`
 	CreatedAt     time.Time              `bson:"created_at" json:"created_at"`
 	UpdatedAt     time.Time              `bson:"updated_at" json:"updated_at"`
 	DeletedAt     *time.Time             `bson:"deleted_at,omitempty" json:"deleted_at,omitempty"`
+
+	// MinOrderQty, MaxOrderQty and OrderQtyIncrement limit the quantity of
+	// the product on one order line; zero means no limit. See ValidateOrderQuantities.
+	MinOrderQty       int32 `bson:"min_order_qty,omitempty" json:"min_order_qty,omitempty"`
+	MaxOrderQty       int32 `bson:"max_order_qty,omitempty" json:"max_order_qty,omitempty"`
+	OrderQtyIncrement int32 `bson:"order_qty_increment,omitempty" json:"order_qty_increment,omitempty"`
+
+	// CreatedBy and UpdatedBy are the user IDs of whoever created and last
+	// edited the product. CreatedBy never changes after creation.
+	CreatedBy string `bson:"created_by,omitempty" json:"created_by,omitempty"`
+	UpdatedBy string `bson:"updated_by,omitempty" json:"updated_by,omitempty"`
+
+	// Rating summarizes the approved reviews of the product. It is managed
+	// by the review service and ignored by Update.
+	Rating RatingSummary `bson:"rating" json:"rating"`
+
+	// Version is incremented by every change to the fields Update writes.
+	// Update only saves a product still at the version it was read at, so
+	// two editors cannot overwrite each other's changes. Products stored
+	// before versioning have version 0.
+	Version int64 `bson:"version" json:"version"`
 }
 
 
@@ -129,15 +156,32 @@ type ProductRepository interface {
 	// Basic CRUD operations
 	Create(ctx context.Context, product *Product) (*Product, error)
 	GetByID(ctx context.Context, id string) (*Product, error)
+	// GetByIDs returns the non-deleted products among ids; unknown and malformed IDs are skipped
+	GetByIDs(ctx context.Context, ids []string) ([]*Product, error)
+	// GetBySKU returns the non-deleted product with exactly the given SKU, which
+	// must already be normalized to upper case
+	GetBySKU(ctx context.Context, sku string) (*Product, error)
 	Update(ctx context.Context, product *Product) error
 	Delete(ctx context.Context, id string) error
 	SoftDelete(ctx context.Context, id string) error
 
 	// Listing and searching
 	List(ctx context.Context, opts *ListOptions) ([]*Product, int64, error)
+	// StreamList passes every product matching opts to fn without loading
+	// them all at once; pagination is ignored and fn errors stop the stream
+	StreamList(ctx context.Context, opts *ListOptions, fn func(*Product) error) error
 	Search(ctx context.Context, query string, opts *ListOptions) ([]*Product, int64, error)
 	GetBySupplier(ctx context.Context, supplierID string, opts *ListOptions) ([]*Product, int64, error)
+	// ReassignSupplier moves every product of one supplier, soft-deleted ones
+	// included, to another and returns how many it moved
+	ReassignSupplier(ctx context.Context, fromSupplierID, toSupplierID string) (int64, error)
 	GetByCategory(ctx context.Context, categoryID string, opts *ListOptions) ([]*Product, int64, error)
+	// CountByCategory returns the number of non-deleted products per category ID
+	CountByCategory(ctx context.Context) (map[string]int64, error)
+	// BulkSetCategories adds, removes or replaces the categories of the
+	// non-deleted products with the given IDs, as mode says, and returns how
+	// many products changed
+	BulkSetCategories(ctx context.Context, productIDs, categoryIDs []string, mode string) (int64, error)
 
 	// Inventory operations
 	UpdateStock(ctx context.Context, id string, quantity int32) error
@@ -150,13 +194,34 @@ type ProductRepository interface {
 
 	// Variant operations
 	UpdateVariantStock(ctx context.Context, productID, variantID string, quantity int32) error
+
+	// Media operations
+	// SetImageMetadata stores the probed metadata of one image of a product.
+	// It is a no-op when the product no longer has an image with that URL.
+	SetImageMetadata(ctx context.Context, productID, imageURL string, metadata *MediaMetadata) error
+
+	// Bundle operations
+	// SetBundleComponents replaces the components of a product; no
+	// components turn it back into a plain product
+	SetBundleComponents(ctx context.Context, productID string, components []BundleComponent, updatedBy string) (*Product, error)
+	// IsBundleComponent reports whether any non-deleted bundle lists productID
+	IsBundleComponent(ctx context.Context, productID string) (bool, error)
+
+	// Search maintenance
+	RebuildSearchIndex(ctx context.Context) (int64, error)
+
+	// Review operations
+	// SetRating stores the rating summary of a product's approved reviews
+	SetRating(ctx context.Context, productID string, rating RatingSummary) error
 }
 
 // ProductUseCase defines the business logic for product operations
 type ProductUseCase interface {
 	// Basic CRUD operations
-	CreateProduct(ctx context.Context, product *Product) (*Product, error)
+	CreateProduct(ctx context.Context, product *Product, locationID string) (*Product, error)
+	CloneProduct(ctx context.Context, sourceID string, overrides *Product, copyVariants bool) (*Product, error)
 	GetProduct(ctx context.Context, id string) (*Product, error)
+	BatchGetProducts(ctx context.Context, ids []string) (found []*Product, missing []string, err error)
 	GetProductBySKU(ctx context.Context, sku string) (*Product, error)
 	GetProductByBarcode(ctx context.Context, barcode string) (*Product, error)
 	UpdateProduct(ctx context.Context, product *Product) error
@@ -182,16 +247,34 @@ type ProductUseCase interface {
 	CalculateMarkup(productID string) (decimal.Decimal, decimal.Decimal, error)
 
 	// Variant management
+	GetVariant(ctx context.Context, productID, variantID string) (*Variant, error)
+	ListVariants(ctx context.Context, productID string) ([]Variant, error)
 	AddVariant(ctx context.Context, productID string, variant *Variant) error
 	UpdateVariant(ctx context.Context, productID string, variant *Variant) error
 	RemoveVariant(ctx context.Context, productID, variantID string) error
 	UpdateVariantStock(ctx context.Context, productID, variantID string, quantity int32) error
 
+	// Bundle management
+	SetBundleComponents(ctx context.Context, productID string, components []BundleComponent, updatedBy string) (*Product, error)
+	RemoveBundle(ctx context.Context, productID, updatedBy string) (*Product, error)
+	GetBundleAvailability(ctx context.Context, productID, locationID string) (*BundleAvailability, error)
+
+	// Image management
+	ReorderProductImages(ctx context.Context, productID string, imageURLs []string) (*Product, error)
+	SetPrimaryProductImage(ctx context.Context, productID, imageURL string) (*Product, error)
+
 	// Visibility and publishing
 	BulkUpdateProductVisibility(ctx context.Context, supplierID string, productIDs []string, isVisible bool) error
 	PublishProducts(ctx context.Context, productIDs []string, publish bool) error
 
+	// Supplier changes
+	ReassignSupplierProducts(ctx context.Context, fromSupplierID, toSupplierID string) (int64, error)
+
+	// Category changes
+	BulkSetCategories(ctx context.Context, productIDs, categoryIDs []string, mode string) (int64, error)
+
 	// Validation and utilities
 	ValidateProduct(product *Product) error
-	GenerateProductReport(ctx context.Context, format string) ([]byte, error)
+	GenerateProductReport(ctx context.Context, format string, filter *ProductFilter, caller Caller) ([]byte, error)
+	RebuildSearchIndex(ctx context.Context) (int64, error)
 }
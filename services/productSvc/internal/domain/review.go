@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MinRating and MaxRating bound the star rating of a review
+const (
+	MinRating = 1
+	MaxRating = 5
+)
+
+// MaxReviewTextLength bounds the text of a review, in characters
+const MaxReviewTextLength = 5000
+
+// Review errors
+var (
+	ErrReviewNotFound  = fmt.Errorf("%w: review not found", ErrNotFound)
+	ErrInvalidRating   = fmt.Errorf("%w: rating must be between %d and %d", ErrValidation, MinRating, MaxRating)
+	ErrReviewTooLong   = fmt.Errorf("%w: review text is longer than %d characters", ErrValidation, MaxReviewTextLength)
+	ErrDuplicateReview = fmt.Errorf("%w: the user has already reviewed this product", ErrAlreadyExists)
+)
+
+// Review is a customer's rating of a product. Reviews start unapproved and
+// are only shown to customers, and counted in the product's rating, once
+// staff approve them.
+type Review struct {
+	ID        string `bson:"_id" json:"id"`
+	ProductID string `bson:"product_id" json:"product_id"`
+	UserID    string `bson:"user_id" json:"user_id"`
+	Rating    int32  `bson:"rating" json:"rating"`
+	Text      string `bson:"text,omitempty" json:"text,omitempty"`
+	// VerifiedPurchase is set when the user had a delivered order containing
+	// the product when the review was written
+	VerifiedPurchase bool `bson:"verified_purchase" json:"verified_purchase"`
+	Approved         bool `bson:"approved" json:"approved"`
+	// ModeratedBy is the user ID of the staff member who last approved or
+	// rejected the review
+	ModeratedBy string     `bson:"moderated_by,omitempty" json:"moderated_by,omitempty"`
+	ModeratedAt *time.Time `bson:"moderated_at,omitempty" json:"moderated_at,omitempty"`
+	CreatedAt   time.Time  `bson:"created_at" json:"created_at"`
+	UpdatedAt   time.Time  `bson:"updated_at" json:"updated_at"`
+}
+
+// NewReview validates a rating and its text and returns an unapproved review
+func NewReview(productID, userID string, rating int32, text string) (*Review, error) {
+	if productID == "" {
+		return nil, fmt.Errorf("%w: product ID is required", ErrValidation)
+	}
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user ID is required", ErrValidation)
+	}
+	if rating < MinRating || rating > MaxRating {
+		return nil, ErrInvalidRating
+	}
+	text = strings.TrimSpace(text)
+	if utf8.RuneCountInString(text) > MaxReviewTextLength {
+		return nil, ErrReviewTooLong
+	}
+
+	now := time.Now()
+	return &Review{
+		ProductID: productID,
+		UserID:    userID,
+		Rating:    rating,
+		Text:      text,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// RatingSummary is the average rating and number of approved reviews of a
+// product, kept on the product so listings need not read its reviews
+type RatingSummary struct {
+	Average float64 `bson:"average" json:"average"`
+	Count   int64   `bson:"count" json:"count"`
+}
+
+// ReviewListOptions selects a page of a product's reviews
+type ReviewListOptions struct {
+	// IncludeUnapproved lists reviews still waiting for moderation too
+	IncludeUnapproved bool
+	Limit             int
+	Offset            int
+}
+
+// ReviewRepository stores product reviews
+type ReviewRepository interface {
+	// Create stores a new review and sets its ID. It returns
+	// ErrDuplicateReview when the user already reviewed the product.
+	Create(ctx context.Context, review *Review) error
+	GetByID(ctx context.Context, id string) (*Review, error)
+	// ListByProduct returns a page of the product's reviews, newest first,
+	// and how many reviews match in total
+	ListByProduct(ctx context.Context, productID string, opts ReviewListOptions) ([]*Review, int64, error)
+	// SetApproved approves or rejects a review and returns the updated review
+	SetApproved(ctx context.Context, id string, approved bool, moderatedBy string) (*Review, error)
+	// Summarize computes the rating summary over the product's approved reviews
+	Summarize(ctx context.Context, productID string) (RatingSummary, error)
+}
+
+// PurchaseVerifier tells whether a user bought a product, which marks their
+// review of it as a verified purchase
+type PurchaseVerifier interface {
+	HasPurchased(ctx context.Context, userID, productID string) (bool, error)
+}
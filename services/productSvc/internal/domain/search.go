@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// DefaultStopWords are dropped from text searches when no list is configured
+var DefaultStopWords = []string{
+	"a", "an", "and", "are", "as", "at", "be", "by", "for", "from", "in",
+	"is", "it", "of", "on", "or", "the", "to", "with",
+}
+
+// SearchPolicy decides how a free-text product search is run. Queries shorter
+// than MinQueryLength, or made up of stop words only, would match almost every
+// product in the text index (or none at all), so they are matched as a prefix
+// of the product name or SKU instead.
+type SearchPolicy struct {
+	MinQueryLength int
+	stopWords      map[string]bool
+}
+
+// NewSearchPolicy creates a search policy. Stop words are matched case-insensitively.
+func NewSearchPolicy(minQueryLength int, stopWords []string) SearchPolicy {
+	words := make(map[string]bool, len(stopWords))
+	for _, w := range stopWords {
+		if w = strings.ToLower(strings.TrimSpace(w)); w != "" {
+			words[w] = true
+		}
+	}
+	return SearchPolicy{MinQueryLength: minQueryLength, stopWords: words}
+}
+
+// Apply rewrites the search term of filter according to the policy. Stop words
+// are removed from the text query; a query that is too short or has nothing
+// left moves to NamePrefix. Queries with quoted phrases are left as they are,
+// since the phrase has to match word for word.
+func (p SearchPolicy) Apply(filter *ProductFilter) {
+	if filter == nil || filter.SearchTerm == "" {
+		return
+	}
+
+	term := strings.TrimSpace(filter.SearchTerm)
+	if strings.Contains(term, `"`) {
+		filter.SearchTerm = term
+		return
+	}
+
+	var kept []string
+	for _, word := range strings.Fields(term) {
+		if !p.stopWords[strings.ToLower(word)] {
+			kept = append(kept, word)
+		}
+	}
+
+	if len(kept) == 0 || utf8.RuneCountInString(term) < p.MinQueryLength {
+		filter.SearchTerm = ""
+		filter.NamePrefix = term
+		return
+	}
+	filter.SearchTerm = strings.Join(kept, " ")
+}
+
+// SearchWeights are the relative weights of the text-indexed product fields.
+// A term found in a field with weight 10 scores ten times as high as the same
+// term in a field with weight 1.
+type SearchWeights struct {
+	Name        int
+	SKU         int
+	Description int
+}
+
+// DefaultSearchWeights rank name matches above SKU matches, and both above
+// matches in the description
+var DefaultSearchWeights = SearchWeights{Name: 10, SKU: 5, Description: 1}
@@ -0,0 +1,34 @@
+package domain
+
+import "testing"
+
+func TestSearchPolicyApply(t *testing.T) {
+	policy := NewSearchPolicy(3, DefaultStopWords)
+
+	tests := []struct {
+		name       string
+		term       string
+		wantSearch string
+		wantPrefix string
+	}{
+		{name: "too short", term: "a", wantPrefix: "a"},
+		{name: "too short after trimming", term: "  ab ", wantPrefix: "ab"},
+		{name: "stop words only", term: "The And Of", wantPrefix: "The And Of"},
+		{name: "stop words stripped", term: "lamp for the desk", wantSearch: "lamp desk"},
+		{name: "normal query unchanged", term: "wireless mouse", wantSearch: "wireless mouse"},
+		{name: "quoted phrase kept", term: `"the lamp"`, wantSearch: `"the lamp"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := &ProductFilter{SearchTerm: tt.term}
+			policy.Apply(filter)
+			if filter.SearchTerm != tt.wantSearch {
+				t.Errorf("SearchTerm = %q, want %q", filter.SearchTerm, tt.wantSearch)
+			}
+			if filter.NamePrefix != tt.wantPrefix {
+				t.Errorf("NamePrefix = %q, want %q", filter.NamePrefix, tt.wantPrefix)
+			}
+		})
+	}
+}
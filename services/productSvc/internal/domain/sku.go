@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+)
+
+// SKUStrategy selects how SKUs are generated for products created without one
+type SKUStrategy string
+
+const (
+	// SKUStrategyUUID uses the first 8 characters of a random UUID
+	SKUStrategyUUID SKUStrategy = "uuid"
+	// SKUStrategyCategory prefixes a sequence number with the product's first category
+	SKUStrategyCategory SKUStrategy = "category"
+	// SKUStrategySupplier prefixes a sequence number with the product's supplier
+	SKUStrategySupplier SKUStrategy = "supplier"
+)
+
+// ParseSKUStrategy validates a configured SKU strategy name
+func ParseSKUStrategy(name string) (SKUStrategy, error) {
+	switch strategy := SKUStrategy(name); strategy {
+	case SKUStrategyUUID, SKUStrategyCategory, SKUStrategySupplier:
+		return strategy, nil
+	default:
+		return "", fmt.Errorf("unknown SKU strategy %q (want uuid, category or supplier)", name)
+	}
+}
+
+// SKUSequence hands out increasing numbers per SKU prefix. Numbers are never
+// handed out twice, even to concurrent callers.
+type SKUSequence interface {
+	Next(ctx context.Context, prefix string) (int64, error)
+}
@@ -0,0 +1,148 @@
+// Package mediaprobe reads the metadata of product media over HTTP.
+package mediaprobe
+
+import (
+	"context"
+	"fmt"
+	"image"
+	_ "image/gif"  // Register GIF for image.DecodeConfig
+	_ "image/jpeg" // Register JPEG for image.DecodeConfig
+	_ "image/png"  // Register PNG for image.DecodeConfig
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// headerBytes is how much of an image is fetched to read its dimensions.
+// Every supported format keeps them in the first few hundred bytes, but
+// JPEGs may carry large EXIF blocks ahead of the frame header.
+const headerBytes = 64 << 10
+
+// HTTPProber probes media with a HEAD request for the content type and
+// size, followed for images by a ranged GET of the first headerBytes to read
+// the dimensions. The file itself is never downloaded in full.
+type HTTPProber struct {
+	client *http.Client
+}
+
+// Ensure HTTPProber implements MediaProber
+var _ domain.MediaProber = (*HTTPProber)(nil)
+
+// NewHTTPProber creates a prober using client, or http.DefaultClient when nil
+func NewHTTPProber(client *http.Client) *HTTPProber {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &HTTPProber{client: client}
+}
+
+// Probe returns the metadata of the media at url
+func (p *HTTPProber) Probe(ctx context.Context, url string) (*domain.MediaMetadata, error) {
+	metadata := &domain.MediaMetadata{ByteSize: -1}
+
+	resp, err := p.do(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusMethodNotAllowed || resp.StatusCode == http.StatusNotImplemented:
+		// Some hosts only answer GET; the ranged GET below fills in the rest
+	case resp.StatusCode/100 != 2:
+		return nil, fmt.Errorf("HEAD %s: %s", url, resp.Status)
+	default:
+		metadata.ContentType = contentType(resp.Header)
+		metadata.ByteSize = resp.ContentLength
+	}
+
+	if metadata.ContentType == "" || strings.HasPrefix(metadata.ContentType, "image/") {
+		// Without a HEAD answer there is nothing to report; otherwise the
+		// dimensions are simply left out
+		if err := p.readImageHeader(ctx, url, metadata); err != nil && metadata.ContentType == "" {
+			return nil, err
+		}
+	}
+
+	if metadata.ByteSize < 0 {
+		metadata.ByteSize = 0
+	}
+	metadata.ProbedAt = time.Now()
+	return metadata, nil
+}
+
+// readImageHeader fetches the start of an image and reads its dimensions,
+// and the content type and size when HEAD did not provide them. Formats that
+// cannot be decoded are left without dimensions.
+func (p *HTTPProber) readImageHeader(ctx context.Context, url string, metadata *domain.MediaMetadata) error {
+	resp, err := p.do(ctx, http.MethodGet, url, http.Header{
+		"Range": {fmt.Sprintf("bytes=0-%d", headerBytes-1)},
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		if size, ok := totalFromContentRange(resp.Header.Get("Content-Range")); ok && metadata.ByteSize < 0 {
+			metadata.ByteSize = size
+		}
+	case http.StatusOK:
+		// The host ignored the range; only the first headerBytes are read
+		if metadata.ByteSize < 0 {
+			metadata.ByteSize = resp.ContentLength
+		}
+	default:
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	if metadata.ContentType == "" {
+		metadata.ContentType = contentType(resp.Header)
+	}
+
+	config, _, err := image.DecodeConfig(io.LimitReader(resp.Body, headerBytes))
+	if err == nil {
+		metadata.Width = int32(config.Width)
+		metadata.Height = int32(config.Height)
+	}
+	return nil
+}
+
+// do sends a request with the given extra headers
+func (p *HTTPProber) do(ctx context.Context, method, url string, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	return p.client.Do(req)
+}
+
+// contentType returns the media type of a response without its parameters
+func contentType(header http.Header) string {
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		return ""
+	}
+	return mediaType
+}
+
+// totalFromContentRange reads the complete length from a Content-Range
+// header such as "bytes 0-65535/1048576"
+func totalFromContentRange(value string) (int64, bool) {
+	i := strings.LastIndexByte(value, '/')
+	if i < 0 {
+		return 0, false
+	}
+	size, err := strconv.ParseInt(value[i+1:], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return size, true
+}
@@ -2,11 +2,13 @@ package mongodb
 
 import (
 	"context"
+	"regexp"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.uber.org/zap"
 
 	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
@@ -121,3 +123,113 @@ func (r *categoryRepository) List(ctx context.Context, parentID string, depth in
 
 	return categories, nil
 }
+
+func (r *categoryRepository) ListAll(ctx context.Context) ([]*domain.Category, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{})
+	if err != nil {
+		r.logger.Error("Failed to list all categories", zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var categories []*domain.Category
+	if err := cursor.All(ctx, &categories); err != nil {
+		r.logger.Error("Failed to decode categories", zap.Error(err))
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (r *categoryRepository) ListDescendants(ctx context.Context, id string) ([]*domain.Category, error) {
+	// Paths hold the ancestor IDs separated by slashes
+	filter := bson.M{"path": bson.M{"$regex": "(^|/)" + regexp.QuoteMeta(id) + "(/|$)"}}
+
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		r.logger.Error("Failed to list descendant categories", zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var categories []*domain.Category
+	if err := cursor.All(ctx, &categories); err != nil {
+		r.logger.Error("Failed to decode categories", zap.Error(err))
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (r *categoryRepository) UpdateHierarchy(ctx context.Context, categories []*domain.Category) error {
+	if len(categories) == 0 {
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(categories))
+	for _, category := range categories {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": category.ID}).
+			SetUpdate(bson.M{"$set": bson.M{
+				"parent_id":  category.ParentID,
+				"level":      category.Level,
+				"path":       category.Path,
+				"updated_at": category.UpdatedAt,
+			}}))
+	}
+
+	if _, err := r.collection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false)); err != nil {
+		r.logger.Error("Failed to update category hierarchy", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *categoryRepository) AdjustProductCounts(ctx context.Context, categoryIDs []string, delta int64) error {
+	objectIDs := make([]primitive.ObjectID, 0, len(categoryIDs))
+	for _, id := range categoryIDs {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			// Products may reference categories by IDs that were never valid;
+			// there is no counter to adjust for those
+			continue
+		}
+		objectIDs = append(objectIDs, objectID)
+	}
+	if len(objectIDs) == 0 {
+		return nil
+	}
+
+	_, err := r.collection.UpdateMany(ctx,
+		bson.M{"_id": bson.M{"$in": objectIDs}},
+		bson.M{"$inc": bson.M{"product_count": delta}},
+	)
+	if err != nil {
+		r.logger.Error("Failed to adjust category product counts", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *categoryRepository) SetProductCount(ctx context.Context, id string, count int64) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return domain.ErrInvalidID
+	}
+
+	result, err := r.collection.UpdateOne(ctx,
+		bson.M{"_id": objectID},
+		bson.M{"$set": bson.M{"product_count": count}},
+	)
+	if err != nil {
+		r.logger.Error("Failed to set category product count", zap.Error(err))
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
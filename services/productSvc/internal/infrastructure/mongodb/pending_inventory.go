@@ -0,0 +1,79 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// PendingInventoryRepository keeps one document per product waiting for its
+// inventory row
+type PendingInventoryRepository struct {
+	collection *mongo.Collection
+}
+
+// Ensure PendingInventoryRepository implements domain.PendingInventoryQueue
+var _ domain.PendingInventoryQueue = (*PendingInventoryRepository)(nil)
+
+// NewPendingInventoryRepository creates a new MongoDB pending inventory queue
+func NewPendingInventoryRepository(db *mongo.Database) *PendingInventoryRepository {
+	return &PendingInventoryRepository{
+		collection: db.Collection("pending_inventory"),
+	}
+}
+
+// Enqueue upserts the product's queue entry and counts the failed attempt
+func (r *PendingInventoryRepository) Enqueue(ctx context.Context, item *domain.PendingInventory) error {
+	now := time.Now()
+	_, err := r.collection.UpdateOne(ctx,
+		bson.M{"_id": item.ProductID},
+		bson.M{
+			"$set": bson.M{
+				"sku":         item.SKU,
+				"location_id": item.LocationID,
+				"last_error":  item.LastError,
+				"updated_at":  now,
+			},
+			"$inc":         bson.M{"attempts": item.Attempts},
+			"$setOnInsert": bson.M{"created_at": now},
+		},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to queue inventory creation for product %s: %w", item.ProductID, err)
+	}
+	return nil
+}
+
+// List returns up to limit queued products, oldest first
+func (r *PendingInventoryRepository) List(ctx context.Context, limit int) ([]*domain.PendingInventory, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending inventory: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var items []*domain.PendingInventory
+	if err := cursor.All(ctx, &items); err != nil {
+		return nil, fmt.Errorf("failed to decode pending inventory: %w", err)
+	}
+	return items, nil
+}
+
+// Remove deletes the product's queue entry
+func (r *PendingInventoryRepository) Remove(ctx context.Context, productID string) error {
+	if _, err := r.collection.DeleteOne(ctx, bson.M{"_id": productID}); err != nil {
+		return fmt.Errorf("failed to dequeue product %s: %w", productID, err)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package mongodb
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+func TestBulkSetCategories(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	productIDs := []string{primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex()}
+	categoryIDs := []string{"lamps", "lights"}
+
+	tests := []struct {
+		mode       string
+		operator   string
+		filterPath string
+	}{
+		{mode: domain.CategoryModeAdd, operator: "$addToSet", filterPath: "category_ids"},
+		{mode: domain.CategoryModeRemove, operator: "$pull", filterPath: "category_ids"},
+		{mode: domain.CategoryModeReplace, operator: "$set", filterPath: "$nor"},
+	}
+	for _, tt := range tests {
+		mt.Run(tt.mode, func(mt *mtest.T) {
+			r := &ProductRepository{collection: mt.Coll, logger: zap.NewNop()}
+			mt.AddMockResponses(mtest.CreateSuccessResponse(
+				bson.E{Key: "n", Value: 2},
+				bson.E{Key: "nModified", Value: 1},
+			))
+
+			count, err := r.BulkSetCategories(context.Background(), productIDs, categoryIDs, tt.mode)
+			if err != nil {
+				mt.Fatal(err)
+			}
+			if count != 1 {
+				mt.Errorf("count = %d, want the modified count 1", count)
+			}
+
+			event := mt.GetStartedEvent()
+			if event.CommandName != "update" {
+				mt.Fatalf("command = %s, want update", event.CommandName)
+			}
+			updates := event.Command.Lookup("updates").Array()
+			values, err := updates.Values()
+			if err != nil || len(values) != 1 {
+				mt.Fatalf("updates = %v, want one UpdateMany", updates)
+			}
+			statement := values[0].Document()
+			if multi, ok := statement.Lookup("multi").BooleanOK(); !ok || !multi {
+				mt.Errorf("update %v should change many documents", statement)
+			}
+			filter := statement.Lookup("q").Document()
+			if _, err := filter.LookupErr("deleted_at"); err != nil {
+				mt.Errorf("filter = %v, should leave out deleted products", filter)
+			}
+			if _, err := filter.LookupErr(tt.filterPath); err != nil {
+				mt.Errorf("filter = %v, should skip products the change leaves as they are", filter)
+			}
+			update := statement.Lookup("u").Document()
+			if _, err := update.LookupErr(tt.operator, "category_ids"); err != nil {
+				mt.Errorf("update = %v, want category_ids changed with %s", update, tt.operator)
+			}
+			if _, err := update.LookupErr("$set", "updated_at"); err != nil {
+				mt.Errorf("update = %v, should set updated_at", update)
+			}
+		})
+	}
+
+	mt.Run("invalid product ID", func(mt *mtest.T) {
+		r := &ProductRepository{collection: mt.Coll, logger: zap.NewNop()}
+		if _, err := r.BulkSetCategories(context.Background(), []string{"nope"}, categoryIDs, domain.CategoryModeAdd); err != domain.ErrInvalidID {
+			mt.Fatalf("err = %v, want ErrInvalidID", err)
+		}
+		if events := mt.GetAllStartedEvents(); len(events) != 0 {
+			mt.Fatalf("sent %d commands, want none", len(events))
+		}
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -17,16 +19,49 @@ import (
 
 // ProductRepository is a MongoDB implementation of the ProductRepository interface
 type ProductRepository struct {
-	collection *mongo.Collection
-	logger     *zap.Logger
+	collection    *mongo.Collection
+	searchWeights domain.SearchWeights
+	logger        *zap.Logger
 }
 
-// NewProductRepository creates a new MongoDB product repository
-func NewProductRepository(db *mongo.Database, logger *zap.Logger) *ProductRepository {
-	return &ProductRepository{
-		collection: db.Collection("products"),
-		logger:     logger.With(zap.String("component", "mongodb.ProductRepository")),
+// skuIndexName is the name of the unique index on product SKUs
+const skuIndexName = "sku_unique"
+
+// NewProductRepository creates a new MongoDB product repository. The text
+// index is created with searchWeights if the collection has none yet.
+func NewProductRepository(db *mongo.Database, searchWeights domain.SearchWeights, logger *zap.Logger) *ProductRepository {
+	r := &ProductRepository{
+		collection:    db.Collection("products"),
+		searchWeights: searchWeights,
+		logger:        logger.With(zap.String("component", "mongodb.ProductRepository")),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "sku", Value: 1}},
+		Options: options.Index().SetName(skuIndexName).SetUnique(true),
+	})
+	if err != nil {
+		// Usually means existing products already share a SKU
+		r.logger.Warn("Failed to create unique SKU index", zap.Error(err))
+	}
+
+	_, err = r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "bundle_components.product_id", Value: 1}},
+	})
+	if err != nil {
+		r.logger.Warn("Failed to create bundle component index", zap.Error(err))
+	}
+
+	if _, err := r.collection.Indexes().CreateOne(ctx, r.textIndexModel(productTextIndexName)); err != nil {
+		// An older text index, or one with other weights, is left in place
+		// until RebuildSearchIndex replaces it
+		r.logger.Warn("Failed to create product text index", zap.Error(err))
 	}
+
+	return r
 }
 
 // Create creates a new product in the database
@@ -39,11 +74,15 @@ func (r *ProductRepository) Create(ctx context.Context, product *domain.Product)
 	if product.UpdatedAt.IsZero() {
 		product.UpdatedAt = now
 	}
+	product.Version = 1
 
 	// Insert the product
 	result, err := r.collection.InsertOne(ctx, product)
 	if err != nil {
 		if mongo.IsDuplicateKeyError(err) {
+			if strings.Contains(err.Error(), skuIndexName) {
+				return nil, domain.ErrSKUAlreadyExists
+			}
 			return nil, domain.ErrProductAlreadyExists
 		}
 		return nil, fmt.Errorf("failed to insert product: %w", err)
@@ -80,6 +119,50 @@ func (r *ProductRepository) GetByID(ctx context.Context, id string) (*domain.Pro
 	return &product, nil
 }
 
+// GetBySKU retrieves a product by its exact SKU. SKUs are stored upper-cased,
+// so this is an equality match served by the unique SKU index.
+func (r *ProductRepository) GetBySKU(ctx context.Context, sku string) (*domain.Product, error) {
+	var product domain.Product
+	err := r.collection.FindOne(ctx, bson.M{"sku": sku, "deleted_at": bson.M{"$exists": false}}).Decode(&product)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, domain.ErrProductNotFound
+		}
+		return nil, fmt.Errorf("failed to find product by SKU: %w", err)
+	}
+
+	return &product, nil
+}
+
+// GetByIDs retrieves the non-deleted products with the given IDs in a single
+// query. IDs that are not valid ObjectIDs cannot match and are skipped.
+func (r *ProductRepository) GetByIDs(ctx context.Context, ids []string) ([]*domain.Product, error) {
+	objIDs := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		objID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			continue
+		}
+		objIDs = append(objIDs, objID)
+	}
+	if len(objIDs) == 0 {
+		return []*domain.Product{}, nil
+	}
+
+	filter := bson.M{"_id": bson.M{"$in": objIDs}, "deleted_at": bson.M{"$exists": false}}
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find products: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var products []*domain.Product
+	if err := cursor.All(ctx, &products); err != nil {
+		return nil, fmt.Errorf("failed to decode products: %w", err)
+	}
+	return products, nil
+}
+
 // Update updates an existing product
 func (r *ProductRepository) Update(ctx context.Context, product *domain.Product) error {
 	if product.ID.IsZero() {
@@ -105,17 +188,24 @@ func (r *ProductRepository) Update(ctx context.Context, product *domain.Product)
 			"is_visible":     product.IsVisible,
 			"variants":       product.Variants,
 
+			"min_order_qty":       product.MinOrderQty,
+			"max_order_qty":       product.MaxOrderQty,
+			"order_qty_increment": product.OrderQtyIncrement,
+
+			"images":         product.Images,
 			"image_urls":     product.ImageURLs,
 			"video_urls":     product.VideoURLs,
 			"metadata":       product.Metadata,
 			"updated_at":     product.UpdatedAt,
+			"updated_by":     product.UpdatedBy,
+			"version":        product.Version + 1,
 		},
 	}
 
 	// Execute update
 	result, err := r.collection.UpdateOne(
 		ctx,
-		bson.M{"_id": product.ID, "deleted_at": bson.M{"$exists": false}},
+		bson.M{"_id": product.ID, "deleted_at": bson.M{"$exists": false}, "version": versionFilter(product.Version)},
 		update,
 	)
 	if err != nil {
@@ -126,12 +216,31 @@ func (r *ProductRepository) Update(ctx context.Context, product *domain.Product)
 	}
 
 	if result.MatchedCount == 0 {
-		return domain.ErrProductNotFound
+		// Tell a product that is gone from one saved by someone else since
+		// it was read
+		count, err := r.collection.CountDocuments(ctx, bson.M{"_id": product.ID, "deleted_at": bson.M{"$exists": false}})
+		if err != nil {
+			return fmt.Errorf("failed to update product: %w", err)
+		}
+		if count == 0 {
+			return domain.ErrProductNotFound
+		}
+		return fmt.Errorf("%w: product %s is no longer at version %d", domain.ErrConflict, product.ID.Hex(), product.Version)
 	}
 
+	product.Version++
 	return nil
 }
 
+// versionFilter matches products at version, where products stored before
+// versioning, which have no version field, count as version 0
+func versionFilter(version int64) interface{} {
+	if version == 0 {
+		return bson.M{"$in": bson.A{0, nil}}
+	}
+	return version
+}
+
 // Delete permanently deletes a product
 func (r *ProductRepository) Delete(ctx context.Context, id string) error {
 	// Parse the ID
@@ -170,6 +279,7 @@ func (r *ProductRepository) SoftDelete(ctx context.Context, id string) error {
 				"deleted_at": time.Now(),
 				"updated_at": time.Now(),
 			},
+			"$inc": bson.M{"version": 1},
 		},
 	)
 	if err != nil {
@@ -185,8 +295,118 @@ func (r *ProductRepository) SoftDelete(ctx context.Context, id string) error {
 
 // List retrieves a list of products with pagination and filtering
 func (r *ProductRepository) List(ctx context.Context, opts *domain.ListOptions) ([]*domain.Product, int64, error) {
+	filter, findOptions, err := listQuery(opts)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Apply pagination if provided
+	if opts != nil && opts.Pagination != nil {
+		if opts.Pagination.PageSize > 0 {
+			findOptions.SetLimit(int64(opts.Pagination.PageSize))
+			if opts.Pagination.Page > 0 {
+				findOptions.SetSkip(int64((opts.Pagination.Page - 1) * opts.Pagination.PageSize))
+			}
+		}
+	}
+
+	// Apply sorting if provided
+	if opts != nil && opts.Sort != nil {
+		findOptions.SetSort(sortDocument(opts.Sort))
+	}
+
+	// Count total matching documents
+	total, err := r.collection.CountDocuments(ctx, filter)
+	if isMissingTextIndex(err) {
+		r.logger.Warn("Product text index missing, searching by pattern until it is rebuilt")
+		withoutTextSearch(filter, findOptions, opts)
+		total, err = r.collection.CountDocuments(ctx, filter)
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count products: %w", err)
+	}
+
+	// Find products
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to find products: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	// Decode products
+	var products []*domain.Product
+	if err := cursor.All(ctx, &products); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode products: %w", err)
+	}
+
+	return products, total, nil
+}
+
+// streamBatchSize is the number of products fetched from MongoDB per cursor
+// batch while streaming
+const streamBatchSize = 200
+
+// StreamList passes every product matching the filter of opts to fn, in the
+// order of opts.Sort, reading them from a cursor in batches instead of
+// loading them all. Pagination is ignored. Streaming stops at the first error
+// from fn or when ctx is done; the cursor is closed either way.
+func (r *ProductRepository) StreamList(ctx context.Context, opts *domain.ListOptions, fn func(*domain.Product) error) error {
+	filter, findOptions, err := listQuery(opts)
+	if err != nil {
+		return err
+	}
+	if opts != nil && opts.Sort != nil {
+		findOptions.SetSort(sortDocument(opts.Sort))
+	}
+	findOptions.SetBatchSize(streamBatchSize)
+
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if isMissingTextIndex(err) {
+		r.logger.Warn("Product text index missing, searching by pattern until it is rebuilt")
+		withoutTextSearch(filter, findOptions, opts)
+		cursor, err = r.collection.Find(ctx, filter, findOptions)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find products: %w", err)
+	}
+	defer func() {
+		// ctx may already be cancelled; the server-side cursor is killed
+		// with a context of its own
+		closeCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := cursor.Close(closeCtx); err != nil {
+			r.logger.Warn("Failed to close product cursor", zap.Error(err))
+		}
+	}()
+
+	for cursor.Next(ctx) {
+		var product domain.Product
+		if err := cursor.Decode(&product); err != nil {
+			return fmt.Errorf("failed to decode product: %w", err)
+		}
+		if err := fn(&product); err != nil {
+			return err
+		}
+		// The driver does not check ctx before fetching the next batch
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := cursor.Err(); err != nil {
+		return fmt.Errorf("failed to read products: %w", err)
+	}
+	return nil
+}
+
+// listQuery builds the filter of List and StreamList from the filter of
+// opts. Text searches without an explicit sort are ordered by relevance.
+func listQuery(opts *domain.ListOptions) (bson.M, *options.FindOptions, error) {
 	// Build the base filter
 	filter := bson.M{"deleted_at": bson.M{"$exists": false}}
+	findOptions := options.Find()
 
 	// Apply filters from ListOptions if provided
 	if opts != nil && opts.Filter != nil {
@@ -210,6 +430,39 @@ func (r *ProductRepository) List(ctx context.Context, opts *domain.ListOptions)
 		// Apply search term
 		if opts.Filter.SearchTerm != "" {
 			filter["$text"] = bson.M{"$search": opts.Filter.SearchTerm}
+			if opts.Sort == nil {
+				// Without an explicit sort, best matches come first; the
+				// weighted text index ranks name matches highest. Equal
+				// scores are ordered by ID so pages never overlap.
+				score := bson.M{"$meta": "textScore"}
+				findOptions.SetProjection(bson.M{"score": score})
+				findOptions.SetSort(bson.D{{Key: "score", Value: score}, {Key: "_id", Value: 1}})
+			}
+		}
+		if opts.Filter.NamePrefix != "" {
+			prefix := primitive.Regex{Pattern: "^" + regexp.QuoteMeta(opts.Filter.NamePrefix), Options: "i"}
+			filter["$or"] = []bson.M{{"name": prefix}, {"sku": prefix}}
+		}
+
+		if opts.Filter.SupplierID != "" {
+			filter["supplier_id"] = opts.Filter.SupplierID
+		}
+		if opts.Filter.IsActive != nil {
+			filter["is_active"] = *opts.Filter.IsActive
+		}
+		if opts.Filter.IncludeDeleted {
+			delete(filter, "deleted_at")
+		}
+
+		createdFilter := bson.M{}
+		if !opts.Filter.CreatedAfter.IsZero() {
+			createdFilter["$gte"] = opts.Filter.CreatedAfter
+		}
+		if !opts.Filter.CreatedBefore.IsZero() {
+			createdFilter["$lt"] = opts.Filter.CreatedBefore
+		}
+		if len(createdFilter) > 0 {
+			filter["created_at"] = createdFilter
 		}
 
 		// Apply IDs filter
@@ -218,7 +471,7 @@ func (r *ProductRepository) List(ctx context.Context, opts *domain.ListOptions)
 			for _, id := range opts.Filter.IDs {
 				objID, err := primitive.ObjectIDFromHex(id)
 				if err != nil {
-					return nil, 0, fmt.Errorf("invalid product ID: %v", id)
+					return nil, nil, fmt.Errorf("invalid product ID: %v", id)
 				}
 				objectIDs = append(objectIDs, objID)
 			}
@@ -226,61 +479,30 @@ func (r *ProductRepository) List(ctx context.Context, opts *domain.ListOptions)
 		}
 	}
 
-	// Set up find options
-	findOptions := options.Find()
-
-	// Apply pagination if provided
-	if opts != nil && opts.Pagination != nil {
-		if opts.Pagination.PageSize > 0 {
-			findOptions.SetLimit(int64(opts.Pagination.PageSize))
-			if opts.Pagination.Page > 0 {
-				findOptions.SetSkip(int64((opts.Pagination.Page - 1) * opts.Pagination.PageSize))
-			}
-		}
-
-		// Apply sorting if provided
-		if opts.Sort != nil {
-			sortField := "created_at" // Default sort field
-			switch opts.Sort.Field {
-			case domain.SortFieldName:
-				sortField = "name"
-			case domain.SortFieldPrice:
-				sortField = "selling_price"
-			case domain.SortFieldCreatedAt:
-				sortField = "created_at"
-			case domain.SortFieldUpdatedAt:
-				sortField = "updated_at"
-			}
-
-			sortOrder := 1 // Default to ascending
-			if opts.Sort.Order == domain.SortOrderDesc {
-				sortOrder = -1
-			}
+	return filter, findOptions, nil
+}
 
-			findOptions.SetSort(bson.D{{Key: sortField, Value: sortOrder}})
-		}
+// sortDocument converts a sort option to a MongoDB sort document. Products
+// with equal values are ordered by ID, so pages never overlap or skip one.
+func sortDocument(sort *domain.SortOption) bson.D {
+	sortField := "created_at" // Default sort field
+	switch sort.Field {
+	case domain.SortFieldName:
+		sortField = "name"
+	case domain.SortFieldPrice:
+		sortField = "selling_price"
+	case domain.SortFieldCreatedAt:
+		sortField = "created_at"
+	case domain.SortFieldUpdatedAt:
+		sortField = "updated_at"
 	}
 
-	// Count total matching documents
-	total, err := r.collection.CountDocuments(ctx, filter)
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to count products: %w", err)
+	sortOrder := 1 // Default to ascending
+	if sort.Order == domain.SortOrderDesc {
+		sortOrder = -1
 	}
 
-	// Find products
-	cursor, err := r.collection.Find(ctx, filter, findOptions)
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to find products: %w", err)
-	}
-	defer cursor.Close(ctx)
-
-	// Decode products
-	var products []*domain.Product
-	if err := cursor.All(ctx, &products); err != nil {
-		return nil, 0, fmt.Errorf("failed to decode products: %w", err)
-	}
-
-	return products, total, nil
+	return bson.D{{Key: sortField, Value: sortOrder}, {Key: "_id", Value: sortOrder}}
 }
 
 // Search searches for products by query
@@ -294,13 +516,9 @@ func (r *ProductRepository) Search(ctx context.Context, query string, opts *doma
 	if opts != nil {
 		// Copy the filter if it exists
 		if opts.Filter != nil {
-			searchOpts.Filter = &domain.ProductFilter{
-				IDs:         opts.Filter.IDs,
-				CategoryIDs: opts.Filter.CategoryIDs,
-				MinPrice:    opts.Filter.MinPrice,
-				MaxPrice:    opts.Filter.MaxPrice,
-				SearchTerm:  query, // Override the search term with the query parameter
-			}
+			filter := *opts.Filter
+			filter.SearchTerm = query // Override the search term with the query parameter
+			searchOpts.Filter = &filter
 		} else {
 			searchOpts.Filter = &domain.ProductFilter{
 				SearchTerm: query,
@@ -363,6 +581,7 @@ func (r *ProductRepository) BulkUpdateVisibility(ctx context.Context, supplierID
 			fmt.Sprintf("is_visible.%s", supplierID): isVisible,
 			"updated_at": time.Now(),
 		},
+		"$inc": bson.M{"version": 1},
 	}
 
 	// Execute bulk update
@@ -457,10 +676,10 @@ func (r *ProductRepository) GetBySupplier(ctx context.Context, supplierID string
 		if opts.Sort.Order == domain.SortOrderDesc {
 			sortOrder = -1
 		}
-		findOptions.SetSort(bson.D{{sortField, sortOrder}})
+		findOptions.SetSort(bson.D{{Key: sortField, Value: sortOrder}})
 	} else {
 		// Default sorting by name ascending
-		findOptions.SetSort(bson.D{{"name", 1}})
+		findOptions.SetSort(bson.D{{Key: "name", Value: 1}})
 	}
 
 	// Execute query
@@ -562,10 +781,10 @@ func (r *ProductRepository) GetByCategory(ctx context.Context, categoryID string
 		if opts.Sort.Order == domain.SortOrderDesc {
 			sortOrder = -1
 		}
-		findOptions.SetSort(bson.D{{sortField, sortOrder}})
+		findOptions.SetSort(bson.D{{Key: sortField, Value: sortOrder}})
 	} else {
 		// Default sorting by name ascending
-		findOptions.SetSort(bson.D{{"name", 1}})
+		findOptions.SetSort(bson.D{{Key: "name", Value: 1}})
 	}
 
 	// Execute query
@@ -597,6 +816,36 @@ func (r *ProductRepository) GetByCategory(ctx context.Context, categoryID string
 	return products, totalCount, nil
 }
 
+// CountByCategory returns the number of non-deleted products per category ID
+func (r *ProductRepository) CountByCategory(ctx context.Context) (map[string]int64, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"deleted_at": bson.M{"$exists": false}}}},
+		{{Key: "$unwind", Value: "$category_ids"}},
+		{{Key: "$group", Value: bson.M{"_id": "$category_ids", "count": bson.M{"$sum": 1}}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		r.logger.Error("Failed to count products by category", zap.Error(err))
+		return nil, fmt.Errorf("failed to count products by category: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var rows []struct {
+		CategoryID string `bson:"_id"`
+		Count      int64  `bson:"count"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("failed to decode category counts: %w", err)
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.CategoryID] = row.Count
+	}
+	return counts, nil
+}
+
 // BulkUpdateStock is deprecated - inventory operations are handled by inventorySvc
 func (r *ProductRepository) BulkUpdateStock(ctx context.Context, updates map[string]int32) error {
 	return fmt.Errorf("inventory operations are handled by inventorySvc")
@@ -607,6 +856,79 @@ func (r *ProductRepository) GetLowStockProducts(ctx context.Context, threshold i
 	return nil, 0, fmt.Errorf("inventory operations are handled by inventorySvc")
 }
 
+// ReassignSupplier moves every product of fromSupplierID to toSupplierID.
+// Soft-deleted products are moved too, so none is left pointing at a
+// supplier that no longer exists.
+func (r *ProductRepository) ReassignSupplier(ctx context.Context, fromSupplierID, toSupplierID string) (int64, error) {
+	result, err := r.collection.UpdateMany(
+		ctx,
+		bson.M{"supplier_id": fromSupplierID},
+		bson.M{
+			"$set": bson.M{
+				"supplier_id": toSupplierID,
+				"updated_at":  time.Now(),
+			},
+			"$inc": bson.M{"version": 1},
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reassign supplier products: %w", err)
+	}
+	return result.ModifiedCount, nil
+}
+
+// BulkSetCategories changes the category_ids of many products in one
+// UpdateMany: $addToSet to add, $pull to remove and $set to replace. Products
+// the change would leave as they are are not matched, so their updated_at is
+// kept and they are not counted. categoryIDs must not repeat an ID.
+func (r *ProductRepository) BulkSetCategories(ctx context.Context, productIDs, categoryIDs []string, mode string) (int64, error) {
+	objIDs := make([]primitive.ObjectID, 0, len(productIDs))
+	for _, id := range productIDs {
+		objID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return 0, domain.ErrInvalidID
+		}
+		objIDs = append(objIDs, objID)
+	}
+
+	filter := bson.M{
+		"_id":        bson.M{"$in": objIDs},
+		"deleted_at": bson.M{"$exists": false},
+	}
+	now := time.Now()
+	var update bson.M
+	switch mode {
+	case domain.CategoryModeAdd:
+		filter["category_ids"] = bson.M{"$not": bson.M{"$all": categoryIDs}}
+		update = bson.M{
+			"$addToSet": bson.M{"category_ids": bson.M{"$each": categoryIDs}},
+			"$set":      bson.M{"updated_at": now},
+			"$inc":      bson.M{"version": 1},
+		}
+	case domain.CategoryModeRemove:
+		filter["category_ids"] = bson.M{"$in": categoryIDs}
+		update = bson.M{
+			"$pull": bson.M{"category_ids": bson.M{"$in": categoryIDs}},
+			"$set":  bson.M{"updated_at": now},
+			"$inc":  bson.M{"version": 1},
+		}
+	case domain.CategoryModeReplace:
+		filter["$nor"] = bson.A{bson.M{"category_ids": bson.M{"$size": len(categoryIDs), "$all": categoryIDs}}}
+		update = bson.M{
+			"$set": bson.M{"category_ids": categoryIDs, "updated_at": now},
+			"$inc": bson.M{"version": 1},
+		}
+	default:
+		return 0, fmt.Errorf("%w: unknown category mode %q", domain.ErrValidation, mode)
+	}
+
+	result, err := r.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update product categories: %w", err)
+	}
+	return result.ModifiedCount, nil
+}
+
 // PublishProducts updates the published status of multiple products
 func (r *ProductRepository) PublishProducts(ctx context.Context, productIDs []string, publish bool) error {
 	if len(productIDs) == 0 {
@@ -652,6 +974,89 @@ func (r *ProductRepository) PublishProducts(ctx context.Context, productIDs []st
 	return nil
 }
 
+// SetImageMetadata stores the probed metadata of the image with imageURL.
+// The update does not touch updated_at, as the product itself did not change.
+func (r *ProductRepository) SetImageMetadata(ctx context.Context, productID, imageURL string, metadata *domain.MediaMetadata) error {
+	objID, err := primitive.ObjectIDFromHex(productID)
+	if err != nil {
+		return domain.ErrInvalidID
+	}
+
+	_, err = r.collection.UpdateOne(
+		ctx,
+		bson.M{"_id": objID, "images.url": imageURL},
+		bson.M{"$set": bson.M{"images.$.metadata": metadata}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set image metadata: %w", err)
+	}
+	return nil
+}
+
+// SetRating stores the rating summary of a product. It does not touch
+// updated_at, since a new review does not change the product itself.
+func (r *ProductRepository) SetRating(ctx context.Context, productID string, rating domain.RatingSummary) error {
+	objID, err := primitive.ObjectIDFromHex(productID)
+	if err != nil {
+		return domain.ErrInvalidID
+	}
+
+	result, err := r.collection.UpdateOne(ctx,
+		bson.M{"_id": objID},
+		bson.M{"$set": bson.M{"rating": rating}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set product rating: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrProductNotFound
+	}
+	return nil
+}
+
+// SetBundleComponents replaces the bundle components of a product and
+// returns the updated product
+func (r *ProductRepository) SetBundleComponents(ctx context.Context, productID string, components []domain.BundleComponent, updatedBy string) (*domain.Product, error) {
+	objID, err := primitive.ObjectIDFromHex(productID)
+	if err != nil {
+		return nil, domain.ErrInvalidID
+	}
+
+	update := bson.M{"$set": bson.M{"updated_at": time.Now(), "updated_by": updatedBy}}
+	if len(components) == 0 {
+		update["$unset"] = bson.M{"bundle_components": ""}
+	} else {
+		update["$set"].(bson.M)["bundle_components"] = components
+	}
+
+	var product domain.Product
+	err = r.collection.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": objID, "deleted_at": bson.M{"$exists": false}},
+		update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&product)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, domain.ErrProductNotFound
+		}
+		return nil, fmt.Errorf("failed to set bundle components: %w", err)
+	}
+	return &product, nil
+}
+
+// IsBundleComponent reports whether a non-deleted bundle lists productID
+func (r *ProductRepository) IsBundleComponent(ctx context.Context, productID string) (bool, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{
+		"bundle_components.product_id": productID,
+		"deleted_at":                   bson.M{"$exists": false},
+	}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, fmt.Errorf("failed to look up bundles: %w", err)
+	}
+	return count > 0, nil
+}
+
 // UpdateVariantStock updates the stock quantity of a product variant
 func (r *ProductRepository) UpdateVariantStock(ctx context.Context, productID, variantID string, quantity int32) error {
 	// Validate input
@@ -704,6 +1109,7 @@ func (r *ProductRepository) UpdateVariantStock(ctx context.Context, productID, v
 					"variants.$[elem].updated_at":     time.Now(),
 					"updated_at":                      time.Now(),
 				},
+				"$inc": bson.M{"version": 1},
 			}
 
 			// Execute the update with arrayFilters
@@ -738,3 +1144,152 @@ func (r *ProductRepository) UpdateVariantStock(ctx context.Context, productID, v
 
 	return nil
 }
+
+// productTextIndexName is the name of the text index backing product search
+const productTextIndexName = "product_text_search"
+
+// textIndexModel describes the product text index with the configured field weights
+func (r *ProductRepository) textIndexModel(name string) mongo.IndexModel {
+	return mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "name", Value: "text"},
+			{Key: "description", Value: "text"},
+			{Key: "sku", Value: "text"},
+		},
+		Options: options.Index().SetName(name).SetWeights(bson.D{
+			// MongoDB rejects weights below 1
+			{Key: "name", Value: max(r.searchWeights.Name, 1)},
+			{Key: "description", Value: max(r.searchWeights.Description, 1)},
+			{Key: "sku", Value: max(r.searchWeights.SKU, 1)},
+		}),
+	}
+}
+
+// RebuildSearchIndex replaces the text index on the products collection with
+// one built with the configured weights, returning the number of live
+// products covered by the new index. The new index is built under a new name
+// before the old one is dropped, so search keeps using the old index while it
+// builds. Servers that allow a single text index per collection refuse that;
+// the old index is then dropped first, and List falls back to pattern
+// matching until the new one is ready.
+func (r *ProductRepository) RebuildSearchIndex(ctx context.Context) (int64, error) {
+	existing, err := r.textIndexNames(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	name := fmt.Sprintf("%s_%d", productTextIndexName, time.Now().UnixNano())
+	_, err = r.collection.Indexes().CreateOne(ctx, r.textIndexModel(name))
+	switch {
+	case err == nil:
+		if err := r.dropIndexes(ctx, existing); err != nil {
+			return 0, err
+		}
+	case len(existing) > 0 && isTextIndexConflict(err):
+		r.logger.Info("Server allows one text index, replacing the old one in place",
+			zap.Strings("old_indexes", existing),
+		)
+		if err := r.dropIndexes(ctx, existing); err != nil {
+			return 0, err
+		}
+		if _, err := r.collection.Indexes().CreateOne(ctx, r.textIndexModel(name)); err != nil {
+			return 0, fmt.Errorf("failed to create text index: %w", err)
+		}
+	default:
+		return 0, fmt.Errorf("failed to create text index: %w", err)
+	}
+
+	count, err := r.collection.CountDocuments(ctx, bson.M{"deleted_at": bson.M{"$exists": false}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count products: %w", err)
+	}
+
+	r.logger.Info("Rebuilt product text index", zap.String("index", name), zap.Int64("products", count))
+	return count, nil
+}
+
+// textIndexNames returns the names of the text indexes on the products
+// collection, whatever they are called
+func (r *ProductRepository) textIndexNames(ctx context.Context) ([]string, error) {
+	cursor, err := r.collection.Indexes().List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list product indexes: %w", err)
+	}
+	var indexes []bson.M
+	if err := cursor.All(ctx, &indexes); err != nil {
+		return nil, fmt.Errorf("failed to decode product indexes: %w", err)
+	}
+	var names []string
+	for _, idx := range indexes {
+		if _, isText := idx["textIndexVersion"]; !isText {
+			continue
+		}
+		if name, ok := idx["name"].(string); ok {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// dropIndexes drops the named indexes of the products collection
+func (r *ProductRepository) dropIndexes(ctx context.Context, names []string) error {
+	for _, name := range names {
+		if _, err := r.collection.Indexes().DropOne(ctx, name); err != nil {
+			return fmt.Errorf("failed to drop text index %q: %w", name, err)
+		}
+		r.logger.Info("Dropped product text index", zap.String("index", name))
+	}
+	return nil
+}
+
+// MongoDB error codes of text index conflicts and of $text queries without one
+const (
+	codeIndexNotFound         = 27
+	codeCannotCreateIndex     = 67
+	codeIndexOptionsConflict  = 85
+	codeIndexKeySpecsConflict = 86
+)
+
+// isTextIndexConflict reports whether err is the server refusing a second
+// text index on the collection
+func isTextIndexConflict(err error) bool {
+	var se mongo.ServerError
+	return errors.As(err, &se) &&
+		(se.HasErrorCode(codeIndexOptionsConflict) || se.HasErrorCode(codeIndexKeySpecsConflict) || se.HasErrorCode(codeCannotCreateIndex))
+}
+
+// isMissingTextIndex reports whether err is a $text query failing because the
+// collection has no text index, e.g. while RebuildSearchIndex replaces it
+func isMissingTextIndex(err error) bool {
+	var se mongo.ServerError
+	return errors.As(err, &se) && se.HasErrorCode(codeIndexNotFound)
+}
+
+// withoutTextSearch replaces the $text condition of a list query with a
+// case-insensitive match of any search word on the indexed fields, and drops
+// the relevance ordering that only a text index can provide
+func withoutTextSearch(filter bson.M, findOptions *options.FindOptions, opts *domain.ListOptions) {
+	text, ok := filter["$text"].(bson.M)
+	if !ok {
+		return
+	}
+	delete(filter, "$text")
+
+	term, _ := text["$search"].(string)
+	words := strings.Fields(term)
+	for i, w := range words {
+		words[i] = regexp.QuoteMeta(strings.Trim(w, `"-`))
+	}
+	pattern := primitive.Regex{Pattern: strings.Join(words, "|"), Options: "i"}
+	match := bson.M{"$or": []bson.M{{"name": pattern}, {"description": pattern}, {"sku": pattern}}}
+	if and, ok := filter["$and"].([]bson.M); ok {
+		filter["$and"] = append(and, match)
+	} else {
+		filter["$and"] = []bson.M{match}
+	}
+
+	findOptions.Projection = nil
+	if opts == nil || opts.Sort == nil {
+		findOptions.Sort = nil
+	}
+}
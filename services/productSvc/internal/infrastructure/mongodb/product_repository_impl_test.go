@@ -0,0 +1,44 @@
+package mongodb
+
+import (
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+func TestListQueryExcludesDeletedUnlessIncluded(t *testing.T) {
+	filter, _, err := listQuery(&domain.ListOptions{Filter: &domain.ProductFilter{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := filter["deleted_at"]; !ok {
+		t.Fatal("lists should leave out soft-deleted products by default")
+	}
+
+	filter, _, err = listQuery(&domain.ListOptions{Filter: &domain.ProductFilter{IncludeDeleted: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cond, ok := filter["deleted_at"]; ok {
+		t.Fatalf("filter still has deleted_at condition %v", cond)
+	}
+}
+
+func TestSortDocumentBreaksTiesByID(t *testing.T) {
+	tests := []struct {
+		sort domain.SortOption
+		want bson.D
+	}{
+		{sort: domain.DefaultSort, want: bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}},
+		{sort: domain.SortOption{Field: domain.SortFieldName, Order: domain.SortOrderAsc}, want: bson.D{{Key: "name", Value: 1}, {Key: "_id", Value: 1}}},
+		{sort: domain.SortOption{Field: domain.SortFieldPrice}, want: bson.D{{Key: "selling_price", Value: 1}, {Key: "_id", Value: 1}}},
+	}
+	for _, tt := range tests {
+		if got := sortDocument(&tt.sort); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("sortDocument(%+v) = %v, want %v", tt.sort, got, tt.want)
+		}
+	}
+}
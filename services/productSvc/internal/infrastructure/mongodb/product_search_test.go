@@ -0,0 +1,225 @@
+package mongodb
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+func TestWithoutTextSearchReplacesTextCondition(t *testing.T) {
+	opts := &domain.ListOptions{Filter: &domain.ProductFilter{SearchTerm: "red shoe"}}
+	filter, findOptions, err := listQuery(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := filter["$text"]; !ok {
+		t.Fatal("listQuery should search the text index")
+	}
+
+	withoutTextSearch(filter, findOptions, opts)
+
+	if _, ok := filter["$text"]; ok {
+		t.Fatal("$text should be removed")
+	}
+	and, ok := filter["$and"].([]bson.M)
+	if !ok || len(and) != 1 {
+		t.Fatalf("$and = %#v, want one pattern match", filter["$and"])
+	}
+	or := and[0]["$or"].([]bson.M)
+	if len(or) != 3 {
+		t.Fatalf("pattern should match name, description and sku, got %d fields", len(or))
+	}
+	pattern := or[0]["name"].(primitive.Regex)
+	if pattern.Pattern != "red|shoe" || pattern.Options != "i" {
+		t.Fatalf("pattern = %+v", pattern)
+	}
+	if findOptions.Projection != nil || findOptions.Sort != nil {
+		t.Fatal("relevance projection and sort should be dropped")
+	}
+}
+
+func TestWithoutTextSearchKeepsExplicitSort(t *testing.T) {
+	opts := &domain.ListOptions{
+		Filter: &domain.ProductFilter{SearchTerm: "a.b"},
+		Sort:   &domain.SortOption{Field: domain.SortFieldName},
+	}
+	filter := bson.M{"$text": bson.M{"$search": "a.b"}}
+	findOptions := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+
+	withoutTextSearch(filter, findOptions, opts)
+
+	if findOptions.Sort == nil {
+		t.Fatal("explicit sort should be kept")
+	}
+	and := filter["$and"].([]bson.M)
+	pattern := and[0]["$or"].([]bson.M)[0]["name"].(primitive.Regex)
+	if pattern.Pattern != `a\.b` {
+		t.Fatalf("search words should be escaped, got %q", pattern.Pattern)
+	}
+}
+
+func TestTextIndexErrorClassification(t *testing.T) {
+	missing := mongo.CommandError{Code: codeIndexNotFound, Message: "text index required for $text query"}
+	conflict := mongo.CommandError{Code: codeIndexOptionsConflict, Message: "An equivalent index already exists"}
+
+	if !isMissingTextIndex(missing) || isMissingTextIndex(conflict) || isMissingTextIndex(nil) {
+		t.Error("isMissingTextIndex misclassified")
+	}
+	if !isTextIndexConflict(conflict) || isTextIndexConflict(missing) || isTextIndexConflict(nil) {
+		t.Error("isTextIndexConflict misclassified")
+	}
+}
+
+func TestRebuildSearchIndexCreatesNewIndexBeforeDroppingOld(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("new index first", func(mt *mtest.T) {
+		r := &ProductRepository{collection: mt.Coll, logger: zap.NewNop()}
+		ns := mt.Coll.Database().Name() + "." + mt.Coll.Name()
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, ns, mtest.FirstBatch,
+				bson.D{{Key: "name", Value: "_id_"}},
+				bson.D{{Key: "name", Value: productTextIndexName}, {Key: "textIndexVersion", Value: 3}},
+			),
+			mtest.CreateSuccessResponse(), // createIndexes
+			mtest.CreateSuccessResponse(), // dropIndexes
+			mtest.CreateCursorResponse(0, ns, mtest.FirstBatch, bson.D{{Key: "n", Value: 7}}),
+		)
+
+		count, err := r.RebuildSearchIndex(context.Background())
+		if err != nil {
+			mt.Fatal(err)
+		}
+		if count != 7 {
+			mt.Fatalf("count = %d, want 7", count)
+		}
+
+		started := mt.GetAllStartedEvents()
+		var commands []string
+		for _, e := range started {
+			commands = append(commands, e.CommandName)
+		}
+		want := []string{"listIndexes", "createIndexes", "dropIndexes", "aggregate"}
+		if strings.Join(commands, ",") != strings.Join(want, ",") {
+			mt.Fatalf("commands = %v, want %v", commands, want)
+		}
+		dropped := started[2].Command.Lookup("index").StringValue()
+		if dropped != productTextIndexName {
+			mt.Fatalf("dropped %q, want the old index", dropped)
+		}
+	})
+
+	mt.Run("single text index server", func(mt *mtest.T) {
+		r := &ProductRepository{collection: mt.Coll, logger: zap.NewNop()}
+		ns := mt.Coll.Database().Name() + "." + mt.Coll.Name()
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, ns, mtest.FirstBatch,
+				bson.D{{Key: "name", Value: productTextIndexName}, {Key: "textIndexVersion", Value: 3}},
+			),
+			mtest.CreateCommandErrorResponse(mtest.CommandError{Code: codeIndexOptionsConflict, Message: "too many text indexes"}),
+			mtest.CreateSuccessResponse(), // dropIndexes
+			mtest.CreateSuccessResponse(), // createIndexes
+			mtest.CreateCursorResponse(0, ns, mtest.FirstBatch, bson.D{{Key: "n", Value: 2}}),
+		)
+
+		count, err := r.RebuildSearchIndex(context.Background())
+		if err != nil {
+			mt.Fatal(err)
+		}
+		if count != 2 {
+			mt.Fatalf("count = %d, want 2", count)
+		}
+	})
+}
+
+// weightOf returns the weight of field in a text index model
+func weightOf(t *testing.T, model mongo.IndexModel, field string) int {
+	t.Helper()
+	for _, e := range model.Options.Weights.(bson.D) {
+		if e.Key == field {
+			return e.Value.(int)
+		}
+	}
+	t.Fatalf("no weight for %s", field)
+	return 0
+}
+
+func TestTextIndexWeightsRankNameAboveDescription(t *testing.T) {
+	r := &ProductRepository{searchWeights: domain.DefaultSearchWeights}
+	model := r.textIndexModel(productTextIndexName)
+
+	name, sku, description := weightOf(t, model, "name"), weightOf(t, model, "sku"), weightOf(t, model, "description")
+	if !(name > sku && sku > description) {
+		t.Fatalf("weights name=%d sku=%d description=%d, want name > sku > description", name, sku, description)
+	}
+
+	// MongoDB rejects weights below 1
+	zero := (&ProductRepository{}).textIndexModel(productTextIndexName)
+	for _, field := range []string{"name", "sku", "description"} {
+		if w := weightOf(t, zero, field); w != 1 {
+			t.Errorf("unset %s weight = %d, want 1", field, w)
+		}
+	}
+}
+
+// A term found in a product name outranks the same term found only in a
+// description because the index weights name matches higher and search
+// results are sorted by text score. The scores themselves are computed by the
+// server, so the mock returns them and the test checks the query asks for
+// that order and keeps it.
+func TestSearchSortsByTextScore(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("name match first", func(mt *mtest.T) {
+		r := &ProductRepository{collection: mt.Coll, logger: zap.NewNop()}
+		ns := mt.Coll.Database().Name() + "." + mt.Coll.Name()
+		nameMatch := bson.D{{Key: "_id", Value: primitive.NewObjectID()}, {Key: "name", Value: "Desk lamp"}, {Key: "score", Value: 10.5}}
+		descriptionMatch := bson.D{{Key: "_id", Value: primitive.NewObjectID()}, {Key: "name", Value: "Desk"}, {Key: "description", Value: "Fits a lamp"}, {Key: "score", Value: 0.75}}
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, ns, mtest.FirstBatch, bson.D{{Key: "n", Value: 2}}),
+			mtest.CreateCursorResponse(0, ns, mtest.FirstBatch, nameMatch, descriptionMatch),
+		)
+
+		products, total, err := r.List(context.Background(), &domain.ListOptions{Filter: &domain.ProductFilter{SearchTerm: "lamp"}})
+		if err != nil {
+			mt.Fatal(err)
+		}
+		if total != 2 || len(products) != 2 || products[0].Name != "Desk lamp" {
+			mt.Fatalf("got %d of %d products, first %q, want the name match first", len(products), total, products[0].Name)
+		}
+
+		find := mt.GetAllStartedEvents()[1].Command
+		if meta := find.Lookup("sort", "score", "$meta").StringValue(); meta != "textScore" {
+			mt.Fatalf("sort = %s, want by text score", find.Lookup("sort"))
+		}
+		if keys, _ := find.Lookup("sort").Document().Elements(); len(keys) != 2 || keys[1].Key() != "_id" {
+			mt.Fatalf("sort = %s, want equal scores ordered by _id", find.Lookup("sort"))
+		}
+		if meta := find.Lookup("projection", "score", "$meta").StringValue(); meta != "textScore" {
+			mt.Fatalf("projection = %s, want the text score", find.Lookup("projection"))
+		}
+	})
+}
+
+func TestSearchWithExplicitSortSkipsTextScore(t *testing.T) {
+	opts := &domain.ListOptions{
+		Filter: &domain.ProductFilter{SearchTerm: "lamp"},
+		Sort:   &domain.SortOption{Field: domain.SortFieldName},
+	}
+	_, findOptions, err := listQuery(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if findOptions.Sort != nil || findOptions.Projection != nil {
+		t.Fatal("an explicit sort should replace the text score")
+	}
+}
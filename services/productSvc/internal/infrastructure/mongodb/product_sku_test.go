@@ -0,0 +1,56 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+func TestGetBySKU(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("found with an equality match", func(mt *mtest.T) {
+		r := &ProductRepository{collection: mt.Coll, logger: zap.NewNop()}
+		ns := mt.Coll.Database().Name() + "." + mt.Coll.Name()
+		id := primitive.NewObjectID()
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, ns, mtest.FirstBatch,
+			bson.D{{Key: "_id", Value: id}, {Key: "sku", Value: "LAMP-01"}, {Key: "name", Value: "Desk lamp"}},
+		))
+
+		product, err := r.GetBySKU(context.Background(), "LAMP-01")
+		if err != nil {
+			mt.Fatal(err)
+		}
+		if product.ID != id || product.SKU != "LAMP-01" {
+			mt.Fatalf("product = %+v, want LAMP-01", product)
+		}
+
+		filter := mt.GetStartedEvent().Command.Lookup("filter").Document()
+		if sku, ok := filter.Lookup("sku").StringValueOK(); !ok || sku != "LAMP-01" {
+			mt.Fatalf("filter = %v, want an exact sku match", filter)
+		}
+		if _, err := filter.LookupErr("$text"); err == nil {
+			mt.Fatalf("filter = %v, should not use text search", filter)
+		}
+		if _, err := filter.LookupErr("deleted_at"); err != nil {
+			mt.Fatalf("filter = %v, should leave out deleted products", filter)
+		}
+	})
+
+	mt.Run("not found", func(mt *mtest.T) {
+		r := &ProductRepository{collection: mt.Coll, logger: zap.NewNop()}
+		ns := mt.Coll.Database().Name() + "." + mt.Coll.Name()
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, ns, mtest.FirstBatch))
+
+		if _, err := r.GetBySKU(context.Background(), "LAMP-02"); !errors.Is(err, domain.ErrProductNotFound) {
+			mt.Fatalf("error = %v, want %v", err, domain.ErrProductNotFound)
+		}
+	})
+}
@@ -0,0 +1,121 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// commandNames returns the names of the commands mt sent, in order
+func commandNames(mt *mtest.T) []string {
+	var names []string
+	for _, e := range mt.GetAllStartedEvents() {
+		names = append(names, e.CommandName)
+	}
+	return names
+}
+
+func productDocument(sku string) bson.D {
+	return bson.D{{Key: "_id", Value: primitive.NewObjectID()}, {Key: "sku", Value: sku}}
+}
+
+func TestStreamListReadsEveryBatch(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("two batches", func(mt *mtest.T) {
+		r := &ProductRepository{collection: mt.Coll, logger: zap.NewNop()}
+		ns := mt.Coll.Database().Name() + "." + mt.Coll.Name()
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(42, ns, mtest.FirstBatch, productDocument("A"), productDocument("B")),
+			mtest.CreateCursorResponse(0, ns, mtest.NextBatch, productDocument("C")),
+		)
+
+		var skus []string
+		err := r.StreamList(context.Background(), &domain.ListOptions{}, func(p *domain.Product) error {
+			skus = append(skus, p.SKU)
+			return nil
+		})
+		if err != nil {
+			mt.Fatal(err)
+		}
+		if len(skus) != 3 {
+			mt.Fatalf("streamed %v, want A, B and C", skus)
+		}
+		if batchSize := mt.GetStartedEvent().Command.Lookup("batchSize").AsInt64(); batchSize != streamBatchSize {
+			mt.Fatalf("batchSize = %d, want %d", batchSize, streamBatchSize)
+		}
+	})
+}
+
+func TestStreamListStopsWhenCancelledMidStream(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("cancelled", func(mt *mtest.T) {
+		r := &ProductRepository{collection: mt.Coll, logger: zap.NewNop()}
+		ns := mt.Coll.Database().Name() + "." + mt.Coll.Name()
+		mt.AddMockResponses(
+			// The cursor has more batches on the server when the client goes away
+			mtest.CreateCursorResponse(42, ns, mtest.FirstBatch, productDocument("A"), productDocument("B")),
+			mtest.CreateSuccessResponse(), // killCursors
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		streamed := 0
+		err := r.StreamList(ctx, &domain.ListOptions{}, func(p *domain.Product) error {
+			streamed++
+			cancel()
+			return nil
+		})
+
+		if !errors.Is(err, context.Canceled) {
+			mt.Fatalf("error = %v, want %v", err, context.Canceled)
+		}
+		if streamed != 1 {
+			mt.Fatalf("streamed %d products, want the stream to stop after the first", streamed)
+		}
+		names := commandNames(mt)
+		for _, name := range names {
+			if name == "getMore" {
+				mt.Fatalf("commands = %v, no batch should be fetched after cancellation", names)
+			}
+		}
+		if names[len(names)-1] != "killCursors" {
+			mt.Fatalf("commands = %v, want the cursor killed", names)
+		}
+	})
+}
+
+func TestStreamListStopsOnCallbackError(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("callback error", func(mt *mtest.T) {
+		r := &ProductRepository{collection: mt.Coll, logger: zap.NewNop()}
+		ns := mt.Coll.Database().Name() + "." + mt.Coll.Name()
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(42, ns, mtest.FirstBatch, productDocument("A"), productDocument("B")),
+			mtest.CreateSuccessResponse(), // killCursors
+		)
+		errSend := errors.New("client went away")
+
+		streamed := 0
+		err := r.StreamList(context.Background(), &domain.ListOptions{}, func(p *domain.Product) error {
+			streamed++
+			return errSend
+		})
+
+		if !errors.Is(err, errSend) || streamed != 1 {
+			mt.Fatalf("error = %v after %d products, want %v after 1", err, streamed, errSend)
+		}
+		if names := commandNames(mt); names[len(names)-1] != "killCursors" {
+			mt.Fatalf("commands = %v, want the cursor killed", names)
+		}
+	})
+}
@@ -0,0 +1,106 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+func TestUpdateChecksVersion(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("current version", func(mt *mtest.T) {
+		r := &ProductRepository{collection: mt.Coll, logger: zap.NewNop()}
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+		product := &domain.Product{ID: primitive.NewObjectID(), Name: "Desk lamp", Version: 4}
+
+		if err := r.Update(context.Background(), product); err != nil {
+			mt.Fatal(err)
+		}
+		if product.Version != 5 {
+			mt.Fatalf("version = %d, want 5 after the save", product.Version)
+		}
+
+		update := mt.GetStartedEvent().Command.Lookup("updates").Array().Index(0).Value().Document()
+		if version, ok := update.Lookup("q", "version").AsInt64OK(); !ok || version != 4 {
+			mt.Fatalf("filter = %v, want the version read", update.Lookup("q"))
+		}
+		if version, ok := update.Lookup("u", "$set", "version").AsInt64OK(); !ok || version != 5 {
+			mt.Fatalf("update = %v, want the next version", update.Lookup("u"))
+		}
+	})
+
+	mt.Run("stale version", func(mt *mtest.T) {
+		r := &ProductRepository{collection: mt.Coll, logger: zap.NewNop()}
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}),
+			mtest.CreateCursorResponse(0, "test.products", mtest.FirstBatch, bson.D{{Key: "n", Value: 1}}),
+		)
+		product := &domain.Product{ID: primitive.NewObjectID(), Name: "Desk lamp", Version: 4}
+
+		err := r.Update(context.Background(), product)
+
+		if !errors.Is(err, domain.ErrConflict) {
+			mt.Fatalf("err = %v, want ErrConflict", err)
+		}
+		if product.Version != 4 {
+			mt.Fatalf("version = %d, want the stale version left as it was", product.Version)
+		}
+	})
+
+	mt.Run("product gone", func(mt *mtest.T) {
+		r := &ProductRepository{collection: mt.Coll, logger: zap.NewNop()}
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}),
+			mtest.CreateCursorResponse(0, "test.products", mtest.FirstBatch),
+		)
+
+		err := r.Update(context.Background(), &domain.Product{ID: primitive.NewObjectID(), Version: 4})
+
+		if !errors.Is(err, domain.ErrProductNotFound) {
+			mt.Fatalf("err = %v, want ErrProductNotFound", err)
+		}
+	})
+
+	mt.Run("unversioned product", func(mt *mtest.T) {
+		r := &ProductRepository{collection: mt.Coll, logger: zap.NewNop()}
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+
+		if err := r.Update(context.Background(), &domain.Product{ID: primitive.NewObjectID()}); err != nil {
+			mt.Fatal(err)
+		}
+
+		filter := mt.GetStartedEvent().Command.Lookup("updates").Array().Index(0).Value().Document().Lookup("q", "version")
+		if _, err := filter.Document().LookupErr("$in"); err != nil {
+			mt.Fatalf("version filter = %v, want products without a version to match version 0", filter)
+		}
+	})
+}
+
+func TestUpdateSavesOrderQuantityLimits(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("limits", func(mt *mtest.T) {
+		r := &ProductRepository{collection: mt.Coll, logger: zap.NewNop()}
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+		product := &domain.Product{ID: primitive.NewObjectID(), Name: "Screws", MinOrderQty: 10, MaxOrderQty: 500, OrderQtyIncrement: 5}
+
+		if err := r.Update(context.Background(), product); err != nil {
+			mt.Fatal(err)
+		}
+
+		set := mt.GetStartedEvent().Command.Lookup("updates").Array().Index(0).Value().Document().Lookup("u", "$set").Document()
+		for field, want := range map[string]int32{"min_order_qty": 10, "max_order_qty": 500, "order_qty_increment": 5} {
+			if got, ok := set.Lookup(field).Int32OK(); !ok || got != want {
+				mt.Errorf("%s = %v, want %d", field, set.Lookup(field), want)
+			}
+		}
+	})
+}
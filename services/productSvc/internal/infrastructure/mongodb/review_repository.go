@@ -0,0 +1,158 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// ReviewRepository stores product reviews, one per user and product
+type ReviewRepository struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// Ensure ReviewRepository implements domain.ReviewRepository
+var _ domain.ReviewRepository = (*ReviewRepository)(nil)
+
+// NewReviewRepository creates a new MongoDB review repository. The unique
+// index on product and user is what prevents a second review of a product by
+// the same user.
+func NewReviewRepository(db *mongo.Database, logger *zap.Logger) *ReviewRepository {
+	r := &ReviewRepository{
+		collection: db.Collection("product_reviews"),
+		logger:     logger.With(zap.String("component", "mongodb.ReviewRepository")),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "product_id", Value: 1}, {Key: "user_id", Value: 1}},
+			Options: options.Index().SetName("product_user_unique").SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "product_id", Value: 1}, {Key: "approved", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+	})
+	if err != nil {
+		r.logger.Warn("Failed to create review indexes", zap.Error(err))
+	}
+
+	return r
+}
+
+// Create inserts the review with a new ID
+func (r *ReviewRepository) Create(ctx context.Context, review *domain.Review) error {
+	review.ID = primitive.NewObjectID().Hex()
+	if _, err := r.collection.InsertOne(ctx, review); err != nil {
+		review.ID = ""
+		if mongo.IsDuplicateKeyError(err) {
+			return domain.ErrDuplicateReview
+		}
+		return fmt.Errorf("failed to create review: %w", err)
+	}
+	return nil
+}
+
+// GetByID returns the review with the given ID
+func (r *ReviewRepository) GetByID(ctx context.Context, id string) (*domain.Review, error) {
+	var review domain.Review
+	if err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&review); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, domain.ErrReviewNotFound
+		}
+		return nil, fmt.Errorf("failed to get review: %w", err)
+	}
+	return &review, nil
+}
+
+// ListByProduct returns a page of the product's reviews, newest first
+func (r *ReviewRepository) ListByProduct(ctx context.Context, productID string, opts domain.ReviewListOptions) ([]*domain.Review, int64, error) {
+	filter := bson.M{"product_id": productID}
+	if !opts.IncludeUnapproved {
+		filter["approved"] = true
+	}
+
+	total, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count reviews: %w", err)
+	}
+
+	findOpts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}})
+	if opts.Limit > 0 {
+		findOpts.SetLimit(int64(opts.Limit))
+	}
+	if opts.Offset > 0 {
+		findOpts.SetSkip(int64(opts.Offset))
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, findOpts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list reviews: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	reviews := []*domain.Review{}
+	if err := cursor.All(ctx, &reviews); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode reviews: %w", err)
+	}
+	return reviews, total, nil
+}
+
+// SetApproved records the moderation decision on the review
+func (r *ReviewRepository) SetApproved(ctx context.Context, id string, approved bool, moderatedBy string) (*domain.Review, error) {
+	now := time.Now()
+	var review domain.Review
+	err := r.collection.FindOneAndUpdate(ctx,
+		bson.M{"_id": id},
+		bson.M{"$set": bson.M{
+			"approved":     approved,
+			"moderated_by": moderatedBy,
+			"moderated_at": now,
+			"updated_at":   now,
+		}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&review)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, domain.ErrReviewNotFound
+		}
+		return nil, fmt.Errorf("failed to moderate review: %w", err)
+	}
+	return &review, nil
+}
+
+// Summarize averages the ratings of the product's approved reviews
+func (r *ReviewRepository) Summarize(ctx context.Context, productID string) (domain.RatingSummary, error) {
+	cursor, err := r.collection.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"product_id": productID, "approved": true}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":     nil,
+			"average": bson.M{"$avg": "$rating"},
+			"count":   bson.M{"$sum": 1},
+		}}},
+	})
+	if err != nil {
+		return domain.RatingSummary{}, fmt.Errorf("failed to summarize reviews: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var summary domain.RatingSummary
+	if cursor.Next(ctx) {
+		if err := cursor.Decode(&summary); err != nil {
+			return domain.RatingSummary{}, fmt.Errorf("failed to decode review summary: %w", err)
+		}
+	}
+	return summary, cursor.Err()
+}
@@ -0,0 +1,91 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+func TestCreateDuplicateReview(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("duplicate", func(mt *mtest.T) {
+		r := &ReviewRepository{collection: mt.Coll, logger: zap.NewNop()}
+		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
+			Index:   0,
+			Code:    11000,
+			Message: "E11000 duplicate key error collection: product_reviews index: product_user_unique",
+		}))
+		review := &domain.Review{ProductID: "product-1", UserID: "user-1", Rating: 4}
+
+		err := r.Create(context.Background(), review)
+
+		if !errors.Is(err, domain.ErrDuplicateReview) {
+			mt.Fatalf("err = %v, want ErrDuplicateReview", err)
+		}
+		if review.ID != "" {
+			mt.Errorf("ID = %q, want it cleared for a review that was not stored", review.ID)
+		}
+	})
+
+	mt.Run("first review", func(mt *mtest.T) {
+		r := &ReviewRepository{collection: mt.Coll, logger: zap.NewNop()}
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		review := &domain.Review{ProductID: "product-1", UserID: "user-1", Rating: 4}
+
+		if err := r.Create(context.Background(), review); err != nil {
+			mt.Fatal(err)
+		}
+		if review.ID == "" {
+			mt.Error("a stored review gets an ID")
+		}
+	})
+}
+
+func TestSummarizeReviews(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("approved reviews", func(mt *mtest.T) {
+		r := &ReviewRepository{collection: mt.Coll, logger: zap.NewNop()}
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "test.product_reviews", mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: nil},
+			{Key: "average", Value: 3.5},
+			{Key: "count", Value: int32(4)},
+		}))
+
+		summary, err := r.Summarize(context.Background(), "product-1")
+		if err != nil {
+			mt.Fatal(err)
+		}
+		if summary != (domain.RatingSummary{Average: 3.5, Count: 4}) {
+			mt.Errorf("summary = %+v, want average 3.5 over 4 reviews", summary)
+		}
+
+		match := mt.GetStartedEvent().Command.Lookup("pipeline").Array().Index(0).Value().Document().Lookup("$match").Document()
+		if productID := match.Lookup("product_id").StringValue(); productID != "product-1" {
+			mt.Errorf("product_id = %q, want product-1", productID)
+		}
+		if approved, ok := match.Lookup("approved").BooleanOK(); !ok || !approved {
+			mt.Errorf("match = %v, want only approved reviews counted", match)
+		}
+	})
+
+	mt.Run("no approved reviews", func(mt *mtest.T) {
+		r := &ReviewRepository{collection: mt.Coll, logger: zap.NewNop()}
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "test.product_reviews", mtest.FirstBatch))
+
+		summary, err := r.Summarize(context.Background(), "product-1")
+		if err != nil {
+			mt.Fatal(err)
+		}
+		if summary != (domain.RatingSummary{}) {
+			mt.Errorf("summary = %+v, want a zero rating", summary)
+		}
+	})
+}
@@ -0,0 +1,44 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// SKUSequenceRepository keeps one counter document per SKU prefix
+type SKUSequenceRepository struct {
+	collection *mongo.Collection
+}
+
+// Ensure SKUSequenceRepository implements domain.SKUSequence
+var _ domain.SKUSequence = (*SKUSequenceRepository)(nil)
+
+// NewSKUSequenceRepository creates a new MongoDB SKU sequence repository
+func NewSKUSequenceRepository(db *mongo.Database) *SKUSequenceRepository {
+	return &SKUSequenceRepository{
+		collection: db.Collection("sku_sequences"),
+	}
+}
+
+// Next atomically increments and returns the counter of prefix, creating it
+// on first use
+func (r *SKUSequenceRepository) Next(ctx context.Context, prefix string) (int64, error) {
+	var counter struct {
+		Seq int64 `bson:"seq"`
+	}
+	err := r.collection.FindOneAndUpdate(ctx,
+		bson.M{"_id": prefix},
+		bson.M{"$inc": bson.M{"seq": 1}},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&counter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment SKU sequence %q: %w", prefix, err)
+	}
+	return counter.Seq, nil
+}
@@ -0,0 +1,54 @@
+package orders
+
+import (
+	"context"
+
+	orderclient "github.com/leonvanderhaeghen/stockplatform/pkg/clients/order"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/models"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// pageSize is how many orders are read per call to the order service
+const pageSize = 100
+
+// maxPages bounds how much of a user's order history is searched, so one
+// review by a prolific customer cannot scan it all
+const maxPages = 10
+
+// PurchaseVerifier looks for the product among a user's delivered orders
+type PurchaseVerifier struct {
+	client *orderclient.Client
+}
+
+// Ensure PurchaseVerifier implements domain.PurchaseVerifier
+var _ domain.PurchaseVerifier = (*PurchaseVerifier)(nil)
+
+// NewPurchaseVerifier creates a purchase verifier backed by the order service
+func NewPurchaseVerifier(client *orderclient.Client) *PurchaseVerifier {
+	return &PurchaseVerifier{client: client}
+}
+
+// HasPurchased reports whether one of the user's most recent orders was
+// delivered and contains the product
+func (v *PurchaseVerifier) HasPurchased(ctx context.Context, userID, productID string) (bool, error) {
+	for page := 0; page < maxPages; page++ {
+		orders, err := v.client.GetUserOrders(ctx, userID, pageSize, int32(page*pageSize))
+		if err != nil {
+			return false, err
+		}
+		for _, order := range orders {
+			if order.Status != models.OrderStatusDelivered {
+				continue
+			}
+			for _, item := range order.Items {
+				if item.ProductID == productID {
+					return true, nil
+				}
+			}
+		}
+		if len(orders) < pageSize {
+			break
+		}
+	}
+	return false, nil
+}
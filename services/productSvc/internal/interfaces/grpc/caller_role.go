@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/identity"
+	productv1 "github.com/leonvanderhaeghen/stockplatform/services/productSvc/api/gen/go/proto/product/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// callerFromContext returns the caller the gateway forwarded, empty for
+// internal callers
+func callerFromContext(ctx context.Context) domain.Caller {
+	caller := identity.FromIncomingContext(ctx)
+	return domain.Caller{
+		UserID:     caller.UserID,
+		Role:       caller.Role,
+		SupplierID: caller.SupplierID,
+	}
+}
+
+// redactForCaller strips the cost price and the IDs of the staff who edited
+// products unless the caller is staff, so purchase costs and internal user
+// IDs never reach customer-facing clients
+func redactForCaller(ctx context.Context, products ...*productv1.Product) {
+	if identity.IsStaff(ctx) {
+		return
+	}
+	for _, p := range products {
+		p.CostPrice = ""
+		p.CreatedBy = ""
+		p.UpdatedBy = ""
+	}
+}
@@ -0,0 +1,153 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/identity"
+	productv1 "github.com/leonvanderhaeghen/stockplatform/services/productSvc/api/gen/go/proto/product/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// SetBundleComponents handles the SetBundleComponents gRPC request
+func (s *ProductServer) SetBundleComponents(ctx context.Context, req *productv1.SetBundleComponentsRequest) (*productv1.SetBundleComponentsResponse, error) {
+	start := time.Now()
+	log := s.logger.With(
+		zap.String("method", "SetBundleComponents"),
+		zap.String("product_id", req.GetProductId()),
+	)
+
+	log.Debug("Processing SetBundleComponents request")
+
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "product ID is required")
+	}
+
+	components := make([]domain.BundleComponent, 0, len(req.GetComponents()))
+	for _, c := range req.GetComponents() {
+		components = append(components, domain.BundleComponent{
+			ProductID:       c.GetProductId(),
+			VariantOptionID: c.GetVariantOptionId(),
+			Quantity:        c.GetQuantity(),
+		})
+	}
+
+	product, err := s.service.SetBundleComponents(ctx, req.GetProductId(), components, identity.UserID(ctx))
+	if err != nil {
+		s.logError(log, err, "Failed to set bundle components")
+		return nil, bundleStatusError(err)
+	}
+
+	log.Info("Bundle components set successfully",
+		zap.Int("components", len(product.BundleComponents)),
+		zap.Duration("duration", time.Since(start)),
+	)
+
+	pb := toProtoProduct(product)
+	s.formatPrices(pb)
+	redactForCaller(ctx, pb)
+	return &productv1.SetBundleComponentsResponse{Product: pb}, nil
+}
+
+// RemoveBundle handles the RemoveBundle gRPC request
+func (s *ProductServer) RemoveBundle(ctx context.Context, req *productv1.RemoveBundleRequest) (*productv1.RemoveBundleResponse, error) {
+	log := s.logger.With(
+		zap.String("method", "RemoveBundle"),
+		zap.String("product_id", req.GetProductId()),
+	)
+
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "product ID is required")
+	}
+
+	product, err := s.service.RemoveBundle(ctx, req.GetProductId(), identity.UserID(ctx))
+	if err != nil {
+		s.logError(log, err, "Failed to remove bundle")
+		return nil, bundleStatusError(err)
+	}
+
+	pb := toProtoProduct(product)
+	s.formatPrices(pb)
+	redactForCaller(ctx, pb)
+	return &productv1.RemoveBundleResponse{Product: pb}, nil
+}
+
+// GetBundleAvailability handles the GetBundleAvailability gRPC request
+func (s *ProductServer) GetBundleAvailability(ctx context.Context, req *productv1.GetBundleAvailabilityRequest) (*productv1.GetBundleAvailabilityResponse, error) {
+	log := s.logger.With(
+		zap.String("method", "GetBundleAvailability"),
+		zap.String("product_id", req.GetProductId()),
+		zap.String("location_id", req.GetLocationId()),
+	)
+
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "product ID is required")
+	}
+
+	availability, err := s.service.GetBundleAvailability(ctx, req.GetProductId(), req.GetLocationId())
+	if err != nil {
+		s.logError(log, err, "Failed to get bundle availability")
+		return nil, bundleStatusError(err)
+	}
+
+	components := make([]*productv1.BundleComponentAvailability, 0, len(availability.Components))
+	for _, c := range availability.Components {
+		components = append(components, &productv1.BundleComponentAvailability{
+			Component: toProtoBundleComponent(c.Component),
+			Available: c.Available,
+			Bundles:   c.Bundles,
+		})
+	}
+
+	return &productv1.GetBundleAvailabilityResponse{
+		ProductId:  availability.BundleID,
+		LocationId: availability.LocationID,
+		Available:  availability.Available,
+		Components: components,
+	}, nil
+}
+
+// bundleStatusError maps bundle management errors to gRPC status errors
+func bundleStatusError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrBundleComponentInUse), errors.Is(err, domain.ErrCircularBundle):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrNotABundle):
+		return status.Error(codes.FailedPrecondition, "product is not a bundle")
+	case errors.Is(err, domain.ErrNotFound):
+		return status.Error(codes.NotFound, "product not found")
+	case errors.Is(err, domain.ErrInvalidID):
+		return status.Error(codes.InvalidArgument, "invalid product ID format")
+	case errors.Is(err, domain.ErrValidation):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
+
+// toProtoBundleComponents converts the components of a bundle to protobuf
+func toProtoBundleComponents(components []domain.BundleComponent) []*productv1.BundleComponent {
+	if len(components) == 0 {
+		return nil
+	}
+	pb := make([]*productv1.BundleComponent, 0, len(components))
+	for _, c := range components {
+		pb = append(pb, toProtoBundleComponent(c))
+	}
+	return pb
+}
+
+// toProtoBundleComponent converts a bundle component to protobuf
+func toProtoBundleComponent(c domain.BundleComponent) *productv1.BundleComponent {
+	return &productv1.BundleComponent{
+		ProductId:       c.ProductID,
+		VariantOptionId: c.VariantOptionID,
+		Sku:             c.SKU,
+		Quantity:        c.Quantity,
+	}
+}
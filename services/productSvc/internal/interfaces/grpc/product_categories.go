@@ -0,0 +1,171 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	productv1 "github.com/leonvanderhaeghen/stockplatform/services/productSvc/api/gen/go/proto/product/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// UpdateCategory handles the UpdateCategory gRPC request
+func (s *ProductServer) UpdateCategory(ctx context.Context, req *productv1.UpdateCategoryRequest) (*productv1.UpdateCategoryResponse, error) {
+	start := time.Now()
+	log := s.logger.With(
+		zap.String("method", "UpdateCategory"),
+		zap.String("category_id", req.GetId()),
+	)
+
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "category ID is required")
+	}
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "category name is required")
+	}
+
+	category, err := s.categoryService.GetCategory(ctx, req.GetId())
+	if err != nil {
+		s.logError(log, err, "Failed to get category")
+		return nil, categoryError(err)
+	}
+
+	category.Name = req.GetName()
+	category.Description = req.GetDescription()
+	if err := s.categoryService.UpdateCategory(ctx, category); err != nil {
+		s.logError(log, err, "Failed to update category")
+		return nil, categoryError(err)
+	}
+
+	log.Info("Category updated successfully", zap.Duration("duration", time.Since(start)))
+	return &productv1.UpdateCategoryResponse{
+		Category: toProtoCategory(category),
+	}, nil
+}
+
+// MoveCategory handles the MoveCategory gRPC request
+func (s *ProductServer) MoveCategory(ctx context.Context, req *productv1.MoveCategoryRequest) (*productv1.MoveCategoryResponse, error) {
+	start := time.Now()
+	log := s.logger.With(
+		zap.String("method", "MoveCategory"),
+		zap.String("category_id", req.GetId()),
+		zap.String("new_parent_id", req.GetNewParentId()),
+	)
+
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "category ID is required")
+	}
+
+	category, err := s.categoryService.MoveCategory(ctx, req.GetId(), req.GetNewParentId())
+	if err != nil {
+		s.logError(log, err, "Failed to move category")
+		return nil, categoryError(err)
+	}
+
+	log.Info("Category moved successfully", zap.Duration("duration", time.Since(start)))
+	return &productv1.MoveCategoryResponse{
+		Category: toProtoCategory(category),
+	}, nil
+}
+
+// GetCategory handles the GetCategory gRPC request
+func (s *ProductServer) GetCategory(ctx context.Context, req *productv1.GetCategoryRequest) (*productv1.GetCategoryResponse, error) {
+	log := s.logger.With(
+		zap.String("method", "GetCategory"),
+		zap.String("category_id", req.GetId()),
+	)
+
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "category ID is required")
+	}
+
+	category, err := s.categoryService.GetCategory(ctx, req.GetId())
+	if err != nil {
+		s.logError(log, err, "Failed to get category")
+		return nil, categoryError(err)
+	}
+
+	return &productv1.GetCategoryResponse{
+		Category: toProtoCategory(category),
+	}, nil
+}
+
+// SetCategoryAttributes handles the SetCategoryAttributes gRPC request
+func (s *ProductServer) SetCategoryAttributes(ctx context.Context, req *productv1.SetCategoryAttributesRequest) (*productv1.SetCategoryAttributesResponse, error) {
+	start := time.Now()
+	log := s.logger.With(
+		zap.String("method", "SetCategoryAttributes"),
+		zap.String("category_id", req.GetCategoryId()),
+	)
+
+	if req.GetCategoryId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "category ID is required")
+	}
+
+	category, err := s.categoryService.SetCategoryAttributes(ctx, req.GetCategoryId(), toDomainAttributes(req.GetAttributes()))
+	if err != nil {
+		s.logError(log, err, "Failed to set category attributes")
+		return nil, categoryError(err)
+	}
+
+	log.Info("Category attributes set successfully",
+		zap.Int("attributes", len(category.Attributes)),
+		zap.Duration("duration", time.Since(start)),
+	)
+	return &productv1.SetCategoryAttributesResponse{
+		Category: toProtoCategory(category),
+	}, nil
+}
+
+// categoryError maps category errors to gRPC status errors
+func categoryError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidID):
+		return status.Error(codes.InvalidArgument, "invalid category ID")
+	case errors.Is(err, domain.ErrParentCategoryNotFound):
+		return status.Error(codes.FailedPrecondition, "parent category not found")
+	case errors.Is(err, domain.ErrCategoryCycle):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrNotFound):
+		return status.Error(codes.NotFound, "category not found")
+	case errors.Is(err, domain.ErrValidation):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
+
+// toProtoAttributes converts a category attribute schema to protobuf
+func toProtoAttributes(attributes []domain.AttributeDefinition) []*productv1.CategoryAttribute {
+	if len(attributes) == 0 {
+		return nil
+	}
+	pb := make([]*productv1.CategoryAttribute, 0, len(attributes))
+	for _, a := range attributes {
+		pb = append(pb, &productv1.CategoryAttribute{
+			Name:          a.Name,
+			Type:          string(a.Type),
+			Required:      a.Required,
+			AllowedValues: a.AllowedValues,
+		})
+	}
+	return pb
+}
+
+// toDomainAttributes converts a protobuf category attribute schema
+func toDomainAttributes(pb []*productv1.CategoryAttribute) []domain.AttributeDefinition {
+	attributes := make([]domain.AttributeDefinition, 0, len(pb))
+	for _, a := range pb {
+		attributes = append(attributes, domain.AttributeDefinition{
+			Name:          a.GetName(),
+			Type:          domain.AttributeType(a.GetType()),
+			Required:      a.GetRequired(),
+			AllowedValues: a.GetAllowedValues(),
+		})
+	}
+	return attributes
+}
@@ -0,0 +1,37 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	productv1 "github.com/leonvanderhaeghen/stockplatform/services/productSvc/api/gen/go/proto/product/v1"
+)
+
+func TestBulkSetCategoriesRejectsBadRequests(t *testing.T) {
+	tooMany := make([]string, maxBulkSetCategoryProducts+1)
+	for i := range tooMany {
+		tooMany[i] = "product"
+	}
+
+	tests := []struct {
+		name string
+		req  *productv1.BulkSetCategoriesRequest
+	}{
+		{name: "too many products", req: &productv1.BulkSetCategoriesRequest{ProductIds: tooMany, CategoryIds: []string{"lamps"}, Mode: "add"}},
+		{name: "unknown mode", req: &productv1.BulkSetCategoriesRequest{ProductIds: []string{"product"}, CategoryIds: []string{"lamps"}, Mode: "merge"}},
+		{name: "no products", req: &productv1.BulkSetCategoriesRequest{CategoryIds: []string{"lamps"}, Mode: "remove"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestProductServer(nil)
+
+			_, err := server.BulkSetCategories(context.Background(), tt.req)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("err = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/identity"
+	productv1 "github.com/leonvanderhaeghen/stockplatform/services/productSvc/api/gen/go/proto/product/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// CloneProduct handles the CloneProduct gRPC request
+func (s *ProductServer) CloneProduct(ctx context.Context, req *productv1.CloneProductRequest) (*productv1.CloneProductResponse, error) {
+	start := time.Now()
+	log := s.logger.With(
+		zap.String("method", "CloneProduct"),
+		zap.String("source_id", req.GetSourceId()),
+	)
+
+	if req.GetSourceId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "source product ID is required")
+	}
+
+	overrides := &domain.Product{
+		Name:         req.GetName(),
+		Description:  req.GetDescription(),
+		CostPrice:    req.GetCostPrice(),
+		SellingPrice: req.GetSellingPrice(),
+		Currency:     req.GetCurrency(),
+		SKU:          req.GetSku(),
+		Barcode:      req.GetBarcode(),
+		CategoryIDs:  req.GetCategoryIds(),
+		SupplierID:   req.GetSupplierId(),
+		CreatedBy:    identity.UserID(ctx),
+	}
+
+	clone, err := s.service.CloneProduct(ctx, req.GetSourceId(), overrides, req.GetCopyVariants())
+	if err != nil {
+		s.logError(log, err, "Failed to clone product")
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return nil, status.Error(codes.NotFound, "source product not found")
+		case errors.Is(err, domain.ErrInvalidID):
+			return nil, status.Error(codes.InvalidArgument, "invalid source product ID")
+		case errors.Is(err, domain.ErrValidation):
+			return nil, status.Error(codes.InvalidArgument, "invalid product data: "+err.Error())
+		case errors.Is(err, domain.ErrAlreadyExists):
+			return nil, status.Error(codes.AlreadyExists, "product with this SKU or barcode already exists")
+		case errors.Is(err, domain.ErrSupplierNotFound):
+			return nil, status.Error(codes.FailedPrecondition, "supplier not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	log.Info("Product cloned successfully",
+		zap.String("product_id", clone.ID.Hex()),
+		zap.Duration("duration", time.Since(start)),
+	)
+
+	pbProduct := toProtoProduct(clone)
+	s.formatPrices(pbProduct)
+	redactForCaller(ctx, pbProduct)
+	return &productv1.CloneProductResponse{Product: pbProduct}, nil
+}
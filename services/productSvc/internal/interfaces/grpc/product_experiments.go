@@ -0,0 +1,23 @@
+package grpc
+
+import (
+	"github.com/leonvanderhaeghen/stockplatform/pkg/featureflags"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// rankingFlag is the experiment deciding how product lists are ordered when the
+// client asks for no particular sort
+const rankingFlag = "product-ranking"
+
+// experimentSort returns the order of an unsorted product list for the
+// caller's ranking variant, or nil for control, which keeps the default order
+func experimentSort(flags featureflags.Flags) *domain.SortOption {
+	switch flags.Variant(rankingFlag) {
+	case "newest":
+		return &domain.SortOption{Field: domain.SortFieldCreatedAt, Order: domain.SortOrderDesc}
+	case "price-asc":
+		return &domain.SortOption{Field: domain.SortFieldPrice, Order: domain.SortOrderAsc}
+	default:
+		return nil
+	}
+}
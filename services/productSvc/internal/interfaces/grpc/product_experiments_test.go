@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/featureflags"
+	productv1 "github.com/leonvanderhaeghen/stockplatform/services/productSvc/api/gen/go/proto/product/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+func TestRankingExperimentSortsUnsortedLists(t *testing.T) {
+	// Lists outside the experiment reach the repository with the service's
+	// default sort, which is the zero SortOption on the test server
+	tests := []struct {
+		name  string
+		flags string
+		want  domain.SortOption
+	}{
+		{name: "newest", flags: "product-ranking=newest", want: domain.SortOption{Field: domain.SortFieldCreatedAt, Order: domain.SortOrderDesc}},
+		{name: "cheapest first", flags: "product-ranking=price-asc", want: domain.SortOption{Field: domain.SortFieldPrice, Order: domain.SortOrderAsc}},
+		{name: "control", want: domain.SortOption{}},
+		{name: "unknown variant", flags: "product-ranking=shuffle", want: domain.SortOption{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &listingProductRepository{}
+			server := newTestProductServer(repo)
+			ctx := context.Background()
+			if tt.flags != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(featureflags.MetadataKey, tt.flags))
+			}
+
+			if _, err := server.ListProducts(ctx, &productv1.ListProductsRequest{}); err != nil {
+				t.Fatal(err)
+			}
+
+			if sort := repo.opts.Sort; sort == nil || *sort != tt.want {
+				t.Fatalf("sort = %+v, want %+v", sort, tt.want)
+			}
+		})
+	}
+}
+
+func TestRankingExperimentKeepsRequestedSort(t *testing.T) {
+	repo := &listingProductRepository{}
+	server := newTestProductServer(repo)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(featureflags.MetadataKey, "product-ranking=newest"))
+
+	_, err := server.ListProducts(ctx, &productv1.ListProductsRequest{
+		Sort: &productv1.ProductSort{Field: productv1.ProductSort_SORT_FIELD_NAME, Order: productv1.ProductSort_SORT_ORDER_ASC},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := domain.SortOption{Field: domain.SortFieldName, Order: domain.SortOrderAsc}
+	if sort := repo.opts.Sort; sort == nil || *sort != want {
+		t.Fatalf("sort = %+v, want the requested sort by name", sort)
+	}
+}
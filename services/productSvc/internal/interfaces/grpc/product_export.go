@@ -0,0 +1,78 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	productv1 "github.com/leonvanderhaeghen/stockplatform/services/productSvc/api/gen/go/proto/product/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// defaultExportFormat is used when an export request names no format
+const defaultExportFormat = "csv"
+
+// toDomainProductFilter converts a protobuf product filter to the domain filter
+// shared by listing and exports
+func toDomainProductFilter(f *productv1.ProductFilter) *domain.ProductFilter {
+	filter := &domain.ProductFilter{
+		IDs:         f.GetIds(),
+		CategoryIDs: f.GetCategoryIds(),
+		MinPrice:    f.GetMinPrice(),
+		MaxPrice:    f.GetMaxPrice(),
+		SearchTerm:  f.GetSearchTerm(),
+		SupplierID:  f.GetSupplierId(),
+		IsActive:    f.IsActive,
+	}
+	if f.GetCreatedAfter() != nil {
+		filter.CreatedAfter = f.GetCreatedAfter().AsTime()
+	}
+	if f.GetCreatedBefore() != nil {
+		filter.CreatedBefore = f.GetCreatedBefore().AsTime()
+	}
+	return filter
+}
+
+// ExportProducts handles the ExportProducts gRPC request. Supplier-role callers
+// can only export their own supplier's products.
+func (s *ProductServer) ExportProducts(ctx context.Context, req *productv1.ExportProductsRequest) (*productv1.ExportProductsResponse, error) {
+	start := time.Now()
+	caller := callerFromContext(ctx)
+	log := s.logger.With(
+		zap.String("method", "ExportProducts"),
+		zap.String("caller_role", caller.Role),
+		zap.String("supplier_id", req.GetFilter().GetSupplierId()),
+	)
+
+	format := req.GetFormat()
+	if format == "" {
+		format = defaultExportFormat
+	}
+
+	data, err := s.service.GenerateProductReport(ctx, format, toDomainProductFilter(req.GetFilter()), caller)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrForbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, domain.ErrValidation):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		s.logError(log, err, "Failed to export products")
+		return nil, status.Error(codes.Internal, "failed to export products")
+	}
+
+	log.Info("Products exported",
+		zap.Int("bytes", len(data)),
+		zap.Duration("duration", time.Since(start)),
+	)
+
+	return &productv1.ExportProductsResponse{
+		Data:        data,
+		Filename:    "products.csv",
+		ContentType: "text/csv",
+	}, nil
+}
@@ -0,0 +1,143 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	productv1 "github.com/leonvanderhaeghen/stockplatform/services/productSvc/api/gen/go/proto/product/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// ReorderProductImages handles the ReorderProductImages gRPC request
+func (s *ProductServer) ReorderProductImages(ctx context.Context, req *productv1.ReorderProductImagesRequest) (*productv1.ReorderProductImagesResponse, error) {
+	start := time.Now()
+	log := s.logger.With(
+		zap.String("method", "ReorderProductImages"),
+		zap.String("product_id", req.GetProductId()),
+	)
+
+	log.Debug("Processing ReorderProductImages request")
+
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "product ID is required")
+	}
+
+	product, err := s.service.ReorderProductImages(ctx, req.GetProductId(), req.GetImageUrls())
+	if err != nil {
+		s.logError(log, err, "Failed to reorder product images")
+		return nil, imageStatusError(err)
+	}
+
+	log.Info("Product images reordered successfully",
+		zap.Int("images", len(product.Images)),
+		zap.Duration("duration", time.Since(start)),
+	)
+
+	pb := toProtoProduct(product)
+	s.formatPrices(pb)
+	return &productv1.ReorderProductImagesResponse{
+		Product: pb,
+	}, nil
+}
+
+// SetPrimaryProductImage handles the SetPrimaryProductImage gRPC request
+func (s *ProductServer) SetPrimaryProductImage(ctx context.Context, req *productv1.SetPrimaryProductImageRequest) (*productv1.SetPrimaryProductImageResponse, error) {
+	start := time.Now()
+	log := s.logger.With(
+		zap.String("method", "SetPrimaryProductImage"),
+		zap.String("product_id", req.GetProductId()),
+		zap.String("image_url", req.GetImageUrl()),
+	)
+
+	log.Debug("Processing SetPrimaryProductImage request")
+
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "product ID is required")
+	}
+
+	product, err := s.service.SetPrimaryProductImage(ctx, req.GetProductId(), req.GetImageUrl())
+	if err != nil {
+		s.logError(log, err, "Failed to set primary product image")
+		return nil, imageStatusError(err)
+	}
+
+	log.Info("Primary product image set successfully",
+		zap.Duration("duration", time.Since(start)),
+	)
+
+	pb := toProtoProduct(product)
+	s.formatPrices(pb)
+	return &productv1.SetPrimaryProductImageResponse{
+		Product: pb,
+	}, nil
+}
+
+// imageStatusError maps image management errors to gRPC status errors
+func imageStatusError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrImageNotFound):
+		return status.Error(codes.NotFound, "image not found")
+	case errors.Is(err, domain.ErrNotFound):
+		return status.Error(codes.NotFound, "product not found")
+	case errors.Is(err, domain.ErrInvalidID):
+		return status.Error(codes.InvalidArgument, "invalid product ID format")
+	case errors.Is(err, domain.ErrValidation):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrConflict):
+		return status.Error(codes.Aborted, "product was modified concurrently, retry")
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
+
+// toProtoImages converts the ordered images of a product to protobuf
+func toProtoImages(p *domain.Product) []*productv1.ProductImage {
+	images := p.OrderedImages()
+	result := make([]*productv1.ProductImage, 0, len(images))
+	for _, img := range images {
+		result = append(result, &productv1.ProductImage{
+			Url:       img.URL,
+			Position:  img.Position,
+			IsPrimary: img.IsPrimary,
+			Metadata:  toProtoMediaMetadata(img.Metadata),
+		})
+	}
+	return result
+}
+
+// toProtoMediaMetadata converts probed image metadata to protobuf
+func toProtoMediaMetadata(m *domain.MediaMetadata) *productv1.MediaMetadata {
+	if m == nil {
+		return nil
+	}
+	return &productv1.MediaMetadata{
+		ContentType: m.ContentType,
+		Width:       m.Width,
+		Height:      m.Height,
+		ByteSize:    m.ByteSize,
+		ProbedAt:    timestamppb.New(m.ProbedAt),
+	}
+}
+
+// fromProtoImages converts protobuf images to domain images in request order
+func fromProtoImages(images []*productv1.ProductImage) []domain.ProductImage {
+	if len(images) == 0 {
+		return nil
+	}
+
+	result := make([]domain.ProductImage, 0, len(images))
+	for i, img := range images {
+		result = append(result, domain.ProductImage{
+			URL:       img.GetUrl(),
+			Position:  int32(i),
+			IsPrimary: img.GetIsPrimary(),
+		})
+	}
+	return result
+}
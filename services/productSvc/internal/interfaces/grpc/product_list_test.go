@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	productv1 "github.com/leonvanderhaeghen/stockplatform/services/productSvc/api/gen/go/proto/product/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// listingProductRepository lists its products and, like the database, leaves
+// out soft-deleted ones unless the filter includes them. It records the
+// options of the last listing.
+type listingProductRepository struct {
+	domain.ProductRepository
+	products []*domain.Product
+	opts     *domain.ListOptions
+}
+
+func (r *listingProductRepository) List(ctx context.Context, opts *domain.ListOptions) ([]*domain.Product, int64, error) {
+	r.opts = opts
+	includeDeleted := opts.Filter != nil && opts.Filter.IncludeDeleted
+	var products []*domain.Product
+	for _, p := range r.products {
+		if p.DeletedAt == nil || includeDeleted {
+			copied := *p
+			products = append(products, &copied)
+		}
+	}
+	return products, int64(len(products)), nil
+}
+
+// productStates returns a draft, a live and a soft-deleted product
+func productStates() (draft, live, deleted *domain.Product) {
+	deletedAt := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	draft = &domain.Product{ID: primitive.NewObjectID(), Name: "Draft", SKU: "DRAFT-1", IsActive: true}
+	live = &domain.Product{ID: primitive.NewObjectID(), Name: "Live", SKU: "LIVE-1", IsActive: true, IsPublished: true}
+	deleted = &domain.Product{ID: primitive.NewObjectID(), Name: "Deleted", SKU: "GONE-1", IsPublished: true, DeletedAt: &deletedAt}
+	return draft, live, deleted
+}
+
+func listedByID(t *testing.T, server *ProductServer, ctx context.Context) map[string]*productv1.Product {
+	t.Helper()
+	resp, err := server.ListProducts(ctx, &productv1.ListProductsRequest{IncludeDeleted: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	byID := make(map[string]*productv1.Product, len(resp.GetProducts()))
+	for _, p := range resp.GetProducts() {
+		byID[p.GetId()] = p
+	}
+	return byID
+}
+
+func TestAdminListIncludesSoftDeletedProducts(t *testing.T) {
+	draft, live, deleted := productStates()
+	server := newTestProductServer(&listingProductRepository{products: []*domain.Product{draft, live, deleted}})
+
+	listed := listedByID(t, server, asRole("ADMIN"))
+	if len(listed) != 3 {
+		t.Fatalf("listed %d products, want 3", len(listed))
+	}
+
+	tests := []struct {
+		product                    *domain.Product
+		wantPublished, wantDeleted bool
+	}{
+		{product: draft},
+		{product: live, wantPublished: true},
+		{product: deleted, wantPublished: true, wantDeleted: true},
+	}
+	for _, tt := range tests {
+		p := listed[tt.product.ID.Hex()]
+		if p.GetIsPublished() != tt.wantPublished || p.GetIsDeleted() != tt.wantDeleted {
+			t.Errorf("%s: published %v deleted %v, want %v %v", tt.product.Name,
+				p.GetIsPublished(), p.GetIsDeleted(), tt.wantPublished, tt.wantDeleted)
+		}
+		if tt.wantDeleted != (p.GetDeletedAt() != nil) {
+			t.Errorf("%s: deleted_at = %v", tt.product.Name, p.GetDeletedAt())
+		}
+	}
+	if got := listed[deleted.ID.Hex()].GetDeletedAt().AsTime(); !got.Equal(*deleted.DeletedAt) {
+		t.Errorf("deleted_at = %v, want %v", got, *deleted.DeletedAt)
+	}
+}
+
+func TestCustomerListExcludesSoftDeletedProducts(t *testing.T) {
+	draft, live, deleted := productStates()
+	server := newTestProductServer(&listingProductRepository{products: []*domain.Product{draft, live, deleted}})
+
+	listed := listedByID(t, server, asRole("CUSTOMER"))
+	if _, ok := listed[deleted.ID.Hex()]; ok {
+		t.Fatal("customers must not see soft-deleted products, even when asking for them")
+	}
+	if len(listed) != 2 {
+		t.Fatalf("listed %d products, want 2", len(listed))
+	}
+}
@@ -0,0 +1,124 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/identity"
+	productv1 "github.com/leonvanderhaeghen/stockplatform/services/productSvc/api/gen/go/proto/product/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// CreateProductReview handles the CreateProductReview gRPC request. The
+// reviewer is the user the gateway forwards, never a field of the request.
+func (s *ProductServer) CreateProductReview(ctx context.Context, req *productv1.CreateProductReviewRequest) (*productv1.CreateProductReviewResponse, error) {
+	log := s.logger.With(
+		zap.String("method", "CreateProductReview"),
+		zap.String("product_id", req.GetProductId()),
+	)
+
+	userID := identity.UserID(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "reviews can only be written by signed-in users")
+	}
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "product ID is required")
+	}
+
+	review, err := s.reviews.CreateReview(ctx, req.GetProductId(), userID, req.GetRating(), req.GetText())
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidID):
+			return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+		case errors.Is(err, domain.ErrValidation):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, domain.ErrDuplicateReview):
+			return nil, status.Error(codes.AlreadyExists, "you have already reviewed this product")
+		case errors.Is(err, domain.ErrNotFound):
+			return nil, status.Error(codes.NotFound, "product not found")
+		default:
+			s.logError(log, err, "Failed to create review")
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	return &productv1.CreateProductReviewResponse{Review: toProtoReview(review)}, nil
+}
+
+// ListProductReviews handles the ListProductReviews gRPC request. Reviews
+// waiting for moderation are only listed for staff.
+func (s *ProductServer) ListProductReviews(ctx context.Context, req *productv1.ListProductReviewsRequest) (*productv1.ListProductReviewsResponse, error) {
+	log := s.logger.With(
+		zap.String("method", "ListProductReviews"),
+		zap.String("product_id", req.GetProductId()),
+	)
+
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "product ID is required")
+	}
+	if req.GetIncludeUnapproved() && !identity.IsStaff(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "only staff can list unapproved reviews")
+	}
+
+	reviews, total, err := s.reviews.ListReviews(ctx, req.GetProductId(), req.GetIncludeUnapproved(), int(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidID) {
+			return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+		}
+		s.logError(log, err, "Failed to list reviews")
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	pbReviews := make([]*productv1.ProductReview, 0, len(reviews))
+	for _, review := range reviews {
+		pbReviews = append(pbReviews, toProtoReview(review))
+	}
+	return &productv1.ListProductReviewsResponse{Reviews: pbReviews, TotalCount: total}, nil
+}
+
+// ModerateProductReview handles the ModerateProductReview gRPC request
+func (s *ProductServer) ModerateProductReview(ctx context.Context, req *productv1.ModerateProductReviewRequest) (*productv1.ModerateProductReviewResponse, error) {
+	log := s.logger.With(
+		zap.String("method", "ModerateProductReview"),
+		zap.String("review_id", req.GetReviewId()),
+	)
+
+	if !identity.IsStaff(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "only staff can moderate reviews")
+	}
+	if req.GetReviewId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "review ID is required")
+	}
+
+	review, err := s.reviews.ModerateReview(ctx, req.GetReviewId(), req.GetApproved(), identity.UserID(ctx))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "review not found")
+		}
+		s.logError(log, err, "Failed to moderate review")
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	log.Info("Review moderated", zap.Bool("approved", review.Approved))
+	return &productv1.ModerateProductReviewResponse{Review: toProtoReview(review)}, nil
+}
+
+// toProtoReview converts a domain review to its protobuf representation
+func toProtoReview(r *domain.Review) *productv1.ProductReview {
+	return &productv1.ProductReview{
+		Id:               r.ID,
+		ProductId:        r.ProductID,
+		UserId:           r.UserID,
+		Rating:           r.Rating,
+		Text:             r.Text,
+		VerifiedPurchase: r.VerifiedPurchase,
+		Approved:         r.Approved,
+		CreatedAt:        timestamppb.New(r.CreatedAt),
+		UpdatedAt:        timestamppb.New(r.UpdatedAt),
+	}
+}
@@ -13,6 +13,8 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/featureflags"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/identity"
 	productv1 "github.com/leonvanderhaeghen/stockplatform/services/productSvc/api/gen/go/proto/product/v1"
 	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/application"
 	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
@@ -53,6 +55,8 @@ type ProductServer struct {
 	productv1.UnimplementedProductServiceServer
 	service        *application.ProductService
 	categoryService *application.CategoryService
+	reviews        *application.ReviewService
+	prices         domain.PricePolicy
 	logger         *zap.Logger
 }
 
@@ -60,11 +64,14 @@ type ProductServer struct {
 func NewProductServer(
 	service *application.ProductService,
 	categoryService *application.CategoryService,
+	reviews *application.ReviewService,
 	logger *zap.Logger,
 ) *ProductServer {
 	return &ProductServer{
 		service:        service,
 		categoryService: categoryService,
+		reviews:        reviews,
+		prices:         service.Prices(),
 		logger:         logger.Named("grpc_product_server"),
 	}
 }
@@ -90,12 +97,6 @@ func (s *ProductServer) CreateProduct(ctx context.Context, req *productv1.Create
 		return nil, err
 	}
 
-	if req.GetSku() == "" {
-		err := status.Error(codes.InvalidArgument, "product SKU is required")
-		s.logError(log, err, "Validation failed")
-		return nil, err
-	}
-
 	// Convert request metadata from map[string]string to map[string]interface{}
 	metadata := make(map[string]interface{})
 	for k, v := range req.GetMetadata() {
@@ -118,12 +119,18 @@ func (s *ProductServer) CreateProduct(ctx context.Context, req *productv1.Create
 
 
 		ImageURLs:     req.GetImageUrls(),
+		Images:        fromProtoImages(req.GetImages()),
 		VideoURLs:     req.GetVideoUrls(),
 		Metadata:      metadata,
+		CreatedBy:     identity.UserID(ctx),
+
+		MinOrderQty:       req.GetMinOrderQty(),
+		MaxOrderQty:       req.GetMaxOrderQty(),
+		OrderQtyIncrement: req.GetOrderQtyIncrement(),
 	}
 
 	// Call the application service
-	created, err := s.service.CreateProduct(ctx, product)
+	created, err := s.service.CreateProduct(ctx, product, req.GetPrimaryLocationId())
 	if err != nil {
 		s.logError(log, err, "Failed to create product")
 		
@@ -159,9 +166,19 @@ func (s *ProductServer) CreateProduct(ctx context.Context, req *productv1.Create
 		CategoryIds:   created.CategoryIDs,
 		SupplierId:    created.SupplierID,
 		IsActive:      created.IsActive,
+		IsPublished:   created.IsPublished,
+		IsDeleted:     created.DeletedAt != nil,
 		ImageUrls:     created.ImageURLs,
+		Images:        toProtoImages(created),
 		VideoUrls:     created.VideoURLs,
 		Metadata:      convertMetadata(created.Metadata),
+		CreatedBy:     created.CreatedBy,
+		UpdatedBy:     created.UpdatedBy,
+
+		MinOrderQty:       created.MinOrderQty,
+		MaxOrderQty:       created.MaxOrderQty,
+		OrderQtyIncrement: created.OrderQtyIncrement,
+		Version:           created.Version,
 	}
 
 	// Only set timestamps if they are not zero
@@ -171,6 +188,11 @@ func (s *ProductServer) CreateProduct(ctx context.Context, req *productv1.Create
 	if !created.UpdatedAt.IsZero() {
 		pbProduct.UpdatedAt = timestamppb.New(created.UpdatedAt)
 	}
+	if created.DeletedAt != nil {
+		pbProduct.DeletedAt = timestamppb.New(*created.DeletedAt)
+	}
+
+	s.formatPrices(pbProduct)
 
 	return &productv1.CreateProductResponse{
 		Product: pbProduct,
@@ -232,9 +254,22 @@ func (s *ProductServer) GetProduct(ctx context.Context, req *productv1.GetProduc
 		CategoryIds:   product.CategoryIDs,
 		SupplierId:    product.SupplierID,
 		IsActive:      product.IsActive,
+		IsPublished:   product.IsPublished,
+		IsDeleted:     product.DeletedAt != nil,
 		ImageUrls:     product.ImageURLs,
+		Images:        toProtoImages(product),
 		VideoUrls:     product.VideoURLs,
 		Metadata:      convertMetadata(product.Metadata),
+		CreatedBy:     product.CreatedBy,
+		UpdatedBy:     product.UpdatedBy,
+
+		MinOrderQty:       product.MinOrderQty,
+		MaxOrderQty:       product.MaxOrderQty,
+		OrderQtyIncrement: product.OrderQtyIncrement,
+		BundleComponents:  toProtoBundleComponents(product.BundleComponents),
+		RatingAverage:     product.Rating.Average,
+		RatingCount:       product.Rating.Count,
+		Version:           product.Version,
 	}
 
 	// Only set timestamps if they are not zero
@@ -244,12 +279,66 @@ func (s *ProductServer) GetProduct(ctx context.Context, req *productv1.GetProduc
 	if !product.UpdatedAt.IsZero() {
 		pbProduct.UpdatedAt = timestamppb.New(product.UpdatedAt)
 	}
+	if product.DeletedAt != nil {
+		pbProduct.DeletedAt = timestamppb.New(*product.DeletedAt)
+	}
+
+	s.formatPrices(pbProduct)
+	redactForCaller(ctx, pbProduct)
 
 	return &productv1.GetProductResponse{
 		Product: pbProduct,
 	}, nil
 }
 
+// maxBatchGetProducts caps the number of IDs accepted by one BatchGetProducts call
+const maxBatchGetProducts = 500
+
+// BatchGetProducts handles the BatchGetProducts gRPC request
+func (s *ProductServer) BatchGetProducts(ctx context.Context, req *productv1.BatchGetProductsRequest) (*productv1.BatchGetProductsResponse, error) {
+	start := time.Now()
+	log := s.logger.With(
+		zap.String("method", "BatchGetProducts"),
+		zap.Int("count", len(req.GetIds())),
+	)
+
+	log.Debug("Processing BatchGetProducts request")
+
+	if len(req.GetIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one product ID is required")
+	}
+	if len(req.GetIds()) > maxBatchGetProducts {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d product IDs may be requested at once", maxBatchGetProducts)
+	}
+
+	products, missing, err := s.service.BatchGetProducts(ctx, req.GetIds())
+	if err != nil {
+		s.logError(log, err, "Failed to batch get products")
+		if errors.Is(err, domain.ErrValidation) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	pbProducts := make([]*productv1.Product, 0, len(products))
+	for _, product := range products {
+		pbProducts = append(pbProducts, toProtoProduct(product))
+	}
+	s.formatPrices(pbProducts...)
+	redactForCaller(ctx, pbProducts...)
+
+	log.Info("Products retrieved successfully",
+		zap.Int("found", len(products)),
+		zap.Int("missing", len(missing)),
+		zap.Duration("duration", time.Since(start)),
+	)
+
+	return &productv1.BatchGetProductsResponse{
+		Products:   pbProducts,
+		MissingIds: missing,
+	}, nil
+}
+
 // ListProducts handles the ListProducts gRPC request
 func (s *ProductServer) ListProducts(ctx context.Context, req *productv1.ListProductsRequest) (*productv1.ListProductsResponse, error) {
 	start := time.Now()
@@ -265,45 +354,21 @@ func (s *ProductServer) ListProducts(ctx context.Context, req *productv1.ListPro
 
 	// Apply filters if provided
 	if req.GetFilter() != nil {
-		opts.Filter = &domain.ProductFilter{
-			IDs:         req.GetFilter().GetIds(),
-			CategoryIDs: req.GetFilter().GetCategoryIds(),
-			MinPrice:    req.GetFilter().GetMinPrice(),
-			MaxPrice:    req.GetFilter().GetMaxPrice(),
-			SearchTerm:  req.GetFilter().GetSearchTerm(),
+		opts.Filter = toDomainProductFilter(req.GetFilter())
+	}
+	// Soft-deleted products stay out of customer-facing lists
+	if req.GetIncludeDeleted() && identity.IsStaff(ctx) {
+		if opts.Filter == nil {
+			opts.Filter = &domain.ProductFilter{}
 		}
+		opts.Filter.IncludeDeleted = true
 	}
 
 	// Apply sorting if provided
 	if req.GetSort() != nil {
-		var sortField domain.SortField
-		switch req.GetSort().GetField() {
-		case productv1.ProductSort_SORT_FIELD_NAME:
-			sortField = domain.SortFieldName
-		case productv1.ProductSort_SORT_FIELD_PRICE:
-			sortField = domain.SortFieldPrice
-		case productv1.ProductSort_SORT_FIELD_CREATED_AT:
-			sortField = domain.SortFieldCreatedAt
-		case productv1.ProductSort_SORT_FIELD_UPDATED_AT:
-			sortField = domain.SortFieldUpdatedAt
-		default:
-			sortField = domain.SortFieldCreatedAt
-		}
-
-		var sortOrder domain.SortOrder
-		switch req.GetSort().GetOrder() {
-		case productv1.ProductSort_SORT_ORDER_ASC:
-			sortOrder = domain.SortOrderAsc
-		case productv1.ProductSort_SORT_ORDER_DESC:
-			sortOrder = domain.SortOrderDesc
-		default:
-			sortOrder = domain.SortOrderDesc
-		}
-
-		opts.Sort = &domain.SortOption{
-			Field: sortField,
-			Order: sortOrder,
-		}
+		opts.Sort = toDomainSort(req.GetSort())
+	} else {
+		opts.Sort = experimentSort(featureflags.FromIncomingContext(ctx))
 	}
 
 	// Apply pagination
@@ -336,9 +401,22 @@ func (s *ProductServer) ListProducts(ctx context.Context, req *productv1.ListPro
 			CategoryIds:   p.CategoryIDs,
 			SupplierId:    p.SupplierID,
 			IsActive:      p.IsActive,
+			IsPublished:   p.IsPublished,
+			IsDeleted:     p.DeletedAt != nil,
 			ImageUrls:     p.ImageURLs,
+			Images:        toProtoImages(p),
 			VideoUrls:     p.VideoURLs,
 			Metadata:      convertMetadata(p.Metadata),
+			CreatedBy:     p.CreatedBy,
+			UpdatedBy:     p.UpdatedBy,
+
+			MinOrderQty:       p.MinOrderQty,
+			MaxOrderQty:       p.MaxOrderQty,
+			OrderQtyIncrement: p.OrderQtyIncrement,
+			BundleComponents:  toProtoBundleComponents(p.BundleComponents),
+			RatingAverage:     p.Rating.Average,
+			RatingCount:       p.Rating.Count,
+			Version:           p.Version,
 		}
 
 		// Only set timestamps if they are not zero
@@ -348,10 +426,16 @@ func (s *ProductServer) ListProducts(ctx context.Context, req *productv1.ListPro
 		if !p.UpdatedAt.IsZero() {
 			pbProduct.UpdatedAt = timestamppb.New(p.UpdatedAt)
 		}
+		if p.DeletedAt != nil {
+			pbProduct.DeletedAt = timestamppb.New(*p.DeletedAt)
+		}
 
 		pbProducts = append(pbProducts, pbProduct)
 	}
 
+	s.formatPrices(pbProducts...)
+	redactForCaller(ctx, pbProducts...)
+
 	// Log successful operation
 	log.Info("Products listed successfully",
 		zap.Int("count", len(products)),
@@ -407,6 +491,8 @@ func (s *ProductServer) ListCategories(ctx context.Context, req *productv1.ListC
 			ParentId:    cat.ParentID,
 			CreatedAt:   timestamppb.New(cat.CreatedAt),
 			UpdatedAt:   timestamppb.New(cat.UpdatedAt),
+			ProductCount: cat.ProductCount,
+			Attributes:   toProtoAttributes(cat.Attributes),
 		})
 	}
 
@@ -446,12 +532,16 @@ func (s *ProductServer) CreateCategory(ctx context.Context, req *productv1.Creat
 		Name:        req.GetName(),
 		Description: req.GetDescription(),
 		ParentID:    req.GetParentId(),
+		Attributes:  toDomainAttributes(req.GetAttributes()),
 	}
 
 	// Create category using the category service
 	createdCategory, err := s.categoryService.CreateCategory(ctx, category)
 	if err != nil {
 		s.logError(log, err, "Failed to create category")
+		if errors.Is(err, domain.ErrInvalidAttributeSchema) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to create category")
 	}
 
@@ -465,6 +555,7 @@ func (s *ProductServer) CreateCategory(ctx context.Context, req *productv1.Creat
 		Path:        createdCategory.Path,
 		CreatedAt:   timestamppb.New(createdCategory.CreatedAt),
 		UpdatedAt:   timestamppb.New(createdCategory.UpdatedAt),
+		Attributes:  toProtoAttributes(createdCategory.Attributes),
 	}
 
 	log.Info("Successfully created category",
@@ -477,6 +568,98 @@ func (s *ProductServer) CreateCategory(ctx context.Context, req *productv1.Creat
 	}, nil
 }
 
+// RebuildSearchIndex handles the RebuildSearchIndex gRPC request
+func (s *ProductServer) RebuildSearchIndex(ctx context.Context, req *productv1.RebuildSearchIndexRequest) (*productv1.RebuildSearchIndexResponse, error) {
+	start := time.Now()
+	log := s.logger.With(
+		zap.String("method", "RebuildSearchIndex"),
+	)
+
+	log.Debug("Processing RebuildSearchIndex request")
+
+	count, err := s.service.RebuildSearchIndex(ctx)
+	if err != nil {
+		s.logError(log, err, "Failed to rebuild search index")
+		return nil, status.Error(codes.Internal, "failed to rebuild search index")
+	}
+
+	log.Info("Search index rebuilt successfully",
+		zap.Int64("products_indexed", count),
+		zap.Duration("duration", time.Since(start)),
+	)
+
+	return &productv1.RebuildSearchIndexResponse{
+		ProductsIndexed: count,
+	}, nil
+}
+
+// ReassignSupplierProducts handles the ReassignSupplierProducts gRPC request
+func (s *ProductServer) ReassignSupplierProducts(ctx context.Context, req *productv1.ReassignSupplierProductsRequest) (*productv1.ReassignSupplierProductsResponse, error) {
+	log := s.logger.With(
+		zap.String("method", "ReassignSupplierProducts"),
+		zap.String("from_supplier_id", req.GetFromSupplierId()),
+		zap.String("to_supplier_id", req.GetToSupplierId()),
+	)
+
+	count, err := s.service.ReassignSupplierProducts(ctx, req.GetFromSupplierId(), req.GetToSupplierId())
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		s.logError(log, err, "Failed to reassign supplier products")
+		return nil, status.Error(codes.Internal, "failed to reassign supplier products")
+	}
+
+	log.Info("Supplier products reassigned", zap.Int64("products_reassigned", count))
+	return &productv1.ReassignSupplierProductsResponse{
+		ProductsReassigned: count,
+	}, nil
+}
+
+// maxBulkSetCategoryProducts caps the number of products one
+// BulkSetCategories call changes
+const maxBulkSetCategoryProducts = 1000
+
+// BulkSetCategories handles the BulkSetCategories gRPC request
+func (s *ProductServer) BulkSetCategories(ctx context.Context, req *productv1.BulkSetCategoriesRequest) (*productv1.BulkSetCategoriesResponse, error) {
+	log := s.logger.With(
+		zap.String("method", "BulkSetCategories"),
+		zap.String("mode", req.GetMode()),
+		zap.Int("product_count", len(req.GetProductIds())),
+	)
+
+	if len(req.GetProductIds()) > maxBulkSetCategoryProducts {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d products may be changed at once", maxBulkSetCategoryProducts)
+	}
+
+	count, err := s.service.BulkSetCategories(ctx, req.GetProductIds(), req.GetCategoryIds(), req.GetMode())
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) || errors.Is(err, domain.ErrInvalidID) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		s.logError(log, err, "Failed to set product categories")
+		return nil, status.Error(codes.Internal, "failed to set product categories")
+	}
+
+	log.Info("Product categories set", zap.Int64("products_updated", count))
+	return &productv1.BulkSetCategoriesResponse{
+		ProductsUpdated: count,
+	}, nil
+}
+
+// formatPrices writes the prices of products with their currency's number of
+// decimal places, covering products stored before prices were normalized
+func (s *ProductServer) formatPrices(products ...*productv1.Product) {
+	for _, p := range products {
+		if p.CostPrice != "" {
+			p.CostPrice = s.prices.Format(p.CostPrice, p.Currency)
+		}
+		if p.SellingPrice != "" {
+			p.SellingPrice = s.prices.Format(p.SellingPrice, p.Currency)
+		}
+	}
+}
+
 // logError logs errors with additional context
 func (s *ProductServer) logError(log *zap.Logger, err error, msg string) {
 	log.Error(msg,
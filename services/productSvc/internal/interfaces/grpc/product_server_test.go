@@ -0,0 +1,201 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/identity"
+	productv1 "github.com/leonvanderhaeghen/stockplatform/services/productSvc/api/gen/go/proto/product/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/application"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// memoryProductRepository is a product repository that only supports lookups
+// by ID; anything else panics on the nil embedded interface
+type memoryProductRepository struct {
+	domain.ProductRepository
+	products map[string]*domain.Product
+}
+
+func newMemoryProductRepository(products ...*domain.Product) *memoryProductRepository {
+	r := &memoryProductRepository{products: make(map[string]*domain.Product)}
+	for _, p := range products {
+		r.products[p.ID.Hex()] = p
+	}
+	return r
+}
+
+func (r *memoryProductRepository) GetByID(ctx context.Context, id string) (*domain.Product, error) {
+	if _, err := primitive.ObjectIDFromHex(id); err != nil {
+		return nil, domain.ErrInvalidID
+	}
+	p, ok := r.products[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	copied := *p
+	return &copied, nil
+}
+
+func (r *memoryProductRepository) GetByIDs(ctx context.Context, ids []string) ([]*domain.Product, error) {
+	var products []*domain.Product
+	for _, id := range ids {
+		if p, ok := r.products[id]; ok {
+			copied := *p
+			products = append(products, &copied)
+		}
+	}
+	return products, nil
+}
+
+// newTestProductServer returns a product server over repo
+func newTestProductServer(repo domain.ProductRepository) *ProductServer {
+	service := application.NewProductService(repo, nil, nil, nil, "", "", nil,
+		domain.SearchPolicy{}, domain.SortOption{}, domain.MediaPolicy{}, domain.NewPricePolicy(nil),
+		nil, domain.RetryPolicy{}, nil, zap.NewNop())
+	return NewProductServer(service, nil, nil, zap.NewNop())
+}
+
+// asRole returns a context carrying the metadata the gateway forwards for a
+// caller with role
+func asRole(role string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		identity.RoleMetadataKey, role,
+		identity.UserIDMetadataKey, "user-1",
+	))
+}
+
+func newPricedProduct() *domain.Product {
+	return &domain.Product{
+		ID:           primitive.NewObjectID(),
+		Name:         "Desk lamp",
+		SKU:          "LAMP-1",
+		CostPrice:    "12.5",
+		SellingPrice: "29.99",
+		Currency:     "EUR",
+		CreatedBy:    "staff-7",
+		UpdatedBy:    "staff-8",
+	}
+}
+
+func TestGetProductStripsCostPriceForCustomers(t *testing.T) {
+	product := newPricedProduct()
+	server := newTestProductServer(newMemoryProductRepository(product))
+
+	for name, ctx := range map[string]context.Context{
+		"customer":  asRole("CUSTOMER"),
+		"anonymous": context.Background(),
+	} {
+		resp, err := server.GetProduct(ctx, &productv1.GetProductRequest{Id: product.ID.Hex()})
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		got := resp.GetProduct()
+		if got.GetCostPrice() != "" || got.GetCreatedBy() != "" || got.GetUpdatedBy() != "" {
+			t.Errorf("%s: cost price and editors should be stripped, got %q %q %q", name, got.GetCostPrice(), got.GetCreatedBy(), got.GetUpdatedBy())
+		}
+		if got.GetSellingPrice() != "29.99" {
+			t.Errorf("%s: selling price = %q, want 29.99", name, got.GetSellingPrice())
+		}
+	}
+}
+
+func TestGetProductKeepsCostPriceForStaff(t *testing.T) {
+	product := newPricedProduct()
+	server := newTestProductServer(newMemoryProductRepository(product))
+
+	for _, role := range []string{"STAFF", "ADMIN"} {
+		resp, err := server.GetProduct(asRole(role), &productv1.GetProductRequest{Id: product.ID.Hex()})
+		if err != nil {
+			t.Fatalf("%s: %v", role, err)
+		}
+		if got := resp.GetProduct().GetCostPrice(); got != "12.50" {
+			t.Errorf("%s: cost price = %q, want 12.50", role, got)
+		}
+		if got := resp.GetProduct().GetCreatedBy(); got != "staff-7" {
+			t.Errorf("%s: created by = %q, want staff-7", role, got)
+		}
+	}
+}
+
+func TestBatchGetProductsStripsCostPriceForCustomers(t *testing.T) {
+	product := newPricedProduct()
+	server := newTestProductServer(newMemoryProductRepository(product))
+
+	resp, err := server.BatchGetProducts(asRole("CUSTOMER"), &productv1.BatchGetProductsRequest{Ids: []string{product.ID.Hex()}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.GetProducts()) != 1 || resp.GetProducts()[0].GetCostPrice() != "" {
+		t.Fatalf("cost price should be stripped, got %+v", resp.GetProducts())
+	}
+}
+
+func (r *memoryProductRepository) Update(ctx context.Context, product *domain.Product) error {
+	if _, ok := r.products[product.ID.Hex()]; !ok {
+		return domain.ErrNotFound
+	}
+	stored := *product
+	r.products[product.ID.Hex()] = &stored
+	return nil
+}
+
+func TestUpdateProductRecordsCallerAndKeepsCreator(t *testing.T) {
+	product := newPricedProduct()
+	repo := newMemoryProductRepository(product)
+	server := newTestProductServer(repo)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		identity.RoleMetadataKey, "STAFF",
+		identity.UserIDMetadataKey, "staff-9",
+	))
+	resp, err := server.UpdateProduct(ctx, &productv1.UpdateProductRequest{
+		Id:           product.ID.Hex(),
+		Name:         "Desk lamp, brass",
+		Sku:          product.SKU,
+		CostPrice:    product.CostPrice,
+		SellingPrice: product.SellingPrice,
+		Currency:     product.Currency,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stored := repo.products[product.ID.Hex()]
+	if stored.UpdatedBy != "staff-9" || resp.GetProduct().GetUpdatedBy() != "staff-9" {
+		t.Errorf("updated by = %q (response %q), want the caller staff-9", stored.UpdatedBy, resp.GetProduct().GetUpdatedBy())
+	}
+	if stored.CreatedBy != "staff-7" || resp.GetProduct().GetCreatedBy() != "staff-7" {
+		t.Errorf("created by = %q (response %q), should stay staff-7", stored.CreatedBy, resp.GetProduct().GetCreatedBy())
+	}
+}
+
+func TestUpdateProductStaleVersionIsAborted(t *testing.T) {
+	product := newPricedProduct()
+	product.Version = 2
+	repo := newMemoryProductRepository(product)
+	server := newTestProductServer(repo)
+
+	_, err := server.UpdateProduct(asRole("STAFF"), &productv1.UpdateProductRequest{
+		Id:           product.ID.Hex(),
+		Name:         "Desk lamp, brass",
+		Sku:          product.SKU,
+		CostPrice:    product.CostPrice,
+		SellingPrice: product.SellingPrice,
+		Currency:     product.Currency,
+		Version:      1,
+	})
+
+	if status.Code(err) != codes.Aborted {
+		t.Fatalf("err = %v, want Aborted so the client refetches", err)
+	}
+	if stored := repo.products[product.ID.Hex()]; stored.Name != "Desk lamp" {
+		t.Fatalf("stored name = %q, the stale edit must not be saved", stored.Name)
+	}
+}
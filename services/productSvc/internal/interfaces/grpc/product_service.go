@@ -63,11 +63,12 @@ func (s *ProductServiceServer) CreateProduct(
 		IsActive:      req.IsActive,
 
 		ImageURLs:     req.ImageUrls,
+		Images:        fromProtoImages(req.Images),
 		VideoURLs:     req.VideoUrls,
 		Metadata:      metadata,
 	}
 
-	created, err := s.productService.CreateProduct(ctx, product)
+	created, err := s.productService.CreateProduct(ctx, product, req.PrimaryLocationId)
 	if err != nil {
 		s.logger.Error("Failed to create product", zap.Error(err))
 		code, msg := convertToGRPCError(err)
@@ -159,6 +160,8 @@ func toProtoCategory(c *domain.Category) *productv1.Category {
 		Path:        c.Path,
 		CreatedAt:   timestamppb.New(c.CreatedAt),
 		UpdatedAt:   timestamppb.New(c.UpdatedAt),
+		ProductCount: c.ProductCount,
+		Attributes:   toProtoAttributes(c.Attributes),
 	}
 }
 
@@ -176,10 +179,23 @@ func toProtoProduct(p *domain.Product) *productv1.Product {
 		CategoryIds:   p.CategoryIDs,
 		SupplierId:    p.SupplierID,
 		IsActive:      p.IsActive,
+		IsPublished:   p.IsPublished,
+		IsDeleted:     p.DeletedAt != nil,
 
 		ImageUrls:     p.ImageURLs,
+		Images:        toProtoImages(p),
 		VideoUrls:     p.VideoURLs,
 		Metadata:      convertMetadata(p.Metadata),
+		CreatedBy:     p.CreatedBy,
+		UpdatedBy:     p.UpdatedBy,
+
+		MinOrderQty:       p.MinOrderQty,
+		MaxOrderQty:       p.MaxOrderQty,
+		OrderQtyIncrement: p.OrderQtyIncrement,
+		BundleComponents:  toProtoBundleComponents(p.BundleComponents),
+		RatingAverage:     p.Rating.Average,
+		RatingCount:       p.Rating.Count,
+		Version:           p.Version,
 	}
 
 	// Only set timestamps if they are not zero
@@ -189,6 +205,9 @@ func toProtoProduct(p *domain.Product) *productv1.Product {
 	if !p.UpdatedAt.IsZero() {
 		pbProduct.UpdatedAt = timestamppb.New(p.UpdatedAt)
 	}
+	if p.DeletedAt != nil {
+		pbProduct.DeletedAt = timestamppb.New(*p.DeletedAt)
+	}
 
 	return pbProduct
 }
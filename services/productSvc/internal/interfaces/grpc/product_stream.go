@@ -0,0 +1,129 @@
+package grpc
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/identity"
+	productv1 "github.com/leonvanderhaeghen/stockplatform/services/productSvc/api/gen/go/proto/product/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+const (
+	// defaultStreamBatchSize is the number of products per StreamProducts
+	// message when the request names none
+	defaultStreamBatchSize = 100
+	// maxStreamBatchSize caps the number of products per StreamProducts message
+	maxStreamBatchSize = 500
+)
+
+// StreamProducts handles the StreamProducts gRPC request. Products are sent in
+// batches as they are read, so the full result set is never held in memory.
+func (s *ProductServer) StreamProducts(req *productv1.StreamProductsRequest, stream grpc.ServerStreamingServer[productv1.StreamProductsResponse]) error {
+	start := time.Now()
+	ctx := stream.Context()
+	log := s.logger.With(
+		zap.String("method", "StreamProducts"),
+	)
+
+	batchSize := int(req.GetBatchSize())
+	if batchSize < 0 || batchSize > maxStreamBatchSize {
+		return status.Errorf(codes.InvalidArgument, "batch_size must be between 1 and %d", maxStreamBatchSize)
+	}
+	if batchSize == 0 {
+		batchSize = defaultStreamBatchSize
+	}
+
+	opts := &domain.ListOptions{}
+	if req.GetFilter() != nil {
+		opts.Filter = toDomainProductFilter(req.GetFilter())
+	}
+	// Soft-deleted products stay out of customer-facing streams
+	if req.GetIncludeDeleted() && identity.IsStaff(ctx) {
+		if opts.Filter == nil {
+			opts.Filter = &domain.ProductFilter{}
+		}
+		opts.Filter.IncludeDeleted = true
+	}
+	if req.GetSort() != nil {
+		opts.Sort = toDomainSort(req.GetSort())
+	}
+
+	sent := 0
+	batch := make([]*productv1.Product, 0, batchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		s.formatPrices(batch...)
+		redactForCaller(ctx, batch...)
+		if err := stream.Send(&productv1.StreamProductsResponse{Products: batch}); err != nil {
+			return err
+		}
+		sent += len(batch)
+		batch = make([]*productv1.Product, 0, batchSize)
+		return nil
+	}
+
+	err := s.service.StreamProducts(ctx, opts, func(p *domain.Product) error {
+		batch = append(batch, toProtoProduct(p))
+		if len(batch) < batchSize {
+			return nil
+		}
+		return flush()
+	})
+	if err == nil {
+		err = flush()
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			log.Info("Product stream ended by client", zap.Int("sent", sent))
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		s.logError(log, err, "Failed to stream products")
+		return status.Error(codes.Internal, "failed to stream products")
+	}
+
+	log.Info("Products streamed successfully",
+		zap.Int("count", sent),
+		zap.Duration("duration", time.Since(start)),
+	)
+	return nil
+}
+
+// toDomainSort converts a protobuf product sort to the domain sort option.
+// Unknown fields sort by creation date and unknown orders descend.
+func toDomainSort(sort *productv1.ProductSort) *domain.SortOption {
+	var sortField domain.SortField
+	switch sort.GetField() {
+	case productv1.ProductSort_SORT_FIELD_NAME:
+		sortField = domain.SortFieldName
+	case productv1.ProductSort_SORT_FIELD_PRICE:
+		sortField = domain.SortFieldPrice
+	case productv1.ProductSort_SORT_FIELD_CREATED_AT:
+		sortField = domain.SortFieldCreatedAt
+	case productv1.ProductSort_SORT_FIELD_UPDATED_AT:
+		sortField = domain.SortFieldUpdatedAt
+	default:
+		sortField = domain.SortFieldCreatedAt
+	}
+
+	var sortOrder domain.SortOrder
+	switch sort.GetOrder() {
+	case productv1.ProductSort_SORT_ORDER_ASC:
+		sortOrder = domain.SortOrderAsc
+	case productv1.ProductSort_SORT_ORDER_DESC:
+		sortOrder = domain.SortOrderDesc
+	default:
+		sortOrder = domain.SortOrderDesc
+	}
+
+	return &domain.SortOption{
+		Field: sortField,
+		Order: sortOrder,
+	}
+}
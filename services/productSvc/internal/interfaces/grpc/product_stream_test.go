@@ -0,0 +1,158 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	productv1 "github.com/leonvanderhaeghen/stockplatform/services/productSvc/api/gen/go/proto/product/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// endlessProductRepository streams products until its context is done, like
+// a catalog far larger than any client reads, and reports on done the error
+// the stream ended with
+type endlessProductRepository struct {
+	domain.ProductRepository
+	done chan error
+}
+
+func newEndlessProductRepository() *endlessProductRepository {
+	return &endlessProductRepository{done: make(chan error, 1)}
+}
+
+func (r *endlessProductRepository) StreamList(ctx context.Context, opts *domain.ListOptions, fn func(*domain.Product) error) (err error) {
+	defer func() { r.done <- err }()
+	for i := 0; ; i++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		p := &domain.Product{ID: primitive.NewObjectID(), Name: fmt.Sprintf("Product %d", i), SKU: fmt.Sprintf("SKU-%d", i)}
+		if err := fn(p); err != nil {
+			return err
+		}
+	}
+}
+
+// waitForStreamEnd returns the error the repository stream ended with
+func (r *endlessProductRepository) waitForStreamEnd(t *testing.T) error {
+	t.Helper()
+	select {
+	case err := <-r.done:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatal("the repository stream kept running after the client went away")
+		return nil
+	}
+}
+
+// cancellingProductStream is a StreamProducts stream whose client goes away
+// after receiving its first message
+type cancellingProductStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	sent   []*productv1.StreamProductsResponse
+}
+
+func (s *cancellingProductStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *cancellingProductStream) Send(resp *productv1.StreamProductsResponse) error {
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
+	s.sent = append(s.sent, resp)
+	s.cancel()
+	return nil
+}
+
+func TestStreamProductsStopsWhenCancelledMidStream(t *testing.T) {
+	repo := newEndlessProductRepository()
+	server := newTestProductServer(repo)
+	ctx, cancel := context.WithCancel(asRole("ADMIN"))
+	defer cancel()
+	stream := &cancellingProductStream{ctx: ctx, cancel: cancel}
+
+	err := server.StreamProducts(&productv1.StreamProductsRequest{BatchSize: 3}, stream)
+
+	if status.Code(err) != codes.Canceled {
+		t.Fatalf("StreamProducts error = %v, want %s", err, codes.Canceled)
+	}
+	if len(stream.sent) != 1 || len(stream.sent[0].GetProducts()) != 3 {
+		t.Fatalf("sent %d messages, want one batch of 3 before the client went away", len(stream.sent))
+	}
+	if err := repo.waitForStreamEnd(t); err == nil {
+		t.Fatal("the repository stream ended without an error after cancellation")
+	}
+}
+
+func TestStreamProductsClientDisconnect(t *testing.T) {
+	repo := newEndlessProductRepository()
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	productv1.RegisterProductServiceServer(grpcServer, newTestProductServer(repo))
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.Dial("passthrough:///bufnet",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := productv1.NewProductServiceClient(conn).StreamProducts(ctx, &productv1.StreamProductsRequest{BatchSize: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv: %v", err)
+	}
+	if len(resp.GetProducts()) != 10 {
+		t.Fatalf("first batch has %d products, want 10", len(resp.GetProducts()))
+	}
+
+	cancel()
+
+	if err := repo.waitForStreamEnd(t); err == nil {
+		t.Fatal("the repository stream ended without an error after the client disconnected")
+	}
+	// Batches already in flight may still arrive before the cancellation
+	for {
+		if _, err := stream.Recv(); err != nil {
+			if status.Code(err) != codes.Canceled {
+				t.Fatalf("Recv after cancel = %v, want %s", err, codes.Canceled)
+			}
+			break
+		}
+	}
+}
+
+func TestStreamProductsRejectsOversizedBatches(t *testing.T) {
+	server := newTestProductServer(newEndlessProductRepository())
+	stream := &cancellingProductStream{ctx: context.Background(), cancel: func() {}}
+
+	err := server.StreamProducts(&productv1.StreamProductsRequest{BatchSize: maxStreamBatchSize + 1}, stream)
+
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("StreamProducts error = %v, want %s", err, codes.InvalidArgument)
+	}
+}
@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/identity"
+	productv1 "github.com/leonvanderhaeghen/stockplatform/services/productSvc/api/gen/go/proto/product/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// UpdateProduct handles the UpdateProduct gRPC request. A product that changed
+// since the caller read it is reported as Aborted, so the caller can fetch it
+// again and reapply the edit.
+func (s *ProductServer) UpdateProduct(ctx context.Context, req *productv1.UpdateProductRequest) (*productv1.UpdateProductResponse, error) {
+	start := time.Now()
+	log := s.logger.With(
+		zap.String("method", "UpdateProduct"),
+		zap.String("product_id", req.GetId()),
+		zap.Int64("version", req.GetVersion()),
+	)
+
+	id, err := primitive.ObjectIDFromHex(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product ID format")
+	}
+
+	metadata := make(map[string]interface{}, len(req.GetMetadata()))
+	for k, v := range req.GetMetadata() {
+		metadata[k] = v
+	}
+
+	input := &domain.Product{
+		ID:           id,
+		Name:         req.GetName(),
+		Description:  req.GetDescription(),
+		CostPrice:    req.GetCostPrice(),
+		SellingPrice: req.GetSellingPrice(),
+		Currency:     req.GetCurrency(),
+		SKU:          req.GetSku(),
+		Barcode:      req.GetBarcode(),
+		CategoryIDs:  req.GetCategoryIds(),
+		SupplierID:   req.GetSupplierId(),
+		IsActive:     req.GetIsActive(),
+		ImageURLs:    req.GetImageUrls(),
+		Images:       fromProtoImages(req.GetImages()),
+		VideoURLs:    req.GetVideoUrls(),
+		Metadata:     metadata,
+		UpdatedBy:    identity.UserID(ctx),
+		Version:      req.GetVersion(),
+	}
+
+	if err := s.service.UpdateProduct(ctx, input); err != nil {
+		s.logError(log, err, "Failed to update product")
+		switch {
+		case errors.Is(err, domain.ErrConflict):
+			return nil, status.Error(codes.Aborted, "product was modified by someone else, fetch it again and retry")
+		case errors.Is(err, domain.ErrSupplierNotFound):
+			return nil, status.Error(codes.FailedPrecondition, "supplier not found")
+		case errors.Is(err, domain.ErrNotFound):
+			return nil, status.Error(codes.NotFound, "product not found")
+		case errors.Is(err, domain.ErrValidation):
+			return nil, status.Error(codes.InvalidArgument, "invalid product data: "+err.Error())
+		case errors.Is(err, domain.ErrAlreadyExists):
+			return nil, status.Error(codes.AlreadyExists, "product with this SKU or barcode already exists")
+		default:
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	updated, err := s.service.GetProduct(ctx, req.GetId())
+	if err != nil {
+		s.logError(log, err, "Failed to get updated product")
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	log.Info("Product updated successfully",
+		zap.Int64("new_version", updated.Version),
+		zap.Duration("duration", time.Since(start)),
+	)
+
+	pbProduct := toProtoProduct(updated)
+	s.formatPrices(pbProduct)
+	redactForCaller(ctx, pbProduct)
+	return &productv1.UpdateProductResponse{Product: pbProduct}, nil
+}
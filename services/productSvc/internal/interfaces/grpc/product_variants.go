@@ -0,0 +1,129 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	productv1 "github.com/leonvanderhaeghen/stockplatform/services/productSvc/api/gen/go/proto/product/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+// GetVariant handles the GetVariant gRPC request
+func (s *ProductServer) GetVariant(ctx context.Context, req *productv1.GetVariantRequest) (*productv1.GetVariantResponse, error) {
+	start := time.Now()
+	log := s.logger.With(
+		zap.String("method", "GetVariant"),
+		zap.String("product_id", req.GetProductId()),
+		zap.String("variant_id", req.GetVariantId()),
+	)
+
+	log.Debug("Processing GetVariant request")
+
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "product ID is required")
+	}
+	if req.GetVariantId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "variant ID is required")
+	}
+
+	variant, err := s.service.GetVariant(ctx, req.GetProductId(), req.GetVariantId())
+	if err != nil {
+		s.logError(log, err, "Failed to get variant")
+		return nil, variantStatusError(err)
+	}
+
+	log.Info("Variant retrieved successfully",
+		zap.Duration("duration", time.Since(start)),
+	)
+
+	return &productv1.GetVariantResponse{
+		Variant: toProtoVariant(variant),
+	}, nil
+}
+
+// ListVariants handles the ListVariants gRPC request
+func (s *ProductServer) ListVariants(ctx context.Context, req *productv1.ListVariantsRequest) (*productv1.ListVariantsResponse, error) {
+	start := time.Now()
+	log := s.logger.With(
+		zap.String("method", "ListVariants"),
+		zap.String("product_id", req.GetProductId()),
+	)
+
+	log.Debug("Processing ListVariants request")
+
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "product ID is required")
+	}
+
+	variants, err := s.service.ListVariants(ctx, req.GetProductId())
+	if err != nil {
+		s.logError(log, err, "Failed to list variants")
+		return nil, variantStatusError(err)
+	}
+
+	pbVariants := make([]*productv1.Variant, 0, len(variants))
+	for i := range variants {
+		pbVariants = append(pbVariants, toProtoVariant(&variants[i]))
+	}
+
+	log.Info("Variants listed successfully",
+		zap.Int("variants", len(pbVariants)),
+		zap.Duration("duration", time.Since(start)),
+	)
+
+	return &productv1.ListVariantsResponse{
+		Variants: pbVariants,
+	}, nil
+}
+
+// variantStatusError maps variant lookup errors to gRPC status errors
+func variantStatusError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrVariantNotFound):
+		return status.Error(codes.NotFound, "variant not found")
+	case errors.Is(err, domain.ErrNotFound):
+		return status.Error(codes.NotFound, "product not found")
+	case errors.Is(err, domain.ErrInvalidID):
+		return status.Error(codes.InvalidArgument, "invalid product ID format")
+	case errors.Is(err, domain.ErrValidation):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
+
+// toProtoVariant converts a domain variant to protobuf
+func toProtoVariant(v *domain.Variant) *productv1.Variant {
+	options := make([]*productv1.VariantOption, 0, len(v.Options))
+	for _, o := range v.Options {
+		options = append(options, &productv1.VariantOption{
+			Id:              o.ID,
+			Name:            o.Name,
+			Value:           o.Value,
+			Sku:             o.SKU,
+			Barcode:         o.Barcode,
+			PriceAdjustment: o.PriceAdjustment,
+			IsDefault:       o.IsDefault,
+			ImageUrl:        o.ImageURL,
+		})
+	}
+
+	pb := &productv1.Variant{
+		Id:      v.ID,
+		Name:    v.Name,
+		Options: options,
+	}
+	if !v.CreatedAt.IsZero() {
+		pb.CreatedAt = timestamppb.New(v.CreatedAt)
+	}
+	if !v.UpdatedAt.IsZero() {
+		pb.UpdatedAt = timestamppb.New(v.UpdatedAt)
+	}
+	return pb
+}
@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	productv1 "github.com/leonvanderhaeghen/stockplatform/services/productSvc/api/gen/go/proto/product/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+)
+
+func newVariantProduct() *domain.Product {
+	product := newPricedProduct()
+	product.Variants = []domain.Variant{
+		{ID: "size", Name: "Size", Options: []domain.VariantOption{{ID: "s", Name: "Small", Value: "S"}, {ID: "l", Name: "Large", Value: "L"}}},
+		{ID: "color", Name: "Color", Options: []domain.VariantOption{{ID: "red", Name: "Red", Value: "red"}}},
+	}
+	return product
+}
+
+func TestGetVariant(t *testing.T) {
+	product := newVariantProduct()
+	server := newTestProductServer(newMemoryProductRepository(product))
+
+	resp, err := server.GetVariant(context.Background(), &productv1.GetVariantRequest{ProductId: product.ID.Hex(), VariantId: "color"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetVariant().GetId() != "color" || resp.GetVariant().GetName() != "Color" {
+		t.Fatalf("variant = %+v, want color", resp.GetVariant())
+	}
+}
+
+func TestGetVariantNotFound(t *testing.T) {
+	product := newVariantProduct()
+	server := newTestProductServer(newMemoryProductRepository(product))
+
+	_, err := server.GetVariant(context.Background(), &productv1.GetVariantRequest{ProductId: product.ID.Hex(), VariantId: "material"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("missing variant: code = %s, want NotFound", status.Code(err))
+	}
+
+	_, err = server.GetVariant(context.Background(), &productv1.GetVariantRequest{ProductId: primitive.NewObjectID().Hex(), VariantId: "size"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("missing product: code = %s, want NotFound", status.Code(err))
+	}
+
+	_, err = server.GetVariant(context.Background(), &productv1.GetVariantRequest{ProductId: product.ID.Hex()})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("no variant ID: code = %s, want InvalidArgument", status.Code(err))
+	}
+}
+
+func TestListVariants(t *testing.T) {
+	product := newVariantProduct()
+	server := newTestProductServer(newMemoryProductRepository(product))
+
+	resp, err := server.ListVariants(context.Background(), &productv1.ListVariantsRequest{ProductId: product.ID.Hex()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	variants := resp.GetVariants()
+	if len(variants) != 2 || variants[0].GetId() != "size" || variants[1].GetId() != "color" {
+		t.Fatalf("variants = %+v, want size and color", variants)
+	}
+	if len(variants[0].GetOptions()) != 2 {
+		t.Fatalf("size options = %d, want 2", len(variants[0].GetOptions()))
+	}
+}
@@ -1,10 +1,8 @@
 package server
 
 import (
+	"context"
 	"net"
-	"os"
-	"os/signal"
-	"syscall"
 	"time"
 
 	"go.uber.org/zap"
@@ -13,13 +11,19 @@ import (
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/shutdown"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/version"
 	productv1 "github.com/leonvanderhaeghen/stockplatform/services/productSvc/api/gen/go/proto/product/v1"
 	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/application"
 	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/config"
 	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/database"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/domain"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/infrastructure/mediaprobe"
+	"github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/infrastructure/orders"
 	grpchandlers "github.com/leonvanderhaeghen/stockplatform/services/productSvc/internal/interfaces/grpc"
 	supplierclient "github.com/leonvanderhaeghen/stockplatform/pkg/clients/supplier"
 	inventoryclient "github.com/leonvanderhaeghen/stockplatform/pkg/clients/inventory"
+	orderclient "github.com/leonvanderhaeghen/stockplatform/pkg/clients/order"
 )
 
 // Server holds the gRPC server and its dependencies
@@ -31,6 +35,11 @@ type Server struct {
 	logger         *zap.Logger
 	supplierClient *supplierclient.Client
 	inventoryClient *inventoryclient.Client
+	orderClient     *orderclient.Client
+	categoryCounts  *application.CategoryCountReconciler
+	pendingInventory *application.InventoryReconciler
+	mediaProbes      *application.MediaProbeWorker
+	shutdown         *shutdown.Coordinator
 }
 
 // New creates a new server instance
@@ -45,7 +54,7 @@ func New(cfg *config.Config, db *database.Database, logger *zap.Logger) *Server
 // Initialize sets up the gRPC server with all services
 func (s *Server) Initialize() error {
 	// Create gRPC server
-	s.grpcServer = grpc.NewServer()
+	s.grpcServer = grpc.NewServer(s.config.GRPCLimits.ServerOptions()...)
 	s.healthServer = health.NewServer()
 
 	// Initialize supplier gRPC client
@@ -70,16 +79,45 @@ func (s *Server) Initialize() error {
 	}
 	s.inventoryClient = inventoryClient
 
+	// Initialize order gRPC client, used to verify reviewers' purchases
+	orderClient, err := orderclient.New(orderclient.Config{Address: s.config.OrderServiceAddr}, s.logger)
+	if err != nil {
+		s.logger.Error("Failed to initialize order client", zap.Error(err))
+		return err
+	}
+	s.orderClient = orderClient
+
+	skuStrategy, err := domain.ParseSKUStrategy(s.config.SKUStrategy)
+	if err != nil {
+		s.logger.Error("Invalid SKU_STRATEGY", zap.Error(err))
+		return err
+	}
+
+	s.mediaProbes = application.NewMediaProbeWorker(s.database.ProductRepo, mediaprobe.NewHTTPProber(nil), s.config.MediaProbeInterval, s.config.MediaProbeTimeout, s.config.MediaProbeQueueSize, s.logger)
+
 	// Initialize application services
-	productService := application.NewProductService(s.database.ProductRepo, supplierClient, inventoryClient, s.logger)
-	categoryService := application.NewCategoryService(s.database.CategoryRepo, s.logger)
+	productService := application.NewProductService(s.database.ProductRepo, s.database.CategoryRepo, supplierClient, inventoryClient, s.config.DefaultLocationID, skuStrategy, s.database.SKUSequence, domain.NewSearchPolicy(s.config.SearchMinQueryLength, s.config.SearchStopWords), s.config.DefaultSort, domain.NewMediaPolicy(s.config.MediaAllowedHosts), domain.NewPricePolicy(s.config.CurrencyMinorUnits), s.mediaProbes, s.config.InventoryCreateRetry, s.database.PendingInventory, s.logger)
+	s.checkDefaultLocation(productService)
+	categoryService := application.NewCategoryService(s.database.CategoryRepo, s.database.ProductRepo, s.logger)
+	s.categoryCounts = application.NewCategoryCountReconciler(categoryService, s.config.CategoryCountReconcileInterval, s.logger)
+	reviewService := application.NewReviewService(s.database.ReviewRepo, s.database.ProductRepo, orders.NewPurchaseVerifier(orderClient), s.logger)
+	s.pendingInventory = application.NewInventoryReconciler(productService, s.config.InventoryReconcileInterval, s.logger)
+
+	s.shutdown = shutdown.New(s.config.ShutdownDrainTimeout, s.logger)
+	s.shutdown.AddWorker("category_count_reconciler", s.categoryCounts.Stop)
+	s.shutdown.AddWorker("inventory_reconciler", s.pendingInventory.Stop)
+	s.shutdown.AddWorker("media_prober", s.mediaProbes.Stop)
+	s.shutdown.AddServer("grpc", shutdown.GRPCServer(s.grpcServer))
+	s.shutdown.AddCloser("supplier_client", supplierClient.Close)
+	s.shutdown.AddCloser("inventory_client", inventoryClient.Close)
+	s.shutdown.AddCloser("order_client", orderClient.Close)
 
 	// Register gRPC services
-	productServer := grpchandlers.NewProductServer(productService, categoryService, s.logger)
+	productServer := grpchandlers.NewProductServer(productService, categoryService, reviewService, s.logger)
 	productv1.RegisterProductServiceServer(s.grpcServer, productServer)
 
 	// Register health check service
-	grpc_health_v1.RegisterHealthServer(s.grpcServer, s.healthServer)
+	grpc_health_v1.RegisterHealthServer(s.grpcServer, version.NewHealthServer(s.healthServer))
 	s.healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
 
 	// Enable reflection for development
@@ -89,7 +127,24 @@ func (s *Server) Initialize() error {
 	return nil
 }
 
-// Start starts the gRPC server
+// checkDefaultLocation warns when the configured default location is missing
+// from the location registry. It does not stop startup, since the inventory
+// service may simply not be up yet.
+func (s *Server) checkDefaultLocation(productService *application.ProductService) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	locationID := productService.DefaultLocationID()
+	if err := productService.CheckLocation(ctx, locationID); err != nil {
+		s.logger.Warn("Default location could not be verified; set DEFAULT_LOCATION_ID to an existing location",
+			zap.String("location_id", locationID),
+			zap.Error(err),
+		)
+	}
+}
+
+// Start starts the gRPC server and the background workers and blocks until
+// the process is told to stop
 func (s *Server) Start() error {
 	// Create listener
 	lis, err := net.Listen("tcp", ":"+s.config.GRPCPort)
@@ -106,48 +161,23 @@ func (s *Server) Start() error {
 		}
 	}()
 
-	// Wait for interrupt signal
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
-
-	s.logger.Info("Received shutdown signal, stopping server...")
-	return s.Stop()
-}
-
-// Stop gracefully stops the gRPC server
-func (s *Server) Stop() error {
-	s.logger.Info("Shutting down gRPC server...")
-
-	// Close supplier client connection
-	if s.supplierClient != nil {
-		if err := s.supplierClient.Close(); err != nil {
-			s.logger.Error("Failed to close supplier client", zap.Error(err))
-		}
-	}
-
-	// Close inventory client connection
-	if s.inventoryClient != nil {
-		if err := s.inventoryClient.Close(); err != nil {
-			s.logger.Error("Failed to close inventory client", zap.Error(err))
-		}
-	}
+	// Keep category product counts from drifting
+	s.categoryCounts.Start()
 
-	// Graceful shutdown with timeout
-	done := make(chan struct{})
-	go func() {
-		s.grpcServer.GracefulStop()
-		close(done)
-	}()
+	// Create the inventory rows that failed when their products were created
+	s.pendingInventory.Start()
 
-	// Wait for graceful shutdown or timeout
-	select {
-	case <-done:
-		s.logger.Info("gRPC server stopped gracefully")
-	case <-time.After(10 * time.Second):
-		s.logger.Warn("Graceful shutdown timed out, forcing stop")
-		s.grpcServer.Stop()
-	}
+	// Read the metadata of newly added product images
+	s.mediaProbes.Start()
 
+	s.shutdown.ShutdownOnSignal()
 	return nil
 }
+
+// Stop drains the background workers, then stops the gRPC server and closes
+// the supplier and inventory clients the workers and handlers use
+func (s *Server) Stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.ShutdownDrainTimeout+shutdown.ServerStopTimeout)
+	defer cancel()
+	return s.shutdown.Shutdown(ctx)
+}
@@ -195,6 +195,10 @@ type Store struct {
 	Metadata      map[string]string      `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Currency      string                 `protobuf:"bytes,12,opt,name=currency,proto3" json:"currency,omitempty"`              // ISO 4217 code sales at this store are made in
+	TaxRate       string                 `protobuf:"bytes,13,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"` // Sales tax as a decimal fraction, e.g. "0.0825"
+	Timezone      string                 `protobuf:"bytes,14,opt,name=timezone,proto3" json:"timezone,omitempty"`              // IANA timezone the hours and holidays are in, e.g. "Europe/Brussels"; empty means UTC
+	Holidays      []*Holiday             `protobuf:"bytes,15,rep,name=holidays,proto3" json:"holidays,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -306,6 +310,87 @@ func (x *Store) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Store) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Store) GetTaxRate() string {
+	if x != nil {
+		return x.TaxRate
+	}
+	return ""
+}
+
+func (x *Store) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Store) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+// Holiday is a date the store is closed on, whatever its weekly hours say
+type Holiday struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD in the store's timezone
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Holiday) Reset() {
+	*x = Holiday{}
+	mi := &file_store_v1_store_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Holiday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Holiday) ProtoMessage() {}
+
+func (x *Holiday) ProtoReflect() protoreflect.Message {
+	mi := &file_store_v1_store_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Holiday.ProtoReflect.Descriptor instead.
+func (*Holiday) Descriptor() ([]byte, []int) {
+	return file_store_v1_store_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Holiday) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Holiday) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 // Address represents a physical address
 type Address struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -322,7 +407,7 @@ type Address struct {
 
 func (x *Address) Reset() {
 	*x = Address{}
-	mi := &file_store_v1_store_proto_msgTypes[1]
+	mi := &file_store_v1_store_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -334,7 +419,7 @@ func (x *Address) String() string {
 func (*Address) ProtoMessage() {}
 
 func (x *Address) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[1]
+	mi := &file_store_v1_store_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -347,7 +432,7 @@ func (x *Address) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Address.ProtoReflect.Descriptor instead.
 func (*Address) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{1}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Address) GetStreet() string {
@@ -409,7 +494,7 @@ type StoreHours struct {
 
 func (x *StoreHours) Reset() {
 	*x = StoreHours{}
-	mi := &file_store_v1_store_proto_msgTypes[2]
+	mi := &file_store_v1_store_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -421,7 +506,7 @@ func (x *StoreHours) String() string {
 func (*StoreHours) ProtoMessage() {}
 
 func (x *StoreHours) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[2]
+	mi := &file_store_v1_store_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -434,7 +519,7 @@ func (x *StoreHours) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StoreHours.ProtoReflect.Descriptor instead.
 func (*StoreHours) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{2}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *StoreHours) GetDays() []*DayHours {
@@ -456,7 +541,7 @@ type DayHours struct {
 
 func (x *DayHours) Reset() {
 	*x = DayHours{}
-	mi := &file_store_v1_store_proto_msgTypes[3]
+	mi := &file_store_v1_store_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -468,7 +553,7 @@ func (x *DayHours) String() string {
 func (*DayHours) ProtoMessage() {}
 
 func (x *DayHours) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[3]
+	mi := &file_store_v1_store_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -481,7 +566,7 @@ func (x *DayHours) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DayHours.ProtoReflect.Descriptor instead.
 func (*DayHours) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{3}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *DayHours) GetDay() string {
@@ -523,13 +608,17 @@ type StoreProduct struct {
 	StorePrice        string                 `protobuf:"bytes,6,opt,name=store_price,json=storePrice,proto3" json:"store_price,omitempty"`                       // Store-specific pricing (optional)
 	IsAvailable       bool                   `protobuf:"varint,7,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
 	LastUpdated       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	// Quantity limits for one sale line; zero means no limit
+	MinOrderQty       int32 `protobuf:"varint,9,opt,name=min_order_qty,json=minOrderQty,proto3" json:"min_order_qty,omitempty"`
+	MaxOrderQty       int32 `protobuf:"varint,10,opt,name=max_order_qty,json=maxOrderQty,proto3" json:"max_order_qty,omitempty"`
+	OrderQtyIncrement int32 `protobuf:"varint,11,opt,name=order_qty_increment,json=orderQtyIncrement,proto3" json:"order_qty_increment,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *StoreProduct) Reset() {
 	*x = StoreProduct{}
-	mi := &file_store_v1_store_proto_msgTypes[4]
+	mi := &file_store_v1_store_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -541,7 +630,7 @@ func (x *StoreProduct) String() string {
 func (*StoreProduct) ProtoMessage() {}
 
 func (x *StoreProduct) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[4]
+	mi := &file_store_v1_store_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -554,7 +643,7 @@ func (x *StoreProduct) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StoreProduct.ProtoReflect.Descriptor instead.
 func (*StoreProduct) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{4}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *StoreProduct) GetStoreId() string {
@@ -613,6 +702,27 @@ func (x *StoreProduct) GetLastUpdated() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *StoreProduct) GetMinOrderQty() int32 {
+	if x != nil {
+		return x.MinOrderQty
+	}
+	return 0
+}
+
+func (x *StoreProduct) GetMaxOrderQty() int32 {
+	if x != nil {
+		return x.MaxOrderQty
+	}
+	return 0
+}
+
+func (x *StoreProduct) GetOrderQtyIncrement() int32 {
+	if x != nil {
+		return x.OrderQtyIncrement
+	}
+	return 0
+}
+
 // ProductReservation represents a reserved product
 type ProductReservation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -632,7 +742,7 @@ type ProductReservation struct {
 
 func (x *ProductReservation) Reset() {
 	*x = ProductReservation{}
-	mi := &file_store_v1_store_proto_msgTypes[5]
+	mi := &file_store_v1_store_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -644,7 +754,7 @@ func (x *ProductReservation) String() string {
 func (*ProductReservation) ProtoMessage() {}
 
 func (x *ProductReservation) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[5]
+	mi := &file_store_v1_store_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -657,7 +767,7 @@ func (x *ProductReservation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductReservation.ProtoReflect.Descriptor instead.
 func (*ProductReservation) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{5}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ProductReservation) GetId() string {
@@ -743,7 +853,7 @@ type StoreUser struct {
 
 func (x *StoreUser) Reset() {
 	*x = StoreUser{}
-	mi := &file_store_v1_store_proto_msgTypes[6]
+	mi := &file_store_v1_store_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -755,7 +865,7 @@ func (x *StoreUser) String() string {
 func (*StoreUser) ProtoMessage() {}
 
 func (x *StoreUser) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[6]
+	mi := &file_store_v1_store_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -768,7 +878,7 @@ func (x *StoreUser) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StoreUser.ProtoReflect.Descriptor instead.
 func (*StoreUser) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{6}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *StoreUser) GetStoreId() string {
@@ -813,13 +923,17 @@ type StoreSale struct {
 	SaleType       SaleType               `protobuf:"varint,9,opt,name=sale_type,json=saleType,proto3,enum=store.v1.SaleType" json:"sale_type,omitempty"`
 	SaleDate       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=sale_date,json=saleDate,proto3" json:"sale_date,omitempty"`
 	Metadata       map[string]string      `protobuf:"bytes,11,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Subtotal       string                 `protobuf:"bytes,12,opt,name=subtotal,proto3" json:"subtotal,omitempty"` // Sum of the item subtotals, before tax
+	TaxRate        string                 `protobuf:"bytes,13,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	TaxAmount      string                 `protobuf:"bytes,14,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
+	ReceiptNumber  int64                  `protobuf:"varint,15,opt,name=receipt_number,json=receiptNumber,proto3" json:"receipt_number,omitempty"` // Sequential per store
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *StoreSale) Reset() {
 	*x = StoreSale{}
-	mi := &file_store_v1_store_proto_msgTypes[7]
+	mi := &file_store_v1_store_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -831,7 +945,7 @@ func (x *StoreSale) String() string {
 func (*StoreSale) ProtoMessage() {}
 
 func (x *StoreSale) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[7]
+	mi := &file_store_v1_store_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -844,7 +958,7 @@ func (x *StoreSale) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StoreSale.ProtoReflect.Descriptor instead.
 func (*StoreSale) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{7}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *StoreSale) GetId() string {
@@ -924,6 +1038,183 @@ func (x *StoreSale) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *StoreSale) GetSubtotal() string {
+	if x != nil {
+		return x.Subtotal
+	}
+	return ""
+}
+
+func (x *StoreSale) GetTaxRate() string {
+	if x != nil {
+		return x.TaxRate
+	}
+	return ""
+}
+
+func (x *StoreSale) GetTaxAmount() string {
+	if x != nil {
+		return x.TaxAmount
+	}
+	return ""
+}
+
+func (x *StoreSale) GetReceiptNumber() int64 {
+	if x != nil {
+		return x.ReceiptNumber
+	}
+	return 0
+}
+
+// Receipt is the printable summary of a store sale
+type Receipt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReceiptNumber int64                  `protobuf:"varint,1,opt,name=receipt_number,json=receiptNumber,proto3" json:"receipt_number,omitempty"`
+	SaleId        string                 `protobuf:"bytes,2,opt,name=sale_id,json=saleId,proto3" json:"sale_id,omitempty"`
+	StoreId       string                 `protobuf:"bytes,3,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	StoreName     string                 `protobuf:"bytes,4,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	StoreAddress  *Address               `protobuf:"bytes,5,opt,name=store_address,json=storeAddress,proto3" json:"store_address,omitempty"`
+	StorePhone    string                 `protobuf:"bytes,6,opt,name=store_phone,json=storePhone,proto3" json:"store_phone,omitempty"`
+	SalesUserId   string                 `protobuf:"bytes,7,opt,name=sales_user_id,json=salesUserId,proto3" json:"sales_user_id,omitempty"`
+	IssuedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	Lines         []*StoreSaleItem       `protobuf:"bytes,9,rep,name=lines,proto3" json:"lines,omitempty"`
+	Subtotal      string                 `protobuf:"bytes,10,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	TaxRate       string                 `protobuf:"bytes,11,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	TaxAmount     string                 `protobuf:"bytes,12,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
+	Total         string                 `protobuf:"bytes,13,opt,name=total,proto3" json:"total,omitempty"`
+	Currency      string                 `protobuf:"bytes,14,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Receipt) Reset() {
+	*x = Receipt{}
+	mi := &file_store_v1_store_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Receipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Receipt) ProtoMessage() {}
+
+func (x *Receipt) ProtoReflect() protoreflect.Message {
+	mi := &file_store_v1_store_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return file_store_v1_store_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Receipt) GetReceiptNumber() int64 {
+	if x != nil {
+		return x.ReceiptNumber
+	}
+	return 0
+}
+
+func (x *Receipt) GetSaleId() string {
+	if x != nil {
+		return x.SaleId
+	}
+	return ""
+}
+
+func (x *Receipt) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *Receipt) GetStoreName() string {
+	if x != nil {
+		return x.StoreName
+	}
+	return ""
+}
+
+func (x *Receipt) GetStoreAddress() *Address {
+	if x != nil {
+		return x.StoreAddress
+	}
+	return nil
+}
+
+func (x *Receipt) GetStorePhone() string {
+	if x != nil {
+		return x.StorePhone
+	}
+	return ""
+}
+
+func (x *Receipt) GetSalesUserId() string {
+	if x != nil {
+		return x.SalesUserId
+	}
+	return ""
+}
+
+func (x *Receipt) GetIssuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
+}
+
+func (x *Receipt) GetLines() []*StoreSaleItem {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *Receipt) GetSubtotal() string {
+	if x != nil {
+		return x.Subtotal
+	}
+	return ""
+}
+
+func (x *Receipt) GetTaxRate() string {
+	if x != nil {
+		return x.TaxRate
+	}
+	return ""
+}
+
+func (x *Receipt) GetTaxAmount() string {
+	if x != nil {
+		return x.TaxAmount
+	}
+	return ""
+}
+
+func (x *Receipt) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
+
+func (x *Receipt) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type StoreSaleItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
@@ -938,7 +1229,7 @@ type StoreSaleItem struct {
 
 func (x *StoreSaleItem) Reset() {
 	*x = StoreSaleItem{}
-	mi := &file_store_v1_store_proto_msgTypes[8]
+	mi := &file_store_v1_store_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -950,7 +1241,7 @@ func (x *StoreSaleItem) String() string {
 func (*StoreSaleItem) ProtoMessage() {}
 
 func (x *StoreSaleItem) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[8]
+	mi := &file_store_v1_store_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -963,7 +1254,7 @@ func (x *StoreSaleItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StoreSaleItem.ProtoReflect.Descriptor instead.
 func (*StoreSaleItem) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{8}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *StoreSaleItem) GetProductId() string {
@@ -1018,13 +1309,17 @@ type CreateStoreRequest struct {
 	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
 	Hours         *StoreHours            `protobuf:"bytes,6,opt,name=hours,proto3" json:"hours,omitempty"`
 	Metadata      map[string]string      `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Currency      string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`              // Defaults to the service's default currency
+	TaxRate       string                 `protobuf:"bytes,9,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"` // Defaults to no tax
+	Timezone      string                 `protobuf:"bytes,10,opt,name=timezone,proto3" json:"timezone,omitempty"`             // Defaults to UTC
+	Holidays      []*Holiday             `protobuf:"bytes,11,rep,name=holidays,proto3" json:"holidays,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateStoreRequest) Reset() {
 	*x = CreateStoreRequest{}
-	mi := &file_store_v1_store_proto_msgTypes[9]
+	mi := &file_store_v1_store_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1036,7 +1331,7 @@ func (x *CreateStoreRequest) String() string {
 func (*CreateStoreRequest) ProtoMessage() {}
 
 func (x *CreateStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[9]
+	mi := &file_store_v1_store_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1049,7 +1344,7 @@ func (x *CreateStoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateStoreRequest.ProtoReflect.Descriptor instead.
 func (*CreateStoreRequest) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{9}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CreateStoreRequest) GetName() string {
@@ -1101,16 +1396,44 @@ func (x *CreateStoreRequest) GetMetadata() map[string]string {
 	return nil
 }
 
-type CreateStoreResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Store         *Store                 `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+func (x *CreateStoreRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateStoreRequest) GetTaxRate() string {
+	if x != nil {
+		return x.TaxRate
+	}
+	return ""
+}
+
+func (x *CreateStoreRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CreateStoreRequest) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+type CreateStoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Store         *Store                 `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateStoreResponse) Reset() {
 	*x = CreateStoreResponse{}
-	mi := &file_store_v1_store_proto_msgTypes[10]
+	mi := &file_store_v1_store_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1122,7 +1445,7 @@ func (x *CreateStoreResponse) String() string {
 func (*CreateStoreResponse) ProtoMessage() {}
 
 func (x *CreateStoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[10]
+	mi := &file_store_v1_store_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1135,7 +1458,7 @@ func (x *CreateStoreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateStoreResponse.ProtoReflect.Descriptor instead.
 func (*CreateStoreResponse) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{10}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CreateStoreResponse) GetStore() *Store {
@@ -1154,7 +1477,7 @@ type GetStoreRequest struct {
 
 func (x *GetStoreRequest) Reset() {
 	*x = GetStoreRequest{}
-	mi := &file_store_v1_store_proto_msgTypes[11]
+	mi := &file_store_v1_store_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1166,7 +1489,7 @@ func (x *GetStoreRequest) String() string {
 func (*GetStoreRequest) ProtoMessage() {}
 
 func (x *GetStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[11]
+	mi := &file_store_v1_store_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1179,7 +1502,7 @@ func (x *GetStoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStoreRequest.ProtoReflect.Descriptor instead.
 func (*GetStoreRequest) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{11}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetStoreRequest) GetId() string {
@@ -1198,7 +1521,7 @@ type GetStoreResponse struct {
 
 func (x *GetStoreResponse) Reset() {
 	*x = GetStoreResponse{}
-	mi := &file_store_v1_store_proto_msgTypes[12]
+	mi := &file_store_v1_store_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1210,7 +1533,7 @@ func (x *GetStoreResponse) String() string {
 func (*GetStoreResponse) ProtoMessage() {}
 
 func (x *GetStoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[12]
+	mi := &file_store_v1_store_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1223,7 +1546,7 @@ func (x *GetStoreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStoreResponse.ProtoReflect.Descriptor instead.
 func (*GetStoreResponse) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{12}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetStoreResponse) GetStore() *Store {
@@ -1240,13 +1563,15 @@ type ListStoresRequest struct {
 	ActiveOnly    bool                   `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"` // Only return active stores
 	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	Search        string                 `protobuf:"bytes,6,opt,name=search,proto3" json:"search,omitempty"`   // Case-insensitive match on name or description
+	Country       string                 `protobuf:"bytes,7,opt,name=country,proto3" json:"country,omitempty"` // Filter by country, case-insensitive
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListStoresRequest) Reset() {
 	*x = ListStoresRequest{}
-	mi := &file_store_v1_store_proto_msgTypes[13]
+	mi := &file_store_v1_store_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1258,7 +1583,7 @@ func (x *ListStoresRequest) String() string {
 func (*ListStoresRequest) ProtoMessage() {}
 
 func (x *ListStoresRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[13]
+	mi := &file_store_v1_store_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1271,7 +1596,7 @@ func (x *ListStoresRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListStoresRequest.ProtoReflect.Descriptor instead.
 func (*ListStoresRequest) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{13}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListStoresRequest) GetCity() string {
@@ -1309,17 +1634,33 @@ func (x *ListStoresRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *ListStoresRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListStoresRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
 type ListStoresResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Stores        []*Store               `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores,omitempty"`
 	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`   // Limit applied; 0 when the list is not limited
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"` // Offset applied
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListStoresResponse) Reset() {
 	*x = ListStoresResponse{}
-	mi := &file_store_v1_store_proto_msgTypes[14]
+	mi := &file_store_v1_store_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1331,7 +1672,7 @@ func (x *ListStoresResponse) String() string {
 func (*ListStoresResponse) ProtoMessage() {}
 
 func (x *ListStoresResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[14]
+	mi := &file_store_v1_store_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1344,7 +1685,7 @@ func (x *ListStoresResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListStoresResponse.ProtoReflect.Descriptor instead.
 func (*ListStoresResponse) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{14}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListStoresResponse) GetStores() []*Store {
@@ -1361,6 +1702,20 @@ func (x *ListStoresResponse) GetTotalCount() int32 {
 	return 0
 }
 
+func (x *ListStoresResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListStoresResponse) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
 type UpdateStoreRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Store         *Store                 `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
@@ -1370,7 +1725,7 @@ type UpdateStoreRequest struct {
 
 func (x *UpdateStoreRequest) Reset() {
 	*x = UpdateStoreRequest{}
-	mi := &file_store_v1_store_proto_msgTypes[15]
+	mi := &file_store_v1_store_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1382,7 +1737,7 @@ func (x *UpdateStoreRequest) String() string {
 func (*UpdateStoreRequest) ProtoMessage() {}
 
 func (x *UpdateStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[15]
+	mi := &file_store_v1_store_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1395,7 +1750,7 @@ func (x *UpdateStoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateStoreRequest.ProtoReflect.Descriptor instead.
 func (*UpdateStoreRequest) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{15}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UpdateStoreRequest) GetStore() *Store {
@@ -1414,7 +1769,7 @@ type UpdateStoreResponse struct {
 
 func (x *UpdateStoreResponse) Reset() {
 	*x = UpdateStoreResponse{}
-	mi := &file_store_v1_store_proto_msgTypes[16]
+	mi := &file_store_v1_store_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1426,7 +1781,7 @@ func (x *UpdateStoreResponse) String() string {
 func (*UpdateStoreResponse) ProtoMessage() {}
 
 func (x *UpdateStoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[16]
+	mi := &file_store_v1_store_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1439,7 +1794,7 @@ func (x *UpdateStoreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateStoreResponse.ProtoReflect.Descriptor instead.
 func (*UpdateStoreResponse) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{16}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpdateStoreResponse) GetSuccess() bool {
@@ -1449,28 +1804,31 @@ func (x *UpdateStoreResponse) GetSuccess() bool {
 	return false
 }
 
-type DeleteStoreRequest struct {
+// UpdateStoreCalendarRequest replaces a store's timezone and holidays
+type UpdateStoreCalendarRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StoreId       string                 `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	Timezone      string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Holidays      []*Holiday             `protobuf:"bytes,3,rep,name=holidays,proto3" json:"holidays,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteStoreRequest) Reset() {
-	*x = DeleteStoreRequest{}
-	mi := &file_store_v1_store_proto_msgTypes[17]
+func (x *UpdateStoreCalendarRequest) Reset() {
+	*x = UpdateStoreCalendarRequest{}
+	mi := &file_store_v1_store_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteStoreRequest) String() string {
+func (x *UpdateStoreCalendarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteStoreRequest) ProtoMessage() {}
+func (*UpdateStoreCalendarRequest) ProtoMessage() {}
 
-func (x *DeleteStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[17]
+func (x *UpdateStoreCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_v1_store_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1481,40 +1839,54 @@ func (x *DeleteStoreRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteStoreRequest.ProtoReflect.Descriptor instead.
-func (*DeleteStoreRequest) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use UpdateStoreCalendarRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStoreCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_store_v1_store_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *DeleteStoreRequest) GetId() string {
+func (x *UpdateStoreCalendarRequest) GetStoreId() string {
 	if x != nil {
-		return x.Id
+		return x.StoreId
 	}
 	return ""
 }
 
-type DeleteStoreResponse struct {
+func (x *UpdateStoreCalendarRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *UpdateStoreCalendarRequest) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+type UpdateStoreCalendarResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Store         *Store                 `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteStoreResponse) Reset() {
-	*x = DeleteStoreResponse{}
-	mi := &file_store_v1_store_proto_msgTypes[18]
+func (x *UpdateStoreCalendarResponse) Reset() {
+	*x = UpdateStoreCalendarResponse{}
+	mi := &file_store_v1_store_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteStoreResponse) String() string {
+func (x *UpdateStoreCalendarResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteStoreResponse) ProtoMessage() {}
+func (*UpdateStoreCalendarResponse) ProtoMessage() {}
 
-func (x *DeleteStoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[18]
+func (x *UpdateStoreCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_v1_store_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1525,44 +1897,44 @@ func (x *DeleteStoreResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteStoreResponse.ProtoReflect.Descriptor instead.
-func (*DeleteStoreResponse) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use UpdateStoreCalendarResponse.ProtoReflect.Descriptor instead.
+func (*UpdateStoreCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_store_v1_store_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *DeleteStoreResponse) GetSuccess() bool {
+func (x *UpdateStoreCalendarResponse) GetStore() *Store {
 	if x != nil {
-		return x.Success
+		return x.Store
 	}
-	return false
+	return nil
 }
 
-// Store inventory management requests/responses
-type AddProductToStoreRequest struct {
+// CheckStoreOpenRequest asks whether a store is open, and optionally when a
+// lead time of some business days ends
+type CheckStoreOpenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StoreId       string                 `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
-	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	InitialStock  int32                  `protobuf:"varint,3,opt,name=initial_stock,json=initialStock,proto3" json:"initial_stock,omitempty"`
-	StorePrice    string                 `protobuf:"bytes,4,opt,name=store_price,json=storePrice,proto3" json:"store_price,omitempty"` // Optional store-specific price
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`                                            // Defaults to now
+	LeadTimeDays  int32                  `protobuf:"varint,3,opt,name=lead_time_days,json=leadTimeDays,proto3" json:"lead_time_days,omitempty"` // Business days to add to at for ready_by
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddProductToStoreRequest) Reset() {
-	*x = AddProductToStoreRequest{}
-	mi := &file_store_v1_store_proto_msgTypes[19]
+func (x *CheckStoreOpenRequest) Reset() {
+	*x = CheckStoreOpenRequest{}
+	mi := &file_store_v1_store_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddProductToStoreRequest) String() string {
+func (x *CheckStoreOpenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddProductToStoreRequest) ProtoMessage() {}
+func (*CheckStoreOpenRequest) ProtoMessage() {}
 
-func (x *AddProductToStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[19]
+func (x *CheckStoreOpenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_v1_store_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1573,61 +1945,57 @@ func (x *AddProductToStoreRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddProductToStoreRequest.ProtoReflect.Descriptor instead.
-func (*AddProductToStoreRequest) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use CheckStoreOpenRequest.ProtoReflect.Descriptor instead.
+func (*CheckStoreOpenRequest) Descriptor() ([]byte, []int) {
+	return file_store_v1_store_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *AddProductToStoreRequest) GetStoreId() string {
+func (x *CheckStoreOpenRequest) GetStoreId() string {
 	if x != nil {
 		return x.StoreId
 	}
 	return ""
 }
 
-func (x *AddProductToStoreRequest) GetProductId() string {
+func (x *CheckStoreOpenRequest) GetAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ProductId
+		return x.At
 	}
-	return ""
+	return nil
 }
 
-func (x *AddProductToStoreRequest) GetInitialStock() int32 {
+func (x *CheckStoreOpenRequest) GetLeadTimeDays() int32 {
 	if x != nil {
-		return x.InitialStock
+		return x.LeadTimeDays
 	}
 	return 0
 }
 
-func (x *AddProductToStoreRequest) GetStorePrice() string {
-	if x != nil {
-		return x.StorePrice
-	}
-	return ""
-}
-
-type AddProductToStoreResponse struct {
+type CheckStoreOpenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StoreProduct  *StoreProduct          `protobuf:"bytes,1,opt,name=store_product,json=storeProduct,proto3" json:"store_product,omitempty"`
+	IsOpen        bool                   `protobuf:"varint,1,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
+	LocalTime     string                 `protobuf:"bytes,2,opt,name=local_time,json=localTime,proto3" json:"local_time,omitempty"` // at in the store's timezone, RFC3339
+	Holiday       string                 `protobuf:"bytes,3,opt,name=holiday,proto3" json:"holiday,omitempty"`                      // Name of the holiday at falls on, if any
+	ReadyBy       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ready_by,json=readyBy,proto3" json:"ready_by,omitempty"`       // Set when lead_time_days is positive
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddProductToStoreResponse) Reset() {
-	*x = AddProductToStoreResponse{}
-	mi := &file_store_v1_store_proto_msgTypes[20]
+func (x *CheckStoreOpenResponse) Reset() {
+	*x = CheckStoreOpenResponse{}
+	mi := &file_store_v1_store_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddProductToStoreResponse) String() string {
+func (x *CheckStoreOpenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddProductToStoreResponse) ProtoMessage() {}
+func (*CheckStoreOpenResponse) ProtoMessage() {}
 
-func (x *AddProductToStoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[20]
+func (x *CheckStoreOpenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_v1_store_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1638,43 +2006,61 @@ func (x *AddProductToStoreResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddProductToStoreResponse.ProtoReflect.Descriptor instead.
-func (*AddProductToStoreResponse) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use CheckStoreOpenResponse.ProtoReflect.Descriptor instead.
+func (*CheckStoreOpenResponse) Descriptor() ([]byte, []int) {
+	return file_store_v1_store_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *AddProductToStoreResponse) GetStoreProduct() *StoreProduct {
+func (x *CheckStoreOpenResponse) GetIsOpen() bool {
 	if x != nil {
-		return x.StoreProduct
+		return x.IsOpen
+	}
+	return false
+}
+
+func (x *CheckStoreOpenResponse) GetLocalTime() string {
+	if x != nil {
+		return x.LocalTime
+	}
+	return ""
+}
+
+func (x *CheckStoreOpenResponse) GetHoliday() string {
+	if x != nil {
+		return x.Holiday
+	}
+	return ""
+}
+
+func (x *CheckStoreOpenResponse) GetReadyBy() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadyBy
 	}
 	return nil
 }
 
-type UpdateStoreProductStockRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	StoreId          string                 `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
-	ProductId        string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	NewStockQuantity int32                  `protobuf:"varint,3,opt,name=new_stock_quantity,json=newStockQuantity,proto3" json:"new_stock_quantity,omitempty"`
-	Reason           string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"` // Reason for stock change
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type DeleteStoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateStoreProductStockRequest) Reset() {
-	*x = UpdateStoreProductStockRequest{}
-	mi := &file_store_v1_store_proto_msgTypes[21]
+func (x *DeleteStoreRequest) Reset() {
+	*x = DeleteStoreRequest{}
+	mi := &file_store_v1_store_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateStoreProductStockRequest) String() string {
+func (x *DeleteStoreRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateStoreProductStockRequest) ProtoMessage() {}
+func (*DeleteStoreRequest) ProtoMessage() {}
 
-func (x *UpdateStoreProductStockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[21]
+func (x *DeleteStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_v1_store_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1685,33 +2071,262 @@ func (x *UpdateStoreProductStockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateStoreProductStockRequest.ProtoReflect.Descriptor instead.
-func (*UpdateStoreProductStockRequest) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *UpdateStoreProductStockRequest) GetStoreId() string {
-	if x != nil {
-		return x.StoreId
-	}
-	return ""
+// Deprecated: Use DeleteStoreRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStoreRequest) Descriptor() ([]byte, []int) {
+	return file_store_v1_store_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *UpdateStoreProductStockRequest) GetProductId() string {
+func (x *DeleteStoreRequest) GetId() string {
 	if x != nil {
-		return x.ProductId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateStoreProductStockRequest) GetNewStockQuantity() int32 {
-	if x != nil {
-		return x.NewStockQuantity
-	}
-	return 0
+type DeleteStoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateStoreProductStockRequest) GetReason() string {
+func (x *DeleteStoreResponse) Reset() {
+	*x = DeleteStoreResponse{}
+	mi := &file_store_v1_store_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStoreResponse) ProtoMessage() {}
+
+func (x *DeleteStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_v1_store_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStoreResponse.ProtoReflect.Descriptor instead.
+func (*DeleteStoreResponse) Descriptor() ([]byte, []int) {
+	return file_store_v1_store_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteStoreResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Store inventory management requests/responses
+type AddProductToStoreRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	StoreId      string                 `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	ProductId    string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	InitialStock int32                  `protobuf:"varint,3,opt,name=initial_stock,json=initialStock,proto3" json:"initial_stock,omitempty"`
+	StorePrice   string                 `protobuf:"bytes,4,opt,name=store_price,json=storePrice,proto3" json:"store_price,omitempty"` // Optional store-specific price
+	// Quantity limits for one sale line, usually copied from the product; zero means no limit
+	MinOrderQty       int32 `protobuf:"varint,5,opt,name=min_order_qty,json=minOrderQty,proto3" json:"min_order_qty,omitempty"`
+	MaxOrderQty       int32 `protobuf:"varint,6,opt,name=max_order_qty,json=maxOrderQty,proto3" json:"max_order_qty,omitempty"`
+	OrderQtyIncrement int32 `protobuf:"varint,7,opt,name=order_qty_increment,json=orderQtyIncrement,proto3" json:"order_qty_increment,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AddProductToStoreRequest) Reset() {
+	*x = AddProductToStoreRequest{}
+	mi := &file_store_v1_store_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductToStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductToStoreRequest) ProtoMessage() {}
+
+func (x *AddProductToStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_v1_store_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductToStoreRequest.ProtoReflect.Descriptor instead.
+func (*AddProductToStoreRequest) Descriptor() ([]byte, []int) {
+	return file_store_v1_store_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AddProductToStoreRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *AddProductToStoreRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AddProductToStoreRequest) GetInitialStock() int32 {
+	if x != nil {
+		return x.InitialStock
+	}
+	return 0
+}
+
+func (x *AddProductToStoreRequest) GetStorePrice() string {
+	if x != nil {
+		return x.StorePrice
+	}
+	return ""
+}
+
+func (x *AddProductToStoreRequest) GetMinOrderQty() int32 {
+	if x != nil {
+		return x.MinOrderQty
+	}
+	return 0
+}
+
+func (x *AddProductToStoreRequest) GetMaxOrderQty() int32 {
+	if x != nil {
+		return x.MaxOrderQty
+	}
+	return 0
+}
+
+func (x *AddProductToStoreRequest) GetOrderQtyIncrement() int32 {
+	if x != nil {
+		return x.OrderQtyIncrement
+	}
+	return 0
+}
+
+type AddProductToStoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StoreProduct  *StoreProduct          `protobuf:"bytes,1,opt,name=store_product,json=storeProduct,proto3" json:"store_product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductToStoreResponse) Reset() {
+	*x = AddProductToStoreResponse{}
+	mi := &file_store_v1_store_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductToStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductToStoreResponse) ProtoMessage() {}
+
+func (x *AddProductToStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_v1_store_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductToStoreResponse.ProtoReflect.Descriptor instead.
+func (*AddProductToStoreResponse) Descriptor() ([]byte, []int) {
+	return file_store_v1_store_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AddProductToStoreResponse) GetStoreProduct() *StoreProduct {
+	if x != nil {
+		return x.StoreProduct
+	}
+	return nil
+}
+
+type UpdateStoreProductStockRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StoreId          string                 `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	ProductId        string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	NewStockQuantity int32                  `protobuf:"varint,3,opt,name=new_stock_quantity,json=newStockQuantity,proto3" json:"new_stock_quantity,omitempty"`
+	Reason           string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"` // Reason for stock change
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateStoreProductStockRequest) Reset() {
+	*x = UpdateStoreProductStockRequest{}
+	mi := &file_store_v1_store_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStoreProductStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStoreProductStockRequest) ProtoMessage() {}
+
+func (x *UpdateStoreProductStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_v1_store_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStoreProductStockRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStoreProductStockRequest) Descriptor() ([]byte, []int) {
+	return file_store_v1_store_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateStoreProductStockRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *UpdateStoreProductStockRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UpdateStoreProductStockRequest) GetNewStockQuantity() int32 {
+	if x != nil {
+		return x.NewStockQuantity
+	}
+	return 0
+}
+
+func (x *UpdateStoreProductStockRequest) GetReason() string {
 	if x != nil {
 		return x.Reason
 	}
@@ -1727,7 +2342,7 @@ type UpdateStoreProductStockResponse struct {
 
 func (x *UpdateStoreProductStockResponse) Reset() {
 	*x = UpdateStoreProductStockResponse{}
-	mi := &file_store_v1_store_proto_msgTypes[22]
+	mi := &file_store_v1_store_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1739,7 +2354,7 @@ func (x *UpdateStoreProductStockResponse) String() string {
 func (*UpdateStoreProductStockResponse) ProtoMessage() {}
 
 func (x *UpdateStoreProductStockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[22]
+	mi := &file_store_v1_store_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1752,7 +2367,7 @@ func (x *UpdateStoreProductStockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateStoreProductStockResponse.ProtoReflect.Descriptor instead.
 func (*UpdateStoreProductStockResponse) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{22}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *UpdateStoreProductStockResponse) GetSuccess() bool {
@@ -1772,7 +2387,7 @@ type RemoveProductFromStoreRequest struct {
 
 func (x *RemoveProductFromStoreRequest) Reset() {
 	*x = RemoveProductFromStoreRequest{}
-	mi := &file_store_v1_store_proto_msgTypes[23]
+	mi := &file_store_v1_store_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1784,7 +2399,7 @@ func (x *RemoveProductFromStoreRequest) String() string {
 func (*RemoveProductFromStoreRequest) ProtoMessage() {}
 
 func (x *RemoveProductFromStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[23]
+	mi := &file_store_v1_store_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1797,7 +2412,7 @@ func (x *RemoveProductFromStoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveProductFromStoreRequest.ProtoReflect.Descriptor instead.
 func (*RemoveProductFromStoreRequest) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{23}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *RemoveProductFromStoreRequest) GetStoreId() string {
@@ -1823,7 +2438,7 @@ type RemoveProductFromStoreResponse struct {
 
 func (x *RemoveProductFromStoreResponse) Reset() {
 	*x = RemoveProductFromStoreResponse{}
-	mi := &file_store_v1_store_proto_msgTypes[24]
+	mi := &file_store_v1_store_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1835,7 +2450,7 @@ func (x *RemoveProductFromStoreResponse) String() string {
 func (*RemoveProductFromStoreResponse) ProtoMessage() {}
 
 func (x *RemoveProductFromStoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[24]
+	mi := &file_store_v1_store_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1848,7 +2463,7 @@ func (x *RemoveProductFromStoreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveProductFromStoreResponse.ProtoReflect.Descriptor instead.
 func (*RemoveProductFromStoreResponse) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{24}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *RemoveProductFromStoreResponse) GetSuccess() bool {
@@ -1870,7 +2485,7 @@ type GetStoreProductsRequest struct {
 
 func (x *GetStoreProductsRequest) Reset() {
 	*x = GetStoreProductsRequest{}
-	mi := &file_store_v1_store_proto_msgTypes[25]
+	mi := &file_store_v1_store_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1882,7 +2497,7 @@ func (x *GetStoreProductsRequest) String() string {
 func (*GetStoreProductsRequest) ProtoMessage() {}
 
 func (x *GetStoreProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[25]
+	mi := &file_store_v1_store_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1895,60 +2510,590 @@ func (x *GetStoreProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStoreProductsRequest.ProtoReflect.Descriptor instead.
 func (*GetStoreProductsRequest) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{25}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetStoreProductsRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *GetStoreProductsRequest) GetAvailableOnly() bool {
+	if x != nil {
+		return x.AvailableOnly
+	}
+	return false
+}
+
+func (x *GetStoreProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetStoreProductsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetStoreProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*StoreProduct        `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStoreProductsResponse) Reset() {
+	*x = GetStoreProductsResponse{}
+	mi := &file_store_v1_store_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStoreProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoreProductsResponse) ProtoMessage() {}
+
+func (x *GetStoreProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_v1_store_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoreProductsResponse.ProtoReflect.Descriptor instead.
+func (*GetStoreProductsResponse) Descriptor() ([]byte, []int) {
+	return file_store_v1_store_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetStoreProductsResponse) GetProducts() []*StoreProduct {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *GetStoreProductsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetProductStoreLocationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	AvailableOnly bool                   `protobuf:"varint,2,opt,name=available_only,json=availableOnly,proto3" json:"available_only,omitempty"` // Only return stores with available stock
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductStoreLocationsRequest) Reset() {
+	*x = GetProductStoreLocationsRequest{}
+	mi := &file_store_v1_store_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductStoreLocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductStoreLocationsRequest) ProtoMessage() {}
+
+func (x *GetProductStoreLocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_v1_store_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductStoreLocationsRequest.ProtoReflect.Descriptor instead.
+func (*GetProductStoreLocationsRequest) Descriptor() ([]byte, []int) {
+	return file_store_v1_store_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetProductStoreLocationsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetProductStoreLocationsRequest) GetAvailableOnly() bool {
+	if x != nil {
+		return x.AvailableOnly
+	}
+	return false
+}
+
+type GetProductStoreLocationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locations     []*StoreProduct        `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductStoreLocationsResponse) Reset() {
+	*x = GetProductStoreLocationsResponse{}
+	mi := &file_store_v1_store_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductStoreLocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductStoreLocationsResponse) ProtoMessage() {}
+
+func (x *GetProductStoreLocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_v1_store_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductStoreLocationsResponse.ProtoReflect.Descriptor instead.
+func (*GetProductStoreLocationsResponse) Descriptor() ([]byte, []int) {
+	return file_store_v1_store_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetProductStoreLocationsResponse) GetLocations() []*StoreProduct {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+type CartItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartItem) Reset() {
+	*x = CartItem{}
+	mi := &file_store_v1_store_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartItem) ProtoMessage() {}
+
+func (x *CartItem) ProtoReflect() protoreflect.Message {
+	mi := &file_store_v1_store_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartItem.ProtoReflect.Descriptor instead.
+func (*CartItem) Descriptor() ([]byte, []int) {
+	return file_store_v1_store_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CartItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CartItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type CartItemAvailability struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProductId         string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	RequestedQuantity int32                  `protobuf:"varint,2,opt,name=requested_quantity,json=requestedQuantity,proto3" json:"requested_quantity,omitempty"` // Summed over cart lines for the same product
+	AvailableQuantity int32                  `protobuf:"varint,3,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"` // 0 when the store does not stock or sell the product
+	Available         bool                   `protobuf:"varint,4,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CartItemAvailability) Reset() {
+	*x = CartItemAvailability{}
+	mi := &file_store_v1_store_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartItemAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartItemAvailability) ProtoMessage() {}
+
+func (x *CartItemAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_store_v1_store_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartItemAvailability.ProtoReflect.Descriptor instead.
+func (*CartItemAvailability) Descriptor() ([]byte, []int) {
+	return file_store_v1_store_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CartItemAvailability) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CartItemAvailability) GetRequestedQuantity() int32 {
+	if x != nil {
+		return x.RequestedQuantity
+	}
+	return 0
+}
+
+func (x *CartItemAvailability) GetAvailableQuantity() int32 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *CartItemAvailability) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+type CheckCartAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StoreId       string                 `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	Items         []*CartItem            `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckCartAvailabilityRequest) Reset() {
+	*x = CheckCartAvailabilityRequest{}
+	mi := &file_store_v1_store_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckCartAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckCartAvailabilityRequest) ProtoMessage() {}
+
+func (x *CheckCartAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_v1_store_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckCartAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*CheckCartAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_store_v1_store_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CheckCartAvailabilityRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *CheckCartAvailabilityRequest) GetItems() []*CartItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CheckCartAvailabilityResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	AllAvailable  bool                    `protobuf:"varint,1,opt,name=all_available,json=allAvailable,proto3" json:"all_available,omitempty"`
+	Items         []*CartItemAvailability `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"` // One per distinct product, in cart order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckCartAvailabilityResponse) Reset() {
+	*x = CheckCartAvailabilityResponse{}
+	mi := &file_store_v1_store_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckCartAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckCartAvailabilityResponse) ProtoMessage() {}
+
+func (x *CheckCartAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_v1_store_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckCartAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*CheckCartAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_store_v1_store_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CheckCartAvailabilityResponse) GetAllAvailable() bool {
+	if x != nil {
+		return x.AllAvailable
+	}
+	return false
+}
+
+func (x *CheckCartAvailabilityResponse) GetItems() []*CartItemAvailability {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// CatalogFilter narrows a store catalog with the standard product filters
+type CatalogFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryIds   []string               `protobuf:"bytes,1,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"` // Products in any of these categories
+	MinPrice      string                 `protobuf:"bytes,2,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`          // Minimum effective price (inclusive), decimal string
+	MaxPrice      string                 `protobuf:"bytes,3,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`          // Maximum effective price (inclusive), decimal string
+	SearchTerm    string                 `protobuf:"bytes,4,opt,name=search_term,json=searchTerm,proto3" json:"search_term,omitempty"`    // Case-insensitive match on name, SKU or description
+	SupplierId    string                 `protobuf:"bytes,5,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogFilter) Reset() {
+	*x = CatalogFilter{}
+	mi := &file_store_v1_store_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogFilter) ProtoMessage() {}
+
+func (x *CatalogFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_store_v1_store_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogFilter.ProtoReflect.Descriptor instead.
+func (*CatalogFilter) Descriptor() ([]byte, []int) {
+	return file_store_v1_store_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CatalogFilter) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *CatalogFilter) GetMinPrice() string {
+	if x != nil {
+		return x.MinPrice
+	}
+	return ""
+}
+
+func (x *CatalogFilter) GetMaxPrice() string {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return ""
+}
+
+func (x *CatalogFilter) GetSearchTerm() string {
+	if x != nil {
+		return x.SearchTerm
+	}
+	return ""
+}
+
+func (x *CatalogFilter) GetSupplierId() string {
+	if x != nil {
+		return x.SupplierId
+	}
+	return ""
+}
+
+type ListStoreCatalogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StoreId       string                 `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	Filter        *CatalogFilter         `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"` // Default 50, at most 500
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStoreCatalogRequest) Reset() {
+	*x = ListStoreCatalogRequest{}
+	mi := &file_store_v1_store_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStoreCatalogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStoreCatalogRequest) ProtoMessage() {}
+
+func (x *ListStoreCatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_v1_store_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStoreCatalogRequest.ProtoReflect.Descriptor instead.
+func (*ListStoreCatalogRequest) Descriptor() ([]byte, []int) {
+	return file_store_v1_store_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetStoreProductsRequest) GetStoreId() string {
+func (x *ListStoreCatalogRequest) GetStoreId() string {
 	if x != nil {
 		return x.StoreId
 	}
 	return ""
 }
 
-func (x *GetStoreProductsRequest) GetAvailableOnly() bool {
+func (x *ListStoreCatalogRequest) GetFilter() *CatalogFilter {
 	if x != nil {
-		return x.AvailableOnly
+		return x.Filter
 	}
-	return false
+	return nil
 }
 
-func (x *GetStoreProductsRequest) GetLimit() int32 {
+func (x *ListStoreCatalogRequest) GetLimit() int32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-func (x *GetStoreProductsRequest) GetOffset() int32 {
+func (x *ListStoreCatalogRequest) GetOffset() int32 {
 	if x != nil {
 		return x.Offset
 	}
 	return 0
 }
 
-type GetStoreProductsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*StoreProduct        `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// StoreCatalogItem is a product as sold at one store
+type StoreCatalogItem struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProductId         string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Sku               string                 `protobuf:"bytes,4,opt,name=sku,proto3" json:"sku,omitempty"`
+	CategoryIds       []string               `protobuf:"bytes,5,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	SupplierId        string                 `protobuf:"bytes,6,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	ImageUrls         []string               `protobuf:"bytes,7,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
+	Currency          string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	ListPrice         string                 `protobuf:"bytes,9,opt,name=list_price,json=listPrice,proto3" json:"list_price,omitempty"`                 // The product's selling price
+	EffectivePrice    string                 `protobuf:"bytes,10,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"` // The store price when the store sets one, otherwise the list price
+	AvailableQuantity int32                  `protobuf:"varint,11,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`
+	MinOrderQty       int32                  `protobuf:"varint,12,opt,name=min_order_qty,json=minOrderQty,proto3" json:"min_order_qty,omitempty"`
+	MaxOrderQty       int32                  `protobuf:"varint,13,opt,name=max_order_qty,json=maxOrderQty,proto3" json:"max_order_qty,omitempty"`
+	OrderQtyIncrement int32                  `protobuf:"varint,14,opt,name=order_qty_increment,json=orderQtyIncrement,proto3" json:"order_qty_increment,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetStoreProductsResponse) Reset() {
-	*x = GetStoreProductsResponse{}
-	mi := &file_store_v1_store_proto_msgTypes[26]
+func (x *StoreCatalogItem) Reset() {
+	*x = StoreCatalogItem{}
+	mi := &file_store_v1_store_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStoreProductsResponse) String() string {
+func (x *StoreCatalogItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStoreProductsResponse) ProtoMessage() {}
+func (*StoreCatalogItem) ProtoMessage() {}
 
-func (x *GetStoreProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[26]
+func (x *StoreCatalogItem) ProtoReflect() protoreflect.Message {
+	mi := &file_store_v1_store_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1959,99 +3104,132 @@ func (x *GetStoreProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStoreProductsResponse.ProtoReflect.Descriptor instead.
-func (*GetStoreProductsResponse) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use StoreCatalogItem.ProtoReflect.Descriptor instead.
+func (*StoreCatalogItem) Descriptor() ([]byte, []int) {
+	return file_store_v1_store_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetStoreProductsResponse) GetProducts() []*StoreProduct {
+func (x *StoreCatalogItem) GetProductId() string {
 	if x != nil {
-		return x.Products
+		return x.ProductId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetStoreProductsResponse) GetTotalCount() int32 {
+func (x *StoreCatalogItem) GetName() string {
 	if x != nil {
-		return x.TotalCount
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type GetProductStoreLocationsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	AvailableOnly bool                   `protobuf:"varint,2,opt,name=available_only,json=availableOnly,proto3" json:"available_only,omitempty"` // Only return stores with available stock
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *StoreCatalogItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (x *GetProductStoreLocationsRequest) Reset() {
-	*x = GetProductStoreLocationsRequest{}
-	mi := &file_store_v1_store_proto_msgTypes[27]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *StoreCatalogItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
 }
 
-func (x *GetProductStoreLocationsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *StoreCatalogItem) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
 }
 
-func (*GetProductStoreLocationsRequest) ProtoMessage() {}
+func (x *StoreCatalogItem) GetSupplierId() string {
+	if x != nil {
+		return x.SupplierId
+	}
+	return ""
+}
 
-func (x *GetProductStoreLocationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[27]
+func (x *StoreCatalogItem) GetImageUrls() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ImageUrls
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetProductStoreLocationsRequest.ProtoReflect.Descriptor instead.
-func (*GetProductStoreLocationsRequest) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{27}
+func (x *StoreCatalogItem) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
 }
 
-func (x *GetProductStoreLocationsRequest) GetProductId() string {
+func (x *StoreCatalogItem) GetListPrice() string {
 	if x != nil {
-		return x.ProductId
+		return x.ListPrice
 	}
 	return ""
 }
 
-func (x *GetProductStoreLocationsRequest) GetAvailableOnly() bool {
+func (x *StoreCatalogItem) GetEffectivePrice() string {
 	if x != nil {
-		return x.AvailableOnly
+		return x.EffectivePrice
 	}
-	return false
+	return ""
 }
 
-type GetProductStoreLocationsResponse struct {
+func (x *StoreCatalogItem) GetAvailableQuantity() int32 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *StoreCatalogItem) GetMinOrderQty() int32 {
+	if x != nil {
+		return x.MinOrderQty
+	}
+	return 0
+}
+
+func (x *StoreCatalogItem) GetMaxOrderQty() int32 {
+	if x != nil {
+		return x.MaxOrderQty
+	}
+	return 0
+}
+
+func (x *StoreCatalogItem) GetOrderQtyIncrement() int32 {
+	if x != nil {
+		return x.OrderQtyIncrement
+	}
+	return 0
+}
+
+type ListStoreCatalogResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Locations     []*StoreProduct        `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
+	Items         []*StoreCatalogItem    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // Sorted by name
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductStoreLocationsResponse) Reset() {
-	*x = GetProductStoreLocationsResponse{}
-	mi := &file_store_v1_store_proto_msgTypes[28]
+func (x *ListStoreCatalogResponse) Reset() {
+	*x = ListStoreCatalogResponse{}
+	mi := &file_store_v1_store_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductStoreLocationsResponse) String() string {
+func (x *ListStoreCatalogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductStoreLocationsResponse) ProtoMessage() {}
+func (*ListStoreCatalogResponse) ProtoMessage() {}
 
-func (x *GetProductStoreLocationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[28]
+func (x *ListStoreCatalogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_v1_store_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2062,18 +3240,25 @@ func (x *GetProductStoreLocationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductStoreLocationsResponse.ProtoReflect.Descriptor instead.
-func (*GetProductStoreLocationsResponse) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ListStoreCatalogResponse.ProtoReflect.Descriptor instead.
+func (*ListStoreCatalogResponse) Descriptor() ([]byte, []int) {
+	return file_store_v1_store_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetProductStoreLocationsResponse) GetLocations() []*StoreProduct {
+func (x *ListStoreCatalogResponse) GetItems() []*StoreCatalogItem {
 	if x != nil {
-		return x.Locations
+		return x.Items
 	}
 	return nil
 }
 
+func (x *ListStoreCatalogResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 // Reservation requests/responses
 type ReserveProductRequest struct {
 	state                    protoimpl.MessageState `protogen:"open.v1"`
@@ -2089,7 +3274,7 @@ type ReserveProductRequest struct {
 
 func (x *ReserveProductRequest) Reset() {
 	*x = ReserveProductRequest{}
-	mi := &file_store_v1_store_proto_msgTypes[29]
+	mi := &file_store_v1_store_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2101,7 +3286,7 @@ func (x *ReserveProductRequest) String() string {
 func (*ReserveProductRequest) ProtoMessage() {}
 
 func (x *ReserveProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[29]
+	mi := &file_store_v1_store_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2114,7 +3299,7 @@ func (x *ReserveProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReserveProductRequest.ProtoReflect.Descriptor instead.
 func (*ReserveProductRequest) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{29}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ReserveProductRequest) GetStoreId() string {
@@ -2168,7 +3353,7 @@ type ReserveProductResponse struct {
 
 func (x *ReserveProductResponse) Reset() {
 	*x = ReserveProductResponse{}
-	mi := &file_store_v1_store_proto_msgTypes[30]
+	mi := &file_store_v1_store_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2180,7 +3365,7 @@ func (x *ReserveProductResponse) String() string {
 func (*ReserveProductResponse) ProtoMessage() {}
 
 func (x *ReserveProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[30]
+	mi := &file_store_v1_store_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2193,7 +3378,7 @@ func (x *ReserveProductResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReserveProductResponse.ProtoReflect.Descriptor instead.
 func (*ReserveProductResponse) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{30}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ReserveProductResponse) GetReservation() *ProductReservation {
@@ -2212,7 +3397,7 @@ type CancelReservationRequest struct {
 
 func (x *CancelReservationRequest) Reset() {
 	*x = CancelReservationRequest{}
-	mi := &file_store_v1_store_proto_msgTypes[31]
+	mi := &file_store_v1_store_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2224,7 +3409,7 @@ func (x *CancelReservationRequest) String() string {
 func (*CancelReservationRequest) ProtoMessage() {}
 
 func (x *CancelReservationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[31]
+	mi := &file_store_v1_store_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2237,7 +3422,7 @@ func (x *CancelReservationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelReservationRequest.ProtoReflect.Descriptor instead.
 func (*CancelReservationRequest) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{31}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *CancelReservationRequest) GetReservationId() string {
@@ -2256,7 +3441,7 @@ type CancelReservationResponse struct {
 
 func (x *CancelReservationResponse) Reset() {
 	*x = CancelReservationResponse{}
-	mi := &file_store_v1_store_proto_msgTypes[32]
+	mi := &file_store_v1_store_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2268,7 +3453,7 @@ func (x *CancelReservationResponse) String() string {
 func (*CancelReservationResponse) ProtoMessage() {}
 
 func (x *CancelReservationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[32]
+	mi := &file_store_v1_store_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2281,7 +3466,7 @@ func (x *CancelReservationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelReservationResponse.ProtoReflect.Descriptor instead.
 func (*CancelReservationResponse) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{32}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *CancelReservationResponse) GetSuccess() bool {
@@ -2304,7 +3489,7 @@ type GetReservationsRequest struct {
 
 func (x *GetReservationsRequest) Reset() {
 	*x = GetReservationsRequest{}
-	mi := &file_store_v1_store_proto_msgTypes[33]
+	mi := &file_store_v1_store_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2316,7 +3501,7 @@ func (x *GetReservationsRequest) String() string {
 func (*GetReservationsRequest) ProtoMessage() {}
 
 func (x *GetReservationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[33]
+	mi := &file_store_v1_store_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2329,7 +3514,7 @@ func (x *GetReservationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReservationsRequest.ProtoReflect.Descriptor instead.
 func (*GetReservationsRequest) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{33}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *GetReservationsRequest) GetStoreId() string {
@@ -2377,7 +3562,7 @@ type GetReservationsResponse struct {
 
 func (x *GetReservationsResponse) Reset() {
 	*x = GetReservationsResponse{}
-	mi := &file_store_v1_store_proto_msgTypes[34]
+	mi := &file_store_v1_store_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2389,7 +3574,7 @@ func (x *GetReservationsResponse) String() string {
 func (*GetReservationsResponse) ProtoMessage() {}
 
 func (x *GetReservationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[34]
+	mi := &file_store_v1_store_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2402,7 +3587,7 @@ func (x *GetReservationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReservationsResponse.ProtoReflect.Descriptor instead.
 func (*GetReservationsResponse) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{34}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *GetReservationsResponse) GetReservations() []*ProductReservation {
@@ -2429,7 +3614,7 @@ type CompleteReservationRequest struct {
 
 func (x *CompleteReservationRequest) Reset() {
 	*x = CompleteReservationRequest{}
-	mi := &file_store_v1_store_proto_msgTypes[35]
+	mi := &file_store_v1_store_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2441,7 +3626,7 @@ func (x *CompleteReservationRequest) String() string {
 func (*CompleteReservationRequest) ProtoMessage() {}
 
 func (x *CompleteReservationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[35]
+	mi := &file_store_v1_store_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2454,7 +3639,7 @@ func (x *CompleteReservationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompleteReservationRequest.ProtoReflect.Descriptor instead.
 func (*CompleteReservationRequest) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{35}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *CompleteReservationRequest) GetReservationId() string {
@@ -2481,7 +3666,7 @@ type CompleteReservationResponse struct {
 
 func (x *CompleteReservationResponse) Reset() {
 	*x = CompleteReservationResponse{}
-	mi := &file_store_v1_store_proto_msgTypes[36]
+	mi := &file_store_v1_store_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2493,7 +3678,7 @@ func (x *CompleteReservationResponse) String() string {
 func (*CompleteReservationResponse) ProtoMessage() {}
 
 func (x *CompleteReservationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[36]
+	mi := &file_store_v1_store_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2506,7 +3691,7 @@ func (x *CompleteReservationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompleteReservationResponse.ProtoReflect.Descriptor instead.
 func (*CompleteReservationResponse) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{36}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *CompleteReservationResponse) GetSuccess() bool {
@@ -2535,7 +3720,7 @@ type AssignUserToStoreRequest struct {
 
 func (x *AssignUserToStoreRequest) Reset() {
 	*x = AssignUserToStoreRequest{}
-	mi := &file_store_v1_store_proto_msgTypes[37]
+	mi := &file_store_v1_store_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2547,7 +3732,7 @@ func (x *AssignUserToStoreRequest) String() string {
 func (*AssignUserToStoreRequest) ProtoMessage() {}
 
 func (x *AssignUserToStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[37]
+	mi := &file_store_v1_store_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2560,7 +3745,7 @@ func (x *AssignUserToStoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignUserToStoreRequest.ProtoReflect.Descriptor instead.
 func (*AssignUserToStoreRequest) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{37}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *AssignUserToStoreRequest) GetStoreId() string {
@@ -2593,7 +3778,7 @@ type AssignUserToStoreResponse struct {
 
 func (x *AssignUserToStoreResponse) Reset() {
 	*x = AssignUserToStoreResponse{}
-	mi := &file_store_v1_store_proto_msgTypes[38]
+	mi := &file_store_v1_store_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2605,7 +3790,7 @@ func (x *AssignUserToStoreResponse) String() string {
 func (*AssignUserToStoreResponse) ProtoMessage() {}
 
 func (x *AssignUserToStoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[38]
+	mi := &file_store_v1_store_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2618,7 +3803,7 @@ func (x *AssignUserToStoreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignUserToStoreResponse.ProtoReflect.Descriptor instead.
 func (*AssignUserToStoreResponse) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{38}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *AssignUserToStoreResponse) GetSuccess() bool {
@@ -2638,7 +3823,7 @@ type RemoveUserFromStoreRequest struct {
 
 func (x *RemoveUserFromStoreRequest) Reset() {
 	*x = RemoveUserFromStoreRequest{}
-	mi := &file_store_v1_store_proto_msgTypes[39]
+	mi := &file_store_v1_store_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2650,7 +3835,7 @@ func (x *RemoveUserFromStoreRequest) String() string {
 func (*RemoveUserFromStoreRequest) ProtoMessage() {}
 
 func (x *RemoveUserFromStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[39]
+	mi := &file_store_v1_store_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2663,7 +3848,7 @@ func (x *RemoveUserFromStoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveUserFromStoreRequest.ProtoReflect.Descriptor instead.
 func (*RemoveUserFromStoreRequest) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{39}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *RemoveUserFromStoreRequest) GetStoreId() string {
@@ -2689,7 +3874,7 @@ type RemoveUserFromStoreResponse struct {
 
 func (x *RemoveUserFromStoreResponse) Reset() {
 	*x = RemoveUserFromStoreResponse{}
-	mi := &file_store_v1_store_proto_msgTypes[40]
+	mi := &file_store_v1_store_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2701,7 +3886,7 @@ func (x *RemoveUserFromStoreResponse) String() string {
 func (*RemoveUserFromStoreResponse) ProtoMessage() {}
 
 func (x *RemoveUserFromStoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[40]
+	mi := &file_store_v1_store_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2714,7 +3899,7 @@ func (x *RemoveUserFromStoreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveUserFromStoreResponse.ProtoReflect.Descriptor instead.
 func (*RemoveUserFromStoreResponse) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{40}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *RemoveUserFromStoreResponse) GetSuccess() bool {
@@ -2734,7 +3919,7 @@ type GetStoreUsersRequest struct {
 
 func (x *GetStoreUsersRequest) Reset() {
 	*x = GetStoreUsersRequest{}
-	mi := &file_store_v1_store_proto_msgTypes[41]
+	mi := &file_store_v1_store_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2746,7 +3931,7 @@ func (x *GetStoreUsersRequest) String() string {
 func (*GetStoreUsersRequest) ProtoMessage() {}
 
 func (x *GetStoreUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[41]
+	mi := &file_store_v1_store_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2759,7 +3944,7 @@ func (x *GetStoreUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStoreUsersRequest.ProtoReflect.Descriptor instead.
 func (*GetStoreUsersRequest) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{41}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *GetStoreUsersRequest) GetStoreId() string {
@@ -2785,7 +3970,7 @@ type GetStoreUsersResponse struct {
 
 func (x *GetStoreUsersResponse) Reset() {
 	*x = GetStoreUsersResponse{}
-	mi := &file_store_v1_store_proto_msgTypes[42]
+	mi := &file_store_v1_store_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2797,7 +3982,7 @@ func (x *GetStoreUsersResponse) String() string {
 func (*GetStoreUsersResponse) ProtoMessage() {}
 
 func (x *GetStoreUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[42]
+	mi := &file_store_v1_store_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2810,7 +3995,7 @@ func (x *GetStoreUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStoreUsersResponse.ProtoReflect.Descriptor instead.
 func (*GetStoreUsersResponse) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{42}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *GetStoreUsersResponse) GetUsers() []*StoreUser {
@@ -2829,7 +4014,7 @@ type GetUserStoresRequest struct {
 
 func (x *GetUserStoresRequest) Reset() {
 	*x = GetUserStoresRequest{}
-	mi := &file_store_v1_store_proto_msgTypes[43]
+	mi := &file_store_v1_store_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2841,7 +4026,7 @@ func (x *GetUserStoresRequest) String() string {
 func (*GetUserStoresRequest) ProtoMessage() {}
 
 func (x *GetUserStoresRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[43]
+	mi := &file_store_v1_store_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2854,7 +4039,7 @@ func (x *GetUserStoresRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserStoresRequest.ProtoReflect.Descriptor instead.
 func (*GetUserStoresRequest) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{43}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *GetUserStoresRequest) GetUserId() string {
@@ -2873,7 +4058,7 @@ type GetUserStoresResponse struct {
 
 func (x *GetUserStoresResponse) Reset() {
 	*x = GetUserStoresResponse{}
-	mi := &file_store_v1_store_proto_msgTypes[44]
+	mi := &file_store_v1_store_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2885,7 +4070,7 @@ func (x *GetUserStoresResponse) String() string {
 func (*GetUserStoresResponse) ProtoMessage() {}
 
 func (x *GetUserStoresResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[44]
+	mi := &file_store_v1_store_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2898,7 +4083,7 @@ func (x *GetUserStoresResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserStoresResponse.ProtoReflect.Descriptor instead.
 func (*GetUserStoresResponse) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{44}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *GetUserStoresResponse) GetStores() []*StoreUser {
@@ -2924,7 +4109,7 @@ type RecordSaleRequest struct {
 
 func (x *RecordSaleRequest) Reset() {
 	*x = RecordSaleRequest{}
-	mi := &file_store_v1_store_proto_msgTypes[45]
+	mi := &file_store_v1_store_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2936,7 +4121,7 @@ func (x *RecordSaleRequest) String() string {
 func (*RecordSaleRequest) ProtoMessage() {}
 
 func (x *RecordSaleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[45]
+	mi := &file_store_v1_store_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2949,7 +4134,7 @@ func (x *RecordSaleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordSaleRequest.ProtoReflect.Descriptor instead.
 func (*RecordSaleRequest) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{45}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *RecordSaleRequest) GetStoreId() string {
@@ -3004,13 +4189,14 @@ func (x *RecordSaleRequest) GetMetadata() map[string]string {
 type RecordSaleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Sale          *StoreSale             `protobuf:"bytes,1,opt,name=sale,proto3" json:"sale,omitempty"`
+	Receipt       *Receipt               `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RecordSaleResponse) Reset() {
 	*x = RecordSaleResponse{}
-	mi := &file_store_v1_store_proto_msgTypes[46]
+	mi := &file_store_v1_store_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3022,7 +4208,7 @@ func (x *RecordSaleResponse) String() string {
 func (*RecordSaleResponse) ProtoMessage() {}
 
 func (x *RecordSaleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[46]
+	mi := &file_store_v1_store_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3035,7 +4221,7 @@ func (x *RecordSaleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordSaleResponse.ProtoReflect.Descriptor instead.
 func (*RecordSaleResponse) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{46}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *RecordSaleResponse) GetSale() *StoreSale {
@@ -3045,6 +4231,13 @@ func (x *RecordSaleResponse) GetSale() *StoreSale {
 	return nil
 }
 
+func (x *RecordSaleResponse) GetReceipt() *Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
 type GetStoreSalesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StoreId       string                 `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
@@ -3059,7 +4252,7 @@ type GetStoreSalesRequest struct {
 
 func (x *GetStoreSalesRequest) Reset() {
 	*x = GetStoreSalesRequest{}
-	mi := &file_store_v1_store_proto_msgTypes[47]
+	mi := &file_store_v1_store_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3071,7 +4264,7 @@ func (x *GetStoreSalesRequest) String() string {
 func (*GetStoreSalesRequest) ProtoMessage() {}
 
 func (x *GetStoreSalesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[47]
+	mi := &file_store_v1_store_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3084,7 +4277,7 @@ func (x *GetStoreSalesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStoreSalesRequest.ProtoReflect.Descriptor instead.
 func (*GetStoreSalesRequest) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{47}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *GetStoreSalesRequest) GetStoreId() string {
@@ -3140,7 +4333,7 @@ type GetStoreSalesResponse struct {
 
 func (x *GetStoreSalesResponse) Reset() {
 	*x = GetStoreSalesResponse{}
-	mi := &file_store_v1_store_proto_msgTypes[48]
+	mi := &file_store_v1_store_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3152,7 +4345,7 @@ func (x *GetStoreSalesResponse) String() string {
 func (*GetStoreSalesResponse) ProtoMessage() {}
 
 func (x *GetStoreSalesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[48]
+	mi := &file_store_v1_store_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3165,7 +4358,7 @@ func (x *GetStoreSalesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStoreSalesResponse.ProtoReflect.Descriptor instead.
 func (*GetStoreSalesResponse) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{48}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *GetStoreSalesResponse) GetSales() []*StoreSale {
@@ -3200,7 +4393,7 @@ type ExportStoreProductsRequest struct {
 
 func (x *ExportStoreProductsRequest) Reset() {
 	*x = ExportStoreProductsRequest{}
-	mi := &file_store_v1_store_proto_msgTypes[49]
+	mi := &file_store_v1_store_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3212,7 +4405,7 @@ func (x *ExportStoreProductsRequest) String() string {
 func (*ExportStoreProductsRequest) ProtoMessage() {}
 
 func (x *ExportStoreProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[49]
+	mi := &file_store_v1_store_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3225,7 +4418,7 @@ func (x *ExportStoreProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportStoreProductsRequest.ProtoReflect.Descriptor instead.
 func (*ExportStoreProductsRequest) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{49}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *ExportStoreProductsRequest) GetStoreId() string {
@@ -3253,7 +4446,7 @@ type ExportStoreProductsResponse struct {
 
 func (x *ExportStoreProductsResponse) Reset() {
 	*x = ExportStoreProductsResponse{}
-	mi := &file_store_v1_store_proto_msgTypes[50]
+	mi := &file_store_v1_store_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3265,7 +4458,7 @@ func (x *ExportStoreProductsResponse) String() string {
 func (*ExportStoreProductsResponse) ProtoMessage() {}
 
 func (x *ExportStoreProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[50]
+	mi := &file_store_v1_store_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3278,7 +4471,7 @@ func (x *ExportStoreProductsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportStoreProductsResponse.ProtoReflect.Descriptor instead.
 func (*ExportStoreProductsResponse) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{50}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *ExportStoreProductsResponse) GetData() []byte {
@@ -3314,7 +4507,7 @@ type ExportStoreSalesRequest struct {
 
 func (x *ExportStoreSalesRequest) Reset() {
 	*x = ExportStoreSalesRequest{}
-	mi := &file_store_v1_store_proto_msgTypes[51]
+	mi := &file_store_v1_store_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3326,7 +4519,7 @@ func (x *ExportStoreSalesRequest) String() string {
 func (*ExportStoreSalesRequest) ProtoMessage() {}
 
 func (x *ExportStoreSalesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[51]
+	mi := &file_store_v1_store_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3339,7 +4532,7 @@ func (x *ExportStoreSalesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportStoreSalesRequest.ProtoReflect.Descriptor instead.
 func (*ExportStoreSalesRequest) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{51}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *ExportStoreSalesRequest) GetStoreId() string {
@@ -3381,7 +4574,7 @@ type ExportStoreSalesResponse struct {
 
 func (x *ExportStoreSalesResponse) Reset() {
 	*x = ExportStoreSalesResponse{}
-	mi := &file_store_v1_store_proto_msgTypes[52]
+	mi := &file_store_v1_store_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3393,7 +4586,7 @@ func (x *ExportStoreSalesResponse) String() string {
 func (*ExportStoreSalesResponse) ProtoMessage() {}
 
 func (x *ExportStoreSalesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_v1_store_proto_msgTypes[52]
+	mi := &file_store_v1_store_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3406,7 +4599,7 @@ func (x *ExportStoreSalesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportStoreSalesResponse.ProtoReflect.Descriptor instead.
 func (*ExportStoreSalesResponse) Descriptor() ([]byte, []int) {
-	return file_store_v1_store_proto_rawDescGZIP(), []int{52}
+	return file_store_v1_store_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *ExportStoreSalesResponse) GetData() []byte {
@@ -3434,7 +4627,7 @@ var File_store_v1_store_proto protoreflect.FileDescriptor
 
 const file_store_v1_store_proto_rawDesc = "" +
 	"\n" +
-	"\x14store/v1/store.proto\x12\bstore.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdd\x03\n" +
+	"\x14store/v1/store.proto\x12\bstore.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdf\x04\n" +
 	"\x05Store\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -3449,10 +4642,17 @@ const file_store_v1_store_proto_rawDesc = "" +
 	"created_at\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a;\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\bcurrency\x18\f \x01(\tR\bcurrency\x12\x19\n" +
+	"\btax_rate\x18\r \x01(\tR\ataxRate\x12\x1a\n" +
+	"\btimezone\x18\x0e \x01(\tR\btimezone\x12-\n" +
+	"\bholidays\x18\x0f \x03(\v2\x11.store.v1.HolidayR\bholidays\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc0\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"1\n" +
+	"\aHoliday\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xc0\x01\n" +
 	"\aAddress\x12\x16\n" +
 	"\x06street\x18\x01 \x01(\tR\x06street\x12\x12\n" +
 	"\x04city\x18\x02 \x01(\tR\x04city\x12\x14\n" +
@@ -3470,7 +4670,7 @@ const file_store_v1_store_proto_rawDesc = "" +
 	"\topen_time\x18\x02 \x01(\tR\bopenTime\x12\x1d\n" +
 	"\n" +
 	"close_time\x18\x03 \x01(\tR\tcloseTime\x12\x1b\n" +
-	"\tis_closed\x18\x04 \x01(\bR\bisClosed\"\xce\x02\n" +
+	"\tis_closed\x18\x04 \x01(\bR\bisClosed\"\xc6\x03\n" +
 	"\fStoreProduct\x12\x19\n" +
 	"\bstore_id\x18\x01 \x01(\tR\astoreId\x12\x1d\n" +
 	"\n" +
@@ -3481,7 +4681,11 @@ const file_store_v1_store_proto_rawDesc = "" +
 	"\vstore_price\x18\x06 \x01(\tR\n" +
 	"storePrice\x12!\n" +
 	"\fis_available\x18\a \x01(\bR\visAvailable\x12=\n" +
-	"\flast_updated\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vlastUpdated\"\x95\x03\n" +
+	"\flast_updated\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vlastUpdated\x12\"\n" +
+	"\rmin_order_qty\x18\t \x01(\x05R\vminOrderQty\x12\"\n" +
+	"\rmax_order_qty\x18\n" +
+	" \x01(\x05R\vmaxOrderQty\x12.\n" +
+	"\x13order_qty_increment\x18\v \x01(\x05R\x11orderQtyIncrement\"\x95\x03\n" +
 	"\x12ProductReservation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bstore_id\x18\x02 \x01(\tR\astoreId\x12\x1d\n" +
@@ -3502,7 +4706,7 @@ const file_store_v1_store_proto_rawDesc = "" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12+\n" +
 	"\x04role\x18\x03 \x01(\x0e2\x17.store.v1.StoreUserRoleR\x04role\x12;\n" +
 	"\vassigned_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"assignedAt\"\xf3\x03\n" +
+	"assignedAt\"\xf0\x04\n" +
 	"\tStoreSale\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bstore_id\x18\x02 \x01(\tR\astoreId\x12\x19\n" +
@@ -3515,10 +4719,34 @@ const file_store_v1_store_proto_rawDesc = "" +
 	"\tsale_type\x18\t \x01(\x0e2\x12.store.v1.SaleTypeR\bsaleType\x127\n" +
 	"\tsale_date\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\bsaleDate\x12=\n" +
-	"\bmetadata\x18\v \x03(\v2!.store.v1.StoreSale.MetadataEntryR\bmetadata\x1a;\n" +
+	"\bmetadata\x18\v \x03(\v2!.store.v1.StoreSale.MetadataEntryR\bmetadata\x12\x1a\n" +
+	"\bsubtotal\x18\f \x01(\tR\bsubtotal\x12\x19\n" +
+	"\btax_rate\x18\r \x01(\tR\ataxRate\x12\x1d\n" +
+	"\n" +
+	"tax_amount\x18\x0e \x01(\tR\ttaxAmount\x12%\n" +
+	"\x0ereceipt_number\x18\x0f \x01(\x03R\rreceiptNumber\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc9\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf0\x03\n" +
+	"\aReceipt\x12%\n" +
+	"\x0ereceipt_number\x18\x01 \x01(\x03R\rreceiptNumber\x12\x17\n" +
+	"\asale_id\x18\x02 \x01(\tR\x06saleId\x12\x19\n" +
+	"\bstore_id\x18\x03 \x01(\tR\astoreId\x12\x1d\n" +
+	"\n" +
+	"store_name\x18\x04 \x01(\tR\tstoreName\x126\n" +
+	"\rstore_address\x18\x05 \x01(\v2\x11.store.v1.AddressR\fstoreAddress\x12\x1f\n" +
+	"\vstore_phone\x18\x06 \x01(\tR\n" +
+	"storePhone\x12\"\n" +
+	"\rsales_user_id\x18\a \x01(\tR\vsalesUserId\x127\n" +
+	"\tissued_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bissuedAt\x12-\n" +
+	"\x05lines\x18\t \x03(\v2\x17.store.v1.StoreSaleItemR\x05lines\x12\x1a\n" +
+	"\bsubtotal\x18\n" +
+	" \x01(\tR\bsubtotal\x12\x19\n" +
+	"\btax_rate\x18\v \x01(\tR\ataxRate\x12\x1d\n" +
+	"\n" +
+	"tax_amount\x18\f \x01(\tR\ttaxAmount\x12\x14\n" +
+	"\x05total\x18\r \x01(\tR\x05total\x12\x1a\n" +
+	"\bcurrency\x18\x0e \x01(\tR\bcurrency\"\xc9\x01\n" +
 	"\rStoreSaleItem\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12!\n" +
@@ -3528,7 +4756,7 @@ const file_store_v1_store_proto_rawDesc = "" +
 	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x1d\n" +
 	"\n" +
 	"unit_price\x18\x05 \x01(\tR\tunitPrice\x12\x1a\n" +
-	"\bsubtotal\x18\x06 \x01(\tR\bsubtotal\"\xd4\x02\n" +
+	"\bsubtotal\x18\x06 \x01(\tR\bsubtotal\"\xd6\x03\n" +
 	"\x12CreateStoreRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12+\n" +
@@ -3536,7 +4764,12 @@ const file_store_v1_store_proto_rawDesc = "" +
 	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x14\n" +
 	"\x05email\x18\x05 \x01(\tR\x05email\x12*\n" +
 	"\x05hours\x18\x06 \x01(\v2\x14.store.v1.StoreHoursR\x05hours\x12F\n" +
-	"\bmetadata\x18\a \x03(\v2*.store.v1.CreateStoreRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\bmetadata\x18\a \x03(\v2*.store.v1.CreateStoreRequest.MetadataEntryR\bmetadata\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12\x19\n" +
+	"\btax_rate\x18\t \x01(\tR\ataxRate\x12\x1a\n" +
+	"\btimezone\x18\n" +
+	" \x01(\tR\btimezone\x12-\n" +
+	"\bholidays\x18\v \x03(\v2\x11.store.v1.HolidayR\bholidays\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"<\n" +
@@ -3545,33 +4778,56 @@ const file_store_v1_store_proto_rawDesc = "" +
 	"\x0fGetStoreRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"9\n" +
 	"\x10GetStoreResponse\x12%\n" +
-	"\x05store\x18\x01 \x01(\v2\x0f.store.v1.StoreR\x05store\"\x8c\x01\n" +
+	"\x05store\x18\x01 \x01(\v2\x0f.store.v1.StoreR\x05store\"\xbe\x01\n" +
 	"\x11ListStoresRequest\x12\x12\n" +
 	"\x04city\x18\x01 \x01(\tR\x04city\x12\x14\n" +
 	"\x05state\x18\x02 \x01(\tR\x05state\x12\x1f\n" +
 	"\vactive_only\x18\x03 \x01(\bR\n" +
 	"activeOnly\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x05 \x01(\x05R\x06offset\"^\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\x12\x16\n" +
+	"\x06search\x18\x06 \x01(\tR\x06search\x12\x18\n" +
+	"\acountry\x18\a \x01(\tR\acountry\"\x8c\x01\n" +
 	"\x12ListStoresResponse\x12'\n" +
 	"\x06stores\x18\x01 \x03(\v2\x0f.store.v1.StoreR\x06stores\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\";\n" +
+	"totalCount\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\";\n" +
 	"\x12UpdateStoreRequest\x12%\n" +
 	"\x05store\x18\x01 \x01(\v2\x0f.store.v1.StoreR\x05store\"/\n" +
 	"\x13UpdateStoreResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"$\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x82\x01\n" +
+	"\x1aUpdateStoreCalendarRequest\x12\x19\n" +
+	"\bstore_id\x18\x01 \x01(\tR\astoreId\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x12-\n" +
+	"\bholidays\x18\x03 \x03(\v2\x11.store.v1.HolidayR\bholidays\"D\n" +
+	"\x1bUpdateStoreCalendarResponse\x12%\n" +
+	"\x05store\x18\x01 \x01(\v2\x0f.store.v1.StoreR\x05store\"\x84\x01\n" +
+	"\x15CheckStoreOpenRequest\x12\x19\n" +
+	"\bstore_id\x18\x01 \x01(\tR\astoreId\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12$\n" +
+	"\x0elead_time_days\x18\x03 \x01(\x05R\fleadTimeDays\"\xa1\x01\n" +
+	"\x16CheckStoreOpenResponse\x12\x17\n" +
+	"\ais_open\x18\x01 \x01(\bR\x06isOpen\x12\x1d\n" +
+	"\n" +
+	"local_time\x18\x02 \x01(\tR\tlocalTime\x12\x18\n" +
+	"\aholiday\x18\x03 \x01(\tR\aholiday\x125\n" +
+	"\bready_by\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\areadyBy\"$\n" +
 	"\x12DeleteStoreRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
 	"\x13DeleteStoreResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x9a\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x92\x02\n" +
 	"\x18AddProductToStoreRequest\x12\x19\n" +
 	"\bstore_id\x18\x01 \x01(\tR\astoreId\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x02 \x01(\tR\tproductId\x12#\n" +
 	"\rinitial_stock\x18\x03 \x01(\x05R\finitialStock\x12\x1f\n" +
 	"\vstore_price\x18\x04 \x01(\tR\n" +
-	"storePrice\"X\n" +
+	"storePrice\x12\"\n" +
+	"\rmin_order_qty\x18\x05 \x01(\x05R\vminOrderQty\x12\"\n" +
+	"\rmax_order_qty\x18\x06 \x01(\x05R\vmaxOrderQty\x12.\n" +
+	"\x13order_qty_increment\x18\a \x01(\x05R\x11orderQtyIncrement\"X\n" +
 	"\x19AddProductToStoreResponse\x12;\n" +
 	"\rstore_product\x18\x01 \x01(\v2\x16.store.v1.StoreProductR\fstoreProduct\"\xa0\x01\n" +
 	"\x1eUpdateStoreProductStockRequest\x12\x19\n" +
@@ -3602,7 +4858,60 @@ const file_store_v1_store_proto_rawDesc = "" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12%\n" +
 	"\x0eavailable_only\x18\x02 \x01(\bR\ravailableOnly\"X\n" +
 	" GetProductStoreLocationsResponse\x124\n" +
-	"\tlocations\x18\x01 \x03(\v2\x16.store.v1.StoreProductR\tlocations\"\xda\x01\n" +
+	"\tlocations\x18\x01 \x03(\v2\x16.store.v1.StoreProductR\tlocations\"E\n" +
+	"\bCartItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\xb1\x01\n" +
+	"\x14CartItemAvailability\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12-\n" +
+	"\x12requested_quantity\x18\x02 \x01(\x05R\x11requestedQuantity\x12-\n" +
+	"\x12available_quantity\x18\x03 \x01(\x05R\x11availableQuantity\x12\x1c\n" +
+	"\tavailable\x18\x04 \x01(\bR\tavailable\"c\n" +
+	"\x1cCheckCartAvailabilityRequest\x12\x19\n" +
+	"\bstore_id\x18\x01 \x01(\tR\astoreId\x12(\n" +
+	"\x05items\x18\x02 \x03(\v2\x12.store.v1.CartItemR\x05items\"z\n" +
+	"\x1dCheckCartAvailabilityResponse\x12#\n" +
+	"\rall_available\x18\x01 \x01(\bR\fallAvailable\x124\n" +
+	"\x05items\x18\x02 \x03(\v2\x1e.store.v1.CartItemAvailabilityR\x05items\"\xae\x01\n" +
+	"\rCatalogFilter\x12!\n" +
+	"\fcategory_ids\x18\x01 \x03(\tR\vcategoryIds\x12\x1b\n" +
+	"\tmin_price\x18\x02 \x01(\tR\bminPrice\x12\x1b\n" +
+	"\tmax_price\x18\x03 \x01(\tR\bmaxPrice\x12\x1f\n" +
+	"\vsearch_term\x18\x04 \x01(\tR\n" +
+	"searchTerm\x12\x1f\n" +
+	"\vsupplier_id\x18\x05 \x01(\tR\n" +
+	"supplierId\"\x93\x01\n" +
+	"\x17ListStoreCatalogRequest\x12\x19\n" +
+	"\bstore_id\x18\x01 \x01(\tR\astoreId\x12/\n" +
+	"\x06filter\x18\x02 \x01(\v2\x17.store.v1.CatalogFilterR\x06filter\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"\xe7\x03\n" +
+	"\x10StoreCatalogItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x10\n" +
+	"\x03sku\x18\x04 \x01(\tR\x03sku\x12!\n" +
+	"\fcategory_ids\x18\x05 \x03(\tR\vcategoryIds\x12\x1f\n" +
+	"\vsupplier_id\x18\x06 \x01(\tR\n" +
+	"supplierId\x12\x1d\n" +
+	"\n" +
+	"image_urls\x18\a \x03(\tR\timageUrls\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"list_price\x18\t \x01(\tR\tlistPrice\x12'\n" +
+	"\x0feffective_price\x18\n" +
+	" \x01(\tR\x0eeffectivePrice\x12-\n" +
+	"\x12available_quantity\x18\v \x01(\x05R\x11availableQuantity\x12\"\n" +
+	"\rmin_order_qty\x18\f \x01(\x05R\vminOrderQty\x12\"\n" +
+	"\rmax_order_qty\x18\r \x01(\x05R\vmaxOrderQty\x12.\n" +
+	"\x13order_qty_increment\x18\x0e \x01(\x05R\x11orderQtyIncrement\"m\n" +
+	"\x18ListStoreCatalogResponse\x120\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.store.v1.StoreCatalogItemR\x05items\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\xda\x01\n" +
 	"\x15ReserveProductRequest\x12\x19\n" +
 	"\bstore_id\x18\x01 \x01(\tR\astoreId\x12\x1d\n" +
 	"\n" +
@@ -3663,9 +4972,10 @@ const file_store_v1_store_proto_rawDesc = "" +
 	"\bmetadata\x18\a \x03(\v2).store.v1.RecordSaleRequest.MetadataEntryR\bmetadata\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"=\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"j\n" +
 	"\x12RecordSaleResponse\x12'\n" +
-	"\x04sale\x18\x01 \x01(\v2\x13.store.v1.StoreSaleR\x04sale\"\xf1\x01\n" +
+	"\x04sale\x18\x01 \x01(\v2\x13.store.v1.StoreSaleR\x04sale\x12+\n" +
+	"\areceipt\x18\x02 \x01(\v2\x11.store.v1.ReceiptR\areceipt\"\xf1\x01\n" +
 	"\x14GetStoreSalesRequest\x12\x19\n" +
 	"\bstore_id\x18\x01 \x01(\tR\astoreId\x12\"\n" +
 	"\rsales_user_id\x18\x02 \x01(\tR\vsalesUserId\x127\n" +
@@ -3709,19 +5019,23 @@ const file_store_v1_store_proto_rawDesc = "" +
 	"\x15SALE_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11SALE_TYPE_WALK_IN\x10\x01\x12\x19\n" +
 	"\x15SALE_TYPE_RESERVATION\x10\x02\x12\x1b\n" +
-	"\x17SALE_TYPE_ONLINE_PICKUP\x10\x032\xb6\x0f\n" +
+	"\x17SALE_TYPE_ONLINE_PICKUP\x10\x032\xb4\x12\n" +
 	"\fStoreService\x12J\n" +
 	"\vCreateStore\x12\x1c.store.v1.CreateStoreRequest\x1a\x1d.store.v1.CreateStoreResponse\x12A\n" +
 	"\bGetStore\x12\x19.store.v1.GetStoreRequest\x1a\x1a.store.v1.GetStoreResponse\x12G\n" +
 	"\n" +
 	"ListStores\x12\x1b.store.v1.ListStoresRequest\x1a\x1c.store.v1.ListStoresResponse\x12J\n" +
 	"\vUpdateStore\x12\x1c.store.v1.UpdateStoreRequest\x1a\x1d.store.v1.UpdateStoreResponse\x12J\n" +
-	"\vDeleteStore\x12\x1c.store.v1.DeleteStoreRequest\x1a\x1d.store.v1.DeleteStoreResponse\x12\\\n" +
+	"\vDeleteStore\x12\x1c.store.v1.DeleteStoreRequest\x1a\x1d.store.v1.DeleteStoreResponse\x12b\n" +
+	"\x13UpdateStoreCalendar\x12$.store.v1.UpdateStoreCalendarRequest\x1a%.store.v1.UpdateStoreCalendarResponse\x12S\n" +
+	"\x0eCheckStoreOpen\x12\x1f.store.v1.CheckStoreOpenRequest\x1a .store.v1.CheckStoreOpenResponse\x12\\\n" +
 	"\x11AddProductToStore\x12\".store.v1.AddProductToStoreRequest\x1a#.store.v1.AddProductToStoreResponse\x12n\n" +
 	"\x17UpdateStoreProductStock\x12(.store.v1.UpdateStoreProductStockRequest\x1a).store.v1.UpdateStoreProductStockResponse\x12k\n" +
 	"\x16RemoveProductFromStore\x12'.store.v1.RemoveProductFromStoreRequest\x1a(.store.v1.RemoveProductFromStoreResponse\x12Y\n" +
 	"\x10GetStoreProducts\x12!.store.v1.GetStoreProductsRequest\x1a\".store.v1.GetStoreProductsResponse\x12q\n" +
-	"\x18GetProductStoreLocations\x12).store.v1.GetProductStoreLocationsRequest\x1a*.store.v1.GetProductStoreLocationsResponse\x12S\n" +
+	"\x18GetProductStoreLocations\x12).store.v1.GetProductStoreLocationsRequest\x1a*.store.v1.GetProductStoreLocationsResponse\x12h\n" +
+	"\x15CheckCartAvailability\x12&.store.v1.CheckCartAvailabilityRequest\x1a'.store.v1.CheckCartAvailabilityResponse\x12Y\n" +
+	"\x10ListStoreCatalog\x12!.store.v1.ListStoreCatalogRequest\x1a\".store.v1.ListStoreCatalogResponse\x12S\n" +
 	"\x0eReserveProduct\x12\x1f.store.v1.ReserveProductRequest\x1a .store.v1.ReserveProductResponse\x12\\\n" +
 	"\x11CancelReservation\x12\".store.v1.CancelReservationRequest\x1a#.store.v1.CancelReservationResponse\x12V\n" +
 	"\x0fGetReservations\x12 .store.v1.GetReservationsRequest\x1a!.store.v1.GetReservationsResponse\x12b\n" +
@@ -3749,164 +5063,200 @@ func file_store_v1_store_proto_rawDescGZIP() []byte {
 }
 
 var file_store_v1_store_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_store_v1_store_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
+var file_store_v1_store_proto_msgTypes = make([]protoimpl.MessageInfo, 71)
 var file_store_v1_store_proto_goTypes = []any{
 	(ReservationStatus)(0),                   // 0: store.v1.ReservationStatus
 	(StoreUserRole)(0),                       // 1: store.v1.StoreUserRole
 	(SaleType)(0),                            // 2: store.v1.SaleType
 	(*Store)(nil),                            // 3: store.v1.Store
-	(*Address)(nil),                          // 4: store.v1.Address
-	(*StoreHours)(nil),                       // 5: store.v1.StoreHours
-	(*DayHours)(nil),                         // 6: store.v1.DayHours
-	(*StoreProduct)(nil),                     // 7: store.v1.StoreProduct
-	(*ProductReservation)(nil),               // 8: store.v1.ProductReservation
-	(*StoreUser)(nil),                        // 9: store.v1.StoreUser
-	(*StoreSale)(nil),                        // 10: store.v1.StoreSale
-	(*StoreSaleItem)(nil),                    // 11: store.v1.StoreSaleItem
-	(*CreateStoreRequest)(nil),               // 12: store.v1.CreateStoreRequest
-	(*CreateStoreResponse)(nil),              // 13: store.v1.CreateStoreResponse
-	(*GetStoreRequest)(nil),                  // 14: store.v1.GetStoreRequest
-	(*GetStoreResponse)(nil),                 // 15: store.v1.GetStoreResponse
-	(*ListStoresRequest)(nil),                // 16: store.v1.ListStoresRequest
-	(*ListStoresResponse)(nil),               // 17: store.v1.ListStoresResponse
-	(*UpdateStoreRequest)(nil),               // 18: store.v1.UpdateStoreRequest
-	(*UpdateStoreResponse)(nil),              // 19: store.v1.UpdateStoreResponse
-	(*DeleteStoreRequest)(nil),               // 20: store.v1.DeleteStoreRequest
-	(*DeleteStoreResponse)(nil),              // 21: store.v1.DeleteStoreResponse
-	(*AddProductToStoreRequest)(nil),         // 22: store.v1.AddProductToStoreRequest
-	(*AddProductToStoreResponse)(nil),        // 23: store.v1.AddProductToStoreResponse
-	(*UpdateStoreProductStockRequest)(nil),   // 24: store.v1.UpdateStoreProductStockRequest
-	(*UpdateStoreProductStockResponse)(nil),  // 25: store.v1.UpdateStoreProductStockResponse
-	(*RemoveProductFromStoreRequest)(nil),    // 26: store.v1.RemoveProductFromStoreRequest
-	(*RemoveProductFromStoreResponse)(nil),   // 27: store.v1.RemoveProductFromStoreResponse
-	(*GetStoreProductsRequest)(nil),          // 28: store.v1.GetStoreProductsRequest
-	(*GetStoreProductsResponse)(nil),         // 29: store.v1.GetStoreProductsResponse
-	(*GetProductStoreLocationsRequest)(nil),  // 30: store.v1.GetProductStoreLocationsRequest
-	(*GetProductStoreLocationsResponse)(nil), // 31: store.v1.GetProductStoreLocationsResponse
-	(*ReserveProductRequest)(nil),            // 32: store.v1.ReserveProductRequest
-	(*ReserveProductResponse)(nil),           // 33: store.v1.ReserveProductResponse
-	(*CancelReservationRequest)(nil),         // 34: store.v1.CancelReservationRequest
-	(*CancelReservationResponse)(nil),        // 35: store.v1.CancelReservationResponse
-	(*GetReservationsRequest)(nil),           // 36: store.v1.GetReservationsRequest
-	(*GetReservationsResponse)(nil),          // 37: store.v1.GetReservationsResponse
-	(*CompleteReservationRequest)(nil),       // 38: store.v1.CompleteReservationRequest
-	(*CompleteReservationResponse)(nil),      // 39: store.v1.CompleteReservationResponse
-	(*AssignUserToStoreRequest)(nil),         // 40: store.v1.AssignUserToStoreRequest
-	(*AssignUserToStoreResponse)(nil),        // 41: store.v1.AssignUserToStoreResponse
-	(*RemoveUserFromStoreRequest)(nil),       // 42: store.v1.RemoveUserFromStoreRequest
-	(*RemoveUserFromStoreResponse)(nil),      // 43: store.v1.RemoveUserFromStoreResponse
-	(*GetStoreUsersRequest)(nil),             // 44: store.v1.GetStoreUsersRequest
-	(*GetStoreUsersResponse)(nil),            // 45: store.v1.GetStoreUsersResponse
-	(*GetUserStoresRequest)(nil),             // 46: store.v1.GetUserStoresRequest
-	(*GetUserStoresResponse)(nil),            // 47: store.v1.GetUserStoresResponse
-	(*RecordSaleRequest)(nil),                // 48: store.v1.RecordSaleRequest
-	(*RecordSaleResponse)(nil),               // 49: store.v1.RecordSaleResponse
-	(*GetStoreSalesRequest)(nil),             // 50: store.v1.GetStoreSalesRequest
-	(*GetStoreSalesResponse)(nil),            // 51: store.v1.GetStoreSalesResponse
-	(*ExportStoreProductsRequest)(nil),       // 52: store.v1.ExportStoreProductsRequest
-	(*ExportStoreProductsResponse)(nil),      // 53: store.v1.ExportStoreProductsResponse
-	(*ExportStoreSalesRequest)(nil),          // 54: store.v1.ExportStoreSalesRequest
-	(*ExportStoreSalesResponse)(nil),         // 55: store.v1.ExportStoreSalesResponse
-	nil,                                      // 56: store.v1.Store.MetadataEntry
-	nil,                                      // 57: store.v1.StoreSale.MetadataEntry
-	nil,                                      // 58: store.v1.CreateStoreRequest.MetadataEntry
-	nil,                                      // 59: store.v1.RecordSaleRequest.MetadataEntry
-	(*timestamppb.Timestamp)(nil),            // 60: google.protobuf.Timestamp
+	(*Holiday)(nil),                          // 4: store.v1.Holiday
+	(*Address)(nil),                          // 5: store.v1.Address
+	(*StoreHours)(nil),                       // 6: store.v1.StoreHours
+	(*DayHours)(nil),                         // 7: store.v1.DayHours
+	(*StoreProduct)(nil),                     // 8: store.v1.StoreProduct
+	(*ProductReservation)(nil),               // 9: store.v1.ProductReservation
+	(*StoreUser)(nil),                        // 10: store.v1.StoreUser
+	(*StoreSale)(nil),                        // 11: store.v1.StoreSale
+	(*Receipt)(nil),                          // 12: store.v1.Receipt
+	(*StoreSaleItem)(nil),                    // 13: store.v1.StoreSaleItem
+	(*CreateStoreRequest)(nil),               // 14: store.v1.CreateStoreRequest
+	(*CreateStoreResponse)(nil),              // 15: store.v1.CreateStoreResponse
+	(*GetStoreRequest)(nil),                  // 16: store.v1.GetStoreRequest
+	(*GetStoreResponse)(nil),                 // 17: store.v1.GetStoreResponse
+	(*ListStoresRequest)(nil),                // 18: store.v1.ListStoresRequest
+	(*ListStoresResponse)(nil),               // 19: store.v1.ListStoresResponse
+	(*UpdateStoreRequest)(nil),               // 20: store.v1.UpdateStoreRequest
+	(*UpdateStoreResponse)(nil),              // 21: store.v1.UpdateStoreResponse
+	(*UpdateStoreCalendarRequest)(nil),       // 22: store.v1.UpdateStoreCalendarRequest
+	(*UpdateStoreCalendarResponse)(nil),      // 23: store.v1.UpdateStoreCalendarResponse
+	(*CheckStoreOpenRequest)(nil),            // 24: store.v1.CheckStoreOpenRequest
+	(*CheckStoreOpenResponse)(nil),           // 25: store.v1.CheckStoreOpenResponse
+	(*DeleteStoreRequest)(nil),               // 26: store.v1.DeleteStoreRequest
+	(*DeleteStoreResponse)(nil),              // 27: store.v1.DeleteStoreResponse
+	(*AddProductToStoreRequest)(nil),         // 28: store.v1.AddProductToStoreRequest
+	(*AddProductToStoreResponse)(nil),        // 29: store.v1.AddProductToStoreResponse
+	(*UpdateStoreProductStockRequest)(nil),   // 30: store.v1.UpdateStoreProductStockRequest
+	(*UpdateStoreProductStockResponse)(nil),  // 31: store.v1.UpdateStoreProductStockResponse
+	(*RemoveProductFromStoreRequest)(nil),    // 32: store.v1.RemoveProductFromStoreRequest
+	(*RemoveProductFromStoreResponse)(nil),   // 33: store.v1.RemoveProductFromStoreResponse
+	(*GetStoreProductsRequest)(nil),          // 34: store.v1.GetStoreProductsRequest
+	(*GetStoreProductsResponse)(nil),         // 35: store.v1.GetStoreProductsResponse
+	(*GetProductStoreLocationsRequest)(nil),  // 36: store.v1.GetProductStoreLocationsRequest
+	(*GetProductStoreLocationsResponse)(nil), // 37: store.v1.GetProductStoreLocationsResponse
+	(*CartItem)(nil),                         // 38: store.v1.CartItem
+	(*CartItemAvailability)(nil),             // 39: store.v1.CartItemAvailability
+	(*CheckCartAvailabilityRequest)(nil),     // 40: store.v1.CheckCartAvailabilityRequest
+	(*CheckCartAvailabilityResponse)(nil),    // 41: store.v1.CheckCartAvailabilityResponse
+	(*CatalogFilter)(nil),                    // 42: store.v1.CatalogFilter
+	(*ListStoreCatalogRequest)(nil),          // 43: store.v1.ListStoreCatalogRequest
+	(*StoreCatalogItem)(nil),                 // 44: store.v1.StoreCatalogItem
+	(*ListStoreCatalogResponse)(nil),         // 45: store.v1.ListStoreCatalogResponse
+	(*ReserveProductRequest)(nil),            // 46: store.v1.ReserveProductRequest
+	(*ReserveProductResponse)(nil),           // 47: store.v1.ReserveProductResponse
+	(*CancelReservationRequest)(nil),         // 48: store.v1.CancelReservationRequest
+	(*CancelReservationResponse)(nil),        // 49: store.v1.CancelReservationResponse
+	(*GetReservationsRequest)(nil),           // 50: store.v1.GetReservationsRequest
+	(*GetReservationsResponse)(nil),          // 51: store.v1.GetReservationsResponse
+	(*CompleteReservationRequest)(nil),       // 52: store.v1.CompleteReservationRequest
+	(*CompleteReservationResponse)(nil),      // 53: store.v1.CompleteReservationResponse
+	(*AssignUserToStoreRequest)(nil),         // 54: store.v1.AssignUserToStoreRequest
+	(*AssignUserToStoreResponse)(nil),        // 55: store.v1.AssignUserToStoreResponse
+	(*RemoveUserFromStoreRequest)(nil),       // 56: store.v1.RemoveUserFromStoreRequest
+	(*RemoveUserFromStoreResponse)(nil),      // 57: store.v1.RemoveUserFromStoreResponse
+	(*GetStoreUsersRequest)(nil),             // 58: store.v1.GetStoreUsersRequest
+	(*GetStoreUsersResponse)(nil),            // 59: store.v1.GetStoreUsersResponse
+	(*GetUserStoresRequest)(nil),             // 60: store.v1.GetUserStoresRequest
+	(*GetUserStoresResponse)(nil),            // 61: store.v1.GetUserStoresResponse
+	(*RecordSaleRequest)(nil),                // 62: store.v1.RecordSaleRequest
+	(*RecordSaleResponse)(nil),               // 63: store.v1.RecordSaleResponse
+	(*GetStoreSalesRequest)(nil),             // 64: store.v1.GetStoreSalesRequest
+	(*GetStoreSalesResponse)(nil),            // 65: store.v1.GetStoreSalesResponse
+	(*ExportStoreProductsRequest)(nil),       // 66: store.v1.ExportStoreProductsRequest
+	(*ExportStoreProductsResponse)(nil),      // 67: store.v1.ExportStoreProductsResponse
+	(*ExportStoreSalesRequest)(nil),          // 68: store.v1.ExportStoreSalesRequest
+	(*ExportStoreSalesResponse)(nil),         // 69: store.v1.ExportStoreSalesResponse
+	nil,                                      // 70: store.v1.Store.MetadataEntry
+	nil,                                      // 71: store.v1.StoreSale.MetadataEntry
+	nil,                                      // 72: store.v1.CreateStoreRequest.MetadataEntry
+	nil,                                      // 73: store.v1.RecordSaleRequest.MetadataEntry
+	(*timestamppb.Timestamp)(nil),            // 74: google.protobuf.Timestamp
 }
 var file_store_v1_store_proto_depIdxs = []int32{
-	4,  // 0: store.v1.Store.address:type_name -> store.v1.Address
-	5,  // 1: store.v1.Store.hours:type_name -> store.v1.StoreHours
-	56, // 2: store.v1.Store.metadata:type_name -> store.v1.Store.MetadataEntry
-	60, // 3: store.v1.Store.created_at:type_name -> google.protobuf.Timestamp
-	60, // 4: store.v1.Store.updated_at:type_name -> google.protobuf.Timestamp
-	6,  // 5: store.v1.StoreHours.days:type_name -> store.v1.DayHours
-	60, // 6: store.v1.StoreProduct.last_updated:type_name -> google.protobuf.Timestamp
-	0,  // 7: store.v1.ProductReservation.status:type_name -> store.v1.ReservationStatus
-	60, // 8: store.v1.ProductReservation.reserved_at:type_name -> google.protobuf.Timestamp
-	60, // 9: store.v1.ProductReservation.expires_at:type_name -> google.protobuf.Timestamp
-	60, // 10: store.v1.ProductReservation.completed_at:type_name -> google.protobuf.Timestamp
-	1,  // 11: store.v1.StoreUser.role:type_name -> store.v1.StoreUserRole
-	60, // 12: store.v1.StoreUser.assigned_at:type_name -> google.protobuf.Timestamp
-	11, // 13: store.v1.StoreSale.items:type_name -> store.v1.StoreSaleItem
-	2,  // 14: store.v1.StoreSale.sale_type:type_name -> store.v1.SaleType
-	60, // 15: store.v1.StoreSale.sale_date:type_name -> google.protobuf.Timestamp
-	57, // 16: store.v1.StoreSale.metadata:type_name -> store.v1.StoreSale.MetadataEntry
-	4,  // 17: store.v1.CreateStoreRequest.address:type_name -> store.v1.Address
-	5,  // 18: store.v1.CreateStoreRequest.hours:type_name -> store.v1.StoreHours
-	58, // 19: store.v1.CreateStoreRequest.metadata:type_name -> store.v1.CreateStoreRequest.MetadataEntry
-	3,  // 20: store.v1.CreateStoreResponse.store:type_name -> store.v1.Store
-	3,  // 21: store.v1.GetStoreResponse.store:type_name -> store.v1.Store
-	3,  // 22: store.v1.ListStoresResponse.stores:type_name -> store.v1.Store
-	3,  // 23: store.v1.UpdateStoreRequest.store:type_name -> store.v1.Store
-	7,  // 24: store.v1.AddProductToStoreResponse.store_product:type_name -> store.v1.StoreProduct
-	7,  // 25: store.v1.GetStoreProductsResponse.products:type_name -> store.v1.StoreProduct
-	7,  // 26: store.v1.GetProductStoreLocationsResponse.locations:type_name -> store.v1.StoreProduct
-	8,  // 27: store.v1.ReserveProductResponse.reservation:type_name -> store.v1.ProductReservation
-	0,  // 28: store.v1.GetReservationsRequest.status:type_name -> store.v1.ReservationStatus
-	8,  // 29: store.v1.GetReservationsResponse.reservations:type_name -> store.v1.ProductReservation
-	10, // 30: store.v1.CompleteReservationResponse.sale:type_name -> store.v1.StoreSale
-	1,  // 31: store.v1.AssignUserToStoreRequest.role:type_name -> store.v1.StoreUserRole
-	1,  // 32: store.v1.GetStoreUsersRequest.role:type_name -> store.v1.StoreUserRole
-	9,  // 33: store.v1.GetStoreUsersResponse.users:type_name -> store.v1.StoreUser
-	9,  // 34: store.v1.GetUserStoresResponse.stores:type_name -> store.v1.StoreUser
-	11, // 35: store.v1.RecordSaleRequest.items:type_name -> store.v1.StoreSaleItem
-	2,  // 36: store.v1.RecordSaleRequest.sale_type:type_name -> store.v1.SaleType
-	59, // 37: store.v1.RecordSaleRequest.metadata:type_name -> store.v1.RecordSaleRequest.MetadataEntry
-	10, // 38: store.v1.RecordSaleResponse.sale:type_name -> store.v1.StoreSale
-	60, // 39: store.v1.GetStoreSalesRequest.from_date:type_name -> google.protobuf.Timestamp
-	60, // 40: store.v1.GetStoreSalesRequest.to_date:type_name -> google.protobuf.Timestamp
-	10, // 41: store.v1.GetStoreSalesResponse.sales:type_name -> store.v1.StoreSale
-	60, // 42: store.v1.ExportStoreSalesRequest.from_date:type_name -> google.protobuf.Timestamp
-	60, // 43: store.v1.ExportStoreSalesRequest.to_date:type_name -> google.protobuf.Timestamp
-	12, // 44: store.v1.StoreService.CreateStore:input_type -> store.v1.CreateStoreRequest
-	14, // 45: store.v1.StoreService.GetStore:input_type -> store.v1.GetStoreRequest
-	16, // 46: store.v1.StoreService.ListStores:input_type -> store.v1.ListStoresRequest
-	18, // 47: store.v1.StoreService.UpdateStore:input_type -> store.v1.UpdateStoreRequest
-	20, // 48: store.v1.StoreService.DeleteStore:input_type -> store.v1.DeleteStoreRequest
-	22, // 49: store.v1.StoreService.AddProductToStore:input_type -> store.v1.AddProductToStoreRequest
-	24, // 50: store.v1.StoreService.UpdateStoreProductStock:input_type -> store.v1.UpdateStoreProductStockRequest
-	26, // 51: store.v1.StoreService.RemoveProductFromStore:input_type -> store.v1.RemoveProductFromStoreRequest
-	28, // 52: store.v1.StoreService.GetStoreProducts:input_type -> store.v1.GetStoreProductsRequest
-	30, // 53: store.v1.StoreService.GetProductStoreLocations:input_type -> store.v1.GetProductStoreLocationsRequest
-	32, // 54: store.v1.StoreService.ReserveProduct:input_type -> store.v1.ReserveProductRequest
-	34, // 55: store.v1.StoreService.CancelReservation:input_type -> store.v1.CancelReservationRequest
-	36, // 56: store.v1.StoreService.GetReservations:input_type -> store.v1.GetReservationsRequest
-	38, // 57: store.v1.StoreService.CompleteReservation:input_type -> store.v1.CompleteReservationRequest
-	40, // 58: store.v1.StoreService.AssignUserToStore:input_type -> store.v1.AssignUserToStoreRequest
-	42, // 59: store.v1.StoreService.RemoveUserFromStore:input_type -> store.v1.RemoveUserFromStoreRequest
-	44, // 60: store.v1.StoreService.GetStoreUsers:input_type -> store.v1.GetStoreUsersRequest
-	46, // 61: store.v1.StoreService.GetUserStores:input_type -> store.v1.GetUserStoresRequest
-	48, // 62: store.v1.StoreService.RecordSale:input_type -> store.v1.RecordSaleRequest
-	50, // 63: store.v1.StoreService.GetStoreSales:input_type -> store.v1.GetStoreSalesRequest
-	52, // 64: store.v1.StoreService.ExportStoreProducts:input_type -> store.v1.ExportStoreProductsRequest
-	54, // 65: store.v1.StoreService.ExportStoreSales:input_type -> store.v1.ExportStoreSalesRequest
-	13, // 66: store.v1.StoreService.CreateStore:output_type -> store.v1.CreateStoreResponse
-	15, // 67: store.v1.StoreService.GetStore:output_type -> store.v1.GetStoreResponse
-	17, // 68: store.v1.StoreService.ListStores:output_type -> store.v1.ListStoresResponse
-	19, // 69: store.v1.StoreService.UpdateStore:output_type -> store.v1.UpdateStoreResponse
-	21, // 70: store.v1.StoreService.DeleteStore:output_type -> store.v1.DeleteStoreResponse
-	23, // 71: store.v1.StoreService.AddProductToStore:output_type -> store.v1.AddProductToStoreResponse
-	25, // 72: store.v1.StoreService.UpdateStoreProductStock:output_type -> store.v1.UpdateStoreProductStockResponse
-	27, // 73: store.v1.StoreService.RemoveProductFromStore:output_type -> store.v1.RemoveProductFromStoreResponse
-	29, // 74: store.v1.StoreService.GetStoreProducts:output_type -> store.v1.GetStoreProductsResponse
-	31, // 75: store.v1.StoreService.GetProductStoreLocations:output_type -> store.v1.GetProductStoreLocationsResponse
-	33, // 76: store.v1.StoreService.ReserveProduct:output_type -> store.v1.ReserveProductResponse
-	35, // 77: store.v1.StoreService.CancelReservation:output_type -> store.v1.CancelReservationResponse
-	37, // 78: store.v1.StoreService.GetReservations:output_type -> store.v1.GetReservationsResponse
-	39, // 79: store.v1.StoreService.CompleteReservation:output_type -> store.v1.CompleteReservationResponse
-	41, // 80: store.v1.StoreService.AssignUserToStore:output_type -> store.v1.AssignUserToStoreResponse
-	43, // 81: store.v1.StoreService.RemoveUserFromStore:output_type -> store.v1.RemoveUserFromStoreResponse
-	45, // 82: store.v1.StoreService.GetStoreUsers:output_type -> store.v1.GetStoreUsersResponse
-	47, // 83: store.v1.StoreService.GetUserStores:output_type -> store.v1.GetUserStoresResponse
-	49, // 84: store.v1.StoreService.RecordSale:output_type -> store.v1.RecordSaleResponse
-	51, // 85: store.v1.StoreService.GetStoreSales:output_type -> store.v1.GetStoreSalesResponse
-	53, // 86: store.v1.StoreService.ExportStoreProducts:output_type -> store.v1.ExportStoreProductsResponse
-	55, // 87: store.v1.StoreService.ExportStoreSales:output_type -> store.v1.ExportStoreSalesResponse
-	66, // [66:88] is the sub-list for method output_type
-	44, // [44:66] is the sub-list for method input_type
-	44, // [44:44] is the sub-list for extension type_name
-	44, // [44:44] is the sub-list for extension extendee
-	0,  // [0:44] is the sub-list for field type_name
+	5,  // 0: store.v1.Store.address:type_name -> store.v1.Address
+	6,  // 1: store.v1.Store.hours:type_name -> store.v1.StoreHours
+	70, // 2: store.v1.Store.metadata:type_name -> store.v1.Store.MetadataEntry
+	74, // 3: store.v1.Store.created_at:type_name -> google.protobuf.Timestamp
+	74, // 4: store.v1.Store.updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 5: store.v1.Store.holidays:type_name -> store.v1.Holiday
+	7,  // 6: store.v1.StoreHours.days:type_name -> store.v1.DayHours
+	74, // 7: store.v1.StoreProduct.last_updated:type_name -> google.protobuf.Timestamp
+	0,  // 8: store.v1.ProductReservation.status:type_name -> store.v1.ReservationStatus
+	74, // 9: store.v1.ProductReservation.reserved_at:type_name -> google.protobuf.Timestamp
+	74, // 10: store.v1.ProductReservation.expires_at:type_name -> google.protobuf.Timestamp
+	74, // 11: store.v1.ProductReservation.completed_at:type_name -> google.protobuf.Timestamp
+	1,  // 12: store.v1.StoreUser.role:type_name -> store.v1.StoreUserRole
+	74, // 13: store.v1.StoreUser.assigned_at:type_name -> google.protobuf.Timestamp
+	13, // 14: store.v1.StoreSale.items:type_name -> store.v1.StoreSaleItem
+	2,  // 15: store.v1.StoreSale.sale_type:type_name -> store.v1.SaleType
+	74, // 16: store.v1.StoreSale.sale_date:type_name -> google.protobuf.Timestamp
+	71, // 17: store.v1.StoreSale.metadata:type_name -> store.v1.StoreSale.MetadataEntry
+	5,  // 18: store.v1.Receipt.store_address:type_name -> store.v1.Address
+	74, // 19: store.v1.Receipt.issued_at:type_name -> google.protobuf.Timestamp
+	13, // 20: store.v1.Receipt.lines:type_name -> store.v1.StoreSaleItem
+	5,  // 21: store.v1.CreateStoreRequest.address:type_name -> store.v1.Address
+	6,  // 22: store.v1.CreateStoreRequest.hours:type_name -> store.v1.StoreHours
+	72, // 23: store.v1.CreateStoreRequest.metadata:type_name -> store.v1.CreateStoreRequest.MetadataEntry
+	4,  // 24: store.v1.CreateStoreRequest.holidays:type_name -> store.v1.Holiday
+	3,  // 25: store.v1.CreateStoreResponse.store:type_name -> store.v1.Store
+	3,  // 26: store.v1.GetStoreResponse.store:type_name -> store.v1.Store
+	3,  // 27: store.v1.ListStoresResponse.stores:type_name -> store.v1.Store
+	3,  // 28: store.v1.UpdateStoreRequest.store:type_name -> store.v1.Store
+	4,  // 29: store.v1.UpdateStoreCalendarRequest.holidays:type_name -> store.v1.Holiday
+	3,  // 30: store.v1.UpdateStoreCalendarResponse.store:type_name -> store.v1.Store
+	74, // 31: store.v1.CheckStoreOpenRequest.at:type_name -> google.protobuf.Timestamp
+	74, // 32: store.v1.CheckStoreOpenResponse.ready_by:type_name -> google.protobuf.Timestamp
+	8,  // 33: store.v1.AddProductToStoreResponse.store_product:type_name -> store.v1.StoreProduct
+	8,  // 34: store.v1.GetStoreProductsResponse.products:type_name -> store.v1.StoreProduct
+	8,  // 35: store.v1.GetProductStoreLocationsResponse.locations:type_name -> store.v1.StoreProduct
+	38, // 36: store.v1.CheckCartAvailabilityRequest.items:type_name -> store.v1.CartItem
+	39, // 37: store.v1.CheckCartAvailabilityResponse.items:type_name -> store.v1.CartItemAvailability
+	42, // 38: store.v1.ListStoreCatalogRequest.filter:type_name -> store.v1.CatalogFilter
+	44, // 39: store.v1.ListStoreCatalogResponse.items:type_name -> store.v1.StoreCatalogItem
+	9,  // 40: store.v1.ReserveProductResponse.reservation:type_name -> store.v1.ProductReservation
+	0,  // 41: store.v1.GetReservationsRequest.status:type_name -> store.v1.ReservationStatus
+	9,  // 42: store.v1.GetReservationsResponse.reservations:type_name -> store.v1.ProductReservation
+	11, // 43: store.v1.CompleteReservationResponse.sale:type_name -> store.v1.StoreSale
+	1,  // 44: store.v1.AssignUserToStoreRequest.role:type_name -> store.v1.StoreUserRole
+	1,  // 45: store.v1.GetStoreUsersRequest.role:type_name -> store.v1.StoreUserRole
+	10, // 46: store.v1.GetStoreUsersResponse.users:type_name -> store.v1.StoreUser
+	10, // 47: store.v1.GetUserStoresResponse.stores:type_name -> store.v1.StoreUser
+	13, // 48: store.v1.RecordSaleRequest.items:type_name -> store.v1.StoreSaleItem
+	2,  // 49: store.v1.RecordSaleRequest.sale_type:type_name -> store.v1.SaleType
+	73, // 50: store.v1.RecordSaleRequest.metadata:type_name -> store.v1.RecordSaleRequest.MetadataEntry
+	11, // 51: store.v1.RecordSaleResponse.sale:type_name -> store.v1.StoreSale
+	12, // 52: store.v1.RecordSaleResponse.receipt:type_name -> store.v1.Receipt
+	74, // 53: store.v1.GetStoreSalesRequest.from_date:type_name -> google.protobuf.Timestamp
+	74, // 54: store.v1.GetStoreSalesRequest.to_date:type_name -> google.protobuf.Timestamp
+	11, // 55: store.v1.GetStoreSalesResponse.sales:type_name -> store.v1.StoreSale
+	74, // 56: store.v1.ExportStoreSalesRequest.from_date:type_name -> google.protobuf.Timestamp
+	74, // 57: store.v1.ExportStoreSalesRequest.to_date:type_name -> google.protobuf.Timestamp
+	14, // 58: store.v1.StoreService.CreateStore:input_type -> store.v1.CreateStoreRequest
+	16, // 59: store.v1.StoreService.GetStore:input_type -> store.v1.GetStoreRequest
+	18, // 60: store.v1.StoreService.ListStores:input_type -> store.v1.ListStoresRequest
+	20, // 61: store.v1.StoreService.UpdateStore:input_type -> store.v1.UpdateStoreRequest
+	26, // 62: store.v1.StoreService.DeleteStore:input_type -> store.v1.DeleteStoreRequest
+	22, // 63: store.v1.StoreService.UpdateStoreCalendar:input_type -> store.v1.UpdateStoreCalendarRequest
+	24, // 64: store.v1.StoreService.CheckStoreOpen:input_type -> store.v1.CheckStoreOpenRequest
+	28, // 65: store.v1.StoreService.AddProductToStore:input_type -> store.v1.AddProductToStoreRequest
+	30, // 66: store.v1.StoreService.UpdateStoreProductStock:input_type -> store.v1.UpdateStoreProductStockRequest
+	32, // 67: store.v1.StoreService.RemoveProductFromStore:input_type -> store.v1.RemoveProductFromStoreRequest
+	34, // 68: store.v1.StoreService.GetStoreProducts:input_type -> store.v1.GetStoreProductsRequest
+	36, // 69: store.v1.StoreService.GetProductStoreLocations:input_type -> store.v1.GetProductStoreLocationsRequest
+	40, // 70: store.v1.StoreService.CheckCartAvailability:input_type -> store.v1.CheckCartAvailabilityRequest
+	43, // 71: store.v1.StoreService.ListStoreCatalog:input_type -> store.v1.ListStoreCatalogRequest
+	46, // 72: store.v1.StoreService.ReserveProduct:input_type -> store.v1.ReserveProductRequest
+	48, // 73: store.v1.StoreService.CancelReservation:input_type -> store.v1.CancelReservationRequest
+	50, // 74: store.v1.StoreService.GetReservations:input_type -> store.v1.GetReservationsRequest
+	52, // 75: store.v1.StoreService.CompleteReservation:input_type -> store.v1.CompleteReservationRequest
+	54, // 76: store.v1.StoreService.AssignUserToStore:input_type -> store.v1.AssignUserToStoreRequest
+	56, // 77: store.v1.StoreService.RemoveUserFromStore:input_type -> store.v1.RemoveUserFromStoreRequest
+	58, // 78: store.v1.StoreService.GetStoreUsers:input_type -> store.v1.GetStoreUsersRequest
+	60, // 79: store.v1.StoreService.GetUserStores:input_type -> store.v1.GetUserStoresRequest
+	62, // 80: store.v1.StoreService.RecordSale:input_type -> store.v1.RecordSaleRequest
+	64, // 81: store.v1.StoreService.GetStoreSales:input_type -> store.v1.GetStoreSalesRequest
+	66, // 82: store.v1.StoreService.ExportStoreProducts:input_type -> store.v1.ExportStoreProductsRequest
+	68, // 83: store.v1.StoreService.ExportStoreSales:input_type -> store.v1.ExportStoreSalesRequest
+	15, // 84: store.v1.StoreService.CreateStore:output_type -> store.v1.CreateStoreResponse
+	17, // 85: store.v1.StoreService.GetStore:output_type -> store.v1.GetStoreResponse
+	19, // 86: store.v1.StoreService.ListStores:output_type -> store.v1.ListStoresResponse
+	21, // 87: store.v1.StoreService.UpdateStore:output_type -> store.v1.UpdateStoreResponse
+	27, // 88: store.v1.StoreService.DeleteStore:output_type -> store.v1.DeleteStoreResponse
+	23, // 89: store.v1.StoreService.UpdateStoreCalendar:output_type -> store.v1.UpdateStoreCalendarResponse
+	25, // 90: store.v1.StoreService.CheckStoreOpen:output_type -> store.v1.CheckStoreOpenResponse
+	29, // 91: store.v1.StoreService.AddProductToStore:output_type -> store.v1.AddProductToStoreResponse
+	31, // 92: store.v1.StoreService.UpdateStoreProductStock:output_type -> store.v1.UpdateStoreProductStockResponse
+	33, // 93: store.v1.StoreService.RemoveProductFromStore:output_type -> store.v1.RemoveProductFromStoreResponse
+	35, // 94: store.v1.StoreService.GetStoreProducts:output_type -> store.v1.GetStoreProductsResponse
+	37, // 95: store.v1.StoreService.GetProductStoreLocations:output_type -> store.v1.GetProductStoreLocationsResponse
+	41, // 96: store.v1.StoreService.CheckCartAvailability:output_type -> store.v1.CheckCartAvailabilityResponse
+	45, // 97: store.v1.StoreService.ListStoreCatalog:output_type -> store.v1.ListStoreCatalogResponse
+	47, // 98: store.v1.StoreService.ReserveProduct:output_type -> store.v1.ReserveProductResponse
+	49, // 99: store.v1.StoreService.CancelReservation:output_type -> store.v1.CancelReservationResponse
+	51, // 100: store.v1.StoreService.GetReservations:output_type -> store.v1.GetReservationsResponse
+	53, // 101: store.v1.StoreService.CompleteReservation:output_type -> store.v1.CompleteReservationResponse
+	55, // 102: store.v1.StoreService.AssignUserToStore:output_type -> store.v1.AssignUserToStoreResponse
+	57, // 103: store.v1.StoreService.RemoveUserFromStore:output_type -> store.v1.RemoveUserFromStoreResponse
+	59, // 104: store.v1.StoreService.GetStoreUsers:output_type -> store.v1.GetStoreUsersResponse
+	61, // 105: store.v1.StoreService.GetUserStores:output_type -> store.v1.GetUserStoresResponse
+	63, // 106: store.v1.StoreService.RecordSale:output_type -> store.v1.RecordSaleResponse
+	65, // 107: store.v1.StoreService.GetStoreSales:output_type -> store.v1.GetStoreSalesResponse
+	67, // 108: store.v1.StoreService.ExportStoreProducts:output_type -> store.v1.ExportStoreProductsResponse
+	69, // 109: store.v1.StoreService.ExportStoreSales:output_type -> store.v1.ExportStoreSalesResponse
+	84, // [84:110] is the sub-list for method output_type
+	58, // [58:84] is the sub-list for method input_type
+	58, // [58:58] is the sub-list for extension type_name
+	58, // [58:58] is the sub-list for extension extendee
+	0,  // [0:58] is the sub-list for field type_name
 }
 
 func init() { file_store_v1_store_proto_init() }
@@ -3920,7 +5270,7 @@ func file_store_v1_store_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_v1_store_proto_rawDesc), len(file_store_v1_store_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   57,
+			NumMessages:   71,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
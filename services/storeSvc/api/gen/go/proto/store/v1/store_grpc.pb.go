@@ -24,11 +24,15 @@ const (
 	StoreService_ListStores_FullMethodName               = "/store.v1.StoreService/ListStores"
 	StoreService_UpdateStore_FullMethodName              = "/store.v1.StoreService/UpdateStore"
 	StoreService_DeleteStore_FullMethodName              = "/store.v1.StoreService/DeleteStore"
+	StoreService_UpdateStoreCalendar_FullMethodName      = "/store.v1.StoreService/UpdateStoreCalendar"
+	StoreService_CheckStoreOpen_FullMethodName           = "/store.v1.StoreService/CheckStoreOpen"
 	StoreService_AddProductToStore_FullMethodName        = "/store.v1.StoreService/AddProductToStore"
 	StoreService_UpdateStoreProductStock_FullMethodName  = "/store.v1.StoreService/UpdateStoreProductStock"
 	StoreService_RemoveProductFromStore_FullMethodName   = "/store.v1.StoreService/RemoveProductFromStore"
 	StoreService_GetStoreProducts_FullMethodName         = "/store.v1.StoreService/GetStoreProducts"
 	StoreService_GetProductStoreLocations_FullMethodName = "/store.v1.StoreService/GetProductStoreLocations"
+	StoreService_CheckCartAvailability_FullMethodName    = "/store.v1.StoreService/CheckCartAvailability"
+	StoreService_ListStoreCatalog_FullMethodName         = "/store.v1.StoreService/ListStoreCatalog"
 	StoreService_ReserveProduct_FullMethodName           = "/store.v1.StoreService/ReserveProduct"
 	StoreService_CancelReservation_FullMethodName        = "/store.v1.StoreService/CancelReservation"
 	StoreService_GetReservations_FullMethodName          = "/store.v1.StoreService/GetReservations"
@@ -55,12 +59,16 @@ type StoreServiceClient interface {
 	ListStores(ctx context.Context, in *ListStoresRequest, opts ...grpc.CallOption) (*ListStoresResponse, error)
 	UpdateStore(ctx context.Context, in *UpdateStoreRequest, opts ...grpc.CallOption) (*UpdateStoreResponse, error)
 	DeleteStore(ctx context.Context, in *DeleteStoreRequest, opts ...grpc.CallOption) (*DeleteStoreResponse, error)
+	UpdateStoreCalendar(ctx context.Context, in *UpdateStoreCalendarRequest, opts ...grpc.CallOption) (*UpdateStoreCalendarResponse, error)
+	CheckStoreOpen(ctx context.Context, in *CheckStoreOpenRequest, opts ...grpc.CallOption) (*CheckStoreOpenResponse, error)
 	// Store inventory management
 	AddProductToStore(ctx context.Context, in *AddProductToStoreRequest, opts ...grpc.CallOption) (*AddProductToStoreResponse, error)
 	UpdateStoreProductStock(ctx context.Context, in *UpdateStoreProductStockRequest, opts ...grpc.CallOption) (*UpdateStoreProductStockResponse, error)
 	RemoveProductFromStore(ctx context.Context, in *RemoveProductFromStoreRequest, opts ...grpc.CallOption) (*RemoveProductFromStoreResponse, error)
 	GetStoreProducts(ctx context.Context, in *GetStoreProductsRequest, opts ...grpc.CallOption) (*GetStoreProductsResponse, error)
 	GetProductStoreLocations(ctx context.Context, in *GetProductStoreLocationsRequest, opts ...grpc.CallOption) (*GetProductStoreLocationsResponse, error)
+	CheckCartAvailability(ctx context.Context, in *CheckCartAvailabilityRequest, opts ...grpc.CallOption) (*CheckCartAvailabilityResponse, error)
+	ListStoreCatalog(ctx context.Context, in *ListStoreCatalogRequest, opts ...grpc.CallOption) (*ListStoreCatalogResponse, error)
 	// Product reservations
 	ReserveProduct(ctx context.Context, in *ReserveProductRequest, opts ...grpc.CallOption) (*ReserveProductResponse, error)
 	CancelReservation(ctx context.Context, in *CancelReservationRequest, opts ...grpc.CallOption) (*CancelReservationResponse, error)
@@ -137,6 +145,26 @@ func (c *storeServiceClient) DeleteStore(ctx context.Context, in *DeleteStoreReq
 	return out, nil
 }
 
+func (c *storeServiceClient) UpdateStoreCalendar(ctx context.Context, in *UpdateStoreCalendarRequest, opts ...grpc.CallOption) (*UpdateStoreCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateStoreCalendarResponse)
+	err := c.cc.Invoke(ctx, StoreService_UpdateStoreCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) CheckStoreOpen(ctx context.Context, in *CheckStoreOpenRequest, opts ...grpc.CallOption) (*CheckStoreOpenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckStoreOpenResponse)
+	err := c.cc.Invoke(ctx, StoreService_CheckStoreOpen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storeServiceClient) AddProductToStore(ctx context.Context, in *AddProductToStoreRequest, opts ...grpc.CallOption) (*AddProductToStoreResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddProductToStoreResponse)
@@ -187,6 +215,26 @@ func (c *storeServiceClient) GetProductStoreLocations(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *storeServiceClient) CheckCartAvailability(ctx context.Context, in *CheckCartAvailabilityRequest, opts ...grpc.CallOption) (*CheckCartAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckCartAvailabilityResponse)
+	err := c.cc.Invoke(ctx, StoreService_CheckCartAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) ListStoreCatalog(ctx context.Context, in *ListStoreCatalogRequest, opts ...grpc.CallOption) (*ListStoreCatalogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStoreCatalogResponse)
+	err := c.cc.Invoke(ctx, StoreService_ListStoreCatalog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storeServiceClient) ReserveProduct(ctx context.Context, in *ReserveProductRequest, opts ...grpc.CallOption) (*ReserveProductResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReserveProductResponse)
@@ -319,12 +367,16 @@ type StoreServiceServer interface {
 	ListStores(context.Context, *ListStoresRequest) (*ListStoresResponse, error)
 	UpdateStore(context.Context, *UpdateStoreRequest) (*UpdateStoreResponse, error)
 	DeleteStore(context.Context, *DeleteStoreRequest) (*DeleteStoreResponse, error)
+	UpdateStoreCalendar(context.Context, *UpdateStoreCalendarRequest) (*UpdateStoreCalendarResponse, error)
+	CheckStoreOpen(context.Context, *CheckStoreOpenRequest) (*CheckStoreOpenResponse, error)
 	// Store inventory management
 	AddProductToStore(context.Context, *AddProductToStoreRequest) (*AddProductToStoreResponse, error)
 	UpdateStoreProductStock(context.Context, *UpdateStoreProductStockRequest) (*UpdateStoreProductStockResponse, error)
 	RemoveProductFromStore(context.Context, *RemoveProductFromStoreRequest) (*RemoveProductFromStoreResponse, error)
 	GetStoreProducts(context.Context, *GetStoreProductsRequest) (*GetStoreProductsResponse, error)
 	GetProductStoreLocations(context.Context, *GetProductStoreLocationsRequest) (*GetProductStoreLocationsResponse, error)
+	CheckCartAvailability(context.Context, *CheckCartAvailabilityRequest) (*CheckCartAvailabilityResponse, error)
+	ListStoreCatalog(context.Context, *ListStoreCatalogRequest) (*ListStoreCatalogResponse, error)
 	// Product reservations
 	ReserveProduct(context.Context, *ReserveProductRequest) (*ReserveProductResponse, error)
 	CancelReservation(context.Context, *CancelReservationRequest) (*CancelReservationResponse, error)
@@ -365,6 +417,12 @@ func (UnimplementedStoreServiceServer) UpdateStore(context.Context, *UpdateStore
 func (UnimplementedStoreServiceServer) DeleteStore(context.Context, *DeleteStoreRequest) (*DeleteStoreResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteStore not implemented")
 }
+func (UnimplementedStoreServiceServer) UpdateStoreCalendar(context.Context, *UpdateStoreCalendarRequest) (*UpdateStoreCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStoreCalendar not implemented")
+}
+func (UnimplementedStoreServiceServer) CheckStoreOpen(context.Context, *CheckStoreOpenRequest) (*CheckStoreOpenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckStoreOpen not implemented")
+}
 func (UnimplementedStoreServiceServer) AddProductToStore(context.Context, *AddProductToStoreRequest) (*AddProductToStoreResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddProductToStore not implemented")
 }
@@ -380,6 +438,12 @@ func (UnimplementedStoreServiceServer) GetStoreProducts(context.Context, *GetSto
 func (UnimplementedStoreServiceServer) GetProductStoreLocations(context.Context, *GetProductStoreLocationsRequest) (*GetProductStoreLocationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProductStoreLocations not implemented")
 }
+func (UnimplementedStoreServiceServer) CheckCartAvailability(context.Context, *CheckCartAvailabilityRequest) (*CheckCartAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckCartAvailability not implemented")
+}
+func (UnimplementedStoreServiceServer) ListStoreCatalog(context.Context, *ListStoreCatalogRequest) (*ListStoreCatalogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStoreCatalog not implemented")
+}
 func (UnimplementedStoreServiceServer) ReserveProduct(context.Context, *ReserveProductRequest) (*ReserveProductResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReserveProduct not implemented")
 }
@@ -526,6 +590,42 @@ func _StoreService_DeleteStore_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StoreService_UpdateStoreCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStoreCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).UpdateStoreCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_UpdateStoreCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).UpdateStoreCalendar(ctx, req.(*UpdateStoreCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_CheckStoreOpen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckStoreOpenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).CheckStoreOpen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_CheckStoreOpen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).CheckStoreOpen(ctx, req.(*CheckStoreOpenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StoreService_AddProductToStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddProductToStoreRequest)
 	if err := dec(in); err != nil {
@@ -616,6 +716,42 @@ func _StoreService_GetProductStoreLocations_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StoreService_CheckCartAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckCartAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).CheckCartAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_CheckCartAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).CheckCartAvailability(ctx, req.(*CheckCartAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_ListStoreCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStoreCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).ListStoreCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_ListStoreCatalog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).ListStoreCatalog(ctx, req.(*ListStoreCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StoreService_ReserveProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReserveProductRequest)
 	if err := dec(in); err != nil {
@@ -859,6 +995,14 @@ var StoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteStore",
 			Handler:    _StoreService_DeleteStore_Handler,
 		},
+		{
+			MethodName: "UpdateStoreCalendar",
+			Handler:    _StoreService_UpdateStoreCalendar_Handler,
+		},
+		{
+			MethodName: "CheckStoreOpen",
+			Handler:    _StoreService_CheckStoreOpen_Handler,
+		},
 		{
 			MethodName: "AddProductToStore",
 			Handler:    _StoreService_AddProductToStore_Handler,
@@ -879,6 +1023,14 @@ var StoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProductStoreLocations",
 			Handler:    _StoreService_GetProductStoreLocations_Handler,
 		},
+		{
+			MethodName: "CheckCartAvailability",
+			Handler:    _StoreService_CheckCartAvailability_Handler,
+		},
+		{
+			MethodName: "ListStoreCatalog",
+			Handler:    _StoreService_ListStoreCatalog_Handler,
+		},
 		{
 			MethodName: "ReserveProduct",
 			Handler:    _StoreService_ReserveProduct_Handler,
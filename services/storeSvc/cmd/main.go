@@ -6,12 +6,15 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/version"
 	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/config"
 	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/database"
 	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/server"
 )
 
 func main() {
+	log.Printf("Starting store service %s (commit %s, built %s)", version.Version, version.Commit, version.BuildDate)
+
 	// Load configuration
 	cfg, err := config.Load()
 	if err != nil {
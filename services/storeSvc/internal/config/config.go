@@ -1,21 +1,61 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // Config holds all configuration for the store service
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Services ServicesConfig
+	Server       ServerConfig
+	Database     DatabaseConfig
+	Services     ServicesConfig
+	Sales        SalesConfig
+	Reservations ReservationsConfig
 }
 
+// ReservationsConfig holds settings for product reservations
+type ReservationsConfig struct {
+	// DefaultDuration is how long reservations made without a duration last
+	DefaultDuration time.Duration
+	// ExpiryInterval is how often expired reservations are swept and their
+	// stock made available again; zero turns the sweeper off
+	ExpiryInterval time.Duration
+}
+
+// SalesConfig holds settings for recording store sales
+type SalesConfig struct {
+	// DefaultCurrency is used for stores created without a currency
+	DefaultCurrency string
+	// SupportedCurrencies lists the ISO 4217 codes a store may sell in
+	SupportedCurrencies []string
+	// RoundingMode decides how amounts are rounded to cents
+	RoundingMode RoundingMode
+}
+
+// RoundingMode is how a money amount is rounded to cents when it falls exactly
+// halfway between two cents
+type RoundingMode string
+
+const (
+	// RoundHalfUp rounds halves away from zero: 2.125 becomes 2.13
+	RoundHalfUp RoundingMode = "HALF_UP"
+	// RoundHalfEven rounds halves to the even cent (banker's rounding): 2.125
+	// becomes 2.12 and 2.135 becomes 2.14
+	RoundHalfEven RoundingMode = "HALF_EVEN"
+)
+
 // ServerConfig holds server-related configuration
 type ServerConfig struct {
 	Port string
 	Host string
+	// MaxRecvMsgSize and MaxSendMsgSize bound gRPC message sizes in bytes,
+	// matching the 10MB the shared clients allow
+	MaxRecvMsgSize int
+	MaxSendMsgSize int
 }
 
 // DatabaseConfig holds database-related configuration
@@ -32,23 +72,45 @@ type ServicesConfig struct {
 	UserServiceAddr      string
 }
 
+// defaultMaxMsgSize is the default gRPC message size limit; gRPC's own 4MB
+// limit on received messages is too small for bulk requests
+const defaultMaxMsgSize = 10 * 1024 * 1024
+
 // Load loads configuration from environment variables
 func Load() (*Config, error) {
 	cfg := &Config{
 		Server: ServerConfig{
 			Port: getEnv("GRPC_PORT", getEnv("SERVER_PORT", "50058")),
 			Host: getEnv("SERVER_HOST", "0.0.0.0"),
+
+			MaxRecvMsgSize: getEnvAsInt("GRPC_MAX_RECV_MSG_SIZE", defaultMaxMsgSize),
+			MaxSendMsgSize: getEnvAsInt("GRPC_MAX_SEND_MSG_SIZE", defaultMaxMsgSize),
 		},
 		Database: DatabaseConfig{
 			URI:      getEnv("MONGO_URI", "mongodb://localhost:27017"),
 			Database: getEnv("DATABASE_NAME", "storedb"),
 		},
 		Services: ServicesConfig{
-			ProductServiceAddr:   getEnv("PRODUCT_SERVICE_ADDR", "localhost:8081"),
+			ProductServiceAddr:   getEnv("PRODUCT_SERVICE_ADDR", "localhost:50053"),
 			InventoryServiceAddr: getEnv("INVENTORY_SERVICE_ADDR", "localhost:8082"),
 			OrderServiceAddr:     getEnv("ORDER_SERVICE_ADDR", "localhost:8083"),
 			UserServiceAddr:      getEnv("USER_SERVICE_ADDR", "localhost:8084"),
 		},
+		Sales: SalesConfig{
+			DefaultCurrency:     strings.ToUpper(getEnv("DEFAULT_CURRENCY", "USD")),
+			SupportedCurrencies: getEnvAsList("SUPPORTED_CURRENCIES", []string{"USD", "EUR", "GBP", "CAD"}),
+			RoundingMode:        RoundingMode(strings.ToUpper(getEnv("SALES_ROUNDING_MODE", string(RoundHalfUp)))),
+		},
+		Reservations: ReservationsConfig{
+			DefaultDuration: getEnvAsDuration("RESERVATION_DEFAULT_DURATION", 24*time.Hour),
+			ExpiryInterval:  getEnvAsDuration("RESERVATION_EXPIRY_INTERVAL", time.Minute),
+		},
+	}
+
+	switch cfg.Sales.RoundingMode {
+	case RoundHalfUp, RoundHalfEven:
+	default:
+		return nil, fmt.Errorf("invalid SALES_ROUNDING_MODE %q: must be %s or %s", cfg.Sales.RoundingMode, RoundHalfUp, RoundHalfEven)
 	}
 
 	return cfg, nil
@@ -71,3 +133,29 @@ func getEnvAsInt(key string, fallback int) int {
 	}
 	return fallback
 }
+
+// getEnvAsDuration gets an environment variable as a duration such as "90s"
+// with a fallback value
+func getEnvAsDuration(key string, fallback time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if d, err := time.ParseDuration(value); err == nil {
+			return d
+		}
+	}
+	return fallback
+}
+
+// getEnvAsList gets a comma separated environment variable as upper-cased values with a fallback
+func getEnvAsList(key string, fallback []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	var list []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.ToUpper(strings.TrimSpace(v)); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLoadRoundingMode(t *testing.T) {
+	tests := []struct {
+		env  string
+		want RoundingMode
+	}{
+		{env: "", want: RoundHalfUp},
+		{env: "half_even", want: RoundHalfEven},
+		{env: "HALF_UP", want: RoundHalfUp},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.env, func(t *testing.T) {
+			t.Setenv("SALES_ROUNDING_MODE", tt.env)
+			cfg, err := Load()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cfg.Sales.RoundingMode != tt.want {
+				t.Errorf("rounding mode = %s, want %s", cfg.Sales.RoundingMode, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadRejectsUnknownRoundingMode(t *testing.T) {
+	t.Setenv("SALES_ROUNDING_MODE", "HALF_DOWN")
+	if _, err := Load(); err == nil {
+		t.Fatal("expected an error for an unknown rounding mode")
+	}
+}
+
+func TestLoadReservationExpiryInterval(t *testing.T) {
+	tests := []struct {
+		env  string
+		want time.Duration
+	}{
+		{env: "", want: time.Minute},
+		{env: "30s", want: 30 * time.Second},
+		{env: "0", want: 0},
+		{env: "soon", want: time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.env, func(t *testing.T) {
+			t.Setenv("RESERVATION_EXPIRY_INTERVAL", tt.env)
+			cfg, err := Load()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cfg.Reservations.ExpiryInterval != tt.want {
+				t.Errorf("expiry interval = %s, want %s", cfg.Reservations.ExpiryInterval, tt.want)
+			}
+		})
+	}
+}
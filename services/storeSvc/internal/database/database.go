@@ -2,8 +2,10 @@ package database
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
@@ -32,6 +34,9 @@ func Initialize(cfg *config.Config) (*Database, error) {
 	}
 
 	db := client.Database(cfg.Database.Database)
+	if err := ensureIndexes(ctx, db); err != nil {
+		return nil, err
+	}
 
 	return &Database{
 		client: client,
@@ -39,6 +44,28 @@ func Initialize(cfg *config.Config) (*Database, error) {
 	}, nil
 }
 
+// NewFromDatabase wraps an already connected database. Indexes are not
+// created; use Initialize to connect to the configured database.
+func NewFromDatabase(db *mongo.Database) *Database {
+	return &Database{
+		client: db.Client(),
+		db:     db,
+	}
+}
+
+// ensureIndexes creates the indexes store listings filter and sort on
+func ensureIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("stores").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "name", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "address.country", Value: 1}, {Key: "is_active", Value: 1}}},
+		{Keys: bson.D{{Key: "is_active", Value: 1}, {Key: "name", Value: 1}}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create store indexes: %w", err)
+	}
+	return nil
+}
+
 // Close closes the database connection
 func (d *Database) Close() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -0,0 +1,129 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// dateLayout is the layout of holiday dates
+const dateLayout = "2006-01-02"
+
+// clockLayout is the layout of opening and closing times
+const clockLayout = "15:04"
+
+// Holiday is a date the store is closed on, whatever its weekly hours say
+type Holiday struct {
+	Date string `bson:"date" json:"date"` // YYYY-MM-DD in the store's timezone
+	Name string `bson:"name" json:"name"`
+}
+
+// ValidateTimezone checks that name is an IANA timezone, e.g. "Europe/Brussels".
+// An empty name is valid and means UTC.
+func ValidateTimezone(name string) error {
+	if _, err := time.LoadLocation(name); err != nil {
+		return fmt.Errorf("invalid timezone %q: %w", name, err)
+	}
+	return nil
+}
+
+// ValidateHolidays checks that every holiday has a YYYY-MM-DD date
+func ValidateHolidays(holidays []Holiday) error {
+	for _, h := range holidays {
+		if _, err := time.Parse(dateLayout, h.Date); err != nil {
+			return fmt.Errorf("invalid holiday date %q, use YYYY-MM-DD", h.Date)
+		}
+	}
+	return nil
+}
+
+// Location returns the store's timezone, UTC when none or an unknown one is set
+func (s *Store) Location() *time.Location {
+	if loc, err := time.LoadLocation(s.Timezone); err == nil {
+		return loc
+	}
+	return time.UTC
+}
+
+// HolidayOn returns the holiday falling on the store's local date at t
+func (s *Store) HolidayOn(t time.Time) (Holiday, bool) {
+	date := t.In(s.Location()).Format(dateLayout)
+	for _, h := range s.Holidays {
+		if h.Date == date {
+			return h, true
+		}
+	}
+	return Holiday{}, false
+}
+
+// hoursOn returns the weekly hours configured for weekday
+func (s *Store) hoursOn(weekday time.Weekday) (DayHours, bool) {
+	for _, day := range s.Hours.Days {
+		if strings.EqualFold(day.Day, weekday.String()) {
+			return day, true
+		}
+	}
+	return DayHours{}, false
+}
+
+// IsBusinessDay reports whether the store opens at all on the local date at t.
+// Holidays are never business days. When the store has no weekly hours, every
+// other day is.
+func (s *Store) IsBusinessDay(t time.Time) bool {
+	if _, ok := s.HolidayOn(t); ok {
+		return false
+	}
+	if len(s.Hours.Days) == 0 {
+		return true
+	}
+	day, ok := s.hoursOn(t.In(s.Location()).Weekday())
+	return ok && !day.IsClosed
+}
+
+// IsOpen reports whether the store is open at t, going by its weekly hours in
+// its own timezone and its holidays. Inactive stores are never open; stores
+// without weekly hours are open on every business day. A closing time at or
+// before the opening time means the store is open until midnight.
+func (s *Store) IsOpen(t time.Time) bool {
+	if !s.IsActive || !s.IsBusinessDay(t) {
+		return false
+	}
+	if len(s.Hours.Days) == 0 {
+		return true
+	}
+
+	local := t.In(s.Location())
+	day, _ := s.hoursOn(local.Weekday())
+	open, err := time.Parse(clockLayout, day.OpenTime)
+	if err != nil {
+		return false
+	}
+	closing, err := time.Parse(clockLayout, day.CloseTime)
+	if err != nil {
+		return false
+	}
+
+	now := local.Hour()*60 + local.Minute()
+	openAt := open.Hour()*60 + open.Minute()
+	closeAt := closing.Hour()*60 + closing.Minute()
+	if closeAt <= openAt {
+		return now >= openAt
+	}
+	return now >= openAt && now < closeAt
+}
+
+// AddBusinessDays returns the time days business days after from, in the
+// store's timezone, skipping closed weekdays and holidays. It is used for
+// lead times such as restock and pickup estimates.
+func (s *Store) AddBusinessDays(from time.Time, days int) time.Time {
+	t := from.In(s.Location())
+	// A store with no business day at all would never get there; give up
+	// after a year
+	for added, checked := 0, 0; added < days && checked < 366+days; checked++ {
+		t = t.AddDate(0, 0, 1)
+		if s.IsBusinessDay(t) {
+			added++
+		}
+	}
+	return t
+}
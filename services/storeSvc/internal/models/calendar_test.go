@@ -0,0 +1,107 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+// weekdayStore is open 09:00-18:00 Monday to Friday in Brussels, and closed on
+// Christmas, a Wednesday in 2024
+func weekdayStore() *Store {
+	days := []DayHours{
+		{Day: "Saturday", IsClosed: true},
+		{Day: "Sunday", IsClosed: true},
+	}
+	for _, day := range []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"} {
+		days = append(days, DayHours{Day: day, OpenTime: "09:00", CloseTime: "18:00"})
+	}
+	return &Store{
+		IsActive: true,
+		Timezone: "Europe/Brussels",
+		Hours:    StoreHours{Days: days},
+		Holidays: []Holiday{{Date: "2024-12-25", Name: "Christmas"}},
+	}
+}
+
+func TestIsOpenOnHolidayOfAnOpenWeekday(t *testing.T) {
+	store := weekdayStore()
+	brussels := store.Location()
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{name: "ordinary Tuesday", at: time.Date(2024, 12, 24, 10, 0, 0, 0, brussels), want: true},
+		{name: "Christmas on a Wednesday", at: time.Date(2024, 12, 25, 10, 0, 0, 0, brussels), want: false},
+		{name: "Thursday after", at: time.Date(2024, 12, 26, 10, 0, 0, 0, brussels), want: true},
+		{name: "Saturday", at: time.Date(2024, 12, 28, 10, 0, 0, 0, brussels), want: false},
+		// 08:30 UTC is 09:30 in Brussels, 17:30 UTC is 18:30
+		{name: "opening in store time", at: time.Date(2024, 12, 24, 8, 30, 0, 0, time.UTC), want: true},
+		{name: "closed in store time", at: time.Date(2024, 12, 24, 17, 30, 0, 0, time.UTC), want: false},
+		// 23:30 UTC on the 24th is already Christmas in Brussels
+		{name: "holiday in store time", at: time.Date(2024, 12, 24, 23, 30, 0, 0, time.UTC), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := store.IsOpen(tt.at); got != tt.want {
+				t.Fatalf("IsOpen(%v) = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+
+	if holiday, ok := store.HolidayOn(time.Date(2024, 12, 25, 12, 0, 0, 0, brussels)); !ok || holiday.Name != "Christmas" {
+		t.Fatalf("HolidayOn = %+v, %v, want Christmas", holiday, ok)
+	}
+}
+
+func TestAddBusinessDaysSkipsHolidays(t *testing.T) {
+	store := weekdayStore()
+	brussels := store.Location()
+	tuesday := time.Date(2024, 12, 24, 10, 0, 0, 0, brussels)
+
+	tests := []struct {
+		days int
+		want time.Time
+	}{
+		{days: 1, want: time.Date(2024, 12, 26, 10, 0, 0, 0, brussels)},
+		{days: 2, want: time.Date(2024, 12, 27, 10, 0, 0, 0, brussels)},
+		{days: 3, want: time.Date(2024, 12, 30, 10, 0, 0, 0, brussels)},
+	}
+	for _, tt := range tests {
+		if got := store.AddBusinessDays(tuesday, tt.days); !got.Equal(tt.want) {
+			t.Errorf("AddBusinessDays(+%d) = %v, want %v", tt.days, got, tt.want)
+		}
+	}
+}
+
+func TestStoreWithoutHoursIsClosedOnlyOnHolidays(t *testing.T) {
+	store := &Store{IsActive: true, Holidays: []Holiday{{Date: "2024-01-01", Name: "New Year"}}}
+
+	if store.IsOpen(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Error("store should be closed on its holiday")
+	}
+	if !store.IsOpen(time.Date(2024, 1, 6, 3, 0, 0, 0, time.UTC)) {
+		t.Error("store without weekly hours should be open on other days")
+	}
+	store.IsActive = false
+	if store.IsOpen(time.Date(2024, 1, 6, 3, 0, 0, 0, time.UTC)) {
+		t.Error("an inactive store is never open")
+	}
+}
+
+func TestValidateCalendar(t *testing.T) {
+	if err := ValidateTimezone("Europe/Brussels"); err != nil {
+		t.Error(err)
+	}
+	if err := ValidateTimezone("Mars/Olympus"); err == nil {
+		t.Error("unknown timezone should be rejected")
+	}
+	if err := ValidateHolidays([]Holiday{{Date: "2024-12-25"}}); err != nil {
+		t.Error(err)
+	}
+	if err := ValidateHolidays([]Holiday{{Date: "25/12/2024"}}); err == nil {
+		t.Error("holiday date in another layout should be rejected")
+	}
+}
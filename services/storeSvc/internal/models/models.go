@@ -14,6 +14,10 @@ type Store struct {
 	IsActive      bool              `bson:"is_active" json:"is_active"`
 	Hours         StoreHours        `bson:"hours" json:"hours"`
 	Metadata      map[string]string `bson:"metadata" json:"metadata"`
+	Currency      string            `bson:"currency" json:"currency"` // ISO 4217 code sales are made in
+	TaxRate       string            `bson:"tax_rate" json:"tax_rate"` // Decimal fraction, e.g. "0.0825"
+	Timezone      string            `bson:"timezone" json:"timezone"` // IANA name the hours are in; empty means UTC
+	Holidays      []Holiday         `bson:"holidays" json:"holidays"` // Dates the store is closed on
 	CreatedAt     time.Time         `bson:"created_at" json:"created_at"`
 	UpdatedAt     time.Time         `bson:"updated_at" json:"updated_at"`
 }
@@ -52,6 +56,12 @@ type StoreProduct struct {
 	StorePrice        string    `bson:"store_price" json:"store_price"` // Store-specific pricing (optional)
 	IsAvailable       bool      `bson:"is_available" json:"is_available"`
 	LastUpdated       time.Time `bson:"last_updated" json:"last_updated"`
+
+	// Quantity limits for one sale line: at least MinOrderQty, at most
+	// MaxOrderQty, in multiples of OrderQtyIncrement. Zero means no limit.
+	MinOrderQty       int32 `bson:"min_order_qty,omitempty" json:"min_order_qty,omitempty"`
+	MaxOrderQty       int32 `bson:"max_order_qty,omitempty" json:"max_order_qty,omitempty"`
+	OrderQtyIncrement int32 `bson:"order_qty_increment,omitempty" json:"order_qty_increment,omitempty"`
 }
 
 // ProductReservation represents a reserved product
@@ -84,7 +94,11 @@ type StoreSale struct {
 	SalesUserID    string            `bson:"sales_user_id" json:"sales_user_id"`           // Employee who made the sale
 	CustomerUserID string            `bson:"customer_user_id,omitempty" json:"customer_user_id,omitempty"` // Customer (optional)
 	Items          []StoreSaleItem   `bson:"items" json:"items"`
+	Subtotal       string            `bson:"subtotal" json:"subtotal"` // Before tax
+	TaxRate        string            `bson:"tax_rate" json:"tax_rate"`
+	TaxAmount      string            `bson:"tax_amount" json:"tax_amount"`
 	TotalAmount    string            `bson:"total_amount" json:"total_amount"`
+	ReceiptNumber  int64             `bson:"receipt_number" json:"receipt_number"` // Sequential per store
 	Currency       string            `bson:"currency" json:"currency"`
 	SaleType       string            `bson:"sale_type" json:"sale_type"` // WALK_IN, RESERVATION, ONLINE_PICKUP
 	SaleDate       time.Time         `bson:"sale_date" json:"sale_date"`
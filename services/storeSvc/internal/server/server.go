@@ -6,7 +6,13 @@ import (
 	"net"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/version"
+
+	productv1 "github.com/leonvanderhaeghen/stockplatform/services/productSvc/api/gen/go/proto/product/v1"
 	storev1 "github.com/leonvanderhaeghen/stockplatform/services/storeSvc/api/gen/go/proto/store/v1"
 	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/config"
 	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/database"
@@ -18,6 +24,8 @@ type Server struct {
 	config   *config.Config
 	database *database.Database
 	grpcSrv  *grpc.Server
+	products *grpc.ClientConn
+	sweeper  *service.ReservationSweeper
 }
 
 // New creates a new server instance
@@ -31,15 +39,35 @@ func New(cfg *config.Config, db *database.Database) *Server {
 // Start starts the gRPC server
 func (s *Server) Start() error {
 	// Create gRPC server
-	s.grpcSrv = grpc.NewServer()
+	s.grpcSrv = grpc.NewServer(
+		grpc.MaxRecvMsgSize(s.config.Server.MaxRecvMsgSize),
+		grpc.MaxSendMsgSize(s.config.Server.MaxSendMsgSize),
+	)
+
+	// Connect to the product service, which store catalogs read product data from
+	productConn, err := grpc.Dial(s.config.Services.ProductServiceAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(s.config.Server.MaxRecvMsgSize)),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to connect to product service: %w", err)
+	}
+	s.products = productConn
 
 	// Register store service
-	storeService, err := service.NewStoreService(s.database, s.config)
+	storeService, err := service.NewStoreService(s.database, s.config, productv1.NewProductServiceClient(productConn))
 	if err != nil {
 		return fmt.Errorf("failed to create store service: %w", err)
 	}
 	storev1.RegisterStoreServiceServer(s.grpcSrv, storeService)
 
+	// Expired reservations give their stock back in the background
+	s.sweeper = service.NewReservationSweeper(s.database, s.config.Reservations.ExpiryInterval, log.Default())
+	s.sweeper.Start()
+
+	// Register health check service; its responses carry the build version
+	grpc_health_v1.RegisterHealthServer(s.grpcSrv, version.NewHealthServer(health.NewServer()))
+
 	// Start listening
 	addr := fmt.Sprintf("%s:%s", s.config.Server.Host, s.config.Server.Port)
 	lis, err := net.Listen("tcp", addr)
@@ -63,4 +91,10 @@ func (s *Server) Stop() {
 		log.Println("Stopping gRPC server...")
 		s.grpcSrv.GracefulStop()
 	}
+	if s.sweeper != nil {
+		s.sweeper.Stop()
+	}
+	if s.products != nil {
+		s.products.Close()
+	}
 }
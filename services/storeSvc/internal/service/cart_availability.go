@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	storev1 "github.com/leonvanderhaeghen/stockplatform/services/storeSvc/api/gen/go/proto/store/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/models"
+)
+
+// CheckCartAvailability checks a whole cart against a store's stock with one
+// query, reporting per product whether the requested quantity is available
+func (s *StoreService) CheckCartAvailability(ctx context.Context, req *storev1.CheckCartAvailabilityRequest) (*storev1.CheckCartAvailabilityResponse, error) {
+	if err := validateStoreID(req.StoreId); err != nil {
+		return nil, err
+	}
+	if len(req.Items) == 0 {
+		return nil, fmt.Errorf("cart must have at least one item")
+	}
+
+	// Combine cart lines for the same product, keeping cart order
+	requested := make(map[string]int32, len(req.Items))
+	productIDs := make([]string, 0, len(req.Items))
+	for _, item := range req.Items {
+		if item.ProductId == "" {
+			return nil, fmt.Errorf("product ID is required for every cart item")
+		}
+		if item.Quantity <= 0 {
+			return nil, fmt.Errorf("quantity must be positive for product %s", item.ProductId)
+		}
+		if _, seen := requested[item.ProductId]; !seen {
+			productIDs = append(productIDs, item.ProductId)
+		}
+		requested[item.ProductId] += item.Quantity
+	}
+
+	cursor, err := s.db.GetCollection("store_products").Find(ctx, bson.M{
+		"store_id":   req.StoreId,
+		"product_id": bson.M{"$in": productIDs},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find store products: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var products []models.StoreProduct
+	if err := cursor.All(ctx, &products); err != nil {
+		return nil, fmt.Errorf("failed to decode store products: %w", err)
+	}
+
+	availableByProduct := make(map[string]int32, len(products))
+	for _, p := range products {
+		if p.IsAvailable {
+			availableByProduct[p.ProductID] = p.AvailableQuantity
+		}
+	}
+
+	resp := &storev1.CheckCartAvailabilityResponse{
+		AllAvailable: true,
+		Items:        make([]*storev1.CartItemAvailability, 0, len(productIDs)),
+	}
+	for _, productID := range productIDs {
+		item := &storev1.CartItemAvailability{
+			ProductId:         productID,
+			RequestedQuantity: requested[productID],
+			AvailableQuantity: availableByProduct[productID],
+		}
+		item.Available = item.AvailableQuantity >= item.RequestedQuantity
+		if !item.Available {
+			resp.AllAvailable = false
+		}
+		resp.Items = append(resp.Items, item)
+	}
+
+	return resp, nil
+}
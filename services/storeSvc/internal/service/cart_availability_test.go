@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+
+	storev1 "github.com/leonvanderhaeghen/stockplatform/services/storeSvc/api/gen/go/proto/store/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/config"
+	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/database"
+)
+
+const testStoreID = "6f1c2a8e-1d3b-4c5e-9f70-2a4b6c8d0e1f"
+
+// newMockStoreService returns a store service over the mock database of mt
+func newMockStoreService(mt *mtest.T) *StoreService {
+	cfg, err := config.Load()
+	if err != nil {
+		mt.Fatal(err)
+	}
+	service, err := NewStoreService(database.NewFromDatabase(mt.DB), cfg, nil)
+	if err != nil {
+		mt.Fatal(err)
+	}
+	return service
+}
+
+// storeProductsResponse is the reply to a find on store_products
+func storeProductsResponse(mt *mtest.T, docs ...bson.D) bson.D {
+	return mtest.CreateCursorResponse(0, mt.DB.Name()+".store_products", mtest.FirstBatch, docs...)
+}
+
+func storeProduct(productID string, available int32, isAvailable bool) bson.D {
+	return bson.D{
+		{Key: "store_id", Value: testStoreID},
+		{Key: "product_id", Value: productID},
+		{Key: "available_quantity", Value: available},
+		{Key: "is_available", Value: isAvailable},
+	}
+}
+
+func TestCheckCartAvailabilityMixedCart(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("mixed cart", func(mt *mtest.T) {
+		service := newMockStoreService(mt)
+		mt.AddMockResponses(storeProductsResponse(mt,
+			storeProduct("in-stock", 10, true),
+			storeProduct("short", 2, true),
+			storeProduct("unlisted", 50, false),
+		))
+
+		resp, err := service.CheckCartAvailability(context.Background(), &storev1.CheckCartAvailabilityRequest{
+			StoreId: testStoreID,
+			Items: []*storev1.CartItem{
+				{ProductId: "in-stock", Quantity: 4},
+				{ProductId: "short", Quantity: 3},
+				{ProductId: "in-stock", Quantity: 6},
+				{ProductId: "unlisted", Quantity: 1},
+				{ProductId: "unknown", Quantity: 1},
+			},
+		})
+		if err != nil {
+			mt.Fatal(err)
+		}
+
+		if resp.GetAllAvailable() {
+			mt.Error("cart with short items should not be all available")
+		}
+		want := []struct {
+			productID            string
+			requested, available int32
+			ok                   bool
+		}{
+			{"in-stock", 10, 10, true},
+			{"short", 3, 2, false},
+			{"unlisted", 1, 0, false},
+			{"unknown", 1, 0, false},
+		}
+		if len(resp.GetItems()) != len(want) {
+			mt.Fatalf("got %d items, want one per product in cart order", len(resp.GetItems()))
+		}
+		for i, w := range want {
+			got := resp.GetItems()[i]
+			if got.GetProductId() != w.productID || got.GetRequestedQuantity() != w.requested ||
+				got.GetAvailableQuantity() != w.available || got.GetAvailable() != w.ok {
+				mt.Errorf("item %d = %v, want %+v", i, got, w)
+			}
+		}
+
+		started := mt.GetAllStartedEvents()
+		if len(started) != 1 || started[0].CommandName != "find" {
+			mt.Fatalf("commands = %d, want a single find", len(started))
+		}
+		ids, err := started[0].Command.Lookup("filter", "product_id", "$in").Array().Values()
+		if err != nil || len(ids) != 4 {
+			mt.Fatalf("$in holds %d product IDs, want the 4 distinct ones", len(ids))
+		}
+	})
+
+	mt.Run("all available", func(mt *mtest.T) {
+		service := newMockStoreService(mt)
+		mt.AddMockResponses(storeProductsResponse(mt, storeProduct("in-stock", 10, true)))
+
+		resp, err := service.CheckCartAvailability(context.Background(), &storev1.CheckCartAvailabilityRequest{
+			StoreId: testStoreID,
+			Items:   []*storev1.CartItem{{ProductId: "in-stock", Quantity: 10}},
+		})
+		if err != nil {
+			mt.Fatal(err)
+		}
+		if !resp.GetAllAvailable() || !resp.GetItems()[0].GetAvailable() {
+			mt.Fatalf("cart should be available: %v", resp)
+		}
+	})
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errStoreNotFound is returned when no store has the requested ID
+var errStoreNotFound = status.Error(codes.NotFound, "store not found")
+
+// validateStoreID rejects a missing or malformed store ID with InvalidArgument
+// instead of looking it up. Store IDs are UUIDs.
+func validateStoreID(id string) error {
+	if id == "" {
+		return status.Error(codes.InvalidArgument, "store ID is required")
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%q is not a valid store ID", id)
+	}
+	return nil
+}
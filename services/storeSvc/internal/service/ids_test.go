@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storev1 "github.com/leonvanderhaeghen/stockplatform/services/storeSvc/api/gen/go/proto/store/v1"
+)
+
+func TestGetStoreRejectsMalformedIDs(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("malformed IDs never reach the database", func(mt *mtest.T) {
+		service := newMockStoreService(mt)
+
+		for _, id := range []string{"", "not-an-id", "507f1f77bcf86cd799439011", testStoreID + "x"} {
+			_, err := service.GetStore(context.Background(), &storev1.GetStoreRequest{Id: id})
+			if code := status.Code(err); code != codes.InvalidArgument {
+				mt.Errorf("GetStore(%q): code = %s, want InvalidArgument (err %v)", id, code, err)
+			}
+		}
+		if started := mt.GetAllStartedEvents(); len(started) != 0 {
+			mt.Fatalf("%d commands sent to the database, want none", len(started))
+		}
+	})
+
+	mt.Run("unknown store is not found", func(mt *mtest.T) {
+		service := newMockStoreService(mt)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, mt.DB.Name()+".stores", mtest.FirstBatch))
+
+		_, err := service.GetStore(context.Background(), &storev1.GetStoreRequest{Id: testStoreID})
+		if code := status.Code(err); code != codes.NotFound {
+			mt.Fatalf("code = %s, want NotFound (err %v)", code, err)
+		}
+	})
+}
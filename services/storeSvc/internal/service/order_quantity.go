@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/models"
+)
+
+// validateOrderQuantityLimits rejects limits that no quantity could satisfy
+func validateOrderQuantityLimits(p *models.StoreProduct) error {
+	switch {
+	case p.MinOrderQty < 0 || p.MaxOrderQty < 0 || p.OrderQtyIncrement < 0:
+		return status.Error(codes.InvalidArgument, "order quantity limits cannot be negative")
+	case p.MaxOrderQty > 0 && p.MaxOrderQty < p.MinOrderQty:
+		return status.Errorf(codes.InvalidArgument, "maximum order quantity %d is below the minimum %d", p.MaxOrderQty, p.MinOrderQty)
+	}
+	return nil
+}
+
+// checkOrderQuantities rejects the first sale line whose quantity breaks the
+// limits of its store product with InvalidArgument. Products the store does
+// not list have no limits.
+func (s *StoreService) checkOrderQuantities(ctx context.Context, storeID string, items []models.StoreSaleItem) error {
+	productIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		productIDs = append(productIDs, item.ProductID)
+	}
+
+	cursor, err := s.db.GetCollection("store_products").Find(ctx, bson.M{
+		"store_id":   storeID,
+		"product_id": bson.M{"$in": productIDs},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find store products: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var products []models.StoreProduct
+	if err := cursor.All(ctx, &products); err != nil {
+		return fmt.Errorf("failed to decode store products: %w", err)
+	}
+	limits := make(map[string]models.StoreProduct, len(products))
+	for _, p := range products {
+		limits[p.ProductID] = p
+	}
+
+	for _, item := range items {
+		p := limits[item.ProductID]
+		switch {
+		case p.MinOrderQty > 0 && item.Quantity < p.MinOrderQty:
+			return status.Errorf(codes.InvalidArgument, "quantity %d of product %s is below the minimum order quantity of %d",
+				item.Quantity, item.ProductID, p.MinOrderQty)
+		case p.MaxOrderQty > 0 && item.Quantity > p.MaxOrderQty:
+			return status.Errorf(codes.InvalidArgument, "quantity %d of product %s exceeds the maximum order quantity of %d",
+				item.Quantity, item.ProductID, p.MaxOrderQty)
+		case p.OrderQtyIncrement > 1 && item.Quantity%p.OrderQtyIncrement != 0:
+			return status.Errorf(codes.InvalidArgument, "quantity %d of product %s is not a multiple of %d",
+				item.Quantity, item.ProductID, p.OrderQtyIncrement)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/models"
+)
+
+func TestCheckOrderQuantities(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	casePack := bson.D{
+		{Key: "store_id", Value: testStoreID},
+		{Key: "product_id", Value: "case-pack"},
+		{Key: "min_order_qty", Value: int32(12)},
+		{Key: "max_order_qty", Value: int32(120)},
+		{Key: "order_qty_increment", Value: int32(6)},
+	}
+
+	tests := []struct {
+		name     string
+		quantity int32
+		wantCode codes.Code
+	}{
+		{name: "below minimum", quantity: 6, wantCode: codes.InvalidArgument},
+		{name: "above maximum", quantity: 126, wantCode: codes.InvalidArgument},
+		{name: "not a multiple", quantity: 15, wantCode: codes.InvalidArgument},
+		{name: "within limits", quantity: 24, wantCode: codes.OK},
+	}
+	for _, tt := range tests {
+		mt.Run(tt.name, func(mt *mtest.T) {
+			service := newMockStoreService(mt)
+			mt.AddMockResponses(storeProductsResponse(mt, casePack))
+
+			err := service.checkOrderQuantities(context.Background(), testStoreID, []models.StoreSaleItem{
+				{ProductID: "case-pack", Quantity: tt.quantity},
+				{ProductID: "unlisted", Quantity: 1},
+			})
+			if code := status.Code(err); code != tt.wantCode {
+				mt.Fatalf("quantity %d: code = %s, want %s (err %v)", tt.quantity, code, tt.wantCode, err)
+			}
+		})
+	}
+}
+
+func TestValidateOrderQuantityLimits(t *testing.T) {
+	valid := &models.StoreProduct{MinOrderQty: 12, MaxOrderQty: 120, OrderQtyIncrement: 6}
+	if err := validateOrderQuantityLimits(valid); err != nil {
+		t.Fatalf("valid limits: %v", err)
+	}
+	for _, p := range []*models.StoreProduct{
+		{MinOrderQty: -1},
+		{MinOrderQty: 10, MaxOrderQty: 5},
+	} {
+		if code := status.Code(validateOrderQuantityLimits(p)); code != codes.InvalidArgument {
+			t.Errorf("limits %+v: code = %s, want InvalidArgument", p, code)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/database"
+	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/models"
+)
+
+// Reservation statuses
+const (
+	ReservationStatusActive  = "ACTIVE"
+	ReservationStatusExpired = "EXPIRED"
+)
+
+// expirySweepBatchSize bounds the reservations one sweep query loads
+const expirySweepBatchSize = 500
+
+// ReservationSweeper periodically expires active reservations past their
+// ExpiresAt and gives their quantity back to the store product's available
+// stock, so lapsed reservations stop holding stock.
+type ReservationSweeper struct {
+	db       *database.Database
+	interval time.Duration
+	logger   *log.Logger
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewReservationSweeper creates a sweeper that runs every interval and logs
+// to logger
+func NewReservationSweeper(db *database.Database, interval time.Duration, logger *log.Logger) *ReservationSweeper {
+	return &ReservationSweeper{
+		db:       db,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Start runs the sweeper in the background until Stop is called. A zero
+// interval leaves it off.
+func (s *ReservationSweeper) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cancel != nil || s.interval <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := s.Sweep(ctx); err != nil && ctx.Err() == nil {
+					s.logger.Printf("Reservation expiry sweep failed: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// Stop stops the sweeper and waits for a running sweep to finish
+func (s *ReservationSweeper) Stop() {
+	s.mu.Lock()
+	cancel := s.cancel
+	s.cancel = nil
+	s.mu.Unlock()
+	if cancel == nil {
+		return
+	}
+	cancel()
+	s.wg.Wait()
+}
+
+// Sweep expires every active reservation past its expiry and returns how many
+// it expired. Each reservation is claimed by moving it from ACTIVE to EXPIRED
+// with a status guard, and its quantity is returned to stock in the same
+// transaction, so a reservation is never expired without its stock coming
+// back. A reservation completed or cancelled meanwhile, or claimed by another
+// sweeper, is left alone and its stock is not counted twice. Transactions need
+// MongoDB running as a replica set.
+func (s *ReservationSweeper) Sweep(ctx context.Context) (int, error) {
+	reservations := s.db.GetCollection("reservations")
+
+	session, err := reservations.Database().Client().StartSession()
+	if err != nil {
+		return 0, fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	expired := 0
+	for {
+		now := time.Now()
+		cursor, err := reservations.Find(ctx,
+			bson.M{"status": ReservationStatusActive, "expires_at": bson.M{"$lte": now}},
+			options.Find().SetLimit(expirySweepBatchSize),
+		)
+		if err != nil {
+			return expired, fmt.Errorf("failed to find expired reservations: %w", err)
+		}
+		var batch []models.ProductReservation
+		if err := cursor.All(ctx, &batch); err != nil {
+			return expired, fmt.Errorf("failed to decode expired reservations: %w", err)
+		}
+
+		for _, r := range batch {
+			claimed, err := session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+				return s.expire(sc, r, now)
+			})
+			if err != nil {
+				// The transaction left the reservation active, so the next
+				// sweep tries it again
+				return expired, fmt.Errorf("failed to expire reservation %s (store %s, product %s, quantity %d): %w",
+					r.ID, r.StoreID, r.ProductID, r.Quantity, err)
+			}
+			if claimed.(bool) {
+				expired++
+			}
+		}
+
+		if len(batch) < expirySweepBatchSize {
+			break
+		}
+	}
+
+	if expired > 0 {
+		s.logger.Printf("Expired %d store reservations", expired)
+	}
+	return expired, nil
+}
+
+// expire claims reservation r for this sweep and returns its quantity to the
+// store product's stock. It must run in a transaction. It reports false when r
+// is no longer active.
+func (s *ReservationSweeper) expire(ctx mongo.SessionContext, r models.ProductReservation, now time.Time) (bool, error) {
+	claimed, err := s.db.GetCollection("reservations").UpdateOne(ctx,
+		bson.M{"_id": r.ID, "status": ReservationStatusActive},
+		bson.M{"$set": bson.M{"status": ReservationStatusExpired}},
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim reservation: %w", err)
+	}
+	if claimed.ModifiedCount == 0 {
+		return false, nil
+	}
+
+	_, err = s.db.GetCollection("store_products").UpdateOne(ctx,
+		bson.M{"store_id": r.StoreID, "product_id": r.ProductID},
+		bson.M{
+			"$inc": bson.M{
+				"reserved_quantity":  -r.Quantity,
+				"available_quantity": r.Quantity,
+			},
+			"$set": bson.M{"last_updated": now},
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to return stock: %w", err)
+	}
+	return true, nil
+}
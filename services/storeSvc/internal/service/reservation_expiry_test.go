@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/database"
+)
+
+// expiredReservationResponse is the reply to the sweep's find: one active
+// reservation of 3 desks that expired an hour ago
+func expiredReservationResponse(mt *mtest.T) bson.D {
+	return mtest.CreateCursorResponse(0, mt.DB.Name()+".reservations", mtest.FirstBatch, bson.D{
+		{Key: "_id", Value: "reservation-1"},
+		{Key: "store_id", Value: testStoreID},
+		{Key: "product_id", Value: "desk"},
+		{Key: "quantity", Value: int32(3)},
+		{Key: "status", Value: ReservationStatusActive},
+		{Key: "expires_at", Value: time.Now().Add(-time.Hour)},
+	})
+}
+
+// discardLogger drops what the sweeper logs
+var discardLogger = log.New(io.Discard, "", 0)
+
+// commitResponse is the reply to committing a sweep's transaction
+func commitResponse() bson.D {
+	return mtest.CreateSuccessResponse()
+}
+
+func noReservationsResponse(mt *mtest.T) bson.D {
+	return mtest.CreateCursorResponse(0, mt.DB.Name()+".reservations", mtest.FirstBatch)
+}
+
+// updateResponse is the reply to an update that modified modified documents
+func updateResponse(modified int32) bson.D {
+	return mtest.CreateSuccessResponse(bson.E{Key: "n", Value: modified}, bson.E{Key: "nModified", Value: modified})
+}
+
+// stockReturns returns the updates sent to store_products
+func stockReturns(mt *mtest.T) []bson.Raw {
+	var updates []bson.Raw
+	for _, event := range mt.GetAllStartedEvents() {
+		if event.CommandName == "update" && event.Command.Lookup("update").StringValue() == "store_products" {
+			updates = append(updates, event.Command)
+		}
+	}
+	return updates
+}
+
+func TestReservationSweeper(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("returns the stock of an expired reservation once", func(mt *mtest.T) {
+		sweeper := NewReservationSweeper(database.NewFromDatabase(mt.DB), time.Minute, discardLogger)
+		mt.AddMockResponses(
+			expiredReservationResponse(mt),
+			updateResponse(1),
+			updateResponse(1),
+			commitResponse(),
+			noReservationsResponse(mt),
+		)
+
+		expired, err := sweeper.Sweep(context.Background())
+		if err != nil {
+			mt.Fatal(err)
+		}
+		if expired != 1 {
+			mt.Fatalf("expired = %d, want 1", expired)
+		}
+		again, err := sweeper.Sweep(context.Background())
+		if err != nil {
+			mt.Fatal(err)
+		}
+		if again != 0 {
+			mt.Fatalf("second sweep expired %d, want 0", again)
+		}
+
+		events := mt.GetAllStartedEvents()
+		find := events[0].Command.Lookup("filter").Document()
+		if status, _ := find.Lookup("status").StringValueOK(); status != ReservationStatusActive {
+			mt.Errorf("find filter = %v, want active reservations only", find)
+		}
+		if _, err := find.LookupErr("expires_at", "$lte"); err != nil {
+			mt.Errorf("find filter = %v, want reservations past their expiry", find)
+		}
+
+		claim := events[1].Command.Lookup("updates").Array().Index(0).Value().Document()
+		if status, _ := claim.Lookup("q", "status").StringValueOK(); status != ReservationStatusActive {
+			mt.Errorf("claim filter = %v, want it guarded on the active status", claim.Lookup("q"))
+		}
+		if status, _ := claim.Lookup("u", "$set", "status").StringValueOK(); status != ReservationStatusExpired {
+			mt.Errorf("claim update = %v, want the reservation expired", claim.Lookup("u"))
+		}
+
+		returns := stockReturns(mt)
+		if len(returns) != 1 {
+			mt.Fatalf("stock returned %d times, want once", len(returns))
+		}
+		statement := returns[0].Lookup("updates").Array().Index(0).Value().Document()
+		if product, _ := statement.Lookup("q", "product_id").StringValueOK(); product != "desk" {
+			mt.Errorf("stock returned to %v, want the desk", statement.Lookup("q"))
+		}
+		if reserved := statement.Lookup("u", "$inc", "reserved_quantity").Int32(); reserved != -3 {
+			mt.Errorf("reserved_quantity changed by %d, want -3", reserved)
+		}
+		if available := statement.Lookup("u", "$inc", "available_quantity").Int32(); available != 3 {
+			mt.Errorf("available_quantity changed by %d, want 3", available)
+		}
+	})
+
+	mt.Run("a reservation completed meanwhile keeps its stock", func(mt *mtest.T) {
+		sweeper := NewReservationSweeper(database.NewFromDatabase(mt.DB), time.Minute, discardLogger)
+		mt.AddMockResponses(expiredReservationResponse(mt), updateResponse(0), commitResponse())
+
+		expired, err := sweeper.Sweep(context.Background())
+		if err != nil {
+			mt.Fatal(err)
+		}
+		if expired != 0 {
+			mt.Fatalf("expired = %d, want 0", expired)
+		}
+		if returns := stockReturns(mt); len(returns) != 0 {
+			mt.Fatalf("stock returned %d times, want never", len(returns))
+		}
+	})
+
+	mt.Run("two sweeps finding the same reservation return its stock once", func(mt *mtest.T) {
+		db := database.NewFromDatabase(mt.DB)
+		first, second := NewReservationSweeper(db, time.Minute, discardLogger), NewReservationSweeper(db, time.Minute, discardLogger)
+		mt.AddMockResponses(
+			expiredReservationResponse(mt),
+			updateResponse(1),
+			updateResponse(1),
+			commitResponse(),
+			expiredReservationResponse(mt), // read before the first claim was visible
+			updateResponse(0),
+			commitResponse(),
+		)
+
+		firstExpired, err := first.Sweep(context.Background())
+		if err != nil {
+			mt.Fatal(err)
+		}
+		secondExpired, err := second.Sweep(context.Background())
+		if err != nil {
+			mt.Fatal(err)
+		}
+		if firstExpired+secondExpired != 1 {
+			mt.Fatalf("expired %d and %d, want the reservation expired once", firstExpired, secondExpired)
+		}
+		if returns := stockReturns(mt); len(returns) != 1 {
+			mt.Fatalf("stock returned %d times, want once", len(returns))
+		}
+	})
+
+	mt.Run("a failed stock return keeps the reservation active", func(mt *mtest.T) {
+		sweeper := NewReservationSweeper(database.NewFromDatabase(mt.DB), time.Minute, discardLogger)
+		mt.AddMockResponses(
+			expiredReservationResponse(mt),
+			updateResponse(1),
+			mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 11600, Message: "interrupted"}),
+			mtest.CreateSuccessResponse(), // abortTransaction
+		)
+
+		expired, err := sweeper.Sweep(context.Background())
+		if err == nil {
+			mt.Fatal("want the failed stock return reported")
+		}
+		if expired != 0 {
+			mt.Fatalf("expired = %d, want 0", expired)
+		}
+
+		var commands []string
+		for _, event := range mt.GetAllStartedEvents() {
+			commands = append(commands, event.CommandName)
+		}
+		if commands[len(commands)-1] != "abortTransaction" {
+			mt.Fatalf("commands = %v, want the claim rolled back", commands)
+		}
+		claim := mt.GetAllStartedEvents()[1].Command
+		if _, err := claim.LookupErr("startTransaction"); err != nil {
+			mt.Fatalf("claim = %v, want it made in the transaction", claim)
+		}
+	})
+}
+
+func TestReservationSweeperOff(t *testing.T) {
+	sweeper := NewReservationSweeper(nil, 0, discardLogger)
+	sweeper.Start()
+	if sweeper.cancel != nil {
+		t.Fatal("a zero interval should leave the sweeper off")
+	}
+	sweeper.Stop()
+}
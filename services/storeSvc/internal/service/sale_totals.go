@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	storev1 "github.com/leonvanderhaeghen/stockplatform/services/storeSvc/api/gen/go/proto/store/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/config"
+	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/models"
+)
+
+// saleTotals holds the amounts of a sale as decimal strings with two decimals
+type saleTotals struct {
+	Subtotal  string
+	TaxAmount string
+	Total     string
+}
+
+// parseDecimal parses a decimal string exactly, avoiding float rounding on money
+func parseDecimal(value string) (*big.Rat, bool) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+	return r, ok
+}
+
+// validateTaxRate checks that a tax rate is a decimal fraction in [0, 1)
+func validateTaxRate(rate string) error {
+	if rate == "" {
+		return nil
+	}
+	r, ok := parseDecimal(rate)
+	if !ok || r.Sign() < 0 || r.Cmp(big.NewRat(1, 1)) >= 0 {
+		return fmt.Errorf("invalid tax rate %q: must be a decimal fraction between 0 and 1", rate)
+	}
+	return nil
+}
+
+// validateCurrency checks a currency against the configured supported list
+func (s *StoreService) validateCurrency(currency string) error {
+	for _, supported := range s.config.Sales.SupportedCurrencies {
+		if currency == supported {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported currency %q: supported currencies are %s",
+		currency, strings.Join(s.config.Sales.SupportedCurrencies, ", "))
+}
+
+// roundCents rounds value to the nearest cent. Values exactly halfway between
+// two cents are resolved by mode.
+func roundCents(value *big.Rat, mode config.RoundingMode) *big.Rat {
+	scaled := new(big.Rat).Mul(value, big.NewRat(100, 1))
+	cents, rem := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+
+	// Compare the dropped fraction with one half
+	twice := new(big.Int).Lsh(new(big.Int).Abs(rem), 1)
+	cmp := twice.Cmp(scaled.Denom())
+	if cmp > 0 || (cmp == 0 && (mode != config.RoundHalfEven || cents.Bit(0) == 1)) {
+		if scaled.Sign() < 0 {
+			cents.Sub(cents, big.NewInt(1))
+		} else {
+			cents.Add(cents, big.NewInt(1))
+		}
+	}
+	return new(big.Rat).SetFrac(cents, big.NewInt(100))
+}
+
+// computeSaleTotals sets each item's subtotal from its unit price and
+// quantity and applies taxRate to the sum. Amounts are kept exact and rounded
+// to cents with mode in three places only: each item subtotal, the sale
+// subtotal (the sum of the exact item amounts) and the tax, which is computed
+// once on the rounded sale subtotal. The total is the rounded subtotal plus
+// the rounded tax and needs no rounding of its own.
+func computeSaleTotals(items []models.StoreSaleItem, taxRate string, mode config.RoundingMode) (saleTotals, error) {
+	subtotal := new(big.Rat)
+	for i := range items {
+		price, ok := parseDecimal(items[i].UnitPrice)
+		if !ok || price.Sign() < 0 {
+			return saleTotals{}, fmt.Errorf("invalid unit price %q for product %s", items[i].UnitPrice, items[i].ProductID)
+		}
+		if items[i].Quantity <= 0 {
+			return saleTotals{}, fmt.Errorf("quantity must be positive for product %s", items[i].ProductID)
+		}
+		line := new(big.Rat).Mul(price, big.NewRat(int64(items[i].Quantity), 1))
+		items[i].Subtotal = roundCents(line, mode).FloatString(2)
+		subtotal.Add(subtotal, line)
+	}
+
+	rate := new(big.Rat)
+	if taxRate != "" {
+		if err := validateTaxRate(taxRate); err != nil {
+			return saleTotals{}, err
+		}
+		rate, _ = parseDecimal(taxRate)
+	}
+
+	// Round the subtotal first so that subtotal + tax adds up on the receipt
+	subtotal = roundCents(subtotal, mode)
+	tax := roundCents(new(big.Rat).Mul(subtotal, rate), mode)
+	total := new(big.Rat).Add(subtotal, tax)
+
+	return saleTotals{
+		Subtotal:  subtotal.FloatString(2),
+		TaxAmount: tax.FloatString(2),
+		Total:     total.FloatString(2),
+	}, nil
+}
+
+// nextReceiptNumber atomically takes the next receipt number of a store. A
+// number taken by a sale that then fails to save is skipped, never reused.
+func (s *StoreService) nextReceiptNumber(ctx context.Context, storeID string) (int64, error) {
+	return takeReceiptNumber(ctx, s.db.GetCollection("receipt_counters"), storeID)
+}
+
+// takeReceiptNumber increments and returns the counter of storeID in a single
+// findAndModify, so concurrent sales never read the same value
+func takeReceiptNumber(ctx context.Context, counters *mongo.Collection, storeID string) (int64, error) {
+	var counter struct {
+		Seq int64 `bson:"seq"`
+	}
+	err := counters.FindOneAndUpdate(ctx,
+		bson.M{"_id": storeID},
+		bson.M{"$inc": bson.M{"seq": 1}},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&counter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to allocate receipt number: %w", err)
+	}
+	return counter.Seq, nil
+}
+
+// buildReceipt assembles the printable receipt of a sale made at store
+func buildReceipt(store *models.Store, sale *models.StoreSale) *storev1.Receipt {
+	protoSale := convertSaleToProto(sale)
+	return &storev1.Receipt{
+		ReceiptNumber: sale.ReceiptNumber,
+		SaleId:        sale.ID,
+		StoreId:       store.ID,
+		StoreName:     store.Name,
+		StoreAddress:  convertAddressToProto(&store.Address),
+		StorePhone:    store.Phone,
+		SalesUserId:   sale.SalesUserID,
+		IssuedAt:      protoSale.SaleDate,
+		Lines:         protoSale.Items,
+		Subtotal:      sale.Subtotal,
+		TaxRate:       sale.TaxRate,
+		TaxAmount:     sale.TaxAmount,
+		Total:         sale.TotalAmount,
+		Currency:      sale.Currency,
+	}
+}
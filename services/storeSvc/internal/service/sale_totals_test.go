@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/config"
+	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/models"
+)
+
+func TestComputeSaleTotals(t *testing.T) {
+	tests := []struct {
+		name      string
+		items     []models.StoreSaleItem
+		taxRate   string
+		subtotals []string
+		want      saleTotals
+	}{
+		{
+			name: "tax on the subtotal",
+			items: []models.StoreSaleItem{
+				{ProductID: "p1", UnitPrice: "9.99", Quantity: 3},
+				{ProductID: "p2", UnitPrice: "0.50", Quantity: 1},
+			},
+			taxRate:   "0.21",
+			subtotals: []string{"29.97", "0.50"},
+			want:      saleTotals{Subtotal: "30.47", TaxAmount: "6.40", Total: "36.87"},
+		},
+		{
+			name:      "no tax rate",
+			items:     []models.StoreSaleItem{{ProductID: "p1", UnitPrice: "4.25", Quantity: 2}},
+			subtotals: []string{"8.50"},
+			want:      saleTotals{Subtotal: "8.50", TaxAmount: "0.00", Total: "8.50"},
+		},
+		{
+			// Item amounts are summed exactly before rounding, so three items
+			// of 0.333 add up to 1.00 rather than 3 x 0.33
+			name: "subtotal summed before rounding",
+			items: []models.StoreSaleItem{
+				{ProductID: "p1", UnitPrice: "0.333", Quantity: 1},
+				{ProductID: "p2", UnitPrice: "0.333", Quantity: 1},
+				{ProductID: "p3", UnitPrice: "0.334", Quantity: 1},
+			},
+			taxRate:   "0.1",
+			subtotals: []string{"0.33", "0.33", "0.33"},
+			want:      saleTotals{Subtotal: "1.00", TaxAmount: "0.10", Total: "1.10"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := computeSaleTotals(tt.items, tt.taxRate, config.RoundHalfUp)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Fatalf("totals = %+v, want %+v", got, tt.want)
+			}
+			for i, item := range tt.items {
+				if item.Subtotal != tt.subtotals[i] {
+					t.Errorf("item %d subtotal = %s, want %s", i, item.Subtotal, tt.subtotals[i])
+				}
+			}
+		})
+	}
+}
+
+func TestComputeSaleTotalsRejectsInvalidInput(t *testing.T) {
+	tests := []struct {
+		name    string
+		item    models.StoreSaleItem
+		taxRate string
+	}{
+		{name: "tax rate of one", item: models.StoreSaleItem{UnitPrice: "1.00", Quantity: 1}, taxRate: "1"},
+		{name: "negative tax rate", item: models.StoreSaleItem{UnitPrice: "1.00", Quantity: 1}, taxRate: "-0.1"},
+		{name: "tax rate not a number", item: models.StoreSaleItem{UnitPrice: "1.00", Quantity: 1}, taxRate: "21%"},
+		{name: "negative unit price", item: models.StoreSaleItem{UnitPrice: "-1.00", Quantity: 1}},
+		{name: "unit price not a number", item: models.StoreSaleItem{UnitPrice: "abc", Quantity: 1}},
+		{name: "zero quantity", item: models.StoreSaleItem{UnitPrice: "1.00", Quantity: 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := computeSaleTotals([]models.StoreSaleItem{tt.item}, tt.taxRate, config.RoundHalfUp)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+// 2.125 lies exactly halfway between two cents: half-up rounds it away from
+// zero, banker's rounding to the even cent
+func TestRoundCentsModes(t *testing.T) {
+	tests := []struct {
+		value    string
+		halfUp   string
+		halfEven string
+	}{
+		{value: "2.125", halfUp: "2.13", halfEven: "2.12"},
+		{value: "2.135", halfUp: "2.14", halfEven: "2.14"},
+		{value: "-2.125", halfUp: "-2.13", halfEven: "-2.12"},
+		{value: "2.1251", halfUp: "2.13", halfEven: "2.13"},
+		{value: "2.124", halfUp: "2.12", halfEven: "2.12"},
+		{value: "2.12", halfUp: "2.12", halfEven: "2.12"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			value, ok := parseDecimal(tt.value)
+			if !ok {
+				t.Fatalf("invalid decimal %q", tt.value)
+			}
+			if got := roundCents(value, config.RoundHalfUp).FloatString(2); got != tt.halfUp {
+				t.Errorf("half-up = %s, want %s", got, tt.halfUp)
+			}
+			if got := roundCents(value, config.RoundHalfEven).FloatString(2); got != tt.halfEven {
+				t.Errorf("half-even = %s, want %s", got, tt.halfEven)
+			}
+		})
+	}
+}
+
+func TestComputeSaleTotalsRoundingMode(t *testing.T) {
+	// 8.50 at 25% is a tax of exactly 2.125
+	items := func() []models.StoreSaleItem {
+		return []models.StoreSaleItem{{ProductID: "p1", UnitPrice: "4.25", Quantity: 2}}
+	}
+
+	halfUp, err := computeSaleTotals(items(), "0.25", config.RoundHalfUp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (saleTotals{Subtotal: "8.50", TaxAmount: "2.13", Total: "10.63"}); halfUp != want {
+		t.Errorf("half-up totals = %+v, want %+v", halfUp, want)
+	}
+
+	halfEven, err := computeSaleTotals(items(), "0.25", config.RoundHalfEven)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (saleTotals{Subtotal: "8.50", TaxAmount: "2.12", Total: "10.62"}); halfEven != want {
+		t.Errorf("half-even totals = %+v, want %+v", halfEven, want)
+	}
+
+	// Item subtotals are rounded with the same mode
+	line := []models.StoreSaleItem{{ProductID: "p1", UnitPrice: "2.125", Quantity: 1}}
+	if _, err := computeSaleTotals(line, "", config.RoundHalfEven); err != nil {
+		t.Fatal(err)
+	}
+	if line[0].Subtotal != "2.12" {
+		t.Errorf("half-even item subtotal = %s, want 2.12", line[0].Subtotal)
+	}
+}
+
+// Concurrent sales get unique receipt numbers because the counter is
+// incremented and read back by the server in one findAndModify; there is no
+// separate read a second sale could interleave with.
+func TestTakeReceiptNumberIncrementsAtomically(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("one findAndModify per receipt", func(mt *mtest.T) {
+		for seq := int64(1); seq <= 3; seq++ {
+			mt.AddMockResponses(bson.D{
+				{Key: "ok", Value: 1},
+				{Key: "value", Value: bson.D{{Key: "_id", Value: "store-1"}, {Key: "seq", Value: seq}}},
+			})
+		}
+
+		seen := make(map[int64]bool)
+		for i := 0; i < 3; i++ {
+			n, err := takeReceiptNumber(context.Background(), mt.Coll, "store-1")
+			if err != nil {
+				mt.Fatal(err)
+			}
+			if seen[n] {
+				mt.Fatalf("receipt number %d handed out twice", n)
+			}
+			seen[n] = true
+		}
+
+		started := mt.GetAllStartedEvents()
+		if len(started) != 3 {
+			mt.Fatalf("commands = %d, want one per receipt", len(started))
+		}
+		for _, e := range started {
+			if e.CommandName != "findAndModify" {
+				mt.Fatalf("command = %s, want findAndModify", e.CommandName)
+			}
+			cmd := e.Command
+			if id := cmd.Lookup("query", "_id").StringValue(); id != "store-1" {
+				mt.Errorf("counter _id = %q, want the store ID", id)
+			}
+			if inc := cmd.Lookup("update", "$inc", "seq").Int32(); inc != 1 {
+				mt.Errorf("$inc seq = %d, want 1", inc)
+			}
+			if !cmd.Lookup("upsert").Boolean() || !cmd.Lookup("new").Boolean() {
+				mt.Errorf("counter should be upserted and read back after the update: %s", cmd)
+			}
+		}
+	})
+
+	mt.Run("error", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 11000, Message: "duplicate key"}))
+
+		if _, err := takeReceiptNumber(context.Background(), mt.Coll, "store-1"); err == nil {
+			mt.Fatal("expected an error")
+		}
+	})
+}
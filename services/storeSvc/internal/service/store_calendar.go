@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	storev1 "github.com/leonvanderhaeghen/stockplatform/services/storeSvc/api/gen/go/proto/store/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/models"
+)
+
+// UpdateStoreCalendar replaces a store's timezone and holidays
+func (s *StoreService) UpdateStoreCalendar(ctx context.Context, req *storev1.UpdateStoreCalendarRequest) (*storev1.UpdateStoreCalendarResponse, error) {
+	if err := validateStoreID(req.StoreId); err != nil {
+		return nil, err
+	}
+	holidays := convertHolidaysFromProto(req.Holidays)
+	if err := models.ValidateTimezone(req.Timezone); err != nil {
+		return nil, err
+	}
+	if err := models.ValidateHolidays(holidays); err != nil {
+		return nil, err
+	}
+
+	var store models.Store
+	err := s.db.GetCollection("stores").FindOneAndUpdate(ctx,
+		bson.M{"_id": req.StoreId},
+		bson.M{"$set": bson.M{
+			"timezone":   req.Timezone,
+			"holidays":   holidays,
+			"updated_at": time.Now(),
+		}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&store)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errStoreNotFound
+		}
+		return nil, fmt.Errorf("failed to update store calendar: %w", err)
+	}
+
+	return &storev1.UpdateStoreCalendarResponse{
+		Store: convertStoreToProto(&store),
+	}, nil
+}
+
+// CheckStoreOpen reports whether a store is open at a given time, in its own
+// timezone and taking its holidays into account, and when a lead time of
+// business days from then ends
+func (s *StoreService) CheckStoreOpen(ctx context.Context, req *storev1.CheckStoreOpenRequest) (*storev1.CheckStoreOpenResponse, error) {
+	if err := validateStoreID(req.StoreId); err != nil {
+		return nil, err
+	}
+	if req.LeadTimeDays < 0 {
+		return nil, fmt.Errorf("lead time must not be negative")
+	}
+
+	var store models.Store
+	err := s.db.GetCollection("stores").FindOne(ctx, bson.M{"_id": req.StoreId}).Decode(&store)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errStoreNotFound
+		}
+		return nil, fmt.Errorf("failed to get store: %w", err)
+	}
+
+	at := time.Now()
+	if req.At != nil {
+		at = req.At.AsTime()
+	}
+
+	resp := &storev1.CheckStoreOpenResponse{
+		IsOpen:    store.IsOpen(at),
+		LocalTime: at.In(store.Location()).Format(time.RFC3339),
+	}
+	if holiday, ok := store.HolidayOn(at); ok {
+		resp.Holiday = holiday.Name
+	}
+	if req.LeadTimeDays > 0 {
+		resp.ReadyBy = timestamppb.New(store.AddBusinessDays(at, int(req.LeadTimeDays)))
+	}
+	return resp, nil
+}
+
+func convertHolidaysToProto(holidays []models.Holiday) []*storev1.Holiday {
+	protoHolidays := make([]*storev1.Holiday, len(holidays))
+	for i, h := range holidays {
+		protoHolidays[i] = &storev1.Holiday{Date: h.Date, Name: h.Name}
+	}
+	return protoHolidays
+}
+
+func convertHolidaysFromProto(holidays []*storev1.Holiday) []models.Holiday {
+	if len(holidays) == 0 {
+		return nil
+	}
+	result := make([]models.Holiday, len(holidays))
+	for i, h := range holidays {
+		result[i] = models.Holiday{Date: h.Date, Name: h.Name}
+	}
+	return result
+}
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	productv1 "github.com/leonvanderhaeghen/stockplatform/services/productSvc/api/gen/go/proto/product/v1"
+	storev1 "github.com/leonvanderhaeghen/stockplatform/services/storeSvc/api/gen/go/proto/store/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/models"
+)
+
+const (
+	// defaultCatalogLimit is the page size of a catalog request without a limit
+	defaultCatalogLimit = 50
+	// maxCatalogLimit bounds the page size of a catalog request
+	maxCatalogLimit = 500
+	// productBatchSize is the most IDs one BatchGetProducts call accepts
+	productBatchSize = 500
+)
+
+// ListStoreCatalog lists the products a store sells: those it stocks, has
+// marked available and has units of, that are also active and published in
+// the product catalog. Each product carries the store's effective price and
+// availability. Results are sorted by name and paginated.
+func (s *StoreService) ListStoreCatalog(ctx context.Context, req *storev1.ListStoreCatalogRequest) (*storev1.ListStoreCatalogResponse, error) {
+	if err := validateStoreID(req.StoreId); err != nil {
+		return nil, err
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultCatalogLimit
+	}
+	if limit > maxCatalogLimit {
+		limit = maxCatalogLimit
+	}
+	offset := max(req.Offset, 0)
+
+	filter, err := newCatalogFilter(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.db.GetCollection("stores").FindOne(ctx, bson.M{"_id": req.StoreId}).Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errStoreNotFound
+		}
+		return nil, fmt.Errorf("failed to get store: %w", err)
+	}
+
+	cursor, err := s.db.GetCollection("store_products").Find(ctx, bson.M{
+		"store_id":           req.StoreId,
+		"is_available":       true,
+		"available_quantity": bson.M{"$gt": 0},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find store products: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var stocked []models.StoreProduct
+	if err := cursor.All(ctx, &stocked); err != nil {
+		return nil, fmt.Errorf("failed to decode store products: %w", err)
+	}
+
+	products, err := s.getProducts(ctx, stocked)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*storev1.StoreCatalogItem
+	for i := range stocked {
+		product, ok := products[stocked[i].ProductID]
+		if !ok || !product.IsActive || !product.IsPublished || product.IsDeleted {
+			continue
+		}
+		item := newCatalogItem(product, &stocked[i])
+		if filter.matches(product, item.EffectivePrice) {
+			items = append(items, item)
+		}
+	}
+
+	sort.SliceStable(items, func(a, b int) bool {
+		if items[a].Name != items[b].Name {
+			return items[a].Name < items[b].Name
+		}
+		return items[a].ProductId < items[b].ProductId
+	})
+
+	total := int32(len(items))
+	start := min(offset, total)
+	end := min(start+limit, total)
+
+	return &storev1.ListStoreCatalogResponse{
+		Items:      items[start:end],
+		TotalCount: total,
+	}, nil
+}
+
+// getProducts fetches the product data of store products from the product
+// service, keyed by product ID. Products the catalog no longer has are left out.
+func (s *StoreService) getProducts(ctx context.Context, stocked []models.StoreProduct) (map[string]*productv1.Product, error) {
+	products := make(map[string]*productv1.Product, len(stocked))
+	for start := 0; start < len(stocked); start += productBatchSize {
+		end := min(start+productBatchSize, len(stocked))
+		ids := make([]string, 0, end-start)
+		for _, sp := range stocked[start:end] {
+			ids = append(ids, sp.ProductID)
+		}
+
+		resp, err := s.products.BatchGetProducts(ctx, &productv1.BatchGetProductsRequest{Ids: ids})
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to get products: %v", err)
+		}
+		for _, p := range resp.Products {
+			products[p.Id] = p
+		}
+	}
+	return products, nil
+}
+
+// newCatalogItem combines a product with how a store sells it
+func newCatalogItem(product *productv1.Product, sp *models.StoreProduct) *storev1.StoreCatalogItem {
+	effectivePrice := product.SellingPrice
+	if strings.TrimSpace(sp.StorePrice) != "" {
+		effectivePrice = sp.StorePrice
+	}
+
+	return &storev1.StoreCatalogItem{
+		ProductId:         product.Id,
+		Name:              product.Name,
+		Description:       product.Description,
+		Sku:               product.Sku,
+		CategoryIds:       product.CategoryIds,
+		SupplierId:        product.SupplierId,
+		ImageUrls:         product.ImageUrls,
+		Currency:          product.Currency,
+		ListPrice:         product.SellingPrice,
+		EffectivePrice:    effectivePrice,
+		AvailableQuantity: sp.AvailableQuantity,
+		MinOrderQty:       sp.MinOrderQty,
+		MaxOrderQty:       sp.MaxOrderQty,
+		OrderQtyIncrement: sp.OrderQtyIncrement,
+	}
+}
+
+// catalogFilter is a parsed CatalogFilter
+type catalogFilter struct {
+	categories map[string]bool
+	minPrice   *big.Rat
+	maxPrice   *big.Rat
+	search     string
+	supplierID string
+}
+
+// newCatalogFilter parses a catalog filter, rejecting malformed price bounds
+func newCatalogFilter(f *storev1.CatalogFilter) (*catalogFilter, error) {
+	filter := &catalogFilter{}
+	if f == nil {
+		return filter, nil
+	}
+
+	if len(f.CategoryIds) > 0 {
+		filter.categories = make(map[string]bool, len(f.CategoryIds))
+		for _, id := range f.CategoryIds {
+			filter.categories[id] = true
+		}
+	}
+	if f.MinPrice != "" {
+		price, ok := parseDecimal(f.MinPrice)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid min_price %q", f.MinPrice)
+		}
+		filter.minPrice = price
+	}
+	if f.MaxPrice != "" {
+		price, ok := parseDecimal(f.MaxPrice)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid max_price %q", f.MaxPrice)
+		}
+		filter.maxPrice = price
+	}
+	filter.search = strings.ToLower(strings.TrimSpace(f.SearchTerm))
+	filter.supplierID = f.SupplierId
+	return filter, nil
+}
+
+// matches reports whether a product sold at effectivePrice passes the filter.
+// Price bounds apply to the effective price, so a store discount can bring a
+// product into range.
+func (f *catalogFilter) matches(product *productv1.Product, effectivePrice string) bool {
+	if f.supplierID != "" && product.SupplierId != f.supplierID {
+		return false
+	}
+	if f.categories != nil {
+		inCategory := false
+		for _, id := range product.CategoryIds {
+			if f.categories[id] {
+				inCategory = true
+				break
+			}
+		}
+		if !inCategory {
+			return false
+		}
+	}
+	if f.minPrice != nil || f.maxPrice != nil {
+		price, ok := parseDecimal(effectivePrice)
+		if !ok {
+			return false
+		}
+		if f.minPrice != nil && price.Cmp(f.minPrice) < 0 {
+			return false
+		}
+		if f.maxPrice != nil && price.Cmp(f.maxPrice) > 0 {
+			return false
+		}
+	}
+	if f.search != "" &&
+		!strings.Contains(strings.ToLower(product.Name), f.search) &&
+		!strings.Contains(strings.ToLower(product.Sku), f.search) &&
+		!strings.Contains(strings.ToLower(product.Description), f.search) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	productv1 "github.com/leonvanderhaeghen/stockplatform/services/productSvc/api/gen/go/proto/product/v1"
+	storev1 "github.com/leonvanderhaeghen/stockplatform/services/storeSvc/api/gen/go/proto/store/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/config"
+	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/database"
+)
+
+// catalogProductClient serves BatchGetProducts from a fixed set of products
+type catalogProductClient struct {
+	productv1.ProductServiceClient
+	products map[string]*productv1.Product
+}
+
+func (c *catalogProductClient) BatchGetProducts(ctx context.Context, in *productv1.BatchGetProductsRequest, opts ...grpc.CallOption) (*productv1.BatchGetProductsResponse, error) {
+	resp := &productv1.BatchGetProductsResponse{}
+	for _, id := range in.Ids {
+		if p, ok := c.products[id]; ok {
+			resp.Products = append(resp.Products, p)
+		}
+	}
+	return resp, nil
+}
+
+// catalogProducts is a product catalog with one product that is sellable and
+// one each that is unpublished, inactive and deleted
+func catalogProducts() map[string]*productv1.Product {
+	sellable := func(id, name, price string) *productv1.Product {
+		return &productv1.Product{Id: id, Name: name, Sku: id, SellingPrice: price, IsActive: true, IsPublished: true}
+	}
+	products := map[string]*productv1.Product{
+		"desk":  sellable("desk", "Desk", "120.00"),
+		"lamp":  sellable("lamp", "Lamp", "25.00"),
+		"chair": sellable("chair", "Chair", "60.00"),
+		"draft": sellable("draft", "Draft", "10.00"),
+		"old":   sellable("old", "Old", "10.00"),
+		"gone":  sellable("gone", "Gone", "10.00"),
+	}
+	products["draft"].IsPublished = false
+	products["old"].IsActive = false
+	products["gone"].IsDeleted = true
+	return products
+}
+
+// newCatalogStoreService returns a store service over the mock database of mt
+// that reads products from catalogProducts
+func newCatalogStoreService(mt *mtest.T) *StoreService {
+	cfg, err := config.Load()
+	if err != nil {
+		mt.Fatal(err)
+	}
+	service, err := NewStoreService(database.NewFromDatabase(mt.DB), cfg, &catalogProductClient{products: catalogProducts()})
+	if err != nil {
+		mt.Fatal(err)
+	}
+	return service
+}
+
+// storeResponse is the reply to a find of the test store
+func storeResponse(mt *mtest.T) bson.D {
+	return mtest.CreateCursorResponse(0, mt.DB.Name()+".stores", mtest.FirstBatch, bson.D{{Key: "_id", Value: testStoreID}})
+}
+
+// stockedProducts is the reply to the catalog's find on store_products: only
+// rows the query matches, one of them with a store price and three whose
+// products are not sellable, plus one the product service does not know
+func stockedProducts(mt *mtest.T) bson.D {
+	lamp := append(storeProduct("lamp", 4, true), bson.E{Key: "store_price", Value: "19.99"})
+	return storeProductsResponse(mt,
+		storeProduct("desk", 2, true),
+		lamp,
+		storeProduct("draft", 5, true),
+		storeProduct("old", 5, true),
+		storeProduct("gone", 5, true),
+		storeProduct("unknown", 5, true),
+	)
+}
+
+func TestListStoreCatalogReturnsStockedVisibleProducts(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("stocked and visible only", func(mt *mtest.T) {
+		service := newCatalogStoreService(mt)
+		mt.AddMockResponses(storeResponse(mt), stockedProducts(mt))
+
+		resp, err := service.ListStoreCatalog(context.Background(), &storev1.ListStoreCatalogRequest{StoreId: testStoreID})
+		if err != nil {
+			mt.Fatal(err)
+		}
+
+		if resp.TotalCount != 2 || len(resp.Items) != 2 {
+			mt.Fatalf("got %d items of %d, want 2 of 2: %v", len(resp.Items), resp.TotalCount, resp.Items)
+		}
+		desk, lamp := resp.Items[0], resp.Items[1]
+		if desk.ProductId != "desk" || lamp.ProductId != "lamp" {
+			mt.Fatalf("items = %s, %s, want desk, lamp sorted by name", desk.ProductId, lamp.ProductId)
+		}
+		if desk.EffectivePrice != "120.00" || desk.AvailableQuantity != 2 {
+			mt.Errorf("desk = %s x%d, want list price 120.00 x2", desk.EffectivePrice, desk.AvailableQuantity)
+		}
+		if lamp.EffectivePrice != "19.99" || lamp.ListPrice != "25.00" {
+			mt.Errorf("lamp price = %s (list %s), want store price 19.99 (list 25.00)", lamp.EffectivePrice, lamp.ListPrice)
+		}
+
+		// The stock conditions are left to the store_products query
+		var find bson.Raw
+		for _, evt := range mt.GetAllStartedEvents() {
+			if evt.CommandName == "find" && evt.Command.Lookup("find").StringValue() == "store_products" {
+				find = evt.Command.Lookup("filter").Document()
+			}
+		}
+		if find == nil {
+			mt.Fatal("no find on store_products")
+		}
+		if find.Lookup("store_id").StringValue() != testStoreID || !find.Lookup("is_available").Boolean() {
+			mt.Errorf("store_products filter = %v, want this store's available products", find)
+		}
+		if _, err := find.LookupErr("available_quantity", "$gt"); err != nil {
+			mt.Errorf("store_products filter = %v, want available_quantity > 0", find)
+		}
+	})
+
+	mt.Run("filter and page", func(mt *mtest.T) {
+		service := newCatalogStoreService(mt)
+		mt.AddMockResponses(storeResponse(mt), stockedProducts(mt))
+
+		// The lamp's store price brings it under the bound, its list price would not
+		resp, err := service.ListStoreCatalog(context.Background(), &storev1.ListStoreCatalogRequest{
+			StoreId: testStoreID,
+			Filter:  &storev1.CatalogFilter{MaxPrice: "20"},
+		})
+		if err != nil {
+			mt.Fatal(err)
+		}
+		if resp.TotalCount != 1 || resp.Items[0].ProductId != "lamp" {
+			mt.Errorf("max_price 20 = %v, want only the lamp", resp.Items)
+		}
+
+		mt.AddMockResponses(storeResponse(mt), stockedProducts(mt))
+		resp, err = service.ListStoreCatalog(context.Background(), &storev1.ListStoreCatalogRequest{
+			StoreId: testStoreID,
+			Limit:   1,
+			Offset:  1,
+		})
+		if err != nil {
+			mt.Fatal(err)
+		}
+		if resp.TotalCount != 2 || len(resp.Items) != 1 || resp.Items[0].ProductId != "lamp" {
+			mt.Errorf("second page = %v of %d, want the lamp of 2", resp.Items, resp.TotalCount)
+		}
+	})
+
+	mt.Run("unknown store", func(mt *mtest.T) {
+		service := newCatalogStoreService(mt)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, mt.DB.Name()+".stores", mtest.FirstBatch))
+
+		_, err := service.ListStoreCatalog(context.Background(), &storev1.ListStoreCatalogRequest{StoreId: testStoreID})
+		if status.Code(err) != codes.NotFound {
+			mt.Errorf("error = %v, want NotFound", err)
+		}
+	})
+
+	mt.Run("invalid price bound", func(mt *mtest.T) {
+		service := newCatalogStoreService(mt)
+
+		_, err := service.ListStoreCatalog(context.Background(), &storev1.ListStoreCatalogRequest{
+			StoreId: testStoreID,
+			Filter:  &storev1.CatalogFilter{MinPrice: "cheap"},
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			mt.Errorf("error = %v, want InvalidArgument", err)
+		}
+	})
+}
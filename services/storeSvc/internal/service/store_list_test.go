@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+
+	storev1 "github.com/leonvanderhaeghen/stockplatform/services/storeSvc/api/gen/go/proto/store/v1"
+)
+
+// storeCountResponse is the reply to the count of a store listing
+func storeCountResponse(mt *mtest.T, n int32) bson.D {
+	return mtest.CreateCursorResponse(0, mt.DB.Name()+".stores", mtest.FirstBatch, bson.D{{Key: "n", Value: n}})
+}
+
+func TestListStoresSearchAndCountry(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("name search and country with active only", func(mt *mtest.T) {
+		service := newMockStoreService(mt)
+		mt.AddMockResponses(
+			storeCountResponse(mt, 3),
+			mtest.CreateCursorResponse(0, mt.DB.Name()+".stores", mtest.FirstBatch,
+				bson.D{{Key: "_id", Value: "store-2"}, {Key: "name", Value: "Ghent Central"}, {Key: "is_active", Value: true}},
+			),
+		)
+
+		resp, err := service.ListStores(context.Background(), &storev1.ListStoresRequest{
+			Search: " central ", Country: "be", ActiveOnly: true, Limit: 1, Offset: 2,
+		})
+		if err != nil {
+			mt.Fatal(err)
+		}
+
+		if len(resp.GetStores()) != 1 || resp.GetStores()[0].GetName() != "Ghent Central" {
+			mt.Fatalf("stores = %v, want Ghent Central", resp.GetStores())
+		}
+		if resp.GetTotalCount() != 3 || resp.GetLimit() != 1 || resp.GetOffset() != 2 {
+			mt.Fatalf("total %d, limit %d, offset %d, want 3, 1 and 2 echoed",
+				resp.GetTotalCount(), resp.GetLimit(), resp.GetOffset())
+		}
+
+		events := mt.GetAllStartedEvents()
+		if len(events) != 2 {
+			mt.Fatalf("sent %d commands, want a count and a find", len(events))
+		}
+		match := events[0].Command.Lookup("pipeline").Array().Index(0).Value().Document().Lookup("$match").Document()
+		find := events[1].Command.Lookup("filter").Document()
+		for name, filter := range map[string]bson.Raw{"count": match, "find": find} {
+			if active, ok := filter.Lookup("is_active").BooleanOK(); !ok || !active {
+				mt.Errorf("%s filter = %v, want active stores only", name, filter)
+			}
+			if country := filter.Lookup("address.country", "$regex").StringValue(); country != "^be$" {
+				mt.Errorf("%s country pattern = %q, want an exact match", name, country)
+			}
+			if options := filter.Lookup("address.country", "$options").StringValue(); options != "i" {
+				mt.Errorf("%s country match should ignore case", name)
+			}
+			or, err := filter.Lookup("$or").Array().Values()
+			if err != nil || len(or) != 2 {
+				mt.Fatalf("%s filter = %v, want the search on name or description", name, filter)
+			}
+			for i, field := range []string{"name", "description"} {
+				if pattern := or[i].Document().Lookup(field, "$regex").StringValue(); pattern != "central" {
+					mt.Errorf("%s %s pattern = %q, want the trimmed search", name, field, pattern)
+				}
+			}
+		}
+		if sort := events[1].Command.Lookup("sort").Document(); sort.Index(0).Key() != "name" {
+			mt.Errorf("sort = %v, want by name", sort)
+		}
+	})
+
+	mt.Run("search is matched literally", func(mt *mtest.T) {
+		service := newMockStoreService(mt)
+		mt.AddMockResponses(storeCountResponse(mt, 0), mtest.CreateCursorResponse(0, mt.DB.Name()+".stores", mtest.FirstBatch))
+
+		if _, err := service.ListStores(context.Background(), &storev1.ListStoresRequest{Search: "a.b*"}); err != nil {
+			mt.Fatal(err)
+		}
+
+		find := mt.GetAllStartedEvents()[1].Command.Lookup("filter").Document()
+		or, _ := find.Lookup("$or").Array().Values()
+		if pattern := or[0].Document().Lookup("name", "$regex").StringValue(); pattern != `a\.b\*` {
+			mt.Fatalf("name pattern = %q, want the search escaped", pattern)
+		}
+		if _, err := find.LookupErr("is_active"); err == nil {
+			mt.Fatalf("filter = %v, should include inactive stores", find)
+		}
+		if _, err := find.LookupErr("address.country"); err == nil {
+			mt.Fatalf("filter = %v, should not filter on country", find)
+		}
+	})
+}
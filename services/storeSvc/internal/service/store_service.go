@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/csv"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	productv1 "github.com/leonvanderhaeghen/stockplatform/services/productSvc/api/gen/go/proto/product/v1"
 	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/config"
 	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/database"
 	"github.com/leonvanderhaeghen/stockplatform/services/storeSvc/internal/models"
@@ -23,20 +25,41 @@ import (
 // StoreService implements the store service gRPC interface
 type StoreService struct {
 	storev1.UnimplementedStoreServiceServer
-	db     *database.Database
-	config *config.Config
+	db       *database.Database
+	config   *config.Config
+	products productv1.ProductServiceClient
 }
 
-// NewStoreService creates a new store service instance
-func NewStoreService(db *database.Database, cfg *config.Config) (*StoreService, error) {
+// NewStoreService creates a new store service instance. products is used to
+// read the product data of store catalogs.
+func NewStoreService(db *database.Database, cfg *config.Config, products productv1.ProductServiceClient) (*StoreService, error) {
 	return &StoreService{
-		db:     db,
-		config: cfg,
+		db:       db,
+		config:   cfg,
+		products: products,
 	}, nil
 }
 
 // CreateStore creates a new store
 func (s *StoreService) CreateStore(ctx context.Context, req *storev1.CreateStoreRequest) (*storev1.CreateStoreResponse, error) {
+	currency := strings.ToUpper(req.Currency)
+	if currency == "" {
+		currency = s.config.Sales.DefaultCurrency
+	}
+	if err := s.validateCurrency(currency); err != nil {
+		return nil, err
+	}
+	if err := validateTaxRate(req.TaxRate); err != nil {
+		return nil, err
+	}
+	holidays := convertHolidaysFromProto(req.Holidays)
+	if err := models.ValidateTimezone(req.Timezone); err != nil {
+		return nil, err
+	}
+	if err := models.ValidateHolidays(holidays); err != nil {
+		return nil, err
+	}
+
 	store := &models.Store{
 		ID:          uuid.New().String(),
 		Name:        req.Name,
@@ -47,6 +70,10 @@ func (s *StoreService) CreateStore(ctx context.Context, req *storev1.CreateStore
 		IsActive:    true,
 		Hours:       convertStoreHoursFromProto(req.Hours),
 		Metadata:    req.Metadata,
+		Currency:    currency,
+		TaxRate:     req.TaxRate,
+		Timezone:    req.Timezone,
+		Holidays:    holidays,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
@@ -64,13 +91,16 @@ func (s *StoreService) CreateStore(ctx context.Context, req *storev1.CreateStore
 
 // GetStore retrieves a store by ID
 func (s *StoreService) GetStore(ctx context.Context, req *storev1.GetStoreRequest) (*storev1.GetStoreResponse, error) {
+	if err := validateStoreID(req.Id); err != nil {
+		return nil, err
+	}
 	collection := s.db.GetCollection("stores")
 	
 	var store models.Store
 	err := collection.FindOne(ctx, bson.M{"_id": req.Id}).Decode(&store)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return nil, fmt.Errorf("store not found")
+			return nil, errStoreNotFound
 		}
 		return nil, fmt.Errorf("failed to get store: %w", err)
 	}
@@ -92,9 +122,19 @@ func (s *StoreService) ListStores(ctx context.Context, req *storev1.ListStoresRe
 	if req.State != "" {
 		filter["address.state"] = bson.M{"$regex": req.State, "$options": "i"}
 	}
+	if req.Country != "" {
+		filter["address.country"] = bson.M{"$regex": "^" + regexp.QuoteMeta(req.Country) + "$", "$options": "i"}
+	}
 	if req.ActiveOnly {
 		filter["is_active"] = true
 	}
+	if search := strings.TrimSpace(req.Search); search != "" {
+		pattern := bson.M{"$regex": regexp.QuoteMeta(search), "$options": "i"}
+		filter["$or"] = bson.A{
+			bson.M{"name": pattern},
+			bson.M{"description": pattern},
+		}
+	}
 
 	// Count total documents
 	total, err := collection.CountDocuments(ctx, filter)
@@ -103,7 +143,7 @@ func (s *StoreService) ListStores(ctx context.Context, req *storev1.ListStoresRe
 	}
 
 	// Find with pagination
-	findOptions := options.Find()
+	findOptions := options.Find().SetSort(bson.D{{Key: "name", Value: 1}, {Key: "_id", Value: 1}})
 	if req.Limit > 0 {
 		findOptions.SetLimit(int64(req.Limit))
 	}
@@ -131,6 +171,8 @@ func (s *StoreService) ListStores(ctx context.Context, req *storev1.ListStoresRe
 	return &storev1.ListStoresResponse{
 		Stores:     protoStores,
 		TotalCount: int32(total),
+		Limit:      max(req.Limit, 0),
+		Offset:     max(req.Offset, 0),
 	}, nil
 }
 
@@ -145,6 +187,12 @@ func (s *StoreService) AddProductToStore(ctx context.Context, req *storev1.AddPr
 		StorePrice:        req.StorePrice,
 		IsAvailable:       true,
 		LastUpdated:       time.Now(),
+		MinOrderQty:       req.MinOrderQty,
+		MaxOrderQty:       req.MaxOrderQty,
+		OrderQtyIncrement: req.OrderQtyIncrement,
+	}
+	if err := validateOrderQuantityLimits(storeProduct); err != nil {
+		return nil, err
 	}
 
 	collection := s.db.GetCollection("store_products")
@@ -175,6 +223,12 @@ func (s *StoreService) ReserveProduct(ctx context.Context, req *storev1.ReserveP
 		return nil, fmt.Errorf("insufficient stock available")
 	}
 
+	// Reservations without a duration would expire at once, so they get the default
+	duration := time.Duration(req.ReservationDurationHours) * time.Hour
+	if duration <= 0 {
+		duration = s.config.Reservations.DefaultDuration
+	}
+
 	// Create reservation
 	reservation := &models.ProductReservation{
 		ID:        uuid.New().String(),
@@ -182,9 +236,9 @@ func (s *StoreService) ReserveProduct(ctx context.Context, req *storev1.ReserveP
 		ProductID: req.ProductId,
 		UserID:    req.UserId,
 		Quantity:  req.Quantity,
-		Status:    "ACTIVE",
+		Status:    ReservationStatusActive,
 		ReservedAt: time.Now(),
-		ExpiresAt:  time.Now().Add(time.Duration(req.ReservationDurationHours) * time.Hour),
+		ExpiresAt:  time.Now().Add(duration),
 		Notes:     req.Notes,
 	}
 
@@ -216,13 +270,46 @@ func (s *StoreService) ReserveProduct(ctx context.Context, req *storev1.ReserveP
 	}, nil
 }
 
-// RecordSale records a sale at a physical store
+// RecordSale records a sale at a physical store in the store's currency,
+// applies the store's tax rate and numbers the sale's receipt
 func (s *StoreService) RecordSale(ctx context.Context, req *storev1.RecordSaleRequest) (*storev1.RecordSaleResponse, error) {
-	// Calculate total amount
-	var totalAmount float64
-	for _, item := range req.Items {
-		price, _ := strconv.ParseFloat(item.UnitPrice, 64)
-		totalAmount += price * float64(item.Quantity)
+	if err := validateStoreID(req.StoreId); err != nil {
+		return nil, err
+	}
+	if len(req.Items) == 0 {
+		return nil, fmt.Errorf("a sale must have at least one item")
+	}
+
+	var store models.Store
+	err := s.db.GetCollection("stores").FindOne(ctx, bson.M{"_id": req.StoreId}).Decode(&store)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errStoreNotFound
+		}
+		return nil, fmt.Errorf("failed to get store: %w", err)
+	}
+
+	// Stores created before currencies were configurable sell in the default currency
+	currency := store.Currency
+	if currency == "" {
+		currency = s.config.Sales.DefaultCurrency
+	}
+	if err := s.validateCurrency(currency); err != nil {
+		return nil, err
+	}
+
+	items := convertSaleItemsFromProto(req.Items)
+	if err := s.checkOrderQuantities(ctx, req.StoreId, items); err != nil {
+		return nil, err
+	}
+	totals, err := computeSaleTotals(items, store.TaxRate, s.config.Sales.RoundingMode)
+	if err != nil {
+		return nil, err
+	}
+
+	receiptNumber, err := s.nextReceiptNumber(ctx, req.StoreId)
+	if err != nil {
+		return nil, err
 	}
 
 	sale := &models.StoreSale{
@@ -230,9 +317,13 @@ func (s *StoreService) RecordSale(ctx context.Context, req *storev1.RecordSaleRe
 		StoreID:        req.StoreId,
 		SalesUserID:    req.SalesUserId,
 		CustomerUserID: req.CustomerUserId,
-		Items:          convertSaleItemsFromProto(req.Items),
-		TotalAmount:    fmt.Sprintf("%.2f", totalAmount),
-		Currency:       "USD", // Default currency
+		Items:          items,
+		Subtotal:       totals.Subtotal,
+		TaxRate:        store.TaxRate,
+		TaxAmount:      totals.TaxAmount,
+		TotalAmount:    totals.Total,
+		ReceiptNumber:  receiptNumber,
+		Currency:       currency,
 		SaleType:       req.SaleType.String(),
 		SaleDate:       time.Now(),
 		ReservationID:  req.ReservationId,
@@ -240,7 +331,7 @@ func (s *StoreService) RecordSale(ctx context.Context, req *storev1.RecordSaleRe
 	}
 
 	collection := s.db.GetCollection("sales")
-	_, err := collection.InsertOne(ctx, sale)
+	_, err = collection.InsertOne(ctx, sale)
 	if err != nil {
 		return nil, fmt.Errorf("failed to record sale: %w", err)
 	}
@@ -255,7 +346,8 @@ func (s *StoreService) RecordSale(ctx context.Context, req *storev1.RecordSaleRe
 	}
 
 	return &storev1.RecordSaleResponse{
-		Sale: convertSaleToProto(sale),
+		Sale:    convertSaleToProto(sale),
+		Receipt: buildReceipt(&store, sale),
 	}, nil
 }
 
@@ -319,6 +411,10 @@ func convertStoreToProto(store *models.Store) *storev1.Store {
 		IsActive:    store.IsActive,
 		Hours:       convertStoreHoursToProto(&store.Hours),
 		Metadata:    store.Metadata,
+		Currency:    store.Currency,
+		TaxRate:     store.TaxRate,
+		Timezone:    store.Timezone,
+		Holidays:    convertHolidaysToProto(store.Holidays),
 		CreatedAt:   timestamppb.New(store.CreatedAt),
 		UpdatedAt:   timestamppb.New(store.UpdatedAt),
 	}
@@ -401,15 +497,18 @@ func convertStoreProductToProto(sp *models.StoreProduct) *storev1.StoreProduct {
 		StorePrice:        sp.StorePrice,
 		IsAvailable:       sp.IsAvailable,
 		LastUpdated:       timestamppb.New(sp.LastUpdated),
+		MinOrderQty:       sp.MinOrderQty,
+		MaxOrderQty:       sp.MaxOrderQty,
+		OrderQtyIncrement: sp.OrderQtyIncrement,
 	}
 }
 
 func convertReservationToProto(r *models.ProductReservation) *storev1.ProductReservation {
 	status := storev1.ReservationStatus_RESERVATION_STATUS_UNSPECIFIED
 	switch r.Status {
-	case "ACTIVE":
+	case ReservationStatusActive:
 		status = storev1.ReservationStatus_RESERVATION_STATUS_ACTIVE
-	case "EXPIRED":
+	case ReservationStatusExpired:
 		status = storev1.ReservationStatus_RESERVATION_STATUS_EXPIRED
 	case "COMPLETED":
 		status = storev1.ReservationStatus_RESERVATION_STATUS_COMPLETED
@@ -470,6 +569,10 @@ func convertSaleToProto(s *models.StoreSale) *storev1.StoreSale {
 		SaleType:       saleType,
 		SaleDate:       timestamppb.New(s.SaleDate),
 		Metadata:       s.Metadata,
+		Subtotal:       s.Subtotal,
+		TaxRate:        s.TaxRate,
+		TaxAmount:      s.TaxAmount,
+		ReceiptNumber:  s.ReceiptNumber,
 	}
 }
 
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.7
+// 	protoc-gen-go v1.36.6
 // 	protoc        (unknown)
 // source: supplier/v1/supplier.proto
 
@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	_ "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -517,6 +518,9 @@ type UpdateSupplierRequest struct {
 	LeadTimeDays  int32                  `protobuf:"varint,14,opt,name=lead_time_days,json=leadTimeDays,proto3" json:"lead_time_days,omitempty"`
 	PaymentTerms  string                 `protobuf:"bytes,15,opt,name=payment_terms,json=paymentTerms,proto3" json:"payment_terms,omitempty"`
 	Metadata      map[string]string      `protobuf:"bytes,16,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Fields to apply, using the field names above (e.g. "phone", "website").
+	// When empty nothing is changed; omitted fields are never cleared.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,17,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -663,6 +667,13 @@ func (x *UpdateSupplierRequest) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *UpdateSupplierRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 // Response containing the updated supplier
 type UpdateSupplierResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -710,8 +721,12 @@ func (x *UpdateSupplierResponse) GetSupplier() *Supplier {
 
 // Request to delete a supplier
 type DeleteSupplierRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Move the supplier's products to this supplier before deleting it
+	ReassignTo string `protobuf:"bytes,2,opt,name=reassign_to,json=reassignTo,proto3" json:"reassign_to,omitempty"`
+	// Delete even though products still reference the supplier
+	Force         bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -753,12 +768,27 @@ func (x *DeleteSupplierRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteSupplierRequest) GetReassignTo() string {
+	if x != nil {
+		return x.ReassignTo
+	}
+	return ""
+}
+
+func (x *DeleteSupplierRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
 // Response for delete operation
 type DeleteSupplierResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Success            bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ProductsReassigned int64                  `protobuf:"varint,2,opt,name=products_reassigned,json=productsReassigned,proto3" json:"products_reassigned,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *DeleteSupplierResponse) Reset() {
@@ -798,6 +828,13 @@ func (x *DeleteSupplierResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *DeleteSupplierResponse) GetProductsReassigned() int64 {
+	if x != nil {
+		return x.ProductsReassigned
+	}
+	return 0
+}
+
 // Request to list suppliers
 type ListSuppliersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1646,11 +1683,252 @@ func (x *SyncInventoryResponse) GetMessage() string {
 	return ""
 }
 
+// Request to validate a supplier's product feed without syncing it
+type ValidateFeedRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SupplierId string                 `protobuf:"bytes,1,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	Options    *SyncOptions           `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	// Supplier categories that map to a platform category; empty skips the check
+	CategoryMapping []string `protobuf:"bytes,3,rep,name=category_mapping,json=categoryMapping,proto3" json:"category_mapping,omitempty"`
+	// Maximum number of record errors to return (default 20)
+	SampleSize    int32 `protobuf:"varint,4,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateFeedRequest) Reset() {
+	*x = ValidateFeedRequest{}
+	mi := &file_supplier_v1_supplier_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateFeedRequest) ProtoMessage() {}
+
+func (x *ValidateFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplier_v1_supplier_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateFeedRequest.ProtoReflect.Descriptor instead.
+func (*ValidateFeedRequest) Descriptor() ([]byte, []int) {
+	return file_supplier_v1_supplier_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ValidateFeedRequest) GetSupplierId() string {
+	if x != nil {
+		return x.SupplierId
+	}
+	return ""
+}
+
+func (x *ValidateFeedRequest) GetOptions() *SyncOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ValidateFeedRequest) GetCategoryMapping() []string {
+	if x != nil {
+		return x.CategoryMapping
+	}
+	return nil
+}
+
+func (x *ValidateFeedRequest) GetSampleSize() int32 {
+	if x != nil {
+		return x.SampleSize
+	}
+	return 0
+}
+
+// A problem found in one feed record
+type FeedRecordError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExternalId    string                 `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Field         string                 `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedRecordError) Reset() {
+	*x = FeedRecordError{}
+	mi := &file_supplier_v1_supplier_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedRecordError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedRecordError) ProtoMessage() {}
+
+func (x *FeedRecordError) ProtoReflect() protoreflect.Message {
+	mi := &file_supplier_v1_supplier_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedRecordError.ProtoReflect.Descriptor instead.
+func (*FeedRecordError) Descriptor() ([]byte, []int) {
+	return file_supplier_v1_supplier_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FeedRecordError) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *FeedRecordError) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *FeedRecordError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FeedRecordError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Response for feed validation
+type ValidateFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SupplierId    string                 `protobuf:"bytes,1,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	AdapterName   string                 `protobuf:"bytes,2,opt,name=adapter_name,json=adapterName,proto3" json:"adapter_name,omitempty"`
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Valid         int32                  `protobuf:"varint,4,opt,name=valid,proto3" json:"valid,omitempty"`
+	Invalid       int32                  `protobuf:"varint,5,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	SampleErrors  []*FeedRecordError     `protobuf:"bytes,6,rep,name=sample_errors,json=sampleErrors,proto3" json:"sample_errors,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateFeedResponse) Reset() {
+	*x = ValidateFeedResponse{}
+	mi := &file_supplier_v1_supplier_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateFeedResponse) ProtoMessage() {}
+
+func (x *ValidateFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplier_v1_supplier_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateFeedResponse.ProtoReflect.Descriptor instead.
+func (*ValidateFeedResponse) Descriptor() ([]byte, []int) {
+	return file_supplier_v1_supplier_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ValidateFeedResponse) GetSupplierId() string {
+	if x != nil {
+		return x.SupplierId
+	}
+	return ""
+}
+
+func (x *ValidateFeedResponse) GetAdapterName() string {
+	if x != nil {
+		return x.AdapterName
+	}
+	return ""
+}
+
+func (x *ValidateFeedResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ValidateFeedResponse) GetValid() int32 {
+	if x != nil {
+		return x.Valid
+	}
+	return 0
+}
+
+func (x *ValidateFeedResponse) GetInvalid() int32 {
+	if x != nil {
+		return x.Invalid
+	}
+	return 0
+}
+
+func (x *ValidateFeedResponse) GetSampleErrors() []*FeedRecordError {
+	if x != nil {
+		return x.SampleErrors
+	}
+	return nil
+}
+
+func (x *ValidateFeedResponse) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ValidateFeedResponse) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
 var File_supplier_v1_supplier_proto protoreflect.FileDescriptor
 
 const file_supplier_v1_supplier_proto_rawDesc = "" +
 	"\n" +
-	"\x1asupplier/v1/supplier.proto\x12\vsupplier.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x8c\x05\n" +
+	"\x1asupplier/v1/supplier.proto\x12\vsupplier.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"\x8c\x05\n" +
 	"\bSupplier\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
@@ -1703,7 +1981,7 @@ const file_supplier_v1_supplier_proto_rawDesc = "" +
 	"\x12GetSupplierRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"H\n" +
 	"\x13GetSupplierResponse\x121\n" +
-	"\bsupplier\x18\x01 \x01(\v2\x15.supplier.v1.SupplierR\bsupplier\"\xb0\x04\n" +
+	"\bsupplier\x18\x01 \x01(\v2\x15.supplier.v1.SupplierR\bsupplier\"\xed\x04\n" +
 	"\x15UpdateSupplierRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
@@ -1722,16 +2000,22 @@ const file_supplier_v1_supplier_proto_rawDesc = "" +
 	"\bcurrency\x18\r \x01(\tR\bcurrency\x12$\n" +
 	"\x0elead_time_days\x18\x0e \x01(\x05R\fleadTimeDays\x12#\n" +
 	"\rpayment_terms\x18\x0f \x01(\tR\fpaymentTerms\x12L\n" +
-	"\bmetadata\x18\x10 \x03(\v20.supplier.v1.UpdateSupplierRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\bmetadata\x18\x10 \x03(\v20.supplier.v1.UpdateSupplierRequest.MetadataEntryR\bmetadata\x12;\n" +
+	"\vupdate_mask\x18\x11 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"K\n" +
 	"\x16UpdateSupplierResponse\x121\n" +
-	"\bsupplier\x18\x01 \x01(\v2\x15.supplier.v1.SupplierR\bsupplier\"'\n" +
+	"\bsupplier\x18\x01 \x01(\v2\x15.supplier.v1.SupplierR\bsupplier\"^\n" +
 	"\x15DeleteSupplierRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"2\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vreassign_to\x18\x02 \x01(\tR\n" +
+	"reassignTo\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\"c\n" +
 	"\x16DeleteSupplierResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"_\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12/\n" +
+	"\x13products_reassigned\x18\x02 \x01(\x03R\x12productsReassigned\"_\n" +
 	"\x14ListSuppliersRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
@@ -1789,7 +2073,31 @@ const file_supplier_v1_supplier_proto_rawDesc = "" +
 	"\aoptions\x18\x02 \x01(\v2\x18.supplier.v1.SyncOptionsR\aoptions\"H\n" +
 	"\x15SyncInventoryResponse\x12\x15\n" +
 	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\xc5\a\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xb6\x01\n" +
+	"\x13ValidateFeedRequest\x12\x1f\n" +
+	"\vsupplier_id\x18\x01 \x01(\tR\n" +
+	"supplierId\x122\n" +
+	"\aoptions\x18\x02 \x01(\v2\x18.supplier.v1.SyncOptionsR\aoptions\x12)\n" +
+	"\x10category_mapping\x18\x03 \x03(\tR\x0fcategoryMapping\x12\x1f\n" +
+	"\vsample_size\x18\x04 \x01(\x05R\n" +
+	"sampleSize\"t\n" +
+	"\x0fFeedRecordError\x12\x1f\n" +
+	"\vexternal_id\x18\x01 \x01(\tR\n" +
+	"externalId\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x14\n" +
+	"\x05field\x18\x03 \x01(\tR\x05field\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xdd\x02\n" +
+	"\x14ValidateFeedResponse\x12\x1f\n" +
+	"\vsupplier_id\x18\x01 \x01(\tR\n" +
+	"supplierId\x12!\n" +
+	"\fadapter_name\x18\x02 \x01(\tR\vadapterName\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x14\n" +
+	"\x05valid\x18\x04 \x01(\x05R\x05valid\x12\x18\n" +
+	"\ainvalid\x18\x05 \x01(\x05R\ainvalid\x12A\n" +
+	"\rsample_errors\x18\x06 \x03(\v2\x1c.supplier.v1.FeedRecordErrorR\fsampleErrors\x129\n" +
+	"\n" +
+	"started_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
+	"\fcompleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt2\x9c\b\n" +
 	"\x0fSupplierService\x12[\n" +
 	"\x0eCreateSupplier\x12\".supplier.v1.CreateSupplierRequest\x1a#.supplier.v1.CreateSupplierResponse\"\x00\x12R\n" +
 	"\vGetSupplier\x12\x1f.supplier.v1.GetSupplierRequest\x1a .supplier.v1.GetSupplierResponse\"\x00\x12[\n" +
@@ -1800,7 +2108,8 @@ const file_supplier_v1_supplier_proto_rawDesc = "" +
 	"\x16GetAdapterCapabilities\x12*.supplier.v1.GetAdapterCapabilitiesRequest\x1a+.supplier.v1.GetAdapterCapabilitiesResponse\"\x00\x12p\n" +
 	"\x15TestAdapterConnection\x12).supplier.v1.TestAdapterConnectionRequest\x1a*.supplier.v1.TestAdapterConnectionResponse\"\x00\x12U\n" +
 	"\fSyncProducts\x12 .supplier.v1.SyncProductsRequest\x1a!.supplier.v1.SyncProductsResponse\"\x00\x12X\n" +
-	"\rSyncInventory\x12!.supplier.v1.SyncInventoryRequest\x1a\".supplier.v1.SyncInventoryResponse\"\x00BiZggithub.com/leonvanderhaeghen/stockplatform/services/supplierSvc/api/gen/go/proto/supplier/v1;supplierv1b\x06proto3"
+	"\rSyncInventory\x12!.supplier.v1.SyncInventoryRequest\x1a\".supplier.v1.SyncInventoryResponse\"\x00\x12U\n" +
+	"\fValidateFeed\x12 .supplier.v1.ValidateFeedRequest\x1a!.supplier.v1.ValidateFeedResponse\"\x00BiZggithub.com/leonvanderhaeghen/stockplatform/services/supplierSvc/api/gen/go/proto/supplier/v1;supplierv1b\x06proto3"
 
 var (
 	file_supplier_v1_supplier_proto_rawDescOnce sync.Once
@@ -1814,7 +2123,7 @@ func file_supplier_v1_supplier_proto_rawDescGZIP() []byte {
 	return file_supplier_v1_supplier_proto_rawDescData
 }
 
-var file_supplier_v1_supplier_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_supplier_v1_supplier_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_supplier_v1_supplier_proto_goTypes = []any{
 	(*Supplier)(nil),                       // 0: supplier.v1.Supplier
 	(*CreateSupplierRequest)(nil),          // 1: supplier.v1.CreateSupplierRequest
@@ -1841,57 +2150,68 @@ var file_supplier_v1_supplier_proto_goTypes = []any{
 	(*SyncProductsResponse)(nil),           // 22: supplier.v1.SyncProductsResponse
 	(*SyncInventoryRequest)(nil),           // 23: supplier.v1.SyncInventoryRequest
 	(*SyncInventoryResponse)(nil),          // 24: supplier.v1.SyncInventoryResponse
-	nil,                                    // 25: supplier.v1.Supplier.MetadataEntry
-	nil,                                    // 26: supplier.v1.CreateSupplierRequest.MetadataEntry
-	nil,                                    // 27: supplier.v1.UpdateSupplierRequest.MetadataEntry
-	nil,                                    // 28: supplier.v1.AdapterCapabilities.CapabilitiesEntry
-	nil,                                    // 29: supplier.v1.TestAdapterConnectionRequest.ConfigEntry
-	(*timestamppb.Timestamp)(nil),          // 30: google.protobuf.Timestamp
+	(*ValidateFeedRequest)(nil),            // 25: supplier.v1.ValidateFeedRequest
+	(*FeedRecordError)(nil),                // 26: supplier.v1.FeedRecordError
+	(*ValidateFeedResponse)(nil),           // 27: supplier.v1.ValidateFeedResponse
+	nil,                                    // 28: supplier.v1.Supplier.MetadataEntry
+	nil,                                    // 29: supplier.v1.CreateSupplierRequest.MetadataEntry
+	nil,                                    // 30: supplier.v1.UpdateSupplierRequest.MetadataEntry
+	nil,                                    // 31: supplier.v1.AdapterCapabilities.CapabilitiesEntry
+	nil,                                    // 32: supplier.v1.TestAdapterConnectionRequest.ConfigEntry
+	(*timestamppb.Timestamp)(nil),          // 33: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),          // 34: google.protobuf.FieldMask
 }
 var file_supplier_v1_supplier_proto_depIdxs = []int32{
-	25, // 0: supplier.v1.Supplier.metadata:type_name -> supplier.v1.Supplier.MetadataEntry
-	30, // 1: supplier.v1.Supplier.created_at:type_name -> google.protobuf.Timestamp
-	30, // 2: supplier.v1.Supplier.updated_at:type_name -> google.protobuf.Timestamp
-	26, // 3: supplier.v1.CreateSupplierRequest.metadata:type_name -> supplier.v1.CreateSupplierRequest.MetadataEntry
+	28, // 0: supplier.v1.Supplier.metadata:type_name -> supplier.v1.Supplier.MetadataEntry
+	33, // 1: supplier.v1.Supplier.created_at:type_name -> google.protobuf.Timestamp
+	33, // 2: supplier.v1.Supplier.updated_at:type_name -> google.protobuf.Timestamp
+	29, // 3: supplier.v1.CreateSupplierRequest.metadata:type_name -> supplier.v1.CreateSupplierRequest.MetadataEntry
 	0,  // 4: supplier.v1.CreateSupplierResponse.supplier:type_name -> supplier.v1.Supplier
 	0,  // 5: supplier.v1.GetSupplierResponse.supplier:type_name -> supplier.v1.Supplier
-	27, // 6: supplier.v1.UpdateSupplierRequest.metadata:type_name -> supplier.v1.UpdateSupplierRequest.MetadataEntry
-	0,  // 7: supplier.v1.UpdateSupplierResponse.supplier:type_name -> supplier.v1.Supplier
-	0,  // 8: supplier.v1.ListSuppliersData.suppliers:type_name -> supplier.v1.Supplier
-	10, // 9: supplier.v1.ListSuppliersResponse.data:type_name -> supplier.v1.ListSuppliersData
-	28, // 10: supplier.v1.AdapterCapabilities.capabilities:type_name -> supplier.v1.AdapterCapabilities.CapabilitiesEntry
-	12, // 11: supplier.v1.SupplierAdapter.capabilities:type_name -> supplier.v1.AdapterCapabilities
-	30, // 12: supplier.v1.SyncOptions.since:type_name -> google.protobuf.Timestamp
-	13, // 13: supplier.v1.ListAdaptersResponse.adapters:type_name -> supplier.v1.SupplierAdapter
-	12, // 14: supplier.v1.GetAdapterCapabilitiesResponse.capabilities:type_name -> supplier.v1.AdapterCapabilities
-	29, // 15: supplier.v1.TestAdapterConnectionRequest.config:type_name -> supplier.v1.TestAdapterConnectionRequest.ConfigEntry
-	14, // 16: supplier.v1.SyncProductsRequest.options:type_name -> supplier.v1.SyncOptions
-	14, // 17: supplier.v1.SyncInventoryRequest.options:type_name -> supplier.v1.SyncOptions
-	1,  // 18: supplier.v1.SupplierService.CreateSupplier:input_type -> supplier.v1.CreateSupplierRequest
-	3,  // 19: supplier.v1.SupplierService.GetSupplier:input_type -> supplier.v1.GetSupplierRequest
-	5,  // 20: supplier.v1.SupplierService.UpdateSupplier:input_type -> supplier.v1.UpdateSupplierRequest
-	7,  // 21: supplier.v1.SupplierService.DeleteSupplier:input_type -> supplier.v1.DeleteSupplierRequest
-	9,  // 22: supplier.v1.SupplierService.ListSuppliers:input_type -> supplier.v1.ListSuppliersRequest
-	15, // 23: supplier.v1.SupplierService.ListAdapters:input_type -> supplier.v1.ListAdaptersRequest
-	17, // 24: supplier.v1.SupplierService.GetAdapterCapabilities:input_type -> supplier.v1.GetAdapterCapabilitiesRequest
-	19, // 25: supplier.v1.SupplierService.TestAdapterConnection:input_type -> supplier.v1.TestAdapterConnectionRequest
-	21, // 26: supplier.v1.SupplierService.SyncProducts:input_type -> supplier.v1.SyncProductsRequest
-	23, // 27: supplier.v1.SupplierService.SyncInventory:input_type -> supplier.v1.SyncInventoryRequest
-	2,  // 28: supplier.v1.SupplierService.CreateSupplier:output_type -> supplier.v1.CreateSupplierResponse
-	4,  // 29: supplier.v1.SupplierService.GetSupplier:output_type -> supplier.v1.GetSupplierResponse
-	6,  // 30: supplier.v1.SupplierService.UpdateSupplier:output_type -> supplier.v1.UpdateSupplierResponse
-	8,  // 31: supplier.v1.SupplierService.DeleteSupplier:output_type -> supplier.v1.DeleteSupplierResponse
-	11, // 32: supplier.v1.SupplierService.ListSuppliers:output_type -> supplier.v1.ListSuppliersResponse
-	16, // 33: supplier.v1.SupplierService.ListAdapters:output_type -> supplier.v1.ListAdaptersResponse
-	18, // 34: supplier.v1.SupplierService.GetAdapterCapabilities:output_type -> supplier.v1.GetAdapterCapabilitiesResponse
-	20, // 35: supplier.v1.SupplierService.TestAdapterConnection:output_type -> supplier.v1.TestAdapterConnectionResponse
-	22, // 36: supplier.v1.SupplierService.SyncProducts:output_type -> supplier.v1.SyncProductsResponse
-	24, // 37: supplier.v1.SupplierService.SyncInventory:output_type -> supplier.v1.SyncInventoryResponse
-	28, // [28:38] is the sub-list for method output_type
-	18, // [18:28] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	30, // 6: supplier.v1.UpdateSupplierRequest.metadata:type_name -> supplier.v1.UpdateSupplierRequest.MetadataEntry
+	34, // 7: supplier.v1.UpdateSupplierRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 8: supplier.v1.UpdateSupplierResponse.supplier:type_name -> supplier.v1.Supplier
+	0,  // 9: supplier.v1.ListSuppliersData.suppliers:type_name -> supplier.v1.Supplier
+	10, // 10: supplier.v1.ListSuppliersResponse.data:type_name -> supplier.v1.ListSuppliersData
+	31, // 11: supplier.v1.AdapterCapabilities.capabilities:type_name -> supplier.v1.AdapterCapabilities.CapabilitiesEntry
+	12, // 12: supplier.v1.SupplierAdapter.capabilities:type_name -> supplier.v1.AdapterCapabilities
+	33, // 13: supplier.v1.SyncOptions.since:type_name -> google.protobuf.Timestamp
+	13, // 14: supplier.v1.ListAdaptersResponse.adapters:type_name -> supplier.v1.SupplierAdapter
+	12, // 15: supplier.v1.GetAdapterCapabilitiesResponse.capabilities:type_name -> supplier.v1.AdapterCapabilities
+	32, // 16: supplier.v1.TestAdapterConnectionRequest.config:type_name -> supplier.v1.TestAdapterConnectionRequest.ConfigEntry
+	14, // 17: supplier.v1.SyncProductsRequest.options:type_name -> supplier.v1.SyncOptions
+	14, // 18: supplier.v1.SyncInventoryRequest.options:type_name -> supplier.v1.SyncOptions
+	14, // 19: supplier.v1.ValidateFeedRequest.options:type_name -> supplier.v1.SyncOptions
+	26, // 20: supplier.v1.ValidateFeedResponse.sample_errors:type_name -> supplier.v1.FeedRecordError
+	33, // 21: supplier.v1.ValidateFeedResponse.started_at:type_name -> google.protobuf.Timestamp
+	33, // 22: supplier.v1.ValidateFeedResponse.completed_at:type_name -> google.protobuf.Timestamp
+	1,  // 23: supplier.v1.SupplierService.CreateSupplier:input_type -> supplier.v1.CreateSupplierRequest
+	3,  // 24: supplier.v1.SupplierService.GetSupplier:input_type -> supplier.v1.GetSupplierRequest
+	5,  // 25: supplier.v1.SupplierService.UpdateSupplier:input_type -> supplier.v1.UpdateSupplierRequest
+	7,  // 26: supplier.v1.SupplierService.DeleteSupplier:input_type -> supplier.v1.DeleteSupplierRequest
+	9,  // 27: supplier.v1.SupplierService.ListSuppliers:input_type -> supplier.v1.ListSuppliersRequest
+	15, // 28: supplier.v1.SupplierService.ListAdapters:input_type -> supplier.v1.ListAdaptersRequest
+	17, // 29: supplier.v1.SupplierService.GetAdapterCapabilities:input_type -> supplier.v1.GetAdapterCapabilitiesRequest
+	19, // 30: supplier.v1.SupplierService.TestAdapterConnection:input_type -> supplier.v1.TestAdapterConnectionRequest
+	21, // 31: supplier.v1.SupplierService.SyncProducts:input_type -> supplier.v1.SyncProductsRequest
+	23, // 32: supplier.v1.SupplierService.SyncInventory:input_type -> supplier.v1.SyncInventoryRequest
+	25, // 33: supplier.v1.SupplierService.ValidateFeed:input_type -> supplier.v1.ValidateFeedRequest
+	2,  // 34: supplier.v1.SupplierService.CreateSupplier:output_type -> supplier.v1.CreateSupplierResponse
+	4,  // 35: supplier.v1.SupplierService.GetSupplier:output_type -> supplier.v1.GetSupplierResponse
+	6,  // 36: supplier.v1.SupplierService.UpdateSupplier:output_type -> supplier.v1.UpdateSupplierResponse
+	8,  // 37: supplier.v1.SupplierService.DeleteSupplier:output_type -> supplier.v1.DeleteSupplierResponse
+	11, // 38: supplier.v1.SupplierService.ListSuppliers:output_type -> supplier.v1.ListSuppliersResponse
+	16, // 39: supplier.v1.SupplierService.ListAdapters:output_type -> supplier.v1.ListAdaptersResponse
+	18, // 40: supplier.v1.SupplierService.GetAdapterCapabilities:output_type -> supplier.v1.GetAdapterCapabilitiesResponse
+	20, // 41: supplier.v1.SupplierService.TestAdapterConnection:output_type -> supplier.v1.TestAdapterConnectionResponse
+	22, // 42: supplier.v1.SupplierService.SyncProducts:output_type -> supplier.v1.SyncProductsResponse
+	24, // 43: supplier.v1.SupplierService.SyncInventory:output_type -> supplier.v1.SyncInventoryResponse
+	27, // 44: supplier.v1.SupplierService.ValidateFeed:output_type -> supplier.v1.ValidateFeedResponse
+	34, // [34:45] is the sub-list for method output_type
+	23, // [23:34] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_supplier_v1_supplier_proto_init() }
@@ -1905,7 +2225,7 @@ func file_supplier_v1_supplier_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_supplier_v1_supplier_proto_rawDesc), len(file_supplier_v1_supplier_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   30,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
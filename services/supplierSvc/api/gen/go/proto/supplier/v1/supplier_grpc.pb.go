@@ -29,6 +29,7 @@ const (
 	SupplierService_TestAdapterConnection_FullMethodName  = "/supplier.v1.SupplierService/TestAdapterConnection"
 	SupplierService_SyncProducts_FullMethodName           = "/supplier.v1.SupplierService/SyncProducts"
 	SupplierService_SyncInventory_FullMethodName          = "/supplier.v1.SupplierService/SyncInventory"
+	SupplierService_ValidateFeed_FullMethodName           = "/supplier.v1.SupplierService/ValidateFeed"
 )
 
 // SupplierServiceClient is the client API for SupplierService service.
@@ -57,6 +58,8 @@ type SupplierServiceClient interface {
 	SyncProducts(ctx context.Context, in *SyncProductsRequest, opts ...grpc.CallOption) (*SyncProductsResponse, error)
 	// Sync inventory from supplier
 	SyncInventory(ctx context.Context, in *SyncInventoryRequest, opts ...grpc.CallOption) (*SyncInventoryResponse, error)
+	// Validate a supplier's product feed without writing anything
+	ValidateFeed(ctx context.Context, in *ValidateFeedRequest, opts ...grpc.CallOption) (*ValidateFeedResponse, error)
 }
 
 type supplierServiceClient struct {
@@ -167,6 +170,16 @@ func (c *supplierServiceClient) SyncInventory(ctx context.Context, in *SyncInven
 	return out, nil
 }
 
+func (c *supplierServiceClient) ValidateFeed(ctx context.Context, in *ValidateFeedRequest, opts ...grpc.CallOption) (*ValidateFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateFeedResponse)
+	err := c.cc.Invoke(ctx, SupplierService_ValidateFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SupplierServiceServer is the server API for SupplierService service.
 // All implementations should embed UnimplementedSupplierServiceServer
 // for forward compatibility.
@@ -193,6 +206,8 @@ type SupplierServiceServer interface {
 	SyncProducts(context.Context, *SyncProductsRequest) (*SyncProductsResponse, error)
 	// Sync inventory from supplier
 	SyncInventory(context.Context, *SyncInventoryRequest) (*SyncInventoryResponse, error)
+	// Validate a supplier's product feed without writing anything
+	ValidateFeed(context.Context, *ValidateFeedRequest) (*ValidateFeedResponse, error)
 }
 
 // UnimplementedSupplierServiceServer should be embedded to have
@@ -232,6 +247,9 @@ func (UnimplementedSupplierServiceServer) SyncProducts(context.Context, *SyncPro
 func (UnimplementedSupplierServiceServer) SyncInventory(context.Context, *SyncInventoryRequest) (*SyncInventoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncInventory not implemented")
 }
+func (UnimplementedSupplierServiceServer) ValidateFeed(context.Context, *ValidateFeedRequest) (*ValidateFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateFeed not implemented")
+}
 func (UnimplementedSupplierServiceServer) testEmbeddedByValue() {}
 
 // UnsafeSupplierServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -432,6 +450,24 @@ func _SupplierService_SyncInventory_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SupplierService_ValidateFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplierServiceServer).ValidateFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplierService_ValidateFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplierServiceServer).ValidateFeed(ctx, req.(*ValidateFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SupplierService_ServiceDesc is the grpc.ServiceDesc for SupplierService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -479,6 +515,10 @@ var SupplierService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SyncInventory",
 			Handler:    _SupplierService_SyncInventory_Handler,
 		},
+		{
+			MethodName: "ValidateFeed",
+			Handler:    _SupplierService_ValidateFeed_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "supplier/v1/supplier.proto",
@@ -5,6 +5,8 @@ import (
 
 	"go.uber.org/zap"
 
+	logging "github.com/leonvanderhaeghen/stockplatform/pkg/logger"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/version"
 	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/config"
 	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/database"
 	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/server"
@@ -12,13 +14,13 @@ import (
 
 func main() {
 	// Initialize logger
-	logger, err := zap.NewDevelopment()
+	logger, err := logging.FromEnv("supplier-service")
 	if err != nil {
 		log.Fatalf("Failed to initialize logger: %v", err)
 	}
 	defer logger.Sync()
 
-	logger.Info("Starting supplier service...")
+	logger.Info("Starting supplier service...", version.LogFields()...)
 
 	// Load configuration
 	cfg := config.Load(logger)
@@ -241,6 +241,9 @@ func (a *SampleAdapter) GetInventory(ctx context.Context, externalIDs []string,
 	if options.FullSync {
 		q.Add("full", "true")
 	}
+	if !options.FromDate.IsZero() {
+		q.Add("from_date", options.FromDate.Format(time.RFC3339))
+	}
 	req.URL.RawQuery = q.Encode()
 
 	// Add authentication
@@ -0,0 +1,55 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/domain"
+)
+
+// ValidateFeed fetches the supplier's product feed through its configured
+// adapter and checks every record as a sync would, without writing anything.
+// It lets an admin vet a new or changed feed before running a real sync.
+func (s *supplierServiceImpl) ValidateFeed(ctx context.Context, supplierID string, opts domain.FeedValidationOptions) (*domain.FeedValidationReport, error) {
+	supplier, err := s.repo.GetByID(ctx, supplierID)
+	if err != nil {
+		return nil, err
+	}
+
+	adapter, err := s.feedAdapter(ctx, supplier)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	opts.Sync.BatchSize = s.batchLimits.Clamp(opts.Sync.BatchSize)
+	records, err := adapter.GetProducts(ctx, opts.Sync)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch feed: %w", err)
+	}
+
+	report := domain.ValidateFeedRecords(records, opts)
+	report.SupplierID = supplierID
+	report.AdapterName = adapter.Name()
+	report.StartTime = start
+	report.EndTime = time.Now()
+	return report, nil
+}
+
+// feedAdapter returns the supplier's configured feed adapter, initialized with
+// the supplier's adapter configuration
+func (s *supplierServiceImpl) feedAdapter(ctx context.Context, supplier *domain.Supplier) (domain.SupplierAdapter, error) {
+	adapterName := supplier.AdapterName()
+	if adapterName == "" {
+		return nil, fmt.Errorf("%w: supplier %s has no feed adapter configured", domain.ErrInvalidInput, supplier.ID.Hex())
+	}
+	adapter, err := s.adapterRegistry.Get(adapterName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidInput, err)
+	}
+	if err := adapter.Initialize(ctx, supplier.AdapterConfig()); err != nil {
+		return nil, fmt.Errorf("%w: failed to initialize adapter: %v", domain.ErrInvalidInput, err)
+	}
+	return adapter, nil
+}
@@ -0,0 +1,124 @@
+package application
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/domain"
+)
+
+// feedAdapter serves a fixed feed and fails if anything is synced
+type feedAdapter struct {
+	domain.SupplierAdapter
+	records []domain.SupplierProductData
+	config  map[string]string
+}
+
+func (a *feedAdapter) Name() string { return "feed" }
+
+func (a *feedAdapter) Initialize(ctx context.Context, config map[string]string) error {
+	a.config = config
+	return nil
+}
+
+func (a *feedAdapter) GetProducts(ctx context.Context, options domain.SupplierSyncOptions) ([]domain.SupplierProductData, error) {
+	return a.records, nil
+}
+
+func feedRecord(id, sku string) domain.SupplierProductData {
+	return domain.SupplierProductData{
+		ExternalID: id,
+		Name:       "Product " + id,
+		SKU:        sku,
+		Price:      9.99,
+		Currency:   "EUR",
+		Categories: []string{"Lighting"},
+	}
+}
+
+func TestValidateFeedReportsDeliberateErrors(t *testing.T) {
+	ctx := context.Background()
+	supplier := &domain.Supplier{
+		Name:     "Acme",
+		Metadata: map[string]string{"adapter": "feed", "adapter.api_url": "https://feed.acme.test"},
+	}
+	repo := newMemorySupplierRepository(supplier)
+
+	noName := feedRecord("3", "SKU-3")
+	noName.Name = ""
+	badPrice := feedRecord("4", "SKU-4")
+	badPrice.Price = math.NaN()
+	badCurrency := feedRecord("5", "SKU-5")
+	badCurrency.Currency = "euro"
+	unmapped := feedRecord("6", "SKU-6")
+	unmapped.Categories = []string{"Garden"}
+	several := feedRecord("7", "")
+	several.Price = -1
+
+	adapter := &feedAdapter{records: []domain.SupplierProductData{
+		feedRecord("1", "SKU-1"),
+		feedRecord("2", "SKU-2"),
+		noName,
+		badPrice,
+		badCurrency,
+		unmapped,
+		several,
+		feedRecord("8", "SKU-1"),
+	}}
+	service := NewSupplierService(repo, domain.SyncBatchLimits{}, nil)
+	require.NoError(t, service.RegisterAdapter(ctx, adapter))
+
+	report, err := service.ValidateFeed(ctx, supplier.ID.Hex(), domain.FeedValidationOptions{
+		CategoryMapping: []string{"lighting"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "feed", report.AdapterName)
+	assert.Equal(t, "https://feed.acme.test", adapter.config["api_url"])
+	assert.Equal(t, 8, report.Total)
+	assert.Equal(t, 2, report.Valid)
+	assert.Equal(t, 6, report.Invalid, "a record with several problems counts once")
+
+	fields := make(map[string][]string)
+	for _, e := range report.SampleErrors {
+		fields[e.ExternalID] = append(fields[e.ExternalID], e.Field)
+	}
+	assert.Equal(t, []string{"name"}, fields["3"])
+	assert.Equal(t, []string{"price"}, fields["4"])
+	assert.Equal(t, []string{"currency"}, fields["5"])
+	assert.Equal(t, []string{"categories"}, fields["6"])
+	assert.ElementsMatch(t, []string{"sku", "price"}, fields["7"])
+	assert.Equal(t, []string{"sku"}, fields["8"], "the second record with a SKU is the duplicate")
+}
+
+func TestValidateFeedCapsSampleErrors(t *testing.T) {
+	ctx := context.Background()
+	supplier := &domain.Supplier{Name: "Acme", Metadata: map[string]string{"adapter": "feed"}}
+	adapter := &feedAdapter{}
+	for i := 0; i < 10; i++ {
+		record := feedRecord("id", "")
+		record.Currency = ""
+		adapter.records = append(adapter.records, record)
+	}
+	service := NewSupplierService(newMemorySupplierRepository(supplier), domain.SyncBatchLimits{}, nil)
+	require.NoError(t, service.RegisterAdapter(ctx, adapter))
+
+	report, err := service.ValidateFeed(ctx, supplier.ID.Hex(), domain.FeedValidationOptions{SampleSize: 3})
+	require.NoError(t, err)
+
+	assert.Equal(t, 10, report.Invalid)
+	assert.Len(t, report.SampleErrors, 3)
+}
+
+func TestValidateFeedWithoutAdapter(t *testing.T) {
+	ctx := context.Background()
+	supplier := &domain.Supplier{Name: "Acme"}
+	service := NewSupplierService(newMemorySupplierRepository(supplier), domain.SyncBatchLimits{}, nil)
+
+	_, err := service.ValidateFeed(ctx, supplier.ID.Hex(), domain.FeedValidationOptions{})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
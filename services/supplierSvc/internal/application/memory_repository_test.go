@@ -0,0 +1,91 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/domain"
+)
+
+// memorySupplierRepository is an in-memory supplier repository for service
+// tests. Suppliers are copied in and out so tests see only what was stored.
+type memorySupplierRepository struct {
+	domain.SupplierRepository
+
+	mu        sync.Mutex
+	suppliers map[string]*domain.Supplier
+}
+
+func newMemorySupplierRepository(suppliers ...*domain.Supplier) *memorySupplierRepository {
+	r := &memorySupplierRepository{suppliers: make(map[string]*domain.Supplier)}
+	for _, supplier := range suppliers {
+		if supplier.ID.IsZero() {
+			supplier.ID = primitive.NewObjectID()
+		}
+		r.put(supplier)
+	}
+	return r
+}
+
+func (r *memorySupplierRepository) put(supplier *domain.Supplier) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *supplier
+	r.suppliers[supplier.ID.Hex()] = &copied
+}
+
+func (r *memorySupplierRepository) Create(ctx context.Context, supplier *domain.Supplier) (*domain.Supplier, error) {
+	if supplier.ID.IsZero() {
+		supplier.ID = primitive.NewObjectID()
+	}
+	r.put(supplier)
+	return supplier, nil
+}
+
+func (r *memorySupplierRepository) GetByID(ctx context.Context, id string) (*domain.Supplier, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	supplier, ok := r.suppliers[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	copied := *supplier
+	return &copied, nil
+}
+
+func (r *memorySupplierRepository) Update(ctx context.Context, supplier *domain.Supplier) error {
+	if _, err := r.GetByID(ctx, supplier.ID.Hex()); err != nil {
+		return err
+	}
+	r.put(supplier)
+	return nil
+}
+
+func (r *memorySupplierRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.suppliers[id]; !ok {
+		return domain.ErrNotFound
+	}
+	delete(r.suppliers, id)
+	return nil
+}
+
+func (r *memorySupplierRepository) RecordSync(ctx context.Context, id string, kind domain.SyncKind, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	supplier, ok := r.suppliers[id]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	switch kind {
+	case domain.SyncKindProducts:
+		supplier.LastProductSyncAt = &at
+	case domain.SyncKindInventory:
+		supplier.LastInventorySyncAt = &at
+	}
+	return nil
+}
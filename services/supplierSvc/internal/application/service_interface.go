@@ -13,8 +13,8 @@ type SupplierService interface {
 	// Basic CRUD operations
 	CreateSupplier(ctx context.Context, supplier *domain.Supplier) (*domain.Supplier, error)
 	GetSupplier(ctx context.Context, id string) (*domain.Supplier, error)
-	UpdateSupplier(ctx context.Context, supplier *domain.Supplier) (*domain.Supplier, error)
-	DeleteSupplier(ctx context.Context, id string) error
+	UpdateSupplier(ctx context.Context, supplier *domain.Supplier, fields []string) (*domain.Supplier, error)
+	DeleteSupplier(ctx context.Context, id string, opts domain.DeleteSupplierOptions) (int64, error)
 	ListSuppliers(ctx context.Context, page, pageSize int32, search string) ([]*domain.Supplier, int32, error)
 	
 	// Adapter-related operations
@@ -24,4 +24,6 @@ type SupplierService interface {
 	TestAdapterConnection(ctx context.Context, adapterName string, config map[string]string) error
 	SyncAdapterProducts(ctx context.Context, adapterName string, options domain.SupplierSyncOptions) (*domain.SupplierSyncStats, error)
 	SyncAdapterInventory(ctx context.Context, adapterName string, options domain.SupplierSyncOptions) (*domain.SupplierSyncStats, error)
+	SyncSupplier(ctx context.Context, supplierID string, kind domain.SyncKind, options domain.SupplierSyncOptions) (*domain.SupplierSyncStats, error)
+	ValidateFeed(ctx context.Context, supplierID string, opts domain.FeedValidationOptions) (*domain.FeedValidationReport, error)
 }
@@ -0,0 +1,90 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/domain"
+)
+
+// memorySupplierProducts counts products per supplier ID
+type memorySupplierProducts struct {
+	counts map[string]int64
+}
+
+func (p *memorySupplierProducts) CountProducts(ctx context.Context, supplierID string) (int64, error) {
+	return p.counts[supplierID], nil
+}
+
+func (p *memorySupplierProducts) ReassignProducts(ctx context.Context, fromSupplierID, toSupplierID string) (int64, error) {
+	moved := p.counts[fromSupplierID]
+	p.counts[toSupplierID] += moved
+	delete(p.counts, fromSupplierID)
+	return moved, nil
+}
+
+// newDeleteTestService returns a service over two stored suppliers, the first
+// of which has three products
+func newDeleteTestService() (SupplierService, *memorySupplierRepository, *memorySupplierProducts, string, string) {
+	acme := &domain.Supplier{Name: "Acme"}
+	globex := &domain.Supplier{Name: "Globex"}
+	repo := newMemorySupplierRepository(acme, globex)
+	products := &memorySupplierProducts{counts: map[string]int64{acme.ID.Hex(): 3}}
+	return NewSupplierService(repo, domain.SyncBatchLimits{}, products), repo, products, acme.ID.Hex(), globex.ID.Hex()
+}
+
+func TestDeleteSupplierWithProductsIsBlocked(t *testing.T) {
+	ctx := context.Background()
+	service, repo, products, acmeID, _ := newDeleteTestService()
+
+	_, err := service.DeleteSupplier(ctx, acmeID, domain.DeleteSupplierOptions{})
+	require.ErrorIs(t, err, domain.ErrSupplierInUse)
+	assert.Contains(t, err.Error(), "3 products")
+
+	_, err = repo.GetByID(ctx, acmeID)
+	assert.NoError(t, err, "the supplier must still exist")
+	assert.Equal(t, int64(3), products.counts[acmeID])
+}
+
+func TestDeleteSupplierReassignsProducts(t *testing.T) {
+	ctx := context.Background()
+	service, repo, products, acmeID, globexID := newDeleteTestService()
+
+	reassigned, err := service.DeleteSupplier(ctx, acmeID, domain.DeleteSupplierOptions{ReassignTo: globexID})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(3), reassigned)
+	assert.Equal(t, int64(3), products.counts[globexID])
+	assert.Zero(t, products.counts[acmeID])
+	_, err = repo.GetByID(ctx, acmeID)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestDeleteSupplierReassignTargetMustExist(t *testing.T) {
+	ctx := context.Background()
+	service, repo, _, acmeID, _ := newDeleteTestService()
+
+	for _, target := range []string{acmeID, "000000000000000000000000"} {
+		_, err := service.DeleteSupplier(ctx, acmeID, domain.DeleteSupplierOptions{ReassignTo: target})
+		assert.ErrorIs(t, err, domain.ErrInvalidInput, "reassign to %s", target)
+	}
+	_, err := repo.GetByID(ctx, acmeID)
+	assert.NoError(t, err)
+}
+
+func TestDeleteSupplierForceAndUnused(t *testing.T) {
+	ctx := context.Background()
+	service, repo, products, acmeID, globexID := newDeleteTestService()
+
+	_, err := service.DeleteSupplier(ctx, globexID, domain.DeleteSupplierOptions{})
+	require.NoError(t, err, "a supplier without products is deleted")
+
+	_, err = service.DeleteSupplier(ctx, acmeID, domain.DeleteSupplierOptions{Force: true})
+	require.NoError(t, err)
+	_, err = repo.GetByID(ctx, acmeID)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.Equal(t, int64(3), products.counts[acmeID], "force leaves the products untouched")
+}
@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/domain"
@@ -11,13 +12,19 @@ import (
 type supplierServiceImpl struct {
 	repo domain.SupplierRepository
 	adapterRegistry domain.AdapterRegistry
+	batchLimits domain.SyncBatchLimits
+	products domain.SupplierProducts
 }
 
-// NewSupplierService creates a new supplier service
-func NewSupplierService(repo domain.SupplierRepository) SupplierService {
+// NewSupplierService creates a new supplier service. batchLimits bounds the
+// batch size of product and inventory syncs; products is checked before a
+// supplier is deleted.
+func NewSupplierService(repo domain.SupplierRepository, batchLimits domain.SyncBatchLimits, products domain.SupplierProducts) SupplierService {
 	return &supplierServiceImpl{
 		repo: repo,
 		adapterRegistry: NewAdapterRegistry(),
+		batchLimits: batchLimits,
+		products: products,
 	}
 }
 
@@ -35,29 +42,25 @@ func (s *supplierServiceImpl) GetSupplier(ctx context.Context, id string) (*doma
 	return s.repo.GetByID(ctx, id)
 }
 
-func (s *supplierServiceImpl) UpdateSupplier(ctx context.Context, supplier *domain.Supplier) (*domain.Supplier, error) {
+// UpdateSupplier merges the given fields of supplier into the stored record.
+// When fields is empty the stored record is returned unchanged.
+func (s *supplierServiceImpl) UpdateSupplier(ctx context.Context, supplier *domain.Supplier, fields []string) (*domain.Supplier, error) {
 	// Check if supplier exists
 	existing, err := s.repo.GetByID(ctx, supplier.ID.Hex())
 	if err != nil {
 		return nil, err
 	}
+	if len(fields) == 0 {
+		return existing, nil
+	}
 
 	// Update fields
-	existing.Name = supplier.Name
-	existing.ContactPerson = supplier.ContactPerson
-	existing.Email = supplier.Email
-	existing.Phone = supplier.Phone
-	existing.Address = supplier.Address
-	existing.City = supplier.City
-	existing.State = supplier.State
-	existing.Country = supplier.Country
-	existing.PostalCode = supplier.PostalCode
-	existing.TaxID = supplier.TaxID
-	existing.Website = supplier.Website
-	existing.Currency = supplier.Currency
-	existing.LeadTimeDays = supplier.LeadTimeDays
-	existing.PaymentTerms = supplier.PaymentTerms
-	existing.Metadata = supplier.Metadata
+	if err := existing.ApplyUpdate(supplier, fields); err != nil {
+		return nil, err
+	}
+	if existing.Name == "" {
+		return nil, domain.ErrInvalidInput
+	}
 
 	// Update in repository
 	if err := s.repo.Update(ctx, existing); err != nil {
@@ -67,8 +70,43 @@ func (s *supplierServiceImpl) UpdateSupplier(ctx context.Context, supplier *doma
 	return existing, nil
 }
 
-func (s *supplierServiceImpl) DeleteSupplier(ctx context.Context, id string) error {
-	return s.repo.Delete(ctx, id)
+// DeleteSupplier deletes a supplier that no product references. With
+// opts.ReassignTo its products are first moved to that supplier, which must
+// exist; with opts.Force it is deleted whatever references it. It returns the
+// number of products reassigned.
+func (s *supplierServiceImpl) DeleteSupplier(ctx context.Context, id string, opts domain.DeleteSupplierOptions) (int64, error) {
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		return 0, err
+	}
+
+	var reassigned int64
+	switch {
+	case opts.ReassignTo != "":
+		if opts.ReassignTo == id {
+			return 0, fmt.Errorf("%w: cannot reassign products to the supplier being deleted", domain.ErrInvalidInput)
+		}
+		if _, err := s.repo.GetByID(ctx, opts.ReassignTo); err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return 0, fmt.Errorf("%w: supplier %s to reassign products to does not exist", domain.ErrInvalidInput, opts.ReassignTo)
+			}
+			return 0, err
+		}
+		n, err := s.products.ReassignProducts(ctx, id, opts.ReassignTo)
+		if err != nil {
+			return 0, fmt.Errorf("failed to reassign products: %w", err)
+		}
+		reassigned = n
+	case !opts.Force:
+		count, err := s.products.CountProducts(ctx, id)
+		if err != nil {
+			return 0, fmt.Errorf("failed to check supplier products: %w", err)
+		}
+		if count > 0 {
+			return 0, fmt.Errorf("%w: %d products reference supplier %s; reassign them or force the deletion", domain.ErrSupplierInUse, count, id)
+		}
+	}
+
+	return reassigned, s.repo.Delete(ctx, id)
 }
 
 func (s *supplierServiceImpl) ListSuppliers(ctx context.Context, page, pageSize int32, search string) ([]*domain.Supplier, int32, error) {
@@ -130,8 +168,14 @@ func (s *supplierServiceImpl) SyncAdapterProducts(ctx context.Context, adapterNa
 		return nil, err
 	}
 
+	options.BatchSize = s.batchLimits.Clamp(options.BatchSize)
+
 	// Sync products
-	return adapter.SyncProducts(ctx, options)
+	stats, err := adapter.SyncProducts(ctx, options)
+	if stats != nil {
+		stats.BatchSize = options.BatchSize
+	}
+	return stats, err
 }
 
 // SyncAdapterInventory syncs inventory data from the supplier
@@ -141,6 +185,12 @@ func (s *supplierServiceImpl) SyncAdapterInventory(ctx context.Context, adapterN
 		return nil, err
 	}
 
+	options.BatchSize = s.batchLimits.Clamp(options.BatchSize)
+
 	// Sync inventory
-	return adapter.SyncInventory(ctx, options)
+	stats, err := adapter.SyncInventory(ctx, options)
+	if stats != nil {
+		stats.BatchSize = options.BatchSize
+	}
+	return stats, err
 }
@@ -0,0 +1,75 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/domain"
+)
+
+func TestUpdateSupplierOnlyAppliesMaskedFields(t *testing.T) {
+	ctx := context.Background()
+	stored := &domain.Supplier{
+		Name:    "Acme",
+		Email:   "sales@acme.test",
+		Phone:   "+32 1 111 11 11",
+		Website: "https://acme.test",
+	}
+	repo := newMemorySupplierRepository(stored)
+	service := NewSupplierService(repo, domain.SyncBatchLimits{}, nil)
+
+	// The request carries zero values for everything it leaves out
+	update := &domain.Supplier{ID: stored.ID, Phone: "+32 2 222 22 22"}
+	updated, err := service.UpdateSupplier(ctx, update, []string{"phone"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "+32 2 222 22 22", updated.Phone)
+	reloaded, err := repo.GetByID(ctx, stored.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, "+32 2 222 22 22", reloaded.Phone)
+	assert.Equal(t, "sales@acme.test", reloaded.Email)
+	assert.Equal(t, "https://acme.test", reloaded.Website)
+	assert.Equal(t, "Acme", reloaded.Name)
+}
+
+func TestUpdateSupplierWithEmptyMaskChangesNothing(t *testing.T) {
+	ctx := context.Background()
+	stored := &domain.Supplier{Name: "Acme", Email: "sales@acme.test", Website: "https://acme.test"}
+	repo := newMemorySupplierRepository(stored)
+	service := NewSupplierService(repo, domain.SyncBatchLimits{}, nil)
+
+	updated, err := service.UpdateSupplier(ctx, &domain.Supplier{ID: stored.ID}, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Acme", updated.Name)
+	reloaded, err := repo.GetByID(ctx, stored.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, "sales@acme.test", reloaded.Email)
+	assert.Equal(t, "https://acme.test", reloaded.Website)
+}
+
+func TestUpdateSupplierCanClearAMaskedField(t *testing.T) {
+	ctx := context.Background()
+	stored := &domain.Supplier{Name: "Acme", Website: "https://acme.test"}
+	repo := newMemorySupplierRepository(stored)
+	service := NewSupplierService(repo, domain.SyncBatchLimits{}, nil)
+
+	_, err := service.UpdateSupplier(ctx, &domain.Supplier{ID: stored.ID}, []string{"website"})
+	require.NoError(t, err)
+
+	reloaded, err := repo.GetByID(ctx, stored.ID.Hex())
+	require.NoError(t, err)
+	assert.Empty(t, reloaded.Website)
+}
+
+func TestUpdateSupplierRejectsClearingTheName(t *testing.T) {
+	ctx := context.Background()
+	stored := &domain.Supplier{Name: "Acme"}
+	service := NewSupplierService(newMemorySupplierRepository(stored), domain.SyncBatchLimits{}, nil)
+
+	_, err := service.UpdateSupplier(ctx, &domain.Supplier{ID: stored.ID}, []string{"name"})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
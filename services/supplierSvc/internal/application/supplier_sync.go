@@ -0,0 +1,56 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/domain"
+)
+
+// SyncSupplier runs a product or inventory sync through the supplier's
+// configured adapter. An incremental sync without a FromDate only pulls the
+// records changed since the last successful sync of the same kind. The start
+// time of a successful sync is recorded, so records changed while it ran are
+// picked up by the next one.
+func (s *supplierServiceImpl) SyncSupplier(ctx context.Context, supplierID string, kind domain.SyncKind, options domain.SupplierSyncOptions) (*domain.SupplierSyncStats, error) {
+	supplier, err := s.repo.GetByID(ctx, supplierID)
+	if err != nil {
+		return nil, err
+	}
+
+	adapter, err := s.feedAdapter(ctx, supplier)
+	if err != nil {
+		return nil, err
+	}
+
+	if !options.FullSync && options.FromDate.IsZero() {
+		options.FromDate = supplier.LastSyncAt(kind)
+	}
+	options.BatchSize = s.batchLimits.Clamp(options.BatchSize)
+
+	start := time.Now()
+	var stats *domain.SupplierSyncStats
+	switch kind {
+	case domain.SyncKindProducts:
+		options.SyncProducts = true
+		stats, err = adapter.SyncProducts(ctx, options)
+	case domain.SyncKindInventory:
+		options.SyncInventory = true
+		stats, err = adapter.SyncInventory(ctx, options)
+	default:
+		return nil, fmt.Errorf("%w: unknown sync kind %q", domain.ErrInvalidInput, kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if stats == nil {
+		stats = &domain.SupplierSyncStats{StartTime: start, EndTime: time.Now()}
+	}
+	stats.BatchSize = options.BatchSize
+
+	if err := s.repo.RecordSync(ctx, supplierID, kind, start); err != nil {
+		return stats, fmt.Errorf("failed to record sync time: %w", err)
+	}
+	return stats, nil
+}
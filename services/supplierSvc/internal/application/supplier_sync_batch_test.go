@@ -0,0 +1,58 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/domain"
+)
+
+// recordingAdapter records the options its syncs were run with
+type recordingAdapter struct {
+	domain.SupplierAdapter
+	options []domain.SupplierSyncOptions
+}
+
+func (a *recordingAdapter) Name() string { return "recording" }
+
+func (a *recordingAdapter) SyncProducts(ctx context.Context, options domain.SupplierSyncOptions) (*domain.SupplierSyncStats, error) {
+	a.options = append(a.options, options)
+	return &domain.SupplierSyncStats{}, nil
+}
+
+func (a *recordingAdapter) SyncInventory(ctx context.Context, options domain.SupplierSyncOptions) (*domain.SupplierSyncStats, error) {
+	a.options = append(a.options, options)
+	return &domain.SupplierSyncStats{}, nil
+}
+
+func TestSyncClampsBatchSize(t *testing.T) {
+	ctx := context.Background()
+	adapter := &recordingAdapter{}
+	service := NewSupplierService(newMemorySupplierRepository(), domain.SyncBatchLimits{Min: 10, Max: 500, Default: 100}, nil)
+	require.NoError(t, service.RegisterAdapter(ctx, adapter))
+
+	tests := []struct {
+		name      string
+		requested int
+		want      int
+	}{
+		{name: "out of range", requested: 100000, want: 500},
+		{name: "zero", requested: 0, want: 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats, err := service.SyncAdapterProducts(ctx, "recording", domain.SupplierSyncOptions{BatchSize: tt.requested})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, adapter.options[len(adapter.options)-1].BatchSize)
+			assert.Equal(t, tt.want, stats.BatchSize, "the sync record reports the effective size")
+
+			stats, err = service.SyncAdapterInventory(ctx, "recording", domain.SupplierSyncOptions{BatchSize: tt.requested})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, adapter.options[len(adapter.options)-1].BatchSize)
+			assert.Equal(t, tt.want, stats.BatchSize)
+		})
+	}
+}
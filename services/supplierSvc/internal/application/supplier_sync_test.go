@@ -0,0 +1,96 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/domain"
+)
+
+// datedFeedAdapter serves products with their last change time and, like a
+// real feed, only processes those changed after the sync's FromDate
+type datedFeedAdapter struct {
+	domain.SupplierAdapter
+	changed   map[string]time.Time
+	options   []domain.SupplierSyncOptions
+	processed [][]string
+}
+
+func (a *datedFeedAdapter) Name() string { return "dated" }
+
+func (a *datedFeedAdapter) Initialize(ctx context.Context, config map[string]string) error {
+	return nil
+}
+
+func (a *datedFeedAdapter) SyncProducts(ctx context.Context, options domain.SupplierSyncOptions) (*domain.SupplierSyncStats, error) {
+	a.options = append(a.options, options)
+	var processed []string
+	for sku, changedAt := range a.changed {
+		if options.FromDate.IsZero() || changedAt.After(options.FromDate) {
+			processed = append(processed, sku)
+		}
+	}
+	a.processed = append(a.processed, processed)
+	return &domain.SupplierSyncStats{ProductsProcessed: len(processed)}, nil
+}
+
+func newSyncTestService(t *testing.T, adapter domain.SupplierAdapter) (SupplierService, *memorySupplierRepository, string) {
+	t.Helper()
+	supplier := &domain.Supplier{Name: "Acme", Metadata: map[string]string{domain.AdapterMetadataKey: adapter.Name()}}
+	repo := newMemorySupplierRepository(supplier)
+	service := NewSupplierService(repo, domain.SyncBatchLimits{Min: 10, Max: 500, Default: 100}, nil)
+	require.NoError(t, service.RegisterAdapter(context.Background(), adapter))
+	return service, repo, supplier.ID.Hex()
+}
+
+func TestIncrementalSyncPassesSinceToAdapter(t *testing.T) {
+	ctx := context.Background()
+	since := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	adapter := &datedFeedAdapter{changed: map[string]time.Time{
+		"OLD-1": since.Add(-time.Hour),
+		"NEW-1": since.Add(time.Hour),
+		"NEW-2": since.Add(2 * time.Hour),
+	}}
+	service, _, supplierID := newSyncTestService(t, adapter)
+
+	stats, err := service.SyncSupplier(ctx, supplierID, domain.SyncKindProducts, domain.SupplierSyncOptions{FromDate: since})
+	require.NoError(t, err)
+
+	require.Len(t, adapter.options, 1)
+	assert.True(t, adapter.options[0].FromDate.Equal(since), "adapter got FromDate %v, want %v", adapter.options[0].FromDate, since)
+	assert.Equal(t, 2, stats.ProductsProcessed)
+	assert.ElementsMatch(t, []string{"NEW-1", "NEW-2"}, adapter.processed[0])
+}
+
+func TestIncrementalSyncDefaultsSinceToLastSync(t *testing.T) {
+	ctx := context.Background()
+	adapter := &datedFeedAdapter{changed: map[string]time.Time{"OLD-1": time.Now().Add(-time.Hour)}}
+	service, repo, supplierID := newSyncTestService(t, adapter)
+
+	before := time.Now()
+	_, err := service.SyncSupplier(ctx, supplierID, domain.SyncKindProducts, domain.SupplierSyncOptions{})
+	require.NoError(t, err)
+	assert.True(t, adapter.options[0].FromDate.IsZero(), "first sync pulls everything")
+
+	supplier, err := repo.GetByID(ctx, supplierID)
+	require.NoError(t, err)
+	lastSync := supplier.LastSyncAt(domain.SyncKindProducts)
+	require.False(t, lastSync.Before(before), "last sync time not recorded")
+
+	// A record changed after the first sync is the only one pulled next time
+	adapter.changed["NEW-1"] = time.Now().Add(time.Minute)
+	stats, err := service.SyncSupplier(ctx, supplierID, domain.SyncKindProducts, domain.SupplierSyncOptions{})
+	require.NoError(t, err)
+	assert.True(t, adapter.options[1].FromDate.Equal(lastSync))
+	assert.Equal(t, 1, stats.ProductsProcessed)
+	assert.Equal(t, []string{"NEW-1"}, adapter.processed[1])
+
+	// A full sync ignores the last sync time
+	_, err = service.SyncSupplier(ctx, supplierID, domain.SyncKindProducts, domain.SupplierSyncOptions{FullSync: true})
+	require.NoError(t, err)
+	assert.True(t, adapter.options[2].FromDate.IsZero())
+}
@@ -2,8 +2,12 @@ package config
 
 import (
 	"os"
+	"strconv"
 
 	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/grpclimits"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/mongoclient"
 )
 
 // Config holds the application configuration
@@ -11,6 +15,16 @@ type Config struct {
 	GRPCPort     string
 	MongoURI     string
 	DatabaseName string
+	MongoPool    mongoclient.PoolConfig
+	GRPCLimits   grpclimits.MessageLimits
+
+	// ProductServiceAddr is asked which products reference a supplier before it is deleted
+	ProductServiceAddr string
+
+	// Bounds applied to the batch size requested for supplier syncs
+	SyncBatchSizeMin     int
+	SyncBatchSizeMax     int
+	SyncBatchSizeDefault int
 }
 
 // Load loads configuration from environment variables
@@ -19,12 +33,41 @@ func Load(logger *zap.Logger) *Config {
 		GRPCPort:     getEnv("GRPC_PORT", "50057"),
 		MongoURI:     getEnv("MONGO_URI", "mongodb://localhost:27017"),
 		DatabaseName: getEnv("DATABASE_NAME", "stockplatform"),
+		MongoPool:    mongoclient.PoolConfigFromEnv(mongoclient.DefaultPoolConfig()),
+		GRPCLimits:   grpclimits.MessageLimitsFromEnv(grpclimits.DefaultMessageLimits()),
+
+		ProductServiceAddr: getEnv("PRODUCT_SERVICE_ADDR", "localhost:50053"),
+
+		SyncBatchSizeMin:     getEnvInt("SYNC_BATCH_SIZE_MIN", 1),
+		SyncBatchSizeMax:     getEnvInt("SYNC_BATCH_SIZE_MAX", 1000),
+		SyncBatchSizeDefault: getEnvInt("SYNC_BATCH_SIZE_DEFAULT", 100),
+	}
+
+	// Keep the limits consistent so clamping always yields a usable size
+	if cfg.SyncBatchSizeMin < 1 {
+		cfg.SyncBatchSizeMin = 1
+	}
+	if cfg.SyncBatchSizeMax < cfg.SyncBatchSizeMin {
+		cfg.SyncBatchSizeMax = cfg.SyncBatchSizeMin
+	}
+	if cfg.SyncBatchSizeDefault < cfg.SyncBatchSizeMin {
+		cfg.SyncBatchSizeDefault = cfg.SyncBatchSizeMin
+	}
+	if cfg.SyncBatchSizeDefault > cfg.SyncBatchSizeMax {
+		cfg.SyncBatchSizeDefault = cfg.SyncBatchSizeMax
 	}
 
 	logger.Info("Configuration loaded",
 		zap.String("grpc_port", cfg.GRPCPort),
 		zap.String("mongo_uri", maskSensitive(cfg.MongoURI)),
 		zap.String("database_name", cfg.DatabaseName),
+		zap.Uint64("mongo_max_pool_size", cfg.MongoPool.MaxPoolSize),
+		zap.Int("grpc_max_recv_msg_size", cfg.GRPCLimits.MaxRecvMsgSize),
+		zap.Int("grpc_max_send_msg_size", cfg.GRPCLimits.MaxSendMsgSize),
+		zap.Int("sync_batch_size_min", cfg.SyncBatchSizeMin),
+		zap.Int("sync_batch_size_max", cfg.SyncBatchSizeMax),
+		zap.Int("sync_batch_size_default", cfg.SyncBatchSizeDefault),
+		zap.String("product_service_addr", cfg.ProductServiceAddr),
 	)
 
 	return cfg
@@ -38,6 +81,16 @@ func getEnv(key, fallback string) string {
 	return fallback
 }
 
+// getEnvInt gets an integer environment variable with fallback
+func getEnvInt(key string, fallback int) int {
+	if value := os.Getenv(key); value != "" {
+		if parsed, err := strconv.Atoi(value); err == nil {
+			return parsed
+		}
+	}
+	return fallback
+}
+
 // maskSensitive masks sensitive information for logging
 func maskSensitive(value string) string {
 	if len(value) > 20 {
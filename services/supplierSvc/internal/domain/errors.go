@@ -9,4 +9,7 @@ var (
 	ErrAlreadyExists = errors.New("resource already exists")
 	ErrUnauthorized  = errors.New("unauthorized")
 	ErrForbidden     = errors.New("forbidden")
+
+	// ErrSupplierInUse is returned when deleting a supplier that products still reference
+	ErrSupplierInUse = errors.New("supplier is referenced by products")
 )
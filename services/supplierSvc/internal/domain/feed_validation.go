@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// Supplier metadata keys that select and configure the supplier's feed adapter.
+// Keys prefixed with AdapterConfigPrefix are passed to the adapter with the
+// prefix stripped, e.g. "adapter.api_url" becomes "api_url".
+const (
+	AdapterMetadataKey  = "adapter"
+	AdapterConfigPrefix = "adapter."
+)
+
+// DefaultFeedSampleSize is the number of record errors kept in a feed
+// validation report when the caller does not ask for a different number
+const DefaultFeedSampleSize = 20
+
+// AdapterName returns the name of the adapter configured for the supplier's
+// feed, or "" when none is configured
+func (s *Supplier) AdapterName() string {
+	return s.Metadata[AdapterMetadataKey]
+}
+
+// AdapterConfig returns the adapter configuration stored in the supplier's metadata
+func (s *Supplier) AdapterConfig() map[string]string {
+	config := make(map[string]string)
+	for key, value := range s.Metadata {
+		if strings.HasPrefix(key, AdapterConfigPrefix) {
+			config[strings.TrimPrefix(key, AdapterConfigPrefix)] = value
+		}
+	}
+	return config
+}
+
+// FeedValidationOptions configures a dry-run validation of a supplier feed
+type FeedValidationOptions struct {
+	Sync SupplierSyncOptions
+	// CategoryMapping lists the supplier categories that map to a platform
+	// category. When empty, records only need to name at least one category.
+	CategoryMapping []string
+	// SampleSize caps the number of record errors kept in the report
+	SampleSize int
+}
+
+// FeedRecordError describes why one feed record would be rejected by a sync
+type FeedRecordError struct {
+	ExternalID string
+	SKU        string
+	Field      string
+	Message    string
+}
+
+// FeedValidationReport summarizes a feed validation. Nothing is written while
+// producing it.
+type FeedValidationReport struct {
+	SupplierID   string
+	AdapterName  string
+	Total        int
+	Valid        int
+	Invalid      int
+	SampleErrors []FeedRecordError
+	StartTime    time.Time
+	EndTime      time.Time
+}
+
+// ValidateFeedRecords checks every record the way a sync would before writing
+// it: required fields, price and currency format, SKU uniqueness within the
+// feed and category mapping. A record with several problems counts once as
+// invalid; each of its problems goes into the error sample while there is room.
+func ValidateFeedRecords(records []SupplierProductData, opts FeedValidationOptions) *FeedValidationReport {
+	sampleSize := opts.SampleSize
+	if sampleSize <= 0 {
+		sampleSize = DefaultFeedSampleSize
+	}
+
+	mapped := make(map[string]bool, len(opts.CategoryMapping))
+	for _, category := range opts.CategoryMapping {
+		mapped[strings.ToLower(strings.TrimSpace(category))] = true
+	}
+
+	report := &FeedValidationReport{Total: len(records)}
+	seenSKUs := make(map[string]string, len(records))
+	for _, record := range records {
+		problems := validateFeedRecord(record, mapped)
+
+		if sku := strings.TrimSpace(record.SKU); sku != "" {
+			if firstID, ok := seenSKUs[sku]; ok {
+				problems = append(problems, FeedRecordError{
+					Field:   "sku",
+					Message: fmt.Sprintf("SKU %s is also used by record %s", sku, firstID),
+				})
+			} else {
+				seenSKUs[sku] = record.ExternalID
+			}
+		}
+
+		if len(problems) == 0 {
+			report.Valid++
+			continue
+		}
+		report.Invalid++
+		for _, problem := range problems {
+			if len(report.SampleErrors) >= sampleSize {
+				break
+			}
+			problem.ExternalID = record.ExternalID
+			problem.SKU = record.SKU
+			report.SampleErrors = append(report.SampleErrors, problem)
+		}
+	}
+	return report
+}
+
+// validateFeedRecord checks the fields of a single record. mapped holds the
+// lower-cased mapped categories and may be empty.
+func validateFeedRecord(record SupplierProductData, mapped map[string]bool) []FeedRecordError {
+	var problems []FeedRecordError
+	required := func(field, value string) {
+		if strings.TrimSpace(value) == "" {
+			problems = append(problems, FeedRecordError{Field: field, Message: field + " is required"})
+		}
+	}
+	required("external_id", record.ExternalID)
+	required("name", record.Name)
+	required("sku", record.SKU)
+
+	switch {
+	case math.IsNaN(record.Price) || math.IsInf(record.Price, 0):
+		problems = append(problems, FeedRecordError{Field: "price", Message: "price is not a number"})
+	case record.Price <= 0:
+		problems = append(problems, FeedRecordError{Field: "price", Message: fmt.Sprintf("price must be positive, got %v", record.Price)})
+	}
+	if !isCurrencyCode(record.Currency) {
+		problems = append(problems, FeedRecordError{Field: "currency", Message: fmt.Sprintf("currency %q is not a three-letter ISO code", record.Currency)})
+	}
+
+	if len(record.Categories) == 0 {
+		problems = append(problems, FeedRecordError{Field: "categories", Message: "at least one category is required"})
+	} else if len(mapped) > 0 {
+		for _, category := range record.Categories {
+			if !mapped[strings.ToLower(strings.TrimSpace(category))] {
+				problems = append(problems, FeedRecordError{Field: "categories", Message: fmt.Sprintf("category %q has no mapping", category)})
+			}
+		}
+	}
+	return problems
+}
+
+// isCurrencyCode reports whether code looks like an ISO 4217 code, e.g. "EUR"
+func isCurrencyCode(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
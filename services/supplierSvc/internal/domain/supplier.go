@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -27,6 +28,56 @@ type Supplier struct {
 	Metadata      map[string]string  `bson:"metadata,omitempty" json:"metadata,omitempty"`
 	CreatedAt     time.Time          `bson:"created_at" json:"created_at"`
 	UpdatedAt     time.Time          `bson:"updated_at" json:"updated_at"`
+
+	// LastProductSyncAt and LastInventorySyncAt record when the last successful
+	// sync of each kind started. Incremental syncs default to pulling only the
+	// records changed since then.
+	LastProductSyncAt   *time.Time `bson:"last_product_sync_at,omitempty" json:"last_product_sync_at,omitempty"`
+	LastInventorySyncAt *time.Time `bson:"last_inventory_sync_at,omitempty" json:"last_inventory_sync_at,omitempty"`
+}
+
+// ApplyUpdate copies the named fields from src onto s. Field names match the
+// snake_case bson/json names (e.g. "phone", "lead_time_days"). An empty field
+// list copies nothing.
+func (s *Supplier) ApplyUpdate(src *Supplier, fields []string) error {
+	for _, field := range fields {
+		switch field {
+		case "name":
+			s.Name = src.Name
+		case "contact_person":
+			s.ContactPerson = src.ContactPerson
+		case "email":
+			s.Email = src.Email
+		case "phone":
+			s.Phone = src.Phone
+		case "address":
+			s.Address = src.Address
+		case "city":
+			s.City = src.City
+		case "state":
+			s.State = src.State
+		case "country":
+			s.Country = src.Country
+		case "postal_code":
+			s.PostalCode = src.PostalCode
+		case "tax_id":
+			s.TaxID = src.TaxID
+		case "website":
+			s.Website = src.Website
+		case "currency":
+			s.Currency = src.Currency
+		case "lead_time_days":
+			s.LeadTimeDays = src.LeadTimeDays
+		case "payment_terms":
+			s.PaymentTerms = src.PaymentTerms
+		case "metadata":
+			s.Metadata = src.Metadata
+		default:
+			return fmt.Errorf("%w: unknown supplier field %q", ErrInvalidInput, field)
+		}
+	}
+
+	return nil
 }
 
 // SupplierRepository defines the interface for supplier data operations
@@ -41,4 +92,6 @@ type SupplierRepository interface {
 	Delete(ctx context.Context, id string) error
 	// List retrieves a list of suppliers with pagination and optional filtering
 	List(ctx context.Context, page, pageSize int32, search string) ([]*Supplier, int32, error)
+	// RecordSync stores the start time of a successful sync of the given kind
+	RecordSync(ctx context.Context, id string, kind SyncKind, at time.Time) error
 }
@@ -59,19 +59,68 @@ type SupplierSyncStats struct {
 	InventoryUpdated   int
 	InventoryErrored   int
 	Errors             []string
+
+	// BatchSize is the effective batch size the sync ran with, after clamping
+	BatchSize int
 }
 
 // SupplierSyncOptions provides configuration options for a sync operation
 type SupplierSyncOptions struct {
-	SyncProducts  bool // Whether to sync product data
-	SyncInventory bool // Whether to sync inventory data
-	FullSync      bool // Whether to do a full sync vs incremental
-	SyncImages    bool // Whether to sync product images
-	BatchSize     int  // Batch size for processing
-	FromDate      time.Time
+	SyncProducts  bool      // Whether to sync product data
+	SyncInventory bool      // Whether to sync inventory data
+	FullSync      bool      // Whether to do a full sync vs incremental
+	SyncImages    bool      // Whether to sync product images
+	BatchSize     int       // Batch size for processing
+	FromDate      time.Time // Only pull records changed after this time when set
 	ToDate        time.Time
 }
 
+// SyncKind names the data a supplier sync pulls
+type SyncKind string
+
+const (
+	SyncKindProducts  SyncKind = "products"
+	SyncKindInventory SyncKind = "inventory"
+)
+
+// LastSyncAt returns when the last successful sync of the given kind started,
+// or the zero time when there has been none
+func (s *Supplier) LastSyncAt(kind SyncKind) time.Time {
+	var last *time.Time
+	switch kind {
+	case SyncKindProducts:
+		last = s.LastProductSyncAt
+	case SyncKindInventory:
+		last = s.LastInventorySyncAt
+	}
+	if last == nil {
+		return time.Time{}
+	}
+	return *last
+}
+
+// SyncBatchLimits bounds the batch size a sync may request
+type SyncBatchLimits struct {
+	Min     int // Smallest batch size accepted
+	Max     int // Largest batch size accepted
+	Default int // Batch size used when none is requested
+}
+
+// Clamp returns the batch size to use for a requested one: the default when
+// zero or negative, otherwise the request bounded to [Min, Max].
+func (l SyncBatchLimits) Clamp(requested int) int {
+	switch {
+	case requested <= 0:
+		return l.Default
+	case requested < l.Min:
+		return l.Min
+	case requested > l.Max:
+		return l.Max
+	default:
+		return requested
+	}
+}
+
 // SupplierAdapter defines the interface for supplier data integrations
 type SupplierAdapter interface {
 	// Name returns the name of this adapter
@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncBatchLimitsClamp(t *testing.T) {
+	limits := SyncBatchLimits{Min: 10, Max: 1000, Default: 100}
+
+	tests := []struct {
+		name      string
+		requested int
+		want      int
+	}{
+		{name: "zero uses the default", requested: 0, want: 100},
+		{name: "negative uses the default", requested: -5, want: 100},
+		{name: "below the minimum", requested: 3, want: 10},
+		{name: "above the maximum", requested: 50000, want: 1000},
+		{name: "in range", requested: 250, want: 250},
+		{name: "at the maximum", requested: 1000, want: 1000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, limits.Clamp(tt.requested))
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package domain
+
+import "context"
+
+// SupplierProducts looks up and moves the products that reference a supplier
+type SupplierProducts interface {
+	// CountProducts returns the number of products of the supplier
+	CountProducts(ctx context.Context, supplierID string) (int64, error)
+	// ReassignProducts moves every product of one supplier to another and
+	// returns how many were moved
+	ReassignProducts(ctx context.Context, fromSupplierID, toSupplierID string) (int64, error)
+}
+
+// DeleteSupplierOptions says what happens to a deleted supplier's products.
+// Without either option a supplier that still has products is not deleted.
+type DeleteSupplierOptions struct {
+	// ReassignTo moves the products to this supplier before deleting
+	ReassignTo string
+	// Force deletes the supplier even though products still reference it
+	Force bool
+}
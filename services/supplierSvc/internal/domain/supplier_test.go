@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestApplyUpdate(t *testing.T) {
+	base := func() *Supplier {
+		return &Supplier{Name: "Acme", Email: "sales@acme.test", Phone: "1", Website: "https://acme.test", LeadTimeDays: 5}
+	}
+	src := &Supplier{Name: "Other", Phone: "2", LeadTimeDays: 9}
+
+	tests := []struct {
+		name    string
+		fields  []string
+		want    *Supplier
+		wantErr error
+	}{
+		{
+			name:   "phone only",
+			fields: []string{"phone"},
+			want:   &Supplier{Name: "Acme", Email: "sales@acme.test", Phone: "2", Website: "https://acme.test", LeadTimeDays: 5},
+		},
+		{
+			name:   "empty field list is a no-op",
+			fields: nil,
+			want:   base(),
+		},
+		{
+			name:   "masked field cleared",
+			fields: []string{"website", "lead_time_days"},
+			want:   &Supplier{Name: "Acme", Email: "sales@acme.test", Phone: "1", LeadTimeDays: 9},
+		},
+		{
+			name:    "unknown field",
+			fields:  []string{"phone", "rating"},
+			wantErr: ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := base()
+			err := got.ApplyUpdate(src, tt.fields)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ApplyUpdate: %v", err)
+			}
+			if got.Name != tt.want.Name || got.Email != tt.want.Email || got.Phone != tt.want.Phone ||
+				got.Website != tt.want.Website || got.LeadTimeDays != tt.want.LeadTimeDays {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
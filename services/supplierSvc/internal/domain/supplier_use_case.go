@@ -7,8 +7,8 @@ type SupplierUseCase interface {
 	// Supplier CRUD operations
 	CreateSupplier(ctx context.Context, supplier *Supplier) (*Supplier, error)
 	GetSupplier(ctx context.Context, id string) (*Supplier, error)
-	UpdateSupplier(ctx context.Context, supplier *Supplier) (*Supplier, error)
-	DeleteSupplier(ctx context.Context, id string) error
+	UpdateSupplier(ctx context.Context, supplier *Supplier, fields []string) (*Supplier, error)
+	DeleteSupplier(ctx context.Context, id string, opts DeleteSupplierOptions) (int64, error)
 	ListSuppliers(ctx context.Context, page, pageSize int32, search string) ([]*Supplier, int32, error)
 
 	// Adapter-related operations
@@ -2,12 +2,15 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
 
 	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/domain"
 )
@@ -16,10 +19,56 @@ type supplierRepository struct {
 	collection *mongo.Collection
 }
 
+// Names of the unique indexes, used to tell which field a duplicate key error is about
+const (
+	taxIDIndexName = "tax_id_unique"
+	nameIndexName  = "name_unique"
+)
+
 // NewSupplierRepository creates a new MongoDB supplier repository
-func NewSupplierRepository(db *mongo.Database, collectionName string) domain.SupplierRepository {
+func NewSupplierRepository(db *mongo.Database, collectionName string, logger *zap.Logger) domain.SupplierRepository {
+	collection := db.Collection(collectionName)
+
+	// Tax IDs are optional and omitted when empty, so that index is sparse.
+	// Names are compared case-insensitively.
+	indexModels := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "tax_id", Value: 1}},
+			Options: options.Index().SetName(taxIDIndexName).SetUnique(true).SetSparse(true),
+		},
+		{
+			Keys: bson.D{{Key: "name", Value: 1}},
+			Options: options.Index().SetName(nameIndexName).SetUnique(true).
+				SetCollation(&options.Collation{Locale: "en", Strength: 2}),
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if _, err := collection.Indexes().CreateMany(ctx, indexModels); err != nil {
+		// Usually means existing suppliers already share a tax ID or name
+		logger.Warn("Failed to create unique supplier indexes", zap.Error(err))
+	}
+
 	return &supplierRepository{
-		collection: db.Collection(collectionName),
+		collection: collection,
+	}
+}
+
+// duplicateError converts a duplicate key error into ErrAlreadyExists naming
+// the conflicting field. Other errors are returned unchanged.
+func duplicateError(err error, supplier *domain.Supplier) error {
+	if !mongo.IsDuplicateKeyError(err) {
+		return err
+	}
+	switch {
+	case strings.Contains(err.Error(), taxIDIndexName):
+		return fmt.Errorf("%w: a supplier with tax ID %q already exists", domain.ErrAlreadyExists, supplier.TaxID)
+	case strings.Contains(err.Error(), nameIndexName):
+		return fmt.Errorf("%w: a supplier named %q already exists", domain.ErrAlreadyExists, supplier.Name)
+	default:
+		return fmt.Errorf("%w: %v", domain.ErrAlreadyExists, err)
 	}
 }
 
@@ -29,7 +78,7 @@ func (r *supplierRepository) Create(ctx context.Context, supplier *domain.Suppli
 
 	result, err := r.collection.InsertOne(ctx, supplier)
 	if err != nil {
-		return nil, err
+		return nil, duplicateError(err, supplier)
 	}
 
 	supplier.ID = result.InsertedID.(primitive.ObjectID)
@@ -74,7 +123,7 @@ func (r *supplierRepository) Update(ctx context.Context, supplier *domain.Suppli
 		if err == mongo.ErrNoDocuments {
 			return domain.ErrNotFound
 		}
-		return err
+		return duplicateError(err, supplier)
 	}
 
 	if result.MatchedCount == 0 {
@@ -84,6 +133,34 @@ func (r *supplierRepository) Update(ctx context.Context, supplier *domain.Suppli
 	return nil
 }
 
+// RecordSync sets only the sync timestamp so it cannot overwrite a concurrent
+// edit of the supplier's other fields
+func (r *supplierRepository) RecordSync(ctx context.Context, id string, kind domain.SyncKind, at time.Time) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return domain.ErrInvalidInput
+	}
+
+	var field string
+	switch kind {
+	case domain.SyncKindProducts:
+		field = "last_product_sync_at"
+	case domain.SyncKindInventory:
+		field = "last_inventory_sync_at"
+	default:
+		return fmt.Errorf("%w: unknown sync kind %q", domain.ErrInvalidInput, kind)
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": objectID}, bson.M{"$set": bson.M{field: at}})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
 func (r *supplierRepository) Delete(ctx context.Context, id string) error {
 	objectID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
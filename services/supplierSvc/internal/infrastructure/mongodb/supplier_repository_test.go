@@ -0,0 +1,64 @@
+package mongodb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/domain"
+)
+
+func TestCreateSupplierWithDuplicateTaxID(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("second supplier with the same tax ID", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(), // createIndexes
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}),
+			mtest.CreateWriteErrorsResponse(mtest.WriteError{
+				Code:    11000,
+				Message: `E11000 duplicate key error collection: test.suppliers index: tax_id_unique dup key: { tax_id: "BE0123456789" }`,
+			}),
+		)
+		repo := NewSupplierRepository(mt.DB, "suppliers", zap.NewNop())
+
+		_, err := repo.Create(context.Background(), &domain.Supplier{Name: "Acme", TaxID: "BE0123456789"})
+		require.NoError(mt, err)
+
+		_, err = repo.Create(context.Background(), &domain.Supplier{Name: "Acme Trading", TaxID: "BE0123456789"})
+		assert.ErrorIs(mt, err, domain.ErrAlreadyExists)
+		assert.Contains(mt, err.Error(), `tax ID "BE0123456789"`)
+	})
+
+	mt.Run("duplicate name", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(),
+			mtest.CreateWriteErrorsResponse(mtest.WriteError{
+				Code:    11000,
+				Message: `E11000 duplicate key error collection: test.suppliers index: name_unique dup key: { name: "acme" }`,
+			}),
+		)
+		repo := NewSupplierRepository(mt.DB, "suppliers", zap.NewNop())
+
+		_, err := repo.Create(context.Background(), &domain.Supplier{Name: "ACME"})
+		assert.ErrorIs(mt, err, domain.ErrAlreadyExists)
+		assert.Contains(mt, err.Error(), `named "ACME"`)
+	})
+
+	mt.Run("other errors are not conflicts", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(),
+			mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 91, Message: "shutting down"}),
+		)
+		repo := NewSupplierRepository(mt.DB, "suppliers", zap.NewNop())
+
+		_, err := repo.Create(context.Background(), &domain.Supplier{Name: "Acme"})
+		require.Error(mt, err)
+		assert.NotErrorIs(mt, err, domain.ErrAlreadyExists)
+	})
+}
@@ -0,0 +1,43 @@
+package product
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	productclient "github.com/leonvanderhaeghen/stockplatform/pkg/clients/product"
+)
+
+// SupplierProducts implements domain.SupplierProducts on top of the product service
+type SupplierProducts struct {
+	client *productclient.Client
+}
+
+// NewSupplierProducts creates a SupplierProducts connected to the product service
+func NewSupplierProducts(productServiceAddr string, logger *zap.Logger) (*SupplierProducts, error) {
+	client, err := productclient.New(productclient.Config{Address: productServiceAddr}, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create product client: %w", err)
+	}
+	return &SupplierProducts{client: client}, nil
+}
+
+// CountProducts reads the supplier's product count from a one-item product page
+func (p *SupplierProducts) CountProducts(ctx context.Context, supplierID string) (int64, error) {
+	resp, err := p.client.ListProducts(ctx, "", supplierID, nil, 1, 0)
+	if err != nil {
+		return 0, err
+	}
+	return int64(resp.TotalCount), nil
+}
+
+// ReassignProducts moves the supplier's products in the product service
+func (p *SupplierProducts) ReassignProducts(ctx context.Context, fromSupplierID, toSupplierID string) (int64, error) {
+	return p.client.ReassignSupplierProducts(ctx, fromSupplierID, toSupplierID)
+}
+
+// Close closes the product service connection
+func (p *SupplierProducts) Close() error {
+	return p.client.Close()
+}
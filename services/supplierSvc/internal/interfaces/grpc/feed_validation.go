@@ -0,0 +1,76 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	supplierv1 "github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/api/gen/go/proto/supplier/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/domain"
+)
+
+// ValidateFeed checks a supplier's product feed without syncing it
+func (s *SupplierServer) ValidateFeed(ctx context.Context, req *supplierv1.ValidateFeedRequest) (*supplierv1.ValidateFeedResponse, error) {
+	if req.GetSupplierId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "supplier ID is required")
+	}
+
+	opts := domain.FeedValidationOptions{
+		Sync: domain.SupplierSyncOptions{
+			SyncProducts: true,
+			FullSync:     req.GetOptions().GetFullSync(),
+			BatchSize:    int(req.GetOptions().GetBatchSize()),
+		},
+		CategoryMapping: req.GetCategoryMapping(),
+		SampleSize:      int(req.GetSampleSize()),
+	}
+	if req.GetOptions().GetSince() != nil {
+		opts.Sync.FromDate = req.GetOptions().GetSince().AsTime()
+	}
+
+	report, err := s.service.ValidateFeed(ctx, req.GetSupplierId(), opts)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return nil, status.Error(codes.NotFound, "supplier not found")
+		case errors.Is(err, domain.ErrInvalidInput):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		s.logger.Error("Failed to validate supplier feed",
+			zap.String("supplier_id", req.GetSupplierId()),
+			zap.Error(err),
+		)
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	s.logger.Info("Validated supplier feed",
+		zap.String("supplier_id", report.SupplierID),
+		zap.String("adapter", report.AdapterName),
+		zap.Int("total", report.Total),
+		zap.Int("invalid", report.Invalid),
+	)
+
+	resp := &supplierv1.ValidateFeedResponse{
+		SupplierId:   report.SupplierID,
+		AdapterName:  report.AdapterName,
+		Total:        int32(report.Total),
+		Valid:        int32(report.Valid),
+		Invalid:      int32(report.Invalid),
+		SampleErrors: make([]*supplierv1.FeedRecordError, 0, len(report.SampleErrors)),
+		StartedAt:    timestamppb.New(report.StartTime),
+		CompletedAt:  timestamppb.New(report.EndTime),
+	}
+	for _, e := range report.SampleErrors {
+		resp.SampleErrors = append(resp.SampleErrors, &supplierv1.FeedRecordError{
+			ExternalId: e.ExternalID,
+			Sku:        e.SKU,
+			Field:      e.Field,
+			Message:    e.Message,
+		})
+	}
+	return resp, nil
+}
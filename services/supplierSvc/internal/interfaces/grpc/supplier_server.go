@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"unicode/utf8"
 
@@ -50,6 +51,9 @@ func (s *SupplierServer) CreateSupplier(ctx context.Context, req *supplierv1.Cre
 
 	created, err := s.service.CreateSupplier(ctx, supplier)
 	if err != nil {
+		if errors.Is(err, domain.ErrAlreadyExists) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -97,11 +101,17 @@ func (s *SupplierServer) UpdateSupplier(ctx context.Context, req *supplierv1.Upd
 		Metadata:      req.GetMetadata(),
 	}
 
-	updated, err := s.service.UpdateSupplier(ctx, supplier)
+	updated, err := s.service.UpdateSupplier(ctx, supplier, req.GetUpdateMask().GetPaths())
 	if err != nil {
 		if err == domain.ErrNotFound {
 			return nil, status.Error(codes.NotFound, "supplier not found")
 		}
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, domain.ErrAlreadyExists) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -111,15 +121,28 @@ func (s *SupplierServer) UpdateSupplier(ctx context.Context, req *supplierv1.Upd
 }
 
 func (s *SupplierServer) DeleteSupplier(ctx context.Context, req *supplierv1.DeleteSupplierRequest) (*supplierv1.DeleteSupplierResponse, error) {
-	if err := s.service.DeleteSupplier(ctx, req.GetId()); err != nil {
-		if err == domain.ErrNotFound {
+	reassigned, err := s.service.DeleteSupplier(ctx, req.GetId(), domain.DeleteSupplierOptions{
+		ReassignTo: req.GetReassignTo(),
+		Force:      req.GetForce(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
 			return nil, status.Error(codes.NotFound, "supplier not found")
+		case errors.Is(err, domain.ErrSupplierInUse):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, domain.ErrInvalidInput):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	if req.GetForce() && req.GetReassignTo() == "" {
+		s.logger.Warn("Supplier force-deleted", zap.String("supplier_id", req.GetId()))
+	}
 	return &supplierv1.DeleteSupplierResponse{
-		Success: true,
+		Success:            true,
+		ProductsReassigned: reassigned,
 	}, nil
 }
 
@@ -0,0 +1,42 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	supplierv1 "github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/api/gen/go/proto/supplier/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/application"
+	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/domain"
+)
+
+// deleteErrService fails every deletion with err
+type deleteErrService struct {
+	application.SupplierService
+	err error
+}
+
+func (s *deleteErrService) DeleteSupplier(ctx context.Context, id string, opts domain.DeleteSupplierOptions) (int64, error) {
+	return 0, s.err
+}
+
+func TestDeleteSupplierErrorCodes(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{err: fmt.Errorf("%w: 3 products reference supplier", domain.ErrSupplierInUse), want: codes.FailedPrecondition},
+		{err: domain.ErrNotFound, want: codes.NotFound},
+		{err: fmt.Errorf("%w: bad reassign target", domain.ErrInvalidInput), want: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		server := NewSupplierServer(&deleteErrService{err: tt.err}, zap.NewNop())
+		_, err := server.DeleteSupplier(context.Background(), &supplierv1.DeleteSupplierRequest{Id: "supplier-1"})
+		assert.Equal(t, tt.want, status.Code(err), tt.err.Error())
+	}
+}
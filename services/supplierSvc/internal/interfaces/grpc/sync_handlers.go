@@ -0,0 +1,83 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	supplierv1 "github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/api/gen/go/proto/supplier/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/domain"
+)
+
+// SyncProducts pulls the supplier's products through its configured adapter
+func (s *SupplierServer) SyncProducts(ctx context.Context, req *supplierv1.SyncProductsRequest) (*supplierv1.SyncProductsResponse, error) {
+	jobID, stats, err := s.syncSupplier(ctx, req.GetSupplierId(), domain.SyncKindProducts, req.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+	return &supplierv1.SyncProductsResponse{
+		JobId: jobID,
+		Message: fmt.Sprintf("processed %d products: %d created, %d updated, %d failed",
+			stats.ProductsProcessed, stats.ProductsCreated, stats.ProductsUpdated, stats.ProductsErrored),
+	}, nil
+}
+
+// SyncInventory pulls the supplier's stock levels through its configured adapter
+func (s *SupplierServer) SyncInventory(ctx context.Context, req *supplierv1.SyncInventoryRequest) (*supplierv1.SyncInventoryResponse, error) {
+	jobID, stats, err := s.syncSupplier(ctx, req.GetSupplierId(), domain.SyncKindInventory, req.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+	return &supplierv1.SyncInventoryResponse{
+		JobId: jobID,
+		Message: fmt.Sprintf("processed %d inventory records: %d updated, %d failed",
+			stats.InventoryProcessed, stats.InventoryUpdated, stats.InventoryErrored),
+	}, nil
+}
+
+// syncSupplier runs a sync and returns the job ID it was logged under
+func (s *SupplierServer) syncSupplier(ctx context.Context, supplierID string, kind domain.SyncKind, opts *supplierv1.SyncOptions) (string, *domain.SupplierSyncStats, error) {
+	if supplierID == "" {
+		return "", nil, status.Error(codes.InvalidArgument, "supplier ID is required")
+	}
+
+	options := domain.SupplierSyncOptions{
+		FullSync:  opts.GetFullSync(),
+		BatchSize: int(opts.GetBatchSize()),
+	}
+	if opts.GetSince() != nil {
+		options.FromDate = opts.GetSince().AsTime()
+	}
+
+	jobID := primitive.NewObjectID().Hex()
+	log := s.logger.With(
+		zap.String("job_id", jobID),
+		zap.String("supplier_id", supplierID),
+		zap.String("kind", string(kind)),
+	)
+
+	stats, err := s.service.SyncSupplier(ctx, supplierID, kind, options)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return "", nil, status.Error(codes.NotFound, "supplier not found")
+		case errors.Is(err, domain.ErrInvalidInput):
+			return "", nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		log.Error("Supplier sync failed", zap.Error(err))
+		return "", nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	log.Info("Supplier sync completed",
+		zap.Bool("full_sync", options.FullSync),
+		zap.Int("batch_size", stats.BatchSize),
+		zap.Duration("duration", stats.EndTime.Sub(stats.StartTime)),
+		zap.Int("errors", len(stats.Errors)),
+	)
+	return jobID, stats, nil
+}
@@ -13,11 +13,14 @@ import (
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/version"
 	supplierv1 "github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/api/gen/go/proto/supplier/v1"
 	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/application"
 	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/bootstrap"
 	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/config"
 	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/database"
+	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/domain"
+	"github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/infrastructure/product"
 	grpchandlers "github.com/leonvanderhaeghen/stockplatform/services/supplierSvc/internal/interfaces/grpc"
 )
 
@@ -41,10 +44,20 @@ func New(cfg *config.Config, db *database.Database, logger *zap.Logger) *Server
 // Initialize sets up the gRPC server with all services
 func (s *Server) Initialize() error {
 	// Create gRPC server
-	s.grpcServer = grpc.NewServer()
+	s.grpcServer = grpc.NewServer(s.config.GRPCLimits.ServerOptions()...)
+
+	// Suppliers that products still reference are not deleted
+	products, err := product.NewSupplierProducts(s.config.ProductServiceAddr, s.logger)
+	if err != nil {
+		return err
+	}
 
 	// Initialize services
-	supplierService := application.NewSupplierService(s.database.SupplierRepo)
+	supplierService := application.NewSupplierService(s.database.SupplierRepo, domain.SyncBatchLimits{
+		Min:     s.config.SyncBatchSizeMin,
+		Max:     s.config.SyncBatchSizeMax,
+		Default: s.config.SyncBatchSizeDefault,
+	}, products)
 
 	// Register supplier adapters
 	bootstrap.RegisterAdapters(supplierService)
@@ -57,7 +70,7 @@ func (s *Server) Initialize() error {
 
 	// Register health check service
 	healthServer := grpchandlers.NewHealthServer(s.logger)
-	grpc_health_v1.RegisterHealthServer(s.grpcServer, healthServer)
+	grpc_health_v1.RegisterHealthServer(s.grpcServer, version.NewHealthServer(healthServer))
 
 	// Enable reflection for development
 	reflection.Register(s.grpcServer)
@@ -9,6 +9,7 @@ package userv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -135,18 +136,26 @@ func (x *ManagedResources) GetSupplierIds() []string {
 
 // User represents a user account
 type User struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email            string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	FirstName        string                 `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName         string                 `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Role             Role                   `protobuf:"varint,5,opt,name=role,proto3,enum=user.v1.Role" json:"role,omitempty"`
-	Phone            string                 `protobuf:"bytes,6,opt,name=phone,proto3" json:"phone,omitempty"`
-	Active           bool                   `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
-	LastLogin        string                 `protobuf:"bytes,8,opt,name=last_login,json=lastLogin,proto3" json:"last_login,omitempty"`
-	CreatedAt        string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email     string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	FirstName string                 `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string                 `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Role      Role                   `protobuf:"varint,5,opt,name=role,proto3,enum=user.v1.Role" json:"role,omitempty"`
+	Phone     string                 `protobuf:"bytes,6,opt,name=phone,proto3" json:"phone,omitempty"`
+	Active    bool                   `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	// RFC3339 forms of the *_ts fields, still populated until clients have moved over
+	//
+	// Deprecated: Marked as deprecated in user/v1/user.proto.
+	LastLogin string `protobuf:"bytes,8,opt,name=last_login,json=lastLogin,proto3" json:"last_login,omitempty"`
+	// Deprecated: Marked as deprecated in user/v1/user.proto.
+	CreatedAt string `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Deprecated: Marked as deprecated in user/v1/user.proto.
 	UpdatedAt        string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	ManagedResources *ManagedResources      `protobuf:"bytes,11,opt,name=managed_resources,json=managedResources,proto3" json:"managed_resources,omitempty"` // Resources this user can manage
+	LastLoginTs      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=last_login_ts,json=lastLoginTs,proto3" json:"last_login_ts,omitempty"`              // Unset until the user first logs in
+	CreatedAtTs      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at_ts,json=createdAtTs,proto3" json:"created_at_ts,omitempty"`
+	UpdatedAtTs      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at_ts,json=updatedAtTs,proto3" json:"updated_at_ts,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -230,6 +239,7 @@ func (x *User) GetActive() bool {
 	return false
 }
 
+// Deprecated: Marked as deprecated in user/v1/user.proto.
 func (x *User) GetLastLogin() string {
 	if x != nil {
 		return x.LastLogin
@@ -237,6 +247,7 @@ func (x *User) GetLastLogin() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in user/v1/user.proto.
 func (x *User) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
@@ -244,6 +255,7 @@ func (x *User) GetCreatedAt() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in user/v1/user.proto.
 func (x *User) GetUpdatedAt() string {
 	if x != nil {
 		return x.UpdatedAt
@@ -258,21 +270,48 @@ func (x *User) GetManagedResources() *ManagedResources {
 	return nil
 }
 
+func (x *User) GetLastLoginTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastLoginTs
+	}
+	return nil
+}
+
+func (x *User) GetCreatedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtTs
+	}
+	return nil
+}
+
+func (x *User) GetUpdatedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAtTs
+	}
+	return nil
+}
+
 // Address represents a user address
 type Address struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Street        string                 `protobuf:"bytes,4,opt,name=street,proto3" json:"street,omitempty"`
-	City          string                 `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
-	State         string                 `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
-	PostalCode    string                 `protobuf:"bytes,7,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
-	Country       string                 `protobuf:"bytes,8,opt,name=country,proto3" json:"country,omitempty"`
-	IsDefault     bool                   `protobuf:"varint,9,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
-	Phone         string                 `protobuf:"bytes,10,opt,name=phone,proto3" json:"phone,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId     string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Street     string                 `protobuf:"bytes,4,opt,name=street,proto3" json:"street,omitempty"`
+	City       string                 `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
+	State      string                 `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	PostalCode string                 `protobuf:"bytes,7,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Country    string                 `protobuf:"bytes,8,opt,name=country,proto3" json:"country,omitempty"`
+	IsDefault  bool                   `protobuf:"varint,9,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Phone      string                 `protobuf:"bytes,10,opt,name=phone,proto3" json:"phone,omitempty"`
+	// RFC3339 forms of the *_ts fields, still populated until clients have moved over
+	//
+	// Deprecated: Marked as deprecated in user/v1/user.proto.
+	CreatedAt string `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Deprecated: Marked as deprecated in user/v1/user.proto.
 	UpdatedAt     string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedAtTs   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at_ts,json=createdAtTs,proto3" json:"created_at_ts,omitempty"`
+	UpdatedAtTs   *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at_ts,json=updatedAtTs,proto3" json:"updated_at_ts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -377,6 +416,7 @@ func (x *Address) GetPhone() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in user/v1/user.proto.
 func (x *Address) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
@@ -384,6 +424,7 @@ func (x *Address) GetCreatedAt() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in user/v1/user.proto.
 func (x *Address) GetUpdatedAt() string {
 	if x != nil {
 		return x.UpdatedAt
@@ -391,6 +432,20 @@ func (x *Address) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *Address) GetCreatedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtTs
+	}
+	return nil
+}
+
+func (x *Address) GetUpdatedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAtTs
+	}
+	return nil
+}
+
 // RegisterUserRequest is the request for registering a new user
 type RegisterUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -568,11 +623,18 @@ func (x *AuthenticateUserRequest) GetPassword() string {
 
 // AuthenticateUserResponse is the response for authenticating a user
 type AuthenticateUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// token is the access token
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	User  *User  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// expires_at is when the access token expires, in Unix seconds
+	ExpiresAt int64 `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// refresh_token is exchanged for new access tokens with RefreshToken
+	RefreshToken string `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// refresh_expires_at is when the refresh token expires, in Unix seconds
+	RefreshExpiresAt int64 `protobuf:"varint,5,opt,name=refresh_expires_at,json=refreshExpiresAt,proto3" json:"refresh_expires_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *AuthenticateUserResponse) Reset() {
@@ -619,6 +681,156 @@ func (x *AuthenticateUserResponse) GetUser() *User {
 	return nil
 }
 
+func (x *AuthenticateUserResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *AuthenticateUserResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *AuthenticateUserResponse) GetRefreshExpiresAt() int64 {
+	if x != nil {
+		return x.RefreshExpiresAt
+	}
+	return 0
+}
+
+// RefreshTokenRequest is the request for refreshing a token
+type RefreshTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// refresh_token is the refresh token returned by AuthenticateUser; access
+	// tokens are refused
+	RefreshToken  string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// RefreshTokenResponse carries the new access token and the user it was
+// issued for
+type RefreshTokenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	User  *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// expires_at is when the new access token expires, in Unix seconds
+	ExpiresAt int64 `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// refresh_token is the refresh token that was exchanged; refreshing does
+	// not extend it
+	RefreshToken string `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// refresh_expires_at is when the refresh token expires, in Unix seconds
+	RefreshExpiresAt int64 `protobuf:"varint,5,opt,name=refresh_expires_at,json=refreshExpiresAt,proto3" json:"refresh_expires_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RefreshTokenResponse) Reset() {
+	*x = RefreshTokenResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenResponse) ProtoMessage() {}
+
+func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RefreshTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *RefreshTokenResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *RefreshTokenResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetRefreshExpiresAt() int64 {
+	if x != nil {
+		return x.RefreshExpiresAt
+	}
+	return 0
+}
+
 // GetUserRequest is the request for retrieving a user by ID
 type GetUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -629,7 +841,7 @@ type GetUserRequest struct {
 
 func (x *GetUserRequest) Reset() {
 	*x = GetUserRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[7]
+	mi := &file_user_v1_user_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -641,7 +853,7 @@ func (x *GetUserRequest) String() string {
 func (*GetUserRequest) ProtoMessage() {}
 
 func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[7]
+	mi := &file_user_v1_user_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,7 +866,7 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
 func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{7}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetUserRequest) GetId() string {
@@ -674,7 +886,7 @@ type GetUserByEmailRequest struct {
 
 func (x *GetUserByEmailRequest) Reset() {
 	*x = GetUserByEmailRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[8]
+	mi := &file_user_v1_user_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -686,7 +898,7 @@ func (x *GetUserByEmailRequest) String() string {
 func (*GetUserByEmailRequest) ProtoMessage() {}
 
 func (x *GetUserByEmailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[8]
+	mi := &file_user_v1_user_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -699,7 +911,7 @@ func (x *GetUserByEmailRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByEmailRequest.ProtoReflect.Descriptor instead.
 func (*GetUserByEmailRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{8}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetUserByEmailRequest) GetEmail() string {
@@ -719,7 +931,7 @@ type GetUserResponse struct {
 
 func (x *GetUserResponse) Reset() {
 	*x = GetUserResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[9]
+	mi := &file_user_v1_user_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -731,7 +943,7 @@ func (x *GetUserResponse) String() string {
 func (*GetUserResponse) ProtoMessage() {}
 
 func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[9]
+	mi := &file_user_v1_user_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -744,7 +956,7 @@ func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
 func (*GetUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{9}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetUserResponse) GetUser() *User {
@@ -767,7 +979,7 @@ type UpdateUserProfileRequest struct {
 
 func (x *UpdateUserProfileRequest) Reset() {
 	*x = UpdateUserProfileRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[10]
+	mi := &file_user_v1_user_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -779,7 +991,7 @@ func (x *UpdateUserProfileRequest) String() string {
 func (*UpdateUserProfileRequest) ProtoMessage() {}
 
 func (x *UpdateUserProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[10]
+	mi := &file_user_v1_user_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -792,7 +1004,7 @@ func (x *UpdateUserProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserProfileRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserProfileRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{10}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateUserProfileRequest) GetId() string {
@@ -833,7 +1045,7 @@ type UpdateUserProfileResponse struct {
 
 func (x *UpdateUserProfileResponse) Reset() {
 	*x = UpdateUserProfileResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[11]
+	mi := &file_user_v1_user_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -845,7 +1057,7 @@ func (x *UpdateUserProfileResponse) String() string {
 func (*UpdateUserProfileResponse) ProtoMessage() {}
 
 func (x *UpdateUserProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[11]
+	mi := &file_user_v1_user_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -858,7 +1070,7 @@ func (x *UpdateUserProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserProfileResponse.ProtoReflect.Descriptor instead.
 func (*UpdateUserProfileResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{11}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateUserProfileResponse) GetSuccess() bool {
@@ -880,7 +1092,7 @@ type ChangeUserPasswordRequest struct {
 
 func (x *ChangeUserPasswordRequest) Reset() {
 	*x = ChangeUserPasswordRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[12]
+	mi := &file_user_v1_user_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -892,7 +1104,7 @@ func (x *ChangeUserPasswordRequest) String() string {
 func (*ChangeUserPasswordRequest) ProtoMessage() {}
 
 func (x *ChangeUserPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[12]
+	mi := &file_user_v1_user_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -905,7 +1117,7 @@ func (x *ChangeUserPasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeUserPasswordRequest.ProtoReflect.Descriptor instead.
 func (*ChangeUserPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{12}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ChangeUserPasswordRequest) GetId() string {
@@ -939,7 +1151,7 @@ type ChangeUserPasswordResponse struct {
 
 func (x *ChangeUserPasswordResponse) Reset() {
 	*x = ChangeUserPasswordResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[13]
+	mi := &file_user_v1_user_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -951,7 +1163,7 @@ func (x *ChangeUserPasswordResponse) String() string {
 func (*ChangeUserPasswordResponse) ProtoMessage() {}
 
 func (x *ChangeUserPasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[13]
+	mi := &file_user_v1_user_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -964,7 +1176,7 @@ func (x *ChangeUserPasswordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeUserPasswordResponse.ProtoReflect.Descriptor instead.
 func (*ChangeUserPasswordResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{13}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ChangeUserPasswordResponse) GetSuccess() bool {
@@ -984,7 +1196,7 @@ type DeactivateUserRequest struct {
 
 func (x *DeactivateUserRequest) Reset() {
 	*x = DeactivateUserRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[14]
+	mi := &file_user_v1_user_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -996,7 +1208,7 @@ func (x *DeactivateUserRequest) String() string {
 func (*DeactivateUserRequest) ProtoMessage() {}
 
 func (x *DeactivateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[14]
+	mi := &file_user_v1_user_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1009,7 +1221,7 @@ func (x *DeactivateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeactivateUserRequest.ProtoReflect.Descriptor instead.
 func (*DeactivateUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{14}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *DeactivateUserRequest) GetId() string {
@@ -1029,7 +1241,7 @@ type DeactivateUserResponse struct {
 
 func (x *DeactivateUserResponse) Reset() {
 	*x = DeactivateUserResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[15]
+	mi := &file_user_v1_user_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1041,7 +1253,7 @@ func (x *DeactivateUserResponse) String() string {
 func (*DeactivateUserResponse) ProtoMessage() {}
 
 func (x *DeactivateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[15]
+	mi := &file_user_v1_user_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1054,7 +1266,7 @@ func (x *DeactivateUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeactivateUserResponse.ProtoReflect.Descriptor instead.
 func (*DeactivateUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{15}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DeactivateUserResponse) GetSuccess() bool {
@@ -1074,7 +1286,7 @@ type ActivateUserRequest struct {
 
 func (x *ActivateUserRequest) Reset() {
 	*x = ActivateUserRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[16]
+	mi := &file_user_v1_user_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1086,7 +1298,7 @@ func (x *ActivateUserRequest) String() string {
 func (*ActivateUserRequest) ProtoMessage() {}
 
 func (x *ActivateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[16]
+	mi := &file_user_v1_user_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1099,7 +1311,7 @@ func (x *ActivateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActivateUserRequest.ProtoReflect.Descriptor instead.
 func (*ActivateUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{16}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ActivateUserRequest) GetId() string {
@@ -1119,7 +1331,7 @@ type ActivateUserResponse struct {
 
 func (x *ActivateUserResponse) Reset() {
 	*x = ActivateUserResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[17]
+	mi := &file_user_v1_user_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1131,7 +1343,7 @@ func (x *ActivateUserResponse) String() string {
 func (*ActivateUserResponse) ProtoMessage() {}
 
 func (x *ActivateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[17]
+	mi := &file_user_v1_user_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1144,7 +1356,7 @@ func (x *ActivateUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActivateUserResponse.ProtoReflect.Descriptor instead.
 func (*ActivateUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{17}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ActivateUserResponse) GetSuccess() bool {
@@ -1167,7 +1379,7 @@ type ListUsersRequest struct {
 
 func (x *ListUsersRequest) Reset() {
 	*x = ListUsersRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[18]
+	mi := &file_user_v1_user_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1179,7 +1391,7 @@ func (x *ListUsersRequest) String() string {
 func (*ListUsersRequest) ProtoMessage() {}
 
 func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[18]
+	mi := &file_user_v1_user_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1192,7 +1404,7 @@ func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
 func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{18}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ListUsersRequest) GetRole() string {
@@ -1227,13 +1439,16 @@ func (x *ListUsersRequest) GetOffset() int32 {
 type ListUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Number of matching users across all pages
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                             // Effective page size
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListUsersResponse) Reset() {
 	*x = ListUsersResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[19]
+	mi := &file_user_v1_user_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1245,7 +1460,7 @@ func (x *ListUsersResponse) String() string {
 func (*ListUsersResponse) ProtoMessage() {}
 
 func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[19]
+	mi := &file_user_v1_user_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1258,7 +1473,7 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
 func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{19}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ListUsersResponse) GetUsers() []*User {
@@ -1268,6 +1483,27 @@ func (x *ListUsersResponse) GetUsers() []*User {
 	return nil
 }
 
+func (x *ListUsersResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListUsersResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListUsersResponse) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
 // CreateUserAddressRequest is the request for creating a user address
 type CreateUserAddressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1286,7 +1522,7 @@ type CreateUserAddressRequest struct {
 
 func (x *CreateUserAddressRequest) Reset() {
 	*x = CreateUserAddressRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[20]
+	mi := &file_user_v1_user_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1298,7 +1534,7 @@ func (x *CreateUserAddressRequest) String() string {
 func (*CreateUserAddressRequest) ProtoMessage() {}
 
 func (x *CreateUserAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[20]
+	mi := &file_user_v1_user_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1311,7 +1547,7 @@ func (x *CreateUserAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserAddressRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserAddressRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{20}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *CreateUserAddressRequest) GetUserId() string {
@@ -1387,7 +1623,7 @@ type CreateUserAddressResponse struct {
 
 func (x *CreateUserAddressResponse) Reset() {
 	*x = CreateUserAddressResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[21]
+	mi := &file_user_v1_user_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1399,7 +1635,7 @@ func (x *CreateUserAddressResponse) String() string {
 func (*CreateUserAddressResponse) ProtoMessage() {}
 
 func (x *CreateUserAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[21]
+	mi := &file_user_v1_user_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1412,7 +1648,7 @@ func (x *CreateUserAddressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserAddressResponse.ProtoReflect.Descriptor instead.
 func (*CreateUserAddressResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{21}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *CreateUserAddressResponse) GetAddress() *Address {
@@ -1432,7 +1668,7 @@ type GetUserAddressesRequest struct {
 
 func (x *GetUserAddressesRequest) Reset() {
 	*x = GetUserAddressesRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[22]
+	mi := &file_user_v1_user_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1444,7 +1680,7 @@ func (x *GetUserAddressesRequest) String() string {
 func (*GetUserAddressesRequest) ProtoMessage() {}
 
 func (x *GetUserAddressesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[22]
+	mi := &file_user_v1_user_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1457,7 +1693,7 @@ func (x *GetUserAddressesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserAddressesRequest.ProtoReflect.Descriptor instead.
 func (*GetUserAddressesRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{22}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetUserAddressesRequest) GetUserId() string {
@@ -1477,7 +1713,7 @@ type GetUserAddressesResponse struct {
 
 func (x *GetUserAddressesResponse) Reset() {
 	*x = GetUserAddressesResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[23]
+	mi := &file_user_v1_user_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1489,7 +1725,7 @@ func (x *GetUserAddressesResponse) String() string {
 func (*GetUserAddressesResponse) ProtoMessage() {}
 
 func (x *GetUserAddressesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[23]
+	mi := &file_user_v1_user_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1502,7 +1738,7 @@ func (x *GetUserAddressesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserAddressesResponse.ProtoReflect.Descriptor instead.
 func (*GetUserAddressesResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{23}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetUserAddressesResponse) GetAddresses() []*Address {
@@ -1522,7 +1758,7 @@ type GetUserDefaultAddressRequest struct {
 
 func (x *GetUserDefaultAddressRequest) Reset() {
 	*x = GetUserDefaultAddressRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[24]
+	mi := &file_user_v1_user_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1534,7 +1770,7 @@ func (x *GetUserDefaultAddressRequest) String() string {
 func (*GetUserDefaultAddressRequest) ProtoMessage() {}
 
 func (x *GetUserDefaultAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[24]
+	mi := &file_user_v1_user_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1547,7 +1783,7 @@ func (x *GetUserDefaultAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserDefaultAddressRequest.ProtoReflect.Descriptor instead.
 func (*GetUserDefaultAddressRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{24}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetUserDefaultAddressRequest) GetUserId() string {
@@ -1567,7 +1803,7 @@ type GetUserDefaultAddressResponse struct {
 
 func (x *GetUserDefaultAddressResponse) Reset() {
 	*x = GetUserDefaultAddressResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[25]
+	mi := &file_user_v1_user_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1579,7 +1815,7 @@ func (x *GetUserDefaultAddressResponse) String() string {
 func (*GetUserDefaultAddressResponse) ProtoMessage() {}
 
 func (x *GetUserDefaultAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[25]
+	mi := &file_user_v1_user_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1592,7 +1828,7 @@ func (x *GetUserDefaultAddressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserDefaultAddressResponse.ProtoReflect.Descriptor instead.
 func (*GetUserDefaultAddressResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{25}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetUserDefaultAddressResponse) GetAddress() *Address {
@@ -1621,7 +1857,7 @@ type UpdateUserAddressRequest struct {
 
 func (x *UpdateUserAddressRequest) Reset() {
 	*x = UpdateUserAddressRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[26]
+	mi := &file_user_v1_user_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1633,7 +1869,7 @@ func (x *UpdateUserAddressRequest) String() string {
 func (*UpdateUserAddressRequest) ProtoMessage() {}
 
 func (x *UpdateUserAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[26]
+	mi := &file_user_v1_user_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1646,7 +1882,7 @@ func (x *UpdateUserAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserAddressRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserAddressRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{26}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *UpdateUserAddressRequest) GetId() string {
@@ -1729,7 +1965,7 @@ type UpdateUserAddressResponse struct {
 
 func (x *UpdateUserAddressResponse) Reset() {
 	*x = UpdateUserAddressResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[27]
+	mi := &file_user_v1_user_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1741,7 +1977,7 @@ func (x *UpdateUserAddressResponse) String() string {
 func (*UpdateUserAddressResponse) ProtoMessage() {}
 
 func (x *UpdateUserAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[27]
+	mi := &file_user_v1_user_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1754,7 +1990,7 @@ func (x *UpdateUserAddressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserAddressResponse.ProtoReflect.Descriptor instead.
 func (*UpdateUserAddressResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{27}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *UpdateUserAddressResponse) GetSuccess() bool {
@@ -1775,7 +2011,7 @@ type DeleteUserAddressRequest struct {
 
 func (x *DeleteUserAddressRequest) Reset() {
 	*x = DeleteUserAddressRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[28]
+	mi := &file_user_v1_user_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1787,7 +2023,7 @@ func (x *DeleteUserAddressRequest) String() string {
 func (*DeleteUserAddressRequest) ProtoMessage() {}
 
 func (x *DeleteUserAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[28]
+	mi := &file_user_v1_user_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1800,7 +2036,7 @@ func (x *DeleteUserAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserAddressRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserAddressRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{28}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *DeleteUserAddressRequest) GetId() string {
@@ -1827,7 +2063,7 @@ type DeleteUserAddressResponse struct {
 
 func (x *DeleteUserAddressResponse) Reset() {
 	*x = DeleteUserAddressResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[29]
+	mi := &file_user_v1_user_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1839,7 +2075,7 @@ func (x *DeleteUserAddressResponse) String() string {
 func (*DeleteUserAddressResponse) ProtoMessage() {}
 
 func (x *DeleteUserAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[29]
+	mi := &file_user_v1_user_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1852,7 +2088,7 @@ func (x *DeleteUserAddressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserAddressResponse.ProtoReflect.Descriptor instead.
 func (*DeleteUserAddressResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{29}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *DeleteUserAddressResponse) GetSuccess() bool {
@@ -1873,7 +2109,7 @@ type SetDefaultUserAddressRequest struct {
 
 func (x *SetDefaultUserAddressRequest) Reset() {
 	*x = SetDefaultUserAddressRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[30]
+	mi := &file_user_v1_user_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1885,7 +2121,7 @@ func (x *SetDefaultUserAddressRequest) String() string {
 func (*SetDefaultUserAddressRequest) ProtoMessage() {}
 
 func (x *SetDefaultUserAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[30]
+	mi := &file_user_v1_user_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1898,7 +2134,7 @@ func (x *SetDefaultUserAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetDefaultUserAddressRequest.ProtoReflect.Descriptor instead.
 func (*SetDefaultUserAddressRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{30}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *SetDefaultUserAddressRequest) GetId() string {
@@ -1925,7 +2161,7 @@ type SetDefaultUserAddressResponse struct {
 
 func (x *SetDefaultUserAddressResponse) Reset() {
 	*x = SetDefaultUserAddressResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[31]
+	mi := &file_user_v1_user_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1937,7 +2173,7 @@ func (x *SetDefaultUserAddressResponse) String() string {
 func (*SetDefaultUserAddressResponse) ProtoMessage() {}
 
 func (x *SetDefaultUserAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[31]
+	mi := &file_user_v1_user_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1950,7 +2186,7 @@ func (x *SetDefaultUserAddressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetDefaultUserAddressResponse.ProtoReflect.Descriptor instead.
 func (*SetDefaultUserAddressResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{31}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *SetDefaultUserAddressResponse) GetSuccess() bool {
@@ -1960,6 +2196,388 @@ func (x *SetDefaultUserAddressResponse) GetSuccess() bool {
 	return false
 }
 
+// AddressInput holds the fields of an address to be created
+type AddressInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Street        string                 `protobuf:"bytes,2,opt,name=street,proto3" json:"street,omitempty"`
+	City          string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,5,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Country       string                 `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
+	Phone         string                 `protobuf:"bytes,7,opt,name=phone,proto3" json:"phone,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,8,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressInput) Reset() {
+	*x = AddressInput{}
+	mi := &file_user_v1_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressInput) ProtoMessage() {}
+
+func (x *AddressInput) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressInput.ProtoReflect.Descriptor instead.
+func (*AddressInput) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AddressInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddressInput) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *AddressInput) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *AddressInput) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *AddressInput) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *AddressInput) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *AddressInput) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *AddressInput) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+// BulkCreateUserAddressesRequest is the request for importing several addresses
+type BulkCreateUserAddressesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Addresses     []*AddressInput        `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateUserAddressesRequest) Reset() {
+	*x = BulkCreateUserAddressesRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateUserAddressesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateUserAddressesRequest) ProtoMessage() {}
+
+func (x *BulkCreateUserAddressesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateUserAddressesRequest.ProtoReflect.Descriptor instead.
+func (*BulkCreateUserAddressesRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BulkCreateUserAddressesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BulkCreateUserAddressesRequest) GetAddresses() []*AddressInput {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+// AddressResult is the outcome of creating one address in a bulk import
+type AddressResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Address       *Address               `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressResult) Reset() {
+	*x = AddressResult{}
+	mi := &file_user_v1_user_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressResult) ProtoMessage() {}
+
+func (x *AddressResult) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressResult.ProtoReflect.Descriptor instead.
+func (*AddressResult) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AddressResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *AddressResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AddressResult) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *AddressResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// BulkCreateUserAddressesResponse is the response for importing several addresses
+type BulkCreateUserAddressesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*AddressResult       `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	CreatedCount  int32                  `protobuf:"varint,2,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	FailedCount   int32                  `protobuf:"varint,3,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateUserAddressesResponse) Reset() {
+	*x = BulkCreateUserAddressesResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateUserAddressesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateUserAddressesResponse) ProtoMessage() {}
+
+func (x *BulkCreateUserAddressesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateUserAddressesResponse.ProtoReflect.Descriptor instead.
+func (*BulkCreateUserAddressesResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BulkCreateUserAddressesResponse) GetResults() []*AddressResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkCreateUserAddressesResponse) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *BulkCreateUserAddressesResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+// CountUsersRequest is the request for counting users
+type CountUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`                                // Optional: only count users with this role
+	ActiveOnly    bool                   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"` // Only count active users
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountUsersRequest) Reset() {
+	*x = CountUsersRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountUsersRequest) ProtoMessage() {}
+
+func (x *CountUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountUsersRequest.ProtoReflect.Descriptor instead.
+func (*CountUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CountUsersRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CountUsersRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+// CountUsersResponse is the response for counting users
+type CountUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountUsersResponse) Reset() {
+	*x = CountUsersResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountUsersResponse) ProtoMessage() {}
+
+func (x *CountUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountUsersResponse.ProtoReflect.Descriptor instead.
+func (*CountUsersResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CountUsersResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 // ValidateTokenRequest is the request for validating a JWT token
 type ValidateTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1970,7 +2588,7 @@ type ValidateTokenRequest struct {
 
 func (x *ValidateTokenRequest) Reset() {
 	*x = ValidateTokenRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[32]
+	mi := &file_user_v1_user_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1982,7 +2600,7 @@ func (x *ValidateTokenRequest) String() string {
 func (*ValidateTokenRequest) ProtoMessage() {}
 
 func (x *ValidateTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[32]
+	mi := &file_user_v1_user_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1995,7 +2613,7 @@ func (x *ValidateTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateTokenRequest.ProtoReflect.Descriptor instead.
 func (*ValidateTokenRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{32}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ValidateTokenRequest) GetToken() string {
@@ -2017,7 +2635,7 @@ type ValidateTokenResponse struct {
 
 func (x *ValidateTokenResponse) Reset() {
 	*x = ValidateTokenResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[33]
+	mi := &file_user_v1_user_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2029,7 +2647,7 @@ func (x *ValidateTokenResponse) String() string {
 func (*ValidateTokenResponse) ProtoMessage() {}
 
 func (x *ValidateTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[33]
+	mi := &file_user_v1_user_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2042,7 +2660,7 @@ func (x *ValidateTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateTokenResponse.ProtoReflect.Descriptor instead.
 func (*ValidateTokenResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{33}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *ValidateTokenResponse) GetValid() bool {
@@ -2079,7 +2697,7 @@ type AuthorizeRequest struct {
 
 func (x *AuthorizeRequest) Reset() {
 	*x = AuthorizeRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[34]
+	mi := &file_user_v1_user_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2091,7 +2709,7 @@ func (x *AuthorizeRequest) String() string {
 func (*AuthorizeRequest) ProtoMessage() {}
 
 func (x *AuthorizeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[34]
+	mi := &file_user_v1_user_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2104,7 +2722,7 @@ func (x *AuthorizeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthorizeRequest.ProtoReflect.Descriptor instead.
 func (*AuthorizeRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{34}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *AuthorizeRequest) GetUserId() string {
@@ -2146,7 +2764,7 @@ type AuthorizeResponse struct {
 
 func (x *AuthorizeResponse) Reset() {
 	*x = AuthorizeResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[35]
+	mi := &file_user_v1_user_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2158,7 +2776,7 @@ func (x *AuthorizeResponse) String() string {
 func (*AuthorizeResponse) ProtoMessage() {}
 
 func (x *AuthorizeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[35]
+	mi := &file_user_v1_user_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2171,7 +2789,7 @@ func (x *AuthorizeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthorizeResponse.ProtoReflect.Descriptor instead.
 func (*AuthorizeResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{35}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *AuthorizeResponse) GetAuthorized() bool {
@@ -2199,7 +2817,7 @@ type CheckPermissionRequest struct {
 
 func (x *CheckPermissionRequest) Reset() {
 	*x = CheckPermissionRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[36]
+	mi := &file_user_v1_user_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2211,7 +2829,7 @@ func (x *CheckPermissionRequest) String() string {
 func (*CheckPermissionRequest) ProtoMessage() {}
 
 func (x *CheckPermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[36]
+	mi := &file_user_v1_user_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2224,7 +2842,7 @@ func (x *CheckPermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckPermissionRequest.ProtoReflect.Descriptor instead.
 func (*CheckPermissionRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{36}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *CheckPermissionRequest) GetRole() Role {
@@ -2251,7 +2869,7 @@ type CheckPermissionResponse struct {
 
 func (x *CheckPermissionResponse) Reset() {
 	*x = CheckPermissionResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[37]
+	mi := &file_user_v1_user_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2263,7 +2881,7 @@ func (x *CheckPermissionResponse) String() string {
 func (*CheckPermissionResponse) ProtoMessage() {}
 
 func (x *CheckPermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[37]
+	mi := &file_user_v1_user_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2276,7 +2894,7 @@ func (x *CheckPermissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckPermissionResponse.ProtoReflect.Descriptor instead.
 func (*CheckPermissionResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{37}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *CheckPermissionResponse) GetAllowed() bool {
@@ -2290,10 +2908,10 @@ var File_user_v1_user_proto protoreflect.FileDescriptor
 
 const file_user_v1_user_proto_rawDesc = "" +
 	"\n" +
-	"\x12user/v1/user.proto\x12\auser.v1\"R\n" +
+	"\x12user/v1/user.proto\x12\auser.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"R\n" +
 	"\x10ManagedResources\x12\x1b\n" +
 	"\tstore_ids\x18\x01 \x03(\tR\bstoreIds\x12!\n" +
-	"\fsupplier_ids\x18\x02 \x03(\tR\vsupplierIds\"\xde\x02\n" +
+	"\fsupplier_ids\x18\x02 \x03(\tR\vsupplierIds\"\xaa\x04\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1d\n" +
@@ -2302,15 +2920,18 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\tlast_name\x18\x04 \x01(\tR\blastName\x12!\n" +
 	"\x04role\x18\x05 \x01(\x0e2\r.user.v1.RoleR\x04role\x12\x14\n" +
 	"\x05phone\x18\x06 \x01(\tR\x05phone\x12\x16\n" +
-	"\x06active\x18\a \x01(\bR\x06active\x12\x1d\n" +
+	"\x06active\x18\a \x01(\bR\x06active\x12!\n" +
 	"\n" +
-	"last_login\x18\b \x01(\tR\tlastLogin\x12\x1d\n" +
+	"last_login\x18\b \x01(\tB\x02\x18\x01R\tlastLogin\x12!\n" +
 	"\n" +
-	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"created_at\x18\t \x01(\tB\x02\x18\x01R\tcreatedAt\x12!\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\tR\tupdatedAt\x12F\n" +
-	"\x11managed_resources\x18\v \x01(\v2\x19.user.v1.ManagedResourcesR\x10managedResources\"\xb6\x02\n" +
+	" \x01(\tB\x02\x18\x01R\tupdatedAt\x12F\n" +
+	"\x11managed_resources\x18\v \x01(\v2\x19.user.v1.ManagedResourcesR\x10managedResources\x12>\n" +
+	"\rlast_login_ts\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vlastLoginTs\x12>\n" +
+	"\rcreated_at_ts\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedAtTs\x12>\n" +
+	"\rupdated_at_ts\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedAtTs\"\xbe\x03\n" +
 	"\aAddress\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
@@ -2324,11 +2945,13 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\n" +
 	"is_default\x18\t \x01(\bR\tisDefault\x12\x14\n" +
 	"\x05phone\x18\n" +
-	" \x01(\tR\x05phone\x12\x1d\n" +
+	" \x01(\tR\x05phone\x12!\n" +
 	"\n" +
-	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"created_at\x18\v \x01(\tB\x02\x18\x01R\tcreatedAt\x12!\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\tR\tupdatedAt\"\x97\x01\n" +
+	"updated_at\x18\f \x01(\tB\x02\x18\x01R\tupdatedAt\x12>\n" +
+	"\rcreated_at_ts\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedAtTs\x12>\n" +
+	"\rupdated_at_ts\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedAtTs\"\x97\x01\n" +
 	"\x13RegisterUserRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1d\n" +
@@ -2340,10 +2963,23 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"K\n" +
 	"\x17AuthenticateUserRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"S\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xc5\x01\n" +
 	"\x18AuthenticateUserResponse\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
-	"\x04user\x18\x02 \x01(\v2\r.user.v1.UserR\x04user\" \n" +
+	"\x04user\x18\x02 \x01(\v2\r.user.v1.UserR\x04user\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt\x12#\n" +
+	"\rrefresh_token\x18\x04 \x01(\tR\frefreshToken\x12,\n" +
+	"\x12refresh_expires_at\x18\x05 \x01(\x03R\x10refreshExpiresAt\":\n" +
+	"\x13RefreshTokenRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"\xc1\x01\n" +
+	"\x14RefreshTokenResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
+	"\x04user\x18\x02 \x01(\v2\r.user.v1.UserR\x04user\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt\x12#\n" +
+	"\rrefresh_token\x18\x04 \x01(\tR\frefreshToken\x12,\n" +
+	"\x12refresh_expires_at\x18\x05 \x01(\x03R\x10refreshExpiresAt\" \n" +
 	"\x0eGetUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"-\n" +
 	"\x15GetUserByEmailRequest\x12\x14\n" +
@@ -2376,9 +3012,13 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x04role\x18\x01 \x01(\tR\x04role\x12\x16\n" +
 	"\x06active\x18\x02 \x01(\bR\x06active\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x04 \x01(\x05R\x06offset\"8\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"\x87\x01\n" +
 	"\x11ListUsersResponse\x12#\n" +
-	"\x05users\x18\x01 \x03(\v2\r.user.v1.UserR\x05users\"\xf9\x01\n" +
+	"\x05users\x18\x01 \x03(\v2\r.user.v1.UserR\x05users\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"\xf9\x01\n" +
 	"\x18CreateUserAddressRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
@@ -2426,7 +3066,36 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"9\n" +
 	"\x1dSetDefaultUserAddressResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\",\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd4\x01\n" +
+	"\fAddressInput\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06street\x18\x02 \x01(\tR\x06street\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12\x1f\n" +
+	"\vpostal_code\x18\x05 \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\x06 \x01(\tR\acountry\x12\x14\n" +
+	"\x05phone\x18\a \x01(\tR\x05phone\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\b \x01(\bR\tisDefault\"n\n" +
+	"\x1eBulkCreateUserAddressesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x123\n" +
+	"\taddresses\x18\x02 \x03(\v2\x15.user.v1.AddressInputR\taddresses\"\x81\x01\n" +
+	"\rAddressResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12*\n" +
+	"\aaddress\x18\x03 \x01(\v2\x10.user.v1.AddressR\aaddress\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\x9b\x01\n" +
+	"\x1fBulkCreateUserAddressesResponse\x120\n" +
+	"\aresults\x18\x01 \x03(\v2\x16.user.v1.AddressResultR\aresults\x12#\n" +
+	"\rcreated_count\x18\x02 \x01(\x05R\fcreatedCount\x12!\n" +
+	"\ffailed_count\x18\x03 \x01(\x05R\vfailedCount\"H\n" +
+	"\x11CountUsersRequest\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x1f\n" +
+	"\vactive_only\x18\x02 \x01(\bR\n" +
+	"activeOnly\"*\n" +
+	"\x12CountUsersResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\",\n" +
 	"\x14ValidateTokenRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\"f\n" +
 	"\x15ValidateTokenResponse\x12\x14\n" +
@@ -2459,11 +3128,11 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\n" +
 	"ROLE_STAFF\x10\x03\x12\x10\n" +
 	"\fROLE_MANAGER\x10\x04\x12\x11\n" +
-	"\rROLE_SUPPLIER\x10\x052\x99\n" +
-	"\n" +
+	"\rROLE_SUPPLIER\x10\x052\x9b\f\n" +
 	"\vUserService\x12K\n" +
 	"\fRegisterUser\x12\x1c.user.v1.RegisterUserRequest\x1a\x1d.user.v1.RegisterUserResponse\x12W\n" +
-	"\x10AuthenticateUser\x12 .user.v1.AuthenticateUserRequest\x1a!.user.v1.AuthenticateUserResponse\x12<\n" +
+	"\x10AuthenticateUser\x12 .user.v1.AuthenticateUserRequest\x1a!.user.v1.AuthenticateUserResponse\x12K\n" +
+	"\fRefreshToken\x12\x1c.user.v1.RefreshTokenRequest\x1a\x1d.user.v1.RefreshTokenResponse\x12<\n" +
 	"\aGetUser\x12\x17.user.v1.GetUserRequest\x1a\x18.user.v1.GetUserResponse\x12J\n" +
 	"\x0eGetUserByEmail\x12\x1e.user.v1.GetUserByEmailRequest\x1a\x18.user.v1.GetUserResponse\x12Z\n" +
 	"\x11UpdateUserProfile\x12!.user.v1.UpdateUserProfileRequest\x1a\".user.v1.UpdateUserProfileResponse\x12]\n" +
@@ -2476,7 +3145,10 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x15GetUserDefaultAddress\x12%.user.v1.GetUserDefaultAddressRequest\x1a&.user.v1.GetUserDefaultAddressResponse\x12Z\n" +
 	"\x11UpdateUserAddress\x12!.user.v1.UpdateUserAddressRequest\x1a\".user.v1.UpdateUserAddressResponse\x12Z\n" +
 	"\x11DeleteUserAddress\x12!.user.v1.DeleteUserAddressRequest\x1a\".user.v1.DeleteUserAddressResponse\x12f\n" +
-	"\x15SetDefaultUserAddress\x12%.user.v1.SetDefaultUserAddressRequest\x1a&.user.v1.SetDefaultUserAddressResponse2\xf7\x01\n" +
+	"\x15SetDefaultUserAddress\x12%.user.v1.SetDefaultUserAddressRequest\x1a&.user.v1.SetDefaultUserAddressResponse\x12l\n" +
+	"\x17BulkCreateUserAddresses\x12'.user.v1.BulkCreateUserAddressesRequest\x1a(.user.v1.BulkCreateUserAddressesResponse\x12E\n" +
+	"\n" +
+	"CountUsers\x12\x1a.user.v1.CountUsersRequest\x1a\x1b.user.v1.CountUsersResponse2\xf7\x01\n" +
 	"\vAuthService\x12N\n" +
 	"\rValidateToken\x12\x1d.user.v1.ValidateTokenRequest\x1a\x1e.user.v1.ValidateTokenResponse\x12T\n" +
 	"\x0fCheckPermission\x12\x1f.user.v1.CheckPermissionRequest\x1a .user.v1.CheckPermissionResponse\x12B\n" +
@@ -2495,101 +3167,125 @@ func file_user_v1_user_proto_rawDescGZIP() []byte {
 }
 
 var file_user_v1_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_user_v1_user_proto_goTypes = []any{
-	(Role)(0),                             // 0: user.v1.Role
-	(*ManagedResources)(nil),              // 1: user.v1.ManagedResources
-	(*User)(nil),                          // 2: user.v1.User
-	(*Address)(nil),                       // 3: user.v1.Address
-	(*RegisterUserRequest)(nil),           // 4: user.v1.RegisterUserRequest
-	(*RegisterUserResponse)(nil),          // 5: user.v1.RegisterUserResponse
-	(*AuthenticateUserRequest)(nil),       // 6: user.v1.AuthenticateUserRequest
-	(*AuthenticateUserResponse)(nil),      // 7: user.v1.AuthenticateUserResponse
-	(*GetUserRequest)(nil),                // 8: user.v1.GetUserRequest
-	(*GetUserByEmailRequest)(nil),         // 9: user.v1.GetUserByEmailRequest
-	(*GetUserResponse)(nil),               // 10: user.v1.GetUserResponse
-	(*UpdateUserProfileRequest)(nil),      // 11: user.v1.UpdateUserProfileRequest
-	(*UpdateUserProfileResponse)(nil),     // 12: user.v1.UpdateUserProfileResponse
-	(*ChangeUserPasswordRequest)(nil),     // 13: user.v1.ChangeUserPasswordRequest
-	(*ChangeUserPasswordResponse)(nil),    // 14: user.v1.ChangeUserPasswordResponse
-	(*DeactivateUserRequest)(nil),         // 15: user.v1.DeactivateUserRequest
-	(*DeactivateUserResponse)(nil),        // 16: user.v1.DeactivateUserResponse
-	(*ActivateUserRequest)(nil),           // 17: user.v1.ActivateUserRequest
-	(*ActivateUserResponse)(nil),          // 18: user.v1.ActivateUserResponse
-	(*ListUsersRequest)(nil),              // 19: user.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),             // 20: user.v1.ListUsersResponse
-	(*CreateUserAddressRequest)(nil),      // 21: user.v1.CreateUserAddressRequest
-	(*CreateUserAddressResponse)(nil),     // 22: user.v1.CreateUserAddressResponse
-	(*GetUserAddressesRequest)(nil),       // 23: user.v1.GetUserAddressesRequest
-	(*GetUserAddressesResponse)(nil),      // 24: user.v1.GetUserAddressesResponse
-	(*GetUserDefaultAddressRequest)(nil),  // 25: user.v1.GetUserDefaultAddressRequest
-	(*GetUserDefaultAddressResponse)(nil), // 26: user.v1.GetUserDefaultAddressResponse
-	(*UpdateUserAddressRequest)(nil),      // 27: user.v1.UpdateUserAddressRequest
-	(*UpdateUserAddressResponse)(nil),     // 28: user.v1.UpdateUserAddressResponse
-	(*DeleteUserAddressRequest)(nil),      // 29: user.v1.DeleteUserAddressRequest
-	(*DeleteUserAddressResponse)(nil),     // 30: user.v1.DeleteUserAddressResponse
-	(*SetDefaultUserAddressRequest)(nil),  // 31: user.v1.SetDefaultUserAddressRequest
-	(*SetDefaultUserAddressResponse)(nil), // 32: user.v1.SetDefaultUserAddressResponse
-	(*ValidateTokenRequest)(nil),          // 33: user.v1.ValidateTokenRequest
-	(*ValidateTokenResponse)(nil),         // 34: user.v1.ValidateTokenResponse
-	(*AuthorizeRequest)(nil),              // 35: user.v1.AuthorizeRequest
-	(*AuthorizeResponse)(nil),             // 36: user.v1.AuthorizeResponse
-	(*CheckPermissionRequest)(nil),        // 37: user.v1.CheckPermissionRequest
-	(*CheckPermissionResponse)(nil),       // 38: user.v1.CheckPermissionResponse
+	(Role)(0),                               // 0: user.v1.Role
+	(*ManagedResources)(nil),                // 1: user.v1.ManagedResources
+	(*User)(nil),                            // 2: user.v1.User
+	(*Address)(nil),                         // 3: user.v1.Address
+	(*RegisterUserRequest)(nil),             // 4: user.v1.RegisterUserRequest
+	(*RegisterUserResponse)(nil),            // 5: user.v1.RegisterUserResponse
+	(*AuthenticateUserRequest)(nil),         // 6: user.v1.AuthenticateUserRequest
+	(*AuthenticateUserResponse)(nil),        // 7: user.v1.AuthenticateUserResponse
+	(*RefreshTokenRequest)(nil),             // 8: user.v1.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),            // 9: user.v1.RefreshTokenResponse
+	(*GetUserRequest)(nil),                  // 10: user.v1.GetUserRequest
+	(*GetUserByEmailRequest)(nil),           // 11: user.v1.GetUserByEmailRequest
+	(*GetUserResponse)(nil),                 // 12: user.v1.GetUserResponse
+	(*UpdateUserProfileRequest)(nil),        // 13: user.v1.UpdateUserProfileRequest
+	(*UpdateUserProfileResponse)(nil),       // 14: user.v1.UpdateUserProfileResponse
+	(*ChangeUserPasswordRequest)(nil),       // 15: user.v1.ChangeUserPasswordRequest
+	(*ChangeUserPasswordResponse)(nil),      // 16: user.v1.ChangeUserPasswordResponse
+	(*DeactivateUserRequest)(nil),           // 17: user.v1.DeactivateUserRequest
+	(*DeactivateUserResponse)(nil),          // 18: user.v1.DeactivateUserResponse
+	(*ActivateUserRequest)(nil),             // 19: user.v1.ActivateUserRequest
+	(*ActivateUserResponse)(nil),            // 20: user.v1.ActivateUserResponse
+	(*ListUsersRequest)(nil),                // 21: user.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),               // 22: user.v1.ListUsersResponse
+	(*CreateUserAddressRequest)(nil),        // 23: user.v1.CreateUserAddressRequest
+	(*CreateUserAddressResponse)(nil),       // 24: user.v1.CreateUserAddressResponse
+	(*GetUserAddressesRequest)(nil),         // 25: user.v1.GetUserAddressesRequest
+	(*GetUserAddressesResponse)(nil),        // 26: user.v1.GetUserAddressesResponse
+	(*GetUserDefaultAddressRequest)(nil),    // 27: user.v1.GetUserDefaultAddressRequest
+	(*GetUserDefaultAddressResponse)(nil),   // 28: user.v1.GetUserDefaultAddressResponse
+	(*UpdateUserAddressRequest)(nil),        // 29: user.v1.UpdateUserAddressRequest
+	(*UpdateUserAddressResponse)(nil),       // 30: user.v1.UpdateUserAddressResponse
+	(*DeleteUserAddressRequest)(nil),        // 31: user.v1.DeleteUserAddressRequest
+	(*DeleteUserAddressResponse)(nil),       // 32: user.v1.DeleteUserAddressResponse
+	(*SetDefaultUserAddressRequest)(nil),    // 33: user.v1.SetDefaultUserAddressRequest
+	(*SetDefaultUserAddressResponse)(nil),   // 34: user.v1.SetDefaultUserAddressResponse
+	(*AddressInput)(nil),                    // 35: user.v1.AddressInput
+	(*BulkCreateUserAddressesRequest)(nil),  // 36: user.v1.BulkCreateUserAddressesRequest
+	(*AddressResult)(nil),                   // 37: user.v1.AddressResult
+	(*BulkCreateUserAddressesResponse)(nil), // 38: user.v1.BulkCreateUserAddressesResponse
+	(*CountUsersRequest)(nil),               // 39: user.v1.CountUsersRequest
+	(*CountUsersResponse)(nil),              // 40: user.v1.CountUsersResponse
+	(*ValidateTokenRequest)(nil),            // 41: user.v1.ValidateTokenRequest
+	(*ValidateTokenResponse)(nil),           // 42: user.v1.ValidateTokenResponse
+	(*AuthorizeRequest)(nil),                // 43: user.v1.AuthorizeRequest
+	(*AuthorizeResponse)(nil),               // 44: user.v1.AuthorizeResponse
+	(*CheckPermissionRequest)(nil),          // 45: user.v1.CheckPermissionRequest
+	(*CheckPermissionResponse)(nil),         // 46: user.v1.CheckPermissionResponse
+	(*timestamppb.Timestamp)(nil),           // 47: google.protobuf.Timestamp
 }
 var file_user_v1_user_proto_depIdxs = []int32{
 	0,  // 0: user.v1.User.role:type_name -> user.v1.Role
 	1,  // 1: user.v1.User.managed_resources:type_name -> user.v1.ManagedResources
-	2,  // 2: user.v1.RegisterUserResponse.user:type_name -> user.v1.User
-	2,  // 3: user.v1.AuthenticateUserResponse.user:type_name -> user.v1.User
-	2,  // 4: user.v1.GetUserResponse.user:type_name -> user.v1.User
-	2,  // 5: user.v1.ListUsersResponse.users:type_name -> user.v1.User
-	3,  // 6: user.v1.CreateUserAddressResponse.address:type_name -> user.v1.Address
-	3,  // 7: user.v1.GetUserAddressesResponse.addresses:type_name -> user.v1.Address
-	3,  // 8: user.v1.GetUserDefaultAddressResponse.address:type_name -> user.v1.Address
-	2,  // 9: user.v1.ValidateTokenResponse.user:type_name -> user.v1.User
-	0,  // 10: user.v1.CheckPermissionRequest.role:type_name -> user.v1.Role
-	4,  // 11: user.v1.UserService.RegisterUser:input_type -> user.v1.RegisterUserRequest
-	6,  // 12: user.v1.UserService.AuthenticateUser:input_type -> user.v1.AuthenticateUserRequest
-	8,  // 13: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
-	9,  // 14: user.v1.UserService.GetUserByEmail:input_type -> user.v1.GetUserByEmailRequest
-	11, // 15: user.v1.UserService.UpdateUserProfile:input_type -> user.v1.UpdateUserProfileRequest
-	13, // 16: user.v1.UserService.ChangeUserPassword:input_type -> user.v1.ChangeUserPasswordRequest
-	15, // 17: user.v1.UserService.DeactivateUser:input_type -> user.v1.DeactivateUserRequest
-	17, // 18: user.v1.UserService.ActivateUser:input_type -> user.v1.ActivateUserRequest
-	19, // 19: user.v1.UserService.ListUsers:input_type -> user.v1.ListUsersRequest
-	21, // 20: user.v1.UserService.CreateUserAddress:input_type -> user.v1.CreateUserAddressRequest
-	23, // 21: user.v1.UserService.GetUserAddresses:input_type -> user.v1.GetUserAddressesRequest
-	25, // 22: user.v1.UserService.GetUserDefaultAddress:input_type -> user.v1.GetUserDefaultAddressRequest
-	27, // 23: user.v1.UserService.UpdateUserAddress:input_type -> user.v1.UpdateUserAddressRequest
-	29, // 24: user.v1.UserService.DeleteUserAddress:input_type -> user.v1.DeleteUserAddressRequest
-	31, // 25: user.v1.UserService.SetDefaultUserAddress:input_type -> user.v1.SetDefaultUserAddressRequest
-	33, // 26: user.v1.AuthService.ValidateToken:input_type -> user.v1.ValidateTokenRequest
-	37, // 27: user.v1.AuthService.CheckPermission:input_type -> user.v1.CheckPermissionRequest
-	35, // 28: user.v1.AuthService.Authorize:input_type -> user.v1.AuthorizeRequest
-	5,  // 29: user.v1.UserService.RegisterUser:output_type -> user.v1.RegisterUserResponse
-	7,  // 30: user.v1.UserService.AuthenticateUser:output_type -> user.v1.AuthenticateUserResponse
-	10, // 31: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
-	10, // 32: user.v1.UserService.GetUserByEmail:output_type -> user.v1.GetUserResponse
-	12, // 33: user.v1.UserService.UpdateUserProfile:output_type -> user.v1.UpdateUserProfileResponse
-	14, // 34: user.v1.UserService.ChangeUserPassword:output_type -> user.v1.ChangeUserPasswordResponse
-	16, // 35: user.v1.UserService.DeactivateUser:output_type -> user.v1.DeactivateUserResponse
-	18, // 36: user.v1.UserService.ActivateUser:output_type -> user.v1.ActivateUserResponse
-	20, // 37: user.v1.UserService.ListUsers:output_type -> user.v1.ListUsersResponse
-	22, // 38: user.v1.UserService.CreateUserAddress:output_type -> user.v1.CreateUserAddressResponse
-	24, // 39: user.v1.UserService.GetUserAddresses:output_type -> user.v1.GetUserAddressesResponse
-	26, // 40: user.v1.UserService.GetUserDefaultAddress:output_type -> user.v1.GetUserDefaultAddressResponse
-	28, // 41: user.v1.UserService.UpdateUserAddress:output_type -> user.v1.UpdateUserAddressResponse
-	30, // 42: user.v1.UserService.DeleteUserAddress:output_type -> user.v1.DeleteUserAddressResponse
-	32, // 43: user.v1.UserService.SetDefaultUserAddress:output_type -> user.v1.SetDefaultUserAddressResponse
-	34, // 44: user.v1.AuthService.ValidateToken:output_type -> user.v1.ValidateTokenResponse
-	38, // 45: user.v1.AuthService.CheckPermission:output_type -> user.v1.CheckPermissionResponse
-	36, // 46: user.v1.AuthService.Authorize:output_type -> user.v1.AuthorizeResponse
-	29, // [29:47] is the sub-list for method output_type
-	11, // [11:29] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	47, // 2: user.v1.User.last_login_ts:type_name -> google.protobuf.Timestamp
+	47, // 3: user.v1.User.created_at_ts:type_name -> google.protobuf.Timestamp
+	47, // 4: user.v1.User.updated_at_ts:type_name -> google.protobuf.Timestamp
+	47, // 5: user.v1.Address.created_at_ts:type_name -> google.protobuf.Timestamp
+	47, // 6: user.v1.Address.updated_at_ts:type_name -> google.protobuf.Timestamp
+	2,  // 7: user.v1.RegisterUserResponse.user:type_name -> user.v1.User
+	2,  // 8: user.v1.AuthenticateUserResponse.user:type_name -> user.v1.User
+	2,  // 9: user.v1.RefreshTokenResponse.user:type_name -> user.v1.User
+	2,  // 10: user.v1.GetUserResponse.user:type_name -> user.v1.User
+	2,  // 11: user.v1.ListUsersResponse.users:type_name -> user.v1.User
+	3,  // 12: user.v1.CreateUserAddressResponse.address:type_name -> user.v1.Address
+	3,  // 13: user.v1.GetUserAddressesResponse.addresses:type_name -> user.v1.Address
+	3,  // 14: user.v1.GetUserDefaultAddressResponse.address:type_name -> user.v1.Address
+	35, // 15: user.v1.BulkCreateUserAddressesRequest.addresses:type_name -> user.v1.AddressInput
+	3,  // 16: user.v1.AddressResult.address:type_name -> user.v1.Address
+	37, // 17: user.v1.BulkCreateUserAddressesResponse.results:type_name -> user.v1.AddressResult
+	2,  // 18: user.v1.ValidateTokenResponse.user:type_name -> user.v1.User
+	0,  // 19: user.v1.CheckPermissionRequest.role:type_name -> user.v1.Role
+	4,  // 20: user.v1.UserService.RegisterUser:input_type -> user.v1.RegisterUserRequest
+	6,  // 21: user.v1.UserService.AuthenticateUser:input_type -> user.v1.AuthenticateUserRequest
+	8,  // 22: user.v1.UserService.RefreshToken:input_type -> user.v1.RefreshTokenRequest
+	10, // 23: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
+	11, // 24: user.v1.UserService.GetUserByEmail:input_type -> user.v1.GetUserByEmailRequest
+	13, // 25: user.v1.UserService.UpdateUserProfile:input_type -> user.v1.UpdateUserProfileRequest
+	15, // 26: user.v1.UserService.ChangeUserPassword:input_type -> user.v1.ChangeUserPasswordRequest
+	17, // 27: user.v1.UserService.DeactivateUser:input_type -> user.v1.DeactivateUserRequest
+	19, // 28: user.v1.UserService.ActivateUser:input_type -> user.v1.ActivateUserRequest
+	21, // 29: user.v1.UserService.ListUsers:input_type -> user.v1.ListUsersRequest
+	23, // 30: user.v1.UserService.CreateUserAddress:input_type -> user.v1.CreateUserAddressRequest
+	25, // 31: user.v1.UserService.GetUserAddresses:input_type -> user.v1.GetUserAddressesRequest
+	27, // 32: user.v1.UserService.GetUserDefaultAddress:input_type -> user.v1.GetUserDefaultAddressRequest
+	29, // 33: user.v1.UserService.UpdateUserAddress:input_type -> user.v1.UpdateUserAddressRequest
+	31, // 34: user.v1.UserService.DeleteUserAddress:input_type -> user.v1.DeleteUserAddressRequest
+	33, // 35: user.v1.UserService.SetDefaultUserAddress:input_type -> user.v1.SetDefaultUserAddressRequest
+	36, // 36: user.v1.UserService.BulkCreateUserAddresses:input_type -> user.v1.BulkCreateUserAddressesRequest
+	39, // 37: user.v1.UserService.CountUsers:input_type -> user.v1.CountUsersRequest
+	41, // 38: user.v1.AuthService.ValidateToken:input_type -> user.v1.ValidateTokenRequest
+	45, // 39: user.v1.AuthService.CheckPermission:input_type -> user.v1.CheckPermissionRequest
+	43, // 40: user.v1.AuthService.Authorize:input_type -> user.v1.AuthorizeRequest
+	5,  // 41: user.v1.UserService.RegisterUser:output_type -> user.v1.RegisterUserResponse
+	7,  // 42: user.v1.UserService.AuthenticateUser:output_type -> user.v1.AuthenticateUserResponse
+	9,  // 43: user.v1.UserService.RefreshToken:output_type -> user.v1.RefreshTokenResponse
+	12, // 44: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
+	12, // 45: user.v1.UserService.GetUserByEmail:output_type -> user.v1.GetUserResponse
+	14, // 46: user.v1.UserService.UpdateUserProfile:output_type -> user.v1.UpdateUserProfileResponse
+	16, // 47: user.v1.UserService.ChangeUserPassword:output_type -> user.v1.ChangeUserPasswordResponse
+	18, // 48: user.v1.UserService.DeactivateUser:output_type -> user.v1.DeactivateUserResponse
+	20, // 49: user.v1.UserService.ActivateUser:output_type -> user.v1.ActivateUserResponse
+	22, // 50: user.v1.UserService.ListUsers:output_type -> user.v1.ListUsersResponse
+	24, // 51: user.v1.UserService.CreateUserAddress:output_type -> user.v1.CreateUserAddressResponse
+	26, // 52: user.v1.UserService.GetUserAddresses:output_type -> user.v1.GetUserAddressesResponse
+	28, // 53: user.v1.UserService.GetUserDefaultAddress:output_type -> user.v1.GetUserDefaultAddressResponse
+	30, // 54: user.v1.UserService.UpdateUserAddress:output_type -> user.v1.UpdateUserAddressResponse
+	32, // 55: user.v1.UserService.DeleteUserAddress:output_type -> user.v1.DeleteUserAddressResponse
+	34, // 56: user.v1.UserService.SetDefaultUserAddress:output_type -> user.v1.SetDefaultUserAddressResponse
+	38, // 57: user.v1.UserService.BulkCreateUserAddresses:output_type -> user.v1.BulkCreateUserAddressesResponse
+	40, // 58: user.v1.UserService.CountUsers:output_type -> user.v1.CountUsersResponse
+	42, // 59: user.v1.AuthService.ValidateToken:output_type -> user.v1.ValidateTokenResponse
+	46, // 60: user.v1.AuthService.CheckPermission:output_type -> user.v1.CheckPermissionResponse
+	44, // 61: user.v1.AuthService.Authorize:output_type -> user.v1.AuthorizeResponse
+	41, // [41:62] is the sub-list for method output_type
+	20, // [20:41] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_user_v1_user_proto_init() }
@@ -2603,7 +3299,7 @@ func file_user_v1_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_v1_user_proto_rawDesc), len(file_user_v1_user_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   38,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
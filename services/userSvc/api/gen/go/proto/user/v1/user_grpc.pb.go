@@ -19,21 +19,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_RegisterUser_FullMethodName          = "/user.v1.UserService/RegisterUser"
-	UserService_AuthenticateUser_FullMethodName      = "/user.v1.UserService/AuthenticateUser"
-	UserService_GetUser_FullMethodName               = "/user.v1.UserService/GetUser"
-	UserService_GetUserByEmail_FullMethodName        = "/user.v1.UserService/GetUserByEmail"
-	UserService_UpdateUserProfile_FullMethodName     = "/user.v1.UserService/UpdateUserProfile"
-	UserService_ChangeUserPassword_FullMethodName    = "/user.v1.UserService/ChangeUserPassword"
-	UserService_DeactivateUser_FullMethodName        = "/user.v1.UserService/DeactivateUser"
-	UserService_ActivateUser_FullMethodName          = "/user.v1.UserService/ActivateUser"
-	UserService_ListUsers_FullMethodName             = "/user.v1.UserService/ListUsers"
-	UserService_CreateUserAddress_FullMethodName     = "/user.v1.UserService/CreateUserAddress"
-	UserService_GetUserAddresses_FullMethodName      = "/user.v1.UserService/GetUserAddresses"
-	UserService_GetUserDefaultAddress_FullMethodName = "/user.v1.UserService/GetUserDefaultAddress"
-	UserService_UpdateUserAddress_FullMethodName     = "/user.v1.UserService/UpdateUserAddress"
-	UserService_DeleteUserAddress_FullMethodName     = "/user.v1.UserService/DeleteUserAddress"
-	UserService_SetDefaultUserAddress_FullMethodName = "/user.v1.UserService/SetDefaultUserAddress"
+	UserService_RegisterUser_FullMethodName            = "/user.v1.UserService/RegisterUser"
+	UserService_AuthenticateUser_FullMethodName        = "/user.v1.UserService/AuthenticateUser"
+	UserService_RefreshToken_FullMethodName            = "/user.v1.UserService/RefreshToken"
+	UserService_GetUser_FullMethodName                 = "/user.v1.UserService/GetUser"
+	UserService_GetUserByEmail_FullMethodName          = "/user.v1.UserService/GetUserByEmail"
+	UserService_UpdateUserProfile_FullMethodName       = "/user.v1.UserService/UpdateUserProfile"
+	UserService_ChangeUserPassword_FullMethodName      = "/user.v1.UserService/ChangeUserPassword"
+	UserService_DeactivateUser_FullMethodName          = "/user.v1.UserService/DeactivateUser"
+	UserService_ActivateUser_FullMethodName            = "/user.v1.UserService/ActivateUser"
+	UserService_ListUsers_FullMethodName               = "/user.v1.UserService/ListUsers"
+	UserService_CreateUserAddress_FullMethodName       = "/user.v1.UserService/CreateUserAddress"
+	UserService_GetUserAddresses_FullMethodName        = "/user.v1.UserService/GetUserAddresses"
+	UserService_GetUserDefaultAddress_FullMethodName   = "/user.v1.UserService/GetUserDefaultAddress"
+	UserService_UpdateUserAddress_FullMethodName       = "/user.v1.UserService/UpdateUserAddress"
+	UserService_DeleteUserAddress_FullMethodName       = "/user.v1.UserService/DeleteUserAddress"
+	UserService_SetDefaultUserAddress_FullMethodName   = "/user.v1.UserService/SetDefaultUserAddress"
+	UserService_BulkCreateUserAddresses_FullMethodName = "/user.v1.UserService/BulkCreateUserAddresses"
+	UserService_CountUsers_FullMethodName              = "/user.v1.UserService/CountUsers"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -46,6 +49,8 @@ type UserServiceClient interface {
 	RegisterUser(ctx context.Context, in *RegisterUserRequest, opts ...grpc.CallOption) (*RegisterUserResponse, error)
 	// AuthenticateUser authenticates a user and returns a JWT token
 	AuthenticateUser(ctx context.Context, in *AuthenticateUserRequest, opts ...grpc.CallOption) (*AuthenticateUserResponse, error)
+	// RefreshToken exchanges an unexpired refresh token for a new access token
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
 	// GetUser retrieves a user by ID
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	// GetUserByEmail retrieves a user by email
@@ -72,6 +77,10 @@ type UserServiceClient interface {
 	DeleteUserAddress(ctx context.Context, in *DeleteUserAddressRequest, opts ...grpc.CallOption) (*DeleteUserAddressResponse, error)
 	// SetDefaultUserAddress sets an address as the default for a user
 	SetDefaultUserAddress(ctx context.Context, in *SetDefaultUserAddressRequest, opts ...grpc.CallOption) (*SetDefaultUserAddressResponse, error)
+	// BulkCreateUserAddresses imports several addresses for a user at once
+	BulkCreateUserAddresses(ctx context.Context, in *BulkCreateUserAddressesRequest, opts ...grpc.CallOption) (*BulkCreateUserAddressesResponse, error)
+	// CountUsers counts users matching a role and activity filter
+	CountUsers(ctx context.Context, in *CountUsersRequest, opts ...grpc.CallOption) (*CountUsersResponse, error)
 }
 
 type userServiceClient struct {
@@ -102,6 +111,16 @@ func (c *userServiceClient) AuthenticateUser(ctx context.Context, in *Authentica
 	return out, nil
 }
 
+func (c *userServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshTokenResponse)
+	err := c.cc.Invoke(ctx, UserService_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserResponse)
@@ -232,6 +251,26 @@ func (c *userServiceClient) SetDefaultUserAddress(ctx context.Context, in *SetDe
 	return out, nil
 }
 
+func (c *userServiceClient) BulkCreateUserAddresses(ctx context.Context, in *BulkCreateUserAddressesRequest, opts ...grpc.CallOption) (*BulkCreateUserAddressesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkCreateUserAddressesResponse)
+	err := c.cc.Invoke(ctx, UserService_BulkCreateUserAddresses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CountUsers(ctx context.Context, in *CountUsersRequest, opts ...grpc.CallOption) (*CountUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountUsersResponse)
+	err := c.cc.Invoke(ctx, UserService_CountUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations should embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -242,6 +281,8 @@ type UserServiceServer interface {
 	RegisterUser(context.Context, *RegisterUserRequest) (*RegisterUserResponse, error)
 	// AuthenticateUser authenticates a user and returns a JWT token
 	AuthenticateUser(context.Context, *AuthenticateUserRequest) (*AuthenticateUserResponse, error)
+	// RefreshToken exchanges an unexpired refresh token for a new access token
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
 	// GetUser retrieves a user by ID
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
 	// GetUserByEmail retrieves a user by email
@@ -268,6 +309,10 @@ type UserServiceServer interface {
 	DeleteUserAddress(context.Context, *DeleteUserAddressRequest) (*DeleteUserAddressResponse, error)
 	// SetDefaultUserAddress sets an address as the default for a user
 	SetDefaultUserAddress(context.Context, *SetDefaultUserAddressRequest) (*SetDefaultUserAddressResponse, error)
+	// BulkCreateUserAddresses imports several addresses for a user at once
+	BulkCreateUserAddresses(context.Context, *BulkCreateUserAddressesRequest) (*BulkCreateUserAddressesResponse, error)
+	// CountUsers counts users matching a role and activity filter
+	CountUsers(context.Context, *CountUsersRequest) (*CountUsersResponse, error)
 }
 
 // UnimplementedUserServiceServer should be embedded to have
@@ -283,6 +328,9 @@ func (UnimplementedUserServiceServer) RegisterUser(context.Context, *RegisterUse
 func (UnimplementedUserServiceServer) AuthenticateUser(context.Context, *AuthenticateUserRequest) (*AuthenticateUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateUser not implemented")
 }
+func (UnimplementedUserServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
 func (UnimplementedUserServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
 }
@@ -322,6 +370,12 @@ func (UnimplementedUserServiceServer) DeleteUserAddress(context.Context, *Delete
 func (UnimplementedUserServiceServer) SetDefaultUserAddress(context.Context, *SetDefaultUserAddressRequest) (*SetDefaultUserAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetDefaultUserAddress not implemented")
 }
+func (UnimplementedUserServiceServer) BulkCreateUserAddresses(context.Context, *BulkCreateUserAddressesRequest) (*BulkCreateUserAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkCreateUserAddresses not implemented")
+}
+func (UnimplementedUserServiceServer) CountUsers(context.Context, *CountUsersRequest) (*CountUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountUsers not implemented")
+}
 func (UnimplementedUserServiceServer) testEmbeddedByValue() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -378,6 +432,24 @@ func _UserService_AuthenticateUser_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserRequest)
 	if err := dec(in); err != nil {
@@ -612,6 +684,42 @@ func _UserService_SetDefaultUserAddress_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_BulkCreateUserAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkCreateUserAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BulkCreateUserAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BulkCreateUserAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BulkCreateUserAddresses(ctx, req.(*BulkCreateUserAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CountUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CountUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CountUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CountUsers(ctx, req.(*CountUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -627,6 +735,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AuthenticateUser",
 			Handler:    _UserService_AuthenticateUser_Handler,
 		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _UserService_RefreshToken_Handler,
+		},
 		{
 			MethodName: "GetUser",
 			Handler:    _UserService_GetUser_Handler,
@@ -679,6 +791,14 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetDefaultUserAddress",
 			Handler:    _UserService_SetDefaultUserAddress_Handler,
 		},
+		{
+			MethodName: "BulkCreateUserAddresses",
+			Handler:    _UserService_BulkCreateUserAddresses_Handler,
+		},
+		{
+			MethodName: "CountUsers",
+			Handler:    _UserService_CountUsers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user/v1/user.proto",
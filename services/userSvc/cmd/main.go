@@ -5,6 +5,8 @@ import (
 
 	"go.uber.org/zap"
 
+	logging "github.com/leonvanderhaeghen/stockplatform/pkg/logger"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/version"
 	"github.com/leonvanderhaeghen/stockplatform/services/userSvc/internal/config"
 	"github.com/leonvanderhaeghen/stockplatform/services/userSvc/internal/database"
 	"github.com/leonvanderhaeghen/stockplatform/services/userSvc/internal/server"
@@ -12,13 +14,13 @@ import (
 
 func main() {
 	// Initialize logger
-	logger, err := zap.NewDevelopment()
+	logger, err := logging.FromEnv("user-service")
 	if err != nil {
 		log.Fatalf("Failed to initialize logger: %v", err)
 	}
 	defer logger.Sync()
 
-	logger.Info("Starting user service...")
+	logger.Info("Starting user service...", version.LogFields()...)
 
 	// Load configuration
 	cfg := config.Load(logger)
@@ -190,7 +190,7 @@ func (s *AuthServiceImpl) Logout(ctx context.Context, tokenString string) error
 
 // generateToken creates a new JWT token for a user
 func (s *AuthServiceImpl) generateToken(user *domain.User) (*domain.AuthToken, error) {
-	expirationTime := time.Now().Add(24 * time.Hour) // 24 hour expiry
+	expirationTime := time.Now().Add(s.config.TokenDuration)
 
 	claims := &domain.Claims{
 		UserID:    user.ID,
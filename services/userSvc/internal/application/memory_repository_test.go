@@ -0,0 +1,185 @@
+package application
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/userSvc/internal/domain"
+)
+
+// memoryUserRepository is an in-memory user repository for service tests
+type memoryUserRepository struct {
+	domain.UserRepository
+
+	mu    sync.Mutex
+	users map[string]*domain.User
+}
+
+func newMemoryUserRepository(users ...*domain.User) *memoryUserRepository {
+	r := &memoryUserRepository{users: make(map[string]*domain.User)}
+	for _, user := range users {
+		copied := *user
+		r.users[user.ID] = &copied
+	}
+	return r
+}
+
+func (r *memoryUserRepository) Create(ctx context.Context, user *domain.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *user
+	r.users[user.ID] = &copied
+	return nil
+}
+
+func (r *memoryUserRepository) GetByID(ctx context.Context, id string) (*domain.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	user, ok := r.users[id]
+	if !ok {
+		return nil, nil
+	}
+	copied := *user
+	return &copied, nil
+}
+
+func (r *memoryUserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, user := range r.users {
+		if user.Email == email {
+			copied := *user
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryUserRepository) Update(ctx context.Context, user *domain.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *user
+	r.users[user.ID] = &copied
+	return nil
+}
+
+// memoryAddressRepository is an in-memory address repository for service
+// tests. CreateMany rejects addresses whose name is in failNames.
+type memoryAddressRepository struct {
+	domain.AddressRepository
+
+	mu        sync.Mutex
+	addresses []*domain.Address
+	failNames map[string]bool
+}
+
+func (r *memoryAddressRepository) add(address *domain.Address) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *address
+	r.addresses = append(r.addresses, &copied)
+}
+
+func (r *memoryAddressRepository) CreateMany(ctx context.Context, addresses []*domain.Address) (map[int]error, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, address := range addresses {
+		if address.IsDefault {
+			r.clearDefaults(address.UserID)
+			break
+		}
+	}
+	failed := make(map[int]error)
+	for i, address := range addresses {
+		if r.failNames[address.Name] {
+			failed[i] = context.DeadlineExceeded
+			continue
+		}
+		copied := *address
+		r.addresses = append(r.addresses, &copied)
+	}
+	return failed, nil
+}
+
+func (r *memoryAddressRepository) GetByUserID(ctx context.Context, userID string) ([]*domain.Address, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var addresses []*domain.Address
+	for _, address := range r.addresses {
+		if address.UserID == userID {
+			copied := *address
+			addresses = append(addresses, &copied)
+		}
+	}
+	sort.SliceStable(addresses, func(a, b int) bool { return addresses[a].CreatedAt.Before(addresses[b].CreatedAt) })
+	return addresses, nil
+}
+
+func (r *memoryAddressRepository) GetDefaultByUserID(ctx context.Context, userID string) (*domain.Address, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, address := range r.addresses {
+		if address.UserID == userID && address.IsDefault {
+			copied := *address
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryAddressRepository) SetDefaultAddress(ctx context.Context, userID, addressID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.clearDefaults(userID)
+	for _, address := range r.addresses {
+		if address.ID == addressID {
+			address.IsDefault = true
+		}
+	}
+	return nil
+}
+
+func (r *memoryAddressRepository) clearDefaults(userID string) {
+	for _, address := range r.addresses {
+		if address.UserID == userID {
+			address.IsDefault = false
+		}
+	}
+}
+
+// List returns a page of the users matching filter, which may hold a role and
+// an active flag, ordered by ID
+func (r *memoryUserRepository) List(ctx context.Context, filter map[string]interface{}, limit, offset int) ([]*domain.User, error) {
+	users := r.matching(filter)
+	if offset >= len(users) {
+		return nil, nil
+	}
+	users = users[offset:]
+	if limit > 0 && limit < len(users) {
+		users = users[:limit]
+	}
+	return users, nil
+}
+
+func (r *memoryUserRepository) Count(ctx context.Context, filter map[string]interface{}) (int64, error) {
+	return int64(len(r.matching(filter))), nil
+}
+
+func (r *memoryUserRepository) matching(filter map[string]interface{}) []*domain.User {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var users []*domain.User
+	for _, user := range r.users {
+		if role, ok := filter["role"]; ok && string(user.Role) != role {
+			continue
+		}
+		if active, ok := filter["active"]; ok && user.Active != active {
+			continue
+		}
+		copied := *user
+		users = append(users, &copied)
+	}
+	sort.Slice(users, func(a, b int) bool { return users[a].ID < users[b].ID })
+	return users
+}
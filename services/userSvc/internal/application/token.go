@@ -0,0 +1,149 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/userSvc/internal/domain"
+)
+
+// Token types, carried in the typ claim so a refresh token cannot be used
+// as an access token or the other way around
+const (
+	accessTokenType  = "access"
+	refreshTokenType = "refresh"
+)
+
+// issueToken signs an access token for the user that expires after the
+// configured token TTL, together with a refresh token that expires after the
+// refresh TTL. Besides the standard sub, iat and exp claims the access token
+// carries the user's name, email and role, which the gateway reads. Users who
+// manage a supplier also get a supplier_id claim, which scopes what they see
+// of that supplier's data.
+func (s *UserService) issueToken(user *domain.User) (*domain.AuthToken, error) {
+	token, err := s.issueAccessToken(user)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshExpiresAt := time.Now().Add(s.refreshTTL)
+	token.RefreshToken, err = s.sign(jwt.MapClaims{
+		"sub": user.ID,
+		"typ": refreshTokenType,
+		"ver": user.TokenVersion,
+		"iat": time.Now().Unix(),
+		"exp": refreshExpiresAt.Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	token.RefreshExpiresAt = refreshExpiresAt
+	return token, nil
+}
+
+// issueAccessToken signs an access token for the user
+func (s *UserService) issueAccessToken(user *domain.User) (*domain.AuthToken, error) {
+	now := time.Now()
+	expiresAt := now.Add(s.tokenTTL)
+
+	claims := jwt.MapClaims{
+		"sub":   user.ID,
+		"typ":   accessTokenType,
+		"name":  user.FullName(),
+		"email": user.Email,
+		"role":  string(user.Role),
+		"iat":   now.Unix(),
+		"exp":   expiresAt.Unix(),
+	}
+	if supplierID := user.PrimarySupplierID(); supplierID != "" {
+		claims["supplier_id"] = supplierID
+	}
+	tokenString, err := s.sign(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.AuthToken{
+		Token:     tokenString,
+		ExpiresAt: expiresAt,
+		TokenType: "Bearer",
+	}, nil
+}
+
+func (s *UserService) sign(claims jwt.MapClaims) (string, error) {
+	tokenString, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(s.jwtSecret))
+	if err != nil {
+		s.logger.Error("Failed to generate JWT token", zap.Error(err))
+		return "", errors.New("failed to generate authentication token")
+	}
+	return tokenString, nil
+}
+
+// RefreshToken exchanges an unexpired refresh token for a new access token.
+// Access tokens are refused, as are refresh tokens issued before the user's
+// tokens were last revoked by a password change or deactivation; deactivated
+// users get ErrUserNotActive. The new access token reflects the user's
+// current name, email and role. The refresh token is handed back unchanged:
+// its expiry is fixed at login, so a session cannot be kept alive forever.
+func (s *UserService) RefreshToken(ctx context.Context, refreshToken string) (*domain.AuthToken, *domain.User, error) {
+	if refreshToken == "" {
+		return nil, nil, domain.ErrInvalidToken
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(refreshToken, claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte(s.jwtSecret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, nil, domain.ErrTokenExpired
+		}
+		s.logger.Debug("Rejected token refresh", zap.Error(err))
+		return nil, nil, domain.ErrInvalidToken
+	}
+
+	if typ, _ := claims["typ"].(string); typ != refreshTokenType {
+		return nil, nil, domain.ErrInvalidToken
+	}
+	userID, err := claims.GetSubject()
+	if err != nil || userID == "" {
+		return nil, nil, domain.ErrInvalidToken
+	}
+	version, ok := claims["ver"].(float64)
+	if !ok {
+		return nil, nil, domain.ErrInvalidToken
+	}
+	// Tokens without an expiry never go stale and cannot be refreshed
+	expiresAt, err := claims.GetExpirationTime()
+	if err != nil || expiresAt == nil {
+		return nil, nil, domain.ErrInvalidToken
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if user == nil {
+		return nil, nil, domain.ErrInvalidToken
+	}
+	if !user.Active {
+		s.logger.Info("Refused token refresh for deactivated user", zap.String("id", user.ID))
+		return nil, nil, domain.ErrUserNotActive
+	}
+	if int(version) != user.TokenVersion {
+		s.logger.Info("Refused revoked refresh token", zap.String("id", user.ID))
+		return nil, nil, domain.ErrInvalidToken
+	}
+
+	token, err := s.issueAccessToken(user)
+	if err != nil {
+		return nil, nil, err
+	}
+	token.RefreshToken = refreshToken
+	token.RefreshExpiresAt = expiresAt.Time
+	return token, user, nil
+}
@@ -0,0 +1,173 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/userSvc/internal/domain"
+)
+
+// newTokenTestService returns a user service issuing access tokens valid for
+// an hour and refresh tokens valid for a day
+func newTokenTestService(users *memoryUserRepository) *UserService {
+	return NewUserService(users, &memoryAddressRepository{}, "test-secret", time.Hour, 24*time.Hour, zap.NewNop())
+}
+
+// parseTestToken returns the claims of a token signed by newTokenTestService
+func parseTestToken(t *testing.T, token string) jwt.MapClaims {
+	t.Helper()
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return []byte("test-secret"), nil
+	})
+	require.NoError(t, err)
+	return claims
+}
+
+func TestLoginTokenCarriesManagedSupplier(t *testing.T) {
+	user := newTestUser(t, domain.RoleStaff)
+	user.AddManagedSupplier("supplier-1", "Acme", "WRITE", "admin-1")
+	user.AddManagedSupplier("supplier-2", "Globex", "READ", "admin-1")
+	service := newTokenTestService(newMemoryUserRepository(user))
+
+	token, err := service.AuthenticateUser(context.Background(), user.Email, "secret-password")
+	require.NoError(t, err)
+
+	claims := parseTestToken(t, token.Token)
+	assert.Equal(t, "supplier-1", claims["supplier_id"])
+	assert.Equal(t, user.ID, claims["sub"])
+}
+
+func TestLoginTokenWithoutManagedSupplier(t *testing.T) {
+	user := newTestUser(t, domain.RoleCustomer)
+	service := newTokenTestService(newMemoryUserRepository(user))
+
+	token, err := service.AuthenticateUser(context.Background(), user.Email, "secret-password")
+	require.NoError(t, err)
+
+	assert.NotContains(t, parseTestToken(t, token.Token), "supplier_id")
+}
+
+// login authenticates user, whose password is the one newTestUser sets
+func login(t *testing.T, service *UserService, user *domain.User) *domain.AuthToken {
+	t.Helper()
+	token, err := service.AuthenticateUser(context.Background(), user.Email, "secret-password")
+	require.NoError(t, err)
+	return token
+}
+
+func TestLoginIssuesRefreshToken(t *testing.T) {
+	user := newTestUser(t, domain.RoleCustomer)
+	service := newTokenTestService(newMemoryUserRepository(user))
+
+	token := login(t, service, user)
+
+	access := parseTestToken(t, token.Token)
+	assert.Equal(t, "access", access["typ"])
+	refresh := parseTestToken(t, token.RefreshToken)
+	assert.Equal(t, "refresh", refresh["typ"])
+	assert.Equal(t, user.ID, refresh["sub"])
+	assert.EqualValues(t, 0, refresh["ver"])
+	assert.NotContains(t, refresh, "role", "refresh tokens carry no authorization")
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), token.RefreshExpiresAt, time.Minute)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), token.ExpiresAt, time.Minute)
+}
+
+func TestRefreshToken(t *testing.T) {
+	user := newTestUser(t, domain.RoleCustomer)
+	users := newMemoryUserRepository(user)
+	service := newTokenTestService(users)
+	issued := login(t, service, user)
+
+	// The role changed since login; the new access token reflects it
+	promoted := *user
+	promoted.Role = domain.RoleStaff
+	require.NoError(t, users.Update(context.Background(), &promoted))
+
+	token, refreshed, err := service.RefreshToken(context.Background(), issued.RefreshToken)
+	require.NoError(t, err)
+
+	assert.Equal(t, user.ID, refreshed.ID)
+	claims := parseTestToken(t, token.Token)
+	assert.Equal(t, "access", claims["typ"])
+	assert.Equal(t, user.ID, claims["sub"])
+	assert.Equal(t, string(domain.RoleStaff), claims["role"])
+	assert.WithinDuration(t, time.Now().Add(time.Hour), token.ExpiresAt, time.Minute)
+
+	assert.Equal(t, issued.RefreshToken, token.RefreshToken, "the refresh token is handed back unchanged")
+	assert.Equal(t, issued.RefreshExpiresAt.Unix(), token.RefreshExpiresAt.Unix(), "refreshing does not extend the refresh token")
+}
+
+func TestRefreshTokenRejectsExpiredToken(t *testing.T) {
+	user := newTestUser(t, domain.RoleCustomer)
+	users := newMemoryUserRepository(user)
+	// Refresh tokens from this service expired a minute before they were issued
+	expiring := NewUserService(users, &memoryAddressRepository{}, "test-secret", time.Hour, -time.Minute, zap.NewNop())
+	issued := login(t, expiring, user)
+
+	_, _, err := newTokenTestService(users).RefreshToken(context.Background(), issued.RefreshToken)
+
+	assert.ErrorIs(t, err, domain.ErrTokenExpired)
+}
+
+func TestRefreshTokenRejectsInvalidTokens(t *testing.T) {
+	user := newTestUser(t, domain.RoleCustomer)
+	service := newTokenTestService(newMemoryUserRepository(user))
+	issued := login(t, service, user)
+
+	foreign := NewUserService(newMemoryUserRepository(user), &memoryAddressRepository{}, "other-secret", time.Hour, 24*time.Hour, zap.NewNop())
+	foreignToken := login(t, foreign, user)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "access token", token: issued.Token},
+		{name: "signed with another secret", token: foreignToken.RefreshToken},
+		{name: "malformed", token: "not-a-token"},
+		{name: "empty", token: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := service.RefreshToken(context.Background(), tt.token)
+			assert.ErrorIs(t, err, domain.ErrInvalidToken)
+		})
+	}
+}
+
+func TestRefreshTokenRejectsDeactivatedUser(t *testing.T) {
+	user := newTestUser(t, domain.RoleCustomer)
+	service := newTokenTestService(newMemoryUserRepository(user))
+	issued := login(t, service, user)
+
+	require.NoError(t, service.DeactivateUser(context.Background(), user.ID))
+	_, _, err := service.RefreshToken(context.Background(), issued.RefreshToken)
+	assert.ErrorIs(t, err, domain.ErrUserNotActive)
+
+	// Reactivating the account does not bring the old refresh token back
+	require.NoError(t, service.ActivateUser(context.Background(), user.ID))
+	_, _, err = service.RefreshToken(context.Background(), issued.RefreshToken)
+	assert.ErrorIs(t, err, domain.ErrInvalidToken)
+}
+
+func TestRefreshTokenRevokedByPasswordChange(t *testing.T) {
+	user := newTestUser(t, domain.RoleCustomer)
+	service := newTokenTestService(newMemoryUserRepository(user))
+	issued := login(t, service, user)
+
+	require.NoError(t, service.ChangeUserPassword(context.Background(), user.ID, "secret-password", "new-secret-password"))
+
+	_, _, err := service.RefreshToken(context.Background(), issued.RefreshToken)
+	assert.ErrorIs(t, err, domain.ErrInvalidToken)
+
+	relogin, err := service.AuthenticateUser(context.Background(), user.Email, "new-secret-password")
+	require.NoError(t, err)
+	_, _, err = service.RefreshToken(context.Background(), relogin.RefreshToken)
+	assert.NoError(t, err, "tokens issued after the change still refresh")
+}
@@ -0,0 +1,41 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/userSvc/internal/domain"
+)
+
+func TestListUsersTotalMatchesAcrossPages(t *testing.T) {
+	users := newMemoryUserRepository()
+	for i := 0; i < 5; i++ {
+		user := newTestUser(t, domain.RoleCustomer)
+		user.Active = i < 3
+		require.NoError(t, users.Create(context.Background(), user))
+	}
+	require.NoError(t, users.Create(context.Background(), newTestUser(t, domain.RoleStaff)))
+	service := newTestUserService(users, &memoryAddressRepository{})
+
+	active := true
+	seen := make(map[string]bool)
+	for offset := 0; offset < 4; offset += 2 {
+		page, total, err := service.ListUsers(context.Background(), "customer", &active, 2, offset)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total, "offset %d", offset)
+		for _, user := range page {
+			assert.Equal(t, domain.RoleCustomer, user.Role)
+			assert.True(t, user.Active)
+			assert.False(t, seen[user.ID], "user %s listed twice", user.ID)
+			seen[user.ID] = true
+		}
+	}
+	assert.Len(t, seen, 3)
+
+	_, total, err := service.ListUsers(context.Background(), "", nil, 2, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), total)
+}
@@ -3,10 +3,10 @@ package application
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
-	"github.com/golang-jwt/jwt/v5"
 	"go.uber.org/zap"
 
 	"github.com/leonvanderhaeghen/stockplatform/services/userSvc/internal/domain"
@@ -17,7 +17,11 @@ type UserService struct {
 	userRepo    domain.UserRepository
 	addressRepo domain.AddressRepository
 	jwtSecret   string
-	logger      *zap.Logger
+	// tokenTTL is how long an issued access token is valid
+	tokenTTL time.Duration
+	// refreshTTL is how long the refresh token issued at login is valid
+	refreshTTL time.Duration
+	logger     *zap.Logger
 }
 
 // NewUserService creates a new user service
@@ -25,12 +29,16 @@ func NewUserService(
 	userRepo domain.UserRepository,
 	addressRepo domain.AddressRepository,
 	jwtSecret string,
+	tokenTTL time.Duration,
+	refreshTTL time.Duration,
 	logger *zap.Logger,
 ) *UserService {
 	return &UserService{
 		userRepo:    userRepo,
 		addressRepo: addressRepo,
 		jwtSecret:   jwtSecret,
+		tokenTTL:    tokenTTL,
+		refreshTTL:  refreshTTL,
 		logger:      logger.Named("user_service"),
 	}
 }
@@ -79,27 +87,27 @@ func (s *UserService) RegisterUserWithRole(ctx context.Context, email, password,
 }
 
 // AuthenticateUser authenticates a user and returns a JWT token
-func (s *UserService) AuthenticateUser(ctx context.Context, email, password string) (string, error) {
+func (s *UserService) AuthenticateUser(ctx context.Context, email, password string) (*domain.AuthToken, error) {
 	s.logger.Info("Authenticating user", zap.String("email", email))
 
 	// Find user by email
 	user, err := s.userRepo.GetByEmail(ctx, email)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	if user == nil {
-		return "", errors.New("invalid email or password")
+		return nil, errors.New("invalid email or password")
 	}
 
 	// Check if user is active
 	if !user.Active {
-		return "", errors.New("account is deactivated")
+		return nil, errors.New("account is deactivated")
 	}
 
 	// Verify password
 	if !user.CheckPassword(password) {
-		return "", errors.New("invalid email or password")
+		return nil, errors.New("invalid email or password")
 	}
 
 	// Update last login time
@@ -112,22 +120,7 @@ func (s *UserService) AuthenticateUser(ctx context.Context, email, password stri
 		// Continue anyway as this is not critical
 	}
 
-	// Generate JWT token
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"sub":  user.ID,
-		"name": user.FullName(),
-		"email": user.Email,
-		"role": string(user.Role),
-		"exp":  time.Now().Add(24 * time.Hour).Unix(),
-	})
-
-	tokenString, err := token.SignedString([]byte(s.jwtSecret))
-	if err != nil {
-		s.logger.Error("Failed to generate JWT token", zap.Error(err))
-		return "", errors.New("failed to generate authentication token")
-	}
-
-	return tokenString, nil
+	return s.issueToken(user)
 }
 
 // GetUserByID retrieves a user by ID
@@ -291,9 +284,10 @@ func (s *UserService) CreateUserAddress(ctx context.Context, userID, name, stree
 		return nil, errors.New("user ID is required")
 	}
 	
-	// Validate required fields
-	if name == "" || street == "" || city == "" || postalCode == "" || country == "" {
-		return nil, errors.New("name, street, city, postal code, and country are required")
+	// Validate required fields and the country
+	input := domain.AddressInput{Name: name, Street: street, City: city, PostalCode: postalCode, Country: country}
+	if err := input.Validate(); err != nil {
+		return nil, err
 	}
 	
 	// Check if user exists
@@ -314,6 +308,98 @@ func (s *UserService) CreateUserAddress(ctx context.Context, userID, name, stree
 	return address, nil
 }
 
+// maxBulkAddresses caps the number of addresses accepted by BulkCreateAddresses
+const maxBulkAddresses = 500
+
+// BulkCreateAddresses creates several addresses for a user in one insert and
+// returns a result per input, so valid addresses are kept when others fail.
+// At most one address ends up as the default: the first valid input claiming
+// default wins and replaces any existing default. If the user has no default
+// and none is claimed, the first created address becomes the default.
+func (s *UserService) BulkCreateAddresses(ctx context.Context, userID string, inputs []domain.AddressInput) ([]*domain.AddressResult, error) {
+	s.logger.Info("Bulk creating user addresses",
+		zap.String("user_id", userID),
+		zap.Int("count", len(inputs)),
+	)
+
+	if userID == "" {
+		return nil, errors.New("user ID is required")
+	}
+	if len(inputs) == 0 {
+		return nil, errors.New("at least one address is required")
+	}
+	if len(inputs) > maxBulkAddresses {
+		return nil, fmt.Errorf("at most %d addresses can be imported at once", maxBulkAddresses)
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	existingDefault, err := s.addressRepo.GetDefaultByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*domain.AddressResult, len(inputs))
+	addresses := make([]*domain.Address, 0, len(inputs))
+	positions := make([]int, 0, len(inputs))
+	defaultClaimed := false
+	for i, in := range inputs {
+		results[i] = &domain.AddressResult{Index: i}
+		if err := in.Validate(); err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		isDefault := in.IsDefault && !defaultClaimed
+		defaultClaimed = defaultClaimed || isDefault
+
+		address := domain.NewAddress(userID, in.Name, in.Street, in.City, in.State, in.PostalCode, in.Country, in.Phone, isDefault)
+		results[i].Address = address
+		addresses = append(addresses, address)
+		positions = append(positions, i)
+	}
+
+	if len(addresses) == 0 {
+		return results, nil
+	}
+	if !defaultClaimed && existingDefault == nil {
+		addresses[0].IsDefault = true
+	}
+
+	failed, err := s.addressRepo.CreateMany(ctx, addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	for j, address := range addresses {
+		insertErr, ok := failed[j]
+		if !ok {
+			continue
+		}
+		results[positions[j]].Address = nil
+		results[positions[j]].Err = insertErr
+
+		// The new default was not stored; put the previous one back
+		if address.IsDefault && existingDefault != nil {
+			if err := s.addressRepo.SetDefaultAddress(ctx, userID, existingDefault.ID); err != nil {
+				s.logger.Error("Failed to restore default address",
+					zap.String("user_id", userID),
+					zap.String("address_id", existingDefault.ID),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+
+	return results, nil
+}
+
 // GetUserAddresses retrieves all addresses for a user
 func (s *UserService) GetUserAddresses(ctx context.Context, userID string) ([]*domain.Address, error) {
 	s.logger.Debug("Getting user addresses", zap.String("user_id", userID))
@@ -347,9 +433,10 @@ func (s *UserService) UpdateUserAddress(ctx context.Context, addressID, userID,
 		return errors.New("address ID and user ID are required")
 	}
 	
-	// Validate required fields
-	if name == "" || street == "" || city == "" || postalCode == "" || country == "" {
-		return errors.New("name, street, city, postal code, and country are required")
+	// Validate required fields and the country
+	input := domain.AddressInput{Name: name, Street: street, City: city, PostalCode: postalCode, Country: country}
+	if err := input.Validate(); err != nil {
+		return err
 	}
 	
 	// Check if address exists and belongs to the user
@@ -441,7 +528,7 @@ func (s *UserService) CreateAdminUser(ctx context.Context, email, password, firs
 }
 
 // ListUsers lists all users with optional filtering and pagination
-func (s *UserService) ListUsers(ctx context.Context, role string, active *bool, limit, offset int) ([]*domain.User, error) {
+func (s *UserService) ListUsers(ctx context.Context, role string, active *bool, limit, offset int) ([]*domain.User, int64, error) {
 	s.logger.Debug("Listing users",
 		zap.String("role", role),
 		zap.Int("limit", limit),
@@ -464,5 +551,26 @@ func (s *UserService) ListUsers(ctx context.Context, role string, active *bool,
 		filter["active"] = *active
 	}
 	
-	return s.userRepo.List(ctx, filter, limit, offset)
+	users, err := s.userRepo.List(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := s.userRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	return users, total, nil
+}
+
+// CountUsers counts users, optionally only those with a role or that are active
+func (s *UserService) CountUsers(ctx context.Context, role string, activeOnly bool) (int64, error) {
+	filter := make(map[string]interface{})
+	if role != "" {
+		filter["role"] = strings.ToUpper(role)
+	}
+	if activeOnly {
+		filter["active"] = true
+	}
+	
+	return s.userRepo.Count(ctx, filter)
 }
@@ -0,0 +1,158 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/services/userSvc/internal/domain"
+)
+
+func newTestUser(t *testing.T, role domain.Role) *domain.User {
+	t.Helper()
+	user, err := domain.NewUser("user@example.com", "secret-password", "Test", "User", role)
+	require.NoError(t, err)
+	return user
+}
+
+func newTestUserService(users *memoryUserRepository, addresses *memoryAddressRepository) *UserService {
+	return NewUserService(users, addresses, "test-secret", 0, 0, zap.NewNop())
+}
+
+func addressInput(name string, isDefault bool) domain.AddressInput {
+	return domain.AddressInput{
+		Name:       name,
+		Street:     "1 Main Street",
+		City:       "Ghent",
+		PostalCode: "9000",
+		Country:    "BE",
+		IsDefault:  isDefault,
+	}
+}
+
+// defaultNames returns the names of the user's default addresses
+func defaultNames(t *testing.T, addresses *memoryAddressRepository, userID string) []string {
+	t.Helper()
+	stored, err := addresses.GetByUserID(context.Background(), userID)
+	require.NoError(t, err)
+	var names []string
+	for _, address := range stored {
+		if address.IsDefault {
+			names = append(names, address.Name)
+		}
+	}
+	return names
+}
+
+func TestBulkCreateAddressesFirstClaimedDefaultWins(t *testing.T) {
+	user := newTestUser(t, domain.RoleCustomer)
+	addresses := &memoryAddressRepository{}
+	addresses.add(domain.NewAddress(user.ID, "existing", "2 Side Street", "Ghent", "", "9000", "BE", "", true))
+	service := newTestUserService(newMemoryUserRepository(user), addresses)
+
+	results, err := service.BulkCreateAddresses(context.Background(), user.ID, []domain.AddressInput{
+		addressInput("office", false),
+		addressInput("warehouse", true),
+		addressInput("depot", true),
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	for i, result := range results {
+		assert.Equal(t, i, result.Index)
+		assert.NoError(t, result.Err)
+	}
+	assert.False(t, results[0].Address.IsDefault)
+	assert.True(t, results[1].Address.IsDefault)
+	assert.False(t, results[2].Address.IsDefault, "only the first input claiming default becomes it")
+
+	assert.Equal(t, []string{"warehouse"}, defaultNames(t, addresses, user.ID), "the existing default is replaced")
+}
+
+func TestBulkCreateAddressesSkipsInvalidDefaultClaim(t *testing.T) {
+	user := newTestUser(t, domain.RoleCustomer)
+	addresses := &memoryAddressRepository{}
+	service := newTestUserService(newMemoryUserRepository(user), addresses)
+
+	invalid := addressInput("", true)
+	results, err := service.BulkCreateAddresses(context.Background(), user.ID, []domain.AddressInput{
+		invalid,
+		addressInput("office", false),
+		addressInput("warehouse", true),
+	})
+	require.NoError(t, err)
+
+	assert.Error(t, results[0].Err)
+	assert.Nil(t, results[0].Address)
+	assert.Equal(t, []string{"warehouse"}, defaultNames(t, addresses, user.ID))
+}
+
+func TestBulkCreateAddressesDefaultsFirstWhenUserHasNone(t *testing.T) {
+	user := newTestUser(t, domain.RoleCustomer)
+	addresses := &memoryAddressRepository{}
+	service := newTestUserService(newMemoryUserRepository(user), addresses)
+
+	_, err := service.BulkCreateAddresses(context.Background(), user.ID, []domain.AddressInput{
+		addressInput("office", false),
+		addressInput("warehouse", false),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"office"}, defaultNames(t, addresses, user.ID))
+}
+
+func TestBulkCreateAddressesKeepsExistingDefaultWhenNoneClaimed(t *testing.T) {
+	user := newTestUser(t, domain.RoleCustomer)
+	addresses := &memoryAddressRepository{}
+	addresses.add(domain.NewAddress(user.ID, "existing", "2 Side Street", "Ghent", "", "9000", "BE", "", true))
+	service := newTestUserService(newMemoryUserRepository(user), addresses)
+
+	_, err := service.BulkCreateAddresses(context.Background(), user.ID, []domain.AddressInput{
+		addressInput("office", false),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"existing"}, defaultNames(t, addresses, user.ID))
+}
+
+func TestBulkCreateAddressesRestoresDefaultWhenNewDefaultFails(t *testing.T) {
+	user := newTestUser(t, domain.RoleCustomer)
+	addresses := &memoryAddressRepository{failNames: map[string]bool{"warehouse": true}}
+	addresses.add(domain.NewAddress(user.ID, "existing", "2 Side Street", "Ghent", "", "9000", "BE", "", true))
+	service := newTestUserService(newMemoryUserRepository(user), addresses)
+
+	results, err := service.BulkCreateAddresses(context.Background(), user.ID, []domain.AddressInput{
+		addressInput("office", false),
+		addressInput("warehouse", true),
+	})
+	require.NoError(t, err)
+
+	assert.NoError(t, results[0].Err)
+	assert.Error(t, results[1].Err)
+	assert.Nil(t, results[1].Address)
+	assert.Equal(t, []string{"existing"}, defaultNames(t, addresses, user.ID))
+}
+
+func TestAddressesRequireISOCountryCode(t *testing.T) {
+	ctx := context.Background()
+	user := newTestUser(t, domain.RoleCustomer)
+	addresses := &memoryAddressRepository{}
+	service := newTestUserService(newMemoryUserRepository(user), addresses)
+
+	_, err := service.CreateUserAddress(ctx, user.ID, "home", "1 Main Street", "Ghent", "", "9000", "Belgium", "", true)
+	assert.ErrorIs(t, err, domain.ErrInvalidCountry)
+
+	err = service.UpdateUserAddress(ctx, "address-1", user.ID, "home", "1 Main Street", "Ghent", "", "9000", "XX", "", true)
+	assert.ErrorIs(t, err, domain.ErrInvalidCountry)
+
+	invalid := addressInput("office", false)
+	invalid.Country = "UK"
+	lowercase := addressInput("warehouse", false)
+	lowercase.Country = "be"
+	results, err := service.BulkCreateAddresses(ctx, user.ID, []domain.AddressInput{invalid, lowercase})
+	require.NoError(t, err)
+	assert.ErrorIs(t, results[0].Err, domain.ErrInvalidCountry)
+	assert.NoError(t, results[1].Err)
+}
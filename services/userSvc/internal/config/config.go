@@ -2,34 +2,54 @@ package config
 
 import (
 	"os"
+	"time"
 
 	"go.uber.org/zap"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/grpclimits"
+	"github.com/leonvanderhaeghen/stockplatform/pkg/mongoclient"
 )
 
 // Config holds the application configuration
 type Config struct {
-	GRPCPort    string
-	MongoURI    string
-	Database    string
-	JWTSecret   string
-	OrderSvcURL string
+	GRPCPort  string
+	MongoURI  string
+	Database  string
+	JWTSecret string
+	// JWTTTL is how long an issued access token is valid
+	JWTTTL time.Duration
+	// JWTRefreshTTL is how long a refresh token is valid; refreshing does not
+	// extend it, so users sign in again once it runs out
+	JWTRefreshTTL time.Duration
+	OrderSvcURL   string
+	MongoPool     mongoclient.PoolConfig
+	GRPCLimits    grpclimits.MessageLimits
 }
 
 // Load loads configuration from environment variables
 func Load(logger *zap.Logger) *Config {
 	cfg := &Config{
-		GRPCPort:    getEnv("GRPC_PORT", "50056"),
-		MongoURI:    getEnv("MONGO_URI", "mongodb://localhost:27017"),
-		Database:    getEnv("DATABASE_NAME", "stockplatform"),
-		JWTSecret:   getEnv("JWT_SECRET", "your-secret-key-here"),
-		OrderSvcURL: getEnv("ORDER_SERVICE_URL", "order-service:50055"),
+		GRPCPort:      getEnv("GRPC_PORT", "50056"),
+		MongoURI:      getEnv("MONGO_URI", "mongodb://localhost:27017"),
+		Database:      getEnv("DATABASE_NAME", "stockplatform"),
+		JWTSecret:     getEnv("JWT_SECRET", "your-secret-key-here"),
+		JWTTTL:        getEnvAsDuration("JWT_TTL", 24*time.Hour),
+		JWTRefreshTTL: getEnvAsDuration("JWT_REFRESH_TTL", 7*24*time.Hour),
+		OrderSvcURL:   getEnv("ORDER_SERVICE_URL", "order-service:50055"),
+		MongoPool:     mongoclient.PoolConfigFromEnv(mongoclient.DefaultPoolConfig()),
+		GRPCLimits:    grpclimits.MessageLimitsFromEnv(grpclimits.DefaultMessageLimits()),
 	}
 
 	logger.Info("Configuration loaded",
 		zap.String("grpc_port", cfg.GRPCPort),
 		zap.String("mongo_uri", maskSensitive(cfg.MongoURI)),
 		zap.String("database", cfg.Database),
+		zap.Uint64("mongo_max_pool_size", cfg.MongoPool.MaxPoolSize),
+		zap.Int("grpc_max_recv_msg_size", cfg.GRPCLimits.MaxRecvMsgSize),
+		zap.Int("grpc_max_send_msg_size", cfg.GRPCLimits.MaxSendMsgSize),
 		zap.String("order_service_url", cfg.OrderSvcURL),
+		zap.Duration("jwt_ttl", cfg.JWTTTL),
+		zap.Duration("jwt_refresh_ttl", cfg.JWTRefreshTTL),
 	)
 
 	return cfg
@@ -43,6 +63,16 @@ func getEnv(key, fallback string) string {
 	return fallback
 }
 
+// getEnvAsDuration gets an environment variable as a duration with a fallback
+func getEnvAsDuration(key string, fallback time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if d, err := time.ParseDuration(value); err == nil {
+			return d
+		}
+	}
+	return fallback
+}
+
 // maskSensitive masks sensitive information for logging
 func maskSensitive(value string) string {
 	if len(value) > 20 {
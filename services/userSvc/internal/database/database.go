@@ -9,6 +9,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.uber.org/zap"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/mongoclient"
 	"github.com/leonvanderhaeghen/stockplatform/services/userSvc/internal/config"
 	"github.com/leonvanderhaeghen/stockplatform/services/userSvc/internal/domain"
 	mongorepo "github.com/leonvanderhaeghen/stockplatform/services/userSvc/internal/infrastructure/mongodb"
@@ -27,7 +28,7 @@ type Database struct {
 // Initialize creates and initializes the database layer
 func Initialize(cfg *config.Config, logger *zap.Logger) (*Database, error) {
 	// Create MongoDB client
-	client, err := createMongoClient(cfg.MongoURI, logger)
+	client, err := createMongoClient(cfg.MongoURI, cfg.MongoPool, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +87,7 @@ func (d *Database) Close() error {
 }
 
 // createMongoClient creates a MongoDB client with proper configuration
-func createMongoClient(mongoURI string, logger *zap.Logger) (*mongo.Client, error) {
+func createMongoClient(mongoURI string, pool mongoclient.PoolConfig, logger *zap.Logger) (*mongo.Client, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -95,10 +96,7 @@ func createMongoClient(mongoURI string, logger *zap.Logger) (*mongo.Client, erro
 	// Set up client options with timeouts and retry settings
 	clientOptions := options.Client()
 	clientOptions.ApplyURI(mongoURI)
-	clientOptions.SetConnectTimeout(10 * time.Second)
-	clientOptions.SetSocketTimeout(30 * time.Second)
-	clientOptions.SetServerSelectionTimeout(10 * time.Second)
-	clientOptions.SetMaxPoolSize(10)
+	pool.Apply(clientOptions)
 
 	client, err := mongo.Connect(ctx, clientOptions)
 	if err != nil {
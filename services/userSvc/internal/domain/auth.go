@@ -78,6 +78,10 @@ type AuthToken struct {
 	Token     string    `json:"token"`
 	ExpiresAt time.Time `json:"expires_at"`
 	TokenType string    `json:"token_type"`
+	// RefreshToken is exchanged for a new access token until
+	// RefreshExpiresAt, which refreshing does not extend
+	RefreshToken     string    `json:"refresh_token,omitempty"`
+	RefreshExpiresAt time.Time `json:"refresh_expires_at,omitempty"`
 }
 
 // Claims represents JWT claims
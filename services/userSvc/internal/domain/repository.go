@@ -21,6 +21,9 @@ type UserRepository interface {
 	
 	// List returns all users with optional filtering and pagination
 	List(ctx context.Context, filter map[string]interface{}, limit, offset int) ([]*User, error)
+	
+	// Count returns the number of users matching a filter
+	Count(ctx context.Context, filter map[string]interface{}) (int64, error)
 }
 
 // AddressRepository defines the interface for address persistence
@@ -28,6 +31,11 @@ type AddressRepository interface {
 	// Create adds a new address
 	Create(ctx context.Context, address *Address) error
 	
+	// CreateMany adds several addresses in a single insert. Failures of
+	// individual documents are returned by their index in addresses; the
+	// error is only set when the insert failed as a whole.
+	CreateMany(ctx context.Context, addresses []*Address) (map[int]error, error)
+	
 	// GetByID finds an address by ID
 	GetByID(ctx context.Context, id string) (*Address, error)
 	
@@ -1,10 +1,13 @@
 package domain
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/countries"
 )
 
 // Role represents user roles
@@ -29,6 +32,9 @@ type User struct {
 	Role         Role      `bson:"role"`
 	Phone        string    `bson:"phone,omitempty"`
 	Active       bool      `bson:"active"`
+	// TokenVersion is embedded in refresh tokens; bumping it revokes every
+	// refresh token issued before
+	TokenVersion int       `bson:"token_version"`
 	LastLogin    time.Time `bson:"last_login,omitempty"`
 	CreatedAt    time.Time `bson:"created_at"`
 	UpdatedAt    time.Time `bson:"updated_at"`
@@ -93,7 +99,7 @@ func (u *User) UpdatePassword(password string) error {
 	}
 
 	u.PasswordHash = string(passwordHash)
-	u.UpdatedAt = time.Now()
+	u.RevokeTokens()
 	return nil
 }
 
@@ -110,9 +116,15 @@ func (u *User) FullName() string {
 	return u.FirstName + " " + u.LastName
 }
 
-// Deactivate deactivates the user account
+// Deactivate deactivates the user account and revokes its refresh tokens
 func (u *User) Deactivate() {
 	u.Active = false
+	u.RevokeTokens()
+}
+
+// RevokeTokens invalidates every refresh token issued to the user so far
+func (u *User) RevokeTokens() {
+	u.TokenVersion++
 	u.UpdatedAt = time.Now()
 }
 
@@ -191,6 +203,42 @@ func (a *Address) SetDefault(isDefault bool) {
 	a.UpdatedAt = time.Now()
 }
 
+// AddressInput holds the fields of an address to be created
+type AddressInput struct {
+	Name       string
+	Street     string
+	City       string
+	State      string
+	PostalCode string
+	Country    string
+	Phone      string
+	IsDefault  bool
+}
+
+// ErrInvalidCountry is returned for addresses whose country is not an ISO
+// 3166-1 alpha-2 code
+var ErrInvalidCountry = errors.New("country must be an ISO 3166-1 alpha-2 code")
+
+// Validate checks that the required address fields are present and that the
+// country is an assigned ISO 3166-1 alpha-2 code
+func (in AddressInput) Validate() error {
+	if in.Name == "" || in.Street == "" || in.City == "" || in.PostalCode == "" || in.Country == "" {
+		return errors.New("name, street, city, postal code, and country are required")
+	}
+	if !countries.IsCode(in.Country) {
+		return ErrInvalidCountry
+	}
+	return nil
+}
+
+// AddressResult reports the outcome of creating one address in a bulk import.
+// Index is the position of the input in the request.
+type AddressResult struct {
+	Index   int
+	Address *Address
+	Err     error
+}
+
 // Store and Supplier Management Methods
 
 // AddManagedStore adds a store to the user's managed stores list
@@ -286,6 +334,15 @@ func (u *User) AddManagedSupplier(supplierID, supplierName, accessLevel, assigne
 	u.UpdatedAt = time.Now()
 }
 
+// PrimarySupplierID returns the supplier the user acts for: the first supplier
+// they manage, or "" when they manage none
+func (u *User) PrimarySupplierID() string {
+	if len(u.ManagedSuppliers) == 0 {
+		return ""
+	}
+	return u.ManagedSuppliers[0].SupplierID
+}
+
 // RemoveManagedSupplier removes a supplier from the user's managed suppliers list
 func (u *User) RemoveManagedSupplier(supplierID string) {
 	for i, supplier := range u.ManagedSuppliers {
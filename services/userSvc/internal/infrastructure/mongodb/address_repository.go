@@ -80,6 +80,51 @@ func (r *AddressRepository) Create(ctx context.Context, address *domain.Address)
 	return nil
 }
 
+// CreateMany adds several addresses in a single unordered insert
+func (r *AddressRepository) CreateMany(ctx context.Context, addresses []*domain.Address) (map[int]error, error) {
+	r.logger.Debug("Creating addresses", zap.Int("count", len(addresses)))
+
+	if len(addresses) == 0 {
+		return nil, nil
+	}
+
+	// A new default replaces the existing one, as in Create
+	for _, address := range addresses {
+		if address.IsDefault {
+			if err := r.clearDefaultAddresses(ctx, address.UserID); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	docs := make([]interface{}, len(addresses))
+	for i, address := range addresses {
+		docs[i] = address
+	}
+
+	_, err := r.collection.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+	if err == nil {
+		return nil, nil
+	}
+
+	var bulkErr mongo.BulkWriteException
+	if !errors.As(err, &bulkErr) || len(bulkErr.WriteErrors) == 0 {
+		r.logger.Error("Failed to create addresses", zap.Error(err))
+		return nil, err
+	}
+
+	failed := make(map[int]error, len(bulkErr.WriteErrors))
+	for _, writeErr := range bulkErr.WriteErrors {
+		failed[writeErr.Index] = errors.New(writeErr.Message)
+	}
+	r.logger.Warn("Some addresses failed to insert",
+		zap.Int("failed", len(failed)),
+		zap.Int("total", len(addresses)),
+	)
+	return failed, nil
+}
+
 // GetByID finds an address by ID
 func (r *AddressRepository) GetByID(ctx context.Context, id string) (*domain.Address, error) {
 	r.logger.Debug("Getting address by ID", zap.String("id", id))
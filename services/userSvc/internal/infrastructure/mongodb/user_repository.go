@@ -199,3 +199,22 @@ func (r *UserRepository) List(ctx context.Context, filter map[string]interface{}
 	
 	return users, nil
 }
+
+// Count returns the number of users matching a filter
+func (r *UserRepository) Count(ctx context.Context, filter map[string]interface{}) (int64, error) {
+	r.logger.Debug("Counting users")
+	
+	// Convert map to bson.M
+	bsonFilter := bson.M{}
+	for k, v := range filter {
+		bsonFilter[k] = v
+	}
+	
+	count, err := r.collection.CountDocuments(ctx, bsonFilter)
+	if err != nil {
+		r.logger.Error("Failed to count users", zap.Error(err))
+		return 0, err
+	}
+	
+	return count, nil
+}
@@ -0,0 +1,11 @@
+package grpc
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errUserNotFound is returned for users that do not exist and for users the
+// caller may not look up, so lookups cannot be used to probe which emails
+// have an account
+var errUserNotFound = status.Error(codes.NotFound, "user not found")
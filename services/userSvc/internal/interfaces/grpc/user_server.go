@@ -2,12 +2,15 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/identity"
 	userv1 "github.com/leonvanderhaeghen/stockplatform/services/userSvc/api/gen/go/proto/user/v1"
 	"github.com/leonvanderhaeghen/stockplatform/services/userSvc/internal/application"
 	"github.com/leonvanderhaeghen/stockplatform/services/userSvc/internal/domain"
@@ -108,13 +111,48 @@ func (s *UserServer) AuthenticateUser(ctx context.Context, req *userv1.Authentic
 		s.logger.Error("Failed to get user details after authentication", zap.Error(err))
 		// Still return the token even if we can't get user details
 		return &userv1.AuthenticateUserResponse{
-			Token: token,
+			Token:            token.Token,
+			ExpiresAt:        token.ExpiresAt.Unix(),
+			RefreshToken:     token.RefreshToken,
+			RefreshExpiresAt: token.RefreshExpiresAt.Unix(),
 		}, nil
 	}
 
 	return &userv1.AuthenticateUserResponse{
-		Token: token,
-		User:  toProtoUser(user),
+		Token:            token.Token,
+		User:             toProtoUser(user),
+		ExpiresAt:        token.ExpiresAt.Unix(),
+		RefreshToken:     token.RefreshToken,
+		RefreshExpiresAt: token.RefreshExpiresAt.Unix(),
+	}, nil
+}
+
+// RefreshToken exchanges a refresh token for a new access token
+func (s *UserServer) RefreshToken(ctx context.Context, req *userv1.RefreshTokenRequest) (*userv1.RefreshTokenResponse, error) {
+	s.logger.Debug("gRPC RefreshToken called")
+
+	if req.RefreshToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "refresh token is required")
+	}
+
+	token, user, err := s.service.RefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrTokenExpired), errors.Is(err, domain.ErrInvalidToken):
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case errors.Is(err, domain.ErrUserNotActive):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		s.logger.Error("Failed to refresh token", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to refresh token")
+	}
+
+	return &userv1.RefreshTokenResponse{
+		Token:            token.Token,
+		User:             toProtoUser(user),
+		ExpiresAt:        token.ExpiresAt.Unix(),
+		RefreshToken:     token.RefreshToken,
+		RefreshExpiresAt: token.RefreshExpiresAt.Unix(),
 	}, nil
 }
 
@@ -137,7 +175,10 @@ func (s *UserServer) GetUser(ctx context.Context, req *userv1.GetUserRequest) (*
 	}, nil
 }
 
-// GetUserByEmail retrieves a user by email
+// GetUserByEmail retrieves a user by email. Staff and admins may look up
+// any email; other callers only their own account. Every other lookup,
+// including one without a forwarded caller, fails with the same NotFound as
+// an unknown email.
 func (s *UserServer) GetUserByEmail(ctx context.Context, req *userv1.GetUserByEmailRequest) (*userv1.GetUserResponse, error) {
 	s.logger.Debug("gRPC GetUserByEmail called", zap.String("email", req.Email))
 
@@ -145,10 +186,19 @@ func (s *UserServer) GetUserByEmail(ctx context.Context, req *userv1.GetUserByEm
 		return nil, status.Error(codes.InvalidArgument, "email is required")
 	}
 
+	isStaff, callerID := identity.IsStaff(ctx), identity.UserID(ctx)
+	if !isStaff && callerID == "" {
+		return nil, errUserNotFound
+	}
+
 	user, err := s.service.GetUserByEmail(ctx, req.Email)
 	if err != nil {
-		s.logger.Error("Failed to get user by email", zap.Error(err))
-		return nil, status.Error(codes.NotFound, "user not found")
+		s.logger.Debug("Failed to get user by email", zap.Error(err))
+		return nil, errUserNotFound
+	}
+	if !isStaff && user.ID != callerID {
+		s.logger.Warn("Refused lookup of another user's email", zap.String("caller_id", callerID))
+		return nil, errUserNotFound
 	}
 
 	return &userv1.GetUserResponse{
@@ -265,7 +315,7 @@ func (s *UserServer) ListUsers(ctx context.Context, req *userv1.ListUsersRequest
 
 	active := &req.Active
 	
-	users, err := s.service.ListUsers(ctx, req.Role, active, limit, offset)
+	users, total, err := s.service.ListUsers(ctx, req.Role, active, limit, offset)
 	if err != nil {
 		s.logger.Error("Failed to list users", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to list users: "+err.Error())
@@ -278,10 +328,29 @@ func (s *UserServer) ListUsers(ctx context.Context, req *userv1.ListUsersRequest
 	}
 
 	return &userv1.ListUsersResponse{
-		Users: protoUsers,
+		Users:      protoUsers,
+		TotalCount: int32(total),
+		Limit:      int32(limit),
+		Offset:     int32(offset),
 	}, nil
 }
 
+// CountUsers counts users matching a role and activity filter
+func (s *UserServer) CountUsers(ctx context.Context, req *userv1.CountUsersRequest) (*userv1.CountUsersResponse, error) {
+	s.logger.Debug("gRPC CountUsers called",
+		zap.String("role", req.Role),
+		zap.Bool("active_only", req.ActiveOnly),
+	)
+
+	count, err := s.service.CountUsers(ctx, req.Role, req.ActiveOnly)
+	if err != nil {
+		s.logger.Error("Failed to count users", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to count users: "+err.Error())
+	}
+
+	return &userv1.CountUsersResponse{Count: count}, nil
+}
+
 // CreateUserAddress creates a new address for a user
 func (s *UserServer) CreateUserAddress(ctx context.Context, req *userv1.CreateUserAddressRequest) (*userv1.CreateUserAddressResponse, error) {
 	s.logger.Info("gRPC CreateUserAddress called",
@@ -310,6 +379,9 @@ func (s *UserServer) CreateUserAddress(ctx context.Context, req *userv1.CreateUs
 	}
 
 	address, err := s.service.CreateUserAddress(ctx, req.UserId, req.Name, req.Street, req.City, req.State, req.PostalCode, req.Country, req.Phone, req.IsDefault)
+	if errors.Is(err, domain.ErrInvalidCountry) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		s.logger.Error("Failed to create user address", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to create user address: "+err.Error())
@@ -320,6 +392,59 @@ func (s *UserServer) CreateUserAddress(ctx context.Context, req *userv1.CreateUs
 	}, nil
 }
 
+// BulkCreateUserAddresses imports several addresses for a user, reporting the outcome of each
+func (s *UserServer) BulkCreateUserAddresses(ctx context.Context, req *userv1.BulkCreateUserAddressesRequest) (*userv1.BulkCreateUserAddressesResponse, error) {
+	s.logger.Info("gRPC BulkCreateUserAddresses called",
+		zap.String("user_id", req.UserId),
+		zap.Int("count", len(req.Addresses)),
+	)
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if len(req.Addresses) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one address is required")
+	}
+
+	inputs := make([]domain.AddressInput, len(req.Addresses))
+	for i, a := range req.Addresses {
+		inputs[i] = domain.AddressInput{
+			Name:       a.Name,
+			Street:     a.Street,
+			City:       a.City,
+			State:      a.State,
+			PostalCode: a.PostalCode,
+			Country:    a.Country,
+			Phone:      a.Phone,
+			IsDefault:  a.IsDefault,
+		}
+	}
+
+	results, err := s.service.BulkCreateAddresses(ctx, req.UserId, inputs)
+	if err != nil {
+		s.logger.Error("Failed to bulk create user addresses", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to bulk create user addresses: "+err.Error())
+	}
+
+	resp := &userv1.BulkCreateUserAddressesResponse{
+		Results: make([]*userv1.AddressResult, 0, len(results)),
+	}
+	for _, r := range results {
+		result := &userv1.AddressResult{Index: int32(r.Index)}
+		if r.Err != nil {
+			result.Error = r.Err.Error()
+			resp.FailedCount++
+		} else {
+			result.Success = true
+			result.Address = toProtoAddress(r.Address)
+			resp.CreatedCount++
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	return resp, nil
+}
+
 // GetUserAddresses retrieves all addresses for a user
 func (s *UserServer) GetUserAddresses(ctx context.Context, req *userv1.GetUserAddressesRequest) (*userv1.GetUserAddressesResponse, error) {
 	s.logger.Debug("gRPC GetUserAddresses called", zap.String("user_id", req.UserId))
@@ -395,6 +520,9 @@ func (s *UserServer) UpdateUserAddress(ctx context.Context, req *userv1.UpdateUs
 	}
 
 	if err := s.service.UpdateUserAddress(ctx, req.Id, req.UserId, req.Name, req.Street, req.City, req.State, req.PostalCode, req.Country, req.Phone, req.IsDefault); err != nil {
+		if errors.Is(err, domain.ErrInvalidCountry) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		s.logger.Error("Failed to update user address", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to update user address: "+err.Error())
 	}
@@ -449,14 +577,16 @@ func (s *UserServer) SetDefaultUserAddress(ctx context.Context, req *userv1.SetD
 // toProtoUser converts a domain user to a proto user
 func toProtoUser(user *domain.User) *userv1.User {
 	protoUser := &userv1.User{
-		Id:        user.ID,
-		Email:     user.Email,
-		FirstName: user.FirstName,
-		LastName:  user.LastName,
-		Phone:     user.Phone,
-		Active:    user.Active,
-		CreatedAt: user.CreatedAt.Format(time.RFC3339),
-		UpdatedAt: user.UpdatedAt.Format(time.RFC3339),
+		Id:          user.ID,
+		Email:       user.Email,
+		FirstName:   user.FirstName,
+		LastName:    user.LastName,
+		Phone:       user.Phone,
+		Active:      user.Active,
+		CreatedAt:   user.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   user.UpdatedAt.Format(time.RFC3339),
+		CreatedAtTs: timestamppb.New(user.CreatedAt),
+		UpdatedAtTs: timestamppb.New(user.UpdatedAt),
 	}
 
 	// Convert role
@@ -474,6 +604,7 @@ func toProtoUser(user *domain.User) *userv1.User {
 	// Set last login if it exists
 	if !user.LastLogin.IsZero() {
 		protoUser.LastLogin = user.LastLogin.Format(time.RFC3339)
+		protoUser.LastLoginTs = timestamppb.New(user.LastLogin)
 	}
 
 	return protoUser
@@ -492,7 +623,9 @@ func toProtoAddress(address *domain.Address) *userv1.Address {
 		Country:    address.Country,
 		IsDefault:  address.IsDefault,
 		Phone:      address.Phone,
-		CreatedAt:  address.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:  address.UpdatedAt.Format(time.RFC3339),
+		CreatedAt:   address.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   address.UpdatedAt.Format(time.RFC3339),
+		CreatedAtTs: timestamppb.New(address.CreatedAt),
+		UpdatedAtTs: timestamppb.New(address.UpdatedAt),
 	}
 }
@@ -0,0 +1,190 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/leonvanderhaeghen/stockplatform/pkg/identity"
+	userv1 "github.com/leonvanderhaeghen/stockplatform/services/userSvc/api/gen/go/proto/user/v1"
+	"github.com/leonvanderhaeghen/stockplatform/services/userSvc/internal/application"
+	"github.com/leonvanderhaeghen/stockplatform/services/userSvc/internal/domain"
+)
+
+// emailUserRepository finds users by email or ID; anything else panics on
+// the nil embedded interface
+type emailUserRepository struct {
+	domain.UserRepository
+	users []*domain.User
+}
+
+func (r *emailUserRepository) GetByID(ctx context.Context, id string) (*domain.User, error) {
+	for _, user := range r.users {
+		if user.ID == id {
+			return user, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *emailUserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	for _, user := range r.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return nil, nil
+}
+
+func newTestUserServer(t *testing.T, users ...*domain.User) userv1.UserServiceServer {
+	t.Helper()
+	service := application.NewUserService(&emailUserRepository{users: users}, nil, "test-secret", 0, 0, zap.NewNop())
+	return NewUserServer(service, zap.NewNop())
+}
+
+func newUser(t *testing.T, email string, role domain.Role) *domain.User {
+	t.Helper()
+	user, err := domain.NewUser(email, "secret-password", "Test", "User", role)
+	require.NoError(t, err)
+	return user
+}
+
+// callerContext returns an incoming context as the gateway forwards it for a
+// user with role; an empty user ID gives a caller without identity
+func callerContext(userID string, role domain.Role) context.Context {
+	if userID == "" {
+		return context.Background()
+	}
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		identity.UserIDMetadataKey, userID,
+		identity.RoleMetadataKey, string(role),
+	))
+}
+
+func TestGetUserByEmailAccess(t *testing.T) {
+	alice := newUser(t, "alice@example.com", domain.RoleCustomer)
+	bob := newUser(t, "bob@example.com", domain.RoleCustomer)
+	server := newTestUserServer(t, alice, bob)
+
+	tests := []struct {
+		name     string
+		callerID string
+		role     domain.Role
+		email    string
+		wantCode codes.Code
+	}{
+		{name: "admin looks up anyone", callerID: "admin-1", role: domain.RoleAdmin, email: "alice@example.com", wantCode: codes.OK},
+		{name: "staff looks up anyone", callerID: "staff-1", role: domain.RoleStaff, email: "bob@example.com", wantCode: codes.OK},
+		{name: "customer looks up self", callerID: alice.ID, role: domain.RoleCustomer, email: "alice@example.com", wantCode: codes.OK},
+		{name: "customer looks up another user", callerID: alice.ID, role: domain.RoleCustomer, email: "bob@example.com", wantCode: codes.NotFound},
+		{name: "no forwarded caller", email: "alice@example.com", wantCode: codes.NotFound},
+		{name: "admin looks up an unknown email", callerID: "admin-1", role: domain.RoleAdmin, email: "nobody@example.com", wantCode: codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := server.GetUserByEmail(callerContext(tt.callerID, tt.role), &userv1.GetUserByEmailRequest{Email: tt.email})
+
+			require.Equal(t, tt.wantCode, status.Code(err), "error: %v", err)
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, tt.email, resp.GetUser().GetEmail())
+			}
+		})
+	}
+}
+
+func TestGetUserByEmailDoesNotRevealWhichEmailsExist(t *testing.T) {
+	alice := newUser(t, "alice@example.com", domain.RoleCustomer)
+	bob := newUser(t, "bob@example.com", domain.RoleCustomer)
+	server := newTestUserServer(t, alice, bob)
+	ctx := callerContext(alice.ID, domain.RoleCustomer)
+
+	_, errExisting := server.GetUserByEmail(ctx, &userv1.GetUserByEmailRequest{Email: "bob@example.com"})
+	_, errUnknown := server.GetUserByEmail(ctx, &userv1.GetUserByEmailRequest{Email: "nobody@example.com"})
+
+	require.Error(t, errExisting)
+	assert.Equal(t, status.Convert(errUnknown).Proto().String(), status.Convert(errExisting).Proto().String(),
+		"another user's email and an unknown email must fail the same way")
+}
+
+// signTestToken signs claims with the secret newTestUserServer uses
+func signTestToken(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("test-secret"))
+	require.NoError(t, err)
+	return token
+}
+
+func TestRefreshTokenStatusCodes(t *testing.T) {
+	active := newUser(t, "active@example.com", domain.RoleCustomer)
+	deactivated := newUser(t, "gone@example.com", domain.RoleCustomer)
+	deactivated.Active = false
+	server := newTestUserServer(t, active, deactivated)
+
+	refreshToken := func(user *domain.User, typ string, expiresAt time.Time) string {
+		return signTestToken(t, jwt.MapClaims{"sub": user.ID, "typ": typ, "ver": 0, "exp": expiresAt.Unix()})
+	}
+	later := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		token    string
+		wantCode codes.Code
+	}{
+		{name: "refresh token", token: refreshToken(active, "refresh", later), wantCode: codes.OK},
+		{name: "no token", wantCode: codes.InvalidArgument},
+		{name: "access token", token: refreshToken(active, "access", later), wantCode: codes.Unauthenticated},
+		{name: "expired", token: refreshToken(active, "refresh", time.Now().Add(-time.Minute)), wantCode: codes.Unauthenticated},
+		{name: "deactivated user", token: refreshToken(deactivated, "refresh", later), wantCode: codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := server.RefreshToken(context.Background(), &userv1.RefreshTokenRequest{RefreshToken: tt.token})
+
+			require.Equal(t, tt.wantCode, status.Code(err), "err = %v", err)
+			if tt.wantCode != codes.OK {
+				return
+			}
+			assert.NotEmpty(t, resp.GetToken())
+			assert.Equal(t, tt.token, resp.GetRefreshToken())
+			assert.Equal(t, later.Unix(), resp.GetRefreshExpiresAt())
+			assert.Equal(t, active.ID, resp.GetUser().GetId())
+		})
+	}
+}
+
+func TestUserTimestampsRoundTrip(t *testing.T) {
+	created := time.Date(2024, 5, 1, 9, 30, 15, 123456789, time.UTC)
+	user := &domain.User{
+		ID:        "user-1",
+		Role:      domain.RoleCustomer,
+		CreatedAt: created,
+		UpdatedAt: created.Add(time.Hour),
+		LastLogin: created.Add(2 * time.Hour),
+	}
+
+	pb := toProtoUser(user)
+
+	assert.True(t, pb.GetCreatedAtTs().AsTime().Equal(user.CreatedAt), "created_at_ts = %v", pb.GetCreatedAtTs().AsTime())
+	assert.True(t, pb.GetUpdatedAtTs().AsTime().Equal(user.UpdatedAt), "updated_at_ts = %v", pb.GetUpdatedAtTs().AsTime())
+	assert.True(t, pb.GetLastLoginTs().AsTime().Equal(user.LastLogin), "last_login_ts = %v", pb.GetLastLoginTs().AsTime())
+	assert.Equal(t, "2024-05-01T09:30:15Z", pb.GetCreatedAt(), "the deprecated string form is still sent")
+
+	address := toProtoAddress(&domain.Address{ID: "address-1", CreatedAt: created, UpdatedAt: created})
+	assert.True(t, address.GetCreatedAtTs().AsTime().Equal(created))
+	assert.Equal(t, "2024-05-01T09:30:15Z", address.GetUpdatedAt())
+}
+
+func TestUserWithoutLoginHasNoLastLoginTimestamp(t *testing.T) {
+	pb := toProtoUser(&domain.User{ID: "user-1", Role: domain.RoleCustomer, CreatedAt: time.Now()})
+
+	assert.Nil(t, pb.GetLastLoginTs())
+	assert.Empty(t, pb.GetLastLogin())
+}
@@ -14,6 +14,7 @@ import (
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/leonvanderhaeghen/stockplatform/pkg/version"
 	userv1 "github.com/leonvanderhaeghen/stockplatform/services/userSvc/api/gen/go/proto/user/v1"
 	"github.com/leonvanderhaeghen/stockplatform/services/userSvc/internal/application"
 	"github.com/leonvanderhaeghen/stockplatform/services/userSvc/internal/config"
@@ -42,13 +43,15 @@ func New(cfg *config.Config, db *database.Database, logger *zap.Logger) *Server
 // Initialize sets up the gRPC server with all services
 func (s *Server) Initialize() error {
 	// Create gRPC server
-	s.grpcServer = grpc.NewServer()
+	s.grpcServer = grpc.NewServer(s.config.GRPCLimits.ServerOptions()...)
 
 	// Initialize services
 	userService := application.NewUserService(
 		s.database.UserRepo,
 		s.database.AddressRepo,
 		s.config.JWTSecret,
+		s.config.JWTTTL,
+		s.config.JWTRefreshTTL,
 		s.logger,
 	)
 	_ = application.NewPermissionService(s.database.PermissionRepo) // Initialize but don't use directly
@@ -56,8 +59,8 @@ func (s *Server) Initialize() error {
 	// Initialize AuthService
 	authConfig := &application.AuthConfig{
 		JWTSecret:       []byte(s.config.JWTSecret),
-		TokenDuration:   24 * time.Hour,
-		RefreshDuration: 7 * 24 * time.Hour,
+		TokenDuration:   s.config.JWTTTL,
+		RefreshDuration: s.config.JWTRefreshTTL,
 	}
 	authService, err := application.NewAuthService(s.database.UserRepo, authConfig, s.logger)
 	if err != nil {
@@ -85,7 +88,7 @@ func (s *Server) Initialize() error {
 
 	// Register health check service
 	healthServer := grpchandlers.NewHealthServer(s.logger)
-	grpc_health_v1.RegisterHealthServer(s.grpcServer, healthServer)
+	grpc_health_v1.RegisterHealthServer(s.grpcServer, version.NewHealthServer(healthServer))
 
 	// Enable reflection for development
 	reflection.Register(s.grpcServer)